@@ -6,6 +6,7 @@ package baseserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -14,7 +15,9 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
+	"github.com/gitpod-io/gitpod/common-go/crash"
 	common_grpc "github.com/gitpod-io/gitpod/common-go/grpc"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/pprof"
@@ -48,6 +51,10 @@ func New(name string, opts ...Option) (*Server, error) {
 	}
 	server.builtinServices = newBuiltinServices(server)
 	server.tracingCloser = tracing.Init(name)
+	server.shutdown = NewShutdownManager(options.logger)
+	server.OnShutdown(ShutdownPhaseFlush, "tracing", 0, func(ctx context.Context) error {
+		return server.tracingCloser.Close()
+	})
 
 	server.httpMux = http.NewServeMux()
 	server.http = &http.Server{Handler: std.Handler("", middleware.New(middleware.Config{
@@ -55,7 +62,7 @@ func New(name string, opts ...Option) (*Server, error) {
 			Prefix:   "gitpod",
 			Registry: server.MetricsRegistry(),
 		}),
-	}), server.httpMux)}
+	}), crash.DefaultReporter.Handler(server.httpMux))}
 
 	err = server.initializeMetrics()
 	if err != nil {
@@ -83,7 +90,7 @@ func New(name string, opts ...Option) (*Server, error) {
 //   - Started when baseserver is configured WithGRPCPort (port is non-negative)
 //   - Use Server.GRPC() to get access to the underlying grpc.Server and register services
 //   - (optional) HTTP server
-//   - Currently does not come with any standard HTTP middlewares
+//   - Comes with HTTP metrics and panic recovery middlewares
 //   - Started when baseserver is configured WithHTTPPort (port is non-negative)
 //   - Use Server.HTTPMux() to get access to the root handler and register your endpoints
 type Server struct {
@@ -105,6 +112,8 @@ type Server struct {
 
 	tracingCloser io.Closer
 
+	shutdown *ShutdownManager
+
 	// listening indicates the server is serving. When closed, the server is in the process of graceful termination.
 	listening chan struct{}
 	closeOnce sync.Once
@@ -189,6 +198,12 @@ func (s *Server) Close() error {
 	return err
 }
 
+// OnShutdown registers a hook which runs when the server shuts down. Hooks of ShutdownPhaseStopAccepting run
+// before the gRPC and HTTP servers stop, all other hooks afterwards. The hooks share the close timeout of the server.
+func (s *Server) OnShutdown(phase ShutdownPhase, name string, timeout time.Duration, hook ShutdownHook) {
+	s.shutdown.Register(phase, name, timeout, hook)
+}
+
 func (s *Server) Logger() *logrus.Entry {
 	return s.options.logger
 }
@@ -222,8 +237,14 @@ func (s *Server) close(ctx context.Context) error {
 	s.Logger().Info("Received graceful shutdown request.")
 	close(s.listening)
 
+	var errs []error
+	errs = append(errs, s.shutdown.run(ctx, ShutdownPhaseStopAccepting))
+
 	if s.grpc != nil {
-		s.grpc.GracefulStop()
+		err := GracefulStopGRPC(ctx, s.grpc)
+		if err != nil {
+			s.Logger().WithError(err).Warn("GRPC server did not stop gracefully in time, cancelled remaining RPCs.")
+		}
 		// s.grpc.GracefulStop() also closes the underlying net.Listener, we just release the reference.
 		s.grpcListener = nil
 		s.Logger().Info("GRPC server terminated.")
@@ -239,6 +260,9 @@ func (s *Server) close(ctx context.Context) error {
 		s.Logger().Info("HTTP server terminated.")
 	}
 
+	errs = append(errs, s.shutdown.run(ctx, ShutdownPhaseDrain))
+	errs = append(errs, s.shutdown.run(ctx, ShutdownPhaseFlush))
+
 	// Always terminate builtin server last, we want to keep it running for as long as possible
 	err := s.builtinServices.Close()
 	if err != nil {
@@ -246,14 +270,32 @@ func (s *Server) close(ctx context.Context) error {
 	}
 	s.Logger().Info("Debug server terminated.")
 
-	err = s.tracingCloser.Close()
+	err = errors.Join(errs...)
 	if err != nil {
-		return fmt.Errorf("failed to close tracing: %w", err)
+		return fmt.Errorf("failed to shut down gracefully: %w", err)
 	}
 
 	return nil
 }
 
+// GracefulStopGRPC stops the server from accepting new RPCs and waits for the pending ones to finish.
+// If ctx is done first, the pending RPCs are cancelled.
+func GracefulStopGRPC(ctx context.Context, srv *grpc.Server) error {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		srv.Stop()
+		<-stopped
+		return ctx.Err()
+	}
+}
+
 func (s *Server) isClosing() bool {
 	select {
 	case <-s.listening:
@@ -353,6 +395,10 @@ func (s *Server) initializeMetrics() error {
 		return fmt.Errorf("failed to register log metrics: %w", err)
 	}
 
+	if err := s.MetricsRegistry().Register(crash.DefaultReporter); err != nil {
+		return fmt.Errorf("failed to register crash metrics: %w", err)
+	}
+
 	reportServerVersion(s.options.version)
 
 	return nil
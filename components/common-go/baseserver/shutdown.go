@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package baseserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ShutdownPhase orders the hooks which run while a server shuts down
+type ShutdownPhase int
+
+const (
+	// ShutdownPhaseStopAccepting hooks stop taking on new work, e.g. by stopping RPC servers
+	ShutdownPhaseStopAccepting ShutdownPhase = iota
+	// ShutdownPhaseDrain hooks finish work which is already in progress, e.g. workspace backups
+	ShutdownPhaseDrain
+	// ShutdownPhaseFlush hooks flush buffered telemetry, e.g. traces
+	ShutdownPhaseFlush
+
+	numShutdownPhases
+)
+
+func (p ShutdownPhase) String() string {
+	switch p {
+	case ShutdownPhaseStopAccepting:
+		return "stop-accepting"
+	case ShutdownPhaseDrain:
+		return "drain"
+	case ShutdownPhaseFlush:
+		return "flush"
+	default:
+		return fmt.Sprintf("phase-%d", int(p))
+	}
+}
+
+// ShutdownHook is called while a server shuts down. The hook must return once ctx is done.
+type ShutdownHook func(ctx context.Context) error
+
+type shutdownHook struct {
+	Name    string
+	Timeout time.Duration
+	Hook    ShutdownHook
+}
+
+// ShutdownManager sequences the shutdown of a server: it first stops accepting new work, then waits for
+// in-flight work to finish and finally flushes telemetry. All phases share the budget of the context
+// passed to Shutdown. Drain hooks should use a timeout below that budget, such that telemetry is still
+// flushed when in-flight work does not finish in time.
+type ShutdownManager struct {
+	log *logrus.Entry
+
+	mu    sync.Mutex
+	hooks [numShutdownPhases][]shutdownHook
+	once  sync.Once
+	err   error
+}
+
+// NewShutdownManager creates a new shutdown manager
+func NewShutdownManager(log *logrus.Entry) *ShutdownManager {
+	return &ShutdownManager{log: log}
+}
+
+// Register adds a hook to a shutdown phase. Hooks of a phase run one after the other in the order they were
+// registered. A hook's context expires after timeout, or when the overall shutdown budget is spent if that
+// happens earlier. A timeout of zero bounds the hook by the overall budget only.
+func (m *ShutdownManager) Register(phase ShutdownPhase, name string, timeout time.Duration, hook ShutdownHook) {
+	if phase < 0 || phase >= numShutdownPhases {
+		panic(fmt.Sprintf("invalid shutdown phase %d", phase))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks[phase] = append(m.hooks[phase], shutdownHook{Name: name, Timeout: timeout, Hook: hook})
+}
+
+// Shutdown runs the hooks of all phases. Failing hooks do not stop the shutdown. Shutdown runs only once,
+// subsequent calls return the result of the first one.
+func (m *ShutdownManager) Shutdown(ctx context.Context) error {
+	m.once.Do(func() {
+		var errs []error
+		for phase := ShutdownPhase(0); phase < numShutdownPhases; phase++ {
+			errs = append(errs, m.run(ctx, phase))
+		}
+		m.err = errors.Join(errs...)
+	})
+	return m.err
+}
+
+func (m *ShutdownManager) run(ctx context.Context, phase ShutdownPhase) error {
+	m.mu.Lock()
+	hooks := append([]shutdownHook(nil), m.hooks[phase]...)
+	m.mu.Unlock()
+
+	var errs []error
+	for _, h := range hooks {
+		hctx, cancel := ctx, context.CancelFunc(func() {})
+		if h.Timeout > 0 {
+			hctx, cancel = context.WithTimeout(ctx, h.Timeout)
+		}
+
+		start := time.Now()
+		err := h.Hook(hctx)
+		cancel()
+
+		log := m.log.WithField("phase", phase.String()).WithField("hook", h.Name).WithField("duration", time.Since(start).String())
+		if err != nil {
+			log.WithError(err).Warn("shutdown hook failed")
+			errs = append(errs, fmt.Errorf("%s: %w", h.Name, err))
+			continue
+		}
+		log.Debug("shutdown hook finished")
+	}
+	return errors.Join(errs...)
+}
+
+// InFlight tracks operations a server must finish before it shuts down, e.g. workspace backups.
+// Operations run on a context which is not cancelled when the server receives a termination signal,
+// but only once the drain budget is spent.
+type InFlight struct {
+	mu     sync.Mutex
+	active int
+	idle   chan struct{}
+
+	abortCtx context.Context
+	abort    context.CancelFunc
+}
+
+// NewInFlight creates a new in-flight operation tracker
+func NewInFlight() *InFlight {
+	abortCtx, abort := context.WithCancel(context.Background())
+	return &InFlight{abortCtx: abortCtx, abort: abort}
+}
+
+// Begin registers an operation. The returned context carries the values of ctx but is only cancelled
+// once Drain gives up waiting. Callers must call done once the operation has finished.
+func (f *InFlight) Begin(ctx context.Context) (opCtx context.Context, done func()) {
+	f.mu.Lock()
+	f.active++
+	f.mu.Unlock()
+
+	opCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(f.abortCtx, cancel)
+
+	var once sync.Once
+	return opCtx, func() {
+		once.Do(func() {
+			stop()
+			cancel()
+
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.active--
+			if f.active == 0 && f.idle != nil {
+				close(f.idle)
+				f.idle = nil
+			}
+		})
+	}
+}
+
+// Active returns the number of operations in progress
+func (f *InFlight) Active() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.active
+}
+
+// Drain waits for all operations to finish. If ctx is done first, Drain cancels the remaining
+// operations and returns an error. Drain is meant to be registered as ShutdownPhaseDrain hook.
+func (f *InFlight) Drain(ctx context.Context) error {
+	f.mu.Lock()
+	if f.active == 0 {
+		f.mu.Unlock()
+		return nil
+	}
+	if f.idle == nil {
+		f.idle = make(chan struct{})
+	}
+	idle := f.idle
+	active := f.active
+	f.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		f.abort()
+		return fmt.Errorf("%d operations did not finish in time: %w", active, ctx.Err())
+	}
+}
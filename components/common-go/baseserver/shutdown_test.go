@@ -0,0 +1,99 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package baseserver_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShutdownManager_RunsPhasesInOrder(t *testing.T) {
+	m := baseserver.NewShutdownManager(log.New())
+
+	var order []string
+	hook := func(name string, err error) baseserver.ShutdownHook {
+		return func(ctx context.Context) error {
+			order = append(order, name)
+			return err
+		}
+	}
+	m.Register(baseserver.ShutdownPhaseFlush, "flush", 0, hook("flush", nil))
+	m.Register(baseserver.ShutdownPhaseDrain, "drain-1", 0, hook("drain-1", errors.New("failed")))
+	m.Register(baseserver.ShutdownPhaseDrain, "drain-2", 0, hook("drain-2", nil))
+	m.Register(baseserver.ShutdownPhaseStopAccepting, "stop", 0, hook("stop", nil))
+
+	err := m.Shutdown(context.Background())
+	require.ErrorContains(t, err, "drain-1: failed")
+	require.Equal(t, []string{"stop", "drain-1", "drain-2", "flush"}, order)
+
+	// shutdown runs only once
+	require.Equal(t, err, m.Shutdown(context.Background()))
+	require.Len(t, order, 4)
+}
+
+func TestShutdownManager_HookTimeout(t *testing.T) {
+	m := baseserver.NewShutdownManager(log.New())
+
+	var flushed bool
+	m.Register(baseserver.ShutdownPhaseDrain, "slow", 50*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	m.Register(baseserver.ShutdownPhaseFlush, "flush", 0, func(ctx context.Context) error {
+		flushed = ctx.Err() == nil
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := m.Shutdown(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.True(t, flushed, "flush hook must run within the remaining budget")
+}
+
+func TestInFlight_Drain(t *testing.T) {
+	f := baseserver.NewInFlight()
+	require.NoError(t, f.Drain(context.Background()), "nothing to drain")
+
+	signalCtx, signal := context.WithCancel(context.Background())
+	opCtx, done := f.Begin(signalCtx)
+	signal()
+	require.NoError(t, opCtx.Err(), "operations must survive the termination signal")
+	require.Equal(t, 1, f.Active())
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		done()
+	}()
+	require.NoError(t, f.Drain(context.Background()))
+	require.Equal(t, 0, f.Active())
+
+	// calling done twice must not corrupt the count
+	done()
+	require.Equal(t, 0, f.Active())
+}
+
+func TestInFlight_DrainTimeout(t *testing.T) {
+	f := baseserver.NewInFlight()
+	opCtx, done := f.Begin(context.Background())
+	defer done()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := f.Drain(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	select {
+	case <-opCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("operation was not cancelled after the drain budget was spent")
+	}
+}
@@ -45,6 +45,8 @@ type CpuStats struct {
 
 type MemoryStats struct {
 	InactiveFileTotal uint64
+	// Shmem is the memory used by shared memory and tmpfs
+	Shmem uint64
 }
 
 func ReadSingleValue(path string) (uint64, error) {
@@ -52,6 +52,13 @@ func (c *Memory) High() (uint64, error) {
 	return cgroups.ReadSingleValue(path)
 }
 
+// Events returns how often the cgroup and its descendants hit their memory limits, keyed by event,
+// e.g. high, max and oom_kill. See memory.events in the cgroup v2 documentation.
+func (m *Memory) Events() (map[string]uint64, error) {
+	path := filepath.Join(m.path, "memory.events")
+	return cgroups.ReadFlatKeyedFile(path)
+}
+
 func (m *Memory) Stat() (*cgroups.MemoryStats, error) {
 	path := filepath.Join(m.path, "memory.stat")
 	statMap, err := cgroups.ReadFlatKeyedFile(path)
@@ -61,6 +68,7 @@ func (m *Memory) Stat() (*cgroups.MemoryStats, error) {
 
 	return &cgroups.MemoryStats{
 		InactiveFileTotal: statMap["inactive_file"],
+		Shmem:             statMap["shmem"],
 	}, nil
 }
 
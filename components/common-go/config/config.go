@@ -0,0 +1,252 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package config loads the JSON configuration files of components.
+//
+// Configuration structs describe themselves using struct tags:
+//
+//	type Config struct {
+//		Namespace string        `json:"namespace" validate:"required"`
+//		Timeout   util.Duration `json:"timeout" default:"30s" env:"MY_COMPONENT_TIMEOUT"`
+//	}
+//
+// Loading a configuration decodes the file strictly, i.e. unknown fields are an error, then applies the defaults
+// to all fields which are still zero, overrides fields with the environment variables which are set, checks the
+// required fields and finally calls the Validate method of the configuration if it has one.
+//
+// Values of default and env tags are parsed like the JSON value of the field, and may omit the quotes of strings,
+// e.g. both "30s" and "\"30s\"" are valid defaults of a util.Duration.
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/watch"
+)
+
+const (
+	tagDefault  = "default"
+	tagEnv      = "env"
+	tagValidate = "validate"
+
+	ruleRequired = "required"
+)
+
+// Validator is implemented by configurations which validate themselves once loaded
+type Validator interface {
+	Validate() error
+}
+
+type options struct {
+	lookupEnv func(key string) (string, bool)
+}
+
+// Option configures how a configuration is loaded
+type Option func(*options)
+
+// WithLookupEnv sets the function environment overrides are looked up with. Defaults to os.LookupEnv.
+func WithLookupEnv(lookup func(key string) (string, bool)) Option {
+	return func(o *options) {
+		o.lookupEnv = lookup
+	}
+}
+
+// Load reads and parses the configuration in fn
+func Load[T any](fn string, opts ...Option) (*T, error) {
+	ctnt, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read configuration: %w", err)
+	}
+
+	cfg, err := Parse[T](ctnt, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fn, err)
+	}
+	return cfg, nil
+}
+
+// Parse decodes the configuration, applies its defaults and environment overrides, and validates it
+func Parse[T any](ctnt []byte, opts ...Option) (*T, error) {
+	o := options{lookupEnv: os.LookupEnv}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var cfg T
+	dec := json.NewDecoder(bytes.NewReader(ctnt))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode configuration: %w", err)
+	}
+
+	err = apply(reflect.ValueOf(&cfg).Elem(), "", &o)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := any(&cfg).(Validator); ok {
+		err = v.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration: %w", err)
+		}
+	}
+
+	return &cfg, nil
+}
+
+// Watch loads the configuration in fn whenever the file changes and passes it to onChange. A configuration which
+// cannot be loaded is logged and skipped, such that the component keeps on running with the last good one.
+func Watch[T any](ctx context.Context, fn string, onChange func(cfg *T), opts ...Option) error {
+	return watch.File(ctx, fn, func() {
+		cfg, err := Load[T](fn, opts...)
+		if err != nil {
+			log.WithError(err).WithField("path", fn).Warn("cannot reload configuration, keeping the current one")
+			return
+		}
+		onChange(cfg)
+	})
+}
+
+// apply walks the configuration and applies the default, env and validate tags of all fields
+func apply(v reflect.Value, path string, o *options) error {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return apply(v.Elem(), path, o)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			err := apply(v.Index(i), fmt.Sprintf("%s[%d]", path, i), o)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			// map values are not addressable, hence we can only act on the ones behind a pointer
+			if iter.Value().Kind() != reflect.Pointer {
+				continue
+			}
+			err := apply(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key()), o)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Struct:
+		// handled below
+
+	default:
+		return nil
+	}
+
+	tpe := v.Type()
+	for i := 0; i < tpe.NumField(); i++ {
+		field := tpe.Field(i)
+		if field.Anonymous && !field.IsExported() {
+			// like encoding/json we promote the exported fields of embedded structs with unexported types
+			err := apply(v.Field(i), path, o)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldPath := path
+		if !field.Anonymous {
+			fieldPath = joinPath(path, fieldName(field))
+		}
+		fv := v.Field(i)
+
+		if def, ok := field.Tag.Lookup(tagDefault); ok && fv.IsZero() {
+			err := setFromString(fv, def)
+			if err != nil {
+				return fmt.Errorf("%s: invalid default %q: %w", fieldPath, def, err)
+			}
+		}
+
+		if key, ok := field.Tag.Lookup(tagEnv); ok {
+			if val, ok := o.lookupEnv(key); ok {
+				err := setFromString(fv, val)
+				if err != nil {
+					return fmt.Errorf("%s: invalid value of %s: %w", fieldPath, key, err)
+				}
+			}
+		}
+
+		if rules, ok := field.Tag.Lookup(tagValidate); ok {
+			for _, rule := range strings.Split(rules, ",") {
+				switch rule {
+				case ruleRequired:
+					if fv.IsZero() {
+						return fmt.Errorf("%s is required", fieldPath)
+					}
+				default:
+					return fmt.Errorf("%s: unknown validation rule %q", fieldPath, rule)
+				}
+			}
+		}
+
+		err := apply(fv, fieldPath, o)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// setFromString parses val like the JSON value of v. Strings may omit their quotes.
+func setFromString(v reflect.Value, val string) error {
+	res := reflect.New(v.Type())
+	if v.Kind() == reflect.String && !res.Type().Implements(jsonUnmarshalerType) {
+		res.Elem().SetString(val)
+		v.Set(res.Elem())
+		return nil
+	}
+
+	err := json.Unmarshal([]byte(val), res.Interface())
+	if err != nil {
+		quoted, _ := json.Marshal(val)
+		if json.Unmarshal(quoted, res.Interface()) != nil {
+			return err
+		}
+	}
+	v.Set(res.Elem())
+	return nil
+}
+
+// fieldName returns the name of the field in the JSON encoding
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+	return name
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+)
+
+type testHook struct {
+	Name    string        `json:"name" validate:"required"`
+	Timeout util.Duration `json:"timeout" default:"5s"`
+}
+
+type testEmbedded struct {
+	Region string `json:"region" default:"eu"`
+}
+
+type testConfig struct {
+	testEmbedded
+
+	Namespace string               `json:"namespace" validate:"required"`
+	Port      int                  `json:"port" default:"8080" env:"TEST_PORT"`
+	Timeout   util.Duration        `json:"timeout" default:"1m"`
+	Verbose   bool                 `json:"verbose" env:"TEST_VERBOSE"`
+	Mode      string               `json:"mode,omitempty" default:"fast" env:"TEST_MODE"`
+	Hooks     []*testHook          `json:"hooks,omitempty"`
+	Classes   map[string]*testHook `json:"classes,omitempty"`
+	Nested    *struct {
+		Attempts int `json:"attempts" default:"3"`
+	} `json:"nested,omitempty"`
+}
+
+func (c *testConfig) Validate() error {
+	if c.Port > 65535 {
+		return errors.New("port out of range")
+	}
+	return nil
+}
+
+func TestParse(t *testing.T) {
+	type Expectation struct {
+		Config *testConfig
+		Error  string
+	}
+	tests := []struct {
+		Name        string
+		Input       string
+		Env         map[string]string
+		Expectation Expectation
+	}{
+		{
+			Name:  "defaults",
+			Input: `{"namespace": "default"}`,
+			Expectation: Expectation{Config: &testConfig{
+				testEmbedded: testEmbedded{Region: "eu"},
+				Namespace:    "default",
+				Port:         8080,
+				Timeout:      util.Duration(time.Minute),
+				Mode:         "fast",
+			}},
+		},
+		{
+			Name:  "values beat defaults",
+			Input: `{"namespace": "default", "region": "us", "port": 9000, "timeout": "10s", "mode": "slow"}`,
+			Expectation: Expectation{Config: &testConfig{
+				testEmbedded: testEmbedded{Region: "us"},
+				Namespace:    "default",
+				Port:         9000,
+				Timeout:      util.Duration(10 * time.Second),
+				Mode:         "slow",
+			}},
+		},
+		{
+			Name:  "environment beats values",
+			Input: `{"namespace": "default", "port": 9000}`,
+			Env:   map[string]string{"TEST_PORT": "9090", "TEST_VERBOSE": "true", "TEST_MODE": "quoteless"},
+			Expectation: Expectation{Config: &testConfig{
+				testEmbedded: testEmbedded{Region: "eu"},
+				Namespace:    "default",
+				Port:         9090,
+				Timeout:      util.Duration(time.Minute),
+				Verbose:      true,
+				Mode:         "quoteless",
+			}},
+		},
+		{
+			Name:  "nested",
+			Input: `{"namespace": "default", "hooks": [{"name": "a"}, {"name": "b", "timeout": "1s"}], "classes": {"default": {"name": "c"}}, "nested": {}}`,
+			Expectation: Expectation{Config: &testConfig{
+				testEmbedded: testEmbedded{Region: "eu"},
+				Namespace:    "default",
+				Port:         8080,
+				Timeout:      util.Duration(time.Minute),
+				Mode:         "fast",
+				Hooks: []*testHook{
+					{Name: "a", Timeout: util.Duration(5 * time.Second)},
+					{Name: "b", Timeout: util.Duration(time.Second)},
+				},
+				Classes: map[string]*testHook{
+					"default": {Name: "c", Timeout: util.Duration(5 * time.Second)},
+				},
+				Nested: &struct {
+					Attempts int `json:"attempts" default:"3"`
+				}{Attempts: 3},
+			}},
+		},
+		{
+			Name:        "missing required field",
+			Input:       `{}`,
+			Expectation: Expectation{Error: "namespace is required"},
+		},
+		{
+			Name:        "missing required nested field",
+			Input:       `{"namespace": "default", "hooks": [{"name": "a"}, {}]}`,
+			Expectation: Expectation{Error: "hooks[1].name is required"},
+		},
+		{
+			Name:        "unknown field",
+			Input:       `{"namespace": "default", "nmespace": "typo"}`,
+			Expectation: Expectation{Error: `cannot decode configuration: json: unknown field "nmespace"`},
+		},
+		{
+			Name:        "invalid environment override",
+			Input:       `{"namespace": "default"}`,
+			Env:         map[string]string{"TEST_PORT": "eighty"},
+			Expectation: Expectation{Error: "port: invalid value of TEST_PORT: invalid character 'e' looking for beginning of value"},
+		},
+		{
+			Name:        "validate",
+			Input:       `{"namespace": "default", "port": 70000}`,
+			Expectation: Expectation{Error: "invalid configuration: port out of range"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg, err := Parse[testConfig]([]byte(test.Input), WithLookupEnv(func(key string) (string, bool) {
+				v, ok := test.Env[key]
+				return v, ok
+			}))
+
+			var act Expectation
+			if err != nil {
+				act.Error = err.Error()
+			} else {
+				act.Config = cfg
+			}
+			if diff := cmp.Diff(test.Expectation, act, cmp.AllowUnexported(testConfig{})); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseInvalidTags(t *testing.T) {
+	type invalidDefault struct {
+		Port int `json:"port" default:"eighty"`
+	}
+	_, err := Parse[invalidDefault]([]byte(`{}`))
+	if err == nil {
+		t.Error("expected an error for an invalid default")
+	}
+
+	type unknownRule struct {
+		Port int `json:"port" validate:"positive"`
+	}
+	_, err = Parse[unknownRule]([]byte(`{"port": 1}`))
+	if err == nil {
+		t.Error("expected an error for an unknown validation rule")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "config.json")
+	err := os.WriteFile(fn, []byte(`{"namespace": "default"}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load[testConfig](fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Namespace != "default" || cfg.Port != 8080 {
+		t.Errorf("unexpected configuration: %+v", cfg)
+	}
+
+	_, err = Load[testConfig](filepath.Join(t.TempDir(), "missing.json"))
+	if err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package crash recovers panics in gRPC and HTTP handlers and reports them as crashes.
+package crash
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+)
+
+// EndpointEnvVar names the environment variable which configures the endpoint of DefaultReporter
+const EndpointEnvVar = "GITPOD_CRASH_REPORT_ENDPOINT"
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+)
+
+var (
+	// DefaultReporter is used by the default gRPC server options and the baseserver
+	DefaultReporter = NewReporter(os.Getenv(EndpointEnvVar))
+)
+
+// Report describes a panic in a request handler
+type Report struct {
+	// ServiceContext identifies the service and version that crashed, see log.ServiceContext
+	ServiceContext interface{} `json:"serviceContext,omitempty"`
+	Time           time.Time   `json:"time"`
+	Panic          string      `json:"panic"`
+	Stack          string      `json:"stack"`
+	Protocol       string      `json:"protocol"`
+	// Method is the full gRPC method or the HTTP method
+	Method    string `json:"method"`
+	Path      string `json:"path,omitempty"`
+	UserAgent string `json:"userAgent,omitempty"`
+	Peer      string `json:"peer,omitempty"`
+	TraceID   string `json:"traceId,omitempty"`
+}
+
+// Reporter logs and counts crashes, and forwards them to a crash-reporting endpoint if one is configured
+type Reporter struct {
+	// Endpoint receives crash reports as JSON POST requests. Reports are not forwarded if empty.
+	Endpoint string
+	Client   *http.Client
+
+	panicsCounter *prometheus.CounterVec
+}
+
+// NewReporter creates a reporter which forwards crash reports to endpoint, unless it is empty
+func NewReporter(endpoint string) *Reporter {
+	return &Reporter{
+		Endpoint: endpoint,
+		Client:   &http.Client{Timeout: 10 * time.Second},
+		panicsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "gitpod_panics_total",
+			Help: "Total number of panics recovered in request handlers by protocol",
+		}, []string{"protocol"}),
+	}
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once
+// the last descriptor has been sent.
+func (r *Reporter) Describe(ch chan<- *prometheus.Desc) {
+	r.panicsCounter.Describe(ch)
+}
+
+// Collect is called by the Prometheus registry when collecting
+// metrics. The implementation sends each collected metric via the
+// provided channel and returns once the last metric has been sent.
+func (r *Reporter) Collect(ch chan<- prometheus.Metric) {
+	r.panicsCounter.Collect(ch)
+}
+
+// Report logs the crash, counts it and forwards it to the endpoint in the background
+func (r *Reporter) Report(ctx context.Context, rep Report) {
+	if rep.Time.IsZero() {
+		rep.Time = time.Now()
+	}
+	if rep.ServiceContext == nil {
+		rep.ServiceContext = log.Log.Data[log.ServiceContextField]
+	}
+	if rep.TraceID == "" {
+		if span := opentracing.SpanFromContext(ctx); span != nil {
+			rep.TraceID = tracing.GetTraceID(span)
+		}
+	}
+
+	log.Extract(ctx).WithFields(logrus.Fields{
+		"stack":    rep.Stack,
+		"protocol": rep.Protocol,
+		"method":   rep.Method,
+		"path":     rep.Path,
+		"peer":     rep.Peer,
+		"traceID":  rep.TraceID,
+	}).Errorf("[PANIC] %s", rep.Panic)
+	r.panicsCounter.WithLabelValues(rep.Protocol).Inc()
+
+	if r.Endpoint == "" {
+		return
+	}
+	go func() {
+		err := r.forward(rep)
+		if err != nil {
+			log.WithError(err).WithField("endpoint", r.Endpoint).Warn("cannot forward crash report")
+		}
+	}()
+}
+
+func (r *Reporter) forward(rep Report) error {
+	body, err := json.Marshal(rep)
+	if err != nil {
+		return err
+	}
+	resp, err := r.Client.Post(r.Endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("crash-reporting endpoint responded with %s", resp.Status)
+	}
+	return nil
+}
+
+// UnaryServerInterceptor recovers panics in unary gRPC handlers, reports them and returns codes.Internal.
+// It must be the last interceptor in the chain, so that upstream interceptors see the error.
+func (r *Reporter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = r.recoverGRPC(ctx, info.FullMethod, p)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor recovers panics in streaming gRPC handlers, reports them and returns codes.Internal.
+// It must be the last interceptor in the chain, so that upstream interceptors see the error.
+func (r *Reporter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				err = r.recoverGRPC(stream.Context(), info.FullMethod, p)
+			}
+		}()
+		return handler(srv, stream)
+	}
+}
+
+func (r *Reporter) recoverGRPC(ctx context.Context, method string, p interface{}) error {
+	rep := Report{
+		Panic:    fmt.Sprint(p),
+		Stack:    string(debug.Stack()),
+		Protocol: ProtocolGRPC,
+		Method:   method,
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ua := md.Get("user-agent"); len(ua) > 0 {
+			rep.UserAgent = ua[0]
+		}
+	}
+	if pr, ok := peer.FromContext(ctx); ok && pr.Addr != nil {
+		rep.Peer = pr.Addr.String()
+	}
+	r.Report(ctx, rep)
+
+	return status.Errorf(codes.Internal, "%s", p)
+}
+
+// Handler recovers panics in HTTP handlers, reports them and responds with 500 Internal Server Error.
+// Panics with http.ErrAbortHandler are passed on, because they are the intended way of aborting a response.
+func (r *Reporter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+			if p == http.ErrAbortHandler {
+				panic(p)
+			}
+
+			r.Report(req.Context(), Report{
+				Panic:     fmt.Sprint(p),
+				Stack:     string(debug.Stack()),
+				Protocol:  ProtocolHTTP,
+				Method:    req.Method,
+				Path:      req.URL.Path,
+				UserAgent: req.UserAgent(),
+				Peer:      req.RemoteAddr,
+			})
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		}()
+		next.ServeHTTP(w, req)
+	})
+}
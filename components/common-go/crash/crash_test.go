@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package crash
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHandler(t *testing.T) {
+	reports := make(chan Report, 1)
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var rep Report
+		err := json.NewDecoder(r.Body).Decode(&rep)
+		if err != nil {
+			t.Errorf("cannot decode crash report: %v", err)
+		}
+		reports <- rep
+	}))
+	defer endpoint.Close()
+
+	reporter := NewReporter(endpoint.URL)
+	handler := reporter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/crash", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("unexpected status code: %d", rec.Code)
+	}
+	if cnt := testutil.ToFloat64(reporter.panicsCounter.WithLabelValues(ProtocolHTTP)); cnt != 1 {
+		t.Errorf("unexpected panic count: %v", cnt)
+	}
+
+	select {
+	case rep := <-reports:
+		if rep.Panic != "boom" || rep.Protocol != ProtocolHTTP || rep.Method != http.MethodPost || rep.Path != "/crash" {
+			t.Errorf("unexpected crash report: %+v", rep)
+		}
+		if !strings.Contains(rep.Stack, "crash_test.go") {
+			t.Errorf("crash report stack does not contain the panicking handler:\n%s", rep.Stack)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("crash report was not forwarded")
+	}
+}
+
+func TestHandlerErrAbortHandler(t *testing.T) {
+	reporter := NewReporter("")
+	handler := reporter.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+
+	defer func() {
+		if p := recover(); p != http.ErrAbortHandler {
+			t.Errorf("expected http.ErrAbortHandler to be passed on, got %v", p)
+		}
+		if cnt := testutil.ToFloat64(reporter.panicsCounter.WithLabelValues(ProtocolHTTP)); cnt != 0 {
+			t.Errorf("unexpected panic count: %v", cnt)
+		}
+	}()
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	reporter := NewReporter("")
+	interceptor := reporter.UnaryServerInterceptor()
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Crash"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("expected codes.Internal, got %v", err)
+	}
+	if cnt := testutil.ToFloat64(reporter.panicsCounter.WithLabelValues(ProtocolGRPC)); cnt != 1 {
+		t.Errorf("unexpected panic count: %v", cnt)
+	}
+
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Ok"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	if err != nil || resp != "ok" {
+		t.Errorf("unexpected response: %v, %v", resp, err)
+	}
+}
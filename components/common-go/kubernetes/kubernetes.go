@@ -45,6 +45,9 @@ const (
 	// WorkspaceManaged indicates which component is responsible for managing the workspace
 	WorkspaceManagedByLabel = "gitpod.io/managed-by"
 
+	// IdempotencyKeyLabel contains the hash of the idempotency key the workspace was started with
+	IdempotencyKeyLabel = "gitpod.io/idempotencyKey"
+
 	// CPULimitAnnotation enforces a strict CPU limit on a workspace by virtue of ws-daemon
 	CPULimitAnnotation = "gitpod.io/cpuLimit"
 
@@ -73,9 +76,24 @@ const (
 	// workspaceCpuBurstLimit denotes the cpu burst limit of a workspace
 	WorkspaceCpuBurstLimitAnnotation = "gitpod.io/cpuBurstLimit"
 
+	// WorkspaceCpuBurstCreditAnnotation denotes the CPU time a workspace can save up for bursts while it is under CPU pressure, e.g. 10m
+	WorkspaceCpuBurstCreditAnnotation = "gitpod.io/cpuBurstCredit"
+
 	// workspaceNetConnLimit denotes the maximum number of connections a workspace can make per minute
 	WorkspaceNetConnLimitAnnotation = "gitpod.io/netConnLimitPerMinute"
 
+	// WorkspaceNetEgressBandwidthAnnotation limits the rate at which a workspace sends data in bits per second, e.g. 100M
+	WorkspaceNetEgressBandwidthAnnotation = "gitpod.io/netEgressBandwidth"
+
+	// WorkspaceNetIngressBandwidthAnnotation limits the rate at which a workspace receives data in bits per second, e.g. 100M
+	WorkspaceNetIngressBandwidthAnnotation = "gitpod.io/netIngressBandwidth"
+
+	// WorkspaceMemoryHighAnnotation sets the memory usage above which a workspace is throttled and reclaimed, e.g. 7Gi
+	WorkspaceMemoryHighAnnotation = "gitpod.io/memoryHigh"
+
+	// WorkspaceMemorySwapAnnotation sets the amount of the node's zram-backed swap a workspace can use, e.g. 4Gi
+	WorkspaceMemorySwapAnnotation = "gitpod.io/memorySwap"
+
 	// workspacePressureStallInfo indicates if pressure stall information should be retrieved for the workspace
 	WorkspacePressureStallInfoAnnotation = "gitpod.io/psi"
 
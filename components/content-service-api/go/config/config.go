@@ -6,8 +6,10 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	"github.com/gitpod-io/gitpod/common-go/util"
 )
 
 // StorageConfig configures the remote storage we use
@@ -125,9 +127,27 @@ type UsageReportConfig struct {
 	BucketName string `json:"bucketName"`
 }
 
+// DefaultForensicsRetention is how long forensic bundles are kept unless configured otherwise
+const DefaultForensicsRetention = 14 * 24 * time.Hour
+
+// ForensicsConfig configures the access to the forensic bundles of abnormally terminated workspaces
+type ForensicsConfig struct {
+	// Retention is how long forensic bundles are kept. Expired bundles are deleted once they are listed.
+	Retention util.Duration `json:"retention,omitempty"`
+}
+
+// GetRetention returns the configured retention or its default
+func (c ForensicsConfig) GetRetention() time.Duration {
+	if c.Retention <= 0 {
+		return DefaultForensicsRetention
+	}
+	return time.Duration(c.Retention)
+}
+
 type ServiceConfig struct {
-	Service baseserver.ServerConfiguration `json:"service"`
-	Storage StorageConfig                  `json:"storage"`
+	Service   baseserver.ServerConfiguration `json:"service"`
+	Storage   StorageConfig                  `json:"storage"`
+	Forensics ForensicsConfig                `json:"forensics,omitempty"`
 	// Deprecated
 	_ UsageReportConfig `json:"usageReport"`
 }
@@ -0,0 +1,149 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: forensics.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ForensicsServiceClient is the client API for ForensicsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ForensicsServiceClient interface {
+	// ListForensicBundles returns the forensic bundles of the specified workspace instance which are not expired yet
+	ListForensicBundles(ctx context.Context, in *ListForensicBundlesRequest, opts ...grpc.CallOption) (*ListForensicBundlesResponse, error)
+	// ForensicBundleDownloadURL provides a URL from where a forensic bundle can be downloaded from
+	ForensicBundleDownloadURL(ctx context.Context, in *ForensicBundleDownloadURLRequest, opts ...grpc.CallOption) (*ForensicBundleDownloadURLResponse, error)
+}
+
+type forensicsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewForensicsServiceClient(cc grpc.ClientConnInterface) ForensicsServiceClient {
+	return &forensicsServiceClient{cc}
+}
+
+func (c *forensicsServiceClient) ListForensicBundles(ctx context.Context, in *ListForensicBundlesRequest, opts ...grpc.CallOption) (*ListForensicBundlesResponse, error) {
+	out := new(ListForensicBundlesResponse)
+	err := c.cc.Invoke(ctx, "/contentservice.ForensicsService/ListForensicBundles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forensicsServiceClient) ForensicBundleDownloadURL(ctx context.Context, in *ForensicBundleDownloadURLRequest, opts ...grpc.CallOption) (*ForensicBundleDownloadURLResponse, error) {
+	out := new(ForensicBundleDownloadURLResponse)
+	err := c.cc.Invoke(ctx, "/contentservice.ForensicsService/ForensicBundleDownloadURL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ForensicsServiceServer is the server API for ForensicsService service.
+// All implementations must embed UnimplementedForensicsServiceServer
+// for forward compatibility
+type ForensicsServiceServer interface {
+	// ListForensicBundles returns the forensic bundles of the specified workspace instance which are not expired yet
+	ListForensicBundles(context.Context, *ListForensicBundlesRequest) (*ListForensicBundlesResponse, error)
+	// ForensicBundleDownloadURL provides a URL from where a forensic bundle can be downloaded from
+	ForensicBundleDownloadURL(context.Context, *ForensicBundleDownloadURLRequest) (*ForensicBundleDownloadURLResponse, error)
+	mustEmbedUnimplementedForensicsServiceServer()
+}
+
+// UnimplementedForensicsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedForensicsServiceServer struct {
+}
+
+func (UnimplementedForensicsServiceServer) ListForensicBundles(context.Context, *ListForensicBundlesRequest) (*ListForensicBundlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForensicBundles not implemented")
+}
+func (UnimplementedForensicsServiceServer) ForensicBundleDownloadURL(context.Context, *ForensicBundleDownloadURLRequest) (*ForensicBundleDownloadURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForensicBundleDownloadURL not implemented")
+}
+func (UnimplementedForensicsServiceServer) mustEmbedUnimplementedForensicsServiceServer() {}
+
+// UnsafeForensicsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ForensicsServiceServer will
+// result in compilation errors.
+type UnsafeForensicsServiceServer interface {
+	mustEmbedUnimplementedForensicsServiceServer()
+}
+
+func RegisterForensicsServiceServer(s grpc.ServiceRegistrar, srv ForensicsServiceServer) {
+	s.RegisterService(&ForensicsService_ServiceDesc, srv)
+}
+
+func _ForensicsService_ListForensicBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForensicBundlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForensicsServiceServer).ListForensicBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/contentservice.ForensicsService/ListForensicBundles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForensicsServiceServer).ListForensicBundles(ctx, req.(*ListForensicBundlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForensicsService_ForensicBundleDownloadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForensicBundleDownloadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForensicsServiceServer).ForensicBundleDownloadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/contentservice.ForensicsService/ForensicBundleDownloadURL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForensicsServiceServer).ForensicBundleDownloadURL(ctx, req.(*ForensicBundleDownloadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ForensicsService_ServiceDesc is the grpc.ServiceDesc for ForensicsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ForensicsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "contentservice.ForensicsService",
+	HandlerType: (*ForensicsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListForensicBundles",
+			Handler:    _ForensicsService_ListForensicBundles_Handler,
+		},
+		{
+			MethodName: "ForensicBundleDownloadURL",
+			Handler:    _ForensicsService_ForensicBundleDownloadURL_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "forensics.proto",
+}
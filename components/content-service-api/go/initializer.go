@@ -22,7 +22,7 @@ func GetCheckoutLocationsFromInitializer(init *WorkspaceInitializer) []string {
 		case *WorkspaceInitializer_Backup:
 			res = append(res, spec.Backup.CheckoutLocation)
 
-		case *WorkspaceInitializer_Prebuild:
+		case *WorkspaceInitializer_Prebuild, *WorkspaceInitializer_MultiRepo:
 			// walkInitializer will visit the Git initializer
 		}
 
@@ -50,21 +50,16 @@ func extractSecretsFromInitializer(init *WorkspaceInitializer, replaceValue bool
 	res := make(map[string]string)
 
 	_ = WalkInitializer([]string{"initializer"}, init, func(path []string, init *WorkspaceInitializer) error {
-		git, ok := init.Spec.(*WorkspaceInitializer_Git)
-		if !ok {
-			return nil
-		}
-
-		pwd := git.Git.Config.AuthPassword
-		if pwd == "" || strings.HasPrefix(pwd, extractedSecretPrefix) {
+		secret := initializerSecret(init)
+		if secret == nil || *secret == "" || strings.HasPrefix(*secret, extractedSecretPrefix) {
 			return nil
 		}
 
 		name := strings.Join(path, ".")
-		res[name] = pwd
+		res[name] = *secret
 
 		if replaceValue {
-			git.Git.Config.AuthPassword = extractedSecretPrefix + name
+			*secret = extractedSecretPrefix + name
 		}
 
 		return nil
@@ -76,28 +71,34 @@ func extractSecretsFromInitializer(init *WorkspaceInitializer, replaceValue bool
 // InjectSecretsToInitializer injects secrets to the initializer. This is the counterpart of ExtractSecretsFromInitializer.
 func InjectSecretsToInitializer(init *WorkspaceInitializer, secrets map[string][]byte) error {
 	return WalkInitializer([]string{"initializer"}, init, func(path []string, init *WorkspaceInitializer) error {
-		git, ok := init.Spec.(*WorkspaceInitializer_Git)
-		if !ok {
-			return nil
-		}
-
-		pwd := git.Git.Config.AuthPassword
-		if !strings.HasPrefix(pwd, extractedSecretPrefix) {
+		secret := initializerSecret(init)
+		if secret == nil || !strings.HasPrefix(*secret, extractedSecretPrefix) {
 			return nil
 		}
 
-		name := strings.TrimPrefix(pwd, extractedSecretPrefix)
+		name := strings.TrimPrefix(*secret, extractedSecretPrefix)
 		val, ok := secrets[name]
 		if !ok {
 			return xerrors.Errorf("secret %s not found", name)
 		}
 
-		git.Git.Config.AuthPassword = string(val)
+		*secret = string(val)
 
 		return nil
 	})
 }
 
+// initializerSecret returns the field of an initializer which holds a secret, or nil if the initializer has none
+func initializerSecret(init *WorkspaceInitializer) *string {
+	switch spec := init.Spec.(type) {
+	case *WorkspaceInitializer_Git:
+		return &spec.Git.Config.AuthPassword
+	case *WorkspaceInitializer_Archive:
+		return &spec.Archive.Authorization
+	}
+	return nil
+}
+
 // WalkInitializer walks the initializer structure
 func WalkInitializer(path []string, init *WorkspaceInitializer, visitor func(path []string, init *WorkspaceInitializer) error) error {
 	if init == nil {
@@ -141,6 +142,21 @@ func WalkInitializer(path []string, init *WorkspaceInitializer, visitor func(pat
 		return visitor(append(path, "download"), init)
 	case *WorkspaceInitializer_Backup:
 		return visitor(append(path, "backup"), init)
+	case *WorkspaceInitializer_Archive:
+		return visitor(append(path, "archive"), init)
+	case *WorkspaceInitializer_MultiRepo:
+		child := append(path, "multi_repo")
+		err := visitor(child, init)
+		if err != nil {
+			return err
+		}
+		for i, g := range spec.MultiRepo.Repositories {
+			err = WalkInitializer(append(child, strconv.Itoa(i)), &WorkspaceInitializer{Spec: &WorkspaceInitializer_Git{Git: g}}, visitor)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
 
 	default:
 		return fmt.Errorf("unsupported workspace initializer in walkInitializer - this is a bug in Gitpod")
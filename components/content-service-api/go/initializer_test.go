@@ -102,6 +102,20 @@ func TestGetCheckoutLocationsFromInitializer(t *testing.T) {
 			},
 			Expectation: "/foo,/bar",
 		},
+		{
+			Name: "multi repo initializer",
+			Initializer: &api.WorkspaceInitializer{
+				Spec: &api.WorkspaceInitializer_MultiRepo{
+					MultiRepo: &api.MultiRepoInitializer{
+						Repositories: []*api.GitInitializer{
+							{CheckoutLocation: "/frontend"},
+							{CheckoutLocation: "/backend"},
+						},
+					},
+				},
+			},
+			Expectation: "/frontend,/backend",
+		},
 		{
 			Name: "nil initializer",
 		},
@@ -185,6 +199,68 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 				"initializer.prebuild.1.git": "some value",
 			},
 		},
+		{
+			Name: "multi repo initializer",
+			Input: &api.WorkspaceInitializer{
+				Spec: &api.WorkspaceInitializer_MultiRepo{
+					MultiRepo: &api.MultiRepoInitializer{
+						Repositories: []*api.GitInitializer{
+							{
+								Config: &api.GitConfig{
+									AuthPassword: "frontend-token",
+								},
+							},
+							{
+								Config: &api.GitConfig{
+									Authentication: api.GitAuthMethod_NO_AUTH,
+								},
+							},
+							{
+								Config: &api.GitConfig{
+									AuthPassword: "backend-token",
+								},
+							},
+						},
+					},
+				},
+			},
+			Expectation: map[string]string{
+				"initializer.multi_repo.0.git": "frontend-token",
+				"initializer.multi_repo.2.git": "backend-token",
+			},
+		},
+		{
+			Name: "composite with archive initializer",
+			Input: &api.WorkspaceInitializer{
+				Spec: &api.WorkspaceInitializer_Composite{
+					Composite: &api.CompositeInitializer{
+						Initializer: []*api.WorkspaceInitializer{
+							{
+								Spec: &api.WorkspaceInitializer_Git{
+									Git: &api.GitInitializer{
+										Config: &api.GitConfig{
+											AuthPassword: "foobar",
+										},
+									},
+								},
+							},
+							{
+								Spec: &api.WorkspaceInitializer_Archive{
+									Archive: &api.ArchiveInitializer{
+										Url:           "https://example.com/dataset.tar.gz",
+										Authorization: "Bearer token",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Expectation: map[string]string{
+				"initializer.composite.0.git":     "foobar",
+				"initializer.composite.1.archive": "Bearer token",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -201,6 +277,12 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 				api.GitInitializer{},
 				api.GitConfig{},
 				api.PrebuildInitializer{},
+				api.WorkspaceInitializer_Composite{},
+				api.CompositeInitializer{},
+				api.WorkspaceInitializer_Archive{},
+				api.ArchiveInitializer{},
+				api.WorkspaceInitializer_MultiRepo{},
+				api.MultiRepoInitializer{},
 			}
 			if diff := cmp.Diff(original, test.Input, cmpopts.IgnoreUnexported(ignoreUnexported...)); diff != "" {
 				t.Errorf("unexpected alteration from GatherSecretsFromInitializer (-want +got):\n%s", diff)
@@ -212,12 +294,15 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 			}
 
 			_ = api.WalkInitializer(nil, test.Input, func(path []string, init *api.WorkspaceInitializer) error {
-				git, ok := init.Spec.(*api.WorkspaceInitializer_Git)
-				if !ok {
-					return nil
+				var pwd string
+				switch spec := init.Spec.(type) {
+				case *api.WorkspaceInitializer_Git:
+					pwd = spec.Git.Config.AuthPassword
+				case *api.WorkspaceInitializer_Archive:
+					pwd = spec.Archive.Authorization
 				}
-				if pwd := git.Git.Config.AuthPassword; pwd != "" && !strings.HasPrefix(pwd, "extracted-secret/") {
-					t.Errorf("expected authPassword to be extracted, but got %s at %s", pwd, filepath.Join(path...))
+				if pwd != "" && !strings.HasPrefix(pwd, "extracted-secret/") {
+					t.Errorf("expected secret to be extracted, but got %s at %s", pwd, filepath.Join(path...))
 				}
 
 				return nil
@@ -234,12 +319,15 @@ func TestExtractInjectSecretsFromInitializer(t *testing.T) {
 			}
 
 			_ = api.WalkInitializer(nil, test.Input, func(path []string, init *api.WorkspaceInitializer) error {
-				git, ok := init.Spec.(*api.WorkspaceInitializer_Git)
-				if !ok {
-					return nil
+				var pwd string
+				switch spec := init.Spec.(type) {
+				case *api.WorkspaceInitializer_Git:
+					pwd = spec.Git.Config.AuthPassword
+				case *api.WorkspaceInitializer_Archive:
+					pwd = spec.Archive.Authorization
 				}
-				if pwd := git.Git.Config.AuthPassword; pwd != "" && strings.HasPrefix(pwd, "extracted-secret/") {
-					t.Errorf("expected authPassword to be injected, but got %s at %s", pwd, filepath.Join(path...))
+				if pwd != "" && strings.HasPrefix(pwd, "extracted-secret/") {
+					t.Errorf("expected secret to be injected, but got %s at %s", pwd, filepath.Join(path...))
 				}
 
 				return nil
@@ -32,6 +32,9 @@ type WorkspaceServiceClient interface {
 	DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*DeleteWorkspaceResponse, error)
 	// WorkspaceSnapshotExists checks whether the snapshot exists or not
 	WorkspaceSnapshotExists(ctx context.Context, in *WorkspaceSnapshotExistsRequest, opts ...grpc.CallOption) (*WorkspaceSnapshotExistsResponse, error)
+	// ListWorkspaceContent lists the backups and snapshots of workspaces from their content catalog,
+	// which is much cheaper than listing their objects in remote storage
+	ListWorkspaceContent(ctx context.Context, in *ListWorkspaceContentRequest, opts ...grpc.CallOption) (*ListWorkspaceContentResponse, error)
 }
 
 type workspaceServiceClient struct {
@@ -69,6 +72,15 @@ func (c *workspaceServiceClient) WorkspaceSnapshotExists(ctx context.Context, in
 	return out, nil
 }
 
+func (c *workspaceServiceClient) ListWorkspaceContent(ctx context.Context, in *ListWorkspaceContentRequest, opts ...grpc.CallOption) (*ListWorkspaceContentResponse, error) {
+	out := new(ListWorkspaceContentResponse)
+	err := c.cc.Invoke(ctx, "/contentservice.WorkspaceService/ListWorkspaceContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceServiceServer is the server API for WorkspaceService service.
 // All implementations must embed UnimplementedWorkspaceServiceServer
 // for forward compatibility
@@ -79,6 +91,9 @@ type WorkspaceServiceServer interface {
 	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*DeleteWorkspaceResponse, error)
 	// WorkspaceSnapshotExists checks whether the snapshot exists or not
 	WorkspaceSnapshotExists(context.Context, *WorkspaceSnapshotExistsRequest) (*WorkspaceSnapshotExistsResponse, error)
+	// ListWorkspaceContent lists the backups and snapshots of workspaces from their content catalog,
+	// which is much cheaper than listing their objects in remote storage
+	ListWorkspaceContent(context.Context, *ListWorkspaceContentRequest) (*ListWorkspaceContentResponse, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
 
@@ -95,6 +110,9 @@ func (UnimplementedWorkspaceServiceServer) DeleteWorkspace(context.Context, *Del
 func (UnimplementedWorkspaceServiceServer) WorkspaceSnapshotExists(context.Context, *WorkspaceSnapshotExistsRequest) (*WorkspaceSnapshotExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WorkspaceSnapshotExists not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) ListWorkspaceContent(context.Context, *ListWorkspaceContentRequest) (*ListWorkspaceContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceContent not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) mustEmbedUnimplementedWorkspaceServiceServer() {}
 
 // UnsafeWorkspaceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +180,24 @@ func _WorkspaceService_WorkspaceSnapshotExists_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_ListWorkspaceContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListWorkspaceContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/contentservice.WorkspaceService/ListWorkspaceContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListWorkspaceContent(ctx, req.(*ListWorkspaceContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +217,10 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WorkspaceSnapshotExists",
 			Handler:    _WorkspaceService_WorkspaceSnapshotExists_Handler,
 		},
+		{
+			MethodName: "ListWorkspaceContent",
+			Handler:    _WorkspaceService_ListWorkspaceContent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workspace.proto",
@@ -0,0 +1,64 @@
+/**
+ * Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+// package: contentservice
+// file: forensics.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as grpc from "@grpc/grpc-js";
+import * as forensics_pb from "./forensics_pb";
+
+interface IForensicsServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    listForensicBundles: IForensicsServiceService_IListForensicBundles;
+    forensicBundleDownloadURL: IForensicsServiceService_IForensicBundleDownloadURL;
+}
+
+interface IForensicsServiceService_IListForensicBundles extends grpc.MethodDefinition<forensics_pb.ListForensicBundlesRequest, forensics_pb.ListForensicBundlesResponse> {
+    path: "/contentservice.ForensicsService/ListForensicBundles";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<forensics_pb.ListForensicBundlesRequest>;
+    requestDeserialize: grpc.deserialize<forensics_pb.ListForensicBundlesRequest>;
+    responseSerialize: grpc.serialize<forensics_pb.ListForensicBundlesResponse>;
+    responseDeserialize: grpc.deserialize<forensics_pb.ListForensicBundlesResponse>;
+}
+interface IForensicsServiceService_IForensicBundleDownloadURL extends grpc.MethodDefinition<forensics_pb.ForensicBundleDownloadURLRequest, forensics_pb.ForensicBundleDownloadURLResponse> {
+    path: "/contentservice.ForensicsService/ForensicBundleDownloadURL";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<forensics_pb.ForensicBundleDownloadURLRequest>;
+    requestDeserialize: grpc.deserialize<forensics_pb.ForensicBundleDownloadURLRequest>;
+    responseSerialize: grpc.serialize<forensics_pb.ForensicBundleDownloadURLResponse>;
+    responseDeserialize: grpc.deserialize<forensics_pb.ForensicBundleDownloadURLResponse>;
+}
+
+export const ForensicsServiceService: IForensicsServiceService;
+
+export interface IForensicsServiceServer extends grpc.UntypedServiceImplementation {
+    listForensicBundles: grpc.handleUnaryCall<forensics_pb.ListForensicBundlesRequest, forensics_pb.ListForensicBundlesResponse>;
+    forensicBundleDownloadURL: grpc.handleUnaryCall<forensics_pb.ForensicBundleDownloadURLRequest, forensics_pb.ForensicBundleDownloadURLResponse>;
+}
+
+export interface IForensicsServiceClient {
+    listForensicBundles(request: forensics_pb.ListForensicBundlesRequest, callback: (error: grpc.ServiceError | null, response: forensics_pb.ListForensicBundlesResponse) => void): grpc.ClientUnaryCall;
+    listForensicBundles(request: forensics_pb.ListForensicBundlesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: forensics_pb.ListForensicBundlesResponse) => void): grpc.ClientUnaryCall;
+    listForensicBundles(request: forensics_pb.ListForensicBundlesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: forensics_pb.ListForensicBundlesResponse) => void): grpc.ClientUnaryCall;
+    forensicBundleDownloadURL(request: forensics_pb.ForensicBundleDownloadURLRequest, callback: (error: grpc.ServiceError | null, response: forensics_pb.ForensicBundleDownloadURLResponse) => void): grpc.ClientUnaryCall;
+    forensicBundleDownloadURL(request: forensics_pb.ForensicBundleDownloadURLRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: forensics_pb.ForensicBundleDownloadURLResponse) => void): grpc.ClientUnaryCall;
+    forensicBundleDownloadURL(request: forensics_pb.ForensicBundleDownloadURLRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: forensics_pb.ForensicBundleDownloadURLResponse) => void): grpc.ClientUnaryCall;
+}
+
+export class ForensicsServiceClient extends grpc.Client implements IForensicsServiceClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: Partial<grpc.ClientOptions>);
+    public listForensicBundles(request: forensics_pb.ListForensicBundlesRequest, callback: (error: grpc.ServiceError | null, response: forensics_pb.ListForensicBundlesResponse) => void): grpc.ClientUnaryCall;
+    public listForensicBundles(request: forensics_pb.ListForensicBundlesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: forensics_pb.ListForensicBundlesResponse) => void): grpc.ClientUnaryCall;
+    public listForensicBundles(request: forensics_pb.ListForensicBundlesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: forensics_pb.ListForensicBundlesResponse) => void): grpc.ClientUnaryCall;
+    public forensicBundleDownloadURL(request: forensics_pb.ForensicBundleDownloadURLRequest, callback: (error: grpc.ServiceError | null, response: forensics_pb.ForensicBundleDownloadURLResponse) => void): grpc.ClientUnaryCall;
+    public forensicBundleDownloadURL(request: forensics_pb.ForensicBundleDownloadURLRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: forensics_pb.ForensicBundleDownloadURLResponse) => void): grpc.ClientUnaryCall;
+    public forensicBundleDownloadURL(request: forensics_pb.ForensicBundleDownloadURLRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: forensics_pb.ForensicBundleDownloadURLResponse) => void): grpc.ClientUnaryCall;
+}
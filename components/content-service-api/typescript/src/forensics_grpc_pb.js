@@ -0,0 +1,86 @@
+// GENERATED CODE -- DO NOT EDIT!
+
+// Original file comments:
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+//
+'use strict';
+var grpc = require('@grpc/grpc-js');
+var forensics_pb = require('./forensics_pb.js');
+
+function serialize_contentservice_ForensicBundleDownloadURLRequest(arg) {
+  if (!(arg instanceof forensics_pb.ForensicBundleDownloadURLRequest)) {
+    throw new Error('Expected argument of type contentservice.ForensicBundleDownloadURLRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_contentservice_ForensicBundleDownloadURLRequest(buffer_arg) {
+  return forensics_pb.ForensicBundleDownloadURLRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_contentservice_ForensicBundleDownloadURLResponse(arg) {
+  if (!(arg instanceof forensics_pb.ForensicBundleDownloadURLResponse)) {
+    throw new Error('Expected argument of type contentservice.ForensicBundleDownloadURLResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_contentservice_ForensicBundleDownloadURLResponse(buffer_arg) {
+  return forensics_pb.ForensicBundleDownloadURLResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_contentservice_ListForensicBundlesRequest(arg) {
+  if (!(arg instanceof forensics_pb.ListForensicBundlesRequest)) {
+    throw new Error('Expected argument of type contentservice.ListForensicBundlesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_contentservice_ListForensicBundlesRequest(buffer_arg) {
+  return forensics_pb.ListForensicBundlesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_contentservice_ListForensicBundlesResponse(arg) {
+  if (!(arg instanceof forensics_pb.ListForensicBundlesResponse)) {
+    throw new Error('Expected argument of type contentservice.ListForensicBundlesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_contentservice_ListForensicBundlesResponse(buffer_arg) {
+  return forensics_pb.ListForensicBundlesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+
+// ForensicsService gives administrators access to the forensic bundles ws-daemon collects
+// when a workspace terminates abnormally, e.g. because it ran out of memory.
+var ForensicsServiceService = exports.ForensicsServiceService = {
+  // ListForensicBundles returns the forensic bundles of the specified workspace instance which are not expired yet
+listForensicBundles: {
+    path: '/contentservice.ForensicsService/ListForensicBundles',
+    requestStream: false,
+    responseStream: false,
+    requestType: forensics_pb.ListForensicBundlesRequest,
+    responseType: forensics_pb.ListForensicBundlesResponse,
+    requestSerialize: serialize_contentservice_ListForensicBundlesRequest,
+    requestDeserialize: deserialize_contentservice_ListForensicBundlesRequest,
+    responseSerialize: serialize_contentservice_ListForensicBundlesResponse,
+    responseDeserialize: deserialize_contentservice_ListForensicBundlesResponse,
+  },
+  // ForensicBundleDownloadURL provides a URL from where a forensic bundle can be downloaded from
+forensicBundleDownloadURL: {
+    path: '/contentservice.ForensicsService/ForensicBundleDownloadURL',
+    requestStream: false,
+    responseStream: false,
+    requestType: forensics_pb.ForensicBundleDownloadURLRequest,
+    responseType: forensics_pb.ForensicBundleDownloadURLResponse,
+    requestSerialize: serialize_contentservice_ForensicBundleDownloadURLRequest,
+    requestDeserialize: deserialize_contentservice_ForensicBundleDownloadURLRequest,
+    responseSerialize: serialize_contentservice_ForensicBundleDownloadURLResponse,
+    responseDeserialize: deserialize_contentservice_ForensicBundleDownloadURLResponse,
+  },
+};
+
+exports.ForensicsServiceClient = grpc.makeGenericClientConstructor(ForensicsServiceService);
@@ -0,0 +1,136 @@
+/**
+ * Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+// package: contentservice
+// file: forensics.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+
+export class ListForensicBundlesRequest extends jspb.Message {
+    getOwnerId(): string;
+    setOwnerId(value: string): ListForensicBundlesRequest;
+    getWorkspaceId(): string;
+    setWorkspaceId(value: string): ListForensicBundlesRequest;
+    getInstanceId(): string;
+    setInstanceId(value: string): ListForensicBundlesRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListForensicBundlesRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListForensicBundlesRequest): ListForensicBundlesRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListForensicBundlesRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListForensicBundlesRequest;
+    static deserializeBinaryFromReader(message: ListForensicBundlesRequest, reader: jspb.BinaryReader): ListForensicBundlesRequest;
+}
+
+export namespace ListForensicBundlesRequest {
+    export type AsObject = {
+        ownerId: string,
+        workspaceId: string,
+        instanceId: string,
+    }
+}
+
+export class ListForensicBundlesResponse extends jspb.Message {
+    clearBundlesList(): void;
+    getBundlesList(): Array<ForensicBundle>;
+    setBundlesList(value: Array<ForensicBundle>): ListForensicBundlesResponse;
+    addBundles(value?: ForensicBundle, index?: number): ForensicBundle;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListForensicBundlesResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListForensicBundlesResponse): ListForensicBundlesResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListForensicBundlesResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListForensicBundlesResponse;
+    static deserializeBinaryFromReader(message: ListForensicBundlesResponse, reader: jspb.BinaryReader): ListForensicBundlesResponse;
+}
+
+export namespace ListForensicBundlesResponse {
+    export type AsObject = {
+        bundlesList: Array<ForensicBundle.AsObject>,
+    }
+}
+
+export class ForensicBundle extends jspb.Message {
+    getName(): string;
+    setName(value: string): ForensicBundle;
+    getReason(): string;
+    setReason(value: string): ForensicBundle;
+    getCreated(): number;
+    setCreated(value: number): ForensicBundle;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ForensicBundle.AsObject;
+    static toObject(includeInstance: boolean, msg: ForensicBundle): ForensicBundle.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ForensicBundle, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ForensicBundle;
+    static deserializeBinaryFromReader(message: ForensicBundle, reader: jspb.BinaryReader): ForensicBundle;
+}
+
+export namespace ForensicBundle {
+    export type AsObject = {
+        name: string,
+        reason: string,
+        created: number,
+    }
+}
+
+export class ForensicBundleDownloadURLRequest extends jspb.Message {
+    getOwnerId(): string;
+    setOwnerId(value: string): ForensicBundleDownloadURLRequest;
+    getWorkspaceId(): string;
+    setWorkspaceId(value: string): ForensicBundleDownloadURLRequest;
+    getInstanceId(): string;
+    setInstanceId(value: string): ForensicBundleDownloadURLRequest;
+    getName(): string;
+    setName(value: string): ForensicBundleDownloadURLRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ForensicBundleDownloadURLRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ForensicBundleDownloadURLRequest): ForensicBundleDownloadURLRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ForensicBundleDownloadURLRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ForensicBundleDownloadURLRequest;
+    static deserializeBinaryFromReader(message: ForensicBundleDownloadURLRequest, reader: jspb.BinaryReader): ForensicBundleDownloadURLRequest;
+}
+
+export namespace ForensicBundleDownloadURLRequest {
+    export type AsObject = {
+        ownerId: string,
+        workspaceId: string,
+        instanceId: string,
+        name: string,
+    }
+}
+
+export class ForensicBundleDownloadURLResponse extends jspb.Message {
+    getUrl(): string;
+    setUrl(value: string): ForensicBundleDownloadURLResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ForensicBundleDownloadURLResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ForensicBundleDownloadURLResponse): ForensicBundleDownloadURLResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ForensicBundleDownloadURLResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ForensicBundleDownloadURLResponse;
+    static deserializeBinaryFromReader(message: ForensicBundleDownloadURLResponse, reader: jspb.BinaryReader): ForensicBundleDownloadURLResponse;
+}
+
+export namespace ForensicBundleDownloadURLResponse {
+    export type AsObject = {
+        url: string,
+    }
+}
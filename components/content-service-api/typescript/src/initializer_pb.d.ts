@@ -49,6 +49,16 @@ export class WorkspaceInitializer extends jspb.Message {
     getBackup(): FromBackupInitializer | undefined;
     setBackup(value?: FromBackupInitializer): WorkspaceInitializer;
 
+    hasArchive(): boolean;
+    clearArchive(): void;
+    getArchive(): ArchiveInitializer | undefined;
+    setArchive(value?: ArchiveInitializer): WorkspaceInitializer;
+
+    hasMultiRepo(): boolean;
+    clearMultiRepo(): void;
+    getMultiRepo(): MultiRepoInitializer | undefined;
+    setMultiRepo(value?: MultiRepoInitializer): WorkspaceInitializer;
+
     getSpecCase(): WorkspaceInitializer.SpecCase;
 
     serializeBinary(): Uint8Array;
@@ -70,6 +80,8 @@ export namespace WorkspaceInitializer {
         composite?: CompositeInitializer.AsObject,
         download?: FileDownloadInitializer.AsObject,
         backup?: FromBackupInitializer.AsObject,
+        archive?: ArchiveInitializer.AsObject,
+        multiRepo?: MultiRepoInitializer.AsObject,
     }
 
     export enum SpecCase {
@@ -81,6 +93,8 @@ export namespace WorkspaceInitializer {
         COMPOSITE = 5,
         DOWNLOAD = 6,
         BACKUP = 7,
+        ARCHIVE = 8,
+        MULTI_REPO = 9,
     }
 
 }
@@ -160,6 +174,41 @@ export namespace FileDownloadInitializer {
 
 }
 
+export class ArchiveInitializer extends jspb.Message {
+    getUrl(): string;
+    setUrl(value: string): ArchiveInitializer;
+    getAuthorization(): string;
+    setAuthorization(value: string): ArchiveInitializer;
+    getFormat(): ArchiveFormat;
+    setFormat(value: ArchiveFormat): ArchiveInitializer;
+    getTargetLocation(): string;
+    setTargetLocation(value: string): ArchiveInitializer;
+    getDigest(): string;
+    setDigest(value: string): ArchiveInitializer;
+    getStripComponents(): number;
+    setStripComponents(value: number): ArchiveInitializer;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ArchiveInitializer.AsObject;
+    static toObject(includeInstance: boolean, msg: ArchiveInitializer): ArchiveInitializer.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ArchiveInitializer, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ArchiveInitializer;
+    static deserializeBinaryFromReader(message: ArchiveInitializer, reader: jspb.BinaryReader): ArchiveInitializer;
+}
+
+export namespace ArchiveInitializer {
+    export type AsObject = {
+        url: string,
+        authorization: string,
+        format: ArchiveFormat,
+        targetLocation: string,
+        digest: string,
+        stripComponents: number,
+    }
+}
+
 export class EmptyInitializer extends jspb.Message {
 
     serializeBinary(): Uint8Array;
@@ -177,6 +226,31 @@ export namespace EmptyInitializer {
     }
 }
 
+export class MultiRepoInitializer extends jspb.Message {
+    clearRepositoriesList(): void;
+    getRepositoriesList(): Array<GitInitializer>;
+    setRepositoriesList(value: Array<GitInitializer>): MultiRepoInitializer;
+    addRepositories(value?: GitInitializer, index?: number): GitInitializer;
+    getMaxParallelism(): number;
+    setMaxParallelism(value: number): MultiRepoInitializer;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): MultiRepoInitializer.AsObject;
+    static toObject(includeInstance: boolean, msg: MultiRepoInitializer): MultiRepoInitializer.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: MultiRepoInitializer, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): MultiRepoInitializer;
+    static deserializeBinaryFromReader(message: MultiRepoInitializer, reader: jspb.BinaryReader): MultiRepoInitializer;
+}
+
+export namespace MultiRepoInitializer {
+    export type AsObject = {
+        repositoriesList: Array<GitInitializer.AsObject>,
+        maxParallelism: number,
+    }
+}
+
 export class GitInitializer extends jspb.Message {
     getRemoteUri(): string;
     setRemoteUri(value: string): GitInitializer;
@@ -193,6 +267,12 @@ export class GitInitializer extends jspb.Message {
     clearConfig(): void;
     getConfig(): GitConfig | undefined;
     setConfig(value?: GitConfig): GitInitializer;
+    getHistoryMode(): GitHistoryMode;
+    setHistoryMode(value: GitHistoryMode): GitInitializer;
+    getCloneDepth(): number;
+    setCloneDepth(value: number): GitInitializer;
+    getLfsMode(): GitLFSMode;
+    setLfsMode(value: GitLFSMode): GitInitializer;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GitInitializer.AsObject;
@@ -212,6 +292,9 @@ export namespace GitInitializer {
         cloneTaget: string,
         checkoutLocation: string,
         config?: GitConfig.AsObject,
+        historyMode: GitHistoryMode,
+        cloneDepth: number,
+        lfsMode: GitLFSMode,
     }
 }
 
@@ -370,6 +453,13 @@ export namespace GitStatus {
     }
 }
 
+export enum ArchiveFormat {
+    ARCHIVE_FORMAT_AUTO = 0,
+    ARCHIVE_FORMAT_TAR = 1,
+    ARCHIVE_FORMAT_TAR_GZ = 2,
+    ARCHIVE_FORMAT_ZIP = 3,
+}
+
 export enum CloneTargetMode {
     REMOTE_HEAD = 0,
     REMOTE_COMMIT = 1,
@@ -377,6 +467,18 @@ export enum CloneTargetMode {
     LOCAL_BRANCH = 3,
 }
 
+export enum GitHistoryMode {
+    BACKGROUND_UNSHALLOW = 0,
+    FULL_HISTORY = 1,
+    SHALLOW = 2,
+}
+
+export enum GitLFSMode {
+    LFS_EAGER = 0,
+    LFS_DEFERRED = 1,
+    LFS_DISABLED = 2,
+}
+
 export enum GitAuthMethod {
     NO_AUTH = 0,
     BASIC_AUTH = 1,
@@ -21,6 +21,8 @@ var jspb = require('google-protobuf');
 var goog = jspb;
 var global = (function() { return this || window || global || self || Function('return this')(); }).call(null);
 
+goog.exportSymbol('proto.contentservice.ArchiveFormat', null, global);
+goog.exportSymbol('proto.contentservice.ArchiveInitializer', null, global);
 goog.exportSymbol('proto.contentservice.CloneTargetMode', null, global);
 goog.exportSymbol('proto.contentservice.CompositeInitializer', null, global);
 goog.exportSymbol('proto.contentservice.EmptyInitializer', null, global);
@@ -29,8 +31,11 @@ goog.exportSymbol('proto.contentservice.FileDownloadInitializer.FileInfo', null,
 goog.exportSymbol('proto.contentservice.FromBackupInitializer', null, global);
 goog.exportSymbol('proto.contentservice.GitAuthMethod', null, global);
 goog.exportSymbol('proto.contentservice.GitConfig', null, global);
+goog.exportSymbol('proto.contentservice.GitHistoryMode', null, global);
 goog.exportSymbol('proto.contentservice.GitInitializer', null, global);
+goog.exportSymbol('proto.contentservice.GitLFSMode', null, global);
 goog.exportSymbol('proto.contentservice.GitStatus', null, global);
+goog.exportSymbol('proto.contentservice.MultiRepoInitializer', null, global);
 goog.exportSymbol('proto.contentservice.PrebuildInitializer', null, global);
 goog.exportSymbol('proto.contentservice.SnapshotInitializer', null, global);
 goog.exportSymbol('proto.contentservice.WorkspaceInitializer', null, global);
@@ -119,6 +124,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.contentservice.FileDownloadInitializer.FileInfo.displayName = 'proto.contentservice.FileDownloadInitializer.FileInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.contentservice.ArchiveInitializer = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.contentservice.ArchiveInitializer, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.contentservice.ArchiveInitializer.displayName = 'proto.contentservice.ArchiveInitializer';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -140,6 +166,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.contentservice.EmptyInitializer.displayName = 'proto.contentservice.EmptyInitializer';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.contentservice.MultiRepoInitializer = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.contentservice.MultiRepoInitializer.repeatedFields_, null);
+};
+goog.inherits(proto.contentservice.MultiRepoInitializer, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.contentservice.MultiRepoInitializer.displayName = 'proto.contentservice.MultiRepoInitializer';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -275,7 +322,7 @@ if (goog.DEBUG && !COMPILED) {
  * @private {!Array<!Array<number>>}
  * @const
  */
-proto.contentservice.WorkspaceInitializer.oneofGroups_ = [[1,2,3,4,5,6,7]];
+proto.contentservice.WorkspaceInitializer.oneofGroups_ = [[1,2,3,4,5,6,7,8,9]];
 
 /**
  * @enum {number}
@@ -288,7 +335,9 @@ proto.contentservice.WorkspaceInitializer.SpecCase = {
   PREBUILD: 4,
   COMPOSITE: 5,
   DOWNLOAD: 6,
-  BACKUP: 7
+  BACKUP: 7,
+  ARCHIVE: 8,
+  MULTI_REPO: 9
 };
 
 /**
@@ -335,7 +384,9 @@ proto.contentservice.WorkspaceInitializer.toObject = function(includeInstance, m
     prebuild: (f = msg.getPrebuild()) && proto.contentservice.PrebuildInitializer.toObject(includeInstance, f),
     composite: (f = msg.getComposite()) && proto.contentservice.CompositeInitializer.toObject(includeInstance, f),
     download: (f = msg.getDownload()) && proto.contentservice.FileDownloadInitializer.toObject(includeInstance, f),
-    backup: (f = msg.getBackup()) && proto.contentservice.FromBackupInitializer.toObject(includeInstance, f)
+    backup: (f = msg.getBackup()) && proto.contentservice.FromBackupInitializer.toObject(includeInstance, f),
+    archive: (f = msg.getArchive()) && proto.contentservice.ArchiveInitializer.toObject(includeInstance, f),
+    multiRepo: (f = msg.getMultiRepo()) && proto.contentservice.MultiRepoInitializer.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -407,6 +458,16 @@ proto.contentservice.WorkspaceInitializer.deserializeBinaryFromReader = function
       reader.readMessage(value,proto.contentservice.FromBackupInitializer.deserializeBinaryFromReader);
       msg.setBackup(value);
       break;
+    case 8:
+      var value = new proto.contentservice.ArchiveInitializer;
+      reader.readMessage(value,proto.contentservice.ArchiveInitializer.deserializeBinaryFromReader);
+      msg.setArchive(value);
+      break;
+    case 9:
+      var value = new proto.contentservice.MultiRepoInitializer;
+      reader.readMessage(value,proto.contentservice.MultiRepoInitializer.deserializeBinaryFromReader);
+      msg.setMultiRepo(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -492,6 +553,22 @@ proto.contentservice.WorkspaceInitializer.serializeBinaryToWriter = function(mes
       proto.contentservice.FromBackupInitializer.serializeBinaryToWriter
     );
   }
+  f = message.getArchive();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.contentservice.ArchiveInitializer.serializeBinaryToWriter
+    );
+  }
+  f = message.getMultiRepo();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.contentservice.MultiRepoInitializer.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -754,6 +831,80 @@ proto.contentservice.WorkspaceInitializer.prototype.hasBackup = function() {
 };
 
 
+/**
+ * optional ArchiveInitializer archive = 8;
+ * @return {?proto.contentservice.ArchiveInitializer}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.getArchive = function() {
+  return /** @type{?proto.contentservice.ArchiveInitializer} */ (
+    jspb.Message.getWrapperField(this, proto.contentservice.ArchiveInitializer, 8));
+};
+
+
+/**
+ * @param {?proto.contentservice.ArchiveInitializer|undefined} value
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+*/
+proto.contentservice.WorkspaceInitializer.prototype.setArchive = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.contentservice.WorkspaceInitializer.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+ */
+proto.contentservice.WorkspaceInitializer.prototype.clearArchive = function() {
+  return this.setArchive(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.hasArchive = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional MultiRepoInitializer multi_repo = 9;
+ * @return {?proto.contentservice.MultiRepoInitializer}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.getMultiRepo = function() {
+  return /** @type{?proto.contentservice.MultiRepoInitializer} */ (
+    jspb.Message.getWrapperField(this, proto.contentservice.MultiRepoInitializer, 9));
+};
+
+
+/**
+ * @param {?proto.contentservice.MultiRepoInitializer|undefined} value
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+*/
+proto.contentservice.WorkspaceInitializer.prototype.setMultiRepo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 9, proto.contentservice.WorkspaceInitializer.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.contentservice.WorkspaceInitializer} returns this
+ */
+proto.contentservice.WorkspaceInitializer.prototype.clearMultiRepo = function() {
+  return this.setMultiRepo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.contentservice.WorkspaceInitializer.prototype.hasMultiRepo = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
 
 /**
  * List of repeated fields within this message type.
@@ -1249,48 +1400,328 @@ proto.contentservice.FileDownloadInitializer.prototype.getFilesList = function()
 
 
 /**
- * @param {!Array<!proto.contentservice.FileDownloadInitializer.FileInfo>} value
- * @return {!proto.contentservice.FileDownloadInitializer} returns this
-*/
-proto.contentservice.FileDownloadInitializer.prototype.setFilesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {!Array<!proto.contentservice.FileDownloadInitializer.FileInfo>} value
+ * @return {!proto.contentservice.FileDownloadInitializer} returns this
+*/
+proto.contentservice.FileDownloadInitializer.prototype.setFilesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.contentservice.FileDownloadInitializer.FileInfo=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.FileDownloadInitializer.FileInfo}
+ */
+proto.contentservice.FileDownloadInitializer.prototype.addFiles = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.contentservice.FileDownloadInitializer.FileInfo, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.FileDownloadInitializer} returns this
+ */
+proto.contentservice.FileDownloadInitializer.prototype.clearFilesList = function() {
+  return this.setFilesList([]);
+};
+
+
+/**
+ * optional string target_location = 2;
+ * @return {string}
+ */
+proto.contentservice.FileDownloadInitializer.prototype.getTargetLocation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.FileDownloadInitializer} returns this
+ */
+proto.contentservice.FileDownloadInitializer.prototype.setTargetLocation = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.contentservice.ArchiveInitializer.prototype.toObject = function(opt_includeInstance) {
+  return proto.contentservice.ArchiveInitializer.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.contentservice.ArchiveInitializer} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.ArchiveInitializer.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    authorization: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    format: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    targetLocation: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    digest: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    stripComponents: jspb.Message.getFieldWithDefault(msg, 6, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.contentservice.ArchiveInitializer}
+ */
+proto.contentservice.ArchiveInitializer.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.contentservice.ArchiveInitializer;
+  return proto.contentservice.ArchiveInitializer.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.contentservice.ArchiveInitializer} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.contentservice.ArchiveInitializer}
+ */
+proto.contentservice.ArchiveInitializer.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAuthorization(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.contentservice.ArchiveFormat} */ (reader.readEnum());
+      msg.setFormat(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetLocation(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDigest(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setStripComponents(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.contentservice.ArchiveInitializer.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.contentservice.ArchiveInitializer.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.contentservice.ArchiveInitializer} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.ArchiveInitializer.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAuthorization();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getFormat();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getTargetLocation();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDigest();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getStripComponents();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string url = 1;
+ * @return {string}
+ */
+proto.contentservice.ArchiveInitializer.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.ArchiveInitializer} returns this
+ */
+proto.contentservice.ArchiveInitializer.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string authorization = 2;
+ * @return {string}
+ */
+proto.contentservice.ArchiveInitializer.prototype.getAuthorization = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.ArchiveInitializer} returns this
+ */
+proto.contentservice.ArchiveInitializer.prototype.setAuthorization = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional ArchiveFormat format = 3;
+ * @return {!proto.contentservice.ArchiveFormat}
+ */
+proto.contentservice.ArchiveInitializer.prototype.getFormat = function() {
+  return /** @type {!proto.contentservice.ArchiveFormat} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.contentservice.ArchiveFormat} value
+ * @return {!proto.contentservice.ArchiveInitializer} returns this
+ */
+proto.contentservice.ArchiveInitializer.prototype.setFormat = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional string target_location = 4;
+ * @return {string}
+ */
+proto.contentservice.ArchiveInitializer.prototype.getTargetLocation = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.ArchiveInitializer} returns this
+ */
+proto.contentservice.ArchiveInitializer.prototype.setTargetLocation = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.contentservice.FileDownloadInitializer.FileInfo=} opt_value
- * @param {number=} opt_index
- * @return {!proto.contentservice.FileDownloadInitializer.FileInfo}
+ * optional string digest = 5;
+ * @return {string}
  */
-proto.contentservice.FileDownloadInitializer.prototype.addFiles = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.contentservice.FileDownloadInitializer.FileInfo, opt_index);
+proto.contentservice.ArchiveInitializer.prototype.getDigest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.contentservice.FileDownloadInitializer} returns this
+ * @param {string} value
+ * @return {!proto.contentservice.ArchiveInitializer} returns this
  */
-proto.contentservice.FileDownloadInitializer.prototype.clearFilesList = function() {
-  return this.setFilesList([]);
+proto.contentservice.ArchiveInitializer.prototype.setDigest = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * optional string target_location = 2;
- * @return {string}
+ * optional uint32 strip_components = 6;
+ * @return {number}
  */
-proto.contentservice.FileDownloadInitializer.prototype.getTargetLocation = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.contentservice.ArchiveInitializer.prototype.getStripComponents = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.contentservice.FileDownloadInitializer} returns this
+ * @param {number} value
+ * @return {!proto.contentservice.ArchiveInitializer} returns this
  */
-proto.contentservice.FileDownloadInitializer.prototype.setTargetLocation = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.contentservice.ArchiveInitializer.prototype.setStripComponents = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
 };
 
 
@@ -1396,6 +1827,196 @@ proto.contentservice.EmptyInitializer.serializeBinaryToWriter = function(message
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.contentservice.MultiRepoInitializer.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.contentservice.MultiRepoInitializer.prototype.toObject = function(opt_includeInstance) {
+  return proto.contentservice.MultiRepoInitializer.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.contentservice.MultiRepoInitializer} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.MultiRepoInitializer.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    repositoriesList: jspb.Message.toObjectList(msg.getRepositoriesList(),
+    proto.contentservice.GitInitializer.toObject, includeInstance),
+    maxParallelism: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.contentservice.MultiRepoInitializer}
+ */
+proto.contentservice.MultiRepoInitializer.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.contentservice.MultiRepoInitializer;
+  return proto.contentservice.MultiRepoInitializer.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.contentservice.MultiRepoInitializer} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.contentservice.MultiRepoInitializer}
+ */
+proto.contentservice.MultiRepoInitializer.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.contentservice.GitInitializer;
+      reader.readMessage(value,proto.contentservice.GitInitializer.deserializeBinaryFromReader);
+      msg.addRepositories(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setMaxParallelism(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.contentservice.MultiRepoInitializer.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.contentservice.MultiRepoInitializer.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.contentservice.MultiRepoInitializer} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.MultiRepoInitializer.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRepositoriesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.contentservice.GitInitializer.serializeBinaryToWriter
+    );
+  }
+  f = message.getMaxParallelism();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated GitInitializer repositories = 1;
+ * @return {!Array<!proto.contentservice.GitInitializer>}
+ */
+proto.contentservice.MultiRepoInitializer.prototype.getRepositoriesList = function() {
+  return /** @type{!Array<!proto.contentservice.GitInitializer>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.contentservice.GitInitializer, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.contentservice.GitInitializer>} value
+ * @return {!proto.contentservice.MultiRepoInitializer} returns this
+*/
+proto.contentservice.MultiRepoInitializer.prototype.setRepositoriesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.contentservice.GitInitializer=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.GitInitializer}
+ */
+proto.contentservice.MultiRepoInitializer.prototype.addRepositories = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.contentservice.GitInitializer, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.MultiRepoInitializer} returns this
+ */
+proto.contentservice.MultiRepoInitializer.prototype.clearRepositoriesList = function() {
+  return this.setRepositoriesList([]);
+};
+
+
+/**
+ * optional uint32 max_parallelism = 2;
+ * @return {number}
+ */
+proto.contentservice.MultiRepoInitializer.prototype.getMaxParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.contentservice.MultiRepoInitializer} returns this
+ */
+proto.contentservice.MultiRepoInitializer.prototype.setMaxParallelism = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1432,7 +2053,10 @@ proto.contentservice.GitInitializer.toObject = function(includeInstance, msg) {
     targetMode: jspb.Message.getFieldWithDefault(msg, 3, 0),
     cloneTaget: jspb.Message.getFieldWithDefault(msg, 4, ""),
     checkoutLocation: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    config: (f = msg.getConfig()) && proto.contentservice.GitConfig.toObject(includeInstance, f)
+    config: (f = msg.getConfig()) && proto.contentservice.GitConfig.toObject(includeInstance, f),
+    historyMode: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    cloneDepth: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    lfsMode: jspb.Message.getFieldWithDefault(msg, 9, 0)
   };
 
   if (includeInstance) {
@@ -1494,6 +2118,18 @@ proto.contentservice.GitInitializer.deserializeBinaryFromReader = function(msg,
       reader.readMessage(value,proto.contentservice.GitConfig.deserializeBinaryFromReader);
       msg.setConfig(value);
       break;
+    case 7:
+      var value = /** @type {!proto.contentservice.GitHistoryMode} */ (reader.readEnum());
+      msg.setHistoryMode(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCloneDepth(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.contentservice.GitLFSMode} */ (reader.readEnum());
+      msg.setLfsMode(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1566,6 +2202,27 @@ proto.contentservice.GitInitializer.serializeBinaryToWriter = function(message,
       proto.contentservice.GitConfig.serializeBinaryToWriter
     );
   }
+  f = message.getHistoryMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      7,
+      f
+    );
+  }
+  f = message.getCloneDepth();
+  if (f !== 0) {
+    writer.writeUint32(
+      8,
+      f
+    );
+  }
+  f = message.getLfsMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
+    );
+  }
 };
 
 
@@ -1696,6 +2353,60 @@ proto.contentservice.GitInitializer.prototype.hasConfig = function() {
 };
 
 
+/**
+ * optional GitHistoryMode history_mode = 7;
+ * @return {!proto.contentservice.GitHistoryMode}
+ */
+proto.contentservice.GitInitializer.prototype.getHistoryMode = function() {
+  return /** @type {!proto.contentservice.GitHistoryMode} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {!proto.contentservice.GitHistoryMode} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setHistoryMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
+};
+
+
+/**
+ * optional uint32 clone_depth = 8;
+ * @return {number}
+ */
+proto.contentservice.GitInitializer.prototype.getCloneDepth = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setCloneDepth = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional GitLFSMode lfs_mode = 9;
+ * @return {!proto.contentservice.GitLFSMode}
+ */
+proto.contentservice.GitInitializer.prototype.getLfsMode = function() {
+  return /** @type {!proto.contentservice.GitLFSMode} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.contentservice.GitLFSMode} value
+ * @return {!proto.contentservice.GitInitializer} returns this
+ */
+proto.contentservice.GitInitializer.prototype.setLfsMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
 
 
 
@@ -2884,6 +3595,16 @@ proto.contentservice.GitStatus.prototype.setTotalUnpushedCommits = function(valu
 };
 
 
+/**
+ * @enum {number}
+ */
+proto.contentservice.ArchiveFormat = {
+  ARCHIVE_FORMAT_AUTO: 0,
+  ARCHIVE_FORMAT_TAR: 1,
+  ARCHIVE_FORMAT_TAR_GZ: 2,
+  ARCHIVE_FORMAT_ZIP: 3
+};
+
 /**
  * @enum {number}
  */
@@ -2894,6 +3615,24 @@ proto.contentservice.CloneTargetMode = {
   LOCAL_BRANCH: 3
 };
 
+/**
+ * @enum {number}
+ */
+proto.contentservice.GitHistoryMode = {
+  BACKGROUND_UNSHALLOW: 0,
+  FULL_HISTORY: 1,
+  SHALLOW: 2
+};
+
+/**
+ * @enum {number}
+ */
+proto.contentservice.GitLFSMode = {
+  LFS_EAGER: 0,
+  LFS_DEFERRED: 1,
+  LFS_DISABLED: 2
+};
+
 /**
  * @enum {number}
  */
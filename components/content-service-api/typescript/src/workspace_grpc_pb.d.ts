@@ -17,6 +17,7 @@ interface IWorkspaceServiceService extends grpc.ServiceDefinition<grpc.UntypedSe
     workspaceDownloadURL: IWorkspaceServiceService_IWorkspaceDownloadURL;
     deleteWorkspace: IWorkspaceServiceService_IDeleteWorkspace;
     workspaceSnapshotExists: IWorkspaceServiceService_IWorkspaceSnapshotExists;
+    listWorkspaceContent: IWorkspaceServiceService_IListWorkspaceContent;
 }
 
 interface IWorkspaceServiceService_IWorkspaceDownloadURL extends grpc.MethodDefinition<workspace_pb.WorkspaceDownloadURLRequest, workspace_pb.WorkspaceDownloadURLResponse> {
@@ -46,6 +47,15 @@ interface IWorkspaceServiceService_IWorkspaceSnapshotExists extends grpc.MethodD
     responseSerialize: grpc.serialize<workspace_pb.WorkspaceSnapshotExistsResponse>;
     responseDeserialize: grpc.deserialize<workspace_pb.WorkspaceSnapshotExistsResponse>;
 }
+interface IWorkspaceServiceService_IListWorkspaceContent extends grpc.MethodDefinition<workspace_pb.ListWorkspaceContentRequest, workspace_pb.ListWorkspaceContentResponse> {
+    path: "/contentservice.WorkspaceService/ListWorkspaceContent";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<workspace_pb.ListWorkspaceContentRequest>;
+    requestDeserialize: grpc.deserialize<workspace_pb.ListWorkspaceContentRequest>;
+    responseSerialize: grpc.serialize<workspace_pb.ListWorkspaceContentResponse>;
+    responseDeserialize: grpc.deserialize<workspace_pb.ListWorkspaceContentResponse>;
+}
 
 export const WorkspaceServiceService: IWorkspaceServiceService;
 
@@ -53,6 +63,7 @@ export interface IWorkspaceServiceServer extends grpc.UntypedServiceImplementati
     workspaceDownloadURL: grpc.handleUnaryCall<workspace_pb.WorkspaceDownloadURLRequest, workspace_pb.WorkspaceDownloadURLResponse>;
     deleteWorkspace: grpc.handleUnaryCall<workspace_pb.DeleteWorkspaceRequest, workspace_pb.DeleteWorkspaceResponse>;
     workspaceSnapshotExists: grpc.handleUnaryCall<workspace_pb.WorkspaceSnapshotExistsRequest, workspace_pb.WorkspaceSnapshotExistsResponse>;
+    listWorkspaceContent: grpc.handleUnaryCall<workspace_pb.ListWorkspaceContentRequest, workspace_pb.ListWorkspaceContentResponse>;
 }
 
 export interface IWorkspaceServiceClient {
@@ -65,6 +76,9 @@ export interface IWorkspaceServiceClient {
     workspaceSnapshotExists(request: workspace_pb.WorkspaceSnapshotExistsRequest, callback: (error: grpc.ServiceError | null, response: workspace_pb.WorkspaceSnapshotExistsResponse) => void): grpc.ClientUnaryCall;
     workspaceSnapshotExists(request: workspace_pb.WorkspaceSnapshotExistsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: workspace_pb.WorkspaceSnapshotExistsResponse) => void): grpc.ClientUnaryCall;
     workspaceSnapshotExists(request: workspace_pb.WorkspaceSnapshotExistsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: workspace_pb.WorkspaceSnapshotExistsResponse) => void): grpc.ClientUnaryCall;
+    listWorkspaceContent(request: workspace_pb.ListWorkspaceContentRequest, callback: (error: grpc.ServiceError | null, response: workspace_pb.ListWorkspaceContentResponse) => void): grpc.ClientUnaryCall;
+    listWorkspaceContent(request: workspace_pb.ListWorkspaceContentRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: workspace_pb.ListWorkspaceContentResponse) => void): grpc.ClientUnaryCall;
+    listWorkspaceContent(request: workspace_pb.ListWorkspaceContentRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: workspace_pb.ListWorkspaceContentResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class WorkspaceServiceClient extends grpc.Client implements IWorkspaceServiceClient {
@@ -78,4 +92,7 @@ export class WorkspaceServiceClient extends grpc.Client implements IWorkspaceSer
     public workspaceSnapshotExists(request: workspace_pb.WorkspaceSnapshotExistsRequest, callback: (error: grpc.ServiceError | null, response: workspace_pb.WorkspaceSnapshotExistsResponse) => void): grpc.ClientUnaryCall;
     public workspaceSnapshotExists(request: workspace_pb.WorkspaceSnapshotExistsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: workspace_pb.WorkspaceSnapshotExistsResponse) => void): grpc.ClientUnaryCall;
     public workspaceSnapshotExists(request: workspace_pb.WorkspaceSnapshotExistsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: workspace_pb.WorkspaceSnapshotExistsResponse) => void): grpc.ClientUnaryCall;
+    public listWorkspaceContent(request: workspace_pb.ListWorkspaceContentRequest, callback: (error: grpc.ServiceError | null, response: workspace_pb.ListWorkspaceContentResponse) => void): grpc.ClientUnaryCall;
+    public listWorkspaceContent(request: workspace_pb.ListWorkspaceContentRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: workspace_pb.ListWorkspaceContentResponse) => void): grpc.ClientUnaryCall;
+    public listWorkspaceContent(request: workspace_pb.ListWorkspaceContentRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: workspace_pb.ListWorkspaceContentResponse) => void): grpc.ClientUnaryCall;
 }
@@ -31,6 +31,28 @@ function deserialize_contentservice_DeleteWorkspaceResponse(buffer_arg) {
   return workspace_pb.DeleteWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_contentservice_ListWorkspaceContentRequest(arg) {
+  if (!(arg instanceof workspace_pb.ListWorkspaceContentRequest)) {
+    throw new Error('Expected argument of type contentservice.ListWorkspaceContentRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_contentservice_ListWorkspaceContentRequest(buffer_arg) {
+  return workspace_pb.ListWorkspaceContentRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_contentservice_ListWorkspaceContentResponse(arg) {
+  if (!(arg instanceof workspace_pb.ListWorkspaceContentResponse)) {
+    throw new Error('Expected argument of type contentservice.ListWorkspaceContentResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_contentservice_ListWorkspaceContentResponse(buffer_arg) {
+  return workspace_pb.ListWorkspaceContentResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_contentservice_WorkspaceDownloadURLRequest(arg) {
   if (!(arg instanceof workspace_pb.WorkspaceDownloadURLRequest)) {
     throw new Error('Expected argument of type contentservice.WorkspaceDownloadURLRequest');
@@ -113,6 +135,19 @@ workspaceSnapshotExists: {
     responseSerialize: serialize_contentservice_WorkspaceSnapshotExistsResponse,
     responseDeserialize: deserialize_contentservice_WorkspaceSnapshotExistsResponse,
   },
+  // ListWorkspaceContent lists the backups and snapshots of workspaces from their content catalog,
+// which is much cheaper than listing their objects in remote storage
+listWorkspaceContent: {
+    path: '/contentservice.WorkspaceService/ListWorkspaceContent',
+    requestStream: false,
+    responseStream: false,
+    requestType: workspace_pb.ListWorkspaceContentRequest,
+    responseType: workspace_pb.ListWorkspaceContentResponse,
+    requestSerialize: serialize_contentservice_ListWorkspaceContentRequest,
+    requestDeserialize: deserialize_contentservice_ListWorkspaceContentRequest,
+    responseSerialize: serialize_contentservice_ListWorkspaceContentResponse,
+    responseDeserialize: deserialize_contentservice_ListWorkspaceContentResponse,
+  },
 };
 
 exports.WorkspaceServiceClient = grpc.makeGenericClientConstructor(WorkspaceServiceService);
@@ -143,3 +143,98 @@ export namespace WorkspaceSnapshotExistsResponse {
         exists: boolean,
     }
 }
+
+export class ListWorkspaceContentRequest extends jspb.Message {
+    getOwnerId(): string;
+    setOwnerId(value: string): ListWorkspaceContentRequest;
+    clearWorkspaceIdsList(): void;
+    getWorkspaceIdsList(): Array<string>;
+    setWorkspaceIdsList(value: Array<string>): ListWorkspaceContentRequest;
+    addWorkspaceIds(value: string, index?: number): string;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListWorkspaceContentRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListWorkspaceContentRequest): ListWorkspaceContentRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListWorkspaceContentRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListWorkspaceContentRequest;
+    static deserializeBinaryFromReader(message: ListWorkspaceContentRequest, reader: jspb.BinaryReader): ListWorkspaceContentRequest;
+}
+
+export namespace ListWorkspaceContentRequest {
+    export type AsObject = {
+        ownerId: string,
+        workspaceIdsList: Array<string>,
+    }
+}
+
+export class ListWorkspaceContentResponse extends jspb.Message {
+    clearContentList(): void;
+    getContentList(): Array<WorkspaceContent>;
+    setContentList(value: Array<WorkspaceContent>): ListWorkspaceContentResponse;
+    addContent(value?: WorkspaceContent, index?: number): WorkspaceContent;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListWorkspaceContentResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListWorkspaceContentResponse): ListWorkspaceContentResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListWorkspaceContentResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListWorkspaceContentResponse;
+    static deserializeBinaryFromReader(message: ListWorkspaceContentResponse, reader: jspb.BinaryReader): ListWorkspaceContentResponse;
+}
+
+export namespace ListWorkspaceContentResponse {
+    export type AsObject = {
+        contentList: Array<WorkspaceContent.AsObject>,
+    }
+}
+
+export class WorkspaceContent extends jspb.Message {
+    getWorkspaceId(): string;
+    setWorkspaceId(value: string): WorkspaceContent;
+    getName(): string;
+    setName(value: string): WorkspaceContent;
+    getKind(): WorkspaceContentKind;
+    setKind(value: WorkspaceContentKind): WorkspaceContent;
+    getSize(): number;
+    setSize(value: number): WorkspaceContent;
+    getCreated(): number;
+    setCreated(value: number): WorkspaceContent;
+    getRetention(): WorkspaceContentRetention;
+    setRetention(value: WorkspaceContentRetention): WorkspaceContent;
+    getFormatVersion(): number;
+    setFormatVersion(value: number): WorkspaceContent;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceContent.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceContent): WorkspaceContent.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceContent, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceContent;
+    static deserializeBinaryFromReader(message: WorkspaceContent, reader: jspb.BinaryReader): WorkspaceContent;
+}
+
+export namespace WorkspaceContent {
+    export type AsObject = {
+        workspaceId: string,
+        name: string,
+        kind: WorkspaceContentKind,
+        size: number,
+        created: number,
+        retention: WorkspaceContentRetention,
+        formatVersion: number,
+    }
+}
+
+export enum WorkspaceContentKind {
+    WORKSPACE_CONTENT_KIND_BACKUP = 0,
+    WORKSPACE_CONTENT_KIND_SNAPSHOT = 1,
+}
+
+export enum WorkspaceContentRetention {
+    WORKSPACE_CONTENT_RETENTION_BACKUP = 0,
+    WORKSPACE_CONTENT_RETENTION_SNAPSHOTS = 1,
+}
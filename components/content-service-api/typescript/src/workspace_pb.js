@@ -23,6 +23,11 @@ var global = (function() { return this || window || global || self || Function('
 
 goog.exportSymbol('proto.contentservice.DeleteWorkspaceRequest', null, global);
 goog.exportSymbol('proto.contentservice.DeleteWorkspaceResponse', null, global);
+goog.exportSymbol('proto.contentservice.ListWorkspaceContentRequest', null, global);
+goog.exportSymbol('proto.contentservice.ListWorkspaceContentResponse', null, global);
+goog.exportSymbol('proto.contentservice.WorkspaceContent', null, global);
+goog.exportSymbol('proto.contentservice.WorkspaceContentKind', null, global);
+goog.exportSymbol('proto.contentservice.WorkspaceContentRetention', null, global);
 goog.exportSymbol('proto.contentservice.WorkspaceDownloadURLRequest', null, global);
 goog.exportSymbol('proto.contentservice.WorkspaceDownloadURLResponse', null, global);
 goog.exportSymbol('proto.contentservice.WorkspaceSnapshotExistsRequest', null, global);
@@ -153,6 +158,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.contentservice.WorkspaceSnapshotExistsResponse.displayName = 'proto.contentservice.WorkspaceSnapshotExistsResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.contentservice.ListWorkspaceContentRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.contentservice.ListWorkspaceContentRequest.repeatedFields_, null);
+};
+goog.inherits(proto.contentservice.ListWorkspaceContentRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.contentservice.ListWorkspaceContentRequest.displayName = 'proto.contentservice.ListWorkspaceContentRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.contentservice.ListWorkspaceContentResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.contentservice.ListWorkspaceContentResponse.repeatedFields_, null);
+};
+goog.inherits(proto.contentservice.ListWorkspaceContentResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.contentservice.ListWorkspaceContentResponse.displayName = 'proto.contentservice.ListWorkspaceContentResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.contentservice.WorkspaceContent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.contentservice.WorkspaceContent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.contentservice.WorkspaceContent.displayName = 'proto.contentservice.WorkspaceContent';
+}
 
 
 
@@ -1054,4 +1122,676 @@ proto.contentservice.WorkspaceSnapshotExistsResponse.prototype.setExists = funct
 };
 
 
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.contentservice.ListWorkspaceContentRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.contentservice.ListWorkspaceContentRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.contentservice.ListWorkspaceContentRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.contentservice.ListWorkspaceContentRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.ListWorkspaceContentRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    ownerId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    workspaceIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.contentservice.ListWorkspaceContentRequest}
+ */
+proto.contentservice.ListWorkspaceContentRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.contentservice.ListWorkspaceContentRequest;
+  return proto.contentservice.ListWorkspaceContentRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.contentservice.ListWorkspaceContentRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.contentservice.ListWorkspaceContentRequest}
+ */
+proto.contentservice.ListWorkspaceContentRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwnerId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addWorkspaceIds(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.contentservice.ListWorkspaceContentRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.contentservice.ListWorkspaceContentRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.contentservice.ListWorkspaceContentRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.ListWorkspaceContentRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOwnerId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getWorkspaceIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string owner_id = 1;
+ * @return {string}
+ */
+proto.contentservice.ListWorkspaceContentRequest.prototype.getOwnerId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.ListWorkspaceContentRequest} returns this
+ */
+proto.contentservice.ListWorkspaceContentRequest.prototype.setOwnerId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string workspace_ids = 2;
+ * @return {!Array<string>}
+ */
+proto.contentservice.ListWorkspaceContentRequest.prototype.getWorkspaceIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.contentservice.ListWorkspaceContentRequest} returns this
+ */
+proto.contentservice.ListWorkspaceContentRequest.prototype.setWorkspaceIdsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.ListWorkspaceContentRequest} returns this
+ */
+proto.contentservice.ListWorkspaceContentRequest.prototype.addWorkspaceIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.ListWorkspaceContentRequest} returns this
+ */
+proto.contentservice.ListWorkspaceContentRequest.prototype.clearWorkspaceIdsList = function() {
+  return this.setWorkspaceIdsList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.contentservice.ListWorkspaceContentResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.contentservice.ListWorkspaceContentResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.contentservice.ListWorkspaceContentResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.contentservice.ListWorkspaceContentResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.ListWorkspaceContentResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    contentList: jspb.Message.toObjectList(msg.getContentList(),
+    proto.contentservice.WorkspaceContent.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.contentservice.ListWorkspaceContentResponse}
+ */
+proto.contentservice.ListWorkspaceContentResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.contentservice.ListWorkspaceContentResponse;
+  return proto.contentservice.ListWorkspaceContentResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.contentservice.ListWorkspaceContentResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.contentservice.ListWorkspaceContentResponse}
+ */
+proto.contentservice.ListWorkspaceContentResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.contentservice.WorkspaceContent;
+      reader.readMessage(value,proto.contentservice.WorkspaceContent.deserializeBinaryFromReader);
+      msg.addContent(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.contentservice.ListWorkspaceContentResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.contentservice.ListWorkspaceContentResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.contentservice.ListWorkspaceContentResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.ListWorkspaceContentResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContentList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.contentservice.WorkspaceContent.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated WorkspaceContent content = 1;
+ * @return {!Array<!proto.contentservice.WorkspaceContent>}
+ */
+proto.contentservice.ListWorkspaceContentResponse.prototype.getContentList = function() {
+  return /** @type{!Array<!proto.contentservice.WorkspaceContent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.contentservice.WorkspaceContent, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.contentservice.WorkspaceContent>} value
+ * @return {!proto.contentservice.ListWorkspaceContentResponse} returns this
+*/
+proto.contentservice.ListWorkspaceContentResponse.prototype.setContentList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.contentservice.WorkspaceContent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.contentservice.WorkspaceContent}
+ */
+proto.contentservice.ListWorkspaceContentResponse.prototype.addContent = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.contentservice.WorkspaceContent, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.contentservice.ListWorkspaceContentResponse} returns this
+ */
+proto.contentservice.ListWorkspaceContentResponse.prototype.clearContentList = function() {
+  return this.setContentList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.contentservice.WorkspaceContent.prototype.toObject = function(opt_includeInstance) {
+  return proto.contentservice.WorkspaceContent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.contentservice.WorkspaceContent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.WorkspaceContent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    workspaceId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    size: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    created: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retention: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    formatVersion: jspb.Message.getFieldWithDefault(msg, 7, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.contentservice.WorkspaceContent}
+ */
+proto.contentservice.WorkspaceContent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.contentservice.WorkspaceContent;
+  return proto.contentservice.WorkspaceContent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.contentservice.WorkspaceContent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.contentservice.WorkspaceContent}
+ */
+proto.contentservice.WorkspaceContent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWorkspaceId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.contentservice.WorkspaceContentKind} */ (reader.readEnum());
+      msg.setKind(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSize(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreated(value);
+      break;
+    case 6:
+      var value = /** @type {!proto.contentservice.WorkspaceContentRetention} */ (reader.readEnum());
+      msg.setRetention(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setFormatVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.contentservice.WorkspaceContent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.contentservice.WorkspaceContent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.contentservice.WorkspaceContent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.contentservice.WorkspaceContent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWorkspaceId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getCreated();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getRetention();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getFormatVersion();
+  if (f !== 0) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string workspace_id = 1;
+ * @return {string}
+ */
+proto.contentservice.WorkspaceContent.prototype.getWorkspaceId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.WorkspaceContent} returns this
+ */
+proto.contentservice.WorkspaceContent.prototype.setWorkspaceId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.contentservice.WorkspaceContent.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.contentservice.WorkspaceContent} returns this
+ */
+proto.contentservice.WorkspaceContent.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional WorkspaceContentKind kind = 3;
+ * @return {!proto.contentservice.WorkspaceContentKind}
+ */
+proto.contentservice.WorkspaceContent.prototype.getKind = function() {
+  return /** @type {!proto.contentservice.WorkspaceContentKind} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.contentservice.WorkspaceContentKind} value
+ * @return {!proto.contentservice.WorkspaceContent} returns this
+ */
+proto.contentservice.WorkspaceContent.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional int64 size = 4;
+ * @return {number}
+ */
+proto.contentservice.WorkspaceContent.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.contentservice.WorkspaceContent} returns this
+ */
+proto.contentservice.WorkspaceContent.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 created = 5;
+ * @return {number}
+ */
+proto.contentservice.WorkspaceContent.prototype.getCreated = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.contentservice.WorkspaceContent} returns this
+ */
+proto.contentservice.WorkspaceContent.prototype.setCreated = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional WorkspaceContentRetention retention = 6;
+ * @return {!proto.contentservice.WorkspaceContentRetention}
+ */
+proto.contentservice.WorkspaceContent.prototype.getRetention = function() {
+  return /** @type {!proto.contentservice.WorkspaceContentRetention} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.contentservice.WorkspaceContentRetention} value
+ * @return {!proto.contentservice.WorkspaceContent} returns this
+ */
+proto.contentservice.WorkspaceContent.prototype.setRetention = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * optional int32 format_version = 7;
+ * @return {number}
+ */
+proto.contentservice.WorkspaceContent.prototype.getFormatVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.contentservice.WorkspaceContent} returns this
+ */
+proto.contentservice.WorkspaceContent.prototype.setFormatVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.contentservice.WorkspaceContentKind = {
+  WORKSPACE_CONTENT_KIND_BACKUP: 0,
+  WORKSPACE_CONTENT_KIND_SNAPSHOT: 1
+};
+
+/**
+ * @enum {number}
+ */
+proto.contentservice.WorkspaceContentRetention = {
+  WORKSPACE_CONTENT_RETENTION_BACKUP: 0,
+  WORKSPACE_CONTENT_RETENTION_SNAPSHOTS: 1
+};
+
 goog.object.extend(exports, proto.contentservice);
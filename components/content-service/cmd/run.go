@@ -52,6 +52,12 @@ var runCmd = &cobra.Command{
 		}
 		api.RegisterHeadlessLogServiceServer(srv.GRPC(), headlessLogService)
 
+		forensicsService, err := service.NewForensicsService(cfg.Storage, cfg.Forensics)
+		if err != nil {
+			log.WithError(err).Fatalf("Cannot create forensics service")
+		}
+		api.RegisterForensicsServiceServer(srv.GRPC(), forensicsService)
+
 		idePluginService, err := service.NewIDEPluginService(cfg.Storage)
 		if err != nil {
 			log.WithError(err).Fatalf("Cannot create IDE Plugin service")
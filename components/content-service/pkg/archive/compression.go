@@ -0,0 +1,118 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/xerrors"
+)
+
+// Compression is the codec an archive is compressed with
+type Compression string
+
+const (
+	// CompressionNone leaves archives uncompressed
+	CompressionNone Compression = "none"
+	// CompressionGzip compresses archives using gzip
+	CompressionGzip Compression = "gzip"
+	// CompressionZstd compresses archives using zstd
+	CompressionZstd Compression = "zstd"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// Validate checks if the compression codec is known and the level within its range. Level 0 selects the
+// default level of the codec.
+func (c Compression) Validate(level int) error {
+	var maxLevel int
+	switch c {
+	case "", CompressionNone:
+		if level != 0 {
+			return xerrors.Errorf("uncompressed archives have no compression level")
+		}
+		return nil
+	case CompressionGzip:
+		maxLevel = gzip.BestCompression
+	case CompressionZstd:
+		maxLevel = 22
+	default:
+		return xerrors.Errorf("unknown compression %q", c)
+	}
+	if level < 0 || level > maxLevel {
+		return xerrors.Errorf("%s compression level must be between 1 and %d", c, maxLevel)
+	}
+	return nil
+}
+
+// ContentType is the content type of archives compressed with this codec
+func (c Compression) ContentType() string {
+	switch c {
+	case CompressionGzip:
+		return "application/gzip"
+	case CompressionZstd:
+		return "application/zstd"
+	default:
+		return "application/x-tar"
+	}
+}
+
+// NewCompressWriter compresses everything written to the returned writer into w. The writer must be closed
+// to flush the compressed stream. Closing it does not close w.
+func NewCompressWriter(w io.Writer, c Compression, level int) (io.WriteCloser, error) {
+	switch c {
+	case "", CompressionNone:
+		return nopWriteCloser{w}, nil
+	case CompressionGzip:
+		if level == 0 {
+			level = gzip.DefaultCompression
+		}
+		return gzip.NewWriterLevel(w, level)
+	case CompressionZstd:
+		if level == 0 {
+			return zstd.NewWriter(w)
+		}
+		return zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	default:
+		return nil, xerrors.Errorf("unknown compression %q", c)
+	}
+}
+
+// NewDecompressReader returns a reader which decompresses r. The codec is detected from the content of r,
+// which is passed through as is if it isn't compressed.
+func NewDecompressReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, zstdMagic):
+		dec, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	default:
+		return io.NopCloser(br), nil
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
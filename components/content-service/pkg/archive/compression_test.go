@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package archive
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	content := bytes.Repeat([]byte("workspace content "), 1024)
+
+	tests := []struct {
+		Compression Compression
+		Level       int
+	}{
+		{Compression: CompressionNone},
+		{Compression: CompressionGzip},
+		{Compression: CompressionGzip, Level: 1},
+		{Compression: CompressionZstd},
+		{Compression: CompressionZstd, Level: 19},
+	}
+	for _, test := range tests {
+		t.Run(string(test.Compression), func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewCompressWriter(&buf, test.Compression, test.Level)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = w.Write(content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = w.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.Compression != CompressionNone && buf.Len() >= len(content) {
+				t.Errorf("expected content to be compressed, got %d bytes from %d", buf.Len(), len(content))
+			}
+
+			r, err := NewDecompressReader(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close()
+			act, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(content, act) {
+				t.Errorf("decompressed content does not match")
+			}
+		})
+	}
+}
+
+func TestCompressionValidate(t *testing.T) {
+	tests := []struct {
+		Compression Compression
+		Level       int
+		Valid       bool
+	}{
+		{Compression: "", Valid: true},
+		{Compression: CompressionNone, Valid: true},
+		{Compression: CompressionNone, Level: 3},
+		{Compression: CompressionGzip, Level: 9, Valid: true},
+		{Compression: CompressionGzip, Level: 10},
+		{Compression: CompressionZstd, Level: 3, Valid: true},
+		{Compression: CompressionZstd, Level: -1},
+		{Compression: "lz4"},
+	}
+	for _, test := range tests {
+		err := test.Compression.Validate(test.Level)
+		if (err == nil) != test.Valid {
+			t.Errorf("%s level %d: unexpected validation result: %v", test.Compression, test.Level, err)
+		}
+	}
+}
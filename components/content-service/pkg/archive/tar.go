@@ -26,8 +26,13 @@ import (
 
 // TarConfig configures tarbal creation/extraction
 type TarConfig struct {
-	UIDMaps []IDMapping
-	GIDMaps []IDMapping
+	UIDMaps          []IDMapping
+	GIDMaps          []IDMapping
+	Includes         []string
+	Excludes         []string
+	Whiteouts        bool
+	Compression      Compression
+	CompressionLevel int
 }
 
 // BuildTarbalOption configures the tarbal creation
@@ -54,7 +59,41 @@ func WithGIDMapping(mappings []IDMapping) TarOption {
 	}
 }
 
-// ExtractTarbal extracts an OCI compatible tar file src to the folder dst, expecting the overlay whiteout format
+// WithIncludes restricts the archive to the given paths during archive creation. The archive contains all paths if none are given.
+func WithIncludes(paths []string) TarOption {
+	return func(o *TarConfig) {
+		o.Includes = paths
+	}
+}
+
+// WithExcludes leaves the paths matching the given patterns out of the archive
+func WithExcludes(patterns []string) TarOption {
+	return func(o *TarConfig) {
+		o.Excludes = patterns
+	}
+}
+
+// WithWhiteouts removes the files marked by whiteout entries during extraction, as found in delta layers
+func WithWhiteouts() TarOption {
+	return func(o *TarConfig) {
+		o.Whiteouts = true
+	}
+}
+
+// WithCompression compresses the archive using the given codec and level during archive creation.
+// Extraction detects the compression of an archive by itself.
+func WithCompression(c Compression, level int) TarOption {
+	return func(o *TarConfig) {
+		o.Compression = c
+		o.CompressionLevel = level
+	}
+}
+
+// WhiteoutPrefix marks a tar entry as whiteout of the file with the same name sans prefix
+const WhiteoutPrefix = ".wh."
+
+// ExtractTarbal extracts an OCI compatible tar file src to the folder dst, expecting the overlay whiteout format.
+// Compressed tar files are decompressed on the fly.
 func ExtractTarbal(ctx context.Context, src io.Reader, dst string, opts ...TarOption) (err error) {
 	type Info struct {
 		UID, GID  int
@@ -73,8 +112,14 @@ func ExtractTarbal(ctx context.Context, src io.Reader, dst string, opts ...TarOp
 		opt(&cfg)
 	}
 
+	decompressed, err := NewDecompressReader(src)
+	if err != nil {
+		return xerrors.Errorf("cannot decompress archive: %w", err)
+	}
+	defer decompressed.Close()
+
 	pipeReader, pipeWriter := io.Pipe()
-	teeReader := io.TeeReader(src, pipeWriter)
+	teeReader := io.TeeReader(decompressed, pipeWriter)
 
 	tarReader := tar.NewReader(pipeReader)
 
@@ -126,6 +171,27 @@ func ExtractTarbal(ctx context.Context, src io.Reader, dst string, opts ...TarOp
 	<-finished
 	tracing.FinishSpan(unpackSpan, &err)
 
+	if cfg.Whiteouts {
+		// Whiteouts mark files which were deleted. We remove the whiteout and the file it marks,
+		// and forget about the whiteout such that we don't try to chown it below.
+		for p := range m {
+			dir, base := path.Split(p)
+			if !strings.HasPrefix(base, WhiteoutPrefix) {
+				continue
+			}
+
+			err = os.RemoveAll(path.Join(dst, dir, strings.TrimPrefix(base, WhiteoutPrefix)))
+			if err != nil {
+				return xerrors.Errorf("cannot apply whiteout %s: %w", p, err)
+			}
+			err = os.Remove(path.Join(dst, p))
+			if err != nil && !os.IsNotExist(err) {
+				return xerrors.Errorf("cannot remove whiteout %s: %w", p, err)
+			}
+			delete(m, p)
+		}
+	}
+
 	chownSpan := opentracing.StartSpan("chown", opentracing.ChildOf(span.Context()))
 	// lets create a sorted list of pathes and chown depth first.
 	paths := make([]string, 0, len(m))
@@ -13,6 +13,8 @@ import (
 	"path/filepath"
 	"syscall"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestExtractTarbal(t *testing.T) {
@@ -110,3 +112,94 @@ func TestExtractTarbal(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractTarbalWhiteouts(t *testing.T) {
+	tests := []struct {
+		Name      string
+		Existing  []string
+		Entries   []string
+		Whiteouts bool
+		Expected  []string
+	}{
+		{
+			Name:      "removes marked files",
+			Existing:  []string{"a.txt", "dir/b.txt", "dir/c.txt"},
+			Entries:   []string{WhiteoutPrefix + "a.txt", "dir/" + WhiteoutPrefix + "b.txt", "new.txt"},
+			Whiteouts: true,
+			Expected:  []string{"dir/c.txt", "new.txt"},
+		},
+		{
+			Name:      "removes marked directories",
+			Existing:  []string{"dir/b.txt", "other.txt"},
+			Entries:   []string{WhiteoutPrefix + "dir"},
+			Whiteouts: true,
+			Expected:  []string{"other.txt"},
+		},
+		{
+			Name:     "keeps whiteouts if disabled",
+			Existing: []string{"a.txt"},
+			Entries:  []string{WhiteoutPrefix + "a.txt"},
+			Expected: []string{WhiteoutPrefix + "a.txt", "a.txt"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				buf = bytes.NewBuffer(nil)
+				tw  = tar.NewWriter(buf)
+			)
+			for _, name := range test.Entries {
+				err := tw.WriteHeader(&tar.Header{
+					Name:     name,
+					Mode:     0644,
+					Uid:      os.Getuid(),
+					Gid:      os.Getgid(),
+					Typeflag: tar.TypeReg,
+				})
+				if err != nil {
+					t.Fatalf("cannot prepare archive: %q", err)
+				}
+			}
+			tw.Close()
+
+			targetFolder := t.TempDir()
+			for _, name := range test.Existing {
+				fn := filepath.Join(targetFolder, name)
+				err := os.MkdirAll(filepath.Dir(fn), 0755)
+				if err != nil {
+					t.Fatalf("cannot prepare test: %v", err)
+				}
+				err = os.WriteFile(fn, nil, 0644)
+				if err != nil {
+					t.Fatalf("cannot prepare test: %v", err)
+				}
+			}
+
+			var opts []TarOption
+			if test.Whiteouts {
+				opts = append(opts, WithWhiteouts())
+			}
+			err := ExtractTarbal(context.Background(), buf, targetFolder, opts...)
+			if err != nil {
+				t.Fatalf("cannot extract tar content: %v", err)
+			}
+
+			var act []string
+			err = filepath.WalkDir(targetFolder, func(path string, d fs.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					return err
+				}
+				rel, err := filepath.Rel(targetFolder, path)
+				act = append(act, rel)
+				return err
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expected, act); diff != "" {
+				t.Errorf("unexpected content (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
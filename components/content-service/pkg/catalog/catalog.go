@@ -0,0 +1,106 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package catalog
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	// PathPrefix is the prefix under which the catalog entries are stored inside a workspace
+	PathPrefix = "catalog"
+
+	// FormatVersion is the version of the format of the catalog entries we write
+	FormatVersion = 1
+)
+
+// Kind is the kind of content a catalog entry describes
+type Kind string
+
+const (
+	// KindBackup is the regular backup of a workspace, including its delta layers
+	KindBackup Kind = "backup"
+	// KindSnapshot is a snapshot of a workspace
+	KindSnapshot Kind = "snapshot"
+)
+
+// Entry describes a backup or snapshot of a workspace. Catalog entries are empty objects which carry all
+// their metadata in their name, such that listing the catalog of a workspace doesn't need to read any object.
+type Entry struct {
+	// Name is the name of the object the entry describes, e.g. full.tar or snapshot-1700000000.tar
+	Name string
+	Kind Kind
+	// Size is the size in bytes of the content in remote storage
+	Size    int64
+	Created time.Time
+	// FormatVersion is the version of the format the entry was written in
+	FormatVersion int
+}
+
+// Path returns the path of a catalog entry relative to the workspace
+func (e Entry) Path() string {
+	return fmt.Sprintf("%s/%s-%d-%d-v%d-%s", PathPrefix, e.Kind, e.Created.Unix(), e.Size, e.FormatVersion, e.Name)
+}
+
+// KindPrefix returns the prefix of the paths of all catalog entries of a kind relative to the workspace
+func KindPrefix(kind Kind) string {
+	return fmt.Sprintf("%s/%s-", PathPrefix, kind)
+}
+
+// ParseEntry parses the name of a catalog entry, which is the last segment of its path
+func ParseEntry(name string) (e Entry, err error) {
+	name = path.Base(name)
+	segs := strings.SplitN(name, "-", 5)
+	if len(segs) != 5 || segs[4] == "" || !strings.HasPrefix(segs[3], "v") {
+		return Entry{}, xerrors.Errorf("%s is not a catalog entry", name)
+	}
+
+	e.Kind = Kind(segs[0])
+	switch e.Kind {
+	case KindBackup, KindSnapshot:
+	default:
+		return Entry{}, xerrors.Errorf("%s is not a catalog entry: unknown kind %s", name, e.Kind)
+	}
+	created, err := strconv.ParseInt(segs[1], 10, 64)
+	if err != nil {
+		return Entry{}, xerrors.Errorf("%s is not a catalog entry: %w", name, err)
+	}
+	e.Created = time.Unix(created, 0)
+	e.Size, err = strconv.ParseInt(segs[2], 10, 64)
+	if err != nil {
+		return Entry{}, xerrors.Errorf("%s is not a catalog entry: %w", name, err)
+	}
+	e.FormatVersion, err = strconv.Atoi(strings.TrimPrefix(segs[3], "v"))
+	if err != nil {
+		return Entry{}, xerrors.Errorf("%s is not a catalog entry: %w", name, err)
+	}
+	e.Name = segs[4]
+
+	return e, nil
+}
+
+// Superseded returns the catalog entries among objects which describe the same content as latest, but were
+// created before it, e.g. the entries of previous backups of a workspace. Objects which are not catalog entries are ignored.
+func Superseded(objects []string, latest Entry) []string {
+	var res []string
+	for _, obj := range objects {
+		e, err := ParseEntry(obj)
+		if err != nil {
+			continue
+		}
+		// entries only carry the second they were created in
+		if e.Kind != latest.Kind || e.Name != latest.Name || e.Created.Unix() >= latest.Created.Unix() {
+			continue
+		}
+		res = append(res, obj)
+	}
+	return res
+}
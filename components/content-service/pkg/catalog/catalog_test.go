@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package catalog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/catalog"
+)
+
+func TestSuperseded(t *testing.T) {
+	latest := catalog.Entry{
+		Name:          "full.tar",
+		Kind:          catalog.KindBackup,
+		Size:          8192,
+		Created:       time.Unix(1700000200, 500),
+		FormatVersion: catalog.FormatVersion,
+	}
+
+	tests := []struct {
+		Name        string
+		Objects     []string
+		Expectation []string
+	}{
+		{
+			Name: "no objects",
+		},
+		{
+			Name: "previous backups",
+			Objects: []string{
+				"workspaces/foo/catalog/backup-1700000100-2048-v1-full.tar",
+				"workspaces/foo/catalog/backup-1700000200-8192-v1-full.tar",
+				"workspaces/foo/catalog/backup-1700000000-1024-v1-full.tar",
+			},
+			Expectation: []string{
+				"workspaces/foo/catalog/backup-1700000100-2048-v1-full.tar",
+				"workspaces/foo/catalog/backup-1700000000-1024-v1-full.tar",
+			},
+		},
+		{
+			Name: "later backups are kept",
+			Objects: []string{
+				"workspaces/foo/catalog/backup-1700000300-4096-v1-full.tar",
+			},
+		},
+		{
+			Name: "other content is kept",
+			Objects: []string{
+				"workspaces/foo/catalog/snapshot-1700000100-2048-v1-full.tar",
+				"workspaces/foo/catalog/backup-1700000100-2048-v1-other.tar",
+				"workspaces/foo/catalog/foobar",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := catalog.Superseded(test.Objects, latest)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected superseded entries (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package forensics
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	// UploadedBundlePathPrefix is the prefix under which forensic bundles are stored inside an instance
+	UploadedBundlePathPrefix = "forensics"
+
+	bundleSuffix = ".tar.gz"
+)
+
+// UploadedBundlePath returns the path of a forensic bundle relative to the workspace instance.
+// Bundles are named after the time they were collected and why.
+func UploadedBundlePath(created time.Time, reason string) string {
+	return fmt.Sprintf("%s/%d-%s%s", UploadedBundlePathPrefix, created.Unix(), reason, bundleSuffix)
+}
+
+// ParseBundleName parses the name of a forensic bundle, which is the last segment of its uploaded path
+func ParseBundleName(name string) (created time.Time, reason string, err error) {
+	name = path.Base(name)
+	if !strings.HasSuffix(name, bundleSuffix) {
+		return time.Time{}, "", xerrors.Errorf("%s is not a forensic bundle", name)
+	}
+	ts, reason, ok := strings.Cut(strings.TrimSuffix(name, bundleSuffix), "-")
+	if !ok || reason == "" {
+		return time.Time{}, "", xerrors.Errorf("%s is not a forensic bundle", name)
+	}
+	secs, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return time.Time{}, "", xerrors.Errorf("%s is not a forensic bundle: %w", name, err)
+	}
+	return time.Unix(secs, 0), reason, nil
+}
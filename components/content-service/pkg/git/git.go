@@ -12,6 +12,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/opentracing/opentracing-go"
@@ -95,8 +96,22 @@ type Client struct {
 
 	// if true will run git command as gitpod user (should be executed as root that has access to sudo in this case)
 	RunAsGitpodUser bool
+
+	// CloneDepth is the number of commits a clone fetches. Zero fetches the latest commit only, FullHistory all of them.
+	CloneDepth int
 }
 
+// FullHistory is the CloneDepth of a clone which fetches the full history
+const FullHistory = -1
+
+// ConfigUnshallow is the Git config key which, if false, prevents supervisor from fetching
+// the full history of a shallow clone once the workspace runs.
+const ConfigUnshallow = "gitpod.unshallow"
+
+// ConfigLFS is the Git config key which, if "deferred", makes supervisor download the
+// Git LFS objects of a repository once the workspace runs.
+const ConfigLFS = "gitpod.lfs"
+
 // Status describes the status of a Git repo/working copy akin to "git status"
 type Status struct {
 	porcelainStatus
@@ -338,7 +353,15 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 		log.WithError(err).Error("cannot create clone location")
 	}
 
-	args := []string{"--depth=1", "--shallow-submodules", c.RemoteURI}
+	var args []string
+	switch {
+	case c.CloneDepth == FullHistory:
+	case c.CloneDepth > 0:
+		args = append(args, "--depth="+strconv.Itoa(c.CloneDepth), "--shallow-submodules")
+	default:
+		args = append(args, "--depth=1", "--shallow-submodules")
+	}
+	args = append(args, c.RemoteURI)
 
 	for key, value := range c.Config {
 		args = append(args, "--config")
@@ -356,6 +379,27 @@ func (c *Client) Clone(ctx context.Context) (err error) {
 	return c.Git(ctx, "clone", args...)
 }
 
+// UsesLFS returns true if a .gitattributes file of the working copy assigns the Git LFS filter
+func (c *Client) UsesLFS(ctx context.Context) (bool, error) {
+	out, err := c.GitWithOutput(ctx, nil, "ls-files", "-z", "--", ":(glob)**/.gitattributes")
+	if err != nil {
+		return false, err
+	}
+	for _, fn := range strings.Split(string(out), "\x00") {
+		if fn == "" {
+			continue
+		}
+		attrs, err := os.ReadFile(filepath.Join(c.Location, fn))
+		if err != nil {
+			return false, err
+		}
+		if bytes.Contains(attrs, []byte("filter=lfs")) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // UpdateRemote performs a git fetch on the upstream remote URI
 func (c *Client) UpdateRemote(ctx context.Context) (err error) {
 	//nolint:staticcheck,ineffassign
@@ -6,6 +6,7 @@ package git
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -531,3 +532,114 @@ func initFromRemote(ctx context.Context, c *Client) error {
 
 	return nil
 }
+
+func TestCloneDepth(t *testing.T) {
+	ctx := context.Background()
+	remote, err := newGitClient(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.Git(ctx, "init"); err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.Git(ctx, "config", "--local", "user.email", "foo@bar.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := remote.Git(ctx, "config", "--local", "user.name", "foo bar"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		if err := remote.Git(ctx, "commit", "--allow-empty", "-m", fmt.Sprintf("commit %d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		Name    string
+		Depth   int
+		Commits string
+		Shallow string
+	}{
+		{Name: "default", Depth: 0, Commits: "1", Shallow: "true"},
+		{Name: "limited", Depth: 3, Commits: "3", Shallow: "true"},
+		{Name: "full history", Depth: FullHistory, Commits: "5", Shallow: "false"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			c, err := newGitClient(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// depth is ignored for local clones which do not use a transport
+			c.RemoteURI = "file://" + remote.Location
+			c.CloneDepth = test.Depth
+			if err := c.Clone(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			out, err := c.GitWithOutput(ctx, nil, "rev-list", "--count", "HEAD")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if commits := strings.TrimSpace(string(out)); commits != test.Commits {
+				t.Errorf("unexpected number of commits: want %s, got %s", test.Commits, commits)
+			}
+			out, err = c.GitWithOutput(ctx, nil, "rev-parse", "--is-shallow-repository")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if shallow := strings.TrimSpace(string(out)); shallow != test.Shallow {
+				t.Errorf("unexpected shallow state: want %s, got %s", test.Shallow, shallow)
+			}
+		})
+	}
+}
+
+func TestUsesLFS(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Files       map[string]string
+		Expectation bool
+	}{
+		{Name: "no attributes", Files: map[string]string{"README.md": "hello"}},
+		{Name: "attributes without lfs", Files: map[string]string{".gitattributes": "*.sh text eol=lf\n"}},
+		{Name: "root attributes", Files: map[string]string{".gitattributes": "*.psd filter=lfs diff=lfs merge=lfs -text\n"}, Expectation: true},
+		{Name: "nested attributes", Files: map[string]string{"assets/.gitattributes": "*.png filter=lfs diff=lfs merge=lfs -text\n"}, Expectation: true},
+		{Name: "untracked attributes", Files: map[string]string{"untracked/.gitattributes": "*.png filter=lfs diff=lfs merge=lfs -text\n"}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := context.Background()
+			c, err := newGitClient(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := c.Git(ctx, "init"); err != nil {
+				t.Fatal(err)
+			}
+			for fn, content := range test.Files {
+				fn = filepath.Join(c.Location, fn)
+				if err := os.MkdirAll(filepath.Dir(fn), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(fn, []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+				if strings.Contains(fn, "untracked") {
+					continue
+				}
+				if err := c.Git(ctx, "add", fn); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			act, err := c.UsesLFS(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if act != test.Expectation {
+				t.Errorf("unexpected result: want %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
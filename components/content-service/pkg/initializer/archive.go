@@ -0,0 +1,333 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+// archiveInitializer downloads an archive and extracts it into the workspace
+type archiveInitializer struct {
+	URL           string
+	Authorization string
+	Format        csapi.ArchiveFormat
+
+	// TargetLocation is the directory the archive is extracted into
+	TargetLocation string
+
+	// Digest is the expected digest of the archive. If empty, the archive is not verified.
+	Digest digest.Digest
+
+	// StripComponents is the number of leading path elements removed from every archive entry
+	StripComponents int
+
+	HTTPClient   *http.Client
+	RetryTimeout time.Duration
+}
+
+// Run initializes the workspace
+func (ws *archiveInitializer) Run(ctx context.Context, mappings []archive.IDMapping) (src csapi.WorkspaceInitSource, metrics csapi.InitializerMetrics, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ArchiveInitializer.Run")
+	defer tracing.FinishSpan(span, &err)
+	span.LogKV("url", ws.URL)
+	start := time.Now()
+	initialSize, fsErr := getFsUsage()
+	if fsErr != nil {
+		log.WithError(fsErr).Error("could not get disk usage")
+	}
+
+	err = os.MkdirAll(ws.TargetLocation, 0755)
+	if err != nil {
+		return src, nil, xerrors.Errorf("cannot create archive target location: %w", err)
+	}
+
+	// The archive is downloaded next to its target location, rather than into a temporary directory of the node,
+	// as archives can be large and should count against the workspace's disk quota.
+	fd, err := os.CreateTemp(filepath.Dir(ws.TargetLocation), ".gitpod-archive-*")
+	if err != nil {
+		return src, nil, xerrors.Errorf("cannot create archive download file: %w", err)
+	}
+	defer os.Remove(fd.Name())
+	defer fd.Close()
+
+	for i := 0; i < otsDownloadAttempts; i++ {
+		span.LogKV("attempt", i)
+		if i > 0 {
+			time.Sleep(ws.RetryTimeout)
+		}
+
+		err = ws.download(ctx, fd)
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			return src, nil, err
+		}
+		if err == nil {
+			break
+		}
+		log.WithError(err).WithField("attempt", i).Warn("cannot download archive")
+	}
+	if err != nil {
+		return src, nil, xerrors.Errorf("cannot download archive from %s: %w", ws.URL, err)
+	}
+
+	err = ws.extract(fd)
+	if err != nil {
+		return src, nil, xerrors.Errorf("cannot extract archive from %s: %w", ws.URL, err)
+	}
+
+	if fsErr == nil {
+		currentSize, fsErr := getFsUsage()
+		if fsErr != nil {
+			log.WithError(fsErr).Error("could not get disk usage")
+		}
+
+		metrics = csapi.InitializerMetrics{csapi.InitializerMetric{
+			Type:     "archive",
+			Duration: time.Since(start),
+			Size:     currentSize - initialSize,
+		}}
+	}
+
+	src = csapi.WorkspaceInitFromOther
+	return
+}
+
+// download writes the archive to fd and verifies its digest
+func (ws *archiveInitializer) download(ctx context.Context, fd *os.File) (err error) {
+	_, err = fd.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	err = fd.Truncate(0)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", ws.URL, nil)
+	if err != nil {
+		return err
+	}
+	if ws.Authorization != "" {
+		req.Header.Set("Authorization", ws.Authorization)
+	}
+
+	resp, err := ws.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return xerrors.Errorf("non-OK download response: %s", resp.Status)
+	}
+
+	digester := digest.Canonical.Digester()
+	_, err = io.Copy(io.MultiWriter(fd, digester.Hash()), resp.Body)
+	if err != nil {
+		return err
+	}
+	if ws.Digest != "" && digester.Digest() != ws.Digest {
+		return xerrors.Errorf("digest mismatch")
+	}
+	return nil
+}
+
+func (ws *archiveInitializer) extract(fd *os.File) error {
+	_, err := fd.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	format := ws.Format
+	if format == csapi.ArchiveFormat_ARCHIVE_FORMAT_AUTO {
+		format, err = archiveFormatFromURL(ws.URL)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch format {
+	case csapi.ArchiveFormat_ARCHIVE_FORMAT_TAR:
+		return ws.extractTar(fd)
+	case csapi.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ:
+		gz, err := gzip.NewReader(fd)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		return ws.extractTar(gz)
+	case csapi.ArchiveFormat_ARCHIVE_FORMAT_ZIP:
+		stat, err := fd.Stat()
+		if err != nil {
+			return err
+		}
+		return ws.extractZip(fd, stat.Size())
+	default:
+		return xerrors.Errorf("unsupported archive format: %s", format)
+	}
+}
+
+func (ws *archiveInitializer) extractTar(r io.Reader) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		dst, ok, err := ws.destination(hdr.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		mode := fs.FileMode(hdr.Mode).Perm()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(dst, mode|0700)
+		case tar.TypeReg:
+			err = writeArchiveFile(dst, tr, mode)
+		case tar.TypeSymlink:
+			err = os.Symlink(hdr.Linkname, dst)
+		default:
+			log.WithField("name", hdr.Name).WithField("type", hdr.Typeflag).Debug("skipping unsupported archive entry")
+		}
+		if err != nil {
+			return xerrors.Errorf("cannot extract %s: %w", hdr.Name, err)
+		}
+	}
+}
+
+func (ws *archiveInitializer) extractZip(r io.ReaderAt, size int64) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+	for _, f := range zr.File {
+		dst, ok, err := ws.destination(f.Name)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		mode := f.Mode()
+		switch {
+		case mode.IsDir():
+			err = os.MkdirAll(dst, mode.Perm()|0700)
+		case mode.IsRegular():
+			err = func() error {
+				rc, err := f.Open()
+				if err != nil {
+					return err
+				}
+				defer rc.Close()
+				return writeArchiveFile(dst, rc, mode.Perm())
+			}()
+		default:
+			log.WithField("name", f.Name).WithField("mode", mode).Debug("skipping unsupported archive entry")
+		}
+		if err != nil {
+			return xerrors.Errorf("cannot extract %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// destination returns the path an archive entry is extracted to. Entries which are fully stripped away are skipped,
+// entries which would end up outside of the target location are rejected.
+func (ws *archiveInitializer) destination(name string) (dst string, ok bool, err error) {
+	name = path.Clean("/" + strings.ReplaceAll(name, "\\", "/"))
+	segments := strings.Split(strings.TrimPrefix(name, "/"), "/")
+	if len(segments) <= ws.StripComponents || (len(segments) == 1 && segments[0] == "") {
+		return "", false, nil
+	}
+	rel := filepath.Join(segments[ws.StripComponents:]...)
+
+	dst = filepath.Join(ws.TargetLocation, rel)
+
+	// the entry must not be written through a symlink which was extracted before
+	parent, err := filepath.EvalSymlinks(filepath.Dir(dst))
+	if os.IsNotExist(err) {
+		parent, err = filepath.Dir(dst), nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	target, err := filepath.EvalSymlinks(ws.TargetLocation)
+	if err != nil {
+		return "", false, err
+	}
+	if parent != target && !strings.HasPrefix(parent, target+string(filepath.Separator)) {
+		return "", false, xerrors.Errorf("archive entry %s is outside of the target location", name)
+	}
+	return dst, true, nil
+}
+
+func writeArchiveFile(dst string, src io.Reader, mode fs.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(dst), 0755)
+	if err != nil {
+		return err
+	}
+	// an earlier entry may have placed a symlink here which we must not write through
+	if fi, err := os.Lstat(dst); err == nil && fi.Mode()&fs.ModeSymlink != 0 {
+		err = os.Remove(dst)
+		if err != nil {
+			return err
+		}
+	}
+	fd, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode|0600)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+
+	_, err = io.Copy(fd, src)
+	return err
+}
+
+// archiveFormatFromURL derives the archive format from the file extension of a URL
+func archiveFormatFromURL(u string) (csapi.ArchiveFormat, error) {
+	pu, err := url.Parse(u)
+	if err != nil {
+		return csapi.ArchiveFormat_ARCHIVE_FORMAT_AUTO, err
+	}
+	p := strings.ToLower(pu.Path)
+	switch {
+	case strings.HasSuffix(p, ".tar.gz"), strings.HasSuffix(p, ".tgz"):
+		return csapi.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ, nil
+	case strings.HasSuffix(p, ".tar"):
+		return csapi.ArchiveFormat_ARCHIVE_FORMAT_TAR, nil
+	case strings.HasSuffix(p, ".zip"):
+		return csapi.ArchiveFormat_ARCHIVE_FORMAT_ZIP, nil
+	default:
+		return csapi.ArchiveFormat_ARCHIVE_FORMAT_AUTO, xerrors.Errorf("cannot derive archive format from %s, please specify the format", pu.Path)
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/opencontainers/go-digest"
+)
+
+type archiveEntry struct {
+	Name     string
+	Content  string
+	Linkname string
+}
+
+func buildTarGz(t *testing.T, entries []archiveEntry) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.Name, Mode: 0644, Size: int64(len(e.Content)), Typeflag: tar.TypeReg}
+		if strings.HasSuffix(e.Name, "/") {
+			hdr.Typeflag, hdr.Mode = tar.TypeDir, 0755
+		} else if e.Linkname != "" {
+			hdr.Typeflag, hdr.Linkname = tar.TypeSymlink, e.Linkname
+		}
+		err := tw.WriteHeader(hdr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = tw.Write([]byte(e.Content))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func buildZip(t *testing.T, entries []archiveEntry) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, e := range entries {
+		w, err := zw.Create(e.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = w.Write([]byte(e.Content))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestArchiveInitializer(t *testing.T) {
+	const authorization = "Bearer secret"
+
+	release := []archiveEntry{
+		{Name: "release-1.0/"},
+		{Name: "release-1.0/bin/tool", Content: "tool"},
+		{Name: "release-1.0/README.md", Content: "readme"},
+	}
+
+	tests := []struct {
+		Name            string
+		URL             string
+		Archive         []byte
+		Format          api.ArchiveFormat
+		Digest          string
+		StripComponents uint32
+		Expectation     map[string]string
+		ExpectedError   string
+	}{
+		{
+			Name:    "tar.gz",
+			URL:     "http://foobar/release.tar.gz",
+			Archive: buildTarGz(t, release),
+			Expectation: map[string]string{
+				"release-1.0/bin/tool":  "tool",
+				"release-1.0/README.md": "readme",
+			},
+		},
+		{
+			Name:            "zip with strip components",
+			URL:             "http://foobar/release.zip",
+			Archive:         buildZip(t, release),
+			StripComponents: 1,
+			Expectation: map[string]string{
+				"bin/tool":  "tool",
+				"README.md": "readme",
+			},
+		},
+		{
+			Name:    "explicit format",
+			URL:     "http://foobar/download?id=1",
+			Archive: buildTarGz(t, release),
+			Format:  api.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ,
+			Digest:  string(digest.FromBytes(buildTarGz(t, release))),
+			Expectation: map[string]string{
+				"release-1.0/bin/tool": "tool",
+			},
+		},
+		{
+			Name:          "unknown format",
+			URL:           "http://foobar/download?id=1",
+			Archive:       buildTarGz(t, release),
+			ExpectedError: "cannot derive archive format",
+		},
+		{
+			Name:          "digest mismatch",
+			URL:           "http://foobar/release.tar.gz",
+			Archive:       buildTarGz(t, release),
+			Digest:        string(digest.FromString("foobar")),
+			ExpectedError: "digest mismatch",
+		},
+		{
+			Name:          "not found",
+			URL:           "http://foobar/missing.tar.gz",
+			ExpectedError: "non-OK download response: Not Found",
+		},
+		{
+			Name: "path traversal",
+			URL:  "http://foobar/release.tar.gz",
+			Archive: buildTarGz(t, []archiveEntry{
+				{Name: "../../outside", Content: "evil"},
+			}),
+			Expectation: map[string]string{
+				"outside": "evil",
+			},
+		},
+		{
+			Name: "symlink escape",
+			URL:  "http://foobar/release.tar.gz",
+			Archive: buildTarGz(t, []archiveEntry{
+				{Name: "link", Linkname: "/tmp"},
+				{Name: "link/outside", Content: "evil"},
+			}),
+			ExpectedError: "outside of the target location",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tmpdir := t.TempDir()
+
+			client := &http.Client{
+				Transport: RoundTripFunc(func(req *http.Request) *http.Response {
+					if req.Header.Get("Authorization") != authorization {
+						return &http.Response{
+							Status:     http.StatusText(http.StatusUnauthorized),
+							StatusCode: http.StatusUnauthorized,
+							Header:     make(http.Header),
+						}
+					}
+					if test.Archive == nil {
+						return &http.Response{
+							Status:     http.StatusText(http.StatusNotFound),
+							StatusCode: http.StatusNotFound,
+							Header:     make(http.Header),
+						}
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(bytes.NewReader(test.Archive)),
+						Header:     make(http.Header),
+					}
+				}),
+			}
+
+			initializer, err := newArchiveInitializer(tmpdir, &api.ArchiveInitializer{
+				Url:             test.URL,
+				Authorization:   authorization,
+				Format:          test.Format,
+				TargetLocation:  "/workspace",
+				Digest:          test.Digest,
+				StripComponents: test.StripComponents,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			initializer.HTTPClient = client
+			initializer.RetryTimeout = 0
+
+			src, _, err := initializer.Run(context.Background(), nil)
+			if test.ExpectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), test.ExpectedError) {
+					t.Fatalf("expected error containing %q, got %v", test.ExpectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if src != api.WorkspaceInitFromOther {
+				t.Errorf("initializer returned wrong content init source. expected %v, got %v", api.WorkspaceInitFromOther, src)
+			}
+
+			for fn, expectedContent := range test.Expectation {
+				content, err := os.ReadFile(filepath.Join(tmpdir, "workspace", fn))
+				if err != nil {
+					t.Errorf("cannot read %s: %v", fn, err)
+					continue
+				}
+				if string(content) != expectedContent {
+					t.Errorf("unexpected content of %s: expected %q, got %q", fn, expectedContent, string(content))
+				}
+			}
+
+			leftovers, _ := filepath.Glob(filepath.Join(tmpdir, ".gitpod-archive-*"))
+			if len(leftovers) > 0 {
+				t.Errorf("downloaded archive was not removed: %v", leftovers)
+			}
+		})
+	}
+}
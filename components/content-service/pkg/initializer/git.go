@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 	"time"
 
@@ -42,6 +43,20 @@ const (
 	LocalBranch CloneTargetMode = "local-branch"
 )
 
+// LFSMode determines how a GitInitializer downloads Git LFS objects
+type LFSMode string
+
+const (
+	// LFSEager downloads the LFS objects of the checkout during initialization
+	LFSEager LFSMode = "eager"
+
+	// LFSDeferred configures LFS but leaves downloading the objects to supervisor
+	LFSDeferred LFSMode = "deferred"
+
+	// LFSDisabled leaves LFS pointer files in the checkout
+	LFSDisabled LFSMode = "disabled"
+)
+
 // GitInitializer is a local workspace with a Git connection
 type GitInitializer struct {
 	git.Client
@@ -54,6 +69,12 @@ type GitInitializer struct {
 
 	// If true, the Git initializer will chown(gitpod) after the clone
 	Chown bool
+
+	// If true, a shallow clone is not deepened once the workspace runs
+	KeepShallow bool
+
+	// LFS determines how Git LFS objects are downloaded if the repository uses LFS. Defaults to LFSEager.
+	LFS LFSMode
 }
 
 // Run initializes the workspace using Git
@@ -127,6 +148,13 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 			log.WithError(err).WithField("location", ws.Location).Error("cannot configure checkout defaultRemote")
 		}
 
+		if ws.KeepShallow {
+			err = ws.Git(ctx, "config", git.ConfigUnshallow, "false")
+			if err != nil {
+				log.WithError(err).WithField("location", ws.Location).Error("cannot disable unshallow")
+			}
+		}
+
 		return nil
 	}
 	onGitCloneFailure := func(e error, d time.Duration) {
@@ -181,6 +209,9 @@ func (ws *GitInitializer) Run(ctx context.Context, mappings []archive.IDMapping)
 	if err := ws.UpdateSubmodules(ctx); err != nil {
 		log.WithError(err).Warn("error while updating submodules - continuing")
 	}
+	if err := ws.realizeLFS(ctx); err != nil {
+		log.WithError(err).WithField("location", ws.Location).Warn("error while downloading Git LFS objects - continuing")
+	}
 
 	log.WithField("stage", "init").WithField("location", ws.Location).Debug("Git operations complete")
 
@@ -235,7 +266,8 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 		//
 		// We don't recurse submodules because callers realizeCloneTarget() are expected to update submodules explicitly,
 		// and deal with any error appropriately (i.e. emit a warning rather than fail).
-		if err := ws.Git(ctx, "fetch", "--depth=1", "origin", "--recurse-submodules=no", ws.CloneTarget); err != nil {
+		args := append(ws.depthArgs(1), "origin", "--recurse-submodules=no", ws.CloneTarget)
+		if err := ws.Git(ctx, "fetch", args...); err != nil {
 			log.WithError(err).WithField("remoteURI", ws.RemoteURI).WithField("branch", ws.CloneTarget).Error("Cannot fetch remote branch")
 			return err
 		}
@@ -253,7 +285,7 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 		// We did a shallow clone before, hence need to fetch the commit we are about to check out.
 		// Because we don't want to make the "git fetch" mechanism in supervisor more complicated,
 		// we'll just fetch the 20 commits right away.
-		if err := ws.Git(ctx, "fetch", "origin", ws.CloneTarget, "--depth=20"); err != nil {
+		if err := ws.Git(ctx, "fetch", append([]string{"origin", ws.CloneTarget}, ws.depthArgs(20)...)...); err != nil {
 			return err
 		}
 
@@ -276,6 +308,52 @@ func (ws *GitInitializer) realizeCloneTarget(ctx context.Context) (err error) {
 	return nil
 }
 
+// realizeLFS replaces the LFS pointer files of the checkout with their objects if the repository uses LFS
+func (ws *GitInitializer) realizeLFS(ctx context.Context) (err error) {
+	if ws.LFS == LFSDisabled {
+		return nil
+	}
+
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "realizeLFS")
+	span.SetTag("lfsMode", ws.LFS)
+	defer tracing.FinishSpan(span, &err)
+
+	usesLFS, err := ws.UsesLFS(ctx)
+	if err != nil {
+		return err
+	}
+	span.SetTag("usesLFS", usesLFS)
+	if !usesLFS {
+		return nil
+	}
+
+	// the filters make future checkouts download the objects they need
+	if err := ws.Git(ctx, "lfs", "install", "--local"); err != nil {
+		return err
+	}
+	if ws.LFS == LFSDeferred {
+		return ws.Git(ctx, "config", git.ConfigLFS, string(LFSDeferred))
+	}
+
+	if err := ws.Git(ctx, "lfs", "fetch", "origin"); err != nil {
+		return err
+	}
+	return ws.Git(ctx, "lfs", "checkout")
+}
+
+// depthArgs returns the arguments for a fetch of at least min commits which retains the depth of the clone.
+// A fetch with depth would turn a clone of the full history into a shallow one.
+func (ws *GitInitializer) depthArgs(min int) []string {
+	if ws.CloneDepth == git.FullHistory {
+		return nil
+	}
+	if ws.CloneDepth > min {
+		min = ws.CloneDepth
+	}
+	return []string{"--depth=" + strconv.Itoa(min)}
+}
+
 func checkGitStatus(err error) error {
 	if err != nil {
 		if strings.Contains(err.Error(), "The requested URL returned error: 524") {
@@ -133,6 +133,12 @@ func NewFromRequest(ctx context.Context, loc string, rs storage.DirectDownloader
 		}
 
 		initializer, err = newGitInitializer(ctx, loc, ir.Git, opts.ForceGitpodUserForGit)
+	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_MultiRepo); ok {
+		if ir.MultiRepo == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing multi repo initializer spec")
+		}
+
+		initializer, err = newMultiRepoInitializer(ctx, loc, ir.MultiRepo, opts.ForceGitpodUserForGit)
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Prebuild); ok {
 		if ir.Prebuild == nil {
 			return nil, status.Error(codes.InvalidArgument, "missing prebuild initializer spec")
@@ -160,6 +166,11 @@ func NewFromRequest(ctx context.Context, loc string, rs storage.DirectDownloader
 		initializer, err = newSnapshotInitializer(loc, rs, ir.Snapshot)
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Download); ok {
 		initializer, err = newFileDownloadInitializer(loc, ir.Download)
+	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Archive); ok {
+		if ir.Archive == nil {
+			return nil, status.Error(codes.InvalidArgument, "missing archive initializer spec")
+		}
+		initializer, err = newArchiveInitializer(loc, ir.Archive)
 	} else if ir, ok := spec.(*csapi.WorkspaceInitializer_Backup); ok {
 		initializer, err = newFromBackupInitializer(loc, rs, ir.Backup)
 	} else {
@@ -194,6 +205,32 @@ func newFileDownloadInitializer(loc string, req *csapi.FileDownloadInitializer)
 	return initializer, nil
 }
 
+// newArchiveInitializer creates an archive initializer for a request
+func newArchiveInitializer(loc string, req *csapi.ArchiveInitializer) (*archiveInitializer, error) {
+	if req.Url == "" {
+		return nil, status.Error(codes.InvalidArgument, "archive initializer misses URL")
+	}
+	var dgst digest.Digest
+	if req.Digest != "" {
+		var err error
+		dgst, err = digest.Parse(req.Digest)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid digest %s: %w", req.Digest, err)
+		}
+	}
+	initializer := &archiveInitializer{
+		URL:             req.Url,
+		Authorization:   req.Authorization,
+		Format:          req.Format,
+		TargetLocation:  filepath.Join(loc, req.TargetLocation),
+		Digest:          dgst,
+		StripComponents: int(req.StripComponents),
+		HTTPClient:      http.DefaultClient,
+		RetryTimeout:    1 * time.Second,
+	}
+	return initializer, nil
+}
+
 // newFromBackupInitializer creates a backup restoration initializer for a request
 func newFromBackupInitializer(loc string, rs storage.DirectDownloader, req *csapi.FromBackupInitializer) (*fromBackupInitializer, error) {
 	return &fromBackupInitializer{
@@ -230,6 +267,10 @@ func (bi *fromBackupInitializer) Run(ctx context.Context, mappings []archive.IDM
 	if err != nil {
 		return src, nil, xerrors.Errorf("cannot restore backup: %w", err)
 	}
+	err = restoreDeltaLayers(ctx, bi.RemoteStorage, bi.Location, mappings)
+	if err != nil {
+		return src, nil, err
+	}
 
 	if fsErr == nil {
 		currentSize, fsErr := getFsUsage()
@@ -268,6 +309,32 @@ func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitialize
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid target mode: %v", req.TargetMode))
 	}
 
+	var (
+		cloneDepth  = int(req.CloneDepth)
+		keepShallow bool
+	)
+	switch req.HistoryMode {
+	case csapi.GitHistoryMode_BACKGROUND_UNSHALLOW:
+	case csapi.GitHistoryMode_FULL_HISTORY:
+		cloneDepth = git.FullHistory
+	case csapi.GitHistoryMode_SHALLOW:
+		keepShallow = true
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid history mode: %v", req.HistoryMode))
+	}
+
+	var lfs LFSMode
+	switch req.LfsMode {
+	case csapi.GitLFSMode_LFS_EAGER:
+		lfs = LFSEager
+	case csapi.GitLFSMode_LFS_DEFERRED:
+		lfs = LFSDeferred
+	case csapi.GitLFSMode_LFS_DISABLED:
+		lfs = LFSDisabled
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid LFS mode: %v", req.LfsMode))
+	}
+
 	var authMethod = git.BasicAuth
 	if req.Config.Authentication == csapi.GitAuthMethod_NO_AUTH {
 		authMethod = git.NoAuth
@@ -304,10 +371,13 @@ func newGitInitializer(ctx context.Context, loc string, req *csapi.GitInitialize
 			AuthMethod:        authMethod,
 			AuthProvider:      authProvider,
 			RunAsGitpodUser:   forceGitpodUser,
+			CloneDepth:        cloneDepth,
 		},
 		TargetMode:  targetMode,
 		CloneTarget: req.CloneTaget,
 		Chown:       false,
+		KeepShallow: keepShallow,
+		LFS:         lfs,
 	}, nil
 }
 
@@ -476,6 +546,10 @@ func InitializeWorkspace(ctx context.Context, location string, remoteStorage sto
 	span.SetTag("hasBackup", hasBackup)
 	if hasBackup {
 		src = csapi.WorkspaceInitFromBackup
+		err = restoreDeltaLayers(ctx, remoteStorage, location, cfg.mappings)
+		if err != nil {
+			return src, nil, err
+		}
 	} else {
 		src, stats, err = cfg.Initializer.Run(ctx, cfg.mappings)
 		if err != nil {
@@ -486,6 +560,20 @@ func InitializeWorkspace(ctx context.Context, location string, remoteStorage sto
 	return
 }
 
+// restoreDeltaLayers applies the delta layers of the default backup in order, until the next layer is not found.
+// The remote storage is expected to offer only the layers listed in the delta manifest of the backup.
+func restoreDeltaLayers(ctx context.Context, rs storage.DirectDownloader, location string, mappings []archive.IDMapping) error {
+	for n := 1; ; n++ {
+		found, err := rs.Download(ctx, location, storage.DeltaLayer(n), mappings)
+		if err != nil {
+			return xerrors.Errorf("cannot restore delta layer %d: %w", n, err)
+		}
+		if !found {
+			return nil
+		}
+	}
+}
+
 // Some workspace content may have a `/dst/.gitpod` file or directory. That would break
 // the workspace ready file placement (see https://github.com/gitpod-io/gitpod/issues/7694).
 // This function ensures that workspaces do not have a `.gitpod` file or directory present.
@@ -11,7 +11,10 @@ import (
 
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/git"
 	"github.com/gitpod-io/gitpod/content-service/pkg/initializer"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/google/go-cmp/cmp"
 )
 
 type InitializerFunc func(ctx context.Context, mappings []archive.IDMapping) (csapi.WorkspaceInitSource, csapi.InitializerMetrics, error)
@@ -99,3 +102,146 @@ func TestCompositeInitializer(t *testing.T) {
 		})
 	}
 }
+
+type RecordingDownloader struct {
+	Available map[string]bool
+	Downloads []string
+}
+
+func (d *RecordingDownloader) Download(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error) {
+	d.Downloads = append(d.Downloads, name)
+	return d.Available[name], nil
+}
+
+func (d *RecordingDownloader) DownloadSnapshot(ctx context.Context, destination string, name string, mappings []archive.IDMapping) (found bool, err error) {
+	return d.Download(ctx, destination, name, mappings)
+}
+
+func TestInitializeWorkspaceDeltaLayers(t *testing.T) {
+	tests := []struct {
+		Name              string
+		Available         []string
+		ExpectedSrc       csapi.WorkspaceInitSource
+		ExpectedDownloads []string
+	}{
+		{
+			Name:              "no backup",
+			ExpectedSrc:       csapi.WorkspaceInitFromOther,
+			ExpectedDownloads: []string{storage.DefaultBackup},
+		},
+		{
+			Name:              "full backup only",
+			Available:         []string{storage.DefaultBackup},
+			ExpectedSrc:       csapi.WorkspaceInitFromBackup,
+			ExpectedDownloads: []string{storage.DefaultBackup, storage.DeltaLayer(1)},
+		},
+		{
+			Name:        "delta layers",
+			Available:   []string{storage.DefaultBackup, storage.DeltaLayer(1), storage.DeltaLayer(2)},
+			ExpectedSrc: csapi.WorkspaceInitFromBackup,
+			ExpectedDownloads: []string{
+				storage.DefaultBackup,
+				storage.DeltaLayer(1),
+				storage.DeltaLayer(2),
+				storage.DeltaLayer(3),
+			},
+		},
+		{
+			Name:              "delta layers are ignored without backup",
+			Available:         []string{storage.DeltaLayer(1)},
+			ExpectedSrc:       csapi.WorkspaceInitFromOther,
+			ExpectedDownloads: []string{storage.DefaultBackup},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			rs := &RecordingDownloader{Available: make(map[string]bool)}
+			for _, name := range test.Available {
+				rs.Available[name] = true
+			}
+
+			src, _, err := initializer.InitializeWorkspace(context.Background(), t.TempDir(), rs, initializer.WithInitializer(&RecordingInitializer{}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if src != test.ExpectedSrc {
+				t.Errorf("unexpected init source: want %s, got %s", test.ExpectedSrc, src)
+			}
+			if diff := cmp.Diff(test.ExpectedDownloads, rs.Downloads); diff != "" {
+				t.Errorf("unexpected downloads (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewGitInitializerHistoryMode(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Mode        csapi.GitHistoryMode
+		Depth       uint32
+		CloneDepth  int
+		KeepShallow bool
+	}{
+		{Name: "background unshallow", Mode: csapi.GitHistoryMode_BACKGROUND_UNSHALLOW, Depth: 50, CloneDepth: 50},
+		{Name: "background unshallow default depth", Mode: csapi.GitHistoryMode_BACKGROUND_UNSHALLOW},
+		{Name: "full history", Mode: csapi.GitHistoryMode_FULL_HISTORY, Depth: 50, CloneDepth: git.FullHistory},
+		{Name: "shallow", Mode: csapi.GitHistoryMode_SHALLOW, Depth: 10, CloneDepth: 10, KeepShallow: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			init, err := initializer.NewFromRequest(context.Background(), "/workspace", nil, &csapi.WorkspaceInitializer{
+				Spec: &csapi.WorkspaceInitializer_Git{Git: &csapi.GitInitializer{
+					RemoteUri:   "https://github.com/gitpod-io/gitpod",
+					TargetMode:  csapi.CloneTargetMode_REMOTE_HEAD,
+					Config:      &csapi.GitConfig{Authentication: csapi.GitAuthMethod_NO_AUTH},
+					HistoryMode: test.Mode,
+					CloneDepth:  test.Depth,
+				}},
+			}, initializer.NewFromRequestOpts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gitinit, ok := init.(*initializer.GitInitializer)
+			if !ok {
+				t.Fatalf("unexpected initializer %T", init)
+			}
+			if gitinit.CloneDepth != test.CloneDepth {
+				t.Errorf("unexpected clone depth: want %d, got %d", test.CloneDepth, gitinit.CloneDepth)
+			}
+			if gitinit.KeepShallow != test.KeepShallow {
+				t.Errorf("unexpected keep shallow: want %v, got %v", test.KeepShallow, gitinit.KeepShallow)
+			}
+		})
+	}
+}
+
+func TestNewGitInitializerLFSMode(t *testing.T) {
+	tests := []struct {
+		Mode        csapi.GitLFSMode
+		Expectation initializer.LFSMode
+	}{
+		{Mode: csapi.GitLFSMode_LFS_EAGER, Expectation: initializer.LFSEager},
+		{Mode: csapi.GitLFSMode_LFS_DEFERRED, Expectation: initializer.LFSDeferred},
+		{Mode: csapi.GitLFSMode_LFS_DISABLED, Expectation: initializer.LFSDisabled},
+	}
+	for _, test := range tests {
+		t.Run(test.Mode.String(), func(t *testing.T) {
+			init, err := initializer.NewFromRequest(context.Background(), "/workspace", nil, &csapi.WorkspaceInitializer{
+				Spec: &csapi.WorkspaceInitializer_Git{Git: &csapi.GitInitializer{
+					RemoteUri:  "https://github.com/gitpod-io/gitpod",
+					TargetMode: csapi.CloneTargetMode_REMOTE_HEAD,
+					Config:     &csapi.GitConfig{Authentication: csapi.GitAuthMethod_NO_AUTH},
+					LfsMode:    test.Mode,
+				}},
+			}, initializer.NewFromRequestOpts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if act := init.(*initializer.GitInitializer).LFS; act != test.Expectation {
+				t.Errorf("unexpected LFS mode: want %s, got %s", test.Expectation, act)
+			}
+		})
+	}
+}
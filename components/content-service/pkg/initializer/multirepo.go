@@ -0,0 +1,186 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+// RepositoryInitializer initializes a single repository of a MultiRepoInitializer
+type RepositoryInitializer struct {
+	// Location is the checkout location of the repository, relative to the workspace
+	Location string
+
+	Initializer Initializer
+}
+
+// MultiRepoInitializer initializes several repositories in parallel
+type MultiRepoInitializer struct {
+	Repositories []RepositoryInitializer
+
+	// MaxParallelism limits how many repositories are initialized at the same time. Zero initializes all at once.
+	MaxParallelism int
+}
+
+// RepositoryError is the reason a single repository could not be initialized
+type RepositoryError struct {
+	Location string
+	Err      error
+}
+
+func (e *RepositoryError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Location, e.Err)
+}
+
+func (e *RepositoryError) Unwrap() error {
+	return e.Err
+}
+
+// MultiRepoError lists all repositories of a MultiRepoInitializer which could not be initialized
+type MultiRepoError struct {
+	Failures []*RepositoryError
+	Total    int
+}
+
+func (e *MultiRepoError) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		msgs[i] = f.Error()
+	}
+	return fmt.Sprintf("cannot initialize %d of %d repositories: %s", len(e.Failures), e.Total, strings.Join(msgs, "; "))
+}
+
+func (e *MultiRepoError) Unwrap() []error {
+	res := make([]error, len(e.Failures))
+	for i, f := range e.Failures {
+		res[i] = f
+	}
+	return res
+}
+
+// Run initializes all repositories. A repository which fails does not stop the others.
+func (m *MultiRepoInitializer) Run(ctx context.Context, mappings []archive.IDMapping) (_ csapi.WorkspaceInitSource, _ csapi.InitializerMetrics, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MultiRepoInitializer.Run")
+	defer tracing.FinishSpan(span, &err)
+	span.LogKV("repositories", len(m.Repositories), "maxParallelism", m.MaxParallelism)
+	start := time.Now()
+	initialSize, fsErr := getFsUsage()
+	if fsErr != nil {
+		log.WithError(fsErr).Error("could not get disk usage")
+	}
+
+	parallelism := m.MaxParallelism
+	if parallelism <= 0 || parallelism > len(m.Repositories) {
+		parallelism = len(m.Repositories)
+	}
+
+	var (
+		stats = make([]csapi.InitializerMetrics, len(m.Repositories))
+		errs  = make([]error, len(m.Repositories))
+		sem   = make(chan struct{}, parallelism)
+		wg    sync.WaitGroup
+	)
+	for i, repo := range m.Repositories {
+		wg.Add(1)
+		go func(i int, repo RepositoryInitializer) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			_, stats[i], errs[i] = repo.Initializer.Run(ctx, mappings)
+			if errs[i] != nil {
+				log.WithError(errs[i]).WithField("location", repo.Location).Warn("cannot initialize repository")
+			}
+		}(i, repo)
+	}
+	wg.Wait()
+
+	var (
+		total    csapi.InitializerMetrics
+		failures []*RepositoryError
+	)
+	for i, repo := range m.Repositories {
+		if errs[i] != nil {
+			failures = append(failures, &RepositoryError{Location: repo.Location, Err: errs[i]})
+			continue
+		}
+		total = append(total, stats[i]...)
+	}
+	if len(failures) > 0 {
+		return csapi.WorkspaceInitFromOther, nil, &MultiRepoError{Failures: failures, Total: len(m.Repositories)}
+	}
+
+	if fsErr == nil {
+		currentSize, fsErr := getFsUsage()
+		if fsErr != nil {
+			log.WithError(fsErr).Error("could not get disk usage")
+		}
+
+		total = append(total, csapi.InitializerMetric{
+			Type:     "multiRepo",
+			Duration: time.Since(start),
+			Size:     currentSize - initialSize,
+		})
+	}
+
+	return csapi.WorkspaceInitFromOther, total, nil
+}
+
+// newMultiRepoInitializer creates a multi repository initializer for a request.
+// Returns gRPC errors.
+func newMultiRepoInitializer(ctx context.Context, loc string, req *csapi.MultiRepoInitializer, forceGitpodUser bool) (*MultiRepoInitializer, error) {
+	if len(req.Repositories) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "multi repo initializer misses repositories")
+	}
+
+	// repositories are cloned in parallel, hence they must not share their checkout location
+	locations := make([]string, len(req.Repositories))
+	for i, repo := range req.Repositories {
+		locations[i] = filepath.Clean("/" + repo.CheckoutLocation)
+		for _, other := range locations[:i] {
+			if isNestedLocation(locations[i], other) || isNestedLocation(other, locations[i]) {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("checkout locations %s and %s overlap", repo.CheckoutLocation, other))
+			}
+		}
+	}
+
+	res := &MultiRepoInitializer{
+		Repositories:   make([]RepositoryInitializer, len(req.Repositories)),
+		MaxParallelism: int(req.MaxParallelism),
+	}
+	for i, repo := range req.Repositories {
+		gitinit, err := newGitInitializer(ctx, loc, repo, forceGitpodUser)
+		if err != nil {
+			return nil, err
+		}
+		res.Repositories[i] = RepositoryInitializer{
+			Location:    repo.CheckoutLocation,
+			Initializer: gitinit,
+		}
+	}
+	return res, nil
+}
+
+// isNestedLocation returns true if loc is parent or equal to the cleaned, absolute path other
+func isNestedLocation(loc, other string) bool {
+	if loc == other || loc == "/" {
+		return true
+	}
+	return strings.HasPrefix(other, loc+"/")
+}
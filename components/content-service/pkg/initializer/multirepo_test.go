@@ -0,0 +1,175 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package initializer_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/initializer"
+)
+
+func TestMultiRepoInitializer(t *testing.T) {
+	var (
+		errFrontend = fmt.Errorf("access denied")
+		errDocs     = fmt.Errorf("repository not found")
+	)
+	failing := func(err error) initializer.Initializer {
+		return InitializerFunc(func(ctx context.Context, mappings []archive.IDMapping) (csapi.WorkspaceInitSource, csapi.InitializerMetrics, error) {
+			return csapi.WorkspaceInitFromOther, nil, err
+		})
+	}
+
+	tests := []struct {
+		Name     string
+		Repos    []initializer.RepositoryInitializer
+		Failures []string
+	}{
+		{
+			Name: "success",
+			Repos: []initializer.RepositoryInitializer{
+				{Location: "frontend", Initializer: &RecordingInitializer{}},
+				{Location: "backend", Initializer: &RecordingInitializer{}},
+			},
+		},
+		{
+			Name: "independent failures",
+			Repos: []initializer.RepositoryInitializer{
+				{Location: "frontend", Initializer: failing(errFrontend)},
+				{Location: "backend", Initializer: &RecordingInitializer{}},
+				{Location: "docs", Initializer: failing(errDocs)},
+				{Location: "infra", Initializer: &RecordingInitializer{}},
+			},
+			Failures: []string{"frontend", "docs"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			init := &initializer.MultiRepoInitializer{Repositories: test.Repos}
+			_, _, err := init.Run(context.Background(), nil)
+
+			for _, repo := range test.Repos {
+				if rec, ok := repo.Initializer.(*RecordingInitializer); ok && rec.CallCount != 1 {
+					t.Errorf("unexpected call count for %s: expected 1, got %d", repo.Location, rec.CallCount)
+				}
+			}
+
+			if len(test.Failures) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var mrerr *initializer.MultiRepoError
+			if !errors.As(err, &mrerr) {
+				t.Fatalf("expected a MultiRepoError, got %v", err)
+			}
+			var failures []string
+			for _, f := range mrerr.Failures {
+				failures = append(failures, f.Location)
+			}
+			if diff := cmp.Diff(test.Failures, failures); diff != "" {
+				t.Errorf("unexpected failures (-want +got):\n%s", diff)
+			}
+			if !errors.Is(err, errFrontend) || !errors.Is(err, errDocs) {
+				t.Errorf("error does not wrap the failures of the repositories: %v", err)
+			}
+		})
+	}
+}
+
+func TestMultiRepoInitializerParallelism(t *testing.T) {
+	var running, maxRunning int32
+	clone := InitializerFunc(func(ctx context.Context, mappings []archive.IDMapping) (csapi.WorkspaceInitSource, csapi.InitializerMetrics, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return csapi.WorkspaceInitFromOther, nil, nil
+	})
+
+	init := &initializer.MultiRepoInitializer{MaxParallelism: 2}
+	for i := 0; i < 6; i++ {
+		init.Repositories = append(init.Repositories, initializer.RepositoryInitializer{Location: fmt.Sprintf("repo-%d", i), Initializer: clone})
+	}
+	_, _, err := init.Run(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if maxRunning != 2 {
+		t.Errorf("unexpected number of parallel clones: expected 2, got %d", maxRunning)
+	}
+}
+
+func TestNewMultiRepoInitializerFromRequest(t *testing.T) {
+	repo := func(location string) *csapi.GitInitializer {
+		return &csapi.GitInitializer{
+			RemoteUri:        "https://github.com/gitpod-io/" + location,
+			CheckoutLocation: location,
+			TargetMode:       csapi.CloneTargetMode_REMOTE_HEAD,
+			Config:           &csapi.GitConfig{Authentication: csapi.GitAuthMethod_NO_AUTH},
+		}
+	}
+
+	tests := []struct {
+		Name      string
+		Locations []string
+		Valid     bool
+	}{
+		{Name: "distinct", Locations: []string{"frontend", "backend", "backend-tools"}, Valid: true},
+		{Name: "none"},
+		{Name: "equal", Locations: []string{"frontend", "./frontend/"}},
+		{Name: "nested", Locations: []string{"frontend", "frontend/vendor/lib"}},
+		{Name: "workspace root", Locations: []string{"", "backend"}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			req := &csapi.MultiRepoInitializer{}
+			for _, loc := range test.Locations {
+				req.Repositories = append(req.Repositories, repo(loc))
+			}
+
+			init, err := initializer.NewFromRequest(context.Background(), "/workspace", nil, &csapi.WorkspaceInitializer{
+				Spec: &csapi.WorkspaceInitializer_MultiRepo{MultiRepo: req},
+			}, initializer.NewFromRequestOpts{})
+			if !test.Valid {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("expected InvalidArgument, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mr, ok := init.(*initializer.MultiRepoInitializer)
+			if !ok {
+				t.Fatalf("unexpected initializer %T", init)
+			}
+			var locations []string
+			for _, r := range mr.Repositories {
+				locations = append(locations, r.Initializer.(*initializer.GitInitializer).Location)
+			}
+			if diff := cmp.Diff([]string{"/workspace/frontend", "/workspace/backend", "/workspace/backend-tools"}, locations); diff != "" {
+				t.Errorf("unexpected checkout locations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/forensics"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+// ForensicsService implements ForensicsServiceServer
+type ForensicsService struct {
+	cfg       config.StorageConfig
+	retention time.Duration
+	s         storage.PresignedAccess
+	daFactory func(cfg *config.StorageConfig) (storage.DirectAccess, error)
+	now       func() time.Time
+
+	api.UnimplementedForensicsServiceServer
+}
+
+// NewForensicsService creates a new forensics service
+func NewForensicsService(cfg config.StorageConfig, forensicsCfg config.ForensicsConfig) (res *ForensicsService, err error) {
+	s, err := storage.NewPresignedAccess(&cfg)
+	if err != nil {
+		return nil, err
+	}
+	daFactory := func(cfg *config.StorageConfig) (storage.DirectAccess, error) {
+		return storage.NewDirectAccess(cfg)
+	}
+	return &ForensicsService{
+		cfg:       cfg,
+		retention: forensicsCfg.GetRetention(),
+		s:         s,
+		daFactory: daFactory,
+		now:       time.Now,
+	}, nil
+}
+
+// ListForensicBundles returns the forensic bundles of the specified workspace instance which are not expired yet.
+// Expired bundles are deleted on the way.
+func (fs *ForensicsService) ListForensicBundles(ctx context.Context, req *api.ListForensicBundlesRequest) (resp *api.ListForensicBundlesResponse, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListForensicBundles")
+	span.SetTag("user", req.OwnerId)
+	span.SetTag("workspaceId", req.WorkspaceId)
+	span.SetTag("instanceId", req.InstanceId)
+	defer tracing.FinishSpan(span, &err)
+
+	da, err := fs.daFactory(&fs.cfg)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot use configured storage: %w", err)
+	}
+
+	err = da.Init(ctx, req.OwnerId, req.WorkspaceId, req.InstanceId)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot use configured storage: %w", err)
+	}
+
+	prefix := fs.s.InstanceObject(req.OwnerId, req.WorkspaceId, req.InstanceId, forensics.UploadedBundlePathPrefix)
+	objects, err := da.ListObjects(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &api.ListForensicBundlesResponse{}
+	for _, obj := range objects {
+		name := path.Base(obj)
+		created, reason, err := forensics.ParseBundleName(name)
+		if err != nil {
+			log.WithError(err).WithFields(log.OWI(req.OwnerId, req.WorkspaceId, req.InstanceId)).Warn("ignoring unexpected object among forensic bundles")
+			continue
+		}
+		if fs.expired(created) {
+			err = fs.s.DeleteObject(ctx, fs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Name: obj})
+			if err != nil && err != storage.ErrNotFound {
+				log.WithError(err).WithFields(log.OWI(req.OwnerId, req.WorkspaceId, req.InstanceId)).WithField("bundle", name).Warn("cannot delete expired forensic bundle")
+			}
+			continue
+		}
+		resp.Bundles = append(resp.Bundles, &api.ForensicBundle{
+			Name:    name,
+			Reason:  reason,
+			Created: created.Unix(),
+		})
+	}
+	sort.Slice(resp.Bundles, func(i, j int) bool { return resp.Bundles[i].Created < resp.Bundles[j].Created })
+
+	return resp, nil
+}
+
+// ForensicBundleDownloadURL provides a URL from where a forensic bundle can be downloaded from
+func (fs *ForensicsService) ForensicBundleDownloadURL(ctx context.Context, req *api.ForensicBundleDownloadURLRequest) (resp *api.ForensicBundleDownloadURLResponse, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ForensicBundleDownloadURL")
+	span.SetTag("user", req.OwnerId)
+	span.SetTag("workspaceId", req.WorkspaceId)
+	span.SetTag("instanceId", req.InstanceId)
+	defer tracing.FinishSpan(span, &err)
+
+	if req.Name != path.Base(req.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bundle name %s", req.Name)
+	}
+	created, _, err := forensics.ParseBundleName(req.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if fs.expired(created) {
+		return nil, status.Errorf(codes.NotFound, "forensic bundle %s has expired", req.Name)
+	}
+
+	blobName := fs.s.InstanceObject(req.OwnerId, req.WorkspaceId, req.InstanceId, forensics.UploadedBundlePathPrefix+"/"+req.Name)
+	info, err := fs.s.SignDownload(ctx, fs.s.Bucket(req.OwnerId), blobName, &storage.SignedURLOptions{})
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		log.WithFields(log.OWI(req.OwnerId, req.WorkspaceId, req.InstanceId)).
+			WithField("bucket", fs.s.Bucket(req.OwnerId)).
+			WithField("blobName", blobName).
+			WithError(err).
+			Error("error getting SignDownload URL")
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	return &api.ForensicBundleDownloadURLResponse{
+		Url: info.URL,
+	}, nil
+}
+
+func (fs *ForensicsService) expired(created time.Time) bool {
+	return fs.now().Sub(created) > fs.retention
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	storagemock "github.com/gitpod-io/gitpod/content-service/pkg/storage/mock"
+)
+
+func TestListForensicBundles(t *testing.T) {
+	const (
+		OwnerId     = "1234"
+		WorkspaceId = "amber-baboon-cij4wozf"
+		InstanceId  = "958aff1c-849a-460f-8af4-5c5b1401a599"
+	)
+	now := time.Unix(1700000000, 0)
+	bundle := func(name string) string {
+		return fmt.Sprintf("workspace/%s/instance/%s/forensics/%s", WorkspaceId, InstanceId, name)
+	}
+
+	tests := []struct {
+		Name            string
+		Files           []string
+		ExpectedBundles []*api.ForensicBundle
+		ExpectedDeletes []string
+	}{
+		{
+			Name: "no bundles",
+		},
+		{
+			Name: "recent bundles",
+			Files: []string{
+				bundle("1699999000-OOMKilled.tar.gz"),
+				bundle("1699990000-BackupFailure.tar.gz"),
+			},
+			ExpectedBundles: []*api.ForensicBundle{
+				{Name: "1699990000-BackupFailure.tar.gz", Reason: "BackupFailure", Created: 1699990000},
+				{Name: "1699999000-OOMKilled.tar.gz", Reason: "OOMKilled", Created: 1699999000},
+			},
+		},
+		{
+			Name: "expired bundles are deleted",
+			Files: []string{
+				bundle("1699999000-OOMKilled.tar.gz"),
+				bundle("1690000000-AgentSmith.tar.gz"),
+			},
+			ExpectedBundles: []*api.ForensicBundle{
+				{Name: "1699999000-OOMKilled.tar.gz", Reason: "OOMKilled", Created: 1699999000},
+			},
+			ExpectedDeletes: []string{bundle("1690000000-AgentSmith.tar.gz")},
+		},
+		{
+			Name: "unexpected objects are ignored",
+			Files: []string{
+				bundle("foobar"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := storagemock.NewMockPresignedAccess(ctrl)
+			da := storagemock.NewMockDirectAccess(ctrl)
+			svc := ForensicsService{
+				cfg:       config.StorageConfig{Kind: config.GCloudStorage},
+				retention: 24 * time.Hour,
+				s:         s,
+				daFactory: func(cfg *config.StorageConfig) (storage.DirectAccess, error) { return da, nil },
+				now:       func() time.Time { return now },
+			}
+
+			s.EXPECT().InstanceObject(OwnerId, WorkspaceId, InstanceId, "forensics").Return("")
+			s.EXPECT().Bucket(OwnerId).Return("gitpod-user-1234").AnyTimes()
+			da.EXPECT().Init(gomock.Any(), OwnerId, WorkspaceId, InstanceId)
+			da.EXPECT().ListObjects(gomock.Any(), gomock.Any()).Return(test.Files, nil)
+			for _, obj := range test.ExpectedDeletes {
+				s.EXPECT().DeleteObject(gomock.Any(), "gitpod-user-1234", &storage.DeleteObjectQuery{Name: obj})
+			}
+
+			resp, err := svc.ListForensicBundles(context.Background(), &api.ListForensicBundlesRequest{
+				OwnerId:     OwnerId,
+				WorkspaceId: WorkspaceId,
+				InstanceId:  InstanceId,
+			})
+			if err != nil {
+				t.Fatalf("ListForensicBundles err: %v", err)
+			}
+			if diff := cmp.Diff(test.ExpectedBundles, resp.Bundles, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected bundles (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestForensicBundleDownloadURL(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		Name         string
+		BundleName   string
+		ExpectedCode codes.Code
+	}{
+		{Name: "valid bundle", BundleName: "1699999000-OOMKilled.tar.gz", ExpectedCode: codes.OK},
+		{Name: "expired bundle", BundleName: "1690000000-OOMKilled.tar.gz", ExpectedCode: codes.NotFound},
+		{Name: "not a bundle", BundleName: "../backup.tar", ExpectedCode: codes.InvalidArgument},
+		{Name: "path in name", BundleName: "../logs/1699999000-OOMKilled.tar.gz", ExpectedCode: codes.InvalidArgument},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := storagemock.NewMockPresignedAccess(ctrl)
+			svc := ForensicsService{
+				retention: 24 * time.Hour,
+				s:         s,
+				now:       func() time.Time { return now },
+			}
+			if test.ExpectedCode == codes.OK {
+				s.EXPECT().InstanceObject("owner", "workspace", "instance", "forensics/"+test.BundleName).Return("blob")
+				s.EXPECT().Bucket("owner").Return("bucket")
+				s.EXPECT().SignDownload(gomock.Any(), "bucket", "blob", gomock.Any()).Return(&storage.DownloadInfo{URL: "https://example.com/blob"}, nil)
+			}
+
+			_, err := svc.ForensicBundleDownloadURL(context.Background(), &api.ForensicBundleDownloadURLRequest{
+				OwnerId:     "owner",
+				WorkspaceId: "workspace",
+				InstanceId:  "instance",
+				Name:        test.BundleName,
+			})
+			if code := status.Code(err); code != test.ExpectedCode {
+				t.Errorf("unexpected code: got %v, want %v (%v)", code, test.ExpectedCode, err)
+			}
+		})
+	}
+}
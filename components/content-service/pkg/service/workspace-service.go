@@ -7,9 +7,11 @@ package service
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
 
 	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -17,13 +19,15 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	"github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/catalog"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 )
 
 // WorkspaceService implements WorkspaceServiceServer
 type WorkspaceService struct {
-	cfg config.StorageConfig
-	s   storage.PresignedAccess
+	cfg       config.StorageConfig
+	s         storage.PresignedAccess
+	daFactory func(cfg *config.StorageConfig) (storage.DirectAccess, error)
 
 	api.UnimplementedWorkspaceServiceServer
 }
@@ -34,7 +38,10 @@ func NewWorkspaceService(cfg config.StorageConfig) (res *WorkspaceService, err e
 	if err != nil {
 		return nil, err
 	}
-	return &WorkspaceService{cfg: cfg, s: s}, nil
+	daFactory := func(cfg *config.StorageConfig) (storage.DirectAccess, error) {
+		return storage.NewDirectAccess(cfg)
+	}
+	return &WorkspaceService{cfg: cfg, s: s, daFactory: daFactory}, nil
 }
 
 // WorkspaceDownloadURL provides a URL from where the content of a workspace can be downloaded from
@@ -100,6 +107,13 @@ func (cs *WorkspaceService) DeleteWorkspace(ctx context.Context, req *api.Delete
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
+	catalogPrefix := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, catalog.KindPrefix(catalog.KindBackup))
+	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Prefix: catalogPrefix})
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		// the backup is gone nonetheless, the catalog just lists it for a little longer
+		log.WithError(err).Warn("error deleting workspace backup catalog entries: ", catalogPrefix)
+	}
+
 	trailPrefix := cs.s.BackupObject(req.OwnerId, req.WorkspaceId, "trail-")
 	err = cs.s.DeleteObject(ctx, cs.s.Bucket(req.OwnerId), &storage.DeleteObjectQuery{Prefix: trailPrefix})
 	if err != nil {
@@ -129,3 +143,93 @@ func (cs *WorkspaceService) WorkspaceSnapshotExists(ctx context.Context, req *ap
 		Exists: exists,
 	}, nil
 }
+
+// ListWorkspaceContent lists the backups and snapshots of workspaces from their content catalog.
+// ws-daemon prunes the entries of superseded backups when it records a backup. Entries it failed to prune are
+// left out of the listing.
+func (cs *WorkspaceService) ListWorkspaceContent(ctx context.Context, req *api.ListWorkspaceContentRequest) (resp *api.ListWorkspaceContentResponse, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ListWorkspaceContent")
+	span.SetTag("user", req.OwnerId)
+	span.SetTag("workspaces", len(req.WorkspaceIds))
+	defer tracing.FinishSpan(span, &err)
+
+	resp = &api.ListWorkspaceContentResponse{}
+	for _, workspaceID := range req.WorkspaceIds {
+		content, err := cs.listWorkspaceContent(ctx, req.OwnerId, workspaceID)
+		if err != nil {
+			log.WithError(err).WithFields(log.OWI(req.OwnerId, workspaceID, "")).Error("cannot list workspace content catalog")
+			return nil, status.Error(codes.Unknown, err.Error())
+		}
+		resp.Content = append(resp.Content, content...)
+	}
+	sort.SliceStable(resp.Content, func(i, j int) bool { return resp.Content[i].Created < resp.Content[j].Created })
+
+	return resp, nil
+}
+
+func (cs *WorkspaceService) listWorkspaceContent(ctx context.Context, ownerID, workspaceID string) ([]*api.WorkspaceContent, error) {
+	da, err := cs.daFactory(&cs.cfg)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot use configured storage: %w", err)
+	}
+	err = da.Init(ctx, ownerID, workspaceID, "")
+	if err != nil {
+		return nil, xerrors.Errorf("cannot use configured storage: %w", err)
+	}
+
+	prefix := cs.s.BackupObject(ownerID, workspaceID, catalog.PathPrefix)
+	if !strings.HasSuffix(prefix, "/") {
+		prefix = prefix + "/"
+	}
+	objects, err := da.ListObjects(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	type catalogObject struct {
+		Name  string
+		Entry catalog.Entry
+	}
+	latest := make(map[string]catalogObject, len(objects))
+	for _, obj := range objects {
+		entry, err := catalog.ParseEntry(obj)
+		if err != nil {
+			log.WithError(err).WithFields(log.OWI(ownerID, workspaceID, "")).Warn("ignoring unexpected object in workspace content catalog")
+			continue
+		}
+
+		key := string(entry.Kind) + "/" + entry.Name
+		if prev, exists := latest[key]; exists && prev.Entry.Created.After(entry.Created) {
+			continue
+		}
+		latest[key] = catalogObject{Name: obj, Entry: entry}
+	}
+
+	res := make([]*api.WorkspaceContent, 0, len(latest))
+	for _, obj := range latest {
+		content := &api.WorkspaceContent{
+			WorkspaceId:   workspaceID,
+			Name:          obj.Entry.Name,
+			Size:          obj.Entry.Size,
+			Created:       obj.Entry.Created.Unix(),
+			FormatVersion: int32(obj.Entry.FormatVersion),
+		}
+		switch obj.Entry.Kind {
+		case catalog.KindBackup:
+			content.Kind = api.WorkspaceContentKind_WORKSPACE_CONTENT_KIND_BACKUP
+			content.Retention = api.WorkspaceContentRetention_WORKSPACE_CONTENT_RETENTION_BACKUP
+		case catalog.KindSnapshot:
+			content.Kind = api.WorkspaceContentKind_WORKSPACE_CONTENT_KIND_SNAPSHOT
+			content.Retention = api.WorkspaceContentRetention_WORKSPACE_CONTENT_RETENTION_SNAPSHOTS
+		}
+		res = append(res, content)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Created != res[j].Created {
+			return res[i].Created < res[j].Created
+		}
+		return res[i].Name < res[j].Name
+	})
+
+	return res, nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	storagemock "github.com/gitpod-io/gitpod/content-service/pkg/storage/mock"
+)
+
+func TestListWorkspaceContent(t *testing.T) {
+	const (
+		OwnerId     = "1234"
+		WorkspaceId = "amber-baboon-cij4wozf"
+	)
+	entry := func(name string) string {
+		return fmt.Sprintf("workspaces/%s/catalog/%s", WorkspaceId, name)
+	}
+
+	tests := []struct {
+		Name            string
+		Files           []string
+		ExpectedContent []*api.WorkspaceContent
+	}{
+		{
+			Name: "empty catalog",
+		},
+		{
+			Name: "backup and snapshots",
+			Files: []string{
+				entry("snapshot-1700000100-2048-v1-snapshot-1700000100000000000.tar"),
+				entry("backup-1700000200-4096-v1-full.tar"),
+				entry("snapshot-1700000000-1024-v1-snapshot-1700000000000000000.tar"),
+			},
+			ExpectedContent: []*api.WorkspaceContent{
+				{
+					WorkspaceId:   WorkspaceId,
+					Name:          "snapshot-1700000000000000000.tar",
+					Kind:          api.WorkspaceContentKind_WORKSPACE_CONTENT_KIND_SNAPSHOT,
+					Size:          1024,
+					Created:       1700000000,
+					Retention:     api.WorkspaceContentRetention_WORKSPACE_CONTENT_RETENTION_SNAPSHOTS,
+					FormatVersion: 1,
+				},
+				{
+					WorkspaceId:   WorkspaceId,
+					Name:          "snapshot-1700000100000000000.tar",
+					Kind:          api.WorkspaceContentKind_WORKSPACE_CONTENT_KIND_SNAPSHOT,
+					Size:          2048,
+					Created:       1700000100,
+					Retention:     api.WorkspaceContentRetention_WORKSPACE_CONTENT_RETENTION_SNAPSHOTS,
+					FormatVersion: 1,
+				},
+				{
+					WorkspaceId:   WorkspaceId,
+					Name:          "full.tar",
+					Kind:          api.WorkspaceContentKind_WORKSPACE_CONTENT_KIND_BACKUP,
+					Size:          4096,
+					Created:       1700000200,
+					Retention:     api.WorkspaceContentRetention_WORKSPACE_CONTENT_RETENTION_BACKUP,
+					FormatVersion: 1,
+				},
+			},
+		},
+		{
+			Name: "superseded backups are not listed",
+			Files: []string{
+				entry("backup-1700000300-8192-v1-full.tar"),
+				entry("backup-1700000200-4096-v1-full.tar"),
+				entry("backup-1700000100-2048-v1-full.tar"),
+			},
+			ExpectedContent: []*api.WorkspaceContent{
+				{
+					WorkspaceId:   WorkspaceId,
+					Name:          "full.tar",
+					Kind:          api.WorkspaceContentKind_WORKSPACE_CONTENT_KIND_BACKUP,
+					Size:          8192,
+					Created:       1700000300,
+					Retention:     api.WorkspaceContentRetention_WORKSPACE_CONTENT_RETENTION_BACKUP,
+					FormatVersion: 1,
+				},
+			},
+		},
+		{
+			Name: "unexpected objects are ignored",
+			Files: []string{
+				entry("foobar"),
+				entry("archive-1700000000-1024-v1-full.tar"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			s := storagemock.NewMockPresignedAccess(ctrl)
+			da := storagemock.NewMockDirectAccess(ctrl)
+			svc := WorkspaceService{
+				cfg:       config.StorageConfig{Kind: config.GCloudStorage},
+				s:         s,
+				daFactory: func(cfg *config.StorageConfig) (storage.DirectAccess, error) { return da, nil },
+			}
+
+			s.EXPECT().BackupObject(OwnerId, WorkspaceId, "catalog").Return(fmt.Sprintf("workspaces/%s/catalog", WorkspaceId))
+			s.EXPECT().Bucket(OwnerId).Return("gitpod-user-1234").AnyTimes()
+			da.EXPECT().Init(gomock.Any(), OwnerId, WorkspaceId, "")
+			da.EXPECT().ListObjects(gomock.Any(), fmt.Sprintf("workspaces/%s/catalog/", WorkspaceId)).Return(test.Files, nil)
+			// listing must not modify the catalog
+			s.EXPECT().DeleteObject(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			resp, err := svc.ListWorkspaceContent(context.Background(), &api.ListWorkspaceContentRequest{
+				OwnerId:      OwnerId,
+				WorkspaceIds: []string{WorkspaceId},
+			})
+			if err != nil {
+				t.Fatalf("ListWorkspaceContent err: %v", err)
+			}
+			if diff := cmp.Diff(test.ExpectedContent, resp.Content, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected content (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
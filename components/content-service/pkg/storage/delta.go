@@ -0,0 +1,40 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// DefaultBackupDelta is the name of the manifest listing the delta layers of the default backup
+	DefaultBackupDelta = "delta.json"
+
+	deltaLayerPrefix = "delta-"
+	deltaLayerSuffix = ".tar"
+)
+
+// DeltaManifest lists the delta layers which are applied on top of the default backup during restore.
+// Delta layers contain the files which changed since the previous backup, and whiteouts for the files
+// which were deleted.
+type DeltaManifest struct {
+	// Base is the digest of the full backup the layers apply to. Layers must not be applied
+	// to a full backup of a different digest.
+	Base string `json:"base"`
+
+	// Layers are the names of the delta layers in the order they must be applied
+	Layers []string `json:"layers"`
+}
+
+// DeltaLayer returns the name of the n-th delta layer applied on top of the default backup, starting at one
+func DeltaLayer(n int) string {
+	return fmt.Sprintf("%s%d%s", deltaLayerPrefix, n, deltaLayerSuffix)
+}
+
+// IsDeltaLayer returns true if name is the name of a delta layer
+func IsDeltaLayer(name string) bool {
+	return strings.HasPrefix(name, deltaLayerPrefix) && strings.HasSuffix(name, deltaLayerSuffix)
+}
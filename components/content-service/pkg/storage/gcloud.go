@@ -358,6 +358,12 @@ func (rs *DirectGCPStorage) Upload(ctx context.Context, source string, name stri
 	defer tracing.FinishSpan(span, &err)
 	log := log.WithFields(log.OWI(rs.Username, rs.WorkspaceName, ""))
 
+	options, err := GetUploadOptions(opts)
+	if err != nil {
+		err = xerrors.Errorf("cannot get options: %w", err)
+		return
+	}
+
 	if rs.client == nil {
 		err = xerrors.Errorf("no gcloud client available - did you call Init()?")
 		return
@@ -391,6 +397,13 @@ func (rs *DirectGCPStorage) Upload(ctx context.Context, source string, name stri
 		return
 	}
 
+	if options.BandwidthLimit > 0 {
+		// gsutil cannot be throttled, hence we upload through the client
+		err = rs.uploadThrottled(ctx, sfn, bucket, object, options)
+		uploadSpan.Finish()
+		return
+	}
+
 	var wg sync.WaitGroup
 
 	wg.Add(1)
@@ -403,11 +416,27 @@ func (rs *DirectGCPStorage) Upload(ctx context.Context, source string, name stri
 			sa = fmt.Sprintf(`-o "Credentials:gs_service_key_file=%v"`, rs.GCPConfig.CredentialsFile)
 		}
 
+		// objects above the threshold are uploaded in parts which are composed into the final object
+		var (
+			threshold     = "150M"
+			componentSize = ""
+			processCount  = 3
+			threadCount   = 6
+		)
+		if options.PartSize > 0 {
+			threshold = fmt.Sprintf("%d", options.PartSize)
+			componentSize = fmt.Sprintf(`-o "GSUtil:parallel_composite_upload_component_size=%d"`, options.PartSize)
+		}
+		if options.Parallelism > 0 {
+			processCount = 1
+			threadCount = options.Parallelism
+		}
+
 		args := fmt.Sprintf(`gsutil -q -m %v\
-		  -o "GSUtil:parallel_composite_upload_threshold=150M" \
-		  -o "GSUtil:parallel_process_count=3" \
-		  -o "GSUtil:parallel_thread_count=6" \
-		  cp %s gs://%s`, sa, source, filepath.Join(bucket, object))
+		  -o "GSUtil:parallel_composite_upload_threshold=%s" %s \
+		  -o "GSUtil:parallel_process_count=%d" \
+		  -o "GSUtil:parallel_thread_count=%d" \
+		  cp %s gs://%s`, sa, threshold, componentSize, processCount, threadCount, source, filepath.Join(bucket, object))
 
 		log.WithField("flags", args).Debug("gsutil flags")
 
@@ -429,6 +458,26 @@ func (rs *DirectGCPStorage) Upload(ctx context.Context, source string, name stri
 	return
 }
 
+func (rs *DirectGCPStorage) uploadThrottled(ctx context.Context, src io.Reader, bucket, object string, options *UploadOptions) error {
+	wr := rs.client.Bucket(bucket).Object(object).NewWriter(ctx)
+	wr.ContentType = options.ContentType
+	wr.Metadata = options.Annotations
+	if options.PartSize > 0 {
+		wr.ChunkSize = int(options.PartSize)
+	}
+
+	_, err := io.Copy(wr, newThrottledReader(ctx, src, options.BandwidthLimit))
+	if err != nil {
+		_ = wr.Close()
+		return xerrors.Errorf("cannot upload file: %w", err)
+	}
+	err = wr.Close()
+	if err != nil {
+		return xerrors.Errorf("cannot upload file: %w", err)
+	}
+	return nil
+}
+
 func (rs *DirectGCPStorage) bucketName() string {
 	return gcpBucketName(rs.Stage, rs.Username)
 }
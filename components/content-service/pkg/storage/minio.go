@@ -300,11 +300,22 @@ func (rs *DirectMinIOStorage) Upload(ctx context.Context, source string, name st
 	span.LogKV("endpoint", rs.MinIOConfig.Endpoint)
 	span.LogKV("region", rs.MinIOConfig.Region)
 	span.LogKV("key", rs.MinIOConfig.AccessKeyID)
-	_, err = rs.client.FPutObject(ctx, bucket, obj, source, minio.PutObjectOptions{
+	putOpts := minio.PutObjectOptions{
 		NumThreads:   rs.MinIOConfig.ParallelUpload,
 		UserMetadata: options.Annotations,
 		ContentType:  options.ContentType,
-	})
+	}
+	if options.Parallelism > 0 {
+		putOpts.NumThreads = uint(options.Parallelism)
+	}
+	if options.PartSize > 0 {
+		putOpts.PartSize = uint64(options.PartSize)
+	}
+	if options.BandwidthLimit > 0 {
+		err = rs.putThrottled(ctx, bucket, obj, source, options.BandwidthLimit, putOpts)
+		return
+	}
+	_, err = rs.client.FPutObject(ctx, bucket, obj, source, putOpts)
 	if err != nil {
 		return
 	}
@@ -312,6 +323,21 @@ func (rs *DirectMinIOStorage) Upload(ctx context.Context, source string, name st
 	return
 }
 
+func (rs *DirectMinIOStorage) putThrottled(ctx context.Context, bucket, obj, source string, bytesPerSecond int64, opts minio.PutObjectOptions) error {
+	f, err := os.Open(source)
+	if err != nil {
+		return xerrors.Errorf("cannot open file for uploading: %w", err)
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	_, err = rs.client.PutObject(ctx, bucket, obj, newThrottledReader(ctx, f, bytesPerSecond), stat.Size(), opts)
+	return err
+}
+
 func minioBucketName(ownerID, bucketName string) string {
 	if bucketName != "" {
 		return bucketName
@@ -42,7 +42,11 @@ func (d *NamedURLDownloader) Download(ctx context.Context, destination string, n
 	}
 	defer resp.Body.Close()
 
-	err = extractTarbal(ctx, destination, resp.Body, mappings)
+	var opts []archive.TarOption
+	if IsDeltaLayer(name) {
+		opts = append(opts, archive.WithWhiteouts())
+	}
+	err = extractTarbal(ctx, destination, resp.Body, mappings, opts...)
 	if err != nil {
 		return true, err
 	}
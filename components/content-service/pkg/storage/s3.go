@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -420,16 +421,27 @@ func (s3st *s3Storage) Upload(ctx context.Context, source string, name string, o
 
 	uploader := s3manager.NewUploader(s3c, func(u *s3manager.Uploader) {
 		u.Concurrency = defaultCopyConcurrency
+		if options.Parallelism > 0 {
+			u.Concurrency = options.Parallelism
+		}
 		u.PartSize = defaultPartSize * megabytes
+		if options.PartSize > 0 {
+			u.PartSize = options.PartSize
+		}
 		u.BufferProvider = s3manager.NewBufferedReadSeekerWriteToPool(25 * megabytes)
 	})
+	// f implements io.ReadSeeker and hence is uploaded in parallel.
+	// cf. https://aws.github.io/aws-sdk-go-v2/docs/sdk-utilities/s3/#putobjectinput-body-field-ioreadseeker-vs-ioreader
+	var body io.Reader = f
+	if options.BandwidthLimit > 0 {
+		// throttled uploads are read sequentially, but their parts are still uploaded concurrently
+		body = newThrottledReader(ctx, f, options.BandwidthLimit)
+	}
+
 	_, err = uploader.Upload(ctx, &s3.PutObjectInput{
 		Bucket: aws.String(bucket),
 		Key:    aws.String(obj),
-
-		// f implements io.ReadSeeker and hence is uploaded in parallel.
-		// cf. https://aws.github.io/aws-sdk-go-v2/docs/sdk-utilities/s3/#putobjectinput-body-field-ioreadseeker-vs-ioreader
-		Body: f,
+		Body:   body,
 
 		Metadata:    options.Annotations,
 		ContentType: contentType,
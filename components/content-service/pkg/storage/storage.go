@@ -28,6 +28,9 @@ const (
 
 	// DefaultBackupManifest is the name of the manifest of the regular default backup we upload
 	DefaultBackupManifest = "wsfull.json"
+
+	// MinUploadPartSize is the smallest part size of parallel uploads supported by all storages
+	MinUploadPartSize = 5 * 1024 * 1024
 )
 
 var (
@@ -169,6 +172,16 @@ type UploadOptions struct {
 	Annotations map[string]string
 
 	ContentType string
+
+	// PartSize is the size in bytes of the parts large objects are split into to upload them concurrently.
+	// Zero selects the default of the storage.
+	PartSize int64
+
+	// Parallelism is the number of parts uploaded concurrently. Zero selects the default of the storage.
+	Parallelism int
+
+	// BandwidthLimit is the maximum upload rate in bytes per second. Zero means no limit.
+	BandwidthLimit int64
 }
 
 // UploadOption configures a particular aspect of remote storage upload
@@ -190,6 +203,33 @@ func WithContentType(ct string) UploadOption {
 	}
 }
 
+// WithParallelUpload splits objects larger than partSize into parts of that size and uploads up to parallelism
+// of them concurrently. Zero values select the defaults of the storage.
+func WithParallelUpload(partSize int64, parallelism int) UploadOption {
+	return func(opts *UploadOptions) error {
+		if partSize != 0 && partSize < MinUploadPartSize {
+			return xerrors.Errorf("part size must be at least %d bytes", MinUploadPartSize)
+		}
+		if parallelism < 0 {
+			return xerrors.Errorf("parallelism must not be negative")
+		}
+		opts.PartSize = partSize
+		opts.Parallelism = parallelism
+		return nil
+	}
+}
+
+// WithBandwidthLimit limits the upload rate to bytesPerSecond. Zero means no limit.
+func WithBandwidthLimit(bytesPerSecond int64) UploadOption {
+	return func(opts *UploadOptions) error {
+		if bytesPerSecond < 0 {
+			return xerrors.Errorf("bandwidth limit must not be negative")
+		}
+		opts.BandwidthLimit = bytesPerSecond
+		return nil
+	}
+}
+
 // GetUploadOptions turns functional opts into a struct
 func GetUploadOptions(opts []UploadOption) (*UploadOptions, error) {
 	res := &UploadOptions{}
@@ -284,8 +324,9 @@ func loadAwsConfig(s3config *config.S3Config) (*aws.Config, error) {
 	return &cfg, nil
 }
 
-func extractTarbal(ctx context.Context, dest string, src io.Reader, mappings []archive.IDMapping) error {
-	err := archive.ExtractTarbal(ctx, src, dest, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
+func extractTarbal(ctx context.Context, dest string, src io.Reader, mappings []archive.IDMapping, opts ...archive.TarOption) error {
+	opts = append(opts, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
+	err := archive.ExtractTarbal(ctx, src, dest, opts...)
 	if err != nil {
 		return xerrors.Errorf("tar %s: %s", dest, err.Error())
 	}
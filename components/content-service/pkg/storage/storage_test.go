@@ -5,8 +5,12 @@
 package storage
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"io"
 	"testing"
+	"time"
 
 	"golang.org/x/xerrors"
 )
@@ -72,3 +76,67 @@ func equivalentError(e1 error, e2 error) bool {
 	}
 	return false
 }
+
+func TestWithParallelUpload(t *testing.T) {
+	tests := []struct {
+		Name        string
+		PartSize    int64
+		Parallelism int
+		Expectation *UploadOptions
+	}{
+		{
+			Name:        "storage defaults",
+			Expectation: &UploadOptions{},
+		},
+		{
+			Name:        "configured",
+			PartSize:    64 * 1024 * 1024,
+			Parallelism: 8,
+			Expectation: &UploadOptions{PartSize: 64 * 1024 * 1024, Parallelism: 8},
+		},
+		{
+			Name:     "part size too small",
+			PartSize: 1024,
+		},
+		{
+			Name:        "negative parallelism",
+			Parallelism: -1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			opts, err := GetUploadOptions([]UploadOption{WithParallelUpload(test.PartSize, test.Parallelism)})
+			if test.Expectation == nil {
+				if err == nil {
+					t.Fatalf("expected error, got options %+v", opts)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if opts.PartSize != test.Expectation.PartSize || opts.Parallelism != test.Expectation.Parallelism {
+				t.Fatalf("unexpected options: is %+v but expected %+v", opts, test.Expectation)
+			}
+		})
+	}
+}
+
+func TestThrottledReader(t *testing.T) {
+	const limit = 10 * 1024
+	content := bytes.Repeat([]byte("a"), 2*limit)
+
+	t0 := time.Now()
+	act, err := io.ReadAll(newThrottledReader(context.Background(), bytes.NewReader(content), limit))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(content, act) {
+		t.Fatalf("unexpected content: read %d bytes instead of %d", len(act), len(content))
+	}
+	// the first second worth of data is available right away
+	if dt := time.Since(t0); dt < 900*time.Millisecond {
+		t.Fatalf("reading was not throttled: took %s", dt)
+	}
+}
@@ -232,6 +232,7 @@ export class JsonRpcOrganizationClient implements PromiseClient<typeof Organizat
             workspaceSharingDisabled: request?.workspaceSharingDisabled,
             defaultWorkspaceImage: request?.defaultWorkspaceImage,
             allowedWorkspaceClasses: request?.allowedWorkspaceClasses,
+            defaultWorkspaceClass: request?.defaultWorkspaceClass,
             restrictedEditorNames: request?.restrictedEditorNames,
             defaultRole: request?.defaultRole,
         };
@@ -22,6 +22,8 @@ import {
     GetWorkspaceDefaultImageResponse,
     GetWorkspaceEditorCredentialsRequest,
     GetWorkspaceEditorCredentialsResponse,
+    GetWorkspaceSSHAccessRequest,
+    GetWorkspaceSSHAccessResponse,
     GetWorkspaceOwnerTokenRequest,
     GetWorkspaceOwnerTokenResponse,
     SendHeartBeatRequest,
@@ -267,6 +269,14 @@ export class JsonRpcWorkspaceClient implements PromiseClient<typeof WorkspaceSer
         return result;
     }
 
+    async getWorkspaceSSHAccess(
+        _request: PartialMessage<GetWorkspaceSSHAccessRequest>,
+        _options?: CallOptions | undefined,
+    ): Promise<GetWorkspaceSSHAccessResponse> {
+        // SSH access tokens are only issued by the public API, the JSON-RPC API has the owner token only
+        throw new ApplicationError(ErrorCodes.UNIMPLEMENTED, "not implemented (for jrpc)");
+    }
+
     async updateWorkspace(
         request: PartialMessage<UpdateWorkspaceRequest>,
         _options?: CallOptions | undefined,
@@ -28,6 +28,7 @@ func (agent *Smith) stopWorkspace(supervisorPID int, instanceID string) error {
 		Id: instanceID,
 		// Stop workspace without grace period.
 		Policy: wsmanapi.StopWorkspacePolicy_IMMEDIATELY,
+		Reason: wsmanapi.StopReason_STOP_REASON_AGENT_SMITH,
 	}
 	_, err := agent.wsman.StopWorkspace(context.Background(), req)
 	if err == nil {
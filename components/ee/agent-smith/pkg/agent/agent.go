@@ -23,10 +23,12 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/lru"
 
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/baseline"
 	"github.com/gitpod-io/gitpod/agent-smith/pkg/classifier"
 	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
 	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
 	"github.com/gitpod-io/gitpod/agent-smith/pkg/detector"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/siem"
 	common_grpc "github.com/gitpod-io/gitpod/common-go/grpc"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
@@ -53,6 +55,8 @@ type Smith struct {
 
 	detector   detector.ProcessDetector
 	classifier classifier.ProcessClassifier
+	siem       *siem.Exporter
+	baselines  *baseline.Baselines
 }
 
 // NewAgentSmith creates a new agent smith
@@ -129,12 +133,29 @@ func NewAgentSmith(cfg config.Config) (*Smith, error) {
 	if err != nil {
 		return nil, err
 	}
+	detec.CollectTraits = cfg.Baseline != nil
 
 	class, err := cfg.Blocklists.Classifier()
 	if err != nil {
 		return nil, err
 	}
 
+	var siemExporter *siem.Exporter
+	if cfg.SIEM != nil {
+		siemExporter, err = siem.NewExporter(cfg.SIEM)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create SIEM exporter: %w", err)
+		}
+	}
+
+	var baselines *baseline.Baselines
+	if cfg.Baseline != nil {
+		baselines, err = baseline.New(cfg.Baseline)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create baselines: %w", err)
+		}
+	}
+
 	m := newAgentMetrics()
 	res := &Smith{
 		EnforcementRules: map[string]config.EnforcementRules{
@@ -152,6 +173,8 @@ func NewAgentSmith(cfg config.Config) (*Smith, error) {
 
 		detector:   detec,
 		classifier: class,
+		siem:       siemExporter,
+		baselines:  baselines,
 
 		notifiedInfringements: lru.New(notificationCacheSize),
 		metrics:               m,
@@ -227,6 +250,11 @@ type classifiedProcess struct {
 	Err error
 }
 
+type anomalousProcess struct {
+	P detector.Process
+	A baseline.Anomaly
+}
+
 // Start gets a stream of Infringements from Run and executes a callback on them to apply a Penalty
 func (agent *Smith) Start(ctx context.Context, callback func(InfringingWorkspace, []config.PenaltyKind)) {
 	ps, err := agent.detector.DiscoverProcesses(ctx)
@@ -234,10 +262,18 @@ func (agent *Smith) Start(ctx context.Context, callback func(InfringingWorkspace
 		log.WithError(err).Fatal("cannot start process detector")
 	}
 
+	if agent.siem != nil {
+		go agent.siem.Run(ctx)
+	}
+	if agent.baselines != nil {
+		go agent.baselines.Run(ctx)
+	}
+
 	var (
 		wg  sync.WaitGroup
 		cli = make(chan detector.Process, 500)
 		clo = make(chan classifiedProcess, 50)
+		ano = make(chan anomalousProcess, 50)
 	)
 	agent.metrics.RegisterClassificationQueues(cli, clo)
 
@@ -257,6 +293,12 @@ func (agent *Smith) Start(ctx context.Context, callback func(InfringingWorkspace
 					workspaces[i.Workspace.PID] = i.Workspace
 				}
 				wsMutex.Unlock()
+				// compare the process with the baseline of its repository, regardless of any signature matching it
+				if agent.baselines != nil {
+					for _, a := range agent.baselines.Observe(i) {
+						ano <- anomalousProcess{P: i, A: a}
+					}
+				}
 				// perform classification of the process
 				class, err := agent.classifier.Matches(i.Path, i.CommandLine)
 				// optimisation: early out to not block on the CLO chan
@@ -309,6 +351,7 @@ func (agent *Smith) Start(ctx context.Context, callback func(InfringingWorkspace
 			_, _ = agent.Penalize(InfringingWorkspace{
 				SupervisorPID: proc.Workspace.PID,
 				Owner:         proc.Workspace.OwnerID,
+				WorkspaceID:   proc.Workspace.WorkspaceID,
 				InstanceID:    proc.Workspace.InstanceID,
 				GitRemoteURL:  []string{proc.Workspace.GitURL},
 				Infringements: []Infringement{
@@ -319,10 +362,36 @@ func (agent *Smith) Start(ctx context.Context, callback func(InfringingWorkspace
 					},
 				},
 			})
+		case an := <-ano:
+			agent.handleAnomaly(an.P, an.A)
 		}
 	}
 }
 
+// handleAnomaly reports an anomalous process as infringement unless the baselines run in shadow mode
+func (agent *Smith) handleAnomaly(proc detector.Process, a baseline.Anomaly) {
+	owi := log.OWI(proc.Workspace.OwnerID, proc.Workspace.WorkspaceID, proc.Workspace.InstanceID)
+	if agent.Config.Baseline.Shadow {
+		log.WithFields(owi).WithField("anomaly", a.Description()).WithField("commandLine", proc.CommandLine).Info("found anomalous process (shadow mode)")
+		return
+	}
+
+	_, _ = agent.Penalize(InfringingWorkspace{
+		SupervisorPID: proc.Workspace.PID,
+		Owner:         proc.Workspace.OwnerID,
+		WorkspaceID:   proc.Workspace.WorkspaceID,
+		InstanceID:    proc.Workspace.InstanceID,
+		GitRemoteURL:  []string{proc.Workspace.GitURL},
+		Infringements: []Infringement{
+			{
+				Kind:        config.GradeKind(config.InfringementAnomaly, common.SeverityAudit),
+				Description: a.Description(),
+				CommandLine: proc.CommandLine,
+			},
+		},
+	})
+}
+
 // Penalize acts on infringements and e.g. stops pods
 func (agent *Smith) Penalize(ws InfringingWorkspace) ([]config.PenaltyKind, error) {
 	var remoteURL string
@@ -332,6 +401,10 @@ func (agent *Smith) Penalize(ws InfringingWorkspace) ([]config.PenaltyKind, erro
 
 	owi := log.OWI(ws.Owner, ws.WorkspaceID, ws.InstanceID)
 
+	for _, v := range ws.Infringements {
+		agent.siem.Emit(infringementEvent(ws, v, siem.EventDetection))
+	}
+
 	penalty := getPenalty(agent.EnforcementRules[defaultRuleset], agent.EnforcementRules[remoteURL], ws.Infringements)
 	for _, p := range penalty {
 		switch p {
@@ -339,6 +412,7 @@ func (agent *Smith) Penalize(ws InfringingWorkspace) ([]config.PenaltyKind, erro
 			log.WithField("infringement", log.TrustedValueWrap{Value: ws.Infringements}).WithFields(owi).Info("stopping workspace")
 			agent.metrics.penaltyAttempts.WithLabelValues(string(p)).Inc()
 			err := agent.stopWorkspace(ws.SupervisorPID, ws.InstanceID)
+			agent.exportEnforcement(ws, p, err)
 			if err != nil {
 				log.WithError(err).WithFields(owi).Debug("failed to stop workspace")
 				agent.metrics.penaltyFailures.WithLabelValues(string(p), err.Error()).Inc()
@@ -348,6 +422,7 @@ func (agent *Smith) Penalize(ws InfringingWorkspace) ([]config.PenaltyKind, erro
 			log.WithField("infringement", log.TrustedValueWrap{Value: ws.Infringements}).WithFields(owi).Info("stopping workspace and blocking user")
 			agent.metrics.penaltyAttempts.WithLabelValues(string(p)).Inc()
 			err := agent.stopWorkspaceAndBlockUser(ws.SupervisorPID, ws.Owner, ws.WorkspaceID, ws.InstanceID)
+			agent.exportEnforcement(ws, p, err)
 			if err != nil {
 				log.WithError(err).WithFields(owi).Debug("failed to stop workspace and block user")
 				agent.metrics.penaltyFailures.WithLabelValues(string(p), err.Error()).Inc()
@@ -357,6 +432,7 @@ func (agent *Smith) Penalize(ws InfringingWorkspace) ([]config.PenaltyKind, erro
 			log.WithField("infringement", log.TrustedValueWrap{Value: ws.Infringements}).WithFields(owi).Info("limiting CPU")
 			agent.metrics.penaltyAttempts.WithLabelValues(string(p)).Inc()
 			err := agent.limitCPUUse(ws.Pod)
+			agent.exportEnforcement(ws, p, err)
 			if err != nil {
 				log.WithError(err).WithFields(owi).Debug("failed to limit CPU")
 				agent.metrics.penaltyFailures.WithLabelValues(string(p), err.Error()).Inc()
@@ -368,6 +444,36 @@ func (agent *Smith) Penalize(ws InfringingWorkspace) ([]config.PenaltyKind, erro
 	return penalty, nil
 }
 
+// exportEnforcement exports a penalty applied to a workspace as enforcement of all its infringements
+func (agent *Smith) exportEnforcement(ws InfringingWorkspace, penalty config.PenaltyKind, err error) {
+	for _, v := range ws.Infringements {
+		ev := infringementEvent(ws, v, siem.EventEnforcement)
+		ev.Penalty = string(penalty)
+		if err != nil {
+			ev.Error = err.Error()
+		}
+		agent.siem.Emit(ev)
+	}
+}
+
+func infringementEvent(ws InfringingWorkspace, v Infringement, tpe siem.EventType) siem.Event {
+	var remoteURL string
+	if len(ws.GitRemoteURL) > 0 {
+		remoteURL = ws.GitRemoteURL[0]
+	}
+	return siem.Event{
+		Type:         tpe,
+		Severity:     v.Kind.Severity(),
+		Kind:         string(v.Kind),
+		Description:  v.Description,
+		CommandLine:  v.CommandLine,
+		Owner:        ws.Owner,
+		WorkspaceID:  ws.WorkspaceID,
+		InstanceID:   ws.InstanceID,
+		GitRemoteURL: remoteURL,
+	}
+}
+
 func findEnforcementRules(rules map[string]config.EnforcementRules, remoteURL string) config.EnforcementRules {
 	res, ok := rules[remoteURL]
 	if ok {
@@ -420,10 +526,22 @@ func (agent *Smith) Describe(d chan<- *prometheus.Desc) {
 	agent.metrics.Describe(d)
 	agent.classifier.Describe(d)
 	agent.detector.Describe(d)
+	if agent.siem != nil {
+		agent.siem.Describe(d)
+	}
+	if agent.baselines != nil {
+		agent.baselines.Describe(d)
+	}
 }
 
 func (agent *Smith) Collect(m chan<- prometheus.Metric) {
 	agent.metrics.Collect(m)
 	agent.classifier.Collect(m)
 	agent.detector.Collect(m)
+	if agent.siem != nil {
+		agent.siem.Collect(m)
+	}
+	if agent.baselines != nil {
+		agent.baselines.Collect(m)
+	}
 }
@@ -0,0 +1,348 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package baseline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/detector"
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const (
+	defaultMinWorkspaces = 20
+	defaultMaxFrequency  = 0.05
+
+	// maxProfiles limits the number of repositories we learn baselines for
+	maxProfiles = 10000
+	// maxFeatures limits the number of distinct features we learn per repository
+	maxFeatures = 5000
+	// workspaceCacheSize is the number of workspaces whose features we remember to count each feature once per workspace
+	workspaceCacheSize = 10000
+
+	saveInterval = 5 * time.Minute
+)
+
+// FeatureKind describes what a feature of a process captures
+type FeatureKind string
+
+const (
+	// FeatureExec is the position of an executable in the process tree of a workspace
+	FeatureExec FeatureKind = "exec"
+	// FeatureListen is an executable which accepts network connections
+	FeatureListen FeatureKind = "listen"
+	// FeatureSetuid is an executable which runs with another effective user than its real user
+	FeatureSetuid FeatureKind = "setuid"
+)
+
+// significant returns true if anomalies of this kind are worth reporting. Unusual process trees alone are
+// too common to act upon and are only counted.
+func (k FeatureKind) significant() bool {
+	return k == FeatureListen || k == FeatureSetuid
+}
+
+// Feature is a property of a process which we compare with the baseline of its repository
+type Feature struct {
+	Kind  FeatureKind
+	Value string
+}
+
+func (f Feature) String() string {
+	return string(f.Kind) + ":" + f.Value
+}
+
+// Features returns the features of a process
+func Features(p detector.Process) []Feature {
+	if len(p.CommandLine) == 0 {
+		return nil
+	}
+	exe := filepath.Base(p.CommandLine[0])
+
+	res := []Feature{{Kind: FeatureExec, Value: strings.Join(append(append([]string{}, p.Ancestry...), exe), ">")}}
+	if p.Traits != nil {
+		if len(p.Traits.ListeningPorts) > 0 {
+			res = append(res, Feature{Kind: FeatureListen, Value: exe})
+		}
+		if p.Traits.Setuid {
+			res = append(res, Feature{Kind: FeatureSetuid, Value: exe})
+		}
+	}
+	return res
+}
+
+// Profile is the baseline of a repository
+type Profile struct {
+	// Workspaces is the number of workspaces we have observed
+	Workspaces int `json:"workspaces"`
+	// Features counts the workspaces each feature was observed in
+	Features map[string]int `json:"features"`
+}
+
+// Anomaly is a significant feature of a process which is rare among the workspaces of its repository
+type Anomaly struct {
+	Feature    Feature
+	Repository string
+	// Seen is the number of other workspaces of the repository the feature was observed in
+	Seen int
+	// Workspaces is the number of other workspaces of the repository we have observed
+	Workspaces int
+	// ListeningPorts are the ports of a listen anomaly
+	ListeningPorts []int
+}
+
+// Description describes the anomaly for humans
+func (a Anomaly) Description() string {
+	var what string
+	switch a.Feature.Kind {
+	case FeatureListen:
+		ports := make([]string, len(a.ListeningPorts))
+		for i, p := range a.ListeningPorts {
+			ports[i] = strconv.Itoa(p)
+		}
+		what = fmt.Sprintf("unexpected network daemon %s listening on %s", a.Feature.Value, strings.Join(ports, ","))
+	case FeatureSetuid:
+		what = fmt.Sprintf("unexpected setuid process %s", a.Feature.Value)
+	default:
+		what = fmt.Sprintf("unexpected process %s", a.Feature.Value)
+	}
+	return fmt.Sprintf("%s (seen in %d of %d workspaces of %s)", what, a.Seen, a.Workspaces, a.Repository)
+}
+
+// Baselines learns which processes are typical for the workspaces of a repository and finds anomalies
+type Baselines struct {
+	MinWorkspaces int
+	MaxFrequency  float64
+	StateFile     string
+
+	profiles   map[string]*Profile
+	workspaces *lru.Cache
+	mu         sync.Mutex
+
+	anomalies    *prometheus.CounterVec
+	profileGauge prometheus.GaugeFunc
+}
+
+// workspaceFeatures are the features we have observed in a workspace
+type workspaceFeatures map[string]struct{}
+
+// New creates baselines and loads their state
+func New(cfg *config.Baseline) (*Baselines, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	workspaces, err := lru.New(workspaceCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Baselines{
+		MinWorkspaces: cfg.MinWorkspaces,
+		MaxFrequency:  cfg.MaxFrequency,
+		StateFile:     cfg.StateFile,
+		profiles:      make(map[string]*Profile),
+		workspaces:    workspaces,
+		anomalies: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "agent_smith",
+			Name:      "baseline_anomalies_total",
+			Help:      "total count of process features which are rare among the workspaces of their repository",
+		}, []string{"kind"}),
+	}
+	if res.MinWorkspaces == 0 {
+		res.MinWorkspaces = defaultMinWorkspaces
+	}
+	if res.MaxFrequency == 0 {
+		res.MaxFrequency = defaultMaxFrequency
+	}
+	res.profileGauge = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "gitpod",
+		Subsystem: "agent_smith",
+		Name:      "baseline_profiles",
+		Help:      "number of repositories agent smith has learned a baseline for",
+	}, func() float64 {
+		res.mu.Lock()
+		defer res.mu.Unlock()
+		return float64(len(res.profiles))
+	})
+
+	err = res.load()
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Observe adds the features of a process to the baseline of its repository and returns those
+// significant features which are anomalous.
+func (b *Baselines) Observe(p detector.Process) []Anomaly {
+	if p.Workspace == nil {
+		return nil
+	}
+	repo := Repository(p.Workspace.GitURL)
+	if repo == "" {
+		return nil
+	}
+	features := Features(p)
+	if len(features) == 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	profile, ok := b.profiles[repo]
+	if !ok {
+		if len(b.profiles) >= maxProfiles {
+			return nil
+		}
+		profile = &Profile{Features: make(map[string]int)}
+		b.profiles[repo] = profile
+	}
+
+	var ws workspaceFeatures
+	if v, ok := b.workspaces.Get(p.Workspace.WorkspaceID); ok {
+		ws = v.(workspaceFeatures)
+	} else {
+		ws = make(workspaceFeatures)
+		b.workspaces.Add(p.Workspace.WorkspaceID, ws)
+		profile.Workspaces++
+	}
+
+	// we compare with all other workspaces, i.e. those observed before this one
+	others := profile.Workspaces - 1
+	var res []Anomaly
+	for _, f := range features {
+		key := f.String()
+		if _, seen := ws[key]; seen {
+			continue
+		}
+		ws[key] = struct{}{}
+
+		seen := profile.Features[key]
+		if others >= b.MinWorkspaces && float64(seen)/float64(others) < b.MaxFrequency {
+			b.anomalies.WithLabelValues(string(f.Kind)).Inc()
+			if f.Kind.significant() {
+				a := Anomaly{Feature: f, Repository: repo, Seen: seen, Workspaces: others}
+				if f.Kind == FeatureListen {
+					a.ListeningPorts = p.Traits.ListeningPorts
+				}
+				res = append(res, a)
+			}
+		}
+
+		if _, known := profile.Features[key]; known || len(profile.Features) < maxFeatures {
+			profile.Features[key] = seen + 1
+		}
+	}
+	return res
+}
+
+// Repository returns the repository a context URL points to, e.g. github.com/gitpod-io/gitpod for
+// https://github.com/gitpod-io/gitpod/pull/1. It returns an empty string if the URL has no repository.
+func Repository(contextURL string) string {
+	if idx := strings.LastIndex(contextURL, "://"); idx >= 0 {
+		contextURL = contextURL[idx+len("://"):]
+	}
+	segments := strings.Split(strings.Trim(contextURL, "/"), "/")
+	if len(segments) < 3 || segments[1] == "" || segments[2] == "" {
+		return ""
+	}
+	return strings.ToLower(strings.Join(segments[:3], "/"))
+}
+
+// Run persists the baselines periodically until the context is canceled
+func (b *Baselines) Run(ctx context.Context) {
+	if b.StateFile == "" {
+		return
+	}
+
+	t := time.NewTicker(saveInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			err := b.save()
+			if err != nil {
+				log.WithError(err).WithField("stateFile", b.StateFile).Warn("cannot save baselines")
+			}
+			return
+		case <-t.C:
+			err := b.save()
+			if err != nil {
+				log.WithError(err).WithField("stateFile", b.StateFile).Warn("cannot save baselines")
+			}
+		}
+	}
+}
+
+func (b *Baselines) load() error {
+	if b.StateFile == "" {
+		return nil
+	}
+
+	fc, err := os.ReadFile(b.StateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot read baselines: %w", err)
+	}
+	var profiles map[string]*Profile
+	err = json.Unmarshal(fc, &profiles)
+	if err != nil {
+		return xerrors.Errorf("cannot parse baselines from %s: %w", b.StateFile, err)
+	}
+	for repo, p := range profiles {
+		if p == nil {
+			continue
+		}
+		if p.Features == nil {
+			p.Features = make(map[string]int)
+		}
+		b.profiles[repo] = p
+	}
+	return nil
+}
+
+func (b *Baselines) save() error {
+	b.mu.Lock()
+	fc, err := json.Marshal(b.profiles)
+	b.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tmp := b.StateFile + ".tmp"
+	err = os.WriteFile(tmp, fc, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, b.StateFile)
+}
+
+func (b *Baselines) Describe(d chan<- *prometheus.Desc) {
+	b.anomalies.Describe(d)
+	b.profileGauge.Describe(d)
+}
+
+func (b *Baselines) Collect(m chan<- prometheus.Metric) {
+	b.anomalies.Collect(m)
+	b.profileGauge.Collect(m)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package baseline
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/detector"
+)
+
+const testRepo = "https://github.com/gitpod-io/gitpod"
+
+func process(workspaceID string, traits *detector.ProcessTraits, cmdline ...string) detector.Process {
+	return detector.Process{
+		CommandLine: cmdline,
+		Ancestry:    []string{"bash"},
+		Traits:      traits,
+		Workspace: &common.Workspace{
+			WorkspaceID: workspaceID,
+			InstanceID:  workspaceID + "-instance",
+			GitURL:      testRepo,
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	b, err := New(&config.Baseline{MinWorkspaces: 5, MaxFrequency: 0.1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listener := &detector.ProcessTraits{ListeningPorts: []int{4444}}
+
+	// while learning nothing is anomalous
+	for i := 0; i < 5; i++ {
+		ws := fmt.Sprintf("ws-%d", i)
+		if res := b.Observe(process(ws, nil, "/usr/bin/node", "server.js")); len(res) != 0 {
+			t.Fatalf("unexpected anomalies while learning: %v", res)
+		}
+	}
+	if res := b.Observe(process("ws-0", listener, "/usr/bin/node", "server.js")); len(res) != 0 {
+		t.Fatalf("unexpected anomalies while learning: %v", res)
+	}
+
+	// the process tree is unusual but exec features are only counted
+	if res := b.Observe(process("ws-5", nil, "/tmp/xmrig")); len(res) != 0 {
+		t.Errorf("unexpected anomalies for exec feature: %v", res)
+	}
+
+	res := b.Observe(process("ws-6", listener, "/tmp/ncat", "-l", "4444"))
+	exp := []Anomaly{{
+		Feature:        Feature{Kind: FeatureListen, Value: "ncat"},
+		Repository:     "github.com/gitpod-io/gitpod",
+		Seen:           0,
+		Workspaces:     6,
+		ListeningPorts: []int{4444},
+	}}
+	if diff := cmp.Diff(exp, res); diff != "" {
+		t.Errorf("unexpected anomalies (-want +got):\n%s", diff)
+	}
+
+	// each feature is reported once per workspace
+	if res := b.Observe(process("ws-6", listener, "/tmp/ncat", "-l", "4444")); len(res) != 0 {
+		t.Errorf("unexpected repeated anomalies: %v", res)
+	}
+
+	// one of seven workspaces has a listening node which is above the max frequency
+	if res := b.Observe(process("ws-7", listener, "/usr/bin/node", "server.js")); len(res) != 0 {
+		t.Errorf("unexpected anomalies for common feature: %v", res)
+	}
+}
+
+func TestRepository(t *testing.T) {
+	tests := []struct {
+		ContextURL  string
+		Expectation string
+	}{
+		{ContextURL: "https://github.com/gitpod-io/gitpod", Expectation: "github.com/gitpod-io/gitpod"},
+		{ContextURL: "https://github.com/Gitpod-IO/Gitpod/pull/1", Expectation: "github.com/gitpod-io/gitpod"},
+		{ContextURL: "https://gitlab.com/group/sub/repo.git", Expectation: "gitlab.com/group/sub"},
+		{ContextURL: "https://github.com/gitpod-io", Expectation: ""},
+		{ContextURL: "", Expectation: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.ContextURL, func(t *testing.T) {
+			if act := Repository(test.ContextURL); act != test.Expectation {
+				t.Errorf("unexpected repository: want %q, got %q", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestState(t *testing.T) {
+	cfg := &config.Baseline{StateFile: filepath.Join(t.TempDir(), "baselines.json")}
+	b, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Observe(process("ws-0", &detector.ProcessTraits{Setuid: true}, "/usr/bin/sudo"))
+	err = b.save()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restarted, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(b.profiles, restarted.profiles); diff != "" {
+		t.Errorf("unexpected profiles after restart (-want +got):\n%s", diff)
+	}
+}
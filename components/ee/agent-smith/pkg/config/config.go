@@ -94,6 +94,8 @@ type InfringementKind string
 const (
 	// InfringementExec means a user executed a blocklisted executable
 	InfringementExec InfringementKind = "blocklisted executable"
+	// InfringementAnomaly means a process behaved unlike the processes of the other workspaces of its repository
+	InfringementAnomaly InfringementKind = "anomalous process"
 )
 
 // PenaltyKind describes a kind of penalty for a violating workspace
@@ -138,6 +140,7 @@ func (g GradedInfringementKind) Kind() (InfringementKind, error) {
 
 	validKinds := []InfringementKind{
 		InfringementExec,
+		InfringementAnomaly,
 	}
 	for _, k := range validKinds {
 		if string(k) == wopfx {
@@ -175,9 +178,85 @@ type Config struct {
 	ExcessiveCPUCheck *ExcessiveCPUCheck `json:"excessiveCPUCheck,omitempty"`
 	Kubernetes        Kubernetes         `json:"kubernetes"`
 
+	// SIEM exports all detections and enforcements to a security information and event management system
+	SIEM *SIEM `json:"siem,omitempty"`
+
+	// Baseline learns the typical processes of the workspaces of each repository and reports anomalies
+	Baseline *Baseline `json:"baseline,omitempty"`
+
 	ProbePath string `json:"probePath,omitempty"`
 }
 
+// SIEM configures the sinks detections and enforcements are exported to
+type SIEM struct {
+	Syslog *SyslogSink `json:"syslog,omitempty"`
+	OTLP   *OTLPSink   `json:"otlp,omitempty"`
+}
+
+// Validate returns an error if the SIEM configuration is invalid
+func (s *SIEM) Validate() error {
+	if s == nil {
+		return nil
+	}
+	if s.Syslog == nil && s.OTLP == nil {
+		return xerrors.Errorf("siem: no sink configured")
+	}
+	if s.Syslog != nil {
+		if s.Syslog.Network != "udp" && s.Syslog.Network != "tcp" {
+			return xerrors.Errorf("siem: syslog network must be udp or tcp")
+		}
+		if s.Syslog.Address == "" {
+			return xerrors.Errorf("siem: syslog address is required")
+		}
+	}
+	if s.OTLP != nil && s.OTLP.Endpoint == "" {
+		return xerrors.Errorf("siem: otlp endpoint is required")
+	}
+	return nil
+}
+
+// Baseline configures the anomaly detection based on the processes typical for the workspaces of a repository
+type Baseline struct {
+	// Shadow only logs and counts anomalies instead of reporting them as infringements
+	Shadow bool `json:"shadow,omitempty"`
+
+	// MinWorkspaces is the number of workspaces of a repository we must have observed before we report anomalies. Defaults to 20.
+	MinWorkspaces int `json:"minWorkspaces,omitempty"`
+
+	// MaxFrequency is the share of observed workspaces below which a process trait is anomalous. Defaults to 0.05.
+	MaxFrequency float64 `json:"maxFrequency,omitempty"`
+
+	// StateFile persists the baselines across restarts. Baselines aggregated elsewhere can be provided using this file.
+	StateFile string `json:"stateFile,omitempty"`
+}
+
+// Validate returns an error if the baseline configuration is invalid
+func (b *Baseline) Validate() error {
+	if b == nil {
+		return nil
+	}
+	if b.MinWorkspaces < 0 {
+		return xerrors.Errorf("baseline: minWorkspaces must not be negative")
+	}
+	if b.MaxFrequency < 0 || b.MaxFrequency >= 1 {
+		return xerrors.Errorf("baseline: maxFrequency must be between 0 and 1")
+	}
+	return nil
+}
+
+// SyslogSink sends events in the Common Event Format (CEF) to a syslog server
+type SyslogSink struct {
+	// Network is either udp or tcp
+	Network string `json:"network"`
+	Address string `json:"address"`
+}
+
+// OTLPSink sends events as OpenTelemetry logs to an OTLP/HTTP endpoint, e.g. http://otel-collector:4318/v1/logs
+type OTLPSink struct {
+	Endpoint string            `json:"endpoint"`
+	Headers  map[string]string `json:"headers,omitempty"`
+}
+
 type TLS struct {
 	Authority   string `json:"ca"`
 	Certificate string `json:"crt"`
@@ -17,6 +17,22 @@ type Process struct {
 	CommandLine []string
 	Kind        ProcessKind
 	Workspace   *common.Workspace
+
+	// Ancestry lists the executables of the ancestors of a user workload, from the child of supervisor
+	// down to the parent of the process.
+	Ancestry []string
+
+	// Traits are only collected if the detector is configured to do so
+	Traits *ProcessTraits
+}
+
+// ProcessTraits describe the privileges and network activity of a process at the time it was discovered
+type ProcessTraits struct {
+	// Setuid is true if the effective user of the process differs from its real user, e.g. after running a setuid binary
+	Setuid bool
+
+	// ListeningPorts are the TCP ports the process accepts connections on
+	ListeningPorts []int
 }
 
 // ProcessDetector discovers processes on the node
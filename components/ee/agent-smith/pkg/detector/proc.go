@@ -30,6 +30,7 @@ import (
 type discoverableProcFS interface {
 	Discover() map[int]*process
 	Environ(pid int) ([]string, error)
+	Traits(pid int) (*ProcessTraits, error)
 }
 
 type realProcfs procfs.FS
@@ -170,6 +171,70 @@ func (p realProcfs) Environ(pid int) ([]string, error) {
 	return parseGitpodEnviron(f)
 }
 
+func (p realProcfs) Traits(pid int) (*ProcessTraits, error) {
+	proc, err := procfs.FS(p).Proc(pid)
+	if err != nil {
+		return nil, err
+	}
+	status, err := proc.NewStatus()
+	if err != nil {
+		return nil, err
+	}
+	res := &ProcessTraits{
+		Setuid: status.UIDs[0] != status.UIDs[1],
+	}
+
+	fds, err := proc.FileDescriptorTargets()
+	if err != nil {
+		return nil, err
+	}
+	sockets := make(map[uint64]struct{})
+	for _, fd := range fds {
+		inode, ok := strings.CutPrefix(fd, "socket:[")
+		if !ok {
+			continue
+		}
+		if n, err := strconv.ParseUint(strings.TrimSuffix(inode, "]"), 10, 64); err == nil {
+			sockets[n] = struct{}{}
+		}
+	}
+	if len(sockets) == 0 {
+		return res, nil
+	}
+
+	// /proc/<pid>/net shows the sockets of the network namespace of the process, i.e. that of its workspace
+	netns, err := procfs.NewFS(fmt.Sprintf("/proc/%d", pid))
+	if err != nil {
+		return nil, err
+	}
+	for _, list := range []func() (procfs.NetTCP, error){netns.NetTCP, netns.NetTCP6} {
+		lines, err := list()
+		if err != nil {
+			continue
+		}
+		res.ListeningPorts = append(res.ListeningPorts, listeningPorts(lines, sockets)...)
+	}
+	sort.Ints(res.ListeningPorts)
+	return res, nil
+}
+
+// tcpListen is the state of listening sockets in /proc/net/tcp
+const tcpListen = 0x0A
+
+func listeningPorts(lines procfs.NetTCP, sockets map[uint64]struct{}) []int {
+	var res []int
+	for _, l := range lines {
+		if l.St != tcpListen {
+			continue
+		}
+		if _, ok := sockets[l.Inode]; !ok {
+			continue
+		}
+		res = append(res, int(l.LocalPort))
+	}
+	return res
+}
+
 func parseGitpodEnviron(r io.Reader) ([]string, error) {
 	// Note: this function is benchmarked in BenchmarkParseGitpodEnviron.
 	//       At the time of this wriging it consumed 3+N allocs where N is the number of
@@ -255,6 +320,9 @@ type ProcfsDetector struct {
 
 	startOnce sync.Once
 
+	// CollectTraits makes the detector inspect the privileges and sockets of every user workload it discovers
+	CollectTraits bool
+
 	proc  discoverableProcFS
 	cache *lru.Cache
 }
@@ -379,6 +447,14 @@ func (det *ProcfsDetector) run(processes chan<- Process) {
 			CommandLine: p.Cmdline,
 			Kind:        p.Kind,
 			Workspace:   p.Workspace,
+			Ancestry:    ancestry(p),
+		}
+		if det.CollectTraits {
+			traits, err := det.proc.Traits(p.PID)
+			if err != nil {
+				log.WithField("pid", p.PID).WithError(err).Debug("cannot get process traits")
+			}
+			proc.Traits = traits
 		}
 		log.WithField("proc", proc).Debug("found process")
 		processes <- proc
@@ -424,6 +500,29 @@ func findWorkspaces(proc discoverableProcFS, p *process, d int, ws *common.Works
 	}
 }
 
+// maxAncestry limits the number of ancestors we report for a process
+const maxAncestry = 16
+
+// ancestry returns the executables of all ancestors of p below supervisor, starting with the outermost one
+func ancestry(p *process) []string {
+	var res []string
+	for a := p.Parent; a != nil && a.Kind == ProcessUserWorkload && len(res) < maxAncestry; a = a.Parent {
+		res = append(res, executable(a.Cmdline))
+	}
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+	return res
+}
+
+// executable returns the name of the executable of a command line
+func executable(cmdline []string) string {
+	if len(cmdline) == 0 {
+		return ""
+	}
+	return filepath.Base(cmdline[0])
+}
+
 func isSupervisor(cmdline []string) bool {
 	return len(cmdline) == 2 && cmdline[0] == "supervisor" && cmdline[1] == "init"
 }
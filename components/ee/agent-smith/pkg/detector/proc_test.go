@@ -7,6 +7,8 @@ package detector
 import (
 	"bytes"
 	"fmt"
+	"net"
+	"os"
 	"sort"
 	"sync"
 	"testing"
@@ -19,8 +21,9 @@ import (
 )
 
 type memoryProcEntry struct {
-	P   *process
-	Env []string
+	P      *process
+	Env    []string
+	Traits *ProcessTraits
 }
 
 type memoryProc map[int]memoryProcEntry
@@ -41,6 +44,14 @@ func (p memoryProc) Environ(pid int) ([]string, error) {
 	return proc.Env, nil
 }
 
+func (p memoryProc) Traits(pid int) (*ProcessTraits, error) {
+	proc, ok := p[pid]
+	if !ok {
+		return nil, fmt.Errorf("process does not exist")
+	}
+	return proc.Traits, nil
+}
+
 var ws = &common.Workspace{WorkspaceID: "foobar", InstanceID: "baz", PID: 3}
 
 func TestFindWorkspaces(t *testing.T) {
@@ -201,9 +212,10 @@ func TestFindWorkspaces(t *testing.T) {
 
 func TestRunDetector(t *testing.T) {
 	tests := []struct {
-		Name        string
-		Proc        []memoryProc
-		Expectation []Process
+		Name          string
+		Proc          []memoryProc
+		CollectTraits bool
+		Expectation   []Process
 	}{
 		{
 			Name: "happy path",
@@ -243,6 +255,38 @@ func TestRunDetector(t *testing.T) {
 				{Path: "", CommandLine: []string{"another-bad-actor", "has", "args"}, Kind: ProcessUserWorkload, Workspace: ws},
 			},
 		},
+		{
+			Name:          "ancestry and traits",
+			CollectTraits: true,
+			Proc: []memoryProc{
+				(func() memoryProc {
+					res := make(map[int]memoryProcEntry)
+					res[1] = memoryProcEntry{P: &process{Hash: 1, PID: 1}}
+					res[2] = memoryProcEntry{
+						P:   &process{Hash: 2, PID: 2, Parent: res[1].P, Cmdline: []string{"/proc/self/exe", "ring1"}},
+						Env: []string{"GITPOD_WORKSPACE_ID=foobar", "GITPOD_INSTANCE_ID=baz"},
+					}
+					res[3] = memoryProcEntry{P: &process{Hash: 3, PID: 3, Parent: res[2].P, Cmdline: []string{"supervisor", "init"}}}
+					res[4] = memoryProcEntry{P: &process{Hash: 4, PID: 4, Parent: res[3].P, Cmdline: []string{"/bin/bash"}}}
+					res[5] = memoryProcEntry{P: &process{Hash: 5, PID: 5, Parent: res[4].P, Cmdline: []string{"/usr/bin/python3", "server.py"}}}
+					res[6] = memoryProcEntry{
+						P:      &process{Hash: 6, PID: 6, Parent: res[5].P, Cmdline: []string{"nc", "-l", "4444"}},
+						Traits: &ProcessTraits{ListeningPorts: []int{4444}},
+					}
+					res[1].P.Children = []*process{res[2].P}
+					res[2].P.Children = []*process{res[3].P}
+					res[3].P.Children = []*process{res[4].P}
+					res[4].P.Children = []*process{res[5].P}
+					res[5].P.Children = []*process{res[6].P}
+					return res
+				})(),
+			},
+			Expectation: []Process{
+				{CommandLine: []string{"/bin/bash"}, Kind: ProcessUserWorkload, Workspace: ws},
+				{CommandLine: []string{"/usr/bin/python3", "server.py"}, Kind: ProcessUserWorkload, Workspace: ws, Ancestry: []string{"bash"}},
+				{CommandLine: []string{"nc", "-l", "4444"}, Kind: ProcessUserWorkload, Workspace: ws, Ancestry: []string{"bash", "python3"}, Traits: &ProcessTraits{ListeningPorts: []int{4444}}},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -254,6 +298,7 @@ func TestRunDetector(t *testing.T) {
 				cacheUseCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{}, []string{"use"}),
 				workspaceGauge:     prometheus.NewGauge(prometheus.GaugeOpts{Name: "dont"}),
 				cache:              cache,
+				CollectTraits:      test.CollectTraits,
 			}
 
 			var wg sync.WaitGroup
@@ -273,8 +318,11 @@ func TestRunDetector(t *testing.T) {
 			close(ps)
 			wg.Wait()
 
-			sort.Slice(res, func(i, j int) bool {
-				return res[i].Kind < res[j].Kind
+			sort.SliceStable(res, func(i, j int) bool {
+				if res[i].Kind != res[j].Kind {
+					return res[i].Kind < res[j].Kind
+				}
+				return len(res[i].Ancestry) < len(res[j].Ancestry)
 			})
 
 			if diff := cmp.Diff(test.Expectation, res); diff != "" {
@@ -298,6 +346,29 @@ func TestDiscovery(t *testing.T) {
 	}
 }
 
+func TestTraits(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	p, err := procfs.NewFS("/proc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	traits, err := realProcfs(p).Traits(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	port := l.Addr().(*net.TCPAddr).Port
+	exp := &ProcessTraits{Setuid: false, ListeningPorts: []int{port}}
+	if diff := cmp.Diff(exp, traits); diff != "" {
+		t.Errorf("unexpected traits (-want +got):\n%s", diff)
+	}
+}
+
 func TestParseGitpodEnviron(t *testing.T) {
 	tests := []struct {
 		Name        string
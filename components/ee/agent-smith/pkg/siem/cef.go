@@ -0,0 +1,114 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package siem
+
+import (
+	"context"
+	"fmt"
+	"log/syslog"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`)
+)
+
+// SyslogSink sends events in the Common Event Format (CEF) to a syslog server
+type SyslogSink struct {
+	w *syslog.Writer
+}
+
+// NewSyslogSink connects to the configured syslog server
+func NewSyslogSink(cfg *config.SyslogSink) (*SyslogSink, error) {
+	w, err := syslog.Dial(cfg.Network, cfg.Address, syslog.LOG_WARNING|syslog.LOG_AUTH, "agent-smith")
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogSink{w: w}, nil
+}
+
+func (s *SyslogSink) Name() string { return "syslog" }
+
+func (s *SyslogSink) Export(ctx context.Context, ev Event) error {
+	msg := FormatCEF(ev)
+	switch ev.Severity {
+	case common.SeverityBarely:
+		return s.w.Info(msg)
+	case common.SeverityVery:
+		return s.w.Err(msg)
+	default:
+		return s.w.Warning(msg)
+	}
+}
+
+func (s *SyslogSink) Close() error {
+	return s.w.Close()
+}
+
+// FormatCEF formats an event in the Common Event Format
+func FormatCEF(ev Event) string {
+	name := ev.Kind
+	if ev.Type == EventEnforcement {
+		name = ev.Penalty
+	}
+
+	var ext []string
+	add := func(key, value string) {
+		if value == "" {
+			return
+		}
+		ext = append(ext, key+"="+cefExtensionEscaper.Replace(value))
+	}
+	addCustom := func(n int, label, value string) {
+		if value == "" {
+			return
+		}
+		add(fmt.Sprintf("cs%dLabel", n), label)
+		add(fmt.Sprintf("cs%d", n), value)
+	}
+
+	add("rt", fmt.Sprint(ev.Time.UnixMilli()))
+	add("suser", ev.Owner)
+	addCustom(1, "workspaceId", ev.WorkspaceID)
+	addCustom(2, "instanceId", ev.InstanceID)
+	addCustom(3, "gitRemoteURL", ev.GitRemoteURL)
+	addCustom(4, "infringement", ev.Kind)
+	add("msg", ev.Description)
+	addCustom(5, "commandLine", strings.Join(ev.CommandLine, " "))
+	if ev.Type == EventEnforcement {
+		outcome := "success"
+		if ev.Error != "" {
+			outcome = "failure"
+		}
+		add("act", ev.Penalty)
+		add("outcome", outcome)
+		add("reason", ev.Error)
+	}
+
+	var res strings.Builder
+	fmt.Fprintf(&res, "CEF:0|Gitpod|agent-smith|1.0|%s|%s|%d|",
+		cefHeaderEscaper.Replace(string(ev.Type)),
+		cefHeaderEscaper.Replace(name),
+		cefSeverity(ev.Severity),
+	)
+	res.WriteString(strings.Join(ext, " "))
+	return res.String()
+}
+
+// cefSeverity maps severities to the CEF severity scale from 0 to 10
+func cefSeverity(s common.Severity) int {
+	switch s {
+	case common.SeverityBarely:
+		return 3
+	case common.SeverityVery:
+		return 8
+	default:
+		return 5
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package siem
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+)
+
+// otlpTimeout is the time we give the OTLP endpoint to accept an event
+const otlpTimeout = 10 * time.Second
+
+// OTLPSink sends events as OpenTelemetry logs using the JSON encoding of OTLP/HTTP
+type OTLPSink struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+}
+
+// NewOTLPSink creates a new OTLP sink
+func NewOTLPSink(cfg *config.OTLPSink) *OTLPSink {
+	return &OTLPSink{
+		endpoint: cfg.Endpoint,
+		headers:  cfg.Headers,
+		client:   &http.Client{Timeout: otlpTimeout},
+	}
+}
+
+func (s *OTLPSink) Name() string { return "otlp" }
+
+func (s *OTLPSink) Export(ctx context.Context, ev Event) error {
+	body, err := json.Marshal(otlpLogsRequest(ev))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return xerrors.Errorf("OTLP endpoint returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func (s *OTLPSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string         `json:"timeUnixNano"`
+	SeverityNumber int            `json:"severityNumber"`
+	SeverityText   string         `json:"severityText"`
+	Body           otlpAnyValue   `json:"body"`
+	Attributes     []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope struct {
+		Name string `json:"name"`
+	} `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	} `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpExportLogsServiceRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+func otlpLogsRequest(ev Event) *otlpExportLogsServiceRequest {
+	var attrs []otlpKeyValue
+	add := func(k, v string) {
+		if v == "" {
+			return
+		}
+		attrs = append(attrs, otlpKeyValue{Key: k, Value: otlpAnyValue{StringValue: v}})
+	}
+	add("event.name", "agent_smith."+string(ev.Type))
+	add("gitpod.infringement", ev.Kind)
+	add("gitpod.penalty", ev.Penalty)
+	add("error.message", ev.Error)
+	add("gitpod.owner", ev.Owner)
+	add("gitpod.workspace.id", ev.WorkspaceID)
+	add("gitpod.instance.id", ev.InstanceID)
+	add("gitpod.git.remote_url", ev.GitRemoteURL)
+	if len(ev.CommandLine) > 0 {
+		add("process.command_line", strings.Join(ev.CommandLine, " "))
+	}
+
+	severityNumber, severityText := otlpSeverity(ev.Severity)
+	scope := otlpScopeLogs{
+		LogRecords: []otlpLogRecord{{
+			TimeUnixNano:   fmt.Sprint(ev.Time.UnixNano()),
+			SeverityNumber: severityNumber,
+			SeverityText:   severityText,
+			Body:           otlpAnyValue{StringValue: ev.Description},
+			Attributes:     attrs,
+		}},
+	}
+	scope.Scope.Name = "agent-smith"
+
+	res := otlpResourceLogs{ScopeLogs: []otlpScopeLogs{scope}}
+	res.Resource.Attributes = []otlpKeyValue{{Key: "service.name", Value: otlpAnyValue{StringValue: "agent-smith"}}}
+
+	return &otlpExportLogsServiceRequest{ResourceLogs: []otlpResourceLogs{res}}
+}
+
+// otlpSeverity maps severities to the severity numbers of the OpenTelemetry log data model
+func otlpSeverity(s common.Severity) (int, string) {
+	switch s {
+	case common.SeverityBarely:
+		return 9, "INFO"
+	case common.SeverityVery:
+		return 17, "ERROR"
+	default:
+		return 13, "WARN"
+	}
+}
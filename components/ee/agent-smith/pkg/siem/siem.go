@@ -0,0 +1,161 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package siem
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+// eventQueueSize is the number of events which wait for export before new events are dropped
+const eventQueueSize = 100
+
+// EventType distinguishes detections from enforcements
+type EventType string
+
+const (
+	// EventDetection is emitted for every infringement agent smith detects
+	EventDetection EventType = "detection"
+	// EventEnforcement is emitted for every penalty agent smith applies
+	EventEnforcement EventType = "enforcement"
+)
+
+// Event is a detection or enforcement exported to a SIEM
+type Event struct {
+	Time     time.Time
+	Type     EventType
+	Severity common.Severity
+
+	// Kind is the graded infringement kind
+	Kind        string
+	Description string
+	CommandLine []string
+
+	// Penalty and Error are only set on enforcements
+	Penalty string
+	Error   string
+
+	Owner        string
+	WorkspaceID  string
+	InstanceID   string
+	GitRemoteURL string
+}
+
+// Sink sends events to a SIEM
+type Sink interface {
+	Name() string
+	Export(ctx context.Context, ev Event) error
+	Close() error
+}
+
+// Exporter sends events to all configured sinks without blocking the caller
+type Exporter struct {
+	sinks  []Sink
+	events chan Event
+
+	eventsDropped  prometheus.Counter
+	exportFailures *prometheus.CounterVec
+}
+
+// NewExporter creates an exporter for the configured sinks
+func NewExporter(cfg *config.SIEM) (*Exporter, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var sinks []Sink
+	if cfg.Syslog != nil {
+		s, err := NewSyslogSink(cfg.Syslog)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create syslog sink: %w", err)
+		}
+		sinks = append(sinks, s)
+	}
+	if cfg.OTLP != nil {
+		sinks = append(sinks, NewOTLPSink(cfg.OTLP))
+	}
+
+	return newExporter(sinks...), nil
+}
+
+func newExporter(sinks ...Sink) *Exporter {
+	return &Exporter{
+		sinks:  sinks,
+		events: make(chan Event, eventQueueSize),
+		eventsDropped: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "agent_smith",
+			Name:      "siem_events_dropped_total",
+			Help:      "total count of events which were not exported because of backpressure",
+		}),
+		exportFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "agent_smith",
+			Name:      "siem_export_failures_total",
+			Help:      "total count of events which could not be exported",
+		}, []string{"sink"}),
+	}
+}
+
+// Emit queues an event for export. If the queue is full the event is dropped.
+func (e *Exporter) Emit(ev Event) {
+	if e == nil {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+
+	select {
+	case e.events <- ev:
+	default:
+		e.eventsDropped.Inc()
+	}
+}
+
+// Run exports queued events until the context is canceled and closes the sinks afterwards
+func (e *Exporter) Run(ctx context.Context) {
+	defer func() {
+		for _, s := range e.sinks {
+			err := s.Close()
+			if err != nil {
+				log.WithError(err).WithField("sink", s.Name()).Warn("cannot close SIEM sink")
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev := <-e.events:
+			for _, s := range e.sinks {
+				err := s.Export(ctx, ev)
+				if err != nil {
+					log.WithError(err).WithField("sink", s.Name()).Warn("cannot export event to SIEM")
+					e.exportFailures.WithLabelValues(s.Name()).Inc()
+				}
+			}
+		}
+	}
+}
+
+func (e *Exporter) Describe(d chan<- *prometheus.Desc) {
+	e.eventsDropped.Describe(d)
+	e.exportFailures.Describe(d)
+}
+
+func (e *Exporter) Collect(m chan<- prometheus.Metric) {
+	e.eventsDropped.Collect(m)
+	e.exportFailures.Collect(m)
+}
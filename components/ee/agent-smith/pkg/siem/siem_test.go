@@ -0,0 +1,127 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package siem
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/common"
+	"github.com/gitpod-io/gitpod/agent-smith/pkg/config"
+)
+
+var testTime = time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestFormatCEF(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Event       Event
+		Expectation string
+	}{
+		{
+			Name: "detection",
+			Event: Event{
+				Time:         testTime,
+				Type:         EventDetection,
+				Severity:     common.SeverityVery,
+				Kind:         "very blocklisted executable",
+				Description:  "very: matched signature=miner",
+				CommandLine:  []string{"./xmrig", "--donate-level=1"},
+				Owner:        "owner-id",
+				WorkspaceID:  "workspace-id",
+				InstanceID:   "instance-id",
+				GitRemoteURL: "https://github.com/gitpod-io/gitpod",
+			},
+			Expectation: `CEF:0|Gitpod|agent-smith|1.0|detection|very blocklisted executable|8|rt=1685620800000 suser=owner-id cs1Label=workspaceId cs1=workspace-id cs2Label=instanceId cs2=instance-id cs3Label=gitRemoteURL cs3=https://github.com/gitpod-io/gitpod cs4Label=infringement cs4=very blocklisted executable msg=very: matched signature\=miner cs5Label=commandLine cs5=./xmrig --donate-level\=1`,
+		},
+		{
+			Name: "failed enforcement",
+			Event: Event{
+				Time:       testTime,
+				Type:       EventEnforcement,
+				Severity:   common.SeverityAudit,
+				Kind:       "blocklisted executable",
+				Penalty:    "stop workspace",
+				Error:      "ws-manager\nunavailable",
+				InstanceID: "instance-id",
+			},
+			Expectation: `CEF:0|Gitpod|agent-smith|1.0|enforcement|stop workspace|5|rt=1685620800000 cs2Label=instanceId cs2=instance-id cs4Label=infringement cs4=blocklisted executable act=stop workspace outcome=failure reason=ws-manager\nunavailable`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := FormatCEF(test.Event)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected CEF message (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOTLPSink(t *testing.T) {
+	var (
+		body   map[string]interface{}
+		header http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		b, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(b, &body)
+	}))
+	defer srv.Close()
+
+	sink := NewOTLPSink(&config.OTLPSink{
+		Endpoint: srv.URL + "/v1/logs",
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+	err := sink.Export(context.Background(), Event{
+		Time:        testTime,
+		Type:        EventEnforcement,
+		Severity:    common.SeverityBarely,
+		Kind:        "barely blocklisted executable",
+		Description: "barely: matched binary",
+		Penalty:     "limit CPU",
+		InstanceID:  "instance-id",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if act := header.Get("Authorization"); act != "Bearer token" {
+		t.Errorf("unexpected authorization header: %s", act)
+	}
+	expectation := map[string]interface{}{
+		"resourceLogs": []interface{}{map[string]interface{}{
+			"resource": map[string]interface{}{"attributes": []interface{}{
+				map[string]interface{}{"key": "service.name", "value": map[string]interface{}{"stringValue": "agent-smith"}},
+			}},
+			"scopeLogs": []interface{}{map[string]interface{}{
+				"scope": map[string]interface{}{"name": "agent-smith"},
+				"logRecords": []interface{}{map[string]interface{}{
+					"timeUnixNano":   "1685620800000000000",
+					"severityNumber": float64(9),
+					"severityText":   "INFO",
+					"body":           map[string]interface{}{"stringValue": "barely: matched binary"},
+					"attributes": []interface{}{
+						map[string]interface{}{"key": "event.name", "value": map[string]interface{}{"stringValue": "agent_smith.enforcement"}},
+						map[string]interface{}{"key": "gitpod.infringement", "value": map[string]interface{}{"stringValue": "barely blocklisted executable"}},
+						map[string]interface{}{"key": "gitpod.penalty", "value": map[string]interface{}{"stringValue": "limit CPU"}},
+						map[string]interface{}{"key": "gitpod.instance.id", "value": map[string]interface{}{"stringValue": "instance-id"}},
+					},
+				}},
+			}},
+		}},
+	}
+	if diff := cmp.Diff(expectation, body); diff != "" {
+		t.Errorf("unexpected OTLP request (-want +got):\n%s", diff)
+	}
+}
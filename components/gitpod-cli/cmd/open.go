@@ -10,12 +10,15 @@ import (
 	"time"
 
 	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	supervisorapi "github.com/gitpod-io/gitpod/supervisor/api"
 
 	"context"
 
 	"github.com/google/shlex"
 	"github.com/spf13/cobra"
 	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // initCmd represents the init command
@@ -24,8 +27,6 @@ var openCmd = &cobra.Command{
 	Short: "Opens a file in Gitpod",
 	Args:  cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		// TODO(ak) use NotificationService.NotifyActive supervisor API instead
-
 		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
 		defer cancel()
 
@@ -39,6 +40,26 @@ var openCmd = &cobra.Command{
 
 		wait, _ := cmd.Flags().GetBool("wait")
 
+		notifyCtx := ctx
+		if wait {
+			// the active client only responds once all files are closed again
+			notifyCtx = cmd.Context()
+		}
+		handled, err := notifyActive(notifyCtx, client, &supervisorapi.NotifyActiveRequest{
+			ActionData: &supervisorapi.NotifyActiveRequest_Open{
+				Open: &supervisorapi.NotifyActiveRequest_OpenData{
+					Urls:  args,
+					Await: wait,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if handled {
+			return nil
+		}
+
 		pcmd := os.Getenv("GP_OPEN_EDITOR")
 		if pcmd == "" {
 			return xerrors.Errorf("GP_OPEN_EDITOR is not set")
@@ -66,6 +87,19 @@ var openCmd = &cobra.Command{
 	},
 }
 
+// notifyActive asks the active IDE client to carry out an action. It returns false if there
+// is no active client, in which case the action is up to the IDE commands in the environment.
+func notifyActive(ctx context.Context, client *supervisor.SupervisorClient, req *supervisorapi.NotifyActiveRequest) (handled bool, err error) {
+	_, err = client.Notification.NotifyActive(ctx, req)
+	if code := status.Code(err); code == codes.NotFound || code == codes.Unimplemented {
+		return false, nil
+	}
+	if err != nil {
+		return false, xerrors.Errorf("cannot notify active client: %w", err)
+	}
+	return true, nil
+}
+
 func init() {
 	rootCmd.AddCommand(openCmd)
 	openCmd.Flags().BoolP("wait", "w", false, "wait until all opened files are closed again")
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/supervisor"
+	supervisorapi "github.com/gitpod-io/gitpod/supervisor/api"
 	"github.com/google/shlex"
 	"github.com/spf13/cobra"
 	"golang.org/x/xerrors"
@@ -31,8 +32,6 @@ var previewCmd = &cobra.Command{
 	Short: "Opens a URL in the IDE's preview",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		// TODO(ak) use NotificationService.NotifyActive supervisor API instead
-
 		ctx, cancel := context.WithTimeout(cmd.Context(), 5*time.Second)
 		defer cancel()
 		client, err := supervisor.New(ctx)
@@ -53,6 +52,21 @@ var previewCmd = &cobra.Command{
 			gpBrowserEnvVar = "GP_EXTERNAL_BROWSER"
 		}
 
+		handled, err := notifyActive(ctx, client, &supervisorapi.NotifyActiveRequest{
+			ActionData: &supervisorapi.NotifyActiveRequest_Preview{
+				Preview: &supervisorapi.NotifyActiveRequest_PreviewData{
+					Url:      url,
+					External: previewCmdOpts.External,
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if handled {
+			return nil
+		}
+
 		return openPreview(gpBrowserEnvVar, url)
 	},
 }
@@ -0,0 +1,39 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/filesync"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+var syncPullCmd = &cobra.Command{
+	Use:     "pull <ssh-destination>:<workspace-dir> <local-dir>",
+	Short:   "Mirror a directory of the workspace into a local directory",
+	Example: "  gp sync pull gitpodio-gitpod-abc123@gitpodio-gitpod-abc123.ssh.ws-eu.gitpod.io:/workspace/gitpod/assets ./assets",
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		destination, dir, err := parseSyncRemote(args[0])
+		if err != nil {
+			return err
+		}
+		remote, closeRemote, err := openSyncRemote(cmd.Context(), destination, dir)
+		if err != nil {
+			return err
+		}
+		defer closeRemote()
+
+		err = filesync.Sync(remote, filesync.Dir{Root: args[1]}, syncOptions())
+		if err != nil {
+			return xerrors.Errorf("cannot sync %s from the workspace: %w", dir, err)
+		}
+		return closeRemote()
+	},
+}
+
+func init() {
+	syncCmd.AddCommand(syncPullCmd)
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/filesync"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+var syncPushCmd = &cobra.Command{
+	Use:     "push <local-dir> <ssh-destination>:<workspace-dir>",
+	Short:   "Mirror a local directory into the workspace",
+	Example: "  gp sync push ./assets gitpodio-gitpod-abc123@gitpodio-gitpod-abc123.ssh.ws-eu.gitpod.io:/workspace/gitpod/assets",
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		destination, dir, err := parseSyncRemote(args[1])
+		if err != nil {
+			return err
+		}
+		remote, closeRemote, err := openSyncRemote(cmd.Context(), destination, dir)
+		if err != nil {
+			return err
+		}
+		defer closeRemote()
+
+		err = filesync.Sync(filesync.Dir{Root: args[0]}, remote, syncOptions())
+		if err != nil {
+			return xerrors.Errorf("cannot sync %s into the workspace: %w", args[0], err)
+		}
+		return closeRemote()
+	},
+}
+
+func init() {
+	syncCmd.AddCommand(syncPushCmd)
+}
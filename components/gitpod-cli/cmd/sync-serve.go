@@ -0,0 +1,27 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/filesync"
+	"github.com/spf13/cobra"
+)
+
+// syncServeCmd is what gp sync push and pull talk to in the workspace, using the SSH subsystem supervisor registers
+var syncServeCmd = &cobra.Command{
+	Use:    "serve",
+	Short:  "Serve gp sync requests on stdin and stdout",
+	Hidden: true,
+	Args:   cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return filesync.Serve(os.Stdin, os.Stdout, filesync.WorkDir())
+	},
+}
+
+func init() {
+	syncCmd.AddCommand(syncServeCmd)
+}
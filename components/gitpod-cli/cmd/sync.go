@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/filesync"
+	"github.com/gitpod-io/gitpod/gitpod-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+)
+
+// syncSSHSubsystem is the SSH subsystem supervisor registers for gp sync serve
+const syncSSHSubsystem = "gitpod-sync"
+
+var syncOpts struct {
+	Delete   bool
+	Excludes []string
+	SSH      string
+	Verbose  bool
+}
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Mirror directories between your machine and a workspace",
+	Long: `Mirror directories between your machine and a workspace over SSH.
+
+Only the parts of files which changed are transferred. Run gp sync on your machine with the
+SSH destination you connect to the workspace with, i.e. what you would pass to ssh.`,
+}
+
+// parseSyncRemote splits <destination>:<dir> into the SSH destination and the directory in the workspace
+func parseSyncRemote(remote string) (destination, dir string, err error) {
+	destination, dir, ok := strings.Cut(remote, ":")
+	if !ok || destination == "" {
+		return "", "", GpError{Err: xerrors.Errorf("cannot parse %q, should be something like `<ssh-destination>:/workspace/assets`", remote), OutCome: utils.Outcome_UserErr, ErrorCode: utils.UserErrorCode_InvalidArguments}
+	}
+	if dir == "" {
+		dir = "."
+	}
+	return destination, dir, nil
+}
+
+// openSyncRemote starts gp sync serve in the workspace and opens dir there. The returned function closes the connection.
+func openSyncRemote(ctx context.Context, destination, dir string) (*filesync.Remote, func() error, error) {
+	ssh := exec.CommandContext(ctx, syncOpts.SSH, "-s", destination, syncSSHSubsystem)
+	ssh.Stderr = os.Stderr
+	stdin, err := ssh.StdinPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	stdout, err := ssh.StdoutPipe()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = ssh.Start()
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot start %s: %w", syncOpts.SSH, err)
+	}
+	closeRemote := func() error {
+		stdin.Close()
+		return ssh.Wait()
+	}
+
+	remote, err := filesync.OpenRemote(stdout, stdin, dir)
+	if err != nil {
+		_ = closeRemote()
+		return nil, nil, xerrors.Errorf("cannot open %s in the workspace: %w", dir, err)
+	}
+	return remote, closeRemote, nil
+}
+
+func syncOptions() filesync.Options {
+	res := filesync.Options{
+		Delete:   syncOpts.Delete,
+		Excludes: syncOpts.Excludes,
+	}
+	if syncOpts.Verbose {
+		res.Progress = func(op string, f filesync.File) {
+			fmt.Printf("%s %s\n", op, f.Path)
+		}
+	}
+	return res
+}
+
+func init() {
+	syncCmd.PersistentFlags().BoolVar(&syncOpts.Delete, "delete", false, "delete files from the destination which do not exist in the source")
+	syncCmd.PersistentFlags().StringSliceVar(&syncOpts.Excludes, "exclude", nil, "exclude files whose path or name matches the pattern, e.g. node_modules or *.log")
+	syncCmd.PersistentFlags().StringVar(&syncOpts.SSH, "ssh", "ssh", "SSH client to connect to the workspace with")
+	syncCmd.PersistentFlags().BoolVarP(&syncOpts.Verbose, "verbose", "v", false, "print every file we transfer or delete")
+	rootCmd.AddCommand(syncCmd)
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package filesync
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+)
+
+const (
+	// blockSize is the size of the blocks we look for when computing a delta
+	blockSize = 8 << 10
+
+	// maxLiteralSize is the most data a single delta op carries
+	maxLiteralSize = 64 << 10
+
+	rollingModulus = 1 << 16
+)
+
+// Signature describes the blocks of a file, so that a delta against it can be computed without having the file.
+type Signature struct {
+	BlockSize int
+	Size      int64
+	Blocks    []BlockSignature
+}
+
+// BlockSignature identifies a block by a cheap rolling checksum and a strong hash.
+type BlockSignature struct {
+	Weak   uint32
+	Strong [sha256.Size]byte
+}
+
+// DeltaOp either copies a block of the file the delta is applied to, or adds literal data.
+type DeltaOp struct {
+	// Block is the index of the block to copy, or -1 if Data is to be added
+	Block int
+	Data  []byte
+}
+
+// computeSignature reads r and returns the signature of its blocks
+func computeSignature(r io.Reader) (*Signature, error) {
+	res := &Signature{BlockSize: blockSize}
+	buf := make([]byte, blockSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		res.Size += int64(n)
+		if n > 0 {
+			res.Blocks = append(res.Blocks, BlockSignature{
+				Weak:   newRollingChecksum(buf[:n]).sum(),
+				Strong: sha256.Sum256(buf[:n]),
+			})
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// computeDelta returns the ops which turn the file described by sig into data. Blocks we find in data
+// are copied from the existing file, everything else is sent along as literal data.
+func computeDelta(data []byte, sig *Signature) []DeltaOp {
+	var res []DeltaOp
+	addLiteral := func(b []byte) {
+		for len(b) > 0 {
+			if l := len(res); l > 0 && res[l-1].Block == -1 && len(res[l-1].Data) < maxLiteralSize {
+				n := min(maxLiteralSize-len(res[l-1].Data), len(b))
+				res[l-1].Data = append(res[l-1].Data, b[:n]...)
+				b = b[n:]
+				continue
+			}
+			n := min(maxLiteralSize, len(b))
+			res = append(res, DeltaOp{Block: -1, Data: append([]byte(nil), b[:n]...)})
+			b = b[n:]
+		}
+	}
+
+	if sig == nil || len(sig.Blocks) == 0 || sig.BlockSize <= 0 {
+		addLiteral(data)
+		return res
+	}
+
+	bs := sig.BlockSize
+	blocks := make(map[uint32][]int, len(sig.Blocks))
+	for i, b := range sig.Blocks {
+		blocks[b.Weak] = append(blocks[b.Weak], i)
+	}
+	match := func(window []byte, weak uint32) int {
+		candidates, ok := blocks[weak]
+		if !ok {
+			return -1
+		}
+		strong := sha256.Sum256(window)
+		for _, i := range candidates {
+			if sig.Blocks[i].Strong == strong && len(window) == blockLen(sig, i) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var (
+		literalStart int
+		pos          int
+		rc           *rollingChecksum
+	)
+	for pos+bs <= len(data) {
+		if rc == nil {
+			rc = newRollingChecksum(data[pos : pos+bs])
+		}
+		if i := match(data[pos:pos+bs], rc.sum()); i >= 0 {
+			addLiteral(data[literalStart:pos])
+			res = append(res, DeltaOp{Block: i})
+			pos += bs
+			literalStart = pos
+			rc = nil
+			continue
+		}
+		if pos+bs < len(data) {
+			rc.roll(data[pos], data[pos+bs])
+		}
+		pos++
+	}
+	// the last block of the file may be shorter than the block size, hence cannot match the window above
+	last := len(sig.Blocks) - 1
+	if n := blockLen(sig, last); n < bs && len(data)-n >= literalStart {
+		tail := data[len(data)-n:]
+		if match(tail, newRollingChecksum(tail).sum()) == last {
+			addLiteral(data[literalStart : len(data)-n])
+			res = append(res, DeltaOp{Block: last})
+			literalStart = len(data)
+		}
+	}
+	addLiteral(data[literalStart:])
+	return res
+}
+
+// applyDelta writes the result of applying ops to basis to w
+func applyDelta(w io.Writer, basis io.ReaderAt, sig *Signature, ops []DeltaOp) error {
+	for _, op := range ops {
+		if op.Block < 0 {
+			_, err := w.Write(op.Data)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if basis == nil || sig == nil || op.Block >= len(sig.Blocks) {
+			return errInvalidDelta
+		}
+		buf := make([]byte, blockLen(sig, op.Block))
+		_, err := basis.ReadAt(buf, int64(op.Block)*int64(sig.BlockSize))
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if sha256.Sum256(buf) != sig.Blocks[op.Block].Strong {
+			// the file changed since we computed the signature
+			return errInvalidDelta
+		}
+		_, err = io.Copy(w, bytes.NewReader(buf))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockLen returns the length of the i-th block. Only the last block can be shorter than the block size.
+func blockLen(sig *Signature, i int) int {
+	if i < len(sig.Blocks)-1 {
+		return sig.BlockSize
+	}
+	return int(sig.Size - int64(i)*int64(sig.BlockSize))
+}
+
+// rollingChecksum is the weak checksum rsync uses, which we can move along a file one byte at a time
+type rollingChecksum struct {
+	a, b uint32
+	n    uint32
+}
+
+func newRollingChecksum(block []byte) *rollingChecksum {
+	res := &rollingChecksum{n: uint32(len(block))}
+	for i, c := range block {
+		res.a += uint32(c)
+		res.b += uint32(len(block)-i) * uint32(c)
+	}
+	res.a %= rollingModulus
+	res.b %= rollingModulus
+	return res
+}
+
+// roll moves the window by one byte, dropping out and adding in
+func (r *rollingChecksum) roll(out, in byte) {
+	r.a = (r.a - uint32(out) + uint32(in)) % rollingModulus
+	r.b = (r.b - r.n*uint32(out) + r.a) % rollingModulus
+}
+
+func (r *rollingChecksum) sum() uint32 {
+	return r.a | r.b<<16
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package filesync mirrors a directory into another one, possibly on the other end of an SSH connection.
+// Much like rsync, it transfers only the parts of a file which changed.
+package filesync
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+var errInvalidDelta = errors.New("delta does not apply to the file")
+
+// File describes a file or directory relative to the root of the synced directory.
+type File struct {
+	// Path is slash separated and relative to the root
+	Path    string
+	Mode    fs.FileMode
+	Size    int64
+	ModTime time.Time
+}
+
+func (f File) upToDate(other File) bool {
+	if f.Mode.IsDir() || other.Mode.IsDir() {
+		return f.Mode.IsDir() == other.Mode.IsDir()
+	}
+	// like rsync, we compare modification times at a resolution of a second as not every file system is more precise
+	return f.Size == other.Size && f.ModTime.Unix() == other.ModTime.Unix()
+}
+
+// Endpoint is one side of a sync.
+type Endpoint interface {
+	// List returns all regular files and directories below the root, excluding those which match one of the patterns
+	List(excludes []string) ([]File, error)
+	// Signature returns the signature of a file, or nil if it does not exist
+	Signature(path string) (*Signature, error)
+	// Delta returns the ops which turn the file described by sig into the file at path
+	Delta(path string, sig *Signature) ([]DeltaOp, error)
+	// Apply turns the file at f.Path into the file described by f by applying the delta to it
+	Apply(f File, sig *Signature, delta []DeltaOp) error
+	// Remove removes a file or empty directory
+	Remove(path string) error
+}
+
+// Options configure a sync.
+type Options struct {
+	// Delete removes files from the destination which do not exist in the source
+	Delete bool
+	// Excludes are patterns as understood by path.Match. A file is excluded if its path or name matches.
+	Excludes []string
+	// Progress, if not nil, is called for every file we transfer or remove
+	Progress func(op string, f File)
+}
+
+// Sync mirrors src into dst.
+func Sync(src, dst Endpoint, opts Options) error {
+	srcFiles, err := src.List(opts.Excludes)
+	if err != nil {
+		return fmt.Errorf("cannot list source files: %w", err)
+	}
+	dstFiles, err := dst.List(opts.Excludes)
+	if err != nil {
+		return fmt.Errorf("cannot list destination files: %w", err)
+	}
+	existing := make(map[string]File, len(dstFiles))
+	for _, f := range dstFiles {
+		existing[f.Path] = f
+	}
+
+	// List returns parents before their children, which is the order we need to create them in
+	for _, f := range srcFiles {
+		other, exists := existing[f.Path]
+		delete(existing, f.Path)
+		if exists && f.upToDate(other) {
+			continue
+		}
+		if exists && f.Mode.IsDir() != other.Mode.IsDir() {
+			err = removeAll(dst, other, existing)
+			if err != nil {
+				return err
+			}
+		}
+
+		var (
+			sig   *Signature
+			delta []DeltaOp
+		)
+		if !f.Mode.IsDir() {
+			sig, err = dst.Signature(f.Path)
+			if err != nil {
+				return fmt.Errorf("cannot compute signature of %s: %w", f.Path, err)
+			}
+			delta, err = src.Delta(f.Path, sig)
+			if err != nil {
+				return fmt.Errorf("cannot compute delta of %s: %w", f.Path, err)
+			}
+		}
+		err = dst.Apply(f, sig, delta)
+		if err != nil {
+			return fmt.Errorf("cannot update %s: %w", f.Path, err)
+		}
+		if opts.Progress != nil {
+			opts.Progress("update", f)
+		}
+	}
+
+	if !opts.Delete {
+		return nil
+	}
+	var obsolete []File
+	for _, f := range existing {
+		obsolete = append(obsolete, f)
+	}
+	// remove children before their parents
+	sort.Slice(obsolete, func(i, j int) bool { return obsolete[i].Path > obsolete[j].Path })
+	for _, f := range obsolete {
+		err = dst.Remove(f.Path)
+		if err != nil {
+			return fmt.Errorf("cannot remove %s: %w", f.Path, err)
+		}
+		if opts.Progress != nil {
+			opts.Progress("remove", f)
+		}
+	}
+	return nil
+}
+
+// removeAll removes f and, if it is a directory, everything below it from dst and existing
+func removeAll(dst Endpoint, f File, existing map[string]File) error {
+	var children []string
+	for p := range existing {
+		if strings.HasPrefix(p, f.Path+"/") {
+			children = append(children, p)
+			delete(existing, p)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(children)))
+	for _, c := range append(children, f.Path) {
+		err := dst.Remove(c)
+		if err != nil {
+			return fmt.Errorf("cannot remove %s: %w", c, err)
+		}
+	}
+	return nil
+}
+
+// Dir is an Endpoint backed by a local directory.
+type Dir struct {
+	Root string
+}
+
+var _ Endpoint = Dir{}
+
+// resolve returns the local path of a slash separated path relative to the root. It refuses paths which leave the root.
+func (d Dir) resolve(p string) (string, error) {
+	fn := filepath.FromSlash(p)
+	if !filepath.IsLocal(fn) {
+		return "", fmt.Errorf("invalid path %q", p)
+	}
+	return filepath.Join(d.Root, fn), nil
+}
+
+// List implements Endpoint. We skip symlinks and other special files.
+func (d Dir) List(excludes []string) ([]File, error) {
+	if _, err := os.Stat(d.Root); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	var res []File
+	err := filepath.WalkDir(d.Root, func(fn string, e fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if fn == d.Root {
+			return nil
+		}
+		rel, err := filepath.Rel(d.Root, fn)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if isExcluded(rel, excludes) {
+			if e.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !e.IsDir() && !e.Type().IsRegular() {
+			return nil
+		}
+		info, err := e.Info()
+		if err != nil {
+			return err
+		}
+		res = append(res, File{
+			Path:    rel,
+			Mode:    info.Mode() & (fs.ModeDir | fs.ModePerm),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+		return nil
+	})
+	return res, err
+}
+
+func isExcluded(p string, excludes []string) bool {
+	for _, pattern := range excludes {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, path.Base(p)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Signature implements Endpoint.
+func (d Dir) Signature(p string) (*Signature, error) {
+	fn, err := d.resolve(p)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(fn)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return computeSignature(f)
+}
+
+// Delta implements Endpoint.
+func (d Dir) Delta(p string, sig *Signature) ([]DeltaOp, error) {
+	fn, err := d.resolve(p)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+	return computeDelta(data, sig), nil
+}
+
+// Apply implements Endpoint. Files are replaced atomically, so that a failed sync never leaves half written files behind.
+func (d Dir) Apply(f File, sig *Signature, delta []DeltaOp) error {
+	fn, err := d.resolve(f.Path)
+	if err != nil {
+		return err
+	}
+	if f.Mode.IsDir() {
+		err = os.MkdirAll(fn, f.Mode.Perm()|0700)
+		if err != nil {
+			return err
+		}
+		return os.Chmod(fn, f.Mode.Perm()|0700)
+	}
+
+	err = os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		return err
+	}
+	var basis io.ReaderAt
+	if sig != nil {
+		b, err := os.Open(fn)
+		if err != nil {
+			return err
+		}
+		defer b.Close()
+		basis = b
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fn), "."+filepath.Base(fn)+".sync-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	err = applyDelta(tmp, basis, sig, delta)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(tmp.Name(), f.Mode.Perm())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(tmp.Name(), f.ModTime, f.ModTime)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), fn)
+}
+
+// Remove implements Endpoint.
+func (d Dir) Remove(p string) error {
+	fn, err := d.resolve(p)
+	if err != nil {
+		return err
+	}
+	return os.Remove(fn)
+}
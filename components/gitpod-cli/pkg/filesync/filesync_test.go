@@ -0,0 +1,242 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package filesync
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDelta(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	random := func(n int) []byte {
+		res := make([]byte, n)
+		_, _ = rnd.Read(res)
+		return res
+	}
+	concat := func(b ...[]byte) []byte {
+		return bytes.Join(b, nil)
+	}
+	var (
+		a = random(3 * blockSize)
+		b = random(blockSize / 2)
+	)
+
+	tests := []struct {
+		Name     string
+		Basis    []byte
+		Target   []byte
+		Literals int
+	}{
+		{Name: "new file", Target: a, Literals: len(a)},
+		{Name: "unchanged", Basis: a, Target: a},
+		{Name: "appended", Basis: a, Target: concat(a, b), Literals: len(b)},
+		{Name: "prepended", Basis: a, Target: concat(b, a), Literals: len(b)},
+		{Name: "inserted", Basis: a, Target: concat(a[:blockSize], b, a[blockSize:]), Literals: len(b)},
+		{Name: "short last block", Basis: concat(a, b), Target: concat(b, a, b), Literals: len(b)},
+		{Name: "truncated", Basis: concat(a, b), Target: a},
+		{Name: "empty", Basis: a},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var sig *Signature
+			if test.Basis != nil {
+				var err error
+				sig, err = computeSignature(bytes.NewReader(test.Basis))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			delta := computeDelta(test.Target, sig)
+			var literals int
+			for _, op := range delta {
+				literals += len(op.Data)
+			}
+			if literals != test.Literals {
+				t.Errorf("unexpected literal data: want %d bytes, got %d bytes", test.Literals, literals)
+			}
+
+			var act bytes.Buffer
+			err := applyDelta(&act, bytes.NewReader(test.Basis), sig, delta)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(act.Bytes(), test.Target) {
+				t.Error("applying the delta does not produce the target")
+			}
+		})
+	}
+}
+
+func TestSync(t *testing.T) {
+	type Expectation map[string]string
+
+	mtime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	write := func(t *testing.T, root string, files map[string]string) {
+		for fn, content := range files {
+			fn = filepath.Join(root, fn)
+			err := os.MkdirAll(filepath.Dir(fn), 0755)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = os.WriteFile(fn, []byte(content), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = os.Chtimes(fn, mtime, mtime)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	read := func(t *testing.T, root string) Expectation {
+		res := make(Expectation)
+		err := filepath.WalkDir(root, func(fn string, e os.DirEntry, err error) error {
+			if err != nil || e.IsDir() {
+				return err
+			}
+			content, err := os.ReadFile(fn)
+			if err != nil {
+				return err
+			}
+			rel, _ := filepath.Rel(root, fn)
+			res[filepath.ToSlash(rel)] = string(content)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	tests := []struct {
+		Name        string
+		Src         map[string]string
+		Dst         map[string]string
+		Options     Options
+		Expectation Expectation
+		// Back is what we expect when syncing the destination back into an empty directory, if it differs from Expectation
+		Back Expectation
+	}{
+		{
+			Name:        "empty destination",
+			Src:         map[string]string{"a.txt": "a", "dir/b.txt": "b"},
+			Expectation: Expectation{"a.txt": "a", "dir/b.txt": "b"},
+		},
+		{
+			Name:        "changed file",
+			Src:         map[string]string{"a.txt": "new content"},
+			Dst:         map[string]string{"a.txt": "old"},
+			Expectation: Expectation{"a.txt": "new content"},
+		},
+		{
+			Name:        "keeps other files",
+			Src:         map[string]string{"a.txt": "a"},
+			Dst:         map[string]string{"b.txt": "b"},
+			Expectation: Expectation{"a.txt": "a", "b.txt": "b"},
+		},
+		{
+			Name:        "delete",
+			Src:         map[string]string{"a.txt": "a"},
+			Dst:         map[string]string{"b.txt": "b", "dir/c.txt": "c"},
+			Options:     Options{Delete: true},
+			Expectation: Expectation{"a.txt": "a"},
+		},
+		{
+			Name:        "directory replaces file",
+			Src:         map[string]string{"a/b.txt": "b"},
+			Dst:         map[string]string{"a": "a"},
+			Options:     Options{Delete: true},
+			Expectation: Expectation{"a/b.txt": "b"},
+		},
+		{
+			Name:        "file replaces directory",
+			Src:         map[string]string{"a": "a"},
+			Dst:         map[string]string{"a/b.txt": "b"},
+			Expectation: Expectation{"a": "a"},
+		},
+		{
+			Name:        "excludes",
+			Src:         map[string]string{"a.txt": "a", "node_modules/b.js": "b", "dir/c.log": "c"},
+			Dst:         map[string]string{"d.log": "d"},
+			Options:     Options{Delete: true, Excludes: []string{"node_modules", "*.log"}},
+			Expectation: Expectation{"a.txt": "a", "d.log": "d"},
+			Back:        Expectation{"a.txt": "a"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				src = t.TempDir()
+				dst = t.TempDir()
+			)
+			write(t, src, test.Src)
+			write(t, dst, test.Dst)
+
+			// we sync into the remote, and back
+			var (
+				clientR, serverW = io.Pipe()
+				serverR, clientW = io.Pipe()
+				served           = make(chan error, 1)
+			)
+			go func() {
+				served <- Serve(serverR, serverW, filepath.Dir(dst))
+			}()
+			remote, err := OpenRemote(clientR, clientW, filepath.Base(dst))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = Sync(Dir{Root: src}, remote, test.Options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, read(t, dst)); diff != "" {
+				t.Errorf("unexpected destination (-want +got):\n%s", diff)
+			}
+
+			back := t.TempDir()
+			err = Sync(remote, Dir{Root: back}, test.Options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectBack := test.Back
+			if expectBack == nil {
+				expectBack = test.Expectation
+			}
+			if diff := cmp.Diff(expectBack, read(t, back)); diff != "" {
+				t.Errorf("unexpected result of syncing back (-want +got):\n%s", diff)
+			}
+
+			clientW.Close()
+			if err := <-served; err != nil {
+				t.Errorf("unexpected server error: %v", err)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	d := Dir{Root: "/workspace"}
+	for _, p := range []string{"../etc/passwd", "/etc/passwd", "a/../../b", ""} {
+		if _, err := d.resolve(p); err == nil {
+			t.Errorf("resolve(%q) must fail", p)
+		}
+	}
+	act, err := d.resolve("a/b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act != "/workspace/a/b" {
+		t.Errorf("unexpected path: %s", act)
+	}
+}
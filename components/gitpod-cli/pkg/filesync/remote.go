@@ -0,0 +1,210 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package filesync
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// protocolVersion is sent by the client first, so that we can evolve the protocol
+const protocolVersion = 1
+
+type request struct {
+	Op        string
+	Root      string
+	Path      string
+	Excludes  []string
+	File      File
+	Signature *Signature
+	Delta     []DeltaOp
+}
+
+type response struct {
+	Error     string
+	Files     []File
+	Signature *Signature
+	Delta     []DeltaOp
+}
+
+const (
+	opOpen      = "open"
+	opList      = "list"
+	opSignature = "signature"
+	opDelta     = "delta"
+	opApply     = "apply"
+	opRemove    = "remove"
+)
+
+// Serve answers the requests of a Remote read from r until r is closed. This is what runs on the other end of the SSH connection.
+// Relative roots are resolved against workDir.
+func Serve(r io.Reader, w io.Writer, workDir string) error {
+	var (
+		dec = gob.NewDecoder(r)
+		enc = gob.NewEncoder(w)
+		dir *Dir
+	)
+	var version int
+	err := dec.Decode(&version)
+	if err != nil {
+		return err
+	}
+	if version != protocolVersion {
+		return enc.Encode(response{Error: fmt.Sprintf("unsupported protocol version %d", version)})
+	}
+	err = enc.Encode(response{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		var req request
+		err := dec.Decode(&req)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var resp response
+		if req.Op != opOpen && dir == nil {
+			resp.Error = "no directory opened"
+		} else {
+			switch req.Op {
+			case opOpen:
+				root := req.Root
+				if !filepath.IsAbs(root) {
+					root = filepath.Join(workDir, root)
+				}
+				dir = &Dir{Root: filepath.Clean(root)}
+			case opList:
+				resp.Files, err = dir.List(req.Excludes)
+			case opSignature:
+				resp.Signature, err = dir.Signature(req.Path)
+			case opDelta:
+				resp.Delta, err = dir.Delta(req.Path, req.Signature)
+			case opApply:
+				err = dir.Apply(req.File, req.Signature, req.Delta)
+			case opRemove:
+				err = dir.Remove(req.Path)
+			default:
+				err = fmt.Errorf("unknown operation %q", req.Op)
+			}
+			if err != nil {
+				resp.Error = err.Error()
+			}
+		}
+
+		err = enc.Encode(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Remote is an Endpoint on the other end of a connection to Serve.
+type Remote struct {
+	enc *gob.Encoder
+	dec *gob.Decoder
+}
+
+var _ Endpoint = &Remote{}
+
+// OpenRemote opens the directory root on the server at the other end of r and w.
+func OpenRemote(r io.Reader, w io.Writer, root string) (*Remote, error) {
+	res := &Remote{
+		enc: gob.NewEncoder(w),
+		dec: gob.NewDecoder(r),
+	}
+	err := res.enc.Encode(protocolVersion)
+	if err != nil {
+		return nil, err
+	}
+	var resp response
+	err = res.dec.Decode(&resp)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to sync server: %w", err)
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+
+	_, err = res.call(request{Op: opOpen, Root: root})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Remote) call(req request) (*response, error) {
+	err := r.enc.Encode(req)
+	if err != nil {
+		return nil, err
+	}
+	var resp response
+	err = r.dec.Decode(&resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+	return &resp, nil
+}
+
+// List implements Endpoint.
+func (r *Remote) List(excludes []string) ([]File, error) {
+	resp, err := r.call(request{Op: opList, Excludes: excludes})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Files, nil
+}
+
+// Signature implements Endpoint.
+func (r *Remote) Signature(path string) (*Signature, error) {
+	resp, err := r.call(request{Op: opSignature, Path: path})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Signature, nil
+}
+
+// Delta implements Endpoint.
+func (r *Remote) Delta(path string, sig *Signature) ([]DeltaOp, error) {
+	resp, err := r.call(request{Op: opDelta, Path: path, Signature: sig})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Delta, nil
+}
+
+// Apply implements Endpoint.
+func (r *Remote) Apply(f File, sig *Signature, delta []DeltaOp) error {
+	_, err := r.call(request{Op: opApply, File: f, Signature: sig, Delta: delta})
+	return err
+}
+
+// Remove implements Endpoint.
+func (r *Remote) Remove(path string) error {
+	_, err := r.call(request{Op: opRemove, Path: path})
+	return err
+}
+
+// WorkDir returns the directory relative roots are resolved against when serving.
+func WorkDir() string {
+	if wd := os.Getenv("GITPOD_REPO_ROOT"); wd != "" {
+		return wd
+	}
+	if wd, err := os.UserHomeDir(); err == nil {
+		return wd
+	}
+	return "/"
+}
@@ -4,7 +4,7 @@
  * See License.AGPL.txt in the project root for license information.
  */
 
-import { OrgMemberRole, OrganizationSettings } from "@gitpod/gitpod-protocol";
+import { CommandPolicy, OrgMemberRole, OrganizationSettings } from "@gitpod/gitpod-protocol";
 import { Entity, Column, PrimaryColumn } from "typeorm";
 import { TypeORM } from "../typeorm";
 
@@ -24,6 +24,9 @@ export class DBOrgSettings implements OrganizationSettings {
     @Column("json", { nullable: true })
     allowedWorkspaceClasses?: string[] | null;
 
+    @Column("varchar", { nullable: true })
+    defaultWorkspaceClass?: string | null;
+
     @Column("json", { nullable: true })
     pinnedEditorVersions?: { [key: string]: string } | null;
 
@@ -33,6 +36,9 @@ export class DBOrgSettings implements OrganizationSettings {
     @Column("varchar", { nullable: true })
     defaultRole?: OrgMemberRole | undefined;
 
+    @Column("json", { nullable: true })
+    commandPolicy?: CommandPolicy | null;
+
     @Column()
     deleted: boolean;
 }
@@ -77,6 +77,9 @@ type GitpodConfig struct {
 	// Configure VS Code integration
 	Vscode *Vscode `yaml:"vscode,omitempty" json:"vscode,omitempty"`
 
+	// List of warmup commands which prepare caches for language servers and build tools. They run during prebuilds and their caches are restored when a workspace is started from the prebuild.
+	Warmup []*WarmupItems `yaml:"warmup,omitempty" json:"warmup,omitempty"`
+
 	// Path to where the IDE's workspace should be opened. Supports vscode's `*.code-workspace` files.
 	WorkspaceLocation string `yaml:"workspaceLocation,omitempty" json:"workspaceLocation,omitempty"`
 }
@@ -207,6 +210,9 @@ type TasksItems struct {
 	// A shell command to run between `before` and the main `command`. This command is executed only on after initializing a workspace with a fresh clone, but not on restarts and snapshots. This command is expected to terminate. If it fails, the `command` property will not be executed.
 	Init string `yaml:"init,omitempty" json:"init,omitempty"`
 
+	// Runs the task once for every combination of the given environment variable values, e.g. `SERVICE: [api, web]`. Each instance opens its own terminal with the values set in its environment.
+	Matrix map[string][]interface{} `yaml:"matrix,omitempty" json:"matrix,omitempty"`
+
 	// Name of the task. Shown on the tab of the opened terminal.
 	Name string `yaml:"name,omitempty" json:"name,omitempty"`
 
@@ -226,3 +232,16 @@ type Vscode struct {
 	// List of extensions which should be installed for users of this workspace. The identifier of an extension is always '${publisher}.${name}'. For example: 'vscode.csharp'.
 	Extensions []string `yaml:"extensions,omitempty" json:"extensions,omitempty"`
 }
+
+// WarmupItems
+type WarmupItems struct {
+
+	// Paths outside of `/workspace` which hold the results of the warmup command, e.g. `~/.cache/go-build` or `~/.gradle`. Relative paths are resolved against the repository root.
+	Cache []string `yaml:"cache,omitempty" json:"cache,omitempty"`
+
+	// A shell command which warms up the caches, e.g. `go build ./...` or `./gradlew --dry-run build`. This command is expected to terminate.
+	Command string `yaml:"command" json:"command"`
+
+	// Name of the warmup command, reported by the warmup status.
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+}
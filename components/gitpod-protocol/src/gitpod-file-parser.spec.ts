@@ -141,5 +141,24 @@ gitConfig:
             image: DEFAULT_IMAGE,
         });
     }
+
+    @test public testValidate() {
+        const content = `image: 42\nports:\n  - port: 3000\n    onOpen: nothing\n`;
+
+        const result = this.parser.validate(content);
+        expect(result.diagnostics.map((d) => d.path)).to.deep.equal(["/image", "/ports/0/onOpen"]);
+    }
+
+    @test public testValidateUnparsable() {
+        const result = this.parser.validate(`tasks: [`);
+        expect(result.parsedConfig).to.be.undefined;
+        expect(result.diagnostics).to.have.length(1);
+        expect(result.diagnostics[0].path).to.equal("");
+    }
+
+    @test public testValidateEmpty() {
+        const result = this.parser.validate(``);
+        expect(result.diagnostics).to.be.empty;
+    }
 }
 module.exports = new TestGitpodFileParser(); // Only to circumvent no usage warning :-/
@@ -14,6 +14,7 @@ export type MaybeConfig = WorkspaceConfig | undefined;
 
 const schema = require("../data/gitpod-schema.json");
 const validate = new Ajv().compile(schema as object);
+const validateAll = new Ajv({ allErrors: true, jsonPointers: true }).compile(schema as object);
 const defaultParseOptions = {
     acceptPortRanges: false,
 };
@@ -24,8 +25,44 @@ export interface ParseResult {
     validationErrors?: string[];
 }
 
+export interface ValidationDiagnostic {
+    /**
+     * JSON pointer to the offending element, empty if the problem concerns the file as a whole
+     */
+    path: string;
+    message: string;
+}
+
+export interface ValidationResult {
+    parsedConfig?: WorkspaceConfig;
+    diagnostics: ValidationDiagnostic[];
+}
+
 @injectable()
 export class GitpodFileParser {
+    /**
+     * validate checks the content against the schema and reports all violations, rather than just the first one.
+     */
+    public validate(content: string): ValidationResult {
+        let parsedConfig: any;
+        try {
+            parsedConfig = yaml.safeLoad(content) ?? {};
+        } catch (err) {
+            return {
+                diagnostics: [{ path: "", message: "Unparsable Gitpod configuration: " + err.toString() }],
+            };
+        }
+        validateAll(parsedConfig);
+        const diagnostics = (validateAll.errors || []).map((e) => {
+            let message = e.message || e.keyword;
+            if (e.keyword === "additionalProperties") {
+                message += ": " + (e.params as any).additionalProperty;
+            }
+            return { path: e.dataPath, message };
+        });
+        return { parsedConfig, diagnostics };
+    }
+
     public parse(content: string, parseOptions = {}, defaultConfig: WorkspaceConfig = {}): ParseResult {
         const options = {
             ...defaultParseOptions,
@@ -99,6 +99,8 @@ export interface AdditionalUserData extends Partial<WorkspaceTimeoutSetting> {
     knownGitHubOrgs?: string[];
     // Git clone URL pointing to the user's dotfile repo
     dotfileRepo?: string;
+    // the shell used in the user's workspaces and the profile it sources on start
+    shellSettings?: ShellSettings;
     // preferred workspace classes
     workspaceClasses?: WorkspaceClasses;
     // additional user profile data
@@ -109,6 +111,13 @@ export interface AdditionalUserData extends Partial<WorkspaceTimeoutSetting> {
     workspaceAutostartOptions?: WorkspaceAutostartOption[];
 }
 
+export interface ShellSettings {
+    // name of the shell, one of bash, zsh or fish
+    shell?: string;
+    // snippet the shell sources when it starts, in the syntax of that shell
+    profile?: string;
+}
+
 export interface WorkspaceAutostartOption {
     cloneURL: string;
     organizationId: string;
@@ -793,6 +802,7 @@ export interface WorkspaceConfig {
     image?: ImageConfig;
     ports?: PortConfig[];
     tasks?: TaskConfig[];
+    warmup?: WarmupConfig[];
     checkoutLocation?: string;
     workspaceLocation?: string;
     gitConfig?: { [config: string]: string };
@@ -956,6 +966,7 @@ export interface TaskConfig {
     prebuild?: string;
     command?: string;
     env?: { [env: string]: any };
+    matrix?: { [env: string]: (string | number | boolean)[] };
     openIn?: "bottom" | "main" | "left" | "right";
     openMode?: "split-top" | "split-left" | "split-right" | "split-bottom" | "tab-before" | "tab-after";
 }
@@ -966,6 +977,12 @@ export namespace TaskConfig {
     }
 }
 
+export interface WarmupConfig {
+    name?: string;
+    command: string;
+    cache?: string[];
+}
+
 export namespace WorkspaceImageBuild {
     export type Phase = "BaseImage" | "GitpodLayer" | "Error" | "Done";
     export interface StateInfo {
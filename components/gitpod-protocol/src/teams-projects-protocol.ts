@@ -217,12 +217,33 @@ export interface OrganizationSettings {
     // empty array to allow all kind of workspace classes
     allowedWorkspaceClasses?: string[] | null;
 
+    // null or empty string to use the installation's default workspace class
+    defaultWorkspaceClass?: string | null;
+
     pinnedEditorVersions?: { [key: string]: string } | null;
 
     restrictedEditorNames?: string[] | null;
 
     // what role new members will get, default is "member"
     defaultRole?: OrgMemberRole;
+
+    // null to let tasks run any command
+    commandPolicy?: CommandPolicy | null;
+}
+
+/**
+ * CommandPolicy restricts the commands workspace tasks may run. Supervisor enforces it, see
+ * components/supervisor/pkg/supervisor/commandpolicy.go for the semantics.
+ */
+export interface CommandPolicy {
+    // regular expressions (RE2 syntax) of command lines which must not run
+    deny?: string[];
+    // regular expressions (RE2 syntax) of command lines which may run even though they violate the policy otherwise
+    allow?: string[];
+    // executables which must not run, either by name or by absolute path
+    blockedBinaries?: string[];
+    // only report violations instead of blocking the commands
+    audit?: boolean;
 }
 
 export type TeamMemberRole = OrgMemberRole;
@@ -17,6 +17,15 @@ type ServiceConfiguration struct {
 	Server        *baseserver.Configuration `json:"server,omitempty"`
 	IDEConfigPath string                    `json:"ideConfigPath"`
 	DockerCfg     string                    `json:"dockerCfg"`
+	SettingsSync  *SettingsSyncConfig       `json:"settingsSync,omitempty"`
+}
+
+// SettingsSyncConfig configures the settings sync backend of browser IDEs. Without it, settings sync is disabled.
+type SettingsSyncConfig struct {
+	// Location is the directory the settings sync resources of all users are stored in
+	Location string `json:"location"`
+	// MaxContentSize is the maximum size of a resource in bytes. Zero means no limit.
+	MaxContentSize int `json:"maxContentSize,omitempty"`
 }
 
 func Read(fn string) (*ServiceConfiguration, error) {
@@ -28,6 +28,14 @@ const _ = grpc.SupportPackageIsVersion7
 type IDEServiceClient interface {
 	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
 	ResolveWorkspaceConfig(ctx context.Context, in *ResolveWorkspaceConfigRequest, opts ...grpc.CallOption) (*ResolveWorkspaceConfigResponse, error)
+	// GetSyncManifest returns the latest revision of each settings sync resource of a user
+	GetSyncManifest(ctx context.Context, in *GetSyncManifestRequest, opts ...grpc.CallOption) (*GetSyncManifestResponse, error)
+	// GetSyncResource returns the latest revision of a settings sync resource of a user
+	GetSyncResource(ctx context.Context, in *GetSyncResourceRequest, opts ...grpc.CallOption) (*GetSyncResourceResponse, error)
+	// UpdateSyncResource stores a new revision of a settings sync resource of a user
+	UpdateSyncResource(ctx context.Context, in *UpdateSyncResourceRequest, opts ...grpc.CallOption) (*UpdateSyncResourceResponse, error)
+	// DeleteSyncResources deletes all settings sync resources of a user
+	DeleteSyncResources(ctx context.Context, in *DeleteSyncResourcesRequest, opts ...grpc.CallOption) (*DeleteSyncResourcesResponse, error)
 }
 
 type iDEServiceClient struct {
@@ -56,12 +64,56 @@ func (c *iDEServiceClient) ResolveWorkspaceConfig(ctx context.Context, in *Resol
 	return out, nil
 }
 
+func (c *iDEServiceClient) GetSyncManifest(ctx context.Context, in *GetSyncManifestRequest, opts ...grpc.CallOption) (*GetSyncManifestResponse, error) {
+	out := new(GetSyncManifestResponse)
+	err := c.cc.Invoke(ctx, "/ide_service_api.IDEService/GetSyncManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServiceClient) GetSyncResource(ctx context.Context, in *GetSyncResourceRequest, opts ...grpc.CallOption) (*GetSyncResourceResponse, error) {
+	out := new(GetSyncResourceResponse)
+	err := c.cc.Invoke(ctx, "/ide_service_api.IDEService/GetSyncResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServiceClient) UpdateSyncResource(ctx context.Context, in *UpdateSyncResourceRequest, opts ...grpc.CallOption) (*UpdateSyncResourceResponse, error) {
+	out := new(UpdateSyncResourceResponse)
+	err := c.cc.Invoke(ctx, "/ide_service_api.IDEService/UpdateSyncResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iDEServiceClient) DeleteSyncResources(ctx context.Context, in *DeleteSyncResourcesRequest, opts ...grpc.CallOption) (*DeleteSyncResourcesResponse, error) {
+	out := new(DeleteSyncResourcesResponse)
+	err := c.cc.Invoke(ctx, "/ide_service_api.IDEService/DeleteSyncResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IDEServiceServer is the server API for IDEService service.
 // All implementations must embed UnimplementedIDEServiceServer
 // for forward compatibility
 type IDEServiceServer interface {
 	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
 	ResolveWorkspaceConfig(context.Context, *ResolveWorkspaceConfigRequest) (*ResolveWorkspaceConfigResponse, error)
+	// GetSyncManifest returns the latest revision of each settings sync resource of a user
+	GetSyncManifest(context.Context, *GetSyncManifestRequest) (*GetSyncManifestResponse, error)
+	// GetSyncResource returns the latest revision of a settings sync resource of a user
+	GetSyncResource(context.Context, *GetSyncResourceRequest) (*GetSyncResourceResponse, error)
+	// UpdateSyncResource stores a new revision of a settings sync resource of a user
+	UpdateSyncResource(context.Context, *UpdateSyncResourceRequest) (*UpdateSyncResourceResponse, error)
+	// DeleteSyncResources deletes all settings sync resources of a user
+	DeleteSyncResources(context.Context, *DeleteSyncResourcesRequest) (*DeleteSyncResourcesResponse, error)
 	mustEmbedUnimplementedIDEServiceServer()
 }
 
@@ -75,6 +127,18 @@ func (UnimplementedIDEServiceServer) GetConfig(context.Context, *GetConfigReques
 func (UnimplementedIDEServiceServer) ResolveWorkspaceConfig(context.Context, *ResolveWorkspaceConfigRequest) (*ResolveWorkspaceConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveWorkspaceConfig not implemented")
 }
+func (UnimplementedIDEServiceServer) GetSyncManifest(context.Context, *GetSyncManifestRequest) (*GetSyncManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncManifest not implemented")
+}
+func (UnimplementedIDEServiceServer) GetSyncResource(context.Context, *GetSyncResourceRequest) (*GetSyncResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSyncResource not implemented")
+}
+func (UnimplementedIDEServiceServer) UpdateSyncResource(context.Context, *UpdateSyncResourceRequest) (*UpdateSyncResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSyncResource not implemented")
+}
+func (UnimplementedIDEServiceServer) DeleteSyncResources(context.Context, *DeleteSyncResourcesRequest) (*DeleteSyncResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSyncResources not implemented")
+}
 func (UnimplementedIDEServiceServer) mustEmbedUnimplementedIDEServiceServer() {}
 
 // UnsafeIDEServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -124,6 +188,78 @@ func _IDEService_ResolveWorkspaceConfig_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IDEService_GetSyncManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDEServiceServer).GetSyncManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide_service_api.IDEService/GetSyncManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServiceServer).GetSyncManifest(ctx, req.(*GetSyncManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IDEService_GetSyncResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSyncResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDEServiceServer).GetSyncResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide_service_api.IDEService/GetSyncResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServiceServer).GetSyncResource(ctx, req.(*GetSyncResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IDEService_UpdateSyncResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSyncResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDEServiceServer).UpdateSyncResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide_service_api.IDEService/UpdateSyncResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServiceServer).UpdateSyncResource(ctx, req.(*UpdateSyncResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IDEService_DeleteSyncResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSyncResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IDEServiceServer).DeleteSyncResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ide_service_api.IDEService/DeleteSyncResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IDEServiceServer).DeleteSyncResources(ctx, req.(*DeleteSyncResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IDEService_ServiceDesc is the grpc.ServiceDesc for IDEService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -139,6 +275,22 @@ var IDEService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveWorkspaceConfig",
 			Handler:    _IDEService_ResolveWorkspaceConfig_Handler,
 		},
+		{
+			MethodName: "GetSyncManifest",
+			Handler:    _IDEService_GetSyncManifest_Handler,
+		},
+		{
+			MethodName: "GetSyncResource",
+			Handler:    _IDEService_GetSyncResource_Handler,
+		},
+		{
+			MethodName: "UpdateSyncResource",
+			Handler:    _IDEService_UpdateSyncResource_Handler,
+		},
+		{
+			MethodName: "DeleteSyncResources",
+			Handler:    _IDEService_DeleteSyncResources_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ide.proto",
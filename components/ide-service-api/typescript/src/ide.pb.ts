@@ -96,6 +96,53 @@ export interface ResolveWorkspaceConfigResponse {
   ideSettings: string;
 }
 
+export interface GetSyncManifestRequest {
+  userId: string;
+}
+
+export interface GetSyncManifestResponse {
+  resources: SyncResourceRef[];
+}
+
+/** SyncResourceRef identifies the latest revision of a settings sync resource */
+export interface SyncResourceRef {
+  /** resource is the kind of data VS Code synchronises, e.g. settings, keybindings or globalState */
+  resource: string;
+  ref: string;
+}
+
+export interface GetSyncResourceRequest {
+  userId: string;
+  resource: string;
+}
+
+export interface GetSyncResourceResponse {
+  ref: string;
+  content: string;
+}
+
+export interface UpdateSyncResourceRequest {
+  userId: string;
+  resource: string;
+  content: string;
+  /**
+   * expected_ref is the revision the update is based on. If it isn't the latest revision of the
+   * resource anymore, the update fails with FAILED_PRECONDITION. It's empty for the first revision.
+   */
+  expectedRef: string;
+}
+
+export interface UpdateSyncResourceResponse {
+  ref: string;
+}
+
+export interface DeleteSyncResourcesRequest {
+  userId: string;
+}
+
+export interface DeleteSyncResourcesResponse {
+}
+
 function createBaseGetConfigRequest(): GetConfigRequest {
   return { user: undefined };
 }
@@ -634,6 +681,605 @@ export const ResolveWorkspaceConfigResponse = {
   },
 };
 
+function createBaseGetSyncManifestRequest(): GetSyncManifestRequest {
+  return { userId: "" };
+}
+
+export const GetSyncManifestRequest = {
+  encode(message: GetSyncManifestRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.userId !== "") {
+      writer.uint32(10).string(message.userId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetSyncManifestRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetSyncManifestRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetSyncManifestRequest {
+    return { userId: isSet(object.userId) ? String(object.userId) : "" };
+  },
+
+  toJSON(message: GetSyncManifestRequest): unknown {
+    const obj: any = {};
+    if (message.userId !== "") {
+      obj.userId = message.userId;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetSyncManifestRequest>): GetSyncManifestRequest {
+    return GetSyncManifestRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetSyncManifestRequest>): GetSyncManifestRequest {
+    const message = createBaseGetSyncManifestRequest();
+    message.userId = object.userId ?? "";
+    return message;
+  },
+};
+
+function createBaseGetSyncManifestResponse(): GetSyncManifestResponse {
+  return { resources: [] };
+}
+
+export const GetSyncManifestResponse = {
+  encode(message: GetSyncManifestResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.resources) {
+      SyncResourceRef.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetSyncManifestResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetSyncManifestResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.resources.push(SyncResourceRef.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetSyncManifestResponse {
+    return {
+      resources: Array.isArray(object?.resources) ? object.resources.map((e: any) => SyncResourceRef.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: GetSyncManifestResponse): unknown {
+    const obj: any = {};
+    if (message.resources?.length) {
+      obj.resources = message.resources.map((e) => SyncResourceRef.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetSyncManifestResponse>): GetSyncManifestResponse {
+    return GetSyncManifestResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetSyncManifestResponse>): GetSyncManifestResponse {
+    const message = createBaseGetSyncManifestResponse();
+    message.resources = object.resources?.map((e) => SyncResourceRef.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSyncResourceRef(): SyncResourceRef {
+  return { resource: "", ref: "" };
+}
+
+export const SyncResourceRef = {
+  encode(message: SyncResourceRef, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.resource !== "") {
+      writer.uint32(10).string(message.resource);
+    }
+    if (message.ref !== "") {
+      writer.uint32(18).string(message.ref);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SyncResourceRef {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSyncResourceRef();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.resource = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ref = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SyncResourceRef {
+    return {
+      resource: isSet(object.resource) ? String(object.resource) : "",
+      ref: isSet(object.ref) ? String(object.ref) : "",
+    };
+  },
+
+  toJSON(message: SyncResourceRef): unknown {
+    const obj: any = {};
+    if (message.resource !== "") {
+      obj.resource = message.resource;
+    }
+    if (message.ref !== "") {
+      obj.ref = message.ref;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SyncResourceRef>): SyncResourceRef {
+    return SyncResourceRef.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SyncResourceRef>): SyncResourceRef {
+    const message = createBaseSyncResourceRef();
+    message.resource = object.resource ?? "";
+    message.ref = object.ref ?? "";
+    return message;
+  },
+};
+
+function createBaseGetSyncResourceRequest(): GetSyncResourceRequest {
+  return { userId: "", resource: "" };
+}
+
+export const GetSyncResourceRequest = {
+  encode(message: GetSyncResourceRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.userId !== "") {
+      writer.uint32(10).string(message.userId);
+    }
+    if (message.resource !== "") {
+      writer.uint32(18).string(message.resource);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetSyncResourceRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetSyncResourceRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.resource = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetSyncResourceRequest {
+    return {
+      userId: isSet(object.userId) ? String(object.userId) : "",
+      resource: isSet(object.resource) ? String(object.resource) : "",
+    };
+  },
+
+  toJSON(message: GetSyncResourceRequest): unknown {
+    const obj: any = {};
+    if (message.userId !== "") {
+      obj.userId = message.userId;
+    }
+    if (message.resource !== "") {
+      obj.resource = message.resource;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetSyncResourceRequest>): GetSyncResourceRequest {
+    return GetSyncResourceRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetSyncResourceRequest>): GetSyncResourceRequest {
+    const message = createBaseGetSyncResourceRequest();
+    message.userId = object.userId ?? "";
+    message.resource = object.resource ?? "";
+    return message;
+  },
+};
+
+function createBaseGetSyncResourceResponse(): GetSyncResourceResponse {
+  return { ref: "", content: "" };
+}
+
+export const GetSyncResourceResponse = {
+  encode(message: GetSyncResourceResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.ref !== "") {
+      writer.uint32(10).string(message.ref);
+    }
+    if (message.content !== "") {
+      writer.uint32(18).string(message.content);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetSyncResourceResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetSyncResourceResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.ref = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetSyncResourceResponse {
+    return {
+      ref: isSet(object.ref) ? String(object.ref) : "",
+      content: isSet(object.content) ? String(object.content) : "",
+    };
+  },
+
+  toJSON(message: GetSyncResourceResponse): unknown {
+    const obj: any = {};
+    if (message.ref !== "") {
+      obj.ref = message.ref;
+    }
+    if (message.content !== "") {
+      obj.content = message.content;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetSyncResourceResponse>): GetSyncResourceResponse {
+    return GetSyncResourceResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetSyncResourceResponse>): GetSyncResourceResponse {
+    const message = createBaseGetSyncResourceResponse();
+    message.ref = object.ref ?? "";
+    message.content = object.content ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdateSyncResourceRequest(): UpdateSyncResourceRequest {
+  return { userId: "", resource: "", content: "", expectedRef: "" };
+}
+
+export const UpdateSyncResourceRequest = {
+  encode(message: UpdateSyncResourceRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.userId !== "") {
+      writer.uint32(10).string(message.userId);
+    }
+    if (message.resource !== "") {
+      writer.uint32(18).string(message.resource);
+    }
+    if (message.content !== "") {
+      writer.uint32(26).string(message.content);
+    }
+    if (message.expectedRef !== "") {
+      writer.uint32(34).string(message.expectedRef);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateSyncResourceRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateSyncResourceRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.resource = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expectedRef = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateSyncResourceRequest {
+    return {
+      userId: isSet(object.userId) ? String(object.userId) : "",
+      resource: isSet(object.resource) ? String(object.resource) : "",
+      content: isSet(object.content) ? String(object.content) : "",
+      expectedRef: isSet(object.expectedRef) ? String(object.expectedRef) : "",
+    };
+  },
+
+  toJSON(message: UpdateSyncResourceRequest): unknown {
+    const obj: any = {};
+    if (message.userId !== "") {
+      obj.userId = message.userId;
+    }
+    if (message.resource !== "") {
+      obj.resource = message.resource;
+    }
+    if (message.content !== "") {
+      obj.content = message.content;
+    }
+    if (message.expectedRef !== "") {
+      obj.expectedRef = message.expectedRef;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateSyncResourceRequest>): UpdateSyncResourceRequest {
+    return UpdateSyncResourceRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateSyncResourceRequest>): UpdateSyncResourceRequest {
+    const message = createBaseUpdateSyncResourceRequest();
+    message.userId = object.userId ?? "";
+    message.resource = object.resource ?? "";
+    message.content = object.content ?? "";
+    message.expectedRef = object.expectedRef ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdateSyncResourceResponse(): UpdateSyncResourceResponse {
+  return { ref: "" };
+}
+
+export const UpdateSyncResourceResponse = {
+  encode(message: UpdateSyncResourceResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.ref !== "") {
+      writer.uint32(10).string(message.ref);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateSyncResourceResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateSyncResourceResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.ref = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateSyncResourceResponse {
+    return { ref: isSet(object.ref) ? String(object.ref) : "" };
+  },
+
+  toJSON(message: UpdateSyncResourceResponse): unknown {
+    const obj: any = {};
+    if (message.ref !== "") {
+      obj.ref = message.ref;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateSyncResourceResponse>): UpdateSyncResourceResponse {
+    return UpdateSyncResourceResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateSyncResourceResponse>): UpdateSyncResourceResponse {
+    const message = createBaseUpdateSyncResourceResponse();
+    message.ref = object.ref ?? "";
+    return message;
+  },
+};
+
+function createBaseDeleteSyncResourcesRequest(): DeleteSyncResourcesRequest {
+  return { userId: "" };
+}
+
+export const DeleteSyncResourcesRequest = {
+  encode(message: DeleteSyncResourcesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.userId !== "") {
+      writer.uint32(10).string(message.userId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteSyncResourcesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteSyncResourcesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteSyncResourcesRequest {
+    return { userId: isSet(object.userId) ? String(object.userId) : "" };
+  },
+
+  toJSON(message: DeleteSyncResourcesRequest): unknown {
+    const obj: any = {};
+    if (message.userId !== "") {
+      obj.userId = message.userId;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DeleteSyncResourcesRequest>): DeleteSyncResourcesRequest {
+    return DeleteSyncResourcesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteSyncResourcesRequest>): DeleteSyncResourcesRequest {
+    const message = createBaseDeleteSyncResourcesRequest();
+    message.userId = object.userId ?? "";
+    return message;
+  },
+};
+
+function createBaseDeleteSyncResourcesResponse(): DeleteSyncResourcesResponse {
+  return {};
+}
+
+export const DeleteSyncResourcesResponse = {
+  encode(_: DeleteSyncResourcesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteSyncResourcesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteSyncResourcesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): DeleteSyncResourcesResponse {
+    return {};
+  },
+
+  toJSON(_: DeleteSyncResourcesResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<DeleteSyncResourcesResponse>): DeleteSyncResourcesResponse {
+    return DeleteSyncResourcesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<DeleteSyncResourcesResponse>): DeleteSyncResourcesResponse {
+    const message = createBaseDeleteSyncResourcesResponse();
+    return message;
+  },
+};
+
 export type IDEServiceDefinition = typeof IDEServiceDefinition;
 export const IDEServiceDefinition = {
   name: "IDEService",
@@ -655,6 +1301,42 @@ export const IDEServiceDefinition = {
       responseStream: false,
       options: { idempotencyLevel: "IDEMPOTENT" },
     },
+    /** GetSyncManifest returns the latest revision of each settings sync resource of a user */
+    getSyncManifest: {
+      name: "GetSyncManifest",
+      requestType: GetSyncManifestRequest,
+      requestStream: false,
+      responseType: GetSyncManifestResponse,
+      responseStream: false,
+      options: { idempotencyLevel: "IDEMPOTENT" },
+    },
+    /** GetSyncResource returns the latest revision of a settings sync resource of a user */
+    getSyncResource: {
+      name: "GetSyncResource",
+      requestType: GetSyncResourceRequest,
+      requestStream: false,
+      responseType: GetSyncResourceResponse,
+      responseStream: false,
+      options: { idempotencyLevel: "IDEMPOTENT" },
+    },
+    /** UpdateSyncResource stores a new revision of a settings sync resource of a user */
+    updateSyncResource: {
+      name: "UpdateSyncResource",
+      requestType: UpdateSyncResourceRequest,
+      requestStream: false,
+      responseType: UpdateSyncResourceResponse,
+      responseStream: false,
+      options: {},
+    },
+    /** DeleteSyncResources deletes all settings sync resources of a user */
+    deleteSyncResources: {
+      name: "DeleteSyncResources",
+      requestType: DeleteSyncResourcesRequest,
+      requestStream: false,
+      responseType: DeleteSyncResourcesResponse,
+      responseStream: false,
+      options: {},
+    },
   },
 } as const;
 
@@ -664,6 +1346,26 @@ export interface IDEServiceImplementation<CallContextExt = {}> {
     request: ResolveWorkspaceConfigRequest,
     context: CallContext & CallContextExt,
   ): Promise<DeepPartial<ResolveWorkspaceConfigResponse>>;
+  /** GetSyncManifest returns the latest revision of each settings sync resource of a user */
+  getSyncManifest(
+    request: GetSyncManifestRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<GetSyncManifestResponse>>;
+  /** GetSyncResource returns the latest revision of a settings sync resource of a user */
+  getSyncResource(
+    request: GetSyncResourceRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<GetSyncResourceResponse>>;
+  /** UpdateSyncResource stores a new revision of a settings sync resource of a user */
+  updateSyncResource(
+    request: UpdateSyncResourceRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<UpdateSyncResourceResponse>>;
+  /** DeleteSyncResources deletes all settings sync resources of a user */
+  deleteSyncResources(
+    request: DeleteSyncResourcesRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<DeleteSyncResourcesResponse>>;
 }
 
 export interface IDEServiceClient<CallOptionsExt = {}> {
@@ -672,6 +1374,26 @@ export interface IDEServiceClient<CallOptionsExt = {}> {
     request: DeepPartial<ResolveWorkspaceConfigRequest>,
     options?: CallOptions & CallOptionsExt,
   ): Promise<ResolveWorkspaceConfigResponse>;
+  /** GetSyncManifest returns the latest revision of each settings sync resource of a user */
+  getSyncManifest(
+    request: DeepPartial<GetSyncManifestRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<GetSyncManifestResponse>;
+  /** GetSyncResource returns the latest revision of a settings sync resource of a user */
+  getSyncResource(
+    request: DeepPartial<GetSyncResourceRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<GetSyncResourceResponse>;
+  /** UpdateSyncResource stores a new revision of a settings sync resource of a user */
+  updateSyncResource(
+    request: DeepPartial<UpdateSyncResourceRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<UpdateSyncResourceResponse>;
+  /** DeleteSyncResources deletes all settings sync resources of a user */
+  deleteSyncResources(
+    request: DeepPartial<DeleteSyncResourcesRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<DeleteSyncResourcesResponse>;
 }
 
 export interface DataLoaderOptions {
@@ -48,6 +48,7 @@ type IDEServiceServer struct {
 	ideConfigFileName              string
 	experimentsClient              experiments.Client
 	resolver                       ResolverProvider
+	syncStore                      *settingsSyncStore
 
 	api.UnimplementedIDEServiceServer
 }
@@ -164,6 +165,12 @@ func New(cfg *config.ServiceConfiguration, resolver ResolverProvider) *IDEServic
 		experimentsClient: experiments.NewClient(),
 		resolver:          resolver,
 	}
+	if cfg.SettingsSync != nil {
+		s.syncStore, err = newSettingsSyncStore(cfg.SettingsSync)
+		if err != nil {
+			log.WithField("location", cfg.SettingsSync.Location).WithError(err).Fatal("cannot create settings sync store")
+		}
+	}
 	return s
 }
 
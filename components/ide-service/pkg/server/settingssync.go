@@ -0,0 +1,282 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	api "github.com/gitpod-io/gitpod/ide-service-api"
+	"github.com/gitpod-io/gitpod/ide-service-api/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// syncResources are the kinds of data VS Code synchronises
+var syncResources = map[string]struct{}{
+	"settings":    {},
+	"keybindings": {},
+	"snippets":    {},
+	"tasks":       {},
+	"extensions":  {},
+	"globalState": {},
+	"profiles":    {},
+}
+
+// syncResource is the latest revision of a settings sync resource as it is stored on disk
+type syncResource struct {
+	Ref     string `json:"ref"`
+	Content string `json:"content"`
+}
+
+// settingsSyncStore stores the settings sync resources of each user in a directory of its own,
+// one file per resource.
+type settingsSyncStore struct {
+	location       string
+	maxContentSize int
+
+	mu sync.Mutex
+}
+
+func newSettingsSyncStore(cfg *config.SettingsSyncConfig) (*settingsSyncStore, error) {
+	err := os.MkdirAll(cfg.Location, 0755)
+	if err != nil {
+		return nil, err
+	}
+	return &settingsSyncStore{
+		location:       cfg.Location,
+		maxContentSize: cfg.MaxContentSize,
+	}, nil
+}
+
+func (s *settingsSyncStore) userDir(userID string) (string, error) {
+	if userID == "" || userID == "." || userID == ".." || strings.ContainsAny(userID, `/\`) {
+		return "", status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+	return filepath.Join(s.location, userID), nil
+}
+
+func (s *settingsSyncStore) resourceFile(userID, resource string) (string, error) {
+	if _, ok := syncResources[resource]; !ok {
+		return "", status.Errorf(codes.InvalidArgument, "unknown resource %q", resource)
+	}
+	dir, err := s.userDir(userID)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, resource+".json"), nil
+}
+
+func (s *settingsSyncStore) read(fn string) (*syncResource, error) {
+	content, err := os.ReadFile(fn)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot read resource: %v", err)
+	}
+	var res syncResource
+	err = json.Unmarshal(content, &res)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot parse resource: %v", err)
+	}
+	return &res, nil
+}
+
+// Manifest returns the latest revision of each resource of a user, ordered by resource name
+func (s *settingsSyncStore) Manifest(userID string) ([]*api.SyncResourceRef, error) {
+	dir, err := s.userDir(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var refs []*api.SyncResourceRef
+	for resource := range syncResources {
+		res, err := s.read(filepath.Join(dir, resource+".json"))
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			continue
+		}
+		refs = append(refs, &api.SyncResourceRef{Resource: resource, Ref: res.Ref})
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].Resource < refs[j].Resource })
+	return refs, nil
+}
+
+// Get returns the latest revision of a resource
+func (s *settingsSyncStore) Get(userID, resource string) (*syncResource, error) {
+	fn, err := s.resourceFile(userID, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.read(fn)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, status.Errorf(codes.NotFound, "resource %s does not exist", resource)
+	}
+	return res, nil
+}
+
+// Update stores a new revision of a resource if expectedRef is still its latest revision
+func (s *settingsSyncStore) Update(userID, resource, content, expectedRef string) (ref string, err error) {
+	fn, err := s.resourceFile(userID, resource)
+	if err != nil {
+		return "", err
+	}
+	if s.maxContentSize > 0 && len(content) > s.maxContentSize {
+		return "", status.Errorf(codes.InvalidArgument, "content exceeds the maximum size of %d bytes", s.maxContentSize)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	current, err := s.read(fn)
+	if err != nil {
+		return "", err
+	}
+	var currentRef string
+	if current != nil {
+		currentRef = current.Ref
+	}
+	if currentRef != expectedRef {
+		return "", status.Errorf(codes.FailedPrecondition, "resource %s has changed", resource)
+	}
+
+	hash := sha256.Sum256([]byte(content))
+	res := syncResource{
+		Ref:     hex.EncodeToString(hash[:]),
+		Content: content,
+	}
+	if res.Ref == currentRef {
+		return res.Ref, nil
+	}
+
+	err = writeFileAtomically(fn, res)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "cannot write resource: %v", err)
+	}
+	return res.Ref, nil
+}
+
+// DeleteAll deletes all resources of a user
+func (s *settingsSyncStore) DeleteAll(userID string) error {
+	dir, err := s.userDir(userID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err = os.RemoveAll(dir)
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot delete resources: %v", err)
+	}
+	return nil
+}
+
+// writeFileAtomically writes the JSON encoding of v to a temporary file and moves it into place,
+// so that readers never observe a partially written resource.
+func writeFileAtomically(fn string, v interface{}) error {
+	content, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(fn), "."+filepath.Base(fn)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(content)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), fn)
+}
+
+func (s *IDEServiceServer) settingsSync() (*settingsSyncStore, error) {
+	if s.syncStore == nil {
+		return nil, status.Error(codes.Unimplemented, "settings sync is not enabled")
+	}
+	return s.syncStore, nil
+}
+
+func (s *IDEServiceServer) GetSyncManifest(ctx context.Context, req *api.GetSyncManifestRequest) (*api.GetSyncManifestResponse, error) {
+	store, err := s.settingsSync()
+	if err != nil {
+		return nil, err
+	}
+	refs, err := store.Manifest(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetSyncManifestResponse{Resources: refs}, nil
+}
+
+func (s *IDEServiceServer) GetSyncResource(ctx context.Context, req *api.GetSyncResourceRequest) (*api.GetSyncResourceResponse, error) {
+	store, err := s.settingsSync()
+	if err != nil {
+		return nil, err
+	}
+	res, err := store.Get(req.UserId, req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetSyncResourceResponse{Ref: res.Ref, Content: res.Content}, nil
+}
+
+func (s *IDEServiceServer) UpdateSyncResource(ctx context.Context, req *api.UpdateSyncResourceRequest) (*api.UpdateSyncResourceResponse, error) {
+	store, err := s.settingsSync()
+	if err != nil {
+		return nil, err
+	}
+	ref, err := store.Update(req.UserId, req.Resource, req.Content, req.ExpectedRef)
+	if err != nil {
+		return nil, err
+	}
+	return &api.UpdateSyncResourceResponse{Ref: ref}, nil
+}
+
+func (s *IDEServiceServer) DeleteSyncResources(ctx context.Context, req *api.DeleteSyncResourcesRequest) (*api.DeleteSyncResourcesResponse, error) {
+	store, err := s.settingsSync()
+	if err != nil {
+		return nil, err
+	}
+	err = store.DeleteAll(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &api.DeleteSyncResourcesResponse{}, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/ide-service-api/config"
+)
+
+func TestSettingsSyncStore(t *testing.T) {
+	type update struct {
+		UserID      string
+		Resource    string
+		Content     string
+		ExpectedRef string
+		Code        codes.Code
+	}
+	tests := []struct {
+		Name     string
+		Updates  []update
+		Manifest map[string]string
+	}{
+		{
+			Name: "first revision",
+			Updates: []update{
+				{UserID: "foo", Resource: "settings", Content: "{}"},
+			},
+			Manifest: map[string]string{
+				"settings": "44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a",
+			},
+		},
+		{
+			Name: "update latest revision",
+			Updates: []update{
+				{UserID: "foo", Resource: "settings", Content: "{}"},
+				{UserID: "foo", Resource: "settings", Content: "[]", ExpectedRef: "44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a"},
+				{UserID: "foo", Resource: "keybindings", Content: "[]"},
+			},
+			Manifest: map[string]string{
+				"keybindings": "4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+				"settings":    "4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945",
+			},
+		},
+		{
+			Name: "update outdated revision",
+			Updates: []update{
+				{UserID: "foo", Resource: "settings", Content: "{}"},
+				{UserID: "foo", Resource: "settings", Content: "[]", Code: codes.FailedPrecondition},
+			},
+			Manifest: map[string]string{
+				"settings": "44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a",
+			},
+		},
+		{
+			Name: "other user",
+			Updates: []update{
+				{UserID: "bar", Resource: "settings", Content: "{}"},
+			},
+		},
+		{
+			Name: "invalid input",
+			Updates: []update{
+				{UserID: "foo", Resource: "unknown", Content: "{}", Code: codes.InvalidArgument},
+				{UserID: "../foo", Resource: "settings", Content: "{}", Code: codes.InvalidArgument},
+				{UserID: "", Resource: "settings", Content: "{}", Code: codes.InvalidArgument},
+				{UserID: "foo", Resource: "settings", Content: "0123456789abcdef", Code: codes.InvalidArgument},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			store, err := newSettingsSyncStore(&config.SettingsSyncConfig{Location: t.TempDir(), MaxContentSize: 10})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, u := range test.Updates {
+				_, err := store.Update(u.UserID, u.Resource, u.Content, u.ExpectedRef)
+				if code := status.Code(err); code != u.Code {
+					t.Fatalf("unexpected result of updating %s of %q: got %v, want %v: %v", u.Resource, u.UserID, code, u.Code, err)
+				}
+			}
+
+			refs, err := store.Manifest("foo")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var manifest map[string]string
+			for _, ref := range refs {
+				if manifest == nil {
+					manifest = make(map[string]string)
+				}
+				manifest[ref.Resource] = ref.Ref
+			}
+			if !reflect.DeepEqual(test.Manifest, manifest) {
+				t.Errorf("unexpected manifest: got %v, want %v", manifest, test.Manifest)
+			}
+		})
+	}
+}
+
+func TestSettingsSyncStoreDeleteAll(t *testing.T) {
+	store, err := newSettingsSyncStore(&config.SettingsSyncConfig{Location: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ref, err := store.Update("foo", "settings", "{}", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := store.Get("foo", "settings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := (&syncResource{Ref: ref, Content: "{}"}); !reflect.DeepEqual(exp, res) {
+		t.Errorf("unexpected resource: got %+v, want %+v", res, exp)
+	}
+
+	err = store.DeleteAll("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = store.Get("foo", "settings")
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("expected resource to be deleted, got %v", err)
+	}
+}
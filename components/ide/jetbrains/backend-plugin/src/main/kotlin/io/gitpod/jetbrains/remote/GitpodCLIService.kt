@@ -71,7 +71,7 @@ class GitpodCLIService : RestService() {
             val file = parseFilePath(fileStr) ?: return "invalid file"
             val shouldWait = getBooleanParameter("wait", urlDecoder)
             return withClient(request, context) {
-                CommandLineProcessor.doOpenFileOrProject(file, shouldWait).future.await()
+                open(file, shouldWait)
             }
         }
         if (operation == "preview") {
@@ -81,29 +81,7 @@ class GitpodCLIService : RestService() {
             }
 
             return withClient(request, context) { project ->
-                var resolvedUrl = url
-                val uri = URI.create(url)
-                val localHostUriMetadata = LocalHostUri.extractLocalHostUriMetaDataForPortMapping(uri)
-                val gitpodPortForwardingService = serviceOrNull<GitpodPortForwardingService>()
-
-                if (localHostUriMetadata.isPresent && gitpodPortForwardingService != null) {
-                    var localHostUriFromPort = Optional.empty<URI>()
-
-                    application.invokeAndWait {
-                        localHostUriFromPort = gitpodPortForwardingService
-                                .getLocalHostUriFromHostPort(localHostUriMetadata.get().port)
-                    }
-
-                    if (localHostUriFromPort.isPresent) {
-                        resolvedUrl =  localHostUriFromPort.get()
-                                .withPath(uri.path)
-                                .withQuery(uri.query)
-                                .withFragment(uri.fragment)
-                                .toString()
-                    }
-                }
-
-                BrowserUtil.browse(resolvedUrl, project)
+                preview(url, project)
             }
         }
         return "invalid operation"
@@ -111,49 +89,86 @@ class GitpodCLIService : RestService() {
 
     private fun withClient(request: FullHttpRequest, context: ChannelHandlerContext, action: suspend (project: Project?) -> Unit): String? {
         GlobalScope.launch {
+            withClientSession(action)
+            sendOk(request, context)
+        }
+        return null
+    }
+
+    companion object {
+        const val SERVICE_NAME = "gitpod/cli"
+
+        private data class ClientSessionAndProject(val session: ClientSession, val project: Project?)
+
+        private suspend fun getClientSessionAndProjectAsync(): ClientSessionAndProject {
+            val project = RestService.getLastFocusedOrOpenedProject()
+            var session: ClientSession? = null
+            while (session == null) {
+                if (project != null) {
+                    session = ClientSessionsManager.getProjectSessions(project, ClientKind.REMOTE).firstOrNull()
+                }
+                if (session == null) {
+                    session = ClientSessionsManager.getAppSessions(ClientKind.REMOTE).firstOrNull()
+                }
+                if (session == null) {
+                    delay(1000L)
+                }
+            }
+            return ClientSessionAndProject(session, project)
+        }
+
+        /**
+         * Waits for a remote client and runs the action on behalf of it.
+         */
+        suspend fun withClientSession(action: suspend (project: Project?) -> Unit) {
             getClientSessionAndProjectAsync().let { (session, project) ->
                 ClientId.withClientId(session.clientId) {
                     action(project)
-                    sendOk(request, context)
                 }
             }
         }
-        return null
-    }
 
-    private data class ClientSessionAndProject(val session: ClientSession, val project: Project?)
+        suspend fun open(file: Path, shouldWait: Boolean) {
+            CommandLineProcessor.doOpenFileOrProject(file, shouldWait).future.await()
+        }
 
-    private suspend fun getClientSessionAndProjectAsync(): ClientSessionAndProject {
-        val project = getLastFocusedOrOpenedProject()
-        var session: ClientSession? = null
-        while (session == null) {
-            if (project != null) {
-                session = ClientSessionsManager.getProjectSessions(project, ClientKind.REMOTE).firstOrNull()
-            }
-            if (session == null) {
-                session = ClientSessionsManager.getAppSessions(ClientKind.REMOTE).firstOrNull()
-            }
-            if (session == null) {
-                delay(1000L)
+        suspend fun preview(url: String, project: Project?) {
+            var resolvedUrl = url
+            val uri = URI.create(url)
+            val localHostUriMetadata = LocalHostUri.extractLocalHostUriMetaDataForPortMapping(uri)
+            val gitpodPortForwardingService = serviceOrNull<GitpodPortForwardingService>()
+
+            if (localHostUriMetadata.isPresent && gitpodPortForwardingService != null) {
+                var localHostUriFromPort = Optional.empty<URI>()
+
+                application.invokeAndWait {
+                    localHostUriFromPort = gitpodPortForwardingService
+                            .getLocalHostUriFromHostPort(localHostUriMetadata.get().port)
+                }
+
+                if (localHostUriFromPort.isPresent) {
+                    resolvedUrl =  localHostUriFromPort.get()
+                            .withPath(uri.path)
+                            .withQuery(uri.query)
+                            .withFragment(uri.fragment)
+                            .toString()
+                }
             }
+
+            BrowserUtil.browse(resolvedUrl, project)
         }
-        return ClientSessionAndProject(session, project)
-    }
 
-    private fun parseFilePath(path: String): Path? {
-        return try {
-            var file: Path = Path.of(FileUtilRt.toSystemDependentName(path)) // handle paths like '/file/foo\qwe'
-            if (!file.isAbsolute) {
-                file = file.toAbsolutePath()
+        fun parseFilePath(path: String): Path? {
+            return try {
+                var file: Path = Path.of(FileUtilRt.toSystemDependentName(path)) // handle paths like '/file/foo\qwe'
+                if (!file.isAbsolute) {
+                    file = file.toAbsolutePath()
+                }
+                file.normalize()
+            } catch (e: InvalidPathException) {
+                thisLogger().warn("gitpod cli: failed to parse file path:", e)
+                null
             }
-            file.normalize()
-        } catch (e: InvalidPathException) {
-            thisLogger().warn("gitpod cli: failed to parse file path:", e)
-            null
         }
     }
-
-    companion object {
-        const val SERVICE_NAME = "gitpod/cli"
-    }
 }
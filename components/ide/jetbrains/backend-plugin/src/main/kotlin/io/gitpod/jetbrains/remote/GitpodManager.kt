@@ -227,6 +227,82 @@ class GitpodManager : Disposable {
         }
     }
 
+    private val activeJob = GlobalScope.launch {
+        if (application.isHeadlessEnvironment) {
+            return@launch
+        }
+        val notifications = NotificationServiceGrpc.newStub(supervisorChannel)
+        val futureNotifications = NotificationServiceGrpc.newFutureStub(supervisorChannel)
+        while (isActive) {
+            try {
+                val f = CompletableFuture<Void>()
+                notifications.subscribeActive(
+                    SubscribeActiveRequest.newBuilder().build(),
+                    object : ClientResponseObserver<SubscribeActiveRequest, SubscribeActiveResponse> {
+
+                        override fun beforeStart(requestStream: ClientCallStreamObserver<SubscribeActiveRequest>) {
+                            lifetime.onTerminationOrNow {
+                                requestStream.cancel(null, null)
+                            }
+                        }
+
+                        override fun onNext(n: SubscribeActiveResponse) {
+                            GlobalScope.launch {
+                                try {
+                                    handleActiveRequest(n.request)
+                                } catch (t: Throwable) {
+                                    thisLogger().error("gitpod: failed to handle active request: ", t)
+                                }
+                                futureNotifications.notifyActiveRespond(
+                                    NotifyActiveRespondRequest.newBuilder()
+                                        .setRequestId(n.requestId)
+                                        .setResponse(NotifyActiveResponse.newBuilder().build())
+                                        .build()
+                                )
+                            }
+                        }
+
+                        override fun onError(t: Throwable) {
+                            f.completeExceptionally(t)
+                        }
+
+                        override fun onCompleted() {
+                            f.complete(null)
+                        }
+                    })
+                f.await()
+                // another client became active, it is up to that client to handle gp open and gp preview now
+                break
+            } catch (t: Throwable) {
+                if (t is CancellationException) {
+                    throw t
+                }
+                thisLogger().error("gitpod: failed to subscribe as active client: ", t)
+            }
+            delay(1000L)
+        }
+    }
+    init {
+        lifetime.onTerminationOrNow {
+            activeJob.cancel()
+        }
+    }
+
+    private suspend fun handleActiveRequest(request: NotifyActiveRequest) {
+        when (request.actionDataCase) {
+            NotifyActiveRequest.ActionDataCase.OPEN -> GitpodCLIService.withClientSession {
+                for (url in request.open.urlsList) {
+                    val file = GitpodCLIService.parseFilePath(url) ?: continue
+                    GitpodCLIService.open(file, request.open.await)
+                }
+            }
+            NotifyActiveRequest.ActionDataCase.PREVIEW -> GitpodCLIService.withClientSession { project ->
+                GitpodCLIService.preview(request.preview.url, project)
+            }
+            else -> thisLogger().warn("gitpod: unsupported active request: ${request.actionDataCase}")
+        }
+    }
+
     var infoResponse: WorkspaceInfoResponse? = null
     val pendingInfo = CompletableFuture<WorkspaceInfoResponse>()
 
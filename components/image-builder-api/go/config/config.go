@@ -51,6 +51,60 @@ type Configuration struct {
 	SubassemblyBucketName string `json:"subassemblyBucketName,omitempty"`
 	// SubassemblyBucketPrefix configures an optional key prefix used for locating subassemblies in the bucket
 	SubassemblyBucketPrefix string `json:"subassemblyBucketPrefix,omitempty"`
+
+	// Provenance configures the SLSA provenance attestations attached to workspace images
+	Provenance ProvenanceConfig `json:"provenance,omitempty"`
+
+	// ImagePolicy restricts the images workspace images can be built from
+	ImagePolicy ImagePolicyConfig `json:"imagePolicy,omitempty"`
+
+	// ImageGC configures the deletion of workspace images which are not used anymore. It requires the
+	// WorkspaceImageRepository to differ from the BaseImageRepository.
+	ImageGC ImageGCConfig `json:"imageGC,omitempty"`
+}
+
+// ImageGCConfig configures the garbage collection of the WorkspaceImageRepository. Workspace images which have not been
+// referenced by any workspace, prebuild or image build for MaxAge are deleted from the registry.
+type ImageGCConfig struct {
+	// Enabled starts the garbage collection
+	Enabled bool `json:"enabled"`
+
+	// MaxAge is how long a workspace image must not have been referenced before it is deleted, e.g. 720h
+	MaxAge string `json:"maxAge"`
+
+	// Interval is how often the garbage collection runs. Defaults to 6h.
+	Interval string `json:"interval,omitempty"`
+
+	// DryRun only reports the workspace images which would be deleted
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// StateFile persists when workspace images were last referenced. Without it, image-builder starts observing
+	// the references anew whenever it restarts, which postpones the deletion of images accordingly.
+	StateFile string `json:"stateFile,omitempty"`
+}
+
+// ImagePolicyConfig restricts the registries users can use images from, e.g. in their .gitpod.yml or in the FROM
+// instructions of their Dockerfile.
+// Entries are either registry hosts (e.g. docker.io) or repository prefixes (e.g. docker.io/gitpod).
+type ImagePolicyConfig struct {
+	// AllowedRegistries lists the registries images can be used from. All registries are allowed if empty.
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+
+	// DeniedRegistries lists the registries images must not be used from. Takes precedence over AllowedRegistries.
+	DeniedRegistries []string `json:"deniedRegistries,omitempty"`
+
+	// DefaultImage is the default workspace image of the installation. It is always permitted.
+	DefaultImage string `json:"defaultImage,omitempty"`
+}
+
+// ProvenanceConfig configures the generation of build provenance attestations
+type ProvenanceConfig struct {
+	// Enabled makes image-builder push a SLSA provenance attestation for every workspace image it builds.
+	// The attestation is pushed as OCI referrer of the workspace image.
+	Enabled bool `json:"enabled"`
+
+	// BuilderID identifies this builder in the attestation. Defaults to the builder image if empty.
+	BuilderID string `json:"builderID,omitempty"`
 }
 
 type TLS struct {
@@ -45,6 +45,8 @@ func (b *Builder) Build() error {
 		cl       *client.Client
 		teardown func() error = func() error { return nil }
 		err      error
+		// incremental is true if the build context is sent to a buildkit daemon which has seen previous builds
+		incremental bool
 	)
 	if b.Config.ExternalBuildkitd != "" {
 		log.WithField("socketPath", b.Config.ExternalBuildkitd).Info("using external buildkit daemon")
@@ -53,6 +55,8 @@ func (b *Builder) Build() error {
 		if err != nil {
 			log.Warn("cannot connect to node-local buildkitd - falling back to pod-local one")
 			cl, teardown, err = StartBuildkit(buildkitdSocketPath)
+		} else {
+			incremental = true
 		}
 	} else {
 		cl, teardown, err = StartBuildkit(buildkitdSocketPath)
@@ -65,7 +69,7 @@ func (b *Builder) Build() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	err = b.buildBaseLayer(ctx, cl)
+	err = b.buildBaseLayer(ctx, cl, incremental)
 	if err != nil {
 		return err
 	}
@@ -77,13 +81,18 @@ func (b *Builder) Build() error {
 	return nil
 }
 
-func (b *Builder) buildBaseLayer(ctx context.Context, cl *client.Client) error {
+func (b *Builder) buildBaseLayer(ctx context.Context, cl *client.Client, incremental bool) error {
 	if !b.Config.BuildBase {
 		return nil
 	}
 
+	err := checkDockerfileImagePolicy(b.Config.ImagePolicy, b.Config.Dockerfile)
+	if err != nil {
+		return err
+	}
+
 	log.Info("building base image")
-	return buildImage(ctx, b.Config.ContextDir, b.Config.Dockerfile, b.Config.WorkspaceLayerAuth, b.Config.BaseRef)
+	return buildImage(ctx, b.Config.ContextDir, b.Config.Dockerfile, b.Config.WorkspaceLayerAuth, b.Config.BaseRef, incremental)
 }
 
 func (b *Builder) buildWorkspaceImage(ctx context.Context) (err error) {
@@ -94,7 +103,7 @@ func (b *Builder) buildWorkspaceImage(ctx context.Context) (err error) {
 	return crane.Copy(b.Config.BaseRef, b.Config.TargetRef, crane.Insecure, crane.WithJobs(runtime.GOMAXPROCS(0)))
 }
 
-func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target string) (err error) {
+func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target string, incremental bool) (err error) {
 	log.Info("waiting for build context")
 	waitctx, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
@@ -131,6 +140,19 @@ func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target s
 		contextdir = "."
 	}
 
+	dockerfileDir, excludes, err := prepareBuildContext(contextdir, dockerfile)
+	if err != nil {
+		return xerrors.Errorf("cannot prepare build context: %w", err)
+	}
+	if incremental {
+		t0 := time.Now()
+		err = normalizeModTimes(contextdir, excludes)
+		if err != nil {
+			return xerrors.Errorf("cannot prepare build context for incremental transfer: %w", err)
+		}
+		log.WithField("duration", time.Since(t0).String()).Info("prepared build context for incremental transfer")
+	}
+
 	buildctlArgs := []string{
 		// "--debug",
 		"build",
@@ -141,7 +163,7 @@ func buildImage(ctx context.Context, contextDir, dockerfile, authLayer, target s
 		//"--export-cache=type=registry,ref=" + target + "-cache",
 		//"--import-cache=type=registry,ref=" + target + "-cache",
 		"--frontend=dockerfile.v0",
-		"--local=dockerfile=" + filepath.Dir(dockerfile),
+		"--local=dockerfile=" + dockerfileDir,
 		"--opt=filename=" + filepath.Base(dockerfile),
 	}
 
@@ -8,6 +8,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"encoding/base64"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,6 +27,7 @@ type Config struct {
 	Dockerfile         string
 	ContextDir         string
 	ExternalBuildkitd  string
+	ImagePolicy        *ImagePolicy
 	localCacheImport   string
 }
 
@@ -50,6 +52,13 @@ func GetConfigFromEnv() (*Config, error) {
 	if cfg.TargetRef == "" {
 		cfg.TargetRef = "localhost:8080/target:latest"
 	}
+	if policy := os.Getenv("BOB_IMAGE_POLICY"); policy != "" {
+		cfg.ImagePolicy = &ImagePolicy{}
+		err := json.Unmarshal([]byte(policy), cfg.ImagePolicy)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot unmarshal BOB_IMAGE_POLICY: %w", err)
+		}
+	}
 	if cfg.BuildBase {
 		if cfg.Dockerfile == "" {
 			return nil, xerrors.Errorf("When building the base image BOB_DOCKERFILE_PATH is mandatory")
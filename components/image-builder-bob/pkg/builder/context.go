@@ -0,0 +1,189 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/moby/patternmatcher"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const (
+	dockerIgnoreFile = ".dockerignore"
+	// gitpodIgnoreFile lists paths which are excluded from the build context in addition to the .dockerignore file.
+	// It lets repositories slim down the context of their workspace image without affecting other Docker builds.
+	gitpodIgnoreFile = ".gitpodignore"
+)
+
+// stagedDockerfileDir holds the Dockerfile together with the merged ignore file if there is a .gitpodignore file
+var stagedDockerfileDir = "/tmp/bob-dockerfile"
+
+// prepareBuildContext determines the files which are excluded from the build context and returns the directory
+// buildkit should read the Dockerfile from.
+//
+// buildkit reads a Dockerfile-specific ignore file (<Dockerfile>.dockerignore) in favour of the .dockerignore file of
+// the context. If the context has a .gitpodignore file, we place the Dockerfile next to an ignore file which combines
+// both, such that the workspace content itself stays untouched.
+func prepareBuildContext(contextDir, dockerfile string) (dockerfileDir string, excludes []string, err error) {
+	dockerfileDir = filepath.Dir(dockerfile)
+
+	ignoreFile := dockerfile + dockerIgnoreFile
+	if _, err := os.Stat(ignoreFile); err != nil {
+		ignoreFile = filepath.Join(contextDir, dockerIgnoreFile)
+	}
+	excludes, err = readIgnoreFile(ignoreFile)
+	if err != nil {
+		return "", nil, err
+	}
+
+	gitpodExcludes, err := readIgnoreFile(filepath.Join(contextDir, gitpodIgnoreFile))
+	if err != nil {
+		return "", nil, err
+	}
+	if len(gitpodExcludes) == 0 {
+		return dockerfileDir, excludes, nil
+	}
+	excludes = append(excludes, gitpodExcludes...)
+
+	err = os.MkdirAll(stagedDockerfileDir, 0755)
+	if err != nil {
+		return "", nil, xerrors.Errorf("cannot create Dockerfile staging directory: %w", err)
+	}
+	content, err := os.ReadFile(dockerfile)
+	if err != nil {
+		return "", nil, xerrors.Errorf("cannot read Dockerfile: %w", err)
+	}
+	stagedDockerfile := filepath.Join(stagedDockerfileDir, filepath.Base(dockerfile))
+	err = os.WriteFile(stagedDockerfile, content, 0644)
+	if err != nil {
+		return "", nil, xerrors.Errorf("cannot stage Dockerfile: %w", err)
+	}
+	err = os.WriteFile(stagedDockerfile+dockerIgnoreFile, []byte(strings.Join(excludes, "\n")+"\n"), 0644)
+	if err != nil {
+		return "", nil, xerrors.Errorf("cannot write ignore file: %w", err)
+	}
+	log.WithField("patterns", len(gitpodExcludes)).Info("excluding paths of .gitpodignore from the build context")
+
+	return stagedDockerfileDir, excludes, nil
+}
+
+// readIgnoreFile reads the patterns of a .dockerignore-style file. A missing file yields no patterns.
+func readIgnoreFile(fn string) ([]string, error) {
+	f, err := os.Open(fn)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read %s: %w", fn, err)
+	}
+	defer f.Close()
+
+	var res []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		pattern := strings.TrimSpace(scanner.Text())
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+
+		var invert bool
+		if strings.HasPrefix(pattern, "!") {
+			invert = true
+			pattern = strings.TrimSpace(pattern[1:])
+		}
+		if len(pattern) > 0 {
+			pattern = filepath.Clean(pattern)
+			pattern = filepath.ToSlash(pattern)
+			if len(pattern) > 1 && pattern[0] == '/' {
+				pattern = pattern[1:]
+			}
+		}
+		if invert {
+			pattern = "!" + pattern
+		}
+		res = append(res, pattern)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("cannot read %s: %w", fn, err)
+	}
+	return res, nil
+}
+
+// normalizeModTimes derives the modification time of every file in the build context from its content.
+//
+// buildkit transfers a context incrementally: it compares the files against the context it received for the
+// previous build and only sends files whose metadata changed. As every build starts from a fresh checkout,
+// all modification times differ though. Deriving them from the content makes files with unchanged content
+// look unchanged, so that a long-running buildkit daemon receives only the changes since the last build.
+func normalizeModTimes(contextDir string, excludes []string) (err error) {
+	pm, err := patternmatcher.New(excludes)
+	if err != nil {
+		return xerrors.Errorf("invalid ignore pattern: %w", err)
+	}
+
+	return filepath.WalkDir(contextDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(contextDir, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+
+		excluded, err := pm.MatchesOrParentMatches(filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		if excluded {
+			if d.IsDir() && !pm.Exclusions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		mtime, err := contentModTime(path)
+		if err != nil {
+			return err
+		}
+		return os.Chtimes(path, mtime, mtime)
+	})
+}
+
+// contentModTime returns a modification time which is derived from the content of a file
+func contentModTime(fn string) (time.Time, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	if err != nil {
+		return time.Time{}, xerrors.Errorf("cannot hash %s: %w", fn, err)
+	}
+	sum := h.Sum(nil)
+
+	sec := int64(binary.BigEndian.Uint32(sum[0:4]))
+	nsec := int64(binary.BigEndian.Uint32(sum[4:8]) % uint32(time.Second))
+	return time.Unix(sec, nsec), nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPrepareBuildContext(t *testing.T) {
+	tests := []struct {
+		Name                string
+		Files               map[string]string
+		ExpectedExcludes    []string
+		ExpectedIgnoreFile  string
+		ExpectStagedContext bool
+	}{
+		{
+			Name:  "no ignore files",
+			Files: map[string]string{"Dockerfile": "FROM alpine"},
+		},
+		{
+			Name: "dockerignore only",
+			Files: map[string]string{
+				"Dockerfile":    "FROM alpine",
+				".dockerignore": "# build output\nnode_modules\n\n/dist/\n",
+			},
+			ExpectedExcludes: []string{"node_modules", "dist"},
+		},
+		{
+			Name: "gitpodignore",
+			Files: map[string]string{
+				"Dockerfile":    "FROM alpine",
+				".dockerignore": "node_modules",
+				".gitpodignore": "services/*/testdata\n!services/api/testdata",
+			},
+			ExpectedExcludes:    []string{"node_modules", "services/*/testdata", "!services/api/testdata"},
+			ExpectedIgnoreFile:  "node_modules\nservices/*/testdata\n!services/api/testdata\n",
+			ExpectStagedContext: true,
+		},
+		{
+			Name: "dockerfile specific ignore file",
+			Files: map[string]string{
+				"Dockerfile":              "FROM alpine",
+				"Dockerfile.dockerignore": "vendor",
+				".dockerignore":           "node_modules",
+				".gitpodignore":           "docs",
+			},
+			ExpectedExcludes:    []string{"vendor", "docs"},
+			ExpectedIgnoreFile:  "vendor\ndocs\n",
+			ExpectStagedContext: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			contextDir := t.TempDir()
+			stagedDockerfileDir = filepath.Join(t.TempDir(), "staged")
+			for name, content := range test.Files {
+				err := os.WriteFile(filepath.Join(contextDir, name), []byte(content), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			dockerfileDir, excludes, err := prepareBuildContext(contextDir, filepath.Join(contextDir, "Dockerfile"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(test.ExpectedExcludes, excludes) {
+				t.Errorf("unexpected excludes: expected %q, got %q", test.ExpectedExcludes, excludes)
+			}
+			if !test.ExpectStagedContext {
+				if dockerfileDir != contextDir {
+					t.Errorf("expected Dockerfile to be read from %s, got %s", contextDir, dockerfileDir)
+				}
+				return
+			}
+
+			if dockerfileDir != stagedDockerfileDir {
+				t.Fatalf("expected Dockerfile to be staged in %s, got %s", stagedDockerfileDir, dockerfileDir)
+			}
+			dockerfile, err := os.ReadFile(filepath.Join(dockerfileDir, "Dockerfile"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(dockerfile) != test.Files["Dockerfile"] {
+				t.Errorf("unexpected staged Dockerfile: expected %q, got %q", test.Files["Dockerfile"], dockerfile)
+			}
+			ignoreFile, err := os.ReadFile(filepath.Join(dockerfileDir, "Dockerfile.dockerignore"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(ignoreFile) != test.ExpectedIgnoreFile {
+				t.Errorf("unexpected ignore file: expected %q, got %q", test.ExpectedIgnoreFile, ignoreFile)
+			}
+		})
+	}
+}
+
+func TestNormalizeModTimes(t *testing.T) {
+	contextDir := t.TempDir()
+	files := map[string]string{
+		"a.txt":              "hello",
+		"b.txt":              "hello",
+		"c.txt":              "world",
+		"node_modules/x.js":  "hello",
+		"services/api/main":  "hello",
+		"services/api/.keep": "",
+	}
+	for name, content := range files {
+		fn := filepath.Join(contextDir, name)
+		err := os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(fn, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	before, err := os.Stat(filepath.Join(contextDir, "node_modules/x.js"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = normalizeModTimes(contextDir, []string{"node_modules"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	modTime := func(name string) string {
+		stat, err := os.Stat(filepath.Join(contextDir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return stat.ModTime().String()
+	}
+	if modTime("a.txt") != modTime("b.txt") || modTime("a.txt") != modTime("services/api/main") {
+		t.Errorf("files with the same content must have the same modification time")
+	}
+	if modTime("a.txt") == modTime("c.txt") {
+		t.Errorf("files with different content must have different modification times")
+	}
+	if modTime("node_modules/x.js") != before.ModTime().String() {
+		t.Errorf("excluded files must not be touched")
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"bytes"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/docker/distribution/reference"
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+	"github.com/moby/buildkit/frontend/dockerfile/shell"
+	"golang.org/x/xerrors"
+)
+
+// ImagePolicy restricts the registries the images a Dockerfile builds on can come from. image-builder
+// passes the image policy of the installation in BOB_IMAGE_POLICY.
+type ImagePolicy struct {
+	// AllowedRegistries lists the registries images can be used from. All registries are allowed if empty.
+	AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+
+	// DeniedRegistries lists the registries images must not be used from. Takes precedence over AllowedRegistries.
+	DeniedRegistries []string `json:"deniedRegistries,omitempty"`
+
+	// Exempt lists repositories which are permitted regardless of the registries
+	Exempt []string `json:"exempt,omitempty"`
+}
+
+// checkDockerfileImagePolicy makes sure the policy permits all images the Dockerfile pulls: those of
+// FROM instructions, COPY --from and RUN --mount=from= which do not refer to a build stage, and the
+// frontend image of a syntax directive.
+func checkDockerfileImagePolicy(policy *ImagePolicy, dockerfile string) error {
+	if policy == nil {
+		return nil
+	}
+
+	dt, err := os.ReadFile(dockerfile)
+	if err != nil {
+		return xerrors.Errorf("cannot read Dockerfile: %w", err)
+	}
+	images, err := dockerfileImages(dt)
+	if err != nil {
+		return xerrors.Errorf("cannot parse Dockerfile: %w", err)
+	}
+	for _, img := range images {
+		permitted, err := policy.permits(img)
+		if err != nil {
+			return xerrors.Errorf("cannot parse image ref %s: %w", img, err)
+		}
+		if !permitted {
+			return xerrors.Errorf("image %s is not permitted by the image policy of this installation", img)
+		}
+	}
+	return nil
+}
+
+// dockerfileImages returns the images a Dockerfile pulls. Build args are resolved the way buildkit resolves them
+// when no --build-arg is given, i.e. to their default values and the platform of the build.
+func dockerfileImages(dt []byte) ([]string, error) {
+	var images []string
+	if syntax, _, _, ok := parser.DetectSyntax(dt); ok {
+		images = append(images, syntax)
+	}
+
+	ast, err := parser.Parse(bytes.NewReader(dt))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		lex        = shell.NewLex(ast.EscapeToken)
+		args       = platformArgs()
+		stages     int
+		stageNames = make(map[string]struct{})
+		isStage    = func(name string) bool {
+			_, ok := stageNames[strings.ToLower(name)]
+			return ok
+		}
+		// COPY --from and RUN --mount=from= can refer to stages which are defined later on
+		from []string
+	)
+	for _, n := range ast.AST.Children {
+		switch strings.ToLower(n.Value) {
+		case "arg":
+			if stages > 0 {
+				// only ARGs before the first FROM apply to FROM instructions
+				continue
+			}
+			for arg := n.Next; arg != nil; arg = arg.Next {
+				key, value, hasValue := strings.Cut(arg.Value, "=")
+				if _, exists := args[key]; exists && !hasValue {
+					// e.g. ARG TARGETARCH to use the platform of the build
+					continue
+				}
+				value, err = lex.ProcessWordWithMap(value, args)
+				if err != nil {
+					return nil, err
+				}
+				args[key] = value
+			}
+		case "from":
+			if n.Next == nil {
+				return nil, xerrors.Errorf("FROM requires an argument (line %d)", n.StartLine)
+			}
+			base, err := lex.ProcessWordWithMap(n.Next.Value, args)
+			if err != nil {
+				return nil, err
+			}
+			if base == "" {
+				return nil, xerrors.Errorf("base name (%s) should not be blank (line %d)", n.Next.Value, n.StartLine)
+			}
+			if base != "scratch" && !isStage(base) {
+				images = append(images, base)
+			}
+			if as := n.Next.Next; as != nil && strings.EqualFold(as.Value, "as") && as.Next != nil {
+				stageNames[strings.ToLower(as.Next.Value)] = struct{}{}
+			}
+			stages++
+		case "copy":
+			if f, ok := flagValue(n.Flags, "from"); ok {
+				from = append(from, f)
+			}
+		case "run":
+			for _, fl := range n.Flags {
+				mount, ok := strings.CutPrefix(fl, "--mount=")
+				if !ok {
+					continue
+				}
+				for _, field := range strings.Split(mount, ",") {
+					if f, ok := strings.CutPrefix(field, "from="); ok {
+						from = append(from, f)
+					}
+				}
+			}
+		}
+	}
+	for _, f := range from {
+		if f == "" || isStage(f) {
+			continue
+		}
+		if idx, err := strconv.Atoi(f); err == nil && idx >= 0 && idx < stages {
+			continue
+		}
+		images = append(images, f)
+	}
+
+	return images, nil
+}
+
+// flagValue returns the value of an instruction flag, e.g. from for --from=builder
+func flagValue(flags []string, name string) (string, bool) {
+	for _, fl := range flags {
+		if v, ok := strings.CutPrefix(fl, "--"+name+"="); ok {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// platformArgs returns the automatic platform build args buildkit provides
+func platformArgs() map[string]string {
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	return map[string]string{
+		"BUILDPLATFORM":  platform,
+		"BUILDOS":        runtime.GOOS,
+		"BUILDARCH":      runtime.GOARCH,
+		"BUILDVARIANT":   "",
+		"TARGETPLATFORM": platform,
+		"TARGETOS":       runtime.GOOS,
+		"TARGETARCH":     runtime.GOARCH,
+		"TARGETVARIANT":  "",
+	}
+}
+
+// permits returns true if the policy permits the image ref
+func (p *ImagePolicy) permits(ref string) (bool, error) {
+	if len(p.AllowedRegistries) == 0 && len(p.DeniedRegistries) == 0 {
+		return true, nil
+	}
+
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return false, err
+	}
+	name := named.Name()
+
+	for _, e := range p.Exempt {
+		if e == "" {
+			continue
+		}
+		en, err := reference.ParseNormalizedNamed(e)
+		if err != nil {
+			return false, xerrors.Errorf("invalid exempt repository %s: %w", e, err)
+		}
+		if en.Name() == name {
+			return true, nil
+		}
+	}
+
+	if matchesAnyRegistry(p.DeniedRegistries, name) {
+		return false, nil
+	}
+	if len(p.AllowedRegistries) == 0 {
+		return true, nil
+	}
+	return matchesAnyRegistry(p.AllowedRegistries, name), nil
+}
+
+// matchesAnyRegistry returns true if the fully qualified repository name is part of any of the registries,
+// which are either registry hosts or repository prefixes.
+func matchesAnyRegistry(registries []string, name string) bool {
+	for _, r := range registries {
+		r = strings.TrimSuffix(r, "/")
+		if r == "" {
+			continue
+		}
+		if name == r || strings.HasPrefix(name, r+"/") {
+			return true
+		}
+	}
+	return false
+}
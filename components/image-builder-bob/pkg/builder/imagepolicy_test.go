@@ -0,0 +1,136 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package builder
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+func TestDockerfileImages(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Dockerfile  string
+		Expectation []string
+	}{
+		{
+			Name:        "single FROM",
+			Dockerfile:  "FROM gitpod/workspace-full:latest\nRUN echo hello",
+			Expectation: []string{"gitpod/workspace-full:latest"},
+		},
+		{
+			Name:        "syntax directive",
+			Dockerfile:  "# syntax=docker/dockerfile:1\nFROM alpine",
+			Expectation: []string{"docker/dockerfile:1", "alpine"},
+		},
+		{
+			Name:        "build args",
+			Dockerfile:  "ARG REGISTRY=quay.io\nARG IMAGE=${REGISTRY}/foo/bar\nFROM $IMAGE\nARG IGNORED=evil.io/image",
+			Expectation: []string{"quay.io/foo/bar"},
+		},
+		{
+			Name:        "platform args",
+			Dockerfile:  "ARG TARGETARCH\nFROM --platform=$BUILDPLATFORM golang:1.22\nFROM foo/bar-${TARGETARCH}",
+			Expectation: []string{"golang:1.22", "foo/bar-" + runtime.GOARCH},
+		},
+		{
+			Name:        "multi-stage",
+			Dockerfile:  "FROM golang:1.22 AS Builder\nRUN go build\nFROM builder AS test\nFROM scratch\nCOPY --from=builder /app /app\nCOPY --from=0 /src /src",
+			Expectation: []string{"golang:1.22"},
+		},
+		{
+			Name:        "images outside of FROM",
+			Dockerfile:  "FROM alpine\nCOPY --from=evil.io/tools:latest /bin/tool /bin/tool\nRUN --mount=type=bind,from=evil.io/src,target=/src make\nCOPY --from=later /x /x\nFROM alpine AS later",
+			Expectation: []string{"alpine", "alpine", "evil.io/tools:latest", "evil.io/src"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act, err := dockerfileImages([]byte(test.Dockerfile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(act, test.Expectation) {
+				t.Errorf("unexpected images: got %v, want %v", act, test.Expectation)
+			}
+		})
+	}
+}
+
+func TestCheckDockerfileImagePolicy(t *testing.T) {
+	policy := &ImagePolicy{
+		AllowedRegistries: []string{"docker.io/library", "quay.io"},
+		DeniedRegistries:  []string{"quay.io/untrusted"},
+		Exempt:            []string{"gitpod/workspace-full:2024-01-01"},
+	}
+
+	tests := []struct {
+		Name        string
+		Policy      *ImagePolicy
+		Dockerfile  string
+		ExpectError bool
+	}{
+		{
+			Name:       "no policy",
+			Dockerfile: "FROM evil.io/image",
+		},
+		{
+			Name:       "allowed FROM",
+			Policy:     policy,
+			Dockerfile: "FROM alpine\nFROM quay.io/foo/bar",
+		},
+		{
+			Name:       "exempt FROM",
+			Policy:     policy,
+			Dockerfile: "FROM gitpod/workspace-full:latest",
+		},
+		{
+			Name:        "disallowed FROM",
+			Policy:      policy,
+			Dockerfile:  "FROM alpine AS base\nFROM evil.io/image\nCOPY --from=base / /",
+			ExpectError: true,
+		},
+		{
+			Name:        "denied FROM",
+			Policy:      policy,
+			Dockerfile:  "FROM quay.io/untrusted/image",
+			ExpectError: true,
+		},
+		{
+			Name:        "disallowed FROM through build arg",
+			Policy:      policy,
+			Dockerfile:  "ARG BASE=evil.io/image\nFROM ${BASE}",
+			ExpectError: true,
+		},
+		{
+			Name:        "disallowed COPY --from",
+			Policy:      policy,
+			Dockerfile:  "FROM alpine\nCOPY --from=evil.io/image /bin/sh /bin/sh",
+			ExpectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			dockerfile := filepath.Join(t.TempDir(), "Dockerfile")
+			err := os.WriteFile(dockerfile, []byte(test.Dockerfile), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = checkDockerfileImagePolicy(test.Policy, dockerfile)
+			if test.ExpectError && err == nil {
+				t.Error("expected the image policy to reject the Dockerfile")
+			}
+			if !test.ExpectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,364 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/auth"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+)
+
+const defaultImageGCInterval = 6 * time.Hour
+
+// workspaceImageTag matches the tags getWorkspaceImageRef produces. All other tags of the
+// workspace image repository (e.g. referrers tags) are never collected on their own.
+var workspaceImageTag = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// imageUsage records when workspace images were last referenced
+type imageUsage struct {
+	// Since is when we started observing references. Images we have never seen referenced count as used at that time.
+	Since time.Time `json:"since"`
+	// LastUsed maps workspace image tags to the last time they were referenced
+	LastUsed map[string]time.Time `json:"lastUsed"`
+}
+
+// LastSeen returns the last time a workspace image tag was known to be in use
+func (u *imageUsage) LastSeen(tag string) time.Time {
+	if t := u.LastUsed[tag]; t.After(u.Since) {
+		return t
+	}
+	return u.Since
+}
+
+// imageGC deletes workspace images which have not been referenced by any workspace, prebuild or image build for some time
+type imageGC struct {
+	Repository string
+	MaxAge     time.Duration
+	Interval   time.Duration
+	DryRun     bool
+	StateFile  string
+
+	auth    auth.RegistryAuthenticator
+	wsman   wsmanapi.WorkspaceManagerClient
+	metrics *metrics
+	client  *http.Client
+	now     func() time.Time
+
+	usage imageUsage
+	mu    sync.Mutex
+}
+
+func newImageGC(cfg config.Configuration, authentication auth.RegistryAuthenticator, wsman wsmanapi.WorkspaceManagerClient, m *metrics) (*imageGC, error) {
+	if !cfg.ImageGC.Enabled {
+		return nil, nil
+	}
+	if cfg.WorkspaceImageRepository == "" {
+		return nil, xerrors.Errorf("imageGC requires a workspaceImageRepository")
+	}
+	if cfg.WorkspaceImageRepository == cfg.BaseImageRepository {
+		// base images carry the same kind of tags as workspace images and would be collected, too
+		return nil, xerrors.Errorf("imageGC requires the workspaceImageRepository to differ from the baseImageRepository")
+	}
+
+	maxAge, err := time.ParseDuration(cfg.ImageGC.MaxAge)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid imageGC.maxAge: %w", err)
+	}
+	if maxAge <= 0 {
+		return nil, xerrors.Errorf("imageGC.maxAge must be positive")
+	}
+	interval := defaultImageGCInterval
+	if cfg.ImageGC.Interval != "" {
+		interval, err = time.ParseDuration(cfg.ImageGC.Interval)
+		if err != nil {
+			return nil, xerrors.Errorf("invalid imageGC.interval: %w", err)
+		}
+		if interval <= 0 {
+			return nil, xerrors.Errorf("imageGC.interval must be positive")
+		}
+	}
+
+	gc := &imageGC{
+		Repository: cfg.WorkspaceImageRepository,
+		MaxAge:     maxAge,
+		Interval:   interval,
+		DryRun:     cfg.ImageGC.DryRun,
+		StateFile:  cfg.ImageGC.StateFile,
+		auth:       authentication,
+		wsman:      wsman,
+		metrics:    m,
+		now:        time.Now,
+	}
+	err = gc.loadState()
+	if err != nil {
+		return nil, err
+	}
+	return gc, nil
+}
+
+// Touch marks a workspace image as used. It is safe to call on a nil imageGC.
+func (gc *imageGC) Touch(ref string) {
+	if gc == nil {
+		return
+	}
+	tag, ok := strings.CutPrefix(ref, gc.Repository+":")
+	if !ok || !workspaceImageTag.MatchString(tag) {
+		return
+	}
+
+	gc.mu.Lock()
+	gc.usage.LastUsed[tag] = gc.now()
+	gc.mu.Unlock()
+}
+
+// touchWorkspace marks the images a workspace uses, which is its own image and the image a build produces
+func (gc *imageGC) touchWorkspace(status *wsmanapi.WorkspaceStatus) {
+	gc.Touch(status.GetSpec().GetWorkspaceImage())
+	gc.Touch(status.GetMetadata().GetAnnotations()[annotationRef])
+}
+
+func (gc *imageGC) loadState() error {
+	gc.usage = imageUsage{Since: gc.now(), LastUsed: make(map[string]time.Time)}
+	if gc.StateFile == "" {
+		return nil
+	}
+
+	fc, err := os.ReadFile(gc.StateFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot read imageGC state: %w", err)
+	}
+	var usage imageUsage
+	err = json.Unmarshal(fc, &usage)
+	if err != nil {
+		// a broken state only means we start observing anew, which never deletes images prematurely
+		log.WithError(err).WithField("stateFile", gc.StateFile).Warn("cannot parse imageGC state - starting anew")
+		return nil
+	}
+	if usage.LastUsed == nil {
+		usage.LastUsed = make(map[string]time.Time)
+	}
+	gc.usage = usage
+	return nil
+}
+
+func (gc *imageGC) saveState() error {
+	if gc.StateFile == "" {
+		return nil
+	}
+
+	gc.mu.Lock()
+	fc, err := json.Marshal(gc.usage)
+	gc.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(gc.StateFile), ".imagegc-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(fc)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), gc.StateFile)
+}
+
+// Run observes the workspaces and periodically collects the images none of them used for MaxAge
+func (gc *imageGC) Run(ctx context.Context) {
+	log.WithField("repository", gc.Repository).WithField("maxAge", gc.MaxAge).WithField("dryRun", gc.DryRun).Info("starting workspace image garbage collection")
+
+	go gc.watchWorkspaces(ctx)
+
+	ticker := time.NewTicker(gc.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		err := gc.collect(ctx)
+		if err != nil {
+			log.WithError(err).Warn("workspace image garbage collection failed")
+		}
+	}
+}
+
+// watchWorkspaces marks the images of all workspaces as used, regardless of which image-builder replica served them
+func (gc *imageGC) watchWorkspaces(ctx context.Context) {
+	for ctx.Err() == nil {
+		sub, err := gc.wsman.Subscribe(ctx, &wsmanapi.SubscribeRequest{})
+		if err != nil {
+			log.WithError(err).Info("imageGC cannot subscribe to ws-manager - retrying")
+			time.Sleep(5 * time.Second)
+			continue
+		}
+
+		for {
+			msg, err := sub.Recv()
+			if err != nil {
+				log.WithError(err).Info("imageGC lost connection to ws-manager - retrying")
+				time.Sleep(1 * time.Second)
+				break
+			}
+			if status := msg.GetStatus(); status != nil {
+				gc.touchWorkspace(status)
+			}
+		}
+	}
+}
+
+// collect deletes all workspace images which have not been used for MaxAge
+func (gc *imageGC) collect(ctx context.Context) error {
+	// Workspaces which run for a long time might not have sent an update recently.
+	// If we cannot tell which workspaces exist we must not delete anything.
+	wss, err := gc.wsman.GetWorkspaces(ctx, &wsmanapi.GetWorkspacesRequest{})
+	if err != nil {
+		return xerrors.Errorf("cannot get workspaces: %w", err)
+	}
+	for _, ws := range wss.GetStatus() {
+		gc.touchWorkspace(ws)
+	}
+
+	ath, err := auth.AllowedAuthForAll().GetAuthFor(ctx, gc.auth, gc.Repository)
+	if err != nil {
+		return xerrors.Errorf("cannot get registry authentication: %w", err)
+	}
+	reg, err := newRegistryClient(gc.Repository, ath, gc.client)
+	if err != nil {
+		return err
+	}
+
+	tags, err := reg.Tags(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Tags which point to the same manifest are deleted together, hence we may only
+	// delete a manifest if none of its tags has been used.
+	var (
+		deadline  = gc.now().Add(-gc.MaxAge)
+		manifests = make(map[digest.Digest][]string)
+		used      = make(map[digest.Digest]bool)
+	)
+	gc.mu.Lock()
+	usage := imageUsage{Since: gc.usage.Since, LastUsed: make(map[string]time.Time, len(gc.usage.LastUsed))}
+	for tag, t := range gc.usage.LastUsed {
+		usage.LastUsed[tag] = t
+	}
+	gc.mu.Unlock()
+	for _, tag := range tags {
+		if !workspaceImageTag.MatchString(tag) {
+			continue
+		}
+
+		dgst, err := reg.ManifestDigest(ctx, tag)
+		if err != nil {
+			return err
+		}
+		if dgst == "" {
+			continue
+		}
+		manifests[dgst] = append(manifests[dgst], tag)
+		if usage.LastSeen(tag).After(deadline) {
+			used[dgst] = true
+		}
+	}
+
+	var candidates []digest.Digest
+	for dgst := range manifests {
+		if !used[dgst] {
+			candidates = append(candidates, dgst)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+
+	var deleted []string
+	for _, dgst := range candidates {
+		tags := manifests[dgst]
+		entry := log.WithField("digest", dgst).WithField("tags", tags)
+		if gc.DryRun {
+			entry.Info("would delete unused workspace image (dry run)")
+			gc.metrics.ImageCollected(true)
+			continue
+		}
+
+		err = reg.DeleteManifest(ctx, dgst)
+		if errors.Is(err, errDeleteUnsupported) {
+			return xerrors.Errorf("cannot delete workspace images from %s: %w", gc.Repository, err)
+		}
+		if err != nil {
+			entry.WithError(err).Warn("cannot delete unused workspace image")
+			gc.metrics.ImageCollectionFailed()
+			continue
+		}
+		entry.Info("deleted unused workspace image")
+		gc.metrics.ImageCollected(false)
+		deleted = append(deleted, tags...)
+
+		// the provenance of an image is attached using the referrers tag of its manifest
+		gc.deleteReferrers(ctx, reg, dgst)
+	}
+
+	gc.mu.Lock()
+	for _, tag := range deleted {
+		delete(gc.usage.LastUsed, tag)
+	}
+	// images which do not exist anymore can't be referenced
+	existing := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		existing[tag] = struct{}{}
+	}
+	for tag, t := range gc.usage.LastUsed {
+		if _, ok := existing[tag]; !ok && t.Before(deadline) {
+			delete(gc.usage.LastUsed, tag)
+		}
+	}
+	gc.mu.Unlock()
+
+	err = gc.saveState()
+	if err != nil {
+		log.WithError(err).WithField("stateFile", gc.StateFile).Warn("cannot save imageGC state")
+	}
+	return nil
+}
+
+func (gc *imageGC) deleteReferrers(ctx context.Context, reg *registryClient, subject digest.Digest) {
+	referrers, err := reg.ManifestDigest(ctx, subject.Algorithm().String()+"-"+subject.Encoded())
+	if err != nil || referrers == "" {
+		return
+	}
+	err = reg.DeleteManifest(ctx, referrers)
+	if err != nil {
+		log.WithError(err).WithField("digest", subject).Warn("cannot delete referrers of unused workspace image")
+	}
+}
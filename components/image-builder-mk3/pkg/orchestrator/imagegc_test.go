@@ -0,0 +1,300 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	wsmock "github.com/gitpod-io/gitpod/ws-manager/api/mock"
+)
+
+// gcRegistry serves the tags of a single repository. Deleting a manifest removes all of its tags.
+type gcRegistry struct {
+	Tags     map[string]digest.Digest
+	PageSize int
+
+	mu sync.Mutex
+}
+
+func (reg *gcRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v2/workspace-images/")
+	switch {
+	case path == "tags/list" && r.Method == http.MethodGet:
+		var tags []string
+		for tag := range reg.Tags {
+			tags = append(tags, tag)
+		}
+		sort.Strings(tags)
+		if last := r.URL.Query().Get("last"); last != "" {
+			idx := sort.SearchStrings(tags, last)
+			tags = tags[idx+1:]
+		}
+		if len(tags) > reg.PageSize {
+			tags = tags[:reg.PageSize]
+			w.Header().Set("Link", `</v2/workspace-images/tags/list?n=2&last=`+tags[len(tags)-1]+`>; rel="next"`)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": "workspace-images", "tags": tags})
+	case strings.HasPrefix(path, "manifests/") && r.Method == http.MethodHead:
+		dgst, ok := reg.Tags[strings.TrimPrefix(path, "manifests/")]
+		if !ok {
+			http.Error(w, "", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Docker-Content-Digest", dgst.String())
+	case strings.HasPrefix(path, "manifests/") && r.Method == http.MethodDelete:
+		dgst := digest.Digest(strings.TrimPrefix(path, "manifests/"))
+		var found bool
+		for tag, d := range reg.Tags {
+			if d == dgst {
+				delete(reg.Tags, tag)
+				found = true
+			}
+		}
+		if !found {
+			http.Error(w, "", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		http.Error(w, "", http.StatusNotFound)
+	}
+}
+
+func (reg *gcRegistry) tags() []string {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+
+	var res []string
+	for tag := range reg.Tags {
+		res = append(res, tag)
+	}
+	sort.Strings(res)
+	return res
+}
+
+func TestImageGC(t *testing.T) {
+	var (
+		now     = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+		maxAge  = 30 * 24 * time.Hour
+		stale   = now.Add(-maxAge - time.Hour)
+		recent  = now.Add(-time.Hour)
+		used    = strings.Repeat("a", 64)
+		alias   = strings.Repeat("b", 64)
+		unused  = strings.Repeat("c", 64)
+		running = strings.Repeat("d", 64)
+		never   = strings.Repeat("e", 64)
+
+		usedDigest    = digest.FromString("used")
+		unusedDigest  = digest.FromString("unused")
+		runningDigest = digest.FromString("running")
+		neverDigest   = digest.FromString("never")
+	)
+	referrers := unusedDigest.Algorithm().String() + "-" + unusedDigest.Encoded()
+
+	tests := []struct {
+		Name        string
+		DryRun      bool
+		Since       time.Time
+		Expectation []string
+	}{
+		{
+			Name:        "delete",
+			Since:       stale,
+			Expectation: []string{used, alias, running, "latest"},
+		},
+		{
+			Name:        "dry run",
+			DryRun:      true,
+			Since:       stale,
+			Expectation: []string{used, alias, unused, running, never, "latest", referrers},
+		},
+		{
+			Name:        "recently started observing",
+			Since:       recent,
+			Expectation: []string{used, alias, unused, running, never, "latest", referrers},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			reg := &gcRegistry{
+				PageSize: 2,
+				Tags: map[string]digest.Digest{
+					used:      usedDigest,
+					alias:     usedDigest,
+					unused:    unusedDigest,
+					running:   runningDigest,
+					never:     neverDigest,
+					"latest":  digest.FromString("latest"),
+					referrers: digest.FromString("referrers"),
+				},
+			}
+			srv := httptest.NewServer(reg)
+			t.Cleanup(srv.Close)
+			repo := strings.TrimPrefix(srv.URL, "http://") + "/workspace-images"
+
+			ctrl := gomock.NewController(t)
+			wsman := wsmock.NewMockWorkspaceManagerClient(ctrl)
+			wsman.EXPECT().GetWorkspaces(gomock.Any(), gomock.Any()).Return(&wsmanapi.GetWorkspacesResponse{
+				Status: []*wsmanapi.WorkspaceStatus{
+					{Spec: &wsmanapi.WorkspaceSpec{WorkspaceImage: repo + ":" + running}},
+				},
+			}, nil)
+
+			m := newMetrics()
+			gc, err := newImageGC(config.Configuration{
+				BaseImageRepository:      "registry/base-images",
+				WorkspaceImageRepository: repo,
+				ImageGC: config.ImageGCConfig{
+					Enabled:   true,
+					MaxAge:    maxAge.String(),
+					DryRun:    test.DryRun,
+					StateFile: filepath.Join(t.TempDir(), "state.json"),
+				},
+			}, nil, wsman, m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gc.now = func() time.Time { return recent }
+			gc.Touch(repo + ":" + used)
+			gc.Touch("other-registry/workspace-images:" + unused)
+			gc.now = func() time.Time { return now }
+			gc.usage.Since = test.Since
+			gc.usage.LastUsed[unused] = stale
+			gc.usage.LastUsed[alias] = stale
+
+			err = gc.collect(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			exp := append([]string(nil), test.Expectation...)
+			sort.Strings(exp)
+			if diff := cmp.Diff(exp, reg.tags()); diff != "" {
+				t.Errorf("unexpected tags (-want +got):\n%s", diff)
+			}
+			if _, ok := gc.usage.LastUsed[running]; !ok {
+				t.Errorf("image of running workspace was not marked as used")
+			}
+
+			fc, err := os.ReadFile(gc.StateFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var state imageUsage
+			err = json.Unmarshal(fc, &state)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, stillTracked := state.LastUsed[unused]
+			if deleted := !test.DryRun && test.Since.Equal(stale); deleted == stillTracked {
+				t.Errorf("unexpected state for deleted image: tracked=%v", stillTracked)
+			}
+		})
+	}
+}
+
+func TestNewImageGC(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Config   config.ImageGCConfig
+		BaseRepo string
+		Disabled bool
+		Error    bool
+		Interval time.Duration
+	}{
+		{Name: "disabled", Config: config.ImageGCConfig{MaxAge: "invalid"}, Disabled: true},
+		{Name: "default interval", Config: config.ImageGCConfig{Enabled: true, MaxAge: "720h"}, Interval: defaultImageGCInterval},
+		{Name: "interval", Config: config.ImageGCConfig{Enabled: true, MaxAge: "720h", Interval: "1h"}, Interval: time.Hour},
+		{Name: "missing max age", Config: config.ImageGCConfig{Enabled: true}, Error: true},
+		{Name: "negative max age", Config: config.ImageGCConfig{Enabled: true, MaxAge: "-1h"}, Error: true},
+		{Name: "invalid interval", Config: config.ImageGCConfig{Enabled: true, MaxAge: "720h", Interval: "often"}, Error: true},
+		{Name: "shared repository", Config: config.ImageGCConfig{Enabled: true, MaxAge: "720h"}, BaseRepo: "registry/workspace-images", Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			baseRepo := test.BaseRepo
+			if baseRepo == "" {
+				baseRepo = "registry/base-images"
+			}
+			gc, err := newImageGC(config.Configuration{
+				BaseImageRepository:      baseRepo,
+				WorkspaceImageRepository: "registry/workspace-images",
+				ImageGC:                  test.Config,
+			}, nil, nil, newMetrics())
+			if test.Error {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.Disabled {
+				if gc != nil {
+					t.Error("expected no imageGC")
+				}
+				// must not panic
+				gc.Touch("registry/workspace-images:" + strings.Repeat("a", 64))
+				return
+			}
+			if gc.Interval != test.Interval {
+				t.Errorf("unexpected interval: want %v, got %v", test.Interval, gc.Interval)
+			}
+		})
+	}
+}
+
+func TestImageGCState(t *testing.T) {
+	var (
+		fn  = filepath.Join(t.TempDir(), "state.json")
+		cfg = config.Configuration{
+			BaseImageRepository:      "registry/base-images",
+			WorkspaceImageRepository: "registry/workspace-images",
+			ImageGC:                  config.ImageGCConfig{Enabled: true, MaxAge: "720h", StateFile: fn},
+		}
+		tag = strings.Repeat("a", 64)
+	)
+
+	gc, err := newImageGC(cfg, nil, nil, newMetrics())
+	if err != nil {
+		t.Fatal(err)
+	}
+	gc.Touch(cfg.WorkspaceImageRepository + ":" + tag)
+	err = gc.saveState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restarted, err := newImageGC(cfg, nil, nil, newMetrics())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !restarted.usage.Since.Equal(gc.usage.Since) {
+		t.Errorf("observation start was not restored: want %v, got %v", gc.usage.Since, restarted.usage.Since)
+	}
+	if !restarted.usage.LastUsed[tag].Equal(gc.usage.LastUsed[tag]) {
+		t.Errorf("last use was not restored: want %v, got %v", gc.usage.LastUsed[tag], restarted.usage.LastUsed[tag])
+	}
+}
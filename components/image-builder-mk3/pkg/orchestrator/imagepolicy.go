@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/distribution/reference"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+)
+
+// checkImagePolicy makes sure the image policy of the installation permits building from ref.
+// The images image-builder produces itself and the default workspace image are always permitted.
+func (o *Orchestrator) checkImagePolicy(ref string) error {
+	permitted, err := imagePolicyPermits(o.Config.ImagePolicy, o.imagePolicyExempt(), ref)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "cannot parse image ref: %v", err)
+	}
+	if !permitted {
+		return status.Errorf(codes.PermissionDenied, "image %s is not permitted by the image policy of this installation", ref)
+	}
+	return nil
+}
+
+// imagePolicyExempt returns the repositories which are permitted regardless of the image policy
+func (o *Orchestrator) imagePolicyExempt() []string {
+	return []string{
+		o.Config.BaseImageRepository,
+		o.Config.WorkspaceImageRepository,
+		o.Config.ImagePolicy.DefaultImage,
+	}
+}
+
+// bobImagePolicy returns the image policy bob enforces on the images a Dockerfile pulls, e.g. in FROM instructions.
+// Those are only known inside the build workspace, which is why image-builder cannot check them itself.
+// Returns an empty string if the installation has no image policy.
+func (o *Orchestrator) bobImagePolicy() (string, error) {
+	policy := o.Config.ImagePolicy
+	if len(policy.AllowedRegistries) == 0 && len(policy.DeniedRegistries) == 0 {
+		return "", nil
+	}
+
+	res, err := json.Marshal(struct {
+		AllowedRegistries []string `json:"allowedRegistries,omitempty"`
+		DeniedRegistries  []string `json:"deniedRegistries,omitempty"`
+		Exempt            []string `json:"exempt,omitempty"`
+	}{
+		AllowedRegistries: policy.AllowedRegistries,
+		DeniedRegistries:  policy.DeniedRegistries,
+		Exempt:            o.imagePolicyExempt(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(res), nil
+}
+
+// imagePolicyPermits returns true if the policy permits the image ref. Repositories listed in exempt
+// are permitted regardless of the policy.
+func imagePolicyPermits(policy config.ImagePolicyConfig, exempt []string, ref string) (bool, error) {
+	if len(policy.AllowedRegistries) == 0 && len(policy.DeniedRegistries) == 0 {
+		return true, nil
+	}
+
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return false, err
+	}
+	name := named.Name()
+
+	for _, e := range exempt {
+		if e == "" {
+			continue
+		}
+		en, err := reference.ParseNormalizedNamed(e)
+		if err != nil {
+			return false, xerrors.Errorf("invalid exempt repository %s: %w", e, err)
+		}
+		if en.Name() == name {
+			return true, nil
+		}
+	}
+
+	if matchesAnyRegistry(policy.DeniedRegistries, name) {
+		return false, nil
+	}
+	if len(policy.AllowedRegistries) == 0 {
+		return true, nil
+	}
+	return matchesAnyRegistry(policy.AllowedRegistries, name), nil
+}
+
+// matchesAnyRegistry returns true if the fully qualified repository name is part of any of the registries,
+// which are either registry hosts or repository prefixes.
+func matchesAnyRegistry(registries []string, name string) bool {
+	for _, r := range registries {
+		r = strings.TrimSuffix(r, "/")
+		if r == "" {
+			continue
+		}
+		if name == r || strings.HasPrefix(name, r+"/") {
+			return true
+		}
+	}
+	return false
+}
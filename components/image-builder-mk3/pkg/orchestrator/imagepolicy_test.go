@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/image-builder/api/config"
+)
+
+func TestImagePolicyPermits(t *testing.T) {
+	type Expectation struct {
+		Permitted bool
+		Error     bool
+	}
+	exempt := []string{"registry.gitpod.io/base-images", "gitpod/workspace-full:latest"}
+	tests := []struct {
+		Name        string
+		Policy      config.ImagePolicyConfig
+		Ref         string
+		Expectation Expectation
+	}{
+		{
+			Name:        "no policy",
+			Ref:         "alpine:latest",
+			Expectation: Expectation{Permitted: true},
+		},
+		{
+			Name:        "allowed registry",
+			Policy:      config.ImagePolicyConfig{AllowedRegistries: []string{"docker.io"}},
+			Ref:         "alpine:latest",
+			Expectation: Expectation{Permitted: true},
+		},
+		{
+			Name:        "registry not allowed",
+			Policy:      config.ImagePolicyConfig{AllowedRegistries: []string{"gcr.io"}},
+			Ref:         "alpine:latest",
+			Expectation: Expectation{Permitted: false},
+		},
+		{
+			Name:        "allowed repository prefix",
+			Policy:      config.ImagePolicyConfig{AllowedRegistries: []string{"docker.io/gitpod/"}},
+			Ref:         "gitpod/workspace-node:2024-01-01",
+			Expectation: Expectation{Permitted: true},
+		},
+		{
+			Name:        "prefix matches whole path segments only",
+			Policy:      config.ImagePolicyConfig{AllowedRegistries: []string{"docker.io/gitpod"}},
+			Ref:         "gitpodfake/workspace-node",
+			Expectation: Expectation{Permitted: false},
+		},
+		{
+			Name:        "denied registry",
+			Policy:      config.ImagePolicyConfig{DeniedRegistries: []string{"quay.io"}},
+			Ref:         "quay.io/foo/bar@sha256:a4c5ba2e3b9a0cb3ce1bd02ed4c2f8db1dfa4b2e7f8a4dd2b0d57cf1ea53f4f2",
+			Expectation: Expectation{Permitted: false},
+		},
+		{
+			Name: "deny takes precedence",
+			Policy: config.ImagePolicyConfig{
+				AllowedRegistries: []string{"docker.io"},
+				DeniedRegistries:  []string{"docker.io/library"},
+			},
+			Ref:         "ubuntu",
+			Expectation: Expectation{Permitted: false},
+		},
+		{
+			Name:        "base image repository is exempt",
+			Policy:      config.ImagePolicyConfig{AllowedRegistries: []string{"gcr.io"}},
+			Ref:         "registry.gitpod.io/base-images:8fe2c1e5",
+			Expectation: Expectation{Permitted: true},
+		},
+		{
+			Name:        "default image is exempt",
+			Policy:      config.ImagePolicyConfig{DeniedRegistries: []string{"docker.io"}},
+			Ref:         "docker.io/gitpod/workspace-full:2024-01-01",
+			Expectation: Expectation{Permitted: true},
+		},
+		{
+			Name:        "invalid ref",
+			Policy:      config.ImagePolicyConfig{AllowedRegistries: []string{"docker.io"}},
+			Ref:         "Not A Ref",
+			Expectation: Expectation{Error: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			permitted, err := imagePolicyPermits(test.Policy, exempt, test.Ref)
+			act := Expectation{Permitted: permitted, Error: err != nil}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBobImagePolicy(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Policy      config.ImagePolicyConfig
+		Expectation string
+	}{
+		{
+			Name:        "no policy",
+			Policy:      config.ImagePolicyConfig{DefaultImage: "gitpod/workspace-full:latest"},
+			Expectation: "",
+		},
+		{
+			Name: "policy",
+			Policy: config.ImagePolicyConfig{
+				AllowedRegistries: []string{"docker.io"},
+				DeniedRegistries:  []string{"docker.io/evil"},
+				DefaultImage:      "gitpod/workspace-full:latest",
+			},
+			Expectation: `{"allowedRegistries":["docker.io"],"deniedRegistries":["docker.io/evil"],"exempt":["registry.gitpod.io/base-images","registry.gitpod.io/workspace-images","gitpod/workspace-full:latest"]}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			o := &Orchestrator{Config: config.Configuration{
+				BaseImageRepository:      "registry.gitpod.io/base-images",
+				WorkspaceImageRepository: "registry.gitpod.io/workspace-images",
+				ImagePolicy:              test.Policy,
+			}}
+			act, err := o.bobImagePolicy()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected policy (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,14 @@ func (o *Orchestrator) RegisterMetrics(reg prometheus.Registerer) error {
 	if err != nil {
 		return err
 	}
+	err = reg.Register(o.metrics.imagesCollectedTotal)
+	if err != nil {
+		return err
+	}
+	err = reg.Register(o.metrics.imageCollectionFailuresTotal)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -31,6 +39,9 @@ const (
 type metrics struct {
 	imageBuildsDoneTotal    *prometheus.CounterVec
 	imageBuildsStartedTotal prometheus.Counter
+
+	imagesCollectedTotal         *prometheus.CounterVec
+	imageCollectionFailuresTotal prometheus.Counter
 }
 
 func newMetrics() *metrics {
@@ -45,6 +56,18 @@ func newMetrics() *metrics {
 			Subsystem: metricsSubsystem,
 			Name:      "builds_started_total",
 		}),
+		imagesCollectedTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "gc_images_deleted_total",
+			Help:      "Number of unused workspace images deleted from the registry, or which would have been deleted in a dry run",
+		}, []string{"dry_run"}),
+		imageCollectionFailuresTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "gc_image_deletion_failures_total",
+			Help:      "Number of unused workspace images which could not be deleted from the registry",
+		}),
 	}
 }
 
@@ -55,3 +78,11 @@ func (m *metrics) BuildDone(success bool) {
 func (m *metrics) BuildStarted() {
 	m.imageBuildsStartedTotal.Inc()
 }
+
+func (m *metrics) ImageCollected(dryRun bool) {
+	m.imagesCollectedTotal.WithLabelValues(strconv.FormatBool(dryRun)).Inc()
+}
+
+func (m *metrics) ImageCollectionFailed() {
+	m.imageCollectionFailuresTotal.Inc()
+}
@@ -122,6 +122,10 @@ func NewOrchestratingBuilder(cfg config.Configuration) (res *Orchestrator, err e
 		metrics:       newMetrics(),
 	}
 	o.monitor = newBuildMonitor(o, o.wsman)
+	o.gc, err = newImageGC(cfg, o.Auth, o.wsman, o.metrics)
+	if err != nil {
+		return nil, err
+	}
 
 	return o, nil
 }
@@ -141,6 +145,7 @@ type Orchestrator struct {
 	mu            sync.RWMutex
 
 	monitor *buildMonitor
+	gc      *imageGC
 
 	metrics *metrics
 
@@ -150,6 +155,9 @@ type Orchestrator struct {
 // Start fires up the internals of this image builder
 func (o *Orchestrator) Start(ctx context.Context) error {
 	go o.monitor.Run()
+	if o.gc != nil {
+		go o.gc.Run(ctx)
+	}
 	return nil
 }
 
@@ -190,6 +198,7 @@ func (o *Orchestrator) ResolveWorkspaceImage(ctx context.Context, req *protocol.
 		return nil, status.Errorf(codes.InvalidArgument, "cannot produce image ref: %v", err)
 	}
 	span.LogKV("refstr", refstr, "baseref", baseref)
+	o.gc.Touch(refstr)
 
 	// to check if the image exists we must have access to the image caching registry and the refstr we check here does not come
 	// from the user. Thus we can safely use auth.AllowedAuthForAll here.
@@ -233,6 +242,12 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 
 	// resolve to ref to baseImageNameResolved (if it exists)
 	if req.BaseImageNameResolved != "" && !req.GetForceRebuild() {
+		// the workspace image might have been built before the image policy changed
+		err = o.checkImagePolicy(req.BaseImageNameResolved)
+		if err != nil {
+			return err
+		}
+
 		if req.Auth != nil && req.Auth.GetSelective() != nil {
 			// allow access to baseImage repository so we can look it up later
 			req.Auth.GetSelective().AllowBaserep = true
@@ -243,6 +258,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 		if err != nil {
 			return status.Errorf(codes.Internal, "cannot produce workspace image ref: %q", err)
 		}
+		o.gc.Touch(wsrefstr)
 		wsrefAuth, err := reqauth.GetAuthFor(ctx, o.Auth, wsrefstr)
 		if err != nil {
 			return status.Errorf(codes.Internal, "cannot get workspace image authentication: %q", err)
@@ -289,6 +305,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 	if err != nil {
 		return status.Errorf(codes.Internal, "cannot produce workspace image ref: %q", err)
 	}
+	o.gc.Touch(wsrefstr)
 	wsrefAuth, err := auth.AllowedAuthForAll().GetAuthFor(ctx, o.Auth, wsrefstr)
 	if err != nil {
 		return status.Errorf(codes.Internal, "cannot get workspace image authentication: %q", err)
@@ -349,6 +366,12 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 	}
 	contextPath = filepath.Join("/workspace", strings.TrimPrefix(contextPath, "/workspace"))
 
+	// the images the Dockerfile pulls are subject to the image policy, too
+	imagePolicy, err := o.bobImagePolicy()
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot marshal image policy: %q", err)
+	}
+
 	o.censor(buildID, []string{
 		wsrefstr,
 		baseref,
@@ -388,7 +411,11 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 		}
 	}
 
-	var swr *wsmanapi.StartWorkspaceResponse
+	var (
+		swr          *wsmanapi.StartWorkspaceResponse
+		startedBuild bool
+		startedOn    = time.Now()
+	)
 	err = retry(ctx, func(ctx context.Context) (err error) {
 		swr, err = o.wsman.StartWorkspace(ctx, &wsmanapi.StartWorkspaceRequest{
 			Id:            buildID,
@@ -417,6 +444,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 					{Name: "BOB_BUILD_BASE", Value: buildBase},
 					{Name: "BOB_DOCKERFILE_PATH", Value: dockerfilePath},
 					{Name: "BOB_CONTEXT_DIR", Value: contextPath},
+					{Name: "BOB_IMAGE_POLICY", Value: imagePolicy},
 					{Name: "GITPOD_TASKS", Value: `[{"name": "build", "init": "sudo -E /app/bob build"}]`},
 					{Name: "WORKSPACEKIT_RING2_ENCLAVE", Value: "/app/bob proxy"},
 					{Name: "WORKSPACEKIT_BOBPROXY_BASEREF", Value: baseref},
@@ -446,6 +474,7 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 	} else if err != nil {
 		return status.Errorf(codes.Internal, "cannot start build: %q", err)
 	} else {
+		startedBuild = true
 		o.monitor.RegisterNewBuild(buildID, wsrefstr, baseref, swr.Url, swr.OwnerToken)
 		o.PublishLog(buildID, "starting image build ...\n")
 	}
@@ -471,6 +500,29 @@ func (o *Orchestrator) Build(req *protocol.BuildRequest, resp protocol.ImageBuil
 			} else if !exists {
 				update.Status = protocol.BuildStatus_done_failure
 				update.Message = "image build did not produce a workspace image"
+			} else if startedBuild && o.Config.Provenance.Enabled {
+				// Only the request which started the build attests it. Failing to do so does not fail the build.
+				builderID := o.Config.Provenance.BuilderID
+				if builderID == "" {
+					builderID = o.Config.BuilderImage
+				}
+				// The attestation must not be cut short when the client goes away, hence it is pushed on a detached context.
+				provCtx, cancel := context.WithTimeout(opentracing.ContextWithSpan(context.Background(), span), provenanceTimeout)
+				err = attachProvenance(provCtx, newRegistryResolver(wsrefAuth), provenanceBuild{
+					BuildID:        buildID,
+					BuilderID:      builderID,
+					ImageRef:       wsrefstr,
+					BaseRef:        baseref,
+					Source:         initializer,
+					DockerfilePath: dockerfilePath,
+					ContextPath:    contextPath,
+					StartedOn:      startedOn,
+					FinishedOn:     time.Now(),
+				})
+				cancel()
+				if err != nil {
+					log.WithError(err).WithField("ref", wsrefstr).Error("cannot attach provenance to workspace image")
+				}
 			}
 		}
 
@@ -607,6 +659,11 @@ func (o *Orchestrator) getAbsoluteImageRef(ctx context.Context, ref string, allo
 	span.LogKV("ref", ref)
 
 	log.WithField("ref", ref).Debug("getAbsoluteImageRef")
+	err = o.checkImagePolicy(ref)
+	if err != nil {
+		return "", err
+	}
+
 	auth, err := allowedAuth.GetAuthFor(ctx, o.Auth, ref)
 	if err != nil {
 		return "", status.Errorf(codes.InvalidArgument, "cannt resolve base image ref: %v", err)
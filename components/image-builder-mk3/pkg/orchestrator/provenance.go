@@ -0,0 +1,393 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/remotes"
+	dockerremote "github.com/containerd/containerd/remotes/docker"
+	"github.com/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	"github.com/gitpod-io/gitpod/image-builder/pkg/auth"
+)
+
+const (
+	inTotoStatementType = "https://in-toto.io/Statement/v1"
+	slsaProvenanceType  = "https://slsa.dev/provenance/v1"
+	provenanceBuildType = "https://gitpod.io/image-builder/workspace-image/v1"
+
+	// provenanceMediaType is the media type of the attestation layer and the artifact type of the referrer manifest
+	provenanceMediaType = "application/vnd.in-toto+json"
+	// emptyJSONMediaType is the media type of the empty config of artifact manifests (image-spec v1.1)
+	emptyJSONMediaType = "application/vnd.oci.empty.v1+json"
+
+	// provenanceTimeout is the time we give pushing an attestation
+	provenanceTimeout = 2 * time.Minute
+)
+
+// referrerManifest is an image manifest with the artifactType field of image-spec v1.1,
+// which the image-spec version we depend on does not have yet.
+type referrerManifest struct {
+	ociv1.Manifest
+	ArtifactType string `json:"artifactType,omitempty"`
+}
+
+// provenanceStatement is an in-toto statement carrying a SLSA v1 provenance predicate
+type provenanceStatement struct {
+	Type          string              `json:"_type"`
+	Subject       []provenanceSubject `json:"subject"`
+	PredicateType string              `json:"predicateType"`
+	Predicate     provenancePredicate `json:"predicate"`
+}
+
+type provenanceSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type provenancePredicate struct {
+	BuildDefinition provenanceBuildDefinition `json:"buildDefinition"`
+	RunDetails      provenanceRunDetails      `json:"runDetails"`
+}
+
+type provenanceBuildDefinition struct {
+	BuildType            string                 `json:"buildType"`
+	ExternalParameters   map[string]interface{} `json:"externalParameters"`
+	InternalParameters   map[string]interface{} `json:"internalParameters,omitempty"`
+	ResolvedDependencies []provenanceDependency `json:"resolvedDependencies,omitempty"`
+}
+
+type provenanceDependency struct {
+	URI    string            `json:"uri"`
+	Digest map[string]string `json:"digest,omitempty"`
+}
+
+type provenanceRunDetails struct {
+	Builder  provenanceBuilder  `json:"builder"`
+	Metadata provenanceMetadata `json:"metadata"`
+}
+
+type provenanceBuilder struct {
+	ID string `json:"id"`
+}
+
+type provenanceMetadata struct {
+	InvocationID string    `json:"invocationId"`
+	StartedOn    time.Time `json:"startedOn"`
+	FinishedOn   time.Time `json:"finishedOn"`
+}
+
+// provenanceBuild describes a finished workspace image build
+type provenanceBuild struct {
+	BuildID        string
+	BuilderID      string
+	Image          ociv1.Descriptor
+	ImageRef       string
+	BaseRef        string
+	Source         *csapi.WorkspaceInitializer
+	DockerfilePath string
+	ContextPath    string
+	StartedOn      time.Time
+	FinishedOn     time.Time
+}
+
+func newProvenanceStatement(b provenanceBuild) (*provenanceStatement, error) {
+	name, err := reference.ParseNormalizedNamed(b.ImageRef)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse image ref: %w", err)
+	}
+
+	external := map[string]interface{}{
+		"baseRef":        b.BaseRef,
+		"dockerfilePath": b.DockerfilePath,
+		"contextPath":    b.ContextPath,
+	}
+
+	var deps []provenanceDependency
+	if baseref, err := reference.ParseNormalizedNamed(b.BaseRef); err == nil {
+		dep := provenanceDependency{URI: "pkg:docker/" + reference.FamiliarString(baseref)}
+		if d, ok := baseref.(reference.Digested); ok {
+			dep.Digest = map[string]string{d.Digest().Algorithm().String(): d.Digest().Encoded()}
+		}
+		deps = append(deps, dep)
+	}
+
+	var sources []map[string]string
+	for _, git := range gitInitializers(b.Source) {
+		src := map[string]string{"repository": git.RemoteUri}
+		dep := provenanceDependency{URI: "git+" + git.RemoteUri}
+		switch git.TargetMode {
+		case csapi.CloneTargetMode_REMOTE_COMMIT:
+			src["commit"] = git.CloneTaget
+			dep.URI += "@" + git.CloneTaget
+			dep.Digest = map[string]string{"gitCommit": git.CloneTaget}
+		case csapi.CloneTargetMode_REMOTE_BRANCH, csapi.CloneTargetMode_LOCAL_BRANCH:
+			src["ref"] = "refs/heads/" + git.CloneTaget
+			dep.URI += "@refs/heads/" + git.CloneTaget
+		}
+		sources = append(sources, src)
+		deps = append(deps, dep)
+	}
+	if len(sources) > 0 {
+		external["source"] = sources
+	}
+
+	return &provenanceStatement{
+		Type: inTotoStatementType,
+		Subject: []provenanceSubject{
+			{
+				Name:   name.Name(),
+				Digest: map[string]string{b.Image.Digest.Algorithm().String(): b.Image.Digest.Encoded()},
+			},
+		},
+		PredicateType: slsaProvenanceType,
+		Predicate: provenancePredicate{
+			BuildDefinition: provenanceBuildDefinition{
+				BuildType:            provenanceBuildType,
+				ExternalParameters:   external,
+				ResolvedDependencies: deps,
+			},
+			RunDetails: provenanceRunDetails{
+				Builder: provenanceBuilder{ID: b.BuilderID},
+				Metadata: provenanceMetadata{
+					InvocationID: b.BuildID,
+					StartedOn:    b.StartedOn.UTC(),
+					FinishedOn:   b.FinishedOn.UTC(),
+				},
+			},
+		},
+	}, nil
+}
+
+// gitInitializers returns all Git initializers contained in init
+func gitInitializers(init *csapi.WorkspaceInitializer) []*csapi.GitInitializer {
+	if init == nil {
+		return nil
+	}
+
+	switch spec := init.Spec.(type) {
+	case *csapi.WorkspaceInitializer_Git:
+		return []*csapi.GitInitializer{spec.Git}
+	case *csapi.WorkspaceInitializer_MultiRepo:
+		return spec.MultiRepo.Repositories
+	case *csapi.WorkspaceInitializer_Composite:
+		var res []*csapi.GitInitializer
+		for _, c := range spec.Composite.Initializer {
+			res = append(res, gitInitializers(c)...)
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+// attachProvenance generates a SLSA provenance attestation for a workspace image and pushes it
+// as OCI referrer, i.e. as manifest whose subject is the workspace image.
+func attachProvenance(ctx context.Context, resolver remotes.Resolver, b provenanceBuild) (err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "attachProvenance")
+	defer tracing.FinishSpan(span, &err)
+	span.SetTag("ref", b.ImageRef)
+
+	_, b.Image, err = resolver.Resolve(ctx, b.ImageRef)
+	if err != nil {
+		return xerrors.Errorf("cannot resolve workspace image: %w", err)
+	}
+
+	stmt, err := newProvenanceStatement(b)
+	if err != nil {
+		return err
+	}
+	stmtData, err := json.Marshal(stmt)
+	if err != nil {
+		return xerrors.Errorf("cannot marshal provenance: %w", err)
+	}
+
+	// Artifact manifests use the empty JSON object as config. The artifact type is set on the manifest.
+	cfgData := []byte("{}")
+	cfgDesc := ociv1.Descriptor{
+		MediaType: emptyJSONMediaType,
+		Digest:    digest.FromBytes(cfgData),
+		Size:      int64(len(cfgData)),
+	}
+	layerDesc := ociv1.Descriptor{
+		MediaType: provenanceMediaType,
+		Digest:    digest.FromBytes(stmtData),
+		Size:      int64(len(stmtData)),
+		Annotations: map[string]string{
+			"in-toto.io/predicate-type": slsaProvenanceType,
+		},
+	}
+	subject := ociv1.Descriptor{
+		MediaType: b.Image.MediaType,
+		Digest:    b.Image.Digest,
+		Size:      b.Image.Size,
+	}
+	annotations := map[string]string{
+		ociv1.AnnotationCreated: b.FinishedOn.UTC().Format(time.RFC3339),
+	}
+	mfData, err := json.Marshal(referrerManifest{
+		Manifest: ociv1.Manifest{
+			Versioned:   specs.Versioned{SchemaVersion: 2},
+			MediaType:   ociv1.MediaTypeImageManifest,
+			Config:      cfgDesc,
+			Layers:      []ociv1.Descriptor{layerDesc},
+			Subject:     &subject,
+			Annotations: annotations,
+		},
+		ArtifactType: provenanceMediaType,
+	})
+	if err != nil {
+		return xerrors.Errorf("cannot marshal provenance manifest: %w", err)
+	}
+	mfDesc := ociv1.Descriptor{
+		MediaType:    ociv1.MediaTypeImageManifest,
+		Digest:       digest.FromBytes(mfData),
+		Size:         int64(len(mfData)),
+		ArtifactType: provenanceMediaType,
+		Annotations:  annotations,
+	}
+
+	name, err := reference.ParseNormalizedNamed(b.ImageRef)
+	if err != nil {
+		return xerrors.Errorf("cannot parse image ref: %w", err)
+	}
+	name = reference.TrimNamed(name)
+	ref, err := reference.WithDigest(name, mfDesc.Digest)
+	if err != nil {
+		return err
+	}
+	pusher, err := resolver.Pusher(ctx, ref.String())
+	if err != nil {
+		return xerrors.Errorf("cannot create pusher: %w", err)
+	}
+
+	// The manifest must be pushed last as registries validate the blobs it references.
+	for _, c := range []struct {
+		Desc ociv1.Descriptor
+		Data []byte
+	}{
+		{cfgDesc, cfgData},
+		{layerDesc, stmtData},
+		{mfDesc, mfData},
+	} {
+		err = pushContent(ctx, pusher, c.Desc, c.Data)
+		if err != nil {
+			return xerrors.Errorf("cannot push %s: %w", c.Desc.Digest, err)
+		}
+	}
+
+	err = pushReferrersTag(ctx, resolver, name, subject.Digest, mfDesc)
+	if err != nil {
+		return xerrors.Errorf("cannot push referrers tag: %w", err)
+	}
+
+	return nil
+}
+
+// pushReferrersTag adds a referrer to the index behind the referrers tag of its subject. Registries which
+// do not support the referrers API only list referrers through that tag (OCI distribution spec v1.1).
+func pushReferrersTag(ctx context.Context, resolver remotes.Resolver, name reference.Named, subject digest.Digest, referrer ociv1.Descriptor) error {
+	tag, err := reference.WithTag(name, subject.Algorithm().String()+"-"+subject.Encoded())
+	if err != nil {
+		return err
+	}
+
+	idx := ociv1.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociv1.MediaTypeImageIndex,
+	}
+	_, desc, err := resolver.Resolve(ctx, tag.String())
+	if err == nil {
+		idx, err = fetchIndex(ctx, resolver, tag.String(), desc)
+		if err != nil {
+			return err
+		}
+	} else if !errdefs.IsNotFound(err) {
+		return err
+	}
+	for _, m := range idx.Manifests {
+		if m.Digest == referrer.Digest {
+			return nil
+		}
+	}
+	idx.Manifests = append(idx.Manifests, referrer)
+
+	idxData, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	pusher, err := resolver.Pusher(ctx, tag.String())
+	if err != nil {
+		return xerrors.Errorf("cannot create pusher: %w", err)
+	}
+	return pushContent(ctx, pusher, ociv1.Descriptor{
+		MediaType: ociv1.MediaTypeImageIndex,
+		Digest:    digest.FromBytes(idxData),
+		Size:      int64(len(idxData)),
+	}, idxData)
+}
+
+func fetchIndex(ctx context.Context, resolver remotes.Resolver, ref string, desc ociv1.Descriptor) (idx ociv1.Index, err error) {
+	fetcher, err := resolver.Fetcher(ctx, ref)
+	if err != nil {
+		return idx, xerrors.Errorf("cannot create fetcher: %w", err)
+	}
+	rc, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return idx, xerrors.Errorf("cannot fetch referrers index: %w", err)
+	}
+	defer rc.Close()
+
+	err = json.NewDecoder(io.LimitReader(rc, desc.Size)).Decode(&idx)
+	if err != nil {
+		return idx, xerrors.Errorf("cannot unmarshal referrers index: %w", err)
+	}
+	return idx, nil
+}
+
+func pushContent(ctx context.Context, pusher remotes.Pusher, desc ociv1.Descriptor, data []byte) error {
+	w, err := pusher.Push(ctx, desc)
+	if errdefs.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	_, err = w.Write(data)
+	if err != nil {
+		return err
+	}
+	err = w.Commit(ctx, desc.Size, desc.Digest)
+	if errdefs.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+func newRegistryResolver(authentication *auth.Authentication) remotes.Resolver {
+	return dockerremote.NewResolver(dockerremote.ResolverOptions{
+		Authorizer: dockerremote.NewDockerAuthorizer(dockerremote.WithAuthCreds(func(host string) (username, password string, err error) {
+			if authentication == nil {
+				return
+			}
+
+			return authentication.Username, authentication.Password, nil
+		})),
+	})
+}
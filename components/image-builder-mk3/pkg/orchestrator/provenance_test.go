@@ -0,0 +1,432 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/remotes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+)
+
+func TestNewProvenanceStatement(t *testing.T) {
+	var (
+		startedOn  = time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+		finishedOn = startedOn.Add(5 * time.Minute)
+		imageDgst  = digest.FromString("image")
+		baseDgst   = digest.FromString("base")
+	)
+
+	tests := []struct {
+		Name        string
+		Source      *csapi.WorkspaceInitializer
+		Expectation *provenanceStatement
+	}{
+		{
+			Name: "ref source",
+			Source: &csapi.WorkspaceInitializer{
+				Spec: &csapi.WorkspaceInitializer_Empty{Empty: &csapi.EmptyInitializer{}},
+			},
+			Expectation: &provenanceStatement{
+				Type:          inTotoStatementType,
+				Subject:       []provenanceSubject{{Name: "docker.io/registry/workspace", Digest: map[string]string{"sha256": imageDgst.Encoded()}}},
+				PredicateType: slsaProvenanceType,
+				Predicate: provenancePredicate{
+					BuildDefinition: provenanceBuildDefinition{
+						BuildType: provenanceBuildType,
+						ExternalParameters: map[string]interface{}{
+							"baseRef":        "registry/base@" + baseDgst.String(),
+							"dockerfilePath": "/workspace/Dockerfile",
+							"contextPath":    "/workspace",
+						},
+						ResolvedDependencies: []provenanceDependency{
+							{URI: "pkg:docker/registry/base@" + baseDgst.String(), Digest: map[string]string{"sha256": baseDgst.Encoded()}},
+						},
+					},
+					RunDetails: provenanceRunDetails{
+						Builder:  provenanceBuilder{ID: "builder-image"},
+						Metadata: provenanceMetadata{InvocationID: "build-id", StartedOn: startedOn, FinishedOn: finishedOn},
+					},
+				},
+			},
+		},
+		{
+			Name: "git source",
+			Source: &csapi.WorkspaceInitializer{
+				Spec: &csapi.WorkspaceInitializer_Composite{Composite: &csapi.CompositeInitializer{
+					Initializer: []*csapi.WorkspaceInitializer{
+						{Spec: &csapi.WorkspaceInitializer_Git{Git: &csapi.GitInitializer{
+							RemoteUri:  "https://github.com/gitpod-io/gitpod",
+							TargetMode: csapi.CloneTargetMode_REMOTE_COMMIT,
+							CloneTaget: "0123456789abcdef",
+						}}},
+						{Spec: &csapi.WorkspaceInitializer_Git{Git: &csapi.GitInitializer{
+							RemoteUri:  "https://github.com/gitpod-io/website",
+							TargetMode: csapi.CloneTargetMode_REMOTE_BRANCH,
+							CloneTaget: "main",
+						}}},
+					},
+				}},
+			},
+			Expectation: &provenanceStatement{
+				Type:          inTotoStatementType,
+				Subject:       []provenanceSubject{{Name: "docker.io/registry/workspace", Digest: map[string]string{"sha256": imageDgst.Encoded()}}},
+				PredicateType: slsaProvenanceType,
+				Predicate: provenancePredicate{
+					BuildDefinition: provenanceBuildDefinition{
+						BuildType: provenanceBuildType,
+						ExternalParameters: map[string]interface{}{
+							"baseRef":        "registry/base@" + baseDgst.String(),
+							"dockerfilePath": "/workspace/Dockerfile",
+							"contextPath":    "/workspace",
+							"source": []map[string]string{
+								{"repository": "https://github.com/gitpod-io/gitpod", "commit": "0123456789abcdef"},
+								{"repository": "https://github.com/gitpod-io/website", "ref": "refs/heads/main"},
+							},
+						},
+						ResolvedDependencies: []provenanceDependency{
+							{URI: "pkg:docker/registry/base@" + baseDgst.String(), Digest: map[string]string{"sha256": baseDgst.Encoded()}},
+							{URI: "git+https://github.com/gitpod-io/gitpod@0123456789abcdef", Digest: map[string]string{"gitCommit": "0123456789abcdef"}},
+							{URI: "git+https://github.com/gitpod-io/website@refs/heads/main"},
+						},
+					},
+					RunDetails: provenanceRunDetails{
+						Builder:  provenanceBuilder{ID: "builder-image"},
+						Metadata: provenanceMetadata{InvocationID: "build-id", StartedOn: startedOn, FinishedOn: finishedOn},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act, err := newProvenanceStatement(provenanceBuild{
+				BuildID:        "build-id",
+				BuilderID:      "builder-image",
+				Image:          ociv1.Descriptor{Digest: imageDgst},
+				ImageRef:       "registry/workspace:tag",
+				BaseRef:        "registry/base@" + baseDgst.String(),
+				Source:         test.Source,
+				DockerfilePath: "/workspace/Dockerfile",
+				ContextPath:    "/workspace",
+				StartedOn:      startedOn,
+				FinishedOn:     finishedOn,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected statement (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAttachProvenance(t *testing.T) {
+	const (
+		imageRef = "registry.example.com/workspace:tag"
+		repo     = "registry.example.com/workspace"
+	)
+	var (
+		image = ociv1.Descriptor{
+			MediaType: ociv1.MediaTypeImageManifest,
+			Digest:    digest.FromString("image"),
+			Size:      42,
+		}
+		referrersTag  = repo + ":sha256-" + image.Digest.Encoded()
+		otherReferrer = ociv1.Descriptor{
+			MediaType:    ociv1.MediaTypeImageManifest,
+			Digest:       digest.FromString("signature"),
+			Size:         10,
+			ArtifactType: "application/vnd.dev.cosign.artifact.sig.v1+json",
+		}
+	)
+
+	type Expectation struct {
+		Referrers []digest.Digest
+		Pushes    int
+	}
+	tests := []struct {
+		Name        string
+		Referrers   []ociv1.Descriptor
+		Attach      int
+		Expectation Expectation
+	}{
+		{
+			Name:   "no referrers",
+			Attach: 1,
+			Expectation: Expectation{
+				Pushes: 4,
+			},
+		},
+		{
+			Name:      "existing referrers",
+			Referrers: []ociv1.Descriptor{otherReferrer},
+			Attach:    1,
+			Expectation: Expectation{
+				Referrers: []digest.Digest{otherReferrer.Digest},
+				Pushes:    4,
+			},
+		},
+		{
+			Name:   "attached twice",
+			Attach: 2,
+			Expectation: Expectation{
+				Pushes: 4,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			reg := newFakeRegistry()
+			reg.Tags[imageRef] = image
+			if len(test.Referrers) > 0 {
+				reg.put(referrersTag, ociv1.MediaTypeImageIndex, ociv1.Index{
+					Versioned: specs.Versioned{SchemaVersion: 2},
+					MediaType: ociv1.MediaTypeImageIndex,
+					Manifests: test.Referrers,
+				})
+			}
+			reg.Pushed = nil
+
+			finishedOn := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+			for i := 0; i < test.Attach; i++ {
+				err := attachProvenance(context.Background(), reg, provenanceBuild{
+					BuildID:    "build-id",
+					BuilderID:  "builder-image",
+					ImageRef:   imageRef,
+					BaseRef:    "registry.example.com/base:latest",
+					Source:     &csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Empty{Empty: &csapi.EmptyInitializer{}}},
+					StartedOn:  finishedOn.Add(-time.Minute),
+					FinishedOn: finishedOn,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var idx ociv1.Index
+			reg.get(t, referrersTag, &idx)
+			if len(idx.Manifests) != len(test.Expectation.Referrers)+1 {
+				t.Fatalf("expected %d referrers, got %d", len(test.Expectation.Referrers)+1, len(idx.Manifests))
+			}
+			var act Expectation
+			for _, m := range idx.Manifests[:len(idx.Manifests)-1] {
+				act.Referrers = append(act.Referrers, m.Digest)
+			}
+			act.Pushes = len(reg.Pushed)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected referrers (-want +got):\n%s", diff)
+			}
+
+			// The push order matters: registries validate the blobs a manifest references and the referrers index
+			// must only list manifests which exist.
+			referrer := idx.Manifests[len(idx.Manifests)-1]
+			if referrer.ArtifactType != provenanceMediaType {
+				t.Errorf("referrers index lists artifact type %q, expected %q", referrer.ArtifactType, provenanceMediaType)
+			}
+			if reg.Pushed[2] != referrer.Digest || reg.Pushed[3] != reg.Tags[referrersTag].Digest {
+				t.Errorf("unexpected push order: %v", reg.Pushed)
+			}
+
+			var mf referrerManifest
+			reg.get(t, repo+"@"+referrer.Digest.String(), &mf)
+			if mf.ArtifactType != provenanceMediaType {
+				t.Errorf("unexpected artifact type %q", mf.ArtifactType)
+			}
+			if mf.Config.MediaType != emptyJSONMediaType {
+				t.Errorf("unexpected config media type %q", mf.Config.MediaType)
+			}
+			if diff := cmp.Diff(&image, mf.Subject); diff != "" {
+				t.Errorf("unexpected subject (-want +got):\n%s", diff)
+			}
+			if len(mf.Layers) != 1 {
+				t.Fatalf("expected one layer, got %d", len(mf.Layers))
+			}
+
+			var stmt provenanceStatement
+			err := json.Unmarshal(reg.Blobs[mf.Layers[0].Digest], &stmt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if act := stmt.Subject[0].Digest["sha256"]; act != image.Digest.Encoded() {
+				t.Errorf("attestation subject has digest %s, expected %s", act, image.Digest.Encoded())
+			}
+		})
+	}
+}
+
+func TestPushContent(t *testing.T) {
+	data := []byte("content")
+	desc := ociv1.Descriptor{
+		MediaType: provenanceMediaType,
+		Digest:    digest.FromBytes(data),
+		Size:      int64(len(data)),
+	}
+	errPush := errors.New("push failed")
+
+	tests := []struct {
+		Name        string
+		PushErr     error
+		CommitErr   error
+		Expectation error
+		Written     bool
+	}{
+		{Name: "new content", Written: true},
+		{Name: "exists before push", PushErr: errdefs.ErrAlreadyExists},
+		{Name: "exists on commit", CommitErr: errdefs.ErrAlreadyExists, Written: true},
+		{Name: "push fails", PushErr: errPush, Expectation: errPush},
+		{Name: "commit fails", CommitErr: errPush, Expectation: errPush, Written: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			w := &fakeWriter{commit: func(w *fakeWriter) error { return test.CommitErr }}
+			pusher := remotes.PusherFunc(func(ctx context.Context, d ociv1.Descriptor) (content.Writer, error) {
+				if test.PushErr != nil {
+					return nil, test.PushErr
+				}
+				return w, nil
+			})
+
+			err := pushContent(context.Background(), pusher, desc, data)
+			if !errors.Is(err, test.Expectation) {
+				t.Errorf("unexpected error: want %v, got %v", test.Expectation, err)
+			}
+			if written := w.Len() > 0; written != test.Written {
+				t.Errorf("unexpected write: want %v, got %v", test.Written, written)
+			}
+			if test.Written && !w.closed {
+				t.Error("writer was not closed")
+			}
+		})
+	}
+}
+
+// fakeRegistry is an in-memory registry which implements remotes.Resolver
+type fakeRegistry struct {
+	Blobs map[digest.Digest][]byte
+	Tags  map[string]ociv1.Descriptor
+	// Pushed lists the digests of all pushed content in order
+	Pushed []digest.Digest
+}
+
+func newFakeRegistry() *fakeRegistry {
+	return &fakeRegistry{
+		Blobs: make(map[digest.Digest][]byte),
+		Tags:  make(map[string]ociv1.Descriptor),
+	}
+}
+
+func (r *fakeRegistry) put(ref, mediaType string, obj interface{}) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	desc := ociv1.Descriptor{MediaType: mediaType, Digest: digest.FromBytes(data), Size: int64(len(data))}
+	r.Blobs[desc.Digest] = data
+	r.Tags[ref] = desc
+}
+
+func (r *fakeRegistry) get(t *testing.T, ref string, obj interface{}) {
+	desc, ok := r.Tags[ref]
+	if !ok {
+		t.Fatalf("%s does not exist", ref)
+	}
+	err := json.Unmarshal(r.Blobs[desc.Digest], obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (r *fakeRegistry) Resolve(ctx context.Context, ref string) (string, ociv1.Descriptor, error) {
+	desc, ok := r.Tags[ref]
+	if !ok {
+		return "", ociv1.Descriptor{}, errdefs.ErrNotFound
+	}
+	return ref, desc, nil
+}
+
+func (r *fakeRegistry) Fetcher(ctx context.Context, ref string) (remotes.Fetcher, error) {
+	return remotes.FetcherFunc(func(ctx context.Context, desc ociv1.Descriptor) (io.ReadCloser, error) {
+		data, ok := r.Blobs[desc.Digest]
+		if !ok {
+			return nil, errdefs.ErrNotFound
+		}
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}), nil
+}
+
+func (r *fakeRegistry) Pusher(ctx context.Context, ref string) (remotes.Pusher, error) {
+	return remotes.PusherFunc(func(ctx context.Context, desc ociv1.Descriptor) (content.Writer, error) {
+		isManifest := desc.MediaType == ociv1.MediaTypeImageManifest || desc.MediaType == ociv1.MediaTypeImageIndex
+		if isManifest && r.Tags[ref].Digest == desc.Digest {
+			return nil, errdefs.ErrAlreadyExists
+		}
+		if _, exists := r.Blobs[desc.Digest]; exists && !isManifest {
+			return nil, errdefs.ErrAlreadyExists
+		}
+
+		return &fakeWriter{commit: func(w *fakeWriter) error {
+			if dgst := digest.FromBytes(w.Bytes()); dgst != desc.Digest {
+				return errors.New("digest mismatch")
+			}
+			r.Blobs[desc.Digest] = w.Bytes()
+			if isManifest {
+				r.Tags[ref] = desc
+			}
+			r.Pushed = append(r.Pushed, desc.Digest)
+			return nil
+		}}, nil
+	}), nil
+}
+
+type fakeWriter struct {
+	bytes.Buffer
+	commit func(w *fakeWriter) error
+	closed bool
+}
+
+func (w *fakeWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func (w *fakeWriter) Digest() digest.Digest {
+	return digest.FromBytes(w.Bytes())
+}
+
+func (w *fakeWriter) Commit(ctx context.Context, size int64, expected digest.Digest, opts ...content.Opt) error {
+	defer w.Close()
+	if size != int64(w.Len()) || expected != w.Digest() {
+		return errors.New("unexpected size or digest")
+	}
+	return w.commit(w)
+}
+
+func (w *fakeWriter) Status() (content.Status, error) {
+	return content.Status{Offset: int64(w.Len())}, nil
+}
+
+func (w *fakeWriter) Truncate(size int64) error {
+	w.Buffer.Truncate(int(size))
+	return nil
+}
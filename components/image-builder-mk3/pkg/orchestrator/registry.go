@@ -0,0 +1,212 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package orchestrator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	dockerremote "github.com/containerd/containerd/remotes/docker"
+	"github.com/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/image-builder/pkg/auth"
+)
+
+// errDeleteUnsupported is returned if the registry does not permit deleting manifests
+var errDeleteUnsupported = xerrors.Errorf("registry does not support deleting images")
+
+// manifestMediaTypes are the manifests we accept when resolving tags
+var manifestMediaTypes = []string{
+	ociv1.MediaTypeImageManifest,
+	ociv1.MediaTypeImageIndex,
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+}
+
+// registryClient implements the parts of the OCI distribution API which containerd's resolver does not offer,
+// i.e. listing tags and deleting manifests of a repository.
+type registryClient struct {
+	repo       reference.Named
+	base       *url.URL
+	client     *http.Client
+	authorizer dockerremote.Authorizer
+}
+
+func newRegistryClient(repo string, authentication *auth.Authentication, client *http.Client) (*registryClient, error) {
+	named, err := reference.ParseNormalizedNamed(repo)
+	if err != nil {
+		return nil, xerrors.Errorf("invalid repository %s: %w", repo, err)
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	host := reference.Domain(named)
+	scheme := "https"
+	if local, _ := dockerremote.MatchLocalhost(host); local {
+		scheme = "http"
+	}
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+
+	return &registryClient{
+		repo:   named,
+		base:   &url.URL{Scheme: scheme, Host: host, Path: "/v2/" + reference.Path(named) + "/"},
+		client: client,
+		authorizer: dockerremote.NewDockerAuthorizer(
+			dockerremote.WithAuthClient(client),
+			dockerremote.WithAuthCreds(func(host string) (username, password string, err error) {
+				if authentication == nil {
+					return
+				}
+				return authentication.Username, authentication.Password, nil
+			}),
+		),
+	}, nil
+}
+
+// Tags lists all tags of the repository
+func (c *registryClient) Tags(ctx context.Context) ([]string, error) {
+	var (
+		res  []string
+		next = c.base.ResolveReference(&url.URL{Path: "tags/list"})
+	)
+	for next != nil {
+		resp, err := c.do(ctx, http.MethodGet, next, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			// the repository does not exist (yet)
+			resp.Body.Close()
+			return nil, nil
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, xerrors.Errorf("cannot list tags of %s: %s", c.repo, resp.Status)
+		}
+
+		var page struct {
+			Tags []string `json:"tags"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, xerrors.Errorf("cannot decode tags of %s: %w", c.repo, err)
+		}
+		res = append(res, page.Tags...)
+
+		next, err = nextPage(next, resp.Header.Get("Link"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// nextPage parses the RFC5988 link header registries use to paginate tags
+func nextPage(current *url.URL, link string) (*url.URL, error) {
+	if link == "" {
+		return nil, nil
+	}
+	start, end := strings.Index(link, "<"), strings.Index(link, ">")
+	if start < 0 || end < start || !strings.Contains(link[end:], `rel="next"`) {
+		return nil, nil
+	}
+	next, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return nil, xerrors.Errorf("invalid link header %q: %w", link, err)
+	}
+	return current.ResolveReference(next), nil
+}
+
+// ManifestDigest resolves a tag of the repository to the digest of its manifest. It returns an empty digest if the tag does not exist.
+func (c *registryClient) ManifestDigest(ctx context.Context, tag string) (digest.Digest, error) {
+	header := http.Header{"Accept": []string{strings.Join(manifestMediaTypes, ", ")}}
+	resp, err := c.do(ctx, http.MethodHead, c.base.ResolveReference(&url.URL{Path: "manifests/" + tag}), header)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", xerrors.Errorf("cannot resolve %s:%s: %s", c.repo, tag, resp.Status)
+	}
+	if dgst, err := digest.Parse(resp.Header.Get("Docker-Content-Digest")); err == nil {
+		return dgst, nil
+	}
+
+	// not all registries return the digest, in which case we compute it from the manifest
+	resp, err = c.do(ctx, http.MethodGet, c.base.ResolveReference(&url.URL{Path: "manifests/" + tag}), header)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", xerrors.Errorf("cannot fetch %s:%s: %s", c.repo, tag, resp.Status)
+	}
+	return digest.FromReader(resp.Body)
+}
+
+// DeleteManifest deletes a manifest, and with it all tags which point to it
+func (c *registryClient) DeleteManifest(ctx context.Context, dgst digest.Digest) error {
+	resp, err := c.do(ctx, http.MethodDelete, c.base.ResolveReference(&url.URL{Path: "manifests/" + dgst.String()}), nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusNotFound:
+		return nil
+	case http.StatusMethodNotAllowed:
+		return errDeleteUnsupported
+	default:
+		return xerrors.Errorf("cannot delete %s@%s: %s", c.repo, dgst, resp.Status)
+	}
+}
+
+// do sends a request to the registry and authenticates it once the registry challenged us
+func (c *registryClient) do(ctx context.Context, method string, u *url.URL, header http.Header) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if header != nil {
+			req.Header = header.Clone()
+		}
+		err = c.authorizer.Authorize(ctx, req)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot authorize request to %s: %w", u.Host, err)
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusUnauthorized || attempt > 0 {
+			return resp, nil
+		}
+
+		err = c.authorizer.AddResponses(ctx, []*http.Response{resp})
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("cannot authenticate with %s: %w", u.Host, err)
+		}
+	}
+}
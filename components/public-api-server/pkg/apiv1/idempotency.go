@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package apiv1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// idempotencyTTL is how long we remember the workspace created for an idempotency token
+	idempotencyTTL = 24 * time.Hour
+	// idempotencyClaimTTL is how long a request may hold an idempotency token before it completes
+	idempotencyClaimTTL = 5 * time.Minute
+	// idempotencyPending marks a token whose request is still in progress
+	idempotencyPending = "pending"
+
+	maxIdempotencyTokenLength = 256
+)
+
+// IdempotencyStore remembers the workspaces created for idempotency tokens.
+type IdempotencyStore interface {
+	// Claim reserves a key for a request. If the key was claimed before, it returns false and the
+	// ID of the workspace created for the key. The ID is empty while the other request is in progress.
+	Claim(ctx context.Context, key string) (claimed bool, workspaceID string, err error)
+
+	// Complete stores the ID of the workspace created for a claimed key
+	Complete(ctx context.Context, key, workspaceID string) error
+
+	// Release frees a claimed key after its request failed
+	Release(ctx context.Context, key string) error
+}
+
+// idempotencyKey scopes an idempotency token to a user
+func idempotencyKey(userID, token string) string {
+	hash := sha256.Sum256([]byte(userID + ":" + token))
+	return "public-api:workspace-idempotency:" + hex.EncodeToString(hash[:])
+}
+
+func NewRedisIdempotencyStore(client *redis.Client) *RedisIdempotencyStore {
+	return &RedisIdempotencyStore{Client: client}
+}
+
+type RedisIdempotencyStore struct {
+	Client *redis.Client
+}
+
+// Claim reserves a key for a request
+func (s *RedisIdempotencyStore) Claim(ctx context.Context, key string) (bool, string, error) {
+	claimed, err := s.Client.SetNX(ctx, key, idempotencyPending, idempotencyClaimTTL).Result()
+	if err != nil {
+		return false, "", err
+	}
+	if claimed {
+		return true, "", nil
+	}
+
+	workspaceID, err := s.Client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		// the other request released the key in the meantime
+		return false, "", nil
+	}
+	if err != nil {
+		return false, "", err
+	}
+	if workspaceID == idempotencyPending {
+		return false, "", nil
+	}
+	return false, workspaceID, nil
+}
+
+// Complete stores the ID of the workspace created for a claimed key
+func (s *RedisIdempotencyStore) Complete(ctx context.Context, key, workspaceID string) error {
+	return s.Client.Set(ctx, key, workspaceID, idempotencyTTL).Err()
+}
+
+// Release frees a claimed key after its request failed
+func (s *RedisIdempotencyStore) Release(ctx context.Context, key string) error {
+	return s.Client.Del(ctx, key).Err()
+}
+
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{
+		entries: make(map[string]inMemoryIdempotencyEntry),
+	}
+}
+
+type inMemoryIdempotencyEntry struct {
+	WorkspaceID string
+	Expires     time.Time
+}
+
+type InMemoryIdempotencyStore struct {
+	mu      sync.Mutex
+	entries map[string]inMemoryIdempotencyEntry
+}
+
+// Claim reserves a key for a request
+func (s *InMemoryIdempotencyStore) Claim(ctx context.Context, key string) (bool, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry, ok := s.entries[key]; ok && time.Now().Before(entry.Expires) {
+		return false, entry.WorkspaceID, nil
+	}
+	s.entries[key] = inMemoryIdempotencyEntry{Expires: time.Now().Add(idempotencyClaimTTL)}
+	return true, "", nil
+}
+
+// Complete stores the ID of the workspace created for a claimed key
+func (s *InMemoryIdempotencyStore) Complete(ctx context.Context, key, workspaceID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = inMemoryIdempotencyEntry{WorkspaceID: workspaceID, Expires: time.Now().Add(idempotencyTTL)}
+	return nil
+}
+
+// Release frees a claimed key after its request failed
+func (s *InMemoryIdempotencyStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
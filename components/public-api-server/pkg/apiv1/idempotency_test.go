@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package apiv1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIdempotencyStore(t *testing.T) {
+	stores := map[string]func(t *testing.T) IdempotencyStore{
+		"in-memory": func(t *testing.T) IdempotencyStore {
+			return NewInMemoryIdempotencyStore()
+		},
+		"redis": func(t *testing.T) IdempotencyStore {
+			s := miniredis.RunT(t)
+			return NewRedisIdempotencyStore(redis.NewClient(&redis.Options{Addr: s.Addr()}))
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newStore(t)
+			key := idempotencyKey("user-id", "token")
+
+			claimed, workspaceID, err := store.Claim(ctx, key)
+			require.NoError(t, err)
+			require.True(t, claimed)
+			require.Empty(t, workspaceID)
+
+			// the first request is still in progress
+			claimed, workspaceID, err = store.Claim(ctx, key)
+			require.NoError(t, err)
+			require.False(t, claimed)
+			require.Empty(t, workspaceID)
+
+			// the first request failed
+			require.NoError(t, store.Release(ctx, key))
+			claimed, _, err = store.Claim(ctx, key)
+			require.NoError(t, err)
+			require.True(t, claimed)
+
+			require.NoError(t, store.Complete(ctx, key, "ws-id"))
+			claimed, workspaceID, err = store.Claim(ctx, key)
+			require.NoError(t, err)
+			require.False(t, claimed)
+			require.Equal(t, "ws-id", workspaceID)
+
+			// tokens are scoped to users
+			claimed, _, err = store.Claim(ctx, idempotencyKey("other-user-id", "token"))
+			require.NoError(t, err)
+			require.True(t, claimed)
+		})
+	}
+}
@@ -20,29 +20,49 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func NewWorkspaceService(serverConnPool proxy.ServerConnectionPool, expClient experiments.Client) *WorkspaceService {
+func NewWorkspaceService(serverConnPool proxy.ServerConnectionPool, expClient experiments.Client, idempotency IdempotencyStore) *WorkspaceService {
 	return &WorkspaceService{
 		connectionPool: serverConnPool,
 		expClient:      expClient,
+		idempotency:    idempotency,
 	}
 }
 
 type WorkspaceService struct {
 	connectionPool proxy.ServerConnectionPool
 	expClient      experiments.Client
+	idempotency    IdempotencyStore
 
 	v1connect.UnimplementedWorkspacesServiceHandler
 }
 
 func (s *WorkspaceService) CreateAndStartWorkspace(ctx context.Context, req *connect.Request[v1.CreateAndStartWorkspaceRequest]) (*connect.Response[v1.CreateAndStartWorkspaceResponse], error) {
-
 	conn, err := getConnection(ctx, s.connectionPool)
 	if err != nil {
 		return nil, err
 	}
 
+	contextURL, err := workspaceContextURL(ctx, conn, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var claimedKey string
+	if token := req.Msg.GetIdempotencyToken(); token != "" {
+		var workspaceID string
+		claimedKey, workspaceID, err = s.claimIdempotencyToken(ctx, conn, token)
+		if err != nil {
+			return nil, err
+		}
+		if workspaceID != "" {
+			return connect.NewResponse(&v1.CreateAndStartWorkspaceResponse{
+				WorkspaceId: workspaceID,
+			}), nil
+		}
+	}
+
 	ws, err := conn.CreateWorkspace(ctx, &protocol.CreateWorkspaceOptions{
-		ContextURL:                         req.Msg.GetContextUrl(),
+		ContextURL:                         contextURL,
 		OrganizationId:                     req.Msg.GetOrganizationId(),
 		IgnoreRunningWorkspaceOnSameCommit: req.Msg.GetIgnoreRunningWorkspaceOnSameCommit(),
 		IgnoreRunningPrebuild:              req.Msg.GetIgnoreRunningPrebuild(),
@@ -52,21 +72,65 @@ func (s *WorkspaceService) CreateAndStartWorkspace(ctx context.Context, req *con
 			WorkspaceClass: req.Msg.GetStartSpec().GetWorkspaceClass(),
 			Region:         req.Msg.GetStartSpec().GetRegion(),
 			IdeSettings: &protocol.IDESettings{
-				DefaultIde:       req.Msg.StartSpec.IdeSettings.GetDefaultIde(),
-				UseLatestVersion: req.Msg.StartSpec.IdeSettings.GetUseLatestVersion(),
+				DefaultIde:       req.Msg.GetStartSpec().GetIdeSettings().GetDefaultIde(),
+				UseLatestVersion: req.Msg.GetStartSpec().GetIdeSettings().GetUseLatestVersion(),
+				PreferToolbox:    req.Msg.GetStartSpec().GetIdeSettings().GetPreferToolbox(),
 			},
 		},
 	})
 	if err != nil {
 		log.Extract(ctx).WithError(err).Error("Failed to create workspace.")
+		if claimedKey != "" {
+			if relErr := s.idempotency.Release(ctx, claimedKey); relErr != nil {
+				log.Extract(ctx).WithError(relErr).Warn("Failed to release idempotency token.")
+			}
+		}
 		return nil, proxy.ConvertError(err)
 	}
 
+	if claimedKey != "" {
+		if err := s.idempotency.Complete(ctx, claimedKey, ws.CreatedWorkspaceID); err != nil {
+			// the workspace exists, hence we must not fail the request
+			log.Extract(ctx).WithError(err).Warn("Failed to store workspace for idempotency token.")
+		}
+	}
+
 	return connect.NewResponse(&v1.CreateAndStartWorkspaceResponse{
 		WorkspaceId: ws.CreatedWorkspaceID,
 	}), nil
 }
 
+// claimIdempotencyToken claims the idempotency token of the current user. It returns the ID of the workspace
+// a previous request with the same token created, if any.
+func (s *WorkspaceService) claimIdempotencyToken(ctx context.Context, conn protocol.APIInterface, token string) (key string, workspaceID string, err error) {
+	if s.idempotency == nil {
+		return "", "", connect.NewError(connect.CodeUnimplemented, fmt.Errorf("Idempotency tokens are not supported."))
+	}
+	if len(token) > maxIdempotencyTokenLength {
+		return "", "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Idempotency token must not be longer than %d characters.", maxIdempotencyTokenLength))
+	}
+
+	user, err := conn.GetLoggedInUser(ctx)
+	if err != nil {
+		log.Extract(ctx).WithError(err).Error("Failed to get logged in user.")
+		return "", "", proxy.ConvertError(err)
+	}
+
+	key = idempotencyKey(user.ID, token)
+	claimed, workspaceID, err := s.idempotency.Claim(ctx, key)
+	if err != nil {
+		log.Extract(ctx).WithError(err).Error("Failed to claim idempotency token.")
+		return "", "", connect.NewError(connect.CodeInternal, fmt.Errorf("Failed to claim idempotency token."))
+	}
+	if claimed {
+		return key, "", nil
+	}
+	if workspaceID == "" {
+		return "", "", connect.NewError(connect.CodeAborted, fmt.Errorf("Another request with the same idempotency token is in progress."))
+	}
+	return "", workspaceID, nil
+}
+
 func (s *WorkspaceService) GetWorkspace(ctx context.Context, req *connect.Request[v1.GetWorkspaceRequest]) (*connect.Response[v1.GetWorkspaceResponse], error) {
 	workspaceID, err := validateWorkspaceID(ctx, req.Msg.GetWorkspaceId())
 	if err != nil {
@@ -0,0 +1,171 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package apiv1
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	connect "github.com/bufbuild/connect-go"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	v1 "github.com/gitpod-io/gitpod/components/public-api/go/experimental/v1"
+	protocol "github.com/gitpod-io/gitpod/gitpod-protocol"
+	"github.com/gitpod-io/gitpod/public-api-server/pkg/proxy"
+)
+
+var (
+	// contextURLPrefix matches the prefixes server understands in front of a context URL. Server
+	// parses only one of them, hence we cannot add another one to a context URL which has one already.
+	contextURLPrefix = regexp.MustCompile(`^/?(open-prebuild/[^/]*/|referrer:[^/]*/|imagebuild/|[^/]*=[^/]*/)`)
+	envVarName       = regexp.MustCompile(`^[\w-]+$`)
+	referrerName     = regexp.MustCompile(`^[^/:]+$`)
+)
+
+// workspaceContextURL composes the context URL server creates the workspace from. Server expresses the
+// context options as prefixes of the context URL, which is what the dashboard does, too.
+func workspaceContextURL(ctx context.Context, conn protocol.APIInterface, req *v1.CreateAndStartWorkspaceRequest) (string, error) {
+	var contextURL string
+	switch source := req.GetSource().(type) {
+	case *v1.CreateAndStartWorkspaceRequest_ContextUrl:
+		contextURL = strings.TrimSpace(source.ContextUrl)
+		if contextURL == "" {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Context URL must not be empty."))
+		}
+	case *v1.CreateAndStartWorkspaceRequest_SnapshotId:
+		if _, err := validateUUID(source.SnapshotId); err != nil {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Snapshot ID must be a valid UUID."))
+		}
+		contextURL = "snapshot/" + source.SnapshotId
+	case *v1.CreateAndStartWorkspaceRequest_PrebuildId:
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Prebuilds need the context URL they were built for, use context_url with context_options.prebuild_id instead."))
+	default:
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Either a context URL or a snapshot ID must be specified."))
+	}
+
+	opts := req.GetContextOptions()
+	if branch := opts.GetBranch(); branch != "" {
+		if req.GetContextUrl() == "" {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Branch can only be specified with a context URL."))
+		}
+		var err error
+		contextURL, err = branchContextURL(ctx, conn, contextURL, branch)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var prefixes []string
+	if envVars := opts.GetEnvVars(); len(envVars) > 0 {
+		prefix, err := envVarsPrefix(envVars)
+		if err != nil {
+			return "", err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	if prebuildID := opts.GetPrebuildId(); prebuildID != "" {
+		if req.GetContextUrl() == "" {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Prebuild ID can only be specified with a context URL."))
+		}
+		if _, err := validateUUID(prebuildID); err != nil {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Prebuild ID must be a valid UUID."))
+		}
+		prefixes = append(prefixes, "open-prebuild/"+prebuildID+"/")
+	}
+	if referrer := opts.GetReferrer(); referrer != "" {
+		if !referrerName.MatchString(referrer) {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Referrer must not contain slashes or colons."))
+		}
+		prefixes = append(prefixes, "referrer:"+referrer+"/")
+	}
+
+	switch {
+	case len(prefixes) == 0:
+		return contextURL, nil
+	case len(prefixes) > 1:
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("At most one of env vars, prebuild ID and referrer can be specified."))
+	case contextURLPrefix.MatchString(contextURL):
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Context URL has a prefix already and cannot be combined with env vars, prebuild ID or referrer."))
+	}
+	return prefixes[0] + contextURL, nil
+}
+
+// envVarsPrefix returns the context URL prefix which sets the env vars, e.g. FOO=bar,BAZ=qux/
+func envVarsPrefix(envVars map[string]string) (string, error) {
+	names := make([]string, 0, len(envVars))
+	for name, value := range envVars {
+		if !envVarName.MatchString(name) {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Env var name %q is invalid, it must consist of letters, digits, underscores and dashes.", name))
+		}
+		if value == "" {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Env var %s must not be empty.", name))
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	vars := make([]string, 0, len(names))
+	for _, name := range names {
+		// server decodes the values, but splits the prefix at commas, equal signs and slashes before
+		value := strings.ReplaceAll(url.PathEscape(envVars[name]), "=", "%3D")
+		vars = append(vars, name+"="+value)
+	}
+	return strings.Join(vars, ",") + "/", nil
+}
+
+// branchContextURL returns the context URL of a branch of the repository the context URL points to
+func branchContextURL(ctx context.Context, conn protocol.APIInterface, contextURL, branch string) (string, error) {
+	if strings.ContainsAny(branch, " \t\n?#") {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Branch %q is invalid.", branch))
+	}
+	u, err := url.Parse(contextURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Branch can only be specified with the URL of a repository."))
+	}
+
+	providers, err := conn.GetAuthProviders(ctx)
+	if err != nil {
+		log.Extract(ctx).WithError(err).Error("Failed to get auth providers.")
+		return "", proxy.ConvertError(err)
+	}
+	var providerType string
+	for _, p := range providers {
+		if strings.EqualFold(p.Host, u.Host) {
+			providerType = p.AuthProviderType
+			break
+		}
+	}
+
+	repoPath := strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), ".git")
+	segments := strings.Split(strings.TrimPrefix(repoPath, "/"), "/")
+	switch providerType {
+	case "GitHub", "Bitbucket":
+		if len(segments) != 2 {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Branch can only be specified with the URL of a repository."))
+		}
+		sep := "/tree/"
+		if providerType == "Bitbucket" {
+			sep = "/src/"
+		}
+		return u.Scheme + "://" + u.Host + repoPath + sep + branch, nil
+	case "GitLab":
+		if len(segments) < 2 || strings.Contains(repoPath, "/-/") {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Branch can only be specified with the URL of a repository."))
+		}
+		return u.Scheme + "://" + u.Host + repoPath + "/-/tree/" + branch, nil
+	case "BitbucketServer":
+		if len(segments) != 4 || segments[2] != "repos" {
+			return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Branch can only be specified with the URL of a repository."))
+		}
+		return u.Scheme + "://" + u.Host + repoPath + "/browse?at=" + url.QueryEscape("refs/heads/"+branch), nil
+	case "":
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("No Git provider is configured for %s.", u.Host))
+	default:
+		return "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("Branch cannot be specified for %s repositories, use the URL of the branch as context URL instead.", providerType))
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/gitpod-io/gitpod/public-api-server/pkg/jws/jwstest"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	"github.com/sourcegraph/jsonrpc2"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -531,6 +532,189 @@ func TestWorkspaceService_StreamWorkspaceStatus(t *testing.T) {
 	})
 }
 
+func TestWorkspaceService_CreateAndStartWorkspace(t *testing.T) {
+	var (
+		orgID      = uuid.New().String()
+		snapshotID = uuid.New().String()
+		prebuildID = uuid.New().String()
+		repoURL    = "https://github.com/gitpod-io/gitpod"
+	)
+
+	t.Run("proxies request with context options to server", func(t *testing.T) {
+		serverMock, client := setupWorkspacesService(t)
+
+		serverMock.EXPECT().CreateWorkspace(gomock.Any(), &protocol.CreateWorkspaceOptions{
+			ContextURL:     "FOO=bar,PATHS=a%2Fb%2Cc%3Dd/" + repoURL,
+			OrganizationId: orgID,
+			StartWorkspaceOptions: protocol.StartWorkspaceOptions{
+				WorkspaceClass: "g1-large",
+				IdeSettings: &protocol.IDESettings{
+					DefaultIde:    "intellij",
+					PreferToolbox: true,
+				},
+			},
+		}).Return(&protocol.WorkspaceCreationResult{CreatedWorkspaceID: "ws-id"}, nil)
+
+		resp, err := client.CreateAndStartWorkspace(context.Background(), connect.NewRequest(&v1.CreateAndStartWorkspaceRequest{
+			Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: repoURL},
+			OrganizationId: orgID,
+			StartSpec: &v1.StartWorkspaceSpec{
+				WorkspaceClass: "g1-large",
+				IdeSettings:    &v1.IDESettings{DefaultIde: "intellij", PreferToolbox: true},
+			},
+			ContextOptions: &v1.WorkspaceContextOptions{
+				EnvVars: map[string]string{"PATHS": "a/b,c=d", "FOO": "bar"},
+			},
+		}))
+		require.NoError(t, err)
+		require.Equal(t, "ws-id", resp.Msg.GetWorkspaceId())
+	})
+
+	t.Run("returns the workspace of a previous request with the same idempotency token", func(t *testing.T) {
+		serverMock, client := setupWorkspacesService(t)
+
+		serverMock.EXPECT().GetLoggedInUser(gomock.Any()).Return(&protocol.User{ID: "user-id"}, nil).Times(3)
+		gomock.InOrder(
+			serverMock.EXPECT().CreateWorkspace(gomock.Any(), gomock.Any()).Return(nil, &jsonrpc2.Error{Code: 500, Message: "failed"}),
+			serverMock.EXPECT().CreateWorkspace(gomock.Any(), gomock.Any()).Return(&protocol.WorkspaceCreationResult{CreatedWorkspaceID: "ws-id"}, nil),
+		)
+
+		req := &v1.CreateAndStartWorkspaceRequest{
+			IdempotencyToken: "token",
+			Source:           &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: repoURL},
+			OrganizationId:   orgID,
+		}
+		_, err := client.CreateAndStartWorkspace(context.Background(), connect.NewRequest(req))
+		require.Error(t, err)
+
+		for i := 0; i < 2; i++ {
+			resp, err := client.CreateAndStartWorkspace(context.Background(), connect.NewRequest(req))
+			require.NoError(t, err)
+			require.Equal(t, "ws-id", resp.Msg.GetWorkspaceId())
+		}
+	})
+
+	tests := []struct {
+		Name          string
+		Request       *v1.CreateAndStartWorkspaceRequest
+		AuthProviders []*protocol.AuthProviderInfo
+		Expectation   string
+		Code          connect.Code
+	}{
+		{
+			Name:        "context URL",
+			Request:     &v1.CreateAndStartWorkspaceRequest{Source: &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: repoURL}},
+			Expectation: repoURL,
+		},
+		{
+			Name:        "snapshot",
+			Request:     &v1.CreateAndStartWorkspaceRequest{Source: &v1.CreateAndStartWorkspaceRequest_SnapshotId{SnapshotId: snapshotID}},
+			Expectation: "snapshot/" + snapshotID,
+		},
+		{
+			Name: "prebuild",
+			Request: &v1.CreateAndStartWorkspaceRequest{
+				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: repoURL},
+				ContextOptions: &v1.WorkspaceContextOptions{PrebuildId: prebuildID},
+			},
+			Expectation: "open-prebuild/" + prebuildID + "/" + repoURL,
+		},
+		{
+			Name: "GitHub branch with referrer",
+			Request: &v1.CreateAndStartWorkspaceRequest{
+				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: repoURL + ".git"},
+				ContextOptions: &v1.WorkspaceContextOptions{Branch: "feature/foo", Referrer: "jetbrains-gateway"},
+			},
+			AuthProviders: []*protocol.AuthProviderInfo{{Host: "github.com", AuthProviderType: "GitHub"}},
+			Expectation:   "referrer:jetbrains-gateway/" + repoURL + "/tree/feature/foo",
+		},
+		{
+			Name: "GitLab branch",
+			Request: &v1.CreateAndStartWorkspaceRequest{
+				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: "https://gitlab.com/group/sub/repo"},
+				ContextOptions: &v1.WorkspaceContextOptions{Branch: "main"},
+			},
+			AuthProviders: []*protocol.AuthProviderInfo{{Host: "gitlab.com", AuthProviderType: "GitLab"}},
+			Expectation:   "https://gitlab.com/group/sub/repo/-/tree/main",
+		},
+		{
+			Name: "Bitbucket Server branch",
+			Request: &v1.CreateAndStartWorkspaceRequest{
+				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: "https://bitbucket.example.com/projects/FOO/repos/repo"},
+				ContextOptions: &v1.WorkspaceContextOptions{Branch: "main"},
+			},
+			AuthProviders: []*protocol.AuthProviderInfo{{Host: "bitbucket.example.com", AuthProviderType: "BitbucketServer"}},
+			Expectation:   "https://bitbucket.example.com/projects/FOO/repos/repo/browse?at=refs%2Fheads%2Fmain",
+		},
+		{
+			Name: "branch of unknown host",
+			Request: &v1.CreateAndStartWorkspaceRequest{
+				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: "https://git.example.com/foo/bar"},
+				ContextOptions: &v1.WorkspaceContextOptions{Branch: "main"},
+			},
+			Code: connect.CodeInvalidArgument,
+		},
+		{
+			Name: "branch of pull request",
+			Request: &v1.CreateAndStartWorkspaceRequest{
+				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: repoURL + "/pull/1"},
+				ContextOptions: &v1.WorkspaceContextOptions{Branch: "main"},
+			},
+			AuthProviders: []*protocol.AuthProviderInfo{{Host: "github.com", AuthProviderType: "GitHub"}},
+			Code:          connect.CodeInvalidArgument,
+		},
+		{
+			Name:    "missing source",
+			Request: &v1.CreateAndStartWorkspaceRequest{},
+			Code:    connect.CodeInvalidArgument,
+		},
+		{
+			Name:    "prebuild source",
+			Request: &v1.CreateAndStartWorkspaceRequest{Source: &v1.CreateAndStartWorkspaceRequest_PrebuildId{PrebuildId: prebuildID}},
+			Code:    connect.CodeInvalidArgument,
+		},
+		{
+			Name: "env vars and referrer",
+			Request: &v1.CreateAndStartWorkspaceRequest{
+				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: repoURL},
+				ContextOptions: &v1.WorkspaceContextOptions{EnvVars: map[string]string{"FOO": "bar"}, Referrer: "vscode"},
+			},
+			Code: connect.CodeInvalidArgument,
+		},
+		{
+			Name: "env vars and prefixed context URL",
+			Request: &v1.CreateAndStartWorkspaceRequest{
+				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: "referrer:vscode/" + repoURL},
+				ContextOptions: &v1.WorkspaceContextOptions{EnvVars: map[string]string{"FOO": "bar"}},
+			},
+			Code: connect.CodeInvalidArgument,
+		},
+		{
+			Name: "invalid env var name",
+			Request: &v1.CreateAndStartWorkspaceRequest{
+				Source:         &v1.CreateAndStartWorkspaceRequest_ContextUrl{ContextUrl: repoURL},
+				ContextOptions: &v1.WorkspaceContextOptions{EnvVars: map[string]string{"FOO BAR": "bar"}},
+			},
+			Code: connect.CodeInvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			t.Cleanup(ctrl.Finish)
+
+			serverMock := protocol.NewMockAPIInterface(ctrl)
+			if test.Request.GetContextOptions().GetBranch() != "" {
+				serverMock.EXPECT().GetAuthProviders(gomock.Any()).Return(test.AuthProviders, nil).AnyTimes()
+			}
+
+			act, err := workspaceContextURL(context.Background(), serverMock, test.Request)
+			requireErrorCode(t, test.Code, err)
+			require.Equal(t, test.Expectation, act)
+		})
+	}
+}
+
 func TestClientServerStreamInterceptor(t *testing.T) {
 	testInterceptor := &TestInterceptor{
 		expectedToken: "auth-token",
@@ -544,7 +728,7 @@ func TestClientServerStreamInterceptor(t *testing.T) {
 
 	svc := NewWorkspaceService(&FakeServerConnPool{
 		api: serverMock,
-	}, nil)
+	}, nil, NewInMemoryIdempotencyStore())
 
 	keyset := jwstest.GenerateKeySet(t)
 	rsa256, err := jws.NewRSA256(keyset)
@@ -813,7 +997,7 @@ func setupWorkspacesService(t *testing.T) (*protocol.MockAPIInterface, v1connect
 
 	svc := NewWorkspaceService(&FakeServerConnPool{
 		api: serverMock,
-	}, nil)
+	}, nil, NewInMemoryIdempotencyStore())
 
 	keyset := jwstest.GenerateKeySet(t)
 	rsa256, err := jws.NewRSA256(keyset)
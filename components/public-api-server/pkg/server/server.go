@@ -146,6 +146,7 @@ func Start(logger *logrus.Entry, version string, cfg *config.Configuration) erro
 		cipher:          cipherSet,
 		oidcService:     oidcService,
 		idpService:      idpService,
+		idempotency:     apiv1.NewRedisIdempotencyStore(redisClient),
 		authCfg:         cfg.Auth,
 		sessionVerifier: rsa256,
 	}); registerErr != nil {
@@ -167,6 +168,7 @@ type registerDependencies struct {
 	cipher      db.Cipher
 	oidcService *oidc.Service
 	idpService  *identityprovider.Service
+	idempotency apiv1.IdempotencyStore
 
 	sessionVerifier jws.SignerVerifier
 	authCfg         config.AuthConfiguration
@@ -195,7 +197,7 @@ func register(srv *baseserver.Server, deps *registerDependencies) error {
 		),
 	}
 
-	rootHandler.Mount(v1connect.NewWorkspacesServiceHandler(apiv1.NewWorkspaceService(deps.connPool, deps.expClient), handlerOptions...))
+	rootHandler.Mount(v1connect.NewWorkspacesServiceHandler(apiv1.NewWorkspaceService(deps.connPool, deps.expClient, deps.idempotency), handlerOptions...))
 	rootHandler.Mount(v1connect.NewTeamsServiceHandler(apiv1.NewTeamsService(deps.connPool), handlerOptions...))
 	rootHandler.Mount(v1connect.NewUserServiceHandler(apiv1.NewUserService(deps.connPool), handlerOptions...))
 	rootHandler.Mount(v1connect.NewSCMServiceHandler(apiv1.NewSCMService(deps.connPool), handlerOptions...))
@@ -36,6 +36,8 @@ type ConfigurationServiceClient interface {
 	UpdateConfiguration(ctx context.Context, in *UpdateConfigurationRequest, opts ...grpc.CallOption) (*UpdateConfigurationResponse, error)
 	// Deletes a configuration.
 	DeleteConfiguration(ctx context.Context, in *DeleteConfigurationRequest, opts ...grpc.CallOption) (*DeleteConfigurationResponse, error)
+	// Validates a workspace configuration (.gitpod.yml) and reports the problems found in it.
+	ValidateWorkspaceConfig(ctx context.Context, in *ValidateWorkspaceConfigRequest, opts ...grpc.CallOption) (*ValidateWorkspaceConfigResponse, error)
 }
 
 type configurationServiceClient struct {
@@ -91,6 +93,15 @@ func (c *configurationServiceClient) DeleteConfiguration(ctx context.Context, in
 	return out, nil
 }
 
+func (c *configurationServiceClient) ValidateWorkspaceConfig(ctx context.Context, in *ValidateWorkspaceConfigRequest, opts ...grpc.CallOption) (*ValidateWorkspaceConfigResponse, error) {
+	out := new(ValidateWorkspaceConfigResponse)
+	err := c.cc.Invoke(ctx, "/gitpod.v1.ConfigurationService/ValidateWorkspaceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ConfigurationServiceServer is the server API for ConfigurationService service.
 // All implementations must embed UnimplementedConfigurationServiceServer
 // for forward compatibility
@@ -105,6 +116,8 @@ type ConfigurationServiceServer interface {
 	UpdateConfiguration(context.Context, *UpdateConfigurationRequest) (*UpdateConfigurationResponse, error)
 	// Deletes a configuration.
 	DeleteConfiguration(context.Context, *DeleteConfigurationRequest) (*DeleteConfigurationResponse, error)
+	// Validates a workspace configuration (.gitpod.yml) and reports the problems found in it.
+	ValidateWorkspaceConfig(context.Context, *ValidateWorkspaceConfigRequest) (*ValidateWorkspaceConfigResponse, error)
 	mustEmbedUnimplementedConfigurationServiceServer()
 }
 
@@ -127,6 +140,9 @@ func (UnimplementedConfigurationServiceServer) UpdateConfiguration(context.Conte
 func (UnimplementedConfigurationServiceServer) DeleteConfiguration(context.Context, *DeleteConfigurationRequest) (*DeleteConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfiguration not implemented")
 }
+func (UnimplementedConfigurationServiceServer) ValidateWorkspaceConfig(context.Context, *ValidateWorkspaceConfigRequest) (*ValidateWorkspaceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateWorkspaceConfig not implemented")
+}
 func (UnimplementedConfigurationServiceServer) mustEmbedUnimplementedConfigurationServiceServer() {}
 
 // UnsafeConfigurationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -230,6 +246,24 @@ func _ConfigurationService_DeleteConfiguration_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ConfigurationService_ValidateWorkspaceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateWorkspaceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigurationServiceServer).ValidateWorkspaceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitpod.v1.ConfigurationService/ValidateWorkspaceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigurationServiceServer).ValidateWorkspaceConfig(ctx, req.(*ValidateWorkspaceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ConfigurationService_ServiceDesc is the grpc.ServiceDesc for ConfigurationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -257,6 +291,10 @@ var ConfigurationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteConfiguration",
 			Handler:    _ConfigurationService_DeleteConfiguration_Handler,
 		},
+		{
+			MethodName: "ValidateWorkspaceConfig",
+			Handler:    _ConfigurationService_ValidateWorkspaceConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gitpod/v1/configuration.proto",
@@ -68,3 +68,13 @@ func (s *ProxyConfigurationServiceHandler) DeleteConfiguration(ctx context.Conte
 
 	return connect_go.NewResponse(resp), nil
 }
+
+func (s *ProxyConfigurationServiceHandler) ValidateWorkspaceConfig(ctx context.Context, req *connect_go.Request[v1.ValidateWorkspaceConfigRequest]) (*connect_go.Response[v1.ValidateWorkspaceConfigResponse], error) {
+	resp, err := s.Client.ValidateWorkspaceConfig(ctx, req.Msg)
+	if err != nil {
+		// TODO(milan): Convert to correct status code
+		return nil, err
+	}
+
+	return connect_go.NewResponse(resp), nil
+}
@@ -73,6 +73,9 @@ type WorkspaceServiceClient interface {
 	// GetWorkspaceEditorCredentials returns an credentials that is used in editor
 	// to encrypt and decrypt secrets
 	GetWorkspaceEditorCredentials(context.Context, *connect_go.Request[v1.GetWorkspaceEditorCredentialsRequest]) (*connect_go.Response[v1.GetWorkspaceEditorCredentialsResponse], error)
+	// GetWorkspaceSSHAccess returns the credentials and the SSH gateway host
+	// needed to establish an SSH session with a running workspace.
+	GetWorkspaceSSHAccess(context.Context, *connect_go.Request[v1.GetWorkspaceSSHAccessRequest]) (*connect_go.Response[v1.GetWorkspaceSSHAccessResponse], error)
 	// CreateWorkspaceSnapshot creates a snapshot of the workspace that can be
 	// shared with others.
 	CreateWorkspaceSnapshot(context.Context, *connect_go.Request[v1.CreateWorkspaceSnapshotRequest]) (*connect_go.Response[v1.CreateWorkspaceSnapshotResponse], error)
@@ -167,6 +170,11 @@ func NewWorkspaceServiceClient(httpClient connect_go.HTTPClient, baseURL string,
 			baseURL+"/gitpod.v1.WorkspaceService/GetWorkspaceEditorCredentials",
 			opts...,
 		),
+		getWorkspaceSSHAccess: connect_go.NewClient[v1.GetWorkspaceSSHAccessRequest, v1.GetWorkspaceSSHAccessResponse](
+			httpClient,
+			baseURL+"/gitpod.v1.WorkspaceService/GetWorkspaceSSHAccess",
+			opts...,
+		),
 		createWorkspaceSnapshot: connect_go.NewClient[v1.CreateWorkspaceSnapshotRequest, v1.CreateWorkspaceSnapshotResponse](
 			httpClient,
 			baseURL+"/gitpod.v1.WorkspaceService/CreateWorkspaceSnapshot",
@@ -202,6 +210,7 @@ type workspaceServiceClient struct {
 	sendHeartBeat                 *connect_go.Client[v1.SendHeartBeatRequest, v1.SendHeartBeatResponse]
 	getWorkspaceOwnerToken        *connect_go.Client[v1.GetWorkspaceOwnerTokenRequest, v1.GetWorkspaceOwnerTokenResponse]
 	getWorkspaceEditorCredentials *connect_go.Client[v1.GetWorkspaceEditorCredentialsRequest, v1.GetWorkspaceEditorCredentialsResponse]
+	getWorkspaceSSHAccess         *connect_go.Client[v1.GetWorkspaceSSHAccessRequest, v1.GetWorkspaceSSHAccessResponse]
 	createWorkspaceSnapshot       *connect_go.Client[v1.CreateWorkspaceSnapshotRequest, v1.CreateWorkspaceSnapshotResponse]
 	waitForWorkspaceSnapshot      *connect_go.Client[v1.WaitForWorkspaceSnapshotRequest, v1.WaitForWorkspaceSnapshotResponse]
 	updateWorkspacePort           *connect_go.Client[v1.UpdateWorkspacePortRequest, v1.UpdateWorkspacePortResponse]
@@ -282,6 +291,11 @@ func (c *workspaceServiceClient) GetWorkspaceEditorCredentials(ctx context.Conte
 	return c.getWorkspaceEditorCredentials.CallUnary(ctx, req)
 }
 
+// GetWorkspaceSSHAccess calls gitpod.v1.WorkspaceService.GetWorkspaceSSHAccess.
+func (c *workspaceServiceClient) GetWorkspaceSSHAccess(ctx context.Context, req *connect_go.Request[v1.GetWorkspaceSSHAccessRequest]) (*connect_go.Response[v1.GetWorkspaceSSHAccessResponse], error) {
+	return c.getWorkspaceSSHAccess.CallUnary(ctx, req)
+}
+
 // CreateWorkspaceSnapshot calls gitpod.v1.WorkspaceService.CreateWorkspaceSnapshot.
 func (c *workspaceServiceClient) CreateWorkspaceSnapshot(ctx context.Context, req *connect_go.Request[v1.CreateWorkspaceSnapshotRequest]) (*connect_go.Response[v1.CreateWorkspaceSnapshotResponse], error) {
 	return c.createWorkspaceSnapshot.CallUnary(ctx, req)
@@ -341,6 +355,9 @@ type WorkspaceServiceHandler interface {
 	// GetWorkspaceEditorCredentials returns an credentials that is used in editor
 	// to encrypt and decrypt secrets
 	GetWorkspaceEditorCredentials(context.Context, *connect_go.Request[v1.GetWorkspaceEditorCredentialsRequest]) (*connect_go.Response[v1.GetWorkspaceEditorCredentialsResponse], error)
+	// GetWorkspaceSSHAccess returns the credentials and the SSH gateway host
+	// needed to establish an SSH session with a running workspace.
+	GetWorkspaceSSHAccess(context.Context, *connect_go.Request[v1.GetWorkspaceSSHAccessRequest]) (*connect_go.Response[v1.GetWorkspaceSSHAccessResponse], error)
 	// CreateWorkspaceSnapshot creates a snapshot of the workspace that can be
 	// shared with others.
 	CreateWorkspaceSnapshot(context.Context, *connect_go.Request[v1.CreateWorkspaceSnapshotRequest]) (*connect_go.Response[v1.CreateWorkspaceSnapshotResponse], error)
@@ -432,6 +449,11 @@ func NewWorkspaceServiceHandler(svc WorkspaceServiceHandler, opts ...connect_go.
 		svc.GetWorkspaceEditorCredentials,
 		opts...,
 	))
+	mux.Handle("/gitpod.v1.WorkspaceService/GetWorkspaceSSHAccess", connect_go.NewUnaryHandler(
+		"/gitpod.v1.WorkspaceService/GetWorkspaceSSHAccess",
+		svc.GetWorkspaceSSHAccess,
+		opts...,
+	))
 	mux.Handle("/gitpod.v1.WorkspaceService/CreateWorkspaceSnapshot", connect_go.NewUnaryHandler(
 		"/gitpod.v1.WorkspaceService/CreateWorkspaceSnapshot",
 		svc.CreateWorkspaceSnapshot,
@@ -513,6 +535,10 @@ func (UnimplementedWorkspaceServiceHandler) GetWorkspaceEditorCredentials(contex
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("gitpod.v1.WorkspaceService.GetWorkspaceEditorCredentials is not implemented"))
 }
 
+func (UnimplementedWorkspaceServiceHandler) GetWorkspaceSSHAccess(context.Context, *connect_go.Request[v1.GetWorkspaceSSHAccessRequest]) (*connect_go.Response[v1.GetWorkspaceSSHAccessResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("gitpod.v1.WorkspaceService.GetWorkspaceSSHAccess is not implemented"))
+}
+
 func (UnimplementedWorkspaceServiceHandler) CreateWorkspaceSnapshot(context.Context, *connect_go.Request[v1.CreateWorkspaceSnapshotRequest]) (*connect_go.Response[v1.CreateWorkspaceSnapshotResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("gitpod.v1.WorkspaceService.CreateWorkspaceSnapshot is not implemented"))
 }
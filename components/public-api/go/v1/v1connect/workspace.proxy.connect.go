@@ -159,6 +159,16 @@ func (s *ProxyWorkspaceServiceHandler) GetWorkspaceEditorCredentials(ctx context
 	return connect_go.NewResponse(resp), nil
 }
 
+func (s *ProxyWorkspaceServiceHandler) GetWorkspaceSSHAccess(ctx context.Context, req *connect_go.Request[v1.GetWorkspaceSSHAccessRequest]) (*connect_go.Response[v1.GetWorkspaceSSHAccessResponse], error) {
+	resp, err := s.Client.GetWorkspaceSSHAccess(ctx, req.Msg)
+	if err != nil {
+		// TODO(milan): Convert to correct status code
+		return nil, err
+	}
+
+	return connect_go.NewResponse(resp), nil
+}
+
 func (s *ProxyWorkspaceServiceHandler) CreateWorkspaceSnapshot(ctx context.Context, req *connect_go.Request[v1.CreateWorkspaceSnapshotRequest]) (*connect_go.Response[v1.CreateWorkspaceSnapshotResponse], error) {
 	resp, err := s.Client.CreateWorkspaceSnapshot(ctx, req.Msg)
 	if err != nil {
@@ -68,6 +68,9 @@ type WorkspaceServiceClient interface {
 	// GetWorkspaceEditorCredentials returns an credentials that is used in editor
 	// to encrypt and decrypt secrets
 	GetWorkspaceEditorCredentials(ctx context.Context, in *GetWorkspaceEditorCredentialsRequest, opts ...grpc.CallOption) (*GetWorkspaceEditorCredentialsResponse, error)
+	// GetWorkspaceSSHAccess returns the credentials and the SSH gateway host
+	// needed to establish an SSH session with a running workspace.
+	GetWorkspaceSSHAccess(ctx context.Context, in *GetWorkspaceSSHAccessRequest, opts ...grpc.CallOption) (*GetWorkspaceSSHAccessResponse, error)
 	// CreateWorkspaceSnapshot creates a snapshot of the workspace that can be
 	// shared with others.
 	CreateWorkspaceSnapshot(ctx context.Context, in *CreateWorkspaceSnapshotRequest, opts ...grpc.CallOption) (*CreateWorkspaceSnapshotResponse, error)
@@ -243,6 +246,15 @@ func (c *workspaceServiceClient) GetWorkspaceEditorCredentials(ctx context.Conte
 	return out, nil
 }
 
+func (c *workspaceServiceClient) GetWorkspaceSSHAccess(ctx context.Context, in *GetWorkspaceSSHAccessRequest, opts ...grpc.CallOption) (*GetWorkspaceSSHAccessResponse, error) {
+	out := new(GetWorkspaceSSHAccessResponse)
+	err := c.cc.Invoke(ctx, "/gitpod.v1.WorkspaceService/GetWorkspaceSSHAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) CreateWorkspaceSnapshot(ctx context.Context, in *CreateWorkspaceSnapshotRequest, opts ...grpc.CallOption) (*CreateWorkspaceSnapshotResponse, error) {
 	out := new(CreateWorkspaceSnapshotResponse)
 	err := c.cc.Invoke(ctx, "/gitpod.v1.WorkspaceService/CreateWorkspaceSnapshot", in, out, opts...)
@@ -316,6 +328,9 @@ type WorkspaceServiceServer interface {
 	// GetWorkspaceEditorCredentials returns an credentials that is used in editor
 	// to encrypt and decrypt secrets
 	GetWorkspaceEditorCredentials(context.Context, *GetWorkspaceEditorCredentialsRequest) (*GetWorkspaceEditorCredentialsResponse, error)
+	// GetWorkspaceSSHAccess returns the credentials and the SSH gateway host
+	// needed to establish an SSH session with a running workspace.
+	GetWorkspaceSSHAccess(context.Context, *GetWorkspaceSSHAccessRequest) (*GetWorkspaceSSHAccessResponse, error)
 	// CreateWorkspaceSnapshot creates a snapshot of the workspace that can be
 	// shared with others.
 	CreateWorkspaceSnapshot(context.Context, *CreateWorkspaceSnapshotRequest) (*CreateWorkspaceSnapshotResponse, error)
@@ -375,6 +390,9 @@ func (UnimplementedWorkspaceServiceServer) GetWorkspaceOwnerToken(context.Contex
 func (UnimplementedWorkspaceServiceServer) GetWorkspaceEditorCredentials(context.Context, *GetWorkspaceEditorCredentialsRequest) (*GetWorkspaceEditorCredentialsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceEditorCredentials not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceSSHAccess(context.Context, *GetWorkspaceSSHAccessRequest) (*GetWorkspaceSSHAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceSSHAccess not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) CreateWorkspaceSnapshot(context.Context, *CreateWorkspaceSnapshotRequest) (*CreateWorkspaceSnapshotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceSnapshot not implemented")
 }
@@ -670,6 +688,24 @@ func _WorkspaceService_GetWorkspaceEditorCredentials_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_GetWorkspaceSSHAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceSSHAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetWorkspaceSSHAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitpod.v1.WorkspaceService/GetWorkspaceSSHAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetWorkspaceSSHAccess(ctx, req.(*GetWorkspaceSSHAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_CreateWorkspaceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateWorkspaceSnapshotRequest)
 	if err := dec(in); err != nil {
@@ -787,6 +823,10 @@ var WorkspaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkspaceEditorCredentials",
 			Handler:    _WorkspaceService_GetWorkspaceEditorCredentials_Handler,
 		},
+		{
+			MethodName: "GetWorkspaceSSHAccess",
+			Handler:    _WorkspaceService_GetWorkspaceSSHAccess_Handler,
+		},
 		{
 			MethodName: "CreateWorkspaceSnapshot",
 			Handler:    _WorkspaceService_CreateWorkspaceSnapshot_Handler,
@@ -1043,6 +1043,7 @@ export class PublicAPIConverter {
             workspaceSharingDisabled: !!settings.workspaceSharingDisabled,
             defaultWorkspaceImage: settings.defaultWorkspaceImage || undefined,
             allowedWorkspaceClasses: settings.allowedWorkspaceClasses || [],
+            defaultWorkspaceClass: settings.defaultWorkspaceClass || undefined,
             pinnedEditorVersions: settings.pinnedEditorVersions || {},
             restrictedEditorNames: settings.restrictedEditorNames || [],
             defaultRole: settings.defaultRole || undefined,
@@ -9,7 +9,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateConfigurationRequest, CreateConfigurationResponse, DeleteConfigurationRequest, DeleteConfigurationResponse, GetConfigurationRequest, GetConfigurationResponse, ListConfigurationsRequest, ListConfigurationsResponse, UpdateConfigurationRequest, UpdateConfigurationResponse } from "./configuration_pb.js";
+import { CreateConfigurationRequest, CreateConfigurationResponse, DeleteConfigurationRequest, DeleteConfigurationResponse, GetConfigurationRequest, GetConfigurationResponse, ListConfigurationsRequest, ListConfigurationsResponse, UpdateConfigurationRequest, UpdateConfigurationResponse, ValidateWorkspaceConfigRequest, ValidateWorkspaceConfigResponse } from "./configuration_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -73,5 +73,16 @@ export const ConfigurationService = {
       O: DeleteConfigurationResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Validates a workspace configuration (.gitpod.yml) and reports the problems found in it.
+     *
+     * @generated from rpc gitpod.v1.ConfigurationService.ValidateWorkspaceConfig
+     */
+    validateWorkspaceConfig: {
+      name: "ValidateWorkspaceConfig",
+      I: ValidateWorkspaceConfigRequest,
+      O: ValidateWorkspaceConfigResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
@@ -826,3 +826,163 @@ export class DeleteConfigurationResponse extends Message<DeleteConfigurationResp
     return proto3.util.equals(DeleteConfigurationResponse, a, b);
   }
 }
+
+/**
+ * @generated from message gitpod.v1.ValidateWorkspaceConfigRequest
+ */
+export class ValidateWorkspaceConfigRequest extends Message<ValidateWorkspaceConfigRequest> {
+  /**
+   * content is the content of the .gitpod.yml file
+   *
+   * @generated from field: string content = 1;
+   */
+  content = "";
+
+  constructor(data?: PartialMessage<ValidateWorkspaceConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gitpod.v1.ValidateWorkspaceConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateWorkspaceConfigRequest {
+    return new ValidateWorkspaceConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateWorkspaceConfigRequest {
+    return new ValidateWorkspaceConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateWorkspaceConfigRequest {
+    return new ValidateWorkspaceConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidateWorkspaceConfigRequest | PlainMessage<ValidateWorkspaceConfigRequest> | undefined, b: ValidateWorkspaceConfigRequest | PlainMessage<ValidateWorkspaceConfigRequest> | undefined): boolean {
+    return proto3.util.equals(ValidateWorkspaceConfigRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gitpod.v1.ValidateWorkspaceConfigResponse
+ */
+export class ValidateWorkspaceConfigResponse extends Message<ValidateWorkspaceConfigResponse> {
+  /**
+   * diagnostics lists the problems found in the workspace configuration. It is empty if the configuration is valid.
+   *
+   * @generated from field: repeated gitpod.v1.WorkspaceConfigDiagnostic diagnostics = 1;
+   */
+  diagnostics: WorkspaceConfigDiagnostic[] = [];
+
+  constructor(data?: PartialMessage<ValidateWorkspaceConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gitpod.v1.ValidateWorkspaceConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "diagnostics", kind: "message", T: WorkspaceConfigDiagnostic, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ValidateWorkspaceConfigResponse {
+    return new ValidateWorkspaceConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ValidateWorkspaceConfigResponse {
+    return new ValidateWorkspaceConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ValidateWorkspaceConfigResponse {
+    return new ValidateWorkspaceConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ValidateWorkspaceConfigResponse | PlainMessage<ValidateWorkspaceConfigResponse> | undefined, b: ValidateWorkspaceConfigResponse | PlainMessage<ValidateWorkspaceConfigResponse> | undefined): boolean {
+    return proto3.util.equals(ValidateWorkspaceConfigResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message gitpod.v1.WorkspaceConfigDiagnostic
+ */
+export class WorkspaceConfigDiagnostic extends Message<WorkspaceConfigDiagnostic> {
+  /**
+   * @generated from field: gitpod.v1.WorkspaceConfigDiagnostic.Severity severity = 1;
+   */
+  severity = WorkspaceConfigDiagnostic_Severity.UNSPECIFIED;
+
+  /**
+   * path points to the offending element as a JSON pointer, e.g. "/tasks/0/init".
+   * It is empty for problems concerning the file as a whole.
+   *
+   * @generated from field: string path = 2;
+   */
+  path = "";
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<WorkspaceConfigDiagnostic>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gitpod.v1.WorkspaceConfigDiagnostic";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "severity", kind: "enum", T: proto3.getEnumType(WorkspaceConfigDiagnostic_Severity) },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkspaceConfigDiagnostic {
+    return new WorkspaceConfigDiagnostic().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkspaceConfigDiagnostic {
+    return new WorkspaceConfigDiagnostic().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkspaceConfigDiagnostic {
+    return new WorkspaceConfigDiagnostic().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkspaceConfigDiagnostic | PlainMessage<WorkspaceConfigDiagnostic> | undefined, b: WorkspaceConfigDiagnostic | PlainMessage<WorkspaceConfigDiagnostic> | undefined): boolean {
+    return proto3.util.equals(WorkspaceConfigDiagnostic, a, b);
+  }
+}
+
+/**
+ * @generated from enum gitpod.v1.WorkspaceConfigDiagnostic.Severity
+ */
+export enum WorkspaceConfigDiagnostic_Severity {
+  /**
+   * @generated from enum value: SEVERITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The workspace configuration cannot be used as is.
+   *
+   * @generated from enum value: SEVERITY_ERROR = 1;
+   */
+  ERROR = 1,
+
+  /**
+   * The workspace configuration can be used, but likely doesn't do what was intended.
+   *
+   * @generated from enum value: SEVERITY_WARNING = 2;
+   */
+  WARNING = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WorkspaceConfigDiagnostic_Severity)
+proto3.util.setEnumType(WorkspaceConfigDiagnostic_Severity, "gitpod.v1.WorkspaceConfigDiagnostic.Severity", [
+  { no: 0, name: "SEVERITY_UNSPECIFIED" },
+  { no: 1, name: "SEVERITY_ERROR" },
+  { no: 2, name: "SEVERITY_WARNING" },
+]);
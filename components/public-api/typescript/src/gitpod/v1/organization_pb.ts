@@ -208,6 +208,11 @@ export class OrganizationSettings extends Message<OrganizationSettings> {
    */
   defaultRole = "";
 
+  /**
+   * @generated from field: string default_workspace_class = 7;
+   */
+  defaultWorkspaceClass = "";
+
   constructor(data?: PartialMessage<OrganizationSettings>) {
     super();
     proto3.util.initPartial(data, this);
@@ -222,6 +227,7 @@ export class OrganizationSettings extends Message<OrganizationSettings> {
     { no: 4, name: "restricted_editor_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 5, name: "pinned_editor_versions", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 6, name: "default_role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "default_workspace_class", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OrganizationSettings {
@@ -486,6 +492,16 @@ export class UpdateOrganizationSettingsRequest extends Message<UpdateOrganizatio
    */
   defaultRole?: string;
 
+  /**
+   * default_workspace_class is the ID of the class used by workspaces of the
+   * organization that do not ask for a class. It must be one of the
+   * allowed_workspace_classes. Pass an empty string to reset to the
+   * installation default.
+   *
+   * @generated from field: optional string default_workspace_class = 11;
+   */
+  defaultWorkspaceClass?: string;
+
   constructor(data?: PartialMessage<UpdateOrganizationSettingsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -503,6 +519,7 @@ export class UpdateOrganizationSettingsRequest extends Message<UpdateOrganizatio
     { no: 8, name: "pinned_editor_versions", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
     { no: 9, name: "update_pinned_editor_versions", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
     { no: 10, name: "default_role", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
+    { no: 11, name: "default_workspace_class", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationSettingsRequest {
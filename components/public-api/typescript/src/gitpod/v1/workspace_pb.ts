@@ -1132,6 +1132,101 @@ export class GetWorkspaceEditorCredentialsResponse extends Message<GetWorkspaceE
   }
 }
 
+/**
+ * @generated from message gitpod.v1.GetWorkspaceSSHAccessRequest
+ */
+export class GetWorkspaceSSHAccessRequest extends Message<GetWorkspaceSSHAccessRequest> {
+  /**
+   * @generated from field: string workspace_id = 1;
+   */
+  workspaceId = "";
+
+  constructor(data?: PartialMessage<GetWorkspaceSSHAccessRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gitpod.v1.GetWorkspaceSSHAccessRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workspace_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWorkspaceSSHAccessRequest {
+    return new GetWorkspaceSSHAccessRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWorkspaceSSHAccessRequest {
+    return new GetWorkspaceSSHAccessRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWorkspaceSSHAccessRequest {
+    return new GetWorkspaceSSHAccessRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWorkspaceSSHAccessRequest | PlainMessage<GetWorkspaceSSHAccessRequest> | undefined, b: GetWorkspaceSSHAccessRequest | PlainMessage<GetWorkspaceSSHAccessRequest> | undefined): boolean {
+    return proto3.util.equals(GetWorkspaceSSHAccessRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message gitpod.v1.GetWorkspaceSSHAccessResponse
+ */
+export class GetWorkspaceSSHAccessResponse extends Message<GetWorkspaceSSHAccessResponse> {
+  /**
+   * host is the SSH gateway host of the workspace
+   *
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  /**
+   * username is the user to log in as when authenticating with an SSH key
+   *
+   * @generated from field: string username = 2;
+   */
+  username = "";
+
+  /**
+   * token authenticates the SSH session without an SSH key. It is either
+   * passed as the password, or appended to the username separated by '#'.
+   * The token is short-lived and only valid for the current instance of the
+   * workspace. Request a new token to establish another SSH session.
+   *
+   * @generated from field: string token = 3;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<GetWorkspaceSSHAccessResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "gitpod.v1.GetWorkspaceSSHAccessResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWorkspaceSSHAccessResponse {
+    return new GetWorkspaceSSHAccessResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWorkspaceSSHAccessResponse {
+    return new GetWorkspaceSSHAccessResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWorkspaceSSHAccessResponse {
+    return new GetWorkspaceSSHAccessResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWorkspaceSSHAccessResponse | PlainMessage<GetWorkspaceSSHAccessResponse> | undefined, b: GetWorkspaceSSHAccessResponse | PlainMessage<GetWorkspaceSSHAccessResponse> | undefined): boolean {
+    return proto3.util.equals(GetWorkspaceSSHAccessResponse, a, b);
+  }
+}
+
 /**
  * +resource get workspace
  *
@@ -76,6 +76,7 @@ func (reg *Registry) handleBlob(ctx context.Context, r *http.Request) http.Handl
 			reg.LayerSource,
 		},
 		ConfigModifier: reg.ConfigModifier,
+		Fetches:        reg.blobFetches,
 
 		Metrics: reg.metrics,
 	}
@@ -103,6 +104,7 @@ type blobHandler struct {
 	IPFS              *IPFSBlobCache
 	AdditionalSources []BlobSource
 	ConfigModifier    ConfigModifier
+	Fetches           *blobFetchGroup
 
 	Metrics *metrics
 }
@@ -145,7 +147,7 @@ func (bh *blobHandler) getBlob(w http.ResponseWriter, r *http.Request) {
 		}
 
 		// 3. upstream registry
-		srcs = append(srcs, proxyingBlobSource{Fetcher: fetcher, Blobs: manifest.Layers})
+		srcs = append(srcs, proxyingBlobSource{Fetcher: fetcher, Blobs: manifest.Layers, Fetches: bh.Fetches})
 
 		srcs = append(srcs, &configBlobSource{Fetcher: fetcher, Spec: bh.Spec, Manifest: manifest, ConfigModifier: bh.ConfigModifier})
 		srcs = append(srcs, bh.AdditionalSources...)
@@ -331,6 +333,9 @@ func (sbs storeBlobSource) GetBlob(ctx context.Context, spec *api.ImageSpec, dgs
 type proxyingBlobSource struct {
 	Fetcher remotes.Fetcher
 	Blobs   []ociv1.Descriptor
+
+	// Fetches coalesces concurrent fetches of the same blob, if set
+	Fetches *blobFetchGroup
 }
 
 func (sbs proxyingBlobSource) Name() string {
@@ -359,7 +364,14 @@ func (pbs proxyingBlobSource) GetBlob(ctx context.Context, spec *api.ImageSpec,
 		return
 	}
 
-	r, err := pbs.Fetcher.Fetch(ctx, src)
+	var r io.ReadCloser
+	if pbs.Fetches != nil {
+		r, err = pbs.Fetches.Fetch(src.Digest, func(ctx context.Context) (io.ReadCloser, error) {
+			return pbs.Fetcher.Fetch(ctx, src)
+		})
+	} else {
+		r, err = pbs.Fetcher.Fetch(ctx, src)
+	}
 	if err != nil {
 		return
 	}
@@ -7,6 +7,7 @@ package registry
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -29,6 +30,7 @@ import (
 	"github.com/ipfs/kubo/repo/fsrepo"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	redis "github.com/redis/go-redis/v9"
 
 	rfapi "github.com/gitpod-io/gitpod/registry-facade/api"
@@ -250,3 +252,80 @@ func (rw *failFirstResponseWriter) Write(buf []byte) (int, error) {
 func (rw *failFirstResponseWriter) WriteHeader(code int) {
 	rw.code = code
 }
+
+func TestGetBlobFallsBackOnUpstreamError(t *testing.T) {
+	const ref = "registry.example.com/base:latest"
+	layer := []byte("layer")
+	layerDgst := digest.FromBytes(layer)
+
+	manifest, err := json.Marshal(ociv1.Manifest{
+		Config: ociv1.Descriptor{MediaType: ociv1.MediaTypeImageConfig, Digest: digest.FromString("config")},
+		Layers: []ociv1.Descriptor{{MediaType: ociv1.MediaTypeImageLayerGzip, Digest: layerDgst, Size: int64(len(layer))}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifestDgst := digest.FromBytes(manifest)
+	manifestDesc, err := json.Marshal(ociv1.Descriptor{MediaType: ociv1.MediaTypeImageManifest, Digest: manifestDgst, Size: int64(len(manifest))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// upstream serves the manifest, but fails to serve the layer
+	upstream := &fakeFetcher{Content: map[string][]byte{
+		ref:                    manifestDesc,
+		manifestDgst.Encoded(): manifest,
+	}}
+
+	fetches := &blobFetchGroup{Dir: t.TempDir()}
+	proxy := proxyingBlobSource{Fetcher: upstream, Blobs: []ociv1.Descriptor{{Digest: layerDgst}}, Fetches: fetches}
+	_, _, _, rc, err := proxy.GetBlob(context.Background(), nil, layerDgst)
+	if err == nil {
+		rc.Close()
+		t.Fatal("expected the upstream error when fetching the blob")
+	}
+
+	bh := &blobHandler{
+		Context: context.Background(),
+		Digest:  layerDgst,
+		Name:    "unittest",
+
+		Spec:     &rfapi.ImageSpec{BaseRef: ref},
+		Resolver: upstream,
+		Store:    &alwaysNotFoundStore{},
+		AdditionalSources: []BlobSource{
+			staticBlobSource{Digest: layerDgst, MediaType: "application/x-fallback", Content: layer},
+		},
+		Fetches: fetches,
+	}
+
+	w := httptest.NewRecorder()
+	bh.getBlob(w, httptest.NewRequest("GET", "http://example.com", nil))
+
+	if w.Code != http.StatusOK {
+		t.Errorf("unexpected status code %d", w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/x-fallback" {
+		t.Errorf("expected the blob to be served by the fallback source, got content type %q", ct)
+	}
+	if !bytes.Equal(w.Body.Bytes(), layer) {
+		t.Errorf("unexpected blob content %q", w.Body.Bytes())
+	}
+}
+
+type staticBlobSource struct {
+	Digest    digest.Digest
+	MediaType string
+	Content   []byte
+}
+
+func (s staticBlobSource) Name() string {
+	return "static"
+}
+
+func (s staticBlobSource) HasBlob(ctx context.Context, spec *rfapi.ImageSpec, dgst digest.Digest) bool {
+	return dgst == s.Digest
+}
+
+func (s staticBlobSource) GetBlob(ctx context.Context, spec *rfapi.ImageSpec, dgst digest.Digest) (dontCache bool, mediaType string, url string, data io.ReadCloser, err error) {
+	return true, s.MediaType, "", io.NopCloser(bytes.NewReader(s.Content)), nil
+}
@@ -0,0 +1,242 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+// blobFetchGroup coalesces concurrent fetches of the same blob into a single upstream request.
+// The blob is spooled to a temporary file while it's downloaded, from which all requesters read
+// as soon as the data arrives.
+type blobFetchGroup struct {
+	// Dir is the directory blobs are spooled to. Defaults to the system's temporary directory.
+	Dir string
+
+	// Coalesced is called whenever a fetch joins another one which is in flight already
+	Coalesced func()
+
+	mu       sync.Mutex
+	inflight map[digest.Digest]*sharedFetch
+}
+
+// Fetch returns a reader for the blob. If the blob is not fetched already, fetch is called to download it.
+// Fetch returns once upstream has responded, so that a failing fetch surfaces as error rather than on the first
+// Read and the requester can fall back to another source.
+// The download is independent of the context of any requester and is cancelled once all readers are closed.
+func (g *blobFetchGroup) Fetch(dgst digest.Digest, fetch func(ctx context.Context) (io.ReadCloser, error)) (io.ReadCloser, error) {
+	f, r, err := g.join(dgst, fetch)
+	if err != nil {
+		return nil, err
+	}
+
+	<-f.started
+	if f.startErr != nil {
+		r.Close()
+		return nil, f.startErr
+	}
+	return r, nil
+}
+
+// join returns a reader for the fetch of the blob which is in flight, or starts a new fetch
+func (g *blobFetchGroup) join(dgst digest.Digest, fetch func(ctx context.Context) (io.ReadCloser, error)) (*sharedFetch, io.ReadCloser, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if f, ok := g.inflight[dgst]; ok {
+		if r := f.newReader(); r != nil {
+			if g.Coalesced != nil {
+				g.Coalesced()
+			}
+			return f, r, nil
+		}
+	}
+
+	spool, err := os.CreateTemp(g.Dir, "blob-"+dgst.Encoded()+"-*")
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot create spool file: %w", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &sharedFetch{
+		spool:   spool,
+		cancel:  cancel,
+		started: make(chan struct{}),
+	}
+	f.cond = sync.NewCond(&f.mu)
+	r := f.newReader()
+
+	if g.inflight == nil {
+		g.inflight = make(map[digest.Digest]*sharedFetch)
+	}
+	g.inflight[dgst] = f
+
+	go func() {
+		f.download(ctx, spool, fetch)
+
+		g.mu.Lock()
+		if g.inflight[dgst] == f {
+			delete(g.inflight, dgst)
+		}
+		g.mu.Unlock()
+	}()
+
+	return f, r, nil
+}
+
+// sharedFetch is a single download of a blob shared by all its readers
+type sharedFetch struct {
+	spool  *os.File
+	cancel context.CancelFunc
+
+	// started is closed once upstream has responded. startErr is the error fetch returned, if any.
+	started  chan struct{}
+	startErr error
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	written int64
+	done    bool
+	err     error
+	readers int
+}
+
+// download writes the blob to the spool file. The spool is passed in as the fetch drops it once all readers are gone.
+func (f *sharedFetch) download(ctx context.Context, spool *os.File, fetch func(ctx context.Context) (io.ReadCloser, error)) {
+	rc, err := fetch(ctx)
+	f.startErr = err
+	close(f.started)
+	if err != nil {
+		f.finish(err)
+		return
+	}
+	defer rc.Close()
+
+	bp := bufPool.Get().(*[]byte)
+	defer bufPool.Put(bp)
+	buf := *bp
+	var off int64
+	for {
+		n, rerr := rc.Read(buf)
+		if n > 0 {
+			_, werr := spool.WriteAt(buf[:n], off)
+			if werr != nil {
+				f.finish(werr)
+				return
+			}
+			off += int64(n)
+			f.mu.Lock()
+			f.written = off
+			f.mu.Unlock()
+			f.cond.Broadcast()
+		}
+		if rerr == io.EOF {
+			f.finish(nil)
+			return
+		}
+		if rerr != nil {
+			f.finish(rerr)
+			return
+		}
+	}
+}
+
+func (f *sharedFetch) finish(err error) {
+	f.mu.Lock()
+	f.done = true
+	f.err = err
+	f.mu.Unlock()
+	f.cond.Broadcast()
+}
+
+// newReader returns a reader for the blob, or nil if the download failed or all readers have been closed already
+func (f *sharedFetch) newReader() io.ReadCloser {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil || f.spool == nil {
+		return nil
+	}
+	f.readers++
+	return &sharedFetchReader{fetch: f}
+}
+
+func (f *sharedFetch) release() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.readers--
+	if f.readers > 0 {
+		return
+	}
+
+	// the last reader is gone - there's no one left to serve the blob to
+	f.cancel()
+	f.done = true
+	f.cond.Broadcast()
+
+	fn := f.spool.Name()
+	f.spool.Close()
+	f.spool = nil
+	err := os.Remove(fn)
+	if err != nil {
+		log.WithError(err).WithField("fn", fn).Warn("cannot remove blob spool file")
+	}
+}
+
+type sharedFetchReader struct {
+	fetch  *sharedFetch
+	off    int64
+	closed bool
+}
+
+// Read reads from the spool file, waiting for the download to catch up if necessary
+func (r *sharedFetchReader) Read(b []byte) (n int, err error) {
+	f := r.fetch
+
+	f.mu.Lock()
+	for r.off >= f.written && !f.done {
+		f.cond.Wait()
+	}
+	var (
+		written = f.written
+		spool   = f.spool
+		ferr    = f.err
+	)
+	f.mu.Unlock()
+
+	if r.off >= written {
+		if ferr != nil {
+			return 0, ferr
+		}
+		return 0, io.EOF
+	}
+	if int64(len(b)) > written-r.off {
+		b = b[:written-r.off]
+	}
+	n, err = spool.ReadAt(b, r.off)
+	r.off += int64(n)
+	if err == io.EOF {
+		// the download might not be finished yet
+		err = nil
+	}
+	return n, err
+}
+
+func (r *sharedFetchReader) Close() error {
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+	r.fetch.release()
+	return nil
+}
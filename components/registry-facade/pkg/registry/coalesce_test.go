@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+)
+
+func TestBlobFetchGroup(t *testing.T) {
+	content := bytes.Repeat([]byte("layer"), 10000)
+	dgst := digest.FromBytes(content)
+
+	var (
+		fetches   int32
+		coalesced int32
+		release   = make(chan struct{})
+	)
+	g := &blobFetchGroup{
+		Dir:       t.TempDir(),
+		Coalesced: func() { atomic.AddInt32(&coalesced, 1) },
+	}
+	fetch := func(ctx context.Context) (io.ReadCloser, error) {
+		atomic.AddInt32(&fetches, 1)
+		pr, pw := io.Pipe()
+		go func() {
+			// hand out the first half of the blob right away so that readers stream while the fetch is in flight
+			_, _ = pw.Write(content[:len(content)/2])
+			<-release
+			_, _ = pw.Write(content[len(content)/2:])
+			pw.Close()
+		}()
+		return pr, nil
+	}
+
+	const requesters = 10
+	var readers []io.ReadCloser
+	for i := 0; i < requesters; i++ {
+		r, err := g.Fetch(dgst, fetch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		readers = append(readers, r)
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]byte, requesters)
+	for i, r := range readers {
+		wg.Add(1)
+		go func(i int, r io.ReadCloser) {
+			defer wg.Done()
+			defer r.Close()
+			results[i], _ = io.ReadAll(r)
+		}(i, r)
+	}
+	close(release)
+	wg.Wait()
+
+	if fetches := atomic.LoadInt32(&fetches); fetches != 1 {
+		t.Errorf("expected a single upstream fetch, got %d", fetches)
+	}
+	if coalesced := atomic.LoadInt32(&coalesced); coalesced != requesters-1 {
+		t.Errorf("expected %d coalesced fetches, got %d", requesters-1, coalesced)
+	}
+	for i, res := range results {
+		if !bytes.Equal(content, res) {
+			t.Errorf("requester %d received %d bytes instead of the blob", i, len(res))
+		}
+	}
+}
+
+func TestBlobFetchGroupFailedFetch(t *testing.T) {
+	dgst := digest.FromString("blob")
+	errFetch := errors.New("upstream unavailable")
+
+	g := &blobFetchGroup{Dir: t.TempDir()}
+	_, err := g.Fetch(dgst, func(ctx context.Context) (io.ReadCloser, error) {
+		return nil, errFetch
+	})
+	if !errors.Is(err, errFetch) {
+		t.Errorf("expected fetch error, got %v", err)
+	}
+
+	// a failed fetch must not be joined, but retried
+	r, err := g.Fetch(dgst, func(ctx context.Context) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader([]byte("blob"))), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	res, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res) != "blob" {
+		t.Errorf("unexpected blob content %q", res)
+	}
+}
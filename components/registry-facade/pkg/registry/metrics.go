@@ -57,6 +57,7 @@ type metrics struct {
 	BlobDownloadSizeCounter *prometheus.CounterVec
 	BlobDownloadCounter     *prometheus.CounterVec
 	BlobDownloadSpeedHist   *prometheus.HistogramVec
+	BlobCoalescedCounter    prometheus.Counter
 }
 
 func newMetrics(reg prometheus.Registerer, upstream bool) (*metrics, error) {
@@ -120,6 +121,17 @@ func newMetrics(reg prometheus.Registerer, upstream bool) (*metrics, error) {
 		}
 	}
 
+	blobCoalescedCounter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "blob_req_coalesced_total",
+		Help: "number of blob downloads which joined a download of the same blob already in flight",
+	})
+	if upstream {
+		err = reg.Register(blobCoalescedCounter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &metrics{
 		ManifestHist:            manifestHist,
 		ReqFailedCounter:        reqFailedCounter,
@@ -127,5 +139,6 @@ func newMetrics(reg prometheus.Registerer, upstream bool) (*metrics, error) {
 		BlobDownloadSpeedHist:   blobDownloadSpeedHist,
 		BlobDownloadSizeCounter: blobDownloadSizeCounter,
 		BlobDownloadCounter:     blobDownloadCounter,
+		BlobCoalescedCounter:    blobCoalescedCounter,
 	}, nil
 }
@@ -81,6 +81,7 @@ type Registry struct {
 	SpecProvider   map[string]ImageSpecProvider
 
 	staticLayerSource *RevisioningLayerSource
+	blobFetches       *blobFetchGroup
 	metrics           *metrics
 	srv               *http.Server
 }
@@ -242,7 +243,10 @@ func NewRegistry(cfg config.Config, newResolver ResolverProvider, reg prometheus
 		LayerSource:       layerSource,
 		staticLayerSource: staticLayer,
 		ConfigModifier:    NewConfigModifierFromLayerSource(layerSource),
-		metrics:           metrics,
+		blobFetches: &blobFetchGroup{
+			Coalesced: metrics.BlobCoalescedCounter.Inc,
+		},
+		metrics: metrics,
 	}, nil
 }
 
@@ -19,6 +19,10 @@ import {
     ListConfigurationsResponse,
     PrebuildSettings,
     UpdateConfigurationRequest,
+    ValidateWorkspaceConfigRequest,
+    ValidateWorkspaceConfigResponse,
+    WorkspaceConfigDiagnostic,
+    WorkspaceConfigDiagnostic_Severity,
 } from "@gitpod/public-api/lib/gitpod/v1/configuration_pb";
 import { PaginationResponse } from "@gitpod/public-api/lib/gitpod/v1/pagination_pb";
 import { ApplicationError, ErrorCodes } from "@gitpod/gitpod-protocol/lib/messaging/error";
@@ -30,6 +34,9 @@ import { SortOrder } from "@gitpod/public-api/lib/gitpod/v1/sorting_pb";
 import { Project } from "@gitpod/gitpod-protocol";
 import { DeepPartial } from "@gitpod/gitpod-protocol/lib/util/deep-partial";
 import { ContextService } from "../workspace/context-service";
+import { WorkspaceService } from "../workspace/workspace-service";
+import { GitpodFileParser } from "@gitpod/gitpod-protocol/lib/gitpod-file-parser";
+import { log } from "@gitpod/gitpod-protocol/lib/util/logging";
 
 function buildUpdateObject<T extends Record<string, any>>(obj: T): Partial<T> {
     const update: Partial<T> = {};
@@ -58,6 +65,10 @@ export class ConfigurationServiceAPI implements ServiceImpl<typeof Configuration
         private readonly userService: UserService,
         @inject(ContextService)
         private readonly contextService: ContextService,
+        @inject(WorkspaceService)
+        private readonly workspaceService: WorkspaceService,
+        @inject(GitpodFileParser)
+        private readonly gitpodFileParser: GitpodFileParser,
     ) {}
 
     async createConfiguration(
@@ -238,4 +249,58 @@ export class ConfigurationServiceAPI implements ServiceImpl<typeof Configuration
 
         return new DeleteConfigurationResponse();
     }
+
+    async validateWorkspaceConfig(
+        req: ValidateWorkspaceConfigRequest,
+        _: HandlerContext,
+    ): Promise<ValidateWorkspaceConfigResponse> {
+        const user = await this.userService.findUserById(ctxUserId(), ctxUserId());
+        if (!user) {
+            throw new ApplicationError(ErrorCodes.NOT_FOUND, "user not found");
+        }
+
+        const error = (path: string, message: string) =>
+            new WorkspaceConfigDiagnostic({ severity: WorkspaceConfigDiagnostic_Severity.ERROR, path, message });
+        const warning = (path: string, message: string) =>
+            new WorkspaceConfigDiagnostic({ severity: WorkspaceConfigDiagnostic_Severity.WARNING, path, message });
+
+        const { parsedConfig, diagnostics } = this.gitpodFileParser.validate(req.content);
+        const result = diagnostics.map((d) => error(d.path, d.message));
+        if (!parsedConfig || result.length > 0) {
+            // the semantic checks below rely on a config which matches the schema
+            return new ValidateWorkspaceConfigResponse({ diagnostics: result });
+        }
+
+        const ports = new Map<string, number>();
+        (parsedConfig.ports || []).forEach((port, i) => {
+            const key = String(port.port);
+            const first = ports.get(key);
+            if (first !== undefined) {
+                result.push(error(`/ports/${i}/port`, `port ${key} is already declared at /ports/${first}`));
+            } else {
+                ports.set(key, i);
+            }
+        });
+
+        (parsedConfig.tasks || []).forEach((task, i) => {
+            if (!task.before && !task.init && !task.prebuild && !task.command) {
+                result.push(warning(`/tasks/${i}`, "task does not run any command"));
+            }
+        });
+
+        if (typeof parsedConfig.image === "string") {
+            try {
+                await this.workspaceService.validateImageRef({}, user, parsedConfig.image);
+            } catch (err) {
+                if (ApplicationError.hasErrorCode(err) && err.code === ErrorCodes.BAD_REQUEST) {
+                    result.push(error("/image", err.message));
+                } else {
+                    log.warn({ userId: user.id }, "cannot verify workspace image", err, { image: parsedConfig.image });
+                    result.push(warning("/image", "image could not be verified"));
+                }
+            }
+        }
+
+        return new ValidateWorkspaceConfigResponse({ diagnostics: result });
+    }
 }
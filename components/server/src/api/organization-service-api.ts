@@ -269,6 +269,9 @@ export class OrganizationServiceAPI implements ServiceImpl<typeof OrganizationSe
             update.defaultWorkspaceImage = req.defaultWorkspaceImage;
         }
         update.allowedWorkspaceClasses = req.allowedWorkspaceClasses;
+        if (typeof req.defaultWorkspaceClass === "string") {
+            update.defaultWorkspaceClass = req.defaultWorkspaceClass;
+        }
         if (req.updatePinnedEditorVersions) {
             update.pinnedEditorVersions = req.pinnedEditorVersions;
         }
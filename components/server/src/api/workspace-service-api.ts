@@ -21,6 +21,8 @@ import {
     GetWorkspaceDefaultImageResponse,
     GetWorkspaceEditorCredentialsRequest,
     GetWorkspaceEditorCredentialsResponse,
+    GetWorkspaceSSHAccessRequest,
+    GetWorkspaceSSHAccessResponse,
     GetWorkspaceOwnerTokenRequest,
     GetWorkspaceOwnerTokenResponse,
     SendHeartBeatRequest,
@@ -287,6 +289,17 @@ export class WorkspaceServiceAPI implements ServiceImpl<typeof WorkspaceServiceI
         return response;
     }
 
+    async getWorkspaceSSHAccess(
+        req: GetWorkspaceSSHAccessRequest,
+        _: HandlerContext,
+    ): Promise<GetWorkspaceSSHAccessResponse> {
+        if (!req.workspaceId) {
+            throw new ApplicationError(ErrorCodes.BAD_REQUEST, "workspaceId is required");
+        }
+        const access = await this.workspaceService.getSSHAccess(ctxUserId(), req.workspaceId);
+        return new GetWorkspaceSSHAccessResponse(access);
+    }
+
     async updateWorkspace(req: UpdateWorkspaceRequest): Promise<UpdateWorkspaceResponse> {
         if (!req.workspaceId) {
             throw new ApplicationError(ErrorCodes.BAD_REQUEST, "workspaceId is required");
@@ -22,7 +22,13 @@ import { IRateLimiterOptions } from "rate-limiter-flexible";
 export const Config = Symbol("Config");
 export type Config = Omit<
     ConfigSerialized,
-    "hostUrl" | "stripeSecretsFile" | "stripeConfigFile" | "linkedInSecretsFile" | "patSigningKeyFile" | "auth"
+    | "hostUrl"
+    | "stripeSecretsFile"
+    | "stripeConfigFile"
+    | "linkedInSecretsFile"
+    | "patSigningKeyFile"
+    | "sshAccessTokenSigningKeyFile"
+    | "auth"
 > & {
     hostUrl: GitpodHostUrl;
     workspaceDefaults: WorkspaceDefaults;
@@ -32,6 +38,7 @@ export type Config = Omit<
     inactivityPeriodForReposInDays?: number;
 
     patSigningKey: string;
+    sshAccessTokenSigningKey: string;
     admin: {
         loginKey?: string;
         // Absolute file path pointing to a file which contains admin credentials, encoded as JSON.
@@ -69,6 +76,15 @@ export interface WorkspaceDefaults {
     defaultFeatureFlags: NamedWorkspaceFeatureFlag[];
     timeoutDefault?: string;
     timeoutExtended?: string;
+    imagePolicy?: WorkspaceImagePolicy;
+}
+
+/** WorkspaceImagePolicy restricts the registries (or repository prefixes) users can use images from */
+export interface WorkspaceImagePolicy {
+    /** allowedRegistries lists the registries images can be used from. All registries are allowed if empty. */
+    allowedRegistries: string[];
+    /** deniedRegistries lists the registries images must not be used from. Takes precedence over allowedRegistries. */
+    deniedRegistries: string[];
 }
 
 export interface WorkspaceGarbageCollection {
@@ -261,6 +277,12 @@ export interface ConfigSerialized {
      */
     patSigningKeyFile?: string;
 
+    /**
+     * File containing the signing key for short-lived SSH access tokens.
+     * This is the same signing key the SSH gateway of ws-proxy verifies tokens with.
+     */
+    sshAccessTokenSigningKeyFile?: string;
+
     auth: {
         pki: AuthPKIConfig;
         session: {
@@ -390,6 +412,17 @@ export namespace ConfigFile {
             }
         }
 
+        let sshAccessTokenSigningKey = "";
+        if (config.sshAccessTokenSigningKeyFile) {
+            try {
+                sshAccessTokenSigningKey = fs
+                    .readFileSync(filePathTelepresenceAware(config.sshAccessTokenSigningKeyFile), "utf-8")
+                    .trim();
+            } catch (error) {
+                log.error("Could not load SSH access token signing key", error);
+            }
+        }
+
         const authPKI: Config["auth"]["pki"] = {
             signing: {
                 id: config.auth.pki.signing.id,
@@ -420,6 +453,7 @@ export namespace ConfigFile {
             },
             inactivityPeriodForReposInDays,
             patSigningKey,
+            sshAccessTokenSigningKey,
             admin: {
                 ...config.admin,
                 credentialsPath: config.admin.credentialsPath,
@@ -453,6 +453,32 @@ describe("OrganizationService", async () => {
             },
         );
 
+        await assertUpdateSettings(
+            "should update default workspace class",
+            { defaultWorkspaceClass: "default" },
+            {
+                workspaceSharingDisabled: true,
+                defaultWorkspaceImage: "ubuntu",
+                defaultWorkspaceClass: "default",
+            },
+        );
+
+        try {
+            await os.updateSettings(adminId, myOrg.id, { defaultWorkspaceClass: "foo" });
+            expect.fail("should have failed");
+        } catch (err) {
+            expect(err.message).to.equal("workspace class foo is not available", "invalid default class");
+        }
+
+        await assertUpdateSettings(
+            "should reset default workspace class",
+            { defaultWorkspaceClass: "" },
+            {
+                workspaceSharingDisabled: true,
+                defaultWorkspaceImage: "ubuntu",
+            },
+        );
+
         validateDefaultWorkspaceImage = () => {
             throw new Error("invalid image");
         };
@@ -472,5 +498,22 @@ describe("OrganizationService", async () => {
             },
         );
         await assertUpdateSettings("should enable workspace sharing", { workspaceSharingDisabled: false }, {});
+
+        await assertUpdateSettings(
+            "should update command policy",
+            { commandPolicy: { deny: ["curl .*\\|\\s*(ba)?sh"], allow: [], blockedBinaries: ["nc"], audit: true } },
+            {
+                commandPolicy: { deny: ["curl .*\\|\\s*(ba)?sh"], blockedBinaries: ["nc"], audit: true },
+            },
+        );
+
+        try {
+            await os.updateSettings(adminId, myOrg.id, { commandPolicy: { deny: ["foo(?=bar)"] } });
+            expect.fail("should have failed");
+        } catch (err) {
+            expect(err.message).to.equal("invalid commandPolicy pattern: foo(?=bar)", "invalid pattern");
+        }
+
+        await assertUpdateSettings("should reset command policy", { commandPolicy: null }, {});
     });
 });
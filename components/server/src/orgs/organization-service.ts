@@ -6,6 +6,7 @@
 
 import { BUILTIN_INSTLLATION_ADMIN_USER_ID, TeamDB, UserDB } from "@gitpod/gitpod-db/lib";
 import {
+    CommandPolicy,
     OrgMemberInfo,
     OrgMemberRole,
     Organization,
@@ -454,6 +455,36 @@ export class OrganizationService {
                 }
             }
         }
+        if (typeof settings.defaultWorkspaceClass === "string") {
+            const defaultWorkspaceClass = settings.defaultWorkspaceClass.trim();
+            if (defaultWorkspaceClass) {
+                const allClasses = await this.installationService.getInstallationWorkspaceClasses(userId);
+                if (!allClasses.some((e) => e.id === defaultWorkspaceClass)) {
+                    throw new ApplicationError(
+                        ErrorCodes.BAD_REQUEST,
+                        `workspace class ${defaultWorkspaceClass} is not available`,
+                    );
+                }
+                // the default has to be allowed by the classes being set, or the current ones if they remain untouched
+                const allowedWorkspaceClasses =
+                    settings.allowedWorkspaceClasses !== undefined
+                        ? settings.allowedWorkspaceClasses
+                        : (await this.teamDB.findOrgSettings(orgId))?.allowedWorkspaceClasses;
+                if (
+                    allowedWorkspaceClasses &&
+                    allowedWorkspaceClasses.length > 0 &&
+                    !allowedWorkspaceClasses.includes(defaultWorkspaceClass)
+                ) {
+                    throw new ApplicationError(
+                        ErrorCodes.BAD_REQUEST,
+                        "the default workspace class has to be one of the allowed workspace classes.",
+                    );
+                }
+                settings = { ...settings, defaultWorkspaceClass };
+            } else {
+                settings = { ...settings, defaultWorkspaceClass: null };
+            }
+        }
         if (settings.pinnedEditorVersions) {
             const ideConfig = await this.ideService.getIDEConfig({ user: { id: userId } });
             for (const [key, version] of Object.entries(settings.pinnedEditorVersions)) {
@@ -474,6 +505,9 @@ export class OrganizationService {
         if (settings.defaultRole && !TeamMemberRole.isValid(settings.defaultRole)) {
             throw new ApplicationError(ErrorCodes.BAD_REQUEST, "Invalid default role");
         }
+        if (settings.commandPolicy !== undefined) {
+            settings = { ...settings, commandPolicy: this.validateCommandPolicy(settings.commandPolicy) };
+        }
         return this.toSettings(await this.teamDB.setOrgSettings(orgId, settings));
     }
 
@@ -488,6 +522,9 @@ export class OrganizationService {
         if (settings.allowedWorkspaceClasses) {
             result.allowedWorkspaceClasses = settings.allowedWorkspaceClasses;
         }
+        if (settings.defaultWorkspaceClass) {
+            result.defaultWorkspaceClass = settings.defaultWorkspaceClass;
+        }
         if (settings.pinnedEditorVersions) {
             result.pinnedEditorVersions = settings.pinnedEditorVersions;
         }
@@ -497,12 +534,61 @@ export class OrganizationService {
         if (settings.defaultRole) {
             result.defaultRole = settings.defaultRole;
         }
+        if (settings.commandPolicy) {
+            result.commandPolicy = settings.commandPolicy;
+        }
+        return result;
+    }
+
+    /**
+     * Supervisor fails to start workspaces with an invalid command policy, hence we reject those right away.
+     * Returns null for policies which do not restrict anything.
+     */
+    private validateCommandPolicy(policy: CommandPolicy | null): CommandPolicy | null {
+        if (!policy) {
+            return null;
+        }
+        const result: CommandPolicy = {};
+        for (const key of ["deny", "allow", "blockedBinaries"] as const) {
+            const values = policy[key];
+            if (values === undefined || values === null) {
+                continue;
+            }
+            if (!Array.isArray(values) || values.some((v) => typeof v !== "string" || v.trim() === "")) {
+                throw new ApplicationError(ErrorCodes.BAD_REQUEST, `commandPolicy.${key} must be a list of strings`);
+            }
+            if (values.length > 0) {
+                result[key] = values;
+            }
+        }
+        for (const pattern of [...(result.deny || []), ...(result.allow || [])]) {
+            // supervisor uses RE2, which supports neither lookarounds nor backreferences
+            let valid = !/\(\?<?[=!]|\\[1-9]/.test(pattern);
+            try {
+                new RegExp(pattern);
+            } catch (err) {
+                valid = false;
+            }
+            if (!valid) {
+                throw new ApplicationError(ErrorCodes.BAD_REQUEST, `invalid commandPolicy pattern: ${pattern}`);
+            }
+        }
+        if (!result.deny && !result.blockedBinaries) {
+            return null;
+        }
+        if (policy.audit) {
+            result.audit = true;
+        }
         return result;
     }
 
     public async listWorkspaceClasses(userId: string, orgId: string): Promise<SupportedWorkspaceClass[]> {
         const allClasses = await this.installationService.getInstallationWorkspaceClasses(userId);
         const settings = await this.getSettings(userId, orgId);
+        const orgDefault = allClasses.find((e) => e.id === settings.defaultWorkspaceClass);
+        if (orgDefault) {
+            allClasses.forEach((e) => (e.isDefault = e === orgDefault));
+        }
         if (settings && !!settings.allowedWorkspaceClasses && settings.allowedWorkspaceClasses.length > 0) {
             const availableClasses = allClasses.filter((e) => settings.allowedWorkspaceClasses!.includes(e.id));
             const defaultIndexInScope = availableClasses.findIndex((e) => e.isDefault);
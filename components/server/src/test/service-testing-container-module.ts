@@ -291,6 +291,7 @@ const mockApplyingContainerModule = new ContainerModule((bind, unbound, isbound,
         authProviderConfigs: [],
         installationShortname: "gitpod",
         auth: mockAuthConfig,
+        sshAccessTokenSigningKey: "ssh-access-token-signing-key-for-tests",
         prebuildLimiter: {
             "*": {
                 limit: 50,
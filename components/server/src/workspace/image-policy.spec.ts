@@ -0,0 +1,55 @@
+/**
+ * Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+import "reflect-metadata";
+
+import { suite, test } from "@testdeck/mocha";
+import { expect } from "chai";
+import { isImagePermitted, normalizeImageName } from "./image-policy";
+
+@suite
+class TestImagePolicy {
+    @test
+    public testNormalizeImageName() {
+        expect(normalizeImageName("ubuntu")).equals("docker.io/library/ubuntu");
+        expect(normalizeImageName("gitpod/workspace-full:latest")).equals("docker.io/gitpod/workspace-full");
+        expect(normalizeImageName("index.docker.io/gitpod/workspace-full")).equals("docker.io/gitpod/workspace-full");
+        expect(normalizeImageName("localhost:5000/foo:1.0")).equals("localhost:5000/foo");
+        expect(normalizeImageName("eu.gcr.io/gitpod/foo@sha256:abc")).equals("eu.gcr.io/gitpod/foo");
+    }
+
+    @test
+    public testNoPolicy() {
+        expect(isImagePermitted(undefined, "gitpod/workspace-full", "quay.io/foo/bar")).to.be.true;
+        expect(isImagePermitted({ allowedRegistries: [], deniedRegistries: [] }, "", "quay.io/foo/bar")).to.be.true;
+    }
+
+    @test
+    public testAllowedRegistries() {
+        const policy = { allowedRegistries: ["eu.gcr.io", "docker.io/gitpod"], deniedRegistries: [] };
+        expect(isImagePermitted(policy, "", "eu.gcr.io/foo/bar:1.0")).to.be.true;
+        expect(isImagePermitted(policy, "", "gitpod/workspace-node")).to.be.true;
+        expect(isImagePermitted(policy, "", "gitpodfake/workspace-node")).to.be.false;
+        expect(isImagePermitted(policy, "", "ubuntu")).to.be.false;
+    }
+
+    @test
+    public testDeniedRegistries() {
+        const policy = { allowedRegistries: ["docker.io"], deniedRegistries: ["docker.io/library"] };
+        expect(isImagePermitted(policy, "", "gitpod/workspace-full")).to.be.true;
+        expect(isImagePermitted(policy, "", "ubuntu:22.04")).to.be.false;
+    }
+
+    @test
+    public testDefaultImageIsExempt() {
+        const policy = { allowedRegistries: [], deniedRegistries: ["docker.io"] };
+        const defaultImage = "gitpod/workspace-full:latest";
+        expect(isImagePermitted(policy, defaultImage, "docker.io/gitpod/workspace-full:2024-01-01")).to.be.true;
+        expect(isImagePermitted(policy, defaultImage, "gitpod/workspace-base")).to.be.false;
+    }
+}
+
+module.exports = new TestImagePolicy();
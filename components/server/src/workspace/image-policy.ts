@@ -0,0 +1,59 @@
+/**
+ * Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+import { WorkspaceImagePolicy } from "../config";
+
+/**
+ * normalizeImageName returns the fully qualified repository name of an image ref without tag or digest,
+ * e.g. `docker.io/library/ubuntu` for `ubuntu:22.04`. This mirrors how image-builder normalizes refs.
+ */
+export function normalizeImageName(ref: string): string {
+    let name = ref.split("@")[0];
+    const colon = name.lastIndexOf(":");
+    if (colon > name.lastIndexOf("/")) {
+        name = name.substring(0, colon);
+    }
+
+    const slash = name.indexOf("/");
+    const domain = slash === -1 ? "" : name.substring(0, slash);
+    if (slash === -1 || (!domain.includes(".") && !domain.includes(":") && domain !== "localhost")) {
+        name = "docker.io/" + name;
+    } else if (domain === "index.docker.io") {
+        name = "docker.io" + name.substring(slash);
+    }
+    if (name.startsWith("docker.io/") && name.split("/").length === 2) {
+        name = "docker.io/library/" + name.substring("docker.io/".length);
+    }
+    return name.toLowerCase();
+}
+
+function matchesAnyRegistry(registries: string[], name: string): boolean {
+    return registries.some((r) => {
+        r = r.replace(/\/$/, "");
+        return r !== "" && (name === r || name.startsWith(r + "/"));
+    });
+}
+
+/**
+ * isImagePermitted returns true if the image policy of the installation permits using the image ref.
+ * The default workspace image of the installation is always permitted.
+ */
+export function isImagePermitted(policy: WorkspaceImagePolicy | undefined, defaultImage: string, ref: string): boolean {
+    const allowed = policy?.allowedRegistries || [];
+    const denied = policy?.deniedRegistries || [];
+    if (allowed.length === 0 && denied.length === 0) {
+        return true;
+    }
+
+    const name = normalizeImageName(ref);
+    if (name === normalizeImageName(defaultImage)) {
+        return true;
+    }
+    if (matchesAnyRegistry(denied, name)) {
+        return false;
+    }
+    return allowed.length === 0 || matchesAnyRegistry(allowed, name);
+}
@@ -19,10 +19,14 @@ import {
     AdditionalContentContext,
 } from "@gitpod/gitpod-protocol";
 import { createHash } from "crypto";
+import { ApplicationError, ErrorCodes } from "@gitpod/gitpod-protocol/lib/messaging/error";
+import { Config } from "../config";
+import { isImagePermitted } from "./image-policy";
 
 @injectable()
 export class ImageSourceProvider {
     @inject(HostContextProvider) protected readonly hostContextProvider: HostContextProvider;
+    @inject(Config) protected readonly config: Config;
 
     public async getImageSource(
         ctx: TraceContext,
@@ -84,6 +88,13 @@ export class ImageSourceProvider {
                     dockerFileHash: lastDockerFileSha,
                 };
             } else if (typeof imgcfg === "string") {
+                const { imagePolicy, workspaceImage } = this.config.workspaceDefaults;
+                if (!isImagePermitted(imagePolicy, workspaceImage, imgcfg)) {
+                    throw new ApplicationError(
+                        ErrorCodes.PERMISSION_DENIED,
+                        `The image ${imgcfg} is not permitted by the image policy of this installation.`,
+                    );
+                }
                 result = <WorkspaceImageSourceReference>{
                     baseImageResolved: imgcfg,
                 };
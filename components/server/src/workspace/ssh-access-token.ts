@@ -0,0 +1,27 @@
+/**
+ * Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+ * Licensed under the GNU Affero General Public License (AGPL).
+ * See License.AGPL.txt in the project root for license information.
+ */
+
+import * as crypto from "crypto";
+
+/**
+ * How long an SSH access token is valid. The token is only needed to establish an SSH session,
+ * sessions outlive it.
+ */
+export const SSH_ACCESS_TOKEN_LIFETIME_SECONDS = 15 * 60;
+
+/**
+ * signSSHAccessToken issues a token which grants SSH access to a single workspace instance until it expires.
+ * The SSH gateway of ws-proxy verifies it with the same signing key, see ws-proxy/pkg/sshproxy/accesstoken.go.
+ * The token is `<expiry>.<signature>`, where expiry is in seconds since the epoch.
+ */
+export function signSSHAccessToken(signingKey: string, workspaceId: string, instanceId: string, expiry: Date): string {
+    const exp = Math.floor(expiry.getTime() / 1000).toString();
+    const signature = crypto
+        .createHmac("sha256", signingKey)
+        .update(`ssh.${workspaceId}.${instanceId}.${exp}`)
+        .digest("base64url");
+    return `${exp}.${signature}`;
+}
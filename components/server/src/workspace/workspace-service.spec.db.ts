@@ -29,6 +29,7 @@ import { ConfigProvider } from "./config-provider";
 import { UserService } from "../user/user-service";
 import { SYSTEM_USER } from "../authorization/authorizer";
 import { v4 } from "uuid";
+import { SSH_ACCESS_TOKEN_LIFETIME_SECONDS, signSSHAccessToken } from "./ssh-access-token";
 
 const expect = chai.expect;
 
@@ -260,6 +261,59 @@ describe("WorkspaceService", async () => {
         );
     });
 
+    it("should getSSHAccess", async () => {
+        const svc = container.get(WorkspaceService);
+        const db = container.get<WorkspaceDB>(WorkspaceDB);
+        const ws = await createTestWorkspace(svc, org, owner, project);
+
+        await expectError(
+            ErrorCodes.PRECONDITION_FAILED,
+            svc.getSSHAccess(owner.id, ws.id),
+            "PRECONDITION_FAILED for non-running workspace",
+        );
+
+        const instance = await db.storeInstance({
+            id: v4(),
+            workspaceId: ws.id,
+            creationTime: new Date().toISOString(),
+            status: {
+                conditions: {},
+                phase: "running",
+                ownerToken: "owner-token",
+            },
+            region: "us-central1",
+            ideUrl: `https://${ws.id}.ws-us.gitpod.io`,
+            configuration: {
+                ideImage: "",
+            },
+            workspaceImage: "",
+        });
+
+        const access = await svc.getSSHAccess(owner.id, ws.id);
+        expect(access.host).to.equal(`${ws.id}.ssh.ws-us.gitpod.io`);
+        expect(access.username).to.equal(ws.id);
+        expect(access.token).to.not.equal("owner-token", "must not hand out the owner token");
+        const expiry = new Date(Number(access.token.split(".")[0]) * 1000);
+        expect(expiry.getTime()).to.be.greaterThan(Date.now());
+        expect(expiry.getTime()).to.be.at.most(Date.now() + SSH_ACCESS_TOKEN_LIFETIME_SECONDS * 1000);
+        expect(access.token).to.equal(
+            signSSHAccessToken("ssh-access-token-signing-key-for-tests", ws.id, instance.id, expiry),
+            "token must be scoped to the current instance",
+        );
+
+        await expectError(
+            ErrorCodes.PERMISSION_DENIED,
+            svc.getSSHAccess(member.id, ws.id),
+            "PERMISSION_DENIED if member asks for SSH access",
+        );
+
+        await expectError(
+            ErrorCodes.NOT_FOUND,
+            svc.getSSHAccess(stranger.id, ws.id),
+            "NOT_FOUND if stranger asks for SSH access",
+        );
+    });
+
     it("should getIDECredentials", async () => {
         const svc = container.get(WorkspaceService);
         const ws = await createTestWorkspace(svc, org, owner, project);
@@ -76,6 +76,7 @@ import { InstallationService } from "../auth/installation-service";
 import { PublicAPIConverter } from "@gitpod/public-api-common/lib/public-api-converter";
 import { WatchWorkspaceStatusResponse } from "@gitpod/public-api/lib/gitpod/v1/workspace_pb";
 import { ContextParser } from "./context-parser-service";
+import { SSH_ACCESS_TOKEN_LIFETIME_SECONDS, signSSHAccessToken } from "./ssh-access-token";
 
 export const GIT_STATUS_LENGTH_CAP_BYTES = 4096;
 
@@ -122,15 +123,16 @@ export class WorkspaceService {
         project: Project | undefined,
         workspaceClassOverride: string | undefined,
     ) {
+        const settings = await this.orgService.getSettings(userId, organizationId);
         const workspaceClass = await getWorkspaceClassForInstance(
             ctx,
             { type: "regular" },
             previousInstance,
             project,
             workspaceClassOverride,
+            settings.defaultWorkspaceClass || undefined,
             this.config.workspaceClasses,
         );
-        const settings = await this.orgService.getSettings(userId, organizationId);
         const allAllowedClsInInstallation = await this.installationService.getInstallationWorkspaceClasses(userId);
         const checkHasOtherOptions = (allowedCls: string[]) =>
             allowedCls.filter((e) => allAllowedClsInInstallation.findIndex((cls) => cls.id === e) !== -1).length > 0;
@@ -307,6 +309,33 @@ export class WorkspaceService {
         return ownerToken;
     }
 
+    async getSSHAccess(
+        userId: string,
+        workspaceId: string,
+    ): Promise<{ host: string; username: string; token: string }> {
+        await this.auth.checkPermissionOnWorkspace(userId, "access", workspaceId);
+
+        // Check: is deleted?
+        await this.getWorkspace(userId, workspaceId);
+
+        if (!this.config.sshAccessTokenSigningKey) {
+            throw new ApplicationError(ErrorCodes.PRECONDITION_FAILED, "SSH access tokens are not configured");
+        }
+        const instance = await this.db.findCurrentInstance(workspaceId);
+        if (!instance || instance.status.phase !== "running" || !instance.ideUrl) {
+            throw new ApplicationError(ErrorCodes.PRECONDITION_FAILED, "workspace is not running");
+        }
+        // the SSH gateway serves workspaces on the subdomain <workspaceId>.ssh of their IDE host
+        const host = new URL(instance.ideUrl).host.replace(workspaceId, workspaceId + ".ssh");
+        // unlike the owner token, the access token is short-lived and grants nothing but SSH access to this instance
+        const expiry = new Date(Date.now() + SSH_ACCESS_TOKEN_LIFETIME_SECONDS * 1000);
+        return {
+            host,
+            username: workspaceId,
+            token: signSSHAccessToken(this.config.sshAccessTokenSigningKey, workspaceId, instance.id, expiry),
+        };
+    }
+
     async getIDECredentials(userId: string, workspaceId: string): Promise<string> {
         await this.auth.checkPermissionOnWorkspace(userId, "access", workspaceId);
 
@@ -741,6 +770,7 @@ export class WorkspaceService {
             }
             options.ideSettings.pinnedIDEversions = orgSettings.pinnedEditorVersions;
         }
+        options.organizationWorkspaceClass = orgSettings.defaultWorkspaceClass || undefined;
 
         // at this point we're about to actually start a new workspace
         const result = await this.workspaceStarter.startWorkspace(ctx, workspace, user, await projectPromise, options);
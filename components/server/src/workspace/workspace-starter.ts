@@ -19,6 +19,7 @@ import {
     DBWithTracing,
     ProjectDB,
     RedisPublisher,
+    TeamDB,
     TracedUserDB,
     TracedWorkspaceDB,
     UserDB,
@@ -141,6 +142,8 @@ import { getFeatureFlagEnableExperimentalJBTB } from "../util/featureflags";
 export interface StartWorkspaceOptions extends Omit<GitpodServer.StartWorkspaceOptions, "ideSettings"> {
     excludeFeatureFlags?: NamedWorkspaceFeatureFlag[];
     ideSettings?: ExtendedIDESettings;
+    // the workspace class configured by the organization, used if neither the request nor the project specify one
+    organizationWorkspaceClass?: string;
 }
 
 const MAX_INSTANCE_START_RETRIES = 2;
@@ -154,6 +157,7 @@ export async function getWorkspaceClassForInstance(
     previousInstance: Pick<WorkspaceInstance, "workspaceClass"> | undefined,
     project: Project | undefined,
     workspaceClassOverride: string | undefined,
+    organizationWorkspaceClass: string | undefined,
     config: WorkspaceClassesConfig,
 ): Promise<string> {
     const span = TraceContext.startSpan("getWorkspaceClassForInstance", ctx);
@@ -178,6 +182,9 @@ export async function getWorkspaceClassForInstance(
                     break;
             }
         }
+        if (!workspaceClass) {
+            workspaceClass = organizationWorkspaceClass;
+        }
         if (!workspaceClass) {
             workspaceClass = config.find((c) => !!c.isDefault)?.id;
         }
@@ -231,6 +238,7 @@ export class WorkspaceStarter {
         @inject(RedisMutex) private readonly redisMutex: RedisMutex,
         @inject(RedisPublisher) private readonly publisher: RedisPublisher,
         @inject(EnvVarService) private readonly envVarService: EnvVarService,
+        @inject(TeamDB) private readonly teamDB: TeamDB,
     ) {}
 
     public async startWorkspace(
@@ -338,6 +346,7 @@ export class WorkspaceStarter {
                 fromBackup,
                 options.region,
                 options.workspaceClass,
+                options.organizationWorkspaceClass,
             );
             // we run the actual creation of a new instance in a distributed lock, to make sure we always only start one instance per workspace.
             await this.redisMutex.using(["workspace-start-" + workspace.id], 2000, async () => {
@@ -892,6 +901,7 @@ export class WorkspaceStarter {
         fromBackup: boolean,
         regionPreference: WorkspaceRegion | undefined,
         workspaceClassOverride?: string,
+        organizationWorkspaceClass?: string,
     ): Promise<WorkspaceInstance> {
         const span = TraceContext.startSpan("newInstance", ctx);
         try {
@@ -977,6 +987,7 @@ export class WorkspaceStarter {
                 previousInstance,
                 project,
                 workspaceClassOverride,
+                organizationWorkspaceClass,
                 this.config.workspaceClasses,
             );
 
@@ -1391,6 +1402,21 @@ export class WorkspaceStarter {
         dotfileEnv.setValue(user.additionalData?.dotfileRepo || "");
         envvars.push(dotfileEnv);
 
+        // like dotfiles, the shell settings are ignored by supervisor in prebuilds
+        const shellSettings = user.additionalData?.shellSettings;
+        if (shellSettings?.shell) {
+            const shellEnv = new EnvironmentVariable();
+            shellEnv.setName("SUPERVISOR_SHELL");
+            shellEnv.setValue(shellSettings.shell);
+            envvars.push(shellEnv);
+        }
+        if (shellSettings?.profile) {
+            const shellProfileEnv = new EnvironmentVariable();
+            shellProfileEnv.setName("SUPERVISOR_SHELL_PROFILE");
+            shellProfileEnv.setValue(shellSettings.profile);
+            envvars.push(shellProfileEnv);
+        }
+
         if (workspace.config.coreDump?.enabled) {
             // default core dump size is 262144 blocks (if blocksize is 4096)
             const defaultLimit: number = 1073741824;
@@ -1515,17 +1541,28 @@ export class WorkspaceStarter {
         orgIdEnv.setValue(await this.configProvider.getDefaultImage(workspace.organizationId));
         sysEnvvars.push(orgIdEnv);
 
+        sysEnvvars.push(newEnvVar("GITPOD_ORGANIZATION_ID", workspace.organizationId));
+        const orgSettings = await this.teamDB.findOrgSettings(workspace.organizationId);
+        if (orgSettings?.commandPolicy) {
+            // the GITPOD_ prefix is reserved, hence users cannot override the policy with their own env vars
+            sysEnvvars.push(newEnvVar("GITPOD_COMMAND_POLICY", JSON.stringify(orgSettings.commandPolicy)));
+        }
+
         const client = getExperimentsClientForBackend();
-        const [isSetJavaXmx, isSetJavaProcessorCount] = await Promise.all([
+        const [isSetJavaXmx, isSetJavaProcessorCount, isSetNodeMaxOldSpaceSize] = await Promise.all([
             client
                 .getValueAsync("supervisor_set_java_xmx", false, { user })
                 .then((v) => newEnvVar("GITPOD_IS_SET_JAVA_XMX", String(v))),
             client
                 .getValueAsync("supervisor_set_java_processor_count", false, { user })
                 .then((v) => newEnvVar("GITPOD_IS_SET_JAVA_PROCESSOR_COUNT", String(v))),
+            client
+                .getValueAsync("supervisor_set_node_max_old_space_size", false, { user })
+                .then((v) => newEnvVar("GITPOD_IS_SET_NODE_MAX_OLD_SPACE_SIZE", String(v))),
         ]);
         sysEnvvars.push(isSetJavaXmx);
         sysEnvvars.push(isSetJavaProcessorCount);
+        sysEnvvars.push(isSetNodeMaxOldSpaceSize);
         const spec = new StartWorkspaceSpec();
         await createGitpodTokenPromise;
         spec.setEnvvarsList(envvars);
@@ -23,7 +23,7 @@ protoc \
     --plugin=protoc-gen-grpc-java=$PROTOC_GEN_GRPC_JAVA_PATH \
     --grpc-java_out=$OUT_DIR \
     --java_out=$OUT_DIR \
-    ./*.proto
+    ./*.proto ./v1/*.proto
 
 # revert Java reserved keywords
 sed -i 's/private_visibility = 0;/private = 0;/g' status.proto
@@ -31,7 +31,7 @@ local_go_protoc() {
         --go_opt=paths=source_relative \
         --go-grpc_out=go \
         --go-grpc_opt=paths=source_relative \
-        *.proto
+        *.proto v1/*.proto
 }
 
 go_protoc_gateway() {
@@ -43,6 +43,9 @@ type StatusServiceClient interface {
 	TasksStatus(ctx context.Context, in *TasksStatusRequest, opts ...grpc.CallOption) (StatusService_TasksStatusClient, error)
 	// ResourcesStatus provides workspace resources status information.
 	ResourcesStatus(ctx context.Context, in *ResourcesStatuRequest, opts ...grpc.CallOption) (*ResourcesStatusResponse, error)
+	// WarmupStatus provides the status of the warmup commands configured in .gitpod.yml. When used
+	// with `wait`, the call returns when all warmup commands have finished.
+	WarmupStatus(ctx context.Context, in *WarmupStatusRequest, opts ...grpc.CallOption) (*WarmupStatusResponse, error)
 }
 
 type statusServiceClient struct {
@@ -162,6 +165,15 @@ func (c *statusServiceClient) ResourcesStatus(ctx context.Context, in *Resources
 	return out, nil
 }
 
+func (c *statusServiceClient) WarmupStatus(ctx context.Context, in *WarmupStatusRequest, opts ...grpc.CallOption) (*WarmupStatusResponse, error) {
+	out := new(WarmupStatusResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.StatusService/WarmupStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatusServiceServer is the server API for StatusService service.
 // All implementations must embed UnimplementedStatusServiceServer
 // for forward compatibility
@@ -183,6 +195,9 @@ type StatusServiceServer interface {
 	TasksStatus(*TasksStatusRequest, StatusService_TasksStatusServer) error
 	// ResourcesStatus provides workspace resources status information.
 	ResourcesStatus(context.Context, *ResourcesStatuRequest) (*ResourcesStatusResponse, error)
+	// WarmupStatus provides the status of the warmup commands configured in .gitpod.yml. When used
+	// with `wait`, the call returns when all warmup commands have finished.
+	WarmupStatus(context.Context, *WarmupStatusRequest) (*WarmupStatusResponse, error)
 	mustEmbedUnimplementedStatusServiceServer()
 }
 
@@ -211,6 +226,9 @@ func (UnimplementedStatusServiceServer) TasksStatus(*TasksStatusRequest, StatusS
 func (UnimplementedStatusServiceServer) ResourcesStatus(context.Context, *ResourcesStatuRequest) (*ResourcesStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourcesStatus not implemented")
 }
+func (UnimplementedStatusServiceServer) WarmupStatus(context.Context, *WarmupStatusRequest) (*WarmupStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmupStatus not implemented")
+}
 func (UnimplementedStatusServiceServer) mustEmbedUnimplementedStatusServiceServer() {}
 
 // UnsafeStatusServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -356,6 +374,24 @@ func _StatusService_ResourcesStatus_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatusService_WarmupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmupStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServiceServer).WarmupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.StatusService/WarmupStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServiceServer).WarmupStatus(ctx, req.(*WarmupStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StatusService_ServiceDesc is the grpc.ServiceDesc for StatusService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -383,6 +419,10 @@ var StatusService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResourcesStatus",
 			Handler:    _StatusService_ResourcesStatus_Handler,
 		},
+		{
+			MethodName: "WarmupStatus",
+			Handler:    _StatusService_WarmupStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
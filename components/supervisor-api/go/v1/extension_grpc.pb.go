@@ -0,0 +1,343 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: v1/extension.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ExtensionServiceClient is the client API for ExtensionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExtensionServiceClient interface {
+	// CreateExtensionToken issues a token for an IDE extension. Only the IDE can issue tokens: it authenticates
+	// with the registrar token supervisor passes to the IDE launcher through a pipe. The launcher finds the file descriptor
+	// of that pipe in the GITPOD_EXTENSION_REGISTRAR_TOKEN_FD environment variable.
+	CreateExtensionToken(ctx context.Context, in *CreateExtensionTokenRequest, opts ...grpc.CallOption) (*CreateExtensionTokenResponse, error)
+	// RevokeExtensionTokens revokes all tokens of an extension. Like CreateExtensionToken, only the IDE can call it.
+	RevokeExtensionTokens(ctx context.Context, in *RevokeExtensionTokensRequest, opts ...grpc.CallOption) (*RevokeExtensionTokensResponse, error)
+	// ListTasks returns the tasks of the workspace. Needs EXTENSION_SCOPE_TASKS_READ.
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// ListPorts returns the ports of the workspace. Needs EXTENSION_SCOPE_PORTS_READ.
+	ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error)
+	// ExposePort exposes a port of the workspace. Needs EXTENSION_SCOPE_PORTS_WRITE.
+	ExposePort(ctx context.Context, in *ExposePortRequest, opts ...grpc.CallOption) (*ExposePortResponse, error)
+	// GetEnvironment returns the environment variables of the workspace. Needs EXTENSION_SCOPE_ENV_READ.
+	GetEnvironment(ctx context.Context, in *GetEnvironmentRequest, opts ...grpc.CallOption) (*GetEnvironmentResponse, error)
+	// GetGitStatus returns the status of the Git repository of the workspace. Needs EXTENSION_SCOPE_GIT_READ.
+	GetGitStatus(ctx context.Context, in *GetGitStatusRequest, opts ...grpc.CallOption) (*GetGitStatusResponse, error)
+}
+
+type extensionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExtensionServiceClient(cc grpc.ClientConnInterface) ExtensionServiceClient {
+	return &extensionServiceClient{cc}
+}
+
+func (c *extensionServiceClient) CreateExtensionToken(ctx context.Context, in *CreateExtensionTokenRequest, opts ...grpc.CallOption) (*CreateExtensionTokenResponse, error) {
+	out := new(CreateExtensionTokenResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.v1.ExtensionService/CreateExtensionToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) RevokeExtensionTokens(ctx context.Context, in *RevokeExtensionTokensRequest, opts ...grpc.CallOption) (*RevokeExtensionTokensResponse, error) {
+	out := new(RevokeExtensionTokensResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.v1.ExtensionService/RevokeExtensionTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.v1.ExtensionService/ListTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) ListPorts(ctx context.Context, in *ListPortsRequest, opts ...grpc.CallOption) (*ListPortsResponse, error) {
+	out := new(ListPortsResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.v1.ExtensionService/ListPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) ExposePort(ctx context.Context, in *ExposePortRequest, opts ...grpc.CallOption) (*ExposePortResponse, error) {
+	out := new(ExposePortResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.v1.ExtensionService/ExposePort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) GetEnvironment(ctx context.Context, in *GetEnvironmentRequest, opts ...grpc.CallOption) (*GetEnvironmentResponse, error) {
+	out := new(GetEnvironmentResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.v1.ExtensionService/GetEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *extensionServiceClient) GetGitStatus(ctx context.Context, in *GetGitStatusRequest, opts ...grpc.CallOption) (*GetGitStatusResponse, error) {
+	out := new(GetGitStatusResponse)
+	err := c.cc.Invoke(ctx, "/supervisor.v1.ExtensionService/GetGitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExtensionServiceServer is the server API for ExtensionService service.
+// All implementations must embed UnimplementedExtensionServiceServer
+// for forward compatibility
+type ExtensionServiceServer interface {
+	// CreateExtensionToken issues a token for an IDE extension. Only the IDE can issue tokens: it authenticates
+	// with the registrar token supervisor passes to the IDE launcher through a pipe. The launcher finds the file descriptor
+	// of that pipe in the GITPOD_EXTENSION_REGISTRAR_TOKEN_FD environment variable.
+	CreateExtensionToken(context.Context, *CreateExtensionTokenRequest) (*CreateExtensionTokenResponse, error)
+	// RevokeExtensionTokens revokes all tokens of an extension. Like CreateExtensionToken, only the IDE can call it.
+	RevokeExtensionTokens(context.Context, *RevokeExtensionTokensRequest) (*RevokeExtensionTokensResponse, error)
+	// ListTasks returns the tasks of the workspace. Needs EXTENSION_SCOPE_TASKS_READ.
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// ListPorts returns the ports of the workspace. Needs EXTENSION_SCOPE_PORTS_READ.
+	ListPorts(context.Context, *ListPortsRequest) (*ListPortsResponse, error)
+	// ExposePort exposes a port of the workspace. Needs EXTENSION_SCOPE_PORTS_WRITE.
+	ExposePort(context.Context, *ExposePortRequest) (*ExposePortResponse, error)
+	// GetEnvironment returns the environment variables of the workspace. Needs EXTENSION_SCOPE_ENV_READ.
+	GetEnvironment(context.Context, *GetEnvironmentRequest) (*GetEnvironmentResponse, error)
+	// GetGitStatus returns the status of the Git repository of the workspace. Needs EXTENSION_SCOPE_GIT_READ.
+	GetGitStatus(context.Context, *GetGitStatusRequest) (*GetGitStatusResponse, error)
+	mustEmbedUnimplementedExtensionServiceServer()
+}
+
+// UnimplementedExtensionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExtensionServiceServer struct {
+}
+
+func (UnimplementedExtensionServiceServer) CreateExtensionToken(context.Context, *CreateExtensionTokenRequest) (*CreateExtensionTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExtensionToken not implemented")
+}
+func (UnimplementedExtensionServiceServer) RevokeExtensionTokens(context.Context, *RevokeExtensionTokensRequest) (*RevokeExtensionTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeExtensionTokens not implemented")
+}
+func (UnimplementedExtensionServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedExtensionServiceServer) ListPorts(context.Context, *ListPortsRequest) (*ListPortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPorts not implemented")
+}
+func (UnimplementedExtensionServiceServer) ExposePort(context.Context, *ExposePortRequest) (*ExposePortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExposePort not implemented")
+}
+func (UnimplementedExtensionServiceServer) GetEnvironment(context.Context, *GetEnvironmentRequest) (*GetEnvironmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironment not implemented")
+}
+func (UnimplementedExtensionServiceServer) GetGitStatus(context.Context, *GetGitStatusRequest) (*GetGitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGitStatus not implemented")
+}
+func (UnimplementedExtensionServiceServer) mustEmbedUnimplementedExtensionServiceServer() {}
+
+// UnsafeExtensionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExtensionServiceServer will
+// result in compilation errors.
+type UnsafeExtensionServiceServer interface {
+	mustEmbedUnimplementedExtensionServiceServer()
+}
+
+func RegisterExtensionServiceServer(s grpc.ServiceRegistrar, srv ExtensionServiceServer) {
+	s.RegisterService(&ExtensionService_ServiceDesc, srv)
+}
+
+func _ExtensionService_CreateExtensionToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExtensionTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).CreateExtensionToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.v1.ExtensionService/CreateExtensionToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).CreateExtensionToken(ctx, req.(*CreateExtensionTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_RevokeExtensionTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeExtensionTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).RevokeExtensionTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.v1.ExtensionService/RevokeExtensionTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).RevokeExtensionTokens(ctx, req.(*RevokeExtensionTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.v1.ExtensionService/ListTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_ListPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).ListPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.v1.ExtensionService/ListPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).ListPorts(ctx, req.(*ListPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_ExposePort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExposePortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).ExposePort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.v1.ExtensionService/ExposePort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).ExposePort(ctx, req.(*ExposePortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_GetEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).GetEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.v1.ExtensionService/GetEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).GetEnvironment(ctx, req.(*GetEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExtensionService_GetGitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExtensionServiceServer).GetGitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/supervisor.v1.ExtensionService/GetGitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExtensionServiceServer).GetGitStatus(ctx, req.(*GetGitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExtensionService_ServiceDesc is the grpc.ServiceDesc for ExtensionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExtensionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "supervisor.v1.ExtensionService",
+	HandlerType: (*ExtensionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateExtensionToken",
+			Handler:    _ExtensionService_CreateExtensionToken_Handler,
+		},
+		{
+			MethodName: "RevokeExtensionTokens",
+			Handler:    _ExtensionService_RevokeExtensionTokens_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _ExtensionService_ListTasks_Handler,
+		},
+		{
+			MethodName: "ListPorts",
+			Handler:    _ExtensionService_ListPorts_Handler,
+		},
+		{
+			MethodName: "ExposePort",
+			Handler:    _ExtensionService_ExposePort_Handler,
+		},
+		{
+			MethodName: "GetEnvironment",
+			Handler:    _ExtensionService_GetEnvironment_Handler,
+		},
+		{
+			MethodName: "GetGitStatus",
+			Handler:    _ExtensionService_GetGitStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/extension.proto",
+}
@@ -135,6 +135,164 @@ public final class Status {
     // @@protoc_insertion_point(enum_scope:supervisor.ContentSource)
   }
 
+  /**
+   * Protobuf enum {@code supervisor.GitHistoryState}
+   */
+  public enum GitHistoryState
+      implements com.google.protobuf.ProtocolMessageEnum {
+    /**
+     * <pre>
+     * history_pending means the history is still being fetched
+     * </pre>
+     *
+     * <code>history_pending = 0;</code>
+     */
+    history_pending(0),
+    /**
+     * <pre>
+     * history_complete means all repositories contain their full history
+     * </pre>
+     *
+     * <code>history_complete = 1;</code>
+     */
+    history_complete(1),
+    /**
+     * <pre>
+     * history_shallow means at least one repository keeps a shallow history by intention
+     * </pre>
+     *
+     * <code>history_shallow = 2;</code>
+     */
+    history_shallow(2),
+    /**
+     * <pre>
+     * history_failed means the history of at least one repository could not be fetched
+     * </pre>
+     *
+     * <code>history_failed = 3;</code>
+     */
+    history_failed(3),
+    UNRECOGNIZED(-1),
+    ;
+
+    /**
+     * <pre>
+     * history_pending means the history is still being fetched
+     * </pre>
+     *
+     * <code>history_pending = 0;</code>
+     */
+    public static final int history_pending_VALUE = 0;
+    /**
+     * <pre>
+     * history_complete means all repositories contain their full history
+     * </pre>
+     *
+     * <code>history_complete = 1;</code>
+     */
+    public static final int history_complete_VALUE = 1;
+    /**
+     * <pre>
+     * history_shallow means at least one repository keeps a shallow history by intention
+     * </pre>
+     *
+     * <code>history_shallow = 2;</code>
+     */
+    public static final int history_shallow_VALUE = 2;
+    /**
+     * <pre>
+     * history_failed means the history of at least one repository could not be fetched
+     * </pre>
+     *
+     * <code>history_failed = 3;</code>
+     */
+    public static final int history_failed_VALUE = 3;
+
+
+    public final int getNumber() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalArgumentException(
+            "Can't get the number of an unknown enum value.");
+      }
+      return value;
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     * @deprecated Use {@link #forNumber(int)} instead.
+     */
+    @java.lang.Deprecated
+    public static GitHistoryState valueOf(int value) {
+      return forNumber(value);
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     */
+    public static GitHistoryState forNumber(int value) {
+      switch (value) {
+        case 0: return history_pending;
+        case 1: return history_complete;
+        case 2: return history_shallow;
+        case 3: return history_failed;
+        default: return null;
+      }
+    }
+
+    public static com.google.protobuf.Internal.EnumLiteMap<GitHistoryState>
+        internalGetValueMap() {
+      return internalValueMap;
+    }
+    private static final com.google.protobuf.Internal.EnumLiteMap<
+        GitHistoryState> internalValueMap =
+          new com.google.protobuf.Internal.EnumLiteMap<GitHistoryState>() {
+            public GitHistoryState findValueByNumber(int number) {
+              return GitHistoryState.forNumber(number);
+            }
+          };
+
+    public final com.google.protobuf.Descriptors.EnumValueDescriptor
+        getValueDescriptor() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalStateException(
+            "Can't get the descriptor of an unrecognized enum value.");
+      }
+      return getDescriptor().getValues().get(ordinal());
+    }
+    public final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptorForType() {
+      return getDescriptor();
+    }
+    public static final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(1);
+    }
+
+    private static final GitHistoryState[] VALUES = values();
+
+    public static GitHistoryState valueOf(
+        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+      if (desc.getType() != getDescriptor()) {
+        throw new java.lang.IllegalArgumentException(
+          "EnumValueDescriptor is not for this type.");
+      }
+      if (desc.getIndex() == -1) {
+        return UNRECOGNIZED;
+      }
+      return VALUES[desc.getIndex()];
+    }
+
+    private final int value;
+
+    private GitHistoryState(int value) {
+      this.value = value;
+    }
+
+    // @@protoc_insertion_point(enum_scope:supervisor.GitHistoryState)
+  }
+
   /**
    * Protobuf enum {@code supervisor.PortVisibility}
    */
@@ -217,7 +375,7 @@ public final class Status {
     }
     public static final com.google.protobuf.Descriptors.EnumDescriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(1);
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(2);
     }
 
     private static final PortVisibility[] VALUES = values();
@@ -325,7 +483,7 @@ public final class Status {
     }
     public static final com.google.protobuf.Descriptors.EnumDescriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(2);
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(3);
     }
 
     private static final PortProtocol[] VALUES = values();
@@ -464,7 +622,7 @@ public final class Status {
     }
     public static final com.google.protobuf.Descriptors.EnumDescriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(3);
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(4);
     }
 
     private static final OnPortExposedAction[] VALUES = values();
@@ -581,7 +739,7 @@ public final class Status {
     }
     public static final com.google.protobuf.Descriptors.EnumDescriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(4);
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(5);
     }
 
     private static final PortAutoExposure[] VALUES = values();
@@ -698,7 +856,7 @@ public final class Status {
     }
     public static final com.google.protobuf.Descriptors.EnumDescriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(5);
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(6);
     }
 
     private static final TaskState[] VALUES = values();
@@ -815,7 +973,7 @@ public final class Status {
     }
     public static final com.google.protobuf.Descriptors.EnumDescriptor
         getDescriptor() {
-      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(6);
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(7);
     }
 
     private static final ResourceStatusSeverity[] VALUES = values();
@@ -841,6 +999,158 @@ public final class Status {
     // @@protoc_insertion_point(enum_scope:supervisor.ResourceStatusSeverity)
   }
 
+  /**
+   * Protobuf enum {@code supervisor.WarmupState}
+   */
+  public enum WarmupState
+      implements com.google.protobuf.ProtocolMessageEnum {
+    /**
+     * <code>warmup_pending = 0;</code>
+     */
+    warmup_pending(0),
+    /**
+     * <code>warmup_running = 1;</code>
+     */
+    warmup_running(1),
+    /**
+     * <code>warmup_restoring = 2;</code>
+     */
+    warmup_restoring(2),
+    /**
+     * <code>warmup_done = 3;</code>
+     */
+    warmup_done(3),
+    /**
+     * <code>warmup_failed = 4;</code>
+     */
+    warmup_failed(4),
+    /**
+     * <pre>
+     * the warmup command did not run, e.g. because the prebuild tasks failed or there was no cache to restore
+     * </pre>
+     *
+     * <code>warmup_skipped = 5;</code>
+     */
+    warmup_skipped(5),
+    UNRECOGNIZED(-1),
+    ;
+
+    /**
+     * <code>warmup_pending = 0;</code>
+     */
+    public static final int warmup_pending_VALUE = 0;
+    /**
+     * <code>warmup_running = 1;</code>
+     */
+    public static final int warmup_running_VALUE = 1;
+    /**
+     * <code>warmup_restoring = 2;</code>
+     */
+    public static final int warmup_restoring_VALUE = 2;
+    /**
+     * <code>warmup_done = 3;</code>
+     */
+    public static final int warmup_done_VALUE = 3;
+    /**
+     * <code>warmup_failed = 4;</code>
+     */
+    public static final int warmup_failed_VALUE = 4;
+    /**
+     * <pre>
+     * the warmup command did not run, e.g. because the prebuild tasks failed or there was no cache to restore
+     * </pre>
+     *
+     * <code>warmup_skipped = 5;</code>
+     */
+    public static final int warmup_skipped_VALUE = 5;
+
+
+    public final int getNumber() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalArgumentException(
+            "Can't get the number of an unknown enum value.");
+      }
+      return value;
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     * @deprecated Use {@link #forNumber(int)} instead.
+     */
+    @java.lang.Deprecated
+    public static WarmupState valueOf(int value) {
+      return forNumber(value);
+    }
+
+    /**
+     * @param value The numeric wire value of the corresponding enum entry.
+     * @return The enum associated with the given numeric wire value.
+     */
+    public static WarmupState forNumber(int value) {
+      switch (value) {
+        case 0: return warmup_pending;
+        case 1: return warmup_running;
+        case 2: return warmup_restoring;
+        case 3: return warmup_done;
+        case 4: return warmup_failed;
+        case 5: return warmup_skipped;
+        default: return null;
+      }
+    }
+
+    public static com.google.protobuf.Internal.EnumLiteMap<WarmupState>
+        internalGetValueMap() {
+      return internalValueMap;
+    }
+    private static final com.google.protobuf.Internal.EnumLiteMap<
+        WarmupState> internalValueMap =
+          new com.google.protobuf.Internal.EnumLiteMap<WarmupState>() {
+            public WarmupState findValueByNumber(int number) {
+              return WarmupState.forNumber(number);
+            }
+          };
+
+    public final com.google.protobuf.Descriptors.EnumValueDescriptor
+        getValueDescriptor() {
+      if (this == UNRECOGNIZED) {
+        throw new java.lang.IllegalStateException(
+            "Can't get the descriptor of an unrecognized enum value.");
+      }
+      return getDescriptor().getValues().get(ordinal());
+    }
+    public final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptorForType() {
+      return getDescriptor();
+    }
+    public static final com.google.protobuf.Descriptors.EnumDescriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.getDescriptor().getEnumTypes().get(8);
+    }
+
+    private static final WarmupState[] VALUES = values();
+
+    public static WarmupState valueOf(
+        com.google.protobuf.Descriptors.EnumValueDescriptor desc) {
+      if (desc.getType() != getDescriptor()) {
+        throw new java.lang.IllegalArgumentException(
+          "EnumValueDescriptor is not for this type.");
+      }
+      if (desc.getIndex() == -1) {
+        return UNRECOGNIZED;
+      }
+      return VALUES[desc.getIndex()];
+    }
+
+    private final int value;
+
+    private WarmupState(int value) {
+      this.value = value;
+    }
+
+    // @@protoc_insertion_point(enum_scope:supervisor.WarmupState)
+  }
+
   public interface SupervisorStatusRequestOrBuilder extends
       // @@protoc_insertion_point(interface_extends:supervisor.SupervisorStatusRequest)
       com.google.protobuf.MessageOrBuilder {
@@ -4621,6 +4931,24 @@ public final class Status {
      * @return The source.
      */
     io.gitpod.supervisor.api.Status.ContentSource getSource();
+    /**
+     * <pre>
+     * history indicates whether the Git history of the repositories has been fetched in the background
+     * </pre>
+     *
+     * <code>.supervisor.GitHistoryState history = 3;</code>
+     * @return The enum numeric value on the wire for history.
+     */
+    int getHistoryValue();
+    /**
+     * <pre>
+     * history indicates whether the Git history of the repositories has been fetched in the background
+     * </pre>
+     *
+     * <code>.supervisor.GitHistoryState history = 3;</code>
+     * @return The history.
+     */
+    io.gitpod.supervisor.api.Status.GitHistoryState getHistory();
   }
   /**
    * Protobuf type {@code supervisor.ContentStatusResponse}
@@ -4636,6 +4964,7 @@ public final class Status {
     }
     private ContentStatusResponse() {
       source_ = 0;
+      history_ = 0;
     }
 
     @java.lang.Override
@@ -4679,6 +5008,12 @@ public final class Status {
               source_ = rawValue;
               break;
             }
+            case 24: {
+              int rawValue = input.readEnum();
+
+              history_ = rawValue;
+              break;
+            }
             default: {
               if (!parseUnknownField(
                   input, unknownFields, extensionRegistry, tag)) {
@@ -4755,13 +5090,40 @@ public final class Status {
       return result == null ? io.gitpod.supervisor.api.Status.ContentSource.UNRECOGNIZED : result;
     }
 
-    private byte memoizedIsInitialized = -1;
-    @java.lang.Override
-    public final boolean isInitialized() {
-      byte isInitialized = memoizedIsInitialized;
-      if (isInitialized == 1) return true;
-      if (isInitialized == 0) return false;
-
+    public static final int HISTORY_FIELD_NUMBER = 3;
+    private int history_;
+    /**
+     * <pre>
+     * history indicates whether the Git history of the repositories has been fetched in the background
+     * </pre>
+     *
+     * <code>.supervisor.GitHistoryState history = 3;</code>
+     * @return The enum numeric value on the wire for history.
+     */
+    @java.lang.Override public int getHistoryValue() {
+      return history_;
+    }
+    /**
+     * <pre>
+     * history indicates whether the Git history of the repositories has been fetched in the background
+     * </pre>
+     *
+     * <code>.supervisor.GitHistoryState history = 3;</code>
+     * @return The history.
+     */
+    @java.lang.Override public io.gitpod.supervisor.api.Status.GitHistoryState getHistory() {
+      @SuppressWarnings("deprecation")
+      io.gitpod.supervisor.api.Status.GitHistoryState result = io.gitpod.supervisor.api.Status.GitHistoryState.valueOf(history_);
+      return result == null ? io.gitpod.supervisor.api.Status.GitHistoryState.UNRECOGNIZED : result;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
       memoizedIsInitialized = 1;
       return true;
     }
@@ -4775,6 +5137,9 @@ public final class Status {
       if (source_ != io.gitpod.supervisor.api.Status.ContentSource.from_other.getNumber()) {
         output.writeEnum(2, source_);
       }
+      if (history_ != io.gitpod.supervisor.api.Status.GitHistoryState.history_pending.getNumber()) {
+        output.writeEnum(3, history_);
+      }
       unknownFields.writeTo(output);
     }
 
@@ -4792,6 +5157,10 @@ public final class Status {
         size += com.google.protobuf.CodedOutputStream
           .computeEnumSize(2, source_);
       }
+      if (history_ != io.gitpod.supervisor.api.Status.GitHistoryState.history_pending.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(3, history_);
+      }
       size += unknownFields.getSerializedSize();
       memoizedSize = size;
       return size;
@@ -4810,6 +5179,7 @@ public final class Status {
       if (getAvailable()
           != other.getAvailable()) return false;
       if (source_ != other.source_) return false;
+      if (history_ != other.history_) return false;
       if (!unknownFields.equals(other.unknownFields)) return false;
       return true;
     }
@@ -4826,6 +5196,8 @@ public final class Status {
           getAvailable());
       hash = (37 * hash) + SOURCE_FIELD_NUMBER;
       hash = (53 * hash) + source_;
+      hash = (37 * hash) + HISTORY_FIELD_NUMBER;
+      hash = (53 * hash) + history_;
       hash = (29 * hash) + unknownFields.hashCode();
       memoizedHashCode = hash;
       return hash;
@@ -4963,6 +5335,8 @@ public final class Status {
 
         source_ = 0;
 
+        history_ = 0;
+
         return this;
       }
 
@@ -4991,6 +5365,7 @@ public final class Status {
         io.gitpod.supervisor.api.Status.ContentStatusResponse result = new io.gitpod.supervisor.api.Status.ContentStatusResponse(this);
         result.available_ = available_;
         result.source_ = source_;
+        result.history_ = history_;
         onBuilt();
         return result;
       }
@@ -5045,6 +5420,9 @@ public final class Status {
         if (other.source_ != 0) {
           setSourceValue(other.getSourceValue());
         }
+        if (other.history_ != 0) {
+          setHistoryValue(other.getHistoryValue());
+        }
         this.mergeUnknownFields(other.unknownFields);
         onChanged();
         return this;
@@ -5190,6 +5568,80 @@ public final class Status {
         onChanged();
         return this;
       }
+
+      private int history_ = 0;
+      /**
+       * <pre>
+       * history indicates whether the Git history of the repositories has been fetched in the background
+       * </pre>
+       *
+       * <code>.supervisor.GitHistoryState history = 3;</code>
+       * @return The enum numeric value on the wire for history.
+       */
+      @java.lang.Override public int getHistoryValue() {
+        return history_;
+      }
+      /**
+       * <pre>
+       * history indicates whether the Git history of the repositories has been fetched in the background
+       * </pre>
+       *
+       * <code>.supervisor.GitHistoryState history = 3;</code>
+       * @param value The enum numeric value on the wire for history to set.
+       * @return This builder for chaining.
+       */
+      public Builder setHistoryValue(int value) {
+
+        history_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * history indicates whether the Git history of the repositories has been fetched in the background
+       * </pre>
+       *
+       * <code>.supervisor.GitHistoryState history = 3;</code>
+       * @return The history.
+       */
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.GitHistoryState getHistory() {
+        @SuppressWarnings("deprecation")
+        io.gitpod.supervisor.api.Status.GitHistoryState result = io.gitpod.supervisor.api.Status.GitHistoryState.valueOf(history_);
+        return result == null ? io.gitpod.supervisor.api.Status.GitHistoryState.UNRECOGNIZED : result;
+      }
+      /**
+       * <pre>
+       * history indicates whether the Git history of the repositories has been fetched in the background
+       * </pre>
+       *
+       * <code>.supervisor.GitHistoryState history = 3;</code>
+       * @param value The history to set.
+       * @return This builder for chaining.
+       */
+      public Builder setHistory(io.gitpod.supervisor.api.Status.GitHistoryState value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+
+        history_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * history indicates whether the Git history of the repositories has been fetched in the background
+       * </pre>
+       *
+       * <code>.supervisor.GitHistoryState history = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearHistory() {
+
+        history_ = 0;
+        onChanged();
+        return this;
+      }
       @java.lang.Override
       public final Builder setUnknownFields(
           final com.google.protobuf.UnknownFieldSet unknownFields) {
@@ -16744,263 +17196,2554 @@ public final class Status {
 
   }
 
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_SupervisorStatusRequest_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_SupervisorStatusRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_SupervisorStatusResponse_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_SupervisorStatusResponse_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_IDEStatusRequest_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_IDEStatusRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_IDEStatusResponse_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_IDEStatusResponse_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_IDEStatusResponse_DesktopStatus_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_IDEStatusResponse_DesktopStatus_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_ContentStatusRequest_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_ContentStatusRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_ContentStatusResponse_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_ContentStatusResponse_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_BackupStatusRequest_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_BackupStatusRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_BackupStatusResponse_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_BackupStatusResponse_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_PortsStatusRequest_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_PortsStatusRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_PortsStatusResponse_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_PortsStatusResponse_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_ExposedPortInfo_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_ExposedPortInfo_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_TunneledPortInfo_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_TunneledPortInfo_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_TunneledPortInfo_ClientsEntry_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_TunneledPortInfo_ClientsEntry_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_PortsStatus_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_PortsStatus_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_TasksStatusRequest_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_TasksStatusRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_TasksStatusResponse_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_TasksStatusResponse_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_TaskStatus_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_TaskStatus_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_TaskPresentation_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_TaskPresentation_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_ResourcesStatuRequest_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_ResourcesStatuRequest_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_ResourcesStatusResponse_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_ResourcesStatusResponse_fieldAccessorTable;
-  private static final com.google.protobuf.Descriptors.Descriptor
-    internal_static_supervisor_ResourceStatus_descriptor;
-  private static final
-    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
-      internal_static_supervisor_ResourceStatus_fieldAccessorTable;
+  public interface WarmupStatusRequestOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.WarmupStatusRequest)
+      com.google.protobuf.MessageOrBuilder {
 
-  public static com.google.protobuf.Descriptors.FileDescriptor
-      getDescriptor() {
-    return descriptor;
+    /**
+     * <pre>
+     * if true this request will return either when it times out or when all warmup commands have finished.
+     * </pre>
+     *
+     * <code>bool wait = 1;</code>
+     * @return The wait.
+     */
+    boolean getWait();
   }
-  private static  com.google.protobuf.Descriptors.FileDescriptor
-      descriptor;
-  static {
-    java.lang.String[] descriptorData = {
-      "\n\014status.proto\022\nsupervisor\032\034google/api/a" +
-      "nnotations.proto\032\nport.proto\"/\n\027Supervis" +
-      "orStatusRequest\022\024\n\014willShutdown\030\001 \001(\010\"&\n" +
-      "\030SupervisorStatusResponse\022\n\n\002ok\030\001 \001(\010\" \n" +
-      "\020IDEStatusRequest\022\014\n\004wait\030\001 \001(\010\"\253\001\n\021IDES" +
-      "tatusResponse\022\n\n\002ok\030\001 \001(\010\022<\n\007desktop\030\002 \001" +
-      "(\0132+.supervisor.IDEStatusResponse.Deskto" +
-      "pStatus\032L\n\rDesktopStatus\022\014\n\004link\030\001 \001(\t\022\r" +
-      "\n\005label\030\002 \001(\t\022\020\n\010clientID\030\003 \001(\t\022\014\n\004kind\030" +
-      "\004 \001(\t\"$\n\024ContentStatusRequest\022\014\n\004wait\030\001 " +
-      "\001(\010\"U\n\025ContentStatusResponse\022\021\n\tavailabl" +
-      "e\030\001 \001(\010\022)\n\006source\030\002 \001(\0162\031.supervisor.Con" +
-      "tentSource\"\025\n\023BackupStatusRequest\"0\n\024Bac" +
-      "kupStatusResponse\022\030\n\020canary_available\030\001 " +
-      "\001(\010\"%\n\022PortsStatusRequest\022\017\n\007observe\030\001 \001" +
-      "(\010\"=\n\023PortsStatusResponse\022&\n\005ports\030\001 \003(\013" +
-      "2\027.supervisor.PortsStatus\"\263\001\n\017ExposedPor" +
-      "tInfo\022.\n\nvisibility\030\001 \001(\0162\032.supervisor.P" +
-      "ortVisibility\022\013\n\003url\030\002 \001(\t\0227\n\non_exposed" +
-      "\030\003 \001(\0162\037.supervisor.OnPortExposedActionB" +
-      "\002\030\001\022*\n\010protocol\030\004 \001(\0162\030.supervisor.PortP" +
-      "rotocol\"\304\001\n\020TunneledPortInfo\022\023\n\013target_p" +
-      "ort\030\001 \001(\r\022/\n\nvisibility\030\002 \001(\0162\033.supervis" +
-      "or.TunnelVisiblity\022:\n\007clients\030\003 \003(\0132).su" +
-      "pervisor.TunneledPortInfo.ClientsEntry\032." +
-      "\n\014ClientsEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005value\030\002 \001" +
-      "(\r:\0028\001\"\204\003\n\013PortsStatus\022\022\n\nlocal_port\030\001 \001" +
-      "(\r\022\016\n\006served\030\004 \001(\010\022,\n\007exposed\030\005 \001(\0132\033.su" +
-      "pervisor.ExposedPortInfo\0223\n\rauto_exposur" +
-      "e\030\007 \001(\0162\034.supervisor.PortAutoExposure\022.\n" +
-      "\010tunneled\030\006 \001(\0132\034.supervisor.TunneledPor" +
-      "tInfo\022\023\n\013description\030\010 \001(\t\022\014\n\004name\030\t \001(\t" +
-      "\0225\n\007on_open\030\n \001(\0162$.supervisor.PortsStat" +
-      "us.OnOpenAction\"^\n\014OnOpenAction\022\n\n\006ignor" +
-      "e\020\000\022\020\n\014open_browser\020\001\022\020\n\014open_preview\020\002\022" +
-      "\n\n\006notify\020\003\022\022\n\016notify_private\020\004J\004\010\002\020\003\"%\n" +
-      "\022TasksStatusRequest\022\017\n\007observe\030\001 \001(\010\"<\n\023" +
-      "TasksStatusResponse\022%\n\005tasks\030\001 \003(\0132\026.sup" +
-      "ervisor.TaskStatus\"\204\001\n\nTaskStatus\022\n\n\002id\030" +
-      "\001 \001(\t\022$\n\005state\030\002 \001(\0162\025.supervisor.TaskSt" +
-      "ate\022\020\n\010terminal\030\003 \001(\t\0222\n\014presentation\030\004 " +
-      "\001(\0132\034.supervisor.TaskPresentation\"D\n\020Tas" +
-      "kPresentation\022\014\n\004name\030\001 \001(\t\022\017\n\007open_in\030\002" +
-      " \001(\t\022\021\n\topen_mode\030\003 \001(\t\"\027\n\025ResourcesStat" +
-      "uRequest\"n\n\027ResourcesStatusResponse\022*\n\006m" +
-      "emory\030\001 \001(\0132\032.supervisor.ResourceStatus\022" +
-      "\'\n\003cpu\030\002 \001(\0132\032.supervisor.ResourceStatus" +
-      "\"c\n\016ResourceStatus\022\014\n\004used\030\001 \001(\003\022\r\n\005limi" +
-      "t\030\002 \001(\003\0224\n\010severity\030\003 \001(\0162\".supervisor.R" +
-      "esourceStatusSeverity*C\n\rContentSource\022\016" +
-      "\n\nfrom_other\020\000\022\017\n\013from_backup\020\001\022\021\n\rfrom_" +
-      "prebuild\020\002*?\n\016PortVisibility\022\026\n\022private_" +
-      "visibility\020\000\022\025\n\021public_visibility\020\001*#\n\014P" +
-      "ortProtocol\022\010\n\004http\020\000\022\t\n\005https\020\001*e\n\023OnPo" +
-      "rtExposedAction\022\n\n\006ignore\020\000\022\020\n\014open_brow" +
-      "ser\020\001\022\020\n\014open_preview\020\002\022\n\n\006notify\020\003\022\022\n\016n" +
-      "otify_private\020\004*9\n\020PortAutoExposure\022\n\n\006t" +
-      "rying\020\000\022\r\n\tsucceeded\020\001\022\n\n\006failed\020\002*1\n\tTa" +
-      "skState\022\013\n\007opening\020\000\022\013\n\007running\020\001\022\n\n\006clo" +
-      "sed\020\002*=\n\026ResourceStatusSeverity\022\n\n\006norma" +
-      "l\020\000\022\013\n\007warning\020\001\022\n\n\006danger\020\0022\377\007\n\rStatusS" +
-      "ervice\022\266\001\n\020SupervisorStatus\022#.supervisor" +
-      ".SupervisorStatusRequest\032$.supervisor.Su" +
-      "pervisorStatusResponse\"W\202\323\344\223\002Q\022\025/v1/stat" +
-      "us/supervisorZ8\0226/v1/status/supervisor/w" +
-      "illShutdown/{willShutdown=true}\022\203\001\n\tIDES" +
-      "tatus\022\034.supervisor.IDEStatusRequest\032\035.su" +
-      "pervisor.IDEStatusResponse\"9\202\323\344\223\0023\022\016/v1/" +
-      "status/ideZ!\022\037/v1/status/ide/wait/{wait=" +
-      "true}\022\227\001\n\rContentStatus\022 .supervisor.Con" +
-      "tentStatusRequest\032!.supervisor.ContentSt" +
-      "atusResponse\"A\202\323\344\223\002;\022\022/v1/status/content" +
-      "Z%\022#/v1/status/content/wait/{wait=true}\022" +
-      "l\n\014BackupStatus\022\037.supervisor.BackupStatu" +
-      "sRequest\032 .supervisor.BackupStatusRespon" +
-      "se\"\031\202\323\344\223\002\023\022\021/v1/status/backup\022\225\001\n\013PortsS" +
-      "tatus\022\036.supervisor.PortsStatusRequest\032\037." +
-      "supervisor.PortsStatusResponse\"C\202\323\344\223\002=\022\020" +
-      "/v1/status/portsZ)\022\'/v1/status/ports/obs" +
-      "erve/{observe=true}0\001\022\225\001\n\013TasksStatus\022\036." +
-      "supervisor.TasksStatusRequest\032\037.supervis" +
-      "or.TasksStatusResponse\"C\202\323\344\223\002=\022\020/v1/stat" +
-      "us/tasksZ)\022\'/v1/status/tasks/observe/{ob" +
-      "serve=true}0\001\022w\n\017ResourcesStatus\022!.super" +
-      "visor.ResourcesStatuRequest\032#.supervisor" +
-      ".ResourcesStatusResponse\"\034\202\323\344\223\002\026\022\024/v1/st" +
-      "atus/resourcesBF\n\030io.gitpod.supervisor.a" +
-      "piZ*github.com/gitpod-io/gitpod/supervis" +
-      "or/apib\006proto3"
-    };
-    descriptor = com.google.protobuf.Descriptors.FileDescriptor
-      .internalBuildGeneratedFileFrom(descriptorData,
-        new com.google.protobuf.Descriptors.FileDescriptor[] {
-          com.google.api.AnnotationsProto.getDescriptor(),
-          io.gitpod.supervisor.api.Port.getDescriptor(),
-        });
-    internal_static_supervisor_SupervisorStatusRequest_descriptor =
-      getDescriptor().getMessageTypes().get(0);
-    internal_static_supervisor_SupervisorStatusRequest_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_supervisor_SupervisorStatusRequest_descriptor,
-        new java.lang.String[] { "WillShutdown", });
-    internal_static_supervisor_SupervisorStatusResponse_descriptor =
-      getDescriptor().getMessageTypes().get(1);
-    internal_static_supervisor_SupervisorStatusResponse_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_supervisor_SupervisorStatusResponse_descriptor,
-        new java.lang.String[] { "Ok", });
-    internal_static_supervisor_IDEStatusRequest_descriptor =
-      getDescriptor().getMessageTypes().get(2);
-    internal_static_supervisor_IDEStatusRequest_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_supervisor_IDEStatusRequest_descriptor,
-        new java.lang.String[] { "Wait", });
-    internal_static_supervisor_IDEStatusResponse_descriptor =
-      getDescriptor().getMessageTypes().get(3);
-    internal_static_supervisor_IDEStatusResponse_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_supervisor_IDEStatusResponse_descriptor,
-        new java.lang.String[] { "Ok", "Desktop", });
-    internal_static_supervisor_IDEStatusResponse_DesktopStatus_descriptor =
-      internal_static_supervisor_IDEStatusResponse_descriptor.getNestedTypes().get(0);
-    internal_static_supervisor_IDEStatusResponse_DesktopStatus_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_supervisor_IDEStatusResponse_DesktopStatus_descriptor,
-        new java.lang.String[] { "Link", "Label", "ClientID", "Kind", });
-    internal_static_supervisor_ContentStatusRequest_descriptor =
-      getDescriptor().getMessageTypes().get(4);
-    internal_static_supervisor_ContentStatusRequest_fieldAccessorTable = new
-      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
-        internal_static_supervisor_ContentStatusRequest_descriptor,
-        new java.lang.String[] { "Wait", });
-    internal_static_supervisor_ContentStatusResponse_descriptor =
-      getDescriptor().getMessageTypes().get(5);
-    internal_static_supervisor_ContentStatusResponse_fieldAccessorTable = new
+  /**
+   * Protobuf type {@code supervisor.WarmupStatusRequest}
+   */
+  public static final class WarmupStatusRequest extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.WarmupStatusRequest)
+      WarmupStatusRequestOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use WarmupStatusRequest.newBuilder() to construct.
+    private WarmupStatusRequest(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private WarmupStatusRequest() {
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new WarmupStatusRequest();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private WarmupStatusRequest(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 8: {
+
+              wait_ = input.readBool();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusRequest_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusRequest_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.WarmupStatusRequest.class, io.gitpod.supervisor.api.Status.WarmupStatusRequest.Builder.class);
+    }
+
+    public static final int WAIT_FIELD_NUMBER = 1;
+    private boolean wait_;
+    /**
+     * <pre>
+     * if true this request will return either when it times out or when all warmup commands have finished.
+     * </pre>
+     *
+     * <code>bool wait = 1;</code>
+     * @return The wait.
+     */
+    @java.lang.Override
+    public boolean getWait() {
+      return wait_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (wait_ != false) {
+        output.writeBool(1, wait_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (wait_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(1, wait_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.WarmupStatusRequest)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.WarmupStatusRequest other = (io.gitpod.supervisor.api.Status.WarmupStatusRequest) obj;
+
+      if (getWait()
+          != other.getWait()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + WAIT_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getWait());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.WarmupStatusRequest prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.WarmupStatusRequest}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.WarmupStatusRequest)
+        io.gitpod.supervisor.api.Status.WarmupStatusRequestOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusRequest_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusRequest_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.WarmupStatusRequest.class, io.gitpod.supervisor.api.Status.WarmupStatusRequest.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.WarmupStatusRequest.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        wait_ = false;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusRequest_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupStatusRequest getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.WarmupStatusRequest.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupStatusRequest build() {
+        io.gitpod.supervisor.api.Status.WarmupStatusRequest result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupStatusRequest buildPartial() {
+        io.gitpod.supervisor.api.Status.WarmupStatusRequest result = new io.gitpod.supervisor.api.Status.WarmupStatusRequest(this);
+        result.wait_ = wait_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.WarmupStatusRequest) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.WarmupStatusRequest)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.WarmupStatusRequest other) {
+        if (other == io.gitpod.supervisor.api.Status.WarmupStatusRequest.getDefaultInstance()) return this;
+        if (other.getWait() != false) {
+          setWait(other.getWait());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.WarmupStatusRequest parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.WarmupStatusRequest) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private boolean wait_ ;
+      /**
+       * <pre>
+       * if true this request will return either when it times out or when all warmup commands have finished.
+       * </pre>
+       *
+       * <code>bool wait = 1;</code>
+       * @return The wait.
+       */
+      @java.lang.Override
+      public boolean getWait() {
+        return wait_;
+      }
+      /**
+       * <pre>
+       * if true this request will return either when it times out or when all warmup commands have finished.
+       * </pre>
+       *
+       * <code>bool wait = 1;</code>
+       * @param value The wait to set.
+       * @return This builder for chaining.
+       */
+      public Builder setWait(boolean value) {
+
+        wait_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * if true this request will return either when it times out or when all warmup commands have finished.
+       * </pre>
+       *
+       * <code>bool wait = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearWait() {
+
+        wait_ = false;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.WarmupStatusRequest)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.WarmupStatusRequest)
+    private static final io.gitpod.supervisor.api.Status.WarmupStatusRequest DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.WarmupStatusRequest();
+    }
+
+    public static io.gitpod.supervisor.api.Status.WarmupStatusRequest getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<WarmupStatusRequest>
+        PARSER = new com.google.protobuf.AbstractParser<WarmupStatusRequest>() {
+      @java.lang.Override
+      public WarmupStatusRequest parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new WarmupStatusRequest(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<WarmupStatusRequest> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<WarmupStatusRequest> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.WarmupStatusRequest getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface WarmupStatusResponseOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.WarmupStatusResponse)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    java.util.List<io.gitpod.supervisor.api.Status.WarmupStatus>
+        getWarmupsList();
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    io.gitpod.supervisor.api.Status.WarmupStatus getWarmups(int index);
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    int getWarmupsCount();
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    java.util.List<? extends io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder>
+        getWarmupsOrBuilderList();
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder getWarmupsOrBuilder(
+        int index);
+  }
+  /**
+   * Protobuf type {@code supervisor.WarmupStatusResponse}
+   */
+  public static final class WarmupStatusResponse extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.WarmupStatusResponse)
+      WarmupStatusResponseOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use WarmupStatusResponse.newBuilder() to construct.
+    private WarmupStatusResponse(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private WarmupStatusResponse() {
+      warmups_ = java.util.Collections.emptyList();
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new WarmupStatusResponse();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private WarmupStatusResponse(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      int mutable_bitField0_ = 0;
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              if (!((mutable_bitField0_ & 0x00000001) != 0)) {
+                warmups_ = new java.util.ArrayList<io.gitpod.supervisor.api.Status.WarmupStatus>();
+                mutable_bitField0_ |= 0x00000001;
+              }
+              warmups_.add(
+                  input.readMessage(io.gitpod.supervisor.api.Status.WarmupStatus.parser(), extensionRegistry));
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        if (((mutable_bitField0_ & 0x00000001) != 0)) {
+          warmups_ = java.util.Collections.unmodifiableList(warmups_);
+        }
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusResponse_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusResponse_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.WarmupStatusResponse.class, io.gitpod.supervisor.api.Status.WarmupStatusResponse.Builder.class);
+    }
+
+    public static final int TASKS_FIELD_NUMBER = 1;
+    private java.util.List<io.gitpod.supervisor.api.Status.WarmupStatus> warmups_;
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<io.gitpod.supervisor.api.Status.WarmupStatus> getWarmupsList() {
+      return warmups_;
+    }
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    @java.lang.Override
+    public java.util.List<? extends io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder>
+        getWarmupsOrBuilderList() {
+      return warmups_;
+    }
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    @java.lang.Override
+    public int getWarmupsCount() {
+      return warmups_.size();
+    }
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.WarmupStatus getWarmups(int index) {
+      return warmups_.get(index);
+    }
+    /**
+     * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+     */
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder getWarmupsOrBuilder(
+        int index) {
+      return warmups_.get(index);
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      for (int i = 0; i < warmups_.size(); i++) {
+        output.writeMessage(1, warmups_.get(i));
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      for (int i = 0; i < warmups_.size(); i++) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeMessageSize(1, warmups_.get(i));
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.WarmupStatusResponse)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.WarmupStatusResponse other = (io.gitpod.supervisor.api.Status.WarmupStatusResponse) obj;
+
+      if (!getWarmupsList()
+          .equals(other.getWarmupsList())) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      if (getWarmupsCount() > 0) {
+        hash = (37 * hash) + TASKS_FIELD_NUMBER;
+        hash = (53 * hash) + getWarmupsList().hashCode();
+      }
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.WarmupStatusResponse prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.WarmupStatusResponse}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.WarmupStatusResponse)
+        io.gitpod.supervisor.api.Status.WarmupStatusResponseOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusResponse_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusResponse_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.WarmupStatusResponse.class, io.gitpod.supervisor.api.Status.WarmupStatusResponse.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.WarmupStatusResponse.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+          getWarmupsFieldBuilder();
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        if (warmupsBuilder_ == null) {
+          warmups_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+        } else {
+          warmupsBuilder_.clear();
+        }
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatusResponse_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupStatusResponse getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.WarmupStatusResponse.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupStatusResponse build() {
+        io.gitpod.supervisor.api.Status.WarmupStatusResponse result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupStatusResponse buildPartial() {
+        io.gitpod.supervisor.api.Status.WarmupStatusResponse result = new io.gitpod.supervisor.api.Status.WarmupStatusResponse(this);
+        int from_bitField0_ = bitField0_;
+        if (warmupsBuilder_ == null) {
+          if (((bitField0_ & 0x00000001) != 0)) {
+            warmups_ = java.util.Collections.unmodifiableList(warmups_);
+            bitField0_ = (bitField0_ & ~0x00000001);
+          }
+          result.warmups_ = warmups_;
+        } else {
+          result.warmups_ = warmupsBuilder_.build();
+        }
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.WarmupStatusResponse) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.WarmupStatusResponse)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.WarmupStatusResponse other) {
+        if (other == io.gitpod.supervisor.api.Status.WarmupStatusResponse.getDefaultInstance()) return this;
+        if (warmupsBuilder_ == null) {
+          if (!other.warmups_.isEmpty()) {
+            if (warmups_.isEmpty()) {
+              warmups_ = other.warmups_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+            } else {
+              ensureWarmupsIsMutable();
+              warmups_.addAll(other.warmups_);
+            }
+            onChanged();
+          }
+        } else {
+          if (!other.warmups_.isEmpty()) {
+            if (warmupsBuilder_.isEmpty()) {
+              warmupsBuilder_.dispose();
+              warmupsBuilder_ = null;
+              warmups_ = other.warmups_;
+              bitField0_ = (bitField0_ & ~0x00000001);
+              warmupsBuilder_ =
+                com.google.protobuf.GeneratedMessageV3.alwaysUseFieldBuilders ?
+                   getWarmupsFieldBuilder() : null;
+            } else {
+              warmupsBuilder_.addAllMessages(other.warmups_);
+            }
+          }
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.WarmupStatusResponse parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.WarmupStatusResponse) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+      private int bitField0_;
+
+      private java.util.List<io.gitpod.supervisor.api.Status.WarmupStatus> warmups_ =
+        java.util.Collections.emptyList();
+      private void ensureWarmupsIsMutable() {
+        if (!((bitField0_ & 0x00000001) != 0)) {
+          warmups_ = new java.util.ArrayList<io.gitpod.supervisor.api.Status.WarmupStatus>(warmups_);
+          bitField0_ |= 0x00000001;
+         }
+      }
+
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.WarmupStatus, io.gitpod.supervisor.api.Status.WarmupStatus.Builder, io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder> warmupsBuilder_;
+
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Status.WarmupStatus> getWarmupsList() {
+        if (warmupsBuilder_ == null) {
+          return java.util.Collections.unmodifiableList(warmups_);
+        } else {
+          return warmupsBuilder_.getMessageList();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public int getWarmupsCount() {
+        if (warmupsBuilder_ == null) {
+          return warmups_.size();
+        } else {
+          return warmupsBuilder_.getCount();
+        }
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.WarmupStatus getWarmups(int index) {
+        if (warmupsBuilder_ == null) {
+          return warmups_.get(index);
+        } else {
+          return warmupsBuilder_.getMessage(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public Builder setWarmups(
+          int index, io.gitpod.supervisor.api.Status.WarmupStatus value) {
+        if (warmupsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureWarmupsIsMutable();
+          warmups_.set(index, value);
+          onChanged();
+        } else {
+          warmupsBuilder_.setMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public Builder setWarmups(
+          int index, io.gitpod.supervisor.api.Status.WarmupStatus.Builder builderForValue) {
+        if (warmupsBuilder_ == null) {
+          ensureWarmupsIsMutable();
+          warmups_.set(index, builderForValue.build());
+          onChanged();
+        } else {
+          warmupsBuilder_.setMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public Builder addWarmups(io.gitpod.supervisor.api.Status.WarmupStatus value) {
+        if (warmupsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureWarmupsIsMutable();
+          warmups_.add(value);
+          onChanged();
+        } else {
+          warmupsBuilder_.addMessage(value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public Builder addWarmups(
+          int index, io.gitpod.supervisor.api.Status.WarmupStatus value) {
+        if (warmupsBuilder_ == null) {
+          if (value == null) {
+            throw new NullPointerException();
+          }
+          ensureWarmupsIsMutable();
+          warmups_.add(index, value);
+          onChanged();
+        } else {
+          warmupsBuilder_.addMessage(index, value);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public Builder addWarmups(
+          io.gitpod.supervisor.api.Status.WarmupStatus.Builder builderForValue) {
+        if (warmupsBuilder_ == null) {
+          ensureWarmupsIsMutable();
+          warmups_.add(builderForValue.build());
+          onChanged();
+        } else {
+          warmupsBuilder_.addMessage(builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public Builder addWarmups(
+          int index, io.gitpod.supervisor.api.Status.WarmupStatus.Builder builderForValue) {
+        if (warmupsBuilder_ == null) {
+          ensureWarmupsIsMutable();
+          warmups_.add(index, builderForValue.build());
+          onChanged();
+        } else {
+          warmupsBuilder_.addMessage(index, builderForValue.build());
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public Builder addAllWarmups(
+          java.lang.Iterable<? extends io.gitpod.supervisor.api.Status.WarmupStatus> values) {
+        if (warmupsBuilder_ == null) {
+          ensureWarmupsIsMutable();
+          com.google.protobuf.AbstractMessageLite.Builder.addAll(
+              values, warmups_);
+          onChanged();
+        } else {
+          warmupsBuilder_.addAllMessages(values);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public Builder clearWarmups() {
+        if (warmupsBuilder_ == null) {
+          warmups_ = java.util.Collections.emptyList();
+          bitField0_ = (bitField0_ & ~0x00000001);
+          onChanged();
+        } else {
+          warmupsBuilder_.clear();
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public Builder removeWarmups(int index) {
+        if (warmupsBuilder_ == null) {
+          ensureWarmupsIsMutable();
+          warmups_.remove(index);
+          onChanged();
+        } else {
+          warmupsBuilder_.remove(index);
+        }
+        return this;
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.WarmupStatus.Builder getWarmupsBuilder(
+          int index) {
+        return getWarmupsFieldBuilder().getBuilder(index);
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder getWarmupsOrBuilder(
+          int index) {
+        if (warmupsBuilder_ == null) {
+          return warmups_.get(index);  } else {
+          return warmupsBuilder_.getMessageOrBuilder(index);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public java.util.List<? extends io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder>
+           getWarmupsOrBuilderList() {
+        if (warmupsBuilder_ != null) {
+          return warmupsBuilder_.getMessageOrBuilderList();
+        } else {
+          return java.util.Collections.unmodifiableList(warmups_);
+        }
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.WarmupStatus.Builder addWarmupsBuilder() {
+        return getWarmupsFieldBuilder().addBuilder(
+            io.gitpod.supervisor.api.Status.WarmupStatus.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public io.gitpod.supervisor.api.Status.WarmupStatus.Builder addWarmupsBuilder(
+          int index) {
+        return getWarmupsFieldBuilder().addBuilder(
+            index, io.gitpod.supervisor.api.Status.WarmupStatus.getDefaultInstance());
+      }
+      /**
+       * <code>repeated .supervisor.WarmupStatus warmups = 1;</code>
+       */
+      public java.util.List<io.gitpod.supervisor.api.Status.WarmupStatus.Builder>
+           getWarmupsBuilderList() {
+        return getWarmupsFieldBuilder().getBuilderList();
+      }
+      private com.google.protobuf.RepeatedFieldBuilderV3<
+          io.gitpod.supervisor.api.Status.WarmupStatus, io.gitpod.supervisor.api.Status.WarmupStatus.Builder, io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder>
+          getWarmupsFieldBuilder() {
+        if (warmupsBuilder_ == null) {
+          warmupsBuilder_ = new com.google.protobuf.RepeatedFieldBuilderV3<
+              io.gitpod.supervisor.api.Status.WarmupStatus, io.gitpod.supervisor.api.Status.WarmupStatus.Builder, io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder>(
+                  warmups_,
+                  ((bitField0_ & 0x00000001) != 0),
+                  getParentForChildren(),
+                  isClean());
+          warmups_ = null;
+        }
+        return warmupsBuilder_;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.WarmupStatusResponse)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.WarmupStatusResponse)
+    private static final io.gitpod.supervisor.api.Status.WarmupStatusResponse DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.WarmupStatusResponse();
+    }
+
+    public static io.gitpod.supervisor.api.Status.WarmupStatusResponse getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<WarmupStatusResponse>
+        PARSER = new com.google.protobuf.AbstractParser<WarmupStatusResponse>() {
+      @java.lang.Override
+      public WarmupStatusResponse parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new WarmupStatusResponse(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<WarmupStatusResponse> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<WarmupStatusResponse> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.WarmupStatusResponse getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  public interface WarmupStatusOrBuilder extends
+      // @@protoc_insertion_point(interface_extends:supervisor.WarmupStatus)
+      com.google.protobuf.MessageOrBuilder {
+
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    java.lang.String getName();
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    com.google.protobuf.ByteString
+        getNameBytes();
+
+    /**
+     * <code>.supervisor.WarmupState state = 2;</code>
+     * @return The enum numeric value on the wire for state.
+     */
+    int getStateValue();
+    /**
+     * <code>.supervisor.WarmupState state = 2;</code>
+     * @return The state.
+     */
+    io.gitpod.supervisor.api.Status.WarmupState getState();
+
+    /**
+     * <pre>
+     * message describes why a warmup command failed
+     * </pre>
+     *
+     * <code>string message = 3;</code>
+     * @return The message.
+     */
+    java.lang.String getMessage();
+    /**
+     * <pre>
+     * message describes why a warmup command failed
+     * </pre>
+     *
+     * <code>string message = 3;</code>
+     * @return The bytes for message.
+     */
+    com.google.protobuf.ByteString
+        getMessageBytes();
+
+    /**
+     * <pre>
+     * restored is true if the caches were restored from a prebuild rather than produced in this workspace
+     * </pre>
+     *
+     * <code>bool restored = 4;</code>
+     * @return The restored.
+     */
+    boolean getRestored();
+  }
+  /**
+   * Protobuf type {@code supervisor.WarmupStatus}
+   */
+  public static final class WarmupStatus extends
+      com.google.protobuf.GeneratedMessageV3 implements
+      // @@protoc_insertion_point(message_implements:supervisor.WarmupStatus)
+      WarmupStatusOrBuilder {
+  private static final long serialVersionUID = 0L;
+    // Use WarmupStatus.newBuilder() to construct.
+    private WarmupStatus(com.google.protobuf.GeneratedMessageV3.Builder<?> builder) {
+      super(builder);
+    }
+    private WarmupStatus() {
+      name_ = "";
+      state_ = 0;
+      message_ = "";
+    }
+
+    @java.lang.Override
+    @SuppressWarnings({"unused"})
+    protected java.lang.Object newInstance(
+        UnusedPrivateParameter unused) {
+      return new WarmupStatus();
+    }
+
+    @java.lang.Override
+    public final com.google.protobuf.UnknownFieldSet
+    getUnknownFields() {
+      return this.unknownFields;
+    }
+    private WarmupStatus(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      this();
+      if (extensionRegistry == null) {
+        throw new java.lang.NullPointerException();
+      }
+      com.google.protobuf.UnknownFieldSet.Builder unknownFields =
+          com.google.protobuf.UnknownFieldSet.newBuilder();
+      try {
+        boolean done = false;
+        while (!done) {
+          int tag = input.readTag();
+          switch (tag) {
+            case 0:
+              done = true;
+              break;
+            case 10: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              name_ = s;
+              break;
+            }
+            case 16: {
+              int rawValue = input.readEnum();
+
+              state_ = rawValue;
+              break;
+            }
+            case 26: {
+              java.lang.String s = input.readStringRequireUtf8();
+
+              message_ = s;
+              break;
+            }
+            case 32: {
+
+              restored_ = input.readBool();
+              break;
+            }
+            default: {
+              if (!parseUnknownField(
+                  input, unknownFields, extensionRegistry, tag)) {
+                done = true;
+              }
+              break;
+            }
+          }
+        }
+      } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+        throw e.setUnfinishedMessage(this);
+      } catch (com.google.protobuf.UninitializedMessageException e) {
+        throw e.asInvalidProtocolBufferException().setUnfinishedMessage(this);
+      } catch (java.io.IOException e) {
+        throw new com.google.protobuf.InvalidProtocolBufferException(
+            e).setUnfinishedMessage(this);
+      } finally {
+        this.unknownFields = unknownFields.build();
+        makeExtensionsImmutable();
+      }
+    }
+    public static final com.google.protobuf.Descriptors.Descriptor
+        getDescriptor() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatus_descriptor;
+    }
+
+    @java.lang.Override
+    protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+        internalGetFieldAccessorTable() {
+      return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatus_fieldAccessorTable
+          .ensureFieldAccessorsInitialized(
+              io.gitpod.supervisor.api.Status.WarmupStatus.class, io.gitpod.supervisor.api.Status.WarmupStatus.Builder.class);
+    }
+
+    public static final int NAME_FIELD_NUMBER = 1;
+    private volatile java.lang.Object name_;
+    /**
+     * <code>string name = 1;</code>
+     * @return The name.
+     */
+    @java.lang.Override
+    public java.lang.String getName() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        name_ = s;
+        return s;
+      }
+    }
+    /**
+     * <code>string name = 1;</code>
+     * @return The bytes for name.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getNameBytes() {
+      java.lang.Object ref = name_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        name_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int STATE_FIELD_NUMBER = 2;
+    private int state_;
+    /**
+     * <code>.supervisor.WarmupState state = 2;</code>
+     * @return The enum numeric value on the wire for state.
+     */
+    @java.lang.Override public int getStateValue() {
+      return state_;
+    }
+    /**
+     * <code>.supervisor.WarmupState state = 2;</code>
+     * @return The state.
+     */
+    @java.lang.Override public io.gitpod.supervisor.api.Status.WarmupState getState() {
+      @SuppressWarnings("deprecation")
+      io.gitpod.supervisor.api.Status.WarmupState result = io.gitpod.supervisor.api.Status.WarmupState.valueOf(state_);
+      return result == null ? io.gitpod.supervisor.api.Status.WarmupState.UNRECOGNIZED : result;
+    }
+
+    public static final int MESSAGE_FIELD_NUMBER = 3;
+    private volatile java.lang.Object message_;
+    /**
+     * <pre>
+     * message describes why a warmup command failed
+     * </pre>
+     *
+     * <code>string message = 3;</code>
+     * @return The message.
+     */
+    @java.lang.Override
+    public java.lang.String getMessage() {
+      java.lang.Object ref = message_;
+      if (ref instanceof java.lang.String) {
+        return (java.lang.String) ref;
+      } else {
+        com.google.protobuf.ByteString bs =
+            (com.google.protobuf.ByteString) ref;
+        java.lang.String s = bs.toStringUtf8();
+        message_ = s;
+        return s;
+      }
+    }
+    /**
+     * <pre>
+     * message describes why a warmup command failed
+     * </pre>
+     *
+     * <code>string message = 3;</code>
+     * @return The bytes for message.
+     */
+    @java.lang.Override
+    public com.google.protobuf.ByteString
+        getMessageBytes() {
+      java.lang.Object ref = message_;
+      if (ref instanceof java.lang.String) {
+        com.google.protobuf.ByteString b =
+            com.google.protobuf.ByteString.copyFromUtf8(
+                (java.lang.String) ref);
+        message_ = b;
+        return b;
+      } else {
+        return (com.google.protobuf.ByteString) ref;
+      }
+    }
+
+    public static final int RESTORED_FIELD_NUMBER = 4;
+    private boolean restored_;
+    /**
+     * <pre>
+     * restored is true if the caches were restored from a prebuild rather than produced in this workspace
+     * </pre>
+     *
+     * <code>bool restored = 4;</code>
+     * @return The restored.
+     */
+    @java.lang.Override
+    public boolean getRestored() {
+      return restored_;
+    }
+
+    private byte memoizedIsInitialized = -1;
+    @java.lang.Override
+    public final boolean isInitialized() {
+      byte isInitialized = memoizedIsInitialized;
+      if (isInitialized == 1) return true;
+      if (isInitialized == 0) return false;
+
+      memoizedIsInitialized = 1;
+      return true;
+    }
+
+    @java.lang.Override
+    public void writeTo(com.google.protobuf.CodedOutputStream output)
+                        throws java.io.IOException {
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 1, name_);
+      }
+      if (state_ != io.gitpod.supervisor.api.Status.WarmupState.warmup_pending.getNumber()) {
+        output.writeEnum(2, state_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(message_)) {
+        com.google.protobuf.GeneratedMessageV3.writeString(output, 3, message_);
+      }
+      if (restored_ != false) {
+        output.writeBool(4, restored_);
+      }
+      unknownFields.writeTo(output);
+    }
+
+    @java.lang.Override
+    public int getSerializedSize() {
+      int size = memoizedSize;
+      if (size != -1) return size;
+
+      size = 0;
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(name_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(1, name_);
+      }
+      if (state_ != io.gitpod.supervisor.api.Status.WarmupState.warmup_pending.getNumber()) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeEnumSize(2, state_);
+      }
+      if (!com.google.protobuf.GeneratedMessageV3.isStringEmpty(message_)) {
+        size += com.google.protobuf.GeneratedMessageV3.computeStringSize(3, message_);
+      }
+      if (restored_ != false) {
+        size += com.google.protobuf.CodedOutputStream
+          .computeBoolSize(4, restored_);
+      }
+      size += unknownFields.getSerializedSize();
+      memoizedSize = size;
+      return size;
+    }
+
+    @java.lang.Override
+    public boolean equals(final java.lang.Object obj) {
+      if (obj == this) {
+       return true;
+      }
+      if (!(obj instanceof io.gitpod.supervisor.api.Status.WarmupStatus)) {
+        return super.equals(obj);
+      }
+      io.gitpod.supervisor.api.Status.WarmupStatus other = (io.gitpod.supervisor.api.Status.WarmupStatus) obj;
+
+      if (!getName()
+          .equals(other.getName())) return false;
+      if (state_ != other.state_) return false;
+      if (!getMessage()
+          .equals(other.getMessage())) return false;
+      if (getRestored()
+          != other.getRestored()) return false;
+      if (!unknownFields.equals(other.unknownFields)) return false;
+      return true;
+    }
+
+    @java.lang.Override
+    public int hashCode() {
+      if (memoizedHashCode != 0) {
+        return memoizedHashCode;
+      }
+      int hash = 41;
+      hash = (19 * hash) + getDescriptor().hashCode();
+      hash = (37 * hash) + NAME_FIELD_NUMBER;
+      hash = (53 * hash) + getName().hashCode();
+      hash = (37 * hash) + STATE_FIELD_NUMBER;
+      hash = (53 * hash) + state_;
+      hash = (37 * hash) + MESSAGE_FIELD_NUMBER;
+      hash = (53 * hash) + getMessage().hashCode();
+      hash = (37 * hash) + RESTORED_FIELD_NUMBER;
+      hash = (53 * hash) + com.google.protobuf.Internal.hashBoolean(
+          getRestored());
+      hash = (29 * hash) + unknownFields.hashCode();
+      memoizedHashCode = hash;
+      return hash;
+    }
+
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(
+        java.nio.ByteBuffer data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(
+        java.nio.ByteBuffer data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(
+        com.google.protobuf.ByteString data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(
+        com.google.protobuf.ByteString data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(byte[] data)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(
+        byte[] data,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws com.google.protobuf.InvalidProtocolBufferException {
+      return PARSER.parseFrom(data, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseDelimitedFrom(java.io.InputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseDelimitedFrom(
+        java.io.InputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseDelimitedWithIOException(PARSER, input, extensionRegistry);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(
+        com.google.protobuf.CodedInputStream input)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input);
+    }
+    public static io.gitpod.supervisor.api.Status.WarmupStatus parseFrom(
+        com.google.protobuf.CodedInputStream input,
+        com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+        throws java.io.IOException {
+      return com.google.protobuf.GeneratedMessageV3
+          .parseWithIOException(PARSER, input, extensionRegistry);
+    }
+
+    @java.lang.Override
+    public Builder newBuilderForType() { return newBuilder(); }
+    public static Builder newBuilder() {
+      return DEFAULT_INSTANCE.toBuilder();
+    }
+    public static Builder newBuilder(io.gitpod.supervisor.api.Status.WarmupStatus prototype) {
+      return DEFAULT_INSTANCE.toBuilder().mergeFrom(prototype);
+    }
+    @java.lang.Override
+    public Builder toBuilder() {
+      return this == DEFAULT_INSTANCE
+          ? new Builder() : new Builder().mergeFrom(this);
+    }
+
+    @java.lang.Override
+    protected Builder newBuilderForType(
+        com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+      Builder builder = new Builder(parent);
+      return builder;
+    }
+    /**
+     * Protobuf type {@code supervisor.WarmupStatus}
+     */
+    public static final class Builder extends
+        com.google.protobuf.GeneratedMessageV3.Builder<Builder> implements
+        // @@protoc_insertion_point(builder_implements:supervisor.WarmupStatus)
+        io.gitpod.supervisor.api.Status.WarmupStatusOrBuilder {
+      public static final com.google.protobuf.Descriptors.Descriptor
+          getDescriptor() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatus_descriptor;
+      }
+
+      @java.lang.Override
+      protected com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+          internalGetFieldAccessorTable() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatus_fieldAccessorTable
+            .ensureFieldAccessorsInitialized(
+                io.gitpod.supervisor.api.Status.WarmupStatus.class, io.gitpod.supervisor.api.Status.WarmupStatus.Builder.class);
+      }
+
+      // Construct using io.gitpod.supervisor.api.Status.WarmupStatus.newBuilder()
+      private Builder() {
+        maybeForceBuilderInitialization();
+      }
+
+      private Builder(
+          com.google.protobuf.GeneratedMessageV3.BuilderParent parent) {
+        super(parent);
+        maybeForceBuilderInitialization();
+      }
+      private void maybeForceBuilderInitialization() {
+        if (com.google.protobuf.GeneratedMessageV3
+                .alwaysUseFieldBuilders) {
+        }
+      }
+      @java.lang.Override
+      public Builder clear() {
+        super.clear();
+        name_ = "";
+
+        state_ = 0;
+
+        message_ = "";
+
+        restored_ = false;
+
+        return this;
+      }
+
+      @java.lang.Override
+      public com.google.protobuf.Descriptors.Descriptor
+          getDescriptorForType() {
+        return io.gitpod.supervisor.api.Status.internal_static_supervisor_WarmupStatus_descriptor;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupStatus getDefaultInstanceForType() {
+        return io.gitpod.supervisor.api.Status.WarmupStatus.getDefaultInstance();
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupStatus build() {
+        io.gitpod.supervisor.api.Status.WarmupStatus result = buildPartial();
+        if (!result.isInitialized()) {
+          throw newUninitializedMessageException(result);
+        }
+        return result;
+      }
+
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupStatus buildPartial() {
+        io.gitpod.supervisor.api.Status.WarmupStatus result = new io.gitpod.supervisor.api.Status.WarmupStatus(this);
+        result.name_ = name_;
+        result.state_ = state_;
+        result.message_ = message_;
+        result.restored_ = restored_;
+        onBuilt();
+        return result;
+      }
+
+      @java.lang.Override
+      public Builder clone() {
+        return super.clone();
+      }
+      @java.lang.Override
+      public Builder setField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.setField(field, value);
+      }
+      @java.lang.Override
+      public Builder clearField(
+          com.google.protobuf.Descriptors.FieldDescriptor field) {
+        return super.clearField(field);
+      }
+      @java.lang.Override
+      public Builder clearOneof(
+          com.google.protobuf.Descriptors.OneofDescriptor oneof) {
+        return super.clearOneof(oneof);
+      }
+      @java.lang.Override
+      public Builder setRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          int index, java.lang.Object value) {
+        return super.setRepeatedField(field, index, value);
+      }
+      @java.lang.Override
+      public Builder addRepeatedField(
+          com.google.protobuf.Descriptors.FieldDescriptor field,
+          java.lang.Object value) {
+        return super.addRepeatedField(field, value);
+      }
+      @java.lang.Override
+      public Builder mergeFrom(com.google.protobuf.Message other) {
+        if (other instanceof io.gitpod.supervisor.api.Status.WarmupStatus) {
+          return mergeFrom((io.gitpod.supervisor.api.Status.WarmupStatus)other);
+        } else {
+          super.mergeFrom(other);
+          return this;
+        }
+      }
+
+      public Builder mergeFrom(io.gitpod.supervisor.api.Status.WarmupStatus other) {
+        if (other == io.gitpod.supervisor.api.Status.WarmupStatus.getDefaultInstance()) return this;
+        if (!other.getName().isEmpty()) {
+          name_ = other.name_;
+          onChanged();
+        }
+        if (other.state_ != 0) {
+          setStateValue(other.getStateValue());
+        }
+        if (!other.getMessage().isEmpty()) {
+          message_ = other.message_;
+          onChanged();
+        }
+        if (other.getRestored() != false) {
+          setRestored(other.getRestored());
+        }
+        this.mergeUnknownFields(other.unknownFields);
+        onChanged();
+        return this;
+      }
+
+      @java.lang.Override
+      public final boolean isInitialized() {
+        return true;
+      }
+
+      @java.lang.Override
+      public Builder mergeFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws java.io.IOException {
+        io.gitpod.supervisor.api.Status.WarmupStatus parsedMessage = null;
+        try {
+          parsedMessage = PARSER.parsePartialFrom(input, extensionRegistry);
+        } catch (com.google.protobuf.InvalidProtocolBufferException e) {
+          parsedMessage = (io.gitpod.supervisor.api.Status.WarmupStatus) e.getUnfinishedMessage();
+          throw e.unwrapIOException();
+        } finally {
+          if (parsedMessage != null) {
+            mergeFrom(parsedMessage);
+          }
+        }
+        return this;
+      }
+
+      private java.lang.Object name_ = "";
+      /**
+       * <code>string name = 1;</code>
+       * @return The name.
+       */
+      public java.lang.String getName() {
+        java.lang.Object ref = name_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          name_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return The bytes for name.
+       */
+      public com.google.protobuf.ByteString
+          getNameBytes() {
+        java.lang.Object ref = name_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          name_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setName(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearName() {
+
+        name_ = getDefaultInstance().getName();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>string name = 1;</code>
+       * @param value The bytes for name to set.
+       * @return This builder for chaining.
+       */
+      public Builder setNameBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        name_ = value;
+        onChanged();
+        return this;
+      }
+
+      private int state_ = 0;
+      /**
+       * <code>.supervisor.WarmupState state = 2;</code>
+       * @return The enum numeric value on the wire for state.
+       */
+      @java.lang.Override public int getStateValue() {
+        return state_;
+      }
+      /**
+       * <code>.supervisor.WarmupState state = 2;</code>
+       * @param value The enum numeric value on the wire for state to set.
+       * @return This builder for chaining.
+       */
+      public Builder setStateValue(int value) {
+
+        state_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.supervisor.WarmupState state = 2;</code>
+       * @return The state.
+       */
+      @java.lang.Override
+      public io.gitpod.supervisor.api.Status.WarmupState getState() {
+        @SuppressWarnings("deprecation")
+        io.gitpod.supervisor.api.Status.WarmupState result = io.gitpod.supervisor.api.Status.WarmupState.valueOf(state_);
+        return result == null ? io.gitpod.supervisor.api.Status.WarmupState.UNRECOGNIZED : result;
+      }
+      /**
+       * <code>.supervisor.WarmupState state = 2;</code>
+       * @param value The state to set.
+       * @return This builder for chaining.
+       */
+      public Builder setState(io.gitpod.supervisor.api.Status.WarmupState value) {
+        if (value == null) {
+          throw new NullPointerException();
+        }
+
+        state_ = value.getNumber();
+        onChanged();
+        return this;
+      }
+      /**
+       * <code>.supervisor.WarmupState state = 2;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearState() {
+
+        state_ = 0;
+        onChanged();
+        return this;
+      }
+
+      private java.lang.Object message_ = "";
+      /**
+       * <pre>
+       * message describes why a warmup command failed
+       * </pre>
+       *
+       * <code>string message = 3;</code>
+       * @return The message.
+       */
+      public java.lang.String getMessage() {
+        java.lang.Object ref = message_;
+        if (!(ref instanceof java.lang.String)) {
+          com.google.protobuf.ByteString bs =
+              (com.google.protobuf.ByteString) ref;
+          java.lang.String s = bs.toStringUtf8();
+          message_ = s;
+          return s;
+        } else {
+          return (java.lang.String) ref;
+        }
+      }
+      /**
+       * <pre>
+       * message describes why a warmup command failed
+       * </pre>
+       *
+       * <code>string message = 3;</code>
+       * @return The bytes for message.
+       */
+      public com.google.protobuf.ByteString
+          getMessageBytes() {
+        java.lang.Object ref = message_;
+        if (ref instanceof String) {
+          com.google.protobuf.ByteString b =
+              com.google.protobuf.ByteString.copyFromUtf8(
+                  (java.lang.String) ref);
+          message_ = b;
+          return b;
+        } else {
+          return (com.google.protobuf.ByteString) ref;
+        }
+      }
+      /**
+       * <pre>
+       * message describes why a warmup command failed
+       * </pre>
+       *
+       * <code>string message = 3;</code>
+       * @param value The message to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMessage(
+          java.lang.String value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+
+        message_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * message describes why a warmup command failed
+       * </pre>
+       *
+       * <code>string message = 3;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearMessage() {
+
+        message_ = getDefaultInstance().getMessage();
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * message describes why a warmup command failed
+       * </pre>
+       *
+       * <code>string message = 3;</code>
+       * @param value The bytes for message to set.
+       * @return This builder for chaining.
+       */
+      public Builder setMessageBytes(
+          com.google.protobuf.ByteString value) {
+        if (value == null) {
+    throw new NullPointerException();
+  }
+  checkByteStringIsUtf8(value);
+
+        message_ = value;
+        onChanged();
+        return this;
+      }
+
+      private boolean restored_ ;
+      /**
+       * <pre>
+       * restored is true if the caches were restored from a prebuild rather than produced in this workspace
+       * </pre>
+       *
+       * <code>bool restored = 4;</code>
+       * @return The restored.
+       */
+      @java.lang.Override
+      public boolean getRestored() {
+        return restored_;
+      }
+      /**
+       * <pre>
+       * restored is true if the caches were restored from a prebuild rather than produced in this workspace
+       * </pre>
+       *
+       * <code>bool restored = 4;</code>
+       * @param value The restored to set.
+       * @return This builder for chaining.
+       */
+      public Builder setRestored(boolean value) {
+
+        restored_ = value;
+        onChanged();
+        return this;
+      }
+      /**
+       * <pre>
+       * restored is true if the caches were restored from a prebuild rather than produced in this workspace
+       * </pre>
+       *
+       * <code>bool restored = 4;</code>
+       * @return This builder for chaining.
+       */
+      public Builder clearRestored() {
+
+        restored_ = false;
+        onChanged();
+        return this;
+      }
+      @java.lang.Override
+      public final Builder setUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.setUnknownFields(unknownFields);
+      }
+
+      @java.lang.Override
+      public final Builder mergeUnknownFields(
+          final com.google.protobuf.UnknownFieldSet unknownFields) {
+        return super.mergeUnknownFields(unknownFields);
+      }
+
+
+      // @@protoc_insertion_point(builder_scope:supervisor.WarmupStatus)
+    }
+
+    // @@protoc_insertion_point(class_scope:supervisor.WarmupStatus)
+    private static final io.gitpod.supervisor.api.Status.WarmupStatus DEFAULT_INSTANCE;
+    static {
+      DEFAULT_INSTANCE = new io.gitpod.supervisor.api.Status.WarmupStatus();
+    }
+
+    public static io.gitpod.supervisor.api.Status.WarmupStatus getDefaultInstance() {
+      return DEFAULT_INSTANCE;
+    }
+
+    private static final com.google.protobuf.Parser<WarmupStatus>
+        PARSER = new com.google.protobuf.AbstractParser<WarmupStatus>() {
+      @java.lang.Override
+      public WarmupStatus parsePartialFrom(
+          com.google.protobuf.CodedInputStream input,
+          com.google.protobuf.ExtensionRegistryLite extensionRegistry)
+          throws com.google.protobuf.InvalidProtocolBufferException {
+        return new WarmupStatus(input, extensionRegistry);
+      }
+    };
+
+    public static com.google.protobuf.Parser<WarmupStatus> parser() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Parser<WarmupStatus> getParserForType() {
+      return PARSER;
+    }
+
+    @java.lang.Override
+    public io.gitpod.supervisor.api.Status.WarmupStatus getDefaultInstanceForType() {
+      return DEFAULT_INSTANCE;
+    }
+
+  }
+
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SupervisorStatusRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SupervisorStatusRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_SupervisorStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_SupervisorStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_IDEStatusRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_IDEStatusRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_IDEStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_IDEStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_IDEStatusResponse_DesktopStatus_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_IDEStatusResponse_DesktopStatus_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ContentStatusRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ContentStatusRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ContentStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ContentStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_BackupStatusRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_BackupStatusRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_BackupStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_BackupStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_PortsStatusRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_PortsStatusRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_PortsStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_PortsStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ExposedPortInfo_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ExposedPortInfo_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_TunneledPortInfo_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_TunneledPortInfo_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_TunneledPortInfo_ClientsEntry_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_TunneledPortInfo_ClientsEntry_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_PortsStatus_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_PortsStatus_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_TasksStatusRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_TasksStatusRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_TasksStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_TasksStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_TaskStatus_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_TaskStatus_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_TaskPresentation_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_TaskPresentation_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ResourcesStatuRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ResourcesStatuRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ResourcesStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ResourcesStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_ResourceStatus_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_ResourceStatus_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_WarmupStatusRequest_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_WarmupStatusRequest_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_WarmupStatusResponse_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_WarmupStatusResponse_fieldAccessorTable;
+  private static final com.google.protobuf.Descriptors.Descriptor
+    internal_static_supervisor_WarmupStatus_descriptor;
+  private static final
+    com.google.protobuf.GeneratedMessageV3.FieldAccessorTable
+      internal_static_supervisor_WarmupStatus_fieldAccessorTable;
+
+  public static com.google.protobuf.Descriptors.FileDescriptor
+      getDescriptor() {
+    return descriptor;
+  }
+  private static  com.google.protobuf.Descriptors.FileDescriptor
+      descriptor;
+  static {
+    java.lang.String[] descriptorData = {
+      "\n\014status.proto\022\nsupervisor\032\034google/api/a" +
+      "nnotations.proto\032\nport.proto\"/\n\027Supervis" +
+      "orStatusRequest\022\024\n\014willShutdown\030\001 \001(\010\"&\n" +
+      "\030SupervisorStatusResponse\022\n\n\002ok\030\001 \001(\010\" \n" +
+      "\020IDEStatusRequest\022\014\n\004wait\030\001 \001(\010\"\253\001\n\021IDES" +
+      "tatusResponse\022\n\n\002ok\030\001 \001(\010\022<\n\007desktop\030\002 \001" +
+      "(\0132+.supervisor.IDEStatusResponse.Deskto" +
+      "pStatus\032L\n\rDesktopStatus\022\014\n\004link\030\001 \001(\t\022\r" +
+      "\n\005label\030\002 \001(\t\022\020\n\010clientID\030\003 \001(\t\022\014\n\004kind\030" +
+      "\004 \001(\t\"$\n\024ContentStatusRequest\022\014\n\004wait\030\001 " +
+      "\001(\010\"\203\001\n\025ContentStatusResponse\022\021\n\tavailab" +
+      "le\030\001 \001(\010\022)\n\006source\030\002 \001(\0162\031.supervisor.Co" +
+      "ntentSource\022,\n\007history\030\003 \001(\0162\033.superviso" +
+      "r.GitHistoryState\"\025\n\023BackupStatusRequest" +
+      "\"0\n\024BackupStatusResponse\022\030\n\020canary_avail" +
+      "able\030\001 \001(\010\"%\n\022PortsStatusRequest\022\017\n\007obse" +
+      "rve\030\001 \001(\010\"=\n\023PortsStatusResponse\022&\n\005port" +
+      "s\030\001 \003(\0132\027.supervisor.PortsStatus\"\263\001\n\017Exp" +
+      "osedPortInfo\022.\n\nvisibility\030\001 \001(\0162\032.super" +
+      "visor.PortVisibility\022\013\n\003url\030\002 \001(\t\0227\n\non_" +
+      "exposed\030\003 \001(\0162\037.supervisor.OnPortExposed" +
+      "ActionB\002\030\001\022*\n\010protocol\030\004 \001(\0162\030.superviso" +
+      "r.PortProtocol\"\304\001\n\020TunneledPortInfo\022\023\n\013t" +
+      "arget_port\030\001 \001(\r\022/\n\nvisibility\030\002 \001(\0162\033.s" +
+      "upervisor.TunnelVisiblity\022:\n\007clients\030\003 \003" +
+      "(\0132).supervisor.TunneledPortInfo.Clients" +
+      "Entry\032.\n\014ClientsEntry\022\013\n\003key\030\001 \001(\t\022\r\n\005va" +
+      "lue\030\002 \001(\r:\0028\001\"\204\003\n\013PortsStatus\022\022\n\nlocal_p" +
+      "ort\030\001 \001(\r\022\016\n\006served\030\004 \001(\010\022,\n\007exposed\030\005 \001" +
+      "(\0132\033.supervisor.ExposedPortInfo\0223\n\rauto_" +
+      "exposure\030\007 \001(\0162\034.supervisor.PortAutoExpo" +
+      "sure\022.\n\010tunneled\030\006 \001(\0132\034.supervisor.Tunn" +
+      "eledPortInfo\022\023\n\013description\030\010 \001(\t\022\014\n\004nam" +
+      "e\030\t \001(\t\0225\n\007on_open\030\n \001(\0162$.supervisor.Po" +
+      "rtsStatus.OnOpenAction\"^\n\014OnOpenAction\022\n" +
+      "\n\006ignore\020\000\022\020\n\014open_browser\020\001\022\020\n\014open_pre" +
+      "view\020\002\022\n\n\006notify\020\003\022\022\n\016notify_private\020\004J\004" +
+      "\010\002\020\003\"%\n\022TasksStatusRequest\022\017\n\007observe\030\001 " +
+      "\001(\010\"<\n\023TasksStatusResponse\022%\n\005tasks\030\001 \003(" +
+      "\0132\026.supervisor.TaskStatus\"\204\001\n\nTaskStatus" +
+      "\022\n\n\002id\030\001 \001(\t\022$\n\005state\030\002 \001(\0162\025.supervisor" +
+      ".TaskState\022\020\n\010terminal\030\003 \001(\t\0222\n\014presenta" +
+      "tion\030\004 \001(\0132\034.supervisor.TaskPresentation" +
+      "\"D\n\020TaskPresentation\022\014\n\004name\030\001 \001(\t\022\017\n\007op" +
+      "en_in\030\002 \001(\t\022\021\n\topen_mode\030\003 \001(\t\"\027\n\025Resour" +
+      "cesStatuRequest\"n\n\027ResourcesStatusRespon" +
+      "se\022*\n\006memory\030\001 \001(\0132\032.supervisor.Resource" +
+      "Status\022\'\n\003cpu\030\002 \001(\0132\032.supervisor.Resourc" +
+      "eStatus\"c\n\016ResourceStatus\022\014\n\004used\030\001 \001(\003\022" +
+      "\r\n\005limit\030\002 \001(\003\0224\n\010severity\030\003 \001(\0162\".super" +
+      "visor.ResourceStatusSeverity\"#\n\023WarmupSt" +
+      "atusRequest\022\014\n\004wait\030\001 \001(\010\"A\n\024WarmupStatu" +
+      "sResponse\022)\n\007warmups\030\001 \003(\0132\030.supervisor." +
+      "WarmupStatus\"g\n\014WarmupStatus\022\014\n\004name\030\001 \001" +
+      "(\t\022&\n\005state\030\002 \001(\0162\027.supervisor.WarmupSta" +
+      "te\022\017\n\007message\030\003 \001(\t\022\020\n\010restored\030\004 \001(\010*C\n" +
+      "\rContentSource\022\016\n\nfrom_other\020\000\022\017\n\013from_b" +
+      "ackup\020\001\022\021\n\rfrom_prebuild\020\002*e\n\017GitHistory" +
+      "State\022\023\n\017history_pending\020\000\022\024\n\020history_co" +
+      "mplete\020\001\022\023\n\017history_shallow\020\002\022\022\n\016history" +
+      "_failed\020\003*?\n\016PortVisibility\022\026\n\022private_v" +
+      "isibility\020\000\022\025\n\021public_visibility\020\001*#\n\014Po" +
+      "rtProtocol\022\010\n\004http\020\000\022\t\n\005https\020\001*e\n\023OnPor" +
+      "tExposedAction\022\n\n\006ignore\020\000\022\020\n\014open_brows" +
+      "er\020\001\022\020\n\014open_preview\020\002\022\n\n\006notify\020\003\022\022\n\016no" +
+      "tify_private\020\004*9\n\020PortAutoExposure\022\n\n\006tr" +
+      "ying\020\000\022\r\n\tsucceeded\020\001\022\n\n\006failed\020\002*1\n\tTas" +
+      "kState\022\013\n\007opening\020\000\022\013\n\007running\020\001\022\n\n\006clos" +
+      "ed\020\002*=\n\026ResourceStatusSeverity\022\n\n\006normal" +
+      "\020\000\022\013\n\007warning\020\001\022\n\n\006danger\020\002*\203\001\n\013WarmupSt" +
+      "ate\022\022\n\016warmup_pending\020\000\022\022\n\016warmup_runnin" +
+      "g\020\001\022\024\n\020warmup_restoring\020\002\022\017\n\013warmup_done" +
+      "\020\003\022\021\n\rwarmup_failed\020\004\022\022\n\016warmup_skipped\020" +
+      "\0052\224\t\n\rStatusService\022\266\001\n\020SupervisorStatus" +
+      "\022#.supervisor.SupervisorStatusRequest\032$." +
+      "supervisor.SupervisorStatusResponse\"W\202\323\344" +
+      "\223\002Q\022\025/v1/status/supervisorZ8\0226/v1/status" +
+      "/supervisor/willShutdown/{willShutdown=t" +
+      "rue}\022\203\001\n\tIDEStatus\022\034.supervisor.IDEStatu" +
+      "sRequest\032\035.supervisor.IDEStatusResponse\"" +
+      "9\202\323\344\223\0023\022\016/v1/status/ideZ!\022\037/v1/status/id" +
+      "e/wait/{wait=true}\022\227\001\n\rContentStatus\022 .s" +
+      "upervisor.ContentStatusRequest\032!.supervi" +
+      "sor.ContentStatusResponse\"A\202\323\344\223\002;\022\022/v1/s" +
+      "tatus/contentZ%\022#/v1/status/content/wait" +
+      "/{wait=true}\022l\n\014BackupStatus\022\037.superviso" +
+      "r.BackupStatusRequest\032 .supervisor.Backu" +
+      "pStatusResponse\"\031\202\323\344\223\002\023\022\021/v1/status/back" +
+      "up\022\225\001\n\013PortsStatus\022\036.supervisor.PortsSta" +
+      "tusRequest\032\037.supervisor.PortsStatusRespo" +
+      "nse\"C\202\323\344\223\002=\022\020/v1/status/portsZ)\022\'/v1/sta" +
+      "tus/ports/observe/{observe=true}0\001\022\225\001\n\013T" +
+      "asksStatus\022\036.supervisor.TasksStatusReque" +
+      "st\032\037.supervisor.TasksStatusResponse\"C\202\323\344" +
+      "\223\002=\022\020/v1/status/tasksZ)\022\'/v1/status/task" +
+      "s/observe/{observe=true}0\001\022w\n\017ResourcesS" +
+      "tatus\022!.supervisor.ResourcesStatuRequest" +
+      "\032#.supervisor.ResourcesStatusResponse\"\034\202" +
+      "\323\344\223\002\026\022\024/v1/status/resources\022\222\001\n\014WarmupSt" +
+      "atus\022\037.supervisor.WarmupStatusRequest\032 ." +
+      "supervisor.WarmupStatusResponse\"?\202\323\344\223\0029\022" +
+      "\021/v1/status/warmupZ$\022\"/v1/status/warmup/" +
+      "wait/{wait=true}BF\n\030io.gitpod.supervisor" +
+      ".apiZ*github.com/gitpod-io/gitpod/superv" +
+      "isor/apib\006proto3"
+    };
+    descriptor = com.google.protobuf.Descriptors.FileDescriptor
+      .internalBuildGeneratedFileFrom(descriptorData,
+        new com.google.protobuf.Descriptors.FileDescriptor[] {
+          com.google.api.AnnotationsProto.getDescriptor(),
+          io.gitpod.supervisor.api.Port.getDescriptor(),
+        });
+    internal_static_supervisor_SupervisorStatusRequest_descriptor =
+      getDescriptor().getMessageTypes().get(0);
+    internal_static_supervisor_SupervisorStatusRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SupervisorStatusRequest_descriptor,
+        new java.lang.String[] { "WillShutdown", });
+    internal_static_supervisor_SupervisorStatusResponse_descriptor =
+      getDescriptor().getMessageTypes().get(1);
+    internal_static_supervisor_SupervisorStatusResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_SupervisorStatusResponse_descriptor,
+        new java.lang.String[] { "Ok", });
+    internal_static_supervisor_IDEStatusRequest_descriptor =
+      getDescriptor().getMessageTypes().get(2);
+    internal_static_supervisor_IDEStatusRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_IDEStatusRequest_descriptor,
+        new java.lang.String[] { "Wait", });
+    internal_static_supervisor_IDEStatusResponse_descriptor =
+      getDescriptor().getMessageTypes().get(3);
+    internal_static_supervisor_IDEStatusResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_IDEStatusResponse_descriptor,
+        new java.lang.String[] { "Ok", "Desktop", });
+    internal_static_supervisor_IDEStatusResponse_DesktopStatus_descriptor =
+      internal_static_supervisor_IDEStatusResponse_descriptor.getNestedTypes().get(0);
+    internal_static_supervisor_IDEStatusResponse_DesktopStatus_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_IDEStatusResponse_DesktopStatus_descriptor,
+        new java.lang.String[] { "Link", "Label", "ClientID", "Kind", });
+    internal_static_supervisor_ContentStatusRequest_descriptor =
+      getDescriptor().getMessageTypes().get(4);
+    internal_static_supervisor_ContentStatusRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_ContentStatusRequest_descriptor,
+        new java.lang.String[] { "Wait", });
+    internal_static_supervisor_ContentStatusResponse_descriptor =
+      getDescriptor().getMessageTypes().get(5);
+    internal_static_supervisor_ContentStatusResponse_fieldAccessorTable = new
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_ContentStatusResponse_descriptor,
-        new java.lang.String[] { "Available", "Source", });
+        new java.lang.String[] { "Available", "Source", "History", });
     internal_static_supervisor_BackupStatusRequest_descriptor =
       getDescriptor().getMessageTypes().get(6);
     internal_static_supervisor_BackupStatusRequest_fieldAccessorTable = new
@@ -17091,6 +19834,24 @@ public final class Status {
       com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
         internal_static_supervisor_ResourceStatus_descriptor,
         new java.lang.String[] { "Used", "Limit", "Severity", });
+    internal_static_supervisor_WarmupStatusRequest_descriptor =
+      getDescriptor().getMessageTypes().get(20);
+    internal_static_supervisor_WarmupStatusRequest_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_WarmupStatusRequest_descriptor,
+        new java.lang.String[] { "Wait", });
+    internal_static_supervisor_WarmupStatusResponse_descriptor =
+      getDescriptor().getMessageTypes().get(21);
+    internal_static_supervisor_WarmupStatusResponse_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_WarmupStatusResponse_descriptor,
+        new java.lang.String[] { "Warmups", });
+    internal_static_supervisor_WarmupStatus_descriptor =
+      getDescriptor().getMessageTypes().get(22);
+    internal_static_supervisor_WarmupStatus_fieldAccessorTable = new
+      com.google.protobuf.GeneratedMessageV3.FieldAccessorTable(
+        internal_static_supervisor_WarmupStatus_descriptor,
+        new java.lang.String[] { "Name", "State", "Message", "Restored", });
     com.google.protobuf.ExtensionRegistry registry =
         com.google.protobuf.ExtensionRegistry.newInstance();
     registry.add(com.google.api.AnnotationsProto.http);
@@ -239,6 +239,37 @@ public final class StatusServiceGrpc {
     return getResourcesStatusMethod;
   }
 
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.WarmupStatusRequest,
+      io.gitpod.supervisor.api.Status.WarmupStatusResponse> getWarmupStatusMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "WarmupStatus",
+      requestType = io.gitpod.supervisor.api.Status.WarmupStatusRequest.class,
+      responseType = io.gitpod.supervisor.api.Status.WarmupStatusResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.WarmupStatusRequest,
+      io.gitpod.supervisor.api.Status.WarmupStatusResponse> getWarmupStatusMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.Status.WarmupStatusRequest, io.gitpod.supervisor.api.Status.WarmupStatusResponse> getWarmupStatusMethod;
+    if ((getWarmupStatusMethod = StatusServiceGrpc.getWarmupStatusMethod) == null) {
+      synchronized (StatusServiceGrpc.class) {
+        if ((getWarmupStatusMethod = StatusServiceGrpc.getWarmupStatusMethod) == null) {
+          StatusServiceGrpc.getWarmupStatusMethod = getWarmupStatusMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.Status.WarmupStatusRequest, io.gitpod.supervisor.api.Status.WarmupStatusResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "WarmupStatus"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Status.WarmupStatusRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.Status.WarmupStatusResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new StatusServiceMethodDescriptorSupplier("WarmupStatus"))
+              .build();
+        }
+      }
+    }
+    return getWarmupStatusMethod;
+  }
+
   /**
    * Creates a new async stub that supports all call types for the service
    */
@@ -363,6 +394,17 @@ public final class StatusServiceGrpc {
       io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getResourcesStatusMethod(), responseObserver);
     }
 
+    /**
+     * <pre>
+     * WarmupStatus provides the status of the warmup commands configured in .gitpod.yml. When used
+     * with `wait`, the call returns when all warmup commands have finished.
+     * </pre>
+     */
+    public void warmupStatus(io.gitpod.supervisor.api.Status.WarmupStatusRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.WarmupStatusResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getWarmupStatusMethod(), responseObserver);
+    }
+
     @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
       return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
           .addMethod(
@@ -414,6 +456,13 @@ public final class StatusServiceGrpc {
                 io.gitpod.supervisor.api.Status.ResourcesStatuRequest,
                 io.gitpod.supervisor.api.Status.ResourcesStatusResponse>(
                   this, METHODID_RESOURCES_STATUS)))
+          .addMethod(
+            getWarmupStatusMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.Status.WarmupStatusRequest,
+                io.gitpod.supervisor.api.Status.WarmupStatusResponse>(
+                  this, METHODID_WARMUP_STATUS)))
           .build();
     }
   }
@@ -514,6 +563,18 @@ public final class StatusServiceGrpc {
       io.grpc.stub.ClientCalls.asyncUnaryCall(
           getChannel().newCall(getResourcesStatusMethod(), getCallOptions()), request, responseObserver);
     }
+
+    /**
+     * <pre>
+     * WarmupStatus provides the status of the warmup commands configured in .gitpod.yml. When used
+     * with `wait`, the call returns when all warmup commands have finished.
+     * </pre>
+     */
+    public void warmupStatus(io.gitpod.supervisor.api.Status.WarmupStatusRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.WarmupStatusResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getWarmupStatusMethod(), getCallOptions()), request, responseObserver);
+    }
   }
 
   /**
@@ -607,6 +668,17 @@ public final class StatusServiceGrpc {
       return io.grpc.stub.ClientCalls.blockingUnaryCall(
           getChannel(), getResourcesStatusMethod(), getCallOptions(), request);
     }
+
+    /**
+     * <pre>
+     * WarmupStatus provides the status of the warmup commands configured in .gitpod.yml. When used
+     * with `wait`, the call returns when all warmup commands have finished.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.Status.WarmupStatusResponse warmupStatus(io.gitpod.supervisor.api.Status.WarmupStatusRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getWarmupStatusMethod(), getCallOptions(), request);
+    }
   }
 
   /**
@@ -683,6 +755,18 @@ public final class StatusServiceGrpc {
       return io.grpc.stub.ClientCalls.futureUnaryCall(
           getChannel().newCall(getResourcesStatusMethod(), getCallOptions()), request);
     }
+
+    /**
+     * <pre>
+     * WarmupStatus provides the status of the warmup commands configured in .gitpod.yml. When used
+     * with `wait`, the call returns when all warmup commands have finished.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.Status.WarmupStatusResponse> warmupStatus(
+        io.gitpod.supervisor.api.Status.WarmupStatusRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getWarmupStatusMethod(), getCallOptions()), request);
+    }
   }
 
   private static final int METHODID_SUPERVISOR_STATUS = 0;
@@ -692,6 +776,7 @@ public final class StatusServiceGrpc {
   private static final int METHODID_PORTS_STATUS = 4;
   private static final int METHODID_TASKS_STATUS = 5;
   private static final int METHODID_RESOURCES_STATUS = 6;
+  private static final int METHODID_WARMUP_STATUS = 7;
 
   private static final class MethodHandlers<Req, Resp> implements
       io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
@@ -738,6 +823,10 @@ public final class StatusServiceGrpc {
           serviceImpl.resourcesStatus((io.gitpod.supervisor.api.Status.ResourcesStatuRequest) request,
               (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.ResourcesStatusResponse>) responseObserver);
           break;
+        case METHODID_WARMUP_STATUS:
+          serviceImpl.warmupStatus((io.gitpod.supervisor.api.Status.WarmupStatusRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.Status.WarmupStatusResponse>) responseObserver);
+          break;
         default:
           throw new AssertionError();
       }
@@ -806,6 +895,7 @@ public final class StatusServiceGrpc {
               .addMethod(getPortsStatusMethod())
               .addMethod(getTasksStatusMethod())
               .addMethod(getResourcesStatusMethod())
+              .addMethod(getWarmupStatusMethod())
               .build();
         }
       }
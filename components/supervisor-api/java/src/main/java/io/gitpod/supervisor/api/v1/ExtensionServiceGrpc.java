@@ -0,0 +1,836 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package io.gitpod.supervisor.api.v1;
+
+import static io.grpc.MethodDescriptor.generateFullMethodName;
+
+/**
+ * <pre>
+ * ExtensionService is the stable API for IDE extensions. Every call must carry the token of the extension
+ * as bearer token in the authorization metadata, and the token must have been granted the scope the call needs.
+ * </pre>
+ */
+@javax.annotation.Generated(
+    value = "by gRPC proto compiler (version 1.49.0)",
+    comments = "Source: v1/extension.proto")
+@io.grpc.stub.annotations.GrpcGenerated
+public final class ExtensionServiceGrpc {
+
+  private ExtensionServiceGrpc() {}
+
+  public static final String SERVICE_NAME = "supervisor.v1.ExtensionService";
+
+  // Static method descriptors that strictly reflect the proto.
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest,
+      io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse> getCreateExtensionTokenMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "CreateExtensionToken",
+      requestType = io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest.class,
+      responseType = io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest,
+      io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse> getCreateExtensionTokenMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest, io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse> getCreateExtensionTokenMethod;
+    if ((getCreateExtensionTokenMethod = ExtensionServiceGrpc.getCreateExtensionTokenMethod) == null) {
+      synchronized (ExtensionServiceGrpc.class) {
+        if ((getCreateExtensionTokenMethod = ExtensionServiceGrpc.getCreateExtensionTokenMethod) == null) {
+          ExtensionServiceGrpc.getCreateExtensionTokenMethod = getCreateExtensionTokenMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest, io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "CreateExtensionToken"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new ExtensionServiceMethodDescriptorSupplier("CreateExtensionToken"))
+              .build();
+        }
+      }
+    }
+    return getCreateExtensionTokenMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest,
+      io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse> getRevokeExtensionTokensMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "RevokeExtensionTokens",
+      requestType = io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest.class,
+      responseType = io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest,
+      io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse> getRevokeExtensionTokensMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest, io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse> getRevokeExtensionTokensMethod;
+    if ((getRevokeExtensionTokensMethod = ExtensionServiceGrpc.getRevokeExtensionTokensMethod) == null) {
+      synchronized (ExtensionServiceGrpc.class) {
+        if ((getRevokeExtensionTokensMethod = ExtensionServiceGrpc.getRevokeExtensionTokensMethod) == null) {
+          ExtensionServiceGrpc.getRevokeExtensionTokensMethod = getRevokeExtensionTokensMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest, io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "RevokeExtensionTokens"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new ExtensionServiceMethodDescriptorSupplier("RevokeExtensionTokens"))
+              .build();
+        }
+      }
+    }
+    return getRevokeExtensionTokensMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.ListTasksRequest,
+      io.gitpod.supervisor.api.v1.Extension.ListTasksResponse> getListTasksMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ListTasks",
+      requestType = io.gitpod.supervisor.api.v1.Extension.ListTasksRequest.class,
+      responseType = io.gitpod.supervisor.api.v1.Extension.ListTasksResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.ListTasksRequest,
+      io.gitpod.supervisor.api.v1.Extension.ListTasksResponse> getListTasksMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.ListTasksRequest, io.gitpod.supervisor.api.v1.Extension.ListTasksResponse> getListTasksMethod;
+    if ((getListTasksMethod = ExtensionServiceGrpc.getListTasksMethod) == null) {
+      synchronized (ExtensionServiceGrpc.class) {
+        if ((getListTasksMethod = ExtensionServiceGrpc.getListTasksMethod) == null) {
+          ExtensionServiceGrpc.getListTasksMethod = getListTasksMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.v1.Extension.ListTasksRequest, io.gitpod.supervisor.api.v1.Extension.ListTasksResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ListTasks"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.ListTasksRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.ListTasksResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new ExtensionServiceMethodDescriptorSupplier("ListTasks"))
+              .build();
+        }
+      }
+    }
+    return getListTasksMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.ListPortsRequest,
+      io.gitpod.supervisor.api.v1.Extension.ListPortsResponse> getListPortsMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ListPorts",
+      requestType = io.gitpod.supervisor.api.v1.Extension.ListPortsRequest.class,
+      responseType = io.gitpod.supervisor.api.v1.Extension.ListPortsResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.ListPortsRequest,
+      io.gitpod.supervisor.api.v1.Extension.ListPortsResponse> getListPortsMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.ListPortsRequest, io.gitpod.supervisor.api.v1.Extension.ListPortsResponse> getListPortsMethod;
+    if ((getListPortsMethod = ExtensionServiceGrpc.getListPortsMethod) == null) {
+      synchronized (ExtensionServiceGrpc.class) {
+        if ((getListPortsMethod = ExtensionServiceGrpc.getListPortsMethod) == null) {
+          ExtensionServiceGrpc.getListPortsMethod = getListPortsMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.v1.Extension.ListPortsRequest, io.gitpod.supervisor.api.v1.Extension.ListPortsResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ListPorts"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.ListPortsRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.ListPortsResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new ExtensionServiceMethodDescriptorSupplier("ListPorts"))
+              .build();
+        }
+      }
+    }
+    return getListPortsMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.ExposePortRequest,
+      io.gitpod.supervisor.api.v1.Extension.ExposePortResponse> getExposePortMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "ExposePort",
+      requestType = io.gitpod.supervisor.api.v1.Extension.ExposePortRequest.class,
+      responseType = io.gitpod.supervisor.api.v1.Extension.ExposePortResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.ExposePortRequest,
+      io.gitpod.supervisor.api.v1.Extension.ExposePortResponse> getExposePortMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.ExposePortRequest, io.gitpod.supervisor.api.v1.Extension.ExposePortResponse> getExposePortMethod;
+    if ((getExposePortMethod = ExtensionServiceGrpc.getExposePortMethod) == null) {
+      synchronized (ExtensionServiceGrpc.class) {
+        if ((getExposePortMethod = ExtensionServiceGrpc.getExposePortMethod) == null) {
+          ExtensionServiceGrpc.getExposePortMethod = getExposePortMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.v1.Extension.ExposePortRequest, io.gitpod.supervisor.api.v1.Extension.ExposePortResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "ExposePort"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.ExposePortRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.ExposePortResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new ExtensionServiceMethodDescriptorSupplier("ExposePort"))
+              .build();
+        }
+      }
+    }
+    return getExposePortMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest,
+      io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse> getGetEnvironmentMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetEnvironment",
+      requestType = io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest.class,
+      responseType = io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest,
+      io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse> getGetEnvironmentMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest, io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse> getGetEnvironmentMethod;
+    if ((getGetEnvironmentMethod = ExtensionServiceGrpc.getGetEnvironmentMethod) == null) {
+      synchronized (ExtensionServiceGrpc.class) {
+        if ((getGetEnvironmentMethod = ExtensionServiceGrpc.getGetEnvironmentMethod) == null) {
+          ExtensionServiceGrpc.getGetEnvironmentMethod = getGetEnvironmentMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest, io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetEnvironment"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new ExtensionServiceMethodDescriptorSupplier("GetEnvironment"))
+              .build();
+        }
+      }
+    }
+    return getGetEnvironmentMethod;
+  }
+
+  private static volatile io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest,
+      io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse> getGetGitStatusMethod;
+
+  @io.grpc.stub.annotations.RpcMethod(
+      fullMethodName = SERVICE_NAME + '/' + "GetGitStatus",
+      requestType = io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest.class,
+      responseType = io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse.class,
+      methodType = io.grpc.MethodDescriptor.MethodType.UNARY)
+  public static io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest,
+      io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse> getGetGitStatusMethod() {
+    io.grpc.MethodDescriptor<io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest, io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse> getGetGitStatusMethod;
+    if ((getGetGitStatusMethod = ExtensionServiceGrpc.getGetGitStatusMethod) == null) {
+      synchronized (ExtensionServiceGrpc.class) {
+        if ((getGetGitStatusMethod = ExtensionServiceGrpc.getGetGitStatusMethod) == null) {
+          ExtensionServiceGrpc.getGetGitStatusMethod = getGetGitStatusMethod =
+              io.grpc.MethodDescriptor.<io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest, io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse>newBuilder()
+              .setType(io.grpc.MethodDescriptor.MethodType.UNARY)
+              .setFullMethodName(generateFullMethodName(SERVICE_NAME, "GetGitStatus"))
+              .setSampledToLocalTracing(true)
+              .setRequestMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest.getDefaultInstance()))
+              .setResponseMarshaller(io.grpc.protobuf.ProtoUtils.marshaller(
+                  io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse.getDefaultInstance()))
+              .setSchemaDescriptor(new ExtensionServiceMethodDescriptorSupplier("GetGitStatus"))
+              .build();
+        }
+      }
+    }
+    return getGetGitStatusMethod;
+  }
+
+  /**
+   * Creates a new async stub that supports all call types for the service
+   */
+  public static ExtensionServiceStub newStub(io.grpc.Channel channel) {
+    io.grpc.stub.AbstractStub.StubFactory<ExtensionServiceStub> factory =
+      new io.grpc.stub.AbstractStub.StubFactory<ExtensionServiceStub>() {
+        @java.lang.Override
+        public ExtensionServiceStub newStub(io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+          return new ExtensionServiceStub(channel, callOptions);
+        }
+      };
+    return ExtensionServiceStub.newStub(factory, channel);
+  }
+
+  /**
+   * Creates a new blocking-style stub that supports unary and streaming output calls on the service
+   */
+  public static ExtensionServiceBlockingStub newBlockingStub(
+      io.grpc.Channel channel) {
+    io.grpc.stub.AbstractStub.StubFactory<ExtensionServiceBlockingStub> factory =
+      new io.grpc.stub.AbstractStub.StubFactory<ExtensionServiceBlockingStub>() {
+        @java.lang.Override
+        public ExtensionServiceBlockingStub newStub(io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+          return new ExtensionServiceBlockingStub(channel, callOptions);
+        }
+      };
+    return ExtensionServiceBlockingStub.newStub(factory, channel);
+  }
+
+  /**
+   * Creates a new ListenableFuture-style stub that supports unary calls on the service
+   */
+  public static ExtensionServiceFutureStub newFutureStub(
+      io.grpc.Channel channel) {
+    io.grpc.stub.AbstractStub.StubFactory<ExtensionServiceFutureStub> factory =
+      new io.grpc.stub.AbstractStub.StubFactory<ExtensionServiceFutureStub>() {
+        @java.lang.Override
+        public ExtensionServiceFutureStub newStub(io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+          return new ExtensionServiceFutureStub(channel, callOptions);
+        }
+      };
+    return ExtensionServiceFutureStub.newStub(factory, channel);
+  }
+
+  /**
+   * <pre>
+   * ExtensionService is the stable API for IDE extensions. Every call must carry the token of the extension
+   * as bearer token in the authorization metadata, and the token must have been granted the scope the call needs.
+   * </pre>
+   */
+  public static abstract class ExtensionServiceImplBase implements io.grpc.BindableService {
+
+    /**
+     * <pre>
+     * CreateExtensionToken issues a token for an IDE extension. Only the IDE can issue tokens: it authenticates
+     * with the registrar token supervisor passes to the IDE launcher through a pipe. The launcher finds the file descriptor
+     * of that pipe in the GITPOD_EXTENSION_REGISTRAR_TOKEN_FD environment variable.
+     * </pre>
+     */
+    public void createExtensionToken(io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getCreateExtensionTokenMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * RevokeExtensionTokens revokes all tokens of an extension. Like CreateExtensionToken, only the IDE can call it.
+     * </pre>
+     */
+    public void revokeExtensionTokens(io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getRevokeExtensionTokensMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListTasks returns the tasks of the workspace. Needs EXTENSION_SCOPE_TASKS_READ.
+     * </pre>
+     */
+    public void listTasks(io.gitpod.supervisor.api.v1.Extension.ListTasksRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.ListTasksResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getListTasksMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListPorts returns the ports of the workspace. Needs EXTENSION_SCOPE_PORTS_READ.
+     * </pre>
+     */
+    public void listPorts(io.gitpod.supervisor.api.v1.Extension.ListPortsRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.ListPortsResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getListPortsMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ExposePort exposes a port of the workspace. Needs EXTENSION_SCOPE_PORTS_WRITE.
+     * </pre>
+     */
+    public void exposePort(io.gitpod.supervisor.api.v1.Extension.ExposePortRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.ExposePortResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getExposePortMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * GetEnvironment returns the environment variables of the workspace. Needs EXTENSION_SCOPE_ENV_READ.
+     * </pre>
+     */
+    public void getEnvironment(io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetEnvironmentMethod(), responseObserver);
+    }
+
+    /**
+     * <pre>
+     * GetGitStatus returns the status of the Git repository of the workspace. Needs EXTENSION_SCOPE_GIT_READ.
+     * </pre>
+     */
+    public void getGitStatus(io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse> responseObserver) {
+      io.grpc.stub.ServerCalls.asyncUnimplementedUnaryCall(getGetGitStatusMethod(), responseObserver);
+    }
+
+    @java.lang.Override public final io.grpc.ServerServiceDefinition bindService() {
+      return io.grpc.ServerServiceDefinition.builder(getServiceDescriptor())
+          .addMethod(
+            getCreateExtensionTokenMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest,
+                io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse>(
+                  this, METHODID_CREATE_EXTENSION_TOKEN)))
+          .addMethod(
+            getRevokeExtensionTokensMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest,
+                io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse>(
+                  this, METHODID_REVOKE_EXTENSION_TOKENS)))
+          .addMethod(
+            getListTasksMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.v1.Extension.ListTasksRequest,
+                io.gitpod.supervisor.api.v1.Extension.ListTasksResponse>(
+                  this, METHODID_LIST_TASKS)))
+          .addMethod(
+            getListPortsMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.v1.Extension.ListPortsRequest,
+                io.gitpod.supervisor.api.v1.Extension.ListPortsResponse>(
+                  this, METHODID_LIST_PORTS)))
+          .addMethod(
+            getExposePortMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.v1.Extension.ExposePortRequest,
+                io.gitpod.supervisor.api.v1.Extension.ExposePortResponse>(
+                  this, METHODID_EXPOSE_PORT)))
+          .addMethod(
+            getGetEnvironmentMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest,
+                io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse>(
+                  this, METHODID_GET_ENVIRONMENT)))
+          .addMethod(
+            getGetGitStatusMethod(),
+            io.grpc.stub.ServerCalls.asyncUnaryCall(
+              new MethodHandlers<
+                io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest,
+                io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse>(
+                  this, METHODID_GET_GIT_STATUS)))
+          .build();
+    }
+  }
+
+  /**
+   * <pre>
+   * ExtensionService is the stable API for IDE extensions. Every call must carry the token of the extension
+   * as bearer token in the authorization metadata, and the token must have been granted the scope the call needs.
+   * </pre>
+   */
+  public static final class ExtensionServiceStub extends io.grpc.stub.AbstractAsyncStub<ExtensionServiceStub> {
+    private ExtensionServiceStub(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      super(channel, callOptions);
+    }
+
+    @java.lang.Override
+    protected ExtensionServiceStub build(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      return new ExtensionServiceStub(channel, callOptions);
+    }
+
+    /**
+     * <pre>
+     * CreateExtensionToken issues a token for an IDE extension. Only the IDE can issue tokens: it authenticates
+     * with the registrar token supervisor passes to the IDE launcher through a pipe. The launcher finds the file descriptor
+     * of that pipe in the GITPOD_EXTENSION_REGISTRAR_TOKEN_FD environment variable.
+     * </pre>
+     */
+    public void createExtensionToken(io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getCreateExtensionTokenMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * RevokeExtensionTokens revokes all tokens of an extension. Like CreateExtensionToken, only the IDE can call it.
+     * </pre>
+     */
+    public void revokeExtensionTokens(io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getRevokeExtensionTokensMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListTasks returns the tasks of the workspace. Needs EXTENSION_SCOPE_TASKS_READ.
+     * </pre>
+     */
+    public void listTasks(io.gitpod.supervisor.api.v1.Extension.ListTasksRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.ListTasksResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getListTasksMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ListPorts returns the ports of the workspace. Needs EXTENSION_SCOPE_PORTS_READ.
+     * </pre>
+     */
+    public void listPorts(io.gitpod.supervisor.api.v1.Extension.ListPortsRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.ListPortsResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getListPortsMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * ExposePort exposes a port of the workspace. Needs EXTENSION_SCOPE_PORTS_WRITE.
+     * </pre>
+     */
+    public void exposePort(io.gitpod.supervisor.api.v1.Extension.ExposePortRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.ExposePortResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getExposePortMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * GetEnvironment returns the environment variables of the workspace. Needs EXTENSION_SCOPE_ENV_READ.
+     * </pre>
+     */
+    public void getEnvironment(io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetEnvironmentMethod(), getCallOptions()), request, responseObserver);
+    }
+
+    /**
+     * <pre>
+     * GetGitStatus returns the status of the Git repository of the workspace. Needs EXTENSION_SCOPE_GIT_READ.
+     * </pre>
+     */
+    public void getGitStatus(io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest request,
+        io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse> responseObserver) {
+      io.grpc.stub.ClientCalls.asyncUnaryCall(
+          getChannel().newCall(getGetGitStatusMethod(), getCallOptions()), request, responseObserver);
+    }
+  }
+
+  /**
+   * <pre>
+   * ExtensionService is the stable API for IDE extensions. Every call must carry the token of the extension
+   * as bearer token in the authorization metadata, and the token must have been granted the scope the call needs.
+   * </pre>
+   */
+  public static final class ExtensionServiceBlockingStub extends io.grpc.stub.AbstractBlockingStub<ExtensionServiceBlockingStub> {
+    private ExtensionServiceBlockingStub(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      super(channel, callOptions);
+    }
+
+    @java.lang.Override
+    protected ExtensionServiceBlockingStub build(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      return new ExtensionServiceBlockingStub(channel, callOptions);
+    }
+
+    /**
+     * <pre>
+     * CreateExtensionToken issues a token for an IDE extension. Only the IDE can issue tokens: it authenticates
+     * with the registrar token supervisor passes to the IDE launcher through a pipe. The launcher finds the file descriptor
+     * of that pipe in the GITPOD_EXTENSION_REGISTRAR_TOKEN_FD environment variable.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse createExtensionToken(io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getCreateExtensionTokenMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * RevokeExtensionTokens revokes all tokens of an extension. Like CreateExtensionToken, only the IDE can call it.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse revokeExtensionTokens(io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getRevokeExtensionTokensMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * ListTasks returns the tasks of the workspace. Needs EXTENSION_SCOPE_TASKS_READ.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.v1.Extension.ListTasksResponse listTasks(io.gitpod.supervisor.api.v1.Extension.ListTasksRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getListTasksMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * ListPorts returns the ports of the workspace. Needs EXTENSION_SCOPE_PORTS_READ.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.v1.Extension.ListPortsResponse listPorts(io.gitpod.supervisor.api.v1.Extension.ListPortsRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getListPortsMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * ExposePort exposes a port of the workspace. Needs EXTENSION_SCOPE_PORTS_WRITE.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.v1.Extension.ExposePortResponse exposePort(io.gitpod.supervisor.api.v1.Extension.ExposePortRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getExposePortMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * GetEnvironment returns the environment variables of the workspace. Needs EXTENSION_SCOPE_ENV_READ.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse getEnvironment(io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetEnvironmentMethod(), getCallOptions(), request);
+    }
+
+    /**
+     * <pre>
+     * GetGitStatus returns the status of the Git repository of the workspace. Needs EXTENSION_SCOPE_GIT_READ.
+     * </pre>
+     */
+    public io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse getGitStatus(io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest request) {
+      return io.grpc.stub.ClientCalls.blockingUnaryCall(
+          getChannel(), getGetGitStatusMethod(), getCallOptions(), request);
+    }
+  }
+
+  /**
+   * <pre>
+   * ExtensionService is the stable API for IDE extensions. Every call must carry the token of the extension
+   * as bearer token in the authorization metadata, and the token must have been granted the scope the call needs.
+   * </pre>
+   */
+  public static final class ExtensionServiceFutureStub extends io.grpc.stub.AbstractFutureStub<ExtensionServiceFutureStub> {
+    private ExtensionServiceFutureStub(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      super(channel, callOptions);
+    }
+
+    @java.lang.Override
+    protected ExtensionServiceFutureStub build(
+        io.grpc.Channel channel, io.grpc.CallOptions callOptions) {
+      return new ExtensionServiceFutureStub(channel, callOptions);
+    }
+
+    /**
+     * <pre>
+     * CreateExtensionToken issues a token for an IDE extension. Only the IDE can issue tokens: it authenticates
+     * with the registrar token supervisor passes to the IDE launcher through a pipe. The launcher finds the file descriptor
+     * of that pipe in the GITPOD_EXTENSION_REGISTRAR_TOKEN_FD environment variable.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse> createExtensionToken(
+        io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getCreateExtensionTokenMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * RevokeExtensionTokens revokes all tokens of an extension. Like CreateExtensionToken, only the IDE can call it.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse> revokeExtensionTokens(
+        io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getRevokeExtensionTokensMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * ListTasks returns the tasks of the workspace. Needs EXTENSION_SCOPE_TASKS_READ.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.v1.Extension.ListTasksResponse> listTasks(
+        io.gitpod.supervisor.api.v1.Extension.ListTasksRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getListTasksMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * ListPorts returns the ports of the workspace. Needs EXTENSION_SCOPE_PORTS_READ.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.v1.Extension.ListPortsResponse> listPorts(
+        io.gitpod.supervisor.api.v1.Extension.ListPortsRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getListPortsMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * ExposePort exposes a port of the workspace. Needs EXTENSION_SCOPE_PORTS_WRITE.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.v1.Extension.ExposePortResponse> exposePort(
+        io.gitpod.supervisor.api.v1.Extension.ExposePortRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getExposePortMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * GetEnvironment returns the environment variables of the workspace. Needs EXTENSION_SCOPE_ENV_READ.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse> getEnvironment(
+        io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetEnvironmentMethod(), getCallOptions()), request);
+    }
+
+    /**
+     * <pre>
+     * GetGitStatus returns the status of the Git repository of the workspace. Needs EXTENSION_SCOPE_GIT_READ.
+     * </pre>
+     */
+    public com.google.common.util.concurrent.ListenableFuture<io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse> getGitStatus(
+        io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest request) {
+      return io.grpc.stub.ClientCalls.futureUnaryCall(
+          getChannel().newCall(getGetGitStatusMethod(), getCallOptions()), request);
+    }
+  }
+
+  private static final int METHODID_CREATE_EXTENSION_TOKEN = 0;
+  private static final int METHODID_REVOKE_EXTENSION_TOKENS = 1;
+  private static final int METHODID_LIST_TASKS = 2;
+  private static final int METHODID_LIST_PORTS = 3;
+  private static final int METHODID_EXPOSE_PORT = 4;
+  private static final int METHODID_GET_ENVIRONMENT = 5;
+  private static final int METHODID_GET_GIT_STATUS = 6;
+
+  private static final class MethodHandlers<Req, Resp> implements
+      io.grpc.stub.ServerCalls.UnaryMethod<Req, Resp>,
+      io.grpc.stub.ServerCalls.ServerStreamingMethod<Req, Resp>,
+      io.grpc.stub.ServerCalls.ClientStreamingMethod<Req, Resp>,
+      io.grpc.stub.ServerCalls.BidiStreamingMethod<Req, Resp> {
+    private final ExtensionServiceImplBase serviceImpl;
+    private final int methodId;
+
+    MethodHandlers(ExtensionServiceImplBase serviceImpl, int methodId) {
+      this.serviceImpl = serviceImpl;
+      this.methodId = methodId;
+    }
+
+    @java.lang.Override
+    @java.lang.SuppressWarnings("unchecked")
+    public void invoke(Req request, io.grpc.stub.StreamObserver<Resp> responseObserver) {
+      switch (methodId) {
+        case METHODID_CREATE_EXTENSION_TOKEN:
+          serviceImpl.createExtensionToken((io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.CreateExtensionTokenResponse>) responseObserver);
+          break;
+        case METHODID_REVOKE_EXTENSION_TOKENS:
+          serviceImpl.revokeExtensionTokens((io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.RevokeExtensionTokensResponse>) responseObserver);
+          break;
+        case METHODID_LIST_TASKS:
+          serviceImpl.listTasks((io.gitpod.supervisor.api.v1.Extension.ListTasksRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.ListTasksResponse>) responseObserver);
+          break;
+        case METHODID_LIST_PORTS:
+          serviceImpl.listPorts((io.gitpod.supervisor.api.v1.Extension.ListPortsRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.ListPortsResponse>) responseObserver);
+          break;
+        case METHODID_EXPOSE_PORT:
+          serviceImpl.exposePort((io.gitpod.supervisor.api.v1.Extension.ExposePortRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.ExposePortResponse>) responseObserver);
+          break;
+        case METHODID_GET_ENVIRONMENT:
+          serviceImpl.getEnvironment((io.gitpod.supervisor.api.v1.Extension.GetEnvironmentRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.GetEnvironmentResponse>) responseObserver);
+          break;
+        case METHODID_GET_GIT_STATUS:
+          serviceImpl.getGitStatus((io.gitpod.supervisor.api.v1.Extension.GetGitStatusRequest) request,
+              (io.grpc.stub.StreamObserver<io.gitpod.supervisor.api.v1.Extension.GetGitStatusResponse>) responseObserver);
+          break;
+        default:
+          throw new AssertionError();
+      }
+    }
+
+    @java.lang.Override
+    @java.lang.SuppressWarnings("unchecked")
+    public io.grpc.stub.StreamObserver<Req> invoke(
+        io.grpc.stub.StreamObserver<Resp> responseObserver) {
+      switch (methodId) {
+        default:
+          throw new AssertionError();
+      }
+    }
+  }
+
+  private static abstract class ExtensionServiceBaseDescriptorSupplier
+      implements io.grpc.protobuf.ProtoFileDescriptorSupplier, io.grpc.protobuf.ProtoServiceDescriptorSupplier {
+    ExtensionServiceBaseDescriptorSupplier() {}
+
+    @java.lang.Override
+    public com.google.protobuf.Descriptors.FileDescriptor getFileDescriptor() {
+      return io.gitpod.supervisor.api.v1.Extension.getDescriptor();
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Descriptors.ServiceDescriptor getServiceDescriptor() {
+      return getFileDescriptor().findServiceByName("ExtensionService");
+    }
+  }
+
+  private static final class ExtensionServiceFileDescriptorSupplier
+      extends ExtensionServiceBaseDescriptorSupplier {
+    ExtensionServiceFileDescriptorSupplier() {}
+  }
+
+  private static final class ExtensionServiceMethodDescriptorSupplier
+      extends ExtensionServiceBaseDescriptorSupplier
+      implements io.grpc.protobuf.ProtoMethodDescriptorSupplier {
+    private final String methodName;
+
+    ExtensionServiceMethodDescriptorSupplier(String methodName) {
+      this.methodName = methodName;
+    }
+
+    @java.lang.Override
+    public com.google.protobuf.Descriptors.MethodDescriptor getMethodDescriptor() {
+      return getServiceDescriptor().findMethodByName(methodName);
+    }
+  }
+
+  private static volatile io.grpc.ServiceDescriptor serviceDescriptor;
+
+  public static io.grpc.ServiceDescriptor getServiceDescriptor() {
+    io.grpc.ServiceDescriptor result = serviceDescriptor;
+    if (result == null) {
+      synchronized (ExtensionServiceGrpc.class) {
+        result = serviceDescriptor;
+        if (result == null) {
+          serviceDescriptor = result = io.grpc.ServiceDescriptor.newBuilder(SERVICE_NAME)
+              .setSchemaDescriptor(new ExtensionServiceFileDescriptorSupplier())
+              .addMethod(getCreateExtensionTokenMethod())
+              .addMethod(getRevokeExtensionTokensMethod())
+              .addMethod(getListTasksMethod())
+              .addMethod(getListPortsMethod())
+              .addMethod(getExposePortMethod())
+              .addMethod(getGetEnvironmentMethod())
+              .addMethod(getGetGitStatusMethod())
+              .build();
+        }
+      }
+    }
+    return result;
+  }
+}
@@ -21,13 +21,13 @@ protoc \
     --plugin=protoc-gen-grpc="$DIR"/node_modules/.bin/grpc_tools_node_protoc_plugin \
     --js_out=import_style=commonjs,binary:lib \
     --grpc_out=grpc_js:lib \
-    "${PROTOLOC:-..}"/*.proto
+    "${PROTOLOC:-..}"/*.proto "${PROTOLOC:-..}"/v1/*.proto
 
 protoc \
     -I"$THIRD_PARTY_INCLUDES"/third_party -I/usr/lib/protoc/include \
     -I"${PROTOLOC:-..}" \
     --plugin=protoc-gen-ts="$DIR"/node_modules/.bin/protoc-gen-ts \
     --ts_out=grpc_js:lib \
-    "${PROTOLOC:-..}"/*.proto
+    "${PROTOLOC:-..}"/*.proto "${PROTOLOC:-..}"/v1/*.proto
 
-sed -i '/google_api_annotations_pb/d' lib/*.js
+sed -i '/google_api_annotations_pb/d' lib/*.js lib/v1/*.js
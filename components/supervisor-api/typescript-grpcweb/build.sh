@@ -19,7 +19,7 @@ protoc -I"$THIRD_PARTY_INCLUDES"/third_party -I/usr/lib/protoc/include \
     --plugin="protoc-gen-ts=$DIR/node_modules/.bin/protoc-gen-ts" \
     --js_out="import_style=commonjs,binary:lib" \
     --ts_out="service=grpc-web:lib" \
-    -I"${PROTOLOC:-..}" "${PROTOLOC:-..}"/*.proto
+    -I"${PROTOLOC:-..}" "${PROTOLOC:-..}"/*.proto "${PROTOLOC:-..}"/v1/*.proto
 
 # shellcheck disable=SC2038
 find lib -iname '*.js' -or -iname '*.ts' | xargs sed -i '\/google\/api\/annotations_pb/d'
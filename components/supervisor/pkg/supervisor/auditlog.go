@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+// auditLogEntry has the format of the audit logs of server, see components/gitpod-protocol/src/audit-log.ts
+type auditLogEntry struct {
+	ID             string        `json:"id"`
+	Timestamp      string        `json:"timestamp"`
+	Action         string        `json:"action"`
+	OrganizationID string        `json:"organizationId"`
+	ActorID        string        `json:"actorId"`
+	Args           []interface{} `json:"args"`
+}
+
+// auditLog writes the actions supervisor takes on behalf of the organization of the workspace as JSON lines.
+// Log collection picks them up from the supervisor output like the audit log entries server writes.
+type auditLog struct {
+	out io.Writer
+	cfg *Config
+	now func() time.Time
+	mu  sync.Mutex
+}
+
+func newAuditLog(out io.Writer, cfg *Config) *auditLog {
+	return &auditLog{out: out, cfg: cfg, now: time.Now}
+}
+
+// Record writes an audit log entry of the workspace owner. It is safe to call on a nil auditLog.
+func (l *auditLog) Record(action string, args ...interface{}) {
+	if l == nil {
+		return
+	}
+	if args == nil {
+		args = []interface{}{}
+	}
+
+	entry := auditLogEntry{
+		ID:             uuid.New().String(),
+		Timestamp:      l.now().UTC().Format(time.RFC3339Nano),
+		Action:         action,
+		OrganizationID: l.cfg.OrganizationId,
+		ActorID:        l.cfg.OwnerId,
+		Args:           args,
+	}
+	line, err := json.Marshal(struct {
+		Message  string        `json:"message"`
+		AuditLog auditLogEntry `json:"auditLog"`
+	}{"audit", entry})
+	if err != nil {
+		log.WithError(err).WithField("action", action).Error("cannot marshal audit log entry")
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err = l.out.Write(append(line, '\n'))
+	if err != nil {
+		log.WithError(err).WithField("action", action).Error("cannot write audit log entry")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAuditLog(t *testing.T) {
+	var out bytes.Buffer
+	l := newAuditLog(&out, &Config{WorkspaceConfig: WorkspaceConfig{OwnerId: "owner", OrganizationId: "org"}})
+	l.now = func() time.Time { return time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC) }
+
+	l.Record("commandPolicyViolation", map[string]interface{}{"command": "nc -l 80", "blocked": true})
+	l.Record("noArgs")
+
+	var act []auditLogEntry
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var line struct {
+			Message  string        `json:"message"`
+			AuditLog auditLogEntry `json:"auditLog"`
+		}
+		err := dec.Decode(&line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line.Message != "audit" {
+			t.Errorf("unexpected message: %s", line.Message)
+		}
+		if line.AuditLog.ID == "" {
+			t.Error("audit log entry has no ID")
+		}
+		act = append(act, line.AuditLog)
+	}
+
+	exp := []auditLogEntry{
+		{
+			Timestamp:      "2024-01-01T12:00:00Z",
+			Action:         "commandPolicyViolation",
+			OrganizationID: "org",
+			ActorID:        "owner",
+			Args:           []interface{}{map[string]interface{}{"command": "nc -l 80", "blocked": true}},
+		},
+		{
+			Timestamp:      "2024-01-01T12:00:00Z",
+			Action:         "noArgs",
+			OrganizationID: "org",
+			ActorID:        "owner",
+			Args:           []interface{}{},
+		},
+	}
+	if diff := cmp.Diff(exp, act, cmpopts.IgnoreFields(auditLogEntry{}, "ID")); diff != "" {
+		t.Errorf("unexpected audit log entries (-want +got):\n%s", diff)
+	}
+
+	// must not panic
+	var nilLog *auditLog
+	nilLog.Record("noop")
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// CommandPolicy restricts the commands tasks may run. Organizations configure it for regulated environments.
+//
+// The policy is checked against the task commands before they run. It does not cover commands users type
+// in terminals or commands which scripts invoke.
+type CommandPolicy struct {
+	// Deny lists regular expressions. Command lines matching any of them must not run, e.g. `curl .*\|\s*(ba)?sh`.
+	Deny []string `json:"deny,omitempty"`
+
+	// Allow lists regular expressions of command lines which may run even though they violate the policy otherwise.
+	Allow []string `json:"allow,omitempty"`
+
+	// BlockedBinaries lists executables which must not run, either by name or by absolute path.
+	BlockedBinaries []string `json:"blockedBinaries,omitempty"`
+
+	// Audit only reports violations instead of blocking the commands.
+	Audit bool `json:"audit,omitempty"`
+
+	deny  []*regexp.Regexp
+	allow []*regexp.Regexp
+}
+
+func (p *CommandPolicy) UnmarshalEnvironmentValue(data string) error {
+	var tmp CommandPolicy
+	if err := json.Unmarshal([]byte(data), &tmp); err != nil {
+		return err
+	}
+	if err := tmp.compile(); err != nil {
+		return err
+	}
+	*p = tmp
+	return nil
+}
+
+func (p CommandPolicy) MarshalEnvironmentValue() (string, error) {
+	bytes, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+func (p *CommandPolicy) compile() error {
+	p.deny = make([]*regexp.Regexp, 0, len(p.Deny))
+	for _, expr := range p.Deny {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("invalid deny pattern %q: %w", expr, err)
+		}
+		p.deny = append(p.deny, re)
+	}
+	p.allow = make([]*regexp.Regexp, 0, len(p.Allow))
+	for _, expr := range p.Allow {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return fmt.Errorf("invalid allow pattern %q: %w", expr, err)
+		}
+		p.allow = append(p.allow, re)
+	}
+	return nil
+}
+
+// CommandViolation is a command line which violates a command policy
+type CommandViolation struct {
+	// Command is the offending command line
+	Command string
+	// Rule is the deny pattern or blocked binary the command line violates
+	Rule string
+}
+
+func (v CommandViolation) String() string {
+	return fmt.Sprintf("%s (%s)", v.Command, v.Rule)
+}
+
+// Check returns the command lines which violate the policy. It is safe to call on a nil policy.
+func (p *CommandPolicy) Check(commands ...string) []CommandViolation {
+	if p == nil {
+		return nil
+	}
+	if p.deny == nil && p.allow == nil {
+		// the policy was not created from the environment
+		if err := p.compile(); err != nil {
+			return []CommandViolation{{Command: strings.Join(commands, "\n"), Rule: err.Error()}}
+		}
+	}
+
+	var res []CommandViolation
+	for _, command := range commands {
+		for _, line := range commandLines(command) {
+			if p.allowed(line) {
+				continue
+			}
+			if rule := p.violation(line); rule != "" {
+				res = append(res, CommandViolation{Command: line, Rule: rule})
+			}
+		}
+	}
+	return res
+}
+
+func (p *CommandPolicy) allowed(line string) bool {
+	for _, re := range p.allow {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *CommandPolicy) violation(line string) string {
+	for i, re := range p.deny {
+		if re.MatchString(line) {
+			return "denied by " + p.Deny[i]
+		}
+	}
+	for _, exe := range executables(line) {
+		for _, blocked := range p.BlockedBinaries {
+			if exe == blocked || (!strings.Contains(blocked, "/") && filepath.Base(exe) == blocked) {
+				return "blocked binary " + blocked
+			}
+		}
+	}
+	return ""
+}
+
+// commandLines splits a command into its non-empty lines, joining lines continued with a backslash
+func commandLines(command string) []string {
+	var (
+		res  []string
+		cont string
+	)
+	for _, line := range strings.Split(command, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasSuffix(line, "\\") {
+			cont += strings.TrimSpace(strings.TrimSuffix(line, "\\")) + " "
+			continue
+		}
+		line = strings.TrimSpace(cont + line)
+		cont = ""
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		res = append(res, line)
+	}
+	if cont = strings.TrimSpace(cont); cont != "" {
+		res = append(res, cont)
+	}
+	return res
+}
+
+var (
+	// commandSeparators splits a command line into simple commands
+	commandSeparators = regexp.MustCompile(`\|\||&&|[|&;()\x60{}]|\$\(`)
+	envAssignment     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*=`)
+	// commandWrappers run the command which follows them
+	commandWrappers = map[string]struct{}{
+		"builtin": {},
+		"command": {},
+		"env":     {},
+		"exec":    {},
+		"nice":    {},
+		"nohup":   {},
+		"sudo":    {},
+		"time":    {},
+		"xargs":   {},
+	}
+)
+
+// executables returns the executables a command line runs. This is a best effort which does not
+// understand quoting, hence separators within quotes may yield additional executables.
+func executables(line string) []string {
+	var res []string
+	for _, cmd := range commandSeparators.Split(line, -1) {
+		for _, word := range strings.Fields(cmd) {
+			word = strings.Trim(word, `"'`)
+			if word == "" || envAssignment.MatchString(word) || strings.HasPrefix(word, "-") {
+				continue
+			}
+			res = append(res, word)
+			if _, wrapper := commandWrappers[filepath.Base(word)]; !wrapper {
+				break
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCommandPolicyCheck(t *testing.T) {
+	policy := &CommandPolicy{}
+	err := policy.UnmarshalEnvironmentValue(`{
+		"deny": ["curl .*\\|\\s*(ba)?sh"],
+		"allow": ["^curl https://internal\\.example\\.com/"],
+		"blockedBinaries": ["nc", "/usr/bin/wget"]
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		Name        string
+		Command     string
+		Expectation []CommandViolation
+	}{
+		{
+			Name:    "allowed",
+			Command: "npm install\nnpm run build",
+		},
+		{
+			Name:        "curl pipe to shell",
+			Command:     "npm install\ncurl -fsSL https://example.com/install.sh | bash",
+			Expectation: []CommandViolation{{Command: "curl -fsSL https://example.com/install.sh | bash", Rule: `denied by curl .*\|\s*(ba)?sh`}},
+		},
+		{
+			Name:    "allow overrides deny",
+			Command: "curl https://internal.example.com/setup.sh | sh",
+		},
+		{
+			Name:        "blocked binary by name",
+			Command:     "echo ready && /bin/nc -l 4444",
+			Expectation: []CommandViolation{{Command: "echo ready && /bin/nc -l 4444", Rule: "blocked binary nc"}},
+		},
+		{
+			Name:        "blocked binary behind wrappers and env",
+			Command:     "FOO=bar sudo -E env nc example.com 80",
+			Expectation: []CommandViolation{{Command: "FOO=bar sudo -E env nc example.com 80", Rule: "blocked binary nc"}},
+		},
+		{
+			Name:    "blocked binary by path",
+			Command: "wget https://example.com",
+		},
+		{
+			Name:        "blocked binary in subshell",
+			Command:     "echo $(/usr/bin/wget -qO- https://example.com)",
+			Expectation: []CommandViolation{{Command: "echo $(/usr/bin/wget -qO- https://example.com)", Rule: "blocked binary /usr/bin/wget"}},
+		},
+		{
+			Name:        "continued line",
+			Command:     "curl -fsSL https://example.com/install.sh \\\n  | sh",
+			Expectation: []CommandViolation{{Command: "curl -fsSL https://example.com/install.sh | sh", Rule: `denied by curl .*\|\s*(ba)?sh`}},
+		},
+		{
+			Name:    "comment",
+			Command: "# nc -l 4444",
+		},
+		{
+			Name:    "argument",
+			Command: "echo nc",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := policy.Check(test.Command)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected violations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCommandPolicyNil(t *testing.T) {
+	var policy *CommandPolicy
+	if violations := policy.Check("curl https://example.com | sh"); len(violations) != 0 {
+		t.Errorf("unexpected violations: %v", violations)
+	}
+}
+
+func TestCommandPolicyInvalidPattern(t *testing.T) {
+	policy := &CommandPolicy{}
+	err := policy.UnmarshalEnvironmentValue(`{"deny": ["curl ("]}`)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -248,6 +249,9 @@ type WorkspaceConfig struct {
 	// WorkspaceClassInfo denotes the detail of workspace class
 	WorkspaceClassInfo *WorkspaceClassInfo `env:"GITPOD_WORKSPACE_CLASS_INFO"`
 
+	// CommandPolicy restricts the commands tasks may run
+	CommandPolicy *CommandPolicy `env:"GITPOD_COMMAND_POLICY"`
+
 	// DefaultWorkspaceImage is the default image of current workspace
 	DefaultWorkspaceImage string `env:"GITPOD_DEFAULT_WORKSPACE_IMAGE"`
 
@@ -259,6 +263,10 @@ type WorkspaceConfig struct {
 	// value retrieved from server with FeatureFlag
 	IsSetJavaProcessorCount bool `env:"GITPOD_IS_SET_JAVA_PROCESSOR_COUNT"`
 
+	// IsSetNodeMaxOldSpaceSize is a flag to indicate if NODE_OPTIONS should limit the heap size of Node to the workspace memory
+	// value retrieved from server with FeatureFlag
+	IsSetNodeMaxOldSpaceSize bool `env:"GITPOD_IS_SET_NODE_MAX_OLD_SPACE_SIZE"`
+
 	// IDEPort is the port at which the IDE will need to run on. This is not an IDE config
 	// because Gitpod determines this port, not the IDE.
 	IDEPort int `env:"GITPOD_THEIA_PORT"`
@@ -322,6 +330,12 @@ type WorkspaceConfig struct {
 	// the in-workspace epxerience.
 	DotfileRepo string `env:"SUPERVISOR_DOTFILE_REPO"`
 
+	// Shell is the name of the shell the user selected for their terminals, i.e. bash, zsh or fish.
+	Shell string `env:"SUPERVISOR_SHELL"`
+
+	// ShellProfile is a snippet the user wants their shell to source when it starts, in the syntax of that shell.
+	ShellProfile string `env:"SUPERVISOR_SHELL_PROFILE"`
+
 	// EnvvarOTS points to a URL from which environment variables for child processes can be downloaded from.
 	// This provides a safer means to transport environment variables compared to shipping them on the Kubernetes pod.
 	//
@@ -334,6 +348,9 @@ type WorkspaceConfig struct {
 	// OwnerId is the user id who owns the workspace
 	OwnerId string `env:"GITPOD_OWNER_ID"`
 
+	// OrganizationId is the id of the organization the workspace belongs to
+	OrganizationId string `env:"GITPOD_ORGANIZATION_ID"`
+
 	// DebugWorkspaceType indicates whether it is a regular or prebuild debug workspace
 	DebugWorkspaceType api.DebugWorkspaceType `env:"SUPERVISOR_DEBUG_WORKSPACE_TYPE"`
 
@@ -354,14 +371,81 @@ type WorkspaceGitpodToken struct {
 
 // TaskConfig defines gitpod task shape.
 type TaskConfig struct {
-	Name     *string                 `json:"name,omitempty"`
-	Before   *string                 `json:"before,omitempty"`
-	Init     *string                 `json:"init,omitempty"`
-	Prebuild *string                 `json:"prebuild,omitempty"`
-	Command  *string                 `json:"command,omitempty"`
-	Env      *map[string]interface{} `json:"env,omitempty"`
-	OpenIn   *string                 `json:"openIn,omitempty"`
-	OpenMode *string                 `json:"openMode,omitempty"`
+	Name     *string                  `json:"name,omitempty"`
+	Before   *string                  `json:"before,omitempty"`
+	Init     *string                  `json:"init,omitempty"`
+	Prebuild *string                  `json:"prebuild,omitempty"`
+	Command  *string                  `json:"command,omitempty"`
+	Env      *map[string]interface{}  `json:"env,omitempty"`
+	Matrix   map[string][]interface{} `json:"matrix,omitempty"`
+	OpenIn   *string                  `json:"openIn,omitempty"`
+	OpenMode *string                  `json:"openMode,omitempty"`
+}
+
+// maxTaskMatrixSize is the maximum number of tasks a single task matrix may expand to
+const maxTaskMatrixSize = 16
+
+// expandMatrix returns one task per combination of the matrix values. The values are added to the
+// environment of each task, overriding env vars of the same name, and are listed in the task name.
+// Tasks without a matrix are returned as they are.
+func (t TaskConfig) expandMatrix() ([]TaskConfig, error) {
+	if len(t.Matrix) == 0 {
+		return []TaskConfig{t}, nil
+	}
+
+	keys := make([]string, 0, len(t.Matrix))
+	size := 1
+	for key, values := range t.Matrix {
+		if len(values) == 0 {
+			return nil, xerrors.Errorf("matrix variable %s has no values", key)
+		}
+		size *= len(values)
+		if size > maxTaskMatrixSize {
+			return nil, xerrors.Errorf("matrix expands to more than %d tasks", maxTaskMatrixSize)
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// combinations holds the index of the value per key, ordered like keys
+	combinations := [][]int{{}}
+	for _, key := range keys {
+		var next [][]int
+		for _, combination := range combinations {
+			for i := range t.Matrix[key] {
+				next = append(next, append(slices.Clone(combination), i))
+			}
+		}
+		combinations = next
+	}
+
+	tasks := make([]TaskConfig, 0, len(combinations))
+	for _, combination := range combinations {
+		env := make(map[string]interface{})
+		if t.Env != nil {
+			for key, value := range *t.Env {
+				env[key] = value
+			}
+		}
+		suffix := make([]string, 0, len(keys))
+		for i, key := range keys {
+			value := t.Matrix[key][combination[i]]
+			env[key] = value
+			suffix = append(suffix, fmt.Sprintf("%s=%v", key, value))
+		}
+
+		name := strings.Join(suffix, ", ")
+		if t.Name != nil && *t.Name != "" {
+			name = fmt.Sprintf("%s (%s)", *t.Name, name)
+		}
+
+		task := t
+		task.Name = &name
+		task.Env = &env
+		task.Matrix = nil
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
 }
 
 // Validate validates this configuration.
@@ -487,7 +571,13 @@ func (c Config) getGitpodTasks() (tasks []TaskConfig, err error) {
 			return nil, xerrors.Errorf("cannot parse tasks: %w", err)
 		}
 		if configured != nil {
-			tasks = append(tasks, *configured...)
+			for _, task := range *configured {
+				expanded, err := task.expandMatrix()
+				if err != nil {
+					return nil, xerrors.Errorf("cannot expand task matrix: %w", err)
+				}
+				tasks = append(tasks, expanded...)
+			}
 		}
 	}
 
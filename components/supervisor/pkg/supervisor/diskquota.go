@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	daemonapi "github.com/gitpod-io/gitpod/ws-daemon/api"
+)
+
+const diskQuotaCheckInterval = 1 * time.Minute
+
+// watchDiskQuota periodically checks the disk usage of the workspace as reported by ws-daemon
+// and warns the user when it gets close to the quota of the workspace.
+func watchDiskQuota(ctx context.Context, wg *sync.WaitGroup, cfg *Config, notifications *NotificationService, cstate ContentState) {
+	defer wg.Done()
+
+	if cfg.isHeadless() {
+		return
+	}
+
+	select {
+	case <-cstate.ContentReady():
+	case <-ctx.Done():
+		return
+	}
+
+	var (
+		severity = api.ResourceStatusSeverity_normal
+		ticker   = time.NewTicker(diskQuotaCheckInterval)
+	)
+	defer ticker.Stop()
+	for {
+		disk, err := diskUsage(ctx)
+		if err != nil {
+			log.WithError(err).Debug("cannot get disk usage")
+		} else if disk != nil {
+			var notify bool
+			severity, notify = diskQuotaSeverity(disk.Used, disk.Limit, severity)
+			if notify {
+				go notifyDiskQuota(ctx, notifications, severity, disk)
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// diskQuotaSeverity computes the severity of the disk usage and whether the user needs to be notified about it,
+// which is the case whenever the severity increases. Users are notified again if usage drops and rises again.
+func diskQuotaSeverity(used, limit int64, last api.ResourceStatusSeverity) (severity api.ResourceStatusSeverity, notify bool) {
+	if limit <= 0 {
+		return api.ResourceStatusSeverity_normal, false
+	}
+	severity = calcSeverity(int64((float64(used) / float64(limit)) * 100))
+	return severity, severity > last
+}
+
+func notifyDiskQuota(ctx context.Context, notifications *NotificationService, severity api.ResourceStatusSeverity, disk *daemonapi.Disk) {
+	level := api.NotifyRequest_WARNING
+	if severity == api.ResourceStatusSeverity_danger {
+		level = api.NotifyRequest_ERROR
+	}
+	_, err := notifications.Notify(ctx, &api.NotifyRequest{
+		Level:   level,
+		Message: fmt.Sprintf("This workspace is running out of disk space: %s of %s used. Free up space to avoid failing writes.", formatGiB(disk.Used), formatGiB(disk.Limit)),
+	})
+	if err != nil && ctx.Err() == nil {
+		log.WithError(err).Warn("cannot notify about disk usage")
+	}
+}
+
+func formatGiB(bytes int64) string {
+	return fmt.Sprintf("%.1f GiB", float64(bytes)/(1024*1024*1024))
+}
+
+// diskUsage returns the disk usage of the workspace, or nil if the workspace has no disk quota.
+func diskUsage(ctx context.Context) (*daemonapi.Disk, error) {
+	const socketFN = "/.supervisor/info.sock"
+
+	if _, err := os.Stat(socketFN); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	conn, err := grpc.DialContext(ctx, "unix://"+socketFN, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, xerrors.Errorf("could not dial context: %w", err)
+	}
+	defer conn.Close()
+
+	client := daemonapi.NewWorkspaceInfoServiceClient(conn)
+	resp, err := client.WorkspaceInfo(ctx, &daemonapi.WorkspaceInfoRequest{})
+	if err != nil {
+		return nil, xerrors.Errorf("could not retrieve workspace info: %w", err)
+	}
+	return resp.Resources.GetDisk(), nil
+}
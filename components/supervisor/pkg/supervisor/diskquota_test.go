@@ -0,0 +1,40 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"testing"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+func TestDiskQuotaSeverity(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Used     int64
+		Limit    int64
+		Last     api.ResourceStatusSeverity
+		Severity api.ResourceStatusSeverity
+		Notify   bool
+	}{
+		{Name: "no quota", Used: 100, Limit: 0, Severity: api.ResourceStatusSeverity_normal},
+		{Name: "below threshold", Used: 50, Limit: 100, Severity: api.ResourceStatusSeverity_normal},
+		{Name: "near quota", Used: 85, Limit: 100, Severity: api.ResourceStatusSeverity_warning, Notify: true},
+		{Name: "still near quota", Used: 90, Limit: 100, Last: api.ResourceStatusSeverity_warning, Severity: api.ResourceStatusSeverity_warning},
+		{Name: "quota exhausted", Used: 99, Limit: 100, Last: api.ResourceStatusSeverity_warning, Severity: api.ResourceStatusSeverity_danger, Notify: true},
+		{Name: "usage dropped", Used: 85, Limit: 100, Last: api.ResourceStatusSeverity_danger, Severity: api.ResourceStatusSeverity_warning},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			severity, notify := diskQuotaSeverity(test.Used, test.Limit, test.Last)
+			if severity != test.Severity {
+				t.Errorf("unexpected severity: got %v, want %v", severity, test.Severity)
+			}
+			if notify != test.Notify {
+				t.Errorf("unexpected notify: got %v, want %v", notify, test.Notify)
+			}
+		})
+	}
+}
@@ -106,7 +106,7 @@ func socketActivationForDocker(parentCtx context.Context, wg *sync.WaitGroup, te
 			return err
 		}
 		gpCmd := exec.CommandContext(ctx, gpPath, "open", dockerUpLogFilePath)
-		gpCmd.Env = childProcEnvvars
+		gpCmd.Env = childProcEnv()
 		gpCmd.Stdout = os.Stdout
 		gpCmd.Stderr = os.Stderr
 		return gpCmd.Run()
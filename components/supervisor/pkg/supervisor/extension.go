@@ -0,0 +1,286 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"os"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/content-service/pkg/git"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	v1 "github.com/gitpod-io/gitpod/supervisor/api/v1"
+)
+
+// extensionRegistrarTokenFDEnvVar is the environment variable which tells the IDE launcher the file descriptor
+// it reads the registrar token from. The token is not passed in the environment, which every extension and
+// terminal inherits, but through a pipe: once the launcher has read the token, the pipe is drained and
+// processes which inherit the file descriptor read nothing.
+const extensionRegistrarTokenFDEnvVar = "GITPOD_EXTENSION_REGISTRAR_TOKEN_FD"
+
+type extensionPortsManager interface {
+	Status() []*api.PortsStatus
+	Expose(ctx context.Context, port uint32) error
+}
+
+type extensionToken struct {
+	ExtensionID string
+	Scopes      map[v1.ExtensionScope]struct{}
+}
+
+// extensionService implements the versioned API for IDE extensions. Unlike the other supervisor
+// services, which grant all-access to everything running in the workspace, extensions need a token
+// which the IDE issues for them and which is limited to the scopes the extension was granted.
+type extensionService struct {
+	registrarToken string
+
+	ports   extensionPortsManager
+	tasks   *tasksManager
+	content ContentState
+	git     *git.Client
+	env     func() []string
+
+	mu     sync.RWMutex
+	tokens map[string]*extensionToken
+
+	v1.UnimplementedExtensionServiceServer
+}
+
+func newExtensionService(ports extensionPortsManager, tasks *tasksManager, content ContentState, git *git.Client, env func() []string) (*extensionService, error) {
+	registrarToken, err := newExtensionTokenString()
+	if err != nil {
+		return nil, err
+	}
+	return &extensionService{
+		registrarToken: registrarToken,
+		ports:          ports,
+		tasks:          tasks,
+		content:        content,
+		git:            git,
+		env:            env,
+		tokens:         make(map[string]*extensionToken),
+	}, nil
+}
+
+// registrarTokenPipe returns the read end of a pipe which holds the registrar token and nothing else
+func registrarTokenPipe(token string) (*os.File, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	_, err = w.WriteString(token)
+	w.Close()
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+func newExtensionTokenString() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// RegisterGRPC registers the gRPC extension service.
+func (s *extensionService) RegisterGRPC(srv *grpc.Server) {
+	v1.RegisterExtensionServiceServer(srv, s)
+}
+
+// bearerToken returns the bearer token of the incoming request
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md.Get("authorization") {
+		if token, ok := strings.CutPrefix(v, "Bearer "); ok {
+			return token
+		}
+	}
+	return ""
+}
+
+// authorizeRegistrar makes sure the request was made by the IDE
+func (s *extensionService) authorizeRegistrar(ctx context.Context) error {
+	token := bearerToken(ctx)
+	if token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.registrarToken)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid registrar token")
+	}
+	return nil
+}
+
+// authorize makes sure the request was made by an extension which was granted the scope
+func (s *extensionService) authorize(ctx context.Context, scope v1.ExtensionScope) error {
+	token := bearerToken(ctx)
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "missing extension token")
+	}
+
+	s.mu.RLock()
+	t, ok := s.tokens[token]
+	s.mu.RUnlock()
+	if !ok {
+		return status.Error(codes.Unauthenticated, "invalid extension token")
+	}
+	if _, granted := t.Scopes[scope]; !granted {
+		return status.Errorf(codes.PermissionDenied, "extension %s was not granted %s", t.ExtensionID, scope)
+	}
+	return nil
+}
+
+// CreateExtensionToken issues a token for an extension.
+func (s *extensionService) CreateExtensionToken(ctx context.Context, req *v1.CreateExtensionTokenRequest) (*v1.CreateExtensionTokenResponse, error) {
+	err := s.authorizeRegistrar(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.ExtensionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "extension_id is required")
+	}
+
+	scopes := make(map[v1.ExtensionScope]struct{}, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		if _, known := v1.ExtensionScope_name[int32(scope)]; !known || scope == v1.ExtensionScope_EXTENSION_SCOPE_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown scope %d", scope)
+		}
+		scopes[scope] = struct{}{}
+	}
+
+	token, err := newExtensionTokenString()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.mu.Lock()
+	s.tokens[token] = &extensionToken{ExtensionID: req.ExtensionId, Scopes: scopes}
+	s.mu.Unlock()
+
+	log.WithField("extension", req.ExtensionId).WithField("scopes", req.Scopes).Debug("issued extension token")
+	return &v1.CreateExtensionTokenResponse{Token: token}, nil
+}
+
+// RevokeExtensionTokens revokes all tokens of an extension.
+func (s *extensionService) RevokeExtensionTokens(ctx context.Context, req *v1.RevokeExtensionTokensRequest) (*v1.RevokeExtensionTokensResponse, error) {
+	err := s.authorizeRegistrar(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	for token, t := range s.tokens {
+		if t.ExtensionID == req.ExtensionId {
+			delete(s.tokens, token)
+		}
+	}
+	s.mu.Unlock()
+
+	return &v1.RevokeExtensionTokensResponse{}, nil
+}
+
+// ListTasks returns the tasks of the workspace.
+func (s *extensionService) ListTasks(ctx context.Context, req *v1.ListTasksRequest) (*v1.ListTasksResponse, error) {
+	err := s.authorize(ctx, v1.ExtensionScope_EXTENSION_SCOPE_TASKS_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+	case <-s.tasks.ready:
+	}
+	return &v1.ListTasksResponse{Tasks: s.tasks.Status()}, nil
+}
+
+// ListPorts returns the ports of the workspace.
+func (s *extensionService) ListPorts(ctx context.Context, req *v1.ListPortsRequest) (*v1.ListPortsResponse, error) {
+	err := s.authorize(ctx, v1.ExtensionScope_EXTENSION_SCOPE_PORTS_READ)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListPortsResponse{Ports: s.ports.Status()}, nil
+}
+
+// ExposePort exposes a port of the workspace.
+func (s *extensionService) ExposePort(ctx context.Context, req *v1.ExposePortRequest) (*v1.ExposePortResponse, error) {
+	err := s.authorize(ctx, v1.ExtensionScope_EXTENSION_SCOPE_PORTS_WRITE)
+	if err != nil {
+		return nil, err
+	}
+	if req.Port == 0 || req.Port > 65535 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid port %d", req.Port)
+	}
+
+	err = s.ports.Expose(ctx, req.Port)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &v1.ExposePortResponse{}, nil
+}
+
+// GetEnvironment returns the environment variables of the workspace.
+func (s *extensionService) GetEnvironment(ctx context.Context, req *v1.GetEnvironmentRequest) (*v1.GetEnvironmentResponse, error) {
+	err := s.authorize(ctx, v1.ExtensionScope_EXTENSION_SCOPE_ENV_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	var res v1.GetEnvironmentResponse
+	for _, e := range s.env() {
+		name, value, ok := strings.Cut(e, "=")
+		if !ok {
+			continue
+		}
+		res.Variables = append(res.Variables, &v1.EnvironmentVariable{Name: name, Value: value})
+	}
+	return &res, nil
+}
+
+// GetGitStatus returns the status of the Git repository of the workspace.
+func (s *extensionService) GetGitStatus(ctx context.Context, req *v1.GetGitStatusRequest) (*v1.GetGitStatusResponse, error) {
+	err := s.authorize(ctx, v1.ExtensionScope_EXTENSION_SCOPE_GIT_READ)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+	case <-s.content.ContentReady():
+	}
+	if s.git == nil {
+		return &v1.GetGitStatusResponse{}, nil
+	}
+	stat, err := s.git.Status(ctx)
+	if err != nil {
+		// the workspace does not necessarily have a Git repository
+		log.WithError(err).Debug("cannot get Git status for extension")
+		return &v1.GetGitStatusResponse{}, nil
+	}
+	gs := stat.ToAPI()
+	return &v1.GetGitStatusResponse{
+		Status: &v1.GitStatus{
+			Branch:          gs.Branch,
+			LatestCommit:    gs.LatestCommit,
+			UncommitedFiles: gs.UncommitedFiles,
+			UntrackedFiles:  gs.UntrackedFiles,
+			UnpushedCommits: gs.UnpushedCommits,
+		},
+	}, nil
+}
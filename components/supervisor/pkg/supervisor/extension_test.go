@@ -0,0 +1,175 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	v1 "github.com/gitpod-io/gitpod/supervisor/api/v1"
+)
+
+type fakeExtensionPorts struct {
+	exposed []uint32
+}
+
+func (f *fakeExtensionPorts) Status() []*api.PortsStatus {
+	return []*api.PortsStatus{{LocalPort: 8080}}
+}
+
+func (f *fakeExtensionPorts) Expose(ctx context.Context, port uint32) error {
+	f.exposed = append(f.exposed, port)
+	return nil
+}
+
+func withBearerToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestExtensionServiceAuthorization(t *testing.T) {
+	type Expectation struct {
+		Code    codes.Code
+		Exposed []uint32
+	}
+	tests := []struct {
+		Name        string
+		Scopes      []v1.ExtensionScope
+		Token       func(token string) string
+		Expectation Expectation
+	}{
+		{
+			Name:        "granted scope",
+			Scopes:      []v1.ExtensionScope{v1.ExtensionScope_EXTENSION_SCOPE_PORTS_WRITE},
+			Expectation: Expectation{Code: codes.OK, Exposed: []uint32{3000}},
+		},
+		{
+			Name:        "missing scope",
+			Scopes:      []v1.ExtensionScope{v1.ExtensionScope_EXTENSION_SCOPE_PORTS_READ},
+			Expectation: Expectation{Code: codes.PermissionDenied},
+		},
+		{
+			Name:        "missing token",
+			Scopes:      []v1.ExtensionScope{v1.ExtensionScope_EXTENSION_SCOPE_PORTS_WRITE},
+			Token:       func(string) string { return "" },
+			Expectation: Expectation{Code: codes.Unauthenticated},
+		},
+		{
+			Name:        "unknown token",
+			Scopes:      []v1.ExtensionScope{v1.ExtensionScope_EXTENSION_SCOPE_PORTS_WRITE},
+			Token:       func(string) string { return "foobar" },
+			Expectation: Expectation{Code: codes.Unauthenticated},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ports := &fakeExtensionPorts{}
+			srv, err := newExtensionService(ports, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := srv.CreateExtensionToken(withBearerToken(srv.registrarToken), &v1.CreateExtensionTokenRequest{
+				ExtensionId: "gitpod.test",
+				Scopes:      test.Scopes,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			token := resp.Token
+			if test.Token != nil {
+				token = test.Token(token)
+			}
+
+			_, err = srv.ExposePort(withBearerToken(token), &v1.ExposePortRequest{Port: 3000})
+
+			act := Expectation{Code: status.Code(err), Exposed: ports.exposed}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExtensionServiceRegistrar(t *testing.T) {
+	srv, err := newExtensionService(&fakeExtensionPorts{}, nil, nil, nil, func() []string {
+		return []string{"FOO=bar=baz"}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = srv.CreateExtensionToken(withBearerToken("foobar"), &v1.CreateExtensionTokenRequest{ExtensionId: "gitpod.test"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for invalid registrar token, got %v", err)
+	}
+	_, err = srv.CreateExtensionToken(withBearerToken(srv.registrarToken), &v1.CreateExtensionTokenRequest{
+		ExtensionId: "gitpod.test",
+		Scopes:      []v1.ExtensionScope{v1.ExtensionScope_EXTENSION_SCOPE_UNSPECIFIED},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for unspecified scope, got %v", err)
+	}
+
+	resp, err := srv.CreateExtensionToken(withBearerToken(srv.registrarToken), &v1.CreateExtensionTokenRequest{
+		ExtensionId: "gitpod.test",
+		Scopes:      []v1.ExtensionScope{v1.ExtensionScope_EXTENSION_SCOPE_ENV_READ},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	env, err := srv.GetEnvironment(withBearerToken(resp.Token), &v1.GetEnvironmentRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*v1.EnvironmentVariable{{Name: "FOO", Value: "bar=baz"}}, env.Variables, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected environment (-want +got):\n%s", diff)
+	}
+
+	_, err = srv.RevokeExtensionTokens(withBearerToken(resp.Token), &v1.RevokeExtensionTokensRequest{ExtensionId: "gitpod.test"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected extensions to be unable to revoke tokens, got %v", err)
+	}
+	_, err = srv.RevokeExtensionTokens(withBearerToken(srv.registrarToken), &v1.RevokeExtensionTokensRequest{ExtensionId: "gitpod.test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = srv.GetEnvironment(withBearerToken(resp.Token), &v1.GetEnvironmentRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated for revoked token, got %v", err)
+	}
+}
+
+func TestRegistrarTokenPipe(t *testing.T) {
+	r, err := registrarTokenPipe("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	token, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(token) != "secret" {
+		t.Errorf("unexpected token %q", token)
+	}
+
+	// whoever else holds the file descriptor must not be able to read the token once the IDE launcher has read it
+	rest, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != 0 {
+		t.Errorf("expected the pipe to be drained, read %q", rest)
+	}
+}
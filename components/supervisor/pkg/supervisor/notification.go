@@ -22,6 +22,8 @@ const (
 	NotifierMaxPendingNotifications   = 120
 	SubscriberMaxPendingNotifications = 100
 	SubscriberMaxSubscriptions        = 10
+
+	ActiveSubscriberMaxPendingActions = 10
 )
 
 // NewNotificationService creates a new notification service.
@@ -29,6 +31,7 @@ func NewNotificationService() *NotificationService {
 	return &NotificationService{
 		subscriptions:        make(map[uint64]*subscription),
 		pendingNotifications: make(map[uint64]*pendingNotification),
+		pendingActions:       make(map[uint64]*pendingAction),
 	}
 }
 
@@ -40,9 +43,29 @@ type NotificationService struct {
 	nextNotificationID   uint64
 	pendingNotifications map[uint64]*pendingNotification
 
+	nextActiveSubscriptionID uint64
+	activeSubscription       *activeSubscription
+	nextActionID             uint64
+	pendingActions           map[uint64]*pendingAction
+
 	api.UnimplementedNotificationServiceServer
 }
 
+// activeSubscription is the stream of the client the user used last, e.g. the browser IDE or
+// a desktop IDE. Actions like opening a file are routed to this client.
+type activeSubscription struct {
+	id      uint64
+	channel chan *api.SubscribeActiveResponse
+	// supersede ends the stream once another client becomes active
+	supersede context.CancelFunc
+}
+
+type pendingAction struct {
+	message         *api.SubscribeActiveResponse
+	subscriptionID  uint64
+	responseChannel chan *api.NotifyActiveResponse
+}
+
 type pendingNotification struct {
 	message         *api.SubscribeResponse
 	responseChannel chan *api.NotifyResponse
@@ -256,3 +279,150 @@ func isActionAllowed(action string, req *api.NotifyRequest) bool {
 	}
 	return false
 }
+
+// SubscribeActive makes the calling client the active one and streams the actions it ought to run.
+// The stream of the previously active client ends.
+func (srv *NotificationService) SubscribeActive(req *api.SubscribeActiveRequest, resp api.NotificationService_SubscribeActiveServer) error {
+	ctx, cancel := context.WithCancel(resp.Context())
+	defer cancel()
+
+	subscription := srv.subscribeActive(cancel)
+	log.WithField("subscription", subscription.id).Info("active client changed")
+	defer srv.unsubscribeActive(subscription.id, resp.Context())
+
+	for {
+		select {
+		case msg := <-subscription.channel:
+			err := resp.Send(msg)
+			if err != nil {
+				return status.Errorf(codes.Internal, "Sending action failed. %s", err)
+			}
+		case <-ctx.Done():
+			if resp.Context().Err() != nil {
+				return nil
+			}
+			// superseded by another client - deliver the actions which are already queued for this client
+			for {
+				select {
+				case msg := <-subscription.channel:
+					err := resp.Send(msg)
+					if err != nil {
+						return status.Errorf(codes.Internal, "Sending action failed. %s", err)
+					}
+				default:
+					return nil
+				}
+			}
+		}
+	}
+}
+
+func (srv *NotificationService) subscribeActive(supersede context.CancelFunc) *activeSubscription {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	if srv.activeSubscription != nil {
+		srv.activeSubscription.supersede()
+	}
+	subscription := &activeSubscription{
+		id:        srv.nextActiveSubscriptionID,
+		channel:   make(chan *api.SubscribeActiveResponse, ActiveSubscriberMaxPendingActions),
+		supersede: supersede,
+	}
+	srv.nextActiveSubscriptionID++
+	srv.activeSubscription = subscription
+	return subscription
+}
+
+func (srv *NotificationService) unsubscribeActive(subscriptionID uint64, clientCtx context.Context) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	if srv.activeSubscription != nil && srv.activeSubscription.id == subscriptionID {
+		srv.activeSubscription = nil
+	}
+	if clientCtx.Err() == nil {
+		// The client was superseded but is still connected, hence it can still respond to the actions it received.
+		return
+	}
+	for id, pending := range srv.pendingActions {
+		if pending.subscriptionID == subscriptionID {
+			delete(srv.pendingActions, id)
+			close(pending.responseChannel)
+		}
+	}
+}
+
+// NotifyActive asks the active client to run an action and waits until it has done so.
+func (srv *NotificationService) NotifyActive(ctx context.Context, req *api.NotifyActiveRequest) (*api.NotifyActiveResponse, error) {
+	if req.ActionData == nil {
+		return nil, status.Error(codes.InvalidArgument, "action data is required")
+	}
+
+	pending, err := srv.notifyActive(req)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case resp, ok := <-pending.responseChannel:
+		if !ok {
+			return nil, status.Error(codes.Aborted, "active client disconnected")
+		}
+		return resp, nil
+	case <-ctx.Done():
+		srv.mutex.Lock()
+		delete(srv.pendingActions, pending.message.RequestId)
+		srv.mutex.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+func (srv *NotificationService) notifyActive(req *api.NotifyActiveRequest) (*pendingAction, error) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	subscription := srv.activeSubscription
+	if subscription == nil {
+		return nil, status.Error(codes.NotFound, "no active client")
+	}
+
+	pending := &pendingAction{
+		message: &api.SubscribeActiveResponse{
+			RequestId: srv.nextActionID,
+			Request:   req,
+		},
+		subscriptionID:  subscription.id,
+		responseChannel: make(chan *api.NotifyActiveResponse, 1),
+	}
+	srv.nextActionID++
+
+	select {
+	case subscription.channel <- pending.message:
+	default:
+		log.WithField("subscription", subscription.id).Warn("active client does not consume actions fast enough")
+		return nil, status.Error(codes.ResourceExhausted, "too many pending actions")
+	}
+	srv.pendingActions[pending.message.RequestId] = pending
+	return pending, nil
+}
+
+// NotifyActiveRespond reports that the active client has run an action.
+func (srv *NotificationService) NotifyActiveRespond(ctx context.Context, req *api.NotifyActiveRespondRequest) (*api.NotifyActiveRespondResponse, error) {
+	srv.mutex.Lock()
+	defer srv.mutex.Unlock()
+
+	pending, ok := srv.pendingActions[req.RequestId]
+	if !ok {
+		log.WithField("RequestId", req.RequestId).Info("Invalid or late response to action")
+		return nil, status.Errorf(codes.DeadlineExceeded, "Invalid or late response to action")
+	}
+	delete(srv.pendingActions, req.RequestId)
+
+	resp := req.Response
+	if resp == nil {
+		resp = &api.NotifyActiveResponse{}
+	}
+	pending.responseChannel <- resp
+	return &api.NotifyActiveRespondResponse{}, nil
+}
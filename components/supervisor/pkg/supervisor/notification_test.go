@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/gitpod-io/gitpod/supervisor/api"
 )
@@ -263,3 +265,126 @@ func Test(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+type TestNotificationService_SubscribeActiveServer struct {
+	resps   chan *api.SubscribeActiveResponse
+	context context.Context
+	cancel  context.CancelFunc
+	grpc.ServerStream
+}
+
+func (s *TestNotificationService_SubscribeActiveServer) Send(resp *api.SubscribeActiveResponse) error {
+	s.resps <- resp
+	return nil
+}
+
+func (s *TestNotificationService_SubscribeActiveServer) Context() context.Context {
+	return s.context
+}
+
+func NewSubscribeActiveServer() *TestNotificationService_SubscribeActiveServer {
+	context, cancel := context.WithCancel(context.Background())
+	return &TestNotificationService_SubscribeActiveServer{
+		context: context,
+		cancel:  cancel,
+		resps:   make(chan *api.SubscribeActiveResponse, 10),
+	}
+}
+
+func TestNotifyActive(t *testing.T) {
+	openRequest := &api.NotifyActiveRequest{
+		ActionData: &api.NotifyActiveRequest_Open{Open: &api.NotifyActiveRequest_OpenData{Urls: []string{"/workspace/README.md"}}},
+	}
+
+	t.Run("no active client", func(t *testing.T) {
+		notificationService := NewNotificationService()
+		_, err := notificationService.NotifyActive(context.Background(), openRequest)
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("action is routed to the last active client", func(t *testing.T) {
+		notificationService := NewNotificationService()
+
+		browser := NewSubscribeActiveServer()
+		defer browser.cancel()
+		browserDone := make(chan error, 1)
+		go func() {
+			browserDone <- notificationService.SubscribeActive(&api.SubscribeActiveRequest{}, browser)
+		}()
+		waitForActiveSubscription(t, notificationService, 0)
+
+		desktop := NewSubscribeActiveServer()
+		defer desktop.cancel()
+		go func() {
+			_ = notificationService.SubscribeActive(&api.SubscribeActiveRequest{}, desktop)
+		}()
+		waitForActiveSubscription(t, notificationService, 1)
+
+		select {
+		case err := <-browserDone:
+			if err != nil {
+				t.Errorf("superseded stream failed: %v", err)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("superseded stream did not end")
+		}
+
+		go func() {
+			msg := <-desktop.resps
+			if msg.Request.GetOpen() == nil {
+				t.Errorf("expected open action, got %v", msg.Request)
+			}
+			_, err := notificationService.NotifyActiveRespond(context.Background(), &api.NotifyActiveRespondRequest{RequestId: msg.RequestId})
+			if err != nil {
+				t.Errorf("cannot respond: %v", err)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_, err := notificationService.NotifyActive(ctx, openRequest)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if len(browser.resps) != 0 {
+			t.Errorf("superseded client received the action")
+		}
+	})
+
+	t.Run("active client disconnects", func(t *testing.T) {
+		notificationService := NewNotificationService()
+
+		client := NewSubscribeActiveServer()
+		go func() {
+			_ = notificationService.SubscribeActive(&api.SubscribeActiveRequest{}, client)
+		}()
+		waitForActiveSubscription(t, notificationService, 0)
+		go func() {
+			<-client.resps
+			client.cancel()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_, err := notificationService.NotifyActive(ctx, openRequest)
+		if status.Code(err) != codes.Aborted {
+			t.Errorf("expected Aborted, got %v", err)
+		}
+	})
+}
+
+func waitForActiveSubscription(t *testing.T, srv *NotificationService, id uint64) {
+	t.Helper()
+	for i := 0; i < 100; i++ {
+		srv.mutex.Lock()
+		active := srv.activeSubscription
+		srv.mutex.Unlock()
+		if active != nil && active.id == id {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("subscription %d did not become active", id)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const (
+	// resourceLimitsFile is where ws-daemon publishes the current resource limits of the workspace.
+	// It uses the same keys as the environment variables ws-manager sets, i.e. GITPOD_CPU_COUNT and GITPOD_MEMORY,
+	// but follows limit changes while the workspace runs.
+	resourceLimitsFile = "/.workspace/resource-limits"
+
+	// nodeHeapRatio is the share of the workspace memory we let Node use for its heap, leaving room for
+	// off-heap memory and other processes
+	nodeHeapRatio = 0.75
+)
+
+// resourceLimitHints decides which runtime options we derive from the resource limits of the workspace
+type resourceLimitHints struct {
+	JavaXmx             bool
+	JavaProcessorCount  bool
+	NodeMaxOldSpaceSize bool
+}
+
+// childProcEnv returns the environment variables passed to a child process. The runtime options derived from the
+// resource limits are computed whenever a process starts, so that it is sized to the limits in place at that time.
+func childProcEnv() []string {
+	limits, err := readResourceLimits(resourceLimitsFile)
+	if err != nil && !os.IsNotExist(err) {
+		log.WithError(err).Warn("cannot read resource limits")
+	}
+	return childProcHints.apply(childProcEnvvars, limits)
+}
+
+// readResourceLimits parses the KEY=VALUE lines of the resource limits file
+func readResourceLimits(fn string) (map[string]string, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	res := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		segs := strings.SplitN(strings.TrimSpace(scanner.Text()), "=", 2)
+		if len(segs) != 2 {
+			continue
+		}
+		res[segs[0]] = segs[1]
+	}
+	return res, scanner.Err()
+}
+
+// apply overrides the resource limits in envvars with the published ones and adds the runtime options for them.
+// Options a user has set themselves take precedence.
+func (h resourceLimitHints) apply(envvars []string, limits map[string]string) []string {
+	envs := make(map[string]string, len(envvars))
+	for _, e := range envvars {
+		segs := strings.SplitN(e, "=", 2)
+		if len(segs) < 2 {
+			continue
+		}
+		envs[segs[0]] = segs[1]
+	}
+	for _, name := range []string{"GITPOD_CPU_COUNT", "GITPOD_MEMORY"} {
+		if v, ok := limits[name]; ok {
+			envs[name] = v
+		}
+	}
+
+	if cpuCount, ok := envs["GITPOD_CPU_COUNT"]; ok && h.JavaProcessorCount {
+		if _, exists := envs["JAVA_TOOL_OPTIONS"]; exists {
+			// check if the JAVA_TOOL_OPTIONS already contains the ActiveProcessorCount flag
+			if !strings.Contains(envs["JAVA_TOOL_OPTIONS"], "-XX:ActiveProcessorCount=") {
+				envs["JAVA_TOOL_OPTIONS"] += fmt.Sprintf(" -XX:+UseContainerSupport -XX:ActiveProcessorCount=%s", cpuCount)
+			}
+		} else {
+			envs["JAVA_TOOL_OPTIONS"] = fmt.Sprintf("-XX:+UseContainerSupport -XX:ActiveProcessorCount=%s", cpuCount)
+		}
+	}
+	// Particular Java optimisation: Java pre v10 did not gauge it's available memory correctly, and needed explicitly setting "-Xmx" for all Hotspot/openJDK VMs
+	if mem, ok := envs["GITPOD_MEMORY"]; ok && h.JavaXmx {
+		envs["JAVA_TOOL_OPTIONS"] += fmt.Sprintf(" -Xmx%sm", mem)
+	}
+	// Node sizes its heap based on the memory of the node rather than the workspace
+	if mem, err := strconv.ParseInt(envs["GITPOD_MEMORY"], 10, 64); err == nil && mem > 0 && h.NodeMaxOldSpaceSize {
+		if !strings.Contains(envs["NODE_OPTIONS"], "--max-old-space-size") {
+			envs["NODE_OPTIONS"] = strings.TrimSpace(fmt.Sprintf("%s --max-old-space-size=%d", envs["NODE_OPTIONS"], int64(float64(mem)*nodeHeapRatio)))
+		}
+	}
+
+	res := make([]string, 0, len(envs))
+	for name, value := range envs {
+		res = append(res, fmt.Sprintf("%s=%s", name, value))
+	}
+	return res
+}
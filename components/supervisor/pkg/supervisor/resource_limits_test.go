@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResourceLimitHints(t *testing.T) {
+	all := resourceLimitHints{JavaXmx: true, JavaProcessorCount: true, NodeMaxOldSpaceSize: true}
+
+	tests := []struct {
+		Name        string
+		Hints       resourceLimitHints
+		Input       []string
+		Limits      map[string]string
+		Expectation []string
+	}{
+		{
+			Name:        "no hints",
+			Input:       []string{"GITPOD_CPU_COUNT=4", "GITPOD_MEMORY=8192"},
+			Expectation: []string{"GITPOD_CPU_COUNT=4", "GITPOD_MEMORY=8192"},
+		},
+		{
+			Name:  "hints from environment",
+			Hints: all,
+			Input: []string{"GITPOD_CPU_COUNT=4", "GITPOD_MEMORY=8192"},
+			Expectation: []string{
+				"GITPOD_CPU_COUNT=4",
+				"GITPOD_MEMORY=8192",
+				"JAVA_TOOL_OPTIONS=-XX:+UseContainerSupport -XX:ActiveProcessorCount=4 -Xmx8192m",
+				"NODE_OPTIONS=--max-old-space-size=6144",
+			},
+		},
+		{
+			Name:   "published limits take precedence",
+			Hints:  all,
+			Input:  []string{"GITPOD_CPU_COUNT=4", "GITPOD_MEMORY=8192"},
+			Limits: map[string]string{"GITPOD_CPU_COUNT": "2", "GITPOD_MEMORY": "4096"},
+			Expectation: []string{
+				"GITPOD_CPU_COUNT=2",
+				"GITPOD_MEMORY=4096",
+				"JAVA_TOOL_OPTIONS=-XX:+UseContainerSupport -XX:ActiveProcessorCount=2 -Xmx4096m",
+				"NODE_OPTIONS=--max-old-space-size=3072",
+			},
+		},
+		{
+			Name:  "user options take precedence",
+			Hints: all,
+			Input: []string{
+				"GITPOD_CPU_COUNT=4",
+				"GITPOD_MEMORY=8192",
+				"JAVA_TOOL_OPTIONS=-XX:ActiveProcessorCount=1",
+				"NODE_OPTIONS=--max-old-space-size=1024",
+			},
+			Expectation: []string{
+				"GITPOD_CPU_COUNT=4",
+				"GITPOD_MEMORY=8192",
+				"JAVA_TOOL_OPTIONS=-XX:ActiveProcessorCount=1 -Xmx8192m",
+				"NODE_OPTIONS=--max-old-space-size=1024",
+			},
+		},
+		{
+			Name:        "existing node options",
+			Hints:       resourceLimitHints{NodeMaxOldSpaceSize: true},
+			Input:       []string{"GITPOD_MEMORY=1000", "NODE_OPTIONS=--enable-source-maps"},
+			Expectation: []string{"GITPOD_MEMORY=1000", "NODE_OPTIONS=--enable-source-maps --max-old-space-size=750"},
+		},
+		{
+			Name:        "unknown memory",
+			Hints:       resourceLimitHints{NodeMaxOldSpaceSize: true},
+			Input:       []string{"GITPOD_MEMORY=0"},
+			Expectation: []string{"GITPOD_MEMORY=0"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := test.Hints.apply(test.Input, test.Limits)
+			sort.Strings(act)
+			sort.Strings(test.Expectation)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected environment (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadResourceLimits(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "resource-limits")
+	err := os.WriteFile(fn, []byte("GITPOD_CPU_COUNT=2\nGITPOD_MEMORY=4096\ninvalid\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	act, err := readResourceLimits(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{"GITPOD_CPU_COUNT": "2", "GITPOD_MEMORY": "4096"}, act); diff != "" {
+		t.Errorf("unexpected resource limits (-want +got):\n%s", diff)
+	}
+}
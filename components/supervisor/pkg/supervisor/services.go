@@ -109,6 +109,7 @@ type statusService struct {
 	ideReady        *ideReadyState
 	desktopIdeReady *ideReadyState
 	topService      *TopService
+	Warmup          *warmupManager
 
 	api.UnimplementedStatusServiceServer
 }
@@ -201,6 +202,7 @@ func (s *statusService) ContentStatus(ctx context.Context, req *api.ContentStatu
 			return &api.ContentStatusResponse{
 				Available: true,
 				Source:    srcmap[src],
+				History:   cs.History(),
 			}, nil
 		case <-ctx.Done():
 			if errors.Is(ctx.Err(), context.Canceled) {
@@ -221,6 +223,7 @@ func (s *statusService) ContentStatus(ctx context.Context, req *api.ContentStatu
 	return &api.ContentStatusResponse{
 		Available: true,
 		Source:    srcmap[src],
+		History:   cs.History(),
 	}, nil
 }
 
@@ -879,6 +882,8 @@ type ContentState interface {
 	MarkContentReady(src csapi.WorkspaceInitSource)
 	ContentReady() <-chan struct{}
 	ContentSource() (src csapi.WorkspaceInitSource, ok bool)
+	MarkHistory(state api.GitHistoryState)
+	History() api.GitHistoryState
 }
 
 // NewInMemoryContentState creates a new InMemoryContentState.
@@ -895,6 +900,9 @@ type InMemoryContentState struct {
 
 	contentReadyChan chan struct{}
 	contentSource    csapi.WorkspaceInitSource
+
+	history   api.GitHistoryState
+	historyMu sync.RWMutex
 }
 
 // MarkContentReady marks the workspace content as available.
@@ -920,6 +928,20 @@ func (state *InMemoryContentState) ContentSource() (src csapi.WorkspaceInitSourc
 	return state.contentSource, true
 }
 
+// MarkHistory records whether the Git history of the repositories has been fetched.
+func (state *InMemoryContentState) MarkHistory(history api.GitHistoryState) {
+	state.historyMu.Lock()
+	defer state.historyMu.Unlock()
+	state.history = history
+}
+
+// History returns whether the Git history of the repositories has been fetched.
+func (state *InMemoryContentState) History() api.GitHistoryState {
+	state.historyMu.RLock()
+	defer state.historyMu.RUnlock()
+	return state.history
+}
+
 type portService struct {
 	portsManager *ports.Manager
 
@@ -1037,6 +1059,25 @@ func (s *statusService) ResourcesStatus(ctx context.Context, in *api.ResourcesSt
 	return s.topService.data, nil
 }
 
+// WarmupStatus provides the status of the warmup commands.
+func (s *statusService) WarmupStatus(ctx context.Context, req *api.WarmupStatusRequest) (*api.WarmupStatusResponse, error) {
+	if req.Wait {
+		select {
+		case <-s.Warmup.Done():
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil, status.Error(codes.Canceled, "Context canceled")
+			}
+
+			return nil, status.Error(codes.DeadlineExceeded, ctx.Err().Error())
+		}
+	}
+
+	return &api.WarmupStatusResponse{
+		Warmups: s.Warmup.Status(),
+	}, nil
+}
+
 type taskService struct {
 	tasksManager    *tasksManager
 	willShutdownCtx context.Context
@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+// shellProfileFile is the file the profile snippet of the user is written to, relative to the home directory
+const shellProfileFile = ".config/gitpod/shell-profile"
+
+type shellConfig struct {
+	// RCFile is the startup file of interactive shells relative to the home directory
+	RCFile string
+	// POSIX is true if the shell understands the POSIX shell syntax task commands are composed in
+	POSIX bool
+}
+
+// supportedShells are the shells users can select
+var supportedShells = map[string]shellConfig{
+	"bash": {RCFile: ".bashrc", POSIX: true},
+	"zsh":  {RCFile: ".zshrc", POSIX: true},
+	"fish": {RCFile: ".config/fish/config.fish"},
+}
+
+// userShell returns the path of the shell the user selected, or an empty string if they did not select one
+// or the shell is not available in the workspace image.
+func userShell(cfg *Config) string {
+	if cfg.Shell == "" || cfg.isPrebuild() || cfg.isHeadless() {
+		return ""
+	}
+	if _, ok := supportedShells[cfg.Shell]; !ok {
+		log.WithField("shell", cfg.Shell).Warn("selected shell is not supported")
+		return ""
+	}
+
+	shell, err := exec.LookPath(cfg.Shell)
+	if err != nil {
+		log.WithError(err).WithField("shell", cfg.Shell).Warn("selected shell is not available in the workspace image")
+		return ""
+	}
+	return shell
+}
+
+// installShellProfile writes the profile snippet of the user and makes the startup file of their shell source it.
+// Without a selected shell, the snippet is added for the default shell of the workspace image.
+func installShellProfile(cfg *Config, home string, defaultShell string) error {
+	if cfg.ShellProfile == "" {
+		return nil
+	}
+
+	name := cfg.Shell
+	if name == "" {
+		name = filepath.Base(defaultShell)
+	}
+	shell, ok := supportedShells[name]
+	if !ok {
+		return fmt.Errorf("cannot install profile for unsupported shell %s", name)
+	}
+
+	profile := filepath.Join(home, shellProfileFile)
+	err := writeGitpodUserFile(home, profile, []byte(cfg.ShellProfile+"\n"), false)
+	if err != nil {
+		return err
+	}
+
+	// the startup file is kept if it exists already, e.g. from the workspace image or the dotfiles of the user
+	rc := filepath.Join(home, shell.RCFile)
+	source := fmt.Sprintf("source %s", profile)
+	content, err := os.ReadFile(rc)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if bytes.Contains(content, []byte(source)) {
+		return nil
+	}
+	return writeGitpodUserFile(home, rc, []byte("\n# Gitpod shell profile\n"+source+"\n"), true)
+}
+
+// writeGitpodUserFile writes a file below the home directory, creating missing parent directories.
+// Files and directories created are owned by the gitpod user.
+func writeGitpodUserFile(home, fn string, content []byte, appendContent bool) error {
+	rel, err := filepath.Rel(home, filepath.Dir(fn))
+	if err != nil {
+		return err
+	}
+	dir := home
+	for _, seg := range strings.Split(rel, string(filepath.Separator)) {
+		if seg == "." {
+			continue
+		}
+		dir = filepath.Join(dir, seg)
+		err = os.Mkdir(dir, 0755)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		_ = os.Chown(dir, gitpodUID, gitpodGID)
+	}
+
+	flag := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if appendContent {
+		flag = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+	f, err := os.OpenFile(fn, flag, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_ = f.Chown(gitpodUID, gitpodGID)
+
+	_, err = f.Write(content)
+	return err
+}
+
+// setEnvvar sets an environment variable in a list of NAME=VALUE entries, replacing any previous value
+func setEnvvar(envvars []string, name, value string) []string {
+	res := make([]string, 0, len(envvars)+1)
+	for _, e := range envvars {
+		if strings.HasPrefix(e, name+"=") {
+			continue
+		}
+		res = append(res, e)
+	}
+	return append(res, name+"="+value)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInstallShellProfile(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Shell        string
+		ShellProfile string
+		DefaultShell string
+		Files        map[string]string
+		Expectation  map[string]string
+		ExpectError  bool
+	}{
+		{
+			Name:         "no profile",
+			Shell:        "zsh",
+			DefaultShell: "/bin/bash",
+			Expectation:  map[string]string{},
+		},
+		{
+			Name:         "bash",
+			Shell:        "bash",
+			ShellProfile: "export FOO=bar",
+			DefaultShell: "/bin/bash",
+			Files:        map[string]string{".bashrc": "alias ll='ls -l'\n"},
+			Expectation: map[string]string{
+				".bashrc":        "alias ll='ls -l'\n\n# Gitpod shell profile\nsource HOME/.config/gitpod/shell-profile\n",
+				shellProfileFile: "export FOO=bar\n",
+			},
+		},
+		{
+			Name:         "fish without config",
+			Shell:        "fish",
+			ShellProfile: "set -x FOO bar",
+			DefaultShell: "/bin/bash",
+			Expectation: map[string]string{
+				".config/fish/config.fish": "\n# Gitpod shell profile\nsource HOME/.config/gitpod/shell-profile\n",
+				shellProfileFile:           "set -x FOO bar\n",
+			},
+		},
+		{
+			Name:         "default shell of the image",
+			ShellProfile: "export FOO=bar",
+			DefaultShell: "/usr/bin/zsh",
+			Expectation: map[string]string{
+				".zshrc":         "\n# Gitpod shell profile\nsource HOME/.config/gitpod/shell-profile\n",
+				shellProfileFile: "export FOO=bar\n",
+			},
+		},
+		{
+			Name:         "installed before",
+			Shell:        "bash",
+			ShellProfile: "export FOO=baz",
+			DefaultShell: "/bin/bash",
+			Files: map[string]string{
+				".bashrc":        "\n# Gitpod shell profile\nsource HOME/.config/gitpod/shell-profile\n",
+				shellProfileFile: "export FOO=bar\n",
+			},
+			Expectation: map[string]string{
+				".bashrc":        "\n# Gitpod shell profile\nsource HOME/.config/gitpod/shell-profile\n",
+				shellProfileFile: "export FOO=baz\n",
+			},
+		},
+		{
+			Name:         "unsupported default shell",
+			ShellProfile: "export FOO=bar",
+			DefaultShell: "/bin/tcsh",
+			Expectation:  map[string]string{},
+			ExpectError:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			home := t.TempDir()
+			for fn, content := range test.Files {
+				writeTestFile(t, filepath.Join(home, fn), strings.ReplaceAll(content, "HOME", home))
+			}
+
+			cfg := &Config{WorkspaceConfig: WorkspaceConfig{Shell: test.Shell, ShellProfile: test.ShellProfile}}
+			err := installShellProfile(cfg, home, test.DefaultShell)
+			if (err != nil) != test.ExpectError {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			act := make(map[string]string)
+			err = filepath.Walk(home, func(path string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				content, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				rel, _ := filepath.Rel(home, path)
+				act[rel] = strings.ReplaceAll(string(content), home, "HOME")
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected files (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetEnvvar(t *testing.T) {
+	act := setEnvvar([]string{"SHELL=/bin/bash", "SHELLCHECK=1", "HOME=/home/gitpod"}, "SHELL", "/usr/bin/zsh")
+	if diff := cmp.Diff([]string{"SHELLCHECK=1", "HOME=/home/gitpod", "SHELL=/usr/bin/zsh"}, act); diff != "" {
+		t.Errorf("unexpected envvars (-want +got):\n%s", diff)
+	}
+}
+
+func writeTestFile(t *testing.T, fn, content string) {
+	err := os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(fn, []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -22,7 +22,7 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func newSSHServer(ctx context.Context, cfg *Config, envvars []string) (*sshServer, error) {
+func newSSHServer(ctx context.Context, cfg *Config, envvars func() []string) (*sshServer, error) {
 	bin, err := os.Executable()
 	if err != nil {
 		return nil, xerrors.Errorf("cannot find executable path: %w", err)
@@ -63,7 +63,7 @@ func newSSHServer(ctx context.Context, cfg *Config, envvars []string) (*sshServe
 type sshServer struct {
 	ctx     context.Context
 	cfg     *Config
-	envvars []string
+	envvars func() []string
 
 	sshkey string
 	caPath string
@@ -119,6 +119,7 @@ func (s *sshServer) handleConn(ctx context.Context, conn net.Conn) {
 		"-oPidFile /dev/null",
 		"-oUseDNS no", // Disable DNS lookups.
 		"-oSubsystem sftp internal-sftp",
+		"-oSubsystem gitpod-sync /usr/bin/gp sync serve",
 		"-oStrictModes no", // don't care for home directory and file permissions
 		"-oTrustedUserCAKeys "+s.caPath,
 	)
@@ -145,8 +146,9 @@ func (s *sshServer) handleConn(ctx context.Context, conn net.Conn) {
 	}
 	args = append(args, "-oLogLevel "+sshdLogLevel)
 
+	envvars := s.envvars()
 	envs := make([]string, 0)
-	for _, env := range s.envvars {
+	for _, env := range envvars {
 		s := strings.SplitN(env, "=", 2)
 		if len(s) != 2 {
 			continue
@@ -167,7 +169,7 @@ func (s *sshServer) handleConn(ctx context.Context, conn net.Conn) {
 	log.WithField("args", args).Debug("sshd flags")
 	cmd := exec.CommandContext(ctx, openssh, args...)
 	cmd = runAsGitpodUser(cmd)
-	cmd.Env = s.envvars
+	cmd.Env = envvars
 	cmd.ExtraFiles = []*os.File{socketFD}
 	cmd.Stderr = os.Stderr
 	if s.cfg.WorkspaceLogRateLimit > 0 {
@@ -155,7 +155,13 @@ func (s IDEKind) String() string {
 	return "unknown"
 }
 
-var childProcEnvvars []string
+var (
+	childProcEnvvars []string
+	childProcHints   resourceLimitHints
+
+	// extensionRegistrarToken is passed to the IDE launcher only, so that the IDE can issue tokens for its extensions
+	extensionRegistrarToken string
+)
 
 // Run serves as main entrypoint to the supervisor.
 func Run(options ...RunOption) {
@@ -202,6 +208,11 @@ func Run(options ...RunOption) {
 	// BEWARE: we can only call buildChildProcEnv once, because it might download env vars from a one-time-secret
 	//         URL, which would fail if we tried another time.
 	childProcEnvvars = buildChildProcEnv(cfg, nil, opts.RunGP)
+	childProcHints = resourceLimitHints{
+		JavaXmx:             cfg.IsSetJavaXmx,
+		JavaProcessorCount:  cfg.IsSetJavaProcessorCount,
+		NodeMaxOldSpaceSize: cfg.IsSetNodeMaxOldSpaceSize,
+	}
 
 	err = AddGitpodUserIfNotExists()
 	if err != nil {
@@ -351,13 +362,27 @@ func Run(options ...RunOption) {
 			return ""
 		}
 	}
+	imageShell := termMuxSrv.DefaultShell
+	shell := userShell(cfg)
+	if shell != "" {
+		termMuxSrv.DefaultShell = shell
+		childProcEnvvars = setEnvvar(childProcEnvvars, "SHELL", shell)
+	}
 	termMuxSrv.Env = childProcEnvvars
+	termMuxSrv.EnvProvider = childProcEnv
 	termMuxSrv.DefaultCreds = &syscall.Credential{
 		Uid: gitpodUID,
 		Gid: gitpodGID,
 	}
 
 	taskManager := newTasksManager(cfg, termMuxSrv, cstate, nil, ideReady, desktopIdeReady)
+	taskManager.auditLog = newAuditLog(os.Stderr, cfg)
+	taskManager.telemetry = telemetry
+	if shell != "" && !supportedShells[cfg.Shell].POSIX {
+		// task commands are composed in POSIX shell syntax
+		taskManager.shell = imageShell
+	}
+	warmupManager := newWarmupManager(cfg, gitpodConfigService, cstate)
 
 	gitStatusWg := &sync.WaitGroup{}
 	gitStatusCtx, stopGitStatus := context.WithCancel(ctx)
@@ -375,6 +400,12 @@ func Run(options ...RunOption) {
 
 	taskServiceWg := &sync.WaitGroup{}
 
+	extensions, err := newExtensionService(portMgmt, taskManager, cstate, &git.Client{Location: cfg.RepoRoot}, childProcEnv)
+	if err != nil {
+		log.WithError(err).Fatal("cannot create extension service")
+	}
+	extensionRegistrarToken = extensions.registrarToken
+
 	apiServices := []RegisterableService{
 		&statusService{
 			willShutdownCtx: willShutdownCtx,
@@ -384,6 +415,7 @@ func Run(options ...RunOption) {
 			ideReady:        ideReady,
 			desktopIdeReady: desktopIdeReady,
 			topService:      topService,
+			Warmup:          warmupManager,
 		},
 		termMuxSrv,
 		RegistrableTokenService{Service: tokenService},
@@ -396,6 +428,7 @@ func Run(options ...RunOption) {
 			tasksManager:    taskManager,
 			willShutdownCtx: willShutdownCtx,
 		},
+		extensions,
 	}
 	apiServices = append(apiServices, additionalServices...)
 
@@ -403,6 +436,12 @@ func Run(options ...RunOption) {
 		// We need to checkout dotfiles first, because they may be changing the path which affects the IDE.
 		// TODO(cw): provide better feedback if the IDE start fails because of the dotfiles (provide any feedback at all).
 		installDotfiles(ctx, cfg, tokenService)
+
+		// The profile is installed after the dotfiles such that it's not shadowed by them, and before the tasks start.
+		err = installShellProfile(cfg, "/home/gitpod", imageShell)
+		if err != nil {
+			log.WithError(err).Warn("installing shell profile failed")
+		}
 	}
 
 	shouldShutdown, shutdownDuration := getIDENotReadyShutdownDuration(ctx, exps, host)
@@ -461,14 +500,32 @@ func Run(options ...RunOption) {
 	if !opts.RunGP {
 		wg.Add(1)
 		go socketActivationForDocker(ctx, &wg, termMux, cfg, telemetry, notificationService, cstate)
+
+		wg.Add(1)
+		go watchDiskQuota(ctx, &wg, cfg, notificationService, cstate)
 	}
 
 	if cfg.isHeadless() {
+		// warmup commands run once the prebuild tasks are done, such that they can rely on the dependencies installed by the tasks
+		prebuildSuccessChan := make(chan taskSuccess, 1)
+		go func() {
+			success := <-tasksSuccessChan
+			if success.Failed() {
+				warmupManager.Skip(ctx, "prebuild tasks failed")
+			} else {
+				warmupManager.Run(ctx)
+			}
+			prebuildSuccessChan <- success
+		}()
 		wg.Add(1)
-		go stopWhenTasksAreDone(ctx, &wg, shutdown, tasksSuccessChan)
+		go stopWhenTasksAreDone(ctx, &wg, shutdown, prebuildSuccessChan)
 	} else if !opts.RunGP {
+		go warmupManager.Run(ctx)
+
 		wg.Add(1)
 		go portMgmt.Run(ctx, &wg)
+	} else {
+		go warmupManager.Skip(ctx, "warmup commands do not run in run-gp")
 	}
 
 	if cfg.PreventMetadataAccess {
@@ -484,29 +541,16 @@ func Run(options ...RunOption) {
 
 	if !cfg.isPrebuild() && !opts.RunGP && !cfg.isDebugWorkspace() {
 		go func() {
-			for _, repoRoot := range strings.Split(cfg.RepoRoots, ",") {
-				<-cstate.ContentReady()
-				waitForIde(ctx, ideReady, desktopIdeReady, 1*time.Second)
-
-				start := time.Now()
-				defer func() {
-					log.Debugf("unshallow of local repository took %v", time.Since(start))
-				}()
-
-				if !isShallowRepository(repoRoot) {
-					return
-				}
+			<-cstate.ContentReady()
+			waitForIde(ctx, ideReady, desktopIdeReady, 1*time.Second)
 
-				cmd := runAsGitpodUser(exec.Command("git", "fetch", "--unshallow", "--tags"))
-				cmd.Dir = repoRoot
-				cmd.Stdout = os.Stdout
-				cmd.Stderr = os.Stderr
-				err := cmd.Run()
-				if err != nil {
-					log.WithError(err).Error("git fetch error")
-				}
-			}
+			repoRoots := strings.Split(cfg.RepoRoots, ",")
+			cstate.MarkHistory(unshallowRepositories(repoRoots))
+			pullDeferredLFS(repoRoots)
 		}()
+	} else {
+		// prebuilds keep their history shallow, the workspaces started from them fetch it
+		cstate.MarkHistory(api.GitHistoryState_history_shallow)
 	}
 
 	sigChan := make(chan os.Signal, 1)
@@ -578,6 +622,80 @@ func getIDENotReadyShutdownDuration(ctx context.Context, exps experiments.Client
 	}
 }
 
+// unshallowRepositories fetches the full history of all shallow repositories, except those which
+// keep their shallow history by intention.
+func unshallowRepositories(repoRoots []string) api.GitHistoryState {
+	res := api.GitHistoryState_history_complete
+	for _, repoRoot := range repoRoots {
+		if !isShallowRepository(repoRoot) {
+			continue
+		}
+		if !shouldUnshallow(repoRoot) {
+			if res == api.GitHistoryState_history_complete {
+				res = api.GitHistoryState_history_shallow
+			}
+			continue
+		}
+
+		start := time.Now()
+		cmd := runAsGitpodUser(exec.Command("git", "fetch", "--unshallow", "--tags"))
+		cmd.Dir = repoRoot
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		err := cmd.Run()
+		if err != nil {
+			log.WithError(err).WithField("location", repoRoot).Error("git fetch error")
+			res = api.GitHistoryState_history_failed
+			continue
+		}
+		log.WithField("location", repoRoot).Debugf("unshallow of local repository took %v", time.Since(start))
+	}
+	return res
+}
+
+// pullDeferredLFS downloads the Git LFS objects of all repositories whose content initializer deferred it
+func pullDeferredLFS(repoRoots []string) {
+	for _, repoRoot := range repoRoots {
+		cmd := runAsGitpodUser(exec.Command("git", "config", "--get", git.ConfigLFS))
+		cmd.Dir = repoRoot
+		out, err := cmd.Output()
+		if err != nil || strings.TrimSpace(string(out)) != "deferred" {
+			continue
+		}
+
+		start := time.Now()
+		cmd = runAsGitpodUser(exec.Command("git", "lfs", "pull"))
+		cmd.Dir = repoRoot
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		err = cmd.Run()
+		if err != nil {
+			log.WithError(err).WithField("location", repoRoot).Error("git lfs pull error")
+			continue
+		}
+		log.WithField("location", repoRoot).Debugf("pull of deferred Git LFS objects took %v", time.Since(start))
+
+		cmd = runAsGitpodUser(exec.Command("git", "config", "--unset", git.ConfigLFS))
+		cmd.Dir = repoRoot
+		err = cmd.Run()
+		if err != nil {
+			log.WithError(err).WithField("location", repoRoot).Warn("cannot unset deferred Git LFS pull")
+		}
+	}
+}
+
+// shouldUnshallow returns false if the content initializer asked to keep the shallow history of a repository
+func shouldUnshallow(rootDir string) bool {
+	cmd := runAsGitpodUser(exec.Command("git", "config", "--bool", "--get", git.ConfigUnshallow))
+	cmd.Dir = rootDir
+	out, err := cmd.Output()
+	if err != nil {
+		// the key is not set
+		return true
+	}
+	return strings.TrimSpace(string(out)) != "false"
+}
+
 func isShallowRepository(rootDir string) bool {
 	cmd := runAsGitpodUser(exec.Command("git", "rev-parse", "--is-shallow-repository"))
 	cmd.Dir = rootDir
@@ -985,6 +1103,10 @@ func launchIDE(cfg *Config, ideConfig *IDEConfig, cmd *exec.Cmd, ideStopped chan
 			cmd.Env = append(cmd.Env, "GITPOD_WAIT_IDE_BACKEND=true")
 		}
 		err := cmd.Start()
+		// the IDE has its own copies of the extra files, if it was started
+		for _, f := range cmd.ExtraFiles {
+			f.Close()
+		}
 		if err != nil {
 			if s == func() *ideStatus { i := statusNeverRan; return &i }() {
 				log.WithField("ide", ide.String()).WithError(err).Fatal("IDE failed to start")
@@ -1030,6 +1152,16 @@ func prepareIDELaunch(cfg *Config, ideConfig *IDEConfig) *exec.Cmd {
 	// All supervisor children run as gitpod user. The environment variables we produce are also
 	// gitpod user specific.
 	runAsGitpodUser(cmd)
+	if extensionRegistrarToken != "" {
+		tokenPipe, err := registrarTokenPipe(extensionRegistrarToken)
+		if err != nil {
+			log.WithError(err).Error("cannot pass the extension registrar token to the IDE")
+		} else {
+			// the first extra file is file descriptor 3 in the IDE process
+			cmd.ExtraFiles = append(cmd.ExtraFiles, tokenPipe)
+			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", extensionRegistrarTokenFDEnvVar, 2+len(cmd.ExtraFiles)))
+		}
+	}
 
 	// We need the child process to run in its own process group, s.t. we can suspend and resume
 	// IDE and its children.
@@ -1130,21 +1262,6 @@ func buildChildProcEnv(cfg *Config, envvars []string, runGP bool) []string {
 	envs["HOME"] = "/home/gitpod"
 	envs["USER"] = "gitpod"
 
-	if cpuCount, ok := envs["GITPOD_CPU_COUNT"]; ok && cfg.IsSetJavaProcessorCount {
-		if _, exists := envs["JAVA_TOOL_OPTIONS"]; exists {
-			// check if the JAVA_TOOL_OPTIONS already contains the ActiveProcessorCount flag
-			if !strings.Contains(envs["JAVA_TOOL_OPTIONS"], "-XX:ActiveProcessorCount=") {
-				envs["JAVA_TOOL_OPTIONS"] += fmt.Sprintf(" -XX:+UseContainerSupport -XX:ActiveProcessorCount=%s", cpuCount)
-			}
-		} else {
-			envs["JAVA_TOOL_OPTIONS"] = fmt.Sprintf("-XX:+UseContainerSupport -XX:ActiveProcessorCount=%s", cpuCount)
-		}
-	}
-	// Particular Java optimisation: Java pre v10 did not gauge it's available memory correctly, and needed explicitly setting "-Xmx" for all Hotspot/openJDK VMs
-	if mem, ok := envs["GITPOD_MEMORY"]; ok && cfg.IsSetJavaXmx {
-		envs["JAVA_TOOL_OPTIONS"] += fmt.Sprintf(" -Xmx%sm", mem)
-	}
-
 	if _, ok := envs["HISTFILE"]; !ok {
 		envs["HISTFILE"] = "/workspace/.gitpod/.shell_history"
 		envs["PROMPT_COMMAND"] = "history -a"
@@ -1617,7 +1734,7 @@ func startSSHServer(ctx context.Context, cfg *Config, wg *sync.WaitGroup) {
 	}
 
 	go func() {
-		ssh, err := newSSHServer(ctx, cfg, childProcEnvvars)
+		ssh, err := newSSHServer(ctx, cfg, childProcEnv)
 		if err != nil {
 			log.WithError(err).Error("err creating SSH server")
 			return
@@ -1930,7 +2047,7 @@ func runAsGitpodUser(cmd *exec.Cmd) *exec.Cmd {
 	if cmd.SysProcAttr.Credential == nil {
 		cmd.SysProcAttr.Credential = &syscall.Credential{}
 	}
-	cmd.Env = append(cmd.Env, childProcEnvvars...)
+	cmd.Env = append(cmd.Env, childProcEnv()...)
 	cmd.SysProcAttr.Credential.Uid = gitpodUID
 	cmd.SysProcAttr.Credential.Gid = gitpodGID
 	return cmd
@@ -18,6 +18,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gitpod-io/gitpod/common-go/analytics"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
@@ -109,6 +110,12 @@ type tasksManager struct {
 	reporter        headlessTaskProgressReporter
 	ideReady        *ideReadyState
 	desktopIdeReady *ideReadyState
+	// shell is the shell task terminals run in. If empty, the default shell of the terminal service is used.
+	shell string
+	// auditLog records command policy violations. May be nil.
+	auditLog *auditLog
+	// telemetry receives the analytics events of command policy violations. May be nil.
+	telemetry analytics.Writer
 }
 
 func newTasksManager(config *Config, terminalService *terminal.MuxTerminalService, contentState ContentState, reporter headlessTaskProgressReporter, ideReady *ideReadyState, desktopIdeReady *ideReadyState) *tasksManager {
@@ -276,7 +283,7 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 		}
 		taskLog := log.WithField("command", t.command)
 		taskLog.Info("starting a task terminal...")
-		openRequest := &api.OpenTerminalRequest{}
+		openRequest := &api.OpenTerminalRequest{Shell: tm.shell}
 		if t.config.Env != nil {
 			openRequest.Env = make(map[string]string, len(*t.config.Env))
 			for key, value := range *t.config.Env {
@@ -355,7 +362,7 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 
 		tm.watch(t, term, taskWatchWg)
 
-		if t.command != "" {
+		if t.command != "" && tm.enforceCommandPolicy(t, term) {
 			term.PTY.Write([]byte(t.command + "\n"))
 		}
 	}
@@ -378,6 +385,66 @@ func (tm *tasksManager) Run(ctx context.Context, wg *sync.WaitGroup, successChan
 	successChan <- success
 }
 
+// enforceCommandPolicy audits the commands of a task and returns false if the task must not run them.
+// Blocked tasks explain why in their terminal and fail.
+func (tm *tasksManager) enforceCommandPolicy(t *task, term *terminal.Term) bool {
+	policy := tm.config.CommandPolicy
+	violations := policy.Check(stringValues(t.config.Before, t.config.Init, t.config.Prebuild, t.config.Command)...)
+	if len(violations) == 0 {
+		return true
+	}
+
+	blocked := !policy.Audit
+	for _, v := range violations {
+		log.WithField("task", t.Id).WithField("command", v.Command).WithField("rule", v.Rule).WithField("blocked", blocked).Warn("task command violates command policy")
+		tm.auditLog.Record("commandPolicyViolation", map[string]interface{}{
+			"workspaceId": tm.config.WorkspaceID,
+			"instanceId":  tm.config.WorkspaceInstanceID,
+			"taskId":      t.Id,
+			"command":     v.Command,
+			"rule":        v.Rule,
+			"blocked":     blocked,
+		})
+		if tm.telemetry != nil {
+			tm.telemetry.Track(analytics.TrackMessage{
+				Identity: analytics.Identity{UserID: tm.config.OwnerId},
+				Event:    "supervisor_command_policy_violation",
+				Properties: map[string]interface{}{
+					"workspaceId": tm.config.WorkspaceID,
+					"instanceId":  tm.config.WorkspaceInstanceID,
+					"taskId":      t.Id,
+					"command":     v.Command,
+					"rule":        v.Rule,
+					"blocked":     blocked,
+				},
+			})
+		}
+	}
+	if !blocked {
+		return true
+	}
+
+	var msg strings.Builder
+	msg.WriteString("\r\n\x1b[31mThis task does not run because your organization's command policy does not allow:\r\n")
+	for _, v := range violations {
+		msg.WriteString("  " + v.String() + "\r\n")
+	}
+	msg.WriteString("\x1b[0m\r\n")
+	_, _ = term.Stdout.Write([]byte(msg.String()))
+	_, _ = term.PTY.Write([]byte("exit 1\n"))
+	return false
+}
+
+func stringValues(values ...*string) []string {
+	var res []string
+	for _, v := range values {
+		if v != nil {
+			res = append(res, *v)
+		}
+	}
+	return res
+}
+
 func getCommand(task *task, isHeadless bool, isPrebuild bool, contentSource csapi.WorkspaceInitSource, storeLocation string) string {
 	commands := getCommands(task, isPrebuild, contentSource, storeLocation)
 	command := composeCommand(composeCommandOptions{
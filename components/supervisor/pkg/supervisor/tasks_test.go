@@ -351,3 +351,67 @@ func TestTaskSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGitpodTasksMatrix(t *testing.T) {
+	strp := func(s string) *string { return &s }
+	envp := func(env map[string]interface{}) *map[string]interface{} { return &env }
+
+	tests := []struct {
+		Name        string
+		Tasks       string
+		Expectation []TaskConfig
+		ExpectError bool
+	}{
+		{
+			Name:        "no matrix",
+			Tasks:       `[{"name":"build","command":"make"}]`,
+			Expectation: []TaskConfig{{Name: strp("build"), Command: strp("make")}},
+		},
+		{
+			Name:  "matrix",
+			Tasks: `[{"name":"service","command":"./run","env":{"REGION":"us","DEBUG":true},"matrix":{"SERVICE":["api","web"],"REGION":["eu"]}},{"command":"make"}]`,
+			Expectation: []TaskConfig{
+				{Name: strp("service (REGION=eu, SERVICE=api)"), Command: strp("./run"), Env: envp(map[string]interface{}{"DEBUG": true, "REGION": "eu", "SERVICE": "api"})},
+				{Name: strp("service (REGION=eu, SERVICE=web)"), Command: strp("./run"), Env: envp(map[string]interface{}{"DEBUG": true, "REGION": "eu", "SERVICE": "web"})},
+				{Command: strp("make")},
+			},
+		},
+		{
+			Name:  "unnamed task with numbers",
+			Tasks: `[{"command":"./serve","matrix":{"PORT":[3000,3001]}}]`,
+			Expectation: []TaskConfig{
+				{Name: strp("PORT=3000"), Command: strp("./serve"), Env: envp(map[string]interface{}{"PORT": float64(3000)})},
+				{Name: strp("PORT=3001"), Command: strp("./serve"), Env: envp(map[string]interface{}{"PORT": float64(3001)})},
+			},
+		},
+		{
+			Name:        "empty values",
+			Tasks:       `[{"command":"./run","matrix":{"SERVICE":[]}}]`,
+			ExpectError: true,
+		},
+		{
+			Name:        "too many combinations",
+			Tasks:       `[{"command":"./run","matrix":{"A":[1,2,3,4,5],"B":[1,2,3,4]}}]`,
+			ExpectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg := Config{WorkspaceConfig: WorkspaceConfig{GitpodTasks: test.Tasks}}
+			act, err := cfg.getGitpodTasks()
+			if test.ExpectError {
+				if err == nil {
+					t.Errorf("expected an error, got %v", act)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected tasks (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
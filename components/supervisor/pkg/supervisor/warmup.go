@@ -0,0 +1,332 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+	"github.com/gitpod-io/gitpod/supervisor/pkg/config"
+)
+
+const gitpodHomeDir = "/home/gitpod"
+
+type warmup struct {
+	api.WarmupStatus
+	config *gitpod.WarmupItems
+	key    string
+}
+
+// warmupManager runs the warmup commands configured in .gitpod.yml. Prebuilds run all warmup commands
+// and store their caches in the workspace, such that workspaces started from the prebuild can restore
+// the caches instead of warming up again.
+type warmupManager struct {
+	config        *Config
+	gitpodConfig  config.ConfigInterface
+	contentState  ContentState
+	storeLocation string
+
+	mu       sync.RWMutex
+	warmups  []*warmup
+	done     chan struct{}
+	doneOnce sync.Once
+}
+
+func newWarmupManager(cfg *Config, gitpodConfig config.ConfigInterface, contentState ContentState) *warmupManager {
+	return &warmupManager{
+		config:        cfg,
+		gitpodConfig:  gitpodConfig,
+		contentState:  contentState,
+		storeLocation: filepath.Join(logs.TerminalStoreLocation, "warmup"),
+		done:          make(chan struct{}),
+	}
+}
+
+// Done is closed once all warmup commands have finished.
+func (wm *warmupManager) Done() <-chan struct{} {
+	return wm.done
+}
+
+// Status returns the current status of all warmup commands.
+func (wm *warmupManager) Status() []*api.WarmupStatus {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	res := make([]*api.WarmupStatus, 0, len(wm.warmups))
+	for _, w := range wm.warmups {
+		res = append(res, &api.WarmupStatus{
+			Name:     w.Name,
+			State:    w.State,
+			Message:  w.Message,
+			Restored: w.Restored,
+		})
+	}
+	return res
+}
+
+func (wm *warmupManager) setState(w *warmup, state api.WarmupState, message string) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	w.State = state
+	w.Message = message
+}
+
+func (wm *warmupManager) markDone() {
+	wm.doneOnce.Do(func() { close(wm.done) })
+}
+
+// Run executes the warmup commands and stores their caches in the workspace in prebuilds. Regular workspaces only restore
+// the stored caches: they must not compete with the tasks for the workspace, which might be installing the same dependencies.
+func (wm *warmupManager) Run(ctx context.Context) {
+	defer wm.markDone()
+
+	if !wm.start(ctx) {
+		return
+	}
+
+	for _, w := range wm.warmups {
+		if ctx.Err() != nil {
+			return
+		}
+
+		archive := wm.archiveLocation(w)
+		if !wm.config.isPrebuild() {
+			if _, err := os.Stat(archive); err == nil {
+				wm.restore(ctx, w, archive)
+			} else {
+				wm.setState(w, api.WarmupState_warmup_skipped, "no cache to restore")
+			}
+			continue
+		}
+
+		wm.warmup(ctx, w)
+		if wm.config.isPrebuild() && w.State == api.WarmupState_warmup_done {
+			err := wm.store(ctx, w, archive)
+			if err != nil {
+				log.WithError(err).WithField("warmup", w.Name).Error("cannot store warmup cache")
+				wm.setState(w, api.WarmupState_warmup_failed, "cannot store cache: "+err.Error())
+			}
+		}
+	}
+}
+
+// Skip reports all warmup commands as skipped without running them, e.g. because the prebuild tasks failed.
+func (wm *warmupManager) Skip(ctx context.Context, reason string) {
+	defer wm.markDone()
+
+	if !wm.start(ctx) {
+		return
+	}
+	for _, w := range wm.warmups {
+		wm.setState(w, api.WarmupState_warmup_skipped, reason)
+	}
+}
+
+// start waits for the workspace content and reads the warmup configuration. It returns false if there's nothing to do.
+func (wm *warmupManager) start(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-wm.contentState.ContentReady():
+	}
+
+	err := wm.init(ctx)
+	if err != nil {
+		log.WithError(err).Error("cannot initialize warmup")
+		return false
+	}
+	return true
+}
+
+func (wm *warmupManager) init(ctx context.Context) error {
+	observeCtx, cancel := context.WithCancel(ctx)
+	cfgs := wm.gitpodConfig.Observe(observeCtx)
+	var cfg *gitpod.GitpodConfig
+	select {
+	case <-ctx.Done():
+	case cfg = <-cfgs:
+	}
+	cancel()
+	// the observer only stops once it has tried to send the next update
+	go func() {
+		for range cfgs {
+		}
+	}()
+	if cfg == nil {
+		return nil
+	}
+
+	err := os.MkdirAll(wm.storeLocation, 0755)
+	if err != nil {
+		return xerrors.Errorf("cannot create warmup store location: %w", err)
+	}
+	err = os.Chown(wm.storeLocation, gitpodUID, gitpodGID)
+	if err != nil {
+		return xerrors.Errorf("cannot chown warmup store location: %w", err)
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+	for i, c := range cfg.Warmup {
+		if c == nil || strings.TrimSpace(c.Command) == "" {
+			continue
+		}
+		name := c.Name
+		if name == "" {
+			name = "Warmup " + strconv.Itoa(i+1)
+		}
+		wm.warmups = append(wm.warmups, &warmup{
+			WarmupStatus: api.WarmupStatus{
+				Name:  name,
+				State: api.WarmupState_warmup_pending,
+			},
+			config: c,
+			key:    warmupKey(c),
+		})
+	}
+
+	wm.prune()
+	return nil
+}
+
+// prune removes the caches and logs of warmup commands which are no longer configured
+func (wm *warmupManager) prune() {
+	keys := make(map[string]struct{}, len(wm.warmups))
+	for _, w := range wm.warmups {
+		keys[w.key] = struct{}{}
+	}
+
+	files, err := os.ReadDir(wm.storeLocation)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		log.WithError(err).Warn("cannot list warmup caches")
+		return
+	}
+	for _, f := range files {
+		ext := filepath.Ext(f.Name())
+		if f.IsDir() || (ext != ".tar" && ext != ".log") {
+			continue
+		}
+		if _, ok := keys[strings.TrimSuffix(f.Name(), ext)]; ok {
+			continue
+		}
+		err := os.Remove(filepath.Join(wm.storeLocation, f.Name()))
+		if err != nil {
+			log.WithError(err).WithField("file", f.Name()).Warn("cannot remove stale warmup cache")
+		}
+	}
+}
+
+func (wm *warmupManager) warmup(ctx context.Context, w *warmup) {
+	wlog := log.WithField("warmup", w.Name)
+	wm.setState(w, api.WarmupState_warmup_running, "")
+
+	out, err := os.OpenFile(filepath.Join(wm.storeLocation, w.key+".log"), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		wlog.WithError(err).Error("cannot create warmup log")
+		wm.setState(w, api.WarmupState_warmup_failed, "cannot create log: "+err.Error())
+		return
+	}
+	defer out.Close()
+
+	start := time.Now()
+	cmd := runAsGitpodUser(exec.CommandContext(ctx, "/bin/bash", "-c", w.config.Command))
+	cmd.Dir = wm.config.RepoRoot
+	cmd.Stdout = out
+	cmd.Stderr = out
+	err = cmd.Run()
+	if err != nil {
+		wlog.WithError(err).Warn("warmup command failed")
+		wm.setState(w, api.WarmupState_warmup_failed, err.Error())
+		return
+	}
+	wlog.WithField("duration", time.Since(start).String()).Info("warmup command finished")
+	wm.setState(w, api.WarmupState_warmup_done, "")
+}
+
+// store archives the cache paths of a warmup into the workspace
+func (wm *warmupManager) store(ctx context.Context, w *warmup, archive string) error {
+	var paths []string
+	for _, p := range w.config.Cache {
+		p = resolveWarmupCachePath(p, wm.config.RepoRoot)
+		if _, err := os.Stat(p); err != nil {
+			log.WithField("warmup", w.Name).WithField("path", p).Warn("warmup cache path does not exist")
+			continue
+		}
+		paths = append(paths, p)
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	args := append([]string{"--absolute-names", "-cf", archive}, paths...)
+	out, err := runAsGitpodUser(exec.CommandContext(ctx, "tar", args...)).CombinedOutput()
+	if err != nil {
+		os.Remove(archive)
+		return xerrors.Errorf("%w: %s", err, string(out))
+	}
+	return nil
+}
+
+func (wm *warmupManager) restore(ctx context.Context, w *warmup, archive string) {
+	wm.setState(w, api.WarmupState_warmup_restoring, "")
+
+	out, err := runAsGitpodUser(exec.CommandContext(ctx, "tar", "--absolute-names", "-xf", archive)).CombinedOutput()
+	if err != nil {
+		log.WithError(err).WithField("warmup", w.Name).WithField("out", string(out)).Error("cannot restore warmup cache")
+		wm.setState(w, api.WarmupState_warmup_failed, "cannot restore cache: "+err.Error())
+		return
+	}
+
+	wm.mu.Lock()
+	w.Restored = true
+	wm.mu.Unlock()
+	wm.setState(w, api.WarmupState_warmup_done, "")
+}
+
+func (wm *warmupManager) archiveLocation(w *warmup) string {
+	return filepath.Join(wm.storeLocation, w.key+".tar")
+}
+
+// warmupKey identifies a warmup configuration, such that changed warmup commands do not restore stale caches
+func warmupKey(c *gitpod.WarmupItems) string {
+	h := sha256.New()
+	h.Write([]byte(c.Command))
+	for _, p := range c.Cache {
+		h.Write([]byte{0})
+		h.Write([]byte(p))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+func resolveWarmupCachePath(p, repoRoot string) string {
+	switch {
+	case p == "~":
+		return gitpodHomeDir
+	case strings.HasPrefix(p, "~/"):
+		return filepath.Join(gitpodHomeDir, p[2:])
+	case !filepath.IsAbs(p):
+		return filepath.Join(repoRoot, p)
+	default:
+		return filepath.Clean(p)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package supervisor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	gitpod "github.com/gitpod-io/gitpod/gitpod-protocol"
+	"github.com/gitpod-io/gitpod/supervisor/api"
+)
+
+func TestResolveWarmupCachePath(t *testing.T) {
+	tests := []struct {
+		Path        string
+		Expectation string
+	}{
+		{Path: "~", Expectation: "/home/gitpod"},
+		{Path: "~/.cache/go-build", Expectation: "/home/gitpod/.cache/go-build"},
+		{Path: "/root/.m2/", Expectation: "/root/.m2"},
+		{Path: "build/cache", Expectation: "/workspace/repo/build/cache"},
+		{Path: "~foo", Expectation: "/workspace/repo/~foo"},
+	}
+	for _, test := range tests {
+		t.Run(test.Path, func(t *testing.T) {
+			act := resolveWarmupCachePath(test.Path, "/workspace/repo")
+			if act != test.Expectation {
+				t.Errorf("unexpected path: expected %q, got %q", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestWarmupKey(t *testing.T) {
+	base := &gitpod.WarmupItems{Command: "go build ./...", Cache: []string{"~/.cache/go-build"}}
+	if warmupKey(base) != warmupKey(&gitpod.WarmupItems{Name: "renamed", Command: base.Command, Cache: base.Cache}) {
+		t.Errorf("renaming a warmup must not change its key")
+	}
+	if warmupKey(base) == warmupKey(&gitpod.WarmupItems{Command: "go build ./cmd/...", Cache: base.Cache}) {
+		t.Errorf("changing the command must change the key")
+	}
+	if warmupKey(base) == warmupKey(&gitpod.WarmupItems{Command: base.Command, Cache: []string{"~/.cache"}}) {
+		t.Errorf("changing the cache paths must change the key")
+	}
+}
+
+func TestWarmupManager(t *testing.T) {
+	warmups := []*gitpod.WarmupItems{
+		{Name: "build", Command: "touch build", Cache: []string{"~/.cache/go-build"}},
+		{Command: "touch test"},
+	}
+	skipped := func(message string) []*api.WarmupStatus {
+		return []*api.WarmupStatus{
+			{Name: "build", State: api.WarmupState_warmup_skipped, Message: message},
+			{Name: "Warmup 2", State: api.WarmupState_warmup_skipped, Message: message},
+		}
+	}
+
+	tests := []struct {
+		Name        string
+		Run         func(ctx context.Context, wm *warmupManager)
+		Expectation []*api.WarmupStatus
+	}{
+		{
+			Name:        "regular workspace without caches",
+			Run:         func(ctx context.Context, wm *warmupManager) { wm.Run(ctx) },
+			Expectation: skipped("no cache to restore"),
+		},
+		{
+			Name:        "skip",
+			Run:         func(ctx context.Context, wm *warmupManager) { wm.Skip(ctx, "prebuild tasks failed") },
+			Expectation: skipped("prebuild tasks failed"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				repoRoot     = t.TempDir()
+				contentState = NewInMemoryContentState("")
+				configs      = make(chan *gitpod.GitpodConfig, 1)
+				wm           = newWarmupManager(&Config{WorkspaceConfig: WorkspaceConfig{RepoRoot: repoRoot}}, &testGitpodConfigService{configs: configs}, contentState)
+			)
+			wm.storeLocation = t.TempDir()
+
+			// caches of warmup commands which are no longer configured are pruned
+			current := warmupKey(warmups[0]) + ".log"
+			for _, f := range []string{current, "0123456789abcdef.tar", "0123456789abcdef.log", "unrelated"} {
+				err := os.WriteFile(filepath.Join(wm.storeLocation, f), nil, 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			contentState.MarkContentReady(csapi.WorkspaceInitFromOther)
+			configs <- &gitpod.GitpodConfig{Warmup: warmups}
+			close(configs)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			test.Run(ctx, wm)
+
+			select {
+			case <-wm.Done():
+			default:
+				t.Fatal("warmup is not done")
+			}
+			if diff := cmp.Diff(test.Expectation, wm.Status(), protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected status (-want +got):\n%s", diff)
+			}
+
+			for _, f := range []string{"build", "test"} {
+				if _, err := os.Stat(filepath.Join(repoRoot, f)); err == nil {
+					t.Errorf("warmup command which creates %s ran", f)
+				}
+			}
+
+			files, err := os.ReadDir(wm.storeLocation)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var remaining []string
+			for _, f := range files {
+				remaining = append(remaining, f.Name())
+			}
+			if diff := cmp.Diff([]string{current, "unrelated"}, remaining); diff != "" {
+				t.Errorf("unexpected warmup store content (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type testGitpodConfigService struct {
+	configs chan *gitpod.GitpodConfig
+}
+
+func (service *testGitpodConfigService) Watch(ctx context.Context) {}
+
+func (service *testGitpodConfigService) Observe(ctx context.Context) <-chan *gitpod.GitpodConfig {
+	return service.configs
+}
+
+func (service *testGitpodConfigService) ObserveImageFile(ctx context.Context) <-chan *struct{} {
+	return nil
+}
@@ -50,6 +50,9 @@ type MuxTerminalService struct {
 
 	DefaultShell string
 	Env          []string
+	// EnvProvider allows dynamically to compute the environment
+	// if nil then Env is used
+	EnvProvider  func() []string
 	DefaultCreds *syscall.Credential
 
 	api.UnimplementedTerminalServiceServer
@@ -94,7 +97,11 @@ func (srv *MuxTerminalService) OpenWithOptions(ctx context.Context, req *api.Ope
 	if cmd.Dir == "" {
 		cmd.Dir = srv.DefaultWorkdir
 	}
-	cmd.Env = append(srv.Env, "TERM=xterm-256color")
+	env := srv.Env
+	if srv.EnvProvider != nil {
+		env = srv.EnvProvider()
+	}
+	cmd.Env = append(env, "TERM=xterm-256color")
 	for key, value := range req.Env {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%v=%v", key, value))
 	}
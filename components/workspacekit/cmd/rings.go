@@ -39,8 +39,10 @@ import (
 
 	common_grpc "github.com/gitpod-io/gitpod/common-go/grpc"
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/workspacekit/pkg/fusemount"
 	"github.com/gitpod-io/gitpod/workspacekit/pkg/lift"
 	"github.com/gitpod-io/gitpod/workspacekit/pkg/seccomp"
+	"github.com/gitpod-io/gitpod/workspacekit/pkg/timens"
 	"github.com/gitpod-io/gitpod/ws-daemon/api"
 	daemonapi "github.com/gitpod-io/gitpod/ws-daemon/api"
 )
@@ -342,6 +344,17 @@ var ring1Cmd = &cobra.Command{
 			}
 		}
 
+		// Workspaces of classes which permit FUSE mounts share them below /workspace with all their mount namespaces.
+		// Other workspaces can still mount FUSE filesystems, but those remain private to the mount namespace they were mounted in.
+		fuseMounts := os.Getenv("WORKSPACEKIT_FUSE_MOUNTS") == "true"
+		if fuseMounts {
+			err = fusemount.Share(filepath.Join(ring2Root, "workspace"))
+			if err != nil {
+				log.WithError(err).Warn("cannot share FUSE mounts - they will not propagate")
+				fuseMounts = false
+			}
+		}
+
 		// We deliberately do not bind mount `/etc/resolv.conf` and `/etc/hosts`, but instead place a copy
 		// so that users in the workspace can modify the file.
 		copyPaths := []string{"/etc/resolv.conf", "/etc/hosts"}
@@ -367,6 +380,17 @@ var ring1Cmd = &cobra.Command{
 
 		env = append(env, "WORKSPACEKIT_WRAP_NETNS=true")
 
+		var clockOffset time.Duration
+		if v := os.Getenv("WORKSPACEKIT_CLOCK_OFFSET"); v != "" {
+			clockOffset, err = time.ParseDuration(v)
+			if err != nil {
+				log.WithError(err).WithField("offset", v).Warn("cannot parse clock offset - not shifting clocks")
+			}
+		}
+		if clockOffset != 0 {
+			env = timens.FaketimeEnv(env, clockOffset)
+		}
+
 		socketFN := filepath.Join(os.TempDir(), fmt.Sprintf("workspacekit-ring1-%d.unix", time.Now().UnixNano()))
 		skt, err := net.Listen("unix", socketFN)
 		if err != nil {
@@ -389,12 +413,24 @@ var ring1Cmd = &cobra.Command{
 		cmd.Stdout = os.Stdout
 		cmd.Stderr = os.Stderr
 		cmd.Env = env
+		if clockOffset != 0 {
+			// The time namespace only applies to the children of this thread, hence we lock the
+			// goroutine to it and never unlock it again.
+			runtime.LockOSThread()
+			err = timens.Unshare(clockOffset)
+			if err != nil {
+				log.WithError(err).Warn("cannot shift the monotonic clocks of the workspace")
+			}
+		}
 		if err := cmd.Start(); err != nil {
 			log.WithError(err).Error("failed to start the child process")
 			return
 		}
 		sigc := sigproxy.ForwardAllSignals(context.Background(), cmd.Process.Pid)
 		defer sigproxysignal.StopCatch(sigc)
+		if fuseMounts {
+			go detachFUSEMountsOnStop(wsid, filepath.Join(ring2Root, "workspace"))
+		}
 
 		procLoc := filepath.Join(ring2Root, "proc")
 		err = os.MkdirAll(procLoc, 0755)
@@ -924,6 +960,24 @@ func pivotRoot(rootfs string, fsshift api.FSShiftMethod) error {
 	return nil
 }
 
+// detachFUSEMountsOnStop lazily unmounts the FUSE filesystems mounted below root once the workspace is asked to stop,
+// such that unresponsive FUSE servers cannot keep the workspace from stopping. The mounts propagated to ring1 because root is shared.
+func detachFUSEMountsOnStop(wsid string, root string) {
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, unix.SIGTERM)
+	<-sigc
+	signal.Stop(sigc)
+
+	log := log.WithField("ring", 1).WithField("workspaceId", wsid)
+	mnts, err := fusemount.Detach(root)
+	if err != nil {
+		log.WithError(err).Warn("cannot unmount all FUSE filesystems")
+	}
+	if len(mnts) > 0 {
+		log.WithField("mountpoints", mnts).Info("unmounted FUSE filesystems")
+	}
+}
+
 func handleExit(ec *int) {
 	exitCode := *ec
 	if exitCode != 0 {
@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package fusemount lets FUSE filesystems which users mount in their workspace, e.g. using sshfs, rclone or s3fs,
+// propagate to all mount namespaces of the workspace and cleans them up when the workspace stops.
+package fusemount
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/moby/sys/mountinfo"
+	"golang.org/x/sys/unix"
+	"golang.org/x/xerrors"
+)
+
+// Share makes the mount at root a shared mount, such that mounts below it propagate between the mount namespaces
+// which are created from now on, e.g. those of ring2 and of containers started in the workspace.
+func Share(root string) error {
+	err := unix.Mount("", root, "", unix.MS_SHARED|unix.MS_REC, "")
+	if err != nil {
+		return xerrors.Errorf("cannot make %s shared: %w", root, err)
+	}
+	return nil
+}
+
+// Detach lazily unmounts the FUSE filesystems mounted below root, such that the processes of a stopping workspace
+// cannot block on unresponsive FUSE servers while they look at the workspace content. Files which are open on those
+// filesystems remain usable until they are closed. Detach returns the mountpoints it unmounted.
+func Detach(root string) ([]string, error) {
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	mnts, err := Mountpoints(f, root)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		res  []string
+		errs []string
+	)
+	for _, mnt := range mnts {
+		err := unix.Unmount(mnt, unix.MNT_DETACH)
+		if err != nil && err != unix.EINVAL && err != unix.ENOENT {
+			errs = append(errs, mnt+": "+err.Error())
+			continue
+		}
+		res = append(res, mnt)
+	}
+	if len(errs) > 0 {
+		return res, xerrors.Errorf("cannot unmount FUSE filesystems: %s", strings.Join(errs, ", "))
+	}
+	return res, nil
+}
+
+// Mountpoints returns the mountpoints of the FUSE filesystems below root found in mountinfo, the deepest ones first.
+func Mountpoints(mountinfoReader io.Reader, root string) ([]string, error) {
+	root = filepath.Clean(root)
+	mnts, err := mountinfo.GetMountsFromReader(mountinfoReader, func(i *mountinfo.Info) (skip, stop bool) {
+		if !isFUSE(i.FSType) {
+			return true, false
+		}
+		if i.Mountpoint == root || !strings.HasPrefix(i.Mountpoint, root+"/") {
+			return true, false
+		}
+		return false, false
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse mountinfo: %w", err)
+	}
+
+	res := make([]string, 0, len(mnts))
+	seen := make(map[string]struct{}, len(mnts))
+	for _, mnt := range mnts {
+		if _, ok := seen[mnt.Mountpoint]; ok {
+			continue
+		}
+		seen[mnt.Mountpoint] = struct{}{}
+		res = append(res, mnt.Mountpoint)
+	}
+	// nested mounts must be unmounted before their parents
+	sort.SliceStable(res, func(i, j int) bool {
+		return strings.Count(res[i], "/") > strings.Count(res[j], "/")
+	})
+	return res, nil
+}
+
+// isFUSE returns true if fstype denotes a FUSE filesystem, e.g. fuse.sshfs or fuseblk
+func isFUSE(fstype string) bool {
+	return fstype == "fuse" || fstype == "fuseblk" || strings.HasPrefix(fstype, "fuse.")
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package fusemount
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const mountinfoFixture = `1489 1488 0:104 / / rw,relatime - shiftfs /.workspace/mark rw
+1490 1489 8:16 /workspace /workspace rw,relatime shared:713 - ext4 /dev/sdb rw,discard
+1491 1489 0:105 / /tmp rw,relatime - tmpfs tmpfs rw,uid=33333
+1510 1490 0:120 / /workspace/data rw,nosuid,nodev,relatime shared:714 - fuse.sshfs gitpod@example.com:/data rw,user_id=33333,group_id=33333
+1511 1510 0:121 / /workspace/data/archive rw,nosuid,nodev,relatime shared:715 - fuse.rclone s3:archive rw,user_id=33333,group_id=33333
+1512 1490 0:122 / /workspace/bucket rw,nosuid,nodev,relatime shared:716 - fuse s3fs rw,user_id=33333,group_id=33333
+1513 1490 8:16 /workspace/repo /workspace/bind rw,relatime shared:713 - ext4 /dev/sdb rw,discard
+1514 1489 0:123 / /home/gitpod/remote rw,nosuid,nodev,relatime - fuse.sshfs gitpod@example.com:/ rw,user_id=33333,group_id=33333
+1515 1489 0:124 / /workspace-other rw,nosuid,nodev,relatime - fuse.sshfs gitpod@example.com:/ rw,user_id=33333,group_id=33333
+`
+
+func TestMountpoints(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Root        string
+		Expectation []string
+	}{
+		{
+			Name:        "workspace",
+			Root:        "/workspace",
+			Expectation: []string{"/workspace/data/archive", "/workspace/data", "/workspace/bucket"},
+		},
+		{
+			Name:        "trailing slash",
+			Root:        "/workspace/",
+			Expectation: []string{"/workspace/data/archive", "/workspace/data", "/workspace/bucket"},
+		},
+		{
+			Name:        "nested root",
+			Root:        "/workspace/data",
+			Expectation: []string{"/workspace/data/archive"},
+		},
+		{
+			Name:        "no FUSE mounts",
+			Root:        "/tmp",
+			Expectation: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act, err := Mountpoints(strings.NewReader(mountinfoFixture), test.Root)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected mountpoints (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+// Package timens shifts the clocks of a workspace relative to the node.
+//
+// Linux time namespaces only shift the monotonic and boot time clocks. The wall clock is
+// shifted using libfaketime if the workspace image provides it.
+package timens
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// faketimeLibraries are the locations distributions install libfaketime to
+var faketimeLibraries = []string{
+	"/usr/lib/x86_64-linux-gnu/faketime/libfaketime.so.1",
+	"/usr/lib/aarch64-linux-gnu/faketime/libfaketime.so.1",
+	"/usr/lib64/faketime/libfaketime.so.1",
+	"/usr/lib/faketime/libfaketime.so.1",
+}
+
+// Unshare moves the children the calling thread starts from now on into a new time namespace whose
+// monotonic and boot time clocks are shifted by offset. The caller must lock the OS thread and start
+// the children from it.
+func Unshare(offset time.Duration) error {
+	err := unix.Unshare(unix.CLONE_NEWTIME)
+	if err != nil {
+		return fmt.Errorf("cannot unshare time namespace: %w", err)
+	}
+
+	// the offsets can only be written until the first process enters the namespace
+	err = os.WriteFile("/proc/thread-self/timens_offsets", []byte(formatOffsets(offset)), 0)
+	if err != nil {
+		return fmt.Errorf("cannot set time namespace offsets: %w", err)
+	}
+	return nil
+}
+
+// formatOffsets produces the content of /proc/<pid>/timens_offsets. The nanoseconds must not be negative.
+func formatOffsets(offset time.Duration) string {
+	secs := int64(offset / time.Second)
+	nsecs := int64(offset % time.Second)
+	if nsecs < 0 {
+		secs--
+		nsecs += int64(time.Second)
+	}
+	return fmt.Sprintf("monotonic %d %d\nboottime %d %d\n", secs, nsecs, secs, nsecs)
+}
+
+// FaketimeEnv returns the environment variables which make libfaketime shift the wall clock by offset.
+// The monotonic clock is left to the time namespace. env is the environment the variables are added to.
+func FaketimeEnv(env []string, offset time.Duration) []string {
+	res := append(env,
+		fmt.Sprintf("FAKETIME=%+d", int64(offset/time.Second)),
+		"DONT_FAKE_MONOTONIC=1",
+	)
+
+	var lib string
+	for _, fn := range faketimeLibraries {
+		if _, err := os.Stat(fn); err == nil {
+			lib = fn
+			break
+		}
+	}
+	if lib == "" {
+		return res
+	}
+
+	for i, e := range res {
+		if preload, ok := strings.CutPrefix(e, "LD_PRELOAD="); ok {
+			res[i] = "LD_PRELOAD=" + lib + " " + preload
+			return res
+		}
+	}
+	return append(res, "LD_PRELOAD="+lib)
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package timens
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatOffsets(t *testing.T) {
+	tests := []struct {
+		Offset      time.Duration
+		Expectation string
+	}{
+		{Offset: 48 * time.Hour, Expectation: "monotonic 172800 0\nboottime 172800 0\n"},
+		{Offset: 1500 * time.Millisecond, Expectation: "monotonic 1 500000000\nboottime 1 500000000\n"},
+		{Offset: -1500 * time.Millisecond, Expectation: "monotonic -2 500000000\nboottime -2 500000000\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.Offset.String(), func(t *testing.T) {
+			act := formatOffsets(test.Offset)
+			if act != test.Expectation {
+				t.Errorf("unexpected offsets: %q, expected %q", act, test.Expectation)
+			}
+		})
+	}
+}
@@ -40,6 +40,8 @@ type WorkspaceContentServiceClient interface {
 	DisposeWorkspace(ctx context.Context, in *DisposeWorkspaceRequest, opts ...grpc.CallOption) (*DisposeWorkspaceResponse, error)
 	// BackupWorkspace creates a backup of a workspace
 	BackupWorkspace(ctx context.Context, in *BackupWorkspaceRequest, opts ...grpc.CallOption) (*BackupWorkspaceResponse, error)
+	// RestoreSnapshot downloads a snapshot or backup and unpacks it into a directory of a running workspace
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
 }
 
 type workspaceContentServiceClient struct {
@@ -104,6 +106,15 @@ func (c *workspaceContentServiceClient) BackupWorkspace(ctx context.Context, in
 	return out, nil
 }
 
+func (c *workspaceContentServiceClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/wsdaemon.WorkspaceContentService/RestoreSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceContentServiceServer is the server API for WorkspaceContentService service.
 // All implementations must embed UnimplementedWorkspaceContentServiceServer
 // for forward compatibility
@@ -122,6 +133,8 @@ type WorkspaceContentServiceServer interface {
 	DisposeWorkspace(context.Context, *DisposeWorkspaceRequest) (*DisposeWorkspaceResponse, error)
 	// BackupWorkspace creates a backup of a workspace
 	BackupWorkspace(context.Context, *BackupWorkspaceRequest) (*BackupWorkspaceResponse, error)
+	// RestoreSnapshot downloads a snapshot or backup and unpacks it into a directory of a running workspace
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
 	mustEmbedUnimplementedWorkspaceContentServiceServer()
 }
 
@@ -147,6 +160,9 @@ func (UnimplementedWorkspaceContentServiceServer) DisposeWorkspace(context.Conte
 func (UnimplementedWorkspaceContentServiceServer) BackupWorkspace(context.Context, *BackupWorkspaceRequest) (*BackupWorkspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BackupWorkspace not implemented")
 }
+func (UnimplementedWorkspaceContentServiceServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
 func (UnimplementedWorkspaceContentServiceServer) mustEmbedUnimplementedWorkspaceContentServiceServer() {
 }
 
@@ -269,6 +285,24 @@ func _WorkspaceContentService_BackupWorkspace_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceContentService_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceContentServiceServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsdaemon.WorkspaceContentService/RestoreSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceContentServiceServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceContentService_ServiceDesc is the grpc.ServiceDesc for WorkspaceContentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -300,7 +334,168 @@ var WorkspaceContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BackupWorkspace",
 			Handler:    _WorkspaceContentService_BackupWorkspace_Handler,
 		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _WorkspaceContentService_RestoreSnapshot_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "daemon.proto",
 }
+
+// WorkspaceResourceServiceClient is the client API for WorkspaceResourceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkspaceResourceServiceClient interface {
+	// WorkspaceResourceUsage samples the resource usage of a workspace at the requested interval until the workspace stops
+	WorkspaceResourceUsage(ctx context.Context, in *WorkspaceResourceUsageRequest, opts ...grpc.CallOption) (WorkspaceResourceService_WorkspaceResourceUsageClient, error)
+	// GetWorkspaceDiskUsage returns the disk usage of a workspace broken down by where the data lives. The usage is read
+	// from the quota accounting of the filesystems rather than by walking them, hence it is cheap to call.
+	GetWorkspaceDiskUsage(ctx context.Context, in *GetWorkspaceDiskUsageRequest, opts ...grpc.CallOption) (*GetWorkspaceDiskUsageResponse, error)
+}
+
+type workspaceResourceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkspaceResourceServiceClient(cc grpc.ClientConnInterface) WorkspaceResourceServiceClient {
+	return &workspaceResourceServiceClient{cc}
+}
+
+func (c *workspaceResourceServiceClient) WorkspaceResourceUsage(ctx context.Context, in *WorkspaceResourceUsageRequest, opts ...grpc.CallOption) (WorkspaceResourceService_WorkspaceResourceUsageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WorkspaceResourceService_ServiceDesc.Streams[0], "/wsdaemon.WorkspaceResourceService/WorkspaceResourceUsage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workspaceResourceServiceWorkspaceResourceUsageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkspaceResourceService_WorkspaceResourceUsageClient interface {
+	Recv() (*WorkspaceResourceUsageResponse, error)
+	grpc.ClientStream
+}
+
+type workspaceResourceServiceWorkspaceResourceUsageClient struct {
+	grpc.ClientStream
+}
+
+func (x *workspaceResourceServiceWorkspaceResourceUsageClient) Recv() (*WorkspaceResourceUsageResponse, error) {
+	m := new(WorkspaceResourceUsageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workspaceResourceServiceClient) GetWorkspaceDiskUsage(ctx context.Context, in *GetWorkspaceDiskUsageRequest, opts ...grpc.CallOption) (*GetWorkspaceDiskUsageResponse, error) {
+	out := new(GetWorkspaceDiskUsageResponse)
+	err := c.cc.Invoke(ctx, "/wsdaemon.WorkspaceResourceService/GetWorkspaceDiskUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkspaceResourceServiceServer is the server API for WorkspaceResourceService service.
+// All implementations must embed UnimplementedWorkspaceResourceServiceServer
+// for forward compatibility
+type WorkspaceResourceServiceServer interface {
+	// WorkspaceResourceUsage samples the resource usage of a workspace at the requested interval until the workspace stops
+	WorkspaceResourceUsage(*WorkspaceResourceUsageRequest, WorkspaceResourceService_WorkspaceResourceUsageServer) error
+	// GetWorkspaceDiskUsage returns the disk usage of a workspace broken down by where the data lives. The usage is read
+	// from the quota accounting of the filesystems rather than by walking them, hence it is cheap to call.
+	GetWorkspaceDiskUsage(context.Context, *GetWorkspaceDiskUsageRequest) (*GetWorkspaceDiskUsageResponse, error)
+	mustEmbedUnimplementedWorkspaceResourceServiceServer()
+}
+
+// UnimplementedWorkspaceResourceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWorkspaceResourceServiceServer struct {
+}
+
+func (UnimplementedWorkspaceResourceServiceServer) WorkspaceResourceUsage(*WorkspaceResourceUsageRequest, WorkspaceResourceService_WorkspaceResourceUsageServer) error {
+	return status.Errorf(codes.Unimplemented, "method WorkspaceResourceUsage not implemented")
+}
+func (UnimplementedWorkspaceResourceServiceServer) GetWorkspaceDiskUsage(context.Context, *GetWorkspaceDiskUsageRequest) (*GetWorkspaceDiskUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceDiskUsage not implemented")
+}
+func (UnimplementedWorkspaceResourceServiceServer) mustEmbedUnimplementedWorkspaceResourceServiceServer() {
+}
+
+// UnsafeWorkspaceResourceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkspaceResourceServiceServer will
+// result in compilation errors.
+type UnsafeWorkspaceResourceServiceServer interface {
+	mustEmbedUnimplementedWorkspaceResourceServiceServer()
+}
+
+func RegisterWorkspaceResourceServiceServer(s grpc.ServiceRegistrar, srv WorkspaceResourceServiceServer) {
+	s.RegisterService(&WorkspaceResourceService_ServiceDesc, srv)
+}
+
+func _WorkspaceResourceService_WorkspaceResourceUsage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WorkspaceResourceUsageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkspaceResourceServiceServer).WorkspaceResourceUsage(m, &workspaceResourceServiceWorkspaceResourceUsageServer{stream})
+}
+
+type WorkspaceResourceService_WorkspaceResourceUsageServer interface {
+	Send(*WorkspaceResourceUsageResponse) error
+	grpc.ServerStream
+}
+
+type workspaceResourceServiceWorkspaceResourceUsageServer struct {
+	grpc.ServerStream
+}
+
+func (x *workspaceResourceServiceWorkspaceResourceUsageServer) Send(m *WorkspaceResourceUsageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkspaceResourceService_GetWorkspaceDiskUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceDiskUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceResourceServiceServer).GetWorkspaceDiskUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsdaemon.WorkspaceResourceService/GetWorkspaceDiskUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceResourceServiceServer).GetWorkspaceDiskUsage(ctx, req.(*GetWorkspaceDiskUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkspaceResourceService_ServiceDesc is the grpc.ServiceDesc for WorkspaceResourceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkspaceResourceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wsdaemon.WorkspaceResourceService",
+	HandlerType: (*WorkspaceResourceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWorkspaceDiskUsage",
+			Handler:    _WorkspaceResourceService_GetWorkspaceDiskUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WorkspaceResourceUsage",
+			Handler:       _WorkspaceResourceService_WorkspaceResourceUsage_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "daemon.proto",
+}
@@ -120,6 +120,26 @@ func (mr *MockWorkspaceContentServiceClientMockRecorder) IsWorkspaceExists(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkspaceExists", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).IsWorkspaceExists), varargs...)
 }
 
+// RestoreSnapshot mocks base method.
+func (m *MockWorkspaceContentServiceClient) RestoreSnapshot(arg0 context.Context, arg1 *api.RestoreSnapshotRequest, arg2 ...grpc.CallOption) (*api.RestoreSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreSnapshot", varargs...)
+	ret0, _ := ret[0].(*api.RestoreSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot.
+func (mr *MockWorkspaceContentServiceClientMockRecorder) RestoreSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockWorkspaceContentServiceClient)(nil).RestoreSnapshot), varargs...)
+}
+
 // TakeSnapshot mocks base method.
 func (m *MockWorkspaceContentServiceClient) TakeSnapshot(arg0 context.Context, arg1 *api.TakeSnapshotRequest, arg2 ...grpc.CallOption) (*api.TakeSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +264,21 @@ func (mr *MockWorkspaceContentServiceServerMockRecorder) IsWorkspaceExists(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkspaceExists", reflect.TypeOf((*MockWorkspaceContentServiceServer)(nil).IsWorkspaceExists), arg0, arg1)
 }
 
+// RestoreSnapshot mocks base method.
+func (m *MockWorkspaceContentServiceServer) RestoreSnapshot(arg0 context.Context, arg1 *api.RestoreSnapshotRequest) (*api.RestoreSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*api.RestoreSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot.
+func (mr *MockWorkspaceContentServiceServerMockRecorder) RestoreSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockWorkspaceContentServiceServer)(nil).RestoreSnapshot), arg0, arg1)
+}
+
 // TakeSnapshot mocks base method.
 func (m *MockWorkspaceContentServiceServer) TakeSnapshot(arg0 context.Context, arg1 *api.TakeSnapshotRequest) (*api.TakeSnapshotResponse, error) {
 	m.ctrl.T.Helper()
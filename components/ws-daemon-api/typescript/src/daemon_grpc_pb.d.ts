@@ -21,6 +21,7 @@ interface IWorkspaceContentServiceService extends grpc.ServiceDefinition<grpc.Un
     takeSnapshot: IWorkspaceContentServiceService_ITakeSnapshot;
     disposeWorkspace: IWorkspaceContentServiceService_IDisposeWorkspace;
     backupWorkspace: IWorkspaceContentServiceService_IBackupWorkspace;
+    restoreSnapshot: IWorkspaceContentServiceService_IRestoreSnapshot;
 }
 
 interface IWorkspaceContentServiceService_IInitWorkspace extends grpc.MethodDefinition<daemon_pb.InitWorkspaceRequest, daemon_pb.InitWorkspaceResponse> {
@@ -77,6 +78,15 @@ interface IWorkspaceContentServiceService_IBackupWorkspace extends grpc.MethodDe
     responseSerialize: grpc.serialize<daemon_pb.BackupWorkspaceResponse>;
     responseDeserialize: grpc.deserialize<daemon_pb.BackupWorkspaceResponse>;
 }
+interface IWorkspaceContentServiceService_IRestoreSnapshot extends grpc.MethodDefinition<daemon_pb.RestoreSnapshotRequest, daemon_pb.RestoreSnapshotResponse> {
+    path: "/wsdaemon.WorkspaceContentService/RestoreSnapshot";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<daemon_pb.RestoreSnapshotRequest>;
+    requestDeserialize: grpc.deserialize<daemon_pb.RestoreSnapshotRequest>;
+    responseSerialize: grpc.serialize<daemon_pb.RestoreSnapshotResponse>;
+    responseDeserialize: grpc.deserialize<daemon_pb.RestoreSnapshotResponse>;
+}
 
 export const WorkspaceContentServiceService: IWorkspaceContentServiceService;
 
@@ -87,6 +97,7 @@ export interface IWorkspaceContentServiceServer extends grpc.UntypedServiceImple
     takeSnapshot: grpc.handleUnaryCall<daemon_pb.TakeSnapshotRequest, daemon_pb.TakeSnapshotResponse>;
     disposeWorkspace: grpc.handleUnaryCall<daemon_pb.DisposeWorkspaceRequest, daemon_pb.DisposeWorkspaceResponse>;
     backupWorkspace: grpc.handleUnaryCall<daemon_pb.BackupWorkspaceRequest, daemon_pb.BackupWorkspaceResponse>;
+    restoreSnapshot: grpc.handleUnaryCall<daemon_pb.RestoreSnapshotRequest, daemon_pb.RestoreSnapshotResponse>;
 }
 
 export interface IWorkspaceContentServiceClient {
@@ -108,6 +119,9 @@ export interface IWorkspaceContentServiceClient {
     backupWorkspace(request: daemon_pb.BackupWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.BackupWorkspaceResponse) => void): grpc.ClientUnaryCall;
     backupWorkspace(request: daemon_pb.BackupWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.BackupWorkspaceResponse) => void): grpc.ClientUnaryCall;
     backupWorkspace(request: daemon_pb.BackupWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.BackupWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    restoreSnapshot(request: daemon_pb.RestoreSnapshotRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    restoreSnapshot(request: daemon_pb.RestoreSnapshotRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    restoreSnapshot(request: daemon_pb.RestoreSnapshotRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class WorkspaceContentServiceClient extends grpc.Client implements IWorkspaceContentServiceClient {
@@ -130,4 +144,55 @@ export class WorkspaceContentServiceClient extends grpc.Client implements IWorks
     public backupWorkspace(request: daemon_pb.BackupWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.BackupWorkspaceResponse) => void): grpc.ClientUnaryCall;
     public backupWorkspace(request: daemon_pb.BackupWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.BackupWorkspaceResponse) => void): grpc.ClientUnaryCall;
     public backupWorkspace(request: daemon_pb.BackupWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.BackupWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public restoreSnapshot(request: daemon_pb.RestoreSnapshotRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public restoreSnapshot(request: daemon_pb.RestoreSnapshotRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public restoreSnapshot(request: daemon_pb.RestoreSnapshotRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+}
+
+interface IWorkspaceResourceServiceService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    workspaceResourceUsage: IWorkspaceResourceServiceService_IWorkspaceResourceUsage;
+    getWorkspaceDiskUsage: IWorkspaceResourceServiceService_IGetWorkspaceDiskUsage;
+}
+
+interface IWorkspaceResourceServiceService_IWorkspaceResourceUsage extends grpc.MethodDefinition<daemon_pb.WorkspaceResourceUsageRequest, daemon_pb.WorkspaceResourceUsageResponse> {
+    path: "/wsdaemon.WorkspaceResourceService/WorkspaceResourceUsage";
+    requestStream: false;
+    responseStream: true;
+    requestSerialize: grpc.serialize<daemon_pb.WorkspaceResourceUsageRequest>;
+    requestDeserialize: grpc.deserialize<daemon_pb.WorkspaceResourceUsageRequest>;
+    responseSerialize: grpc.serialize<daemon_pb.WorkspaceResourceUsageResponse>;
+    responseDeserialize: grpc.deserialize<daemon_pb.WorkspaceResourceUsageResponse>;
+}
+interface IWorkspaceResourceServiceService_IGetWorkspaceDiskUsage extends grpc.MethodDefinition<daemon_pb.GetWorkspaceDiskUsageRequest, daemon_pb.GetWorkspaceDiskUsageResponse> {
+    path: "/wsdaemon.WorkspaceResourceService/GetWorkspaceDiskUsage";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<daemon_pb.GetWorkspaceDiskUsageRequest>;
+    requestDeserialize: grpc.deserialize<daemon_pb.GetWorkspaceDiskUsageRequest>;
+    responseSerialize: grpc.serialize<daemon_pb.GetWorkspaceDiskUsageResponse>;
+    responseDeserialize: grpc.deserialize<daemon_pb.GetWorkspaceDiskUsageResponse>;
+}
+
+export const WorkspaceResourceServiceService: IWorkspaceResourceServiceService;
+
+export interface IWorkspaceResourceServiceServer extends grpc.UntypedServiceImplementation {
+    workspaceResourceUsage: grpc.handleServerStreamingCall<daemon_pb.WorkspaceResourceUsageRequest, daemon_pb.WorkspaceResourceUsageResponse>;
+    getWorkspaceDiskUsage: grpc.handleUnaryCall<daemon_pb.GetWorkspaceDiskUsageRequest, daemon_pb.GetWorkspaceDiskUsageResponse>;
+}
+
+export interface IWorkspaceResourceServiceClient {
+    workspaceResourceUsage(request: daemon_pb.WorkspaceResourceUsageRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<daemon_pb.WorkspaceResourceUsageResponse>;
+    workspaceResourceUsage(request: daemon_pb.WorkspaceResourceUsageRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<daemon_pb.WorkspaceResourceUsageResponse>;
+    getWorkspaceDiskUsage(request: daemon_pb.GetWorkspaceDiskUsageRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    getWorkspaceDiskUsage(request: daemon_pb.GetWorkspaceDiskUsageRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    getWorkspaceDiskUsage(request: daemon_pb.GetWorkspaceDiskUsageRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+}
+
+export class WorkspaceResourceServiceClient extends grpc.Client implements IWorkspaceResourceServiceClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: Partial<grpc.ClientOptions>);
+    public workspaceResourceUsage(request: daemon_pb.WorkspaceResourceUsageRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<daemon_pb.WorkspaceResourceUsageResponse>;
+    public workspaceResourceUsage(request: daemon_pb.WorkspaceResourceUsageRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<daemon_pb.WorkspaceResourceUsageResponse>;
+    public getWorkspaceDiskUsage(request: daemon_pb.GetWorkspaceDiskUsageRequest, callback: (error: grpc.ServiceError | null, response: daemon_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    public getWorkspaceDiskUsage(request: daemon_pb.GetWorkspaceDiskUsageRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: daemon_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    public getWorkspaceDiskUsage(request: daemon_pb.GetWorkspaceDiskUsageRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: daemon_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
 }
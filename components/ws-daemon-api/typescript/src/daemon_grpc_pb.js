@@ -55,6 +55,28 @@ function deserialize_wsdaemon_DisposeWorkspaceResponse(buffer_arg) {
   return daemon_pb.DisposeWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsdaemon_GetWorkspaceDiskUsageRequest(arg) {
+  if (!(arg instanceof daemon_pb.GetWorkspaceDiskUsageRequest)) {
+    throw new Error('Expected argument of type wsdaemon.GetWorkspaceDiskUsageRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_GetWorkspaceDiskUsageRequest(buffer_arg) {
+  return daemon_pb.GetWorkspaceDiskUsageRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsdaemon_GetWorkspaceDiskUsageResponse(arg) {
+  if (!(arg instanceof daemon_pb.GetWorkspaceDiskUsageResponse)) {
+    throw new Error('Expected argument of type wsdaemon.GetWorkspaceDiskUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_GetWorkspaceDiskUsageResponse(buffer_arg) {
+  return daemon_pb.GetWorkspaceDiskUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsdaemon_InitWorkspaceRequest(arg) {
   if (!(arg instanceof daemon_pb.InitWorkspaceRequest)) {
     throw new Error('Expected argument of type wsdaemon.InitWorkspaceRequest');
@@ -99,6 +121,28 @@ function deserialize_wsdaemon_IsWorkspaceExistsResponse(buffer_arg) {
   return daemon_pb.IsWorkspaceExistsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsdaemon_RestoreSnapshotRequest(arg) {
+  if (!(arg instanceof daemon_pb.RestoreSnapshotRequest)) {
+    throw new Error('Expected argument of type wsdaemon.RestoreSnapshotRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_RestoreSnapshotRequest(buffer_arg) {
+  return daemon_pb.RestoreSnapshotRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsdaemon_RestoreSnapshotResponse(arg) {
+  if (!(arg instanceof daemon_pb.RestoreSnapshotResponse)) {
+    throw new Error('Expected argument of type wsdaemon.RestoreSnapshotResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_RestoreSnapshotResponse(buffer_arg) {
+  return daemon_pb.RestoreSnapshotResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsdaemon_TakeSnapshotRequest(arg) {
   if (!(arg instanceof daemon_pb.TakeSnapshotRequest)) {
     throw new Error('Expected argument of type wsdaemon.TakeSnapshotRequest');
@@ -143,6 +187,28 @@ function deserialize_wsdaemon_WaitForInitResponse(buffer_arg) {
   return daemon_pb.WaitForInitResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsdaemon_WorkspaceResourceUsageRequest(arg) {
+  if (!(arg instanceof daemon_pb.WorkspaceResourceUsageRequest)) {
+    throw new Error('Expected argument of type wsdaemon.WorkspaceResourceUsageRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_WorkspaceResourceUsageRequest(buffer_arg) {
+  return daemon_pb.WorkspaceResourceUsageRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsdaemon_WorkspaceResourceUsageResponse(arg) {
+  if (!(arg instanceof daemon_pb.WorkspaceResourceUsageResponse)) {
+    throw new Error('Expected argument of type wsdaemon.WorkspaceResourceUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsdaemon_WorkspaceResourceUsageResponse(buffer_arg) {
+  return daemon_pb.WorkspaceResourceUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 var WorkspaceContentServiceService = exports.WorkspaceContentServiceService = {
   // initWorkspace intialises a new workspace folder in the working area
@@ -219,6 +285,48 @@ backupWorkspace: {
     responseSerialize: serialize_wsdaemon_BackupWorkspaceResponse,
     responseDeserialize: deserialize_wsdaemon_BackupWorkspaceResponse,
   },
+  // RestoreSnapshot downloads a snapshot or backup and unpacks it into a directory of a running workspace
+restoreSnapshot: {
+    path: '/wsdaemon.WorkspaceContentService/RestoreSnapshot',
+    requestStream: false,
+    responseStream: false,
+    requestType: daemon_pb.RestoreSnapshotRequest,
+    responseType: daemon_pb.RestoreSnapshotResponse,
+    requestSerialize: serialize_wsdaemon_RestoreSnapshotRequest,
+    requestDeserialize: deserialize_wsdaemon_RestoreSnapshotRequest,
+    responseSerialize: serialize_wsdaemon_RestoreSnapshotResponse,
+    responseDeserialize: deserialize_wsdaemon_RestoreSnapshotResponse,
+  },
 };
 
 exports.WorkspaceContentServiceClient = grpc.makeGenericClientConstructor(WorkspaceContentServiceService);
+// WorkspaceResourceService provides the resource usage of the workspaces on this node
+var WorkspaceResourceServiceService = exports.WorkspaceResourceServiceService = {
+  // WorkspaceResourceUsage samples the resource usage of a workspace at the requested interval until the workspace stops
+workspaceResourceUsage: {
+    path: '/wsdaemon.WorkspaceResourceService/WorkspaceResourceUsage',
+    requestStream: false,
+    responseStream: true,
+    requestType: daemon_pb.WorkspaceResourceUsageRequest,
+    responseType: daemon_pb.WorkspaceResourceUsageResponse,
+    requestSerialize: serialize_wsdaemon_WorkspaceResourceUsageRequest,
+    requestDeserialize: deserialize_wsdaemon_WorkspaceResourceUsageRequest,
+    responseSerialize: serialize_wsdaemon_WorkspaceResourceUsageResponse,
+    responseDeserialize: deserialize_wsdaemon_WorkspaceResourceUsageResponse,
+  },
+  // GetWorkspaceDiskUsage returns the disk usage of a workspace broken down by where the data lives. The usage is read
+// from the quota accounting of the filesystems rather than by walking them, hence it is cheap to call.
+getWorkspaceDiskUsage: {
+    path: '/wsdaemon.WorkspaceResourceService/GetWorkspaceDiskUsage',
+    requestStream: false,
+    responseStream: false,
+    requestType: daemon_pb.GetWorkspaceDiskUsageRequest,
+    responseType: daemon_pb.GetWorkspaceDiskUsageResponse,
+    requestSerialize: serialize_wsdaemon_GetWorkspaceDiskUsageRequest,
+    requestDeserialize: deserialize_wsdaemon_GetWorkspaceDiskUsageRequest,
+    responseSerialize: serialize_wsdaemon_GetWorkspaceDiskUsageResponse,
+    responseDeserialize: deserialize_wsdaemon_GetWorkspaceDiskUsageResponse,
+  },
+};
+
+exports.WorkspaceResourceServiceClient = grpc.makeGenericClientConstructor(WorkspaceResourceServiceService);
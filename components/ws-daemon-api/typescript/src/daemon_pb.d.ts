@@ -173,6 +173,10 @@ export class TakeSnapshotRequest extends jspb.Message {
     setId(value: string): TakeSnapshotRequest;
     getReturnImmediately(): boolean;
     setReturnImmediately(value: boolean): TakeSnapshotRequest;
+    clearPathsList(): void;
+    getPathsList(): Array<string>;
+    setPathsList(value: Array<string>): TakeSnapshotRequest;
+    addPaths(value: string, index?: number): string;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): TakeSnapshotRequest.AsObject;
@@ -188,6 +192,7 @@ export namespace TakeSnapshotRequest {
     export type AsObject = {
         id: string,
         returnImmediately: boolean,
+        pathsList: Array<string>,
     }
 }
 
@@ -211,6 +216,57 @@ export namespace TakeSnapshotResponse {
     }
 }
 
+export class RestoreSnapshotRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): RestoreSnapshotRequest;
+    getSnapshot(): string;
+    setSnapshot(value: string): RestoreSnapshotRequest;
+    getTarget(): string;
+    setTarget(value: string): RestoreSnapshotRequest;
+    getConflictPolicy(): RestoreConflictPolicy;
+    setConflictPolicy(value: RestoreConflictPolicy): RestoreSnapshotRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RestoreSnapshotRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RestoreSnapshotRequest): RestoreSnapshotRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RestoreSnapshotRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RestoreSnapshotRequest;
+    static deserializeBinaryFromReader(message: RestoreSnapshotRequest, reader: jspb.BinaryReader): RestoreSnapshotRequest;
+}
+
+export namespace RestoreSnapshotRequest {
+    export type AsObject = {
+        id: string,
+        snapshot: string,
+        target: string,
+        conflictPolicy: RestoreConflictPolicy,
+    }
+}
+
+export class RestoreSnapshotResponse extends jspb.Message {
+    clearConflictsList(): void;
+    getConflictsList(): Array<string>;
+    setConflictsList(value: Array<string>): RestoreSnapshotResponse;
+    addConflicts(value: string, index?: number): string;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RestoreSnapshotResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RestoreSnapshotResponse): RestoreSnapshotResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RestoreSnapshotResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RestoreSnapshotResponse;
+    static deserializeBinaryFromReader(message: RestoreSnapshotResponse, reader: jspb.BinaryReader): RestoreSnapshotResponse;
+}
+
+export namespace RestoreSnapshotResponse {
+    export type AsObject = {
+        conflictsList: Array<string>,
+    }
+}
+
 export class DisposeWorkspaceRequest extends jspb.Message {
     getId(): string;
     setId(value: string): DisposeWorkspaceRequest;
@@ -300,6 +356,172 @@ export namespace BackupWorkspaceResponse {
     }
 }
 
+export class WorkspaceResourceUsageRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): WorkspaceResourceUsageRequest;
+    getIntervalMs(): number;
+    setIntervalMs(value: number): WorkspaceResourceUsageRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceResourceUsageRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceResourceUsageRequest): WorkspaceResourceUsageRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceResourceUsageRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceResourceUsageRequest;
+    static deserializeBinaryFromReader(message: WorkspaceResourceUsageRequest, reader: jspb.BinaryReader): WorkspaceResourceUsageRequest;
+}
+
+export namespace WorkspaceResourceUsageRequest {
+    export type AsObject = {
+        id: string,
+        intervalMs: number,
+    }
+}
+
+export class WorkspaceResourceUsageResponse extends jspb.Message {
+    getCpuUsed(): number;
+    setCpuUsed(value: number): WorkspaceResourceUsageResponse;
+    getCpuLimit(): number;
+    setCpuLimit(value: number): WorkspaceResourceUsageResponse;
+    getMemoryUsed(): number;
+    setMemoryUsed(value: number): WorkspaceResourceUsageResponse;
+    getMemoryLimit(): number;
+    setMemoryLimit(value: number): WorkspaceResourceUsageResponse;
+    getDiskUsed(): number;
+    setDiskUsed(value: number): WorkspaceResourceUsageResponse;
+    getDiskLimit(): number;
+    setDiskLimit(value: number): WorkspaceResourceUsageResponse;
+    getNetworkRxBytes(): number;
+    setNetworkRxBytes(value: number): WorkspaceResourceUsageResponse;
+    getNetworkTxBytes(): number;
+    setNetworkTxBytes(value: number): WorkspaceResourceUsageResponse;
+    getCollectedAtMs(): number;
+    setCollectedAtMs(value: number): WorkspaceResourceUsageResponse;
+    getNetworkEgressLimit(): number;
+    setNetworkEgressLimit(value: number): WorkspaceResourceUsageResponse;
+    getNetworkIngressLimit(): number;
+    setNetworkIngressLimit(value: number): WorkspaceResourceUsageResponse;
+    getNetworkEgressDroppedPackets(): number;
+    setNetworkEgressDroppedPackets(value: number): WorkspaceResourceUsageResponse;
+    getNetworkIngressDroppedPackets(): number;
+    setNetworkIngressDroppedPackets(value: number): WorkspaceResourceUsageResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceResourceUsageResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceResourceUsageResponse): WorkspaceResourceUsageResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceResourceUsageResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceResourceUsageResponse;
+    static deserializeBinaryFromReader(message: WorkspaceResourceUsageResponse, reader: jspb.BinaryReader): WorkspaceResourceUsageResponse;
+}
+
+export namespace WorkspaceResourceUsageResponse {
+    export type AsObject = {
+        cpuUsed: number,
+        cpuLimit: number,
+        memoryUsed: number,
+        memoryLimit: number,
+        diskUsed: number,
+        diskLimit: number,
+        networkRxBytes: number,
+        networkTxBytes: number,
+        collectedAtMs: number,
+        networkEgressLimit: number,
+        networkIngressLimit: number,
+        networkEgressDroppedPackets: number,
+        networkIngressDroppedPackets: number,
+    }
+}
+
+export class GetWorkspaceDiskUsageRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): GetWorkspaceDiskUsageRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetWorkspaceDiskUsageRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetWorkspaceDiskUsageRequest): GetWorkspaceDiskUsageRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetWorkspaceDiskUsageRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetWorkspaceDiskUsageRequest;
+    static deserializeBinaryFromReader(message: GetWorkspaceDiskUsageRequest, reader: jspb.BinaryReader): GetWorkspaceDiskUsageRequest;
+}
+
+export namespace GetWorkspaceDiskUsageRequest {
+    export type AsObject = {
+        id: string,
+    }
+}
+
+export class GetWorkspaceDiskUsageResponse extends jspb.Message {
+
+    hasWorkspace(): boolean;
+    clearWorkspace(): void;
+    getWorkspace(): DiskUsage | undefined;
+    setWorkspace(value?: DiskUsage): GetWorkspaceDiskUsageResponse;
+
+    hasTmp(): boolean;
+    clearTmp(): void;
+    getTmp(): DiskUsage | undefined;
+    setTmp(value?: DiskUsage): GetWorkspaceDiskUsageResponse;
+
+    hasScratch(): boolean;
+    clearScratch(): void;
+    getScratch(): DiskUsage | undefined;
+    setScratch(value?: DiskUsage): GetWorkspaceDiskUsageResponse;
+    getCollectedAtMs(): number;
+    setCollectedAtMs(value: number): GetWorkspaceDiskUsageResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetWorkspaceDiskUsageResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetWorkspaceDiskUsageResponse): GetWorkspaceDiskUsageResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetWorkspaceDiskUsageResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetWorkspaceDiskUsageResponse;
+    static deserializeBinaryFromReader(message: GetWorkspaceDiskUsageResponse, reader: jspb.BinaryReader): GetWorkspaceDiskUsageResponse;
+}
+
+export namespace GetWorkspaceDiskUsageResponse {
+    export type AsObject = {
+        workspace?: DiskUsage.AsObject,
+        tmp?: DiskUsage.AsObject,
+        scratch?: DiskUsage.AsObject,
+        collectedAtMs: number,
+    }
+}
+
+export class DiskUsage extends jspb.Message {
+    getUsed(): number;
+    setUsed(value: number): DiskUsage;
+    getLimit(): number;
+    setLimit(value: number): DiskUsage;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DiskUsage.AsObject;
+    static toObject(includeInstance: boolean, msg: DiskUsage): DiskUsage.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DiskUsage, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DiskUsage;
+    static deserializeBinaryFromReader(message: DiskUsage, reader: jspb.BinaryReader): DiskUsage;
+}
+
+export namespace DiskUsage {
+    export type AsObject = {
+        used: number,
+        limit: number,
+    }
+}
+
+export enum RestoreConflictPolicy {
+    RESTORE_CONFLICT_FAIL = 0,
+    RESTORE_CONFLICT_SKIP = 1,
+    RESTORE_CONFLICT_OVERWRITE = 2,
+}
+
 export enum WorkspaceContentState {
     NONE = 0,
     SETTING_UP = 1,
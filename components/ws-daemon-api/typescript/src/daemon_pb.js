@@ -25,18 +25,26 @@ var content$service$api_initializer_pb = require('@gitpod/content-service/lib');
 goog.object.extend(proto, content$service$api_initializer_pb);
 goog.exportSymbol('proto.wsdaemon.BackupWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.BackupWorkspaceResponse', null, global);
+goog.exportSymbol('proto.wsdaemon.DiskUsage', null, global);
 goog.exportSymbol('proto.wsdaemon.DisposeWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.DisposeWorkspaceResponse', null, global);
+goog.exportSymbol('proto.wsdaemon.GetWorkspaceDiskUsageRequest', null, global);
+goog.exportSymbol('proto.wsdaemon.GetWorkspaceDiskUsageResponse', null, global);
 goog.exportSymbol('proto.wsdaemon.InitWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.InitWorkspaceResponse', null, global);
 goog.exportSymbol('proto.wsdaemon.IsWorkspaceExistsRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.IsWorkspaceExistsResponse', null, global);
+goog.exportSymbol('proto.wsdaemon.RestoreConflictPolicy', null, global);
+goog.exportSymbol('proto.wsdaemon.RestoreSnapshotRequest', null, global);
+goog.exportSymbol('proto.wsdaemon.RestoreSnapshotResponse', null, global);
 goog.exportSymbol('proto.wsdaemon.TakeSnapshotRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.TakeSnapshotResponse', null, global);
 goog.exportSymbol('proto.wsdaemon.WaitForInitRequest', null, global);
 goog.exportSymbol('proto.wsdaemon.WaitForInitResponse', null, global);
 goog.exportSymbol('proto.wsdaemon.WorkspaceContentState', null, global);
 goog.exportSymbol('proto.wsdaemon.WorkspaceMetadata', null, global);
+goog.exportSymbol('proto.wsdaemon.WorkspaceResourceUsageRequest', null, global);
+goog.exportSymbol('proto.wsdaemon.WorkspaceResourceUsageResponse', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -195,7 +203,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.wsdaemon.TakeSnapshotRequest = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsdaemon.TakeSnapshotRequest.repeatedFields_, null);
 };
 goog.inherits(proto.wsdaemon.TakeSnapshotRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -226,6 +234,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsdaemon.TakeSnapshotResponse.displayName = 'proto.wsdaemon.TakeSnapshotResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.RestoreSnapshotRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.RestoreSnapshotRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.RestoreSnapshotRequest.displayName = 'proto.wsdaemon.RestoreSnapshotRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.RestoreSnapshotResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsdaemon.RestoreSnapshotResponse.repeatedFields_, null);
+};
+goog.inherits(proto.wsdaemon.RestoreSnapshotResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.RestoreSnapshotResponse.displayName = 'proto.wsdaemon.RestoreSnapshotResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -310,6 +360,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsdaemon.BackupWorkspaceResponse.displayName = 'proto.wsdaemon.BackupWorkspaceResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.WorkspaceResourceUsageRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.WorkspaceResourceUsageRequest.displayName = 'proto.wsdaemon.WorkspaceResourceUsageRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.WorkspaceResourceUsageResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.WorkspaceResourceUsageResponse.displayName = 'proto.wsdaemon.WorkspaceResourceUsageResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.GetWorkspaceDiskUsageRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.GetWorkspaceDiskUsageRequest.displayName = 'proto.wsdaemon.GetWorkspaceDiskUsageRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.GetWorkspaceDiskUsageResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.GetWorkspaceDiskUsageResponse.displayName = 'proto.wsdaemon.GetWorkspaceDiskUsageResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsdaemon.DiskUsage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsdaemon.DiskUsage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsdaemon.DiskUsage.displayName = 'proto.wsdaemon.DiskUsage';
+}
 
 
 
@@ -1355,6 +1510,13 @@ proto.wsdaemon.IsWorkspaceExistsResponse.prototype.setExists = function(value) {
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsdaemon.TakeSnapshotRequest.repeatedFields_ = [3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1387,7 +1549,8 @@ proto.wsdaemon.TakeSnapshotRequest.prototype.toObject = function(opt_includeInst
 proto.wsdaemon.TakeSnapshotRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    returnImmediately: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+    returnImmediately: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    pathsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1432,6 +1595,10 @@ proto.wsdaemon.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, r
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setReturnImmediately(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPaths(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1475,6 +1642,13 @@ proto.wsdaemon.TakeSnapshotRequest.serializeBinaryToWriter = function(message, w
       f
     );
   }
+  f = message.getPathsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -1514,6 +1688,43 @@ proto.wsdaemon.TakeSnapshotRequest.prototype.setReturnImmediately = function(val
 };
 
 
+/**
+ * repeated string paths = 3;
+ * @return {!Array<string>}
+ */
+proto.wsdaemon.TakeSnapshotRequest.prototype.getPathsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsdaemon.TakeSnapshotRequest} returns this
+ */
+proto.wsdaemon.TakeSnapshotRequest.prototype.setPathsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsdaemon.TakeSnapshotRequest} returns this
+ */
+proto.wsdaemon.TakeSnapshotRequest.prototype.addPaths = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsdaemon.TakeSnapshotRequest} returns this
+ */
+proto.wsdaemon.TakeSnapshotRequest.prototype.clearPathsList = function() {
+  return this.setPathsList([]);
+};
+
+
 
 
 
@@ -1660,8 +1871,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsdaemon.DisposeWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsdaemon.DisposeWorkspaceRequest.toObject(opt_includeInstance, this);
+proto.wsdaemon.RestoreSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.RestoreSnapshotRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1670,15 +1881,16 @@ proto.wsdaemon.DisposeWorkspaceRequest.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsdaemon.DisposeWorkspaceRequest} msg The msg instance to transform.
+ * @param {!proto.wsdaemon.RestoreSnapshotRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsdaemon.DisposeWorkspaceRequest.toObject = function(includeInstance, msg) {
+proto.wsdaemon.RestoreSnapshotRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    backup: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    backupLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    snapshot: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    target: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    conflictPolicy: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -1692,23 +1904,23 @@ proto.wsdaemon.DisposeWorkspaceRequest.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsdaemon.DisposeWorkspaceRequest}
+ * @return {!proto.wsdaemon.RestoreSnapshotRequest}
  */
-proto.wsdaemon.DisposeWorkspaceRequest.deserializeBinary = function(bytes) {
+proto.wsdaemon.RestoreSnapshotRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsdaemon.DisposeWorkspaceRequest;
-  return proto.wsdaemon.DisposeWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsdaemon.RestoreSnapshotRequest;
+  return proto.wsdaemon.RestoreSnapshotRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsdaemon.DisposeWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!proto.wsdaemon.RestoreSnapshotRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsdaemon.DisposeWorkspaceRequest}
+ * @return {!proto.wsdaemon.RestoreSnapshotRequest}
  */
-proto.wsdaemon.DisposeWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsdaemon.RestoreSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1720,12 +1932,16 @@ proto.wsdaemon.DisposeWorkspaceRequest.deserializeBinaryFromReader = function(ms
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setBackup(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSnapshot(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setBackupLogs(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTarget(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.wsdaemon.RestoreConflictPolicy} */ (reader.readEnum());
+      msg.setConflictPolicy(value);
       break;
     default:
       reader.skipField();
@@ -1740,9 +1956,9 @@ proto.wsdaemon.DisposeWorkspaceRequest.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsdaemon.DisposeWorkspaceRequest.prototype.serializeBinary = function() {
+proto.wsdaemon.RestoreSnapshotRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsdaemon.DisposeWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  proto.wsdaemon.RestoreSnapshotRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1750,11 +1966,11 @@ proto.wsdaemon.DisposeWorkspaceRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsdaemon.DisposeWorkspaceRequest} message
+ * @param {!proto.wsdaemon.RestoreSnapshotRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsdaemon.DisposeWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsdaemon.RestoreSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -1763,20 +1979,27 @@ proto.wsdaemon.DisposeWorkspaceRequest.serializeBinaryToWriter = function(messag
       f
     );
   }
-  f = message.getBackup();
-  if (f) {
-    writer.writeBool(
+  f = message.getSnapshot();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getBackupLogs();
-  if (f) {
-    writer.writeBool(
+  f = message.getTarget();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
+  f = message.getConflictPolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -1784,57 +2007,82 @@ proto.wsdaemon.DisposeWorkspaceRequest.serializeBinaryToWriter = function(messag
  * optional string id = 1;
  * @return {string}
  */
-proto.wsdaemon.DisposeWorkspaceRequest.prototype.getId = function() {
+proto.wsdaemon.RestoreSnapshotRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsdaemon.DisposeWorkspaceRequest} returns this
+ * @return {!proto.wsdaemon.RestoreSnapshotRequest} returns this
  */
-proto.wsdaemon.DisposeWorkspaceRequest.prototype.setId = function(value) {
+proto.wsdaemon.RestoreSnapshotRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool backup = 2;
- * @return {boolean}
+ * optional string snapshot = 2;
+ * @return {string}
  */
-proto.wsdaemon.DisposeWorkspaceRequest.prototype.getBackup = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.wsdaemon.RestoreSnapshotRequest.prototype.getSnapshot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.wsdaemon.DisposeWorkspaceRequest} returns this
+ * @param {string} value
+ * @return {!proto.wsdaemon.RestoreSnapshotRequest} returns this
  */
-proto.wsdaemon.DisposeWorkspaceRequest.prototype.setBackup = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.wsdaemon.RestoreSnapshotRequest.prototype.setSnapshot = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional bool backup_logs = 3;
- * @return {boolean}
+ * optional string target = 3;
+ * @return {string}
  */
-proto.wsdaemon.DisposeWorkspaceRequest.prototype.getBackupLogs = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.wsdaemon.RestoreSnapshotRequest.prototype.getTarget = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.wsdaemon.DisposeWorkspaceRequest} returns this
+ * @param {string} value
+ * @return {!proto.wsdaemon.RestoreSnapshotRequest} returns this
  */
-proto.wsdaemon.DisposeWorkspaceRequest.prototype.setBackupLogs = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.wsdaemon.RestoreSnapshotRequest.prototype.setTarget = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional RestoreConflictPolicy conflict_policy = 4;
+ * @return {!proto.wsdaemon.RestoreConflictPolicy}
+ */
+proto.wsdaemon.RestoreSnapshotRequest.prototype.getConflictPolicy = function() {
+  return /** @type {!proto.wsdaemon.RestoreConflictPolicy} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.wsdaemon.RestoreConflictPolicy} value
+ * @return {!proto.wsdaemon.RestoreSnapshotRequest} returns this
+ */
+proto.wsdaemon.RestoreSnapshotRequest.prototype.setConflictPolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsdaemon.RestoreSnapshotResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1850,8 +2098,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsdaemon.DisposeWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsdaemon.DisposeWorkspaceResponse.toObject(opt_includeInstance, this);
+proto.wsdaemon.RestoreSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.RestoreSnapshotResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1860,13 +2108,13 @@ proto.wsdaemon.DisposeWorkspaceResponse.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsdaemon.DisposeWorkspaceResponse} msg The msg instance to transform.
+ * @param {!proto.wsdaemon.RestoreSnapshotResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsdaemon.DisposeWorkspaceResponse.toObject = function(includeInstance, msg) {
+proto.wsdaemon.RestoreSnapshotResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    gitStatus: (f = msg.getGitStatus()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f)
+    conflictsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -1880,23 +2128,23 @@ proto.wsdaemon.DisposeWorkspaceResponse.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsdaemon.DisposeWorkspaceResponse}
+ * @return {!proto.wsdaemon.RestoreSnapshotResponse}
  */
-proto.wsdaemon.DisposeWorkspaceResponse.deserializeBinary = function(bytes) {
+proto.wsdaemon.RestoreSnapshotResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsdaemon.DisposeWorkspaceResponse;
-  return proto.wsdaemon.DisposeWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsdaemon.RestoreSnapshotResponse;
+  return proto.wsdaemon.RestoreSnapshotResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsdaemon.DisposeWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!proto.wsdaemon.RestoreSnapshotResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsdaemon.DisposeWorkspaceResponse}
+ * @return {!proto.wsdaemon.RestoreSnapshotResponse}
  */
-proto.wsdaemon.DisposeWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsdaemon.RestoreSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1904,9 +2152,8 @@ proto.wsdaemon.DisposeWorkspaceResponse.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new content$service$api_initializer_pb.GitStatus;
-      reader.readMessage(value,content$service$api_initializer_pb.GitStatus.deserializeBinaryFromReader);
-      msg.setGitStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addConflicts(value);
       break;
     default:
       reader.skipField();
@@ -1921,9 +2168,9 @@ proto.wsdaemon.DisposeWorkspaceResponse.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsdaemon.DisposeWorkspaceResponse.prototype.serializeBinary = function() {
+proto.wsdaemon.RestoreSnapshotResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsdaemon.DisposeWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  proto.wsdaemon.RestoreSnapshotResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1931,57 +2178,56 @@ proto.wsdaemon.DisposeWorkspaceResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsdaemon.DisposeWorkspaceResponse} message
+ * @param {!proto.wsdaemon.RestoreSnapshotResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsdaemon.DisposeWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsdaemon.RestoreSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getGitStatus();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getConflictsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       1,
-      f,
-      content$service$api_initializer_pb.GitStatus.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional contentservice.GitStatus git_status = 1;
- * @return {?proto.contentservice.GitStatus}
+ * repeated string conflicts = 1;
+ * @return {!Array<string>}
  */
-proto.wsdaemon.DisposeWorkspaceResponse.prototype.getGitStatus = function() {
-  return /** @type{?proto.contentservice.GitStatus} */ (
-    jspb.Message.getWrapperField(this, content$service$api_initializer_pb.GitStatus, 1));
+proto.wsdaemon.RestoreSnapshotResponse.prototype.getConflictsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {?proto.contentservice.GitStatus|undefined} value
- * @return {!proto.wsdaemon.DisposeWorkspaceResponse} returns this
-*/
-proto.wsdaemon.DisposeWorkspaceResponse.prototype.setGitStatus = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {!Array<string>} value
+ * @return {!proto.wsdaemon.RestoreSnapshotResponse} returns this
+ */
+proto.wsdaemon.RestoreSnapshotResponse.prototype.setConflictsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsdaemon.DisposeWorkspaceResponse} returns this
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsdaemon.RestoreSnapshotResponse} returns this
  */
-proto.wsdaemon.DisposeWorkspaceResponse.prototype.clearGitStatus = function() {
-  return this.setGitStatus(undefined);
+proto.wsdaemon.RestoreSnapshotResponse.prototype.addConflicts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsdaemon.RestoreSnapshotResponse} returns this
  */
-proto.wsdaemon.DisposeWorkspaceResponse.prototype.hasGitStatus = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.wsdaemon.RestoreSnapshotResponse.prototype.clearConflictsList = function() {
+  return this.setConflictsList([]);
 };
 
 
@@ -2001,8 +2247,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsdaemon.BackupWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsdaemon.BackupWorkspaceRequest.toObject(opt_includeInstance, this);
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.DisposeWorkspaceRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2011,13 +2257,15 @@ proto.wsdaemon.BackupWorkspaceRequest.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsdaemon.BackupWorkspaceRequest} msg The msg instance to transform.
+ * @param {!proto.wsdaemon.DisposeWorkspaceRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsdaemon.BackupWorkspaceRequest.toObject = function(includeInstance, msg) {
+proto.wsdaemon.DisposeWorkspaceRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    backup: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    backupLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -2031,23 +2279,23 @@ proto.wsdaemon.BackupWorkspaceRequest.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsdaemon.BackupWorkspaceRequest}
+ * @return {!proto.wsdaemon.DisposeWorkspaceRequest}
  */
-proto.wsdaemon.BackupWorkspaceRequest.deserializeBinary = function(bytes) {
+proto.wsdaemon.DisposeWorkspaceRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsdaemon.BackupWorkspaceRequest;
-  return proto.wsdaemon.BackupWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsdaemon.DisposeWorkspaceRequest;
+  return proto.wsdaemon.DisposeWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsdaemon.BackupWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!proto.wsdaemon.DisposeWorkspaceRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsdaemon.BackupWorkspaceRequest}
+ * @return {!proto.wsdaemon.DisposeWorkspaceRequest}
  */
-proto.wsdaemon.BackupWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsdaemon.DisposeWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2058,6 +2306,14 @@ proto.wsdaemon.BackupWorkspaceRequest.deserializeBinaryFromReader = function(msg
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setBackup(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setBackupLogs(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2071,9 +2327,9 @@ proto.wsdaemon.BackupWorkspaceRequest.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsdaemon.BackupWorkspaceRequest.prototype.serializeBinary = function() {
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsdaemon.BackupWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  proto.wsdaemon.DisposeWorkspaceRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2081,11 +2337,11 @@ proto.wsdaemon.BackupWorkspaceRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsdaemon.BackupWorkspaceRequest} message
+ * @param {!proto.wsdaemon.DisposeWorkspaceRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsdaemon.BackupWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsdaemon.DisposeWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -2094,6 +2350,20 @@ proto.wsdaemon.BackupWorkspaceRequest.serializeBinaryToWriter = function(message
       f
     );
   }
+  f = message.getBackup();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getBackupLogs();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -2101,20 +2371,56 @@ proto.wsdaemon.BackupWorkspaceRequest.serializeBinaryToWriter = function(message
  * optional string id = 1;
  * @return {string}
  */
-proto.wsdaemon.BackupWorkspaceRequest.prototype.getId = function() {
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsdaemon.BackupWorkspaceRequest} returns this
+ * @return {!proto.wsdaemon.DisposeWorkspaceRequest} returns this
  */
-proto.wsdaemon.BackupWorkspaceRequest.prototype.setId = function(value) {
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional bool backup = 2;
+ * @return {boolean}
+ */
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.getBackup = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsdaemon.DisposeWorkspaceRequest} returns this
+ */
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.setBackup = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool backup_logs = 3;
+ * @return {boolean}
+ */
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.getBackupLogs = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsdaemon.DisposeWorkspaceRequest} returns this
+ */
+proto.wsdaemon.DisposeWorkspaceRequest.prototype.setBackupLogs = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 
 
@@ -2131,8 +2437,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsdaemon.BackupWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsdaemon.BackupWorkspaceResponse.toObject(opt_includeInstance, this);
+proto.wsdaemon.DisposeWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.DisposeWorkspaceResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2141,13 +2447,13 @@ proto.wsdaemon.BackupWorkspaceResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsdaemon.BackupWorkspaceResponse} msg The msg instance to transform.
+ * @param {!proto.wsdaemon.DisposeWorkspaceResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsdaemon.BackupWorkspaceResponse.toObject = function(includeInstance, msg) {
+proto.wsdaemon.DisposeWorkspaceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, "")
+    gitStatus: (f = msg.getGitStatus()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -2161,23 +2467,23 @@ proto.wsdaemon.BackupWorkspaceResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsdaemon.BackupWorkspaceResponse}
+ * @return {!proto.wsdaemon.DisposeWorkspaceResponse}
  */
-proto.wsdaemon.BackupWorkspaceResponse.deserializeBinary = function(bytes) {
+proto.wsdaemon.DisposeWorkspaceResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsdaemon.BackupWorkspaceResponse;
-  return proto.wsdaemon.BackupWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsdaemon.DisposeWorkspaceResponse;
+  return proto.wsdaemon.DisposeWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsdaemon.BackupWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!proto.wsdaemon.DisposeWorkspaceResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsdaemon.BackupWorkspaceResponse}
+ * @return {!proto.wsdaemon.DisposeWorkspaceResponse}
  */
-proto.wsdaemon.BackupWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsdaemon.DisposeWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2185,8 +2491,9 @@ proto.wsdaemon.BackupWorkspaceResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      var value = new content$service$api_initializer_pb.GitStatus;
+      reader.readMessage(value,content$service$api_initializer_pb.GitStatus.deserializeBinaryFromReader);
+      msg.setGitStatus(value);
       break;
     default:
       reader.skipField();
@@ -2201,9 +2508,9 @@ proto.wsdaemon.BackupWorkspaceResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsdaemon.BackupWorkspaceResponse.prototype.serializeBinary = function() {
+proto.wsdaemon.DisposeWorkspaceResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsdaemon.BackupWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  proto.wsdaemon.DisposeWorkspaceResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2211,39 +2518,1551 @@ proto.wsdaemon.BackupWorkspaceResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsdaemon.BackupWorkspaceResponse} message
+ * @param {!proto.wsdaemon.DisposeWorkspaceResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsdaemon.BackupWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsdaemon.DisposeWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getGitStatus();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      content$service$api_initializer_pb.GitStatus.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string url = 1;
- * @return {string}
+ * optional contentservice.GitStatus git_status = 1;
+ * @return {?proto.contentservice.GitStatus}
  */
-proto.wsdaemon.BackupWorkspaceResponse.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsdaemon.DisposeWorkspaceResponse.prototype.getGitStatus = function() {
+  return /** @type{?proto.contentservice.GitStatus} */ (
+    jspb.Message.getWrapperField(this, content$service$api_initializer_pb.GitStatus, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsdaemon.BackupWorkspaceResponse} returns this
+ * @param {?proto.contentservice.GitStatus|undefined} value
+ * @return {!proto.wsdaemon.DisposeWorkspaceResponse} returns this
+*/
+proto.wsdaemon.DisposeWorkspaceResponse.prototype.setGitStatus = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsdaemon.DisposeWorkspaceResponse} returns this
  */
-proto.wsdaemon.BackupWorkspaceResponse.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsdaemon.DisposeWorkspaceResponse.prototype.clearGitStatus = function() {
+  return this.setGitStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.DisposeWorkspaceResponse.prototype.hasGitStatus = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.BackupWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.BackupWorkspaceRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.BackupWorkspaceRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.BackupWorkspaceRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
+
 
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.BackupWorkspaceRequest}
+ */
+proto.wsdaemon.BackupWorkspaceRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.BackupWorkspaceRequest;
+  return proto.wsdaemon.BackupWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.BackupWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.BackupWorkspaceRequest}
+ */
+proto.wsdaemon.BackupWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.BackupWorkspaceRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.BackupWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.BackupWorkspaceRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.BackupWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsdaemon.BackupWorkspaceRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsdaemon.BackupWorkspaceRequest} returns this
+ */
+proto.wsdaemon.BackupWorkspaceRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.BackupWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.BackupWorkspaceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.BackupWorkspaceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.BackupWorkspaceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    url: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.BackupWorkspaceResponse}
+ */
+proto.wsdaemon.BackupWorkspaceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.BackupWorkspaceResponse;
+  return proto.wsdaemon.BackupWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.BackupWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.BackupWorkspaceResponse}
+ */
+proto.wsdaemon.BackupWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.BackupWorkspaceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.BackupWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.BackupWorkspaceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.BackupWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string url = 1;
+ * @return {string}
+ */
+proto.wsdaemon.BackupWorkspaceResponse.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsdaemon.BackupWorkspaceResponse} returns this
+ */
+proto.wsdaemon.BackupWorkspaceResponse.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.WorkspaceResourceUsageRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.WorkspaceResourceUsageRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    intervalMs: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageRequest}
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.WorkspaceResourceUsageRequest;
+  return proto.wsdaemon.WorkspaceResourceUsageRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.WorkspaceResourceUsageRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageRequest}
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setIntervalMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.WorkspaceResourceUsageRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.WorkspaceResourceUsageRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getIntervalMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageRequest} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 interval_ms = 2;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.prototype.getIntervalMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageRequest} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageRequest.prototype.setIntervalMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.WorkspaceResourceUsageResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.WorkspaceResourceUsageResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    cpuUsed: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    cpuLimit: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    memoryUsed: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    memoryLimit: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    diskUsed: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    diskLimit: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    networkRxBytes: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    networkTxBytes: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    collectedAtMs: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    networkEgressLimit: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    networkIngressLimit: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    networkEgressDroppedPackets: jspb.Message.getFieldWithDefault(msg, 12, 0),
+    networkIngressDroppedPackets: jspb.Message.getFieldWithDefault(msg, 13, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.WorkspaceResourceUsageResponse;
+  return proto.wsdaemon.WorkspaceResourceUsageResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.WorkspaceResourceUsageResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCpuUsed(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCpuLimit(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMemoryUsed(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setMemoryLimit(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDiskUsed(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setDiskLimit(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNetworkRxBytes(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNetworkTxBytes(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCollectedAtMs(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNetworkEgressLimit(value);
+      break;
+    case 11:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNetworkIngressLimit(value);
+      break;
+    case 12:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNetworkEgressDroppedPackets(value);
+      break;
+    case 13:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNetworkIngressDroppedPackets(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.WorkspaceResourceUsageResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.WorkspaceResourceUsageResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCpuUsed();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getCpuLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getMemoryUsed();
+  if (f !== 0) {
+    writer.writeInt64(
+      3,
+      f
+    );
+  }
+  f = message.getMemoryLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getDiskUsed();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getDiskLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getNetworkRxBytes();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+  f = message.getNetworkTxBytes();
+  if (f !== 0) {
+    writer.writeInt64(
+      8,
+      f
+    );
+  }
+  f = message.getCollectedAtMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+  f = message.getNetworkEgressLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      10,
+      f
+    );
+  }
+  f = message.getNetworkIngressLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      11,
+      f
+    );
+  }
+  f = message.getNetworkEgressDroppedPackets();
+  if (f !== 0) {
+    writer.writeInt64(
+      12,
+      f
+    );
+  }
+  f = message.getNetworkIngressDroppedPackets();
+  if (f !== 0) {
+    writer.writeInt64(
+      13,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 cpu_used = 1;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getCpuUsed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setCpuUsed = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 cpu_limit = 2;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getCpuLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setCpuLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int64 memory_used = 3;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getMemoryUsed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setMemoryUsed = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 memory_limit = 4;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getMemoryLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setMemoryLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int64 disk_used = 5;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getDiskUsed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setDiskUsed = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional int64 disk_limit = 6;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getDiskLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setDiskLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int64 network_rx_bytes = 7;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getNetworkRxBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setNetworkRxBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional int64 network_tx_bytes = 8;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getNetworkTxBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setNetworkTxBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional int64 collected_at_ms = 9;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getCollectedAtMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setCollectedAtMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional int64 network_egress_limit = 10;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getNetworkEgressLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setNetworkEgressLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+/**
+ * optional int64 network_ingress_limit = 11;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getNetworkIngressLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setNetworkIngressLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 11, value);
+};
+
+
+/**
+ * optional int64 network_egress_dropped_packets = 12;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getNetworkEgressDroppedPackets = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 12, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setNetworkEgressDroppedPackets = function(value) {
+  return jspb.Message.setProto3IntField(this, 12, value);
+};
+
+
+/**
+ * optional int64 network_ingress_dropped_packets = 13;
+ * @return {number}
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.getNetworkIngressDroppedPackets = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.WorkspaceResourceUsageResponse} returns this
+ */
+proto.wsdaemon.WorkspaceResourceUsageResponse.prototype.setNetworkIngressDroppedPackets = function(value) {
+  return jspb.Message.setProto3IntField(this, 13, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.GetWorkspaceDiskUsageRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.GetWorkspaceDiskUsageRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageRequest}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.GetWorkspaceDiskUsageRequest;
+  return proto.wsdaemon.GetWorkspaceDiskUsageRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.GetWorkspaceDiskUsageRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageRequest}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.GetWorkspaceDiskUsageRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.GetWorkspaceDiskUsageRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageRequest} returns this
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.GetWorkspaceDiskUsageResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    workspace: (f = msg.getWorkspace()) && proto.wsdaemon.DiskUsage.toObject(includeInstance, f),
+    tmp: (f = msg.getTmp()) && proto.wsdaemon.DiskUsage.toObject(includeInstance, f),
+    scratch: (f = msg.getScratch()) && proto.wsdaemon.DiskUsage.toObject(includeInstance, f),
+    collectedAtMs: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageResponse}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.GetWorkspaceDiskUsageResponse;
+  return proto.wsdaemon.GetWorkspaceDiskUsageResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageResponse}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsdaemon.DiskUsage;
+      reader.readMessage(value,proto.wsdaemon.DiskUsage.deserializeBinaryFromReader);
+      msg.setWorkspace(value);
+      break;
+    case 2:
+      var value = new proto.wsdaemon.DiskUsage;
+      reader.readMessage(value,proto.wsdaemon.DiskUsage.deserializeBinaryFromReader);
+      msg.setTmp(value);
+      break;
+    case 3:
+      var value = new proto.wsdaemon.DiskUsage;
+      reader.readMessage(value,proto.wsdaemon.DiskUsage.deserializeBinaryFromReader);
+      msg.setScratch(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCollectedAtMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.GetWorkspaceDiskUsageResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWorkspace();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.wsdaemon.DiskUsage.serializeBinaryToWriter
+    );
+  }
+  f = message.getTmp();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.wsdaemon.DiskUsage.serializeBinaryToWriter
+    );
+  }
+  f = message.getScratch();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsdaemon.DiskUsage.serializeBinaryToWriter
+    );
+  }
+  f = message.getCollectedAtMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional DiskUsage workspace = 1;
+ * @return {?proto.wsdaemon.DiskUsage}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.getWorkspace = function() {
+  return /** @type{?proto.wsdaemon.DiskUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.DiskUsage, 1));
+};
+
+
+/**
+ * @param {?proto.wsdaemon.DiskUsage|undefined} value
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} returns this
+*/
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.setWorkspace = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} returns this
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.clearWorkspace = function() {
+  return this.setWorkspace(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.hasWorkspace = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional DiskUsage tmp = 2;
+ * @return {?proto.wsdaemon.DiskUsage}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.getTmp = function() {
+  return /** @type{?proto.wsdaemon.DiskUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.DiskUsage, 2));
+};
+
+
+/**
+ * @param {?proto.wsdaemon.DiskUsage|undefined} value
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} returns this
+*/
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.setTmp = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} returns this
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.clearTmp = function() {
+  return this.setTmp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.hasTmp = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional DiskUsage scratch = 3;
+ * @return {?proto.wsdaemon.DiskUsage}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.getScratch = function() {
+  return /** @type{?proto.wsdaemon.DiskUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsdaemon.DiskUsage, 3));
+};
+
+
+/**
+ * @param {?proto.wsdaemon.DiskUsage|undefined} value
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} returns this
+*/
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.setScratch = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} returns this
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.clearScratch = function() {
+  return this.setScratch(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.hasScratch = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional int64 collected_at_ms = 4;
+ * @return {number}
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.getCollectedAtMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.GetWorkspaceDiskUsageResponse} returns this
+ */
+proto.wsdaemon.GetWorkspaceDiskUsageResponse.prototype.setCollectedAtMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsdaemon.DiskUsage.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsdaemon.DiskUsage.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsdaemon.DiskUsage} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.DiskUsage.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    used: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsdaemon.DiskUsage}
+ */
+proto.wsdaemon.DiskUsage.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsdaemon.DiskUsage;
+  return proto.wsdaemon.DiskUsage.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsdaemon.DiskUsage} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsdaemon.DiskUsage}
+ */
+proto.wsdaemon.DiskUsage.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUsed(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLimit(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsdaemon.DiskUsage.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsdaemon.DiskUsage.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsdaemon.DiskUsage} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsdaemon.DiskUsage.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsed();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 used = 1;
+ * @return {number}
+ */
+proto.wsdaemon.DiskUsage.prototype.getUsed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.DiskUsage} returns this
+ */
+proto.wsdaemon.DiskUsage.prototype.setUsed = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 limit = 2;
+ * @return {number}
+ */
+proto.wsdaemon.DiskUsage.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsdaemon.DiskUsage} returns this
+ */
+proto.wsdaemon.DiskUsage.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.wsdaemon.RestoreConflictPolicy = {
+  RESTORE_CONFLICT_FAIL: 0,
+  RESTORE_CONFLICT_SKIP: 1,
+  RESTORE_CONFLICT_OVERWRITE: 2
+};
 
 /**
  * @enum {number}
@@ -457,6 +457,11 @@ export class Resources extends jspb.Message {
     getMemory(): Memory | undefined;
     setMemory(value?: Memory): Resources;
 
+    hasDisk(): boolean;
+    clearDisk(): void;
+    getDisk(): Disk | undefined;
+    setDisk(value?: Disk): Resources;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): Resources.AsObject;
     static toObject(includeInstance: boolean, msg: Resources): Resources.AsObject;
@@ -471,6 +476,7 @@ export namespace Resources {
     export type AsObject = {
         cpu?: Cpu.AsObject,
         memory?: Memory.AsObject,
+        disk?: Disk.AsObject,
     }
 }
 
@@ -520,6 +526,29 @@ export namespace Memory {
     }
 }
 
+export class Disk extends jspb.Message {
+    getUsed(): number;
+    setUsed(value: number): Disk;
+    getLimit(): number;
+    setLimit(value: number): Disk;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Disk.AsObject;
+    static toObject(includeInstance: boolean, msg: Disk): Disk.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Disk, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Disk;
+    static deserializeBinaryFromReader(message: Disk, reader: jspb.BinaryReader): Disk;
+}
+
+export namespace Disk {
+    export type AsObject = {
+        used: number,
+        limit: number,
+    }
+}
+
 export enum FSShiftMethod {
     SHIFTFS = 0,
 }
@@ -22,6 +22,7 @@ var goog = jspb;
 var global = (function() { return this || window || global || self || Function('return this')(); }).call(null);
 
 goog.exportSymbol('proto.iws.Cpu', null, global);
+goog.exportSymbol('proto.iws.Disk', null, global);
 goog.exportSymbol('proto.iws.EvacuateCGroupRequest', null, global);
 goog.exportSymbol('proto.iws.EvacuateCGroupResponse', null, global);
 goog.exportSymbol('proto.iws.FSShiftMethod', null, global);
@@ -550,6 +551,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.iws.Memory.displayName = 'proto.iws.Memory';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.iws.Disk = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.iws.Disk, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.iws.Disk.displayName = 'proto.iws.Disk';
+}
 
 
 
@@ -3434,7 +3456,8 @@ proto.iws.Resources.prototype.toObject = function(opt_includeInstance) {
 proto.iws.Resources.toObject = function(includeInstance, msg) {
   var f, obj = {
     cpu: (f = msg.getCpu()) && proto.iws.Cpu.toObject(includeInstance, f),
-    memory: (f = msg.getMemory()) && proto.iws.Memory.toObject(includeInstance, f)
+    memory: (f = msg.getMemory()) && proto.iws.Memory.toObject(includeInstance, f),
+    disk: (f = msg.getDisk()) && proto.iws.Disk.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3481,6 +3504,11 @@ proto.iws.Resources.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.iws.Memory.deserializeBinaryFromReader);
       msg.setMemory(value);
       break;
+    case 3:
+      var value = new proto.iws.Disk;
+      reader.readMessage(value,proto.iws.Disk.deserializeBinaryFromReader);
+      msg.setDisk(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3526,6 +3554,14 @@ proto.iws.Resources.serializeBinaryToWriter = function(message, writer) {
       proto.iws.Memory.serializeBinaryToWriter
     );
   }
+  f = message.getDisk();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.iws.Disk.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -3603,6 +3639,43 @@ proto.iws.Resources.prototype.hasMemory = function() {
 };
 
 
+/**
+ * optional Disk disk = 3;
+ * @return {?proto.iws.Disk}
+ */
+proto.iws.Resources.prototype.getDisk = function() {
+  return /** @type{?proto.iws.Disk} */ (
+    jspb.Message.getWrapperField(this, proto.iws.Disk, 3));
+};
+
+
+/**
+ * @param {?proto.iws.Disk|undefined} value
+ * @return {!proto.iws.Resources} returns this
+*/
+proto.iws.Resources.prototype.setDisk = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.iws.Resources} returns this
+ */
+proto.iws.Resources.prototype.clearDisk = function() {
+  return this.setDisk(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.iws.Resources.prototype.hasDisk = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -3923,6 +3996,166 @@ proto.iws.Memory.prototype.setLimit = function(value) {
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.iws.Disk.prototype.toObject = function(opt_includeInstance) {
+  return proto.iws.Disk.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.iws.Disk} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.iws.Disk.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    used: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.iws.Disk}
+ */
+proto.iws.Disk.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.iws.Disk;
+  return proto.iws.Disk.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.iws.Disk} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.iws.Disk}
+ */
+proto.iws.Disk.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUsed(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLimit(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.iws.Disk.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.iws.Disk.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.iws.Disk} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.iws.Disk.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUsed();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 used = 1;
+ * @return {number}
+ */
+proto.iws.Disk.prototype.getUsed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.iws.Disk} returns this
+ */
+proto.iws.Disk.prototype.setUsed = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 limit = 2;
+ * @return {number}
+ */
+proto.iws.Disk.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.iws.Disk} returns this
+ */
+proto.iws.Disk.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
 /**
  * @enum {number}
  */
@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/alecthomas/repr"
+	"github.com/spf13/cobra"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+)
+
+var clientRestoreOpts struct {
+	Target         string
+	ConflictPolicy string
+}
+
+// clientRestoreCmd restores a snapshot into a running workspace
+var clientRestoreCmd = &cobra.Command{
+	Use:   "restore <id> <snapshot>",
+	Short: "restores a snapshot into a running workspace",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		policy, ok := api.RestoreConflictPolicy_value["RESTORE_CONFLICT_"+strings.ToUpper(clientRestoreOpts.ConflictPolicy)]
+		if !ok {
+			log.WithField("policy", clientRestoreOpts.ConflictPolicy).Fatal("unknown conflict policy")
+		}
+		req := api.RestoreSnapshotRequest{
+			Id:             args[0],
+			Snapshot:       args[1],
+			Target:         clientRestoreOpts.Target,
+			ConflictPolicy: api.RestoreConflictPolicy(policy),
+		}
+
+		conn, err := getGRPCConnection()
+		if err != nil {
+			log.WithError(err).Fatal("cannot connect")
+		}
+		defer conn.Close()
+
+		client := api.NewWorkspaceContentServiceClient(conn)
+		ctx := context.Background()
+
+		resp, err := client.RestoreSnapshot(ctx, &req)
+		if err != nil {
+			log.WithError(err).Fatal("error during RPC call")
+		}
+
+		repr.Println(resp)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(clientRestoreCmd)
+	clientRestoreCmd.Flags().StringVar(&clientRestoreOpts.Target, "target", "", "directory relative to the workspace location to restore into")
+	clientRestoreCmd.Flags().StringVar(&clientRestoreOpts.ConflictPolicy, "conflict-policy", "fail", "what to do with existing files: fail, skip or overwrite")
+}
@@ -14,13 +14,15 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/api"
 )
 
+var clientSnapshotPaths []string
+
 // clientSnapshotCmd creates a workspace snapshot
 var clientSnapshotCmd = &cobra.Command{
 	Use:   "snapshot <id>",
 	Short: "snapshots a workspace",
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		req := api.TakeSnapshotRequest{Id: args[0]}
+		req := api.TakeSnapshotRequest{Id: args[0], Paths: clientSnapshotPaths}
 
 		conn, err := getGRPCConnection()
 		if err != nil {
@@ -42,4 +44,5 @@ var clientSnapshotCmd = &cobra.Command{
 
 func init() {
 	clientCmd.AddCommand(clientSnapshotCmd)
+	clientSnapshotCmd.Flags().StringArrayVar(&clientSnapshotPaths, "path", nil, "restrict the snapshot to a path or glob pattern relative to the workspace location, prefix with ! to leave it out")
 }
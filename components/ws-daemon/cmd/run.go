@@ -20,13 +20,20 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	commoncfg "github.com/gitpod-io/gitpod/common-go/config"
 	"github.com/gitpod-io/gitpod/common-go/log"
-	"github.com/gitpod-io/gitpod/common-go/watch"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/config"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/daemon"
 )
 
-const grpcServerName = "wsdaemon"
+const (
+	grpcServerName = "wsdaemon"
+
+	// shutdownTimeout is the time ws-daemon takes to shut down, it must stay below the termination grace period of the pod
+	shutdownTimeout = 150 * time.Second
+	// drainTimeout is the part of the shutdown timeout ws-daemon waits for running backups and snapshots
+	drainTimeout = 120 * time.Second
+)
 
 // serveCmd represents the serve command
 var runCmd = &cobra.Command{
@@ -57,10 +64,12 @@ var runCmd = &cobra.Command{
 			baseserver.WithHealthHandler(health),
 			baseserver.WithMetricsRegistry(dmn.MetricsRegistry()),
 			baseserver.WithVersion(Version),
+			baseserver.WithCloseTimeout(shutdownTimeout),
 		)
 		if err != nil {
 			log.WithError(err).Fatal("Cannot set up server.")
 		}
+		dmn.RegisterGRPC(srv.GRPC())
 
 		health.AddReadinessCheck("ws-daemon", dmn.ReadinessProbe())
 		health.AddReadinessCheck("disk-space", freeDiskSpace(cfg.Daemon))
@@ -69,21 +78,19 @@ var runCmd = &cobra.Command{
 		if err != nil {
 			log.WithError(err).Fatal("Cannot start daemon.")
 		}
+		srv.OnShutdown(baseserver.ShutdownPhaseStopAccepting, "daemon", 0, func(ctx context.Context) error {
+			return dmn.Stop()
+		})
+		srv.OnShutdown(baseserver.ShutdownPhaseDrain, "backups", drainTimeout, dmn.InFlight().Drain)
 
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 
-		err = watch.File(ctx, configFile, func() {
+		err = commoncfg.Watch(ctx, configFile, func(cfg *config.Config) {
 			ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 			defer cancel()
 
-			cfg, err := config.Read(configFile)
-			if err != nil {
-				log.WithError(err).Warn("Cannot reload configuration.")
-				return
-			}
-
-			err = dmn.ReloadConfig(ctx, &cfg.Daemon)
+			err := dmn.ReloadConfig(ctx, &cfg.Daemon)
 			if err != nil {
 				log.WithError(err).Warn("Cannot reload configuration.")
 			}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	commoncfg "github.com/gitpod-io/gitpod/common-go/config"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/config"
 )
@@ -28,11 +29,10 @@ var validateConfigCmd = &cobra.Command{
 			log.WithError(err).Fatal("cannot read configuration")
 		}
 
-		var cfg config.Config
-		err = json.Unmarshal(ctnt, &cfg)
+		cfg, err := commoncfg.Parse[config.Config](ctnt)
 		if err != nil {
 			fmt.Println(string(ctnt))
-			log.WithError(err).Fatal("cannot unmarshal configuration")
+			log.WithError(err).Fatal("invalid configuration")
 		}
 
 		enc := json.NewEncoder(os.Stdout)
@@ -600,6 +600,73 @@ func main() {
 						return xerrors.Errorf("failed to apply connection limit: %v", err)
 					}
 
+					return nil
+				},
+			},
+			{
+				Name:  "setup-bandwidth-limit",
+				Usage: "shape the traffic an interface sends using a token bucket filter",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "interface",
+						Required: true,
+					},
+					&cli.Uint64Flag{
+						Name:     "rate",
+						Usage:    "rate in bytes per second, zero removes the limit",
+						Required: true,
+					},
+					&cli.UintFlag{
+						Name:     "handle",
+						Usage:    "major number of the qdisc handle",
+						Required: true,
+					},
+				},
+				Action: func(c *cli.Context) error {
+					ifName := c.String("interface")
+					link, err := netlink.LinkByName(ifName)
+					if err != nil {
+						return xerrors.Errorf("cannot find %q: %v", ifName, err)
+					}
+					handle := netlink.MakeHandle(uint16(c.Uint("handle")), 0)
+
+					rate := c.Uint64("rate")
+					if rate == 0 {
+						qdiscs, err := netlink.QdiscList(link)
+						if err != nil {
+							return xerrors.Errorf("cannot list qdiscs of %q: %v", ifName, err)
+						}
+						for _, qdisc := range qdiscs {
+							if attrs := qdisc.Attrs(); attrs.Handle == handle && attrs.Parent == netlink.HANDLE_ROOT {
+								return netlink.QdiscDel(qdisc)
+							}
+						}
+						return nil
+					}
+
+					// The bucket must hold at least what the interface sends within a timer tick,
+					// otherwise the rate cannot be reached.
+					burst := rate / 100
+					if burst < 32*1024 {
+						burst = 32 * 1024
+					}
+					// packets which wait for more than 50ms are dropped
+					limit := rate/20 + burst
+
+					tbf := &netlink.Tbf{
+						QdiscAttrs: netlink.QdiscAttrs{
+							LinkIndex: link.Attrs().Index,
+							Handle:    handle,
+							Parent:    netlink.HANDLE_ROOT,
+						},
+						Rate:   rate,
+						Buffer: uint32(burst),
+						Limit:  uint32(limit),
+					}
+					if err := netlink.QdiscReplace(tbf); err != nil {
+						return xerrors.Errorf("failed to limit bandwidth of %q: %v", ifName, err)
+					}
+
 					return nil
 				},
 			},
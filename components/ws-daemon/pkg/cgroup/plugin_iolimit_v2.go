@@ -11,28 +11,58 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	v2 "github.com/containerd/cgroups/v2"
+	"github.com/sirupsen/logrus"
+
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/content-service/pkg/initializer"
 )
 
+// IOLimits are the block I/O limits of a workspace. Limits of zero are not enforced.
+type IOLimits struct {
+	WriteBytesPerSecond int64
+	ReadBytesPerSecond  int64
+	WriteIOPS           int64
+	ReadIOPS            int64
+}
+
+// IOBurst configures the limits which apply while the content of a workspace is initialized
+type IOBurst struct {
+	Limits IOLimits
+	// MaxDuration ends the burst if the content of the workspace does not become ready in time.
+	// Zero means the burst lasts until the content is ready.
+	MaxDuration time.Duration
+}
+
+// ioBurstCheckInterval is how often we check if the content of a bursting workspace is ready
+const ioBurstCheckInterval = 2 * time.Second
+
 type IOLimiterV2 struct {
 	limits *v2.Resources
+	burst  *v2.Resources
+
+	burstDuration time.Duration
+	workingArea   string
 
 	cond *sync.Cond
 
 	devices []string
 }
 
-func NewIOLimiterV2(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs int64) (*IOLimiterV2, error) {
+// NewIOLimiterV2 creates a new IO limiter. If burst is not nil, workspaces get the burst limits until the content
+// in their working area is ready.
+func NewIOLimiterV2(limits IOLimits, burst *IOBurst, workingArea string) (*IOLimiterV2, error) {
 	devices := buildDevices()
 	log.WithField("devices", devices).Debug("io limiting devices")
-	return &IOLimiterV2{
-		limits: buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs, devices),
-
-		cond:    sync.NewCond(&sync.Mutex{}),
-		devices: devices,
-	}, nil
+	c := &IOLimiterV2{
+		cond:        sync.NewCond(&sync.Mutex{}),
+		devices:     devices,
+		workingArea: workingArea,
+	}
+	c.setLimits(limits, burst)
+	return c, nil
 }
 
 func (c *IOLimiterV2) Name() string  { return "iolimiter-v2" }
@@ -60,18 +90,48 @@ func (c *IOLimiterV2) Apply(ctx context.Context, opts *PluginOptions) error {
 	go func() {
 		log.WithFields(log.OWI("", "", opts.InstanceId)).WithField("cgroupPath", opts.CgroupPath).Debug("starting io limiting")
 
-		_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), c.limits)
-		if err != nil {
-			log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", c.limits).Warn("cannot write IO limits")
+		c.cond.L.Lock()
+		bursting := c.burst != nil
+		burstDuration := c.burstDuration
+		c.cond.L.Unlock()
+
+		var (
+			burstCheck    <-chan time.Time
+			burstDeadline <-chan time.Time
+			readyFile     = filepath.Join(c.workingArea, opts.InstanceId, initializer.WorkspaceReadyFile)
+		)
+		if bursting {
+			ticker := time.NewTicker(ioBurstCheckInterval)
+			defer ticker.Stop()
+			burstCheck = ticker.C
+			if burstDuration > 0 {
+				timer := time.NewTimer(burstDuration)
+				defer timer.Stop()
+				burstDeadline = timer.C
+			}
+		}
+		endBurst := func(reason string) {
+			bursting = false
+			burstCheck = nil
+			burstDeadline = nil
+			log.WithFields(log.OWI("", "", opts.InstanceId)).WithField("reason", reason).Debug("ending io burst")
 		}
 
+		c.writeLimits(opts, bursting, logrus.WarnLevel)
+
 		for {
 			select {
 			case <-update:
-				_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), c.limits)
-				if err != nil {
-					log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", c.limits).Error("cannot write IO limits")
+				c.writeLimits(opts, bursting, logrus.ErrorLevel)
+			case <-burstCheck:
+				if _, err := os.Stat(readyFile); err != nil {
+					continue
 				}
+				endBurst("content ready")
+				c.writeLimits(opts, bursting, logrus.ErrorLevel)
+			case <-burstDeadline:
+				endBurst("max duration exceeded")
+				c.writeLimits(opts, bursting, logrus.ErrorLevel)
 			case <-ctx.Done():
 				// Prior to shutting down though, we need to reset the IO limits to ensure we don't have
 				// processes stuck in the uninterruptable "D" (disk sleep) state. This would prevent the
@@ -89,16 +149,42 @@ func (c *IOLimiterV2) Apply(ctx context.Context, opts *PluginOptions) error {
 	return nil
 }
 
-func (c *IOLimiterV2) Update(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs int64) {
+// writeLimits writes the limits of a workspace to its cgroup. Workspaces which are bursting get the burst limits
+// as long as a burst is configured.
+func (c *IOLimiterV2) writeLimits(opts *PluginOptions, bursting bool, level logrus.Level) {
+	c.cond.L.Lock()
+	limits := c.limits
+	if bursting && c.burst != nil {
+		limits = c.burst
+	}
+	c.cond.L.Unlock()
+
+	_, err := v2.NewManager(opts.BasePath, filepath.Join("/", opts.CgroupPath), limits)
+	if err != nil {
+		log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).WithField("basePath", opts.BasePath).WithField("cgroupPath", opts.CgroupPath).WithField("limits", limits).Log(level, "cannot write IO limits")
+	}
+}
+
+func (c *IOLimiterV2) Update(limits IOLimits, burst *IOBurst) {
 	c.cond.L.Lock()
 	defer c.cond.L.Unlock()
 
-	c.limits = buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs, c.devices)
+	c.setLimits(limits, burst)
 	log.WithField("limits", c.limits.IO).Info("updating I/O cgroups v2 limits")
 
 	c.cond.Broadcast()
 }
 
+func (c *IOLimiterV2) setLimits(limits IOLimits, burst *IOBurst) {
+	c.limits = buildV2Limits(limits.WriteBytesPerSecond, limits.ReadBytesPerSecond, limits.WriteIOPS, limits.ReadIOPS, c.devices)
+	c.burst = nil
+	c.burstDuration = 0
+	if burst != nil {
+		c.burst = buildV2Limits(burst.Limits.WriteBytesPerSecond, burst.Limits.ReadBytesPerSecond, burst.Limits.WriteIOPS, burst.Limits.ReadIOPS, c.devices)
+		c.burstDuration = burst.MaxDuration
+	}
+}
+
 func buildV2Limits(writeBytesPerSecond, readBytesPerSecond, writeIOPs, readIOPs int64, devices []string) *v2.Resources {
 	resources := &v2.Resources{
 		IO: &v2.IO{},
@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cgroup
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	cgroups "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const (
+	// ResourceLimitsFile is the name of the file within the daemon service directory of a workspace
+	// (mounted at /.workspace) which holds the resource limits of that workspace.
+	ResourceLimitsFile = "resource-limits"
+
+	resourceLimitsInterval = 10 * time.Second
+)
+
+// ResourceLimitsV2 publishes the effective resource limits of a workspace so that supervisor can size runtimes
+// such as the JVM or Node accordingly. The limits are written as KEY=VALUE lines using the names of the
+// environment variables ws-manager sets from the workspace class, i.e. GITPOD_CPU_COUNT and GITPOD_MEMORY (in MiB).
+// Unlike those environment variables the file follows limit changes, e.g. when the CPU limit is adjusted.
+type ResourceLimitsV2 struct {
+	WorkingArea string
+}
+
+func (c *ResourceLimitsV2) Name() string  { return "resource-limits-v2" }
+func (c *ResourceLimitsV2) Type() Version { return Version2 }
+
+func (c *ResourceLimitsV2) Apply(ctx context.Context, opts *PluginOptions) error {
+	fullPath := filepath.Join(opts.BasePath, opts.CgroupPath)
+	if _, err := os.Stat(fullPath); err != nil {
+		return err
+	}
+
+	var (
+		cpu    = cgroups.NewCpuController(fullPath)
+		memory = cgroups.NewMemoryController(fullPath)
+		fn     = filepath.Join(c.WorkingArea, opts.InstanceId+"-daemon", ResourceLimitsFile)
+	)
+	go func() {
+		ticker := time.NewTicker(resourceLimitsInterval)
+		defer ticker.Stop()
+
+		var published string
+		for {
+			limits, err := readResourceLimits(cpu, memory)
+			if err != nil && !os.IsNotExist(err) {
+				log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).Warn("cannot read resource limits")
+			}
+			if err == nil && limits != published {
+				err = writeResourceLimits(fn, limits)
+				if err == nil {
+					published = limits
+				} else if !os.IsNotExist(err) {
+					log.WithError(err).WithFields(log.OWI("", "", opts.InstanceId)).Warn("cannot publish resource limits")
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func readResourceLimits(cpu *cgroups.Cpu, memory *cgroups.Memory) (string, error) {
+	var limits []string
+
+	quota, period, err := cpu.Max()
+	if err != nil {
+		return "", err
+	}
+	if quota != math.MaxUint64 && period > 0 {
+		// the JVM and friends want whole CPUs, hence we round up to not starve them
+		cpus := (quota + period - 1) / period
+		limits = append(limits, fmt.Sprintf("GITPOD_CPU_COUNT=%d", cpus))
+	}
+
+	mem, err := memory.Max()
+	if err != nil {
+		return "", err
+	}
+	if mem != math.MaxUint64 {
+		limits = append(limits, fmt.Sprintf("GITPOD_MEMORY=%d", mem/(1024*1024)))
+	}
+
+	return strings.Join(limits, "\n") + "\n", nil
+}
+
+// writeResourceLimits replaces the resource limits file atomically, so that supervisor never reads a partial file
+func writeResourceLimits(fn, limits string) error {
+	tmp, err := os.CreateTemp(filepath.Dir(fn), "."+ResourceLimitsFile+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.WriteString(limits)
+	if err != nil {
+		tmp.Close()
+		return xerrors.Errorf("cannot write %s: %w", tmp.Name(), err)
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	err = os.Chmod(tmp.Name(), 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), fn)
+}
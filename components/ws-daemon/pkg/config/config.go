@@ -5,34 +5,22 @@
 package config
 
 import (
-	"bytes"
-	"encoding/json"
-	"os"
-
-	"golang.org/x/xerrors"
-
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	commoncfg "github.com/gitpod-io/gitpod/common-go/config"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/daemon"
 )
 
+// Read loads the configuration in fn, applying its defaults and validating it
 func Read(fn string) (*Config, error) {
-	ctnt, err := os.ReadFile(fn)
-	if err != nil {
-		return nil, xerrors.Errorf("cannot read config file: %w", err)
-	}
-
-	var cfg Config
-	dec := json.NewDecoder(bytes.NewReader(ctnt))
-	dec.DisallowUnknownFields()
-	err = dec.Decode(&cfg)
-	if err != nil {
-		return nil, xerrors.Errorf("cannot parse config file: %w", err)
-	}
-
-	return &cfg, nil
+	return commoncfg.Load[Config](fn)
 }
 
 type Config struct {
 	Daemon  daemon.Config                  `json:"daemon"`
 	Service baseserver.ServerConfiguration `json:"service"`
 }
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	return c.Daemon.Validate()
+}
@@ -456,6 +456,24 @@ func (s *Containerd) ContainerRootfs(ctx context.Context, id ID, opts OptsContai
 	return s.Mapping.Translate(rootfs)
 }
 
+// ContainerUpperdir finds the upperdir of the workspace container's rootfs
+func (s *Containerd) ContainerUpperdir(ctx context.Context, id ID, opts OptsContainerRootfs) (loc string, err error) {
+	info, ok := s.cntIdx[string(id)]
+	if !ok {
+		return "", ErrNotFound
+	}
+
+	if info.UpperDir == "" {
+		return "", ErrNoUpperdir
+	}
+
+	if opts.Unmapped {
+		return info.UpperDir, nil
+	}
+
+	return s.Mapping.Translate(info.UpperDir)
+}
+
 // ContainerCGroupPath finds the container's cgroup path suffix
 func (s *Containerd) ContainerCGroupPath(ctx context.Context, id ID) (loc string, err error) {
 	info, ok := s.cntIdx[string(id)]
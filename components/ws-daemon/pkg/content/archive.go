@@ -18,7 +18,8 @@ import (
 	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
 )
 
-// BuildTarbal creates an OCI compatible tar file dst from the folder src, expecting the overlay whiteout format
+// BuildTarbal creates an OCI compatible tar file dst from the folder src, expecting the overlay whiteout format.
+// The tar file is compressed if the options ask for it.
 func BuildTarbal(ctx context.Context, src string, dst string, opts ...carchive.TarOption) (err error) {
 	var cfg carchive.TarConfig
 	for _, opt := range opts {
@@ -53,10 +54,12 @@ func BuildTarbal(ctx context.Context, src string, dst string, opts ...carchive.T
 	}
 
 	tarReader, err := archive.TarWithOptions(src, &archive.TarOptions{
-		UIDMaps:     uidMaps,
-		GIDMaps:     gidMaps,
-		Compression: archive.Uncompressed,
-		CopyPass:    true,
+		UIDMaps:         uidMaps,
+		GIDMaps:         gidMaps,
+		Compression:     archive.Uncompressed,
+		CopyPass:        true,
+		IncludeFiles:    cfg.Includes,
+		ExcludePatterns: cfg.Excludes,
 	})
 	if err != nil {
 		return
@@ -67,11 +70,26 @@ func BuildTarbal(ctx context.Context, src string, dst string, opts ...carchive.T
 	if err != nil {
 		return xerrors.Errorf("Unable to create tar file: %v", err.Error())
 	}
+	defer tarFile.Close()
 
-	_, err = io.Copy(tarFile, tarReader)
+	return writeTarbal(tarFile, tarReader, &cfg)
+}
+
+// writeTarbal copies a tar stream to dst, compressing it as configured
+func writeTarbal(dst io.Writer, tarReader io.Reader, cfg *carchive.TarConfig) error {
+	w, err := carchive.NewCompressWriter(dst, cfg.Compression, cfg.CompressionLevel)
+	if err != nil {
+		return xerrors.Errorf("Unable to create tar file: %v", err.Error())
+	}
+
+	_, err = io.Copy(w, tarReader)
+	if err != nil {
+		return xerrors.Errorf("Unable create tar file: %v", err.Error())
+	}
+	err = w.Close()
 	if err != nil {
 		return xerrors.Errorf("Unable create tar file: %v", err.Error())
 	}
 
-	return
+	return nil
 }
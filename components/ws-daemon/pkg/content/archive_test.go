@@ -0,0 +1,48 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+)
+
+func TestBuildTarbalCompression(t *testing.T) {
+	tests := []struct {
+		Compression carchive.Compression
+		Level       int
+	}{
+		{Compression: carchive.CompressionNone},
+		{Compression: carchive.CompressionGzip, Level: 6},
+		{Compression: carchive.CompressionZstd},
+		{Compression: carchive.CompressionZstd, Level: 3},
+	}
+	for _, test := range tests {
+		t.Run(string(test.Compression), func(t *testing.T) {
+			src, dst := t.TempDir(), t.TempDir()
+			writeFiles(t, src, map[string]string{
+				"a.txt":     "a",
+				"dir/b.txt": "b",
+			})
+
+			fn := filepath.Join(t.TempDir(), "backup.tar")
+			err := content.BuildTarbal(context.Background(), src, fn, carchive.WithCompression(test.Compression, test.Level))
+			if err != nil {
+				t.Fatal(err)
+			}
+			extract(t, fn, dst)
+
+			if diff := cmp.Diff(readFiles(t, src), readFiles(t, dst)); diff != "" {
+				t.Errorf("unexpected restored content (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,387 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+const (
+	restoreCacheExt      = ".tar"
+	restoreCacheIncoming = ".incoming-"
+
+	// restoreCacheFetchTimeout is the maximum time a download into the cache can take
+	restoreCacheFetchTimeout = 30 * time.Minute
+)
+
+// RestoreCache keeps recently uploaded backup and prebuild archives on the node, addressed by their digest.
+// A workspace restarting on the same node can then be restored from the cache rather than object storage.
+// Prebuild archives can be downloaded into the cache as well, such that workspaces starting from the same
+// prebuild on the node share a single download.
+//
+// Archives which are in use by a restore are pinned and not evicted until the restore releases them.
+type RestoreCache struct {
+	cfg RestoreCacheConfig
+
+	mu        sync.Mutex
+	pins      map[string]int
+	downloads singleflight.Group
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+	size      atomic.Int64
+}
+
+// RestoreCacheStats describes the use of the restore cache since ws-daemon started
+type RestoreCacheStats struct {
+	// Hits is the number of archives which were found in the cache
+	Hits uint64
+	// Misses is the number of archives which were not found in the cache
+	Misses uint64
+	// Evictions is the number of archives which were removed to free space or because they expired
+	Evictions uint64
+	// Size is the total size of the cached archives in bytes
+	Size int64
+}
+
+// NewRestoreCache creates the cache location and evicts archives which expired while ws-daemon was not running
+func NewRestoreCache(cfg RestoreCacheConfig) (*RestoreCache, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(cfg.Location, 0755)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create restore cache location: %w", err)
+	}
+
+	// downloads which did not complete before ws-daemon stopped
+	incoming, _ := filepath.Glob(filepath.Join(cfg.Location, restoreCacheIncoming+"*"))
+	for _, fn := range incoming {
+		os.Remove(fn)
+	}
+
+	c := &RestoreCache{cfg: cfg, pins: make(map[string]int)}
+	c.mu.Lock()
+	c.evict()
+	c.mu.Unlock()
+	return c, nil
+}
+
+// Get returns the location of the archive with the given digest if it is cached
+func (c *RestoreCache) Get(dgst string) (path string, ok bool) {
+	path, err := c.path(dgst)
+	if err != nil {
+		return "", false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.touch(path) {
+		c.misses.Add(1)
+		return "", false
+	}
+	c.hits.Add(1)
+	return path, true
+}
+
+// Acquire returns the location of the archive with the given digest if it is cached, like Get. The archive is pinned
+// and will not be evicted until release is called. Callers must call release exactly once if ok is true.
+func (c *RestoreCache) Acquire(dgst string) (path string, release func(), ok bool) {
+	path, err := c.path(dgst)
+	if err != nil {
+		return "", nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.touch(path) {
+		c.misses.Add(1)
+		return "", nil, false
+	}
+	c.hits.Add(1)
+	return path, c.pin(path), true
+}
+
+// pin prevents the eviction of a cached archive until the returned function is called. Callers must hold c.mu.
+func (c *RestoreCache) pin(path string) (release func()) {
+	c.pins[path]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			c.pins[path]--
+			if c.pins[path] <= 0 {
+				delete(c.pins, path)
+			}
+		})
+	}
+}
+
+// touch marks a cached archive as used. It returns false if the archive is not cached. Callers must hold c.mu.
+func (c *RestoreCache) touch(path string) bool {
+	if _, err := os.Stat(path); err != nil {
+		return false
+	}
+	// the modification time tracks when the archive was last used
+	now := time.Now()
+	err := os.Chtimes(path, now, now)
+	if err != nil {
+		log.WithError(err).WithField("path", path).Warn("cannot mark cached archive as used")
+	}
+	return true
+}
+
+// Fetch returns the location of the archive with the given digest, downloading it from url into the cache unless it
+// is cached already. Concurrent fetches of the same archive share a single download. The download is verified
+// against the digest. Like with Acquire, the archive is pinned until release is called.
+func (c *RestoreCache) Fetch(ctx context.Context, dgst, url string, size int64) (path string, release func(), err error) {
+	if path, release, ok := c.Acquire(dgst); ok {
+		return path, release, nil
+	}
+	if c.cfg.MaxBytes > 0 && size > c.cfg.MaxBytes {
+		return "", nil, xerrors.Errorf("archive of %d bytes exceeds the restore cache", size)
+	}
+
+	res, err, _ := c.downloads.Do(dgst, func() (interface{}, error) {
+		// The download is shared with other workspaces, hence it must not be cancelled along with the
+		// workspace which started it.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), restoreCacheFetchTimeout)
+		defer cancel()
+		return c.download(ctx, dgst, url)
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	path = res.(string)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.touch(path) {
+		// the archive was evicted by the time this fetch got to use it
+		return "", nil, xerrors.Errorf("archive %s was evicted from the restore cache", dgst)
+	}
+	return path, c.pin(path), nil
+}
+
+func (c *RestoreCache) download(ctx context.Context, dgst, url string) (path string, err error) {
+	path, err = c.path(dgst)
+	if err != nil {
+		return "", err
+	}
+	c.mu.Lock()
+	cached := c.touch(path)
+	c.mu.Unlock()
+	if cached {
+		// a fetch which completed just now downloaded the archive already
+		return path, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", xerrors.Errorf("cannot download archive: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", xerrors.Errorf("cannot download archive: status %d", resp.StatusCode)
+	}
+
+	tmp, err := os.CreateTemp(c.cfg.Location, restoreCacheIncoming+"*")
+	if err != nil {
+		return "", xerrors.Errorf("cannot download archive: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+	verifier := digest.Digest(dgst).Verifier()
+	_, err = io.Copy(io.MultiWriter(tmp, verifier), resp.Body)
+	if err != nil {
+		tmp.Close()
+		return "", xerrors.Errorf("cannot download archive: %w", err)
+	}
+	err = tmp.Close()
+	if err != nil {
+		return "", xerrors.Errorf("cannot download archive: %w", err)
+	}
+	if !verifier.Verified() {
+		return "", xerrors.Errorf("downloaded archive does not match digest %s", dgst)
+	}
+
+	err = c.Put(tmp.Name(), dgst)
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Stats returns the use of the cache since ws-daemon started
+func (c *RestoreCache) Stats() RestoreCacheStats {
+	return RestoreCacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Size:      c.size.Load(),
+	}
+}
+
+// Put moves the archive at src into the cache. src no longer exists once Put returns successfully.
+func (c *RestoreCache) Put(src string, dgst string) error {
+	path, err := c.path(dgst)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err = os.Rename(src, path)
+	if err != nil {
+		// the cache might live on a different filesystem than src
+		err = copyFile(src, path)
+		if err != nil {
+			return xerrors.Errorf("cannot add archive to restore cache: %w", err)
+		}
+		os.Remove(src)
+	}
+	err = os.Chmod(path, 0644)
+	if err != nil {
+		return xerrors.Errorf("cannot add archive to restore cache: %w", err)
+	}
+	now := time.Now()
+	err = os.Chtimes(path, now, now)
+	if err != nil {
+		return xerrors.Errorf("cannot add archive to restore cache: %w", err)
+	}
+
+	c.evict()
+	return nil
+}
+
+func (c *RestoreCache) path(dgst string) (string, error) {
+	d, err := digest.Parse(dgst)
+	if err != nil {
+		return "", xerrors.Errorf("invalid digest %q: %w", dgst, err)
+	}
+	return filepath.Join(c.cfg.Location, d.Algorithm().String()+"-"+d.Encoded()+restoreCacheExt), nil
+}
+
+// evict removes expired archives and the least recently used ones until the cache fits into MaxBytes.
+// Callers must hold c.mu.
+func (c *RestoreCache) evict() {
+	entries, err := os.ReadDir(c.cfg.Location)
+	if err != nil {
+		log.WithError(err).Warn("cannot list restore cache")
+		return
+	}
+
+	type archive struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	var (
+		archives []archive
+		total    int64
+	)
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), restoreCacheExt) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		p := filepath.Join(c.cfg.Location, e.Name())
+		if c.pins[p] > 0 {
+			// the archive is being restored, hence it counts towards the size but is not evicted
+			total += info.Size()
+			continue
+		}
+		if c.cfg.MaxAge > 0 && time.Since(info.ModTime()) > time.Duration(c.cfg.MaxAge) {
+			c.remove(p)
+			continue
+		}
+		archives = append(archives, archive{path: p, size: info.Size(), modTime: info.ModTime()})
+		total += info.Size()
+	}
+	defer func() { c.size.Store(total) }()
+
+	if c.cfg.MaxBytes == 0 {
+		return
+	}
+	sort.Slice(archives, func(i, j int) bool { return archives[i].modTime.Before(archives[j].modTime) })
+	for _, a := range archives {
+		if total <= c.cfg.MaxBytes {
+			break
+		}
+		c.remove(a.path)
+		total -= a.size
+	}
+}
+
+func (c *RestoreCache) remove(path string) {
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		log.WithError(err).WithField("path", path).Warn("cannot evict archive from restore cache")
+		return
+	}
+	c.evictions.Add(1)
+}
+
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(dst), restoreCacheIncoming+"*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	_, err = io.Copy(tmp, in)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dst)
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+	"github.com/opencontainers/go-digest"
+)
+
+func TestRestoreCache(t *testing.T) {
+	put := func(t *testing.T, c *content.RestoreCache, data string) string {
+		src := filepath.Join(t.TempDir(), "archive.tar")
+		err := os.WriteFile(src, []byte(data), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dgst := digest.FromString(data).String()
+		err = c.Put(src, dgst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := os.Stat(src); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be moved into the cache", src)
+		}
+		return dgst
+	}
+
+	t.Run("get", func(t *testing.T) {
+		c, err := content.NewRestoreCache(content.RestoreCacheConfig{Enabled: true, Location: t.TempDir()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		dgst := put(t, c, "foo")
+
+		path, ok := c.Get(dgst)
+		if !ok {
+			t.Fatal("expected archive to be cached")
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "foo" {
+			t.Errorf("unexpected archive content: %q", data)
+		}
+
+		if _, ok := c.Get(digest.FromString("bar").String()); ok {
+			t.Error("expected unknown archive not to be cached")
+		}
+		if _, ok := c.Get("../../etc/passwd"); ok {
+			t.Error("expected invalid digest not to be cached")
+		}
+	})
+
+	t.Run("evict least recently used", func(t *testing.T) {
+		c, err := content.NewRestoreCache(content.RestoreCacheConfig{Enabled: true, Location: t.TempDir(), MaxBytes: 6})
+		if err != nil {
+			t.Fatal(err)
+		}
+		foo := put(t, c, "foo")
+		fooPath, _ := c.Get(foo)
+		old := time.Now().Add(-time.Hour)
+		err = os.Chtimes(fooPath, old, old)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bar := put(t, c, "bar")
+
+		// using foo makes bar the least recently used archive
+		if _, ok := c.Get(foo); !ok {
+			t.Fatal("expected foo to be cached")
+		}
+		barPath, _ := c.Get(bar)
+		err = os.Chtimes(barPath, old, old)
+		if err != nil {
+			t.Fatal(err)
+		}
+		baz := put(t, c, "baz")
+
+		for dgst, exp := range map[string]bool{foo: true, bar: false, baz: true} {
+			if _, ok := c.Get(dgst); ok != exp {
+				t.Errorf("expected cached(%s) to be %v", dgst, exp)
+			}
+		}
+	})
+
+	t.Run("no eviction during restore", func(t *testing.T) {
+		c, err := content.NewRestoreCache(content.RestoreCacheConfig{Enabled: true, Location: t.TempDir(), MaxBytes: 6})
+		if err != nil {
+			t.Fatal(err)
+		}
+		foo := put(t, c, "foo")
+		fooPath, release, ok := c.Acquire(foo)
+		if !ok {
+			t.Fatal("expected foo to be cached")
+		}
+		old := time.Now().Add(-time.Hour)
+		err = os.Chtimes(fooPath, old, old)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// foo is the least recently used archive, but it is being restored
+		bar := put(t, c, "bar")
+		baz := put(t, c, "baz")
+		if _, err := os.Stat(fooPath); err != nil {
+			t.Fatalf("expected foo not to be evicted while it is restored: %v", err)
+		}
+		if _, ok := c.Get(bar); ok {
+			t.Error("expected bar to be evicted instead of foo")
+		}
+
+		release()
+		err = os.Chtimes(fooPath, old, old)
+		if err != nil {
+			t.Fatal(err)
+		}
+		put(t, c, "qux")
+		for dgst, exp := range map[string]bool{foo: false, baz: true} {
+			if _, ok := c.Get(dgst); ok != exp {
+				t.Errorf("expected cached(%s) to be %v", dgst, exp)
+			}
+		}
+	})
+
+	t.Run("evict expired", func(t *testing.T) {
+		loc := t.TempDir()
+		c, err := content.NewRestoreCache(content.RestoreCacheConfig{Enabled: true, Location: loc})
+		if err != nil {
+			t.Fatal(err)
+		}
+		foo := put(t, c, "foo")
+		fooPath, _ := c.Get(foo)
+		old := time.Now().Add(-2 * time.Hour)
+		err = os.Chtimes(fooPath, old, old)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		c, err = content.NewRestoreCache(content.RestoreCacheConfig{Enabled: true, Location: loc, MaxAge: util.Duration(time.Hour)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := c.Get(foo); ok {
+			t.Error("expected expired archive to be evicted")
+		}
+	})
+}
+
+func TestRestoreCacheFetch(t *testing.T) {
+	var (
+		downloads atomic.Int32
+		release   = make(chan struct{})
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		<-release
+		fmt.Fprint(w, "prebuild")
+	}))
+	defer srv.Close()
+
+	loc := t.TempDir()
+	c, err := content.NewRestoreCache(content.RestoreCacheConfig{Enabled: true, Location: loc})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dgst := digest.FromString("prebuild").String()
+
+	// workspaces starting at the same time share the download
+	var (
+		wg    sync.WaitGroup
+		paths = make([]string, 3)
+		errs  = make([]error, 3)
+	)
+	for i := range paths {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var done func()
+			paths[i], done, errs[i] = c.Fetch(context.Background(), dgst, srv.URL, 8)
+			if done != nil {
+				done()
+			}
+		}(i)
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	for i := range paths {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		data, err := os.ReadFile(paths[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "prebuild" {
+			t.Errorf("unexpected archive content: %q", data)
+		}
+	}
+
+	// workspaces starting later find the archive in the cache
+	_, done, err := c.Fetch(context.Background(), dgst, srv.URL, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done()
+	if n := downloads.Load(); n != 1 {
+		t.Errorf("expected a single download, got %d", n)
+	}
+
+	stats := c.Stats()
+	if stats.Hits != 1 || stats.Misses != 3 || stats.Size != 8 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		_, _, err := c.Fetch(context.Background(), digest.FromString("other").String(), srv.URL, 8)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		entries, err := os.ReadDir(loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 1 {
+			t.Errorf("expected the download to be removed, found %v", entries)
+		}
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		c, err := content.NewRestoreCache(content.RestoreCacheConfig{Enabled: true, Location: t.TempDir(), MaxBytes: 4})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, err = c.Fetch(context.Background(), dgst, srv.URL, 8)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestRestoreCacheConfigValidate(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Config content.RestoreCacheConfig
+		Valid  bool
+	}{
+		{Name: "disabled", Config: content.RestoreCacheConfig{}, Valid: true},
+		{Name: "enabled", Config: content.RestoreCacheConfig{Enabled: true, Location: "/cache"}, Valid: true},
+		{Name: "missing location", Config: content.RestoreCacheConfig{Enabled: true}},
+		{Name: "negative max bytes", Config: content.RestoreCacheConfig{Enabled: true, Location: "/cache", MaxBytes: -1}},
+		{Name: "negative max age", Config: content.RestoreCacheConfig{Enabled: true, Location: "/cache", MaxAge: util.Duration(-time.Second)}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Config.Validate()
+			if (err == nil) != test.Valid {
+				t.Errorf("unexpected validation result: %v", err)
+			}
+		})
+	}
+}
@@ -7,10 +7,14 @@ package content
 import (
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/util"
 	cntntcfg "github.com/gitpod-io/gitpod/content-service/api/config"
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 	"golang.org/x/xerrors"
 )
 
@@ -39,6 +43,21 @@ type Config struct {
 
 	// Initializer configures the isolated content initializer runtime
 	Initializer InitializerConfig `json:"initializer"`
+
+	// RestoreCache configures the node-local cache of backup and prebuild archives
+	RestoreCache RestoreCacheConfig `json:"restoreCache,omitempty"`
+
+	// SnapshotExport configures the registry snapshots are exported to as images
+	SnapshotExport SnapshotExportConfig `json:"snapshotExport,omitempty"`
+
+	// DockerCache configures the quota and backup of the Docker data root in workspaces
+	DockerCache DockerCacheConfig `json:"dockerCache,omitempty"`
+
+	// Encryption configures the encryption of workspace content at rest
+	Encryption EncryptionConfig `json:"encryption,omitempty"`
+
+	// OwnershipRepair configures the repair of the ownership and permissions of restored workspace content
+	OwnershipRepair OwnershipRepairConfig `json:"ownershipRepair,omitempty"`
 }
 
 type BackupConfig struct {
@@ -48,10 +67,165 @@ type BackupConfig struct {
 
 	// Attempts configures how many backup attempts we will make.
 	// Detaults to 3
-	Attempts int `json:"attempts"`
+	Attempts int `json:"attempts" default:"3"`
 
 	// Period is the time between regular workspace backups
 	Period util.Duration `json:"period"`
+
+	// Delta configures incremental backups
+	Delta DeltaBackupConfig `json:"delta,omitempty"`
+
+	// Compression is the codec backup archives are compressed with, i.e. none, gzip or zstd.
+	// Restores detect the codec by themselves. Defaults to none.
+	Compression carchive.Compression `json:"compression,omitempty"`
+
+	// CompressionLevel is the level of the compression codec. Defaults to the default level of the codec.
+	CompressionLevel int `json:"compressionLevel,omitempty"`
+
+	// Upload configures how backup archives are uploaded to the remote storage
+	Upload BackupUploadConfig `json:"upload,omitempty"`
+
+	// Live configures backups which are taken periodically while a workspace runs
+	Live LiveBackupConfig `json:"live,omitempty"`
+
+	// Engine is the engine which uploads and restores backups, i.e. tar or restic. Defaults to tar.
+	// Snapshots and prebuilds are always uploaded as tar archives.
+	Engine BackupEngine `json:"engine,omitempty"`
+
+	// Restic configures the restic backup engine
+	Restic ResticConfig `json:"restic,omitempty"`
+}
+
+// BackupEngine is the engine which uploads the backups of workspaces
+type BackupEngine string
+
+const (
+	// BackupEngineTar uploads backups as tar archives
+	BackupEngineTar BackupEngine = "tar"
+	// BackupEngineRestic uploads backups as snapshots of a restic repository per workspace, which are
+	// deduplicated, encrypted and incremental
+	BackupEngineRestic BackupEngine = "restic"
+)
+
+// Validate validates the backup engine
+func (e BackupEngine) Validate() error {
+	switch e {
+	case "", BackupEngineTar, BackupEngineRestic:
+		return nil
+	default:
+		return xerrors.Errorf("unknown backup engine %q", e)
+	}
+}
+
+type ResticConfig struct {
+	// PasswordFile is the file holding the password of the restic repositories, from which restic derives
+	// the key the backups are encrypted with. Losing the password loses all backups.
+	PasswordFile string `json:"passwordFile"`
+
+	// KeepLast is the number of backups kept per workspace. Defaults to 3.
+	KeepLast int `json:"keepLast,omitempty"`
+
+	// Command is the path to the restic executable. Defaults to restic.
+	Command string `json:"command,omitempty"`
+}
+
+// Validate validates the restic configuration
+func (c ResticConfig) Validate() error {
+	if c.PasswordFile == "" {
+		return xerrors.Errorf("passwordFile is required")
+	}
+	if c.KeepLast < 0 {
+		return xerrors.Errorf("keepLast must not be negative")
+	}
+	return nil
+}
+
+type LiveBackupConfig struct {
+	// Enabled uploads the changes of running workspaces to remote storage every interval, such that the loss
+	// of a node loses at most one interval of work. Changes are uploaded as delta layers, hence live backups
+	// require delta backups unless the restic engine uploads them.
+	Enabled bool `json:"enabled"`
+
+	// Interval is the time between the live backups of a workspace
+	Interval util.Duration `json:"interval,omitempty"`
+}
+
+// Validate validates the live backup configuration
+func (c LiveBackupConfig) Validate(delta DeltaBackupConfig, engine BackupEngine) error {
+	if !c.Enabled {
+		return nil
+	}
+	if !delta.Enabled && engine != BackupEngineRestic {
+		return xerrors.Errorf("live backups require delta backups")
+	}
+	if time.Duration(c.Interval) < time.Minute {
+		return xerrors.Errorf("interval must be at least one minute")
+	}
+	return nil
+}
+
+type BackupUploadConfig struct {
+	// PartSize is the size in bytes of the parts large backups are split into, which are uploaded concurrently
+	// and composed into a single object by the remote storage. Defaults to the default of the storage.
+	PartSize int64 `json:"partSize,omitempty"`
+
+	// Parallelism is the number of parts uploaded concurrently. Defaults to the default of the storage.
+	Parallelism int `json:"parallelism,omitempty"`
+
+	// BandwidthLimit limits the upload rate of each workspace's backups in bytes per second, such that
+	// many concurrent backups don't starve the network traffic of running workspaces. Zero means no limit.
+	BandwidthLimit int64 `json:"bandwidthLimit,omitempty"`
+
+	// ClassBandwidthLimit overrides BandwidthLimit for workspaces of a particular workspace class
+	ClassBandwidthLimit map[string]int64 `json:"classBandwidthLimit,omitempty"`
+}
+
+// BandwidthLimitFor returns the upload bandwidth limit of workspaces of a workspace class
+func (c BackupUploadConfig) BandwidthLimitFor(class string) int64 {
+	if limit, ok := c.ClassBandwidthLimit[class]; ok {
+		return limit
+	}
+	return c.BandwidthLimit
+}
+
+// Validate validates the backup upload configuration
+func (c BackupUploadConfig) Validate() error {
+	if c.PartSize != 0 && c.PartSize < storage.MinUploadPartSize {
+		return xerrors.Errorf("partSize must be at least %d bytes", storage.MinUploadPartSize)
+	}
+	if c.Parallelism < 0 {
+		return xerrors.Errorf("parallelism must not be negative")
+	}
+	if c.BandwidthLimit < 0 {
+		return xerrors.Errorf("bandwidthLimit must not be negative")
+	}
+	for class, limit := range c.ClassBandwidthLimit {
+		if limit < 0 {
+			return xerrors.Errorf("classBandwidthLimit of %s must not be negative", class)
+		}
+	}
+	return nil
+}
+
+type DeltaBackupConfig struct {
+	// Enabled uploads only the files which changed since the previous backup of a workspace
+	// as delta layer on top of its last full backup.
+	Enabled bool `json:"enabled"`
+
+	// FullBackupInterval is the number of delta layers after which the next backup is a full one again,
+	// which compacts the layers. Defaults to 10.
+	FullBackupInterval int `json:"fullBackupInterval,omitempty" default:"10"`
+}
+
+// Validate validates the delta backup configuration
+func (c DeltaBackupConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.FullBackupInterval < 1 {
+		return xerrors.Errorf("fullBackupInterval must be at least 1")
+	}
+	return nil
 }
 
 type UserNamespacesConfig struct {
@@ -89,3 +263,83 @@ type InitializerConfig struct {
 	// Args are additional arguments to pass to the CI runtime
 	Args []string `json:"args"`
 }
+
+type RestoreCacheConfig struct {
+	// Enabled turns on caching of recently uploaded backups and prebuild snapshots on the node,
+	// such that a workspace restarting on the same node does not download its content again.
+	Enabled bool `json:"enabled"`
+
+	// Location is the directory on-disk where cached archives are kept
+	Location string `json:"location"`
+
+	// MaxBytes is the total size of all cached archives. Least recently used archives are evicted
+	// once the cache grows beyond this size. Zero means no size limit.
+	MaxBytes int64 `json:"maxBytes,omitempty"`
+
+	// MaxAge is the time after which an unused archive is evicted. Zero means archives do not expire.
+	MaxAge util.Duration `json:"maxAge,omitempty"`
+
+	// Prebuilds downloads the prebuild and snapshot archives workspaces start from into the cache, such that
+	// workspaces starting from the same prebuild on this node share a single download.
+	Prebuilds bool `json:"prebuilds,omitempty"`
+}
+
+// Validate validates the restore cache configuration
+func (c RestoreCacheConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Location == "" {
+		return xerrors.Errorf("location is required")
+	}
+	if c.MaxBytes < 0 {
+		return xerrors.Errorf("maxBytes must not be negative")
+	}
+	if c.MaxAge < 0 {
+		return xerrors.Errorf("maxAge must not be negative")
+	}
+	return nil
+}
+
+type SnapshotExportConfig struct {
+	// Repository is the image repository exported snapshots are pushed to. Snapshots cannot be
+	// exported if this is empty.
+	Repository string `json:"repository,omitempty"`
+
+	// AuthConfig is the path to a Docker config file holding the credentials for the repository
+	AuthConfig string `json:"authConfig,omitempty"`
+}
+
+// Validate validates the snapshot export configuration
+func (c SnapshotExportConfig) Validate() error {
+	if c.Repository == "" {
+		return xerrors.Errorf("repository is required")
+	}
+	return nil
+}
+
+// DockerDataRoot is where docker-up keeps the Docker images, containers and build cache, relative to the workspace location
+const DockerDataRoot = ".docker-root"
+
+type DockerCacheConfig struct {
+	// Enabled puts the Docker data root of workspaces under a quota of its own, such that it no longer
+	// counts against the workspace storage quota, and excludes it from backups.
+	Enabled bool `json:"enabled"`
+
+	// Size is the quota of the Docker data root, e.g. 50g
+	Size quota.Size `json:"size"`
+
+	// IncludeInBackup keeps the Docker data root in workspace backups nonetheless
+	IncludeInBackup bool `json:"includeInBackup,omitempty"`
+}
+
+// Validate validates the Docker cache configuration
+func (c DockerCacheConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Size <= 0 {
+		return xerrors.Errorf("size is required")
+	}
+	return nil
+}
@@ -8,9 +8,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/gitpod-io/gitpod/common-go/util"
 	"github.com/gitpod-io/gitpod/ws-daemon/api"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -38,3 +41,126 @@ func TestFSShiftMethodUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestDockerCacheConfigValidate(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Config content.DockerCacheConfig
+		Valid  bool
+	}{
+		{Name: "disabled", Config: content.DockerCacheConfig{}, Valid: true},
+		{Name: "enabled", Config: content.DockerCacheConfig{Enabled: true, Size: 50 * quota.Gigabyte}, Valid: true},
+		{Name: "missing size", Config: content.DockerCacheConfig{Enabled: true}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Config.Validate()
+			if (err == nil) != test.Valid {
+				t.Errorf("unexpected validation result: %v", err)
+			}
+		})
+	}
+}
+
+func TestDockerCacheConfigUnmarshalJSON(t *testing.T) {
+	var act content.Config
+	err := json.Unmarshal([]byte(`{"dockerCache":{"enabled":true,"size":"30g"}}`), &act)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(content.DockerCacheConfig{Enabled: true, Size: 30 * quota.Gigabyte}, act.DockerCache); diff != "" {
+		t.Errorf("unexpected result (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeltaBackupConfigValidate(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Config content.DeltaBackupConfig
+		Valid  bool
+	}{
+		{Name: "disabled", Config: content.DeltaBackupConfig{}, Valid: true},
+		{Name: "enabled", Config: content.DeltaBackupConfig{Enabled: true, FullBackupInterval: 10}, Valid: true},
+		{Name: "missing full backup interval", Config: content.DeltaBackupConfig{Enabled: true}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Config.Validate()
+			if (err == nil) != test.Valid {
+				t.Errorf("unexpected validation result: %v", err)
+			}
+		})
+	}
+}
+
+func TestLiveBackupConfigValidate(t *testing.T) {
+	delta := content.DeltaBackupConfig{Enabled: true, FullBackupInterval: 10}
+	tests := []struct {
+		Name   string
+		Config content.LiveBackupConfig
+		Delta  content.DeltaBackupConfig
+		Engine content.BackupEngine
+		Valid  bool
+	}{
+		{Name: "disabled", Config: content.LiveBackupConfig{}, Valid: true},
+		{Name: "enabled", Config: content.LiveBackupConfig{Enabled: true, Interval: util.Duration(5 * time.Minute)}, Delta: delta, Valid: true},
+		{Name: "without delta backups", Config: content.LiveBackupConfig{Enabled: true, Interval: util.Duration(5 * time.Minute)}},
+		{Name: "restic without delta backups", Config: content.LiveBackupConfig{Enabled: true, Interval: util.Duration(5 * time.Minute)}, Engine: content.BackupEngineRestic, Valid: true},
+		{Name: "interval too short", Config: content.LiveBackupConfig{Enabled: true, Interval: util.Duration(10 * time.Second)}, Delta: delta},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Config.Validate(test.Delta, test.Engine)
+			if (err == nil) != test.Valid {
+				t.Errorf("unexpected validation result: %v", err)
+			}
+		})
+	}
+}
+
+func TestBackupUploadConfigValidate(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Config content.BackupUploadConfig
+		Valid  bool
+	}{
+		{Name: "defaults", Config: content.BackupUploadConfig{}, Valid: true},
+		{Name: "parallel", Config: content.BackupUploadConfig{PartSize: 64 << 20, Parallelism: 8}, Valid: true},
+		{Name: "part size too small", Config: content.BackupUploadConfig{PartSize: 1 << 20}},
+		{Name: "bandwidth limit", Config: content.BackupUploadConfig{BandwidthLimit: 50 << 20, ClassBandwidthLimit: map[string]int64{"large": 100 << 20}}, Valid: true},
+		{Name: "negative bandwidth limit", Config: content.BackupUploadConfig{BandwidthLimit: -1}},
+		{Name: "negative class bandwidth limit", Config: content.BackupUploadConfig{ClassBandwidthLimit: map[string]int64{"large": -1}}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Config.Validate()
+			if (err == nil) != test.Valid {
+				t.Errorf("unexpected validation result: %v", err)
+			}
+		})
+	}
+}
+
+func TestBackupUploadConfigBandwidthLimitFor(t *testing.T) {
+	cfg := content.BackupUploadConfig{
+		BandwidthLimit: 50 << 20,
+		ClassBandwidthLimit: map[string]int64{
+			"large":     100 << 20,
+			"unlimited": 0,
+		},
+	}
+	tests := []struct {
+		Class       string
+		Expectation int64
+	}{
+		{Class: "", Expectation: 50 << 20},
+		{Class: "default", Expectation: 50 << 20},
+		{Class: "large", Expectation: 100 << 20},
+		{Class: "unlimited", Expectation: 0},
+	}
+	for _, test := range tests {
+		if act := cfg.BandwidthLimitFor(test.Class); act != test.Expectation {
+			t.Errorf("unexpected bandwidth limit for class %q: got %d, want %d", test.Class, act, test.Expectation)
+		}
+	}
+}
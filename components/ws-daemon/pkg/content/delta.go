@@ -0,0 +1,235 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containers/storage/pkg/archive"
+	"github.com/containers/storage/pkg/idtools"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+// DeltaIndex records the content of a workspace at the time of its last backup, such that
+// the next backup can upload the changes since then as delta layer.
+type DeltaIndex struct {
+	// Base is the digest of the full backup the delta layers apply to
+	Base string `json:"base"`
+
+	// Layers is the number of delta layers uploaded on top of the full backup
+	Layers int `json:"layers"`
+
+	// Since is the time the workspace content was scanned. Files with a later ctime have changed since.
+	Since time.Time `json:"since"`
+
+	// Paths are all paths of the workspace content at the time of the scan, relative to the workspace location
+	Paths []string `json:"paths"`
+
+	// Size is the size in bytes of the full backup and its delta layers in remote storage
+	Size int64 `json:"size,omitempty"`
+}
+
+// ReadDeltaIndex reads a delta index from a file. If the file does not exist, nil is returned.
+func ReadDeltaIndex(fn string) (*DeltaIndex, error) {
+	fc, err := os.ReadFile(fn)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res DeltaIndex
+	err = json.Unmarshal(fc, &res)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal delta index: %w", err)
+	}
+	return &res, nil
+}
+
+// Write writes the delta index to a file
+func (idx *DeltaIndex) Write(fn string) error {
+	fc, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		return err
+	}
+	tmp := fn + ".tmp"
+	err = os.WriteFile(tmp, fc, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, fn)
+}
+
+// ScanChanges walks the content in location and returns the changes since the index was taken, together with
+// all paths currently present. Without an index, all paths are reported as added. Paths matching any of the
+// excludes, or lying below them, are ignored. Excludes are relative to location.
+//
+// Changes are detected based on the ctime of files, which also changes when a file is renamed or its
+// metadata is modified. Of deleted directories only the directory itself is reported.
+func ScanChanges(location string, idx *DeltaIndex, excludes []string) (changes []archive.Change, paths []string, err error) {
+	var (
+		since    time.Time
+		previous = make(map[string]struct{})
+	)
+	if idx != nil {
+		since = idx.Since
+		for _, p := range idx.Paths {
+			previous[p] = struct{}{}
+		}
+	}
+	exclude := make(map[string]struct{}, len(excludes))
+	for _, e := range excludes {
+		exclude["/"+strings.Trim(e, "/")] = struct{}{}
+	}
+
+	current := make(map[string]struct{}, len(previous))
+	err = filepath.WalkDir(location, func(fn string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) {
+			// the file was deleted while we were scanning
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if fn == location {
+			return nil
+		}
+
+		p := "/" + filepath.ToSlash(strings.TrimPrefix(fn, location+string(filepath.Separator)))
+		if _, ok := exclude[p]; ok {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		paths = append(paths, p)
+		current[p] = struct{}{}
+
+		_, existed := previous[p]
+		switch {
+		case !existed:
+			changes = append(changes, archive.Change{Path: p, Kind: archive.ChangeAdd})
+		case changedSince(info, since):
+			changes = append(changes, archive.Change{Path: p, Kind: archive.ChangeModify})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot scan %s: %w", location, err)
+	}
+
+	var deleted []string
+	for p := range previous {
+		if _, ok := current[p]; !ok {
+			deleted = append(deleted, p)
+		}
+	}
+	// Parents sort before their children, hence we see deleted directories before their content
+	sort.Strings(deleted)
+	whiteouts := make(map[string]struct{}, len(deleted))
+	for _, p := range deleted {
+		if hasParentIn(p, whiteouts) {
+			continue
+		}
+		whiteouts[p] = struct{}{}
+		changes = append(changes, archive.Change{Path: p, Kind: archive.ChangeDelete})
+	}
+
+	return changes, paths, nil
+}
+
+func hasParentIn(p string, set map[string]struct{}) bool {
+	for dir := filepath.Dir(p); dir != "/"; dir = filepath.Dir(dir) {
+		if _, ok := set[dir]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ctimeSlack accounts for the kernel setting ctimes from a coarse clock which lags behind time.Now.
+// Files changed shortly before the last scan are considered changed once more.
+const ctimeSlack = 1 * time.Second
+
+func changedSince(info fs.FileInfo, since time.Time) bool {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return true
+	}
+	return !time.Unix(stat.Ctim.Sec, stat.Ctim.Nsec).Before(since.Add(-ctimeSlack))
+}
+
+// BuildDeltaTarbal creates a tar file dst from the changes of the folder src. Deleted paths are
+// added as whiteouts. The tar file is compressed if the options ask for it.
+func BuildDeltaTarbal(ctx context.Context, src string, dst string, changes []archive.Change, opts ...carchive.TarOption) (err error) {
+	var cfg carchive.TarConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	//nolint:staticcheck,ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "buildDeltaTarbal")
+	span.LogKV("src", src, "dst", dst, "changes", len(changes))
+	defer tracing.FinishSpan(span, &err)
+
+	uidMaps := make([]idtools.IDMap, len(cfg.UIDMaps))
+	for i, m := range cfg.UIDMaps {
+		uidMaps[i] = idtools.IDMap{
+			ContainerID: m.ContainerID,
+			HostID:      m.HostID,
+			Size:        m.Size,
+		}
+	}
+	gidMaps := make([]idtools.IDMap, len(cfg.GIDMaps))
+	for i, m := range cfg.GIDMaps {
+		gidMaps[i] = idtools.IDMap{
+			ContainerID: m.ContainerID,
+			HostID:      m.HostID,
+			Size:        m.Size,
+		}
+	}
+
+	tarReader, err := archive.ExportChanges(src, changes, uidMaps, gidMaps)
+	if err != nil {
+		return
+	}
+	defer tarReader.Close()
+
+	tarFile, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY, 0755)
+	if err != nil {
+		return xerrors.Errorf("Unable to create tar file: %v", err.Error())
+	}
+	defer tarFile.Close()
+
+	return writeTarbal(tarFile, tarReader, &cfg)
+}
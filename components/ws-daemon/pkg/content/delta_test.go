@@ -0,0 +1,170 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content_test
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/containers/storage/pkg/archive"
+	"github.com/google/go-cmp/cmp"
+
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+)
+
+func TestDeltaBackup(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		src      = t.TempDir()
+		dst      = t.TempDir()
+		excludes = []string{content.DockerDataRoot}
+	)
+	writeFiles(t, src, map[string]string{
+		"a.txt":                               "a",
+		"dir/b.txt":                           "b",
+		"dir/sub/c.txt":                       "c",
+		"keep.txt":                            "keep",
+		content.DockerDataRoot + "/image.tar": "excluded",
+	})
+
+	// the full backup
+	fullTar := filepath.Join(t.TempDir(), "full.tar")
+	err := content.BuildTarbal(ctx, src, fullTar, carchive.WithExcludes(excludes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	extract(t, fullTar, dst)
+
+	// files changed within the slack of the last scan count as changed
+	time.Sleep(1100 * time.Millisecond)
+	since := time.Now()
+	changes, paths, err := content.ScanChanges(src, nil, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"/a.txt", "/dir", "/dir/b.txt", "/dir/sub", "/dir/sub/c.txt", "/keep.txt"}, paths); diff != "" {
+		t.Errorf("unexpected paths (-want +got):\n%s", diff)
+	}
+	if len(changes) != len(paths) {
+		t.Errorf("expected all paths to be added without an index, got %v", changes)
+	}
+	idx := &content.DeltaIndex{Since: since, Paths: paths}
+
+	writeFiles(t, src, map[string]string{
+		"a.txt":   "changed",
+		"new.txt": "new",
+	})
+	err = os.RemoveAll(filepath.Join(src, "dir", "sub"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes, _, err = content.ScanChanges(src, idx, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedChanges := []archive.Change{
+		{Path: "/a.txt", Kind: archive.ChangeModify},
+		{Path: "/dir", Kind: archive.ChangeModify},
+		{Path: "/new.txt", Kind: archive.ChangeAdd},
+		{Path: "/dir/sub", Kind: archive.ChangeDelete},
+	}
+	if diff := cmp.Diff(expectedChanges, changes); diff != "" {
+		t.Errorf("unexpected changes (-want +got):\n%s", diff)
+	}
+
+	deltaTar := filepath.Join(t.TempDir(), "delta.tar")
+	err = content.BuildDeltaTarbal(ctx, src, deltaTar, changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	extract(t, deltaTar, dst, carchive.WithWhiteouts())
+
+	expected := readFiles(t, src)
+	delete(expected, content.DockerDataRoot+"/image.tar")
+	if diff := cmp.Diff(expected, readFiles(t, dst)); diff != "" {
+		t.Errorf("unexpected restored content (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeltaIndex(t *testing.T) {
+	fn := filepath.Join(t.TempDir(), "index.json")
+
+	idx, err := content.ReadDeltaIndex(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if idx != nil {
+		t.Fatalf("expected no index, got %v", idx)
+	}
+
+	expected := &content.DeltaIndex{Base: "sha256:foo", Layers: 2, Since: time.Now().UTC(), Paths: []string{"/a.txt"}}
+	err = expected.Write(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx, err = content.ReadDeltaIndex(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expected, idx); diff != "" {
+		t.Errorf("unexpected index (-want +got):\n%s", diff)
+	}
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, ctnt := range files {
+		fn := filepath.Join(dir, name)
+		err := os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(fn, []byte(ctnt), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func readFiles(t *testing.T, dir string) map[string]string {
+	res := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		ctnt, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		res[rel] = string(ctnt)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func extract(t *testing.T, fn, dst string, opts ...carchive.TarOption) {
+	f, err := os.Open(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	err = carchive.ExtractTarbal(context.Background(), f, dst, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/sys/unix"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+)
+
+// encryptionKeySize is the size of the raw fscrypt master keys we generate for workspaces
+const encryptionKeySize = 64
+
+// EncryptionConfig configures the encryption of workspace content at rest
+type EncryptionConfig struct {
+	// Enabled encrypts the location of every non-ephemeral workspace using fscrypt with a key of its own.
+	// The key exists in the kernel only while the workspace runs and is removed when the workspace is disposed,
+	// which leaves the content of stopped workspaces unreadable until it is garbage collected.
+	// The filesystem of the working area must support fscrypt (e.g. ext4 with the encrypt feature), XFS does not.
+	Enabled bool `json:"enabled"`
+}
+
+// hookEncryptWorkspaceLocation adds a new encryption key to the filesystem and sets an encryption policy
+// using that key on the (still empty) workspace location. Only the identifier of the key is persisted.
+func hookEncryptWorkspaceLocation(cfg EncryptionConfig) session.WorkspaceLivecycleHook {
+	return func(ctx context.Context, ws *session.Workspace) (err error) {
+		span, _ := opentracing.StartSpanFromContext(ctx, "hook.EncryptWorkspaceLocation")
+		defer tracing.FinishSpan(span, &err)
+
+		if !cfg.Enabled || ws.Ephemeral {
+			// the content of ephemeral workspaces never touches the node's disk
+			return nil
+		}
+		if ws.EncryptionKeyID != "" {
+			return nil
+		}
+
+		key := make([]byte, encryptionKeySize)
+		_, err = rand.Read(key)
+		if err != nil {
+			return xerrors.Errorf("cannot generate workspace encryption key: %w", err)
+		}
+		defer func() {
+			// the key must only be held by the kernel
+			for i := range key {
+				key[i] = 0
+			}
+		}()
+
+		dir, err := os.Open(ws.Location)
+		if err != nil {
+			return xerrors.Errorf("cannot encrypt workspace location: %w", err)
+		}
+		defer dir.Close()
+
+		id, err := addEncryptionKey(dir.Fd(), key)
+		if err != nil {
+			return xerrors.Errorf("cannot add workspace encryption key: %w", err)
+		}
+
+		policy := newEncryptionPolicy(id)
+		_, _, errno := unix.Syscall(unix.SYS_IOCTL, dir.Fd(), unix.FS_IOC_SET_ENCRYPTION_POLICY, uintptr(unsafe.Pointer(&policy)))
+		if errno != 0 {
+			arg := unix.FscryptRemoveKeyArg{Key_spec: keyIdentifierSpec(id)}
+			_, _, _ = unix.Syscall(unix.SYS_IOCTL, dir.Fd(), unix.FS_IOC_REMOVE_ENCRYPTION_KEY, uintptr(unsafe.Pointer(&arg)))
+
+			err = errno
+			if errors.Is(errno, unix.ENOTEMPTY) {
+				err = xerrors.Errorf("workspace location is not empty: %w", errno)
+			}
+			return xerrors.Errorf("cannot set encryption policy on workspace location: %w", err)
+		}
+		ws.EncryptionKeyID = hex.EncodeToString(id[:])
+		log.WithFields(ws.OWI()).WithField("keyID", ws.EncryptionKeyID).Debug("encrypted workspace location")
+
+		return nil
+	}
+}
+
+// hookRemoveEncryptionKey removes the encryption key of a workspace from the filesystem, which wipes the key
+// from memory and makes the remaining workspace content on disk unreadable.
+func hookRemoveEncryptionKey(ctx context.Context, ws *session.Workspace) (err error) {
+	//nolint:ineffassign
+	span, _ := opentracing.StartSpanFromContext(ctx, "hook.RemoveEncryptionKey")
+	defer tracing.FinishSpan(span, &err)
+
+	if ws.EncryptionKeyID == "" {
+		return nil
+	}
+
+	id, err := parseEncryptionKeyID(ws.EncryptionKeyID)
+	if err != nil {
+		return xerrors.Errorf("cannot remove workspace encryption key: %w", err)
+	}
+
+	// the key lives in the keyring of the filesystem, which the working area shares with the workspace location
+	dir, err := os.Open(filepath.Dir(ws.Location))
+	if err != nil {
+		return xerrors.Errorf("cannot remove workspace encryption key: %w", err)
+	}
+	defer dir.Close()
+
+	arg := unix.FscryptRemoveKeyArg{Key_spec: keyIdentifierSpec(id)}
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, dir.Fd(), unix.FS_IOC_REMOVE_ENCRYPTION_KEY, uintptr(unsafe.Pointer(&arg)))
+	if errno == unix.ENOKEY {
+		// the key is gone already, e.g. because the node restarted
+		return nil
+	}
+	if errno != 0 {
+		return xerrors.Errorf("cannot remove workspace encryption key: %w", errno)
+	}
+	if arg.Removal_status_flags&unix.FSCRYPT_KEY_REMOVAL_STATUS_FLAG_FILES_BUSY != 0 {
+		log.WithFields(ws.OWI()).WithField("keyID", ws.EncryptionKeyID).Warn("workspace encryption key removed, but some files are still in use and remain unlocked until closed")
+	}
+
+	return nil
+}
+
+// addEncryptionKeyArg is unix.FscryptAddKeyArg followed by the raw key, as the kernel expects it
+type addEncryptionKeyArg struct {
+	unix.FscryptAddKeyArg
+	Raw [encryptionKeySize]byte
+}
+
+// addEncryptionKey adds a key to the keyring of the filesystem fd resides on and returns the identifier of the key
+func addEncryptionKey(fd uintptr, key []byte) (id [unix.FSCRYPT_KEY_IDENTIFIER_SIZE]byte, err error) {
+	if len(key) != encryptionKeySize {
+		return id, xerrors.Errorf("invalid key size %d", len(key))
+	}
+
+	var arg addEncryptionKeyArg
+	arg.Key_spec.Type = unix.FSCRYPT_KEY_SPEC_TYPE_IDENTIFIER
+	arg.Raw_size = encryptionKeySize
+	copy(arg.Raw[:], key)
+	defer func() {
+		// don't leave a copy of the key behind either
+		arg.Raw = [encryptionKeySize]byte{}
+	}()
+
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, fd, unix.FS_IOC_ADD_ENCRYPTION_KEY, uintptr(unsafe.Pointer(&arg)))
+	if errno != 0 {
+		return id, errno
+	}
+	copy(id[:], arg.Key_spec.U[:unix.FSCRYPT_KEY_IDENTIFIER_SIZE])
+	return id, nil
+}
+
+// newEncryptionPolicy produces a v2 fscrypt policy using the recommended AES-256-XTS/AES-256-CTS modes
+func newEncryptionPolicy(id [unix.FSCRYPT_KEY_IDENTIFIER_SIZE]byte) unix.FscryptPolicyV2 {
+	return unix.FscryptPolicyV2{
+		Version:                   unix.FSCRYPT_POLICY_V2,
+		Contents_encryption_mode:  unix.FSCRYPT_MODE_AES_256_XTS,
+		Filenames_encryption_mode: unix.FSCRYPT_MODE_AES_256_CTS,
+		Flags:                     unix.FSCRYPT_POLICY_FLAGS_PAD_32,
+		Master_key_identifier:     id,
+	}
+}
+
+func keyIdentifierSpec(id [unix.FSCRYPT_KEY_IDENTIFIER_SIZE]byte) unix.FscryptKeySpecifier {
+	spec := unix.FscryptKeySpecifier{Type: unix.FSCRYPT_KEY_SPEC_TYPE_IDENTIFIER}
+	copy(spec.U[:], id[:])
+	return spec
+}
+
+func parseEncryptionKeyID(s string) (id [unix.FSCRYPT_KEY_IDENTIFIER_SIZE]byte, err error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return id, xerrors.Errorf("invalid key identifier %q: %w", s, err)
+	}
+	if len(b) != len(id) {
+		return id, xerrors.Errorf("invalid key identifier %q: expected %d bytes", s, len(id))
+	}
+	copy(id[:], b)
+	return id, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/sys/unix"
+)
+
+func TestAddEncryptionKeyArgLayout(t *testing.T) {
+	// the kernel expects the raw key to immediately follow struct fscrypt_add_key_arg
+	var arg addEncryptionKeyArg
+	if act, exp := unsafe.Offsetof(arg.Raw), unsafe.Sizeof(unix.FscryptAddKeyArg{}); act != exp {
+		t.Errorf("unexpected raw key offset: expected %d, got %d", exp, act)
+	}
+}
+
+func TestParseEncryptionKeyID(t *testing.T) {
+	type Expectation struct {
+		ID    [unix.FSCRYPT_KEY_IDENTIFIER_SIZE]byte
+		Error bool
+	}
+	tests := []struct {
+		Name        string
+		Input       string
+		Expectation Expectation
+	}{
+		{
+			Name:  "valid identifier",
+			Input: "000102030405060708090a0b0c0d0e0f",
+			Expectation: Expectation{
+				ID: [unix.FSCRYPT_KEY_IDENTIFIER_SIZE]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			},
+		},
+		{
+			Name:        "too short",
+			Input:       "0001",
+			Expectation: Expectation{Error: true},
+		},
+		{
+			Name:        "not hex",
+			Input:       "not a key identifier",
+			Expectation: Expectation{Error: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			id, err := parseEncryptionKeyID(test.Input)
+			act := Expectation{ID: id, Error: err != nil}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+
+			if err == nil {
+				spec := keyIdentifierSpec(id)
+				if spec.Type != unix.FSCRYPT_KEY_SPEC_TYPE_IDENTIFIER {
+					t.Errorf("unexpected key spec type %d", spec.Type)
+				}
+				if diff := cmp.Diff(id[:], spec.U[:unix.FSCRYPT_KEY_IDENTIFIER_SIZE]); diff != "" {
+					t.Errorf("unexpected key spec (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	dockerref "github.com/containerd/containerd/reference/docker"
+	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
+)
+
+const (
+	// snapshotLayerRoot is the directory the content of an exported snapshot is placed in,
+	// which is where workspaces find their content
+	snapshotLayerRoot = "workspace"
+
+	// AnnotationWorkspaceID is set on the manifest of exported snapshots
+	AnnotationWorkspaceID = "io.gitpod.workspace.id"
+)
+
+// SnapshotExporter pushes snapshot archives as single-layer OCI images
+type SnapshotExporter struct {
+	repository dockerref.Named
+	resolver   remotes.Resolver
+	tmpDir     string
+}
+
+// NewSnapshotExporter creates a new snapshot exporter. The temporary layer files are created in tmpDir.
+func NewSnapshotExporter(cfg SnapshotExportConfig, tmpDir string) (*SnapshotExporter, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	repository, err := dockerref.ParseNormalizedNamed(cfg.Repository)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse repository: %w", err)
+	}
+	if !dockerref.IsNameOnly(repository) {
+		return nil, xerrors.Errorf("repository must not contain a tag or digest")
+	}
+
+	resolverOpts := docker.ResolverOptions{}
+	if cfg.AuthConfig != "" {
+		auth, err := loadRegistryAuth(cfg.AuthConfig)
+		if err != nil {
+			return nil, err
+		}
+		resolverOpts.Hosts = docker.ConfigureDefaultRegistries(
+			docker.WithAuthorizer(docker.NewDockerAuthorizer(docker.WithAuthCreds(auth.Credentials))),
+		)
+	}
+
+	return &SnapshotExporter{
+		repository: repository,
+		resolver:   docker.NewResolver(resolverOpts),
+		tmpDir:     tmpDir,
+	}, nil
+}
+
+// Export pushes the content of a snapshot archive as image to the configured repository and returns
+// the digest reference of the image.
+func (e *SnapshotExporter) Export(ctx context.Context, archive, tag, workspaceID string) (imageRef string, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "exportSnapshot")
+	span.SetTag("tag", tag)
+	defer tracing.FinishSpan(span, &err)
+
+	ref, err := dockerref.WithTag(e.repository, tag)
+	if err != nil {
+		return "", xerrors.Errorf("invalid tag: %w", err)
+	}
+
+	f, err := os.Open(archive)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	src, err := carchive.NewDecompressReader(f)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	layer, err := os.CreateTemp(e.tmpDir, "snapshot-layer-*.tar.gz")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(layer.Name())
+	defer layer.Close()
+
+	layerDigester := digest.Canonical.Digester()
+	diffID, err := BuildSnapshotLayer(src, io.MultiWriter(layer, layerDigester.Hash()))
+	if err != nil {
+		return "", xerrors.Errorf("cannot build layer: %w", err)
+	}
+	stat, err := layer.Stat()
+	if err != nil {
+		return "", err
+	}
+	layerDesc := ociv1.Descriptor{
+		MediaType: ociv1.MediaTypeImageLayerGzip,
+		Digest:    layerDigester.Digest(),
+		Size:      stat.Size(),
+	}
+
+	created := time.Now().UTC()
+	cfgData, err := json.Marshal(ociv1.Image{
+		Created:      &created,
+		Architecture: runtime.GOARCH,
+		OS:           "linux",
+		RootFS: ociv1.RootFS{
+			Type:    "layers",
+			DiffIDs: []digest.Digest{diffID},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	cfgDesc := ociv1.Descriptor{
+		MediaType: ociv1.MediaTypeImageConfig,
+		Digest:    digest.FromBytes(cfgData),
+		Size:      int64(len(cfgData)),
+	}
+
+	mfData, err := json.Marshal(ociv1.Manifest{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: ociv1.MediaTypeImageManifest,
+		Config:    cfgDesc,
+		Layers:    []ociv1.Descriptor{layerDesc},
+		Annotations: map[string]string{
+			ociv1.AnnotationCreated: created.Format(time.RFC3339),
+			AnnotationWorkspaceID:   workspaceID,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	mfDesc := ociv1.Descriptor{
+		MediaType: ociv1.MediaTypeImageManifest,
+		Digest:    digest.FromBytes(mfData),
+		Size:      int64(len(mfData)),
+	}
+
+	pusher, err := e.resolver.Pusher(ctx, ref.String())
+	if err != nil {
+		return "", xerrors.Errorf("cannot create pusher: %w", err)
+	}
+
+	_, err = layer.Seek(0, io.SeekStart)
+	if err != nil {
+		return "", err
+	}
+	// The manifest must be pushed last as registries validate the blobs it references.
+	for _, c := range []struct {
+		Desc ociv1.Descriptor
+		Data io.Reader
+	}{
+		{layerDesc, layer},
+		{cfgDesc, strings.NewReader(string(cfgData))},
+		{mfDesc, strings.NewReader(string(mfData))},
+	} {
+		err = pushContent(ctx, pusher, c.Desc, c.Data)
+		if err != nil {
+			return "", xerrors.Errorf("cannot push %s: %w", c.Desc.Digest, err)
+		}
+	}
+
+	digestRef, err := dockerref.WithDigest(e.repository, mfDesc.Digest)
+	if err != nil {
+		return "", err
+	}
+	return digestRef.String(), nil
+}
+
+// BuildSnapshotLayer turns a snapshot archive into a gzipped image layer which places the content in the
+// workspace directory. All files are owned by the gitpod user, as they are in workspaces. BuildSnapshotLayer
+// returns the digest of the uncompressed layer.
+func BuildSnapshotLayer(archive io.Reader, dst io.Writer) (diffID digest.Digest, err error) {
+	gz := gzip.NewWriter(dst)
+	diffIDDigester := digest.Canonical.Digester()
+	tw := tar.NewWriter(io.MultiWriter(gz, diffIDDigester.Hash()))
+
+	err = tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     snapshotLayerRoot + "/",
+		Mode:     0755,
+		Uid:      wsinit.GitpodUID,
+		Gid:      wsinit.GitpodGID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	tr := tar.NewReader(archive)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", xerrors.Errorf("cannot read snapshot: %w", err)
+		}
+
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "/"))
+		if name == "." {
+			// the layer root takes the place of the root of the snapshot
+			continue
+		}
+		if name == ".." || strings.HasPrefix(name, "../") {
+			return "", xerrors.Errorf("snapshot contains invalid path %s", hdr.Name)
+		}
+		hdr.Name = path.Join(snapshotLayerRoot, name)
+		if hdr.Typeflag == tar.TypeDir {
+			hdr.Name += "/"
+		}
+		if hdr.Typeflag == tar.TypeLink {
+			// hard links are relative to the root of the archive, symlinks are kept as they are
+			hdr.Linkname = path.Join(snapshotLayerRoot, path.Clean(strings.TrimPrefix(hdr.Linkname, "/")))
+		}
+		hdr.Uid, hdr.Gid = wsinit.GitpodUID, wsinit.GitpodGID
+		hdr.Uname, hdr.Gname = "", ""
+
+		err = tw.WriteHeader(hdr)
+		if err != nil {
+			return "", err
+		}
+		_, err = io.Copy(tw, tr)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return "", err
+	}
+	err = gz.Close()
+	if err != nil {
+		return "", err
+	}
+	return diffIDDigester.Digest(), nil
+}
+
+func pushContent(ctx context.Context, pusher remotes.Pusher, desc ociv1.Descriptor, data io.Reader) error {
+	w, err := pusher.Push(ctx, desc)
+	if errdefs.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	_, err = io.Copy(w, data)
+	if err != nil {
+		return err
+	}
+	err = w.Commit(ctx, desc.Size, desc.Digest)
+	if errdefs.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// registryAuth holds the credentials of a Docker config file
+type registryAuth struct {
+	Auths map[string]struct {
+		Auth     string `json:"auth"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"auths"`
+}
+
+func loadRegistryAuth(fn string) (*registryAuth, error) {
+	fc, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read registry auth: %w", err)
+	}
+	var res registryAuth
+	err = json.Unmarshal(fc, &res)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse registry auth: %w", err)
+	}
+	return &res, nil
+}
+
+// Credentials returns the username and password for a registry host
+func (a *registryAuth) Credentials(host string) (user, pass string, err error) {
+	candidates := []string{host, "https://" + host}
+	if host == "registry-1.docker.io" {
+		candidates = append(candidates, "https://index.docker.io/v1/", "index.docker.io", "docker.io")
+	}
+	for _, c := range candidates {
+		entry, ok := a.Auths[c]
+		if !ok {
+			continue
+		}
+		if entry.Auth == "" {
+			return entry.Username, entry.Password, nil
+		}
+		decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+		if err != nil {
+			return "", "", xerrors.Errorf("cannot decode auth of %s: %w", c, err)
+		}
+		user, pass, _ = strings.Cut(string(decoded), ":")
+		return user, pass, nil
+	}
+	return "", "", nil
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+	"github.com/google/go-cmp/cmp"
+	"github.com/opencontainers/go-digest"
+)
+
+func TestBuildSnapshotLayer(t *testing.T) {
+	type entry struct {
+		Name     string
+		Typeflag byte
+		Linkname string
+		Uid      int
+		Gid      int
+		Content  string
+	}
+
+	tests := []struct {
+		Name        string
+		Archive     []*tar.Header
+		Expectation []entry
+		Error       bool
+	}{
+		{
+			Name: "places content in workspace",
+			Archive: []*tar.Header{
+				{Name: "./", Typeflag: tar.TypeDir, Mode: 0755},
+				{Name: "repo/", Typeflag: tar.TypeDir, Mode: 0755},
+				{Name: "repo/README.md", Typeflag: tar.TypeReg, Mode: 0644, Uid: 0, Gid: 0},
+				{Name: "repo/link", Typeflag: tar.TypeSymlink, Linkname: "README.md"},
+				{Name: "repo/hardlink", Typeflag: tar.TypeLink, Linkname: "repo/README.md"},
+			},
+			Expectation: []entry{
+				{Name: "workspace/", Typeflag: tar.TypeDir, Uid: 33333, Gid: 33333},
+				{Name: "workspace/repo/", Typeflag: tar.TypeDir, Uid: 33333, Gid: 33333},
+				{Name: "workspace/repo/README.md", Typeflag: tar.TypeReg, Uid: 33333, Gid: 33333, Content: "repo/README.md"},
+				{Name: "workspace/repo/link", Typeflag: tar.TypeSymlink, Linkname: "README.md", Uid: 33333, Gid: 33333},
+				{Name: "workspace/repo/hardlink", Typeflag: tar.TypeLink, Linkname: "workspace/repo/README.md", Uid: 33333, Gid: 33333},
+			},
+		},
+		{
+			Name: "rejects paths outside the workspace",
+			Archive: []*tar.Header{
+				{Name: "../etc/passwd", Typeflag: tar.TypeReg, Mode: 0644},
+			},
+			Error: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var archive bytes.Buffer
+			tw := tar.NewWriter(&archive)
+			for _, hdr := range test.Archive {
+				if hdr.Typeflag == tar.TypeReg {
+					hdr.Size = int64(len(hdr.Name))
+				}
+				err := tw.WriteHeader(hdr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if hdr.Typeflag == tar.TypeReg {
+					_, err = tw.Write([]byte(hdr.Name))
+					if err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			err := tw.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var layer bytes.Buffer
+			diffID, err := content.BuildSnapshotLayer(&archive, &layer)
+			if test.Error {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gz, err := gzip.NewReader(&layer)
+			if err != nil {
+				t.Fatal(err)
+			}
+			uncompressed, err := io.ReadAll(gz)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if act := digest.FromBytes(uncompressed); act != diffID {
+				t.Errorf("unexpected diffID: expected %s, got %s", act, diffID)
+			}
+
+			var act []entry
+			tr := tar.NewReader(bytes.NewReader(uncompressed))
+			for {
+				hdr, err := tr.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				c, err := io.ReadAll(tr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				act = append(act, entry{
+					Name:     hdr.Name,
+					Typeflag: hdr.Typeflag,
+					Linkname: hdr.Linkname,
+					Uid:      hdr.Uid,
+					Gid:      hdr.Gid,
+					Content:  string(c),
+				})
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected layer (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -7,8 +7,10 @@ package content
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io/fs"
 	"os"
+	"path/filepath"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
@@ -19,6 +21,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 	"github.com/opentracing/opentracing-go"
+	"golang.org/x/sys/unix"
 	"golang.org/x/xerrors"
 )
 
@@ -26,11 +29,14 @@ import (
 func WorkspaceLifecycleHooks(cfg Config, workspaceCIDR string, uidmapper *iws.Uidmapper, xfs *quota.XFS, cgroupMountPoint string) map[session.WorkspaceState][]session.WorkspaceLivecycleHook {
 	// startIWS starts the in-workspace service for a workspace. This lifecycle hook is idempotent, hence can - and must -
 	// be called on initialization and ready. The on-ready hook exists only to support ws-daemon restarts.
-	startIWS := iws.ServeWorkspace(uidmapper, api.FSShiftMethod(cfg.UserNamespaces.FSShift), cgroupMountPoint, workspaceCIDR)
+	startIWS := iws.ServeWorkspace(uidmapper, api.FSShiftMethod(cfg.UserNamespaces.FSShift), cgroupMountPoint, workspaceCIDR, xfs)
 
 	return map[session.WorkspaceState][]session.WorkspaceLivecycleHook{
 		session.WorkspaceInitializing: {
 			hookSetupWorkspaceLocation,
+			// must run while the workspace location is still empty
+			hookEncryptWorkspaceLocation(cfg.Encryption),
+			hookMountEphemeralStorage,
 			startIWS, // workspacekit is waiting for starting IWS, so it needs to start as soon as possible.
 			hookSetupRemoteStorage(cfg),
 			// When starting a workspace, use soft limit for the following reason to ensure content is restored
@@ -42,10 +48,16 @@ func WorkspaceLifecycleHooks(cfg Config, workspaceCIDR string, uidmapper *iws.Ui
 			startIWS,
 			hookSetupRemoteStorage(cfg),
 			hookInstallQuota(xfs, true),
+			// must run after hookInstallQuota which moves the Docker data root into the workspace project
+			hookInstallDockerCacheQuota(cfg.DockerCache, xfs),
 		},
 		session.WorkspaceDisposed: {
 			iws.StopServingWorkspace,
 			hookRemoveQuota(xfs),
+			hookRemoveDockerCacheQuota(xfs),
+			hookUnmountEphemeralStorage,
+			// runs after the backup, which needs to read the workspace content
+			hookRemoveEncryptionKey,
 		},
 	}
 }
@@ -121,6 +133,11 @@ func hookInstallQuota(xfs *quota.XFS, isHard bool) session.WorkspaceLivecycleHoo
 			return nil
 		}
 
+		if ws.Ephemeral {
+			// the size of the tmpfs limits ephemeral workspaces
+			return nil
+		}
+
 		size := quota.Size(ws.StorageQuota)
 
 		log.WithFields(ws.OWI()).WithField("isHard", isHard).WithField("size", size).WithField("directory", ws.Location).Debug("setting disk quota")
@@ -164,3 +181,137 @@ func hookRemoveQuota(xfs *quota.XFS) session.WorkspaceLivecycleHook {
 		return xfs.RemoveQuota(ws.XFSProjectID)
 	}
 }
+
+// hookInstallDockerCacheQuota enforces a filesystem quota of its own on the Docker data root of the workspace,
+// such that images and the build cache do not eat up the workspace quota.
+func hookInstallDockerCacheQuota(cfg DockerCacheConfig, xfs *quota.XFS) session.WorkspaceLivecycleHook {
+	return func(ctx context.Context, ws *session.Workspace) (err error) {
+		span, _ := opentracing.StartSpanFromContext(ctx, "hook.InstallDockerCacheQuota")
+		defer tracing.FinishSpan(span, &err)
+
+		if !cfg.Enabled || xfs == nil || ws.Ephemeral {
+			return nil
+		}
+
+		// The data root must exist before dockerd starts, otherwise its content would be charged to the workspace project.
+		location := filepath.Join(ws.Location, DockerDataRoot)
+		err = os.Mkdir(location, 0710)
+		if err == nil {
+			err = os.Chown(location, initializer.GitpodUID, initializer.GitpodGID)
+		}
+		if err != nil && !errors.Is(err, fs.ErrExist) {
+			log.WithFields(ws.OWI()).WithError(err).Warn("cannot create docker data root")
+			return nil
+		}
+
+		log.WithFields(ws.OWI()).WithField("size", cfg.Size).WithField("directory", location).Debug("setting docker cache quota")
+
+		var prj int
+		if ws.DockerCacheXFSProjectID != 0 {
+			xfs.RegisterProject(ws.DockerCacheXFSProjectID)
+			prj, err = xfs.SetQuotaWithPrjId(location, cfg.Size, ws.DockerCacheXFSProjectID, true)
+		} else {
+			prj, err = xfs.SetQuota(location, cfg.Size, true)
+		}
+		if err != nil {
+			log.WithFields(ws.OWI()).WithError(err).Warn("cannot enforce docker cache size limit")
+			return nil
+		}
+		ws.DockerCacheXFSProjectID = prj
+
+		return nil
+	}
+}
+
+// hookRemoveDockerCacheQuota removes the filesystem quota of the Docker data root
+func hookRemoveDockerCacheQuota(xfs *quota.XFS) session.WorkspaceLivecycleHook {
+	return func(ctx context.Context, ws *session.Workspace) (err error) {
+		span, _ := opentracing.StartSpanFromContext(ctx, "hook.RemoveDockerCacheQuota")
+		defer tracing.FinishSpan(span, &err)
+
+		if xfs == nil || ws.DockerCacheXFSProjectID == 0 {
+			return nil
+		}
+
+		return xfs.RemoveQuota(ws.DockerCacheXFSProjectID)
+	}
+}
+
+// hookMountEphemeralStorage mounts a tmpfs on the location of ephemeral workspaces, such that their content never
+// touches the node's disk. The tmpfs is sized by the storage quota, which is why it is required.
+func hookMountEphemeralStorage(ctx context.Context, ws *session.Workspace) (err error) {
+	//nolint:ineffassign
+	span, _ := opentracing.StartSpanFromContext(ctx, "hook.MountEphemeralStorage")
+	defer tracing.FinishSpan(span, &err)
+
+	if !ws.Ephemeral {
+		return nil
+	}
+	if ws.StorageQuota == 0 {
+		log.WithFields(ws.OWI()).Warn("no storage quota defined, keeping ephemeral workspace content on disk")
+		return nil
+	}
+
+	mounted, err := isMountpoint(ws.Location)
+	if err != nil {
+		return xerrors.Errorf("cannot mount ephemeral storage: %w", err)
+	}
+	if mounted {
+		return nil
+	}
+
+	opts := fmt.Sprintf("size=%d,mode=0755,uid=%d,gid=%d", ws.StorageQuota, initializer.GitpodUID, initializer.GitpodGID)
+	err = unix.Mount("tmpfs", ws.Location, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, opts)
+	if err != nil {
+		return xerrors.Errorf("cannot mount ephemeral storage: %w", err)
+	}
+	log.WithFields(ws.OWI()).WithField("size", quota.Size(ws.StorageQuota)).Debug("mounted ephemeral storage")
+
+	return nil
+}
+
+// hookUnmountEphemeralStorage unmounts the tmpfs of ephemeral workspaces, which frees the memory held by their content
+func hookUnmountEphemeralStorage(ctx context.Context, ws *session.Workspace) (err error) {
+	//nolint:ineffassign
+	span, _ := opentracing.StartSpanFromContext(ctx, "hook.UnmountEphemeralStorage")
+	defer tracing.FinishSpan(span, &err)
+
+	if !ws.Ephemeral {
+		return nil
+	}
+
+	mounted, err := isMountpoint(ws.Location)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return xerrors.Errorf("cannot unmount ephemeral storage: %w", err)
+	}
+	if !mounted {
+		return nil
+	}
+
+	err = unix.Unmount(ws.Location, 0)
+	if err != nil {
+		log.WithFields(ws.OWI()).WithError(err).Warn("cannot unmount ephemeral storage, detaching it")
+		err = unix.Unmount(ws.Location, unix.MNT_DETACH)
+		if err != nil {
+			return xerrors.Errorf("cannot unmount ephemeral storage: %w", err)
+		}
+	}
+	return nil
+}
+
+// isMountpoint returns true if path resides on another device than its parent
+func isMountpoint(path string) (bool, error) {
+	var st, parent unix.Stat_t
+	err := unix.Stat(path, &st)
+	if err != nil {
+		return false, err
+	}
+	err = unix.Stat(filepath.Dir(path), &parent)
+	if err != nil {
+		return false, err
+	}
+	return st.Dev != parent.Dev, nil
+}
@@ -9,7 +9,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -46,6 +48,10 @@ type RunInitializerOpts struct {
 	GID uint32
 
 	OWI OWI
+
+	// CachedContent maps remote content names to archives in the node's restore cache.
+	// Cached content is extracted from the archive instead of being downloaded.
+	CachedContent map[string]string
 }
 
 type OWI struct {
@@ -74,6 +80,11 @@ func CollectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 		return nil, err
 	} else {
 		rc[storage.DefaultBackup] = *backup
+
+		err = collectDeltaLayers(ctx, rs, ps, workspaceOwner, backup.Meta.Digest, rc)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot collect delta layers: %w", err)
+		}
 	}
 
 	si := initializer.GetSnapshot()
@@ -121,6 +132,55 @@ func CollectRemoteContent(ctx context.Context, rs storage.DirectAccess, ps stora
 	return rc, nil
 }
 
+// collectDeltaLayers adds the delta layers listed in the delta manifest of the default backup to the remote content.
+// Layers are only added if they were taken against the full backup with the given digest.
+func collectDeltaLayers(ctx context.Context, rs storage.DirectAccess, ps storage.PresignedAccess, workspaceOwner, base string, rc map[string]storage.DownloadInfo) error {
+	if base == "" {
+		return nil
+	}
+
+	bkt := rs.Bucket(workspaceOwner)
+	info, err := ps.SignDownload(ctx, bkt, rs.BackupObject(storage.DefaultBackupDelta), &storage.SignedURLOptions{})
+	if err == storage.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, info.URL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return xerrors.Errorf("cannot download delta manifest: status %d", resp.StatusCode)
+	}
+
+	var manifest storage.DeltaManifest
+	err = json.NewDecoder(resp.Body).Decode(&manifest)
+	if err != nil {
+		return xerrors.Errorf("cannot unmarshal delta manifest: %w", err)
+	}
+	if manifest.Base != base {
+		// the full backup was replaced after the delta layers were taken
+		return nil
+	}
+
+	for i, layer := range manifest.Layers {
+		info, err := ps.SignDownload(ctx, bkt, rs.BackupObject(layer), &storage.SignedURLOptions{})
+		if err != nil {
+			return xerrors.Errorf("cannot find delta layer %s: %w", layer, err)
+		}
+		rc[storage.DeltaLayer(i+1)] = *info
+	}
+	return nil
+}
+
 // RunInitializer runs a content initializer in a user, PID and mount namespace to isolate it from ws-daemon
 func RunInitializer(ctx context.Context, destination string, initializer *csapi.WorkspaceInitializer, remoteContent map[string]storage.DownloadInfo, opts RunInitializerOpts) (err error) {
 	//nolint:ineffassign,staticcheck
@@ -167,6 +227,25 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 		UID:           int(opts.UID),
 		OWI:           opts.OWI.Fields(),
 	}
+	var cacheMounts []specs.Mount
+	for name, src := range opts.CachedContent {
+		if _, err := os.Stat(src); err != nil {
+			// the content initializer downloads the content itself
+			log.WithError(err).WithFields(opts.OWI.Fields()).WithField("name", name).Warn("cached content is gone, downloading it instead")
+			continue
+		}
+		if msg.CachedContent == nil {
+			msg.CachedContent = make(map[string]string, len(opts.CachedContent))
+		}
+		dst := fmt.Sprintf("/cache/%d.tar", len(cacheMounts))
+		msg.CachedContent[name] = dst
+		cacheMounts = append(cacheMounts, specs.Mount{
+			Destination: dst,
+			Source:      src,
+			Type:        "bind",
+			Options:     []string{"bind", "rprivate", "ro"},
+		})
+	}
 	fc, err := json.MarshalIndent(msg, "", "  ")
 	if err != nil {
 		return err
@@ -193,6 +272,7 @@ func RunInitializer(ctx context.Context, destination string, initializer *csapi.
 		Type:        "bind",
 		Options:     []string{"bind", "rprivate"},
 	})
+	spec.Mounts = append(spec.Mounts, cacheMounts...)
 
 	spec.Hostname = "content-init"
 	spec.Process.Terminal = false
@@ -326,7 +406,7 @@ func RunInitializerChild() (err error) {
 		return err
 	}
 
-	rs := &remoteContentStorage{RemoteContent: initmsg.RemoteContent}
+	rs := &remoteContentStorage{RemoteContent: initmsg.RemoteContent, CachedContent: initmsg.CachedContent}
 
 	dst := initmsg.Destination
 	initializer, err := wsinit.NewFromRequest(ctx, dst, rs, &req, wsinit.NewFromRequestOpts{ForceGitpodUserForGit: false})
@@ -364,6 +444,7 @@ var _ storage.DirectAccess = &remoteContentStorage{}
 
 type remoteContentStorage struct {
 	RemoteContent map[string]storage.DownloadInfo
+	CachedContent map[string]string
 }
 
 // Init does nothing
@@ -388,6 +469,14 @@ func (rs *remoteContentStorage) Download(ctx context.Context, destination string
 		return false, nil
 	}
 
+	if cached, ok := rs.CachedContent[name]; ok {
+		if _, err := os.Stat(cached); err == nil {
+			span.SetTag("cached", true)
+			return true, rs.extract(ctx, cached, destination, name, mappings)
+		}
+		log.WithField("name", name).WithField("path", cached).Warn("cached content is gone, downloading it instead")
+	}
+
 	span.SetTag("URL", info.URL)
 
 	// create a temporal file to download the content
@@ -416,23 +505,32 @@ func (rs *remoteContentStorage) Download(ctx context.Context, destination string
 	downloadDuration := time.Since(downloadStart)
 	log.WithField("downloadDuration", downloadDuration.String()).Info("aria2c download duration")
 
-	tempFile, err = os.Open(tempFile.Name())
+	defer os.Remove(tempFile.Name())
+
+	return true, rs.extract(ctx, tempFile.Name(), destination, name, mappings)
+}
+
+func (rs *remoteContentStorage) extract(ctx context.Context, src string, destination string, name string, mappings []archive.IDMapping) error {
+	f, err := os.Open(src)
 	if err != nil {
-		return true, xerrors.Errorf("unexpected error downloading file")
+		return xerrors.Errorf("cannot open content archive: %w", err)
 	}
+	defer f.Close()
 
-	defer os.Remove(tempFile.Name())
-	defer tempFile.Close()
+	opts := []archive.TarOption{archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings)}
+	if storage.IsDeltaLayer(name) {
+		opts = append(opts, archive.WithWhiteouts())
+	}
 
 	extractStart := time.Now()
-	err = archive.ExtractTarbal(ctx, tempFile, destination, archive.WithUIDMapping(mappings), archive.WithGIDMapping(mappings))
+	err = archive.ExtractTarbal(ctx, f, destination, opts...)
 	if err != nil {
-		return true, xerrors.Errorf("tar %s: %s", destination, err.Error())
+		return xerrors.Errorf("tar %s: %s", destination, err.Error())
 	}
 	extractDuration := time.Since(extractStart)
 	log.WithField("extractDuration", extractDuration.String()).Info("extract tarbal duration")
 
-	return true, nil
+	return nil
 }
 
 // DownloadSnapshot always returns false and does nothing
@@ -483,6 +581,7 @@ func (rs *remoteContentStorage) SnapshotObject(name string) string {
 type msgInitContent struct {
 	Destination   string
 	RemoteContent map[string]storage.DownloadInfo
+	CachedContent map[string]string
 	Initializer   []byte
 	UID, GID      int
 	IDMappings    []archive.IDMapping
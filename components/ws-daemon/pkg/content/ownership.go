@@ -0,0 +1,102 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+// OwnershipRepairConfig configures the repair of the ownership and permissions of restored workspace content
+type OwnershipRepairConfig struct {
+	// Enabled hands restored files the workspace user cannot access back to it. Older backups and imported
+	// archives contain files owned by users which do not exist within the workspace.
+	Enabled bool `json:"enabled"`
+
+	// IncludeRoot also hands files owned by root within the workspace to the workspace user
+	IncludeRoot bool `json:"includeRoot,omitempty"`
+}
+
+// OwnershipRepairStats counts the files an ownership repair changed
+type OwnershipRepairStats struct {
+	Chowned  int
+	Chmodded int
+}
+
+// RepairOwnership hands the files below location the workspace user cannot access back to it. Files whose owner or
+// group does not exist within the workspace, i.e. is not covered by mappings, are chowned to uid and gid. So are files
+// owned by root within the workspace if includeRoot is true. Files of uid the user cannot read and directories it cannot
+// list get the missing permissions. Symlinks are not followed, and the Docker data root is left alone because
+// the files of containers are owned by all sorts of users.
+func RepairOwnership(location string, mappings []carchive.IDMapping, uid, gid int, includeRoot bool) (stats OwnershipRepairStats, err error) {
+	var rootUID = -1
+	for _, m := range mappings {
+		if m.ContainerID == 0 {
+			rootUID = m.HostID
+		}
+	}
+	repair := func(id, to int) int {
+		if id == to {
+			return -1
+		}
+		if includeRoot && id == rootUID {
+			return to
+		}
+		for _, m := range mappings {
+			if id >= m.HostID && id < m.HostID+m.Size {
+				return -1
+			}
+		}
+		return to
+	}
+
+	err = filepath.WalkDir(location, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && path == filepath.Join(location, DockerDataRoot) {
+			return filepath.SkipDir
+		}
+
+		var stat unix.Stat_t
+		err = unix.Lstat(path, &stat)
+		if err != nil {
+			return err
+		}
+
+		newUID, newGID := repair(int(stat.Uid), uid), repair(int(stat.Gid), gid)
+		if newUID != -1 || newGID != -1 {
+			err = os.Lchown(path, newUID, newGID)
+			if err != nil {
+				return err
+			}
+			stats.Chowned++
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 || newUID == -1 && int(stat.Uid) != uid {
+			return nil
+		}
+		mode := stat.Mode & 0o7777
+		required := uint32(unix.S_IRUSR)
+		if d.IsDir() {
+			required |= unix.S_IXUSR
+		}
+		if mode&required == required {
+			return nil
+		}
+		err = unix.Chmod(path, mode|required)
+		if err != nil {
+			return err
+		}
+		stats.Chmodded++
+		return nil
+	})
+	return stats, err
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/sys/unix"
+
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+func TestRepairOwnership(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("changing the owner of files requires root")
+	}
+
+	const (
+		rootUID = 33333
+		userUID = 133332
+	)
+	mappings := []carchive.IDMapping{
+		{ContainerID: 0, HostID: rootUID, Size: 1},
+		{ContainerID: 1, HostID: 100000, Size: 65534},
+	}
+
+	type file struct {
+		Name string
+		Dir  bool
+		UID  int
+		GID  int
+		Mode uint32
+	}
+	type Expectation struct {
+		Files []file
+		Stats OwnershipRepairStats
+	}
+	tests := []struct {
+		Name        string
+		IncludeRoot bool
+		Files       []file
+		Expectation Expectation
+	}{
+		{
+			Name: "accessible content",
+			Files: []file{
+				{Name: "src", Dir: true, UID: userUID, GID: userUID, Mode: 0755},
+				{Name: "src/main.go", UID: userUID, GID: userUID, Mode: 0644},
+				{Name: "src/other-user", UID: 100500, GID: 100500, Mode: 0600},
+				{Name: "src/root", UID: rootUID, GID: rootUID, Mode: 0600},
+			},
+			Expectation: Expectation{Files: []file{
+				{Name: "src", Dir: true, UID: userUID, GID: userUID, Mode: 0755},
+				{Name: "src/main.go", UID: userUID, GID: userUID, Mode: 0644},
+				{Name: "src/other-user", UID: 100500, GID: 100500, Mode: 0600},
+				{Name: "src/root", UID: rootUID, GID: rootUID, Mode: 0600},
+			}},
+		},
+		{
+			Name: "unmapped owners",
+			Files: []file{
+				{Name: "src", Dir: true, UID: 1000, GID: 1000, Mode: 0700},
+				{Name: "src/main.go", UID: 1000, GID: userUID, Mode: 0644},
+				{Name: "src/group", UID: userUID, GID: 200000, Mode: 0644},
+			},
+			Expectation: Expectation{
+				Files: []file{
+					{Name: "src", Dir: true, UID: userUID, GID: userUID, Mode: 0700},
+					{Name: "src/main.go", UID: userUID, GID: userUID, Mode: 0644},
+					{Name: "src/group", UID: userUID, GID: userUID, Mode: 0644},
+				},
+				Stats: OwnershipRepairStats{Chowned: 3},
+			},
+		},
+		{
+			Name:        "root",
+			IncludeRoot: true,
+			Files: []file{
+				{Name: "src", Dir: true, UID: rootUID, GID: rootUID, Mode: 0755},
+				{Name: DockerDataRoot, Dir: true, UID: rootUID, GID: rootUID, Mode: 0710},
+				{Name: DockerDataRoot + "/image", UID: rootUID, GID: rootUID, Mode: 0600},
+			},
+			Expectation: Expectation{
+				Files: []file{
+					{Name: "src", Dir: true, UID: userUID, GID: userUID, Mode: 0755},
+					{Name: DockerDataRoot, Dir: true, UID: rootUID, GID: rootUID, Mode: 0710},
+					{Name: DockerDataRoot + "/image", UID: rootUID, GID: rootUID, Mode: 0600},
+				},
+				Stats: OwnershipRepairStats{Chowned: 1},
+			},
+		},
+		{
+			Name: "permissions",
+			Files: []file{
+				{Name: "src", Dir: true, UID: userUID, GID: userUID, Mode: 0200},
+				{Name: "src/main.go", UID: userUID, GID: userUID, Mode: 0200},
+				{Name: "src/readonly", UID: userUID, GID: userUID, Mode: 0444},
+				{Name: "src/other-user", UID: 100500, GID: 100500, Mode: 0},
+			},
+			Expectation: Expectation{
+				Files: []file{
+					{Name: "src", Dir: true, UID: userUID, GID: userUID, Mode: 0700},
+					{Name: "src/main.go", UID: userUID, GID: userUID, Mode: 0600},
+					{Name: "src/readonly", UID: userUID, GID: userUID, Mode: 0444},
+					{Name: "src/other-user", UID: 100500, GID: 100500, Mode: 0},
+				},
+				Stats: OwnershipRepairStats{Chmodded: 2},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			location := t.TempDir()
+			err := os.Chown(location, userUID, userUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range test.Files {
+				fn := filepath.Join(location, f.Name)
+				if f.Dir {
+					err = os.Mkdir(fn, 0755)
+				} else {
+					err = os.WriteFile(fn, nil, 0644)
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				err = os.Lchown(fn, f.UID, f.GID)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			// we restrict the permissions once all files exist, which needs directories to be writable
+			for i := len(test.Files) - 1; i >= 0; i-- {
+				err = unix.Chmod(filepath.Join(location, test.Files[i].Name), test.Files[i].Mode)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			stats, err := RepairOwnership(location, mappings, userUID, userUID, test.IncludeRoot)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act := Expectation{Stats: stats}
+			for _, f := range test.Files {
+				var stat unix.Stat_t
+				err = unix.Lstat(filepath.Join(location, f.Name), &stat)
+				if err != nil {
+					t.Fatal(err)
+				}
+				act.Files = append(act.Files, file{Name: f.Name, Dir: f.Dir, UID: int(stat.Uid), GID: int(stat.Gid), Mode: stat.Mode & 0o7777})
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected RepairOwnership (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
+)
+
+// PathFilter restricts an archive to a subset of the workspace content
+type PathFilter struct {
+	// Includes are the paths relative to the workspace location the archive is restricted to
+	Includes []string
+	// Excludes are the patterns of paths left out of the archive
+	Excludes []string
+}
+
+// NewPathFilter resolves paths relative to the workspace location into a path filter. Paths may be glob patterns,
+// which are expanded against the workspace content. Paths prefixed with "!" exclude matching paths, e.g. "!**/node_modules".
+// If no paths are given, the filter is nil and the archive contains the whole workspace.
+func NewPathFilter(location string, paths []string) (*PathFilter, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	var (
+		res      PathFilter
+		patterns int
+		seen     = make(map[string]struct{})
+	)
+	for _, p := range paths {
+		exclude := strings.HasPrefix(p, "!")
+		p = strings.TrimPrefix(p, "!")
+		if filepath.IsAbs(p) {
+			return nil, xerrors.Errorf("path %s must be relative to the workspace location", p)
+		}
+		p = filepath.Clean(p)
+		if p == ".." || strings.HasPrefix(p, "../") {
+			return nil, xerrors.Errorf("path %s is outside of the workspace location", p)
+		}
+		if _, err := filepath.Match(p, ""); err != nil {
+			return nil, xerrors.Errorf("invalid path %s: %w", p, err)
+		}
+
+		if exclude {
+			res.Excludes = append(res.Excludes, p)
+			continue
+		}
+
+		patterns++
+		matches, err := filepath.Glob(filepath.Join(location, p))
+		if err != nil {
+			return nil, xerrors.Errorf("invalid path %s: %w", p, err)
+		}
+		for _, m := range matches {
+			rel, err := filepath.Rel(location, m)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := seen[rel]; ok {
+				continue
+			}
+			seen[rel] = struct{}{}
+			res.Includes = append(res.Includes, rel)
+		}
+	}
+	if patterns > 0 && len(res.Includes) == 0 {
+		// an empty include list would archive the whole workspace
+		return nil, xerrors.Errorf("paths %v match no workspace content", paths)
+	}
+	if patterns == 0 {
+		res.Includes = []string{"."}
+	}
+	sort.Strings(res.Includes)
+
+	return &res, nil
+}
+
+// TarOptions returns the archive options applying the filter in addition to the given excludes
+func (f *PathFilter) TarOptions(excludes []string) []carchive.TarOption {
+	if f == nil {
+		return []carchive.TarOption{carchive.WithExcludes(excludes)}
+	}
+
+	return []carchive.TarOption{
+		carchive.WithIncludes(f.Includes),
+		carchive.WithExcludes(append(append([]string{}, excludes...), f.Excludes...)),
+	}
+}
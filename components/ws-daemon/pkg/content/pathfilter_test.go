@@ -0,0 +1,129 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+)
+
+func TestPathFilter(t *testing.T) {
+	files := map[string]string{
+		".gitpod.yml":                     "tasks: []",
+		"repo/main.js":                    "main",
+		"repo/node_modules/dep/index.js":  "dep",
+		"repo/pkg/a/node_modules/b/b.js":  "b",
+		"repo/pkg/a/index.js":             "a",
+		"other/main.go":                   "main",
+		"another/main.go":                 "main",
+		"another/vendor/modules.txt":      "modules",
+		"another/vendor/dep/dep.go":       "dep",
+		"another/vendor/dep/dep_test.go":  "dep",
+		"another/.git/HEAD":               "ref: refs/heads/main",
+		"another/.git/refs/heads/main.go": "not a go file",
+	}
+
+	tests := []struct {
+		Name        string
+		Paths       []string
+		Expectation map[string]string
+		Error       bool
+	}{
+		{
+			Name:        "no paths",
+			Expectation: files,
+		},
+		{
+			Name:  "path",
+			Paths: []string{"repo"},
+			Expectation: map[string]string{
+				"repo/main.js":                   "main",
+				"repo/node_modules/dep/index.js": "dep",
+				"repo/pkg/a/node_modules/b/b.js": "b",
+				"repo/pkg/a/index.js":            "a",
+			},
+		},
+		{
+			Name:  "path with excludes",
+			Paths: []string{"repo", "!**/node_modules"},
+			Expectation: map[string]string{
+				"repo/main.js":        "main",
+				"repo/pkg/a/index.js": "a",
+			},
+		},
+		{
+			Name:  "glob",
+			Paths: []string{"*/main.*", ".gitpod.yml"},
+			Expectation: map[string]string{
+				".gitpod.yml":     "tasks: []",
+				"repo/main.js":    "main",
+				"other/main.go":   "main",
+				"another/main.go": "main",
+			},
+		},
+		{
+			Name:  "excludes only",
+			Paths: []string{"!repo", "!another/vendor", "!**/.git"},
+			Expectation: map[string]string{
+				".gitpod.yml":     "tasks: []",
+				"other/main.go":   "main",
+				"another/main.go": "main",
+			},
+		},
+		{
+			Name:  "no match",
+			Paths: []string{"does-not-exist"},
+			Error: true,
+		},
+		{
+			Name:  "absolute path",
+			Paths: []string{"/workspace/repo"},
+			Error: true,
+		},
+		{
+			Name:  "outside of the workspace",
+			Paths: []string{"repo/../../etc"},
+			Error: true,
+		},
+		{
+			Name:  "invalid pattern",
+			Paths: []string{"repo/["},
+			Error: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			src, dst := t.TempDir(), t.TempDir()
+			writeFiles(t, src, files)
+
+			filter, err := content.NewPathFilter(src, test.Paths)
+			if test.Error {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fn := filepath.Join(t.TempDir(), "snapshot.tar")
+			err = content.BuildTarbal(context.Background(), src, fn, filter.TarOptions(nil)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			extract(t, fn, dst)
+
+			if diff := cmp.Diff(test.Expectation, readFiles(t, dst)); diff != "" {
+				t.Errorf("unexpected snapshot content (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
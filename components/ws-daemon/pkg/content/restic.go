@@ -0,0 +1,286 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	cntntcfg "github.com/gitpod-io/gitpod/content-service/api/config"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+const (
+	defaultResticCommand  = "restic"
+	defaultResticKeepLast = 3
+
+	// resticRepositoryObject is the name of the restic repository of a workspace in its backup location
+	resticRepositoryObject = "restic"
+
+	// resticExitNoRepository is the exit code of restic if the repository does not exist
+	resticExitNoRepository = 10
+)
+
+// Restic backs up workspaces into restic repositories in the remote storage and restores them
+type Restic struct {
+	Config  ResticConfig
+	Storage cntntcfg.StorageConfig
+}
+
+// NewRestic creates a new restic backup engine
+func NewRestic(cfg ResticConfig, storage cntntcfg.StorageConfig) (*Restic, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Command == "" {
+		cfg.Command = defaultResticCommand
+	}
+	if cfg.KeepLast == 0 {
+		cfg.KeepLast = defaultResticKeepLast
+	}
+	if _, err := exec.LookPath(cfg.Command); err != nil {
+		return nil, xerrors.Errorf("cannot find restic: %w", err)
+	}
+	if _, err := os.Stat(cfg.PasswordFile); err != nil {
+		return nil, xerrors.Errorf("cannot read restic password: %w", err)
+	}
+	return &Restic{Config: cfg, Storage: storage}, nil
+}
+
+// ResticSummary summarises a restic backup
+type ResticSummary struct {
+	SnapshotID string `json:"snapshot_id"`
+	// DataAdded is the number of bytes uploaded to the repository
+	DataAdded int64 `json:"data_added"`
+	// TotalBytesProcessed is the size of the backed up content
+	TotalBytesProcessed int64 `json:"total_bytes_processed"`
+}
+
+type resticSnapshot struct {
+	ID    string    `json:"id"`
+	Time  time.Time `json:"time"`
+	Paths []string  `json:"paths"`
+}
+
+// Backup uploads the content of src as new snapshot of the repository of the workspace. Paths of
+// excludes are relative to src. Snapshots beyond the configured number are forgotten afterwards.
+func (r *Restic) Backup(ctx context.Context, rs storage.DirectAccess, owner, workspaceID, src string, excludes []string) (summary *ResticSummary, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Restic.Backup")
+	defer tracing.FinishSpan(span, &err)
+
+	env, err := r.environment(rs, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.run(ctx, env, "cat", "config")
+	if isResticExitCode(err, resticExitNoRepository) {
+		_, err = r.run(ctx, env, "init")
+	}
+	if err != nil {
+		return nil, xerrors.Errorf("cannot open restic repository: %w", err)
+	}
+
+	args := []string{"backup", "--json", "--host", workspaceID}
+	for _, exclude := range excludes {
+		args = append(args, "--exclude", filepath.Join(src, exclude))
+	}
+	args = append(args, src)
+	out, err := r.run(ctx, env, args...)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot back up workspace: %w", err)
+	}
+	summary, err = parseResticSummary(out)
+	if err != nil {
+		return nil, err
+	}
+
+	// The workspace location changes with every instance, hence we group all snapshots together.
+	_, err = r.run(ctx, env, "forget", "--group-by", "", "--keep-last", fmt.Sprint(r.Config.KeepLast), "--prune")
+	if err != nil {
+		// the backup is complete nonetheless, the next one forgets the snapshots again
+		return summary, xerrors.Errorf("cannot forget old snapshots: %w", err)
+	}
+	return summary, nil
+}
+
+// Restore restores the latest snapshot of the repository of the workspace into dst. It returns false if
+// the workspace has no snapshot.
+func (r *Restic) Restore(ctx context.Context, rs storage.DirectAccess, owner, dst string) (found bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Restic.Restore")
+	defer tracing.FinishSpan(span, &err)
+
+	env, err := r.environment(rs, owner)
+	if err != nil {
+		return false, err
+	}
+
+	out, err := r.run(ctx, env, "snapshots", "--json")
+	if isResticExitCode(err, resticExitNoRepository) {
+		return false, nil
+	}
+	if err != nil {
+		return false, xerrors.Errorf("cannot list restic snapshots: %w", err)
+	}
+	var snapshots []resticSnapshot
+	err = json.Unmarshal(out, &snapshots)
+	if err != nil {
+		return false, xerrors.Errorf("cannot parse restic snapshots: %w", err)
+	}
+	var latest *resticSnapshot
+	for i, s := range snapshots {
+		if len(s.Paths) != 1 {
+			continue
+		}
+		if latest == nil || s.Time.After(latest.Time) {
+			latest = &snapshots[i]
+		}
+	}
+	if latest == nil {
+		return false, nil
+	}
+	span.SetTag("snapshot", latest.ID)
+
+	// the snapshot holds the location of the workspace instance it was taken of, which we restore into dst
+	_, err = r.run(ctx, env, "restore", latest.ID+":"+latest.Paths[0], "--target", dst)
+	if err != nil {
+		return true, xerrors.Errorf("cannot restore snapshot %s: %w", latest.ID, err)
+	}
+	return true, nil
+}
+
+func (r *Restic) environment(rs storage.DirectAccess, owner string) ([]string, error) {
+	repo, env, err := resticRepository(r.Storage, rs.Bucket(owner), rs.BackupObject(resticRepositoryObject))
+	if err != nil {
+		return nil, err
+	}
+	return append(env,
+		"RESTIC_REPOSITORY="+repo,
+		"RESTIC_PASSWORD_FILE="+r.Config.PasswordFile,
+	), nil
+}
+
+func (r *Restic) run(ctx context.Context, env []string, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, r.Config.Command, append([]string{"--no-cache", "--quiet"}, args...)...)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return out, &resticError{Args: args, Stderr: strings.TrimSpace(stderr.String()), Err: err}
+	}
+	return out, nil
+}
+
+type resticError struct {
+	Args   []string
+	Stderr string
+	Err    error
+}
+
+func (e *resticError) Error() string {
+	return fmt.Sprintf("restic %s: %v: %s", e.Args[0], e.Err, e.Stderr)
+}
+
+func (e *resticError) Unwrap() error {
+	return e.Err
+}
+
+func isResticExitCode(err error, code int) bool {
+	var exitErr *exec.ExitError
+	return errors.As(err, &exitErr) && exitErr.ExitCode() == code
+}
+
+// parseResticSummary finds the summary among the JSON messages restic backup prints
+func parseResticSummary(out []byte) (*ResticSummary, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var msg struct {
+			MessageType string `json:"message_type"`
+			ResticSummary
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &msg); err != nil || msg.MessageType != "summary" {
+			continue
+		}
+		return &msg.ResticSummary, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, xerrors.Errorf("restic backup printed no summary")
+}
+
+// resticRepository returns the restic repository for an object in a bucket of the remote storage, and the
+// environment restic needs to access it
+func resticRepository(cfg cntntcfg.StorageConfig, bucket, object string) (repo string, env []string, err error) {
+	object = strings.Trim(object, "/")
+	switch cfg.Kind {
+	case cntntcfg.MinIOStorage:
+		c := cfg.MinIOConfig
+		scheme := "http"
+		if c.Secure {
+			scheme = "https"
+		}
+		accessKey, secretKey := c.AccessKeyID, c.SecretAccessKey
+		if c.AccessKeyIdFile != "" {
+			fc, err := os.ReadFile(c.AccessKeyIdFile)
+			if err != nil {
+				return "", nil, err
+			}
+			accessKey = strings.TrimSpace(string(fc))
+		}
+		if c.SecretAccessKeyFile != "" {
+			fc, err := os.ReadFile(c.SecretAccessKeyFile)
+			if err != nil {
+				return "", nil, err
+			}
+			secretKey = strings.TrimSpace(string(fc))
+		}
+		env = []string{"AWS_ACCESS_KEY_ID=" + accessKey, "AWS_SECRET_ACCESS_KEY=" + secretKey}
+		if c.Region != "" {
+			env = append(env, "AWS_DEFAULT_REGION="+c.Region)
+		}
+		return fmt.Sprintf("s3:%s://%s/%s/%s", scheme, c.Endpoint, bucket, object), env, nil
+	case cntntcfg.S3Storage:
+		if cfg.S3Config == nil {
+			return "", nil, xerrors.Errorf("missing S3 configuration")
+		}
+		c := cfg.S3Config
+		if c.CredentialsFile != "" {
+			env = append(env, "AWS_SHARED_CREDENTIALS_FILE="+c.CredentialsFile)
+		}
+		if c.Region != "" {
+			env = append(env, "AWS_DEFAULT_REGION="+c.Region)
+			return fmt.Sprintf("s3:s3.%s.amazonaws.com/%s/%s", c.Region, bucket, object), env, nil
+		}
+		return fmt.Sprintf("s3:s3.amazonaws.com/%s/%s", bucket, object), env, nil
+	case cntntcfg.GCloudStorage:
+		c := cfg.GCloudConfig
+		env = []string{"GOOGLE_PROJECT_ID=" + c.Project}
+		if c.CredentialsFile != "" {
+			env = append(env, "GOOGLE_APPLICATION_CREDENTIALS="+c.CredentialsFile)
+		}
+		return fmt.Sprintf("gs:%s:/%s", bucket, object), env, nil
+	default:
+		return "", nil, xerrors.Errorf("restic does not support %q storage", cfg.Kind)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	cntntcfg "github.com/gitpod-io/gitpod/content-service/api/config"
+)
+
+func TestResticRepository(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	err := os.WriteFile(secretFile, []byte("secret-from-file\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type Expectation struct {
+		Repo  string
+		Env   []string
+		Error bool
+	}
+	tests := []struct {
+		Name        string
+		Config      cntntcfg.StorageConfig
+		Expectation Expectation
+	}{
+		{
+			Name: "minio",
+			Config: cntntcfg.StorageConfig{Kind: cntntcfg.MinIOStorage, MinIOConfig: cntntcfg.MinIOConfig{
+				Endpoint:            "minio:9000",
+				AccessKeyID:         "access",
+				SecretAccessKeyFile: secretFile,
+				Region:              "local",
+			}},
+			Expectation: Expectation{
+				Repo: "s3:http://minio:9000/gitpod-user/workspaces/ws-id/restic",
+				Env:  []string{"AWS_ACCESS_KEY_ID=access", "AWS_SECRET_ACCESS_KEY=secret-from-file", "AWS_DEFAULT_REGION=local"},
+			},
+		},
+		{
+			Name: "minio secure",
+			Config: cntntcfg.StorageConfig{Kind: cntntcfg.MinIOStorage, MinIOConfig: cntntcfg.MinIOConfig{
+				Endpoint:        "minio.example.com",
+				AccessKeyID:     "access",
+				SecretAccessKey: "secret",
+				Secure:          true,
+			}},
+			Expectation: Expectation{
+				Repo: "s3:https://minio.example.com/gitpod-user/workspaces/ws-id/restic",
+				Env:  []string{"AWS_ACCESS_KEY_ID=access", "AWS_SECRET_ACCESS_KEY=secret"},
+			},
+		},
+		{
+			Name: "s3",
+			Config: cntntcfg.StorageConfig{Kind: cntntcfg.S3Storage, S3Config: &cntntcfg.S3Config{
+				Bucket:          "gitpod-user",
+				Region:          "eu-central-1",
+				CredentialsFile: "/credentials",
+			}},
+			Expectation: Expectation{
+				Repo: "s3:s3.eu-central-1.amazonaws.com/gitpod-user/workspaces/ws-id/restic",
+				Env:  []string{"AWS_SHARED_CREDENTIALS_FILE=/credentials", "AWS_DEFAULT_REGION=eu-central-1"},
+			},
+		},
+		{
+			Name:        "s3 without config",
+			Config:      cntntcfg.StorageConfig{Kind: cntntcfg.S3Storage},
+			Expectation: Expectation{Error: true},
+		},
+		{
+			Name: "gcloud",
+			Config: cntntcfg.StorageConfig{Kind: cntntcfg.GCloudStorage, GCloudConfig: cntntcfg.GCPConfig{
+				CredentialsFile: "/credentials.json",
+				Project:         "gitpod",
+			}},
+			Expectation: Expectation{
+				Repo: "gs:gitpod-user:/workspaces/ws-id/restic",
+				Env:  []string{"GOOGLE_PROJECT_ID=gitpod", "GOOGLE_APPLICATION_CREDENTIALS=/credentials.json"},
+			},
+		},
+		{
+			Name:        "unsupported storage",
+			Config:      cntntcfg.StorageConfig{Kind: "azure"},
+			Expectation: Expectation{Error: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var act Expectation
+			repo, env, err := resticRepository(test.Config, "gitpod-user", "/workspaces/ws-id/restic")
+			if err != nil {
+				act.Error = true
+			} else {
+				act.Repo, act.Env = repo, env
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected resticRepository (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseResticSummary(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Output      string
+		Expectation *ResticSummary
+	}{
+		{
+			Name: "summary",
+			Output: `{"message_type":"status","percent_done":0.5,"total_files":10}
+{"message_type":"summary","files_new":2,"data_added":1024,"total_bytes_processed":4096,"snapshot_id":"abc123"}
+`,
+			Expectation: &ResticSummary{SnapshotID: "abc123", DataAdded: 1024, TotalBytesProcessed: 4096},
+		},
+		{
+			Name:   "no summary",
+			Output: `{"message_type":"status","percent_done":1}`,
+		},
+		{
+			Name:   "garbage",
+			Output: "Fatal: unable to open config file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act, err := parseResticSummary([]byte(test.Output))
+			if test.Expectation == nil {
+				if err == nil {
+					t.Errorf("expected an error, got %+v", act)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected summary (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/unix"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+)
+
+var (
+	// ErrRestoreConflict is returned if a restore would change files which exist in the workspace already
+	ErrRestoreConflict = xerrors.New("restored content conflicts with existing workspace content")
+	// ErrInvalidRestoreTarget is returned if a snapshot cannot be restored into the requested directory
+	ErrInvalidRestoreTarget = xerrors.New("invalid restore target")
+)
+
+// RestoreTarget resolves the directory relative to the workspace location a snapshot is restored into, and creates
+// it if it does not exist. The target must not leave the workspace location, neither by itself nor through symlinks.
+func RestoreTarget(location, target string) (string, error) {
+	if filepath.IsAbs(target) {
+		return "", xerrors.Errorf("%w: %s must be relative to the workspace location", ErrInvalidRestoreTarget, target)
+	}
+	target = filepath.Clean(target)
+	if target == ".." || strings.HasPrefix(target, "../") {
+		return "", xerrors.Errorf("%w: %s is outside of the workspace location", ErrInvalidRestoreTarget, target)
+	}
+	if target == "." {
+		return location, nil
+	}
+
+	dir := location
+	for _, segment := range strings.Split(target, "/") {
+		parent := dir
+		dir = filepath.Join(dir, segment)
+		stat, err := os.Lstat(dir)
+		if os.IsNotExist(err) {
+			err = mkdirLike(dir, parent)
+			if err != nil {
+				return "", err
+			}
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if !stat.IsDir() {
+			return "", xerrors.Errorf("%w: %s is not a directory", ErrInvalidRestoreTarget, target)
+		}
+	}
+	return dir, nil
+}
+
+// mkdirLike creates dir with the owner of its parent, which is the workspace user within the workspace location
+func mkdirLike(dir, parent string) error {
+	var stat unix.Stat_t
+	err := unix.Lstat(parent, &stat)
+	if err != nil {
+		return err
+	}
+	err = os.Mkdir(dir, 0755)
+	if err != nil {
+		return err
+	}
+	return os.Lchown(dir, int(stat.Uid), int(stat.Gid))
+}
+
+// MergeContent moves the content of src into dst, merging directories which exist in both. Files which exist in dst
+// already are handled according to the conflict policy. Symlinks in dst are never followed but treated like files.
+// MergeContent returns the paths relative to dst which existed already. If the policy is to fail on conflicts,
+// dst is left unchanged and ErrRestoreConflict is returned.
+func MergeContent(src, dst string, policy api.RestoreConflictPolicy) (conflicts []string, err error) {
+	conflicts, err = findConflicts(src, dst, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(conflicts) > 0 && policy == api.RestoreConflictPolicy_RESTORE_CONFLICT_FAIL {
+		return conflicts, ErrRestoreConflict
+	}
+
+	err = mergeContent(src, dst, "", policy == api.RestoreConflictPolicy_RESTORE_CONFLICT_OVERWRITE)
+	if err != nil {
+		return nil, err
+	}
+	return conflicts, nil
+}
+
+// findConflicts lists the paths below rel which exist in both src and dst, unless both are directories
+func findConflicts(src, dst, rel string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(src, rel))
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, e := range entries {
+		p := filepath.Join(rel, e.Name())
+		stat, err := os.Lstat(filepath.Join(dst, p))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if e.IsDir() && stat.IsDir() {
+			nested, err := findConflicts(src, dst, p)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, nested...)
+			continue
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+func mergeContent(src, dst, rel string, overwrite bool) error {
+	entries, err := os.ReadDir(filepath.Join(src, rel))
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		p := filepath.Join(rel, e.Name())
+		from, to := filepath.Join(src, p), filepath.Join(dst, p)
+
+		stat, err := os.Lstat(to)
+		if os.IsNotExist(err) {
+			err = move(from, to)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if e.IsDir() && stat.IsDir() {
+			err = mergeContent(src, dst, p, overwrite)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if !overwrite {
+			continue
+		}
+
+		err = os.RemoveAll(to)
+		if err != nil {
+			return err
+		}
+		err = move(from, to)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// move renames src to dst, and copies it if they are on different filesystems, e.g. because the workspace location is encrypted
+func move(src, dst string) error {
+	err := os.Rename(src, dst)
+	if !errors.Is(err, unix.EXDEV) {
+		return err
+	}
+
+	out, err := exec.Command("cp", "-a", src, dst).CombinedOutput()
+	if err != nil {
+		return xerrors.Errorf("cannot copy %s: %w: %s", src, err, string(out))
+	}
+	return os.RemoveAll(src)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package content_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+)
+
+func TestMergeContent(t *testing.T) {
+	restored := map[string]string{
+		"repo/main.go":     "restored",
+		"repo/pkg/a.go":    "a",
+		"repo/README.md":   "readme",
+		"notes/todo.txt":   "todo",
+		"notes/done/1.txt": "1",
+	}
+	existing := map[string]string{
+		"repo/main.go":  "existing",
+		"repo/go.mod":   "module",
+		"notes/done":    "a file where the snapshot has a directory",
+		"unrelated.txt": "unrelated",
+	}
+
+	tests := []struct {
+		Name        string
+		Policy      api.RestoreConflictPolicy
+		Conflicts   []string
+		Expectation map[string]string
+		Error       error
+	}{
+		{
+			Name:        "fail",
+			Policy:      api.RestoreConflictPolicy_RESTORE_CONFLICT_FAIL,
+			Conflicts:   []string{"notes/done", "repo/main.go"},
+			Expectation: existing,
+			Error:       content.ErrRestoreConflict,
+		},
+		{
+			Name:      "skip",
+			Policy:    api.RestoreConflictPolicy_RESTORE_CONFLICT_SKIP,
+			Conflicts: []string{"notes/done", "repo/main.go"},
+			Expectation: map[string]string{
+				"repo/main.go":   "existing",
+				"repo/go.mod":    "module",
+				"repo/pkg/a.go":  "a",
+				"repo/README.md": "readme",
+				"notes/todo.txt": "todo",
+				"notes/done":     "a file where the snapshot has a directory",
+				"unrelated.txt":  "unrelated",
+			},
+		},
+		{
+			Name:      "overwrite",
+			Policy:    api.RestoreConflictPolicy_RESTORE_CONFLICT_OVERWRITE,
+			Conflicts: []string{"notes/done", "repo/main.go"},
+			Expectation: map[string]string{
+				"repo/main.go":     "restored",
+				"repo/go.mod":      "module",
+				"repo/pkg/a.go":    "a",
+				"repo/README.md":   "readme",
+				"notes/todo.txt":   "todo",
+				"notes/done/1.txt": "1",
+				"unrelated.txt":    "unrelated",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			src, dst := t.TempDir(), t.TempDir()
+			writeFiles(t, src, restored)
+			writeFiles(t, dst, existing)
+
+			conflicts, err := content.MergeContent(src, dst, test.Policy)
+			if !errors.Is(err, test.Error) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.Conflicts, conflicts); diff != "" {
+				t.Errorf("unexpected conflicts (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.Expectation, readFiles(t, dst)); diff != "" {
+				t.Errorf("unexpected workspace content (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeContentDoesNotFollowSymlinks(t *testing.T) {
+	src, dst, outside := t.TempDir(), t.TempDir(), t.TempDir()
+	writeFiles(t, src, map[string]string{"repo/link/passwd": "restored"})
+	err := os.MkdirAll(filepath.Join(dst, "repo"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Symlink(outside, filepath.Join(dst, "repo", "link"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conflicts, err := content.MergeContent(src, dst, api.RestoreConflictPolicy_RESTORE_CONFLICT_OVERWRITE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"repo/link"}, conflicts); diff != "" {
+		t.Errorf("unexpected conflicts (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{}, readFiles(t, outside)); diff != "" {
+		t.Errorf("restore wrote outside of the workspace (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"repo/link/passwd": "restored"}, readFiles(t, dst)); diff != "" {
+		t.Errorf("unexpected workspace content (-want +got):\n%s", diff)
+	}
+}
+
+func TestRestoreTarget(t *testing.T) {
+	tests := []struct {
+		Target      string
+		Expectation string
+		Error       bool
+	}{
+		{Target: "", Expectation: "."},
+		{Target: "restored", Expectation: "restored"},
+		{Target: "restored/../other/nested/", Expectation: "other/nested"},
+		{Target: "repo", Expectation: "repo"},
+		{Target: "/etc", Error: true},
+		{Target: "../etc", Error: true},
+		{Target: "restored/../../etc", Error: true},
+		{Target: "link/restored", Error: true},
+		{Target: "repo/main.go", Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Target, func(t *testing.T) {
+			location, outside := t.TempDir(), t.TempDir()
+			writeFiles(t, location, map[string]string{"repo/main.go": "main"})
+			err := os.Symlink(outside, filepath.Join(location, "link"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act, err := content.RestoreTarget(location, test.Target)
+			if test.Error {
+				if !errors.Is(err, content.ErrInvalidRestoreTarget) {
+					t.Fatalf("expected an invalid target error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exp := filepath.Join(location, test.Expectation); act != exp {
+				t.Errorf("unexpected target: want %s, got %s", exp, act)
+			}
+			if stat, err := os.Lstat(act); err != nil || !stat.IsDir() {
+				t.Errorf("target %s is not a directory: %v", act, err)
+			}
+			if entries, _ := os.ReadDir(outside); len(entries) > 0 {
+				t.Errorf("target was created outside of the workspace location")
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"golang.org/x/xerrors"
+
+	glog "github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+)
+
+// BackupEngine uploads the backups of workspaces to remote storage and restores them
+type BackupEngine interface {
+	// Backup uploads the content of a workspace and returns the number of bytes uploaded
+	Backup(ctx context.Context, sess *session.Workspace) (size int64, err error)
+
+	// Restore restores the latest backup of a workspace into its location. It returns false if there is
+	// no backup to restore, in which case the content initializer initializes the workspace.
+	Restore(ctx context.Context, sess *session.Workspace) (restored bool, err error)
+}
+
+func newBackupEngine(wso *DefaultWorkspaceOperations) (BackupEngine, error) {
+	switch wso.config.Backup.Engine {
+	case "", content.BackupEngineTar:
+		return &tarBackupEngine{wso: wso}, nil
+	case content.BackupEngineRestic:
+		restic, err := content.NewRestic(wso.config.Backup.Restic, wso.config.Storage)
+		if err != nil {
+			return nil, err
+		}
+		return &resticBackupEngine{wso: wso, restic: restic}, nil
+	default:
+		return nil, xerrors.Errorf("unknown backup engine %q", wso.config.Backup.Engine)
+	}
+}
+
+// tarBackupEngine uploads backups as tar archives, optionally as delta layers on top of a full backup
+type tarBackupEngine struct {
+	wso *DefaultWorkspaceOperations
+}
+
+func (e *tarBackupEngine) Backup(ctx context.Context, sess *session.Workspace) (int64, error) {
+	return e.wso.uploadWorkspaceContent(ctx, sess, storage.DefaultBackup, nil, nil)
+}
+
+// Restore leaves the backup to the content initializer, which downloads it as part of the remote content
+func (e *tarBackupEngine) Restore(ctx context.Context, sess *session.Workspace) (bool, error) {
+	return false, nil
+}
+
+// resticBackupEngine uploads backups as snapshots of a restic repository per workspace. Restic backups are
+// not listed in the content catalog, because its consumers expect tar archives.
+type resticBackupEngine struct {
+	wso    *DefaultWorkspaceOperations
+	restic *content.Restic
+}
+
+func (e *resticBackupEngine) Backup(ctx context.Context, sess *session.Workspace) (size int64, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "resticBackupEngine.Backup")
+	defer tracing.FinishSpan(span, &err)
+
+	release := e.wso.acquireBackupSlot()
+	defer release()
+
+	start := time.Now()
+	rs, ok := sess.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
+	if rs == nil || !ok {
+		e.wso.metrics.recordFailure(backupTypeBackup, sess.Class, failureStorage)
+		return 0, xerrors.Errorf("no remote storage configured")
+	}
+
+	var summary *content.ResticSummary
+	err = retryIfErr(ctx, e.wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "restic backup"), func(ctx context.Context) (err error) {
+		summary, err = e.restic.Backup(ctx, rs, sess.Owner, sess.WorkspaceID, sess.Location, e.wso.backupExcludes())
+		if summary != nil {
+			// forgetting old snapshots failed, which the next backup catches up on
+			if err != nil {
+				glog.WithError(err).WithFields(sess.OWI()).Warn("cannot forget old restic snapshots")
+			}
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		e.wso.metrics.recordFailure(backupTypeBackup, sess.Class, failureUpload)
+		return 0, xerrors.Errorf("restic backup failed: %w", err)
+	}
+
+	glog.WithFields(sess.OWI()).WithField("snapshot", summary.SnapshotID).WithField("dataAdded", summary.DataAdded).WithField("totalBytes", summary.TotalBytesProcessed).Debug("uploaded restic backup")
+	e.wso.metrics.recordUpload(sess.Class, time.Since(start), summary.DataAdded)
+	e.wso.metrics.recordBackup(backupTypeBackup, sess.Class, time.Since(start), summary.DataAdded)
+	return summary.DataAdded, nil
+}
+
+func (e *resticBackupEngine) Restore(ctx context.Context, sess *session.Workspace) (restored bool, err error) {
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "resticBackupEngine.Restore")
+	defer tracing.FinishSpan(span, &err)
+
+	rs, ok := sess.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
+	if rs == nil || !ok {
+		return false, xerrors.Errorf("no remote storage configured")
+	}
+
+	start := time.Now()
+	found, err := e.restic.Restore(ctx, rs, sess.Owner, sess.Location)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		// Workspaces backed up before the installation switched to restic have their tar backup restored by the
+		// content initializer.
+		return false, nil
+	}
+
+	// Restic restores the content with the IDs it has on the node, which is what the content initializer
+	// would chown the ready file to as well.
+	err = wsinit.EnsureCleanDotGitpodDirectory(ctx, sess.Location)
+	if err != nil {
+		return true, xerrors.Errorf("cannot ensure clean .gitpod directory: %w", err)
+	}
+	stats := csapi.InitializerMetrics{{Type: "restic", Duration: time.Since(start)}}
+	err = wsinit.PlaceWorkspaceReadyFile(ctx, sess.Location, csapi.WorkspaceInitFromBackup, stats, wsinit.GitpodUID+100000-1, wsinit.GitpodGID+100000-1)
+	if err != nil {
+		return true, err
+	}
+	return true, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controller
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+)
+
+const (
+	// backup types, which are also the operations of failures
+	backupTypeBackup   = "backup"
+	backupTypeSnapshot = "snapshot"
+
+	// restore types: the content of a workspace is restored from its backup or a snapshot when it starts,
+	// or a snapshot is restored into the running workspace.
+	restoreTypeBackup   = "backup"
+	restoreTypeSnapshot = "snapshot"
+	restoreTypeLive     = "live"
+
+	operationRestore = "restore"
+
+	failureStorage     = "storage"
+	failureArchive     = "archive"
+	failureUpload      = "upload"
+	failureExport      = "export"
+	failureDownload    = "download"
+	failureNotFound    = "not_found"
+	failureInitializer = "initializer"
+	failureTarget      = "target"
+	failureConflict    = "conflict"
+	failureMerge       = "merge"
+)
+
+func registerContentMetrics(reg prometheus.Registerer, m *Metrics) error {
+	m.BackupDurationHist = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "workspace_backup_seconds",
+		Help:    "time it took to archive and upload workspace content, excluding the time waiting for concurrent backups",
+		Buckets: prometheus.ExponentialBuckets(2, 2, 10),
+	}, []string{"type", "class"})
+	m.BackupSizeHist = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "workspace_backup_archive_bytes",
+		Help:    "size of the uploaded workspace content archives",
+		Buckets: prometheus.ExponentialBuckets(1024*1024, 4, 8),
+	}, []string{"type", "class"})
+	m.UploadThroughputHist = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "workspace_backup_upload_bytes_per_second",
+		Help:    "throughput of uploads of workspace content archives to remote storage",
+		Buckets: prometheus.ExponentialBuckets(256*1024, 2, 12),
+	}, []string{"class"})
+	m.RestoreDurationHist = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "workspace_restore_seconds",
+		Help:    "time it took to download and restore workspace content",
+		Buckets: prometheus.ExponentialBuckets(2, 2, 10),
+	}, []string{"type", "class"})
+	m.ContentFailureCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "workspace_content_failures_total",
+		Help: "total count of failed backups, snapshots and restores of workspace content",
+	}, []string{"operation", "class", "kind"})
+
+	for _, c := range []prometheus.Collector{m.BackupDurationHist, m.BackupSizeHist, m.UploadThroughputHist, m.RestoreDurationHist, m.ContentFailureCounter} {
+		err := reg.Register(c)
+		if err != nil {
+			return xerrors.Errorf("cannot register Prometheus metrics for workspace content: %w", err)
+		}
+	}
+	return nil
+}
+
+// registerRestoreCacheMetrics exposes the use of the node's restore cache. The hit rate is the rate of
+// workspace_restore_cache_hits_total over the sum of the hits and misses.
+func registerRestoreCacheMetrics(reg prometheus.Registerer, cache *content.RestoreCache) error {
+	collectors := []prometheus.Collector{
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "workspace_restore_cache_hits_total",
+			Help: "total count of backup and prebuild archives found in the restore cache",
+		}, func() float64 { return float64(cache.Stats().Hits) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "workspace_restore_cache_misses_total",
+			Help: "total count of backup and prebuild archives not found in the restore cache",
+		}, func() float64 { return float64(cache.Stats().Misses) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "workspace_restore_cache_evictions_total",
+			Help: "total count of archives evicted from the restore cache",
+		}, func() float64 { return float64(cache.Stats().Evictions) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "workspace_restore_cache_bytes",
+			Help: "total size of the archives in the restore cache",
+		}, func() float64 { return float64(cache.Stats().Size) }),
+	}
+	for _, c := range collectors {
+		err := reg.Register(c)
+		if err != nil {
+			return xerrors.Errorf("cannot register Prometheus metrics for the restore cache: %w", err)
+		}
+	}
+	return nil
+}
+
+func (m *Metrics) recordBackup(tpe, class string, duration time.Duration, size int64) {
+	m.BackupDurationHist.WithLabelValues(tpe, class).Observe(duration.Seconds())
+	if size > 0 {
+		m.BackupSizeHist.WithLabelValues(tpe, class).Observe(float64(size))
+	}
+}
+
+func (m *Metrics) recordUpload(class string, duration time.Duration, size int64) {
+	if size <= 0 || duration <= 0 {
+		return
+	}
+	m.UploadThroughputHist.WithLabelValues(class).Observe(float64(size) / duration.Seconds())
+}
+
+func (m *Metrics) recordRestore(tpe, class string, duration time.Duration) {
+	m.RestoreDurationHist.WithLabelValues(tpe, class).Observe(duration.Seconds())
+}
+
+func (m *Metrics) recordFailure(operation, class, kind string) {
+	m.ContentFailureCounter.WithLabelValues(operation, class, kind).Inc()
+}
+
+func backupType(backupName string) string {
+	if backupName == storage.DefaultBackup {
+		return backupTypeBackup
+	}
+	return backupTypeSnapshot
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controller
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+)
+
+func TestContentMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	var m Metrics
+	err := registerContentMetrics(prometheus.WrapRegistererWithPrefix("gitpod_ws_daemon_", reg), &m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m.recordBackup(backupType(storage.DefaultBackup), "small", 3*time.Second, 5*1024*1024)
+	m.recordBackup(backupType("snapshot-1.tar"), "large", time.Second, 0)
+	m.recordUpload("small", 2*time.Second, 4*1024*1024)
+	m.recordUpload("small", 0, 4*1024*1024)
+	m.recordRestore(restoreTypeLive, "small", time.Second)
+	m.recordFailure(backupTypeSnapshot, "large", failureUpload)
+	m.recordFailure(backupTypeSnapshot, "large", failureUpload)
+
+	err = testutil.GatherAndCompare(reg, strings.NewReader(`
+# HELP gitpod_ws_daemon_workspace_content_failures_total total count of failed backups, snapshots and restores of workspace content
+# TYPE gitpod_ws_daemon_workspace_content_failures_total counter
+gitpod_ws_daemon_workspace_content_failures_total{class="large",kind="upload",operation="snapshot"} 2
+`), "gitpod_ws_daemon_workspace_content_failures_total")
+	if err != nil {
+		t.Error(err)
+	}
+
+	for name, exp := range map[string]int{
+		"gitpod_ws_daemon_workspace_backup_seconds":                 2,
+		"gitpod_ws_daemon_workspace_backup_archive_bytes":           1,
+		"gitpod_ws_daemon_workspace_backup_upload_bytes_per_second": 1,
+		"gitpod_ws_daemon_workspace_restore_seconds":                1,
+	} {
+		act, err := testutil.GatherAndCount(reg, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if act != exp {
+			t.Errorf("unexpected number of series for %s: want %d, got %d", name, exp, act)
+		}
+	}
+
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, mf := range mfs {
+		if mf.GetName() != "gitpod_ws_daemon_workspace_backup_upload_bytes_per_second" {
+			continue
+		}
+		if act := mf.GetMetric()[0].GetHistogram().GetSampleSum(); act != 2*1024*1024 {
+			t.Errorf("unexpected upload throughput: want %d, got %f", 2*1024*1024, act)
+		}
+	}
+}
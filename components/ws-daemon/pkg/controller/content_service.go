@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+)
+
+// ContentService serves the content operations on running workspaces which are not driven by the workspace
+// and snapshot resources. All other operations of the WorkspaceContentService are handled by the controllers.
+type ContentService struct {
+	operations WorkspaceOperations
+
+	api.UnimplementedWorkspaceContentServiceServer
+}
+
+func NewContentService(operations WorkspaceOperations) *ContentService {
+	return &ContentService{operations: operations}
+}
+
+// RestoreSnapshot downloads a snapshot or backup and unpacks it into a directory of a running workspace
+func (s *ContentService) RestoreSnapshot(ctx context.Context, req *api.RestoreSnapshotRequest) (*api.RestoreSnapshotResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Snapshot == "" {
+		return nil, status.Error(codes.InvalidArgument, "snapshot is required")
+	}
+	if _, ok := api.RestoreConflictPolicy_name[int32(req.ConflictPolicy)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown conflict policy %d", req.ConflictPolicy)
+	}
+
+	conflicts, err := s.operations.RestoreSnapshot(ctx, RestoreOptions{
+		InstanceID:     req.Id,
+		Snapshot:       req.Snapshot,
+		Target:         req.Target,
+		ConflictPolicy: req.ConflictPolicy,
+	})
+	switch {
+	case err == nil:
+		return &api.RestoreSnapshotResponse{Conflicts: conflicts}, nil
+	case errors.Is(err, errWorkspaceNotFound), errors.Is(err, errSnapshotNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, content.ErrInvalidRestoreTarget):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, content.ErrRestoreConflict):
+		return nil, status.Errorf(codes.AlreadyExists, "%v: %v", err, conflicts)
+	default:
+		log.WithError(err).WithFields(log.OWI("", "", req.Id)).WithField("snapshot", req.Snapshot).Error("cannot restore snapshot")
+		return nil, status.Errorf(codes.Internal, "cannot restore snapshot: %v", err)
+	}
+}
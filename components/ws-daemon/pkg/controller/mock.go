@@ -11,6 +11,7 @@ package controller
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	api "github.com/gitpod-io/gitpod/content-service/api"
 	gomock "github.com/golang/mock/gomock"
@@ -83,6 +84,36 @@ func (mr *MockWorkspaceOperationsMockRecorder) InitWorkspace(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitWorkspace", reflect.TypeOf((*MockWorkspaceOperations)(nil).InitWorkspace), arg0, arg1)
 }
 
+// LiveBackupWorkspace mocks base method.
+func (m *MockWorkspaceOperations) LiveBackupWorkspace(arg0 context.Context, arg1 string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LiveBackupWorkspace", arg0, arg1)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LiveBackupWorkspace indicates an expected call of LiveBackupWorkspace.
+func (mr *MockWorkspaceOperationsMockRecorder) LiveBackupWorkspace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LiveBackupWorkspace", reflect.TypeOf((*MockWorkspaceOperations)(nil).LiveBackupWorkspace), arg0, arg1)
+}
+
+// RestoreSnapshot mocks base method.
+func (m *MockWorkspaceOperations) RestoreSnapshot(arg0 context.Context, arg1 RestoreOptions) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSnapshot", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot.
+func (mr *MockWorkspaceOperationsMockRecorder) RestoreSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockWorkspaceOperations)(nil).RestoreSnapshot), arg0, arg1)
+}
+
 // SetupWorkspace mocks base method.
 func (m *MockWorkspaceOperations) SetupWorkspace(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -98,17 +129,19 @@ func (mr *MockWorkspaceOperationsMockRecorder) SetupWorkspace(arg0, arg1 interfa
 }
 
 // Snapshot mocks base method.
-func (m *MockWorkspaceOperations) Snapshot(arg0 context.Context, arg1, arg2 string) error {
+func (m *MockWorkspaceOperations) Snapshot(arg0 context.Context, arg1, arg2 string, arg3 []string, arg4 string) (string, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Snapshot", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Snapshot", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Snapshot indicates an expected call of Snapshot.
-func (mr *MockWorkspaceOperationsMockRecorder) Snapshot(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockWorkspaceOperationsMockRecorder) Snapshot(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockWorkspaceOperations)(nil).Snapshot), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockWorkspaceOperations)(nil).Snapshot), arg0, arg1, arg2, arg3, arg4)
 }
 
 // SnapshotIDs mocks base method.
@@ -18,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 )
 
@@ -28,15 +29,17 @@ type SnapshotReconciler struct {
 	nodeName                string
 	operations              WorkspaceOperations
 	recorder                record.EventRecorder
+	inflight                *baseserver.InFlight
 }
 
-func NewSnapshotController(c client.Client, recorder record.EventRecorder, nodeName string, maxConcurrentReconciles int, wso WorkspaceOperations) *SnapshotReconciler {
+func NewSnapshotController(c client.Client, recorder record.EventRecorder, nodeName string, maxConcurrentReconciles int, wso WorkspaceOperations, inflight *baseserver.InFlight) *SnapshotReconciler {
 	return &SnapshotReconciler{
 		Client:                  c,
 		maxConcurrentReconciles: maxConcurrentReconciles,
 		nodeName:                nodeName,
 		operations:              wso,
 		recorder:                recorder,
+		inflight:                inflight,
 	}
 }
 
@@ -89,6 +92,10 @@ func (ssc *SnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
+	// finish the snapshot even if ws-daemon is asked to shut down meanwhile
+	ctx, done := ssc.inflight.Begin(ctx)
+	defer done()
+
 	snapshotURL, snapshotName, snapshotErr := ssc.operations.SnapshotIDs(ctx, snapshot.Spec.WorkspaceID)
 	if snapshotErr != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to get snapshot name and URL: %w", snapshotErr)
@@ -109,7 +116,11 @@ func (ssc *SnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, fmt.Errorf("could not set snapshot url: %w", err)
 	}
 
-	snapshotErr = ssc.operations.Snapshot(ctx, snapshot.Spec.WorkspaceID, snapshotName)
+	var exportTag string
+	if snapshot.Spec.Export != nil {
+		exportTag = snapshot.Spec.Export.Tag
+	}
+	imageRef, size, snapshotErr := ssc.operations.Snapshot(ctx, snapshot.Spec.WorkspaceID, snapshotName, snapshot.Spec.Paths, exportTag)
 	if snapshotErr != nil {
 		log.Error(snapshotErr, "could not take snapshot", "workspace", snapshot.Spec.WorkspaceID)
 	}
@@ -121,6 +132,8 @@ func (ssc *SnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 
 		snapshot.Status.Completed = true
+		snapshot.Status.ImageRef = imageRef
+		snapshot.Status.Size = size
 		if snapshotErr != nil {
 			snapshot.Status.Error = fmt.Errorf("could not take snapshot: %w", snapshotErr).Error()
 		}
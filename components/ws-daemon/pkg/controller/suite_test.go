@@ -25,6 +25,7 @@ import (
 
 	//+kubebuilder:scaffold:imports
 
+	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -77,7 +78,7 @@ var _ = BeforeSuite(func() {
 	Expect(err).ToNot(HaveOccurred())
 	ctx, cancel = context.WithCancel(context.Background())
 
-	workspaceCtrl, err = NewWorkspaceController(k8sClient, record.NewFakeRecorder(100), NodeName, secretsNamespace, 5, nil, ctrl_metrics.Registry)
+	workspaceCtrl, err = NewWorkspaceController(k8sClient, record.NewFakeRecorder(100), NodeName, secretsNamespace, 5, nil, ctrl_metrics.Registry, baseserver.NewInFlight(), nil)
 	Expect(err).NotTo(HaveOccurred())
 
 	Expect(workspaceCtrl.SetupWithManager(k8sManager)).To(Succeed())
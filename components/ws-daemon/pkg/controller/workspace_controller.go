@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	glog "github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
@@ -16,6 +17,7 @@ import (
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/forensics"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/opentracing/opentracing-go"
@@ -37,6 +39,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
+// forensicsTimeout limits how long collecting a forensic bundle can delay the stop of a workspace
+const forensicsTimeout = 1 * time.Minute
+
 var retryParams = wait.Backoff{
 	Steps:    10,
 	Duration: 10 * time.Millisecond,
@@ -61,9 +66,13 @@ type WorkspaceController struct {
 	metrics                 *workspaceMetrics
 	secretNamespace         string
 	recorder                record.EventRecorder
+	inflight                *baseserver.InFlight
+	forensics               *forensics.Collector
 }
 
-func NewWorkspaceController(c client.Client, recorder record.EventRecorder, nodeName, secretNamespace string, maxConcurrentReconciles int, ops WorkspaceOperations, reg prometheus.Registerer) (*WorkspaceController, error) {
+// NewWorkspaceController creates a new workspace controller. Forensic bundles of abnormally terminated workspaces
+// are only collected if collector is not nil.
+func NewWorkspaceController(c client.Client, recorder record.EventRecorder, nodeName, secretNamespace string, maxConcurrentReconciles int, ops WorkspaceOperations, reg prometheus.Registerer, inflight *baseserver.InFlight, collector *forensics.Collector) (*WorkspaceController, error) {
 	metrics := newWorkspaceMetrics()
 	reg.Register(metrics)
 
@@ -75,6 +84,8 @@ func NewWorkspaceController(c client.Client, recorder record.EventRecorder, node
 		metrics:                 metrics,
 		secretNamespace:         secretNamespace,
 		recorder:                recorder,
+		inflight:                inflight,
+		forensics:               collector,
 	}, nil
 }
 
@@ -185,6 +196,8 @@ func (wsc *WorkspaceController) handleWorkspaceInit(ctx context.Context, ws *wor
 			Initializer:  init,
 			Headless:     ws.IsHeadless(),
 			StorageQuota: ws.Spec.StorageQuota,
+			Ephemeral:    ws.Spec.Ephemeral,
+			Class:        ws.Spec.Class,
 		})
 
 		err = retry.RetryOnConflict(retryParams, func() error {
@@ -219,7 +232,23 @@ func (wsc *WorkspaceController) handleWorkspaceRunning(ctx context.Context, ws *
 	span, ctx := opentracing.StartSpanFromContext(ctx, "handleWorkspaceRunning")
 	defer tracing.FinishSpan(span, &err)
 
-	return ctrl.Result{}, wsc.operations.SetupWorkspace(ctx, ws.Name)
+	err = wsc.operations.SetupWorkspace(ctx, ws.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if ws.Spec.Type != workspacev1.WorkspaceTypeRegular {
+		return ctrl.Result{}, nil
+	}
+
+	// Live backups are taken during reconciliation, such that they never overlap with the final backup of the workspace.
+	next, liveBackupErr := wsc.operations.LiveBackupWorkspace(ctx, ws.Name)
+	if liveBackupErr != nil {
+		glog.WithError(liveBackupErr).WithFields(ws.OWI()).Warn("live backup failed")
+		wsc.emitEvent(ws, "Live backup", liveBackupErr)
+	}
+
+	return ctrl.Result{RequeueAfter: next}, nil
 }
 
 func (wsc *WorkspaceController) handleWorkspaceStop(ctx context.Context, ws *workspacev1.Workspace, req ctrl.Request) (result ctrl.Result, err error) {
@@ -239,6 +268,10 @@ func (wsc *WorkspaceController) handleWorkspaceStop(ctx context.Context, ws *wor
 		return ctrl.Result{}, nil
 	}
 
+	if ws.IsConditionTrue(workspacev1.WorkspaceConditionContentDeleted) {
+		return ctrl.Result{}, nil
+	}
+
 	if ws.IsConditionTrue(workspacev1.WorkspaceConditionAborted) {
 		span.LogKV("event", "workspace was aborted")
 		return ctrl.Result{}, nil
@@ -262,6 +295,14 @@ func (wsc *WorkspaceController) handleWorkspaceStop(ctx context.Context, ws *wor
 
 	glog.WithFields(ws.OWI()).WithField("workspace", req.NamespacedName).WithField("phase", ws.Status.Phase).Info("handle workspace stop")
 
+	if ws.Spec.Ephemeral {
+		return wsc.deleteEphemeralWorkspace(ctx, ws, req)
+	}
+
+	// Finish the backup even if ws-daemon is asked to shut down meanwhile, otherwise the workspace content is lost.
+	ctx, done := wsc.inflight.Begin(ctx)
+	defer done()
+
 	disposeStart := time.Now()
 	var snapshotName string
 	var snapshotUrl string
@@ -305,6 +346,9 @@ func (wsc *WorkspaceController) handleWorkspaceStop(ctx context.Context, ws *wor
 		SkipBackupContent: false,
 	})
 
+	// The pod and with it the logs of the workspace are gone once the backup condition is set
+	wsc.collectForensics(ctx, ws, disposeErr)
+
 	err = retry.RetryOnConflict(retryParams, func() error {
 		if err := wsc.Get(ctx, req.NamespacedName, ws); err != nil {
 			return err
@@ -344,6 +388,93 @@ func (wsc *WorkspaceController) handleWorkspaceStop(ctx context.Context, ws *wor
 	return ctrl.Result{}, nil
 }
 
+// collectForensics collects a forensic bundle if the workspace terminated abnormally. Failing to do so
+// does not fail the stop of the workspace.
+func (wsc *WorkspaceController) collectForensics(ctx context.Context, ws *workspacev1.Workspace, disposeErr error) {
+	if wsc.forensics == nil {
+		return
+	}
+
+	var reason, message string
+	if disposeErr != nil {
+		reason, message = string(workspacev1.StopReasonBackupFailure), disposeErr.Error()
+	} else {
+		switch r := ws.StopReason(); r {
+		case workspacev1.StopReasonOOMKilled, workspacev1.StopReasonAgentSmith:
+			reason = string(r)
+			if c := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionStopReason)); c != nil {
+				message = c.Message
+			}
+		default:
+			return
+		}
+	}
+
+	req := forensics.Request{
+		Owner:       ws.Spec.Ownership.Owner,
+		WorkspaceID: ws.Spec.Ownership.WorkspaceID,
+		InstanceID:  ws.Name,
+		Namespace:   ws.Namespace,
+		Reason:      reason,
+		Message:     message,
+	}
+	if ws.Status.Runtime != nil {
+		req.PodName = ws.Status.Runtime.PodName
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, forensicsTimeout)
+	defer cancel()
+	err := wsc.forensics.Collect(ctx, req)
+	if err != nil {
+		glog.WithError(err).WithFields(ws.OWI()).WithField("reason", reason).Warn("cannot collect forensic bundle")
+		return
+	}
+	glog.WithFields(ws.OWI()).WithField("reason", reason).Info("collected forensic bundle")
+}
+
+// deleteEphemeralWorkspace deletes the content of an ephemeral workspace without backing it up. Should the deletion
+// fail we retry, as the workspace must not stop while its content is still on the node.
+func (wsc *WorkspaceController) deleteEphemeralWorkspace(ctx context.Context, ws *workspacev1.Workspace, req ctrl.Request) (result ctrl.Result, err error) {
+	err = wsc.operations.DeleteWorkspace(ctx, ws.Name)
+	if err != nil {
+		wsc.emitEvent(ws, "Delete", fmt.Errorf("failed to delete ephemeral workspace content: %w", err))
+		return ctrl.Result{}, fmt.Errorf("failed to delete ephemeral workspace content: %w", err)
+	}
+
+	err = retry.RetryOnConflict(retryParams, func() error {
+		if err := wsc.Get(ctx, req.NamespacedName, ws); err != nil {
+			return err
+		}
+
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionContentDeleted())
+		return wsc.Status().Update(ctx, ws)
+	})
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to set content deleted condition: %w", err)
+	}
+	return ctrl.Result{}, nil
+}
+
+// ReportOOMKill marks a running workspace whose processes were killed by the OOM killer, such that ws-manager
+// can tell the user why e.g. their build died. kills is the total count of processes killed so far.
+func (wsc *WorkspaceController) ReportOOMKill(ctx context.Context, namespace, instanceID string, kills uint64) error {
+	var ws workspacev1.Workspace
+	message := fmt.Sprintf("the OOM killer killed %d processes because the workspace ran out of memory", kills)
+	err := retry.RetryOnConflict(retryParams, func() error {
+		if err := wsc.Get(ctx, types.NamespacedName{Namespace: namespace, Name: instanceID}, &ws); err != nil {
+			return err
+		}
+
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionOOMKill(message))
+		return wsc.Status().Update(ctx, &ws)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set OOM kill condition: %w", err)
+	}
+	wsc.recorder.Event(&ws, corev1.EventTypeWarning, "OOMKill", message)
+	return nil
+}
+
 func (wsc *WorkspaceController) prepareInitializer(ctx context.Context, ws *workspacev1.Workspace) (*csapi.WorkspaceInitializer, error) {
 	var init csapi.WorkspaceInitializer
 	err := proto.Unmarshal(ws.Spec.Initializer, &init)
@@ -6,22 +6,26 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	glog "github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
 	"github.com/gitpod-io/gitpod/content-service/pkg/archive"
+	"github.com/gitpod-io/gitpod/content-service/pkg/catalog"
 	wsinit "github.com/gitpod-io/gitpod/content-service/pkg/initializer"
 	"github.com/gitpod-io/gitpod/content-service/pkg/logs"
 	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
+	"github.com/opencontainers/go-digest"
 	"github.com/opentracing/opentracing-go"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -32,6 +36,11 @@ type Metrics struct {
 	BackupWaitingTimeHist       prometheus.Histogram
 	BackupWaitingTimeoutCounter prometheus.Counter
 	InitializerHistogram        *prometheus.HistogramVec
+	BackupDurationHist          *prometheus.HistogramVec
+	BackupSizeHist              *prometheus.HistogramVec
+	UploadThroughputHist        *prometheus.HistogramVec
+	RestoreDurationHist         *prometheus.HistogramVec
+	ContentFailureCounter       *prometheus.CounterVec
 }
 
 func registerConcurrentBackupMetrics(reg prometheus.Registerer, suffix string) (prometheus.Histogram, prometheus.Counter, error) {
@@ -68,10 +77,17 @@ type WorkspaceOperations interface {
 	DeleteWorkspace(ctx context.Context, instanceID string) error
 	// SnapshotIDs generates the name and url for a snapshot
 	SnapshotIDs(ctx context.Context, instanceID string) (snapshotUrl, snapshotName string, err error)
-	// Snapshot takes a snapshot of the workspace
-	Snapshot(ctx context.Context, instanceID, snapshotName string) (err error)
+	// Snapshot takes a snapshot of the workspace and returns the size of the uploaded archive. If paths are given,
+	// the snapshot is restricted to them. If exportTag is set, the snapshot is also pushed as image and its reference returned.
+	Snapshot(ctx context.Context, instanceID, snapshotName string, paths []string, exportTag string) (imageRef string, size int64, err error)
 	// Setup ensures that the workspace has been setup
 	SetupWorkspace(ctx context.Context, instanceID string) error
+	// RestoreSnapshot downloads a snapshot or backup and merges it into a directory of a running workspace. It returns
+	// the paths relative to that directory which existed already.
+	RestoreSnapshot(ctx context.Context, opts RestoreOptions) (conflicts []string, err error)
+	// LiveBackupWorkspace backs up the content of a running workspace if its live backup is due,
+	// and returns the time until the next live backup is due. It returns zero if live backups are disabled.
+	LiveBackupWorkspace(ctx context.Context, instanceID string) (time.Duration, error)
 }
 
 type DefaultWorkspaceOperations struct {
@@ -79,6 +95,9 @@ type DefaultWorkspaceOperations struct {
 	provider               *WorkspaceProvider
 	backupWorkspaceLimiter chan struct{}
 	metrics                *Metrics
+	restoreCache           *content.RestoreCache
+	snapshotExporter       *content.SnapshotExporter
+	backupEngine           BackupEngine
 }
 
 var _ WorkspaceOperations = (*DefaultWorkspaceOperations)(nil)
@@ -94,6 +113,8 @@ type InitOptions struct {
 	Initializer  *csapi.WorkspaceInitializer
 	Headless     bool
 	StorageQuota int
+	Ephemeral    bool
+	Class        string
 }
 
 type BackupOptions struct {
@@ -104,27 +125,73 @@ type BackupOptions struct {
 	SkipBackupContent bool
 }
 
+type RestoreOptions struct {
+	InstanceID string
+	// Snapshot is the name of a snapshot as produced by SnapshotIDs, or the name of a backup of the workspace
+	Snapshot string
+	// Target is the directory relative to the workspace location the snapshot is restored into
+	Target         string
+	ConflictPolicy api.RestoreConflictPolicy
+}
+
+var (
+	errWorkspaceNotFound = xerrors.New("workspace not found")
+	errSnapshotNotFound  = xerrors.New("snapshot not found")
+)
+
 func NewWorkspaceOperations(config content.Config, provider *WorkspaceProvider, reg prometheus.Registerer) (WorkspaceOperations, error) {
 	waitingTimeHist, waitingTimeoutCounter, err := registerConcurrentBackupMetrics(reg, "_mk2")
 	if err != nil {
 		return nil, err
 	}
+	metrics := &Metrics{
+		BackupWaitingTimeHist:       waitingTimeHist,
+		BackupWaitingTimeoutCounter: waitingTimeoutCounter,
+	}
+	err = registerContentMetrics(reg, metrics)
+	if err != nil {
+		return nil, err
+	}
 
-	return &DefaultWorkspaceOperations{
-		config:   config,
-		provider: provider,
-		metrics: &Metrics{
-			BackupWaitingTimeHist:       waitingTimeHist,
-			BackupWaitingTimeoutCounter: waitingTimeoutCounter,
-		},
+	var restoreCache *content.RestoreCache
+	if config.RestoreCache.Enabled {
+		restoreCache, err = content.NewRestoreCache(config.RestoreCache)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create restore cache: %w", err)
+		}
+		err = registerRestoreCacheMetrics(reg, restoreCache)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var snapshotExporter *content.SnapshotExporter
+	if config.SnapshotExport.Repository != "" {
+		snapshotExporter, err = content.NewSnapshotExporter(config.SnapshotExport, config.TmpDir)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create snapshot exporter: %w", err)
+		}
+	}
+
+	wso := &DefaultWorkspaceOperations{
+		config:           config,
+		provider:         provider,
+		restoreCache:     restoreCache,
+		snapshotExporter: snapshotExporter,
+		metrics:          metrics,
 		// we permit five concurrent backups at any given time, hence the five in the channel
 		backupWorkspaceLimiter: make(chan struct{}, 5),
-	}, nil
+	}
+	wso.backupEngine, err = newBackupEngine(wso)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create backup engine: %w", err)
+	}
+	return wso, nil
 }
 
 func (wso *DefaultWorkspaceOperations) InitWorkspace(ctx context.Context, options InitOptions) (string, error) {
 	ws, err := wso.provider.NewWorkspace(ctx, options.Meta.InstanceID, filepath.Join(wso.provider.Location, options.Meta.InstanceID),
-		wso.creator(options.Meta.Owner, options.Meta.WorkspaceID, options.Meta.InstanceID, options.Initializer, false, options.StorageQuota))
+		wso.creator(options.Meta.Owner, options.Meta.WorkspaceID, options.Meta.InstanceID, options.Initializer, false, options.StorageQuota, options.Ephemeral, options.Class))
 
 	if err != nil {
 		return "bug: cannot add workspace to store", xerrors.Errorf("cannot add workspace to store: %w", err)
@@ -141,6 +208,7 @@ func (wso *DefaultWorkspaceOperations) InitWorkspace(ctx context.Context, option
 
 	remoteContent, err := content.CollectRemoteContent(ctx, rs, ps, options.Meta.Owner, options.Initializer)
 	if err != nil {
+		wso.metrics.recordFailure(operationRestore, options.Class, failureStorage)
 		return "remote content error", xerrors.Errorf("remote content error: %w", err)
 	}
 
@@ -172,11 +240,50 @@ func (wso *DefaultWorkspaceOperations) InitWorkspace(ctx context.Context, option
 		glog.WithFields(ws.OWI()).Warnf("cannot ensure clean slate for workspace %s (this might break content init): %v", ws.InstanceID, err)
 	}
 
+	initStart := time.Now()
+	restored, err := wso.backupEngine.Restore(ctx, ws)
+	if err != nil {
+		wso.metrics.recordFailure(operationRestore, options.Class, failureDownload)
+		glog.WithFields(ws.OWI()).Infof("error restoring backup %v", err)
+		return "cannot restore backup", err
+	}
+	if restored {
+		wso.metrics.recordRestore(restoreTypeBackup, options.Class, time.Since(initStart))
+		wso.repairOwnership(ws, ws.Location)
+
+		err = ws.Persist()
+		if err != nil {
+			return "cannot persist workspace", err
+		}
+		return "", nil
+	}
+
+	var releaseCachedContent func()
+	opts.CachedContent, releaseCachedContent = wso.cachedContent(ctx, ws, remoteContent)
 	err = content.RunInitializer(ctx, ws.Location, options.Initializer, remoteContent, opts)
+	releaseCachedContent()
 	if err != nil {
+		if len(remoteContent) > 0 {
+			wso.metrics.recordFailure(operationRestore, options.Class, failureInitializer)
+		}
 		glog.WithFields(ws.OWI()).Infof("error running initializer %v", err)
 		return err.Error(), err
 	}
+	if _, ok := remoteContent[storage.DefaultBackup]; ok {
+		wso.metrics.recordRestore(restoreTypeBackup, options.Class, time.Since(initStart))
+	} else if len(remoteContent) > 0 {
+		wso.metrics.recordRestore(restoreTypeSnapshot, options.Class, time.Since(initStart))
+	}
+	if len(remoteContent) > 0 {
+		wso.repairOwnership(ws, ws.Location)
+	}
+
+	if wso.config.Backup.Delta.Enabled {
+		err = wso.indexRestoredBackup(ws, remoteContent)
+		if err != nil {
+			glog.WithError(err).WithFields(ws.OWI()).Warn("cannot index restored workspace content, the next backup will be a full one")
+		}
+	}
 
 	err = ws.Persist()
 	if err != nil {
@@ -186,7 +293,57 @@ func (wso *DefaultWorkspaceOperations) InitWorkspace(ctx context.Context, option
 	return "", nil
 }
 
-func (wso *DefaultWorkspaceOperations) creator(owner, workspaceID, instanceID string, init *csapi.WorkspaceInitializer, storageDisabled bool, storageQuota int) WorkspaceFactory {
+// cachedContent finds the remote content which is available in the node's restore cache. If enabled, prebuilds
+// and snapshots are downloaded into the cache first. The cached archives are pinned until release is called, such that
+// they are not evicted while the content initializer restores them.
+func (wso *DefaultWorkspaceOperations) cachedContent(ctx context.Context, sess *session.Workspace, remoteContent map[string]storage.DownloadInfo) (cached map[string]string, release func()) {
+	if wso.restoreCache == nil {
+		return nil, func() {}
+	}
+
+	// the content initializer restores the backup rather than the prebuild the workspace started from
+	_, hasBackup := remoteContent[storage.DefaultBackup]
+
+	var releases []func()
+	release = func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+
+	res := make(map[string]string)
+	for name, info := range remoteContent {
+		if info.Meta.Digest == "" {
+			continue
+		}
+		if !wso.config.RestoreCache.Prebuilds || hasBackup || !isSharedContent(name) {
+			if path, r, ok := wso.restoreCache.Acquire(info.Meta.Digest); ok {
+				res[name] = path
+				releases = append(releases, r)
+			}
+			continue
+		}
+
+		path, r, err := wso.restoreCache.Fetch(ctx, info.Meta.Digest, info.URL, info.Size)
+		if err != nil {
+			// the content initializer downloads the content itself
+			glog.WithError(err).WithFields(sess.OWI()).WithField("name", name).Warn("cannot download content into restore cache")
+			continue
+		}
+		res[name] = path
+		releases = append(releases, r)
+	}
+	return res, release
+}
+
+// isSharedContent returns true if workspaces other than the one starting might start from the remote content.
+// This is the case for prebuilds and snapshots, which are named by their location, but not for backups.
+func isSharedContent(name string) bool {
+	_, _, err := storage.ParseSnapshotName(name)
+	return err == nil
+}
+
+func (wso *DefaultWorkspaceOperations) creator(owner, workspaceID, instanceID string, init *csapi.WorkspaceInitializer, storageDisabled bool, storageQuota int, ephemeral bool, class string) WorkspaceFactory {
 	var checkoutLocation string
 	allLocations := csapi.GetCheckoutLocationsFromInitializer(init)
 	if len(allLocations) > 0 {
@@ -204,6 +361,8 @@ func (wso *DefaultWorkspaceOperations) creator(owner, workspaceID, instanceID st
 			InstanceID:            instanceID,
 			RemoteStorageDisabled: storageDisabled,
 			StorageQuota:          storageQuota,
+			Ephemeral:             ephemeral,
+			Class:                 class,
 
 			ServiceLocDaemon: filepath.Join(wso.config.WorkingArea, serviceDirName),
 			ServiceLocNode:   filepath.Join(wso.config.WorkingAreaNode, serviceDirName),
@@ -242,7 +401,11 @@ func (wso *DefaultWorkspaceOperations) BackupWorkspace(ctx context.Context, opts
 		return nil, nil
 	}
 
-	err = wso.uploadWorkspaceContent(ctx, ws, opts.SnapshotName)
+	if opts.SnapshotName == storage.DefaultBackup {
+		_, err = wso.backupEngine.Backup(ctx, ws)
+	} else {
+		_, err = wso.uploadWorkspaceContent(ctx, ws, opts.SnapshotName, nil, nil)
+	}
 	if err != nil {
 		glog.WithError(err).WithFields(ws.OWI()).Error("final backup failed for workspace")
 		return nil, fmt.Errorf("final backup failed for workspace %s", opts.Meta.InstanceID)
@@ -264,6 +427,55 @@ func (wso *DefaultWorkspaceOperations) BackupWorkspace(ctx context.Context, opts
 	return repo, nil
 }
 
+func (wso *DefaultWorkspaceOperations) LiveBackupWorkspace(ctx context.Context, instanceID string) (next time.Duration, err error) {
+	cfg := wso.config.Backup.Live
+	if !cfg.Enabled {
+		return 0, nil
+	}
+
+	//nolint:ineffassign
+	span, ctx := opentracing.StartSpanFromContext(ctx, "LiveBackupWorkspace")
+	defer tracing.FinishSpan(span, &err)
+
+	ws, err := wso.provider.GetAndConnect(ctx, instanceID)
+	if err != nil {
+		return 0, fmt.Errorf("cannot find workspace %s during LiveBackupWorkspace: %w", instanceID, err)
+	}
+	if ws.RemoteStorageDisabled || ws.Ephemeral {
+		return 0, nil
+	}
+
+	var (
+		interval = time.Duration(cfg.Interval)
+		now      = time.Now()
+	)
+	if ws.LastLiveBackup == nil {
+		// the content was initialized just now, hence there is nothing to back up yet
+		ws.LastLiveBackup = &now
+		return interval, ws.Persist()
+	}
+	if due := ws.LastLiveBackup.Add(interval); now.Before(due) {
+		return due.Sub(now), nil
+	}
+
+	// a failed live backup is not retried before the next interval, the final backup will pick up its changes
+	ws.LastLiveBackup = &now
+	err = ws.Persist()
+	if err != nil {
+		glog.WithError(err).WithFields(ws.OWI()).Warn("cannot persist time of live backup")
+	}
+
+	// Changes are uploaded as delta layer unless a full backup is due, or as restic snapshot. Either way, the
+	// content of the running workspace is left untouched.
+	size, err := wso.backupEngine.Backup(ctx, ws)
+	if err != nil {
+		return interval, fmt.Errorf("live backup failed for workspace %s: %w", instanceID, err)
+	}
+	glog.WithFields(ws.OWI()).WithField("size", size).WithField("duration", time.Since(now)).Debug("uploaded live backup")
+
+	return interval, nil
+}
+
 func (wso *DefaultWorkspaceOperations) DeleteWorkspace(ctx context.Context, instanceID string) error {
 	ws, err := wso.provider.GetAndConnect(ctx, instanceID)
 	if err != nil {
@@ -302,32 +514,131 @@ func (wso *DefaultWorkspaceOperations) SnapshotIDs(ctx context.Context, instance
 	return rs.Qualify(snapshotName), snapshotName, nil
 }
 
-func (wso *DefaultWorkspaceOperations) Snapshot(ctx context.Context, workspaceID, snapshotName string) (err error) {
+func (wso *DefaultWorkspaceOperations) Snapshot(ctx context.Context, workspaceID, snapshotName string, paths []string, exportTag string) (imageRef string, size int64, err error) {
 	//nolint:ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, "TakeSnapshot")
 	span.SetTag("workspace", workspaceID)
 	defer tracing.FinishSpan(span, &err)
 
 	if workspaceID == "" {
-		return fmt.Errorf("workspaceID is required")
+		return "", 0, fmt.Errorf("workspaceID is required")
+	}
+	if exportTag != "" && wso.snapshotExporter == nil {
+		return "", 0, fmt.Errorf("snapshot export is not configured")
 	}
 
 	ws, err := wso.provider.GetAndConnect(ctx, workspaceID)
 	if err != nil {
-		return fmt.Errorf("cannot find workspace %s during DisposeWorkspace", workspaceID)
+		return "", 0, fmt.Errorf("cannot find workspace %s during DisposeWorkspace", workspaceID)
 	}
 
 	if ws.RemoteStorageDisabled {
-		return fmt.Errorf("workspace has no remote storage")
+		return "", 0, fmt.Errorf("workspace has no remote storage")
 	}
 
-	err = wso.uploadWorkspaceContent(ctx, ws, snapshotName)
+	filter, err := content.NewPathFilter(ws.Location, paths)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid snapshot paths: %w", err)
+	}
+
+	var export func(ctx context.Context, archive string) error
+	if exportTag != "" {
+		export = func(ctx context.Context, archive string) (err error) {
+			imageRef, err = wso.snapshotExporter.Export(ctx, archive, exportTag, ws.WorkspaceID)
+			return err
+		}
+	}
+
+	size, err = wso.uploadWorkspaceContent(ctx, ws, snapshotName, filter, export)
 	if err != nil {
 		glog.WithError(err).WithFields(ws.OWI()).Error("snapshot failed for workspace")
-		return fmt.Errorf("snapshot failed for workspace %s", workspaceID)
+		return "", 0, fmt.Errorf("snapshot failed for workspace %s", workspaceID)
 	}
 
-	return nil
+	return imageRef, size, nil
+}
+
+func (wso *DefaultWorkspaceOperations) RestoreSnapshot(ctx context.Context, opts RestoreOptions) (conflicts []string, err error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RestoreSnapshot")
+	span.SetTag("workspace", opts.InstanceID)
+	span.SetTag("snapshot", opts.Snapshot)
+	defer tracing.FinishSpan(span, &err)
+
+	ws, err := wso.provider.GetAndConnect(ctx, opts.InstanceID)
+	if err != nil {
+		return nil, xerrors.Errorf("%w: %s", errWorkspaceNotFound, opts.InstanceID)
+	}
+	if ws.RemoteStorageDisabled {
+		return nil, xerrors.Errorf("workspace has no remote storage")
+	}
+	rs, ok := ws.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
+	if rs == nil || !ok {
+		wso.metrics.recordFailure(operationRestore, ws.Class, failureStorage)
+		return nil, xerrors.Errorf("no remote storage configured")
+	}
+
+	target, err := content.RestoreTarget(ws.Location, opts.Target)
+	if err != nil {
+		wso.metrics.recordFailure(operationRestore, ws.Class, failureTarget)
+		return nil, err
+	}
+	start := time.Now()
+
+	// We download into the service location of the daemon rather than the workspace, such that the workspace
+	// user cannot tamper with the content while we merge it.
+	staging, err := os.MkdirTemp(ws.ServiceLocDaemon, "restore-*")
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create staging directory: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	var found bool
+	if strings.Contains(opts.Snapshot, "@") {
+		found, err = rs.DownloadSnapshot(ctx, staging, opts.Snapshot, backupIDMappings)
+	} else {
+		found, err = rs.Download(ctx, staging, opts.Snapshot, backupIDMappings)
+	}
+	if err != nil {
+		wso.metrics.recordFailure(operationRestore, ws.Class, failureDownload)
+		return nil, xerrors.Errorf("cannot download %s: %w", opts.Snapshot, err)
+	}
+	if !found {
+		wso.metrics.recordFailure(operationRestore, ws.Class, failureNotFound)
+		return nil, xerrors.Errorf("%w: %s", errSnapshotNotFound, opts.Snapshot)
+	}
+
+	wso.repairOwnership(ws, staging)
+
+	conflicts, err = content.MergeContent(staging, target, opts.ConflictPolicy)
+	if errors.Is(err, content.ErrRestoreConflict) {
+		wso.metrics.recordFailure(operationRestore, ws.Class, failureConflict)
+		return conflicts, err
+	}
+	if err != nil {
+		wso.metrics.recordFailure(operationRestore, ws.Class, failureMerge)
+		return conflicts, err
+	}
+	wso.metrics.recordRestore(restoreTypeLive, ws.Class, time.Since(start))
+
+	glog.WithFields(ws.OWI()).WithField("snapshot", opts.Snapshot).WithField("target", opts.Target).WithField("conflicts", len(conflicts)).Info("restored snapshot into workspace")
+	return conflicts, nil
+}
+
+// repairOwnership hands restored files the workspace user cannot access back to it, if enabled. A failed repair
+// does not fail the restore, since the content is complete nonetheless.
+func (wso *DefaultWorkspaceOperations) repairOwnership(sess *session.Workspace, location string) {
+	if !wso.config.OwnershipRepair.Enabled {
+		return
+	}
+
+	stats, err := content.RepairOwnership(location, backupIDMappings, wsinit.GitpodUID+100000-1, wsinit.GitpodGID+100000-1, wso.config.OwnershipRepair.IncludeRoot)
+	if err != nil {
+		glog.WithError(err).WithFields(sess.OWI()).Warn("cannot repair ownership of restored workspace content")
+		return
+	}
+	if stats.Chowned > 0 || stats.Chmodded > 0 {
+		glog.WithFields(sess.OWI()).WithField("chowned", stats.Chowned).WithField("chmodded", stats.Chmodded).Info("repaired ownership of restored workspace content")
+	}
 }
 
 func ensureCleanSlate(location string) error {
@@ -394,52 +705,51 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceLogs(ctx context.Context,
 	return err
 }
 
-func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Context, sess *session.Workspace, backupName string) error {
-	// Avoid too many simultaneous backups in order to avoid excessive memory utilization.
-	var timedOut bool
-	waitStart := time.Now()
-	select {
-	case wso.backupWorkspaceLimiter <- struct{}{}:
-	case <-time.After(15 * time.Minute):
-		// we timed out on the rate limit - let's upload anyways, because we don't want to actually block
-		// an upload. If we reach this point, chances are other things are broken. No upload should ever
-		// take this long.
-		timedOut = true
-		wso.metrics.BackupWaitingTimeoutCounter.Inc()
-	}
-
-	waitTime := time.Since(waitStart)
-	wso.metrics.BackupWaitingTimeHist.Observe(waitTime.Seconds())
-
-	defer func() {
-		// timeout -> we did not add to the limiter
-		if timedOut {
-			return
-		}
-
-		<-wso.backupWorkspaceLimiter
-	}()
+// uploadWorkspaceContent uploads the workspace content to remote storage. If set, the content is restricted to the
+// paths of filter and export is called with the archive once it has been uploaded.
+func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Context, sess *session.Workspace, backupName string, filter *content.PathFilter, export func(ctx context.Context, archive string) error) (size int64, err error) {
+	release := wso.acquireBackupSlot()
+	defer release()
 
 	var (
-		loc  = sess.Location
-		opts []storage.UploadOption
+		loc   = sess.Location
+		opts  = wso.backupUploadOptions(sess)
+		tpe   = backupType(backupName)
+		start = time.Now()
 	)
 
-	err := os.Remove(filepath.Join(sess.Location, wsinit.WorkspaceReadyFile))
-	if err != nil && !errors.Is(err, fs.ErrNotExist) {
-		// We'll still upload the backup, well aware that the UX during restart will be broken.
-		// But it's better to have a backup with all files (albeit one too many), than having no backup at all.
-		glog.WithError(err).WithFields(sess.OWI()).Warn("cannot remove workspace ready file")
-	}
-
 	rs, ok := sess.NonPersistentAttrs[session.AttrRemoteStorage].(storage.DirectAccess)
 	if rs == nil || !ok {
-		return xerrors.Errorf("no remote storage configured")
+		wso.metrics.recordFailure(tpe, sess.Class, failureStorage)
+		return 0, xerrors.Errorf("no remote storage configured")
+	}
+
+	var idx *content.DeltaIndex
+	if wso.config.Backup.Delta.Enabled && backupName == storage.DefaultBackup && filter == nil && export == nil {
+		size, ok, err := wso.uploadDeltaLayer(ctx, sess, rs)
+		if err == nil && ok {
+			wso.metrics.recordBackup(tpe, sess.Class, time.Since(start), size)
+			return size, nil
+		}
+		if err != nil {
+			glog.WithError(err).WithFields(sess.OWI()).Warn("cannot upload delta backup, uploading a full backup instead")
+		}
+
+		// The full backup starts a new chain of delta layers. We scan the content before building the archive,
+		// such that changes made while the archive is built end up in the next delta layer.
+		since := time.Now()
+		_, paths, err := content.ScanChanges(loc, nil, wso.backupExcludes())
+		if err != nil {
+			glog.WithError(err).WithFields(sess.OWI()).Warn("cannot scan workspace content, the next backup will be a full one")
+		} else {
+			idx = &content.DeltaIndex{Since: since, Paths: paths}
+		}
 	}
 
 	var (
 		tmpf     *os.File
 		tmpfSize int64
+		dgst     digest.Digest
 	)
 
 	defer func() {
@@ -461,16 +771,12 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 			}
 		}()
 
-		var opts []archive.TarOption
-		opts = append(opts)
-		mappings := []archive.IDMapping{
-			{ContainerID: 0, HostID: wsinit.GitpodUID, Size: 1},
-			{ContainerID: 1, HostID: 100000, Size: 65534},
+		opts := []archive.TarOption{
+			archive.WithUIDMapping(backupIDMappings),
+			archive.WithGIDMapping(backupIDMappings),
+			archive.WithCompression(wso.config.Backup.Compression, wso.config.Backup.CompressionLevel),
 		}
-		opts = append(opts,
-			archive.WithUIDMapping(mappings),
-			archive.WithGIDMapping(mappings),
-		)
+		opts = append(opts, filter.TarOptions(wso.backupExcludes())...)
 
 		err = content.BuildTarbal(ctx, loc, tmpf.Name(), opts...)
 		if err != nil {
@@ -492,27 +798,312 @@ func (wso *DefaultWorkspaceOperations) uploadWorkspaceContent(ctx context.Contex
 		tmpfSize = stat.Size()
 		glog.WithField("size", tmpfSize).WithField("location", tmpf.Name()).WithFields(sess.OWI()).Debug("created temp file for workspace backup upload")
 
+		if wso.restoreCache != nil || idx != nil {
+			// the digest identifies the archive in the restore cache when the workspace restarts on this node,
+			// and the full backup delta layers apply to
+			dgst, err = digest.FromReader(tmpf)
+			if err != nil {
+				return
+			}
+		}
+
 		return
 	})
 	if err != nil {
-		return xerrors.Errorf("cannot create archive: %w", err)
+		wso.metrics.recordFailure(tpe, sess.Class, failureArchive)
+		return 0, xerrors.Errorf("cannot create archive: %w", err)
+	}
+	if dgst != "" {
+		opts = append(opts, storage.WithAnnotations(map[string]string{
+			storage.ObjectAnnotationDigest: dgst.String(),
+		}))
 	}
 
 	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload layer"), func(ctx context.Context) (err error) {
+		uploadStart := time.Now()
 		_, _, err = rs.Upload(ctx, tmpf.Name(), backupName, opts...)
 		if err != nil {
 			return
 		}
+		wso.metrics.recordUpload(sess.Class, time.Since(uploadStart), tmpfSize)
 
 		return
 	})
 	if err != nil {
-		return xerrors.Errorf("cannot upload workspace content: %w", err)
+		wso.metrics.recordFailure(tpe, sess.Class, failureUpload)
+		return 0, xerrors.Errorf("cannot upload workspace content: %w", err)
+	}
+	wso.recordCatalogEntry(ctx, sess, rs, backupName, tmpfSize)
+
+	if idx != nil {
+		idx.Base = dgst.String()
+		idx.Size = tmpfSize
+		err = idx.Write(deltaIndexFile(sess))
+		if err != nil {
+			glog.WithError(err).WithFields(sess.OWI()).Warn("cannot write delta index, the next backup will be a full one")
+		}
+	}
+
+	if wso.restoreCache != nil && dgst != "" {
+		err = wso.restoreCache.Put(tmpf.Name(), dgst.String())
+		if err != nil {
+			// the backup is safe in remote storage - a restart will just have to download it
+			glog.WithError(err).WithFields(sess.OWI()).Warn("cannot add backup to restore cache")
+		}
 	}
 
+	if export != nil {
+		err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "export snapshot"), func(ctx context.Context) error {
+			return export(ctx, tmpf.Name())
+		})
+		if err != nil {
+			wso.metrics.recordFailure(tpe, sess.Class, failureExport)
+			return 0, xerrors.Errorf("cannot export workspace content: %w", err)
+		}
+	}
+	wso.metrics.recordBackup(tpe, sess.Class, time.Since(start), tmpfSize)
+
+	return tmpfSize, nil
+}
+
+// recordCatalogEntry adds uploaded content to the content catalog of the workspace, from which content-service
+// lists the backups and snapshots of workspaces, and prunes the entries the new one supersedes.
+// The content is safe in remote storage regardless, hence failures are only logged.
+func (wso *DefaultWorkspaceOperations) recordCatalogEntry(ctx context.Context, sess *session.Workspace, rs storage.DirectAccess, name string, size int64) {
+	kind := catalog.KindSnapshot
+	if name == storage.DefaultBackup {
+		kind = catalog.KindBackup
+	}
+	entry := catalog.Entry{
+		Name:          name,
+		Kind:          kind,
+		Size:          size,
+		Created:       time.Now(),
+		FormatVersion: catalog.FormatVersion,
+	}
+
+	// catalog entries carry all their metadata in their name
+	tmpf, err := os.CreateTemp(wso.config.TmpDir, fmt.Sprintf("wscatalog-%s-*", sess.InstanceID))
+	if err != nil {
+		glog.WithError(err).WithFields(sess.OWI()).Warn("cannot record workspace content in catalog")
+		return
+	}
+	tmpf.Close()
+	defer os.Remove(tmpf.Name())
+
+	_, _, err = rs.Upload(ctx, tmpf.Name(), entry.Path())
+	if err != nil {
+		glog.WithError(err).WithFields(sess.OWI()).WithField("entry", entry.Path()).Warn("cannot record workspace content in catalog")
+		return
+	}
+
+	err = wso.pruneCatalog(ctx, sess, rs, entry)
+	if err != nil {
+		// content-service lists the latest entry only, stale entries merely take up space
+		glog.WithError(err).WithFields(sess.OWI()).Warn("cannot prune workspace content catalog")
+	}
+}
+
+// pruneCatalog deletes the catalog entries of the workspace which latest supersedes, e.g. those of previous backups
+func (wso *DefaultWorkspaceOperations) pruneCatalog(ctx context.Context, sess *session.Workspace, rs storage.DirectAccess, latest catalog.Entry) error {
+	objects, err := rs.ListObjects(ctx, rs.BackupObject(catalog.KindPrefix(latest.Kind)))
+	if err != nil {
+		return err
+	}
+	stale := catalog.Superseded(objects, latest)
+	if len(stale) == 0 {
+		return nil
+	}
+
+	ps, err := storage.NewPresignedAccess(&wso.config.Storage)
+	if err != nil {
+		return xerrors.Errorf("no presigned storage available: %w", err)
+	}
+	for _, obj := range stale {
+		err = ps.DeleteObject(ctx, rs.Bucket(sess.Owner), &storage.DeleteObjectQuery{Name: obj})
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return xerrors.Errorf("cannot delete %s: %w", obj, err)
+		}
+	}
 	return nil
 }
 
+// backupUploadOptions configures the upload of the backup archives of a workspace
+func (wso *DefaultWorkspaceOperations) backupUploadOptions(sess *session.Workspace) []storage.UploadOption {
+	cfg := wso.config.Backup
+	return []storage.UploadOption{
+		storage.WithContentType(cfg.Compression.ContentType()),
+		storage.WithParallelUpload(cfg.Upload.PartSize, cfg.Upload.Parallelism),
+		storage.WithBandwidthLimit(cfg.Upload.BandwidthLimitFor(sess.Class)),
+	}
+}
+
+// uploadDeltaLayer uploads the changes since the last backup as delta layer on top of the last full backup.
+// If there is no full backup to build on, or enough delta layers piled up, ok is false and a full backup is due.
+func (wso *DefaultWorkspaceOperations) uploadDeltaLayer(ctx context.Context, sess *session.Workspace, rs storage.DirectAccess) (size int64, ok bool, err error) {
+	idxFN := deltaIndexFile(sess)
+	idx, err := content.ReadDeltaIndex(idxFN)
+	if err != nil {
+		return 0, false, err
+	}
+	if idx == nil || idx.Base == "" || idx.Layers >= wso.config.Backup.Delta.FullBackupInterval {
+		return 0, false, nil
+	}
+
+	since := time.Now()
+	changes, paths, err := content.ScanChanges(sess.Location, idx, wso.backupExcludes())
+	if err != nil {
+		return 0, false, err
+	}
+	if len(changes) == 0 {
+		// the layers uploaded already hold the current content
+		return 0, true, nil
+	}
+
+	tmpf, err := os.CreateTemp(wso.config.TmpDir, fmt.Sprintf("wsdelta-%s-*.tar", sess.InstanceID))
+	if err != nil {
+		return 0, false, err
+	}
+	tmpf.Close()
+	defer os.Remove(tmpf.Name())
+
+	err = content.BuildDeltaTarbal(ctx, sess.Location, tmpf.Name(), changes,
+		archive.WithUIDMapping(backupIDMappings),
+		archive.WithGIDMapping(backupIDMappings),
+		archive.WithCompression(wso.config.Backup.Compression, wso.config.Backup.CompressionLevel),
+	)
+	if err != nil {
+		return 0, false, xerrors.Errorf("cannot create delta layer: %w", err)
+	}
+	stat, err := os.Stat(tmpf.Name())
+	if err != nil {
+		return 0, false, err
+	}
+	glog.WithField("size", stat.Size()).WithField("changes", len(changes)).WithFields(sess.OWI()).Debug("created delta layer for workspace backup upload")
+
+	layer := storage.DeltaLayer(idx.Layers + 1)
+	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload delta layer"), func(ctx context.Context) error {
+		uploadStart := time.Now()
+		_, _, err := rs.Upload(ctx, tmpf.Name(), layer, wso.backupUploadOptions(sess)...)
+		if err != nil {
+			return err
+		}
+		wso.metrics.recordUpload(sess.Class, time.Since(uploadStart), stat.Size())
+		return nil
+	})
+	if err != nil {
+		return 0, false, xerrors.Errorf("cannot upload delta layer: %w", err)
+	}
+
+	// The manifest is uploaded last: a layer only becomes part of the backup once the manifest lists it.
+	manifest := storage.DeltaManifest{Base: idx.Base}
+	for n := 1; n <= idx.Layers+1; n++ {
+		manifest.Layers = append(manifest.Layers, storage.DeltaLayer(n))
+	}
+	mf, err := json.Marshal(manifest)
+	if err != nil {
+		return 0, false, err
+	}
+	mfFN := tmpf.Name() + ".json"
+	err = os.WriteFile(mfFN, mf, 0644)
+	if err != nil {
+		return 0, false, err
+	}
+	defer os.Remove(mfFN)
+	err = retryIfErr(ctx, wso.config.Backup.Attempts, glog.WithFields(sess.OWI()).WithField("op", "upload delta manifest"), func(ctx context.Context) error {
+		_, _, err := rs.Upload(ctx, mfFN, storage.DefaultBackupDelta, storage.WithContentType("application/json"))
+		return err
+	})
+	if err != nil {
+		return 0, false, xerrors.Errorf("cannot upload delta manifest: %w", err)
+	}
+
+	idx = &content.DeltaIndex{Base: idx.Base, Layers: idx.Layers + 1, Since: since, Paths: paths, Size: idx.Size + stat.Size()}
+	wso.recordCatalogEntry(ctx, sess, rs, storage.DefaultBackup, idx.Size)
+	err = idx.Write(idxFN)
+	if err != nil {
+		// The backup is complete nonetheless. The next delta layer will contain the changes of this one once more.
+		glog.WithError(err).WithFields(sess.OWI()).Warn("cannot update delta index")
+	}
+
+	return stat.Size(), true, nil
+}
+
+// indexRestoredBackup records the content of a workspace which was restored from a backup, such that
+// its next backup can be a delta layer on top of the restored ones.
+func (wso *DefaultWorkspaceOperations) indexRestoredBackup(sess *session.Workspace, remoteContent map[string]storage.DownloadInfo) error {
+	backup, ok := remoteContent[storage.DefaultBackup]
+	if !ok || backup.Meta.Digest == "" {
+		return nil
+	}
+
+	var layers int
+	for name := range remoteContent {
+		if storage.IsDeltaLayer(name) {
+			layers++
+		}
+	}
+
+	since := time.Now()
+	_, paths, err := content.ScanChanges(sess.Location, nil, wso.backupExcludes())
+	if err != nil {
+		return err
+	}
+
+	idx := &content.DeltaIndex{Base: backup.Meta.Digest, Layers: layers, Since: since, Paths: paths}
+	return idx.Write(deltaIndexFile(sess))
+}
+
+// acquireBackupSlot waits until fewer than five backups are running, in order to avoid excessive memory
+// utilization. The returned function frees the slot again.
+func (wso *DefaultWorkspaceOperations) acquireBackupSlot() (release func()) {
+	var timedOut bool
+	waitStart := time.Now()
+	select {
+	case wso.backupWorkspaceLimiter <- struct{}{}:
+	case <-time.After(15 * time.Minute):
+		// we timed out on the rate limit - let's upload anyways, because we don't want to actually block
+		// an upload. If we reach this point, chances are other things are broken. No upload should ever
+		// take this long.
+		timedOut = true
+		wso.metrics.BackupWaitingTimeoutCounter.Inc()
+	}
+
+	waitTime := time.Since(waitStart)
+	wso.metrics.BackupWaitingTimeHist.Observe(waitTime.Seconds())
+
+	return func() {
+		// timeout -> we did not add to the limiter
+		if timedOut {
+			return
+		}
+
+		<-wso.backupWorkspaceLimiter
+	}
+}
+
+// backupIDMappings maps the user and group IDs of the workspace content to those we put in backups
+var backupIDMappings = []archive.IDMapping{
+	{ContainerID: 0, HostID: wsinit.GitpodUID, Size: 1},
+	{ContainerID: 1, HostID: 100000, Size: 65534},
+}
+
+// backupExcludes lists the paths which are left out of backups and ignored when scanning for changes, relative to
+// the workspace location. The workspace ready file is placed during content initialization and must not be restored
+// along with the content, because supervisor takes its presence as sign that the content is ready.
+func (wso *DefaultWorkspaceOperations) backupExcludes() []string {
+	excludes := []string{wsinit.WorkspaceReadyFile}
+	if wso.config.DockerCache.Enabled && !wso.config.DockerCache.IncludeInBackup {
+		excludes = append(excludes, content.DockerDataRoot)
+	}
+	return excludes
+}
+
+// deltaIndexFile is the file the delta index of a workspace is kept in
+func deltaIndexFile(sess *session.Workspace) string {
+	return filepath.Join(sess.ServiceLocDaemon, "delta-index.json")
+}
+
 func retryIfErr(ctx context.Context, attempts int, log *logrus.Entry, op func(ctx context.Context) error) (err error) {
 	//nolint:ineffassign
 	span, ctx := opentracing.StartSpanFromContext(ctx, "retryIfErr")
@@ -16,6 +16,8 @@ import (
 	"time"
 
 	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/cgroups"
 )
 
 type CgroupV2CFSController string
@@ -58,6 +60,16 @@ func (basePath CgroupV2CFSController) NrThrottled() (uint64, error) {
 	return uint64(throttled), nil
 }
 
+// Pressure returns the total time in which some tasks of the cgroup stalled on CPU
+func (basePath CgroupV2CFSController) Pressure() (CPUTime, error) {
+	psi, err := cgroups.ReadPSIValue(filepath.Join(string(basePath), "cpu.pressure"))
+	if err != nil {
+		return 0, xerrors.Errorf("cannot read cpu.pressure: %w", err)
+	}
+
+	return CPUTime(time.Duration(psi.Some) * time.Microsecond), nil
+}
+
 func (basePath CgroupV2CFSController) readCpuMax() (time.Duration, time.Duration, error) {
 	cpuMaxPath := filepath.Join(string(basePath), "cpu.max")
 	cpuMax, err := os.ReadFile(cpuMaxPath)
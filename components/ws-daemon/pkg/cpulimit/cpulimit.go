@@ -23,6 +23,9 @@ type Workspace struct {
 	Usage       CPUTime
 	QoS         int
 	Annotations map[string]string
+
+	// Pressure is the total time in which some tasks of the workspace stalled on CPU
+	Pressure CPUTime
 }
 
 type WorkspaceHistory struct {
@@ -31,7 +34,12 @@ type WorkspaceHistory struct {
 	LastUpdate  *Workspace
 	UsageT0     CPUTime
 	ThrottleLag uint64
+	UsageLag    CPUTime
+	PressureLag CPUTime
 	Limit       Bandwidth
+
+	// Credit is the CPU time the workspace can spend on bursts
+	Credit CPUTime
 }
 
 func (h *WorkspaceHistory) Usage() CPUTime {
@@ -46,6 +54,8 @@ func (h *WorkspaceHistory) Update(w Workspace) {
 		h.UsageT0 = w.Usage
 	} else {
 		h.ThrottleLag = h.LastUpdate.NrThrottled
+		h.UsageLag = h.LastUpdate.Usage
+		h.PressureLag = h.LastUpdate.Pressure
 	}
 	h.LastUpdate = &w
 }
@@ -84,6 +94,10 @@ type Distributor struct {
 	TotalBandwidth Bandwidth
 	LastTickUsage  CPUTime
 
+	// BurstCredit (if not nil) decides which workspaces may burst based on CPU pressure.
+	// If BurstCredit is nil, workspaces which were throttled may burst.
+	BurstCredit *BurstCredit
+
 	// Log is used (if not nil) to log out errors. If log is nil, no logging happens.
 	Log *logrus.Entry
 }
@@ -173,6 +187,13 @@ func (d *Distributor) Tick(dt time.Duration) (DistributorDebug, error) {
 		}, err
 	}
 
+	if d.BurstCredit != nil {
+		err = d.BurstCredit.Update(dt)
+		if err != nil && d.Log != nil {
+			d.Log.WithError(err).Warn("cannot update CPU burst credit")
+		}
+	}
+
 	// enforce limits
 	var burstBandwidth Bandwidth
 	for _, id := range wsOrder {
@@ -183,12 +204,19 @@ func (d *Distributor) Tick(dt time.Duration) (DistributorDebug, error) {
 			continue
 		}
 
-		// if we didn't get the max bandwidth, but were throttled last time
+		var mayBurst bool
+		if d.BurstCredit != nil {
+			mayBurst = d.BurstCredit.Burst(ws, limit, dt)
+		} else {
+			mayBurst = ws.Throttled()
+		}
+
+		// if we didn't get the max bandwidth, but were throttled (or stalled on CPU) last time
 		// and there's still some bandwidth left to give, let's act as if had
 		// never spent any CPU time and assume the workspace will spend their
 		// entire bandwidth at once.
 		var burst bool
-		if totalBandwidth < d.TotalBandwidth && ws.Throttled() {
+		if totalBandwidth < d.TotalBandwidth && mayBurst {
 			limit, err = d.BurstLimiter.Limit(ws)
 			if err != nil {
 				log.WithError(err).Errorf("unable to apply burst limit")
@@ -373,6 +401,12 @@ func (cl *compositeLimiter) Limit(wsh *WorkspaceHistory) (Bandwidth, error) {
 	return 0, xerrors.Errorf("no limiter was able to provide a limit", strings.Join(allerr, ", "))
 }
 
+// CPUPressureReader is implemented by CFS controllers which can read the CPU pressure of their cgroup
+type CPUPressureReader interface {
+	// Pressure returns the total time in which some tasks of the cgroup stalled on CPU
+	Pressure() (CPUTime, error)
+}
+
 type CFSController interface {
 	// Usage returns the cpuacct.usage value of the cgroup
 	Usage() (usage CPUTime, err error)
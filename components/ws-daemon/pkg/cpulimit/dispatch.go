@@ -33,6 +33,9 @@ type Config struct {
 
 	ControlPeriod  util.Duration `json:"controlPeriod"`
 	CGroupBasePath string        `json:"cgroupBasePath"`
+
+	// PSI makes workspaces burst based on CPU pressure rather than CFS throttling
+	PSI PressureConfig `json:"psi"`
 }
 
 // NewDispatchListener creates a new resource governer dispatch listener
@@ -70,6 +73,10 @@ func NewDispatchListener(cfg *Config, prom prometheus.Registerer) *DispatchListe
 			CompositeLimiter(AnnotationLimiter(kubernetes.WorkspaceCpuBurstLimitAnnotation), FixedLimiter(BandwidthFromQuantity(d.Config.BurstLimit))),
 			BandwidthFromQuantity(d.Config.TotalBandwidth),
 		)
+		if d.Config.PSI.Enabled {
+			dist.BurstCredit = NewBurstCredit(d.Config.PSI, NodePressureSource())
+			dist.Log = log.WithField("component", "cpulimit")
+		}
 		go dist.Run(context.Background(), time.Duration(d.Config.ControlPeriod))
 	}
 
@@ -138,11 +145,20 @@ func (d *DispatchListener) source(context.Context) ([]Workspace, error) {
 
 		d.workspacesCPUTimeVec.WithLabelValues("none").Add(time.Duration(usage).Seconds())
 
+		var pressure CPUTime
+		if pr, ok := w.CFS.(CPUPressureReader); ok && d.Config.PSI.Enabled {
+			pressure, err = pr.Pressure()
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				log.WithFields(w.OWI).WithError(err).Warn("cannot read CPU pressure")
+			}
+		}
+
 		res = append(res, Workspace{
 			ID:          id,
 			NrThrottled: throttled,
 			Usage:       usage,
 			Annotations: w.Annotations,
+			Pressure:    pressure,
 		})
 	}
 	return res, nil
@@ -0,0 +1,146 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cpulimit
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/cgroups"
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/util"
+)
+
+const (
+	// nodeCPUPressurePath is the system-wide CPU pressure stall information
+	nodeCPUPressurePath = "/proc/pressure/cpu"
+
+	defaultNodePressureThreshold      = 0.2
+	defaultWorkspacePressureThreshold = 0.1
+	defaultMaxBurstCredit             = 10 * time.Minute
+)
+
+// PressureConfig configures the burst credit model which uses the CPU pressure stall information (PSI)
+// of the node and its workspaces to decide which workspaces may burst.
+type PressureConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// NodeThreshold is the share of time (0-1) some tasks on the node stalled on CPU above which no workspace may burst.
+	// Defaults to 0.2.
+	NodeThreshold float64 `json:"nodeThreshold,omitempty"`
+
+	// WorkspaceThreshold is the share of time (0-1) some tasks of a workspace stalled on CPU above which the workspace
+	// spends its burst credit. Defaults to 0.1.
+	WorkspaceThreshold float64 `json:"workspaceThreshold,omitempty"`
+
+	// MaxCredit is the CPU time a workspace can save up for bursts unless its workspace class says otherwise.
+	// Defaults to 10m.
+	MaxCredit util.Duration `json:"maxCredit,omitempty"`
+}
+
+// PressureSource returns the total time in which some tasks stalled on CPU
+type PressureSource func() (CPUTime, error)
+
+// NodePressureSource reads the CPU pressure of the node
+func NodePressureSource() PressureSource {
+	return func() (CPUTime, error) {
+		psi, err := cgroups.ReadPSIValue(nodeCPUPressurePath)
+		if err != nil {
+			return 0, err
+		}
+		return CPUTime(time.Duration(psi.Some) * time.Microsecond), nil
+	}
+}
+
+// NewBurstCredit creates a new burst credit model
+func NewBurstCredit(cfg PressureConfig, nodePressure PressureSource) *BurstCredit {
+	if cfg.NodeThreshold <= 0 {
+		cfg.NodeThreshold = defaultNodePressureThreshold
+	}
+	if cfg.WorkspaceThreshold <= 0 {
+		cfg.WorkspaceThreshold = defaultWorkspacePressureThreshold
+	}
+	if cfg.MaxCredit <= 0 {
+		cfg.MaxCredit = util.Duration(defaultMaxBurstCredit)
+	}
+	return &BurstCredit{
+		Config:       cfg,
+		NodePressure: nodePressure,
+	}
+}
+
+// BurstCredit decides which workspaces may burst based on CPU pressure rather than CFS throttling.
+// Workspaces earn credit while they use less CPU time than their limit grants them, and spend that
+// credit on bursts while they stall on CPU. No workspace may burst while the node itself is under
+// pressure, which leaves the available bandwidth to the workspaces at their regular limit.
+type BurstCredit struct {
+	Config       PressureConfig
+	NodePressure PressureSource
+
+	lastNodePressure CPUTime
+	nodePressure     float64
+}
+
+// Update samples the CPU pressure of the node. Callers are expected to call this function once per distributor tick.
+func (c *BurstCredit) Update(dt time.Duration) error {
+	total, err := c.NodePressure()
+	if err != nil {
+		// without the node pressure we decide on the pressure of the workspaces alone
+		c.nodePressure = 0
+		return xerrors.Errorf("cannot read node CPU pressure: %w", err)
+	}
+
+	if c.lastNodePressure == 0 || total < c.lastNodePressure || dt == 0 {
+		// we need two samples to compute the pressure
+		c.nodePressure = 1
+	} else {
+		c.nodePressure = float64(total-c.lastNodePressure) / float64(dt)
+	}
+	c.lastNodePressure = total
+	return nil
+}
+
+// NodeUnderPressure returns true if the node stalled on CPU for more than the threshold during the last tick
+func (c *BurstCredit) NodeUnderPressure() bool {
+	return c.nodePressure > c.Config.NodeThreshold
+}
+
+// Burst updates the burst credit of a workspace which is entitled to limit and returns true if the workspace may burst.
+func (c *BurstCredit) Burst(wsh *WorkspaceHistory, limit Bandwidth, dt time.Duration) bool {
+	if wsh.LastUpdate == nil || wsh.UsageLag == 0 || dt == 0 {
+		// we need two samples to compute credit and pressure
+		return false
+	}
+
+	// workspaces earn the CPU time they left unused, and pay for the CPU time they used beyond their limit
+	usage := wsh.LastUpdate.Usage - wsh.UsageLag
+	credit := wsh.Credit + limit.Integrate(dt) - usage
+	if credit < 0 {
+		credit = 0
+	}
+	if maxCredit := c.maxCredit(wsh); credit > maxCredit {
+		credit = maxCredit
+	}
+	wsh.Credit = credit
+
+	var pressure float64
+	if wsh.LastUpdate.Pressure > wsh.PressureLag {
+		pressure = float64(wsh.LastUpdate.Pressure-wsh.PressureLag) / float64(dt)
+	}
+
+	return wsh.Credit > 0 && pressure > c.Config.WorkspaceThreshold && !c.NodeUnderPressure()
+}
+
+// maxCredit returns the burst credit of the workspace class of a workspace
+func (c *BurstCredit) maxCredit(wsh *WorkspaceHistory) CPUTime {
+	if value, ok := wsh.LastUpdate.Annotations[kubernetes.WorkspaceCpuBurstCreditAnnotation]; ok {
+		d, err := time.ParseDuration(value)
+		if err == nil && d >= 0 {
+			return CPUTime(d)
+		}
+	}
+	return CPUTime(c.Config.MaxCredit)
+}
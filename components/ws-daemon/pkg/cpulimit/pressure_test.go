@@ -0,0 +1,130 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cpulimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
+)
+
+func TestBurstCredit(t *testing.T) {
+	const dt = 10 * time.Second
+
+	type sample struct {
+		// Usage and Pressure are the CPU time used and stalled during the tick
+		Usage    time.Duration
+		Pressure time.Duration
+		// NodePressure is the time the node stalled during the tick
+		NodePressure time.Duration
+	}
+	type result struct {
+		Burst  bool
+		Credit time.Duration
+	}
+	tests := []struct {
+		Name        string
+		Annotations map[string]string
+		Samples     []sample
+		Expectation []result
+	}{
+		{
+			Name: "earns credit while idle",
+			Samples: []sample{
+				{Usage: 5 * time.Second},
+				{Usage: 5 * time.Second},
+			},
+			// limit is 1 CPU, i.e. 10s of CPU time per tick
+			Expectation: []result{{Credit: 5 * time.Second}, {Credit: 10 * time.Second}},
+		},
+		{
+			Name: "spends credit under pressure",
+			Samples: []sample{
+				{Usage: 0},
+				{Usage: 15 * time.Second, Pressure: 5 * time.Second},
+				{Usage: 15 * time.Second, Pressure: 5 * time.Second},
+				{Usage: 15 * time.Second, Pressure: 5 * time.Second},
+			},
+			Expectation: []result{
+				{Credit: 10 * time.Second},
+				{Burst: true, Credit: 5 * time.Second},
+				{Credit: 0},
+				{Credit: 0},
+			},
+		},
+		{
+			Name: "no burst without pressure",
+			Samples: []sample{
+				{Usage: 0},
+				{Usage: 10 * time.Second, Pressure: 500 * time.Millisecond},
+			},
+			Expectation: []result{{Credit: 10 * time.Second}, {Credit: 10 * time.Second}},
+		},
+		{
+			Name: "no burst while the node is under pressure",
+			Samples: []sample{
+				{Usage: 0},
+				{Usage: 10 * time.Second, Pressure: 5 * time.Second, NodePressure: 3 * time.Second},
+			},
+			Expectation: []result{{Credit: 10 * time.Second}, {Credit: 10 * time.Second}},
+		},
+		{
+			Name:        "credit is capped by workspace class",
+			Annotations: map[string]string{kubernetes.WorkspaceCpuBurstCreditAnnotation: "15s"},
+			Samples: []sample{
+				{Usage: 0},
+				{Usage: 0},
+			},
+			Expectation: []result{{Credit: 10 * time.Second}, {Credit: 15 * time.Second}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			nodePressure := cpulimit.CPUTime(time.Second)
+			bc := cpulimit.NewBurstCredit(cpulimit.PressureConfig{
+				Enabled:   true,
+				MaxCredit: util.Duration(time.Minute),
+			}, func() (cpulimit.CPUTime, error) { return nodePressure, nil })
+			err := bc.Update(dt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ws := cpulimit.Workspace{
+				ID:          "foobar",
+				Usage:       cpulimit.CPUTime(time.Hour),
+				Pressure:    cpulimit.CPUTime(time.Minute),
+				Annotations: test.Annotations,
+			}
+			wsh := &cpulimit.WorkspaceHistory{ID: ws.ID}
+			wsh.Update(ws)
+
+			var act []result
+			for _, s := range test.Samples {
+				ws.Usage += cpulimit.CPUTime(s.Usage)
+				ws.Pressure += cpulimit.CPUTime(s.Pressure)
+				wsh.Update(ws)
+				nodePressure += cpulimit.CPUTime(s.NodePressure)
+				err := bc.Update(dt)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				burst := bc.Burst(wsh, 1000, dt)
+				act = append(act, result{Burst: burst, Credit: time.Duration(wsh.Credit)})
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
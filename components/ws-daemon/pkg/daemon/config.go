@@ -6,14 +6,21 @@ package daemon
 
 import (
 	"context"
+	"time"
 
+	"github.com/gitpod-io/gitpod/common-go/util"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/diskguard"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/forensics"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/memlimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/netlimit"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/resourceusage"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/secprofile"
+	"golang.org/x/xerrors"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -27,13 +34,76 @@ type Config struct {
 	IOLimit             IOLimitConfig             `json:"ioLimit"`
 	ProcLimit           int64                     `json:"procLimit"`
 	NetLimit            netlimit.Config           `json:"netlimit"`
+	NetBandwidthLimit   netlimit.BandwidthConfig  `json:"netBandwidthLimit"`
+	MemoryLimit         memlimit.Config           `json:"memoryLimit"`
 	OOMScores           cgroup.OOMScoreAdjConfig  `json:"oomScores"`
 	DiskSpaceGuard      diskguard.Config          `json:"disk"`
 	WorkspaceController WorkspaceControllerConfig `json:"workspaceController"`
+	ResourceUsage       resourceusage.Config      `json:"resourceUsage"`
+	Forensics           forensics.Config          `json:"forensics"`
+	SecurityProfiles    secprofile.Config         `json:"securityProfiles"`
 
 	RegistryFacadeHost string `json:"registryFacadeHost,omitempty"`
 }
 
+// Validate validates the configuration to catch issues during startup and not at runtime
+func (c *Config) Validate() error {
+	if err := c.Content.DockerCache.Validate(); err != nil {
+		return xerrors.Errorf("content.dockerCache: %w", err)
+	}
+	if err := c.Content.RestoreCache.Validate(); err != nil {
+		return xerrors.Errorf("content.restoreCache: %w", err)
+	}
+	if c.Content.RestoreCache.Enabled && c.Content.Encryption.Enabled {
+		// the restore cache keeps workspace content on the node unencrypted
+		return xerrors.Errorf("content.restoreCache: cannot be enabled together with content.encryption")
+	}
+	if err := c.Content.Backup.Delta.Validate(); err != nil {
+		return xerrors.Errorf("content.backup.delta: %w", err)
+	}
+	if err := c.Content.Backup.Compression.Validate(c.Content.Backup.CompressionLevel); err != nil {
+		return xerrors.Errorf("content.backup.compression: %w", err)
+	}
+	if err := c.Content.Backup.Upload.Validate(); err != nil {
+		return xerrors.Errorf("content.backup.upload: %w", err)
+	}
+	if err := c.Content.Backup.Live.Validate(c.Content.Backup.Delta, c.Content.Backup.Engine); err != nil {
+		return xerrors.Errorf("content.backup.live: %w", err)
+	}
+	if err := c.Content.Backup.Engine.Validate(); err != nil {
+		return xerrors.Errorf("content.backup.engine: %w", err)
+	}
+	if c.Content.Backup.Engine == content.BackupEngineRestic {
+		if err := c.Content.Backup.Restic.Validate(); err != nil {
+			return xerrors.Errorf("content.backup.restic: %w", err)
+		}
+	}
+	if err := c.IOLimit.Validate(); err != nil {
+		return xerrors.Errorf("ioLimit: %w", err)
+	}
+	if c.MemoryLimit.Enabled {
+		if err := c.MemoryLimit.Validate(); err != nil {
+			return xerrors.Errorf("memoryLimit: %w", err)
+		}
+	}
+	if c.ResourceUsage.Enabled {
+		if err := c.ResourceUsage.Validate(); err != nil {
+			return xerrors.Errorf("resourceUsage: %w", err)
+		}
+	}
+	if c.Forensics.Enabled {
+		if err := c.Forensics.Validate(); err != nil {
+			return xerrors.Errorf("forensics: %w", err)
+		}
+	}
+	if c.SecurityProfiles.Enabled {
+		if err := c.SecurityProfiles.Validate(); err != nil {
+			return xerrors.Errorf("securityProfiles: %w", err)
+		}
+	}
+	return nil
+}
+
 type WorkspaceControllerConfig struct {
 	MaxConcurrentReconciles int `json:"maxConcurrentReconciles,omitempty"`
 }
@@ -52,6 +122,59 @@ type IOLimitConfig struct {
 	ReadBWPerSecond  resource.Quantity `json:"readBandwidthPerSecond"`
 	WriteIOPS        int64             `json:"writeIOPS"`
 	ReadIOPS         int64             `json:"readIOPS"`
+
+	// Burst configures the limits which apply while the content of a workspace is initialized, e.g. to speed up
+	// the initial clone or restore. Without burst configuration the limits above apply right away.
+	Burst *IOLimitBurstConfig `json:"burst,omitempty"`
+}
+
+type IOLimitBurstConfig struct {
+	WriteBWPerSecond resource.Quantity `json:"writeBandwidthPerSecond"`
+	ReadBWPerSecond  resource.Quantity `json:"readBandwidthPerSecond"`
+	WriteIOPS        int64             `json:"writeIOPS"`
+	ReadIOPS         int64             `json:"readIOPS"`
+	// MaxDuration limits how long a workspace can burst if its content does not become ready. Zero means no limit.
+	MaxDuration util.Duration `json:"maxDuration,omitempty"`
+}
+
+// Limits returns the regular limits as expected by the IO limiter
+func (c *IOLimitConfig) Limits() cgroup.IOLimits {
+	return cgroup.IOLimits{
+		WriteBytesPerSecond: c.WriteBWPerSecond.Value(),
+		ReadBytesPerSecond:  c.ReadBWPerSecond.Value(),
+		WriteIOPS:           c.WriteIOPS,
+		ReadIOPS:            c.ReadIOPS,
+	}
+}
+
+// BurstLimits returns the burst configuration as expected by the IO limiter, or nil if there's none
+func (c *IOLimitConfig) BurstLimits() *cgroup.IOBurst {
+	if c.Burst == nil {
+		return nil
+	}
+	return &cgroup.IOBurst{
+		Limits: cgroup.IOLimits{
+			WriteBytesPerSecond: c.Burst.WriteBWPerSecond.Value(),
+			ReadBytesPerSecond:  c.Burst.ReadBWPerSecond.Value(),
+			WriteIOPS:           c.Burst.WriteIOPS,
+			ReadIOPS:            c.Burst.ReadIOPS,
+		},
+		MaxDuration: time.Duration(c.Burst.MaxDuration),
+	}
+}
+
+// Validate validates the IO limit configuration
+func (c *IOLimitConfig) Validate() error {
+	if c.Burst == nil {
+		return nil
+	}
+	if c.Burst.WriteBWPerSecond.Sign() < 0 || c.Burst.ReadBWPerSecond.Sign() < 0 || c.Burst.WriteIOPS < 0 || c.Burst.ReadIOPS < 0 {
+		return xerrors.Errorf("burst limits must not be negative")
+	}
+	if c.Burst.MaxDuration < 0 {
+		return xerrors.Errorf("burst.maxDuration must not be negative")
+	}
+	return nil
 }
 
 type ConfigReloader interface {
@@ -0,0 +1,36 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package daemon_test
+
+import (
+	"testing"
+
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/daemon"
+)
+
+func TestConfigValidate(t *testing.T) {
+	restoreCache := content.RestoreCacheConfig{Enabled: true, Location: "/cache"}
+	encryption := content.EncryptionConfig{Enabled: true}
+	tests := []struct {
+		Name    string
+		Content content.Config
+		Valid   bool
+	}{
+		{Name: "defaults", Valid: true},
+		{Name: "restore cache", Content: content.Config{RestoreCache: restoreCache}, Valid: true},
+		{Name: "encryption", Content: content.Config{Encryption: encryption}, Valid: true},
+		{Name: "restore cache with encryption", Content: content.Config{RestoreCache: restoreCache, Encryption: encryption}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg := daemon.Config{Content: test.Content}
+			err := cfg.Validate()
+			if (err == nil) != test.Valid {
+				t.Errorf("unexpected validation result: %v", err)
+			}
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
 	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes"
@@ -26,7 +27,9 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cgroup"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/content"
@@ -34,9 +37,13 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/diskguard"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/forensics"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/memlimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/netlimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/resourceusage"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/secprofile"
 	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 )
 
@@ -95,7 +102,7 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, err
 	}
 
-	cgroupV2IOLimiter, err := cgroup.NewIOLimiterV2(config.IOLimit.WriteBWPerSecond.Value(), config.IOLimit.ReadBWPerSecond.Value(), config.IOLimit.WriteIOPS, config.IOLimit.ReadIOPS)
+	cgroupV2IOLimiter, err := cgroup.NewIOLimiterV2(config.IOLimit.Limits(), config.IOLimit.BurstLimits(), config.Content.WorkingArea)
 	if err != nil {
 		return nil, err
 	}
@@ -134,6 +141,7 @@ func NewDaemon(config Config) (*Daemon, error) {
 		},
 		procV2Plugin,
 		cgroup.NewPSIMetrics(wrappedReg),
+		&cgroup.ResourceLimitsV2{WorkingArea: config.Content.WorkingArea},
 	)
 	if err != nil {
 		return nil, err
@@ -159,9 +167,36 @@ func NewDaemon(config Config) (*Daemon, error) {
 		listener = append(listener, netlimiter)
 	}
 
+	var bandwidthLimiter *netlimit.BandwidthLimiter
+	if config.NetBandwidthLimit.Enabled {
+		bandwidthLimiter, err = netlimit.NewBandwidthLimiter(wrappedReg)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create bandwidth limiter: %w", err)
+		}
+		listener = append(listener, bandwidthLimiter)
+	}
+
+	var memoryLimiter *memlimit.MemoryLimiter
+	if config.MemoryLimit.Enabled {
+		swap := config.MemoryLimit.Swap.Enabled
+		if swap {
+			err = memlimit.SetupSwap(config.MemoryLimit.Swap)
+			if err != nil {
+				// workspaces are still throttled above memory.high, they just cannot swap
+				log.WithError(err).Error("cannot set up zram swap - workspaces will not swap")
+				swap = false
+			}
+		}
+		memoryLimiter, err = memlimit.NewMemoryLimiter(config.CPULimit.CGroupBasePath, swap, wrappedReg)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot create memory limiter: %w", err)
+		}
+		listener = append(listener, memoryLimiter)
+	}
+
 	var configReloader CompositeConfigReloader
 	configReloader = append(configReloader, ConfigReloaderFunc(func(ctx context.Context, config *Config) error {
-		cgroupV2IOLimiter.Update(config.IOLimit.WriteBWPerSecond.Value(), config.IOLimit.ReadBWPerSecond.Value(), config.IOLimit.WriteIOPS, config.IOLimit.ReadIOPS)
+		cgroupV2IOLimiter.Update(config.IOLimit.Limits(), config.IOLimit.BurstLimits())
 		procV2Plugin.Update(config.ProcLimit)
 		if config.NetLimit.Enabled {
 			netlimiter.Update(config.NetLimit)
@@ -213,8 +248,15 @@ func NewDaemon(config Config) (*Daemon, error) {
 		return nil, err
 	}
 
+	var forensicsCollector *forensics.Collector
+	if config.Forensics.Enabled {
+		forensicsCollector = forensics.NewCollector(config.Forensics, config.CPULimit.CGroupBasePath, contentCfg.Storage, clientset)
+		listener = append(listener, forensicsCollector)
+	}
+
+	inflight := baseserver.NewInFlight()
 	wsctrl, err := controller.NewWorkspaceController(
-		mgr.GetClient(), mgr.GetEventRecorderFor("workspace"), nodename, config.Runtime.SecretsNamespace, config.WorkspaceController.MaxConcurrentReconciles, workspaceOps, wrappedReg)
+		mgr.GetClient(), mgr.GetEventRecorderFor("workspace"), nodename, config.Runtime.SecretsNamespace, config.WorkspaceController.MaxConcurrentReconciles, workspaceOps, wrappedReg, inflight, forensicsCollector)
 	if err != nil {
 		return nil, err
 	}
@@ -222,14 +264,27 @@ func NewDaemon(config Config) (*Daemon, error) {
 	if err != nil {
 		return nil, err
 	}
+	if memoryLimiter != nil {
+		memoryLimiter.Reporter = wsctrl
+	}
 
 	ssctrl := controller.NewSnapshotController(
-		mgr.GetClient(), mgr.GetEventRecorderFor("snapshot"), nodename, config.WorkspaceController.MaxConcurrentReconciles, workspaceOps)
+		mgr.GetClient(), mgr.GetEventRecorderFor("snapshot"), nodename, config.WorkspaceController.MaxConcurrentReconciles, workspaceOps, inflight)
 	err = ssctrl.SetupWithManager(mgr)
 	if err != nil {
 		return nil, err
 	}
 
+	var resourceUsage *resourceusage.Service
+	if config.ResourceUsage.Enabled {
+		resourceUsage = resourceusage.NewService(config.ResourceUsage, config.CPULimit.CGroupBasePath, contentCfg.WorkingArea)
+		if bandwidthLimiter != nil {
+			resourceUsage.Bandwidth = bandwidthLimiter
+		}
+		resourceUsage.XFS = xfs
+		listener = append(listener, resourceUsage)
+	}
+
 	housekeeping := controller.NewHousekeeping(contentCfg.WorkingArea, 5*time.Minute)
 	go housekeeping.Start(context.Background())
 
@@ -240,13 +295,22 @@ func NewDaemon(config Config) (*Daemon, error) {
 
 	dsk := diskguard.FromConfig(config.DiskSpaceGuard, clientset, nodename)
 
+	var securityProfiles *secprofile.Installer
+	if config.SecurityProfiles.Enabled {
+		securityProfiles = secprofile.NewInstaller(config.SecurityProfiles)
+	}
+
 	return &Daemon{
-		Config:          config,
-		dispatch:        dsptch,
-		diskGuards:      dsk,
-		configReloader:  configReloader,
-		mgr:             mgr,
-		metricsRegistry: registry,
+		Config:           config,
+		dispatch:         dsptch,
+		diskGuards:       dsk,
+		configReloader:   configReloader,
+		mgr:              mgr,
+		metricsRegistry:  registry,
+		resourceUsage:    resourceUsage,
+		content:          controller.NewContentService(workspaceOps),
+		inflight:         inflight,
+		securityProfiles: securityProfiles,
 	}, nil
 }
 
@@ -262,15 +326,27 @@ func newClientConfig(kubeconfig string) (*rest.Config, error) {
 type Daemon struct {
 	Config Config
 
-	dispatch        *dispatch.Dispatch
-	diskGuards      []*diskguard.Guard
-	configReloader  ConfigReloader
-	mgr             ctrl.Manager
-	metricsRegistry *prometheus.Registry
+	dispatch         *dispatch.Dispatch
+	diskGuards       []*diskguard.Guard
+	configReloader   ConfigReloader
+	mgr              ctrl.Manager
+	metricsRegistry  *prometheus.Registry
+	resourceUsage    *resourceusage.Service
+	content          *controller.ContentService
+	inflight         *baseserver.InFlight
+	securityProfiles *secprofile.Installer
 
 	cancel context.CancelFunc
 }
 
+// RegisterGRPC registers the daemon's gRPC services
+func (d *Daemon) RegisterGRPC(srv *grpc.Server) {
+	api.RegisterWorkspaceContentServiceServer(srv, d.content)
+	if d.resourceUsage != nil {
+		api.RegisterWorkspaceResourceServiceServer(srv, d.resourceUsage)
+	}
+}
+
 func (d *Daemon) ReloadConfig(ctx context.Context, cfg *Config) error {
 	return d.configReloader.ReloadConfig(ctx, cfg)
 }
@@ -289,9 +365,18 @@ func (d *Daemon) Start() error {
 	var ctx context.Context
 	ctx, d.cancel = context.WithCancel(context.Background())
 
+	if d.securityProfiles != nil {
+		err = d.securityProfiles.Start(ctx)
+		if err != nil {
+			return xerrors.Errorf("cannot watch security profiles: %w", err)
+		}
+	}
+
 	go func() {
 		err := d.mgr.Start(ctx)
-		if err != nil {
+		// Once stopped, the manager gives up waiting for running reconciles after its grace period.
+		// Those finish their backups regardless, ws-daemon waits for them while it shuts down.
+		if err != nil && ctx.Err() == nil {
 			log.WithError(err).Fatal("cannot start controller")
 		}
 	}()
@@ -315,6 +400,11 @@ func (d *Daemon) Stop() error {
 	return nil
 }
 
+// InFlight tracks the backups and snapshots the daemon must finish before it shuts down
+func (d *Daemon) InFlight() *baseserver.InFlight {
+	return d.inflight
+}
+
 func (d *Daemon) ReadinessProbe() func() error {
 	return func() error {
 		// use 2 second timeout to ensure that IsContainerdReady() will not block indefinetely
@@ -0,0 +1,449 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package forensics
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/content-service/api/config"
+	csforensics "github.com/gitpod-io/gitpod/content-service/pkg/forensics"
+	"github.com/gitpod-io/gitpod/content-service/pkg/storage"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+)
+
+const (
+	defaultSampleInterval = 30 * time.Second
+	defaultLogTailLines   = 1000
+
+	// snapshotRetention is how long the last snapshot of a workspace is kept once the workspace is gone,
+	// waiting for the workspace to be torn down.
+	snapshotRetention = 1 * time.Hour
+)
+
+// cgroupFiles are the files of the workspace cgroup which end up in a bundle
+var cgroupFiles = []string{
+	"cgroup.events",
+	"cpu.max",
+	"cpu.pressure",
+	"cpu.stat",
+	"io.max",
+	"io.pressure",
+	"io.stat",
+	"memory.current",
+	"memory.events",
+	"memory.max",
+	"memory.peak",
+	"memory.pressure",
+	"memory.stat",
+	"pids.current",
+	"pids.max",
+}
+
+// Config configures the collection of forensic bundles
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// SampleInterval is the time between two snapshots of a running workspace. Defaults to 30s.
+	SampleInterval util.Duration `json:"sampleInterval,omitempty"`
+	// LogTailLines is the number of log lines of each workspace container which end up in a bundle. Defaults to 1000.
+	LogTailLines int64 `json:"logTailLines,omitempty"`
+}
+
+// Validate validates the forensics configuration
+func (c Config) Validate() error {
+	if c.SampleInterval < 0 {
+		return xerrors.Errorf("sampleInterval must not be negative")
+	}
+	if c.LogTailLines < 0 {
+		return xerrors.Errorf("logTailLines must not be negative")
+	}
+	return nil
+}
+
+func (c Config) sampleInterval() time.Duration {
+	if c.SampleInterval == 0 {
+		return defaultSampleInterval
+	}
+	return time.Duration(c.SampleInterval)
+}
+
+func (c Config) logTailLines() int64 {
+	if c.LogTailLines == 0 {
+		return defaultLogTailLines
+	}
+	return c.LogTailLines
+}
+
+// Request describes the workspace a forensic bundle is collected for
+type Request struct {
+	Owner       string
+	WorkspaceID string
+	InstanceID  string
+	Namespace   string
+	PodName     string
+
+	// Reason is why the workspace terminated abnormally, e.g. OOMKilled
+	Reason string
+	// Message says more about the reason
+	Message string
+}
+
+type snapshot struct {
+	Time  time.Time
+	Files map[string][]byte
+}
+
+// Collector collects forensic bundles of workspaces which terminated abnormally. Once the workspace container
+// is gone so are its cgroup and processes, hence the collector snapshots them while the workspace is running
+// and bundles the last snapshot with the logs of the workspace when it's torn down.
+type Collector struct {
+	Config         Config
+	CGroupBasePath string
+	Kubernetes     kubernetes.Interface
+
+	procPath  string
+	daFactory func() (storage.DirectAccess, error)
+
+	mu        sync.Mutex
+	snapshots map[string]*snapshot
+}
+
+// NewCollector creates a new forensics collector which uploads bundles to the given storage
+func NewCollector(cfg Config, cgroupBasePath string, storageCfg config.StorageConfig, clientset kubernetes.Interface) *Collector {
+	return &Collector{
+		Config:         cfg,
+		CGroupBasePath: cgroupBasePath,
+		Kubernetes:     clientset,
+		procPath:       "/proc",
+		daFactory: func() (storage.DirectAccess, error) {
+			return storage.NewDirectAccess(&storageCfg)
+		},
+		snapshots: make(map[string]*snapshot),
+	}
+}
+
+// WorkspaceAdded snapshots the workspace until it goes away
+func (c *Collector) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return xerrors.Errorf("no dispatch available")
+	}
+
+	cgroupPath, err := disp.Runtime.ContainerCGroupPath(context.Background(), ws.ContainerID)
+	if err != nil {
+		return xerrors.Errorf("cannot find workspace cgroup: %w", err)
+	}
+
+	pid, err := disp.Runtime.ContainerPID(context.Background(), ws.ContainerID)
+	if err != nil {
+		return xerrors.Errorf("cannot find workspace container PID: %w", err)
+	}
+
+	go c.sample(ctx, ws.InstanceID, filepath.Join(c.CGroupBasePath, cgroupPath), pid)
+	return nil
+}
+
+func (c *Collector) sample(ctx context.Context, instanceID, cgroupDir string, pid uint64) {
+	ticker := time.NewTicker(c.Config.sampleInterval())
+	defer ticker.Stop()
+	for {
+		snap := c.snapshot(cgroupDir, pid)
+		if len(snap.Files) > 0 {
+			c.mu.Lock()
+			c.snapshots[instanceID] = snap
+			c.mu.Unlock()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			time.AfterFunc(snapshotRetention, func() {
+				c.mu.Lock()
+				defer c.mu.Unlock()
+				if c.snapshots[instanceID] == snap {
+					delete(c.snapshots, instanceID)
+				}
+			})
+			return
+		}
+	}
+}
+
+func (c *Collector) snapshot(cgroupDir string, pid uint64) *snapshot {
+	res := &snapshot{
+		Time:  time.Now(),
+		Files: make(map[string][]byte),
+	}
+	for _, fn := range cgroupFiles {
+		content, err := os.ReadFile(filepath.Join(cgroupDir, fn))
+		if err != nil {
+			continue
+		}
+		res.Files[filepath.Join("cgroup", fn)] = content
+	}
+	if procs := c.processTree(cgroupDir); len(procs) > 0 {
+		res.Files["processes.txt"] = procs
+	}
+	if mounts, err := os.ReadFile(filepath.Join(c.procPath, strconv.FormatUint(pid, 10), "mountinfo")); err == nil {
+		res.Files["mountinfo"] = mounts
+	}
+	return res
+}
+
+// processTree lists all processes in the cgroup of the workspace, including those in its child cgroups
+func (c *Collector) processTree(cgroupDir string) []byte {
+	var pids []int
+	_ = filepath.WalkDir(cgroupDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() != "cgroup.procs" {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		for _, line := range strings.Fields(string(content)) {
+			pid, err := strconv.Atoi(line)
+			if err == nil {
+				pids = append(pids, pid)
+			}
+		}
+		return nil
+	})
+	if len(pids) == 0 {
+		return nil
+	}
+	sort.Ints(pids)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%-8s %-8s %-5s %-12s %s\n", "PID", "PPID", "STATE", "RSS", "COMMAND")
+	for _, pid := range pids {
+		proc, err := c.readProcess(pid)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&buf, "%-8d %-8d %-5s %-12s %s\n", pid, proc.PPID, proc.State, proc.RSS, proc.Command)
+	}
+	return buf.Bytes()
+}
+
+type process struct {
+	PPID    int
+	State   string
+	RSS     string
+	Command string
+}
+
+func (c *Collector) readProcess(pid int) (*process, error) {
+	dir := filepath.Join(c.procPath, strconv.Itoa(pid))
+	stat, err := os.ReadFile(filepath.Join(dir, "stat"))
+	if err != nil {
+		return nil, err
+	}
+	// the command name in the stat file is in parentheses and may contain spaces
+	idx := bytes.LastIndexByte(stat, ')')
+	if idx < 0 {
+		return nil, xerrors.Errorf("cannot parse stat of process %d", pid)
+	}
+	fields := strings.Fields(string(stat[idx+1:]))
+	if len(fields) < 2 {
+		return nil, xerrors.Errorf("cannot parse stat of process %d", pid)
+	}
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse stat of process %d: %w", pid, err)
+	}
+	res := &process{PPID: ppid, State: fields[0], RSS: "-"}
+
+	if cmdline, err := os.ReadFile(filepath.Join(dir, "cmdline")); err == nil && len(cmdline) > 0 {
+		res.Command = strings.TrimSpace(string(bytes.ReplaceAll(cmdline, []byte{0}, []byte{' '})))
+	} else if start := bytes.IndexByte(stat, '('); start >= 0 {
+		res.Command = "[" + string(stat[start+1:idx]) + "]"
+	}
+
+	if f, err := os.Open(filepath.Join(dir, "status")); err == nil {
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			if rss, ok := strings.CutPrefix(scanner.Text(), "VmRSS:"); ok {
+				res.RSS = strings.Join(strings.Fields(rss), " ")
+				break
+			}
+		}
+		f.Close()
+	}
+	return res, nil
+}
+
+// Collect collects a forensic bundle of the workspace and uploads it to the remote storage of the workspace instance
+func (c *Collector) Collect(ctx context.Context, req Request) error {
+	c.mu.Lock()
+	snap := c.snapshots[req.InstanceID]
+	c.mu.Unlock()
+
+	f, err := os.CreateTemp("", "forensics-*.tar.gz")
+	if err != nil {
+		return xerrors.Errorf("cannot create forensic bundle: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	now := time.Now()
+	err = c.writeBundle(ctx, f, req, snap, now)
+	f.Close()
+	if err != nil {
+		return xerrors.Errorf("cannot create forensic bundle: %w", err)
+	}
+
+	da, err := c.daFactory()
+	if err != nil {
+		return xerrors.Errorf("cannot use configured storage: %w", err)
+	}
+	err = da.Init(ctx, req.Owner, req.WorkspaceID, req.InstanceID)
+	if err != nil {
+		return xerrors.Errorf("cannot use configured storage: %w", err)
+	}
+	err = da.EnsureExists(ctx)
+	if err != nil {
+		return err
+	}
+	_, _, err = da.UploadInstance(ctx, f.Name(), csforensics.UploadedBundlePath(now, req.Reason))
+	if err != nil {
+		return xerrors.Errorf("cannot upload forensic bundle: %w", err)
+	}
+
+	c.mu.Lock()
+	if c.snapshots[req.InstanceID] == snap {
+		delete(c.snapshots, req.InstanceID)
+	}
+	c.mu.Unlock()
+
+	return nil
+}
+
+type bundleMetadata struct {
+	Owner       string     `json:"owner"`
+	WorkspaceID string     `json:"workspaceId"`
+	InstanceID  string     `json:"instanceId"`
+	Reason      string     `json:"reason"`
+	Message     string     `json:"message,omitempty"`
+	Collected   time.Time  `json:"collected"`
+	Snapshot    *time.Time `json:"snapshot,omitempty"`
+}
+
+// writeBundle writes the forensic bundle as gzipped tar archive. Everything that is available ends up
+// in the bundle - a missing snapshot or logs do not fail the collection.
+func (c *Collector) writeBundle(ctx context.Context, out io.Writer, req Request, snap *snapshot, now time.Time) error {
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+
+	md := bundleMetadata{
+		Owner:       req.Owner,
+		WorkspaceID: req.WorkspaceID,
+		InstanceID:  req.InstanceID,
+		Reason:      req.Reason,
+		Message:     req.Message,
+		Collected:   now,
+	}
+	if snap != nil {
+		md.Snapshot = &snap.Time
+	}
+	mdJSON, err := json.MarshalIndent(md, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = writeFile(tw, "metadata.json", mdJSON, now)
+	if err != nil {
+		return err
+	}
+
+	if snap != nil {
+		names := make([]string, 0, len(snap.Files))
+		for name := range snap.Files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			err = writeFile(tw, name, snap.Files[name], snap.Time)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for container, logs := range c.containerLogs(ctx, req) {
+		err = writeFile(tw, filepath.Join("logs", container+".log"), logs, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// containerLogs returns the recent logs of all containers of the workspace pod
+func (c *Collector) containerLogs(ctx context.Context, req Request) map[string][]byte {
+	if c.Kubernetes == nil || req.PodName == "" {
+		return nil
+	}
+
+	pod, err := c.Kubernetes.CoreV1().Pods(req.Namespace).Get(ctx, req.PodName, metav1.GetOptions{})
+	if err != nil {
+		log.WithError(err).WithFields(log.OWI(req.Owner, req.WorkspaceID, req.InstanceID)).Warn("cannot get workspace pod for forensic bundle")
+		return nil
+	}
+
+	tailLines := c.Config.logTailLines()
+	res := make(map[string][]byte, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		logs, err := c.Kubernetes.CoreV1().Pods(req.Namespace).GetLogs(req.PodName, &corev1.PodLogOptions{
+			Container:  container.Name,
+			TailLines:  &tailLines,
+			Timestamps: true,
+		}).DoRaw(ctx)
+		if err != nil {
+			log.WithError(err).WithFields(log.OWI(req.Owner, req.WorkspaceID, req.InstanceID)).WithField("container", container.Name).Warn("cannot get container logs for forensic bundle")
+			continue
+		}
+		res[container.Name] = logs
+	}
+	return res
+}
+
+func writeFile(tw *tar.Writer, name string, content []byte, modTime time.Time) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(content)),
+		ModTime: modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write(content)
+	return err
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package forensics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSnapshot(t *testing.T) {
+	cgroupDir := t.TempDir()
+	procDir := t.TempDir()
+	writeFiles(t, cgroupDir, map[string]string{
+		"memory.events":          "low 0\nhigh 0\nmax 12\noom 1\noom_kill 1\n",
+		"memory.max":             "8589934592\n",
+		"cgroup.procs":           "1\n",
+		"workspace/cgroup.procs": "42\n43\n",
+	})
+	writeFiles(t, procDir, map[string]string{
+		"1/stat":       "1 (workspacekit) S 0 1 1 0 -1 4194560",
+		"1/cmdline":    "/.supervisor/workspacekit\x00ring0\x00",
+		"1/status":     "Name:\tworkspacekit\nVmRSS:\t   10240 kB\n",
+		"1/mountinfo":  "1 0 0:1 / / rw - overlay overlay rw\n",
+		"42/stat":      "42 (node (main)) R 1 1 1 0 -1 4194560",
+		"42/cmdline":   "node\x00server.js\x00",
+		"42/status":    "Name:\tnode\nVmRSS:\t 2097152 kB\n",
+		"43/stat":      "43 (kworker) S 42 1 1 0 -1 4194560",
+		"43/something": "",
+	})
+
+	c := &Collector{procPath: procDir}
+	snap := c.snapshot(cgroupDir, 1)
+
+	var files []string
+	for name := range snap.Files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+	if diff := cmp.Diff([]string{"cgroup/memory.events", "cgroup/memory.max", "mountinfo", "processes.txt"}, files); diff != "" {
+		t.Errorf("unexpected files (-want +got):\n%s", diff)
+	}
+
+	expectedProcs := "PID      PPID     STATE RSS          COMMAND\n" +
+		"1        0        S     10240 kB     /.supervisor/workspacekit ring0\n" +
+		"42       1        R     2097152 kB   node server.js\n" +
+		"43       42       S     -            [kworker]\n"
+	if diff := cmp.Diff(expectedProcs, string(snap.Files["processes.txt"])); diff != "" {
+		t.Errorf("unexpected process tree (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteBundle(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		Name     string
+		Snapshot *snapshot
+		Files    []string
+	}{
+		{
+			Name:  "without snapshot",
+			Files: []string{"metadata.json"},
+		},
+		{
+			Name: "with snapshot",
+			Snapshot: &snapshot{
+				Time: now.Add(-10 * time.Second),
+				Files: map[string][]byte{
+					"cgroup/memory.events": []byte("oom_kill 1\n"),
+					"processes.txt":        []byte("PID\n"),
+				},
+			},
+			Files: []string{"metadata.json", "cgroup/memory.events", "processes.txt"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var buf bytes.Buffer
+			c := &Collector{}
+			err := c.writeBundle(context.Background(), &buf, Request{InstanceID: "instance", Reason: "OOMKilled"}, test.Snapshot, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gz, err := gzip.NewReader(&buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tr := tar.NewReader(gz)
+			var files []string
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				files = append(files, hdr.Name)
+			}
+			if diff := cmp.Diff(test.Files, files); diff != "" {
+				t.Errorf("unexpected files (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func writeFiles(t *testing.T, base string, files map[string]string) {
+	for name, content := range files {
+		fn := filepath.Join(base, name)
+		err := os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(fn, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
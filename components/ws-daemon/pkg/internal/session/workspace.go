@@ -69,8 +69,18 @@ type Workspace struct {
 
 	RemoteStorageDisabled bool `json:"remoteStorageDisabled,omitempty"`
 	StorageQuota          int  `json:"storageQuota,omitempty"`
+	// Ephemeral workspaces keep their content on a tmpfs
+	Ephemeral bool `json:"ephemeral,omitempty"`
+	// Class is the workspace class of the workspace
+	Class string `json:"class,omitempty"`
 
 	XFSProjectID int `json:"xfsProjectID"`
+	// DockerCacheXFSProjectID is the project which limits the size of the Docker data root
+	DockerCacheXFSProjectID int `json:"dockerCacheXFSProjectID,omitempty"`
+	// EncryptionKeyID identifies the fscrypt key the workspace location is encrypted with. The key itself is never persisted.
+	EncryptionKeyID string `json:"encryptionKeyID,omitempty"`
+	// LastLiveBackup is the time the last live backup of the running workspace was started
+	LastLiveBackup *time.Time `json:"lastLiveBackup,omitempty"`
 
 	NonPersistentAttrs map[string]interface{} `json:"-"`
 }
@@ -40,6 +40,7 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/internal/session"
 	nsi "github.com/gitpod-io/gitpod/ws-daemon/pkg/nsinsider"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
 )
 
 //
@@ -85,7 +86,7 @@ var (
 )
 
 // ServeWorkspace establishes the IWS server for a workspace
-func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMountPoint string, workspaceCIDR string) func(ctx context.Context, ws *session.Workspace) error {
+func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMountPoint string, workspaceCIDR string, xfs *quota.XFS) func(ctx context.Context, ws *session.Workspace) error {
 	return func(ctx context.Context, ws *session.Workspace) (err error) {
 		span, _ := opentracing.StartSpanFromContext(ctx, "iws.ServeWorkspace")
 		defer tracing.FinishSpan(span, &err)
@@ -100,6 +101,7 @@ func ServeWorkspace(uidmapper *Uidmapper, fsshift api.FSShiftMethod, cgroupMount
 			FSShift:          fsshift,
 			CGroupMountPoint: cgroupMountPoint,
 			WorkspaceCIDR:    workspaceCIDR,
+			XFS:              xfs,
 		}
 		err = iws.Start()
 		if err != nil {
@@ -143,6 +145,9 @@ type InWorkspaceServiceServer struct {
 
 	WorkspaceCIDR string
 
+	// XFS reports the disk usage of the workspace, if its quota is enforced
+	XFS *quota.XFS
+
 	srv  *grpc.Server
 	sckt io.Closer
 
@@ -987,12 +992,30 @@ func (wbs *InWorkspaceServiceServer) WorkspaceInfo(ctx context.Context, req *api
 		}
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
+	resources.Disk = wbs.diskUsage()
 
 	return &api.WorkspaceInfoResponse{
 		Resources: resources,
 	}, nil
 }
 
+// diskUsage returns the disk usage of the workspace content, or nil if its quota isn't enforced
+func (wbs *InWorkspaceServiceServer) diskUsage() *api.Disk {
+	if wbs.XFS == nil || wbs.Session.XFSProjectID == 0 {
+		return nil
+	}
+
+	used, limit, err := wbs.XFS.GetUsage(wbs.Session.XFSProjectID)
+	if err != nil {
+		log.WithError(err).WithFields(wbs.Session.OWI()).Warn("cannot get disk usage")
+		return nil
+	}
+	return &api.Disk{
+		Used:  int64(used),
+		Limit: int64(limit),
+	}
+}
+
 func getWorkspaceResourceInfo(mountPoint, cgroupPath string) (*api.Resources, error) {
 	cpu, err := getCpuResourceInfoV2(mountPoint, cgroupPath)
 	if err != nil {
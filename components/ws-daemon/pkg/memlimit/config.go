@@ -0,0 +1,39 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package memlimit
+
+import (
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Config configures the memory controls of workspaces. memory.high and the swap of a workspace are part of its
+// workspace class, the memory limit itself is the memory limit of the workspace container.
+type Config struct {
+	Enabled bool `json:"enabled"`
+
+	// Swap configures the zram device workspaces swap to. Workspaces only swap if their class allows it.
+	Swap SwapConfig `json:"swap"`
+}
+
+// SwapConfig configures the compressed in-memory swap device of the node
+type SwapConfig struct {
+	Enabled bool `json:"enabled"`
+	// Size is the uncompressed size of the zram device. The memory it uses depends on how well the swapped memory compresses.
+	Size resource.Quantity `json:"size"`
+	// Algorithm is the compression algorithm of the zram device, e.g. lz4 or zstd. Defaults to the kernel's default.
+	Algorithm string `json:"algorithm,omitempty"`
+}
+
+// Validate validates the memory limit configuration
+func (c *Config) Validate() error {
+	if !c.Swap.Enabled {
+		return nil
+	}
+	if c.Swap.Size.Sign() <= 0 {
+		return xerrors.Errorf("swap.size must be greater than zero")
+	}
+	return nil
+}
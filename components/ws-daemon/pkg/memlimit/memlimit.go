@@ -0,0 +1,284 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package memlimit
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	cgroups "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+)
+
+const (
+	memoryLimitInterval = 10 * time.Second
+
+	eventHigh    = "high"
+	eventMax     = "max"
+	eventOOMKill = "oom_kill"
+)
+
+// MemoryControl is how a workspace copes with memory spikes, in bytes. Zero means not configured.
+type MemoryControl struct {
+	// High is the memory usage above which the workspace is throttled and reclaimed
+	High int64
+	// Swap is the amount of swap the workspace can use
+	Swap int64
+}
+
+// memoryControlFromAnnotations reads the memory control of a workspace, as set by ws-manager from its workspace class
+func memoryControlFromAnnotations(annotations map[string]string) (MemoryControl, error) {
+	parse := func(annotation string) (int64, error) {
+		v, ok := annotations[annotation]
+		if !ok || v == "" {
+			return 0, nil
+		}
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return 0, xerrors.Errorf("cannot parse %s: %w", annotation, err)
+		}
+		if q.Sign() < 0 {
+			return 0, xerrors.Errorf("%s must not be negative", annotation)
+		}
+		return q.Value(), nil
+	}
+
+	var (
+		res MemoryControl
+		err error
+	)
+	res.High, err = parse(kubernetes.WorkspaceMemoryHighAnnotation)
+	if err != nil {
+		return MemoryControl{}, err
+	}
+	res.Swap, err = parse(kubernetes.WorkspaceMemorySwapAnnotation)
+	if err != nil {
+		return MemoryControl{}, err
+	}
+	return res, nil
+}
+
+// OOMReporter is told about processes the OOM killer killed in a running workspace
+type OOMReporter interface {
+	// ReportOOMKill reports the total count of processes the OOM killer killed in a workspace so far
+	ReportOOMKill(ctx context.Context, namespace, instanceID string, kills uint64) error
+}
+
+type memoryWorkspace struct {
+	OWI        logrus.Fields
+	InstanceID string
+	Namespace  string
+	// CGroupPath is the full path of the cgroup of the workspace container
+	CGroupPath string
+
+	Desired MemoryControl
+	// applied maps the cgroup files we wrote to their content
+	applied map[string]string
+
+	// events are the memory events we have seen so far, nil until we read them for the first time
+	events           map[string]uint64
+	reportedOOMKills uint64
+}
+
+// MemoryLimiter keeps workspaces from being OOM killed as a whole by a transient memory spike, e.g. a compiler.
+// It throttles workspaces above memory.high and lets them swap according to their workspace class. Should the
+// workspace reach its memory limit (memory.max, the memory limit of the workspace container) anyway, the OOM killer
+// only kills the offending process instead of the whole container, and the limiter reports the kill to ws-manager.
+type MemoryLimiter struct {
+	// Reporter is told about OOM kills in running workspaces, if not nil
+	Reporter OOMReporter
+
+	mu         sync.Mutex
+	workspaces map[string]*memoryWorkspace
+
+	cgroupBasePath string
+	swap           bool
+
+	eventsCounter *prometheus.CounterVec
+}
+
+// NewMemoryLimiter creates a new memory limiter. Workspaces only swap if swap is true, i.e. the node has a swap device.
+func NewMemoryLimiter(cgroupBasePath string, swap bool, prom prometheus.Registerer) (*MemoryLimiter, error) {
+	res := &MemoryLimiter{
+		workspaces:     make(map[string]*memoryWorkspace),
+		cgroupBasePath: cgroupBasePath,
+		swap:           swap,
+		eventsCounter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "workspace_memory_events_total",
+			Help: "Total count of workspaces exceeding memory.high (high), reaching their memory limit (max), and processes killed by the OOM killer (oom_kill)",
+		}, []string{"event"}),
+	}
+	err := prom.Register(res.eventsCounter)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WorkspaceAdded applies the memory control of a workspace and watches it for OOM kills
+func (l *MemoryLimiter) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	control, err := memoryControlFromAnnotations(ws.Pod.Annotations)
+	if err != nil {
+		return err
+	}
+
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return xerrors.Errorf("no dispatch available")
+	}
+	cgroupPath, err := disp.Runtime.ContainerCGroupPath(context.Background(), ws.ContainerID)
+	if err != nil {
+		return xerrors.Errorf("cannot get cgroup path for container %s: %w", ws.ContainerID, err)
+	}
+
+	mws := &memoryWorkspace{
+		OWI:        ws.OWI(),
+		InstanceID: ws.InstanceID,
+		Namespace:  ws.Pod.Namespace,
+		CGroupPath: filepath.Join(l.cgroupBasePath, cgroupPath),
+		Desired:    control,
+		applied:    make(map[string]string),
+	}
+
+	l.mu.Lock()
+	l.workspaces[ws.InstanceID] = mws
+	l.apply(mws)
+	l.mu.Unlock()
+
+	go l.watch(ctx, mws)
+	return nil
+}
+
+// WorkspaceUpdated applies changes to the memory control of a workspace, e.g. because it moved to another class
+func (l *MemoryLimiter) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspace) error {
+	control, err := memoryControlFromAnnotations(ws.Pod.Annotations)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	mws, ok := l.workspaces[ws.InstanceID]
+	if !ok || mws.Desired == control {
+		return nil
+	}
+	mws.Desired = control
+	l.apply(mws)
+	return nil
+}
+
+// watch keeps memory.high below the memory limit, which changes when the workspace is resized in place,
+// and reports memory events until the workspace is gone
+func (l *MemoryLimiter) watch(ctx context.Context, mws *memoryWorkspace) {
+	ticker := time.NewTicker(memoryLimitInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			l.mu.Lock()
+			l.apply(mws)
+			l.mu.Unlock()
+
+			l.checkEvents(ctx, mws)
+
+		case <-ctx.Done():
+			l.mu.Lock()
+			delete(l.workspaces, mws.InstanceID)
+			l.mu.Unlock()
+			return
+		}
+	}
+}
+
+// apply writes the cgroup files whose content changed. Callers are expected to hold mu.
+func (l *MemoryLimiter) apply(mws *memoryWorkspace) {
+	memory := cgroups.NewMemoryController(mws.CGroupPath)
+	max, err := memory.Max()
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithFields(mws.OWI).WithError(err).Warn("cannot read memory limit of workspace")
+		}
+		return
+	}
+
+	files := map[string]string{
+		// Kubernetes has the OOM killer kill the whole container, i.e. the workspace. We'd rather lose the
+		// process which ran out of memory than the workspace.
+		"memory.oom.group": "0",
+		"memory.high":      memoryHigh(mws.Desired.High, max),
+	}
+	if l.swap {
+		files["memory.swap.max"] = strconv.FormatInt(mws.Desired.Swap, 10)
+	}
+
+	for fn, content := range files {
+		if mws.applied[fn] == content {
+			continue
+		}
+		err := os.WriteFile(filepath.Join(mws.CGroupPath, fn), []byte(content), 0644)
+		if err != nil {
+			log.WithFields(mws.OWI).WithError(err).WithField("file", fn).Error("cannot apply memory control")
+			continue
+		}
+		mws.applied[fn] = content
+		log.WithFields(mws.OWI).WithField("file", fn).WithField("value", content).Info("applied memory control")
+	}
+}
+
+// memoryHigh returns the content of memory.high for the desired value, which must be below the memory limit
+// to have an effect before the OOM killer does
+func memoryHigh(desired int64, max uint64) string {
+	if desired <= 0 || max != math.MaxUint64 && uint64(desired) >= max {
+		return "max"
+	}
+	return strconv.FormatInt(desired, 10)
+}
+
+// checkEvents counts new memory events of a workspace and reports OOM kills
+func (l *MemoryLimiter) checkEvents(ctx context.Context, mws *memoryWorkspace) {
+	events, err := cgroups.NewMemoryController(mws.CGroupPath).Events()
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithFields(mws.OWI).WithError(err).Warn("cannot read memory events of workspace")
+		}
+		return
+	}
+
+	if mws.events != nil {
+		for _, evt := range []string{eventHigh, eventMax, eventOOMKill} {
+			if events[evt] > mws.events[evt] {
+				l.eventsCounter.WithLabelValues(evt).Add(float64(events[evt] - mws.events[evt]))
+			}
+		}
+	}
+	mws.events = events
+
+	kills := events[eventOOMKill]
+	if kills <= mws.reportedOOMKills || l.Reporter == nil {
+		return
+	}
+	log.WithFields(mws.OWI).WithField("kills", kills).Warn("OOM killer killed processes in workspace")
+	err = l.Reporter.ReportOOMKill(ctx, mws.Namespace, mws.InstanceID, kills)
+	if err != nil {
+		// we try again the next time
+		log.WithFields(mws.OWI).WithError(err).Warn("cannot report OOM kill")
+		return
+	}
+	mws.reportedOOMKills = kills
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package memlimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+)
+
+func TestMemoryControlFromAnnotations(t *testing.T) {
+	type Expectation struct {
+		Control MemoryControl
+		Error   bool
+	}
+	tests := []struct {
+		Name        string
+		Annotations map[string]string
+		Expectation Expectation
+	}{
+		{
+			Name: "no memory control",
+		},
+		{
+			Name: "high and swap",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceMemoryHighAnnotation: "7Gi",
+				kubernetes.WorkspaceMemorySwapAnnotation: "4Gi",
+			},
+			Expectation: Expectation{Control: MemoryControl{High: 7 << 30, Swap: 4 << 30}},
+		},
+		{
+			Name: "invalid quantity",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceMemoryHighAnnotation: "7 GiB",
+			},
+			Expectation: Expectation{Error: true},
+		},
+		{
+			Name: "negative quantity",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceMemorySwapAnnotation: "-1Gi",
+			},
+			Expectation: Expectation{Error: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			control, err := memoryControlFromAnnotations(test.Annotations)
+			act := Expectation{Control: control, Error: err != nil}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected memoryControlFromAnnotations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMemoryHigh(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Desired     int64
+		Max         uint64
+		Expectation string
+	}{
+		{Name: "not configured", Desired: 0, Max: 8 << 30, Expectation: "max"},
+		{Name: "below limit", Desired: 7 << 30, Max: 8 << 30, Expectation: "7516192768"},
+		{Name: "at limit", Desired: 8 << 30, Max: 8 << 30, Expectation: "max"},
+		{Name: "no limit", Desired: 7 << 30, Max: math.MaxUint64, Expectation: "7516192768"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := memoryHigh(test.Desired, test.Max)
+			if act != test.Expectation {
+				t.Errorf("unexpected memory.high: want %q, got %q", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Swap        bool
+		Desired     MemoryControl
+		Expectation map[string]string
+	}{
+		{
+			Name:    "high and swap",
+			Swap:    true,
+			Desired: MemoryControl{High: 7 << 30, Swap: 4 << 30},
+			Expectation: map[string]string{
+				"memory.oom.group": "0",
+				"memory.high":      "7516192768",
+				"memory.swap.max":  "4294967296",
+			},
+		},
+		{
+			Name:    "no swap device",
+			Desired: MemoryControl{High: 7 << 30, Swap: 4 << 30},
+			Expectation: map[string]string{
+				"memory.oom.group": "0",
+				"memory.high":      "7516192768",
+			},
+		},
+		{
+			Name: "class without swap",
+			Swap: true,
+			Expectation: map[string]string{
+				"memory.oom.group": "0",
+				"memory.high":      "max",
+				"memory.swap.max":  "0",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cgroupPath := t.TempDir()
+			err := os.WriteFile(filepath.Join(cgroupPath, "memory.max"), []byte(fmt.Sprintf("%d\n", 8<<30)), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			limiter, err := NewMemoryLimiter("/", test.Swap, prometheus.NewRegistry())
+			if err != nil {
+				t.Fatal(err)
+			}
+			mws := &memoryWorkspace{CGroupPath: cgroupPath, Desired: test.Desired, applied: make(map[string]string)}
+			limiter.apply(mws)
+
+			act := make(map[string]string)
+			for _, fn := range []string{"memory.oom.group", "memory.high", "memory.swap.max"} {
+				content, err := os.ReadFile(filepath.Join(cgroupPath, fn))
+				if os.IsNotExist(err) {
+					continue
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				act[fn] = string(content)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected cgroup files (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeReporter struct {
+	Kills []uint64
+	Err   error
+}
+
+func (r *fakeReporter) ReportOOMKill(ctx context.Context, namespace, instanceID string, kills uint64) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	r.Kills = append(r.Kills, kills)
+	return nil
+}
+
+func TestCheckEvents(t *testing.T) {
+	cgroupPath := t.TempDir()
+	writeEvents := func(high, max, oomKill int) {
+		content := fmt.Sprintf("low 0\nhigh %d\nmax %d\noom %d\noom_kill %d\noom_group_kill 0\n", high, max, oomKill, oomKill)
+		err := os.WriteFile(filepath.Join(cgroupPath, "memory.events"), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reporter := &fakeReporter{Err: fmt.Errorf("unavailable")}
+	limiter, err := NewMemoryLimiter("/", false, prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	limiter.Reporter = reporter
+	mws := &memoryWorkspace{InstanceID: "foo", Namespace: "default", CGroupPath: cgroupPath, applied: make(map[string]string)}
+
+	// the first events are the baseline of the metrics, but OOM kills are reported nonetheless
+	writeEvents(10, 2, 1)
+	limiter.checkEvents(context.Background(), mws)
+	reporter.Err = nil
+	limiter.checkEvents(context.Background(), mws)
+	limiter.checkEvents(context.Background(), mws)
+
+	writeEvents(15, 3, 2)
+	limiter.checkEvents(context.Background(), mws)
+
+	if diff := cmp.Diff([]uint64{1, 2}, reporter.Kills); diff != "" {
+		t.Errorf("unexpected OOM kill reports (-want +got):\n%s", diff)
+	}
+	for evt, expected := range map[string]float64{eventHigh: 5, eventMax: 1, eventOOMKill: 1} {
+		if act := testutil.ToFloat64(limiter.eventsCounter.WithLabelValues(evt)); act != expected {
+			t.Errorf("unexpected count of %s events: want %v, got %v", evt, expected, act)
+		}
+	}
+}
+
+func TestActiveZramSwap(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Content     string
+		Expectation string
+	}{
+		{
+			Name:    "no swap",
+			Content: "Filename\t\t\t\tType\t\tSize\t\tUsed\t\tPriority\n",
+		},
+		{
+			Name:        "zram",
+			Content:     "Filename\t\t\t\tType\t\tSize\t\tUsed\t\tPriority\n/swapfile                               file\t\t1048572\t\t0\t\t-2\n/dev/zram0                              partition\t8388604\t\t0\t\t100\n",
+			Expectation: "/dev/zram0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fn := filepath.Join(t.TempDir(), "swaps")
+			err := os.WriteFile(fn, []byte(test.Content), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+			act, err := activeZramSwap(fn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if act != test.Expectation {
+				t.Errorf("unexpected zram device: want %q, got %q", test.Expectation, act)
+			}
+		})
+	}
+}
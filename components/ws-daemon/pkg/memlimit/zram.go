@@ -0,0 +1,80 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package memlimit
+
+import (
+	"bufio"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+)
+
+// zramSwapPriority makes the kernel prefer the zram device over other swap devices of the node
+const zramSwapPriority = 100
+
+// SetupSwap activates a zram device as swap of the node, unless the node already swaps to one, e.g. because
+// it was set up when the node booted or by a previous ws-daemon. Requires the zram kernel module.
+func SetupSwap(cfg SwapConfig) error {
+	dev, err := activeZramSwap("/proc/swaps")
+	if err != nil {
+		return err
+	}
+	if dev != "" {
+		log.WithField("device", dev).Info("node already swaps to zram")
+		return nil
+	}
+
+	args := []string{"--find", "--size", strconv.FormatInt(cfg.Size.Value(), 10)}
+	if cfg.Algorithm != "" {
+		args = append(args, "--algorithm", cfg.Algorithm)
+	}
+	out, err := exec.Command("zramctl", args...).Output()
+	if err != nil {
+		return xerrors.Errorf("cannot create zram device: %w", execError(err))
+	}
+	dev = strings.TrimSpace(string(out))
+
+	out, err = exec.Command("mkswap", dev).CombinedOutput()
+	if err != nil {
+		return xerrors.Errorf("cannot format %s as swap: %w: %s", dev, err, string(out))
+	}
+	out, err = exec.Command("swapon", "--priority", strconv.Itoa(zramSwapPriority), dev).CombinedOutput()
+	if err != nil {
+		return xerrors.Errorf("cannot swap to %s: %w: %s", dev, err, string(out))
+	}
+
+	log.WithField("device", dev).WithField("size", cfg.Size.String()).Info("node swaps to zram")
+	return nil
+}
+
+// activeZramSwap returns the zram device the node swaps to from /proc/swaps, or an empty string if there's none
+func activeZramSwap(procSwaps string) (string, error) {
+	f, err := os.Open(procSwaps)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 0 && strings.HasPrefix(fields[0], "/dev/zram") {
+			return fields[0], nil
+		}
+	}
+	return "", scanner.Err()
+}
+
+func execError(err error) error {
+	if exitErr, ok := err.(*exec.ExitError); ok && len(exitErr.Stderr) > 0 {
+		return xerrors.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return err
+}
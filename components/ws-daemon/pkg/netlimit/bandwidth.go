@@ -0,0 +1,414 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mdlayher/netlink"
+	"github.com/mdlayher/netlink/nlenc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+	"golang.org/x/xerrors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/nsinsider"
+)
+
+// Workspaces live in a network namespace of their own, which workspacekit connects to the pod's network namespace
+// using a pair of veths. Everything the workspace sends leaves the pod through eth0, and everything it receives is
+// sent into its network namespace through veth0. We shape the traffic these two interfaces send.
+var bandwidthDirections = []struct {
+	Name      string
+	Interface string
+	// QdiscMajor is the major number of the handle of the qdisc we install, to find its statistics again
+	QdiscMajor uint16
+}{
+	{Name: "egress", Interface: "eth0", QdiscMajor: 0x6770},
+	{Name: "ingress", Interface: "veth0", QdiscMajor: 0x6771},
+}
+
+// BandwidthLimit is the bandwidth a workspace can use in bytes per second. Zero means no limit.
+type BandwidthLimit struct {
+	Egress  int64
+	Ingress int64
+}
+
+func (l BandwidthLimit) rate(direction string) int64 {
+	if direction == "egress" {
+		return l.Egress
+	}
+	return l.Ingress
+}
+
+// bandwidthFromAnnotations reads the bandwidth limit of a workspace. The annotations are in bits per second,
+// like the bandwidth annotations of Kubernetes.
+func bandwidthFromAnnotations(annotations map[string]string) (BandwidthLimit, error) {
+	parse := func(annotation string) (int64, error) {
+		v, ok := annotations[annotation]
+		if !ok || v == "" {
+			return 0, nil
+		}
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return 0, xerrors.Errorf("cannot parse %s: %w", annotation, err)
+		}
+		if q.Sign() < 0 {
+			return 0, xerrors.Errorf("%s must not be negative", annotation)
+		}
+		return q.Value() / 8, nil
+	}
+
+	var (
+		res BandwidthLimit
+		err error
+	)
+	res.Egress, err = parse(kubernetes.WorkspaceNetEgressBandwidthAnnotation)
+	if err != nil {
+		return BandwidthLimit{}, err
+	}
+	res.Ingress, err = parse(kubernetes.WorkspaceNetIngressBandwidthAnnotation)
+	if err != nil {
+		return BandwidthLimit{}, err
+	}
+	return res, nil
+}
+
+// BandwidthStats describes the bandwidth limit of a workspace and how much traffic it dropped
+type BandwidthStats struct {
+	Limit BandwidthLimit
+	// EgressDropped and IngressDropped are the number of packets which were dropped because the workspace exceeded its limit
+	EgressDropped  int64
+	IngressDropped int64
+}
+
+type bandwidthWorkspace struct {
+	OWI        logrus.Fields
+	InstanceID string
+	PodName    string
+	PID        uint64
+
+	Desired BandwidthLimit
+	// Applied is the rate we applied per direction
+	Applied map[string]int64
+}
+
+// BandwidthLimiter shapes the network traffic of workspaces to the bandwidth of their workspace class,
+// such that a single workspace cannot monopolize the bandwidth of a node.
+type BandwidthLimiter struct {
+	mu         sync.Mutex
+	workspaces map[string]*bandwidthWorkspace
+
+	droppedPackets *prometheus.GaugeVec
+
+	procPath string
+	// setup shapes the traffic of an interface in the network namespace of pid
+	setup func(ws *bandwidthWorkspace, iface string, qdiscMajor uint16, rate int64) error
+	// qdiscStats reads the statistics of the qdiscs in the network namespace of pid by major number of their handle
+	qdiscStats func(pid uint64) (map[uint16]qdiscStats, error)
+}
+
+// NewBandwidthLimiter creates a new bandwidth limiter
+func NewBandwidthLimiter(prom prometheus.Registerer) (*BandwidthLimiter, error) {
+	res := &BandwidthLimiter{
+		workspaces: make(map[string]*bandwidthWorkspace),
+		droppedPackets: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "netlimit_bandwidth_dropped_packets",
+			Help: "Number of packets dropped because a workspace exceeded its bandwidth limit",
+		}, []string{"node", "workspace", "direction"}),
+		procPath:   "/proc",
+		setup:      setupBandwidthLimit,
+		qdiscStats: readQdiscStats,
+	}
+	err := prom.Register(res.droppedPackets)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WorkspaceAdded starts shaping the traffic of a workspace which has a bandwidth limit
+func (b *BandwidthLimiter) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	return b.limitWorkspace(ctx, ws)
+}
+
+// WorkspaceUpdated applies changes to the bandwidth limit of a workspace, e.g. because it moved to another class
+func (b *BandwidthLimiter) WorkspaceUpdated(ctx context.Context, ws *dispatch.Workspace) error {
+	return b.limitWorkspace(ctx, ws)
+}
+
+func (b *BandwidthLimiter) limitWorkspace(ctx context.Context, ws *dispatch.Workspace) error {
+	limit, err := bandwidthFromAnnotations(ws.Pod.Annotations)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if bws, ok := b.workspaces[ws.InstanceID]; ok {
+		if bws.Desired != limit {
+			bws.Desired = limit
+			b.apply(bws)
+		}
+		return nil
+	}
+	if limit == (BandwidthLimit{}) {
+		return nil
+	}
+
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return fmt.Errorf("no dispatch available")
+	}
+	pid, err := disp.Runtime.ContainerPID(context.Background(), ws.ContainerID)
+	if err != nil {
+		return fmt.Errorf("could not get pid for container %s of workspace %s", ws.ContainerID, ws.WorkspaceID)
+	}
+
+	bws := &bandwidthWorkspace{
+		OWI:        ws.OWI(),
+		InstanceID: ws.InstanceID,
+		PodName:    ws.Pod.Name,
+		PID:        pid,
+		Desired:    limit,
+		Applied:    make(map[string]int64),
+	}
+	b.workspaces[ws.InstanceID] = bws
+	b.apply(bws)
+
+	go b.watch(ctx, bws)
+	return nil
+}
+
+// watch retries limits which could not be applied yet and updates the metrics until the workspace is gone
+func (b *BandwidthLimiter) watch(ctx context.Context, bws *bandwidthWorkspace) {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+
+	nodeName := os.Getenv("NODENAME")
+	for {
+		select {
+		case <-ticker.C:
+			b.mu.Lock()
+			b.apply(bws)
+			b.mu.Unlock()
+
+			stats, err := b.qdiscStats(bws.PID)
+			if err != nil {
+				log.WithFields(bws.OWI).WithError(err).Warn("could not get bandwidth limit stats")
+				continue
+			}
+			for _, d := range bandwidthDirections {
+				if s, ok := stats[d.QdiscMajor]; ok {
+					b.droppedPackets.WithLabelValues(nodeName, bws.PodName, d.Name).Set(float64(s.Drops))
+				}
+			}
+
+		case <-ctx.Done():
+			b.mu.Lock()
+			delete(b.workspaces, bws.InstanceID)
+			b.mu.Unlock()
+			for _, d := range bandwidthDirections {
+				b.droppedPackets.DeleteLabelValues(nodeName, bws.PodName, d.Name)
+			}
+			return
+		}
+	}
+}
+
+// apply shapes the traffic of all directions whose limit changed. Callers are expected to hold mu.
+func (b *BandwidthLimiter) apply(bws *bandwidthWorkspace) {
+	var ifaces map[string]struct{}
+	for _, d := range bandwidthDirections {
+		rate := bws.Desired.rate(d.Name)
+		if applied, ok := bws.Applied[d.Name]; ok && applied == rate || !ok && rate == 0 {
+			continue
+		}
+
+		if ifaces == nil {
+			var err error
+			ifaces, err = readInterfaces(filepath.Join(b.procPath, strconv.FormatUint(bws.PID, 10), "net", "dev"))
+			if err != nil {
+				log.WithFields(bws.OWI).WithError(err).Warn("cannot list network interfaces of workspace")
+				return
+			}
+		}
+		if _, exists := ifaces[d.Interface]; !exists {
+			// veth0 exists once workspacekit has set up the network of the workspace - we try again later
+			continue
+		}
+
+		err := b.setup(bws, d.Interface, d.QdiscMajor, rate)
+		if err != nil {
+			log.WithFields(bws.OWI).WithError(err).WithField("direction", d.Name).Error("cannot limit bandwidth")
+			continue
+		}
+		bws.Applied[d.Name] = rate
+		log.WithFields(bws.OWI).WithField("direction", d.Name).WithField("bytesPerSecond", rate).Info("limited workspace bandwidth")
+	}
+}
+
+// Stats returns the bandwidth limit of a workspace and the packets it dropped, or nil if the workspace has no limit
+func (b *BandwidthLimiter) Stats(instanceID string) (*BandwidthStats, error) {
+	b.mu.Lock()
+	bws, ok := b.workspaces[instanceID]
+	var res BandwidthStats
+	if ok {
+		res.Limit = bws.Desired
+	}
+	b.mu.Unlock()
+	if !ok {
+		return nil, nil
+	}
+
+	stats, err := b.qdiscStats(bws.PID)
+	if err != nil {
+		return nil, err
+	}
+	res.EgressDropped = int64(stats[bandwidthDirections[0].QdiscMajor].Drops)
+	res.IngressDropped = int64(stats[bandwidthDirections[1].QdiscMajor].Drops)
+	return &res, nil
+}
+
+func setupBandwidthLimit(ws *bandwidthWorkspace, iface string, qdiscMajor uint16, rate int64) error {
+	return nsinsider.Nsinsider(ws.InstanceID, int(ws.PID), func(cmd *exec.Cmd) {
+		cmd.Args = append(cmd.Args, "setup-bandwidth-limit",
+			"--interface", iface,
+			"--rate", strconv.FormatInt(rate, 10),
+			"--handle", strconv.FormatUint(uint64(qdiscMajor), 10),
+		)
+	}, nsinsider.EnterMountNS(false), nsinsider.EnterNetNS(true))
+}
+
+// readInterfaces lists the network interfaces of a network namespace from its net/dev file
+func readInterfaces(netDevLocation string) (map[string]struct{}, error) {
+	f, err := os.Open(netDevLocation)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	res := make(map[string]struct{})
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		iface, _, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			// the first two lines are a header and don't contain a colon
+			continue
+		}
+		res[strings.TrimSpace(iface)] = struct{}{}
+	}
+	return res, scanner.Err()
+}
+
+const (
+	// sizeofTcMsg is the size of struct tcmsg which precedes the attributes of a qdisc message
+	sizeofTcMsg = 20
+	tcHRoot     = 0xFFFFFFFF
+
+	tcaKind        = 1
+	tcaStats2      = 7
+	tcaStatsQueue  = 3
+	tbfQdiscKind   = "tbf"
+	qdiscMajorBits = 16
+)
+
+type qdiscStats struct {
+	Drops uint32
+}
+
+// readQdiscStats reads the statistics of the token bucket filters in the network namespace of pid
+func readQdiscStats(pid uint64) (map[uint16]qdiscStats, error) {
+	ns, err := netns.GetFromPid(int(pid))
+	if err != nil {
+		return nil, fmt.Errorf("could not get handle for network namespace: %w", err)
+	}
+	defer ns.Close()
+
+	conn, err := netlink.Dial(unix.NETLINK_ROUTE, &netlink.Config{NetNS: int(ns)})
+	if err != nil {
+		return nil, fmt.Errorf("could not establish netlink connection: %w", err)
+	}
+	defer conn.Close()
+
+	msgs, err := conn.Execute(netlink.Message{
+		Header: netlink.Header{
+			Type:  unix.RTM_GETQDISC,
+			Flags: netlink.Request | netlink.Dump,
+		},
+		Data: make([]byte, sizeofTcMsg),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list qdiscs: %w", err)
+	}
+
+	res := make(map[uint16]qdiscStats)
+	for _, msg := range msgs {
+		major, stats, ok, err := parseQdiscMessage(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res[major] = stats
+		}
+	}
+	return res, nil
+}
+
+// parseQdiscMessage parses a RTM_NEWQDISC message. ok is false if it's not a token bucket filter at the root.
+func parseQdiscMessage(data []byte) (major uint16, stats qdiscStats, ok bool, err error) {
+	if len(data) < sizeofTcMsg {
+		return 0, qdiscStats{}, false, xerrors.Errorf("qdisc message is too short")
+	}
+	handle := nlenc.Uint32(data[8:12])
+	parent := nlenc.Uint32(data[12:16])
+	if parent != tcHRoot {
+		return 0, qdiscStats{}, false, nil
+	}
+
+	ad, err := netlink.NewAttributeDecoder(data[sizeofTcMsg:])
+	if err != nil {
+		return 0, qdiscStats{}, false, err
+	}
+	var kind string
+	for ad.Next() {
+		switch ad.Type() {
+		case tcaKind:
+			kind = ad.String()
+		case tcaStats2:
+			ad.Nested(func(nad *netlink.AttributeDecoder) error {
+				for nad.Next() {
+					// struct gnet_stats_queue { __u32 qlen; __u32 backlog; __u32 drops; __u32 requeues; __u32 overlimits; }
+					if b := nad.Bytes(); nad.Type() == tcaStatsQueue && len(b) >= 12 {
+						stats.Drops = nlenc.Uint32(b[8:12])
+					}
+				}
+				return nil
+			})
+		}
+	}
+	if err := ad.Err(); err != nil {
+		return 0, qdiscStats{}, false, err
+	}
+	if kind != tbfQdiscKind {
+		return 0, qdiscStats{}, false, nil
+	}
+	return uint16(handle >> qdiscMajorBits), stats, true, nil
+}
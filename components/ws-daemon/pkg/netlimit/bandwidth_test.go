@@ -0,0 +1,218 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package netlimit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mdlayher/netlink"
+	"github.com/mdlayher/netlink/nlenc"
+
+	"github.com/gitpod-io/gitpod/common-go/kubernetes"
+)
+
+func TestBandwidthFromAnnotations(t *testing.T) {
+	type Expectation struct {
+		Limit BandwidthLimit
+		Error bool
+	}
+	tests := []struct {
+		Name        string
+		Annotations map[string]string
+		Expectation Expectation
+	}{
+		{
+			Name: "no limits",
+		},
+		{
+			Name: "egress and ingress",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceNetEgressBandwidthAnnotation:  "100M",
+				kubernetes.WorkspaceNetIngressBandwidthAnnotation: "1G",
+			},
+			Expectation: Expectation{Limit: BandwidthLimit{Egress: 12_500_000, Ingress: 125_000_000}},
+		},
+		{
+			Name: "invalid quantity",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceNetEgressBandwidthAnnotation: "100 Mbit",
+			},
+			Expectation: Expectation{Error: true},
+		},
+		{
+			Name: "negative quantity",
+			Annotations: map[string]string{
+				kubernetes.WorkspaceNetIngressBandwidthAnnotation: "-1M",
+			},
+			Expectation: Expectation{Error: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			limit, err := bandwidthFromAnnotations(test.Annotations)
+			act := Expectation{Limit: limit, Error: err != nil}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBandwidthLimiterApply(t *testing.T) {
+	type setupCall struct {
+		Interface  string
+		QdiscMajor uint16
+		Rate       int64
+	}
+	tests := []struct {
+		Name        string
+		Interfaces  []string
+		Applied     map[string]int64
+		Desired     BandwidthLimit
+		Expectation []setupCall
+	}{
+		{
+			Name:       "new limit",
+			Interfaces: []string{"lo", "eth0", "veth0"},
+			Desired:    BandwidthLimit{Egress: 100, Ingress: 200},
+			Expectation: []setupCall{
+				{Interface: "eth0", QdiscMajor: 0x6770, Rate: 100},
+				{Interface: "veth0", QdiscMajor: 0x6771, Rate: 200},
+			},
+		},
+		{
+			Name:        "workspace network not set up yet",
+			Interfaces:  []string{"lo", "eth0"},
+			Desired:     BandwidthLimit{Egress: 100, Ingress: 200},
+			Expectation: []setupCall{{Interface: "eth0", QdiscMajor: 0x6770, Rate: 100}},
+		},
+		{
+			Name:       "unchanged limit",
+			Interfaces: []string{"lo", "eth0", "veth0"},
+			Applied:    map[string]int64{"egress": 100, "ingress": 200},
+			Desired:    BandwidthLimit{Egress: 100, Ingress: 200},
+		},
+		{
+			Name:        "removed limit",
+			Interfaces:  []string{"lo", "eth0", "veth0"},
+			Applied:     map[string]int64{"egress": 100},
+			Desired:     BandwidthLimit{},
+			Expectation: []setupCall{{Interface: "eth0", QdiscMajor: 0x6770, Rate: 0}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			procPath := t.TempDir()
+			netDev := "Inter-|   Receive\n face |bytes\n"
+			for _, iface := range test.Interfaces {
+				netDev += fmt.Sprintf("%6s: 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0 0\n", iface)
+			}
+			err := os.MkdirAll(filepath.Join(procPath, "42", "net"), 0755)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = os.WriteFile(filepath.Join(procPath, "42", "net", "dev"), []byte(netDev), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var calls []setupCall
+			b := &BandwidthLimiter{
+				procPath: procPath,
+				setup: func(ws *bandwidthWorkspace, iface string, qdiscMajor uint16, rate int64) error {
+					calls = append(calls, setupCall{Interface: iface, QdiscMajor: qdiscMajor, Rate: rate})
+					return nil
+				},
+			}
+			applied := make(map[string]int64)
+			for k, v := range test.Applied {
+				applied[k] = v
+			}
+			bws := &bandwidthWorkspace{InstanceID: "foobar", PID: 42, Desired: test.Desired, Applied: applied}
+			b.apply(bws)
+
+			if diff := cmp.Diff(test.Expectation, calls); diff != "" {
+				t.Errorf("unexpected setup calls (-want +got):\n%s", diff)
+			}
+
+			// everything that was applied must not be applied again
+			calls = nil
+			b.apply(bws)
+			for _, c := range calls {
+				if c.Interface == "eth0" {
+					t.Errorf("egress limit was applied again")
+				}
+			}
+		})
+	}
+}
+
+func TestParseQdiscMessage(t *testing.T) {
+	newMessage := func(handle, parent uint32, kind string, drops uint32) []byte {
+		hdr := make([]byte, sizeofTcMsg)
+		nlenc.PutUint32(hdr[8:12], handle)
+		nlenc.PutUint32(hdr[12:16], parent)
+
+		queue := make([]byte, 20)
+		nlenc.PutUint32(queue[8:12], drops)
+
+		ae := netlink.NewAttributeEncoder()
+		ae.String(tcaKind, kind)
+		ae.Nested(tcaStats2, func(nae *netlink.AttributeEncoder) error {
+			nae.Bytes(1, make([]byte, 16))
+			nae.Bytes(tcaStatsQueue, queue)
+			return nil
+		})
+		attrs, err := ae.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return append(hdr, attrs...)
+	}
+
+	type Expectation struct {
+		Major uint16
+		Stats qdiscStats
+		OK    bool
+	}
+	tests := []struct {
+		Name        string
+		Data        []byte
+		Expectation Expectation
+	}{
+		{
+			Name:        "token bucket filter",
+			Data:        newMessage(0x6770<<16, tcHRoot, "tbf", 23),
+			Expectation: Expectation{Major: 0x6770, Stats: qdiscStats{Drops: 23}, OK: true},
+		},
+		{
+			Name: "other qdisc",
+			Data: newMessage(0x8001<<16, tcHRoot, "noqueue", 0),
+		},
+		{
+			Name: "not at the root",
+			Data: newMessage(0x6770<<16, 1<<16, "tbf", 23),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			major, stats, ok, err := parseQdiscMessage(test.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			act := Expectation{Major: major, Stats: stats, OK: ok}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
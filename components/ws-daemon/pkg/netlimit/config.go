@@ -10,3 +10,9 @@ type Config struct {
 	ConnectionsPerMinute int64 `json:"connectionsPerMinute"`
 	BucketSize           int64 `json:"bucketSize"`
 }
+
+// BandwidthConfig configures the shaping of the network traffic of workspaces. The bandwidth of a workspace
+// is part of its workspace class.
+type BandwidthConfig struct {
+	Enabled bool `json:"enabled"`
+}
@@ -139,6 +139,38 @@ func (xfs *XFS) SetQuotaWithPrjId(path string, quota Size, prjID int, isHard boo
 	return prjID, nil
 }
 
+// GetUsage returns the disk space used by a project and the quota it's limited to. The hard limit is returned
+// as quota if set, the soft limit otherwise. A quota of zero means the project is not limited.
+func (xfs *XFS) GetUsage(projectID int) (used, quota Size, err error) {
+	// report -b lists the used blocks and their limits in units of 1KiB
+	out, err := xfs.exec(xfs.Dir, "report -p -b -N")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	prj := fmt.Sprintf("#%d", projectID)
+	for _, l := range strings.Split(out, "\n") {
+		fields := strings.Fields(l)
+		if len(fields) < 4 || fields[0] != prj {
+			continue
+		}
+
+		var blocks [3]int64
+		for i := range blocks {
+			blocks[i], err = strconv.ParseInt(fields[i+1], 10, 64)
+			if err != nil {
+				return 0, 0, fmt.Errorf("cannot parse quota report of project %d: %w", projectID, err)
+			}
+		}
+		used, soft, hard := Size(blocks[0])*Kilobyte, Size(blocks[1])*Kilobyte, Size(blocks[2])*Kilobyte
+		if hard != 0 {
+			return used, hard, nil
+		}
+		return used, soft, nil
+	}
+	return 0, 0, fmt.Errorf("project %d not found", projectID)
+}
+
 // RegisterProject tells this implementation that a projectID is already in use
 func (xfs *XFS) RegisterProject(prjID int) {
 	xfs.mu.Lock()
@@ -191,3 +191,66 @@ func TestSetQuota(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUsage(t *testing.T) {
+	type Expectation struct {
+		Used  Size
+		Quota Size
+		Error string
+	}
+	tests := []struct {
+		Name        string
+		ProjectID   int
+		Input       string
+		InputErr    error
+		Expectation Expectation
+	}{
+		{
+			Name:        "hard limit",
+			ProjectID:   100,
+			Input:       "#0              4      0      0  00 [------]\n#100         1024      0  10240  00 [------]",
+			Expectation: Expectation{Used: Megabyte, Quota: 10 * Megabyte},
+		},
+		{
+			Name:        "soft limit",
+			ProjectID:   100,
+			Input:       "#100         2048  10240      0  00 [------]",
+			Expectation: Expectation{Used: 2 * Megabyte, Quota: 10 * Megabyte},
+		},
+		{
+			Name:        "unknown project",
+			ProjectID:   200,
+			Input:       "#100         2048  10240      0  00 [------]",
+			Expectation: Expectation{Error: "project 200 not found"},
+		},
+		{
+			Name:        "exec failure",
+			ProjectID:   100,
+			InputErr:    fmt.Errorf("exec failed"),
+			Expectation: Expectation{Error: "exec failed"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			xfs := &XFS{
+				exec: func(dir, command string) (output string, err error) {
+					return test.Input, test.InputErr
+				},
+			}
+
+			var (
+				act Expectation
+				err error
+			)
+			act.Used, act.Quota, err = xfs.GetUsage(test.ProjectID)
+			if err != nil {
+				act.Error = err.Error()
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected GetUsage (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
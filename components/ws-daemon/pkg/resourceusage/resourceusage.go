@@ -0,0 +1,477 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package resourceusage
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v2 "github.com/gitpod-io/gitpod/common-go/cgroups/v2"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/container"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/dispatch"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/netlimit"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
+)
+
+const (
+	defaultInterval    = 5 * time.Second
+	defaultMinInterval = 1 * time.Second
+)
+
+// Config configures the resource usage service
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// DefaultInterval is the time between two samples if a client does not ask for an interval. Defaults to 5s.
+	DefaultInterval util.Duration `json:"defaultInterval,omitempty"`
+	// MinInterval is the shortest interval a client can ask for. Defaults to 1s.
+	MinInterval util.Duration `json:"minInterval,omitempty"`
+}
+
+// Validate validates the resource usage configuration
+func (c Config) Validate() error {
+	if c.DefaultInterval < 0 {
+		return xerrors.Errorf("defaultInterval must not be negative")
+	}
+	if c.MinInterval < 0 {
+		return xerrors.Errorf("minInterval must not be negative")
+	}
+	if c.DefaultInterval != 0 && c.DefaultInterval < c.minInterval() {
+		return xerrors.Errorf("defaultInterval must not be shorter than minInterval")
+	}
+	return nil
+}
+
+func (c Config) minInterval() util.Duration {
+	if c.MinInterval == 0 {
+		return util.Duration(defaultMinInterval)
+	}
+	return c.MinInterval
+}
+
+// sampleInterval returns the interval to sample at if a client asks for requested.
+// A zero request uses the configured default.
+func (c Config) sampleInterval(requested time.Duration) (time.Duration, error) {
+	if requested == 0 {
+		if c.DefaultInterval == 0 {
+			return defaultInterval, nil
+		}
+		return time.Duration(c.DefaultInterval), nil
+	}
+	if min := time.Duration(c.minInterval()); requested < min {
+		return 0, xerrors.Errorf("interval must be at least %s", min)
+	}
+	return requested, nil
+}
+
+type workspace struct {
+	OWI        logrus.Fields
+	InstanceID string
+	CGroupPath string
+	PID        uint64
+	// ScratchProjectID is the XFS project accounting the container's writable layer, or zero if there is none
+	ScratchProjectID int
+
+	// stopped is closed once the workspace is gone
+	stopped chan struct{}
+}
+
+// BandwidthLimits provides the bandwidth limits of workspaces
+type BandwidthLimits interface {
+	Stats(instanceID string) (*netlimit.BandwidthStats, error)
+}
+
+// Service samples the resource usage of the workspaces on this node for as long as a client
+// is interested in them. Nothing is sampled unless a client asks for it.
+type Service struct {
+	Config         Config
+	CGroupBasePath string
+	WorkingArea    string
+	// Bandwidth adds the bandwidth limits to the samples if present
+	Bandwidth BandwidthLimits
+	// XFS accounts the container's writable layer if present and the layer is on the working area's filesystem
+	XFS *quota.XFS
+
+	procPath string
+
+	mu         sync.RWMutex
+	workspaces map[string]*workspace
+
+	api.UnimplementedWorkspaceResourceServiceServer
+}
+
+// NewService creates a new resource usage service
+func NewService(cfg Config, cgroupBasePath, workingArea string) *Service {
+	return &Service{
+		Config:         cfg,
+		CGroupBasePath: cgroupBasePath,
+		WorkingArea:    workingArea,
+		procPath:       "/proc",
+		workspaces:     make(map[string]*workspace),
+	}
+}
+
+// WorkspaceAdded makes a workspace available to clients until it goes away
+func (s *Service) WorkspaceAdded(ctx context.Context, ws *dispatch.Workspace) error {
+	disp := dispatch.GetFromContext(ctx)
+	if disp == nil {
+		return xerrors.Errorf("no dispatch available")
+	}
+
+	cgroupPath, err := disp.Runtime.ContainerCGroupPath(context.Background(), ws.ContainerID)
+	if err != nil {
+		return xerrors.Errorf("cannot find workspace cgroup: %w", err)
+	}
+
+	pid, err := disp.Runtime.ContainerPID(context.Background(), ws.ContainerID)
+	if err != nil {
+		return xerrors.Errorf("cannot find workspace container PID: %w", err)
+	}
+
+	s.add(ctx, &workspace{
+		OWI:              ws.OWI(),
+		InstanceID:       ws.InstanceID,
+		CGroupPath:       cgroupPath,
+		PID:              pid,
+		ScratchProjectID: s.installScratchQuota(disp.Runtime, ws),
+	})
+	return nil
+}
+
+// installScratchQuota puts the container's writable layer into an XFS project without a limit, such that its
+// usage can be read from the quota accounting. It returns zero if the layer cannot be accounted for.
+func (s *Service) installScratchQuota(rt container.Runtime, ws *dispatch.Workspace) int {
+	if s.XFS == nil {
+		return 0
+	}
+
+	upperdir, err := rt.ContainerUpperdir(context.Background(), ws.ContainerID, container.OptsContainerRootfs{})
+	if err != nil {
+		log.WithFields(ws.OWI()).WithError(err).Debug("cannot find container upperdir - not accounting scratch space")
+		return 0
+	}
+	same, err := sameFilesystem(upperdir, s.XFS.Dir)
+	if err != nil || !same {
+		log.WithFields(ws.OWI()).WithError(err).WithField("upperdir", upperdir).Debug("container upperdir is not on the working area filesystem - not accounting scratch space")
+		return 0
+	}
+
+	// Setting the project recursively tags the content that's already in the layer, which is little when the workspace
+	// starts, but can be more after a ws-daemon restart.
+	prj, err := s.XFS.SetQuota(upperdir, 0, true)
+	if err != nil {
+		log.WithFields(ws.OWI()).WithError(err).Warn("cannot account scratch space")
+		return 0
+	}
+	return prj
+}
+
+func sameFilesystem(a, b string) (bool, error) {
+	var sa, sb unix.Stat_t
+	err := unix.Stat(a, &sa)
+	if err != nil {
+		return false, err
+	}
+	err = unix.Stat(b, &sb)
+	if err != nil {
+		return false, err
+	}
+	return sa.Dev == sb.Dev, nil
+}
+
+// add registers ws until ctx is done
+func (s *Service) add(ctx context.Context, ws *workspace) {
+	ws.stopped = make(chan struct{})
+
+	s.mu.Lock()
+	s.workspaces[ws.InstanceID] = ws
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		s.mu.Lock()
+		if s.workspaces[ws.InstanceID] == ws {
+			delete(s.workspaces, ws.InstanceID)
+		}
+		s.mu.Unlock()
+		close(ws.stopped)
+
+		if ws.ScratchProjectID != 0 {
+			err := s.XFS.RemoveQuota(ws.ScratchProjectID)
+			if err != nil {
+				log.WithFields(ws.OWI).WithError(err).Warn("cannot remove scratch space project")
+			}
+		}
+	}()
+}
+
+// GetWorkspaceDiskUsage returns the disk usage of a workspace broken down by where the data lives
+func (s *Service) GetWorkspaceDiskUsage(ctx context.Context, req *api.GetWorkspaceDiskUsageRequest) (*api.GetWorkspaceDiskUsageResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	s.mu.RLock()
+	ws, ok := s.workspaces[req.Id]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "workspace %s does not exist on this node", req.Id)
+	}
+
+	res := &api.GetWorkspaceDiskUsageResponse{
+		CollectedAtMs: time.Now().UnixMilli(),
+	}
+
+	used, limit, err := readDiskUsage(filepath.Join(s.WorkingArea, ws.InstanceID))
+	if err != nil {
+		return nil, sampleError(ws, err)
+	}
+	res.Workspace = &api.DiskUsage{Used: used, Limit: limit}
+
+	// workspacekit mounts a tmpfs on /tmp, the pages of which are charged to the workspace's memory cgroup
+	stat, err := v2.NewMemoryControllerWithMount(s.CGroupBasePath, ws.CGroupPath).Stat()
+	if err != nil {
+		return nil, sampleError(ws, err)
+	}
+	res.Tmp = &api.DiskUsage{Used: int64(stat.Shmem)}
+
+	if ws.ScratchProjectID != 0 {
+		used, _, err := s.XFS.GetUsage(ws.ScratchProjectID)
+		if err != nil {
+			log.WithFields(ws.OWI).WithError(err).Warn("cannot read scratch space usage")
+		} else {
+			res.Scratch = &api.DiskUsage{Used: int64(used)}
+		}
+	}
+
+	return res, nil
+}
+
+// WorkspaceResourceUsage samples the resource usage of a workspace at the requested interval until the workspace stops
+func (s *Service) WorkspaceResourceUsage(req *api.WorkspaceResourceUsageRequest, srv api.WorkspaceResourceService_WorkspaceResourceUsageServer) error {
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "id is required")
+	}
+	interval, err := s.Config.sampleInterval(time.Duration(req.IntervalMs) * time.Millisecond)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	s.mu.RLock()
+	ws, ok := s.workspaces[req.Id]
+	s.mu.RUnlock()
+	if !ok {
+		return status.Errorf(codes.NotFound, "workspace %s does not exist on this node", req.Id)
+	}
+
+	smpl := newSampler(s.CGroupBasePath, ws.CGroupPath, filepath.Join(s.WorkingArea, ws.InstanceID), filepath.Join(s.procPath, fmt.Sprint(ws.PID), "net", "dev"))
+	// The first sample only primes the CPU usage counter - there's no usage to compute from a single reading.
+	_, err = smpl.Sample(time.Now())
+	if err != nil {
+		return sampleError(ws, err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-ws.stopped:
+			return nil
+		case <-ticker.C:
+		}
+
+		usage, err := smpl.Sample(time.Now())
+		if err != nil {
+			return sampleError(ws, err)
+		}
+		s.addBandwidth(ws, usage)
+		err = srv.Send(usage)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Service) addBandwidth(ws *workspace, usage *api.WorkspaceResourceUsageResponse) {
+	if s.Bandwidth == nil {
+		return
+	}
+	stats, err := s.Bandwidth.Stats(ws.InstanceID)
+	if err != nil {
+		log.WithFields(ws.OWI).WithError(err).Debug("cannot read workspace bandwidth limits")
+		return
+	}
+	if stats == nil {
+		return
+	}
+	usage.NetworkEgressLimit = stats.Limit.Egress
+	usage.NetworkIngressLimit = stats.Limit.Ingress
+	usage.NetworkEgressDroppedPackets = stats.EgressDropped
+	usage.NetworkIngressDroppedPackets = stats.IngressDropped
+}
+
+func sampleError(ws *workspace, err error) error {
+	if errors.Is(err, os.ErrNotExist) {
+		// the workspace's cgroup or process has gone away in the meantime
+		return status.Error(codes.NotFound, "workspace has stopped")
+	}
+	log.WithFields(ws.OWI).WithError(err).Warn("cannot sample workspace resource usage")
+	return status.Error(codes.Internal, "cannot sample workspace resource usage")
+}
+
+// sampler reads the resource usage of a single workspace
+type sampler struct {
+	cpu             *v2.Cpu
+	memory          *v2.Memory
+	contentLocation string
+	netDevLocation  string
+
+	lastCPUUsage uint64
+	lastSample   time.Time
+}
+
+func newSampler(cgroupBasePath, cgroupPath, contentLocation, netDevLocation string) *sampler {
+	return &sampler{
+		cpu:             v2.NewCpuControllerWithMount(cgroupBasePath, cgroupPath),
+		memory:          v2.NewMemoryControllerWithMount(cgroupBasePath, cgroupPath),
+		contentLocation: contentLocation,
+		netDevLocation:  netDevLocation,
+	}
+}
+
+// Sample reads the current resource usage. The CPU usage is the average since the previous sample.
+func (s *sampler) Sample(now time.Time) (*api.WorkspaceResourceUsageResponse, error) {
+	res := &api.WorkspaceResourceUsageResponse{
+		CollectedAtMs: now.UnixMilli(),
+	}
+
+	stat, err := s.cpu.Stat()
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read CPU usage: %w", err)
+	}
+	if !s.lastSample.IsZero() && stat.UsageTotal >= s.lastCPUUsage && now.After(s.lastSample) {
+		// cpu.stat is in microseconds, hence the usage in millicores is the used CPU time per elapsed millisecond.
+		res.CpuUsed = int64(float64(stat.UsageTotal-s.lastCPUUsage) / float64(now.Sub(s.lastSample).Microseconds()) * 1000)
+	}
+	s.lastCPUUsage, s.lastSample = stat.UsageTotal, now
+	if quota, period, err := s.cpu.Max(); err == nil && quota != math.MaxUint64 && period > 0 {
+		res.CpuLimit = int64(quota * 1000 / period)
+	}
+
+	res.MemoryUsed, res.MemoryLimit, err = readMemoryUsage(s.memory)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read memory usage: %w", err)
+	}
+
+	res.DiskUsed, res.DiskLimit, err = readDiskUsage(s.contentLocation)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read disk usage: %w", err)
+	}
+
+	res.NetworkRxBytes, res.NetworkTxBytes, err = readNetworkUsage(s.netDevLocation)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read network usage: %w", err)
+	}
+
+	return res, nil
+}
+
+func readMemoryUsage(memory *v2.Memory) (used, limit int64, err error) {
+	current, err := memory.Current()
+	if err != nil {
+		return 0, 0, err
+	}
+	stat, err := memory.Stat()
+	if err != nil {
+		return 0, 0, err
+	}
+	// Page cache can be reclaimed, hence we do not count it as used.
+	if stat.InactiveFileTotal < current {
+		current -= stat.InactiveFileTotal
+	}
+
+	max, err := memory.Max()
+	if err != nil {
+		return 0, 0, err
+	}
+	if max != math.MaxUint64 {
+		limit = int64(max)
+	}
+	return int64(current), limit, nil
+}
+
+// readDiskUsage uses statfs on the workspace directory. If the directory has an XFS project quota,
+// statfs reports the quota as size of the filesystem.
+func readDiskUsage(location string) (used, limit int64, err error) {
+	var stat unix.Statfs_t
+	err = unix.Statfs(location, &stat)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	used = int64(stat.Blocks-stat.Bfree) * stat.Bsize
+	limit = int64(stat.Blocks) * stat.Bsize
+	return used, limit, nil
+}
+
+// readNetworkUsage reads the counters of all interfaces in a network namespace, except the loopback device.
+func readNetworkUsage(netDevLocation string) (rx, tx int64, err error) {
+	f, err := os.Open(netDevLocation)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	return parseNetDev(f)
+}
+
+func parseNetDev(f io.Reader) (rx, tx int64, err error) {
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		iface, counters, ok := strings.Cut(scanner.Text(), ":")
+		if !ok || strings.TrimSpace(iface) == "lo" {
+			// the first two lines are a header and don't contain a colon
+			continue
+		}
+
+		// receive bytes are the first field, transmit bytes the ninth
+		fields := strings.Fields(counters)
+		if len(fields) < 9 {
+			return 0, 0, xerrors.Errorf("invalid net/dev line: %s", scanner.Text())
+		}
+		r, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		t, err := strconv.ParseInt(fields[8], 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		rx += r
+		tx += t
+	}
+	return rx, tx, scanner.Err()
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package resourceusage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-daemon/api"
+)
+
+const netDev = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:    1000      10    0    0    0     0          0         0     1000      10    0    0    0     0       0          0
+  eth0: 5000000    4000    0    0    0     0          0         0   200000    1500    0    0    0     0       0          0
+  tap0:     100       1    0    0    0     0          0         0       50       1    0    0    0     0       0          0
+`
+
+func TestParseNetDev(t *testing.T) {
+	type Expectation struct {
+		Rx, Tx int64
+	}
+	tests := []struct {
+		Name        string
+		Input       string
+		Expectation Expectation
+		Error       bool
+	}{
+		{
+			Name:        "ignores loopback",
+			Input:       netDev,
+			Expectation: Expectation{Rx: 5000100, Tx: 200050},
+		},
+		{
+			Name: "invalid line",
+			Input: `Inter-|   Receive                                                |  Transmit
+  eth0: 5000000    4000
+`,
+			Error: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			rx, tx, err := parseNetDev(strings.NewReader(test.Input))
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.Error {
+				return
+			}
+			if diff := cmp.Diff(test.Expectation, Expectation{Rx: rx, Tx: tx}); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigSampleInterval(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Config      Config
+		Requested   time.Duration
+		Expectation time.Duration
+		Error       bool
+	}{
+		{Name: "zero config uses defaults", Expectation: defaultInterval},
+		{Name: "configured default", Config: Config{DefaultInterval: util.Duration(10 * time.Second)}, Expectation: 10 * time.Second},
+		{Name: "requested interval", Requested: 2 * time.Second, Expectation: 2 * time.Second},
+		{Name: "below default minimum", Requested: 500 * time.Millisecond, Error: true},
+		{Name: "below configured minimum", Config: Config{MinInterval: util.Duration(5 * time.Second)}, Requested: 2 * time.Second, Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act, err := test.Config.sampleInterval(test.Requested)
+			if (err != nil) != test.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if act != test.Expectation {
+				t.Errorf("unexpected interval: want %s, got %s", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Config Config
+		Error  bool
+	}{
+		{Name: "zero config", Config: Config{Enabled: true}},
+		{Name: "negative interval", Config: Config{DefaultInterval: util.Duration(-time.Second)}, Error: true},
+		{Name: "default below minimum", Config: Config{DefaultInterval: util.Duration(time.Second), MinInterval: util.Duration(2 * time.Second)}, Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Config.Validate()
+			if (err != nil) != test.Error {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+type testWorkspace struct {
+	CGroupBase string
+	CGroup     string
+	Content    string
+	NetDev     string
+}
+
+func newTestWorkspace(t *testing.T) *testWorkspace {
+	base := t.TempDir()
+	ws := &testWorkspace{
+		CGroupBase: base,
+		CGroup:     "workspace",
+		Content:    t.TempDir(),
+		NetDev:     filepath.Join(base, "net_dev"),
+	}
+	err := os.MkdirAll(filepath.Join(base, ws.CGroup), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ws.write(t, "cpu.stat", "usage_usec 1000000\nuser_usec 600000\nsystem_usec 400000\n")
+	ws.write(t, "cpu.max", "200000 100000\n")
+	ws.write(t, "memory.current", "3000\n")
+	ws.write(t, "memory.stat", "anon 1000\ninactive_file 1000\n")
+	ws.write(t, "memory.max", "max\n")
+	err = os.WriteFile(ws.NetDev, []byte(netDev), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ws
+}
+
+func (ws *testWorkspace) write(t *testing.T, name, content string) {
+	err := os.WriteFile(filepath.Join(ws.CGroupBase, ws.CGroup, name), []byte(content), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSample(t *testing.T) {
+	ws := newTestWorkspace(t)
+	smpl := newSampler(ws.CGroupBase, ws.CGroup, ws.Content, ws.NetDev)
+
+	start := time.Now()
+	first, err := smpl.Sample(start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.CpuUsed != 0 {
+		t.Errorf("first sample must not report CPU usage, got %d", first.CpuUsed)
+	}
+
+	// half a second of CPU time within one second amounts to 500 millicores
+	ws.write(t, "cpu.stat", "usage_usec 1500000\nuser_usec 900000\nsystem_usec 600000\n")
+	ws.write(t, "memory.max", "4096\n")
+	act, err := smpl.Sample(start.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if act.DiskLimit <= 0 || act.DiskUsed > act.DiskLimit {
+		t.Errorf("unexpected disk usage: %d of %d", act.DiskUsed, act.DiskLimit)
+	}
+	act.DiskUsed, act.DiskLimit = 0, 0
+
+	expectation := &api.WorkspaceResourceUsageResponse{
+		CpuUsed:        500,
+		CpuLimit:       2000,
+		MemoryUsed:     2000,
+		MemoryLimit:    4096,
+		NetworkRxBytes: 5000100,
+		NetworkTxBytes: 200050,
+		CollectedAtMs:  start.Add(time.Second).UnixMilli(),
+	}
+	if diff := cmp.Diff(expectation, act, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected sample (-want +got):\n%s", diff)
+	}
+}
+
+func TestSampleUnlimited(t *testing.T) {
+	ws := newTestWorkspace(t)
+	ws.write(t, "cpu.max", "max 100000\n")
+	smpl := newSampler(ws.CGroupBase, ws.CGroup, ws.Content, ws.NetDev)
+
+	act, err := smpl.Sample(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act.CpuLimit != 0 || act.MemoryLimit != 0 {
+		t.Errorf("unlimited resources must report no limit, got CPU %d and memory %d", act.CpuLimit, act.MemoryLimit)
+	}
+}
+
+type fakeUsageServer struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	samples chan *api.WorkspaceResourceUsageResponse
+}
+
+func (f *fakeUsageServer) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeUsageServer) Send(resp *api.WorkspaceResourceUsageResponse) error {
+	f.samples <- resp
+	return nil
+}
+
+func TestWorkspaceResourceUsage(t *testing.T) {
+	ws := newTestWorkspace(t)
+	svc := NewService(Config{Enabled: true, MinInterval: util.Duration(time.Millisecond)}, ws.CGroupBase, filepath.Dir(ws.Content))
+
+	// the test has no workspace process to read the network usage from
+	svc.procPath = t.TempDir()
+	err := os.MkdirAll(filepath.Join(svc.procPath, "1", "net"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(svc.procPath, "1", "net", "dev"), []byte(netDev), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wsCtx, stopWorkspace := context.WithCancel(context.Background())
+	defer stopWorkspace()
+	svc.add(wsCtx, &workspace{
+		InstanceID: filepath.Base(ws.Content),
+		CGroupPath: ws.CGroup,
+		PID:        1,
+	})
+
+	t.Run("unknown workspace", func(t *testing.T) {
+		err := svc.WorkspaceResourceUsage(&api.WorkspaceResourceUsageRequest{Id: "foobar"}, &fakeUsageServer{ctx: context.Background()})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("interval too short", func(t *testing.T) {
+		svc := NewService(Config{Enabled: true}, ws.CGroupBase, filepath.Dir(ws.Content))
+		err := svc.WorkspaceResourceUsage(&api.WorkspaceResourceUsageRequest{Id: "foobar", IntervalMs: 10}, &fakeUsageServer{ctx: context.Background()})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expected InvalidArgument, got %v", err)
+		}
+	})
+
+	t.Run("streams until the workspace stops", func(t *testing.T) {
+		srv := &fakeUsageServer{
+			ctx:     context.Background(),
+			samples: make(chan *api.WorkspaceResourceUsageResponse),
+		}
+		done := make(chan error, 1)
+		go func() {
+			done <- svc.WorkspaceResourceUsage(&api.WorkspaceResourceUsageRequest{Id: filepath.Base(ws.Content), IntervalMs: 10}, srv)
+		}()
+
+		for i := 0; i < 2; i++ {
+			select {
+			case sample := <-srv.samples:
+				if sample.MemoryUsed != 2000 || sample.NetworkRxBytes != 5000100 {
+					t.Errorf("unexpected sample: %v", sample)
+				}
+			case err := <-done:
+				t.Fatalf("stream ended early: %v", err)
+			case <-time.After(5 * time.Second):
+				t.Fatal("timed out waiting for sample")
+			}
+		}
+
+		stopWorkspace()
+		go func() {
+			for range srv.samples {
+			}
+		}()
+		select {
+		case err := <-done:
+			close(srv.samples)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("stream did not end when the workspace stopped")
+		}
+	})
+}
+
+func TestGetWorkspaceDiskUsage(t *testing.T) {
+	ws := newTestWorkspace(t)
+	ws.write(t, "memory.stat", "anon 1000\nshmem 4096\ninactive_file 1000\n")
+	svc := NewService(Config{Enabled: true}, ws.CGroupBase, filepath.Dir(ws.Content))
+
+	wsCtx, stopWorkspace := context.WithCancel(context.Background())
+	defer stopWorkspace()
+	svc.add(wsCtx, &workspace{
+		InstanceID: filepath.Base(ws.Content),
+		CGroupPath: ws.CGroup,
+	})
+
+	t.Run("unknown workspace", func(t *testing.T) {
+		_, err := svc.GetWorkspaceDiskUsage(context.Background(), &api.GetWorkspaceDiskUsageRequest{Id: "foobar"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("usage", func(t *testing.T) {
+		act, err := svc.GetWorkspaceDiskUsage(context.Background(), &api.GetWorkspaceDiskUsageRequest{Id: filepath.Base(ws.Content)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if act.Workspace == nil || act.Workspace.Limit <= 0 || act.Workspace.Used > act.Workspace.Limit {
+			t.Errorf("unexpected workspace disk usage: %v", act.Workspace)
+		}
+		if diff := cmp.Diff(&api.DiskUsage{Used: 4096}, act.Tmp, protocmp.Transform()); diff != "" {
+			t.Errorf("unexpected tmp usage (-want +got):\n%s", diff)
+		}
+		if act.Scratch != nil {
+			t.Errorf("scratch space must not be reported without a project, got %v", act.Scratch)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package secprofile
+
+import (
+	"golang.org/x/xerrors"
+)
+
+// DefaultAppArmorFS is where the AppArmor securityfs is usually mounted
+const DefaultAppArmorFS = "/sys/kernel/security/apparmor"
+
+// Config configures the installation of the seccomp and AppArmor profiles workspace classes use
+type Config struct {
+	Enabled bool `json:"enabled"`
+
+	// Profiles is the path of the profiles file, usually mounted from a config map. Changes to the file
+	// are applied while ws-daemon runs.
+	Profiles string `json:"profiles"`
+
+	// SeccompDir is the seccomp profile directory of the kubelet as seen from ws-daemon
+	SeccompDir string `json:"seccompDir"`
+
+	// AppArmorFS is the AppArmor securityfs directory the profiles are loaded through. Defaults to DefaultAppArmorFS.
+	AppArmorFS string `json:"appArmorFS,omitempty"`
+}
+
+// Validate validates the security profile configuration
+func (c *Config) Validate() error {
+	if c.Profiles == "" {
+		return xerrors.Errorf("profiles is required")
+	}
+	if c.SeccompDir == "" {
+		return xerrors.Errorf("seccompDir is required")
+	}
+	return nil
+}
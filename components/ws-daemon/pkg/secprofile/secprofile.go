@@ -0,0 +1,157 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package secprofile
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/watch"
+)
+
+// Profiles are the security profiles workspace classes use, as found in the profiles file
+type Profiles struct {
+	// Seccomp maps the paths of seccomp profiles, relative to the seccomp profile directory of the kubelet, to the profiles
+	Seccomp map[string]json.RawMessage `json:"seccomp,omitempty"`
+	// AppArmor maps the names of AppArmor profiles to their source. The source must declare a profile of that name.
+	AppArmor map[string]string `json:"appArmor,omitempty"`
+}
+
+// Installer installs the security profiles of workspace classes on the node and keeps them up to date. Profiles
+// must be installed before workspaces use them - the kubelet refuses to start workspaces whose profiles are missing.
+type Installer struct {
+	Config Config
+
+	mu sync.Mutex
+	// loaded maps the AppArmor profiles we loaded to their source
+	loaded map[string]string
+	// loadAppArmor loads an AppArmor profile into the kernel, replacing the profile of the same name
+	loadAppArmor func(source string) error
+}
+
+// NewInstaller creates a new security profile installer
+func NewInstaller(cfg Config) *Installer {
+	res := &Installer{
+		Config: cfg,
+		loaded: make(map[string]string),
+	}
+	res.loadAppArmor = res.apparmorParser
+	return res
+}
+
+// Start installs the profiles and reinstalls them whenever the profiles file changes, until ctx is canceled
+func (i *Installer) Start(ctx context.Context) error {
+	err := i.Install()
+	if err != nil {
+		// workspaces of classes whose profiles are missing fail to start, all others are unaffected
+		log.WithError(err).Error("cannot install security profiles")
+	}
+
+	return watch.File(ctx, i.Config.Profiles, func() {
+		err := i.Install()
+		if err != nil {
+			log.WithError(err).Error("cannot install security profiles")
+		}
+	})
+}
+
+// Install installs the profiles of the profiles file. Profiles which were removed from the file remain installed
+// because running workspaces may still use them.
+func (i *Installer) Install() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	fc, err := os.ReadFile(i.Config.Profiles)
+	if err != nil {
+		return xerrors.Errorf("cannot read profiles: %w", err)
+	}
+	var profiles Profiles
+	err = json.Unmarshal(fc, &profiles)
+	if err != nil {
+		return xerrors.Errorf("cannot unmarshal profiles: %w", err)
+	}
+
+	// one broken profile must not keep the others from being installed
+	var errs []error
+	for name, profile := range profiles.Seccomp {
+		err := i.installSeccomp(name, profile)
+		if err != nil {
+			errs = append(errs, xerrors.Errorf("seccomp profile %s: %w", name, err))
+		}
+	}
+	for name, source := range profiles.AppArmor {
+		if i.loaded[name] == source {
+			continue
+		}
+		if name == "" || strings.ContainsAny(name, " \t\n/") {
+			errs = append(errs, xerrors.Errorf("AppArmor profile name \"%s\" is invalid", name))
+			continue
+		}
+		err := i.loadAppArmor(source)
+		if err != nil {
+			errs = append(errs, xerrors.Errorf("AppArmor profile %s: %w", name, err))
+			continue
+		}
+		i.loaded[name] = source
+		log.WithField("profile", name).Info("loaded AppArmor profile")
+	}
+	return errors.Join(errs...)
+}
+
+func (i *Installer) installSeccomp(name string, profile json.RawMessage) error {
+	if !filepath.IsLocal(name) {
+		return xerrors.Errorf("must be a path within the seccomp profile directory")
+	}
+
+	fn := filepath.Join(i.Config.SeccompDir, name)
+	current, err := os.ReadFile(fn)
+	if err == nil && bytes.Equal(current, profile) {
+		return nil
+	}
+
+	err = os.MkdirAll(filepath.Dir(fn), 0755)
+	if err != nil {
+		return err
+	}
+	// the kubelet must never read a partially written profile
+	tmp := fn + ".tmp"
+	err = os.WriteFile(tmp, profile, 0644)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmp, fn)
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+
+	log.WithField("profile", name).Info("installed seccomp profile")
+	return nil
+}
+
+func (i *Installer) apparmorParser(source string) error {
+	fs := i.Config.AppArmorFS
+	if fs == "" {
+		fs = DefaultAppArmorFS
+	}
+
+	cmd := exec.Command("apparmor_parser", "--replace", "--subdomainfs", fs)
+	cmd.Stdin = strings.NewReader(source)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return xerrors.Errorf("apparmor_parser failed: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
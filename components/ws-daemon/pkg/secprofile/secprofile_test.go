@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package secprofile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestInstall(t *testing.T) {
+	type Expectation struct {
+		Seccomp  map[string]string
+		AppArmor []string
+		Error    bool
+	}
+	tests := []struct {
+		Name        string
+		Profiles    []string
+		Expectation Expectation
+	}{
+		{
+			Name:     "seccomp and AppArmor",
+			Profiles: []string{`{"seccomp":{"gitpod/untrusted.json":{"defaultAction":"SCMP_ACT_ERRNO"}},"appArmor":{"gitpod-untrusted":"profile gitpod-untrusted {}"}}`},
+			Expectation: Expectation{
+				Seccomp:  map[string]string{"gitpod/untrusted.json": `{"defaultAction":"SCMP_ACT_ERRNO"}`},
+				AppArmor: []string{"profile gitpod-untrusted {}"},
+			},
+		},
+		{
+			Name: "unchanged profiles are not reloaded",
+			Profiles: []string{
+				`{"appArmor":{"gitpod-untrusted":"profile gitpod-untrusted {}"}}`,
+				`{"appArmor":{"gitpod-untrusted":"profile gitpod-untrusted {}"}}`,
+			},
+			Expectation: Expectation{
+				AppArmor: []string{"profile gitpod-untrusted {}"},
+			},
+		},
+		{
+			Name: "changed profiles",
+			Profiles: []string{
+				`{"seccomp":{"untrusted.json":{"defaultAction":"SCMP_ACT_ERRNO"}},"appArmor":{"gitpod-untrusted":"profile gitpod-untrusted {}"}}`,
+				`{"seccomp":{"untrusted.json":{"defaultAction":"SCMP_ACT_KILL"}},"appArmor":{"gitpod-untrusted":"profile gitpod-untrusted { deny network, }"}}`,
+			},
+			Expectation: Expectation{
+				Seccomp:  map[string]string{"untrusted.json": `{"defaultAction":"SCMP_ACT_KILL"}`},
+				AppArmor: []string{"profile gitpod-untrusted {}", "profile gitpod-untrusted { deny network, }"},
+			},
+		},
+		{
+			Name:     "seccomp profile outside the profile directory",
+			Profiles: []string{`{"seccomp":{"../untrusted.json":{},"untrusted.json":{}}}`},
+			Expectation: Expectation{
+				Seccomp: map[string]string{"untrusted.json": `{}`},
+				Error:   true,
+			},
+		},
+		{
+			Name:     "invalid AppArmor profile name",
+			Profiles: []string{`{"appArmor":{"localhost/untrusted":"profile untrusted {}"}}`},
+			Expectation: Expectation{
+				Error: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			base := t.TempDir()
+			seccompDir := filepath.Join(base, "seccomp")
+			inst := NewInstaller(Config{
+				Profiles:   filepath.Join(base, "profiles.json"),
+				SeccompDir: seccompDir,
+			})
+			var act Expectation
+			inst.loadAppArmor = func(source string) error {
+				act.AppArmor = append(act.AppArmor, source)
+				return nil
+			}
+
+			for _, profiles := range test.Profiles {
+				err := os.WriteFile(inst.Config.Profiles, []byte(profiles), 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+				err = inst.Install()
+				if err != nil {
+					act.Error = true
+				}
+			}
+
+			_ = filepath.Walk(seccompDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				content, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				if act.Seccomp == nil {
+					act.Seccomp = make(map[string]string)
+				}
+				rel, _ := filepath.Rel(seccompDir, path)
+				act.Seccomp[rel] = string(content)
+				return nil
+			})
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected installed profiles (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
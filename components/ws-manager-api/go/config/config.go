@@ -8,15 +8,19 @@ import (
 	"bytes"
 	"html/template"
 	iofs "io/fs"
+	"net"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	ozzo "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 	"golang.org/x/xerrors"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -72,14 +76,28 @@ type ServiceConfiguration struct {
 	Health struct {
 		Addr string `json:"addr"`
 	} `json:"health"`
+	// LeaderElection tunes the election of the replica which runs the controllers. If nil, the defaults of controller-runtime apply.
+	LeaderElection *LeaderElectionConfiguration `json:"leaderElection,omitempty"`
+}
+
+// Validate validates the service configuration to catch issues during startup and not at runtime
+func (c *ServiceConfiguration) Validate() error {
+	if err := c.Manager.Validate(); err != nil {
+		return xerrors.Errorf("manager: %w", err)
+	}
+	if err := c.LeaderElection.Validate(); err != nil {
+		return xerrors.Errorf("leaderElection: %w", err)
+	}
+	return nil
 }
 
 // Configuration is the configuration of the ws-manager
 type Configuration struct {
-	// Namespace is the kubernetes namespace the workspace manager operates in
-	Namespace string `json:"namespace"`
+	// Namespace is the kubernetes namespace the workspace manager operates in. It is required because an empty
+	// namespace defaults to a cluster-scoped cache, which ws-manager doesn't have the RBAC for.
+	Namespace string `json:"namespace" validate:"required"`
 	// SecretsNamespace is the kubernetes namespace which contains workspace secrets
-	SecretsNamespace string `json:"secretsNamespace"`
+	SecretsNamespace string `json:"secretsNamespace" validate:"required"`
 	// SchedulerName is the name of the workspace scheduler all pods are created with
 	SchedulerName string `json:"schedulerName"`
 	// SeccompProfile names the seccomp profile workspaces will use
@@ -125,6 +143,29 @@ type Configuration struct {
 	WorkspaceClasses map[string]*WorkspaceClass `json:"workspaceClass"`
 	// PreferredWorkspaceClass is the name of the workspace class that should be used by default
 	PreferredWorkspaceClass string `json:"preferredWorkspaceClass"`
+	// SidecarCatalog lists the sidecars which can run next to the workspace container. Workspace classes
+	// and start requests refer to the sidecars by their name.
+	SidecarCatalog map[string]*SidecarConfiguration `json:"sidecarCatalog,omitempty"`
+	// StartAdmission configures an endpoint which can reject or modify workspace starts before the workspace is created
+	StartAdmission *StartAdmissionConfiguration `json:"startAdmission,omitempty"`
+	// StartPolicy configures an Open Policy Agent decision which can deny or modify workspace starts
+	// based on the request and the workspace it resolves to. OPA is the only supported policy engine.
+	StartPolicy *StartPolicyConfiguration `json:"startPolicy,omitempty"`
+	// PrebuildQueue limits how many prebuilds run at the same time. Prebuilds beyond the limits wait in a queue.
+	PrebuildQueue *PrebuildQueueConfiguration `json:"prebuildQueue,omitempty"`
+	// StartQueue limits how many workspaces run at the same time. Workspaces started while the cluster is
+	// at capacity wait in a queue rather than failing.
+	StartQueue *StartQueueConfiguration `json:"startQueue,omitempty"`
+	// StartRateLimit limits how often a single owner can start workspaces
+	StartRateLimit *StartRateLimitConfiguration `json:"startRateLimit,omitempty"`
+	// NodePreemption stops workspaces on nodes which are about to be preempted, such that their content is
+	// backed up before the node goes away.
+	NodePreemption *NodePreemptionConfiguration `json:"nodePreemption,omitempty"`
+	// HeadlessCompletionHooks are notified once a headless workspace, e.g. a prebuild, completed
+	HeadlessCompletionHooks []*CompletionHookConfiguration `json:"headlessCompletionHooks,omitempty"`
+	// InPlacePodResize resizes the pods of running workspaces whose class changes, rather than restarting them.
+	// Requires the InPlacePodVerticalScaling feature gate of Kubernetes.
+	InPlacePodResize bool `json:"inPlacePodResize,omitempty"`
 	// DebugWorkspacePod adds extra finalizer to workspace to prevent it from shutting down. Helps to debug.
 	DebugWorkspacePod bool `json:"debugWorkspacePod,omitempty"`
 	// WorkspaceMaxConcurrentReconciles configures the max amount of concurrent workspace reconciliations on
@@ -152,6 +193,307 @@ type WorkspaceClass struct {
 
 	// CreditsPerMinute is the cost per minute for this workspace class in credits
 	CreditsPerMinute float32 `json:"creditsPerMinute"`
+
+	// Scheduling configures which nodes workspaces of this class are scheduled to
+	Scheduling *WorkspaceClassScheduling `json:"scheduling,omitempty"`
+
+	// GPU configures the GPUs workspaces of this class get
+	GPU *GPUConfiguration `json:"gpu,omitempty"`
+
+	// Resizable permits running workspaces to move from and to this class
+	Resizable bool `json:"resizable,omitempty"`
+
+	// Sidecars names the entries of the sidecar catalog which run next to every workspace of this class
+	Sidecars []string `json:"sidecars,omitempty"`
+
+	// PodTemplatePatch references a strategic merge patch which is applied to the pods of this class
+	PodTemplatePatch *PodTemplatePatchConfiguration `json:"podTemplatePatch,omitempty"`
+
+	// BackupGracePeriod is how long ws-manager waits for the final backup of a stopping workspace of this class
+	// before it gives up on the backup and releases the pod. Defaults to the content finalization timeout.
+	BackupGracePeriod *util.Duration `json:"backupGracePeriod,omitempty"`
+
+	// FUSEMounts lets FUSE filesystems which users mount below /workspace propagate to all mount namespaces of
+	// workspaces of this class. Such mounts are lazily unmounted when the workspace stops.
+	FUSEMounts bool `json:"fuseMounts,omitempty"`
+
+	// SecurityProfile overrides the seccomp and AppArmor profiles workspaces of this class run with. Workspaces which
+	// move to another class keep the profiles they started with.
+	SecurityProfile *SecurityProfileConfiguration `json:"securityProfile,omitempty"`
+}
+
+// SecurityProfileConfiguration names node-local security profiles, which ws-daemon installs on every workspace node
+type SecurityProfileConfiguration struct {
+	// Seccomp is the path of a seccomp profile relative to the seccomp profile directory of the kubelet.
+	// Defaults to the SeccompProfile of the configuration.
+	Seccomp string `json:"seccomp,omitempty"`
+	// AppArmor is the name of an AppArmor profile. Workspaces are unconfined by AppArmor by default.
+	AppArmor string `json:"appArmor,omitempty"`
+}
+
+// Validate validates a security profile configuration
+func (s *SecurityProfileConfiguration) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	if s.Seccomp != "" && !filepath.IsLocal(s.Seccomp) {
+		return xerrors.Errorf("seccomp profile \"%s\" must be a path within the seccomp profile directory", s.Seccomp)
+	}
+	if strings.ContainsAny(s.AppArmor, " \t\n/") {
+		return xerrors.Errorf("AppArmor profile name \"%s\" is invalid", s.AppArmor)
+	}
+	return nil
+}
+
+// ClassSeccompProfile returns the seccomp profile of workspaces of the given class
+func (c *Configuration) ClassSeccompProfile(class string) string {
+	if cls, ok := c.WorkspaceClasses[class]; ok && cls.SecurityProfile != nil && cls.SecurityProfile.Seccomp != "" {
+		return cls.SecurityProfile.Seccomp
+	}
+	return c.SeccompProfile
+}
+
+// ClassAppArmorProfile returns the AppArmor profile of workspaces of the given class in the format of the AppArmor pod
+// annotation, i.e. localhost/<name> or unconfined
+func (c *Configuration) ClassAppArmorProfile(class string) string {
+	if cls, ok := c.WorkspaceClasses[class]; ok && cls.SecurityProfile != nil && cls.SecurityProfile.AppArmor != "" {
+		return "localhost/" + cls.SecurityProfile.AppArmor
+	}
+	return "unconfined"
+}
+
+// BackupGracePeriod returns how long ws-manager waits for the final backup of a workspace of the given class
+func (c *Configuration) BackupGracePeriod(class string) time.Duration {
+	if cls, ok := c.WorkspaceClasses[class]; ok && cls.BackupGracePeriod != nil {
+		return time.Duration(*cls.BackupGracePeriod)
+	}
+	return time.Duration(c.Timeouts.ContentFinalization)
+}
+
+// ContainerResources returns the resources of the workspace container of this class
+func (c *WorkspaceClass) ContainerResources() (corev1.ResourceRequirements, error) {
+	limits, err := c.Container.Limits.ResourceList()
+	if err != nil {
+		return corev1.ResourceRequirements{}, xerrors.Errorf("cannot parse workspace container limits: %w", err)
+	}
+	requests, err := c.Container.Requests.ResourceList()
+	if err != nil {
+		return corev1.ResourceRequirements{}, xerrors.Errorf("cannot parse workspace container requests: %w", err)
+	}
+	if c.GPU != nil {
+		// extended resources cannot be overcommitted, hence requests and limits must be equal
+		gpus := *resource.NewQuantity(c.GPU.Count, resource.DecimalSI)
+		limits[c.GPU.GetResourceName()] = gpus
+		requests[c.GPU.GetResourceName()] = gpus
+	}
+	return corev1.ResourceRequirements{Limits: limits, Requests: requests}, nil
+}
+
+// CanResizeInPlace returns true if a running container can change its resources from one set to the other.
+// Kubernetes can only resize the CPU and memory of a running container.
+func CanResizeInPlace(from, to corev1.ResourceRequirements) bool {
+	other := func(l corev1.ResourceList) corev1.ResourceList {
+		res := make(corev1.ResourceList, len(l))
+		for k, v := range l {
+			if k == corev1.ResourceCPU || k == corev1.ResourceMemory {
+				continue
+			}
+			res[k] = v
+		}
+		return res
+	}
+	return equality.Semantic.DeepEqual(other(from.Limits), other(to.Limits)) &&
+		equality.Semantic.DeepEqual(other(from.Requests), other(to.Requests))
+}
+
+const (
+	// DefaultGPUResourceName is the extended resource NVIDIA's device plugin advertises GPUs as
+	DefaultGPUResourceName = "nvidia.com/gpu"
+	// DefaultGPURuntimeClassName is the runtime class the NVIDIA GPU operator installs
+	DefaultGPURuntimeClassName = "nvidia"
+)
+
+// GPUConfiguration configures the GPUs of a workspace class
+type GPUConfiguration struct {
+	// Count is the number of GPUs every workspace of the class gets
+	Count int64 `json:"count"`
+	// ResourceName is the extended resource GPUs are requested as. Defaults to nvidia.com/gpu.
+	ResourceName string `json:"resourceName,omitempty"`
+	// RuntimeClassName is the runtime class of the workspace pods. Defaults to nvidia, set to an empty
+	// string if the GPU drivers are made available without a dedicated runtime class.
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+	// NodeSelector restricts workspaces of the class to nodes with the given labels, e.g. a GPU model
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// GetResourceName returns the extended resource GPUs are requested as
+func (g *GPUConfiguration) GetResourceName() corev1.ResourceName {
+	if g.ResourceName == "" {
+		return DefaultGPUResourceName
+	}
+	return corev1.ResourceName(g.ResourceName)
+}
+
+// GetRuntimeClassName returns the runtime class of GPU workspace pods, or nil if no runtime class is required
+func (g *GPUConfiguration) GetRuntimeClassName() *string {
+	if g.RuntimeClassName == nil {
+		name := DefaultGPURuntimeClassName
+		return &name
+	}
+	if *g.RuntimeClassName == "" {
+		return nil
+	}
+	name := *g.RuntimeClassName
+	return &name
+}
+
+// Validate validates a GPU configuration
+func (g *GPUConfiguration) Validate() error {
+	if g == nil {
+		return nil
+	}
+
+	if g.Count <= 0 {
+		return xerrors.Errorf("gpu count must be greater than zero")
+	}
+	if errs := validation.IsQualifiedName(string(g.GetResourceName())); len(errs) > 0 {
+		return xerrors.Errorf("gpu resource name \"%s\" is invalid: %v", g.ResourceName, errs)
+	}
+	for k, v := range g.NodeSelector {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return xerrors.Errorf("gpu node selector key \"%s\" is invalid: %v", k, errs)
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return xerrors.Errorf("gpu node selector value \"%s\" is invalid: %v", v, errs)
+		}
+	}
+	return nil
+}
+
+// SidecarConfiguration configures a container which runs next to the workspace container.
+// Sidecars share the network namespace of the workspace, but none of its volumes.
+type SidecarConfiguration struct {
+	// Image is the container image of the sidecar
+	Image string `json:"image"`
+	// Command overrides the entrypoint of the image
+	Command []string `json:"command,omitempty"`
+	// Args are passed to the entrypoint
+	Args []string `json:"args,omitempty"`
+	// Env is the environment of the sidecar
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Container configures the resources of the sidecar. CPU and memory limits are required, such that a
+	// sidecar cannot take resources away from the workspace.
+	Container ContainerConfiguration `json:"container"`
+}
+
+// ContainerResources returns the resources of the sidecar container
+func (s *SidecarConfiguration) ContainerResources() (corev1.ResourceRequirements, error) {
+	limits, err := s.Container.Limits.ResourceList()
+	if err != nil {
+		return corev1.ResourceRequirements{}, xerrors.Errorf("cannot parse sidecar limits: %w", err)
+	}
+	requests, err := s.Container.Requests.ResourceList()
+	if err != nil {
+		return corev1.ResourceRequirements{}, xerrors.Errorf("cannot parse sidecar requests: %w", err)
+	}
+	return corev1.ResourceRequirements{Limits: limits, Requests: requests}, nil
+}
+
+// SidecarContainerName returns the name of the container a sidecar runs in
+func SidecarContainerName(name string) string {
+	return "sidecar-" + name
+}
+
+// Validate validates a sidecar configuration
+func (s *SidecarConfiguration) Validate() error {
+	if s.Image == "" {
+		return xerrors.Errorf("image is required")
+	}
+	if err := s.Container.Validate(); err != nil {
+		return err
+	}
+	limits := s.Container.Limits
+	if limits == nil || limits.CPU == nil || limits.CPU.BurstLimit == "" || limits.Memory == "" {
+		return xerrors.Errorf("cpu and memory limits are required")
+	}
+	return nil
+}
+
+// DefaultPodTemplatePatchKey is the ConfigMap key a pod template patch is read from by default
+const DefaultPodTemplatePatchKey = "patch.yaml"
+
+// PodTemplatePatchConfiguration references a ConfigMap holding a strategic merge patch for workspace pods,
+// e.g. to set tolerations, a runtime class or additional volumes. The patch is applied after the pod templates.
+type PodTemplatePatchConfiguration struct {
+	// ConfigMap is the name of the ConfigMap in the workspace namespace
+	ConfigMap string `json:"configMap"`
+	// Key is the key of the ConfigMap which holds the patch as YAML or JSON. Defaults to patch.yaml.
+	Key string `json:"key,omitempty"`
+}
+
+// GetKey returns the ConfigMap key the patch is read from
+func (p *PodTemplatePatchConfiguration) GetKey() string {
+	if p.Key == "" {
+		return DefaultPodTemplatePatchKey
+	}
+	return p.Key
+}
+
+// Validate validates a pod template patch configuration
+func (p *PodTemplatePatchConfiguration) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if errs := validation.IsDNS1123Subdomain(p.ConfigMap); len(errs) > 0 {
+		return xerrors.Errorf("pod template patch config map \"%s\" is invalid: %v", p.ConfigMap, errs)
+	}
+	if errs := validation.IsConfigMapKey(p.GetKey()); len(errs) > 0 {
+		return xerrors.Errorf("pod template patch key \"%s\" is invalid: %v", p.Key, errs)
+	}
+	return nil
+}
+
+// WorkspaceClassScheduling configures the scheduling of workspace pods in addition to the
+// node affinity ws-manager requires for all workspaces.
+type WorkspaceClassScheduling struct {
+	// NodeSelectorTerms restrict the nodes of this class. A node must match at least one of the terms.
+	// Every term is combined with the requirements ws-manager has towards workspace nodes.
+	NodeSelectorTerms []corev1.NodeSelectorTerm `json:"nodeSelectorTerms,omitempty"`
+	// PreferredNodeAffinity lets the scheduler prefer some nodes of this class over others.
+	PreferredNodeAffinity []corev1.PreferredSchedulingTerm `json:"preferredNodeAffinity,omitempty"`
+	// PodAffinity is used as pod affinity of the workspace pods
+	PodAffinity *corev1.PodAffinity `json:"podAffinity,omitempty"`
+	// PodAntiAffinity is used as pod anti-affinity of the workspace pods
+	PodAntiAffinity *corev1.PodAntiAffinity `json:"podAntiAffinity,omitempty"`
+	// TopologySpreadConstraints are added to the workspace pods
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// Validate validates a workspace class scheduling configuration
+func (s *WorkspaceClassScheduling) Validate() error {
+	if s == nil {
+		return nil
+	}
+
+	for i, term := range s.NodeSelectorTerms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			return xerrors.Errorf("nodeSelectorTerms[%d] is empty", i)
+		}
+	}
+	for i, c := range s.TopologySpreadConstraints {
+		if c.MaxSkew <= 0 {
+			return xerrors.Errorf("topologySpreadConstraints[%d]: maxSkew must be greater than zero", i)
+		}
+		if c.TopologyKey == "" {
+			return xerrors.Errorf("topologySpreadConstraints[%d]: topologyKey is required", i)
+		}
+		if c.WhenUnsatisfiable != corev1.DoNotSchedule && c.WhenUnsatisfiable != corev1.ScheduleAnyway {
+			return xerrors.Errorf("topologySpreadConstraints[%d]: whenUnsatisfiable must be %s or %s", i, corev1.DoNotSchedule, corev1.ScheduleAnyway)
+		}
+	}
+	return nil
 }
 
 // WorkspaceTimeoutConfiguration configures the timeout behaviour of workspaces
@@ -201,6 +543,10 @@ type WorkspacePodTemplateConfiguration struct {
 	ProbePath string `json:"probePath,omitempty"`
 	// ImagebuildPath is a path to an additional workspace pod template YAML file for imagebuild workspaces
 	ImagebuildPath string `json:"imagebuildPath,omitempty"`
+	// NetworkPolicyPath is a path to a NetworkPolicy YAML file. If set, every workspace of the class gets a NetworkPolicy
+	// rendered from this template instead of being subject to the cluster-wide workspace policy. The template must
+	// therefore allow all traffic a workspace needs, e.g. from ws-proxy. Its pod selector is ignored.
+	NetworkPolicyPath string `json:"networkPolicyPath,omitempty"`
 }
 
 // WorkspaceDaemonConfiguration configures our connection to the workspace sync daemons runnin on the nodes
@@ -218,6 +564,321 @@ type WorkspaceDaemonConfiguration struct {
 	} `json:"tls"`
 }
 
+// AdmissionFailurePolicy decides what happens to a workspace start if the admission endpoint cannot be reached
+type AdmissionFailurePolicy string
+
+const (
+	// AdmissionFailurePolicyFail rejects the start if the admission endpoint cannot be reached
+	AdmissionFailurePolicyFail AdmissionFailurePolicy = "Fail"
+	// AdmissionFailurePolicyIgnore starts the workspace as requested if the admission endpoint cannot be reached
+	AdmissionFailurePolicyIgnore AdmissionFailurePolicy = "Ignore"
+)
+
+// DefaultStartAdmissionTimeout is how long ws-manager waits for an admission decision unless configured otherwise
+const DefaultStartAdmissionTimeout = 5 * time.Second
+
+// StartAdmissionConfiguration configures the webhook ws-manager consults before it starts a workspace
+type StartAdmissionConfiguration struct {
+	// URL is the HTTP(S) endpoint the start requests are POSTed to
+	URL string `json:"url"`
+	// CA is the path to the certificate authority which signed the certificate of the endpoint.
+	// Defaults to the system certificate pool.
+	CA string `json:"ca,omitempty"`
+	// Timeout limits how long ws-manager waits for a decision. Defaults to 5s.
+	Timeout util.Duration `json:"timeout,omitempty"`
+	// FailurePolicy decides whether workspaces start if the endpoint fails. Defaults to Fail.
+	FailurePolicy AdmissionFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// GetTimeout returns the configured timeout or DefaultStartAdmissionTimeout
+func (a *StartAdmissionConfiguration) GetTimeout() time.Duration {
+	if a.Timeout == 0 {
+		return DefaultStartAdmissionTimeout
+	}
+	return time.Duration(a.Timeout)
+}
+
+// GetFailurePolicy returns the configured failure policy or AdmissionFailurePolicyFail
+func (a *StartAdmissionConfiguration) GetFailurePolicy() AdmissionFailurePolicy {
+	if a.FailurePolicy == "" {
+		return AdmissionFailurePolicyFail
+	}
+	return a.FailurePolicy
+}
+
+// Validate validates the start admission configuration
+func (a *StartAdmissionConfiguration) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	return validateWebhook(a.URL, a.Timeout, a.GetFailurePolicy())
+}
+
+// validateWebhook validates what the start admission and the start policy configuration have in common
+func validateWebhook(rawURL string, timeout util.Duration, failurePolicy AdmissionFailurePolicy) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return xerrors.Errorf("url: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return xerrors.Errorf("url must be an absolute http or https URL")
+	}
+	if timeout < 0 {
+		return xerrors.Errorf("timeout must not be negative")
+	}
+	if failurePolicy != AdmissionFailurePolicyFail && failurePolicy != AdmissionFailurePolicyIgnore {
+		return xerrors.Errorf("failurePolicy must be %s or %s", AdmissionFailurePolicyFail, AdmissionFailurePolicyIgnore)
+	}
+	return nil
+}
+
+// DefaultStartPolicyTimeout is how long ws-manager waits for a policy decision unless configured otherwise
+const DefaultStartPolicyTimeout = 2 * time.Second
+
+// StartPolicyConfiguration configures the Open Policy Agent decision ws-manager evaluates before it starts a workspace.
+// Only OPA is supported: ws-manager POSTs the input to the data API of the decision and expects OPA's response format.
+// Other policy engines, e.g. CEL expressions, have to be wrapped by an OPA-compatible endpoint.
+type StartPolicyConfiguration struct {
+	// URL is the OPA data API endpoint of the decision, e.g. http://opa:8181/v1/data/gitpod/workspace/start
+	URL string `json:"url"`
+	// CA is the path to the certificate authority which signed the certificate of OPA.
+	// Defaults to the system certificate pool.
+	CA string `json:"ca,omitempty"`
+	// Timeout limits how long ws-manager waits for a decision. Defaults to 2s.
+	Timeout util.Duration `json:"timeout,omitempty"`
+	// FailurePolicy decides whether workspaces start if the decision cannot be evaluated. Defaults to Fail.
+	FailurePolicy AdmissionFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// GetTimeout returns the configured timeout or DefaultStartPolicyTimeout
+func (p *StartPolicyConfiguration) GetTimeout() time.Duration {
+	if p.Timeout == 0 {
+		return DefaultStartPolicyTimeout
+	}
+	return time.Duration(p.Timeout)
+}
+
+// GetFailurePolicy returns the configured failure policy or AdmissionFailurePolicyFail
+func (p *StartPolicyConfiguration) GetFailurePolicy() AdmissionFailurePolicy {
+	if p.FailurePolicy == "" {
+		return AdmissionFailurePolicyFail
+	}
+	return p.FailurePolicy
+}
+
+// Validate validates the start policy configuration
+func (p *StartPolicyConfiguration) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	return validateWebhook(p.URL, p.Timeout, p.GetFailurePolicy())
+}
+
+// PrebuildQueueConfiguration configures the limits of the prebuild queue. A limit of zero means there is no limit.
+type PrebuildQueueConfiguration struct {
+	// MaxConcurrent is the number of prebuilds which can run at the same time
+	MaxConcurrent int `json:"maxConcurrent,omitempty"`
+	// MaxConcurrentPerProject is the number of prebuilds of the same project which can run at the same time
+	MaxConcurrentPerProject int `json:"maxConcurrentPerProject,omitempty"`
+}
+
+// Validate validates the prebuild queue configuration
+func (q *PrebuildQueueConfiguration) Validate() error {
+	if q == nil {
+		return nil
+	}
+
+	if q.MaxConcurrent < 0 {
+		return xerrors.Errorf("maxConcurrent must not be negative")
+	}
+	if q.MaxConcurrentPerProject < 0 {
+		return xerrors.Errorf("maxConcurrentPerProject must not be negative")
+	}
+	return nil
+}
+
+// StartQueueConfiguration configures the capacity of the cluster. Prebuilds count towards the capacity,
+// but do not wait in the start queue - use the prebuild queue to limit them.
+type StartQueueConfiguration struct {
+	// MaxConcurrent is the number of workspaces which can run at the same time
+	MaxConcurrent int `json:"maxConcurrent"`
+}
+
+// Validate validates the start queue configuration
+func (q *StartQueueConfiguration) Validate() error {
+	if q == nil {
+		return nil
+	}
+
+	if q.MaxConcurrent <= 0 {
+		return xerrors.Errorf("maxConcurrent must be greater than zero")
+	}
+	return nil
+}
+
+// DefaultStartRateLimitKeyCacheSize is the number of owners whose rate limit we track unless configured otherwise
+const DefaultStartRateLimitKeyCacheSize = 10000
+
+// StartRateLimitConfiguration configures a token bucket per owner which workspace starts draw from
+type StartRateLimitConfiguration struct {
+	// BucketSize is the number of workspaces an owner can start in a burst
+	BucketSize uint `json:"bucketSize"`
+	// RefillInterval is the time it takes to add a single token to the bucket of an owner,
+	// i.e. the rate at which an owner can start workspaces once the burst is used up.
+	RefillInterval util.Duration `json:"refillInterval"`
+	// KeyCacheSize is the max number of owners whose buckets are kept in a LRU cache
+	KeyCacheSize uint `json:"keyCacheSize,omitempty"`
+}
+
+// GetKeyCacheSize returns the configured key cache size or DefaultStartRateLimitKeyCacheSize
+func (r *StartRateLimitConfiguration) GetKeyCacheSize() int {
+	if r.KeyCacheSize == 0 {
+		return DefaultStartRateLimitKeyCacheSize
+	}
+	return int(r.KeyCacheSize)
+}
+
+// Validate validates the start rate limit configuration
+func (r *StartRateLimitConfiguration) Validate() error {
+	if r == nil {
+		return nil
+	}
+
+	if r.BucketSize == 0 {
+		return xerrors.Errorf("bucketSize must be greater than zero")
+	}
+	if r.RefillInterval <= 0 {
+		return xerrors.Errorf("refillInterval must be greater than zero")
+	}
+	return nil
+}
+
+// LeaderElectionConfiguration configures the lease the replicas of ws-manager compete for. Only the leader runs the
+// controllers which act on workspaces, all replicas serve the gRPC API. Durations which are not set default to the
+// ones of controller-runtime.
+type LeaderElectionConfiguration struct {
+	// LeaseDuration is how long the other replicas wait before they take over the lease of a leader which stopped renewing it
+	LeaseDuration util.Duration `json:"leaseDuration" default:"15s"`
+	// RenewDeadline is how long the leader tries to renew its lease before it gives up leadership
+	RenewDeadline util.Duration `json:"renewDeadline" default:"10s"`
+	// RetryPeriod is the interval in which replicas try to acquire or renew the lease
+	RetryPeriod util.Duration `json:"retryPeriod" default:"2s"`
+}
+
+// Validate validates the leader election configuration
+func (c *LeaderElectionConfiguration) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.RetryPeriod <= 0 {
+		return xerrors.Errorf("retryPeriod must be greater than zero")
+	}
+	if c.RenewDeadline <= c.RetryPeriod {
+		return xerrors.Errorf("renewDeadline must be greater than retryPeriod")
+	}
+	if c.LeaseDuration <= c.RenewDeadline {
+		return xerrors.Errorf("leaseDuration must be greater than renewDeadline")
+	}
+	return nil
+}
+
+// NodePreemptionConfiguration configures how ws-manager recognises nodes which are about to be preempted, e.g. spot
+// or preemptible VMs. Termination notices are typically surfaced by a node termination handler DaemonSet, which
+// either sets a node condition or taints the node.
+type NodePreemptionConfiguration struct {
+	// NodeConditions are the node condition types which mark a node as being preempted when they are true
+	NodeConditions []string `json:"nodeConditions,omitempty"`
+	// Taints are the keys of taints which mark a node as being preempted, e.g. aws-node-termination-handler/spot-itn
+	Taints []string `json:"taints,omitempty"`
+}
+
+// Validate validates the node preemption configuration
+func (p *NodePreemptionConfiguration) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	if len(p.NodeConditions) == 0 && len(p.Taints) == 0 {
+		return xerrors.Errorf("at least one node condition or taint is required")
+	}
+	for _, t := range p.Taints {
+		if errs := validation.IsQualifiedName(t); len(errs) > 0 {
+			return xerrors.Errorf("taint \"%s\" is invalid: %v", t, errs)
+		}
+	}
+	return nil
+}
+
+// DefaultCompletionHookTimeout is how long ws-manager waits for a completion hook unless configured otherwise
+const DefaultCompletionHookTimeout = 5 * time.Second
+
+// CompletionHookConfiguration configures an endpoint which is notified once headless workspaces complete.
+// Exactly one of URL and GRPC must be set.
+type CompletionHookConfiguration struct {
+	// Name identifies the hook in logs and metrics
+	Name string `json:"name"`
+	// URL is the HTTP(S) endpoint the HeadlessWorkspaceCompletion is POSTed to in its protobuf JSON encoding
+	URL string `json:"url,omitempty"`
+	// GRPC is the address of a HeadlessCompletionHook gRPC service, e.g. ci-status.default.svc:8080
+	GRPC string `json:"grpc,omitempty"`
+	// CA is the path to the certificate authority which signed the certificate of the endpoint. HTTPS endpoints
+	// default to the system certificate pool, gRPC connections are unencrypted unless a CA is configured.
+	CA string `json:"ca,omitempty"`
+	// Timeout limits how long ws-manager waits for the hook. Defaults to 5s.
+	Timeout util.Duration `json:"timeout,omitempty"`
+	// WorkspaceTypes limits the hook to headless workspaces of these types, e.g. Prebuild. Defaults to all headless types.
+	WorkspaceTypes []string `json:"workspaceTypes,omitempty"`
+}
+
+// GetTimeout returns the configured timeout or DefaultCompletionHookTimeout
+func (h *CompletionHookConfiguration) GetTimeout() time.Duration {
+	if h.Timeout == 0 {
+		return DefaultCompletionHookTimeout
+	}
+	return time.Duration(h.Timeout)
+}
+
+// Validate validates the completion hook configuration
+func (h *CompletionHookConfiguration) Validate() error {
+	if h == nil {
+		return xerrors.Errorf("configuration is missing")
+	}
+
+	if h.Name == "" {
+		return xerrors.Errorf("name is required")
+	}
+	switch {
+	case h.URL != "" && h.GRPC != "":
+		return xerrors.Errorf("url and grpc are mutually exclusive")
+	case h.URL != "":
+		u, err := url.Parse(h.URL)
+		if err != nil {
+			return xerrors.Errorf("url: %w", err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return xerrors.Errorf("url must be an absolute http or https URL")
+		}
+	case h.GRPC != "":
+		if _, _, err := net.SplitHostPort(h.GRPC); err != nil {
+			return xerrors.Errorf("grpc: %w", err)
+		}
+	default:
+		return xerrors.Errorf("either url or grpc is required")
+	}
+	if h.Timeout < 0 {
+		return xerrors.Errorf("timeout must not be negative")
+	}
+	for _, tpe := range h.WorkspaceTypes {
+		if tpe != "Prebuild" && tpe != "ImageBuild" {
+			return xerrors.Errorf("workspace type \"%s\" is not headless", tpe)
+		}
+	}
+	return nil
+}
+
 // Validate validates the configuration to catch issues during startup and not at runtime
 func (c *Configuration) Validate() error {
 	err := ozzo.ValidateStruct(&c.Timeouts,
@@ -248,6 +909,53 @@ func (c *Configuration) Validate() error {
 		return err
 	}
 
+	if err := c.StartAdmission.Validate(); err != nil {
+		return xerrors.Errorf("startAdmission: %w", err)
+	}
+
+	if err := c.StartPolicy.Validate(); err != nil {
+		return xerrors.Errorf("startPolicy: %w", err)
+	}
+
+	if err := c.PrebuildQueue.Validate(); err != nil {
+		return xerrors.Errorf("prebuildQueue: %w", err)
+	}
+
+	if err := c.StartQueue.Validate(); err != nil {
+		return xerrors.Errorf("startQueue: %w", err)
+	}
+
+	if err := c.StartRateLimit.Validate(); err != nil {
+		return xerrors.Errorf("startRateLimit: %w", err)
+	}
+
+	if err := c.NodePreemption.Validate(); err != nil {
+		return xerrors.Errorf("nodePreemption: %w", err)
+	}
+
+	hooks := make(map[string]struct{}, len(c.HeadlessCompletionHooks))
+	for i, hook := range c.HeadlessCompletionHooks {
+		if err := hook.Validate(); err != nil {
+			return xerrors.Errorf("headlessCompletionHooks[%d]: %w", i, err)
+		}
+		if _, exists := hooks[hook.Name]; exists {
+			return xerrors.Errorf("headlessCompletionHooks[%d]: name %s is not unique", i, hook.Name)
+		}
+		hooks[hook.Name] = struct{}{}
+	}
+
+	for name, sidecar := range c.SidecarCatalog {
+		if errs := validation.IsDNS1123Label(SidecarContainerName(name)); len(errs) > 0 {
+			return xerrors.Errorf("sidecar name \"%s\" is invalid: %v", name, errs)
+		}
+		if sidecar == nil {
+			return xerrors.Errorf("sidecar %s: configuration is missing", name)
+		}
+		if err := sidecar.Validate(); err != nil {
+			return xerrors.Errorf("sidecar %s: %w", name, err)
+		}
+	}
+
 	if _, ok := c.WorkspaceClasses[DefaultWorkspaceClass]; !ok {
 		return xerrors.Errorf("missing \"%s\" workspace class", DefaultWorkspaceClass)
 	}
@@ -258,12 +966,33 @@ func (c *Configuration) Validate() error {
 		if err := class.Container.Validate(); err != nil {
 			return xerrors.Errorf("workspace class %s: %w", name, err)
 		}
+		if err := class.Scheduling.Validate(); err != nil {
+			return xerrors.Errorf("workspace class %s: %w", name, err)
+		}
+		if err := class.GPU.Validate(); err != nil {
+			return xerrors.Errorf("workspace class %s: %w", name, err)
+		}
+		if err := class.PodTemplatePatch.Validate(); err != nil {
+			return xerrors.Errorf("workspace class %s: %w", name, err)
+		}
+		if err := class.SecurityProfile.Validate(); err != nil {
+			return xerrors.Errorf("workspace class %s: %w", name, err)
+		}
+		if class.BackupGracePeriod != nil && *class.BackupGracePeriod <= 0 {
+			return xerrors.Errorf("workspace class %s: backupGracePeriod must be greater than zero", name)
+		}
+		for _, sidecar := range class.Sidecars {
+			if _, ok := c.SidecarCatalog[sidecar]; !ok {
+				return xerrors.Errorf("workspace class %s: sidecar %s is not in the sidecar catalog", name, sidecar)
+			}
+		}
 
 		err = ozzo.ValidateStruct(&class.Templates,
 			ozzo.Field(&class.Templates.DefaultPath, validPodTemplate),
 			ozzo.Field(&class.Templates.PrebuildPath, validPodTemplate),
 			ozzo.Field(&class.Templates.ProbePath, validPodTemplate),
 			ozzo.Field(&class.Templates.RegularPath, validPodTemplate),
+			ozzo.Field(&class.Templates.NetworkPolicyPath, validNetworkPolicyTemplate),
 		)
 		if err != nil {
 			return xerrors.Errorf("workspace class %s: %w", name, err)
@@ -283,6 +1012,16 @@ var validPodTemplate = ozzo.By(func(o interface{}) error {
 	return err
 })
 
+var validNetworkPolicyTemplate = ozzo.By(func(o interface{}) error {
+	s, ok := o.(string)
+	if !ok {
+		return xerrors.Errorf("field should be string")
+	}
+
+	_, err := GetNetworkPolicyTemplate(s)
+	return err
+})
+
 var validWorkspaceURLTemplate = ozzo.By(func(o interface{}) error {
 	s, ok := o.(string)
 	if !ok {
@@ -370,12 +1109,48 @@ var validResourceLimitConfig = ozzo.By(func(o interface{}) error {
 			return xerrors.Errorf("cannot parse burst limit CPU quantity: %w", err)
 		}
 	}
+	if rc.CPU.BurstCredit != "" {
+		_, err := time.ParseDuration(rc.CPU.BurstCredit)
+		if err != nil {
+			return xerrors.Errorf("cannot parse CPU burst credit: %w", err)
+		}
+	}
+	if rc.Network != nil && rc.Network.Egress != "" {
+		_, err := resource.ParseQuantity(rc.Network.Egress)
+		if err != nil {
+			return xerrors.Errorf("cannot parse egress network quantity: %w", err)
+		}
+	}
+	if rc.Network != nil && rc.Network.Ingress != "" {
+		_, err := resource.ParseQuantity(rc.Network.Ingress)
+		if err != nil {
+			return xerrors.Errorf("cannot parse ingress network quantity: %w", err)
+		}
+	}
 	if rc.Memory != "" {
 		_, err := resource.ParseQuantity(rc.Memory)
 		if err != nil {
 			return xerrors.Errorf("cannot parse Memory quantity: %w", err)
 		}
 	}
+	if rc.MemoryControl != nil && rc.MemoryControl.High != "" {
+		high, err := resource.ParseQuantity(rc.MemoryControl.High)
+		if err != nil {
+			return xerrors.Errorf("cannot parse memory high quantity: %w", err)
+		}
+		if rc.Memory != "" && high.Cmp(resource.MustParse(rc.Memory)) >= 0 {
+			return xerrors.Errorf("memory high must be below the memory limit")
+		}
+	}
+	if rc.MemoryControl != nil && rc.MemoryControl.Swap != "" {
+		swap, err := resource.ParseQuantity(rc.MemoryControl.Swap)
+		if err != nil {
+			return xerrors.Errorf("cannot parse memory swap quantity: %w", err)
+		}
+		if swap.Sign() < 0 {
+			return xerrors.Errorf("memory swap must not be negative")
+		}
+	}
 	if rc.EphemeralStorage != "" {
 		_, err := resource.ParseQuantity(rc.EphemeralStorage)
 		if err != nil {
@@ -435,6 +1210,29 @@ func GetWorkspacePodTemplate(filename string) (*corev1.Pod, error) {
 		return nil, nil
 	}
 
+	var res corev1.Pod
+	err := readTemplate(filename, "pod template", &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetNetworkPolicyTemplate parses a network policy template YAML file. Returns nil if path is empty.
+func GetNetworkPolicyTemplate(filename string) (*networkingv1.NetworkPolicy, error) {
+	if filename == "" {
+		return nil, nil
+	}
+
+	var res networkingv1.NetworkPolicy
+	err := readTemplate(filename, "network policy template", &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func readTemplate(filename, kind string, res interface{}) error {
 	tpr := os.Getenv("TELEPRESENCE_ROOT")
 	if tpr != "" {
 		filename = filepath.Join(tpr, filename)
@@ -442,18 +1240,16 @@ func GetWorkspacePodTemplate(filename string) (*corev1.Pod, error) {
 
 	tpl, err := FS.Open(filename)
 	if err != nil {
-		return nil, xerrors.Errorf("cannot read pod template: %w", err)
+		return xerrors.Errorf("cannot read %s: %w", kind, err)
 	}
 	defer tpl.Close()
 
-	var res corev1.Pod
 	decoder := yaml.NewYAMLOrJSONDecoder(tpl, 4096)
-	err = decoder.Decode(&res)
+	err = decoder.Decode(res)
 	if err != nil {
-		return nil, xerrors.Errorf("cannot unmarshal pod template: %w", err)
+		return xerrors.Errorf("cannot unmarshal %s: %w", kind, err)
 	}
-
-	return &res, nil
+	return nil
 }
 
 // RenderWorkspaceURL takes a workspace URL template and renders it
@@ -520,6 +1316,10 @@ type ResourceLimitConfiguration struct {
 	Memory           string            `json:"memory"`
 	EphemeralStorage string            `json:"ephemeral-storage"`
 	Storage          string            `json:"storage,omitempty"`
+	// Network limits the bandwidth of workspaces, enforced by ws-daemon
+	Network *NetworkResourceLimit `json:"network,omitempty"`
+	// MemoryControl throttles workspaces before they reach their memory limit and lets them swap, enforced by ws-daemon
+	MemoryControl *MemoryResourceLimit `json:"memoryControl,omitempty"`
 }
 
 func (r *ResourceLimitConfiguration) ResourceList() (corev1.ResourceList, error) {
@@ -565,6 +1365,22 @@ func (r *ResourceLimitConfiguration) StorageQuantity() (resource.Quantity, error
 type CpuResourceLimit struct {
 	MinLimit   string `json:"min"`
 	BurstLimit string `json:"burst"`
+	// BurstCredit is the CPU time a workspace can save up for bursts while it is under CPU pressure, e.g. 10m
+	BurstCredit string `json:"burstCredit,omitempty"`
+}
+
+// NetworkResourceLimit limits the network bandwidth of a workspace in bits per second, e.g. 100M
+type NetworkResourceLimit struct {
+	Egress  string `json:"egress,omitempty"`
+	Ingress string `json:"ingress,omitempty"`
+}
+
+// MemoryResourceLimit configures how a workspace copes with memory spikes. High is the memory usage above which
+// the workspace is throttled and reclaimed, and must be below the memory limit. Swap is the amount of the node's
+// zram-backed swap the workspace can use, so that a transient spike does not OOM kill the workspace.
+type MemoryResourceLimit struct {
+	High string `json:"high,omitempty"`
+	Swap string `json:"swap,omitempty"`
 }
 
 type MaintenanceConfig struct {
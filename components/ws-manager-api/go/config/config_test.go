@@ -8,6 +8,9 @@ import (
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+
+	commoncfg "github.com/gitpod-io/gitpod/common-go/config"
 	"github.com/gitpod-io/gitpod/common-go/util"
 )
 
@@ -65,6 +68,324 @@ func TestValidate(t *testing.T) {
 			}),
 			Expectation: `workspace class name "not/a/valid/name" is invalid: [a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')]`,
 		},
+		{
+			Name: "valid backup grace period",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				gracePeriod := util.Duration(20 * time.Minute)
+				c.WorkspaceClasses[DefaultWorkspaceClass].BackupGracePeriod = &gracePeriod
+			}),
+		},
+		{
+			Name: "zero backup grace period",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				gracePeriod := util.Duration(0)
+				c.WorkspaceClasses[DefaultWorkspaceClass].BackupGracePeriod = &gracePeriod
+			}),
+			Expectation: "workspace class " + DefaultWorkspaceClass + ": backupGracePeriod must be greater than zero",
+		},
+		{
+			Name: "valid network limits",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:     &CpuResourceLimit{},
+					Network: &NetworkResourceLimit{Egress: "100M", Ingress: "1G"},
+				}
+			}),
+		},
+		{
+			Name: "invalid network limits",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:     &CpuResourceLimit{},
+					Network: &NetworkResourceLimit{Egress: "100 Mbit"},
+				}
+			}),
+			Expectation: "workspace class g1-standard: limits: cannot parse egress network quantity: quantities must match the regular expression '^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'.",
+		},
+		{
+			Name: "valid memory control",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:           &CpuResourceLimit{},
+					Memory:        "8Gi",
+					MemoryControl: &MemoryResourceLimit{High: "7Gi", Swap: "4Gi"},
+				}
+			}),
+		},
+		{
+			Name: "memory high above limit",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:           &CpuResourceLimit{},
+					Memory:        "8Gi",
+					MemoryControl: &MemoryResourceLimit{High: "8Gi"},
+				}
+			}),
+			Expectation: "workspace class g1-standard: limits: memory high must be below the memory limit.",
+		},
+		{
+			Name: "negative memory swap",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU:           &CpuResourceLimit{},
+					MemoryControl: &MemoryResourceLimit{Swap: "-1Gi"},
+				}
+			}),
+			Expectation: "workspace class g1-standard: limits: memory swap must not be negative.",
+		},
+		{
+			Name: "invalid CPU burst credit",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Container.Limits = &ResourceLimitConfiguration{
+					CPU: &CpuResourceLimit{BurstCredit: "10 minutes"},
+				}
+			}),
+			Expectation: "workspace class g1-standard: limits: cannot parse CPU burst credit: time: unknown unit \" minutes\" in duration \"10 minutes\".",
+		},
+		{
+			Name: "valid scheduling",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Scheduling = &WorkspaceClassScheduling{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{
+						{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "gitpod.io/pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"customer-a"}}}},
+					},
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway},
+					},
+				}
+			}),
+		},
+		{
+			Name: "empty node selector term",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Scheduling = &WorkspaceClassScheduling{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{}},
+				}
+			}),
+			Expectation: `workspace class g1-standard: nodeSelectorTerms[0] is empty`,
+		},
+		{
+			Name: "invalid topology spread constraint",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Scheduling = &WorkspaceClassScheduling{
+					TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+						{MaxSkew: 1, WhenUnsatisfiable: corev1.ScheduleAnyway},
+					},
+				}
+			}),
+			Expectation: `workspace class g1-standard: topologySpreadConstraints[0]: topologyKey is required`,
+		},
+		{
+			Name: "valid gpu",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].GPU = &GPUConfiguration{
+					Count:        1,
+					NodeSelector: map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-40GB"},
+				}
+			}),
+		},
+		{
+			Name: "gpu without count",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].GPU = &GPUConfiguration{}
+			}),
+			Expectation: `workspace class g1-standard: gpu count must be greater than zero`,
+		},
+		{
+			Name: "valid security profile",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].SecurityProfile = &SecurityProfileConfiguration{
+					Seccomp:  "gitpod/untrusted.json",
+					AppArmor: "gitpod-untrusted",
+				}
+			}),
+		},
+		{
+			Name: "seccomp profile outside the profile directory",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].SecurityProfile = &SecurityProfileConfiguration{Seccomp: "../untrusted.json"}
+			}),
+			Expectation: `workspace class g1-standard: seccomp profile "../untrusted.json" must be a path within the seccomp profile directory`,
+		},
+		{
+			Name: "invalid AppArmor profile name",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].SecurityProfile = &SecurityProfileConfiguration{AppArmor: "localhost/untrusted"}
+			}),
+			Expectation: `workspace class g1-standard: AppArmor profile name "localhost/untrusted" is invalid`,
+		},
+		{
+			Name: "valid pod template patch",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].PodTemplatePatch = &PodTemplatePatchConfiguration{ConfigMap: "kata-patch"}
+			}),
+		},
+		{
+			Name: "pod template patch with invalid key",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].PodTemplatePatch = &PodTemplatePatchConfiguration{ConfigMap: "kata-patch", Key: "patch/yaml"}
+			}),
+			Expectation: `workspace class g1-standard: pod template patch key "patch/yaml" is invalid: [a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')]`,
+		},
+		{
+			Name: "missing network policy template",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Templates.NetworkPolicyPath = "/does/not/exist.yaml"
+			}),
+			Expectation: `workspace class g1-standard: networkPolicyPath: cannot read network policy template: open /does/not/exist.yaml: no such file or directory.`,
+		},
+		{
+			Name: "valid sidecar",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.SidecarCatalog = map[string]*SidecarConfiguration{
+					"cloud-sql-proxy": {
+						Image: "gcr.io/cloud-sql-connectors/cloud-sql-proxy:2.8.1",
+						Container: ContainerConfiguration{
+							Limits: &ResourceLimitConfiguration{CPU: &CpuResourceLimit{BurstLimit: "500m"}, Memory: "256Mi"},
+						},
+					},
+				}
+				c.WorkspaceClasses[DefaultWorkspaceClass].Sidecars = []string{"cloud-sql-proxy"}
+			}),
+		},
+		{
+			Name: "sidecar without limits",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.SidecarCatalog = map[string]*SidecarConfiguration{
+					"vault-agent": {Image: "hashicorp/vault:1.15"},
+				}
+			}),
+			Expectation: `sidecar vault-agent: cpu and memory limits are required`,
+		},
+		{
+			Name: "class with unknown sidecar",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.WorkspaceClasses[DefaultWorkspaceClass].Sidecars = []string{"vault-agent"}
+			}),
+			Expectation: `workspace class g1-standard: sidecar vault-agent is not in the sidecar catalog`,
+		},
+		{
+			Name: "valid start admission",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.StartAdmission = &StartAdmissionConfiguration{
+					URL:           "https://quota.example.com/admit",
+					Timeout:       util.Duration(2 * time.Second),
+					FailurePolicy: AdmissionFailurePolicyIgnore,
+				}
+			}),
+		},
+		{
+			Name: "start admission with relative URL",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.StartAdmission = &StartAdmissionConfiguration{URL: "/admit"}
+			}),
+			Expectation: `startAdmission: url must be an absolute http or https URL`,
+		},
+		{
+			Name: "start admission with unknown failure policy",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.StartAdmission = &StartAdmissionConfiguration{URL: "http://quota:8080", FailurePolicy: "Retry"}
+			}),
+			Expectation: `startAdmission: failurePolicy must be Fail or Ignore`,
+		},
+		{
+			Name: "valid start policy",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.StartPolicy = &StartPolicyConfiguration{URL: "http://opa:8181/v1/data/gitpod/workspace/start"}
+			}),
+		},
+		{
+			Name: "start policy with negative timeout",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.StartPolicy = &StartPolicyConfiguration{URL: "http://opa:8181/v1/data/gitpod/workspace/start", Timeout: util.Duration(-time.Second)}
+			}),
+			Expectation: `startPolicy: timeout must not be negative`,
+		},
+		{
+			Name: "valid prebuild queue",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.PrebuildQueue = &PrebuildQueueConfiguration{MaxConcurrent: 20, MaxConcurrentPerProject: 2}
+			}),
+		},
+		{
+			Name: "prebuild queue with negative limit",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.PrebuildQueue = &PrebuildQueueConfiguration{MaxConcurrentPerProject: -1}
+			}),
+			Expectation: `prebuildQueue: maxConcurrentPerProject must not be negative`,
+		},
+		{
+			Name: "valid start queue",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.StartQueue = &StartQueueConfiguration{MaxConcurrent: 100}
+			}),
+		},
+		{
+			Name: "start queue without capacity",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.StartQueue = &StartQueueConfiguration{}
+			}),
+			Expectation: `startQueue: maxConcurrent must be greater than zero`,
+		},
+		{
+			Name: "valid start rate limit",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.StartRateLimit = &StartRateLimitConfiguration{BucketSize: 10, RefillInterval: util.Duration(time.Minute)}
+			}),
+		},
+		{
+			Name: "start rate limit without refill interval",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.StartRateLimit = &StartRateLimitConfiguration{BucketSize: 10}
+			}),
+			Expectation: `startRateLimit: refillInterval must be greater than zero`,
+		},
+		{
+			Name: "valid node preemption",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.NodePreemption = &NodePreemptionConfiguration{Taints: []string{"aws-node-termination-handler/spot-itn"}}
+			}),
+		},
+		{
+			Name: "node preemption without signals",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.NodePreemption = &NodePreemptionConfiguration{}
+			}),
+			Expectation: `nodePreemption: at least one node condition or taint is required`,
+		},
+		{
+			Name: "valid completion hooks",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.HeadlessCompletionHooks = []*CompletionHookConfiguration{
+					{Name: "ci-status", URL: "https://ci.example.com/hooks/gitpod", WorkspaceTypes: []string{"Prebuild"}},
+					{Name: "cache-warmer", GRPC: "cache-warmer.default.svc:8080"},
+				}
+			}),
+		},
+		{
+			Name: "completion hook without endpoint",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.HeadlessCompletionHooks = []*CompletionHookConfiguration{{Name: "ci-status"}}
+			}),
+			Expectation: `headlessCompletionHooks[0]: either url or grpc is required`,
+		},
+		{
+			Name: "completion hook for regular workspaces",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.HeadlessCompletionHooks = []*CompletionHookConfiguration{{Name: "ci-status", GRPC: "ci:8080", WorkspaceTypes: []string{"Regular"}}}
+			}),
+			Expectation: `headlessCompletionHooks[0]: workspace type "Regular" is not headless`,
+		},
+		{
+			Name: "duplicate completion hook",
+			Cfg: fromValidConfig(func(c *Configuration) {
+				c.HeadlessCompletionHooks = []*CompletionHookConfiguration{
+					{Name: "ci-status", GRPC: "ci:8080"},
+					{Name: "ci-status", URL: "http://ci"},
+				}
+			}),
+			Expectation: `headlessCompletionHooks[1]: name ci-status is not unique`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
@@ -81,3 +402,112 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestLeaderElectionConfiguration(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Cfg         *LeaderElectionConfiguration
+		Expectation string
+	}{
+		{
+			Name: "not configured",
+		},
+		{
+			Name: "valid",
+			Cfg: &LeaderElectionConfiguration{
+				LeaseDuration: util.Duration(15 * time.Second),
+				RenewDeadline: util.Duration(10 * time.Second),
+				RetryPeriod:   util.Duration(2 * time.Second),
+			},
+		},
+		{
+			Name: "missing retry period",
+			Cfg: &LeaderElectionConfiguration{
+				LeaseDuration: util.Duration(15 * time.Second),
+				RenewDeadline: util.Duration(10 * time.Second),
+			},
+			Expectation: "retryPeriod must be greater than zero",
+		},
+		{
+			Name: "lease shorter than renew deadline",
+			Cfg: &LeaderElectionConfiguration{
+				LeaseDuration: util.Duration(5 * time.Second),
+				RenewDeadline: util.Duration(10 * time.Second),
+				RetryPeriod:   util.Duration(2 * time.Second),
+			},
+			Expectation: "leaseDuration must be greater than renewDeadline",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := test.Cfg.Validate()
+
+			var errMsg string
+			if err != nil {
+				errMsg = err.Error()
+			}
+
+			if errMsg != test.Expectation {
+				t.Errorf("unexpected validation result: expect \"%s\", got \"%s\"", test.Expectation, errMsg)
+			}
+		})
+	}
+}
+
+func TestLeaderElectionConfigurationDefaults(t *testing.T) {
+	cfg, err := commoncfg.Parse[LeaderElectionConfiguration]([]byte(`{"leaseDuration": "30s"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectation := LeaderElectionConfiguration{
+		LeaseDuration: util.Duration(30 * time.Second),
+		RenewDeadline: util.Duration(10 * time.Second),
+		RetryPeriod:   util.Duration(2 * time.Second),
+	}
+	if *cfg != expectation {
+		t.Errorf("unexpected configuration: expect %+v, got %+v", expectation, *cfg)
+	}
+}
+
+func TestCanResizeInPlace(t *testing.T) {
+	resources := func(class *WorkspaceClass) corev1.ResourceRequirements {
+		res, err := class.ContainerResources()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	small := &WorkspaceClass{Container: ContainerConfiguration{
+		Requests: &ResourceRequestConfiguration{CPU: "1", Memory: "2Gi", EphemeralStorage: "5Gi"},
+		Limits:   &ResourceLimitConfiguration{CPU: &CpuResourceLimit{BurstLimit: "2"}, Memory: "4Gi", EphemeralStorage: "5Gi"},
+	}}
+	large := &WorkspaceClass{Container: ContainerConfiguration{
+		Requests: &ResourceRequestConfiguration{CPU: "4", Memory: "8Gi", EphemeralStorage: "5Gi"},
+		Limits:   &ResourceLimitConfiguration{CPU: &CpuResourceLimit{BurstLimit: "8"}, Memory: "16Gi", EphemeralStorage: "5Gi"},
+	}}
+	moreStorage := &WorkspaceClass{Container: ContainerConfiguration{
+		Requests: &ResourceRequestConfiguration{CPU: "4", Memory: "8Gi", EphemeralStorage: "10Gi"},
+		Limits:   &ResourceLimitConfiguration{CPU: &CpuResourceLimit{BurstLimit: "8"}, Memory: "16Gi", EphemeralStorage: "10Gi"},
+	}}
+	gpu := &WorkspaceClass{Container: large.Container, GPU: &GPUConfiguration{Count: 1}}
+
+	tests := []struct {
+		Name        string
+		From, To    *WorkspaceClass
+		Expectation bool
+	}{
+		{Name: "cpu and memory", From: small, To: large, Expectation: true},
+		{Name: "ephemeral storage", From: small, To: moreStorage},
+		{Name: "add gpu", From: large, To: gpu},
+		{Name: "remove gpu", From: gpu, To: large},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := CanResizeInPlace(resources(test.From), resources(test.To))
+			if act != test.Expectation {
+				t.Errorf("unexpected result: expected %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
@@ -54,6 +54,31 @@ type WorkspaceManagerClient interface {
 	UpdateSSHKey(ctx context.Context, in *UpdateSSHKeyRequest, opts ...grpc.CallOption) (*UpdateSSHKeyResponse, error)
 	// describeCluster provides information about the cluster
 	DescribeCluster(ctx context.Context, in *DescribeClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error)
+	// relocateWorkspace stops a running workspace, backs up its content and restores it in a replacement pod on another node.
+	// The workspace is not reachable until the replacement pod runs. Meanwhile its status has the INTERRUPTED phase and the relocating condition.
+	RelocateWorkspace(ctx context.Context, in *RelocateWorkspaceRequest, opts ...grpc.CallOption) (*RelocateWorkspaceResponse, error)
+	// getWorkspaceResourceUsage streams the resource usage of a running workspace as reported by ws-daemon
+	GetWorkspaceResourceUsage(ctx context.Context, in *GetWorkspaceResourceUsageRequest, opts ...grpc.CallOption) (WorkspaceManager_GetWorkspaceResourceUsageClient, error)
+	// getWorkspaceDiskUsage returns the disk usage of a running workspace broken down by where the data lives, as reported by ws-daemon
+	GetWorkspaceDiskUsage(ctx context.Context, in *GetWorkspaceDiskUsageRequest, opts ...grpc.CallOption) (*GetWorkspaceDiskUsageResponse, error)
+	// updateWorkspaceClass moves a running workspace to another class, resizing its pod in place where possible
+	UpdateWorkspaceClass(ctx context.Context, in *UpdateWorkspaceClassRequest, opts ...grpc.CallOption) (*UpdateWorkspaceClassResponse, error)
+	// drainNode stops scheduling workspaces to a node and stops the workspaces running on it, streaming the progress of their backups
+	DrainNode(ctx context.Context, in *DrainNodeRequest, opts ...grpc.CallOption) (WorkspaceManager_DrainNodeClient, error)
+	// exportSnapshot takes a snapshot of a running workspace and pushes its content as OCI image to the registry configured in ws-daemon
+	ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (*ExportSnapshotResponse, error)
+	// getQueuePosition returns the position of a prebuild in the prebuild queue
+	GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest, opts ...grpc.CallOption) (*GetQueuePositionResponse, error)
+	// listSnapshots lists the snapshots of the workspaces which are still known to this cluster
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	// streamWorkspaceLogs streams the task output of a headless workspace, e.g. of an image build or prebuild, while it runs
+	StreamWorkspaceLogs(ctx context.Context, in *StreamWorkspaceLogsRequest, opts ...grpc.CallOption) (WorkspaceManager_StreamWorkspaceLogsClient, error)
+	// getLastActivity returns when a workspace was last marked active, overall and per source of the activity
+	GetLastActivity(ctx context.Context, in *GetLastActivityRequest, opts ...grpc.CallOption) (*GetLastActivityResponse, error)
+	// restoreSnapshot unpacks a snapshot or backup into a directory of a running workspace, without restarting it
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error)
+	// setRestartClass marks a stopped workspace to start with another workspace class the next time it starts
+	SetRestartClass(ctx context.Context, in *SetRestartClassRequest, opts ...grpc.CallOption) (*SetRestartClassResponse, error)
 }
 
 type workspaceManagerClient struct {
@@ -213,6 +238,183 @@ func (c *workspaceManagerClient) DescribeCluster(ctx context.Context, in *Descri
 	return out, nil
 }
 
+func (c *workspaceManagerClient) RelocateWorkspace(ctx context.Context, in *RelocateWorkspaceRequest, opts ...grpc.CallOption) (*RelocateWorkspaceResponse, error) {
+	out := new(RelocateWorkspaceResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/RelocateWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) GetWorkspaceResourceUsage(ctx context.Context, in *GetWorkspaceResourceUsageRequest, opts ...grpc.CallOption) (WorkspaceManager_GetWorkspaceResourceUsageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WorkspaceManager_ServiceDesc.Streams[1], "/wsman.WorkspaceManager/GetWorkspaceResourceUsage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workspaceManagerGetWorkspaceResourceUsageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkspaceManager_GetWorkspaceResourceUsageClient interface {
+	Recv() (*GetWorkspaceResourceUsageResponse, error)
+	grpc.ClientStream
+}
+
+type workspaceManagerGetWorkspaceResourceUsageClient struct {
+	grpc.ClientStream
+}
+
+func (x *workspaceManagerGetWorkspaceResourceUsageClient) Recv() (*GetWorkspaceResourceUsageResponse, error) {
+	m := new(GetWorkspaceResourceUsageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workspaceManagerClient) GetWorkspaceDiskUsage(ctx context.Context, in *GetWorkspaceDiskUsageRequest, opts ...grpc.CallOption) (*GetWorkspaceDiskUsageResponse, error) {
+	out := new(GetWorkspaceDiskUsageResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/GetWorkspaceDiskUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) UpdateWorkspaceClass(ctx context.Context, in *UpdateWorkspaceClassRequest, opts ...grpc.CallOption) (*UpdateWorkspaceClassResponse, error) {
+	out := new(UpdateWorkspaceClassResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/UpdateWorkspaceClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) DrainNode(ctx context.Context, in *DrainNodeRequest, opts ...grpc.CallOption) (WorkspaceManager_DrainNodeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WorkspaceManager_ServiceDesc.Streams[2], "/wsman.WorkspaceManager/DrainNode", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workspaceManagerDrainNodeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkspaceManager_DrainNodeClient interface {
+	Recv() (*DrainNodeResponse, error)
+	grpc.ClientStream
+}
+
+type workspaceManagerDrainNodeClient struct {
+	grpc.ClientStream
+}
+
+func (x *workspaceManagerDrainNodeClient) Recv() (*DrainNodeResponse, error) {
+	m := new(DrainNodeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workspaceManagerClient) ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (*ExportSnapshotResponse, error) {
+	out := new(ExportSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/ExportSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest, opts ...grpc.CallOption) (*GetQueuePositionResponse, error) {
+	out := new(GetQueuePositionResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/GetQueuePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) StreamWorkspaceLogs(ctx context.Context, in *StreamWorkspaceLogsRequest, opts ...grpc.CallOption) (WorkspaceManager_StreamWorkspaceLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WorkspaceManager_ServiceDesc.Streams[3], "/wsman.WorkspaceManager/StreamWorkspaceLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workspaceManagerStreamWorkspaceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkspaceManager_StreamWorkspaceLogsClient interface {
+	Recv() (*StreamWorkspaceLogsResponse, error)
+	grpc.ClientStream
+}
+
+type workspaceManagerStreamWorkspaceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workspaceManagerStreamWorkspaceLogsClient) Recv() (*StreamWorkspaceLogsResponse, error) {
+	m := new(StreamWorkspaceLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workspaceManagerClient) GetLastActivity(ctx context.Context, in *GetLastActivityRequest, opts ...grpc.CallOption) (*GetLastActivityResponse, error) {
+	out := new(GetLastActivityResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/GetLastActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotResponse, error) {
+	out := new(RestoreSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/RestoreSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceManagerClient) SetRestartClass(ctx context.Context, in *SetRestartClassRequest, opts ...grpc.CallOption) (*SetRestartClassResponse, error) {
+	out := new(SetRestartClassResponse)
+	err := c.cc.Invoke(ctx, "/wsman.WorkspaceManager/SetRestartClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkspaceManagerServer is the server API for WorkspaceManager service.
 // All implementations must embed UnimplementedWorkspaceManagerServer
 // for forward compatibility
@@ -245,6 +447,31 @@ type WorkspaceManagerServer interface {
 	UpdateSSHKey(context.Context, *UpdateSSHKeyRequest) (*UpdateSSHKeyResponse, error)
 	// describeCluster provides information about the cluster
 	DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error)
+	// relocateWorkspace stops a running workspace, backs up its content and restores it in a replacement pod on another node.
+	// The workspace is not reachable until the replacement pod runs. Meanwhile its status has the INTERRUPTED phase and the relocating condition.
+	RelocateWorkspace(context.Context, *RelocateWorkspaceRequest) (*RelocateWorkspaceResponse, error)
+	// getWorkspaceResourceUsage streams the resource usage of a running workspace as reported by ws-daemon
+	GetWorkspaceResourceUsage(*GetWorkspaceResourceUsageRequest, WorkspaceManager_GetWorkspaceResourceUsageServer) error
+	// getWorkspaceDiskUsage returns the disk usage of a running workspace broken down by where the data lives, as reported by ws-daemon
+	GetWorkspaceDiskUsage(context.Context, *GetWorkspaceDiskUsageRequest) (*GetWorkspaceDiskUsageResponse, error)
+	// updateWorkspaceClass moves a running workspace to another class, resizing its pod in place where possible
+	UpdateWorkspaceClass(context.Context, *UpdateWorkspaceClassRequest) (*UpdateWorkspaceClassResponse, error)
+	// drainNode stops scheduling workspaces to a node and stops the workspaces running on it, streaming the progress of their backups
+	DrainNode(*DrainNodeRequest, WorkspaceManager_DrainNodeServer) error
+	// exportSnapshot takes a snapshot of a running workspace and pushes its content as OCI image to the registry configured in ws-daemon
+	ExportSnapshot(context.Context, *ExportSnapshotRequest) (*ExportSnapshotResponse, error)
+	// getQueuePosition returns the position of a prebuild in the prebuild queue
+	GetQueuePosition(context.Context, *GetQueuePositionRequest) (*GetQueuePositionResponse, error)
+	// listSnapshots lists the snapshots of the workspaces which are still known to this cluster
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error)
+	// streamWorkspaceLogs streams the task output of a headless workspace, e.g. of an image build or prebuild, while it runs
+	StreamWorkspaceLogs(*StreamWorkspaceLogsRequest, WorkspaceManager_StreamWorkspaceLogsServer) error
+	// getLastActivity returns when a workspace was last marked active, overall and per source of the activity
+	GetLastActivity(context.Context, *GetLastActivityRequest) (*GetLastActivityResponse, error)
+	// restoreSnapshot unpacks a snapshot or backup into a directory of a running workspace, without restarting it
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error)
+	// setRestartClass marks a stopped workspace to start with another workspace class the next time it starts
+	SetRestartClass(context.Context, *SetRestartClassRequest) (*SetRestartClassResponse, error)
 	mustEmbedUnimplementedWorkspaceManagerServer()
 }
 
@@ -294,6 +521,42 @@ func (UnimplementedWorkspaceManagerServer) UpdateSSHKey(context.Context, *Update
 func (UnimplementedWorkspaceManagerServer) DescribeCluster(context.Context, *DescribeClusterRequest) (*DescribeClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
 }
+func (UnimplementedWorkspaceManagerServer) RelocateWorkspace(context.Context, *RelocateWorkspaceRequest) (*RelocateWorkspaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelocateWorkspace not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) GetWorkspaceResourceUsage(*GetWorkspaceResourceUsageRequest, WorkspaceManager_GetWorkspaceResourceUsageServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetWorkspaceResourceUsage not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) GetWorkspaceDiskUsage(context.Context, *GetWorkspaceDiskUsageRequest) (*GetWorkspaceDiskUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceDiskUsage not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) UpdateWorkspaceClass(context.Context, *UpdateWorkspaceClassRequest) (*UpdateWorkspaceClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceClass not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) DrainNode(*DrainNodeRequest, WorkspaceManager_DrainNodeServer) error {
+	return status.Errorf(codes.Unimplemented, "method DrainNode not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) ExportSnapshot(context.Context, *ExportSnapshotRequest) (*ExportSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSnapshot not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) GetQueuePosition(context.Context, *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueuePosition not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) StreamWorkspaceLogs(*StreamWorkspaceLogsRequest, WorkspaceManager_StreamWorkspaceLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWorkspaceLogs not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) GetLastActivity(context.Context, *GetLastActivityRequest) (*GetLastActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastActivity not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (UnimplementedWorkspaceManagerServer) SetRestartClass(context.Context, *SetRestartClassRequest) (*SetRestartClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRestartClass not implemented")
+}
 func (UnimplementedWorkspaceManagerServer) mustEmbedUnimplementedWorkspaceManagerServer() {}
 
 // UnsafeWorkspaceManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -562,6 +825,231 @@ func _WorkspaceManager_DescribeCluster_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceManager_RelocateWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelocateWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).RelocateWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/RelocateWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).RelocateWorkspace(ctx, req.(*RelocateWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_GetWorkspaceResourceUsage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetWorkspaceResourceUsageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkspaceManagerServer).GetWorkspaceResourceUsage(m, &workspaceManagerGetWorkspaceResourceUsageServer{stream})
+}
+
+type WorkspaceManager_GetWorkspaceResourceUsageServer interface {
+	Send(*GetWorkspaceResourceUsageResponse) error
+	grpc.ServerStream
+}
+
+type workspaceManagerGetWorkspaceResourceUsageServer struct {
+	grpc.ServerStream
+}
+
+func (x *workspaceManagerGetWorkspaceResourceUsageServer) Send(m *GetWorkspaceResourceUsageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkspaceManager_GetWorkspaceDiskUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceDiskUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).GetWorkspaceDiskUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/GetWorkspaceDiskUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).GetWorkspaceDiskUsage(ctx, req.(*GetWorkspaceDiskUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_UpdateWorkspaceClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).UpdateWorkspaceClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/UpdateWorkspaceClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).UpdateWorkspaceClass(ctx, req.(*UpdateWorkspaceClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_DrainNode_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DrainNodeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkspaceManagerServer).DrainNode(m, &workspaceManagerDrainNodeServer{stream})
+}
+
+type WorkspaceManager_DrainNodeServer interface {
+	Send(*DrainNodeResponse) error
+	grpc.ServerStream
+}
+
+type workspaceManagerDrainNodeServer struct {
+	grpc.ServerStream
+}
+
+func (x *workspaceManagerDrainNodeServer) Send(m *DrainNodeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkspaceManager_ExportSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).ExportSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/ExportSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).ExportSnapshot(ctx, req.(*ExportSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_GetQueuePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueuePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).GetQueuePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/GetQueuePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).GetQueuePosition(ctx, req.(*GetQueuePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/ListSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_StreamWorkspaceLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkspaceLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkspaceManagerServer).StreamWorkspaceLogs(m, &workspaceManagerStreamWorkspaceLogsServer{stream})
+}
+
+type WorkspaceManager_StreamWorkspaceLogsServer interface {
+	Send(*StreamWorkspaceLogsResponse) error
+	grpc.ServerStream
+}
+
+type workspaceManagerStreamWorkspaceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workspaceManagerStreamWorkspaceLogsServer) Send(m *StreamWorkspaceLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkspaceManager_GetLastActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).GetLastActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/GetLastActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).GetLastActivity(ctx, req.(*GetLastActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).RestoreSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/RestoreSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceManager_SetRestartClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRestartClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceManagerServer).SetRestartClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.WorkspaceManager/SetRestartClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceManagerServer).SetRestartClass(ctx, req.(*SetRestartClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkspaceManager_ServiceDesc is the grpc.ServiceDesc for WorkspaceManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -621,6 +1109,42 @@ var WorkspaceManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeCluster",
 			Handler:    _WorkspaceManager_DescribeCluster_Handler,
 		},
+		{
+			MethodName: "RelocateWorkspace",
+			Handler:    _WorkspaceManager_RelocateWorkspace_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceDiskUsage",
+			Handler:    _WorkspaceManager_GetWorkspaceDiskUsage_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspaceClass",
+			Handler:    _WorkspaceManager_UpdateWorkspaceClass_Handler,
+		},
+		{
+			MethodName: "ExportSnapshot",
+			Handler:    _WorkspaceManager_ExportSnapshot_Handler,
+		},
+		{
+			MethodName: "GetQueuePosition",
+			Handler:    _WorkspaceManager_GetQueuePosition_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _WorkspaceManager_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "GetLastActivity",
+			Handler:    _WorkspaceManager_GetLastActivity_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _WorkspaceManager_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "SetRestartClass",
+			Handler:    _WorkspaceManager_SetRestartClass_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -628,6 +1152,112 @@ var WorkspaceManager_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _WorkspaceManager_Subscribe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetWorkspaceResourceUsage",
+			Handler:       _WorkspaceManager_GetWorkspaceResourceUsage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DrainNode",
+			Handler:       _WorkspaceManager_DrainNode_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamWorkspaceLogs",
+			Handler:       _WorkspaceManager_StreamWorkspaceLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "core.proto",
+}
+
+// HeadlessCompletionHookClient is the client API for HeadlessCompletionHook service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HeadlessCompletionHookClient interface {
+	// workspaceCompleted is called once a headless workspace has stopped. Calls which fail are retried, hence the same
+	// completion can be delivered more than once.
+	WorkspaceCompleted(ctx context.Context, in *HeadlessWorkspaceCompletion, opts ...grpc.CallOption) (*WorkspaceCompletedResponse, error)
+}
+
+type headlessCompletionHookClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHeadlessCompletionHookClient(cc grpc.ClientConnInterface) HeadlessCompletionHookClient {
+	return &headlessCompletionHookClient{cc}
+}
+
+func (c *headlessCompletionHookClient) WorkspaceCompleted(ctx context.Context, in *HeadlessWorkspaceCompletion, opts ...grpc.CallOption) (*WorkspaceCompletedResponse, error) {
+	out := new(WorkspaceCompletedResponse)
+	err := c.cc.Invoke(ctx, "/wsman.HeadlessCompletionHook/WorkspaceCompleted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HeadlessCompletionHookServer is the server API for HeadlessCompletionHook service.
+// All implementations must embed UnimplementedHeadlessCompletionHookServer
+// for forward compatibility
+type HeadlessCompletionHookServer interface {
+	// workspaceCompleted is called once a headless workspace has stopped. Calls which fail are retried, hence the same
+	// completion can be delivered more than once.
+	WorkspaceCompleted(context.Context, *HeadlessWorkspaceCompletion) (*WorkspaceCompletedResponse, error)
+	mustEmbedUnimplementedHeadlessCompletionHookServer()
+}
+
+// UnimplementedHeadlessCompletionHookServer must be embedded to have forward compatible implementations.
+type UnimplementedHeadlessCompletionHookServer struct {
+}
+
+func (UnimplementedHeadlessCompletionHookServer) WorkspaceCompleted(context.Context, *HeadlessWorkspaceCompletion) (*WorkspaceCompletedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkspaceCompleted not implemented")
+}
+func (UnimplementedHeadlessCompletionHookServer) mustEmbedUnimplementedHeadlessCompletionHookServer() {
+}
+
+// UnsafeHeadlessCompletionHookServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HeadlessCompletionHookServer will
+// result in compilation errors.
+type UnsafeHeadlessCompletionHookServer interface {
+	mustEmbedUnimplementedHeadlessCompletionHookServer()
+}
+
+func RegisterHeadlessCompletionHookServer(s grpc.ServiceRegistrar, srv HeadlessCompletionHookServer) {
+	s.RegisterService(&HeadlessCompletionHook_ServiceDesc, srv)
+}
+
+func _HeadlessCompletionHook_WorkspaceCompleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadlessWorkspaceCompletion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HeadlessCompletionHookServer).WorkspaceCompleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/wsman.HeadlessCompletionHook/WorkspaceCompleted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HeadlessCompletionHookServer).WorkspaceCompleted(ctx, req.(*HeadlessWorkspaceCompletion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HeadlessCompletionHook_ServiceDesc is the grpc.ServiceDesc for HeadlessCompletionHook service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HeadlessCompletionHook_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wsman.HeadlessCompletionHook",
+	HandlerType: (*HeadlessCompletionHookServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WorkspaceCompleted",
+			Handler:    _HeadlessCompletionHook_WorkspaceCompleted_Handler,
+		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "core.proto",
 }
@@ -15,6 +15,31 @@ type SnapshotSpec struct {
 
 	// +kubebuilder:validation:Required
 	WorkspaceID string `json:"workspaceID"`
+
+	// Export requests the snapshot to be pushed as OCI image once it was taken
+	// +kubebuilder:validation:Optional
+	Export *SnapshotExport `json:"export,omitempty"`
+
+	// Message describes the snapshot
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=1024
+	Message string `json:"message,omitempty"`
+
+	// Labels are set by the user who took the snapshot and can be used to filter snapshots
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Paths restricts the snapshot to the given paths relative to the workspace location. Paths may be glob
+	// patterns, paths prefixed with "!" are left out. The snapshot contains the whole workspace if no paths are given.
+	// +kubebuilder:validation:Optional
+	Paths []string `json:"paths,omitempty"`
+}
+
+// SnapshotExport describes the OCI image a snapshot is exported to
+type SnapshotExport struct {
+	// Tag is the tag of the image in the repository ws-daemon is configured to export snapshots to
+	// +kubebuilder:validation:Required
+	Tag string `json:"tag"`
 }
 
 // SnapshotStatus defines the observed state of the snapshot
@@ -30,6 +55,14 @@ type SnapshotStatus struct {
 	// +kubebuilder:validation:Optional
 	URL string `json:"url,omitempty"`
 
+	// ImageRef is the digest reference of the image the snapshot was exported to
+	// +kubebuilder:validation:Optional
+	ImageRef string `json:"imageRef,omitempty"`
+
+	// Size is the size of the uploaded snapshot in bytes
+	// +kubebuilder:validation:Optional
+	Size int64 `json:"size,omitempty"`
+
 	// Completed indicates if the snapshot operation has completed either by taking the snapshot or due to failure
 	// +kubebuilder:validation:Required
 	Completed bool `json:"completed"`
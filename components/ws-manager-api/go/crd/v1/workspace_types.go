@@ -64,6 +64,22 @@ type WorkspaceSpec struct {
 	StorageQuota int `json:"storageQuota,omitempty"`
 
 	SSHGatewayCAPublicKey string `json:"sshGatewayCAPublicKey,omitempty"`
+
+	// Sidecars names the entries of the sidecar catalog which run next to the workspace container,
+	// in addition to those of the workspace class
+	Sidecars []string `json:"sidecars,omitempty"`
+
+	// Priority orders prebuilds waiting in the prebuild queue. Prebuilds with a higher priority start first.
+	Priority int `json:"priority,omitempty"`
+
+	// ClockOffset shifts the clocks inside the workspace
+	// +kubebuilder:validation:Optional
+	ClockOffset *metav1.Duration `json:"clockOffset,omitempty"`
+
+	// Ephemeral workspaces are never backed up or snapshotted. Their content lives on a tmpfs sized by the
+	// storage quota and is deleted from the node when they stop.
+	// +kubebuilder:validation:Optional
+	Ephemeral bool `json:"ephemeral,omitempty"`
 }
 
 type Ownership struct {
@@ -75,6 +91,8 @@ type Ownership struct {
 	Team string `json:"team,omitempty"`
 	// +kubebuilder:validation:Optional
 	Tenant string `json:"tenant,omitempty"`
+	// +kubebuilder:validation:Optional
+	Project string `json:"project,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Regular;Prebuild;ImageBuild
@@ -121,6 +139,10 @@ type TimeoutSpec struct {
 type AdmissionSpec struct {
 	// +kubebuilder:default=Owner
 	Level AdmissionLevel `json:"level"`
+
+	// Expiry is the time at which the admission level reverts to Owner
+	// +kubebuilder:validation:Optional
+	Expiry *metav1.Time `json:"expiry,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Owner;Everyone
@@ -131,12 +153,13 @@ const (
 	AdmissionLevelEveryone AdmissionLevel = "Everyone"
 )
 
-// +kubebuilder:validation:Enum=Http;Https
+// +kubebuilder:validation:Enum=Http;Https;Tcp
 type PortProtocol string
 
 const (
 	PortProtocolHttp  PortProtocol = "Http"
 	PortProtocolHttps PortProtocol = "Https"
+	PortProtocolTcp   PortProtocol = "Tcp"
 )
 
 type PortSpec struct {
@@ -193,6 +216,38 @@ type WorkspaceStatus struct {
 	Storage StorageStatus `json:"storage,omitempty"`
 
 	LastActivity *metav1.Time `json:"lastActivity,omitempty"`
+
+	// LastActivityBySource is the last time each source of activity marked the workspace active.
+	// +kubebuilder:validation:Optional
+	LastActivityBySource map[ActivitySource]metav1.Time `json:"lastActivityBySource,omitempty"`
+
+	// GPU describes the GPUs allocated to the workspace pod.
+	// +kubebuilder:validation:Optional
+	GPU *GPUStatus `json:"gpu,omitempty"`
+
+	// Queue describes the position of the workspace while it waits in the prebuild or start queue.
+	// +kubebuilder:validation:Optional
+	Queue *QueueStatus `json:"queue,omitempty"`
+
+	// ExitCode is the exit code of the workspace container of a headless workspace once it exited by itself.
+	// +kubebuilder:validation:Optional
+	ExitCode *int32 `json:"exitCode,omitempty"`
+
+	// Admission is the admission level last observed by the workspace controller. It lags behind the spec
+	// until the workspace is reconciled and is used to detect changes of the admission level.
+	// +kubebuilder:validation:Optional
+	Admission AdmissionLevel `json:"admission,omitempty"`
+}
+
+// QueueStatus describes the position of a workspace in a queue
+type QueueStatus struct {
+	// Position is the one-based position of the workspace in the queue
+	Position int `json:"position"`
+	// Length is the number of workspaces waiting in the queue
+	Length int `json:"length"`
+	// EstimatedStart is when the workspace is expected to leave the queue
+	// +kubebuilder:validation:Optional
+	EstimatedStart *metav1.Time `json:"estimatedStart,omitempty"`
 }
 
 func (s *WorkspaceStatus) SetCondition(cond metav1.Condition) {
@@ -205,7 +260,7 @@ type StorageStatus struct {
 	MountPath      string `json:"mountPath"`
 }
 
-// +kubebuilder:validation:Enum=Deployed;Failed;Timeout;FirstUserActivity;Closed;HeadlessTaskFailed;StoppedByRequest;Aborted;ContentReady;EverReady;BackupComplete;BackupFailure;Refresh;NodeDisappeared;ThroughputAdjusted
+// +kubebuilder:validation:Enum=Deployed;Failed;Timeout;FirstUserActivity;Closed;HeadlessTaskFailed;StoppedByRequest;Aborted;ContentReady;EverReady;BackupComplete;BackupFailure;Refresh;NodeDisappeared;ThroughputAdjusted;Relocating;PullingImageFailed;Queued;NodePreempted
 type WorkspaceCondition string
 
 const (
@@ -263,6 +318,89 @@ const (
 	// WorkspaceContainerRunning is true if the workspace container is running.
 	// Used to determine if a backup can be taken, only once the container is stopped.
 	WorkspaceConditionContainerRunning WorkspaceCondition = "WorkspaceContainerRunning"
+
+	// Relocating is true while the workspace is stopped, backed up and restored on another node.
+	// The condition message contains the name of the target node.
+	WorkspaceConditionRelocating WorkspaceCondition = "Relocating"
+
+	// PullingImageFailed is true while an image of the workspace pod cannot be pulled.
+	// The condition message names the image reference and the error reported by the kubelet.
+	WorkspaceConditionPullingImageFailed WorkspaceCondition = "PullingImageFailed"
+
+	// Queued is true while a workspace waits in the prebuild or start queue. The condition turns false once
+	// the workspace leaves the queue and its pod is created. The reason names the queue.
+	WorkspaceConditionQueued WorkspaceCondition = "Queued"
+
+	// NodePreempted is true if the workspace was stopped because its node is about to be preempted, e.g. a spot VM
+	// which received a termination notice. The condition message names the signal which marked the node as preempted.
+	WorkspaceConditionNodePreempted WorkspaceCondition = "NodePreempted"
+
+	// OOMKill is true once the OOM killer killed processes in a workspace which kept running, e.g. a compiler which
+	// exceeded the memory of the workspace. ws-daemon sets it, the message says how many processes were killed.
+	// Workspaces whose container was killed have the StopReason OOMKilled instead.
+	WorkspaceConditionOOMKill WorkspaceCondition = "OOMKill"
+
+	// ContentDeleted is true once ws-daemon deleted the content of an ephemeral workspace from its node.
+	// Ephemeral workspaces are not backed up, this condition takes the place of BackupComplete for them.
+	WorkspaceConditionContentDeleted WorkspaceCondition = "ContentDeleted"
+
+	// DaemonUnavailable is true while ws-manager cannot reach ws-daemon on the node of the workspace. Operations which
+	// need ws-daemon fail until it's back, and the node receives no new workspaces in the meantime.
+	WorkspaceConditionDaemonUnavailable WorkspaceCondition = "DaemonUnavailable"
+
+	// StopReason is true once we know why the workspace is stopped. The condition reason is the StopReason,
+	// the message says more about it. The first stop reason sticks, later ones are consequences of the first.
+	WorkspaceConditionStopReason WorkspaceCondition = "StopReason"
+
+	// CompletionHooksNotified is true once the completion hooks were notified of a headless workspace which stopped, or
+	// gave up on it. It is false while notifying them fails, since the last transition time.
+	WorkspaceConditionCompletionHooksNotified WorkspaceCondition = "CompletionHooksNotified"
+)
+
+// StopReason is why a workspace was stopped. The values are valid condition reasons.
+type StopReason string
+
+const (
+	// StopReasonTimeoutInactivity means the workspace timed out because its user was inactive, or because it was closed
+	StopReasonTimeoutInactivity StopReason = "TimeoutInactivity"
+
+	// StopReasonUserRequested means the user of the workspace stopped it
+	StopReasonUserRequested StopReason = "UserRequested"
+
+	// StopReasonAdminStopped means an administrator stopped the workspace, e.g. by draining its node
+	StopReasonAdminStopped StopReason = "AdminStopped"
+
+	// StopReasonNodeFailure means the node of the workspace was preempted or disappeared
+	StopReasonNodeFailure StopReason = "NodeFailure"
+
+	// StopReasonBackupFailure means the workspace failed because its content could not be backed up
+	StopReasonBackupFailure StopReason = "BackupFailure"
+
+	// StopReasonAgentSmith means agent-smith stopped the workspace because it detected abuse
+	StopReasonAgentSmith StopReason = "AgentSmith"
+
+	// StopReasonOOMKilled means the workspace container was killed because it ran out of memory
+	StopReasonOOMKilled StopReason = "OOMKilled"
+)
+
+// ActivitySource is where the activity of a workspace originated
+type ActivitySource string
+
+const (
+	// ActivitySourceUnspecified is the source of activity whose caller did not name it
+	ActivitySourceUnspecified ActivitySource = "Unspecified"
+
+	// ActivitySourceIDEHeartbeat is the heartbeat an IDE sends while the user works in it
+	ActivitySourceIDEHeartbeat ActivitySource = "IDEHeartbeat"
+
+	// ActivitySourcePortTraffic is traffic to a port exposed by the workspace
+	ActivitySourcePortTraffic ActivitySource = "PortTraffic"
+
+	// ActivitySourceTerminalInput is input to a terminal or SSH session of the workspace
+	ActivitySourceTerminalInput ActivitySource = "TerminalInput"
+
+	// ActivitySourceAPICall is a call to the API which acts on the workspace
+	ActivitySourceAPICall ActivitySource = "APICall"
 )
 
 func NewWorkspaceConditionDeployed() metav1.Condition {
@@ -391,6 +529,54 @@ func NewWorkspaceConditionNodeDisappeared() metav1.Condition {
 	}
 }
 
+func NewWorkspaceConditionNodePreempted(message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionNodePreempted),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             "NodePreempted",
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionOOMKill(message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionOOMKill),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             "OOMKill",
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionStopReason(reason StopReason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionStopReason),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(reason),
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionCompletionHooksNotified(status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionCompletionHooksNotified),
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionContentDeleted() metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionContentDeleted),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+	}
+}
+
 func NewWorkspaceConditionContainerRunning(status metav1.ConditionStatus) metav1.Condition {
 	return metav1.Condition{
 		Type:               string(WorkspaceConditionContainerRunning),
@@ -399,6 +585,92 @@ func NewWorkspaceConditionContainerRunning(status metav1.ConditionStatus) metav1
 	}
 }
 
+func NewWorkspaceConditionRelocating(targetNode string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionRelocating),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             "RelocateWorkspaceRequest",
+		Message:            targetNode,
+	}
+}
+
+func NewWorkspaceConditionRelocated() metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionRelocating),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionFalse,
+		Reason:             "RelocationComplete",
+	}
+}
+
+func NewWorkspaceConditionRelocationCancelled(reason string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionRelocating),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionFalse,
+		Reason:             "RelocationCancelled",
+		Message:            reason,
+	}
+}
+
+func NewWorkspaceConditionPullingImageFailed(reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionPullingImageFailed),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionImagePulled() metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionPullingImageFailed),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionFalse,
+		Reason:             "ImagePulled",
+	}
+}
+
+func NewWorkspaceConditionQueued(queue, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionQueued),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             queue,
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionDequeued(queue string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionQueued),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionFalse,
+		Reason:             queue,
+	}
+}
+
+func NewWorkspaceConditionDaemonUnavailable(message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionDaemonUnavailable),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             "DaemonUnavailable",
+		Message:            message,
+	}
+}
+
+func NewWorkspaceConditionDaemonAvailable() metav1.Condition {
+	return metav1.Condition{
+		Type:               string(WorkspaceConditionDaemonUnavailable),
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionFalse,
+		Reason:             "DaemonAvailable",
+	}
+}
+
 // +kubebuilder:validation:Enum:=Unknown;Pending;Imagebuild;Creating;Initializing;Running;Stopping;Stopped
 type WorkspacePhase string
 
@@ -432,6 +704,17 @@ type GitStatus struct {
 	TotalUnpushedCommits int64 `json:"totalUnpushedCommits,omitempty"`
 }
 
+type GPUStatus struct {
+	// Count is the number of GPUs allocated to the workspace
+	Count int64 `json:"count"`
+	// ResourceName is the extended resource the GPUs were requested as, e.g. nvidia.com/gpu
+	ResourceName string `json:"resourceName"`
+	// Product is the GPU model advertised by the node, if known
+	Product string `json:"product,omitempty"`
+	// NodeName is the node Product was read from
+	NodeName string `json:"nodeName,omitempty"`
+}
+
 type WorkspaceRuntimeStatus struct {
 	NodeName string `json:"nodeName,omitempty"`
 	PodName  string `json:"podName,omitempty"`
@@ -485,6 +768,31 @@ func (w *Workspace) IsConditionTrue(condition WorkspaceCondition) bool {
 	return wsk8s.ConditionPresentAndTrue(w.Status.Conditions, string(condition))
 }
 
+// RelocationTarget returns the node the workspace is being relocated to, or an
+// empty string if the workspace is not being relocated.
+func (w *Workspace) RelocationTarget() string {
+	if c := wsk8s.GetCondition(w.Status.Conditions, string(WorkspaceConditionRelocating)); c != nil && c.Status == metav1.ConditionTrue {
+		return c.Message
+	}
+	return ""
+}
+
+// StopReason returns why the workspace was stopped, or an empty string if we don't know (yet).
+func (w *Workspace) StopReason() StopReason {
+	if c := wsk8s.GetCondition(w.Status.Conditions, string(WorkspaceConditionStopReason)); c != nil && c.Status == metav1.ConditionTrue {
+		return StopReason(c.Reason)
+	}
+	return ""
+}
+
+// SetStopReason records why the workspace is stopped, unless a stop reason has been recorded before.
+func (w *Workspace) SetStopReason(reason StopReason, message string) {
+	if w.StopReason() != "" {
+		return
+	}
+	w.Status.SetCondition(NewWorkspaceConditionStopReason(reason, message))
+}
+
 // UpsertConditionOnStatusChange calls SetCondition if the condition does not exist or it's status or message has changed.
 func (w *Workspace) UpsertConditionOnStatusChange(newCondition metav1.Condition) {
 	oldCondition := wsk8s.GetCondition(w.Status.Conditions, newCondition.Type)
@@ -250,6 +250,94 @@ func (mr *MockWorkspaceManagerServerMockRecorder) DescribeWorkspace(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkspace", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).DescribeWorkspace), arg0, arg1)
 }
 
+// DrainNode mocks base method.
+func (m *MockWorkspaceManagerServer) DrainNode(arg0 *api.DrainNodeRequest, arg1 api.WorkspaceManager_DrainNodeServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DrainNode", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DrainNode indicates an expected call of DrainNode.
+func (mr *MockWorkspaceManagerServerMockRecorder) DrainNode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainNode", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).DrainNode), arg0, arg1)
+}
+
+// ExportSnapshot mocks base method.
+func (m *MockWorkspaceManagerServer) ExportSnapshot(arg0 context.Context, arg1 *api.ExportSnapshotRequest) (*api.ExportSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*api.ExportSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSnapshot indicates an expected call of ExportSnapshot.
+func (mr *MockWorkspaceManagerServerMockRecorder) ExportSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSnapshot", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).ExportSnapshot), arg0, arg1)
+}
+
+// GetLastActivity mocks base method.
+func (m *MockWorkspaceManagerServer) GetLastActivity(arg0 context.Context, arg1 *api.GetLastActivityRequest) (*api.GetLastActivityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastActivity", arg0, arg1)
+	ret0, _ := ret[0].(*api.GetLastActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastActivity indicates an expected call of GetLastActivity.
+func (mr *MockWorkspaceManagerServerMockRecorder) GetLastActivity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastActivity", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).GetLastActivity), arg0, arg1)
+}
+
+// GetQueuePosition mocks base method.
+func (m *MockWorkspaceManagerServer) GetQueuePosition(arg0 context.Context, arg1 *api.GetQueuePositionRequest) (*api.GetQueuePositionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueuePosition", arg0, arg1)
+	ret0, _ := ret[0].(*api.GetQueuePositionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueuePosition indicates an expected call of GetQueuePosition.
+func (mr *MockWorkspaceManagerServerMockRecorder) GetQueuePosition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueuePosition", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).GetQueuePosition), arg0, arg1)
+}
+
+// GetWorkspaceDiskUsage mocks base method.
+func (m *MockWorkspaceManagerServer) GetWorkspaceDiskUsage(arg0 context.Context, arg1 *api.GetWorkspaceDiskUsageRequest) (*api.GetWorkspaceDiskUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceDiskUsage", arg0, arg1)
+	ret0, _ := ret[0].(*api.GetWorkspaceDiskUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceDiskUsage indicates an expected call of GetWorkspaceDiskUsage.
+func (mr *MockWorkspaceManagerServerMockRecorder) GetWorkspaceDiskUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceDiskUsage", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).GetWorkspaceDiskUsage), arg0, arg1)
+}
+
+// GetWorkspaceResourceUsage mocks base method.
+func (m *MockWorkspaceManagerServer) GetWorkspaceResourceUsage(arg0 *api.GetWorkspaceResourceUsageRequest, arg1 api.WorkspaceManager_GetWorkspaceResourceUsageServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkspaceResourceUsage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetWorkspaceResourceUsage indicates an expected call of GetWorkspaceResourceUsage.
+func (mr *MockWorkspaceManagerServerMockRecorder) GetWorkspaceResourceUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceResourceUsage", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).GetWorkspaceResourceUsage), arg0, arg1)
+}
+
 // GetWorkspaces mocks base method.
 func (m *MockWorkspaceManagerServer) GetWorkspaces(arg0 context.Context, arg1 *api.GetWorkspacesRequest) (*api.GetWorkspacesResponse, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +353,21 @@ func (mr *MockWorkspaceManagerServerMockRecorder) GetWorkspaces(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaces", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).GetWorkspaces), arg0, arg1)
 }
 
+// ListSnapshots mocks base method.
+func (m *MockWorkspaceManagerServer) ListSnapshots(arg0 context.Context, arg1 *api.ListSnapshotsRequest) (*api.ListSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshots", arg0, arg1)
+	ret0, _ := ret[0].(*api.ListSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockWorkspaceManagerServerMockRecorder) ListSnapshots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).ListSnapshots), arg0, arg1)
+}
+
 // MarkActive mocks base method.
 func (m *MockWorkspaceManagerServer) MarkActive(arg0 context.Context, arg1 *api.MarkActiveRequest) (*api.MarkActiveResponse, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +383,51 @@ func (mr *MockWorkspaceManagerServerMockRecorder) MarkActive(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).MarkActive), arg0, arg1)
 }
 
+// RelocateWorkspace mocks base method.
+func (m *MockWorkspaceManagerServer) RelocateWorkspace(arg0 context.Context, arg1 *api.RelocateWorkspaceRequest) (*api.RelocateWorkspaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelocateWorkspace", arg0, arg1)
+	ret0, _ := ret[0].(*api.RelocateWorkspaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelocateWorkspace indicates an expected call of RelocateWorkspace.
+func (mr *MockWorkspaceManagerServerMockRecorder) RelocateWorkspace(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelocateWorkspace", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).RelocateWorkspace), arg0, arg1)
+}
+
+// RestoreSnapshot mocks base method.
+func (m *MockWorkspaceManagerServer) RestoreSnapshot(arg0 context.Context, arg1 *api.RestoreSnapshotRequest) (*api.RestoreSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*api.RestoreSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot.
+func (mr *MockWorkspaceManagerServerMockRecorder) RestoreSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).RestoreSnapshot), arg0, arg1)
+}
+
+// SetRestartClass mocks base method.
+func (m *MockWorkspaceManagerServer) SetRestartClass(arg0 context.Context, arg1 *api.SetRestartClassRequest) (*api.SetRestartClassResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRestartClass", arg0, arg1)
+	ret0, _ := ret[0].(*api.SetRestartClassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRestartClass indicates an expected call of SetRestartClass.
+func (mr *MockWorkspaceManagerServerMockRecorder) SetRestartClass(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRestartClass", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).SetRestartClass), arg0, arg1)
+}
+
 // SetTimeout mocks base method.
 func (m *MockWorkspaceManagerServer) SetTimeout(arg0 context.Context, arg1 *api.SetTimeoutRequest) (*api.SetTimeoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -325,6 +473,20 @@ func (mr *MockWorkspaceManagerServerMockRecorder) StopWorkspace(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWorkspace", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).StopWorkspace), arg0, arg1)
 }
 
+// StreamWorkspaceLogs mocks base method.
+func (m *MockWorkspaceManagerServer) StreamWorkspaceLogs(arg0 *api.StreamWorkspaceLogsRequest, arg1 api.WorkspaceManager_StreamWorkspaceLogsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamWorkspaceLogs", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamWorkspaceLogs indicates an expected call of StreamWorkspaceLogs.
+func (mr *MockWorkspaceManagerServerMockRecorder) StreamWorkspaceLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkspaceLogs", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).StreamWorkspaceLogs), arg0, arg1)
+}
+
 // Subscribe mocks base method.
 func (m *MockWorkspaceManagerServer) Subscribe(arg0 *api.SubscribeRequest, arg1 api.WorkspaceManager_SubscribeServer) error {
 	m.ctrl.T.Helper()
@@ -369,6 +531,21 @@ func (mr *MockWorkspaceManagerServerMockRecorder) UpdateSSHKey(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSSHKey", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).UpdateSSHKey), arg0, arg1)
 }
 
+// UpdateWorkspaceClass mocks base method.
+func (m *MockWorkspaceManagerServer) UpdateWorkspaceClass(arg0 context.Context, arg1 *api.UpdateWorkspaceClassRequest) (*api.UpdateWorkspaceClassResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkspaceClass", arg0, arg1)
+	ret0, _ := ret[0].(*api.UpdateWorkspaceClassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkspaceClass indicates an expected call of UpdateWorkspaceClass.
+func (mr *MockWorkspaceManagerServerMockRecorder) UpdateWorkspaceClass(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceClass", reflect.TypeOf((*MockWorkspaceManagerServer)(nil).UpdateWorkspaceClass), arg0, arg1)
+}
+
 // mustEmbedUnimplementedWorkspaceManagerServer mocks base method.
 func (m *MockWorkspaceManagerServer) mustEmbedUnimplementedWorkspaceManagerServer() {
 	m.ctrl.T.Helper()
@@ -647,6 +824,126 @@ func (mr *MockWorkspaceManagerClientMockRecorder) DescribeWorkspace(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeWorkspace", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).DescribeWorkspace), varargs...)
 }
 
+// DrainNode mocks base method.
+func (m *MockWorkspaceManagerClient) DrainNode(arg0 context.Context, arg1 *api.DrainNodeRequest, arg2 ...grpc.CallOption) (api.WorkspaceManager_DrainNodeClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DrainNode", varargs...)
+	ret0, _ := ret[0].(api.WorkspaceManager_DrainNodeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DrainNode indicates an expected call of DrainNode.
+func (mr *MockWorkspaceManagerClientMockRecorder) DrainNode(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainNode", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).DrainNode), varargs...)
+}
+
+// ExportSnapshot mocks base method.
+func (m *MockWorkspaceManagerClient) ExportSnapshot(arg0 context.Context, arg1 *api.ExportSnapshotRequest, arg2 ...grpc.CallOption) (*api.ExportSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportSnapshot", varargs...)
+	ret0, _ := ret[0].(*api.ExportSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSnapshot indicates an expected call of ExportSnapshot.
+func (mr *MockWorkspaceManagerClientMockRecorder) ExportSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSnapshot", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).ExportSnapshot), varargs...)
+}
+
+// GetLastActivity mocks base method.
+func (m *MockWorkspaceManagerClient) GetLastActivity(arg0 context.Context, arg1 *api.GetLastActivityRequest, arg2 ...grpc.CallOption) (*api.GetLastActivityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLastActivity", varargs...)
+	ret0, _ := ret[0].(*api.GetLastActivityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastActivity indicates an expected call of GetLastActivity.
+func (mr *MockWorkspaceManagerClientMockRecorder) GetLastActivity(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastActivity", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).GetLastActivity), varargs...)
+}
+
+// GetQueuePosition mocks base method.
+func (m *MockWorkspaceManagerClient) GetQueuePosition(arg0 context.Context, arg1 *api.GetQueuePositionRequest, arg2 ...grpc.CallOption) (*api.GetQueuePositionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetQueuePosition", varargs...)
+	ret0, _ := ret[0].(*api.GetQueuePositionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueuePosition indicates an expected call of GetQueuePosition.
+func (mr *MockWorkspaceManagerClientMockRecorder) GetQueuePosition(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueuePosition", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).GetQueuePosition), varargs...)
+}
+
+// GetWorkspaceDiskUsage mocks base method.
+func (m *MockWorkspaceManagerClient) GetWorkspaceDiskUsage(arg0 context.Context, arg1 *api.GetWorkspaceDiskUsageRequest, arg2 ...grpc.CallOption) (*api.GetWorkspaceDiskUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkspaceDiskUsage", varargs...)
+	ret0, _ := ret[0].(*api.GetWorkspaceDiskUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceDiskUsage indicates an expected call of GetWorkspaceDiskUsage.
+func (mr *MockWorkspaceManagerClientMockRecorder) GetWorkspaceDiskUsage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceDiskUsage", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).GetWorkspaceDiskUsage), varargs...)
+}
+
+// GetWorkspaceResourceUsage mocks base method.
+func (m *MockWorkspaceManagerClient) GetWorkspaceResourceUsage(arg0 context.Context, arg1 *api.GetWorkspaceResourceUsageRequest, arg2 ...grpc.CallOption) (api.WorkspaceManager_GetWorkspaceResourceUsageClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkspaceResourceUsage", varargs...)
+	ret0, _ := ret[0].(api.WorkspaceManager_GetWorkspaceResourceUsageClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkspaceResourceUsage indicates an expected call of GetWorkspaceResourceUsage.
+func (mr *MockWorkspaceManagerClientMockRecorder) GetWorkspaceResourceUsage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaceResourceUsage", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).GetWorkspaceResourceUsage), varargs...)
+}
+
 // GetWorkspaces mocks base method.
 func (m *MockWorkspaceManagerClient) GetWorkspaces(arg0 context.Context, arg1 *api.GetWorkspacesRequest, arg2 ...grpc.CallOption) (*api.GetWorkspacesResponse, error) {
 	m.ctrl.T.Helper()
@@ -667,6 +964,26 @@ func (mr *MockWorkspaceManagerClientMockRecorder) GetWorkspaces(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkspaces", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).GetWorkspaces), varargs...)
 }
 
+// ListSnapshots mocks base method.
+func (m *MockWorkspaceManagerClient) ListSnapshots(arg0 context.Context, arg1 *api.ListSnapshotsRequest, arg2 ...grpc.CallOption) (*api.ListSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshots", varargs...)
+	ret0, _ := ret[0].(*api.ListSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshots indicates an expected call of ListSnapshots.
+func (mr *MockWorkspaceManagerClientMockRecorder) ListSnapshots(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).ListSnapshots), varargs...)
+}
+
 // MarkActive mocks base method.
 func (m *MockWorkspaceManagerClient) MarkActive(arg0 context.Context, arg1 *api.MarkActiveRequest, arg2 ...grpc.CallOption) (*api.MarkActiveResponse, error) {
 	m.ctrl.T.Helper()
@@ -687,6 +1004,66 @@ func (mr *MockWorkspaceManagerClientMockRecorder) MarkActive(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActive", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).MarkActive), varargs...)
 }
 
+// RelocateWorkspace mocks base method.
+func (m *MockWorkspaceManagerClient) RelocateWorkspace(arg0 context.Context, arg1 *api.RelocateWorkspaceRequest, arg2 ...grpc.CallOption) (*api.RelocateWorkspaceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RelocateWorkspace", varargs...)
+	ret0, _ := ret[0].(*api.RelocateWorkspaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelocateWorkspace indicates an expected call of RelocateWorkspace.
+func (mr *MockWorkspaceManagerClientMockRecorder) RelocateWorkspace(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelocateWorkspace", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).RelocateWorkspace), varargs...)
+}
+
+// RestoreSnapshot mocks base method.
+func (m *MockWorkspaceManagerClient) RestoreSnapshot(arg0 context.Context, arg1 *api.RestoreSnapshotRequest, arg2 ...grpc.CallOption) (*api.RestoreSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreSnapshot", varargs...)
+	ret0, _ := ret[0].(*api.RestoreSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSnapshot indicates an expected call of RestoreSnapshot.
+func (mr *MockWorkspaceManagerClientMockRecorder) RestoreSnapshot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSnapshot", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).RestoreSnapshot), varargs...)
+}
+
+// SetRestartClass mocks base method.
+func (m *MockWorkspaceManagerClient) SetRestartClass(arg0 context.Context, arg1 *api.SetRestartClassRequest, arg2 ...grpc.CallOption) (*api.SetRestartClassResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetRestartClass", varargs...)
+	ret0, _ := ret[0].(*api.SetRestartClassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRestartClass indicates an expected call of SetRestartClass.
+func (mr *MockWorkspaceManagerClientMockRecorder) SetRestartClass(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRestartClass", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).SetRestartClass), varargs...)
+}
+
 // SetTimeout mocks base method.
 func (m *MockWorkspaceManagerClient) SetTimeout(arg0 context.Context, arg1 *api.SetTimeoutRequest, arg2 ...grpc.CallOption) (*api.SetTimeoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -747,6 +1124,26 @@ func (mr *MockWorkspaceManagerClientMockRecorder) StopWorkspace(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWorkspace", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).StopWorkspace), varargs...)
 }
 
+// StreamWorkspaceLogs mocks base method.
+func (m *MockWorkspaceManagerClient) StreamWorkspaceLogs(arg0 context.Context, arg1 *api.StreamWorkspaceLogsRequest, arg2 ...grpc.CallOption) (api.WorkspaceManager_StreamWorkspaceLogsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamWorkspaceLogs", varargs...)
+	ret0, _ := ret[0].(api.WorkspaceManager_StreamWorkspaceLogsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamWorkspaceLogs indicates an expected call of StreamWorkspaceLogs.
+func (mr *MockWorkspaceManagerClientMockRecorder) StreamWorkspaceLogs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamWorkspaceLogs", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).StreamWorkspaceLogs), varargs...)
+}
+
 // Subscribe mocks base method.
 func (m *MockWorkspaceManagerClient) Subscribe(arg0 context.Context, arg1 *api.SubscribeRequest, arg2 ...grpc.CallOption) (api.WorkspaceManager_SubscribeClient, error) {
 	m.ctrl.T.Helper()
@@ -806,3 +1203,23 @@ func (mr *MockWorkspaceManagerClientMockRecorder) UpdateSSHKey(arg0, arg1 interf
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSSHKey", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).UpdateSSHKey), varargs...)
 }
+
+// UpdateWorkspaceClass mocks base method.
+func (m *MockWorkspaceManagerClient) UpdateWorkspaceClass(arg0 context.Context, arg1 *api.UpdateWorkspaceClassRequest, arg2 ...grpc.CallOption) (*api.UpdateWorkspaceClassResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkspaceClass", varargs...)
+	ret0, _ := ret[0].(*api.UpdateWorkspaceClassResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkspaceClass indicates an expected call of UpdateWorkspaceClass.
+func (mr *MockWorkspaceManagerClientMockRecorder) UpdateWorkspaceClass(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkspaceClass", reflect.TypeOf((*MockWorkspaceManagerClient)(nil).UpdateWorkspaceClass), varargs...)
+}
@@ -30,6 +30,18 @@ interface IWorkspaceManagerService extends grpc.ServiceDefinition<grpc.UntypedSe
     deleteVolumeSnapshot: IWorkspaceManagerService_IDeleteVolumeSnapshot;
     updateSSHKey: IWorkspaceManagerService_IUpdateSSHKey;
     describeCluster: IWorkspaceManagerService_IDescribeCluster;
+    relocateWorkspace: IWorkspaceManagerService_IRelocateWorkspace;
+    getWorkspaceResourceUsage: IWorkspaceManagerService_IGetWorkspaceResourceUsage;
+    getWorkspaceDiskUsage: IWorkspaceManagerService_IGetWorkspaceDiskUsage;
+    updateWorkspaceClass: IWorkspaceManagerService_IUpdateWorkspaceClass;
+    drainNode: IWorkspaceManagerService_IDrainNode;
+    exportSnapshot: IWorkspaceManagerService_IExportSnapshot;
+    getQueuePosition: IWorkspaceManagerService_IGetQueuePosition;
+    listSnapshots: IWorkspaceManagerService_IListSnapshots;
+    streamWorkspaceLogs: IWorkspaceManagerService_IStreamWorkspaceLogs;
+    getLastActivity: IWorkspaceManagerService_IGetLastActivity;
+    restoreSnapshot: IWorkspaceManagerService_IRestoreSnapshot;
+    setRestartClass: IWorkspaceManagerService_ISetRestartClass;
 }
 
 interface IWorkspaceManagerService_IGetWorkspaces extends grpc.MethodDefinition<core_pb.GetWorkspacesRequest, core_pb.GetWorkspacesResponse> {
@@ -158,6 +170,114 @@ interface IWorkspaceManagerService_IDescribeCluster extends grpc.MethodDefinitio
     responseSerialize: grpc.serialize<core_pb.DescribeClusterResponse>;
     responseDeserialize: grpc.deserialize<core_pb.DescribeClusterResponse>;
 }
+interface IWorkspaceManagerService_IRelocateWorkspace extends grpc.MethodDefinition<core_pb.RelocateWorkspaceRequest, core_pb.RelocateWorkspaceResponse> {
+    path: "/wsman.WorkspaceManager/RelocateWorkspace";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.RelocateWorkspaceRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.RelocateWorkspaceRequest>;
+    responseSerialize: grpc.serialize<core_pb.RelocateWorkspaceResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.RelocateWorkspaceResponse>;
+}
+interface IWorkspaceManagerService_IGetWorkspaceResourceUsage extends grpc.MethodDefinition<core_pb.GetWorkspaceResourceUsageRequest, core_pb.GetWorkspaceResourceUsageResponse> {
+    path: "/wsman.WorkspaceManager/GetWorkspaceResourceUsage";
+    requestStream: false;
+    responseStream: true;
+    requestSerialize: grpc.serialize<core_pb.GetWorkspaceResourceUsageRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.GetWorkspaceResourceUsageRequest>;
+    responseSerialize: grpc.serialize<core_pb.GetWorkspaceResourceUsageResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.GetWorkspaceResourceUsageResponse>;
+}
+interface IWorkspaceManagerService_IGetWorkspaceDiskUsage extends grpc.MethodDefinition<core_pb.GetWorkspaceDiskUsageRequest, core_pb.GetWorkspaceDiskUsageResponse> {
+    path: "/wsman.WorkspaceManager/GetWorkspaceDiskUsage";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.GetWorkspaceDiskUsageRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.GetWorkspaceDiskUsageRequest>;
+    responseSerialize: grpc.serialize<core_pb.GetWorkspaceDiskUsageResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.GetWorkspaceDiskUsageResponse>;
+}
+interface IWorkspaceManagerService_IUpdateWorkspaceClass extends grpc.MethodDefinition<core_pb.UpdateWorkspaceClassRequest, core_pb.UpdateWorkspaceClassResponse> {
+    path: "/wsman.WorkspaceManager/UpdateWorkspaceClass";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.UpdateWorkspaceClassRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.UpdateWorkspaceClassRequest>;
+    responseSerialize: grpc.serialize<core_pb.UpdateWorkspaceClassResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.UpdateWorkspaceClassResponse>;
+}
+interface IWorkspaceManagerService_IDrainNode extends grpc.MethodDefinition<core_pb.DrainNodeRequest, core_pb.DrainNodeResponse> {
+    path: "/wsman.WorkspaceManager/DrainNode";
+    requestStream: false;
+    responseStream: true;
+    requestSerialize: grpc.serialize<core_pb.DrainNodeRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.DrainNodeRequest>;
+    responseSerialize: grpc.serialize<core_pb.DrainNodeResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.DrainNodeResponse>;
+}
+interface IWorkspaceManagerService_IExportSnapshot extends grpc.MethodDefinition<core_pb.ExportSnapshotRequest, core_pb.ExportSnapshotResponse> {
+    path: "/wsman.WorkspaceManager/ExportSnapshot";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.ExportSnapshotRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.ExportSnapshotRequest>;
+    responseSerialize: grpc.serialize<core_pb.ExportSnapshotResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.ExportSnapshotResponse>;
+}
+interface IWorkspaceManagerService_IGetQueuePosition extends grpc.MethodDefinition<core_pb.GetQueuePositionRequest, core_pb.GetQueuePositionResponse> {
+    path: "/wsman.WorkspaceManager/GetQueuePosition";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.GetQueuePositionRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.GetQueuePositionRequest>;
+    responseSerialize: grpc.serialize<core_pb.GetQueuePositionResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.GetQueuePositionResponse>;
+}
+interface IWorkspaceManagerService_IListSnapshots extends grpc.MethodDefinition<core_pb.ListSnapshotsRequest, core_pb.ListSnapshotsResponse> {
+    path: "/wsman.WorkspaceManager/ListSnapshots";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.ListSnapshotsRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.ListSnapshotsRequest>;
+    responseSerialize: grpc.serialize<core_pb.ListSnapshotsResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.ListSnapshotsResponse>;
+}
+interface IWorkspaceManagerService_IStreamWorkspaceLogs extends grpc.MethodDefinition<core_pb.StreamWorkspaceLogsRequest, core_pb.StreamWorkspaceLogsResponse> {
+    path: "/wsman.WorkspaceManager/StreamWorkspaceLogs";
+    requestStream: false;
+    responseStream: true;
+    requestSerialize: grpc.serialize<core_pb.StreamWorkspaceLogsRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.StreamWorkspaceLogsRequest>;
+    responseSerialize: grpc.serialize<core_pb.StreamWorkspaceLogsResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.StreamWorkspaceLogsResponse>;
+}
+interface IWorkspaceManagerService_IGetLastActivity extends grpc.MethodDefinition<core_pb.GetLastActivityRequest, core_pb.GetLastActivityResponse> {
+    path: "/wsman.WorkspaceManager/GetLastActivity";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.GetLastActivityRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.GetLastActivityRequest>;
+    responseSerialize: grpc.serialize<core_pb.GetLastActivityResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.GetLastActivityResponse>;
+}
+interface IWorkspaceManagerService_IRestoreSnapshot extends grpc.MethodDefinition<core_pb.RestoreSnapshotRequest, core_pb.RestoreSnapshotResponse> {
+    path: "/wsman.WorkspaceManager/RestoreSnapshot";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.RestoreSnapshotRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.RestoreSnapshotRequest>;
+    responseSerialize: grpc.serialize<core_pb.RestoreSnapshotResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.RestoreSnapshotResponse>;
+}
+interface IWorkspaceManagerService_ISetRestartClass extends grpc.MethodDefinition<core_pb.SetRestartClassRequest, core_pb.SetRestartClassResponse> {
+    path: "/wsman.WorkspaceManager/SetRestartClass";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.SetRestartClassRequest>;
+    requestDeserialize: grpc.deserialize<core_pb.SetRestartClassRequest>;
+    responseSerialize: grpc.serialize<core_pb.SetRestartClassResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.SetRestartClassResponse>;
+}
 
 export const WorkspaceManagerService: IWorkspaceManagerService;
 
@@ -176,6 +296,18 @@ export interface IWorkspaceManagerServer extends grpc.UntypedServiceImplementati
     deleteVolumeSnapshot: grpc.handleUnaryCall<core_pb.DeleteVolumeSnapshotRequest, core_pb.DeleteVolumeSnapshotResponse>;
     updateSSHKey: grpc.handleUnaryCall<core_pb.UpdateSSHKeyRequest, core_pb.UpdateSSHKeyResponse>;
     describeCluster: grpc.handleUnaryCall<core_pb.DescribeClusterRequest, core_pb.DescribeClusterResponse>;
+    relocateWorkspace: grpc.handleUnaryCall<core_pb.RelocateWorkspaceRequest, core_pb.RelocateWorkspaceResponse>;
+    getWorkspaceResourceUsage: grpc.handleServerStreamingCall<core_pb.GetWorkspaceResourceUsageRequest, core_pb.GetWorkspaceResourceUsageResponse>;
+    getWorkspaceDiskUsage: grpc.handleUnaryCall<core_pb.GetWorkspaceDiskUsageRequest, core_pb.GetWorkspaceDiskUsageResponse>;
+    updateWorkspaceClass: grpc.handleUnaryCall<core_pb.UpdateWorkspaceClassRequest, core_pb.UpdateWorkspaceClassResponse>;
+    drainNode: grpc.handleServerStreamingCall<core_pb.DrainNodeRequest, core_pb.DrainNodeResponse>;
+    exportSnapshot: grpc.handleUnaryCall<core_pb.ExportSnapshotRequest, core_pb.ExportSnapshotResponse>;
+    getQueuePosition: grpc.handleUnaryCall<core_pb.GetQueuePositionRequest, core_pb.GetQueuePositionResponse>;
+    listSnapshots: grpc.handleUnaryCall<core_pb.ListSnapshotsRequest, core_pb.ListSnapshotsResponse>;
+    streamWorkspaceLogs: grpc.handleServerStreamingCall<core_pb.StreamWorkspaceLogsRequest, core_pb.StreamWorkspaceLogsResponse>;
+    getLastActivity: grpc.handleUnaryCall<core_pb.GetLastActivityRequest, core_pb.GetLastActivityResponse>;
+    restoreSnapshot: grpc.handleUnaryCall<core_pb.RestoreSnapshotRequest, core_pb.RestoreSnapshotResponse>;
+    setRestartClass: grpc.handleUnaryCall<core_pb.SetRestartClassRequest, core_pb.SetRestartClassResponse>;
 }
 
 export interface IWorkspaceManagerClient {
@@ -220,6 +352,39 @@ export interface IWorkspaceManagerClient {
     describeCluster(request: core_pb.DescribeClusterRequest, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
+    relocateWorkspace(request: core_pb.RelocateWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: core_pb.RelocateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    relocateWorkspace(request: core_pb.RelocateWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.RelocateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    relocateWorkspace(request: core_pb.RelocateWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.RelocateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    getWorkspaceResourceUsage(request: core_pb.GetWorkspaceResourceUsageRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.GetWorkspaceResourceUsageResponse>;
+    getWorkspaceResourceUsage(request: core_pb.GetWorkspaceResourceUsageRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.GetWorkspaceResourceUsageResponse>;
+    getWorkspaceDiskUsage(request: core_pb.GetWorkspaceDiskUsageRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    getWorkspaceDiskUsage(request: core_pb.GetWorkspaceDiskUsageRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    getWorkspaceDiskUsage(request: core_pb.GetWorkspaceDiskUsageRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    updateWorkspaceClass(request: core_pb.UpdateWorkspaceClassRequest, callback: (error: grpc.ServiceError | null, response: core_pb.UpdateWorkspaceClassResponse) => void): grpc.ClientUnaryCall;
+    updateWorkspaceClass(request: core_pb.UpdateWorkspaceClassRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.UpdateWorkspaceClassResponse) => void): grpc.ClientUnaryCall;
+    updateWorkspaceClass(request: core_pb.UpdateWorkspaceClassRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.UpdateWorkspaceClassResponse) => void): grpc.ClientUnaryCall;
+    drainNode(request: core_pb.DrainNodeRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.DrainNodeResponse>;
+    drainNode(request: core_pb.DrainNodeRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.DrainNodeResponse>;
+    exportSnapshot(request: core_pb.ExportSnapshotRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ExportSnapshotResponse) => void): grpc.ClientUnaryCall;
+    exportSnapshot(request: core_pb.ExportSnapshotRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ExportSnapshotResponse) => void): grpc.ClientUnaryCall;
+    exportSnapshot(request: core_pb.ExportSnapshotRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ExportSnapshotResponse) => void): grpc.ClientUnaryCall;
+    getQueuePosition(request: core_pb.GetQueuePositionRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GetQueuePositionResponse) => void): grpc.ClientUnaryCall;
+    getQueuePosition(request: core_pb.GetQueuePositionRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GetQueuePositionResponse) => void): grpc.ClientUnaryCall;
+    getQueuePosition(request: core_pb.GetQueuePositionRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GetQueuePositionResponse) => void): grpc.ClientUnaryCall;
+    listSnapshots(request: core_pb.ListSnapshotsRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ListSnapshotsResponse) => void): grpc.ClientUnaryCall;
+    listSnapshots(request: core_pb.ListSnapshotsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ListSnapshotsResponse) => void): grpc.ClientUnaryCall;
+    listSnapshots(request: core_pb.ListSnapshotsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ListSnapshotsResponse) => void): grpc.ClientUnaryCall;
+    streamWorkspaceLogs(request: core_pb.StreamWorkspaceLogsRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.StreamWorkspaceLogsResponse>;
+    streamWorkspaceLogs(request: core_pb.StreamWorkspaceLogsRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.StreamWorkspaceLogsResponse>;
+    getLastActivity(request: core_pb.GetLastActivityRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GetLastActivityResponse) => void): grpc.ClientUnaryCall;
+    getLastActivity(request: core_pb.GetLastActivityRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GetLastActivityResponse) => void): grpc.ClientUnaryCall;
+    getLastActivity(request: core_pb.GetLastActivityRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GetLastActivityResponse) => void): grpc.ClientUnaryCall;
+    restoreSnapshot(request: core_pb.RestoreSnapshotRequest, callback: (error: grpc.ServiceError | null, response: core_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    restoreSnapshot(request: core_pb.RestoreSnapshotRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    restoreSnapshot(request: core_pb.RestoreSnapshotRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    setRestartClass(request: core_pb.SetRestartClassRequest, callback: (error: grpc.ServiceError | null, response: core_pb.SetRestartClassResponse) => void): grpc.ClientUnaryCall;
+    setRestartClass(request: core_pb.SetRestartClassRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.SetRestartClassResponse) => void): grpc.ClientUnaryCall;
+    setRestartClass(request: core_pb.SetRestartClassRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.SetRestartClassResponse) => void): grpc.ClientUnaryCall;
 }
 
 export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceManagerClient {
@@ -265,4 +430,70 @@ export class WorkspaceManagerClient extends grpc.Client implements IWorkspaceMan
     public describeCluster(request: core_pb.DescribeClusterRequest, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     public describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
     public describeCluster(request: core_pb.DescribeClusterRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.DescribeClusterResponse) => void): grpc.ClientUnaryCall;
+    public relocateWorkspace(request: core_pb.RelocateWorkspaceRequest, callback: (error: grpc.ServiceError | null, response: core_pb.RelocateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public relocateWorkspace(request: core_pb.RelocateWorkspaceRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.RelocateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public relocateWorkspace(request: core_pb.RelocateWorkspaceRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.RelocateWorkspaceResponse) => void): grpc.ClientUnaryCall;
+    public getWorkspaceResourceUsage(request: core_pb.GetWorkspaceResourceUsageRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.GetWorkspaceResourceUsageResponse>;
+    public getWorkspaceResourceUsage(request: core_pb.GetWorkspaceResourceUsageRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.GetWorkspaceResourceUsageResponse>;
+    public getWorkspaceDiskUsage(request: core_pb.GetWorkspaceDiskUsageRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    public getWorkspaceDiskUsage(request: core_pb.GetWorkspaceDiskUsageRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    public getWorkspaceDiskUsage(request: core_pb.GetWorkspaceDiskUsageRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GetWorkspaceDiskUsageResponse) => void): grpc.ClientUnaryCall;
+    public updateWorkspaceClass(request: core_pb.UpdateWorkspaceClassRequest, callback: (error: grpc.ServiceError | null, response: core_pb.UpdateWorkspaceClassResponse) => void): grpc.ClientUnaryCall;
+    public updateWorkspaceClass(request: core_pb.UpdateWorkspaceClassRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.UpdateWorkspaceClassResponse) => void): grpc.ClientUnaryCall;
+    public updateWorkspaceClass(request: core_pb.UpdateWorkspaceClassRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.UpdateWorkspaceClassResponse) => void): grpc.ClientUnaryCall;
+    public drainNode(request: core_pb.DrainNodeRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.DrainNodeResponse>;
+    public drainNode(request: core_pb.DrainNodeRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.DrainNodeResponse>;
+    public exportSnapshot(request: core_pb.ExportSnapshotRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ExportSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public exportSnapshot(request: core_pb.ExportSnapshotRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ExportSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public exportSnapshot(request: core_pb.ExportSnapshotRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ExportSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public getQueuePosition(request: core_pb.GetQueuePositionRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GetQueuePositionResponse) => void): grpc.ClientUnaryCall;
+    public getQueuePosition(request: core_pb.GetQueuePositionRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GetQueuePositionResponse) => void): grpc.ClientUnaryCall;
+    public getQueuePosition(request: core_pb.GetQueuePositionRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GetQueuePositionResponse) => void): grpc.ClientUnaryCall;
+    public listSnapshots(request: core_pb.ListSnapshotsRequest, callback: (error: grpc.ServiceError | null, response: core_pb.ListSnapshotsResponse) => void): grpc.ClientUnaryCall;
+    public listSnapshots(request: core_pb.ListSnapshotsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.ListSnapshotsResponse) => void): grpc.ClientUnaryCall;
+    public listSnapshots(request: core_pb.ListSnapshotsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.ListSnapshotsResponse) => void): grpc.ClientUnaryCall;
+    public streamWorkspaceLogs(request: core_pb.StreamWorkspaceLogsRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.StreamWorkspaceLogsResponse>;
+    public streamWorkspaceLogs(request: core_pb.StreamWorkspaceLogsRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<core_pb.StreamWorkspaceLogsResponse>;
+    public getLastActivity(request: core_pb.GetLastActivityRequest, callback: (error: grpc.ServiceError | null, response: core_pb.GetLastActivityResponse) => void): grpc.ClientUnaryCall;
+    public getLastActivity(request: core_pb.GetLastActivityRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.GetLastActivityResponse) => void): grpc.ClientUnaryCall;
+    public getLastActivity(request: core_pb.GetLastActivityRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.GetLastActivityResponse) => void): grpc.ClientUnaryCall;
+    public restoreSnapshot(request: core_pb.RestoreSnapshotRequest, callback: (error: grpc.ServiceError | null, response: core_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public restoreSnapshot(request: core_pb.RestoreSnapshotRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public restoreSnapshot(request: core_pb.RestoreSnapshotRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.RestoreSnapshotResponse) => void): grpc.ClientUnaryCall;
+    public setRestartClass(request: core_pb.SetRestartClassRequest, callback: (error: grpc.ServiceError | null, response: core_pb.SetRestartClassResponse) => void): grpc.ClientUnaryCall;
+    public setRestartClass(request: core_pb.SetRestartClassRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.SetRestartClassResponse) => void): grpc.ClientUnaryCall;
+    public setRestartClass(request: core_pb.SetRestartClassRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.SetRestartClassResponse) => void): grpc.ClientUnaryCall;
+}
+
+interface IHeadlessCompletionHookService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    workspaceCompleted: IHeadlessCompletionHookService_IWorkspaceCompleted;
+}
+
+interface IHeadlessCompletionHookService_IWorkspaceCompleted extends grpc.MethodDefinition<core_pb.HeadlessWorkspaceCompletion, core_pb.WorkspaceCompletedResponse> {
+    path: "/wsman.HeadlessCompletionHook/WorkspaceCompleted";
+    requestStream: false;
+    responseStream: false;
+    requestSerialize: grpc.serialize<core_pb.HeadlessWorkspaceCompletion>;
+    requestDeserialize: grpc.deserialize<core_pb.HeadlessWorkspaceCompletion>;
+    responseSerialize: grpc.serialize<core_pb.WorkspaceCompletedResponse>;
+    responseDeserialize: grpc.deserialize<core_pb.WorkspaceCompletedResponse>;
+}
+
+export const HeadlessCompletionHookService: IHeadlessCompletionHookService;
+
+export interface IHeadlessCompletionHookServer extends grpc.UntypedServiceImplementation {
+    workspaceCompleted: grpc.handleUnaryCall<core_pb.HeadlessWorkspaceCompletion, core_pb.WorkspaceCompletedResponse>;
+}
+
+export interface IHeadlessCompletionHookClient {
+    workspaceCompleted(request: core_pb.HeadlessWorkspaceCompletion, callback: (error: grpc.ServiceError | null, response: core_pb.WorkspaceCompletedResponse) => void): grpc.ClientUnaryCall;
+    workspaceCompleted(request: core_pb.HeadlessWorkspaceCompletion, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.WorkspaceCompletedResponse) => void): grpc.ClientUnaryCall;
+    workspaceCompleted(request: core_pb.HeadlessWorkspaceCompletion, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.WorkspaceCompletedResponse) => void): grpc.ClientUnaryCall;
+}
+
+export class HeadlessCompletionHookClient extends grpc.Client implements IHeadlessCompletionHookClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: Partial<grpc.ClientOptions>);
+    public workspaceCompleted(request: core_pb.HeadlessWorkspaceCompletion, callback: (error: grpc.ServiceError | null, response: core_pb.WorkspaceCompletedResponse) => void): grpc.ClientUnaryCall;
+    public workspaceCompleted(request: core_pb.HeadlessWorkspaceCompletion, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: core_pb.WorkspaceCompletedResponse) => void): grpc.ClientUnaryCall;
+    public workspaceCompleted(request: core_pb.HeadlessWorkspaceCompletion, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: core_pb.WorkspaceCompletedResponse) => void): grpc.ClientUnaryCall;
 }
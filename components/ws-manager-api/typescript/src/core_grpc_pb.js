@@ -144,6 +144,138 @@ function deserialize_wsman_DescribeWorkspaceResponse(buffer_arg) {
   return core_pb.DescribeWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_DrainNodeRequest(arg) {
+  if (!(arg instanceof core_pb.DrainNodeRequest)) {
+    throw new Error('Expected argument of type wsman.DrainNodeRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_DrainNodeRequest(buffer_arg) {
+  return core_pb.DrainNodeRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_DrainNodeResponse(arg) {
+  if (!(arg instanceof core_pb.DrainNodeResponse)) {
+    throw new Error('Expected argument of type wsman.DrainNodeResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_DrainNodeResponse(buffer_arg) {
+  return core_pb.DrainNodeResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_ExportSnapshotRequest(arg) {
+  if (!(arg instanceof core_pb.ExportSnapshotRequest)) {
+    throw new Error('Expected argument of type wsman.ExportSnapshotRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_ExportSnapshotRequest(buffer_arg) {
+  return core_pb.ExportSnapshotRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_ExportSnapshotResponse(arg) {
+  if (!(arg instanceof core_pb.ExportSnapshotResponse)) {
+    throw new Error('Expected argument of type wsman.ExportSnapshotResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_ExportSnapshotResponse(buffer_arg) {
+  return core_pb.ExportSnapshotResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GetLastActivityRequest(arg) {
+  if (!(arg instanceof core_pb.GetLastActivityRequest)) {
+    throw new Error('Expected argument of type wsman.GetLastActivityRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetLastActivityRequest(buffer_arg) {
+  return core_pb.GetLastActivityRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GetLastActivityResponse(arg) {
+  if (!(arg instanceof core_pb.GetLastActivityResponse)) {
+    throw new Error('Expected argument of type wsman.GetLastActivityResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetLastActivityResponse(buffer_arg) {
+  return core_pb.GetLastActivityResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GetQueuePositionRequest(arg) {
+  if (!(arg instanceof core_pb.GetQueuePositionRequest)) {
+    throw new Error('Expected argument of type wsman.GetQueuePositionRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetQueuePositionRequest(buffer_arg) {
+  return core_pb.GetQueuePositionRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GetQueuePositionResponse(arg) {
+  if (!(arg instanceof core_pb.GetQueuePositionResponse)) {
+    throw new Error('Expected argument of type wsman.GetQueuePositionResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetQueuePositionResponse(buffer_arg) {
+  return core_pb.GetQueuePositionResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GetWorkspaceDiskUsageRequest(arg) {
+  if (!(arg instanceof core_pb.GetWorkspaceDiskUsageRequest)) {
+    throw new Error('Expected argument of type wsman.GetWorkspaceDiskUsageRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetWorkspaceDiskUsageRequest(buffer_arg) {
+  return core_pb.GetWorkspaceDiskUsageRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GetWorkspaceDiskUsageResponse(arg) {
+  if (!(arg instanceof core_pb.GetWorkspaceDiskUsageResponse)) {
+    throw new Error('Expected argument of type wsman.GetWorkspaceDiskUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetWorkspaceDiskUsageResponse(buffer_arg) {
+  return core_pb.GetWorkspaceDiskUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GetWorkspaceResourceUsageRequest(arg) {
+  if (!(arg instanceof core_pb.GetWorkspaceResourceUsageRequest)) {
+    throw new Error('Expected argument of type wsman.GetWorkspaceResourceUsageRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetWorkspaceResourceUsageRequest(buffer_arg) {
+  return core_pb.GetWorkspaceResourceUsageRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_GetWorkspaceResourceUsageResponse(arg) {
+  if (!(arg instanceof core_pb.GetWorkspaceResourceUsageResponse)) {
+    throw new Error('Expected argument of type wsman.GetWorkspaceResourceUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_GetWorkspaceResourceUsageResponse(buffer_arg) {
+  return core_pb.GetWorkspaceResourceUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_GetWorkspacesRequest(arg) {
   if (!(arg instanceof core_pb.GetWorkspacesRequest)) {
     throw new Error('Expected argument of type wsman.GetWorkspacesRequest');
@@ -166,6 +298,39 @@ function deserialize_wsman_GetWorkspacesResponse(buffer_arg) {
   return core_pb.GetWorkspacesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_HeadlessWorkspaceCompletion(arg) {
+  if (!(arg instanceof core_pb.HeadlessWorkspaceCompletion)) {
+    throw new Error('Expected argument of type wsman.HeadlessWorkspaceCompletion');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_HeadlessWorkspaceCompletion(buffer_arg) {
+  return core_pb.HeadlessWorkspaceCompletion.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_ListSnapshotsRequest(arg) {
+  if (!(arg instanceof core_pb.ListSnapshotsRequest)) {
+    throw new Error('Expected argument of type wsman.ListSnapshotsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_ListSnapshotsRequest(buffer_arg) {
+  return core_pb.ListSnapshotsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_ListSnapshotsResponse(arg) {
+  if (!(arg instanceof core_pb.ListSnapshotsResponse)) {
+    throw new Error('Expected argument of type wsman.ListSnapshotsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_ListSnapshotsResponse(buffer_arg) {
+  return core_pb.ListSnapshotsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_MarkActiveRequest(arg) {
   if (!(arg instanceof core_pb.MarkActiveRequest)) {
     throw new Error('Expected argument of type wsman.MarkActiveRequest');
@@ -188,6 +353,72 @@ function deserialize_wsman_MarkActiveResponse(buffer_arg) {
   return core_pb.MarkActiveResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_RelocateWorkspaceRequest(arg) {
+  if (!(arg instanceof core_pb.RelocateWorkspaceRequest)) {
+    throw new Error('Expected argument of type wsman.RelocateWorkspaceRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_RelocateWorkspaceRequest(buffer_arg) {
+  return core_pb.RelocateWorkspaceRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_RelocateWorkspaceResponse(arg) {
+  if (!(arg instanceof core_pb.RelocateWorkspaceResponse)) {
+    throw new Error('Expected argument of type wsman.RelocateWorkspaceResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_RelocateWorkspaceResponse(buffer_arg) {
+  return core_pb.RelocateWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_RestoreSnapshotRequest(arg) {
+  if (!(arg instanceof core_pb.RestoreSnapshotRequest)) {
+    throw new Error('Expected argument of type wsman.RestoreSnapshotRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_RestoreSnapshotRequest(buffer_arg) {
+  return core_pb.RestoreSnapshotRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_RestoreSnapshotResponse(arg) {
+  if (!(arg instanceof core_pb.RestoreSnapshotResponse)) {
+    throw new Error('Expected argument of type wsman.RestoreSnapshotResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_RestoreSnapshotResponse(buffer_arg) {
+  return core_pb.RestoreSnapshotResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_SetRestartClassRequest(arg) {
+  if (!(arg instanceof core_pb.SetRestartClassRequest)) {
+    throw new Error('Expected argument of type wsman.SetRestartClassRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_SetRestartClassRequest(buffer_arg) {
+  return core_pb.SetRestartClassRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_SetRestartClassResponse(arg) {
+  if (!(arg instanceof core_pb.SetRestartClassResponse)) {
+    throw new Error('Expected argument of type wsman.SetRestartClassResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_SetRestartClassResponse(buffer_arg) {
+  return core_pb.SetRestartClassResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_SetTimeoutRequest(arg) {
   if (!(arg instanceof core_pb.SetTimeoutRequest)) {
     throw new Error('Expected argument of type wsman.SetTimeoutRequest');
@@ -254,6 +485,28 @@ function deserialize_wsman_StopWorkspaceResponse(buffer_arg) {
   return core_pb.StopWorkspaceResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_StreamWorkspaceLogsRequest(arg) {
+  if (!(arg instanceof core_pb.StreamWorkspaceLogsRequest)) {
+    throw new Error('Expected argument of type wsman.StreamWorkspaceLogsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_StreamWorkspaceLogsRequest(buffer_arg) {
+  return core_pb.StreamWorkspaceLogsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_StreamWorkspaceLogsResponse(arg) {
+  if (!(arg instanceof core_pb.StreamWorkspaceLogsResponse)) {
+    throw new Error('Expected argument of type wsman.StreamWorkspaceLogsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_StreamWorkspaceLogsResponse(buffer_arg) {
+  return core_pb.StreamWorkspaceLogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_wsman_SubscribeRequest(arg) {
   if (!(arg instanceof core_pb.SubscribeRequest)) {
     throw new Error('Expected argument of type wsman.SubscribeRequest');
@@ -320,6 +573,39 @@ function deserialize_wsman_UpdateSSHKeyResponse(buffer_arg) {
   return core_pb.UpdateSSHKeyResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_wsman_UpdateWorkspaceClassRequest(arg) {
+  if (!(arg instanceof core_pb.UpdateWorkspaceClassRequest)) {
+    throw new Error('Expected argument of type wsman.UpdateWorkspaceClassRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_UpdateWorkspaceClassRequest(buffer_arg) {
+  return core_pb.UpdateWorkspaceClassRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_UpdateWorkspaceClassResponse(arg) {
+  if (!(arg instanceof core_pb.UpdateWorkspaceClassResponse)) {
+    throw new Error('Expected argument of type wsman.UpdateWorkspaceClassResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_UpdateWorkspaceClassResponse(buffer_arg) {
+  return core_pb.UpdateWorkspaceClassResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_wsman_WorkspaceCompletedResponse(arg) {
+  if (!(arg instanceof core_pb.WorkspaceCompletedResponse)) {
+    throw new Error('Expected argument of type wsman.WorkspaceCompletedResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_wsman_WorkspaceCompletedResponse(buffer_arg) {
+  return core_pb.WorkspaceCompletedResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 
 var WorkspaceManagerService = exports.WorkspaceManagerService = {
   // getWorkspaces produces a list of running workspaces and their status
@@ -490,6 +776,170 @@ describeCluster: {
     responseSerialize: serialize_wsman_DescribeClusterResponse,
     responseDeserialize: deserialize_wsman_DescribeClusterResponse,
   },
+  // relocateWorkspace stops a running workspace, backs up its content and restores it in a replacement pod on another node.
+// The workspace is not reachable until the replacement pod runs. Meanwhile its status has the INTERRUPTED phase and the relocating condition.
+relocateWorkspace: {
+    path: '/wsman.WorkspaceManager/RelocateWorkspace',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.RelocateWorkspaceRequest,
+    responseType: core_pb.RelocateWorkspaceResponse,
+    requestSerialize: serialize_wsman_RelocateWorkspaceRequest,
+    requestDeserialize: deserialize_wsman_RelocateWorkspaceRequest,
+    responseSerialize: serialize_wsman_RelocateWorkspaceResponse,
+    responseDeserialize: deserialize_wsman_RelocateWorkspaceResponse,
+  },
+  // getWorkspaceResourceUsage streams the resource usage of a running workspace as reported by ws-daemon
+getWorkspaceResourceUsage: {
+    path: '/wsman.WorkspaceManager/GetWorkspaceResourceUsage',
+    requestStream: false,
+    responseStream: true,
+    requestType: core_pb.GetWorkspaceResourceUsageRequest,
+    responseType: core_pb.GetWorkspaceResourceUsageResponse,
+    requestSerialize: serialize_wsman_GetWorkspaceResourceUsageRequest,
+    requestDeserialize: deserialize_wsman_GetWorkspaceResourceUsageRequest,
+    responseSerialize: serialize_wsman_GetWorkspaceResourceUsageResponse,
+    responseDeserialize: deserialize_wsman_GetWorkspaceResourceUsageResponse,
+  },
+  // getWorkspaceDiskUsage returns the disk usage of a running workspace broken down by where the data lives, as reported by ws-daemon
+getWorkspaceDiskUsage: {
+    path: '/wsman.WorkspaceManager/GetWorkspaceDiskUsage',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.GetWorkspaceDiskUsageRequest,
+    responseType: core_pb.GetWorkspaceDiskUsageResponse,
+    requestSerialize: serialize_wsman_GetWorkspaceDiskUsageRequest,
+    requestDeserialize: deserialize_wsman_GetWorkspaceDiskUsageRequest,
+    responseSerialize: serialize_wsman_GetWorkspaceDiskUsageResponse,
+    responseDeserialize: deserialize_wsman_GetWorkspaceDiskUsageResponse,
+  },
+  // updateWorkspaceClass moves a running workspace to another class, resizing its pod in place where possible
+updateWorkspaceClass: {
+    path: '/wsman.WorkspaceManager/UpdateWorkspaceClass',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.UpdateWorkspaceClassRequest,
+    responseType: core_pb.UpdateWorkspaceClassResponse,
+    requestSerialize: serialize_wsman_UpdateWorkspaceClassRequest,
+    requestDeserialize: deserialize_wsman_UpdateWorkspaceClassRequest,
+    responseSerialize: serialize_wsman_UpdateWorkspaceClassResponse,
+    responseDeserialize: deserialize_wsman_UpdateWorkspaceClassResponse,
+  },
+  // drainNode stops scheduling workspaces to a node and stops the workspaces running on it, streaming the progress of their backups
+drainNode: {
+    path: '/wsman.WorkspaceManager/DrainNode',
+    requestStream: false,
+    responseStream: true,
+    requestType: core_pb.DrainNodeRequest,
+    responseType: core_pb.DrainNodeResponse,
+    requestSerialize: serialize_wsman_DrainNodeRequest,
+    requestDeserialize: deserialize_wsman_DrainNodeRequest,
+    responseSerialize: serialize_wsman_DrainNodeResponse,
+    responseDeserialize: deserialize_wsman_DrainNodeResponse,
+  },
+  // exportSnapshot takes a snapshot of a running workspace and pushes its content as OCI image to the registry configured in ws-daemon
+exportSnapshot: {
+    path: '/wsman.WorkspaceManager/ExportSnapshot',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.ExportSnapshotRequest,
+    responseType: core_pb.ExportSnapshotResponse,
+    requestSerialize: serialize_wsman_ExportSnapshotRequest,
+    requestDeserialize: deserialize_wsman_ExportSnapshotRequest,
+    responseSerialize: serialize_wsman_ExportSnapshotResponse,
+    responseDeserialize: deserialize_wsman_ExportSnapshotResponse,
+  },
+  // getQueuePosition returns the position of a prebuild in the prebuild queue
+getQueuePosition: {
+    path: '/wsman.WorkspaceManager/GetQueuePosition',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.GetQueuePositionRequest,
+    responseType: core_pb.GetQueuePositionResponse,
+    requestSerialize: serialize_wsman_GetQueuePositionRequest,
+    requestDeserialize: deserialize_wsman_GetQueuePositionRequest,
+    responseSerialize: serialize_wsman_GetQueuePositionResponse,
+    responseDeserialize: deserialize_wsman_GetQueuePositionResponse,
+  },
+  // listSnapshots lists the snapshots of the workspaces which are still known to this cluster
+listSnapshots: {
+    path: '/wsman.WorkspaceManager/ListSnapshots',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.ListSnapshotsRequest,
+    responseType: core_pb.ListSnapshotsResponse,
+    requestSerialize: serialize_wsman_ListSnapshotsRequest,
+    requestDeserialize: deserialize_wsman_ListSnapshotsRequest,
+    responseSerialize: serialize_wsman_ListSnapshotsResponse,
+    responseDeserialize: deserialize_wsman_ListSnapshotsResponse,
+  },
+  // streamWorkspaceLogs streams the task output of a headless workspace, e.g. of an image build or prebuild, while it runs
+streamWorkspaceLogs: {
+    path: '/wsman.WorkspaceManager/StreamWorkspaceLogs',
+    requestStream: false,
+    responseStream: true,
+    requestType: core_pb.StreamWorkspaceLogsRequest,
+    responseType: core_pb.StreamWorkspaceLogsResponse,
+    requestSerialize: serialize_wsman_StreamWorkspaceLogsRequest,
+    requestDeserialize: deserialize_wsman_StreamWorkspaceLogsRequest,
+    responseSerialize: serialize_wsman_StreamWorkspaceLogsResponse,
+    responseDeserialize: deserialize_wsman_StreamWorkspaceLogsResponse,
+  },
+  // getLastActivity returns when a workspace was last marked active, overall and per source of the activity
+getLastActivity: {
+    path: '/wsman.WorkspaceManager/GetLastActivity',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.GetLastActivityRequest,
+    responseType: core_pb.GetLastActivityResponse,
+    requestSerialize: serialize_wsman_GetLastActivityRequest,
+    requestDeserialize: deserialize_wsman_GetLastActivityRequest,
+    responseSerialize: serialize_wsman_GetLastActivityResponse,
+    responseDeserialize: deserialize_wsman_GetLastActivityResponse,
+  },
+  // restoreSnapshot unpacks a snapshot or backup into a directory of a running workspace, without restarting it
+restoreSnapshot: {
+    path: '/wsman.WorkspaceManager/RestoreSnapshot',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.RestoreSnapshotRequest,
+    responseType: core_pb.RestoreSnapshotResponse,
+    requestSerialize: serialize_wsman_RestoreSnapshotRequest,
+    requestDeserialize: deserialize_wsman_RestoreSnapshotRequest,
+    responseSerialize: serialize_wsman_RestoreSnapshotResponse,
+    responseDeserialize: deserialize_wsman_RestoreSnapshotResponse,
+  },
+  // setRestartClass marks a stopped workspace to start with another workspace class the next time it starts
+setRestartClass: {
+    path: '/wsman.WorkspaceManager/SetRestartClass',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.SetRestartClassRequest,
+    responseType: core_pb.SetRestartClassResponse,
+    requestSerialize: serialize_wsman_SetRestartClassRequest,
+    requestDeserialize: deserialize_wsman_SetRestartClassRequest,
+    responseSerialize: serialize_wsman_SetRestartClassResponse,
+    responseDeserialize: deserialize_wsman_SetRestartClassResponse,
+  },
 };
 
 exports.WorkspaceManagerClient = grpc.makeGenericClientConstructor(WorkspaceManagerService);
+// HeadlessCompletionHook is implemented by external systems, e.g. CI status reporters or cache warmers, which want
+// to be notified by ws-manager once a headless workspace completed.
+var HeadlessCompletionHookService = exports.HeadlessCompletionHookService = {
+  // workspaceCompleted is called once a headless workspace has stopped. Calls which fail are retried, hence the same
+// completion can be delivered more than once.
+workspaceCompleted: {
+    path: '/wsman.HeadlessCompletionHook/WorkspaceCompleted',
+    requestStream: false,
+    responseStream: false,
+    requestType: core_pb.HeadlessWorkspaceCompletion,
+    responseType: core_pb.WorkspaceCompletedResponse,
+    requestSerialize: serialize_wsman_HeadlessWorkspaceCompletion,
+    requestDeserialize: deserialize_wsman_HeadlessWorkspaceCompletion,
+    responseSerialize: serialize_wsman_WorkspaceCompletedResponse,
+    responseDeserialize: deserialize_wsman_WorkspaceCompletedResponse,
+  },
+};
+
+exports.HeadlessCompletionHookClient = grpc.makeGenericClientConstructor(HeadlessCompletionHookService);
@@ -104,6 +104,10 @@ export class StartWorkspaceRequest extends jspb.Message {
     setSpec(value?: StartWorkspaceSpec): StartWorkspaceRequest;
     getType(): WorkspaceType;
     setType(value: WorkspaceType): StartWorkspaceRequest;
+    getValidateOnly(): boolean;
+    setValidateOnly(value: boolean): StartWorkspaceRequest;
+    getIdempotencyKey(): string;
+    setIdempotencyKey(value: string): StartWorkspaceRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): StartWorkspaceRequest.AsObject;
@@ -122,6 +126,8 @@ export namespace StartWorkspaceRequest {
         metadata?: WorkspaceMetadata.AsObject,
         spec?: StartWorkspaceSpec.AsObject,
         type: WorkspaceType,
+        validateOnly: boolean,
+        idempotencyKey: string,
     }
 }
 
@@ -130,6 +136,10 @@ export class StartWorkspaceResponse extends jspb.Message {
     setUrl(value: string): StartWorkspaceResponse;
     getOwnerToken(): string;
     setOwnerToken(value: string): StartWorkspaceResponse;
+    clearProblemsList(): void;
+    getProblemsList(): Array<string>;
+    setProblemsList(value: Array<string>): StartWorkspaceResponse;
+    addProblems(value: string, index?: number): string;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): StartWorkspaceResponse.AsObject;
@@ -145,6 +155,7 @@ export namespace StartWorkspaceResponse {
     export type AsObject = {
         url: string,
         ownerToken: string,
+        problemsList: Array<string>,
     }
 }
 
@@ -153,6 +164,8 @@ export class StopWorkspaceRequest extends jspb.Message {
     setId(value: string): StopWorkspaceRequest;
     getPolicy(): StopWorkspacePolicy;
     setPolicy(value: StopWorkspacePolicy): StopWorkspaceRequest;
+    getReason(): StopReason;
+    setReason(value: StopReason): StopWorkspaceRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): StopWorkspaceRequest.AsObject;
@@ -168,6 +181,7 @@ export namespace StopWorkspaceRequest {
     export type AsObject = {
         id: string,
         policy: StopWorkspacePolicy,
+        reason: StopReason,
     }
 }
 
@@ -292,6 +306,8 @@ export class MarkActiveRequest extends jspb.Message {
     setClosed(value: boolean): MarkActiveRequest;
     getIgnoreIfActive(): boolean;
     setIgnoreIfActive(value: boolean): MarkActiveRequest;
+    getSource(): ActivitySource;
+    setSource(value: ActivitySource): MarkActiveRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): MarkActiveRequest.AsObject;
@@ -308,6 +324,7 @@ export namespace MarkActiveRequest {
         id: string,
         closed: boolean,
         ignoreIfActive: boolean,
+        source: ActivitySource,
     }
 }
 
@@ -328,6 +345,80 @@ export namespace MarkActiveResponse {
     }
 }
 
+export class GetLastActivityRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): GetLastActivityRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetLastActivityRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetLastActivityRequest): GetLastActivityRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetLastActivityRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetLastActivityRequest;
+    static deserializeBinaryFromReader(message: GetLastActivityRequest, reader: jspb.BinaryReader): GetLastActivityRequest;
+}
+
+export namespace GetLastActivityRequest {
+    export type AsObject = {
+        id: string,
+    }
+}
+
+export class GetLastActivityResponse extends jspb.Message {
+
+    hasLastActivity(): boolean;
+    clearLastActivity(): void;
+    getLastActivity(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setLastActivity(value?: google_protobuf_timestamp_pb.Timestamp): GetLastActivityResponse;
+    clearSourcesList(): void;
+    getSourcesList(): Array<SourceActivity>;
+    setSourcesList(value: Array<SourceActivity>): GetLastActivityResponse;
+    addSources(value?: SourceActivity, index?: number): SourceActivity;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetLastActivityResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetLastActivityResponse): GetLastActivityResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetLastActivityResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetLastActivityResponse;
+    static deserializeBinaryFromReader(message: GetLastActivityResponse, reader: jspb.BinaryReader): GetLastActivityResponse;
+}
+
+export namespace GetLastActivityResponse {
+    export type AsObject = {
+        lastActivity?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        sourcesList: Array<SourceActivity.AsObject>,
+    }
+}
+
+export class SourceActivity extends jspb.Message {
+    getSource(): ActivitySource;
+    setSource(value: ActivitySource): SourceActivity;
+
+    hasLastActivity(): boolean;
+    clearLastActivity(): void;
+    getLastActivity(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setLastActivity(value?: google_protobuf_timestamp_pb.Timestamp): SourceActivity;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SourceActivity.AsObject;
+    static toObject(includeInstance: boolean, msg: SourceActivity): SourceActivity.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SourceActivity, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SourceActivity;
+    static deserializeBinaryFromReader(message: SourceActivity, reader: jspb.BinaryReader): SourceActivity;
+}
+
+export namespace SourceActivity {
+    export type AsObject = {
+        source: ActivitySource,
+        lastActivity?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    }
+}
+
 export class SetTimeoutRequest extends jspb.Message {
     getId(): string;
     setId(value: string): SetTimeoutRequest;
@@ -422,6 +513,11 @@ export class TakeSnapshotRequest extends jspb.Message {
     setId(value: string): TakeSnapshotRequest;
     getReturnImmediately(): boolean;
     setReturnImmediately(value: boolean): TakeSnapshotRequest;
+    getMessage(): string;
+    setMessage(value: string): TakeSnapshotRequest;
+
+    getLabelsMap(): jspb.Map<string, string>;
+    clearLabelsMap(): void;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): TakeSnapshotRequest.AsObject;
@@ -437,6 +533,9 @@ export namespace TakeSnapshotRequest {
     export type AsObject = {
         id: string,
         returnImmediately: boolean,
+        message: string,
+
+        labelsMap: Array<[string, string]>,
     }
 }
 
@@ -460,11 +559,64 @@ export namespace TakeSnapshotResponse {
     }
 }
 
+export class RestoreSnapshotRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): RestoreSnapshotRequest;
+    getSnapshot(): string;
+    setSnapshot(value: string): RestoreSnapshotRequest;
+    getTarget(): string;
+    setTarget(value: string): RestoreSnapshotRequest;
+    getConflictPolicy(): RestoreConflictPolicy;
+    setConflictPolicy(value: RestoreConflictPolicy): RestoreSnapshotRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RestoreSnapshotRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RestoreSnapshotRequest): RestoreSnapshotRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RestoreSnapshotRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RestoreSnapshotRequest;
+    static deserializeBinaryFromReader(message: RestoreSnapshotRequest, reader: jspb.BinaryReader): RestoreSnapshotRequest;
+}
+
+export namespace RestoreSnapshotRequest {
+    export type AsObject = {
+        id: string,
+        snapshot: string,
+        target: string,
+        conflictPolicy: RestoreConflictPolicy,
+    }
+}
+
+export class RestoreSnapshotResponse extends jspb.Message {
+    clearConflictsList(): void;
+    getConflictsList(): Array<string>;
+    setConflictsList(value: Array<string>): RestoreSnapshotResponse;
+    addConflicts(value: string, index?: number): string;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RestoreSnapshotResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RestoreSnapshotResponse): RestoreSnapshotResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RestoreSnapshotResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RestoreSnapshotResponse;
+    static deserializeBinaryFromReader(message: RestoreSnapshotResponse, reader: jspb.BinaryReader): RestoreSnapshotResponse;
+}
+
+export namespace RestoreSnapshotResponse {
+    export type AsObject = {
+        conflictsList: Array<string>,
+    }
+}
+
 export class ControlAdmissionRequest extends jspb.Message {
     getId(): string;
     setId(value: string): ControlAdmissionRequest;
     getLevel(): AdmissionLevel;
     setLevel(value: AdmissionLevel): ControlAdmissionRequest;
+    getExpiry(): string;
+    setExpiry(value: string): ControlAdmissionRequest;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ControlAdmissionRequest.AsObject;
@@ -480,6 +632,7 @@ export namespace ControlAdmissionRequest {
     export type AsObject = {
         id: string,
         level: AdmissionLevel,
+        expiry: string,
     }
 }
 
@@ -631,6 +784,631 @@ export namespace UpdateSSHKeyResponse {
     }
 }
 
+export class RelocateWorkspaceRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): RelocateWorkspaceRequest;
+    getTargetNode(): string;
+    setTargetNode(value: string): RelocateWorkspaceRequest;
+    clearEnvvarsList(): void;
+    getEnvvarsList(): Array<EnvironmentVariable>;
+    setEnvvarsList(value: Array<EnvironmentVariable>): RelocateWorkspaceRequest;
+    addEnvvars(value?: EnvironmentVariable, index?: number): EnvironmentVariable;
+    clearSysEnvvarsList(): void;
+    getSysEnvvarsList(): Array<EnvironmentVariable>;
+    setSysEnvvarsList(value: Array<EnvironmentVariable>): RelocateWorkspaceRequest;
+    addSysEnvvars(value?: EnvironmentVariable, index?: number): EnvironmentVariable;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RelocateWorkspaceRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RelocateWorkspaceRequest): RelocateWorkspaceRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RelocateWorkspaceRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RelocateWorkspaceRequest;
+    static deserializeBinaryFromReader(message: RelocateWorkspaceRequest, reader: jspb.BinaryReader): RelocateWorkspaceRequest;
+}
+
+export namespace RelocateWorkspaceRequest {
+    export type AsObject = {
+        id: string,
+        targetNode: string,
+        envvarsList: Array<EnvironmentVariable.AsObject>,
+        sysEnvvarsList: Array<EnvironmentVariable.AsObject>,
+    }
+}
+
+export class RelocateWorkspaceResponse extends jspb.Message {
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RelocateWorkspaceResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RelocateWorkspaceResponse): RelocateWorkspaceResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RelocateWorkspaceResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RelocateWorkspaceResponse;
+    static deserializeBinaryFromReader(message: RelocateWorkspaceResponse, reader: jspb.BinaryReader): RelocateWorkspaceResponse;
+}
+
+export namespace RelocateWorkspaceResponse {
+    export type AsObject = {
+    }
+}
+
+export class UpdateWorkspaceClassRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): UpdateWorkspaceClassRequest;
+    getWorkspaceClass(): string;
+    setWorkspaceClass(value: string): UpdateWorkspaceClassRequest;
+    clearEnvvarsList(): void;
+    getEnvvarsList(): Array<EnvironmentVariable>;
+    setEnvvarsList(value: Array<EnvironmentVariable>): UpdateWorkspaceClassRequest;
+    addEnvvars(value?: EnvironmentVariable, index?: number): EnvironmentVariable;
+    clearSysEnvvarsList(): void;
+    getSysEnvvarsList(): Array<EnvironmentVariable>;
+    setSysEnvvarsList(value: Array<EnvironmentVariable>): UpdateWorkspaceClassRequest;
+    addSysEnvvars(value?: EnvironmentVariable, index?: number): EnvironmentVariable;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): UpdateWorkspaceClassRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: UpdateWorkspaceClassRequest): UpdateWorkspaceClassRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: UpdateWorkspaceClassRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): UpdateWorkspaceClassRequest;
+    static deserializeBinaryFromReader(message: UpdateWorkspaceClassRequest, reader: jspb.BinaryReader): UpdateWorkspaceClassRequest;
+}
+
+export namespace UpdateWorkspaceClassRequest {
+    export type AsObject = {
+        id: string,
+        workspaceClass: string,
+        envvarsList: Array<EnvironmentVariable.AsObject>,
+        sysEnvvarsList: Array<EnvironmentVariable.AsObject>,
+    }
+}
+
+export class UpdateWorkspaceClassResponse extends jspb.Message {
+    getRestarting(): boolean;
+    setRestarting(value: boolean): UpdateWorkspaceClassResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): UpdateWorkspaceClassResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: UpdateWorkspaceClassResponse): UpdateWorkspaceClassResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: UpdateWorkspaceClassResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): UpdateWorkspaceClassResponse;
+    static deserializeBinaryFromReader(message: UpdateWorkspaceClassResponse, reader: jspb.BinaryReader): UpdateWorkspaceClassResponse;
+}
+
+export namespace UpdateWorkspaceClassResponse {
+    export type AsObject = {
+        restarting: boolean,
+    }
+}
+
+export class SetRestartClassRequest extends jspb.Message {
+    getWorkspaceId(): string;
+    setWorkspaceId(value: string): SetRestartClassRequest;
+    getWorkspaceClass(): string;
+    setWorkspaceClass(value: string): SetRestartClassRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SetRestartClassRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: SetRestartClassRequest): SetRestartClassRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SetRestartClassRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SetRestartClassRequest;
+    static deserializeBinaryFromReader(message: SetRestartClassRequest, reader: jspb.BinaryReader): SetRestartClassRequest;
+}
+
+export namespace SetRestartClassRequest {
+    export type AsObject = {
+        workspaceId: string,
+        workspaceClass: string,
+    }
+}
+
+export class SetRestartClassResponse extends jspb.Message {
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SetRestartClassResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: SetRestartClassResponse): SetRestartClassResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SetRestartClassResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SetRestartClassResponse;
+    static deserializeBinaryFromReader(message: SetRestartClassResponse, reader: jspb.BinaryReader): SetRestartClassResponse;
+}
+
+export namespace SetRestartClassResponse {
+    export type AsObject = {
+    }
+}
+
+export class ListSnapshotsRequest extends jspb.Message {
+
+    hasMustMatch(): boolean;
+    clearMustMatch(): void;
+    getMustMatch(): MetadataFilter | undefined;
+    setMustMatch(value?: MetadataFilter): ListSnapshotsRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListSnapshotsRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ListSnapshotsRequest): ListSnapshotsRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListSnapshotsRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListSnapshotsRequest;
+    static deserializeBinaryFromReader(message: ListSnapshotsRequest, reader: jspb.BinaryReader): ListSnapshotsRequest;
+}
+
+export namespace ListSnapshotsRequest {
+    export type AsObject = {
+        mustMatch?: MetadataFilter.AsObject,
+    }
+}
+
+export class ListSnapshotsResponse extends jspb.Message {
+    clearSnapshotsList(): void;
+    getSnapshotsList(): Array<SnapshotInfo>;
+    setSnapshotsList(value: Array<SnapshotInfo>): ListSnapshotsResponse;
+    addSnapshots(value?: SnapshotInfo, index?: number): SnapshotInfo;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ListSnapshotsResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ListSnapshotsResponse): ListSnapshotsResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ListSnapshotsResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ListSnapshotsResponse;
+    static deserializeBinaryFromReader(message: ListSnapshotsResponse, reader: jspb.BinaryReader): ListSnapshotsResponse;
+}
+
+export namespace ListSnapshotsResponse {
+    export type AsObject = {
+        snapshotsList: Array<SnapshotInfo.AsObject>,
+    }
+}
+
+export class SnapshotInfo extends jspb.Message {
+    getId(): string;
+    setId(value: string): SnapshotInfo;
+    getInstanceId(): string;
+    setInstanceId(value: string): SnapshotInfo;
+    getMetaId(): string;
+    setMetaId(value: string): SnapshotInfo;
+    getOwner(): string;
+    setOwner(value: string): SnapshotInfo;
+    getUrl(): string;
+    setUrl(value: string): SnapshotInfo;
+    getMessage(): string;
+    setMessage(value: string): SnapshotInfo;
+
+    getLabelsMap(): jspb.Map<string, string>;
+    clearLabelsMap(): void;
+
+    hasCreationTime(): boolean;
+    clearCreationTime(): void;
+    getCreationTime(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setCreationTime(value?: google_protobuf_timestamp_pb.Timestamp): SnapshotInfo;
+    getSize(): number;
+    setSize(value: number): SnapshotInfo;
+    getCompleted(): boolean;
+    setCompleted(value: boolean): SnapshotInfo;
+    getError(): string;
+    setError(value: string): SnapshotInfo;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): SnapshotInfo.AsObject;
+    static toObject(includeInstance: boolean, msg: SnapshotInfo): SnapshotInfo.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: SnapshotInfo, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): SnapshotInfo;
+    static deserializeBinaryFromReader(message: SnapshotInfo, reader: jspb.BinaryReader): SnapshotInfo;
+}
+
+export namespace SnapshotInfo {
+    export type AsObject = {
+        id: string,
+        instanceId: string,
+        metaId: string,
+        owner: string,
+        url: string,
+        message: string,
+
+        labelsMap: Array<[string, string]>,
+        creationTime?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        size: number,
+        completed: boolean,
+        error: string,
+    }
+}
+
+export class ExportSnapshotRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): ExportSnapshotRequest;
+    getTag(): string;
+    setTag(value: string): ExportSnapshotRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ExportSnapshotRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ExportSnapshotRequest): ExportSnapshotRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ExportSnapshotRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ExportSnapshotRequest;
+    static deserializeBinaryFromReader(message: ExportSnapshotRequest, reader: jspb.BinaryReader): ExportSnapshotRequest;
+}
+
+export namespace ExportSnapshotRequest {
+    export type AsObject = {
+        id: string,
+        tag: string,
+    }
+}
+
+export class ExportSnapshotResponse extends jspb.Message {
+    getUrl(): string;
+    setUrl(value: string): ExportSnapshotResponse;
+    getImageRef(): string;
+    setImageRef(value: string): ExportSnapshotResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ExportSnapshotResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: ExportSnapshotResponse): ExportSnapshotResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ExportSnapshotResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ExportSnapshotResponse;
+    static deserializeBinaryFromReader(message: ExportSnapshotResponse, reader: jspb.BinaryReader): ExportSnapshotResponse;
+}
+
+export namespace ExportSnapshotResponse {
+    export type AsObject = {
+        url: string,
+        imageRef: string,
+    }
+}
+
+export class GetQueuePositionRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): GetQueuePositionRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetQueuePositionRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetQueuePositionRequest): GetQueuePositionRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetQueuePositionRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetQueuePositionRequest;
+    static deserializeBinaryFromReader(message: GetQueuePositionRequest, reader: jspb.BinaryReader): GetQueuePositionRequest;
+}
+
+export namespace GetQueuePositionRequest {
+    export type AsObject = {
+        id: string,
+    }
+}
+
+export class GetQueuePositionResponse extends jspb.Message {
+    getQueued(): boolean;
+    setQueued(value: boolean): GetQueuePositionResponse;
+    getPosition(): number;
+    setPosition(value: number): GetQueuePositionResponse;
+    getLength(): number;
+    setLength(value: number): GetQueuePositionResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetQueuePositionResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetQueuePositionResponse): GetQueuePositionResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetQueuePositionResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetQueuePositionResponse;
+    static deserializeBinaryFromReader(message: GetQueuePositionResponse, reader: jspb.BinaryReader): GetQueuePositionResponse;
+}
+
+export namespace GetQueuePositionResponse {
+    export type AsObject = {
+        queued: boolean,
+        position: number,
+        length: number,
+    }
+}
+
+export class StreamWorkspaceLogsRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): StreamWorkspaceLogsRequest;
+    getFollow(): boolean;
+    setFollow(value: boolean): StreamWorkspaceLogsRequest;
+    getBookmark(): string;
+    setBookmark(value: string): StreamWorkspaceLogsRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): StreamWorkspaceLogsRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: StreamWorkspaceLogsRequest): StreamWorkspaceLogsRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: StreamWorkspaceLogsRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): StreamWorkspaceLogsRequest;
+    static deserializeBinaryFromReader(message: StreamWorkspaceLogsRequest, reader: jspb.BinaryReader): StreamWorkspaceLogsRequest;
+}
+
+export namespace StreamWorkspaceLogsRequest {
+    export type AsObject = {
+        id: string,
+        follow: boolean,
+        bookmark: string,
+    }
+}
+
+export class StreamWorkspaceLogsResponse extends jspb.Message {
+    getTaskId(): string;
+    setTaskId(value: string): StreamWorkspaceLogsResponse;
+    getTaskName(): string;
+    setTaskName(value: string): StreamWorkspaceLogsResponse;
+    getData(): Uint8Array | string;
+    getData_asU8(): Uint8Array;
+    getData_asB64(): string;
+    setData(value: Uint8Array | string): StreamWorkspaceLogsResponse;
+    getBookmark(): string;
+    setBookmark(value: string): StreamWorkspaceLogsResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): StreamWorkspaceLogsResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: StreamWorkspaceLogsResponse): StreamWorkspaceLogsResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: StreamWorkspaceLogsResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): StreamWorkspaceLogsResponse;
+    static deserializeBinaryFromReader(message: StreamWorkspaceLogsResponse, reader: jspb.BinaryReader): StreamWorkspaceLogsResponse;
+}
+
+export namespace StreamWorkspaceLogsResponse {
+    export type AsObject = {
+        taskId: string,
+        taskName: string,
+        data: Uint8Array | string,
+        bookmark: string,
+    }
+}
+
+export class DrainNodeRequest extends jspb.Message {
+    getNodeName(): string;
+    setNodeName(value: string): DrainNodeRequest;
+    getTimeout(): string;
+    setTimeout(value: string): DrainNodeRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DrainNodeRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: DrainNodeRequest): DrainNodeRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DrainNodeRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DrainNodeRequest;
+    static deserializeBinaryFromReader(message: DrainNodeRequest, reader: jspb.BinaryReader): DrainNodeRequest;
+}
+
+export namespace DrainNodeRequest {
+    export type AsObject = {
+        nodeName: string,
+        timeout: string,
+    }
+}
+
+export class DrainNodeResponse extends jspb.Message {
+    getTotal(): number;
+    setTotal(value: number): DrainNodeResponse;
+    clearRemainingList(): void;
+    getRemainingList(): Array<string>;
+    setRemainingList(value: Array<string>): DrainNodeResponse;
+    addRemaining(value: string, index?: number): string;
+    clearFailedList(): void;
+    getFailedList(): Array<string>;
+    setFailedList(value: Array<string>): DrainNodeResponse;
+    addFailed(value: string, index?: number): string;
+    getDone(): boolean;
+    setDone(value: boolean): DrainNodeResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DrainNodeResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: DrainNodeResponse): DrainNodeResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DrainNodeResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DrainNodeResponse;
+    static deserializeBinaryFromReader(message: DrainNodeResponse, reader: jspb.BinaryReader): DrainNodeResponse;
+}
+
+export namespace DrainNodeResponse {
+    export type AsObject = {
+        total: number,
+        remainingList: Array<string>,
+        failedList: Array<string>,
+        done: boolean,
+    }
+}
+
+export class GetWorkspaceResourceUsageRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): GetWorkspaceResourceUsageRequest;
+    getInterval(): string;
+    setInterval(value: string): GetWorkspaceResourceUsageRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetWorkspaceResourceUsageRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetWorkspaceResourceUsageRequest): GetWorkspaceResourceUsageRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetWorkspaceResourceUsageRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetWorkspaceResourceUsageRequest;
+    static deserializeBinaryFromReader(message: GetWorkspaceResourceUsageRequest, reader: jspb.BinaryReader): GetWorkspaceResourceUsageRequest;
+}
+
+export namespace GetWorkspaceResourceUsageRequest {
+    export type AsObject = {
+        id: string,
+        interval: string,
+    }
+}
+
+export class GetWorkspaceResourceUsageResponse extends jspb.Message {
+
+    hasUsage(): boolean;
+    clearUsage(): void;
+    getUsage(): WorkspaceResourceUsage | undefined;
+    setUsage(value?: WorkspaceResourceUsage): GetWorkspaceResourceUsageResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetWorkspaceResourceUsageResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetWorkspaceResourceUsageResponse): GetWorkspaceResourceUsageResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetWorkspaceResourceUsageResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetWorkspaceResourceUsageResponse;
+    static deserializeBinaryFromReader(message: GetWorkspaceResourceUsageResponse, reader: jspb.BinaryReader): GetWorkspaceResourceUsageResponse;
+}
+
+export namespace GetWorkspaceResourceUsageResponse {
+    export type AsObject = {
+        usage?: WorkspaceResourceUsage.AsObject,
+    }
+}
+
+export class GetWorkspaceDiskUsageRequest extends jspb.Message {
+    getId(): string;
+    setId(value: string): GetWorkspaceDiskUsageRequest;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetWorkspaceDiskUsageRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: GetWorkspaceDiskUsageRequest): GetWorkspaceDiskUsageRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetWorkspaceDiskUsageRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetWorkspaceDiskUsageRequest;
+    static deserializeBinaryFromReader(message: GetWorkspaceDiskUsageRequest, reader: jspb.BinaryReader): GetWorkspaceDiskUsageRequest;
+}
+
+export namespace GetWorkspaceDiskUsageRequest {
+    export type AsObject = {
+        id: string,
+    }
+}
+
+export class GetWorkspaceDiskUsageResponse extends jspb.Message {
+
+    hasWorkspace(): boolean;
+    clearWorkspace(): void;
+    getWorkspace(): ResourceUsage | undefined;
+    setWorkspace(value?: ResourceUsage): GetWorkspaceDiskUsageResponse;
+
+    hasTmp(): boolean;
+    clearTmp(): void;
+    getTmp(): ResourceUsage | undefined;
+    setTmp(value?: ResourceUsage): GetWorkspaceDiskUsageResponse;
+
+    hasScratch(): boolean;
+    clearScratch(): void;
+    getScratch(): ResourceUsage | undefined;
+    setScratch(value?: ResourceUsage): GetWorkspaceDiskUsageResponse;
+
+    hasCollectedAt(): boolean;
+    clearCollectedAt(): void;
+    getCollectedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setCollectedAt(value?: google_protobuf_timestamp_pb.Timestamp): GetWorkspaceDiskUsageResponse;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GetWorkspaceDiskUsageResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: GetWorkspaceDiskUsageResponse): GetWorkspaceDiskUsageResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GetWorkspaceDiskUsageResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GetWorkspaceDiskUsageResponse;
+    static deserializeBinaryFromReader(message: GetWorkspaceDiskUsageResponse, reader: jspb.BinaryReader): GetWorkspaceDiskUsageResponse;
+}
+
+export namespace GetWorkspaceDiskUsageResponse {
+    export type AsObject = {
+        workspace?: ResourceUsage.AsObject,
+        tmp?: ResourceUsage.AsObject,
+        scratch?: ResourceUsage.AsObject,
+        collectedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    }
+}
+
+export class WorkspaceResourceUsage extends jspb.Message {
+
+    hasCpu(): boolean;
+    clearCpu(): void;
+    getCpu(): ResourceUsage | undefined;
+    setCpu(value?: ResourceUsage): WorkspaceResourceUsage;
+
+    hasMemory(): boolean;
+    clearMemory(): void;
+    getMemory(): ResourceUsage | undefined;
+    setMemory(value?: ResourceUsage): WorkspaceResourceUsage;
+
+    hasDisk(): boolean;
+    clearDisk(): void;
+    getDisk(): ResourceUsage | undefined;
+    setDisk(value?: ResourceUsage): WorkspaceResourceUsage;
+    getNetworkRxBytes(): number;
+    setNetworkRxBytes(value: number): WorkspaceResourceUsage;
+    getNetworkTxBytes(): number;
+    setNetworkTxBytes(value: number): WorkspaceResourceUsage;
+
+    hasCollectedAt(): boolean;
+    clearCollectedAt(): void;
+    getCollectedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setCollectedAt(value?: google_protobuf_timestamp_pb.Timestamp): WorkspaceResourceUsage;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceResourceUsage.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceResourceUsage): WorkspaceResourceUsage.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceResourceUsage, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceResourceUsage;
+    static deserializeBinaryFromReader(message: WorkspaceResourceUsage, reader: jspb.BinaryReader): WorkspaceResourceUsage;
+}
+
+export namespace WorkspaceResourceUsage {
+    export type AsObject = {
+        cpu?: ResourceUsage.AsObject,
+        memory?: ResourceUsage.AsObject,
+        disk?: ResourceUsage.AsObject,
+        networkRxBytes: number,
+        networkTxBytes: number,
+        collectedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    }
+}
+
+export class ResourceUsage extends jspb.Message {
+    getUsed(): number;
+    setUsed(value: number): ResourceUsage;
+    getLimit(): number;
+    setLimit(value: number): ResourceUsage;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ResourceUsage.AsObject;
+    static toObject(includeInstance: boolean, msg: ResourceUsage): ResourceUsage.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ResourceUsage, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ResourceUsage;
+    static deserializeBinaryFromReader(message: ResourceUsage, reader: jspb.BinaryReader): ResourceUsage;
+}
+
+export namespace ResourceUsage {
+    export type AsObject = {
+        used: number,
+        limit: number,
+    }
+}
+
 export class WorkspaceStatus extends jspb.Message {
     getId(): string;
     setId(value: string): WorkspaceStatus;
@@ -671,6 +1449,11 @@ export class WorkspaceStatus extends jspb.Message {
     getAuth(): WorkspaceAuthentication | undefined;
     setAuth(value?: WorkspaceAuthentication): WorkspaceStatus;
 
+    hasQueue(): boolean;
+    clearQueue(): void;
+    getQueue(): WorkspaceQueueStatus | undefined;
+    setQueue(value?: WorkspaceQueueStatus): WorkspaceStatus;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceStatus.AsObject;
     static toObject(includeInstance: boolean, msg: WorkspaceStatus): WorkspaceStatus.AsObject;
@@ -693,6 +1476,36 @@ export namespace WorkspaceStatus {
         repo?: content_service_api_initializer_pb.GitStatus.AsObject,
         runtime?: WorkspaceRuntimeInfo.AsObject,
         auth?: WorkspaceAuthentication.AsObject,
+        queue?: WorkspaceQueueStatus.AsObject,
+    }
+}
+
+export class WorkspaceQueueStatus extends jspb.Message {
+    getPosition(): number;
+    setPosition(value: number): WorkspaceQueueStatus;
+    getLength(): number;
+    setLength(value: number): WorkspaceQueueStatus;
+
+    hasEstimatedStart(): boolean;
+    clearEstimatedStart(): void;
+    getEstimatedStart(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setEstimatedStart(value?: google_protobuf_timestamp_pb.Timestamp): WorkspaceQueueStatus;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceQueueStatus.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceQueueStatus): WorkspaceQueueStatus.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceQueueStatus, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceQueueStatus;
+    static deserializeBinaryFromReader(message: WorkspaceQueueStatus, reader: jspb.BinaryReader): WorkspaceQueueStatus;
+}
+
+export namespace WorkspaceQueueStatus {
+    export type AsObject = {
+        position: number,
+        length: number,
+        estimatedStart?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 }
 
@@ -856,6 +1669,26 @@ export class WorkspaceConditions extends jspb.Message {
     setVolumeSnapshot(value?: VolumeSnapshotInfo): WorkspaceConditions;
     getAborted(): WorkspaceConditionBool;
     setAborted(value: WorkspaceConditionBool): WorkspaceConditions;
+    getPullingImageFailed(): string;
+    setPullingImageFailed(value: string): WorkspaceConditions;
+    getNodePreempted(): string;
+    setNodePreempted(value: string): WorkspaceConditions;
+    getStopReason(): StopReason;
+    setStopReason(value: StopReason): WorkspaceConditions;
+
+    hasContentReady(): boolean;
+    clearContentReady(): void;
+    getContentReady(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setContentReady(value?: google_protobuf_timestamp_pb.Timestamp): WorkspaceConditions;
+
+    hasEverReady(): boolean;
+    clearEverReady(): void;
+    getEverReady(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setEverReady(value?: google_protobuf_timestamp_pb.Timestamp): WorkspaceConditions;
+    getOomKill(): string;
+    setOomKill(value: string): WorkspaceConditions;
+    getRelocating(): string;
+    setRelocating(value: string): WorkspaceConditions;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceConditions.AsObject;
@@ -881,6 +1714,86 @@ export namespace WorkspaceConditions {
         stoppedByRequest: WorkspaceConditionBool,
         volumeSnapshot?: VolumeSnapshotInfo.AsObject,
         aborted: WorkspaceConditionBool,
+        pullingImageFailed: string,
+        nodePreempted: string,
+        stopReason: StopReason,
+        contentReady?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        everReady?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+        oomKill: string,
+        relocating: string,
+    }
+}
+
+export class HeadlessWorkspaceCompletion extends jspb.Message {
+    getId(): string;
+    setId(value: string): HeadlessWorkspaceCompletion;
+
+    hasMetadata(): boolean;
+    clearMetadata(): void;
+    getMetadata(): WorkspaceMetadata | undefined;
+    setMetadata(value?: WorkspaceMetadata): HeadlessWorkspaceCompletion;
+    getType(): WorkspaceType;
+    setType(value: WorkspaceType): HeadlessWorkspaceCompletion;
+    getSuccess(): boolean;
+    setSuccess(value: boolean): HeadlessWorkspaceCompletion;
+    getFailed(): string;
+    setFailed(value: string): HeadlessWorkspaceCompletion;
+    getHeadlessTaskFailed(): string;
+    setHeadlessTaskFailed(value: string): HeadlessWorkspaceCompletion;
+
+    hasExitCode(): boolean;
+    clearExitCode(): void;
+    getExitCode(): number | undefined;
+    setExitCode(value: number): HeadlessWorkspaceCompletion;
+    getSnapshot(): string;
+    setSnapshot(value: string): HeadlessWorkspaceCompletion;
+    getStopReason(): StopReason;
+    setStopReason(value: StopReason): HeadlessWorkspaceCompletion;
+
+    hasCompletedAt(): boolean;
+    clearCompletedAt(): void;
+    getCompletedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setCompletedAt(value?: google_protobuf_timestamp_pb.Timestamp): HeadlessWorkspaceCompletion;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): HeadlessWorkspaceCompletion.AsObject;
+    static toObject(includeInstance: boolean, msg: HeadlessWorkspaceCompletion): HeadlessWorkspaceCompletion.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: HeadlessWorkspaceCompletion, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): HeadlessWorkspaceCompletion;
+    static deserializeBinaryFromReader(message: HeadlessWorkspaceCompletion, reader: jspb.BinaryReader): HeadlessWorkspaceCompletion;
+}
+
+export namespace HeadlessWorkspaceCompletion {
+    export type AsObject = {
+        id: string,
+        metadata?: WorkspaceMetadata.AsObject,
+        type: WorkspaceType,
+        success: boolean,
+        failed: string,
+        headlessTaskFailed: string,
+        exitCode?: number,
+        snapshot: string,
+        stopReason: StopReason,
+        completedAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    }
+}
+
+export class WorkspaceCompletedResponse extends jspb.Message {
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): WorkspaceCompletedResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: WorkspaceCompletedResponse): WorkspaceCompletedResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: WorkspaceCompletedResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): WorkspaceCompletedResponse;
+    static deserializeBinaryFromReader(message: WorkspaceCompletedResponse, reader: jspb.BinaryReader): WorkspaceCompletedResponse;
+}
+
+export namespace WorkspaceCompletedResponse {
+    export type AsObject = {
     }
 }
 
@@ -938,6 +1851,11 @@ export class WorkspaceRuntimeInfo extends jspb.Message {
     getNodeIp(): string;
     setNodeIp(value: string): WorkspaceRuntimeInfo;
 
+    hasGpu(): boolean;
+    clearGpu(): void;
+    getGpu(): GPUAllocation | undefined;
+    setGpu(value?: GPUAllocation): WorkspaceRuntimeInfo;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceRuntimeInfo.AsObject;
     static toObject(includeInstance: boolean, msg: WorkspaceRuntimeInfo): WorkspaceRuntimeInfo.AsObject;
@@ -953,6 +1871,33 @@ export namespace WorkspaceRuntimeInfo {
         nodeName: string,
         podName: string,
         nodeIp: string,
+        gpu?: GPUAllocation.AsObject,
+    }
+}
+
+export class GPUAllocation extends jspb.Message {
+    getCount(): number;
+    setCount(value: number): GPUAllocation;
+    getResourceName(): string;
+    setResourceName(value: string): GPUAllocation;
+    getProduct(): string;
+    setProduct(value: string): GPUAllocation;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): GPUAllocation.AsObject;
+    static toObject(includeInstance: boolean, msg: GPUAllocation): GPUAllocation.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: GPUAllocation, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): GPUAllocation;
+    static deserializeBinaryFromReader(message: GPUAllocation, reader: jspb.BinaryReader): GPUAllocation;
+}
+
+export namespace GPUAllocation {
+    export type AsObject = {
+        count: number,
+        resourceName: string,
+        product: string,
     }
 }
 
@@ -962,6 +1907,11 @@ export class WorkspaceAuthentication extends jspb.Message {
     getOwnerToken(): string;
     setOwnerToken(value: string): WorkspaceAuthentication;
 
+    hasAdmissionExpiry(): boolean;
+    clearAdmissionExpiry(): void;
+    getAdmissionExpiry(): google_protobuf_timestamp_pb.Timestamp | undefined;
+    setAdmissionExpiry(value?: google_protobuf_timestamp_pb.Timestamp): WorkspaceAuthentication;
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceAuthentication.AsObject;
     static toObject(includeInstance: boolean, msg: WorkspaceAuthentication): WorkspaceAuthentication.AsObject;
@@ -976,6 +1926,7 @@ export namespace WorkspaceAuthentication {
     export type AsObject = {
         admission: AdmissionLevel,
         ownerToken: string,
+        admissionExpiry?: google_protobuf_timestamp_pb.Timestamp.AsObject,
     }
 }
 
@@ -1033,6 +1984,16 @@ export class StartWorkspaceSpec extends jspb.Message {
     setClosedTimeout(value: string): StartWorkspaceSpec;
     getMaximumLifetime(): string;
     setMaximumLifetime(value: string): StartWorkspaceSpec;
+    clearSidecarsList(): void;
+    getSidecarsList(): Array<string>;
+    setSidecarsList(value: Array<string>): StartWorkspaceSpec;
+    addSidecars(value: string, index?: number): string;
+    getOpenPullRequest(): boolean;
+    setOpenPullRequest(value: boolean): StartWorkspaceSpec;
+    getClockOffset(): string;
+    setClockOffset(value: string): StartWorkspaceSpec;
+    getEphemeral(): boolean;
+    setEphemeral(value: boolean): StartWorkspaceSpec;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): StartWorkspaceSpec.AsObject;
@@ -1062,6 +2023,10 @@ export namespace StartWorkspaceSpec {
         ideImageLayersList: Array<string>,
         closedTimeout: string,
         maximumLifetime: string,
+        sidecarsList: Array<string>,
+        openPullRequest: boolean,
+        clockOffset: string,
+        ephemeral: boolean,
     }
 }
 
@@ -1237,6 +2202,8 @@ export class WorkspaceClass extends jspb.Message {
     setDescription(value: string): WorkspaceClass;
     getCreditsPerMinute(): number;
     setCreditsPerMinute(value: number): WorkspaceClass;
+    getGpuCount(): number;
+    setGpuCount(value: number): WorkspaceClass;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): WorkspaceClass.AsObject;
@@ -1254,6 +2221,7 @@ export namespace WorkspaceClass {
         displayName: string,
         description: string,
         creditsPerMinute: number,
+        gpuCount: number,
     }
 }
 
@@ -1263,11 +2231,25 @@ export enum StopWorkspacePolicy {
     ABORT = 2,
 }
 
+export enum ActivitySource {
+    ACTIVITY_SOURCE_UNSPECIFIED = 0,
+    ACTIVITY_SOURCE_IDE_HEARTBEAT = 1,
+    ACTIVITY_SOURCE_PORT_TRAFFIC = 2,
+    ACTIVITY_SOURCE_TERMINAL_INPUT = 3,
+    ACTIVITY_SOURCE_API_CALL = 4,
+}
+
 export enum TimeoutType {
     WORKSPACE_TIMEOUT = 0,
     CLOSED_TIMEOUT = 1,
 }
 
+export enum RestoreConflictPolicy {
+    RESTORE_CONFLICT_FAIL = 0,
+    RESTORE_CONFLICT_SKIP = 1,
+    RESTORE_CONFLICT_OVERWRITE = 2,
+}
+
 export enum AdmissionLevel {
     ADMIT_OWNER_ONLY = 0,
     ADMIT_EVERYONE = 1,
@@ -1281,6 +2263,18 @@ export enum PortVisibility {
 export enum PortProtocol {
     PORT_PROTOCOL_HTTP = 0,
     PORT_PROTOCOL_HTTPS = 1,
+    PORT_PROTOCOL_TCP = 2,
+}
+
+export enum StopReason {
+    STOP_REASON_UNSPECIFIED = 0,
+    STOP_REASON_TIMEOUT_INACTIVITY = 1,
+    STOP_REASON_USER_REQUESTED = 2,
+    STOP_REASON_ADMIN_STOPPED = 3,
+    STOP_REASON_NODE_FAILURE = 4,
+    STOP_REASON_BACKUP_FAILURE = 5,
+    STOP_REASON_AGENT_SMITH = 6,
+    STOP_REASON_OOM_KILLED = 7,
 }
 
 export enum WorkspaceConditionBool {
@@ -1292,6 +2286,7 @@ export enum WorkspaceConditionBool {
 export enum WorkspacePhase {
     UNKNOWN = 0,
     PENDING = 1,
+    PENDING_QUEUE = 8,
     CREATING = 2,
     INITIALIZING = 3,
     RUNNING = 4,
@@ -25,6 +25,7 @@ var content$service$api_initializer_pb = require('@gitpod/content-service/lib');
 goog.object.extend(proto, content$service$api_initializer_pb);
 var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
 goog.object.extend(proto, google_protobuf_timestamp_pb);
+goog.exportSymbol('proto.wsman.ActivitySource', null, global);
 goog.exportSymbol('proto.wsman.AdmissionLevel', null, global);
 goog.exportSymbol('proto.wsman.BackupWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsman.BackupWorkspaceResponse', null, global);
@@ -38,28 +39,57 @@ goog.exportSymbol('proto.wsman.DescribeClusterRequest', null, global);
 goog.exportSymbol('proto.wsman.DescribeClusterResponse', null, global);
 goog.exportSymbol('proto.wsman.DescribeWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsman.DescribeWorkspaceResponse', null, global);
+goog.exportSymbol('proto.wsman.DrainNodeRequest', null, global);
+goog.exportSymbol('proto.wsman.DrainNodeResponse', null, global);
 goog.exportSymbol('proto.wsman.EnvironmentVariable', null, global);
 goog.exportSymbol('proto.wsman.EnvironmentVariable.SecretKeyRef', null, global);
+goog.exportSymbol('proto.wsman.ExportSnapshotRequest', null, global);
+goog.exportSymbol('proto.wsman.ExportSnapshotResponse', null, global);
 goog.exportSymbol('proto.wsman.ExposedPorts', null, global);
+goog.exportSymbol('proto.wsman.GPUAllocation', null, global);
+goog.exportSymbol('proto.wsman.GetLastActivityRequest', null, global);
+goog.exportSymbol('proto.wsman.GetLastActivityResponse', null, global);
+goog.exportSymbol('proto.wsman.GetQueuePositionRequest', null, global);
+goog.exportSymbol('proto.wsman.GetQueuePositionResponse', null, global);
+goog.exportSymbol('proto.wsman.GetWorkspaceDiskUsageRequest', null, global);
+goog.exportSymbol('proto.wsman.GetWorkspaceDiskUsageResponse', null, global);
+goog.exportSymbol('proto.wsman.GetWorkspaceResourceUsageRequest', null, global);
+goog.exportSymbol('proto.wsman.GetWorkspaceResourceUsageResponse', null, global);
 goog.exportSymbol('proto.wsman.GetWorkspacesRequest', null, global);
 goog.exportSymbol('proto.wsman.GetWorkspacesResponse', null, global);
 goog.exportSymbol('proto.wsman.GitSpec', null, global);
+goog.exportSymbol('proto.wsman.HeadlessWorkspaceCompletion', null, global);
 goog.exportSymbol('proto.wsman.IDEImage', null, global);
+goog.exportSymbol('proto.wsman.ListSnapshotsRequest', null, global);
+goog.exportSymbol('proto.wsman.ListSnapshotsResponse', null, global);
 goog.exportSymbol('proto.wsman.MarkActiveRequest', null, global);
 goog.exportSymbol('proto.wsman.MarkActiveResponse', null, global);
 goog.exportSymbol('proto.wsman.MetadataFilter', null, global);
 goog.exportSymbol('proto.wsman.PortProtocol', null, global);
 goog.exportSymbol('proto.wsman.PortSpec', null, global);
 goog.exportSymbol('proto.wsman.PortVisibility', null, global);
+goog.exportSymbol('proto.wsman.RelocateWorkspaceRequest', null, global);
+goog.exportSymbol('proto.wsman.RelocateWorkspaceResponse', null, global);
+goog.exportSymbol('proto.wsman.ResourceUsage', null, global);
+goog.exportSymbol('proto.wsman.RestoreConflictPolicy', null, global);
+goog.exportSymbol('proto.wsman.RestoreSnapshotRequest', null, global);
+goog.exportSymbol('proto.wsman.RestoreSnapshotResponse', null, global);
 goog.exportSymbol('proto.wsman.SSHPublicKeys', null, global);
+goog.exportSymbol('proto.wsman.SetRestartClassRequest', null, global);
+goog.exportSymbol('proto.wsman.SetRestartClassResponse', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutRequest', null, global);
 goog.exportSymbol('proto.wsman.SetTimeoutResponse', null, global);
+goog.exportSymbol('proto.wsman.SnapshotInfo', null, global);
+goog.exportSymbol('proto.wsman.SourceActivity', null, global);
 goog.exportSymbol('proto.wsman.StartWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsman.StartWorkspaceResponse', null, global);
 goog.exportSymbol('proto.wsman.StartWorkspaceSpec', null, global);
+goog.exportSymbol('proto.wsman.StopReason', null, global);
 goog.exportSymbol('proto.wsman.StopWorkspacePolicy', null, global);
 goog.exportSymbol('proto.wsman.StopWorkspaceRequest', null, global);
 goog.exportSymbol('proto.wsman.StopWorkspaceResponse', null, global);
+goog.exportSymbol('proto.wsman.StreamWorkspaceLogsRequest', null, global);
+goog.exportSymbol('proto.wsman.StreamWorkspaceLogsResponse', null, global);
 goog.exportSymbol('proto.wsman.SubscribeRequest', null, global);
 goog.exportSymbol('proto.wsman.SubscribeResponse', null, global);
 goog.exportSymbol('proto.wsman.TakeSnapshotRequest', null, global);
@@ -67,14 +97,19 @@ goog.exportSymbol('proto.wsman.TakeSnapshotResponse', null, global);
 goog.exportSymbol('proto.wsman.TimeoutType', null, global);
 goog.exportSymbol('proto.wsman.UpdateSSHKeyRequest', null, global);
 goog.exportSymbol('proto.wsman.UpdateSSHKeyResponse', null, global);
+goog.exportSymbol('proto.wsman.UpdateWorkspaceClassRequest', null, global);
+goog.exportSymbol('proto.wsman.UpdateWorkspaceClassResponse', null, global);
 goog.exportSymbol('proto.wsman.VolumeSnapshotInfo', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceAuthentication', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceClass', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceCompletedResponse', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceConditionBool', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceConditions', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceFeatureFlag', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceMetadata', null, global);
 goog.exportSymbol('proto.wsman.WorkspacePhase', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceQueueStatus', null, global);
+goog.exportSymbol('proto.wsman.WorkspaceResourceUsage', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceRuntimeInfo', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceSpec', null, global);
 goog.exportSymbol('proto.wsman.WorkspaceStatus', null, global);
@@ -174,7 +209,7 @@ if (goog.DEBUG && !COMPILED) {
  * @constructor
  */
 proto.wsman.StartWorkspaceResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.StartWorkspaceResponse.repeatedFields_, null);
 };
 goog.inherits(proto.wsman.StartWorkspaceResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -352,6 +387,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.MarkActiveResponse.displayName = 'proto.wsman.MarkActiveResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.GetLastActivityRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.GetLastActivityRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GetLastActivityRequest.displayName = 'proto.wsman.GetLastActivityRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.GetLastActivityResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.GetLastActivityResponse.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.GetLastActivityResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GetLastActivityResponse.displayName = 'proto.wsman.GetLastActivityResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.SourceActivity = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.SourceActivity, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.SourceActivity.displayName = 'proto.wsman.SourceActivity';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -478,6 +576,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.wsman.TakeSnapshotResponse.displayName = 'proto.wsman.TakeSnapshotResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.RestoreSnapshotRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.RestoreSnapshotRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.RestoreSnapshotRequest.displayName = 'proto.wsman.RestoreSnapshotRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.RestoreSnapshotResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.RestoreSnapshotResponse.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.RestoreSnapshotResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.RestoreSnapshotResponse.displayName = 'proto.wsman.RestoreSnapshotResponse';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -656,16 +796,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.WorkspaceStatus = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.wsman.RelocateWorkspaceRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.RelocateWorkspaceRequest.repeatedFields_, null);
 };
-goog.inherits(proto.wsman.WorkspaceStatus, jspb.Message);
+goog.inherits(proto.wsman.RelocateWorkspaceRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.WorkspaceStatus.displayName = 'proto.wsman.WorkspaceStatus';
+  proto.wsman.RelocateWorkspaceRequest.displayName = 'proto.wsman.RelocateWorkspaceRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -677,16 +817,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.IDEImage = function(opt_data) {
+proto.wsman.RelocateWorkspaceResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.IDEImage, jspb.Message);
+goog.inherits(proto.wsman.RelocateWorkspaceResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.IDEImage.displayName = 'proto.wsman.IDEImage';
+  proto.wsman.RelocateWorkspaceResponse.displayName = 'proto.wsman.RelocateWorkspaceResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -698,16 +838,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.WorkspaceSpec = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.WorkspaceSpec.repeatedFields_, null);
+proto.wsman.UpdateWorkspaceClassRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.UpdateWorkspaceClassRequest.repeatedFields_, null);
 };
-goog.inherits(proto.wsman.WorkspaceSpec, jspb.Message);
+goog.inherits(proto.wsman.UpdateWorkspaceClassRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.WorkspaceSpec.displayName = 'proto.wsman.WorkspaceSpec';
+  proto.wsman.UpdateWorkspaceClassRequest.displayName = 'proto.wsman.UpdateWorkspaceClassRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -719,16 +859,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.PortSpec = function(opt_data) {
+proto.wsman.UpdateWorkspaceClassResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.PortSpec, jspb.Message);
+goog.inherits(proto.wsman.UpdateWorkspaceClassResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.PortSpec.displayName = 'proto.wsman.PortSpec';
+  proto.wsman.UpdateWorkspaceClassResponse.displayName = 'proto.wsman.UpdateWorkspaceClassResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -740,16 +880,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.VolumeSnapshotInfo = function(opt_data) {
+proto.wsman.SetRestartClassRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.VolumeSnapshotInfo, jspb.Message);
+goog.inherits(proto.wsman.SetRestartClassRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.VolumeSnapshotInfo.displayName = 'proto.wsman.VolumeSnapshotInfo';
+  proto.wsman.SetRestartClassRequest.displayName = 'proto.wsman.SetRestartClassRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -761,16 +901,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.WorkspaceConditions = function(opt_data) {
+proto.wsman.SetRestartClassResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.WorkspaceConditions, jspb.Message);
+goog.inherits(proto.wsman.SetRestartClassResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.WorkspaceConditions.displayName = 'proto.wsman.WorkspaceConditions';
+  proto.wsman.SetRestartClassResponse.displayName = 'proto.wsman.SetRestartClassResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -782,16 +922,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.WorkspaceMetadata = function(opt_data) {
+proto.wsman.ListSnapshotsRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.WorkspaceMetadata, jspb.Message);
+goog.inherits(proto.wsman.ListSnapshotsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.WorkspaceMetadata.displayName = 'proto.wsman.WorkspaceMetadata';
+  proto.wsman.ListSnapshotsRequest.displayName = 'proto.wsman.ListSnapshotsRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -803,16 +943,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.WorkspaceRuntimeInfo = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.wsman.ListSnapshotsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.ListSnapshotsResponse.repeatedFields_, null);
 };
-goog.inherits(proto.wsman.WorkspaceRuntimeInfo, jspb.Message);
+goog.inherits(proto.wsman.ListSnapshotsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.WorkspaceRuntimeInfo.displayName = 'proto.wsman.WorkspaceRuntimeInfo';
+  proto.wsman.ListSnapshotsResponse.displayName = 'proto.wsman.ListSnapshotsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -824,16 +964,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.WorkspaceAuthentication = function(opt_data) {
+proto.wsman.SnapshotInfo = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.WorkspaceAuthentication, jspb.Message);
+goog.inherits(proto.wsman.SnapshotInfo, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.WorkspaceAuthentication.displayName = 'proto.wsman.WorkspaceAuthentication';
+  proto.wsman.SnapshotInfo.displayName = 'proto.wsman.SnapshotInfo';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -845,16 +985,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.StartWorkspaceSpec = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.StartWorkspaceSpec.repeatedFields_, null);
+proto.wsman.ExportSnapshotRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.StartWorkspaceSpec, jspb.Message);
+goog.inherits(proto.wsman.ExportSnapshotRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.StartWorkspaceSpec.displayName = 'proto.wsman.StartWorkspaceSpec';
+  proto.wsman.ExportSnapshotRequest.displayName = 'proto.wsman.ExportSnapshotRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -866,16 +1006,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.GitSpec = function(opt_data) {
+proto.wsman.ExportSnapshotResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.GitSpec, jspb.Message);
+goog.inherits(proto.wsman.ExportSnapshotResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.GitSpec.displayName = 'proto.wsman.GitSpec';
+  proto.wsman.ExportSnapshotResponse.displayName = 'proto.wsman.ExportSnapshotResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -887,16 +1027,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.EnvironmentVariable = function(opt_data) {
+proto.wsman.GetQueuePositionRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.EnvironmentVariable, jspb.Message);
+goog.inherits(proto.wsman.GetQueuePositionRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.EnvironmentVariable.displayName = 'proto.wsman.EnvironmentVariable';
+  proto.wsman.GetQueuePositionRequest.displayName = 'proto.wsman.GetQueuePositionRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -908,16 +1048,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.EnvironmentVariable.SecretKeyRef = function(opt_data) {
+proto.wsman.GetQueuePositionResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.EnvironmentVariable.SecretKeyRef, jspb.Message);
+goog.inherits(proto.wsman.GetQueuePositionResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.EnvironmentVariable.SecretKeyRef.displayName = 'proto.wsman.EnvironmentVariable.SecretKeyRef';
+  proto.wsman.GetQueuePositionResponse.displayName = 'proto.wsman.GetQueuePositionResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -929,16 +1069,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.ExposedPorts = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.ExposedPorts.repeatedFields_, null);
+proto.wsman.StreamWorkspaceLogsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.ExposedPorts, jspb.Message);
+goog.inherits(proto.wsman.StreamWorkspaceLogsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.ExposedPorts.displayName = 'proto.wsman.ExposedPorts';
+  proto.wsman.StreamWorkspaceLogsRequest.displayName = 'proto.wsman.StreamWorkspaceLogsRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -950,16 +1090,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.SSHPublicKeys = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.SSHPublicKeys.repeatedFields_, null);
+proto.wsman.StreamWorkspaceLogsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.SSHPublicKeys, jspb.Message);
+goog.inherits(proto.wsman.StreamWorkspaceLogsResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.SSHPublicKeys.displayName = 'proto.wsman.SSHPublicKeys';
+  proto.wsman.StreamWorkspaceLogsResponse.displayName = 'proto.wsman.StreamWorkspaceLogsResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -971,16 +1111,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.DescribeClusterRequest = function(opt_data) {
+proto.wsman.DrainNodeRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.DescribeClusterRequest, jspb.Message);
+goog.inherits(proto.wsman.DrainNodeRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.DescribeClusterRequest.displayName = 'proto.wsman.DescribeClusterRequest';
+  proto.wsman.DrainNodeRequest.displayName = 'proto.wsman.DrainNodeRequest';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -992,16 +1132,16 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.DescribeClusterResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.DescribeClusterResponse.repeatedFields_, null);
+proto.wsman.DrainNodeResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.DrainNodeResponse.repeatedFields_, null);
 };
-goog.inherits(proto.wsman.DescribeClusterResponse, jspb.Message);
+goog.inherits(proto.wsman.DrainNodeResponse, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.DescribeClusterResponse.displayName = 'proto.wsman.DescribeClusterResponse';
+  proto.wsman.DrainNodeResponse.displayName = 'proto.wsman.DrainNodeResponse';
 }
 /**
  * Generated by JsPbCodeGenerator.
@@ -1013,101 +1153,6810 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.wsman.WorkspaceClass = function(opt_data) {
+proto.wsman.GetWorkspaceResourceUsageRequest = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-goog.inherits(proto.wsman.WorkspaceClass, jspb.Message);
+goog.inherits(proto.wsman.GetWorkspaceResourceUsageRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
    * @public
    * @override
    */
-  proto.wsman.WorkspaceClass.displayName = 'proto.wsman.WorkspaceClass';
+  proto.wsman.GetWorkspaceResourceUsageRequest.displayName = 'proto.wsman.GetWorkspaceResourceUsageRequest';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.wsman.MetadataFilter.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.MetadataFilter.toObject(opt_includeInstance, this);
+proto.wsman.GetWorkspaceResourceUsageResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.wsman.GetWorkspaceResourceUsageResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GetWorkspaceResourceUsageResponse.displayName = 'proto.wsman.GetWorkspaceResourceUsageResponse';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.MetadataFilter} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.wsman.MetadataFilter.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    owner: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    metaId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    annotationsMap: (f = msg.getAnnotationsMap()) ? f.toObject(includeInstance, undefined) : []
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.wsman.GetWorkspaceDiskUsageRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.wsman.GetWorkspaceDiskUsageRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GetWorkspaceDiskUsageRequest.displayName = 'proto.wsman.GetWorkspaceDiskUsageRequest';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.MetadataFilter}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.wsman.MetadataFilter.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.MetadataFilter;
-  return proto.wsman.MetadataFilter.deserializeBinaryFromReader(msg, reader);
+proto.wsman.GetWorkspaceDiskUsageResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.wsman.GetWorkspaceDiskUsageResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GetWorkspaceDiskUsageResponse.displayName = 'proto.wsman.GetWorkspaceDiskUsageResponse';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.MetadataFilter} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.MetadataFilter}
- */
-proto.wsman.MetadataFilter.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceResourceUsage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceResourceUsage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceResourceUsage.displayName = 'proto.wsman.WorkspaceResourceUsage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ResourceUsage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.ResourceUsage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ResourceUsage.displayName = 'proto.wsman.ResourceUsage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceStatus = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceStatus, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceStatus.displayName = 'proto.wsman.WorkspaceStatus';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceQueueStatus = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceQueueStatus, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceQueueStatus.displayName = 'proto.wsman.WorkspaceQueueStatus';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.IDEImage = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.IDEImage, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.IDEImage.displayName = 'proto.wsman.IDEImage';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceSpec = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.WorkspaceSpec.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.WorkspaceSpec, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceSpec.displayName = 'proto.wsman.WorkspaceSpec';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.PortSpec = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.PortSpec, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.PortSpec.displayName = 'proto.wsman.PortSpec';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.VolumeSnapshotInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.VolumeSnapshotInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.VolumeSnapshotInfo.displayName = 'proto.wsman.VolumeSnapshotInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceConditions = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceConditions, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceConditions.displayName = 'proto.wsman.WorkspaceConditions';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.HeadlessWorkspaceCompletion = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.HeadlessWorkspaceCompletion, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.HeadlessWorkspaceCompletion.displayName = 'proto.wsman.HeadlessWorkspaceCompletion';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceCompletedResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceCompletedResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceCompletedResponse.displayName = 'proto.wsman.WorkspaceCompletedResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceMetadata = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceMetadata, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceMetadata.displayName = 'proto.wsman.WorkspaceMetadata';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceRuntimeInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceRuntimeInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceRuntimeInfo.displayName = 'proto.wsman.WorkspaceRuntimeInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.GPUAllocation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.GPUAllocation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GPUAllocation.displayName = 'proto.wsman.GPUAllocation';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceAuthentication = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceAuthentication, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceAuthentication.displayName = 'proto.wsman.WorkspaceAuthentication';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.StartWorkspaceSpec = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.StartWorkspaceSpec.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.StartWorkspaceSpec, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.StartWorkspaceSpec.displayName = 'proto.wsman.StartWorkspaceSpec';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.GitSpec = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.GitSpec, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.GitSpec.displayName = 'proto.wsman.GitSpec';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.EnvironmentVariable = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.EnvironmentVariable, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.EnvironmentVariable.displayName = 'proto.wsman.EnvironmentVariable';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.EnvironmentVariable.SecretKeyRef = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.EnvironmentVariable.SecretKeyRef, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.EnvironmentVariable.SecretKeyRef.displayName = 'proto.wsman.EnvironmentVariable.SecretKeyRef';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.ExposedPorts = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.ExposedPorts.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.ExposedPorts, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.ExposedPorts.displayName = 'proto.wsman.ExposedPorts';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.SSHPublicKeys = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.SSHPublicKeys.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.SSHPublicKeys, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.SSHPublicKeys.displayName = 'proto.wsman.SSHPublicKeys';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.DescribeClusterRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.DescribeClusterRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.DescribeClusterRequest.displayName = 'proto.wsman.DescribeClusterRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.DescribeClusterResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.wsman.DescribeClusterResponse.repeatedFields_, null);
+};
+goog.inherits(proto.wsman.DescribeClusterResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.DescribeClusterResponse.displayName = 'proto.wsman.DescribeClusterResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.wsman.WorkspaceClass = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.wsman.WorkspaceClass, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.wsman.WorkspaceClass.displayName = 'proto.wsman.WorkspaceClass';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.MetadataFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.MetadataFilter.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.MetadataFilter} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.MetadataFilter.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    owner: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    metaId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    annotationsMap: (f = msg.getAnnotationsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.MetadataFilter}
+ */
+proto.wsman.MetadataFilter.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.MetadataFilter;
+  return proto.wsman.MetadataFilter.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.MetadataFilter} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.MetadataFilter}
+ */
+proto.wsman.MetadataFilter.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMetaId(value);
+      break;
+    case 3:
+      var value = msg.getAnnotationsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.MetadataFilter.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.MetadataFilter.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.MetadataFilter} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.MetadataFilter.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMetaId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAnnotationsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional string owner = 1;
+ * @return {string}
+ */
+proto.wsman.MetadataFilter.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.MetadataFilter} returns this
+ */
+proto.wsman.MetadataFilter.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string meta_id = 2;
+ * @return {string}
+ */
+proto.wsman.MetadataFilter.prototype.getMetaId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.MetadataFilter} returns this
+ */
+proto.wsman.MetadataFilter.prototype.setMetaId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * map<string, string> annotations = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.wsman.MetadataFilter.prototype.getAnnotationsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.wsman.MetadataFilter} returns this
+ */
+proto.wsman.MetadataFilter.prototype.clearAnnotationsMap = function() {
+  this.getAnnotationsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.GetWorkspacesRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetWorkspacesRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.GetWorkspacesRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetWorkspacesRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mustMatch: (f = msg.getMustMatch()) && proto.wsman.MetadataFilter.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.GetWorkspacesRequest}
+ */
+proto.wsman.GetWorkspacesRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.GetWorkspacesRequest;
+  return proto.wsman.GetWorkspacesRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.GetWorkspacesRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.GetWorkspacesRequest}
+ */
+proto.wsman.GetWorkspacesRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.MetadataFilter;
+      reader.readMessage(value,proto.wsman.MetadataFilter.deserializeBinaryFromReader);
+      msg.setMustMatch(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.GetWorkspacesRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.GetWorkspacesRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.GetWorkspacesRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetWorkspacesRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMustMatch();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.wsman.MetadataFilter.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional MetadataFilter must_match = 1;
+ * @return {?proto.wsman.MetadataFilter}
+ */
+proto.wsman.GetWorkspacesRequest.prototype.getMustMatch = function() {
+  return /** @type{?proto.wsman.MetadataFilter} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.MetadataFilter, 1));
+};
+
+
+/**
+ * @param {?proto.wsman.MetadataFilter|undefined} value
+ * @return {!proto.wsman.GetWorkspacesRequest} returns this
+*/
+proto.wsman.GetWorkspacesRequest.prototype.setMustMatch = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.GetWorkspacesRequest} returns this
+ */
+proto.wsman.GetWorkspacesRequest.prototype.clearMustMatch = function() {
+  return this.setMustMatch(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.GetWorkspacesRequest.prototype.hasMustMatch = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.GetWorkspacesResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.GetWorkspacesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetWorkspacesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.GetWorkspacesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetWorkspacesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    statusList: jspb.Message.toObjectList(msg.getStatusList(),
+    proto.wsman.WorkspaceStatus.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.GetWorkspacesResponse}
+ */
+proto.wsman.GetWorkspacesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.GetWorkspacesResponse;
+  return proto.wsman.GetWorkspacesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.GetWorkspacesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.GetWorkspacesResponse}
+ */
+proto.wsman.GetWorkspacesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.WorkspaceStatus;
+      reader.readMessage(value,proto.wsman.WorkspaceStatus.deserializeBinaryFromReader);
+      msg.addStatus(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.GetWorkspacesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.GetWorkspacesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.GetWorkspacesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetWorkspacesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatusList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.wsman.WorkspaceStatus.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated WorkspaceStatus status = 1;
+ * @return {!Array<!proto.wsman.WorkspaceStatus>}
+ */
+proto.wsman.GetWorkspacesResponse.prototype.getStatusList = function() {
+  return /** @type{!Array<!proto.wsman.WorkspaceStatus>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceStatus, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.WorkspaceStatus>} value
+ * @return {!proto.wsman.GetWorkspacesResponse} returns this
+*/
+proto.wsman.GetWorkspacesResponse.prototype.setStatusList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceStatus=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.WorkspaceStatus}
+ */
+proto.wsman.GetWorkspacesResponse.prototype.addStatus = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.WorkspaceStatus, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.GetWorkspacesResponse} returns this
+ */
+proto.wsman.GetWorkspacesResponse.prototype.clearStatusList = function() {
+  return this.setStatusList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.StartWorkspaceRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.StartWorkspaceRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StartWorkspaceRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    servicePrefix: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
+    spec: (f = msg.getSpec()) && proto.wsman.StartWorkspaceSpec.toObject(includeInstance, f),
+    type: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    validateOnly: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    idempotencyKey: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.StartWorkspaceRequest}
+ */
+proto.wsman.StartWorkspaceRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.StartWorkspaceRequest;
+  return proto.wsman.StartWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.StartWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.StartWorkspaceRequest}
+ */
+proto.wsman.StartWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServicePrefix(value);
+      break;
+    case 3:
+      var value = new proto.wsman.WorkspaceMetadata;
+      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    case 4:
+      var value = new proto.wsman.StartWorkspaceSpec;
+      reader.readMessage(value,proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader);
+      msg.setSpec(value);
+      break;
+    case 6:
+      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setValidateOnly(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIdempotencyKey(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.StartWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.StartWorkspaceRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StartWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getServicePrefix();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.WorkspaceMetadata.serializeBinaryToWriter
+    );
+  }
+  f = message.getSpec();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getValidateOnly();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+  f = message.getIdempotencyKey();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ */
+proto.wsman.StartWorkspaceRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string service_prefix = 2;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.getServicePrefix = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ */
+proto.wsman.StartWorkspaceRequest.prototype.setServicePrefix = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional WorkspaceMetadata metadata = 3;
+ * @return {?proto.wsman.WorkspaceMetadata}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.getMetadata = function() {
+  return /** @type{?proto.wsman.WorkspaceMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata, 3));
+};
+
+
+/**
+ * @param {?proto.wsman.WorkspaceMetadata|undefined} value
+ * @return {!proto.wsman.StartWorkspaceRequest} returns this
+*/
+proto.wsman.StartWorkspaceRequest.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ */
+proto.wsman.StartWorkspaceRequest.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional StartWorkspaceSpec spec = 4;
+ * @return {?proto.wsman.StartWorkspaceSpec}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.getSpec = function() {
+  return /** @type{?proto.wsman.StartWorkspaceSpec} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.StartWorkspaceSpec, 4));
+};
+
+
+/**
+ * @param {?proto.wsman.StartWorkspaceSpec|undefined} value
+ * @return {!proto.wsman.StartWorkspaceRequest} returns this
+*/
+proto.wsman.StartWorkspaceRequest.prototype.setSpec = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ */
+proto.wsman.StartWorkspaceRequest.prototype.clearSpec = function() {
+  return this.setSpec(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.hasSpec = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional WorkspaceType type = 6;
+ * @return {!proto.wsman.WorkspaceType}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.getType = function() {
+  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceType} value
+ * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ */
+proto.wsman.StartWorkspaceRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * optional bool validate_only = 7;
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.getValidateOnly = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ */
+proto.wsman.StartWorkspaceRequest.prototype.setValidateOnly = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
+};
+
+
+/**
+ * optional string idempotency_key = 8;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceRequest.prototype.getIdempotencyKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ */
+proto.wsman.StartWorkspaceRequest.prototype.setIdempotencyKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.StartWorkspaceResponse.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.StartWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.StartWorkspaceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.StartWorkspaceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StartWorkspaceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    problemsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.StartWorkspaceResponse}
+ */
+proto.wsman.StartWorkspaceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.StartWorkspaceResponse;
+  return proto.wsman.StartWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.StartWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.StartWorkspaceResponse}
+ */
+proto.wsman.StartWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwnerToken(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addProblems(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.StartWorkspaceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.StartWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.StartWorkspaceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StartWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOwnerToken();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getProblemsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string url = 1;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceResponse.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceResponse} returns this
+ */
+proto.wsman.StartWorkspaceResponse.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string owner_token = 2;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceResponse.prototype.getOwnerToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceResponse} returns this
+ */
+proto.wsman.StartWorkspaceResponse.prototype.setOwnerToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string problems = 3;
+ * @return {!Array<string>}
+ */
+proto.wsman.StartWorkspaceResponse.prototype.getProblemsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.StartWorkspaceResponse} returns this
+ */
+proto.wsman.StartWorkspaceResponse.prototype.setProblemsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.StartWorkspaceResponse} returns this
+ */
+proto.wsman.StartWorkspaceResponse.prototype.addProblems = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.StartWorkspaceResponse} returns this
+ */
+proto.wsman.StartWorkspaceResponse.prototype.clearProblemsList = function() {
+  return this.setProblemsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.StopWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.StopWorkspaceRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.StopWorkspaceRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StopWorkspaceRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    policy: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    reason: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.StopWorkspaceRequest}
+ */
+proto.wsman.StopWorkspaceRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.StopWorkspaceRequest;
+  return proto.wsman.StopWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.StopWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.StopWorkspaceRequest}
+ */
+proto.wsman.StopWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.wsman.StopWorkspacePolicy} */ (reader.readEnum());
+      msg.setPolicy(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.wsman.StopReason} */ (reader.readEnum());
+      msg.setReason(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.StopWorkspaceRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.StopWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.StopWorkspaceRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StopWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.StopWorkspaceRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StopWorkspaceRequest} returns this
+ */
+proto.wsman.StopWorkspaceRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional StopWorkspacePolicy policy = 2;
+ * @return {!proto.wsman.StopWorkspacePolicy}
+ */
+proto.wsman.StopWorkspaceRequest.prototype.getPolicy = function() {
+  return /** @type {!proto.wsman.StopWorkspacePolicy} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.StopWorkspacePolicy} value
+ * @return {!proto.wsman.StopWorkspaceRequest} returns this
+ */
+proto.wsman.StopWorkspaceRequest.prototype.setPolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional StopReason reason = 3;
+ * @return {!proto.wsman.StopReason}
+ */
+proto.wsman.StopWorkspaceRequest.prototype.getReason = function() {
+  return /** @type {!proto.wsman.StopReason} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.StopReason} value
+ * @return {!proto.wsman.StopWorkspaceRequest} returns this
+ */
+proto.wsman.StopWorkspaceRequest.prototype.setReason = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.StopWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.StopWorkspaceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.StopWorkspaceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StopWorkspaceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.StopWorkspaceResponse}
+ */
+proto.wsman.StopWorkspaceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.StopWorkspaceResponse;
+  return proto.wsman.StopWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.StopWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.StopWorkspaceResponse}
+ */
+proto.wsman.StopWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.StopWorkspaceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.StopWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.StopWorkspaceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.StopWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.DescribeWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DescribeWorkspaceRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DescribeWorkspaceRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DescribeWorkspaceRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.DescribeWorkspaceRequest}
+ */
+proto.wsman.DescribeWorkspaceRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.DescribeWorkspaceRequest;
+  return proto.wsman.DescribeWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.DescribeWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.DescribeWorkspaceRequest}
+ */
+proto.wsman.DescribeWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.DescribeWorkspaceRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.DescribeWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.DescribeWorkspaceRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DescribeWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.DescribeWorkspaceRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.DescribeWorkspaceRequest} returns this
+ */
+proto.wsman.DescribeWorkspaceRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DescribeWorkspaceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DescribeWorkspaceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DescribeWorkspaceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: (f = msg.getStatus()) && proto.wsman.WorkspaceStatus.toObject(includeInstance, f),
+    lastactivity: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.DescribeWorkspaceResponse}
+ */
+proto.wsman.DescribeWorkspaceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.DescribeWorkspaceResponse;
+  return proto.wsman.DescribeWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.DescribeWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.DescribeWorkspaceResponse}
+ */
+proto.wsman.DescribeWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.WorkspaceStatus;
+      reader.readMessage(value,proto.wsman.WorkspaceStatus.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastactivity(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.DescribeWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.DescribeWorkspaceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DescribeWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.wsman.WorkspaceStatus.serializeBinaryToWriter
+    );
+  }
+  f = message.getLastactivity();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional WorkspaceStatus status = 1;
+ * @return {?proto.wsman.WorkspaceStatus}
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.getStatus = function() {
+  return /** @type{?proto.wsman.WorkspaceStatus} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceStatus, 1));
+};
+
+
+/**
+ * @param {?proto.wsman.WorkspaceStatus|undefined} value
+ * @return {!proto.wsman.DescribeWorkspaceResponse} returns this
+*/
+proto.wsman.DescribeWorkspaceResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.DescribeWorkspaceResponse} returns this
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.clearStatus = function() {
+  return this.setStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string lastActivity = 2;
+ * @return {string}
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.getLastactivity = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.DescribeWorkspaceResponse} returns this
+ */
+proto.wsman.DescribeWorkspaceResponse.prototype.setLastactivity = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.SubscribeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SubscribeRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SubscribeRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SubscribeRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    mustMatch: (f = msg.getMustMatch()) && proto.wsman.MetadataFilter.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SubscribeRequest}
+ */
+proto.wsman.SubscribeRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SubscribeRequest;
+  return proto.wsman.SubscribeRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SubscribeRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SubscribeRequest}
+ */
+proto.wsman.SubscribeRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.MetadataFilter;
+      reader.readMessage(value,proto.wsman.MetadataFilter.deserializeBinaryFromReader);
+      msg.setMustMatch(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.SubscribeRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.SubscribeRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SubscribeRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SubscribeRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getMustMatch();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.wsman.MetadataFilter.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional MetadataFilter must_match = 1;
+ * @return {?proto.wsman.MetadataFilter}
+ */
+proto.wsman.SubscribeRequest.prototype.getMustMatch = function() {
+  return /** @type{?proto.wsman.MetadataFilter} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.MetadataFilter, 1));
+};
+
+
+/**
+ * @param {?proto.wsman.MetadataFilter|undefined} value
+ * @return {!proto.wsman.SubscribeRequest} returns this
+*/
+proto.wsman.SubscribeRequest.prototype.setMustMatch = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.SubscribeRequest} returns this
+ */
+proto.wsman.SubscribeRequest.prototype.clearMustMatch = function() {
+  return this.setMustMatch(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.SubscribeRequest.prototype.hasMustMatch = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.SubscribeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SubscribeResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SubscribeResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SubscribeResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: (f = msg.getStatus()) && proto.wsman.WorkspaceStatus.toObject(includeInstance, f),
+    headerMap: (f = msg.getHeaderMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SubscribeResponse}
+ */
+proto.wsman.SubscribeResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SubscribeResponse;
+  return proto.wsman.SubscribeResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SubscribeResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SubscribeResponse}
+ */
+proto.wsman.SubscribeResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.wsman.WorkspaceStatus;
+      reader.readMessage(value,proto.wsman.WorkspaceStatus.deserializeBinaryFromReader);
+      msg.setStatus(value);
+      break;
+    case 3:
+      var value = msg.getHeaderMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.SubscribeResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.SubscribeResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SubscribeResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SubscribeResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.wsman.WorkspaceStatus.serializeBinaryToWriter
+    );
+  }
+  f = message.getHeaderMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional WorkspaceStatus status = 1;
+ * @return {?proto.wsman.WorkspaceStatus}
+ */
+proto.wsman.SubscribeResponse.prototype.getStatus = function() {
+  return /** @type{?proto.wsman.WorkspaceStatus} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceStatus, 1));
+};
+
+
+/**
+ * @param {?proto.wsman.WorkspaceStatus|undefined} value
+ * @return {!proto.wsman.SubscribeResponse} returns this
+*/
+proto.wsman.SubscribeResponse.prototype.setStatus = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.SubscribeResponse} returns this
+ */
+proto.wsman.SubscribeResponse.prototype.clearStatus = function() {
+  return this.setStatus(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.SubscribeResponse.prototype.hasStatus = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * map<string, string> header = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.wsman.SubscribeResponse.prototype.getHeaderMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.wsman.SubscribeResponse} returns this
+ */
+proto.wsman.SubscribeResponse.prototype.clearHeaderMap = function() {
+  this.getHeaderMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.MarkActiveRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.MarkActiveRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.MarkActiveRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.MarkActiveRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    closed: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    ignoreIfActive: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    source: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.MarkActiveRequest}
+ */
+proto.wsman.MarkActiveRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.MarkActiveRequest;
+  return proto.wsman.MarkActiveRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.MarkActiveRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.MarkActiveRequest}
+ */
+proto.wsman.MarkActiveRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setClosed(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIgnoreIfActive(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.wsman.ActivitySource} */ (reader.readEnum());
+      msg.setSource(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.MarkActiveRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.MarkActiveRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.MarkActiveRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.MarkActiveRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getClosed();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getIgnoreIfActive();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getSource();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.MarkActiveRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.MarkActiveRequest} returns this
+ */
+proto.wsman.MarkActiveRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool closed = 2;
+ * @return {boolean}
+ */
+proto.wsman.MarkActiveRequest.prototype.getClosed = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.MarkActiveRequest} returns this
+ */
+proto.wsman.MarkActiveRequest.prototype.setClosed = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional bool ignore_if_active = 3;
+ * @return {boolean}
+ */
+proto.wsman.MarkActiveRequest.prototype.getIgnoreIfActive = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.MarkActiveRequest} returns this
+ */
+proto.wsman.MarkActiveRequest.prototype.setIgnoreIfActive = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional ActivitySource source = 4;
+ * @return {!proto.wsman.ActivitySource}
+ */
+proto.wsman.MarkActiveRequest.prototype.getSource = function() {
+  return /** @type {!proto.wsman.ActivitySource} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.ActivitySource} value
+ * @return {!proto.wsman.MarkActiveRequest} returns this
+ */
+proto.wsman.MarkActiveRequest.prototype.setSource = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.MarkActiveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.MarkActiveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.MarkActiveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.MarkActiveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.MarkActiveResponse}
+ */
+proto.wsman.MarkActiveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.MarkActiveResponse;
+  return proto.wsman.MarkActiveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.MarkActiveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.MarkActiveResponse}
+ */
+proto.wsman.MarkActiveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.MarkActiveResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.MarkActiveResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.MarkActiveResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.MarkActiveResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.GetLastActivityRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetLastActivityRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.GetLastActivityRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetLastActivityRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.GetLastActivityRequest}
+ */
+proto.wsman.GetLastActivityRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.GetLastActivityRequest;
+  return proto.wsman.GetLastActivityRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.GetLastActivityRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.GetLastActivityRequest}
+ */
+proto.wsman.GetLastActivityRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.GetLastActivityRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.GetLastActivityRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.GetLastActivityRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetLastActivityRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.GetLastActivityRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.GetLastActivityRequest} returns this
+ */
+proto.wsman.GetLastActivityRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.GetLastActivityResponse.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.GetLastActivityResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetLastActivityResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.GetLastActivityResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetLastActivityResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    lastActivity: (f = msg.getLastActivity()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    sourcesList: jspb.Message.toObjectList(msg.getSourcesList(),
+    proto.wsman.SourceActivity.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.GetLastActivityResponse}
+ */
+proto.wsman.GetLastActivityResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.GetLastActivityResponse;
+  return proto.wsman.GetLastActivityResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.GetLastActivityResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.GetLastActivityResponse}
+ */
+proto.wsman.GetLastActivityResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastActivity(value);
+      break;
+    case 2:
+      var value = new proto.wsman.SourceActivity;
+      reader.readMessage(value,proto.wsman.SourceActivity.deserializeBinaryFromReader);
+      msg.addSources(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.GetLastActivityResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.GetLastActivityResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.GetLastActivityResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.GetLastActivityResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getLastActivity();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getSourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.wsman.SourceActivity.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_activity = 1;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.GetLastActivityResponse.prototype.getLastActivity = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 1));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.GetLastActivityResponse} returns this
+*/
+proto.wsman.GetLastActivityResponse.prototype.setLastActivity = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.GetLastActivityResponse} returns this
+ */
+proto.wsman.GetLastActivityResponse.prototype.clearLastActivity = function() {
+  return this.setLastActivity(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.GetLastActivityResponse.prototype.hasLastActivity = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * repeated SourceActivity sources = 2;
+ * @return {!Array<!proto.wsman.SourceActivity>}
+ */
+proto.wsman.GetLastActivityResponse.prototype.getSourcesList = function() {
+  return /** @type{!Array<!proto.wsman.SourceActivity>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.SourceActivity, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.SourceActivity>} value
+ * @return {!proto.wsman.GetLastActivityResponse} returns this
+*/
+proto.wsman.GetLastActivityResponse.prototype.setSourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.wsman.SourceActivity=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.SourceActivity}
+ */
+proto.wsman.GetLastActivityResponse.prototype.addSources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.wsman.SourceActivity, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.GetLastActivityResponse} returns this
+ */
+proto.wsman.GetLastActivityResponse.prototype.clearSourcesList = function() {
+  return this.setSourcesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.SourceActivity.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SourceActivity.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SourceActivity} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SourceActivity.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    source: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    lastActivity: (f = msg.getLastActivity()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SourceActivity}
+ */
+proto.wsman.SourceActivity.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SourceActivity;
+  return proto.wsman.SourceActivity.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SourceActivity} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SourceActivity}
+ */
+proto.wsman.SourceActivity.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.wsman.ActivitySource} */ (reader.readEnum());
+      msg.setSource(value);
+      break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setLastActivity(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.SourceActivity.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.SourceActivity.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SourceActivity} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SourceActivity.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSource();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getLastActivity();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional ActivitySource source = 1;
+ * @return {!proto.wsman.ActivitySource}
+ */
+proto.wsman.SourceActivity.prototype.getSource = function() {
+  return /** @type {!proto.wsman.ActivitySource} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.ActivitySource} value
+ * @return {!proto.wsman.SourceActivity} returns this
+ */
+proto.wsman.SourceActivity.prototype.setSource = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp last_activity = 2;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.SourceActivity.prototype.getLastActivity = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.SourceActivity} returns this
+*/
+proto.wsman.SourceActivity.prototype.setLastActivity = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.SourceActivity} returns this
+ */
+proto.wsman.SourceActivity.prototype.clearLastActivity = function() {
+  return this.setLastActivity(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.SourceActivity.prototype.hasLastActivity = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.SetTimeoutRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SetTimeoutRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SetTimeoutRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SetTimeoutRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    duration: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SetTimeoutRequest}
+ */
+proto.wsman.SetTimeoutRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SetTimeoutRequest;
+  return proto.wsman.SetTimeoutRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SetTimeoutRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SetTimeoutRequest}
+ */
+proto.wsman.SetTimeoutRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDuration(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.wsman.TimeoutType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.SetTimeoutRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.SetTimeoutRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SetTimeoutRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SetTimeoutRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDuration();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.SetTimeoutRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.SetTimeoutRequest} returns this
+ */
+proto.wsman.SetTimeoutRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string duration = 2;
+ * @return {string}
+ */
+proto.wsman.SetTimeoutRequest.prototype.getDuration = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.SetTimeoutRequest} returns this
+ */
+proto.wsman.SetTimeoutRequest.prototype.setDuration = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional TimeoutType type = 3;
+ * @return {!proto.wsman.TimeoutType}
+ */
+proto.wsman.SetTimeoutRequest.prototype.getType = function() {
+  return /** @type {!proto.wsman.TimeoutType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.TimeoutType} value
+ * @return {!proto.wsman.SetTimeoutRequest} returns this
+ */
+proto.wsman.SetTimeoutRequest.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.SetTimeoutResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SetTimeoutResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SetTimeoutResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SetTimeoutResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SetTimeoutResponse}
+ */
+proto.wsman.SetTimeoutResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SetTimeoutResponse;
+  return proto.wsman.SetTimeoutResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SetTimeoutResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SetTimeoutResponse}
+ */
+proto.wsman.SetTimeoutResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.SetTimeoutResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.SetTimeoutResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SetTimeoutResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SetTimeoutResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ControlPortRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ControlPortRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ControlPortRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ControlPortRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    expose: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    spec: (f = msg.getSpec()) && proto.wsman.PortSpec.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ControlPortRequest}
+ */
+proto.wsman.ControlPortRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ControlPortRequest;
+  return proto.wsman.ControlPortRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ControlPortRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ControlPortRequest}
+ */
+proto.wsman.ControlPortRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setExpose(value);
+      break;
+    case 3:
+      var value = new proto.wsman.PortSpec;
+      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
+      msg.setSpec(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ControlPortRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ControlPortRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ControlPortRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ControlPortRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getExpose();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getSpec();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.PortSpec.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.ControlPortRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ControlPortRequest} returns this
+ */
+proto.wsman.ControlPortRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool expose = 2;
+ * @return {boolean}
+ */
+proto.wsman.ControlPortRequest.prototype.getExpose = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.ControlPortRequest} returns this
+ */
+proto.wsman.ControlPortRequest.prototype.setExpose = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional PortSpec spec = 3;
+ * @return {?proto.wsman.PortSpec}
+ */
+proto.wsman.ControlPortRequest.prototype.getSpec = function() {
+  return /** @type{?proto.wsman.PortSpec} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.PortSpec, 3));
+};
+
+
+/**
+ * @param {?proto.wsman.PortSpec|undefined} value
+ * @return {!proto.wsman.ControlPortRequest} returns this
+*/
+proto.wsman.ControlPortRequest.prototype.setSpec = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.ControlPortRequest} returns this
+ */
+proto.wsman.ControlPortRequest.prototype.clearSpec = function() {
+  return this.setSpec(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.ControlPortRequest.prototype.hasSpec = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ControlPortResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ControlPortResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ControlPortResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ControlPortResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ControlPortResponse}
+ */
+proto.wsman.ControlPortResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ControlPortResponse;
+  return proto.wsman.ControlPortResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ControlPortResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ControlPortResponse}
+ */
+proto.wsman.ControlPortResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ControlPortResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ControlPortResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ControlPortResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ControlPortResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.TakeSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.TakeSnapshotRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.TakeSnapshotRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.TakeSnapshotRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    returnImmediately: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    message: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.TakeSnapshotRequest}
+ */
+proto.wsman.TakeSnapshotRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.TakeSnapshotRequest;
+  return proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.TakeSnapshotRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.TakeSnapshotRequest}
+ */
+proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReturnImmediately(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 4:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.TakeSnapshotRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.TakeSnapshotRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.TakeSnapshotRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.TakeSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getReturnImmediately();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(4, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.TakeSnapshotRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.TakeSnapshotRequest} returns this
+ */
+proto.wsman.TakeSnapshotRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool return_immediately = 2;
+ * @return {boolean}
+ */
+proto.wsman.TakeSnapshotRequest.prototype.getReturnImmediately = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.TakeSnapshotRequest} returns this
+ */
+proto.wsman.TakeSnapshotRequest.prototype.setReturnImmediately = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional string message = 3;
+ * @return {string}
+ */
+proto.wsman.TakeSnapshotRequest.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.TakeSnapshotRequest} returns this
+ */
+proto.wsman.TakeSnapshotRequest.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * map<string, string> labels = 4;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.wsman.TakeSnapshotRequest.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 4, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.wsman.TakeSnapshotRequest} returns this
+ */
+proto.wsman.TakeSnapshotRequest.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.TakeSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.TakeSnapshotResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.TakeSnapshotResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.TakeSnapshotResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    url: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.TakeSnapshotResponse}
+ */
+proto.wsman.TakeSnapshotResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.TakeSnapshotResponse;
+  return proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.TakeSnapshotResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.TakeSnapshotResponse}
+ */
+proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.TakeSnapshotResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.TakeSnapshotResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.TakeSnapshotResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.TakeSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string url = 1;
+ * @return {string}
+ */
+proto.wsman.TakeSnapshotResponse.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.TakeSnapshotResponse} returns this
+ */
+proto.wsman.TakeSnapshotResponse.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.RestoreSnapshotRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.RestoreSnapshotRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RestoreSnapshotRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    snapshot: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    target: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    conflictPolicy: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.RestoreSnapshotRequest}
+ */
+proto.wsman.RestoreSnapshotRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.RestoreSnapshotRequest;
+  return proto.wsman.RestoreSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.RestoreSnapshotRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.RestoreSnapshotRequest}
+ */
+proto.wsman.RestoreSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSnapshot(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTarget(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.wsman.RestoreConflictPolicy} */ (reader.readEnum());
+      msg.setConflictPolicy(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.RestoreSnapshotRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.RestoreSnapshotRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RestoreSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSnapshot();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getTarget();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getConflictPolicy();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.RestoreSnapshotRequest} returns this
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string snapshot = 2;
+ * @return {string}
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.getSnapshot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.RestoreSnapshotRequest} returns this
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.setSnapshot = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string target = 3;
+ * @return {string}
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.getTarget = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.RestoreSnapshotRequest} returns this
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.setTarget = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional RestoreConflictPolicy conflict_policy = 4;
+ * @return {!proto.wsman.RestoreConflictPolicy}
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.getConflictPolicy = function() {
+  return /** @type {!proto.wsman.RestoreConflictPolicy} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.RestoreConflictPolicy} value
+ * @return {!proto.wsman.RestoreSnapshotRequest} returns this
+ */
+proto.wsman.RestoreSnapshotRequest.prototype.setConflictPolicy = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.RestoreSnapshotResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.RestoreSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.RestoreSnapshotResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.RestoreSnapshotResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RestoreSnapshotResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    conflictsList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.RestoreSnapshotResponse}
+ */
+proto.wsman.RestoreSnapshotResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.RestoreSnapshotResponse;
+  return proto.wsman.RestoreSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.RestoreSnapshotResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.RestoreSnapshotResponse}
+ */
+proto.wsman.RestoreSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addConflicts(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.RestoreSnapshotResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.RestoreSnapshotResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.RestoreSnapshotResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RestoreSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getConflictsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string conflicts = 1;
+ * @return {!Array<string>}
+ */
+proto.wsman.RestoreSnapshotResponse.prototype.getConflictsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.RestoreSnapshotResponse} returns this
+ */
+proto.wsman.RestoreSnapshotResponse.prototype.setConflictsList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.RestoreSnapshotResponse} returns this
+ */
+proto.wsman.RestoreSnapshotResponse.prototype.addConflicts = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.RestoreSnapshotResponse} returns this
+ */
+proto.wsman.RestoreSnapshotResponse.prototype.clearConflictsList = function() {
+  return this.setConflictsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ControlAdmissionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ControlAdmissionRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ControlAdmissionRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ControlAdmissionRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    level: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    expiry: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ControlAdmissionRequest}
+ */
+proto.wsman.ControlAdmissionRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ControlAdmissionRequest;
+  return proto.wsman.ControlAdmissionRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ControlAdmissionRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ControlAdmissionRequest}
+ */
+proto.wsman.ControlAdmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.wsman.AdmissionLevel} */ (reader.readEnum());
+      msg.setLevel(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExpiry(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ControlAdmissionRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ControlAdmissionRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ControlAdmissionRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ControlAdmissionRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLevel();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getExpiry();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.ControlAdmissionRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ControlAdmissionRequest} returns this
+ */
+proto.wsman.ControlAdmissionRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional AdmissionLevel level = 2;
+ * @return {!proto.wsman.AdmissionLevel}
+ */
+proto.wsman.ControlAdmissionRequest.prototype.getLevel = function() {
+  return /** @type {!proto.wsman.AdmissionLevel} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.AdmissionLevel} value
+ * @return {!proto.wsman.ControlAdmissionRequest} returns this
+ */
+proto.wsman.ControlAdmissionRequest.prototype.setLevel = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string expiry = 3;
+ * @return {string}
+ */
+proto.wsman.ControlAdmissionRequest.prototype.getExpiry = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.ControlAdmissionRequest} returns this
+ */
+proto.wsman.ControlAdmissionRequest.prototype.setExpiry = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.ControlAdmissionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ControlAdmissionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.ControlAdmissionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ControlAdmissionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.ControlAdmissionResponse}
+ */
+proto.wsman.ControlAdmissionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.ControlAdmissionResponse;
+  return proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.ControlAdmissionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.ControlAdmissionResponse}
+ */
+proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.ControlAdmissionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.ControlAdmissionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.ControlAdmissionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.ControlAdmissionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DeleteVolumeSnapshotRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DeleteVolumeSnapshotRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    volumeHandle: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    softDelete: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    wsType: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest}
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.DeleteVolumeSnapshotRequest;
+  return proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.DeleteVolumeSnapshotRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest}
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setVolumeHandle(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSoftDelete(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
+      msg.setWsType(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.DeleteVolumeSnapshotRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getVolumeHandle();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getSoftDelete();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getWsType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string volume_handle = 2;
+ * @return {string}
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.getVolumeHandle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.setVolumeHandle = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool soft_delete = 3;
+ * @return {boolean}
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.getSoftDelete = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.setSoftDelete = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional WorkspaceType ws_type = 4;
+ * @return {!proto.wsman.WorkspaceType}
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.getWsType = function() {
+  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.wsman.WorkspaceType} value
+ * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ */
+proto.wsman.DeleteVolumeSnapshotRequest.prototype.setWsType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DeleteVolumeSnapshotResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.DeleteVolumeSnapshotResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    wasDeleted: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.DeleteVolumeSnapshotResponse}
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.DeleteVolumeSnapshotResponse;
+  return proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.DeleteVolumeSnapshotResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.DeleteVolumeSnapshotResponse}
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setWasDeleted(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.DeleteVolumeSnapshotResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.DeleteVolumeSnapshotResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWasDeleted();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool was_deleted = 1;
+ * @return {boolean}
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.prototype.getWasDeleted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.DeleteVolumeSnapshotResponse} returns this
+ */
+proto.wsman.DeleteVolumeSnapshotResponse.prototype.setWasDeleted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.BackupWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.BackupWorkspaceRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.BackupWorkspaceRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.BackupWorkspaceRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.BackupWorkspaceRequest}
+ */
+proto.wsman.BackupWorkspaceRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.BackupWorkspaceRequest;
+  return proto.wsman.BackupWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.BackupWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.BackupWorkspaceRequest}
+ */
+proto.wsman.BackupWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.BackupWorkspaceRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.BackupWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.BackupWorkspaceRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.BackupWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.BackupWorkspaceRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.BackupWorkspaceRequest} returns this
+ */
+proto.wsman.BackupWorkspaceRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.BackupWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.BackupWorkspaceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.BackupWorkspaceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.BackupWorkspaceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    url: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.BackupWorkspaceResponse}
+ */
+proto.wsman.BackupWorkspaceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.BackupWorkspaceResponse;
+  return proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.BackupWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.BackupWorkspaceResponse}
+ */
+proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.BackupWorkspaceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.BackupWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.BackupWorkspaceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.BackupWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string url = 1;
+ * @return {string}
+ */
+proto.wsman.BackupWorkspaceResponse.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.BackupWorkspaceResponse} returns this
+ */
+proto.wsman.BackupWorkspaceResponse.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.UpdateSSHKeyRequest.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.UpdateSSHKeyRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.UpdateSSHKeyRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.UpdateSSHKeyRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.UpdateSSHKeyRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    keysList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.UpdateSSHKeyRequest}
+ */
+proto.wsman.UpdateSSHKeyRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.UpdateSSHKeyRequest;
+  return proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.UpdateSSHKeyRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.UpdateSSHKeyRequest}
+ */
+proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addKeys(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.UpdateSSHKeyRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.UpdateSSHKeyRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.UpdateSSHKeyRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.UpdateSSHKeyRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getKeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.UpdateSSHKeyRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
+ */
+proto.wsman.UpdateSSHKeyRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string keys = 2;
+ * @return {!Array<string>}
+ */
+proto.wsman.UpdateSSHKeyRequest.prototype.getKeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
+ */
+proto.wsman.UpdateSSHKeyRequest.prototype.setKeysList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
+ */
+proto.wsman.UpdateSSHKeyRequest.prototype.addKeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
+ */
+proto.wsman.UpdateSSHKeyRequest.prototype.clearKeysList = function() {
+  return this.setKeysList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.UpdateSSHKeyResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.UpdateSSHKeyResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.UpdateSSHKeyResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.UpdateSSHKeyResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.UpdateSSHKeyResponse}
+ */
+proto.wsman.UpdateSSHKeyResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.UpdateSSHKeyResponse;
+  return proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.UpdateSSHKeyResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.UpdateSSHKeyResponse}
+ */
+proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.UpdateSSHKeyResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.UpdateSSHKeyResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.UpdateSSHKeyResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.UpdateSSHKeyResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.RelocateWorkspaceRequest.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.RelocateWorkspaceRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.RelocateWorkspaceRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RelocateWorkspaceRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    targetNode: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    envvarsList: jspb.Message.toObjectList(msg.getEnvvarsList(),
+    proto.wsman.EnvironmentVariable.toObject, includeInstance),
+    sysEnvvarsList: jspb.Message.toObjectList(msg.getSysEnvvarsList(),
+    proto.wsman.EnvironmentVariable.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.RelocateWorkspaceRequest}
+ */
+proto.wsman.RelocateWorkspaceRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.RelocateWorkspaceRequest;
+  return proto.wsman.RelocateWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.RelocateWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.RelocateWorkspaceRequest}
+ */
+proto.wsman.RelocateWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTargetNode(value);
+      break;
+    case 3:
+      var value = new proto.wsman.EnvironmentVariable;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
+      msg.addEnvvars(value);
+      break;
+    case 4:
+      var value = new proto.wsman.EnvironmentVariable;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
+      msg.addSysEnvvars(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.RelocateWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.RelocateWorkspaceRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RelocateWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTargetNode();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getEnvvarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+    );
+  }
+  f = message.getSysEnvvarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.RelocateWorkspaceRequest} returns this
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string target_node = 2;
+ * @return {string}
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.getTargetNode = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.RelocateWorkspaceRequest} returns this
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.setTargetNode = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated EnvironmentVariable envvars = 3;
+ * @return {!Array<!proto.wsman.EnvironmentVariable>}
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.getEnvvarsList = function() {
+  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.EnvironmentVariable>} value
+ * @return {!proto.wsman.RelocateWorkspaceRequest} returns this
+*/
+proto.wsman.RelocateWorkspaceRequest.prototype.setEnvvarsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.wsman.EnvironmentVariable=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.addEnvvars = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.RelocateWorkspaceRequest} returns this
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.clearEnvvarsList = function() {
+  return this.setEnvvarsList([]);
+};
+
+
+/**
+ * repeated EnvironmentVariable sys_envvars = 4;
+ * @return {!Array<!proto.wsman.EnvironmentVariable>}
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.getSysEnvvarsList = function() {
+  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.EnvironmentVariable>} value
+ * @return {!proto.wsman.RelocateWorkspaceRequest} returns this
+*/
+proto.wsman.RelocateWorkspaceRequest.prototype.setSysEnvvarsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.wsman.EnvironmentVariable=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.addSysEnvvars = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.RelocateWorkspaceRequest} returns this
+ */
+proto.wsman.RelocateWorkspaceRequest.prototype.clearSysEnvvarsList = function() {
+  return this.setSysEnvvarsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.RelocateWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.RelocateWorkspaceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.RelocateWorkspaceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RelocateWorkspaceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.RelocateWorkspaceResponse}
+ */
+proto.wsman.RelocateWorkspaceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.RelocateWorkspaceResponse;
+  return proto.wsman.RelocateWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.RelocateWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.RelocateWorkspaceResponse}
+ */
+proto.wsman.RelocateWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.RelocateWorkspaceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.RelocateWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.RelocateWorkspaceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.RelocateWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.UpdateWorkspaceClassRequest.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.UpdateWorkspaceClassRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.UpdateWorkspaceClassRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.UpdateWorkspaceClassRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    workspaceClass: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    envvarsList: jspb.Message.toObjectList(msg.getEnvvarsList(),
+    proto.wsman.EnvironmentVariable.toObject, includeInstance),
+    sysEnvvarsList: jspb.Message.toObjectList(msg.getSysEnvvarsList(),
+    proto.wsman.EnvironmentVariable.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.UpdateWorkspaceClassRequest}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.UpdateWorkspaceClassRequest;
+  return proto.wsman.UpdateWorkspaceClassRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.UpdateWorkspaceClassRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.UpdateWorkspaceClassRequest}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWorkspaceClass(value);
+      break;
+    case 3:
+      var value = new proto.wsman.EnvironmentVariable;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
+      msg.addEnvvars(value);
+      break;
+    case 4:
+      var value = new proto.wsman.EnvironmentVariable;
+      reader.readMessage(value,proto.wsman.EnvironmentVariable.deserializeBinaryFromReader);
+      msg.addSysEnvvars(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.UpdateWorkspaceClassRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.UpdateWorkspaceClassRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.UpdateWorkspaceClassRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getWorkspaceClass();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getEnvvarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+    );
+  }
+  f = message.getSysEnvvarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.wsman.EnvironmentVariable.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.UpdateWorkspaceClassRequest} returns this
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string workspace_class = 2;
+ * @return {string}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.getWorkspaceClass = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.UpdateWorkspaceClassRequest} returns this
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.setWorkspaceClass = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated EnvironmentVariable envvars = 3;
+ * @return {!Array<!proto.wsman.EnvironmentVariable>}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.getEnvvarsList = function() {
+  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.EnvironmentVariable>} value
+ * @return {!proto.wsman.UpdateWorkspaceClassRequest} returns this
+*/
+proto.wsman.UpdateWorkspaceClassRequest.prototype.setEnvvarsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.wsman.EnvironmentVariable=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.addEnvvars = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.UpdateWorkspaceClassRequest} returns this
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.clearEnvvarsList = function() {
+  return this.setEnvvarsList([]);
+};
+
+
+/**
+ * repeated EnvironmentVariable sys_envvars = 4;
+ * @return {!Array<!proto.wsman.EnvironmentVariable>}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.getSysEnvvarsList = function() {
+  return /** @type{!Array<!proto.wsman.EnvironmentVariable>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.EnvironmentVariable, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.EnvironmentVariable>} value
+ * @return {!proto.wsman.UpdateWorkspaceClassRequest} returns this
+*/
+proto.wsman.UpdateWorkspaceClassRequest.prototype.setSysEnvvarsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.wsman.EnvironmentVariable=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.EnvironmentVariable}
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.addSysEnvvars = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.wsman.EnvironmentVariable, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.UpdateWorkspaceClassRequest} returns this
+ */
+proto.wsman.UpdateWorkspaceClassRequest.prototype.clearSysEnvvarsList = function() {
+  return this.setSysEnvvarsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.UpdateWorkspaceClassResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.UpdateWorkspaceClassResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.UpdateWorkspaceClassResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.UpdateWorkspaceClassResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    restarting: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.UpdateWorkspaceClassResponse}
+ */
+proto.wsman.UpdateWorkspaceClassResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.UpdateWorkspaceClassResponse;
+  return proto.wsman.UpdateWorkspaceClassResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.UpdateWorkspaceClassResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.UpdateWorkspaceClassResponse}
+ */
+proto.wsman.UpdateWorkspaceClassResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRestarting(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.UpdateWorkspaceClassResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.UpdateWorkspaceClassResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.UpdateWorkspaceClassResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.UpdateWorkspaceClassResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRestarting();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool restarting = 1;
+ * @return {boolean}
+ */
+proto.wsman.UpdateWorkspaceClassResponse.prototype.getRestarting = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.UpdateWorkspaceClassResponse} returns this
+ */
+proto.wsman.UpdateWorkspaceClassResponse.prototype.setRestarting = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.SetRestartClassRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SetRestartClassRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SetRestartClassRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SetRestartClassRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    workspaceId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    workspaceClass: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SetRestartClassRequest}
+ */
+proto.wsman.SetRestartClassRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SetRestartClassRequest;
+  return proto.wsman.SetRestartClassRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SetRestartClassRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SetRestartClassRequest}
+ */
+proto.wsman.SetRestartClassRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setOwner(value);
+      msg.setWorkspaceId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMetaId(value);
-      break;
-    case 3:
-      var value = msg.getAnnotationsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      msg.setWorkspaceClass(value);
       break;
     default:
       reader.skipField();
@@ -1122,9 +7971,9 @@ proto.wsman.MetadataFilter.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.MetadataFilter.prototype.serializeBinary = function() {
+proto.wsman.SetRestartClassRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.MetadataFilter.serializeBinaryToWriter(this, writer);
+  proto.wsman.SetRestartClassRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1132,89 +7981,164 @@ proto.wsman.MetadataFilter.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.MetadataFilter} message
+ * @param {!proto.wsman.SetRestartClassRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.MetadataFilter.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.SetRestartClassRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getOwner();
+  f = message.getWorkspaceId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getMetaId();
+  f = message.getWorkspaceClass();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getAnnotationsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
-  }
 };
 
 
 /**
- * optional string owner = 1;
+ * optional string workspace_id = 1;
  * @return {string}
  */
-proto.wsman.MetadataFilter.prototype.getOwner = function() {
+proto.wsman.SetRestartClassRequest.prototype.getWorkspaceId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.MetadataFilter} returns this
+ * @return {!proto.wsman.SetRestartClassRequest} returns this
  */
-proto.wsman.MetadataFilter.prototype.setOwner = function(value) {
+proto.wsman.SetRestartClassRequest.prototype.setWorkspaceId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string meta_id = 2;
+ * optional string workspace_class = 2;
  * @return {string}
  */
-proto.wsman.MetadataFilter.prototype.getMetaId = function() {
+proto.wsman.SetRestartClassRequest.prototype.getWorkspaceClass = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.MetadataFilter} returns this
+ * @return {!proto.wsman.SetRestartClassRequest} returns this
  */
-proto.wsman.MetadataFilter.prototype.setMetaId = function(value) {
+proto.wsman.SetRestartClassRequest.prototype.setWorkspaceClass = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * map<string, string> annotations = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.wsman.MetadataFilter.prototype.getAnnotationsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      null));
+proto.wsman.SetRestartClassResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SetRestartClassResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.wsman.MetadataFilter} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.SetRestartClassResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.MetadataFilter.prototype.clearAnnotationsMap = function() {
-  this.getAnnotationsMap().clear();
-  return this;};
+proto.wsman.SetRestartClassResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.SetRestartClassResponse}
+ */
+proto.wsman.SetRestartClassResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.SetRestartClassResponse;
+  return proto.wsman.SetRestartClassResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.SetRestartClassResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.SetRestartClassResponse}
+ */
+proto.wsman.SetRestartClassResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.SetRestartClassResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.SetRestartClassResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.SetRestartClassResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.SetRestartClassResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
 
 
 
@@ -1233,8 +8157,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.GetWorkspacesRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.GetWorkspacesRequest.toObject(opt_includeInstance, this);
+proto.wsman.ListSnapshotsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ListSnapshotsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1243,11 +8167,11 @@ proto.wsman.GetWorkspacesRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.GetWorkspacesRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.ListSnapshotsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.GetWorkspacesRequest.toObject = function(includeInstance, msg) {
+proto.wsman.ListSnapshotsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     mustMatch: (f = msg.getMustMatch()) && proto.wsman.MetadataFilter.toObject(includeInstance, f)
   };
@@ -1263,23 +8187,23 @@ proto.wsman.GetWorkspacesRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.GetWorkspacesRequest}
+ * @return {!proto.wsman.ListSnapshotsRequest}
  */
-proto.wsman.GetWorkspacesRequest.deserializeBinary = function(bytes) {
+proto.wsman.ListSnapshotsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.GetWorkspacesRequest;
-  return proto.wsman.GetWorkspacesRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ListSnapshotsRequest;
+  return proto.wsman.ListSnapshotsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.GetWorkspacesRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.ListSnapshotsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.GetWorkspacesRequest}
+ * @return {!proto.wsman.ListSnapshotsRequest}
  */
-proto.wsman.GetWorkspacesRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ListSnapshotsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1304,9 +8228,9 @@ proto.wsman.GetWorkspacesRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.GetWorkspacesRequest.prototype.serializeBinary = function() {
+proto.wsman.ListSnapshotsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.GetWorkspacesRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.ListSnapshotsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1314,11 +8238,11 @@ proto.wsman.GetWorkspacesRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.GetWorkspacesRequest} message
+ * @param {!proto.wsman.ListSnapshotsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.GetWorkspacesRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ListSnapshotsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getMustMatch();
   if (f != null) {
@@ -1335,7 +8259,7 @@ proto.wsman.GetWorkspacesRequest.serializeBinaryToWriter = function(message, wri
  * optional MetadataFilter must_match = 1;
  * @return {?proto.wsman.MetadataFilter}
  */
-proto.wsman.GetWorkspacesRequest.prototype.getMustMatch = function() {
+proto.wsman.ListSnapshotsRequest.prototype.getMustMatch = function() {
   return /** @type{?proto.wsman.MetadataFilter} */ (
     jspb.Message.getWrapperField(this, proto.wsman.MetadataFilter, 1));
 };
@@ -1343,18 +8267,18 @@ proto.wsman.GetWorkspacesRequest.prototype.getMustMatch = function() {
 
 /**
  * @param {?proto.wsman.MetadataFilter|undefined} value
- * @return {!proto.wsman.GetWorkspacesRequest} returns this
+ * @return {!proto.wsman.ListSnapshotsRequest} returns this
 */
-proto.wsman.GetWorkspacesRequest.prototype.setMustMatch = function(value) {
+proto.wsman.ListSnapshotsRequest.prototype.setMustMatch = function(value) {
   return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.wsman.GetWorkspacesRequest} returns this
+ * @return {!proto.wsman.ListSnapshotsRequest} returns this
  */
-proto.wsman.GetWorkspacesRequest.prototype.clearMustMatch = function() {
+proto.wsman.ListSnapshotsRequest.prototype.clearMustMatch = function() {
   return this.setMustMatch(undefined);
 };
 
@@ -1363,7 +8287,7 @@ proto.wsman.GetWorkspacesRequest.prototype.clearMustMatch = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.GetWorkspacesRequest.prototype.hasMustMatch = function() {
+proto.wsman.ListSnapshotsRequest.prototype.hasMustMatch = function() {
   return jspb.Message.getField(this, 1) != null;
 };
 
@@ -1374,7 +8298,7 @@ proto.wsman.GetWorkspacesRequest.prototype.hasMustMatch = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.wsman.GetWorkspacesResponse.repeatedFields_ = [1];
+proto.wsman.ListSnapshotsResponse.repeatedFields_ = [1];
 
 
 
@@ -1391,8 +8315,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.GetWorkspacesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.GetWorkspacesResponse.toObject(opt_includeInstance, this);
+proto.wsman.ListSnapshotsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ListSnapshotsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1401,14 +8325,14 @@ proto.wsman.GetWorkspacesResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.GetWorkspacesResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.ListSnapshotsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.GetWorkspacesResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    statusList: jspb.Message.toObjectList(msg.getStatusList(),
-    proto.wsman.WorkspaceStatus.toObject, includeInstance)
+proto.wsman.ListSnapshotsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    snapshotsList: jspb.Message.toObjectList(msg.getSnapshotsList(),
+    proto.wsman.SnapshotInfo.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -1422,23 +8346,23 @@ proto.wsman.GetWorkspacesResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.GetWorkspacesResponse}
+ * @return {!proto.wsman.ListSnapshotsResponse}
  */
-proto.wsman.GetWorkspacesResponse.deserializeBinary = function(bytes) {
+proto.wsman.ListSnapshotsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.GetWorkspacesResponse;
-  return proto.wsman.GetWorkspacesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ListSnapshotsResponse;
+  return proto.wsman.ListSnapshotsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.GetWorkspacesResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.ListSnapshotsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.GetWorkspacesResponse}
+ * @return {!proto.wsman.ListSnapshotsResponse}
  */
-proto.wsman.GetWorkspacesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ListSnapshotsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1446,9 +8370,9 @@ proto.wsman.GetWorkspacesResponse.deserializeBinaryFromReader = function(msg, re
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.wsman.WorkspaceStatus;
-      reader.readMessage(value,proto.wsman.WorkspaceStatus.deserializeBinaryFromReader);
-      msg.addStatus(value);
+      var value = new proto.wsman.SnapshotInfo;
+      reader.readMessage(value,proto.wsman.SnapshotInfo.deserializeBinaryFromReader);
+      msg.addSnapshots(value);
       break;
     default:
       reader.skipField();
@@ -1463,9 +8387,9 @@ proto.wsman.GetWorkspacesResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.GetWorkspacesResponse.prototype.serializeBinary = function() {
+proto.wsman.ListSnapshotsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.GetWorkspacesResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.ListSnapshotsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1473,58 +8397,58 @@ proto.wsman.GetWorkspacesResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.GetWorkspacesResponse} message
+ * @param {!proto.wsman.ListSnapshotsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.GetWorkspacesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ListSnapshotsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatusList();
+  f = message.getSnapshotsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.wsman.WorkspaceStatus.serializeBinaryToWriter
+      proto.wsman.SnapshotInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated WorkspaceStatus status = 1;
- * @return {!Array<!proto.wsman.WorkspaceStatus>}
+ * repeated SnapshotInfo snapshots = 1;
+ * @return {!Array<!proto.wsman.SnapshotInfo>}
  */
-proto.wsman.GetWorkspacesResponse.prototype.getStatusList = function() {
-  return /** @type{!Array<!proto.wsman.WorkspaceStatus>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.WorkspaceStatus, 1));
+proto.wsman.ListSnapshotsResponse.prototype.getSnapshotsList = function() {
+  return /** @type{!Array<!proto.wsman.SnapshotInfo>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.SnapshotInfo, 1));
 };
 
 
 /**
- * @param {!Array<!proto.wsman.WorkspaceStatus>} value
- * @return {!proto.wsman.GetWorkspacesResponse} returns this
+ * @param {!Array<!proto.wsman.SnapshotInfo>} value
+ * @return {!proto.wsman.ListSnapshotsResponse} returns this
 */
-proto.wsman.GetWorkspacesResponse.prototype.setStatusList = function(value) {
+proto.wsman.ListSnapshotsResponse.prototype.setSnapshotsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceStatus=} opt_value
+ * @param {!proto.wsman.SnapshotInfo=} opt_value
  * @param {number=} opt_index
- * @return {!proto.wsman.WorkspaceStatus}
+ * @return {!proto.wsman.SnapshotInfo}
  */
-proto.wsman.GetWorkspacesResponse.prototype.addStatus = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.WorkspaceStatus, opt_index);
+proto.wsman.ListSnapshotsResponse.prototype.addSnapshots = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.wsman.SnapshotInfo, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.wsman.GetWorkspacesResponse} returns this
+ * @return {!proto.wsman.ListSnapshotsResponse} returns this
  */
-proto.wsman.GetWorkspacesResponse.prototype.clearStatusList = function() {
-  return this.setStatusList([]);
+proto.wsman.ListSnapshotsResponse.prototype.clearSnapshotsList = function() {
+  return this.setSnapshotsList([]);
 };
 
 
@@ -1544,8 +8468,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.StartWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.StartWorkspaceRequest.toObject(opt_includeInstance, this);
+proto.wsman.SnapshotInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.SnapshotInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -1554,17 +8478,23 @@ proto.wsman.StartWorkspaceRequest.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.StartWorkspaceRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.SnapshotInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceRequest.toObject = function(includeInstance, msg) {
+proto.wsman.SnapshotInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    servicePrefix: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
-    spec: (f = msg.getSpec()) && proto.wsman.StartWorkspaceSpec.toObject(includeInstance, f),
-    type: jspb.Message.getFieldWithDefault(msg, 6, 0)
+    instanceId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    metaId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    owner: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    url: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    labelsMap: (f = msg.getLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    creationTime: (f = msg.getCreationTime()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    size: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    completed: jspb.Message.getBooleanFieldWithDefault(msg, 10, false),
+    error: jspb.Message.getFieldWithDefault(msg, 11, "")
   };
 
   if (includeInstance) {
@@ -1578,23 +8508,23 @@ proto.wsman.StartWorkspaceRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.StartWorkspaceRequest}
+ * @return {!proto.wsman.SnapshotInfo}
  */
-proto.wsman.StartWorkspaceRequest.deserializeBinary = function(bytes) {
+proto.wsman.SnapshotInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.StartWorkspaceRequest;
-  return proto.wsman.StartWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.SnapshotInfo;
+  return proto.wsman.SnapshotInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.StartWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.SnapshotInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.StartWorkspaceRequest}
+ * @return {!proto.wsman.SnapshotInfo}
  */
-proto.wsman.StartWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.SnapshotInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1607,21 +8537,46 @@ proto.wsman.StartWorkspaceRequest.deserializeBinaryFromReader = function(msg, re
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServicePrefix(value);
+      msg.setInstanceId(value);
       break;
     case 3:
-      var value = new proto.wsman.WorkspaceMetadata;
-      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMetaId(value);
       break;
     case 4:
-      var value = new proto.wsman.StartWorkspaceSpec;
-      reader.readMessage(value,proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader);
-      msg.setSpec(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setOwner(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
       break;
     case 6:
-      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
-      msg.setType(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 7:
+      var value = msg.getLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 8:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreationTime(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setSize(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setCompleted(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
       break;
     default:
       reader.skipField();
@@ -1636,9 +8591,9 @@ proto.wsman.StartWorkspaceRequest.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.StartWorkspaceRequest.prototype.serializeBinary = function() {
+proto.wsman.SnapshotInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.StartWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.SnapshotInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1646,11 +8601,11 @@ proto.wsman.StartWorkspaceRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.StartWorkspaceRequest} message
+ * @param {!proto.wsman.SnapshotInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StartWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.SnapshotInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -1659,33 +8614,71 @@ proto.wsman.StartWorkspaceRequest.serializeBinaryToWriter = function(message, wr
       f
     );
   }
-  f = message.getServicePrefix();
+  f = message.getInstanceId();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getMetaId();
+  if (f.length > 0) {
+    writer.writeString(
       3,
-      f,
-      proto.wsman.WorkspaceMetadata.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getSpec();
+  f = message.getOwner();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(7, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getCreationTime();
   if (f != null) {
     writer.writeMessage(
-      4,
+      8,
       f,
-      proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      6,
+  f = message.getSize();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+  f = message.getCompleted();
+  if (f) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
       f
     );
   }
@@ -1696,287 +8689,220 @@ proto.wsman.StartWorkspaceRequest.serializeBinaryToWriter = function(message, wr
  * optional string id = 1;
  * @return {string}
  */
-proto.wsman.StartWorkspaceRequest.prototype.getId = function() {
+proto.wsman.SnapshotInfo.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ * @return {!proto.wsman.SnapshotInfo} returns this
  */
-proto.wsman.StartWorkspaceRequest.prototype.setId = function(value) {
+proto.wsman.SnapshotInfo.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string service_prefix = 2;
+ * optional string instance_id = 2;
  * @return {string}
  */
-proto.wsman.StartWorkspaceRequest.prototype.getServicePrefix = function() {
+proto.wsman.SnapshotInfo.prototype.getInstanceId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ * @return {!proto.wsman.SnapshotInfo} returns this
  */
-proto.wsman.StartWorkspaceRequest.prototype.setServicePrefix = function(value) {
+proto.wsman.SnapshotInfo.prototype.setInstanceId = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional WorkspaceMetadata metadata = 3;
- * @return {?proto.wsman.WorkspaceMetadata}
+ * optional string meta_id = 3;
+ * @return {string}
  */
-proto.wsman.StartWorkspaceRequest.prototype.getMetadata = function() {
-  return /** @type{?proto.wsman.WorkspaceMetadata} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata, 3));
+proto.wsman.SnapshotInfo.prototype.getMetaId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {?proto.wsman.WorkspaceMetadata|undefined} value
- * @return {!proto.wsman.StartWorkspaceRequest} returns this
-*/
-proto.wsman.StartWorkspaceRequest.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {string} value
+ * @return {!proto.wsman.SnapshotInfo} returns this
+ */
+proto.wsman.SnapshotInfo.prototype.setMetaId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ * optional string owner = 4;
+ * @return {string}
  */
-proto.wsman.StartWorkspaceRequest.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
+proto.wsman.SnapshotInfo.prototype.getOwner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.wsman.SnapshotInfo} returns this
  */
-proto.wsman.StartWorkspaceRequest.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.wsman.SnapshotInfo.prototype.setOwner = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional StartWorkspaceSpec spec = 4;
- * @return {?proto.wsman.StartWorkspaceSpec}
+ * optional string url = 5;
+ * @return {string}
  */
-proto.wsman.StartWorkspaceRequest.prototype.getSpec = function() {
-  return /** @type{?proto.wsman.StartWorkspaceSpec} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.StartWorkspaceSpec, 4));
+proto.wsman.SnapshotInfo.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {?proto.wsman.StartWorkspaceSpec|undefined} value
- * @return {!proto.wsman.StartWorkspaceRequest} returns this
-*/
-proto.wsman.StartWorkspaceRequest.prototype.setSpec = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * @param {string} value
+ * @return {!proto.wsman.SnapshotInfo} returns this
+ */
+proto.wsman.SnapshotInfo.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ * optional string message = 6;
+ * @return {string}
  */
-proto.wsman.StartWorkspaceRequest.prototype.clearSpec = function() {
-  return this.setSpec(undefined);
+proto.wsman.SnapshotInfo.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.wsman.SnapshotInfo} returns this
  */
-proto.wsman.StartWorkspaceRequest.prototype.hasSpec = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.wsman.SnapshotInfo.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * optional WorkspaceType type = 6;
- * @return {!proto.wsman.WorkspaceType}
+ * map<string, string> labels = 7;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.wsman.StartWorkspaceRequest.prototype.getType = function() {
-  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.wsman.SnapshotInfo.prototype.getLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 7, opt_noLazyCreate,
+      null));
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceType} value
- * @return {!proto.wsman.StartWorkspaceRequest} returns this
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.wsman.SnapshotInfo} returns this
  */
-proto.wsman.StartWorkspaceRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 6, value);
-};
-
-
-
+proto.wsman.SnapshotInfo.prototype.clearLabelsMap = function() {
+  this.getLabelsMap().clear();
+  return this;};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional google.protobuf.Timestamp creation_time = 8;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.wsman.StartWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.StartWorkspaceResponse.toObject(opt_includeInstance, this);
+proto.wsman.SnapshotInfo.prototype.getCreationTime = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 8));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.StartWorkspaceResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.StartWorkspaceResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.SnapshotInfo} returns this
+*/
+proto.wsman.SnapshotInfo.prototype.setCreationTime = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.StartWorkspaceResponse}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.SnapshotInfo} returns this
  */
-proto.wsman.StartWorkspaceResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.StartWorkspaceResponse;
-  return proto.wsman.StartWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+proto.wsman.SnapshotInfo.prototype.clearCreationTime = function() {
+  return this.setCreationTime(undefined);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.StartWorkspaceResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.StartWorkspaceResponse}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.StartWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setOwnerToken(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.wsman.SnapshotInfo.prototype.hasCreationTime = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional int64 size = 9;
+ * @return {number}
  */
-proto.wsman.StartWorkspaceResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.StartWorkspaceResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.SnapshotInfo.prototype.getSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.StartWorkspaceResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.wsman.SnapshotInfo} returns this
  */
-proto.wsman.StartWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUrl();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getOwnerToken();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.wsman.SnapshotInfo.prototype.setSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
 };
 
 
 /**
- * optional string url = 1;
- * @return {string}
+ * optional bool completed = 10;
+ * @return {boolean}
  */
-proto.wsman.StartWorkspaceResponse.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.SnapshotInfo.prototype.getCompleted = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.StartWorkspaceResponse} returns this
+ * @param {boolean} value
+ * @return {!proto.wsman.SnapshotInfo} returns this
  */
-proto.wsman.StartWorkspaceResponse.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.SnapshotInfo.prototype.setCompleted = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 10, value);
 };
 
 
 /**
- * optional string owner_token = 2;
+ * optional string error = 11;
  * @return {string}
  */
-proto.wsman.StartWorkspaceResponse.prototype.getOwnerToken = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.SnapshotInfo.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.StartWorkspaceResponse} returns this
+ * @return {!proto.wsman.SnapshotInfo} returns this
  */
-proto.wsman.StartWorkspaceResponse.prototype.setOwnerToken = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.SnapshotInfo.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
 };
 
 
@@ -1996,8 +8922,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.StopWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.StopWorkspaceRequest.toObject(opt_includeInstance, this);
+proto.wsman.ExportSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ExportSnapshotRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2006,14 +8932,14 @@ proto.wsman.StopWorkspaceRequest.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.StopWorkspaceRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.ExportSnapshotRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StopWorkspaceRequest.toObject = function(includeInstance, msg) {
+proto.wsman.ExportSnapshotRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    policy: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    tag: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2027,23 +8953,23 @@ proto.wsman.StopWorkspaceRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.StopWorkspaceRequest}
+ * @return {!proto.wsman.ExportSnapshotRequest}
  */
-proto.wsman.StopWorkspaceRequest.deserializeBinary = function(bytes) {
+proto.wsman.ExportSnapshotRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.StopWorkspaceRequest;
-  return proto.wsman.StopWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ExportSnapshotRequest;
+  return proto.wsman.ExportSnapshotRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.StopWorkspaceRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.ExportSnapshotRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.StopWorkspaceRequest}
+ * @return {!proto.wsman.ExportSnapshotRequest}
  */
-proto.wsman.StopWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ExportSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2055,8 +8981,8 @@ proto.wsman.StopWorkspaceRequest.deserializeBinaryFromReader = function(msg, rea
       msg.setId(value);
       break;
     case 2:
-      var value = /** @type {!proto.wsman.StopWorkspacePolicy} */ (reader.readEnum());
-      msg.setPolicy(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTag(value);
       break;
     default:
       reader.skipField();
@@ -2071,9 +8997,9 @@ proto.wsman.StopWorkspaceRequest.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.StopWorkspaceRequest.prototype.serializeBinary = function() {
+proto.wsman.ExportSnapshotRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.StopWorkspaceRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.ExportSnapshotRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2081,11 +9007,11 @@ proto.wsman.StopWorkspaceRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.StopWorkspaceRequest} message
+ * @param {!proto.wsman.ExportSnapshotRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StopWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ExportSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -2094,9 +9020,9 @@ proto.wsman.StopWorkspaceRequest.serializeBinaryToWriter = function(message, wri
       f
     );
   }
-  f = message.getPolicy();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getTag();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
@@ -2108,35 +9034,35 @@ proto.wsman.StopWorkspaceRequest.serializeBinaryToWriter = function(message, wri
  * optional string id = 1;
  * @return {string}
  */
-proto.wsman.StopWorkspaceRequest.prototype.getId = function() {
+proto.wsman.ExportSnapshotRequest.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.StopWorkspaceRequest} returns this
+ * @return {!proto.wsman.ExportSnapshotRequest} returns this
  */
-proto.wsman.StopWorkspaceRequest.prototype.setId = function(value) {
+proto.wsman.ExportSnapshotRequest.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional StopWorkspacePolicy policy = 2;
- * @return {!proto.wsman.StopWorkspacePolicy}
+ * optional string tag = 2;
+ * @return {string}
  */
-proto.wsman.StopWorkspaceRequest.prototype.getPolicy = function() {
-  return /** @type {!proto.wsman.StopWorkspacePolicy} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.wsman.ExportSnapshotRequest.prototype.getTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!proto.wsman.StopWorkspacePolicy} value
- * @return {!proto.wsman.StopWorkspaceRequest} returns this
+ * @param {string} value
+ * @return {!proto.wsman.ExportSnapshotRequest} returns this
  */
-proto.wsman.StopWorkspaceRequest.prototype.setPolicy = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.wsman.ExportSnapshotRequest.prototype.setTag = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -2156,8 +9082,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.StopWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.StopWorkspaceResponse.toObject(opt_includeInstance, this);
+proto.wsman.ExportSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ExportSnapshotResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2166,13 +9092,14 @@ proto.wsman.StopWorkspaceResponse.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.StopWorkspaceResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.ExportSnapshotResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StopWorkspaceResponse.toObject = function(includeInstance, msg) {
+proto.wsman.ExportSnapshotResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    imageRef: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2186,29 +9113,37 @@ proto.wsman.StopWorkspaceResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.StopWorkspaceResponse}
+ * @return {!proto.wsman.ExportSnapshotResponse}
  */
-proto.wsman.StopWorkspaceResponse.deserializeBinary = function(bytes) {
+proto.wsman.ExportSnapshotResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.StopWorkspaceResponse;
-  return proto.wsman.StopWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ExportSnapshotResponse;
+  return proto.wsman.ExportSnapshotResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.StopWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.ExportSnapshotResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.StopWorkspaceResponse}
+ * @return {!proto.wsman.ExportSnapshotResponse}
  */
-proto.wsman.StopWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ExportSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setImageRef(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2222,9 +9157,9 @@ proto.wsman.StopWorkspaceResponse.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.StopWorkspaceResponse.prototype.serializeBinary = function() {
+proto.wsman.ExportSnapshotResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.StopWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.ExportSnapshotResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2232,142 +9167,62 @@ proto.wsman.StopWorkspaceResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.StopWorkspaceResponse} message
+ * @param {!proto.wsman.ExportSnapshotResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.StopWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ExportSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.wsman.DescribeWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DescribeWorkspaceRequest.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.DescribeWorkspaceRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.wsman.DescribeWorkspaceRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
   }
-  return obj;
-};
-}
-
-
-/**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DescribeWorkspaceRequest}
- */
-proto.wsman.DescribeWorkspaceRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DescribeWorkspaceRequest;
-  return proto.wsman.DescribeWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
-};
-
-
-/**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.DescribeWorkspaceRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DescribeWorkspaceRequest}
- */
-proto.wsman.DescribeWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
+  f = message.getImageRef();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
   }
-  return msg;
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string url = 1;
+ * @return {string}
  */
-proto.wsman.DescribeWorkspaceRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.DescribeWorkspaceRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.ExportSnapshotResponse.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DescribeWorkspaceRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.wsman.ExportSnapshotResponse} returns this
  */
-proto.wsman.DescribeWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.wsman.ExportSnapshotResponse.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string id = 1;
+ * optional string image_ref = 2;
  * @return {string}
  */
-proto.wsman.DescribeWorkspaceRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.ExportSnapshotResponse.prototype.getImageRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.DescribeWorkspaceRequest} returns this
+ * @return {!proto.wsman.ExportSnapshotResponse} returns this
  */
-proto.wsman.DescribeWorkspaceRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.ExportSnapshotResponse.prototype.setImageRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -2387,8 +9242,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.DescribeWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DescribeWorkspaceResponse.toObject(opt_includeInstance, this);
+proto.wsman.GetQueuePositionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetQueuePositionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2397,14 +9252,13 @@ proto.wsman.DescribeWorkspaceResponse.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.DescribeWorkspaceResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.GetQueuePositionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DescribeWorkspaceResponse.toObject = function(includeInstance, msg) {
+proto.wsman.GetQueuePositionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    status: (f = msg.getStatus()) && proto.wsman.WorkspaceStatus.toObject(includeInstance, f),
-    lastactivity: jspb.Message.getFieldWithDefault(msg, 2, "")
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2418,23 +9272,23 @@ proto.wsman.DescribeWorkspaceResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DescribeWorkspaceResponse}
+ * @return {!proto.wsman.GetQueuePositionRequest}
  */
-proto.wsman.DescribeWorkspaceResponse.deserializeBinary = function(bytes) {
+proto.wsman.GetQueuePositionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DescribeWorkspaceResponse;
-  return proto.wsman.DescribeWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.GetQueuePositionRequest;
+  return proto.wsman.GetQueuePositionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.DescribeWorkspaceResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.GetQueuePositionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DescribeWorkspaceResponse}
+ * @return {!proto.wsman.GetQueuePositionRequest}
  */
-proto.wsman.DescribeWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.GetQueuePositionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2442,13 +9296,8 @@ proto.wsman.DescribeWorkspaceResponse.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.wsman.WorkspaceStatus;
-      reader.readMessage(value,proto.wsman.WorkspaceStatus.deserializeBinaryFromReader);
-      msg.setStatus(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setLastactivity(value);
+      msg.setId(value);
       break;
     default:
       reader.skipField();
@@ -2463,9 +9312,9 @@ proto.wsman.DescribeWorkspaceResponse.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.DescribeWorkspaceResponse.prototype.serializeBinary = function() {
+proto.wsman.GetQueuePositionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.DescribeWorkspaceResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.GetQueuePositionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2473,24 +9322,16 @@ proto.wsman.DescribeWorkspaceResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DescribeWorkspaceResponse} message
+ * @param {!proto.wsman.GetQueuePositionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DescribeWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.GetQueuePositionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.wsman.WorkspaceStatus.serializeBinaryToWriter
-    );
-  }
-  f = message.getLastactivity();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
-      2,
+      1,
       f
     );
   }
@@ -2498,57 +9339,20 @@ proto.wsman.DescribeWorkspaceResponse.serializeBinaryToWriter = function(message
 
 
 /**
- * optional WorkspaceStatus status = 1;
- * @return {?proto.wsman.WorkspaceStatus}
- */
-proto.wsman.DescribeWorkspaceResponse.prototype.getStatus = function() {
-  return /** @type{?proto.wsman.WorkspaceStatus} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceStatus, 1));
-};
-
-
-/**
- * @param {?proto.wsman.WorkspaceStatus|undefined} value
- * @return {!proto.wsman.DescribeWorkspaceResponse} returns this
-*/
-proto.wsman.DescribeWorkspaceResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.DescribeWorkspaceResponse} returns this
- */
-proto.wsman.DescribeWorkspaceResponse.prototype.clearStatus = function() {
-  return this.setStatus(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.wsman.DescribeWorkspaceResponse.prototype.hasStatus = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
-
-/**
- * optional string lastActivity = 2;
+ * optional string id = 1;
  * @return {string}
  */
-proto.wsman.DescribeWorkspaceResponse.prototype.getLastactivity = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.GetQueuePositionRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.DescribeWorkspaceResponse} returns this
+ * @return {!proto.wsman.GetQueuePositionRequest} returns this
  */
-proto.wsman.DescribeWorkspaceResponse.prototype.setLastactivity = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.GetQueuePositionRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -2568,8 +9372,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.SubscribeRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.SubscribeRequest.toObject(opt_includeInstance, this);
+proto.wsman.GetQueuePositionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetQueuePositionResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2578,13 +9382,15 @@ proto.wsman.SubscribeRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.SubscribeRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.GetQueuePositionResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SubscribeRequest.toObject = function(includeInstance, msg) {
+proto.wsman.GetQueuePositionResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    mustMatch: (f = msg.getMustMatch()) && proto.wsman.MetadataFilter.toObject(includeInstance, f)
+    queued: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    position: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    length: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -2598,23 +9404,23 @@ proto.wsman.SubscribeRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.SubscribeRequest}
+ * @return {!proto.wsman.GetQueuePositionResponse}
  */
-proto.wsman.SubscribeRequest.deserializeBinary = function(bytes) {
+proto.wsman.GetQueuePositionResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.SubscribeRequest;
-  return proto.wsman.SubscribeRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.GetQueuePositionResponse;
+  return proto.wsman.GetQueuePositionResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.SubscribeRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.GetQueuePositionResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.SubscribeRequest}
+ * @return {!proto.wsman.GetQueuePositionResponse}
  */
-proto.wsman.SubscribeRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.GetQueuePositionResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2622,9 +9428,16 @@ proto.wsman.SubscribeRequest.deserializeBinaryFromReader = function(msg, reader)
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.wsman.MetadataFilter;
-      reader.readMessage(value,proto.wsman.MetadataFilter.deserializeBinaryFromReader);
-      msg.setMustMatch(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setQueued(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPosition(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLength(value);
       break;
     default:
       reader.skipField();
@@ -2639,9 +9452,9 @@ proto.wsman.SubscribeRequest.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.SubscribeRequest.prototype.serializeBinary = function() {
+proto.wsman.GetQueuePositionResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.SubscribeRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.GetQueuePositionResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2649,57 +9462,87 @@ proto.wsman.SubscribeRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.SubscribeRequest} message
+ * @param {!proto.wsman.GetQueuePositionResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SubscribeRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.GetQueuePositionResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getMustMatch();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getQueued();
+  if (f) {
+    writer.writeBool(
       1,
-      f,
-      proto.wsman.MetadataFilter.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getPosition();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getLength();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
     );
   }
 };
 
 
 /**
- * optional MetadataFilter must_match = 1;
- * @return {?proto.wsman.MetadataFilter}
+ * optional bool queued = 1;
+ * @return {boolean}
  */
-proto.wsman.SubscribeRequest.prototype.getMustMatch = function() {
-  return /** @type{?proto.wsman.MetadataFilter} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.MetadataFilter, 1));
+proto.wsman.GetQueuePositionResponse.prototype.getQueued = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {?proto.wsman.MetadataFilter|undefined} value
- * @return {!proto.wsman.SubscribeRequest} returns this
-*/
-proto.wsman.SubscribeRequest.prototype.setMustMatch = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {boolean} value
+ * @return {!proto.wsman.GetQueuePositionResponse} returns this
+ */
+proto.wsman.GetQueuePositionResponse.prototype.setQueued = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.SubscribeRequest} returns this
+ * optional int32 position = 2;
+ * @return {number}
  */
-proto.wsman.SubscribeRequest.prototype.clearMustMatch = function() {
-  return this.setMustMatch(undefined);
+proto.wsman.GetQueuePositionResponse.prototype.getPosition = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.wsman.GetQueuePositionResponse} returns this
  */
-proto.wsman.SubscribeRequest.prototype.hasMustMatch = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.wsman.GetQueuePositionResponse.prototype.setPosition = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 length = 3;
+ * @return {number}
+ */
+proto.wsman.GetQueuePositionResponse.prototype.getLength = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.GetQueuePositionResponse} returns this
+ */
+proto.wsman.GetQueuePositionResponse.prototype.setLength = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
@@ -2719,8 +9562,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.SubscribeResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.SubscribeResponse.toObject(opt_includeInstance, this);
+proto.wsman.StreamWorkspaceLogsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.StreamWorkspaceLogsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -2729,14 +9572,15 @@ proto.wsman.SubscribeResponse.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.SubscribeResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.StreamWorkspaceLogsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SubscribeResponse.toObject = function(includeInstance, msg) {
+proto.wsman.StreamWorkspaceLogsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    status: (f = msg.getStatus()) && proto.wsman.WorkspaceStatus.toObject(includeInstance, f),
-    headerMap: (f = msg.getHeaderMap()) ? f.toObject(includeInstance, undefined) : []
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    follow: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    bookmark: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -2750,23 +9594,23 @@ proto.wsman.SubscribeResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.SubscribeResponse}
+ * @return {!proto.wsman.StreamWorkspaceLogsRequest}
  */
-proto.wsman.SubscribeResponse.deserializeBinary = function(bytes) {
+proto.wsman.StreamWorkspaceLogsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.SubscribeResponse;
-  return proto.wsman.SubscribeResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.StreamWorkspaceLogsRequest;
+  return proto.wsman.StreamWorkspaceLogsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.SubscribeResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.StreamWorkspaceLogsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.SubscribeResponse}
+ * @return {!proto.wsman.StreamWorkspaceLogsRequest}
  */
-proto.wsman.SubscribeResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.StreamWorkspaceLogsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2774,15 +9618,16 @@ proto.wsman.SubscribeResponse.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.wsman.WorkspaceStatus;
-      reader.readMessage(value,proto.wsman.WorkspaceStatus.deserializeBinaryFromReader);
-      msg.setStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setFollow(value);
       break;
     case 3:
-      var value = msg.getHeaderMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBookmark(value);
       break;
     default:
       reader.skipField();
@@ -2797,9 +9642,9 @@ proto.wsman.SubscribeResponse.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.SubscribeResponse.prototype.serializeBinary = function() {
+proto.wsman.StreamWorkspaceLogsRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.SubscribeResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.StreamWorkspaceLogsRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2807,84 +9652,88 @@ proto.wsman.SubscribeResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.SubscribeResponse} message
+ * @param {!proto.wsman.StreamWorkspaceLogsRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SubscribeResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.StreamWorkspaceLogsRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getStatus();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.wsman.WorkspaceStatus.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getHeaderMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  f = message.getFollow();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getBookmark();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
   }
 };
 
 
 /**
- * optional WorkspaceStatus status = 1;
- * @return {?proto.wsman.WorkspaceStatus}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.wsman.SubscribeResponse.prototype.getStatus = function() {
-  return /** @type{?proto.wsman.WorkspaceStatus} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceStatus, 1));
+proto.wsman.StreamWorkspaceLogsRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.wsman.WorkspaceStatus|undefined} value
- * @return {!proto.wsman.SubscribeResponse} returns this
-*/
-proto.wsman.SubscribeResponse.prototype.setStatus = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.wsman.StreamWorkspaceLogsRequest} returns this
+ */
+proto.wsman.StreamWorkspaceLogsRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.SubscribeResponse} returns this
- */
-proto.wsman.SubscribeResponse.prototype.clearStatus = function() {
-  return this.setStatus(undefined);
+ * optional bool follow = 2;
+ * @return {boolean}
+ */
+proto.wsman.StreamWorkspaceLogsRequest.prototype.getFollow = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {boolean} value
+ * @return {!proto.wsman.StreamWorkspaceLogsRequest} returns this
  */
-proto.wsman.SubscribeResponse.prototype.hasStatus = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.wsman.StreamWorkspaceLogsRequest.prototype.setFollow = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
 };
 
 
 /**
- * map<string, string> header = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,string>}
+ * optional string bookmark = 3;
+ * @return {string}
  */
-proto.wsman.SubscribeResponse.prototype.getHeaderMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,string>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      null));
+proto.wsman.StreamWorkspaceLogsRequest.prototype.getBookmark = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.wsman.SubscribeResponse} returns this
+ * @param {string} value
+ * @return {!proto.wsman.StreamWorkspaceLogsRequest} returns this
  */
-proto.wsman.SubscribeResponse.prototype.clearHeaderMap = function() {
-  this.getHeaderMap().clear();
-  return this;};
+proto.wsman.StreamWorkspaceLogsRequest.prototype.setBookmark = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
 
 
 
@@ -2903,8 +9752,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.MarkActiveRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.MarkActiveRequest.toObject(opt_includeInstance, this);
+proto.wsman.StreamWorkspaceLogsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.StreamWorkspaceLogsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2913,15 +9762,16 @@ proto.wsman.MarkActiveRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.MarkActiveRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.StreamWorkspaceLogsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.MarkActiveRequest.toObject = function(includeInstance, msg) {
+proto.wsman.StreamWorkspaceLogsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    closed: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    ignoreIfActive: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    taskId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    taskName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    data: msg.getData_asB64(),
+    bookmark: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -2935,23 +9785,23 @@ proto.wsman.MarkActiveRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.MarkActiveRequest}
+ * @return {!proto.wsman.StreamWorkspaceLogsResponse}
  */
-proto.wsman.MarkActiveRequest.deserializeBinary = function(bytes) {
+proto.wsman.StreamWorkspaceLogsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.MarkActiveRequest;
-  return proto.wsman.MarkActiveRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.StreamWorkspaceLogsResponse;
+  return proto.wsman.StreamWorkspaceLogsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.MarkActiveRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.StreamWorkspaceLogsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.MarkActiveRequest}
+ * @return {!proto.wsman.StreamWorkspaceLogsResponse}
  */
-proto.wsman.MarkActiveRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.StreamWorkspaceLogsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2960,15 +9810,19 @@ proto.wsman.MarkActiveRequest.deserializeBinaryFromReader = function(msg, reader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setTaskId(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setClosed(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTaskName(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIgnoreIfActive(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBookmark(value);
       break;
     default:
       reader.skipField();
@@ -2983,9 +9837,9 @@ proto.wsman.MarkActiveRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.MarkActiveRequest.prototype.serializeBinary = function() {
+proto.wsman.StreamWorkspaceLogsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.MarkActiveRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.StreamWorkspaceLogsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2993,87 +9847,136 @@ proto.wsman.MarkActiveRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.MarkActiveRequest} message
+ * @param {!proto.wsman.StreamWorkspaceLogsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.MarkActiveRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.StreamWorkspaceLogsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getTaskId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getClosed();
-  if (f) {
-    writer.writeBool(
+  f = message.getTaskName();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getIgnoreIfActive();
-  if (f) {
-    writer.writeBool(
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
       3,
       f
     );
   }
+  f = message.getBookmark();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string task_id = 1;
  * @return {string}
  */
-proto.wsman.MarkActiveRequest.prototype.getId = function() {
+proto.wsman.StreamWorkspaceLogsResponse.prototype.getTaskId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.MarkActiveRequest} returns this
+ * @return {!proto.wsman.StreamWorkspaceLogsResponse} returns this
  */
-proto.wsman.MarkActiveRequest.prototype.setId = function(value) {
+proto.wsman.StreamWorkspaceLogsResponse.prototype.setTaskId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool closed = 2;
- * @return {boolean}
+ * optional string task_name = 2;
+ * @return {string}
  */
-proto.wsman.MarkActiveRequest.prototype.getClosed = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.wsman.StreamWorkspaceLogsResponse.prototype.getTaskName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.wsman.MarkActiveRequest} returns this
+ * @param {string} value
+ * @return {!proto.wsman.StreamWorkspaceLogsResponse} returns this
  */
-proto.wsman.MarkActiveRequest.prototype.setClosed = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.wsman.StreamWorkspaceLogsResponse.prototype.setTaskName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional bool ignore_if_active = 3;
- * @return {boolean}
+ * optional bytes data = 3;
+ * @return {!(string|Uint8Array)}
  */
-proto.wsman.MarkActiveRequest.prototype.getIgnoreIfActive = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.wsman.StreamWorkspaceLogsResponse.prototype.getData = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.wsman.MarkActiveRequest} returns this
+ * optional bytes data = 3;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.wsman.MarkActiveRequest.prototype.setIgnoreIfActive = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.wsman.StreamWorkspaceLogsResponse.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
+};
+
+
+/**
+ * optional bytes data = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
+ */
+proto.wsman.StreamWorkspaceLogsResponse.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.wsman.StreamWorkspaceLogsResponse} returns this
+ */
+proto.wsman.StreamWorkspaceLogsResponse.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
+/**
+ * optional string bookmark = 4;
+ * @return {string}
+ */
+proto.wsman.StreamWorkspaceLogsResponse.prototype.getBookmark = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StreamWorkspaceLogsResponse} returns this
+ */
+proto.wsman.StreamWorkspaceLogsResponse.prototype.setBookmark = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
@@ -3093,8 +9996,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.MarkActiveResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.MarkActiveResponse.toObject(opt_includeInstance, this);
+proto.wsman.DrainNodeRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DrainNodeRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3103,13 +10006,14 @@ proto.wsman.MarkActiveResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.MarkActiveResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.DrainNodeRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.MarkActiveResponse.toObject = function(includeInstance, msg) {
+proto.wsman.DrainNodeRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    nodeName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    timeout: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3123,29 +10027,37 @@ proto.wsman.MarkActiveResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.MarkActiveResponse}
+ * @return {!proto.wsman.DrainNodeRequest}
  */
-proto.wsman.MarkActiveResponse.deserializeBinary = function(bytes) {
+proto.wsman.DrainNodeRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.MarkActiveResponse;
-  return proto.wsman.MarkActiveResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.DrainNodeRequest;
+  return proto.wsman.DrainNodeRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.MarkActiveResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.DrainNodeRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.MarkActiveResponse}
+ * @return {!proto.wsman.DrainNodeRequest}
  */
-proto.wsman.MarkActiveResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.DrainNodeRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNodeName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeout(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3159,9 +10071,9 @@ proto.wsman.MarkActiveResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.MarkActiveResponse.prototype.serializeBinary = function() {
+proto.wsman.DrainNodeRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.MarkActiveResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.DrainNodeRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3169,16 +10081,73 @@ proto.wsman.MarkActiveResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.MarkActiveResponse} message
+ * @param {!proto.wsman.DrainNodeRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.MarkActiveResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.DrainNodeRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getNodeName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string node_name = 1;
+ * @return {string}
+ */
+proto.wsman.DrainNodeRequest.prototype.getNodeName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.DrainNodeRequest} returns this
+ */
+proto.wsman.DrainNodeRequest.prototype.setNodeName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string timeout = 2;
+ * @return {string}
+ */
+proto.wsman.DrainNodeRequest.prototype.getTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.DrainNodeRequest} returns this
+ */
+proto.wsman.DrainNodeRequest.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.DrainNodeResponse.repeatedFields_ = [2,3];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3194,8 +10163,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.SetTimeoutRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.SetTimeoutRequest.toObject(opt_includeInstance, this);
+proto.wsman.DrainNodeResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.DrainNodeResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3204,15 +10173,16 @@ proto.wsman.SetTimeoutRequest.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.SetTimeoutRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.DrainNodeResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SetTimeoutRequest.toObject = function(includeInstance, msg) {
+proto.wsman.DrainNodeResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    duration: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    type: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    total: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    remainingList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    failedList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    done: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
   };
 
   if (includeInstance) {
@@ -3226,23 +10196,23 @@ proto.wsman.SetTimeoutRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.SetTimeoutRequest}
+ * @return {!proto.wsman.DrainNodeResponse}
  */
-proto.wsman.SetTimeoutRequest.deserializeBinary = function(bytes) {
+proto.wsman.DrainNodeResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.SetTimeoutRequest;
-  return proto.wsman.SetTimeoutRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.DrainNodeResponse;
+  return proto.wsman.DrainNodeResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.SetTimeoutRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.DrainNodeResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.SetTimeoutRequest}
+ * @return {!proto.wsman.DrainNodeResponse}
  */
-proto.wsman.SetTimeoutRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.DrainNodeResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3250,16 +10220,20 @@ proto.wsman.SetTimeoutRequest.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTotal(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setDuration(value);
+      msg.addRemaining(value);
       break;
     case 3:
-      var value = /** @type {!proto.wsman.TimeoutType} */ (reader.readEnum());
-      msg.setType(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addFailed(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDone(value);
       break;
     default:
       reader.skipField();
@@ -3274,9 +10248,9 @@ proto.wsman.SetTimeoutRequest.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.SetTimeoutRequest.prototype.serializeBinary = function() {
+proto.wsman.DrainNodeResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.SetTimeoutRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.DrainNodeResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3284,87 +10258,150 @@ proto.wsman.SetTimeoutRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.SetTimeoutRequest} message
+ * @param {!proto.wsman.DrainNodeResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SetTimeoutRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.DrainNodeResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTotal();
+  if (f !== 0) {
+    writer.writeInt32(
       1,
       f
     );
   }
-  f = message.getDuration();
+  f = message.getRemainingList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       2,
       f
     );
   }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getFailedList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       3,
       f
     );
   }
+  f = message.getDone();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 total = 1;
+ * @return {number}
+ */
+proto.wsman.DrainNodeResponse.prototype.getTotal = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.DrainNodeResponse} returns this
+ */
+proto.wsman.DrainNodeResponse.prototype.setTotal = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * repeated string remaining = 2;
+ * @return {!Array<string>}
+ */
+proto.wsman.DrainNodeResponse.prototype.getRemainingList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.DrainNodeResponse} returns this
  */
-proto.wsman.SetTimeoutRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.DrainNodeResponse.prototype.setRemainingList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.SetTimeoutRequest} returns this
+ * @param {number=} opt_index
+ * @return {!proto.wsman.DrainNodeResponse} returns this
  */
-proto.wsman.SetTimeoutRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.DrainNodeResponse.prototype.addRemaining = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * optional string duration = 2;
- * @return {string}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.DrainNodeResponse} returns this
  */
-proto.wsman.SetTimeoutRequest.prototype.getDuration = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.DrainNodeResponse.prototype.clearRemainingList = function() {
+  return this.setRemainingList([]);
+};
+
+
+/**
+ * repeated string failed = 3;
+ * @return {!Array<string>}
+ */
+proto.wsman.DrainNodeResponse.prototype.getFailedList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.DrainNodeResponse} returns this
+ */
+proto.wsman.DrainNodeResponse.prototype.setFailedList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.SetTimeoutRequest} returns this
+ * @param {number=} opt_index
+ * @return {!proto.wsman.DrainNodeResponse} returns this
  */
-proto.wsman.SetTimeoutRequest.prototype.setDuration = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.DrainNodeResponse.prototype.addFailed = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * optional TimeoutType type = 3;
- * @return {!proto.wsman.TimeoutType}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.DrainNodeResponse} returns this
  */
-proto.wsman.SetTimeoutRequest.prototype.getType = function() {
-  return /** @type {!proto.wsman.TimeoutType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.wsman.DrainNodeResponse.prototype.clearFailedList = function() {
+  return this.setFailedList([]);
 };
 
 
 /**
- * @param {!proto.wsman.TimeoutType} value
- * @return {!proto.wsman.SetTimeoutRequest} returns this
+ * optional bool done = 4;
+ * @return {boolean}
  */
-proto.wsman.SetTimeoutRequest.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.wsman.DrainNodeResponse.prototype.getDone = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.DrainNodeResponse} returns this
+ */
+proto.wsman.DrainNodeResponse.prototype.setDone = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
@@ -3384,8 +10421,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.SetTimeoutResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.SetTimeoutResponse.toObject(opt_includeInstance, this);
+proto.wsman.GetWorkspaceResourceUsageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetWorkspaceResourceUsageRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3394,13 +10431,14 @@ proto.wsman.SetTimeoutResponse.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.SetTimeoutResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.GetWorkspaceResourceUsageRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SetTimeoutResponse.toObject = function(includeInstance, msg) {
+proto.wsman.GetWorkspaceResourceUsageRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    interval: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -3414,29 +10452,37 @@ proto.wsman.SetTimeoutResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.SetTimeoutResponse}
+ * @return {!proto.wsman.GetWorkspaceResourceUsageRequest}
  */
-proto.wsman.SetTimeoutResponse.deserializeBinary = function(bytes) {
+proto.wsman.GetWorkspaceResourceUsageRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.SetTimeoutResponse;
-  return proto.wsman.SetTimeoutResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.GetWorkspaceResourceUsageRequest;
+  return proto.wsman.GetWorkspaceResourceUsageRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.SetTimeoutResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.GetWorkspaceResourceUsageRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.SetTimeoutResponse}
+ * @return {!proto.wsman.GetWorkspaceResourceUsageRequest}
  */
-proto.wsman.SetTimeoutResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.GetWorkspaceResourceUsageRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInterval(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3450,9 +10496,9 @@ proto.wsman.SetTimeoutResponse.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.SetTimeoutResponse.prototype.serializeBinary = function() {
+proto.wsman.GetWorkspaceResourceUsageRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.SetTimeoutResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.GetWorkspaceResourceUsageRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3460,12 +10506,62 @@ proto.wsman.SetTimeoutResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.SetTimeoutResponse} message
+ * @param {!proto.wsman.GetWorkspaceResourceUsageRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.SetTimeoutResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.GetWorkspaceResourceUsageRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getInterval();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.GetWorkspaceResourceUsageRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.GetWorkspaceResourceUsageRequest} returns this
+ */
+proto.wsman.GetWorkspaceResourceUsageRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string interval = 2;
+ * @return {string}
+ */
+proto.wsman.GetWorkspaceResourceUsageRequest.prototype.getInterval = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.GetWorkspaceResourceUsageRequest} returns this
+ */
+proto.wsman.GetWorkspaceResourceUsageRequest.prototype.setInterval = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -3485,8 +10581,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.ControlPortRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ControlPortRequest.toObject(opt_includeInstance, this);
+proto.wsman.GetWorkspaceResourceUsageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetWorkspaceResourceUsageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3495,15 +10591,13 @@ proto.wsman.ControlPortRequest.prototype.toObject = function(opt_includeInstance
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.ControlPortRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.GetWorkspaceResourceUsageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlPortRequest.toObject = function(includeInstance, msg) {
+proto.wsman.GetWorkspaceResourceUsageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    expose: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
-    spec: (f = msg.getSpec()) && proto.wsman.PortSpec.toObject(includeInstance, f)
+    usage: (f = msg.getUsage()) && proto.wsman.WorkspaceResourceUsage.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3517,23 +10611,23 @@ proto.wsman.ControlPortRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ControlPortRequest}
+ * @return {!proto.wsman.GetWorkspaceResourceUsageResponse}
  */
-proto.wsman.ControlPortRequest.deserializeBinary = function(bytes) {
+proto.wsman.GetWorkspaceResourceUsageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ControlPortRequest;
-  return proto.wsman.ControlPortRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.GetWorkspaceResourceUsageResponse;
+  return proto.wsman.GetWorkspaceResourceUsageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.ControlPortRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.GetWorkspaceResourceUsageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ControlPortRequest}
+ * @return {!proto.wsman.GetWorkspaceResourceUsageResponse}
  */
-proto.wsman.ControlPortRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.GetWorkspaceResourceUsageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3541,17 +10635,9 @@ proto.wsman.ControlPortRequest.deserializeBinaryFromReader = function(msg, reade
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setExpose(value);
-      break;
-    case 3:
-      var value = new proto.wsman.PortSpec;
-      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
-      msg.setSpec(value);
+      var value = new proto.wsman.WorkspaceResourceUsage;
+      reader.readMessage(value,proto.wsman.WorkspaceResourceUsage.deserializeBinaryFromReader);
+      msg.setUsage(value);
       break;
     default:
       reader.skipField();
@@ -3566,9 +10652,9 @@ proto.wsman.ControlPortRequest.deserializeBinaryFromReader = function(msg, reade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.ControlPortRequest.prototype.serializeBinary = function() {
+proto.wsman.GetWorkspaceResourceUsageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.ControlPortRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.GetWorkspaceResourceUsageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3576,98 +10662,48 @@ proto.wsman.ControlPortRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ControlPortRequest} message
+ * @param {!proto.wsman.GetWorkspaceResourceUsageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlPortRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.GetWorkspaceResourceUsageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getExpose();
-  if (f) {
-    writer.writeBool(
-      2,
-      f
-    );
-  }
-  f = message.getSpec();
+  f = message.getUsage();
   if (f != null) {
     writer.writeMessage(
-      3,
+      1,
       f,
-      proto.wsman.PortSpec.serializeBinaryToWriter
+      proto.wsman.WorkspaceResourceUsage.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
- */
-proto.wsman.ControlPortRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.wsman.ControlPortRequest} returns this
- */
-proto.wsman.ControlPortRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional bool expose = 2;
- * @return {boolean}
- */
-proto.wsman.ControlPortRequest.prototype.getExpose = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.wsman.ControlPortRequest} returns this
- */
-proto.wsman.ControlPortRequest.prototype.setExpose = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
-};
-
-
-/**
- * optional PortSpec spec = 3;
- * @return {?proto.wsman.PortSpec}
+ * optional WorkspaceResourceUsage usage = 1;
+ * @return {?proto.wsman.WorkspaceResourceUsage}
  */
-proto.wsman.ControlPortRequest.prototype.getSpec = function() {
-  return /** @type{?proto.wsman.PortSpec} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.PortSpec, 3));
+proto.wsman.GetWorkspaceResourceUsageResponse.prototype.getUsage = function() {
+  return /** @type{?proto.wsman.WorkspaceResourceUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceResourceUsage, 1));
 };
 
 
 /**
- * @param {?proto.wsman.PortSpec|undefined} value
- * @return {!proto.wsman.ControlPortRequest} returns this
+ * @param {?proto.wsman.WorkspaceResourceUsage|undefined} value
+ * @return {!proto.wsman.GetWorkspaceResourceUsageResponse} returns this
 */
-proto.wsman.ControlPortRequest.prototype.setSpec = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+proto.wsman.GetWorkspaceResourceUsageResponse.prototype.setUsage = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.wsman.ControlPortRequest} returns this
+ * @return {!proto.wsman.GetWorkspaceResourceUsageResponse} returns this
  */
-proto.wsman.ControlPortRequest.prototype.clearSpec = function() {
-  return this.setSpec(undefined);
+proto.wsman.GetWorkspaceResourceUsageResponse.prototype.clearUsage = function() {
+  return this.setUsage(undefined);
 };
 
 
@@ -3675,8 +10711,8 @@ proto.wsman.ControlPortRequest.prototype.clearSpec = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.ControlPortRequest.prototype.hasSpec = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.wsman.GetWorkspaceResourceUsageResponse.prototype.hasUsage = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -3696,8 +10732,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.ControlPortResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ControlPortResponse.toObject(opt_includeInstance, this);
+proto.wsman.GetWorkspaceDiskUsageRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetWorkspaceDiskUsageRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3706,13 +10742,13 @@ proto.wsman.ControlPortResponse.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.ControlPortResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.GetWorkspaceDiskUsageRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlPortResponse.toObject = function(includeInstance, msg) {
+proto.wsman.GetWorkspaceDiskUsageRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -3726,29 +10762,33 @@ proto.wsman.ControlPortResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ControlPortResponse}
+ * @return {!proto.wsman.GetWorkspaceDiskUsageRequest}
  */
-proto.wsman.ControlPortResponse.deserializeBinary = function(bytes) {
+proto.wsman.GetWorkspaceDiskUsageRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ControlPortResponse;
-  return proto.wsman.ControlPortResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.GetWorkspaceDiskUsageRequest;
+  return proto.wsman.GetWorkspaceDiskUsageRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.ControlPortResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.GetWorkspaceDiskUsageRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ControlPortResponse}
+ * @return {!proto.wsman.GetWorkspaceDiskUsageRequest}
  */
-proto.wsman.ControlPortResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.GetWorkspaceDiskUsageRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3762,9 +10802,9 @@ proto.wsman.ControlPortResponse.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.ControlPortResponse.prototype.serializeBinary = function() {
+proto.wsman.GetWorkspaceDiskUsageRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.ControlPortResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.GetWorkspaceDiskUsageRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3772,12 +10812,37 @@ proto.wsman.ControlPortResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ControlPortResponse} message
+ * @param {!proto.wsman.GetWorkspaceDiskUsageRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlPortResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.wsman.GetWorkspaceDiskUsageRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.wsman.GetWorkspaceDiskUsageRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.GetWorkspaceDiskUsageRequest} returns this
+ */
+proto.wsman.GetWorkspaceDiskUsageRequest.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -3797,8 +10862,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.TakeSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.TakeSnapshotRequest.toObject(opt_includeInstance, this);
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GetWorkspaceDiskUsageResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3807,14 +10872,16 @@ proto.wsman.TakeSnapshotRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.TakeSnapshotRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.GetWorkspaceDiskUsageResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.TakeSnapshotRequest.toObject = function(includeInstance, msg) {
+proto.wsman.GetWorkspaceDiskUsageResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    returnImmediately: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+    workspace: (f = msg.getWorkspace()) && proto.wsman.ResourceUsage.toObject(includeInstance, f),
+    tmp: (f = msg.getTmp()) && proto.wsman.ResourceUsage.toObject(includeInstance, f),
+    scratch: (f = msg.getScratch()) && proto.wsman.ResourceUsage.toObject(includeInstance, f),
+    collectedAt: (f = msg.getCollectedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3828,23 +10895,23 @@ proto.wsman.TakeSnapshotRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.TakeSnapshotRequest}
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse}
  */
-proto.wsman.TakeSnapshotRequest.deserializeBinary = function(bytes) {
+proto.wsman.GetWorkspaceDiskUsageResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.TakeSnapshotRequest;
-  return proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.GetWorkspaceDiskUsageResponse;
+  return proto.wsman.GetWorkspaceDiskUsageResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.TakeSnapshotRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.GetWorkspaceDiskUsageResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.TakeSnapshotRequest}
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse}
  */
-proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.GetWorkspaceDiskUsageResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3852,12 +10919,24 @@ proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = new proto.wsman.ResourceUsage;
+      reader.readMessage(value,proto.wsman.ResourceUsage.deserializeBinaryFromReader);
+      msg.setWorkspace(value);
       break;
     case 2:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setReturnImmediately(value);
+      var value = new proto.wsman.ResourceUsage;
+      reader.readMessage(value,proto.wsman.ResourceUsage.deserializeBinaryFromReader);
+      msg.setTmp(value);
+      break;
+    case 3:
+      var value = new proto.wsman.ResourceUsage;
+      reader.readMessage(value,proto.wsman.ResourceUsage.deserializeBinaryFromReader);
+      msg.setScratch(value);
+      break;
+    case 4:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCollectedAt(value);
       break;
     default:
       reader.skipField();
@@ -3872,9 +10951,9 @@ proto.wsman.TakeSnapshotRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.TakeSnapshotRequest.prototype.serializeBinary = function() {
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.TakeSnapshotRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.GetWorkspaceDiskUsageResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3882,62 +10961,192 @@ proto.wsman.TakeSnapshotRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.TakeSnapshotRequest} message
+ * @param {!proto.wsman.GetWorkspaceDiskUsageResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.TakeSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.GetWorkspaceDiskUsageResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getWorkspace();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.wsman.ResourceUsage.serializeBinaryToWriter
     );
   }
-  f = message.getReturnImmediately();
-  if (f) {
-    writer.writeBool(
+  f = message.getTmp();
+  if (f != null) {
+    writer.writeMessage(
       2,
-      f
+      f,
+      proto.wsman.ResourceUsage.serializeBinaryToWriter
+    );
+  }
+  f = message.getScratch();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.ResourceUsage.serializeBinaryToWriter
+    );
+  }
+  f = message.getCollectedAt();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * optional ResourceUsage workspace = 1;
+ * @return {?proto.wsman.ResourceUsage}
  */
-proto.wsman.TakeSnapshotRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.getWorkspace = function() {
+  return /** @type{?proto.wsman.ResourceUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.ResourceUsage, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.TakeSnapshotRequest} returns this
+ * @param {?proto.wsman.ResourceUsage|undefined} value
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse} returns this
+*/
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.setWorkspace = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse} returns this
  */
-proto.wsman.TakeSnapshotRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.clearWorkspace = function() {
+  return this.setWorkspace(undefined);
 };
 
 
 /**
- * optional bool return_immediately = 2;
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.TakeSnapshotRequest.prototype.getReturnImmediately = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.hasWorkspace = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.wsman.TakeSnapshotRequest} returns this
+ * optional ResourceUsage tmp = 2;
+ * @return {?proto.wsman.ResourceUsage}
  */
-proto.wsman.TakeSnapshotRequest.prototype.setReturnImmediately = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 2, value);
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.getTmp = function() {
+  return /** @type{?proto.wsman.ResourceUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.ResourceUsage, 2));
+};
+
+
+/**
+ * @param {?proto.wsman.ResourceUsage|undefined} value
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse} returns this
+*/
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.setTmp = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse} returns this
+ */
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.clearTmp = function() {
+  return this.setTmp(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.hasTmp = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional ResourceUsage scratch = 3;
+ * @return {?proto.wsman.ResourceUsage}
+ */
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.getScratch = function() {
+  return /** @type{?proto.wsman.ResourceUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.ResourceUsage, 3));
+};
+
+
+/**
+ * @param {?proto.wsman.ResourceUsage|undefined} value
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse} returns this
+*/
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.setScratch = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse} returns this
+ */
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.clearScratch = function() {
+  return this.setScratch(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.hasScratch = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp collected_at = 4;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.getCollectedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 4));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse} returns this
+*/
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.setCollectedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.GetWorkspaceDiskUsageResponse} returns this
+ */
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.clearCollectedAt = function() {
+  return this.setCollectedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.GetWorkspaceDiskUsageResponse.prototype.hasCollectedAt = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -3957,8 +11166,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.TakeSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.TakeSnapshotResponse.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceResourceUsage.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceResourceUsage.toObject(opt_includeInstance, this);
 };
 
 
@@ -3967,13 +11176,18 @@ proto.wsman.TakeSnapshotResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.TakeSnapshotResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceResourceUsage} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.TakeSnapshotResponse.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceResourceUsage.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, "")
+    cpu: (f = msg.getCpu()) && proto.wsman.ResourceUsage.toObject(includeInstance, f),
+    memory: (f = msg.getMemory()) && proto.wsman.ResourceUsage.toObject(includeInstance, f),
+    disk: (f = msg.getDisk()) && proto.wsman.ResourceUsage.toObject(includeInstance, f),
+    networkRxBytes: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    networkTxBytes: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    collectedAt: (f = msg.getCollectedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3987,23 +11201,23 @@ proto.wsman.TakeSnapshotResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.TakeSnapshotResponse}
+ * @return {!proto.wsman.WorkspaceResourceUsage}
  */
-proto.wsman.TakeSnapshotResponse.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceResourceUsage.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.TakeSnapshotResponse;
-  return proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceResourceUsage;
+  return proto.wsman.WorkspaceResourceUsage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.TakeSnapshotResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceResourceUsage} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.TakeSnapshotResponse}
+ * @return {!proto.wsman.WorkspaceResourceUsage}
  */
-proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceResourceUsage.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4011,8 +11225,32 @@ proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      var value = new proto.wsman.ResourceUsage;
+      reader.readMessage(value,proto.wsman.ResourceUsage.deserializeBinaryFromReader);
+      msg.setCpu(value);
+      break;
+    case 2:
+      var value = new proto.wsman.ResourceUsage;
+      reader.readMessage(value,proto.wsman.ResourceUsage.deserializeBinaryFromReader);
+      msg.setMemory(value);
+      break;
+    case 3:
+      var value = new proto.wsman.ResourceUsage;
+      reader.readMessage(value,proto.wsman.ResourceUsage.deserializeBinaryFromReader);
+      msg.setDisk(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNetworkRxBytes(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setNetworkTxBytes(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCollectedAt(value);
       break;
     default:
       reader.skipField();
@@ -4027,9 +11265,9 @@ proto.wsman.TakeSnapshotResponse.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.TakeSnapshotResponse.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceResourceUsage.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.TakeSnapshotResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceResourceUsage.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4037,197 +11275,242 @@ proto.wsman.TakeSnapshotResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.TakeSnapshotResponse} message
+ * @param {!proto.wsman.WorkspaceResourceUsage} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.TakeSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceResourceUsage.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCpu();
+  if (f != null) {
+    writer.writeMessage(
       1,
+      f,
+      proto.wsman.ResourceUsage.serializeBinaryToWriter
+    );
+  }
+  f = message.getMemory();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.wsman.ResourceUsage.serializeBinaryToWriter
+    );
+  }
+  f = message.getDisk();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.wsman.ResourceUsage.serializeBinaryToWriter
+    );
+  }
+  f = message.getNetworkRxBytes();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+  f = message.getNetworkTxBytes();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
       f
     );
   }
+  f = message.getCollectedAt();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional ResourceUsage cpu = 1;
+ * @return {?proto.wsman.ResourceUsage}
+ */
+proto.wsman.WorkspaceResourceUsage.prototype.getCpu = function() {
+  return /** @type{?proto.wsman.ResourceUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.ResourceUsage, 1));
+};
+
+
+/**
+ * @param {?proto.wsman.ResourceUsage|undefined} value
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
+*/
+proto.wsman.WorkspaceResourceUsage.prototype.setCpu = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
+ */
+proto.wsman.WorkspaceResourceUsage.prototype.clearCpu = function() {
+  return this.setCpu(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceResourceUsage.prototype.hasCpu = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional ResourceUsage memory = 2;
+ * @return {?proto.wsman.ResourceUsage}
+ */
+proto.wsman.WorkspaceResourceUsage.prototype.getMemory = function() {
+  return /** @type{?proto.wsman.ResourceUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.ResourceUsage, 2));
+};
+
+
+/**
+ * @param {?proto.wsman.ResourceUsage|undefined} value
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
+*/
+proto.wsman.WorkspaceResourceUsage.prototype.setMemory = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * optional string url = 1;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
  */
-proto.wsman.TakeSnapshotResponse.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceResourceUsage.prototype.clearMemory = function() {
+  return this.setMemory(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.TakeSnapshotResponse} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.TakeSnapshotResponse.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceResourceUsage.prototype.hasMemory = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
+/**
+ * optional ResourceUsage disk = 3;
+ * @return {?proto.wsman.ResourceUsage}
+ */
+proto.wsman.WorkspaceResourceUsage.prototype.getDisk = function() {
+  return /** @type{?proto.wsman.ResourceUsage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.ResourceUsage, 3));
+};
+
 
+/**
+ * @param {?proto.wsman.ResourceUsage|undefined} value
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
+*/
+proto.wsman.WorkspaceResourceUsage.prototype.setDisk = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
  */
-proto.wsman.ControlAdmissionRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ControlAdmissionRequest.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceResourceUsage.prototype.clearDisk = function() {
+  return this.setDisk(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.ControlAdmissionRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.ControlAdmissionRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    level: jspb.Message.getFieldWithDefault(msg, 2, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.wsman.WorkspaceResourceUsage.prototype.hasDisk = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ControlAdmissionRequest}
+ * optional int64 network_rx_bytes = 4;
+ * @return {number}
  */
-proto.wsman.ControlAdmissionRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ControlAdmissionRequest;
-  return proto.wsman.ControlAdmissionRequest.deserializeBinaryFromReader(msg, reader);
+proto.wsman.WorkspaceResourceUsage.prototype.getNetworkRxBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.ControlAdmissionRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ControlAdmissionRequest}
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
  */
-proto.wsman.ControlAdmissionRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 2:
-      var value = /** @type {!proto.wsman.AdmissionLevel} */ (reader.readEnum());
-      msg.setLevel(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.wsman.WorkspaceResourceUsage.prototype.setNetworkRxBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional int64 network_tx_bytes = 5;
+ * @return {number}
  */
-proto.wsman.ControlAdmissionRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.ControlAdmissionRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.WorkspaceResourceUsage.prototype.getNetworkTxBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ControlAdmissionRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
  */
-proto.wsman.ControlAdmissionRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getLevel();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      2,
-      f
-    );
-  }
+proto.wsman.WorkspaceResourceUsage.prototype.setNetworkTxBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * optional google.protobuf.Timestamp collected_at = 6;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.wsman.ControlAdmissionRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceResourceUsage.prototype.getCollectedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.ControlAdmissionRequest} returns this
- */
-proto.wsman.ControlAdmissionRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
+*/
+proto.wsman.WorkspaceResourceUsage.prototype.setCollectedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
 };
 
 
 /**
- * optional AdmissionLevel level = 2;
- * @return {!proto.wsman.AdmissionLevel}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceResourceUsage} returns this
  */
-proto.wsman.ControlAdmissionRequest.prototype.getLevel = function() {
-  return /** @type {!proto.wsman.AdmissionLevel} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.wsman.WorkspaceResourceUsage.prototype.clearCollectedAt = function() {
+  return this.setCollectedAt(undefined);
 };
 
 
 /**
- * @param {!proto.wsman.AdmissionLevel} value
- * @return {!proto.wsman.ControlAdmissionRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.ControlAdmissionRequest.prototype.setLevel = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+proto.wsman.WorkspaceResourceUsage.prototype.hasCollectedAt = function() {
+  return jspb.Message.getField(this, 6) != null;
 };
 
 
@@ -4247,8 +11530,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.ControlAdmissionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.ControlAdmissionResponse.toObject(opt_includeInstance, this);
+proto.wsman.ResourceUsage.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.ResourceUsage.toObject(opt_includeInstance, this);
 };
 
 
@@ -4257,13 +11540,14 @@ proto.wsman.ControlAdmissionResponse.prototype.toObject = function(opt_includeIn
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.ControlAdmissionResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.ResourceUsage} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlAdmissionResponse.toObject = function(includeInstance, msg) {
+proto.wsman.ResourceUsage.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    used: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    limit: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -4277,29 +11561,37 @@ proto.wsman.ControlAdmissionResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.ControlAdmissionResponse}
+ * @return {!proto.wsman.ResourceUsage}
  */
-proto.wsman.ControlAdmissionResponse.deserializeBinary = function(bytes) {
+proto.wsman.ResourceUsage.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.ControlAdmissionResponse;
-  return proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.ResourceUsage;
+  return proto.wsman.ResourceUsage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.ControlAdmissionResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.ResourceUsage} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.ControlAdmissionResponse}
+ * @return {!proto.wsman.ResourceUsage}
  */
-proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.ResourceUsage.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUsed(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLimit(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4313,9 +11605,9 @@ proto.wsman.ControlAdmissionResponse.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.ControlAdmissionResponse.prototype.serializeBinary = function() {
+proto.wsman.ResourceUsage.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.ControlAdmissionResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.ResourceUsage.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4323,12 +11615,62 @@ proto.wsman.ControlAdmissionResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.ControlAdmissionResponse} message
+ * @param {!proto.wsman.ResourceUsage} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.ControlAdmissionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.ResourceUsage.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getUsed();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 used = 1;
+ * @return {number}
+ */
+proto.wsman.ResourceUsage.prototype.getUsed = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.ResourceUsage} returns this
+ */
+proto.wsman.ResourceUsage.prototype.setUsed = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 limit = 2;
+ * @return {number}
+ */
+proto.wsman.ResourceUsage.prototype.getLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.ResourceUsage} returns this
+ */
+proto.wsman.ResourceUsage.prototype.setLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
@@ -4348,8 +11690,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DeleteVolumeSnapshotRequest.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceStatus.toObject(opt_includeInstance, this);
 };
 
 
@@ -4358,16 +11700,23 @@ proto.wsman.DeleteVolumeSnapshotRequest.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.DeleteVolumeSnapshotRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceStatus} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DeleteVolumeSnapshotRequest.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    volumeHandle: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    softDelete: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    wsType: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    statusVersion: jspb.Message.getFieldWithDefault(msg, 10, 0),
+    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
+    spec: (f = msg.getSpec()) && proto.wsman.WorkspaceSpec.toObject(includeInstance, f),
+    phase: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    conditions: (f = msg.getConditions()) && proto.wsman.WorkspaceConditions.toObject(includeInstance, f),
+    message: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    repo: (f = msg.getRepo()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f),
+    runtime: (f = msg.getRuntime()) && proto.wsman.WorkspaceRuntimeInfo.toObject(includeInstance, f),
+    auth: (f = msg.getAuth()) && proto.wsman.WorkspaceAuthentication.toObject(includeInstance, f),
+    queue: (f = msg.getQueue()) && proto.wsman.WorkspaceQueueStatus.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4381,23 +11730,23 @@ proto.wsman.DeleteVolumeSnapshotRequest.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest}
+ * @return {!proto.wsman.WorkspaceStatus}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceStatus.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DeleteVolumeSnapshotRequest;
-  return proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceStatus;
+  return proto.wsman.WorkspaceStatus.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.DeleteVolumeSnapshotRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceStatus} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest}
+ * @return {!proto.wsman.WorkspaceStatus}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4408,17 +11757,52 @@ proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader = function(m
       var value = /** @type {string} */ (reader.readString());
       msg.setId(value);
       break;
+    case 10:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setStatusVersion(value);
+      break;
     case 2:
+      var value = new proto.wsman.WorkspaceMetadata;
+      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    case 3:
+      var value = new proto.wsman.WorkspaceSpec;
+      reader.readMessage(value,proto.wsman.WorkspaceSpec.deserializeBinaryFromReader);
+      msg.setSpec(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.wsman.WorkspacePhase} */ (reader.readEnum());
+      msg.setPhase(value);
+      break;
+    case 5:
+      var value = new proto.wsman.WorkspaceConditions;
+      reader.readMessage(value,proto.wsman.WorkspaceConditions.deserializeBinaryFromReader);
+      msg.setConditions(value);
+      break;
+    case 6:
       var value = /** @type {string} */ (reader.readString());
-      msg.setVolumeHandle(value);
+      msg.setMessage(value);
       break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setSoftDelete(value);
+    case 7:
+      var value = new content$service$api_initializer_pb.GitStatus;
+      reader.readMessage(value,content$service$api_initializer_pb.GitStatus.deserializeBinaryFromReader);
+      msg.setRepo(value);
       break;
-    case 4:
-      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
-      msg.setWsType(value);
+    case 8:
+      var value = new proto.wsman.WorkspaceRuntimeInfo;
+      reader.readMessage(value,proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader);
+      msg.setRuntime(value);
+      break;
+    case 9:
+      var value = new proto.wsman.WorkspaceAuthentication;
+      reader.readMessage(value,proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader);
+      msg.setAuth(value);
+      break;
+    case 11:
+      var value = new proto.wsman.WorkspaceQueueStatus;
+      reader.readMessage(value,proto.wsman.WorkspaceQueueStatus.deserializeBinaryFromReader);
+      msg.setQueue(value);
       break;
     default:
       reader.skipField();
@@ -4433,9 +11817,9 @@ proto.wsman.DeleteVolumeSnapshotRequest.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceStatus.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceStatus.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4443,11 +11827,11 @@ proto.wsman.DeleteVolumeSnapshotRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DeleteVolumeSnapshotRequest} message
+ * @param {!proto.wsman.WorkspaceStatus} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceStatus.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -4456,27 +11840,83 @@ proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter = function(messa
       f
     );
   }
-  f = message.getVolumeHandle();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
+  f = message.getStatusVersion();
+  if (f !== 0) {
+    writer.writeUint64(
+      10,
       f
     );
   }
-  f = message.getSoftDelete();
-  if (f) {
-    writer.writeBool(
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.wsman.WorkspaceMetadata.serializeBinaryToWriter
+    );
+  }
+  f = message.getSpec();
+  if (f != null) {
+    writer.writeMessage(
       3,
-      f
+      f,
+      proto.wsman.WorkspaceSpec.serializeBinaryToWriter
     );
   }
-  f = message.getWsType();
+  f = message.getPhase();
   if (f !== 0.0) {
     writer.writeEnum(
       4,
       f
     );
   }
+  f = message.getConditions();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.wsman.WorkspaceConditions.serializeBinaryToWriter
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getRepo();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      content$service$api_initializer_pb.GitStatus.serializeBinaryToWriter
+    );
+  }
+  f = message.getRuntime();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter
+    );
+  }
+  f = message.getAuth();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter
+    );
+  }
+  f = message.getQueue();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.wsman.WorkspaceQueueStatus.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -4484,471 +11924,333 @@ proto.wsman.DeleteVolumeSnapshotRequest.serializeBinaryToWriter = function(messa
  * optional string id = 1;
  * @return {string}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.getId = function() {
+proto.wsman.WorkspaceStatus.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.setId = function(value) {
+proto.wsman.WorkspaceStatus.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string volume_handle = 2;
- * @return {string}
+ * optional uint64 status_version = 10;
+ * @return {number}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.getVolumeHandle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.WorkspaceStatus.prototype.getStatusVersion = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.setVolumeHandle = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.WorkspaceStatus.prototype.setStatusVersion = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
 };
 
 
 /**
- * optional bool soft_delete = 3;
- * @return {boolean}
+ * optional WorkspaceMetadata metadata = 2;
+ * @return {?proto.wsman.WorkspaceMetadata}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.getSoftDelete = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.wsman.WorkspaceStatus.prototype.getMetadata = function() {
+  return /** @type{?proto.wsman.WorkspaceMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata, 2));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
- */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.setSoftDelete = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+ * @param {?proto.wsman.WorkspaceMetadata|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * optional WorkspaceType ws_type = 4;
- * @return {!proto.wsman.WorkspaceType}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.getWsType = function() {
-  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.wsman.WorkspaceStatus.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceType} value
- * @return {!proto.wsman.DeleteVolumeSnapshotRequest} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.DeleteVolumeSnapshotRequest.prototype.setWsType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.wsman.WorkspaceStatus.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
+/**
+ * optional WorkspaceSpec spec = 3;
+ * @return {?proto.wsman.WorkspaceSpec}
+ */
+proto.wsman.WorkspaceStatus.prototype.getSpec = function() {
+  return /** @type{?proto.wsman.WorkspaceSpec} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceSpec, 3));
+};
 
 
+/**
+ * @param {?proto.wsman.WorkspaceSpec|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setSpec = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.DeleteVolumeSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.DeleteVolumeSnapshotResponse.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceStatus.prototype.clearSpec = function() {
+  return this.setSpec(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.DeleteVolumeSnapshotResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.DeleteVolumeSnapshotResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    wasDeleted: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
-  };
+proto.wsman.WorkspaceStatus.prototype.hasSpec = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * optional WorkspacePhase phase = 4;
+ * @return {!proto.wsman.WorkspacePhase}
+ */
+proto.wsman.WorkspaceStatus.prototype.getPhase = function() {
+  return /** @type {!proto.wsman.WorkspacePhase} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.DeleteVolumeSnapshotResponse}
+ * @param {!proto.wsman.WorkspacePhase} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.DeleteVolumeSnapshotResponse;
-  return proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader(msg, reader);
+proto.wsman.WorkspaceStatus.prototype.setPhase = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.DeleteVolumeSnapshotResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.DeleteVolumeSnapshotResponse}
+ * optional WorkspaceConditions conditions = 5;
+ * @return {?proto.wsman.WorkspaceConditions}
  */
-proto.wsman.DeleteVolumeSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setWasDeleted(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.wsman.WorkspaceStatus.prototype.getConditions = function() {
+  return /** @type{?proto.wsman.WorkspaceConditions} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceConditions, 5));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
- */
-proto.wsman.DeleteVolumeSnapshotResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.DeleteVolumeSnapshotResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+ * @param {?proto.wsman.WorkspaceConditions|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setConditions = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.DeleteVolumeSnapshotResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.DeleteVolumeSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getWasDeleted();
-  if (f) {
-    writer.writeBool(
-      1,
-      f
-    );
-  }
+proto.wsman.WorkspaceStatus.prototype.clearConditions = function() {
+  return this.setConditions(undefined);
 };
 
 
 /**
- * optional bool was_deleted = 1;
+ * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.DeleteVolumeSnapshotResponse.prototype.getWasDeleted = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.wsman.WorkspaceStatus.prototype.hasConditions = function() {
+  return jspb.Message.getField(this, 5) != null;
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.wsman.DeleteVolumeSnapshotResponse} returns this
+ * optional string message = 6;
+ * @return {string}
  */
-proto.wsman.DeleteVolumeSnapshotResponse.prototype.setWasDeleted = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.wsman.WorkspaceStatus.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.BackupWorkspaceRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.BackupWorkspaceRequest.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceStatus.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.BackupWorkspaceRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional contentservice.GitStatus repo = 7;
+ * @return {?proto.contentservice.GitStatus}
  */
-proto.wsman.BackupWorkspaceRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.wsman.WorkspaceStatus.prototype.getRepo = function() {
+  return /** @type{?proto.contentservice.GitStatus} */ (
+    jspb.Message.getWrapperField(this, content$service$api_initializer_pb.GitStatus, 7));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.BackupWorkspaceRequest}
- */
-proto.wsman.BackupWorkspaceRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.BackupWorkspaceRequest;
-  return proto.wsman.BackupWorkspaceRequest.deserializeBinaryFromReader(msg, reader);
+ * @param {?proto.contentservice.GitStatus|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setRepo = function(value) {
+  return jspb.Message.setWrapperField(this, 7, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.BackupWorkspaceRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.BackupWorkspaceRequest}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.BackupWorkspaceRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.wsman.WorkspaceStatus.prototype.clearRepo = function() {
+  return this.setRepo(undefined);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.BackupWorkspaceRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.BackupWorkspaceRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.WorkspaceStatus.prototype.hasRepo = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.BackupWorkspaceRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional WorkspaceRuntimeInfo runtime = 8;
+ * @return {?proto.wsman.WorkspaceRuntimeInfo}
  */
-proto.wsman.BackupWorkspaceRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.wsman.WorkspaceStatus.prototype.getRuntime = function() {
+  return /** @type{?proto.wsman.WorkspaceRuntimeInfo} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceRuntimeInfo, 8));
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
- */
-proto.wsman.BackupWorkspaceRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+ * @param {?proto.wsman.WorkspaceRuntimeInfo|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setRuntime = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.BackupWorkspaceRequest} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.BackupWorkspaceRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceStatus.prototype.clearRuntime = function() {
+  return this.setRuntime(undefined);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.BackupWorkspaceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.BackupWorkspaceResponse.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceStatus.prototype.hasRuntime = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.BackupWorkspaceResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional WorkspaceAuthentication auth = 9;
+ * @return {?proto.wsman.WorkspaceAuthentication}
  */
-proto.wsman.BackupWorkspaceResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.wsman.WorkspaceStatus.prototype.getAuth = function() {
+  return /** @type{?proto.wsman.WorkspaceAuthentication} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceAuthentication, 9));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.BackupWorkspaceResponse}
- */
-proto.wsman.BackupWorkspaceResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.BackupWorkspaceResponse;
-  return proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader(msg, reader);
+ * @param {?proto.wsman.WorkspaceAuthentication|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setAuth = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.BackupWorkspaceResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.BackupWorkspaceResponse}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.BackupWorkspaceResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.wsman.WorkspaceStatus.prototype.clearAuth = function() {
+  return this.setAuth(undefined);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.BackupWorkspaceResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.BackupWorkspaceResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.WorkspaceStatus.prototype.hasAuth = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.BackupWorkspaceResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional WorkspaceQueueStatus queue = 11;
+ * @return {?proto.wsman.WorkspaceQueueStatus}
  */
-proto.wsman.BackupWorkspaceResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUrl();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.wsman.WorkspaceStatus.prototype.getQueue = function() {
+  return /** @type{?proto.wsman.WorkspaceQueueStatus} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceQueueStatus, 11));
 };
 
 
 /**
- * optional string url = 1;
- * @return {string}
- */
-proto.wsman.BackupWorkspaceResponse.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+ * @param {?proto.wsman.WorkspaceQueueStatus|undefined} value
+ * @return {!proto.wsman.WorkspaceStatus} returns this
+*/
+proto.wsman.WorkspaceStatus.prototype.setQueue = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.BackupWorkspaceResponse} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceStatus} returns this
  */
-proto.wsman.BackupWorkspaceResponse.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceStatus.prototype.clearQueue = function() {
+  return this.setQueue(undefined);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.UpdateSSHKeyRequest.repeatedFields_ = [2];
+proto.wsman.WorkspaceStatus.prototype.hasQueue = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
 
 
 
@@ -4965,8 +12267,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.UpdateSSHKeyRequest.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceQueueStatus.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceQueueStatus.toObject(opt_includeInstance, this);
 };
 
 
@@ -4975,14 +12277,15 @@ proto.wsman.UpdateSSHKeyRequest.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.UpdateSSHKeyRequest} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceQueueStatus} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.UpdateSSHKeyRequest.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceQueueStatus.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    keysList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    position: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    length: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    estimatedStart: (f = msg.getEstimatedStart()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4996,23 +12299,23 @@ proto.wsman.UpdateSSHKeyRequest.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.UpdateSSHKeyRequest}
+ * @return {!proto.wsman.WorkspaceQueueStatus}
  */
-proto.wsman.UpdateSSHKeyRequest.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceQueueStatus.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.UpdateSSHKeyRequest;
-  return proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceQueueStatus;
+  return proto.wsman.WorkspaceQueueStatus.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.UpdateSSHKeyRequest} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceQueueStatus} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.UpdateSSHKeyRequest}
+ * @return {!proto.wsman.WorkspaceQueueStatus}
  */
-proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceQueueStatus.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5020,12 +12323,17 @@ proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader = function(msg, read
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPosition(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.addKeys(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLength(value);
+      break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setEstimatedStart(value);
       break;
     default:
       reader.skipField();
@@ -5040,9 +12348,9 @@ proto.wsman.UpdateSSHKeyRequest.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceQueueStatus.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.UpdateSSHKeyRequest.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceQueueStatus.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5050,81 +12358,107 @@ proto.wsman.UpdateSSHKeyRequest.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.UpdateSSHKeyRequest} message
+ * @param {!proto.wsman.WorkspaceQueueStatus} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.UpdateSSHKeyRequest.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceQueueStatus.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPosition();
+  if (f !== 0) {
+    writer.writeInt32(
       1,
       f
     );
   }
-  f = message.getKeysList();
-  if (f.length > 0) {
-    writer.writeRepeatedString(
+  f = message.getLength();
+  if (f !== 0) {
+    writer.writeInt32(
       2,
       f
     );
   }
+  f = message.getEstimatedStart();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
- * @return {string}
+ * optional int32 position = 1;
+ * @return {number}
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceQueueStatus.prototype.getPosition = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceQueueStatus} returns this
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceQueueStatus.prototype.setPosition = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * repeated string keys = 2;
- * @return {!Array<string>}
+ * optional int32 length = 2;
+ * @return {number}
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.getKeysList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+proto.wsman.WorkspaceQueueStatus.prototype.getLength = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceQueueStatus} returns this
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.setKeysList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
+proto.wsman.WorkspaceQueueStatus.prototype.setLength = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
+ * optional google.protobuf.Timestamp estimated_start = 3;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.addKeys = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+proto.wsman.WorkspaceQueueStatus.prototype.getEstimatedStart = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.UpdateSSHKeyRequest} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceQueueStatus} returns this
+*/
+proto.wsman.WorkspaceQueueStatus.prototype.setEstimatedStart = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceQueueStatus} returns this
  */
-proto.wsman.UpdateSSHKeyRequest.prototype.clearKeysList = function() {
-  return this.setKeysList([]);
+proto.wsman.WorkspaceQueueStatus.prototype.clearEstimatedStart = function() {
+  return this.setEstimatedStart(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceQueueStatus.prototype.hasEstimatedStart = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -5144,8 +12478,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.UpdateSSHKeyResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.UpdateSSHKeyResponse.toObject(opt_includeInstance, this);
+proto.wsman.IDEImage.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.IDEImage.toObject(opt_includeInstance, this);
 };
 
 
@@ -5154,13 +12488,14 @@ proto.wsman.UpdateSSHKeyResponse.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.UpdateSSHKeyResponse} msg The msg instance to transform.
+ * @param {!proto.wsman.IDEImage} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.UpdateSSHKeyResponse.toObject = function(includeInstance, msg) {
+proto.wsman.IDEImage.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    webRef: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    supervisorRef: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -5174,29 +12509,37 @@ proto.wsman.UpdateSSHKeyResponse.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.UpdateSSHKeyResponse}
+ * @return {!proto.wsman.IDEImage}
  */
-proto.wsman.UpdateSSHKeyResponse.deserializeBinary = function(bytes) {
+proto.wsman.IDEImage.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.UpdateSSHKeyResponse;
-  return proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.IDEImage;
+  return proto.wsman.IDEImage.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.UpdateSSHKeyResponse} msg The message object to deserialize into.
+ * @param {!proto.wsman.IDEImage} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.UpdateSSHKeyResponse}
+ * @return {!proto.wsman.IDEImage}
  */
-proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.IDEImage.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWebRef(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSupervisorRef(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -5210,9 +12553,9 @@ proto.wsman.UpdateSSHKeyResponse.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.UpdateSSHKeyResponse.prototype.serializeBinary = function() {
+proto.wsman.IDEImage.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.UpdateSSHKeyResponse.serializeBinaryToWriter(this, writer);
+  proto.wsman.IDEImage.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5220,15 +12563,72 @@ proto.wsman.UpdateSSHKeyResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.UpdateSSHKeyResponse} message
+ * @param {!proto.wsman.IDEImage} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.UpdateSSHKeyResponse.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.IDEImage.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getWebRef();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSupervisorRef();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
+/**
+ * optional string web_ref = 1;
+ * @return {string}
+ */
+proto.wsman.IDEImage.prototype.getWebRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.IDEImage} returns this
+ */
+proto.wsman.IDEImage.prototype.setWebRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string supervisor_ref = 3;
+ * @return {string}
+ */
+proto.wsman.IDEImage.prototype.getSupervisorRef = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.IDEImage} returns this
+ */
+proto.wsman.IDEImage.prototype.setSupervisorRef = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.wsman.WorkspaceSpec.repeatedFields_ = [5,10];
 
 
 
@@ -5245,8 +12645,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceStatus.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceSpec.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceSpec.toObject(opt_includeInstance, this);
 };
 
 
@@ -5255,22 +12655,23 @@ proto.wsman.WorkspaceStatus.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceStatus} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceSpec} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceSpec.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    statusVersion: jspb.Message.getFieldWithDefault(msg, 10, 0),
-    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
-    spec: (f = msg.getSpec()) && proto.wsman.WorkspaceSpec.toObject(includeInstance, f),
-    phase: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    conditions: (f = msg.getConditions()) && proto.wsman.WorkspaceConditions.toObject(includeInstance, f),
-    message: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    repo: (f = msg.getRepo()) && content$service$api_initializer_pb.GitStatus.toObject(includeInstance, f),
-    runtime: (f = msg.getRuntime()) && proto.wsman.WorkspaceRuntimeInfo.toObject(includeInstance, f),
-    auth: (f = msg.getAuth()) && proto.wsman.WorkspaceAuthentication.toObject(includeInstance, f)
+    workspaceImage: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    headless: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    exposedPortsList: jspb.Message.toObjectList(msg.getExposedPortsList(),
+    proto.wsman.PortSpec.toObject, includeInstance),
+    type: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    timeout: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    ideImage: (f = msg.getIdeImage()) && proto.wsman.IDEImage.toObject(includeInstance, f),
+    pb_class: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f,
+    closedTimeout: jspb.Message.getFieldWithDefault(msg, 11, "")
   };
 
   if (includeInstance) {
@@ -5284,23 +12685,23 @@ proto.wsman.WorkspaceStatus.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceStatus}
+ * @return {!proto.wsman.WorkspaceSpec}
  */
-proto.wsman.WorkspaceStatus.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceSpec.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceStatus;
-  return proto.wsman.WorkspaceStatus.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceSpec;
+  return proto.wsman.WorkspaceSpec.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceStatus} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceSpec} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceStatus}
+ * @return {!proto.wsman.WorkspaceSpec}
  */
-proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5309,49 +12710,45 @@ proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader)
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 10:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setStatusVersion(value);
-      break;
-    case 2:
-      var value = new proto.wsman.WorkspaceMetadata;
-      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
+      msg.setWorkspaceImage(value);
       break;
     case 3:
-      var value = new proto.wsman.WorkspaceSpec;
-      reader.readMessage(value,proto.wsman.WorkspaceSpec.deserializeBinaryFromReader);
-      msg.setSpec(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setHeadless(value);
       break;
     case 4:
-      var value = /** @type {!proto.wsman.WorkspacePhase} */ (reader.readEnum());
-      msg.setPhase(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
       break;
     case 5:
-      var value = new proto.wsman.WorkspaceConditions;
-      reader.readMessage(value,proto.wsman.WorkspaceConditions.deserializeBinaryFromReader);
-      msg.setConditions(value);
+      var value = new proto.wsman.PortSpec;
+      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
+      msg.addExposedPorts(value);
       break;
     case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMessage(value);
+      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
+      msg.setType(value);
       break;
     case 7:
-      var value = new content$service$api_initializer_pb.GitStatus;
-      reader.readMessage(value,content$service$api_initializer_pb.GitStatus.deserializeBinaryFromReader);
-      msg.setRepo(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeout(value);
       break;
     case 8:
-      var value = new proto.wsman.WorkspaceRuntimeInfo;
-      reader.readMessage(value,proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader);
-      msg.setRuntime(value);
+      var value = new proto.wsman.IDEImage;
+      reader.readMessage(value,proto.wsman.IDEImage.deserializeBinaryFromReader);
+      msg.setIdeImage(value);
       break;
     case 9:
-      var value = new proto.wsman.WorkspaceAuthentication;
-      reader.readMessage(value,proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader);
-      msg.setAuth(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClass(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addIdeImageLayers(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClosedTimeout(value);
       break;
     default:
       reader.skipField();
@@ -5366,9 +12763,9 @@ proto.wsman.WorkspaceStatus.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceStatus.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceSpec.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceStatus.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceSpec.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5376,152 +12773,240 @@ proto.wsman.WorkspaceStatus.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceStatus} message
+ * @param {!proto.wsman.WorkspaceSpec} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceSpec.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getWorkspaceImage();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getStatusVersion();
-  if (f !== 0) {
-    writer.writeUint64(
-      10,
-      f
-    );
-  }
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.wsman.WorkspaceMetadata.serializeBinaryToWriter
-    );
-  }
-  f = message.getSpec();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getHeadless();
+  if (f) {
+    writer.writeBool(
       3,
-      f,
-      proto.wsman.WorkspaceSpec.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getPhase();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getConditions();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getExposedPortsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       5,
       f,
-      proto.wsman.WorkspaceConditions.serializeBinaryToWriter
+      proto.wsman.PortSpec.serializeBinaryToWriter
     );
   }
-  f = message.getMessage();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       6,
       f
     );
   }
-  f = message.getRepo();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getTimeout();
+  if (f.length > 0) {
+    writer.writeString(
       7,
-      f,
-      content$service$api_initializer_pb.GitStatus.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getRuntime();
+  f = message.getIdeImage();
   if (f != null) {
     writer.writeMessage(
       8,
       f,
-      proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter
+      proto.wsman.IDEImage.serializeBinaryToWriter
     );
   }
-  f = message.getAuth();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getClass();
+  if (f.length > 0) {
+    writer.writeString(
       9,
-      f,
-      proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getIdeImageLayersList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      10,
+      f
+    );
+  }
+  f = message.getClosedTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
     );
   }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string workspace_image = 1;
+ * @return {string}
+ */
+proto.wsman.WorkspaceSpec.prototype.getWorkspaceImage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+ */
+proto.wsman.WorkspaceSpec.prototype.setWorkspaceImage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool headless = 3;
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceSpec.prototype.getHeadless = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+ */
+proto.wsman.WorkspaceSpec.prototype.setHeadless = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+/**
+ * optional string url = 4;
  * @return {string}
  */
-proto.wsman.WorkspaceStatus.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceSpec.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+ */
+proto.wsman.WorkspaceSpec.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * repeated PortSpec exposed_ports = 5;
+ * @return {!Array<!proto.wsman.PortSpec>}
+ */
+proto.wsman.WorkspaceSpec.prototype.getExposedPortsList = function() {
+  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.wsman.PortSpec>} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+*/
+proto.wsman.WorkspaceSpec.prototype.setExposedPortsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.wsman.PortSpec=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.PortSpec}
+ */
+proto.wsman.WorkspaceSpec.prototype.addExposedPorts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.wsman.PortSpec, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+ */
+proto.wsman.WorkspaceSpec.prototype.clearExposedPortsList = function() {
+  return this.setExposedPortsList([]);
+};
+
+
+/**
+ * optional WorkspaceType type = 6;
+ * @return {!proto.wsman.WorkspaceType}
+ */
+proto.wsman.WorkspaceSpec.prototype.getType = function() {
+  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * @param {!proto.wsman.WorkspaceType} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceSpec.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
 };
 
 
 /**
- * optional uint64 status_version = 10;
- * @return {number}
+ * optional string timeout = 7;
+ * @return {string}
  */
-proto.wsman.WorkspaceStatus.prototype.getStatusVersion = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+proto.wsman.WorkspaceSpec.prototype.getTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.setStatusVersion = function(value) {
-  return jspb.Message.setProto3IntField(this, 10, value);
+proto.wsman.WorkspaceSpec.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
 /**
- * optional WorkspaceMetadata metadata = 2;
- * @return {?proto.wsman.WorkspaceMetadata}
+ * optional IDEImage ide_image = 8;
+ * @return {?proto.wsman.IDEImage}
  */
-proto.wsman.WorkspaceStatus.prototype.getMetadata = function() {
-  return /** @type{?proto.wsman.WorkspaceMetadata} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata, 2));
+proto.wsman.WorkspaceSpec.prototype.getIdeImage = function() {
+  return /** @type{?proto.wsman.IDEImage} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.IDEImage, 8));
 };
 
 
 /**
- * @param {?proto.wsman.WorkspaceMetadata|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * @param {?proto.wsman.IDEImage|undefined} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
 */
-proto.wsman.WorkspaceStatus.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+proto.wsman.WorkspaceSpec.prototype.setIdeImage = function(value) {
+  return jspb.Message.setWrapperField(this, 8, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
+proto.wsman.WorkspaceSpec.prototype.clearIdeImage = function() {
+  return this.setIdeImage(undefined);
 };
 
 
@@ -5529,229 +13014,301 @@ proto.wsman.WorkspaceStatus.prototype.clearMetadata = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.WorkspaceStatus.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.wsman.WorkspaceSpec.prototype.hasIdeImage = function() {
+  return jspb.Message.getField(this, 8) != null;
 };
 
 
 /**
- * optional WorkspaceSpec spec = 3;
- * @return {?proto.wsman.WorkspaceSpec}
+ * optional string class = 9;
+ * @return {string}
  */
-proto.wsman.WorkspaceStatus.prototype.getSpec = function() {
-  return /** @type{?proto.wsman.WorkspaceSpec} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceSpec, 3));
+proto.wsman.WorkspaceSpec.prototype.getClass = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * @param {?proto.wsman.WorkspaceSpec|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setSpec = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+ */
+proto.wsman.WorkspaceSpec.prototype.setClass = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * repeated string ide_image_layers = 10;
+ * @return {!Array<string>}
  */
-proto.wsman.WorkspaceStatus.prototype.clearSpec = function() {
-  return this.setSpec(undefined);
+proto.wsman.WorkspaceSpec.prototype.getIdeImageLayersList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.hasSpec = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.wsman.WorkspaceSpec.prototype.setIdeImageLayersList = function(value) {
+  return jspb.Message.setField(this, 10, value || []);
 };
 
 
 /**
- * optional WorkspacePhase phase = 4;
- * @return {!proto.wsman.WorkspacePhase}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.getPhase = function() {
-  return /** @type {!proto.wsman.WorkspacePhase} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.wsman.WorkspaceSpec.prototype.addIdeImageLayers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
 };
 
 
 /**
- * @param {!proto.wsman.WorkspacePhase} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.WorkspaceSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.setPhase = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.wsman.WorkspaceSpec.prototype.clearIdeImageLayersList = function() {
+  return this.setIdeImageLayersList([]);
 };
 
 
 /**
- * optional WorkspaceConditions conditions = 5;
- * @return {?proto.wsman.WorkspaceConditions}
+ * optional string closed_timeout = 11;
+ * @return {string}
  */
-proto.wsman.WorkspaceStatus.prototype.getConditions = function() {
-  return /** @type{?proto.wsman.WorkspaceConditions} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceConditions, 5));
+proto.wsman.WorkspaceSpec.prototype.getClosedTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
 };
 
 
 /**
- * @param {?proto.wsman.WorkspaceConditions|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setConditions = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceSpec} returns this
+ */
+proto.wsman.WorkspaceSpec.prototype.setClosedTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.wsman.WorkspaceStatus.prototype.clearConditions = function() {
-  return this.setConditions(undefined);
+proto.wsman.PortSpec.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.PortSpec.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.PortSpec} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceStatus.prototype.hasConditions = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.wsman.PortSpec.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    port: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    visibility: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    protocol: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional string message = 6;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.PortSpec}
  */
-proto.wsman.WorkspaceStatus.prototype.getMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.wsman.PortSpec.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.PortSpec;
+  return proto.wsman.PortSpec.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.PortSpec} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.PortSpec}
  */
-proto.wsman.WorkspaceStatus.prototype.setMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.wsman.PortSpec.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.wsman.PortVisibility} */ (reader.readEnum());
+      msg.setVisibility(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.wsman.PortProtocol} */ (reader.readEnum());
+      msg.setProtocol(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional contentservice.GitStatus repo = 7;
- * @return {?proto.contentservice.GitStatus}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceStatus.prototype.getRepo = function() {
-  return /** @type{?proto.contentservice.GitStatus} */ (
-    jspb.Message.getWrapperField(this, content$service$api_initializer_pb.GitStatus, 7));
+proto.wsman.PortSpec.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.PortSpec.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {?proto.contentservice.GitStatus|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setRepo = function(value) {
-  return jspb.Message.setWrapperField(this, 7, value);
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.PortSpec} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.PortSpec.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getVisibility();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getProtocol();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * optional uint32 port = 1;
+ * @return {number}
  */
-proto.wsman.WorkspaceStatus.prototype.clearRepo = function() {
-  return this.setRepo(undefined);
+proto.wsman.PortSpec.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.wsman.PortSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.hasRepo = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.wsman.PortSpec.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional WorkspaceRuntimeInfo runtime = 8;
- * @return {?proto.wsman.WorkspaceRuntimeInfo}
+ * optional PortVisibility visibility = 3;
+ * @return {!proto.wsman.PortVisibility}
  */
-proto.wsman.WorkspaceStatus.prototype.getRuntime = function() {
-  return /** @type{?proto.wsman.WorkspaceRuntimeInfo} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceRuntimeInfo, 8));
-};
-
-
-/**
- * @param {?proto.wsman.WorkspaceRuntimeInfo|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setRuntime = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+proto.wsman.PortSpec.prototype.getVisibility = function() {
+  return /** @type {!proto.wsman.PortVisibility} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * @param {!proto.wsman.PortVisibility} value
+ * @return {!proto.wsman.PortSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.clearRuntime = function() {
-  return this.setRuntime(undefined);
+proto.wsman.PortSpec.prototype.setVisibility = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string url = 4;
+ * @return {string}
  */
-proto.wsman.WorkspaceStatus.prototype.hasRuntime = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.wsman.PortSpec.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * optional WorkspaceAuthentication auth = 9;
- * @return {?proto.wsman.WorkspaceAuthentication}
+ * @param {string} value
+ * @return {!proto.wsman.PortSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.getAuth = function() {
-  return /** @type{?proto.wsman.WorkspaceAuthentication} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceAuthentication, 9));
-};
-
-
-/**
- * @param {?proto.wsman.WorkspaceAuthentication|undefined} value
- * @return {!proto.wsman.WorkspaceStatus} returns this
-*/
-proto.wsman.WorkspaceStatus.prototype.setAuth = function(value) {
-  return jspb.Message.setWrapperField(this, 9, value);
+proto.wsman.PortSpec.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceStatus} returns this
+ * optional PortProtocol protocol = 5;
+ * @return {!proto.wsman.PortProtocol}
  */
-proto.wsman.WorkspaceStatus.prototype.clearAuth = function() {
-  return this.setAuth(undefined);
+proto.wsman.PortSpec.prototype.getProtocol = function() {
+  return /** @type {!proto.wsman.PortProtocol} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!proto.wsman.PortProtocol} value
+ * @return {!proto.wsman.PortSpec} returns this
  */
-proto.wsman.WorkspaceStatus.prototype.hasAuth = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.wsman.PortSpec.prototype.setProtocol = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
 };
 
 
@@ -5771,8 +13328,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.IDEImage.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.IDEImage.toObject(opt_includeInstance, this);
+proto.wsman.VolumeSnapshotInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.VolumeSnapshotInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -5781,14 +13338,14 @@ proto.wsman.IDEImage.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.IDEImage} msg The msg instance to transform.
+ * @param {!proto.wsman.VolumeSnapshotInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.IDEImage.toObject = function(includeInstance, msg) {
+proto.wsman.VolumeSnapshotInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    webRef: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    supervisorRef: jspb.Message.getFieldWithDefault(msg, 3, "")
+    volumeSnapshotName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    volumeSnapshotHandle: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -5802,23 +13359,23 @@ proto.wsman.IDEImage.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.IDEImage}
+ * @return {!proto.wsman.VolumeSnapshotInfo}
  */
-proto.wsman.IDEImage.deserializeBinary = function(bytes) {
+proto.wsman.VolumeSnapshotInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.IDEImage;
-  return proto.wsman.IDEImage.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.VolumeSnapshotInfo;
+  return proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.IDEImage} msg The message object to deserialize into.
+ * @param {!proto.wsman.VolumeSnapshotInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.IDEImage}
+ * @return {!proto.wsman.VolumeSnapshotInfo}
  */
-proto.wsman.IDEImage.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5827,11 +13384,11 @@ proto.wsman.IDEImage.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setWebRef(value);
+      msg.setVolumeSnapshotName(value);
       break;
-    case 3:
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSupervisorRef(value);
+      msg.setVolumeSnapshotHandle(value);
       break;
     default:
       reader.skipField();
@@ -5846,9 +13403,9 @@ proto.wsman.IDEImage.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.IDEImage.prototype.serializeBinary = function() {
+proto.wsman.VolumeSnapshotInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.IDEImage.serializeBinaryToWriter(this, writer);
+  proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5856,23 +13413,23 @@ proto.wsman.IDEImage.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.IDEImage} message
+ * @param {!proto.wsman.VolumeSnapshotInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.IDEImage.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWebRef();
+  f = message.getVolumeSnapshotName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getSupervisorRef();
+  f = message.getVolumeSnapshotHandle();
   if (f.length > 0) {
     writer.writeString(
-      3,
+      2,
       f
     );
   }
@@ -5880,49 +13437,42 @@ proto.wsman.IDEImage.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string web_ref = 1;
+ * optional string volume_snapshot_name = 1;
  * @return {string}
  */
-proto.wsman.IDEImage.prototype.getWebRef = function() {
+proto.wsman.VolumeSnapshotInfo.prototype.getVolumeSnapshotName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.IDEImage} returns this
+ * @return {!proto.wsman.VolumeSnapshotInfo} returns this
  */
-proto.wsman.IDEImage.prototype.setWebRef = function(value) {
+proto.wsman.VolumeSnapshotInfo.prototype.setVolumeSnapshotName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string supervisor_ref = 3;
+ * optional string volume_snapshot_handle = 2;
  * @return {string}
  */
-proto.wsman.IDEImage.prototype.getSupervisorRef = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.wsman.VolumeSnapshotInfo.prototype.getVolumeSnapshotHandle = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.IDEImage} returns this
+ * @return {!proto.wsman.VolumeSnapshotInfo} returns this
  */
-proto.wsman.IDEImage.prototype.setSupervisorRef = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.wsman.VolumeSnapshotInfo.prototype.setVolumeSnapshotHandle = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.wsman.WorkspaceSpec.repeatedFields_ = [5,10];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5938,8 +13488,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceSpec.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceSpec.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceConditions.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceConditions.toObject(opt_includeInstance, this);
 };
 
 
@@ -5948,23 +13498,31 @@ proto.wsman.WorkspaceSpec.prototype.toObject = function(opt_includeInstance) {
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceSpec} msg The msg instance to transform.
+ * @param {!proto.wsman.WorkspaceConditions} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceSpec.toObject = function(includeInstance, msg) {
+proto.wsman.WorkspaceConditions.toObject = function(includeInstance, msg) {
   var f, obj = {
-    workspaceImage: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    headless: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    exposedPortsList: jspb.Message.toObjectList(msg.getExposedPortsList(),
-    proto.wsman.PortSpec.toObject, includeInstance),
-    type: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    timeout: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    ideImage: (f = msg.getIdeImage()) && proto.wsman.IDEImage.toObject(includeInstance, f),
-    pb_class: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 10)) == null ? undefined : f,
-    closedTimeout: jspb.Message.getFieldWithDefault(msg, 11, "")
+    failed: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    timeout: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    pullingImages: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    snapshot: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    finalBackupComplete: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    deployed: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    networkNotReady: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    firstUserActivity: (f = msg.getFirstUserActivity()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    headlessTaskFailed: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    stoppedByRequest: jspb.Message.getFieldWithDefault(msg, 11, 0),
+    volumeSnapshot: (f = msg.getVolumeSnapshot()) && proto.wsman.VolumeSnapshotInfo.toObject(includeInstance, f),
+    aborted: jspb.Message.getFieldWithDefault(msg, 13, 0),
+    pullingImageFailed: jspb.Message.getFieldWithDefault(msg, 14, ""),
+    nodePreempted: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    stopReason: jspb.Message.getFieldWithDefault(msg, 16, 0),
+    contentReady: (f = msg.getContentReady()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    everReady: (f = msg.getEverReady()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    oomKill: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    relocating: jspb.Message.getFieldWithDefault(msg, 20, "")
   };
 
   if (includeInstance) {
@@ -5978,23 +13536,23 @@ proto.wsman.WorkspaceSpec.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceSpec}
+ * @return {!proto.wsman.WorkspaceConditions}
  */
-proto.wsman.WorkspaceSpec.deserializeBinary = function(bytes) {
+proto.wsman.WorkspaceConditions.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceSpec;
-  return proto.wsman.WorkspaceSpec.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.WorkspaceConditions;
+  return proto.wsman.WorkspaceConditions.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceSpec} msg The message object to deserialize into.
+ * @param {!proto.wsman.WorkspaceConditions} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceSpec}
+ * @return {!proto.wsman.WorkspaceConditions}
  */
-proto.wsman.WorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.WorkspaceConditions.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6003,45 +13561,83 @@ proto.wsman.WorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setWorkspaceImage(value);
+      msg.setFailed(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimeout(value);
       break;
     case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setHeadless(value);
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setPullingImages(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSnapshot(value);
+      break;
+    case 6:
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setFinalBackupComplete(value);
+      break;
+    case 7:
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setDeployed(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setNetworkNotReady(value);
+      break;
+    case 9:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setFirstUserActivity(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHeadlessTaskFailed(value);
+      break;
+    case 11:
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setStoppedByRequest(value);
       break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+    case 12:
+      var value = new proto.wsman.VolumeSnapshotInfo;
+      reader.readMessage(value,proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader);
+      msg.setVolumeSnapshot(value);
       break;
-    case 5:
-      var value = new proto.wsman.PortSpec;
-      reader.readMessage(value,proto.wsman.PortSpec.deserializeBinaryFromReader);
-      msg.addExposedPorts(value);
+    case 13:
+      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
+      msg.setAborted(value);
       break;
-    case 6:
-      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
-      msg.setType(value);
+    case 14:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPullingImageFailed(value);
       break;
-    case 7:
+    case 15:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTimeout(value);
+      msg.setNodePreempted(value);
       break;
-    case 8:
-      var value = new proto.wsman.IDEImage;
-      reader.readMessage(value,proto.wsman.IDEImage.deserializeBinaryFromReader);
-      msg.setIdeImage(value);
+    case 16:
+      var value = /** @type {!proto.wsman.StopReason} */ (reader.readEnum());
+      msg.setStopReason(value);
       break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setClass(value);
+    case 17:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setContentReady(value);
       break;
-    case 10:
+    case 18:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setEverReady(value);
+      break;
+    case 19:
       var value = /** @type {string} */ (reader.readString());
-      msg.addIdeImageLayers(value);
+      msg.setOomKill(value);
       break;
-    case 11:
+    case 20:
       var value = /** @type {string} */ (reader.readString());
-      msg.setClosedTimeout(value);
+      msg.setRelocating(value);
       break;
     default:
       reader.skipField();
@@ -6056,9 +13652,9 @@ proto.wsman.WorkspaceSpec.deserializeBinaryFromReader = function(msg, reader) {
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceSpec.prototype.serializeBinary = function() {
+proto.wsman.WorkspaceConditions.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceSpec.serializeBinaryToWriter(this, writer);
+  proto.wsman.WorkspaceConditions.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6066,81 +13662,146 @@ proto.wsman.WorkspaceSpec.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceSpec} message
+ * @param {!proto.wsman.WorkspaceConditions} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceSpec.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.WorkspaceConditions.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWorkspaceImage();
+  f = message.getFailed();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getHeadless();
-  if (f) {
-    writer.writeBool(
-      3,
+  f = message.getTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
       f
     );
   }
-  f = message.getUrl();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
+  f = message.getPullingImages();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
       f
     );
   }
-  f = message.getExposedPortsList();
+  f = message.getSnapshot();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       5,
-      f,
-      proto.wsman.PortSpec.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getType();
+  f = message.getFinalBackupComplete();
   if (f !== 0.0) {
     writer.writeEnum(
       6,
       f
     );
   }
-  f = message.getTimeout();
+  f = message.getDeployed();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      7,
+      f
+    );
+  }
+  f = message.getNetworkNotReady();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getFirstUserActivity();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getHeadlessTaskFailed();
   if (f.length > 0) {
     writer.writeString(
-      7,
+      10,
       f
     );
   }
-  f = message.getIdeImage();
+  f = message.getStoppedByRequest();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      11,
+      f
+    );
+  }
+  f = message.getVolumeSnapshot();
   if (f != null) {
     writer.writeMessage(
-      8,
+      12,
       f,
-      proto.wsman.IDEImage.serializeBinaryToWriter
+      proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter
     );
   }
-  f = message.getClass();
+  f = message.getAborted();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      13,
+      f
+    );
+  }
+  f = message.getPullingImageFailed();
   if (f.length > 0) {
     writer.writeString(
-      9,
+      14,
       f
     );
   }
-  f = message.getIdeImageLayersList();
+  f = message.getNodePreempted();
   if (f.length > 0) {
-    writer.writeRepeatedString(
-      10,
+    writer.writeString(
+      15,
       f
     );
   }
-  f = message.getClosedTimeout();
+  f = message.getStopReason();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      16,
+      f
+    );
+  }
+  f = message.getContentReady();
+  if (f != null) {
+    writer.writeMessage(
+      17,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getEverReady();
+  if (f != null) {
+    writer.writeMessage(
+      18,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getOomKill();
   if (f.length > 0) {
     writer.writeString(
-      11,
+      19,
+      f
+    );
+  }
+  f = message.getRelocating();
+  if (f.length > 0) {
+    writer.writeString(
+      20,
       f
     );
   }
@@ -6148,620 +13809,420 @@ proto.wsman.WorkspaceSpec.serializeBinaryToWriter = function(message, writer) {
 
 
 /**
- * optional string workspace_image = 1;
+ * optional string failed = 1;
  * @return {string}
  */
-proto.wsman.WorkspaceSpec.prototype.getWorkspaceImage = function() {
+proto.wsman.WorkspaceConditions.prototype.getFailed = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.setWorkspaceImage = function(value) {
+proto.wsman.WorkspaceConditions.prototype.setFailed = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional bool headless = 3;
- * @return {boolean}
- */
-proto.wsman.WorkspaceSpec.prototype.getHeadless = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
-};
-
-
-/**
- * @param {boolean} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
- */
-proto.wsman.WorkspaceSpec.prototype.setHeadless = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
-};
-
-
-/**
- * optional string url = 4;
+ * optional string timeout = 2;
  * @return {string}
  */
-proto.wsman.WorkspaceSpec.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.wsman.WorkspaceConditions.prototype.getTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
- */
-proto.wsman.WorkspaceSpec.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * repeated PortSpec exposed_ports = 5;
- * @return {!Array<!proto.wsman.PortSpec>}
- */
-proto.wsman.WorkspaceSpec.prototype.getExposedPortsList = function() {
-  return /** @type{!Array<!proto.wsman.PortSpec>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.wsman.PortSpec, 5));
-};
-
-
-/**
- * @param {!Array<!proto.wsman.PortSpec>} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
-*/
-proto.wsman.WorkspaceSpec.prototype.setExposedPortsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 5, value);
-};
-
-
-/**
- * @param {!proto.wsman.PortSpec=} opt_value
- * @param {number=} opt_index
- * @return {!proto.wsman.PortSpec}
- */
-proto.wsman.WorkspaceSpec.prototype.addExposedPorts = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.wsman.PortSpec, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.clearExposedPortsList = function() {
-  return this.setExposedPortsList([]);
+proto.wsman.WorkspaceConditions.prototype.setTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional WorkspaceType type = 6;
- * @return {!proto.wsman.WorkspaceType}
+ * optional WorkspaceConditionBool pulling_images = 3;
+ * @return {!proto.wsman.WorkspaceConditionBool}
  */
-proto.wsman.WorkspaceSpec.prototype.getType = function() {
-  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.wsman.WorkspaceConditions.prototype.getPullingImages = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceType} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 6, value);
+proto.wsman.WorkspaceConditions.prototype.setPullingImages = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
 };
 
 
 /**
- * optional string timeout = 7;
+ * optional string snapshot = 5;
  * @return {string}
  */
-proto.wsman.WorkspaceSpec.prototype.getTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.wsman.WorkspaceConditions.prototype.getSnapshot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.wsman.WorkspaceConditions.prototype.setSnapshot = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * optional IDEImage ide_image = 8;
- * @return {?proto.wsman.IDEImage}
+ * optional WorkspaceConditionBool final_backup_complete = 6;
+ * @return {!proto.wsman.WorkspaceConditionBool}
  */
-proto.wsman.WorkspaceSpec.prototype.getIdeImage = function() {
-  return /** @type{?proto.wsman.IDEImage} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.IDEImage, 8));
+proto.wsman.WorkspaceConditions.prototype.getFinalBackupComplete = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
 };
 
 
 /**
- * @param {?proto.wsman.IDEImage|undefined} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
-*/
-proto.wsman.WorkspaceSpec.prototype.setIdeImage = function(value) {
-  return jspb.Message.setWrapperField(this, 8, value);
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+ */
+proto.wsman.WorkspaceConditions.prototype.setFinalBackupComplete = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * optional WorkspaceConditionBool deployed = 7;
+ * @return {!proto.wsman.WorkspaceConditionBool}
  */
-proto.wsman.WorkspaceSpec.prototype.clearIdeImage = function() {
-  return this.setIdeImage(undefined);
+proto.wsman.WorkspaceConditions.prototype.getDeployed = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.hasIdeImage = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.wsman.WorkspaceConditions.prototype.setDeployed = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
 };
 
 
 /**
- * optional string class = 9;
- * @return {string}
+ * optional WorkspaceConditionBool network_not_ready = 8;
+ * @return {!proto.wsman.WorkspaceConditionBool}
  */
-proto.wsman.WorkspaceSpec.prototype.getClass = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.wsman.WorkspaceConditions.prototype.getNetworkNotReady = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.setClass = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.wsman.WorkspaceConditions.prototype.setNetworkNotReady = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
 };
 
 
 /**
- * repeated string ide_image_layers = 10;
- * @return {!Array<string>}
+ * optional google.protobuf.Timestamp first_user_activity = 9;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.wsman.WorkspaceSpec.prototype.getIdeImageLayersList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 10));
+proto.wsman.WorkspaceConditions.prototype.getFirstUserActivity = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 9));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
- */
-proto.wsman.WorkspaceSpec.prototype.setIdeImageLayersList = function(value) {
-  return jspb.Message.setField(this, 10, value || []);
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+*/
+proto.wsman.WorkspaceConditions.prototype.setFirstUserActivity = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
 };
 
 
 /**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.addIdeImageLayers = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 10, value, opt_index);
+proto.wsman.WorkspaceConditions.prototype.clearFirstUserActivity = function() {
+  return this.setFirstUserActivity(undefined);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.WorkspaceSpec.prototype.clearIdeImageLayersList = function() {
-  return this.setIdeImageLayersList([]);
+proto.wsman.WorkspaceConditions.prototype.hasFirstUserActivity = function() {
+  return jspb.Message.getField(this, 9) != null;
 };
 
 
 /**
- * optional string closed_timeout = 11;
+ * optional string headless_task_failed = 10;
  * @return {string}
  */
-proto.wsman.WorkspaceSpec.prototype.getClosedTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+proto.wsman.WorkspaceConditions.prototype.getHeadlessTaskFailed = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceSpec} returns this
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.WorkspaceSpec.prototype.setClosedTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 11, value);
+proto.wsman.WorkspaceConditions.prototype.setHeadlessTaskFailed = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
 };
 
 
+/**
+ * optional WorkspaceConditionBool stopped_by_request = 11;
+ * @return {!proto.wsman.WorkspaceConditionBool}
+ */
+proto.wsman.WorkspaceConditions.prototype.getStoppedByRequest = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.PortSpec.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.PortSpec.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceConditions.prototype.setStoppedByRequest = function(value) {
+  return jspb.Message.setProto3EnumField(this, 11, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.PortSpec} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional VolumeSnapshotInfo volume_snapshot = 12;
+ * @return {?proto.wsman.VolumeSnapshotInfo}
  */
-proto.wsman.PortSpec.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    port: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    visibility: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    url: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    protocol: jspb.Message.getFieldWithDefault(msg, 5, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.wsman.WorkspaceConditions.prototype.getVolumeSnapshot = function() {
+  return /** @type{?proto.wsman.VolumeSnapshotInfo} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.VolumeSnapshotInfo, 12));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.PortSpec}
- */
-proto.wsman.PortSpec.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.PortSpec;
-  return proto.wsman.PortSpec.deserializeBinaryFromReader(msg, reader);
+ * @param {?proto.wsman.VolumeSnapshotInfo|undefined} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+*/
+proto.wsman.WorkspaceConditions.prototype.setVolumeSnapshot = function(value) {
+  return jspb.Message.setWrapperField(this, 12, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.PortSpec} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.PortSpec}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.PortSpec.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
-      break;
-    case 3:
-      var value = /** @type {!proto.wsman.PortVisibility} */ (reader.readEnum());
-      msg.setVisibility(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
-      break;
-    case 5:
-      var value = /** @type {!proto.wsman.PortProtocol} */ (reader.readEnum());
-      msg.setProtocol(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.wsman.WorkspaceConditions.prototype.clearVolumeSnapshot = function() {
+  return this.setVolumeSnapshot(undefined);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.PortSpec.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.PortSpec.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.WorkspaceConditions.prototype.hasVolumeSnapshot = function() {
+  return jspb.Message.getField(this, 12) != null;
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.PortSpec} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional WorkspaceConditionBool aborted = 13;
+ * @return {!proto.wsman.WorkspaceConditionBool}
  */
-proto.wsman.PortSpec.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
-      1,
-      f
-    );
-  }
-  f = message.getVisibility();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      3,
-      f
-    );
-  }
-  f = message.getUrl();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getProtocol();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
-      f
-    );
-  }
+proto.wsman.WorkspaceConditions.prototype.getAborted = function() {
+  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
 };
 
 
 /**
- * optional uint32 port = 1;
- * @return {number}
+ * @param {!proto.wsman.WorkspaceConditionBool} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.PortSpec.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.wsman.WorkspaceConditions.prototype.setAborted = function(value) {
+  return jspb.Message.setProto3EnumField(this, 13, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.wsman.PortSpec} returns this
+ * optional string pulling_image_failed = 14;
+ * @return {string}
  */
-proto.wsman.PortSpec.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.wsman.WorkspaceConditions.prototype.getPullingImageFailed = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
 };
 
 
 /**
- * optional PortVisibility visibility = 3;
- * @return {!proto.wsman.PortVisibility}
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.PortSpec.prototype.getVisibility = function() {
-  return /** @type {!proto.wsman.PortVisibility} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.wsman.WorkspaceConditions.prototype.setPullingImageFailed = function(value) {
+  return jspb.Message.setProto3StringField(this, 14, value);
 };
 
 
 /**
- * @param {!proto.wsman.PortVisibility} value
- * @return {!proto.wsman.PortSpec} returns this
+ * optional string node_preempted = 15;
+ * @return {string}
  */
-proto.wsman.PortSpec.prototype.setVisibility = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.wsman.WorkspaceConditions.prototype.getNodePreempted = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
 };
 
 
 /**
- * optional string url = 4;
- * @return {string}
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.PortSpec.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.wsman.WorkspaceConditions.prototype.setNodePreempted = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.PortSpec} returns this
+ * optional StopReason stop_reason = 16;
+ * @return {!proto.wsman.StopReason}
  */
-proto.wsman.PortSpec.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.wsman.WorkspaceConditions.prototype.getStopReason = function() {
+  return /** @type {!proto.wsman.StopReason} */ (jspb.Message.getFieldWithDefault(this, 16, 0));
 };
 
 
 /**
- * optional PortProtocol protocol = 5;
- * @return {!proto.wsman.PortProtocol}
+ * @param {!proto.wsman.StopReason} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.PortSpec.prototype.getProtocol = function() {
-  return /** @type {!proto.wsman.PortProtocol} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.wsman.WorkspaceConditions.prototype.setStopReason = function(value) {
+  return jspb.Message.setProto3EnumField(this, 16, value);
 };
 
 
 /**
- * @param {!proto.wsman.PortProtocol} value
- * @return {!proto.wsman.PortSpec} returns this
+ * optional google.protobuf.Timestamp content_ready = 17;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.wsman.PortSpec.prototype.setProtocol = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.wsman.WorkspaceConditions.prototype.getContentReady = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 17));
 };
 
 
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+*/
+proto.wsman.WorkspaceConditions.prototype.setContentReady = function(value) {
+  return jspb.Message.setWrapperField(this, 17, value);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.VolumeSnapshotInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.VolumeSnapshotInfo.toObject(opt_includeInstance, this);
+proto.wsman.WorkspaceConditions.prototype.clearContentReady = function() {
+  return this.setContentReady(undefined);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.wsman.VolumeSnapshotInfo} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.VolumeSnapshotInfo.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    volumeSnapshotName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    volumeSnapshotHandle: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.wsman.WorkspaceConditions.prototype.hasContentReady = function() {
+  return jspb.Message.getField(this, 17) != null;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.VolumeSnapshotInfo}
+ * optional google.protobuf.Timestamp ever_ready = 18;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.wsman.VolumeSnapshotInfo.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.VolumeSnapshotInfo;
-  return proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader(msg, reader);
+proto.wsman.WorkspaceConditions.prototype.getEverReady = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 18));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.wsman.VolumeSnapshotInfo} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.VolumeSnapshotInfo}
- */
-proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setVolumeSnapshotName(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setVolumeSnapshotHandle(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceConditions} returns this
+*/
+proto.wsman.WorkspaceConditions.prototype.setEverReady = function(value) {
+  return jspb.Message.setWrapperField(this, 18, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.VolumeSnapshotInfo.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.wsman.WorkspaceConditions.prototype.clearEverReady = function() {
+  return this.setEverReady(undefined);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.VolumeSnapshotInfo} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getVolumeSnapshotName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = message.getVolumeSnapshotHandle();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.wsman.WorkspaceConditions.prototype.hasEverReady = function() {
+  return jspb.Message.getField(this, 18) != null;
 };
 
 
 /**
- * optional string volume_snapshot_name = 1;
+ * optional string oom_kill = 19;
  * @return {string}
  */
-proto.wsman.VolumeSnapshotInfo.prototype.getVolumeSnapshotName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.WorkspaceConditions.prototype.getOomKill = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.VolumeSnapshotInfo} returns this
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.VolumeSnapshotInfo.prototype.setVolumeSnapshotName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.WorkspaceConditions.prototype.setOomKill = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
 };
 
 
 /**
- * optional string volume_snapshot_handle = 2;
+ * optional string relocating = 20;
  * @return {string}
  */
-proto.wsman.VolumeSnapshotInfo.prototype.getVolumeSnapshotHandle = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.WorkspaceConditions.prototype.getRelocating = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.VolumeSnapshotInfo} returns this
+ * @return {!proto.wsman.WorkspaceConditions} returns this
  */
-proto.wsman.VolumeSnapshotInfo.prototype.setVolumeSnapshotHandle = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.wsman.WorkspaceConditions.prototype.setRelocating = function(value) {
+  return jspb.Message.setProto3StringField(this, 20, value);
 };
 
 
@@ -6781,8 +14242,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceConditions.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceConditions.toObject(opt_includeInstance, this);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.HeadlessWorkspaceCompletion.toObject(opt_includeInstance, this);
 };
 
 
@@ -6791,24 +14252,22 @@ proto.wsman.WorkspaceConditions.prototype.toObject = function(opt_includeInstanc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceConditions} msg The msg instance to transform.
+ * @param {!proto.wsman.HeadlessWorkspaceCompletion} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceConditions.toObject = function(includeInstance, msg) {
+proto.wsman.HeadlessWorkspaceCompletion.toObject = function(includeInstance, msg) {
   var f, obj = {
-    failed: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    timeout: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    pullingImages: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    snapshot: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    finalBackupComplete: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    deployed: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    networkNotReady: jspb.Message.getFieldWithDefault(msg, 8, 0),
-    firstUserActivity: (f = msg.getFirstUserActivity()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
-    headlessTaskFailed: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    stoppedByRequest: jspb.Message.getFieldWithDefault(msg, 11, 0),
-    volumeSnapshot: (f = msg.getVolumeSnapshot()) && proto.wsman.VolumeSnapshotInfo.toObject(includeInstance, f),
-    aborted: jspb.Message.getFieldWithDefault(msg, 13, 0)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    metadata: (f = msg.getMetadata()) && proto.wsman.WorkspaceMetadata.toObject(includeInstance, f),
+    type: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    success: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    failed: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    headlessTaskFailed: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    exitCode: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    snapshot: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    stopReason: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    completedAt: (f = msg.getCompletedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -6822,23 +14281,23 @@ proto.wsman.WorkspaceConditions.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceConditions}
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion}
  */
-proto.wsman.WorkspaceConditions.deserializeBinary = function(bytes) {
+proto.wsman.HeadlessWorkspaceCompletion.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceConditions;
-  return proto.wsman.WorkspaceConditions.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.HeadlessWorkspaceCompletion;
+  return proto.wsman.HeadlessWorkspaceCompletion.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceConditions} msg The message object to deserialize into.
+ * @param {!proto.wsman.HeadlessWorkspaceCompletion} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceConditions}
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion}
  */
-proto.wsman.WorkspaceConditions.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.HeadlessWorkspaceCompletion.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6847,53 +14306,45 @@ proto.wsman.WorkspaceConditions.deserializeBinaryFromReader = function(msg, read
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFailed(value);
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setTimeout(value);
+      var value = new proto.wsman.WorkspaceMetadata;
+      reader.readMessage(value,proto.wsman.WorkspaceMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
       break;
     case 3:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setPullingImages(value);
+      var value = /** @type {!proto.wsman.WorkspaceType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSuccess(value);
       break;
     case 5:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSnapshot(value);
+      msg.setFailed(value);
       break;
     case 6:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setFinalBackupComplete(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHeadlessTaskFailed(value);
       break;
     case 7:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setDeployed(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
       break;
     case 8:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setNetworkNotReady(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSnapshot(value);
       break;
     case 9:
-      var value = new google_protobuf_timestamp_pb.Timestamp;
-      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
-      msg.setFirstUserActivity(value);
+      var value = /** @type {!proto.wsman.StopReason} */ (reader.readEnum());
+      msg.setStopReason(value);
       break;
     case 10:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setHeadlessTaskFailed(value);
-      break;
-    case 11:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setStoppedByRequest(value);
-      break;
-    case 12:
-      var value = new proto.wsman.VolumeSnapshotInfo;
-      reader.readMessage(value,proto.wsman.VolumeSnapshotInfo.deserializeBinaryFromReader);
-      msg.setVolumeSnapshot(value);
-      break;
-    case 13:
-      var value = /** @type {!proto.wsman.WorkspaceConditionBool} */ (reader.readEnum());
-      msg.setAborted(value);
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCompletedAt(value);
       break;
     default:
       reader.skipField();
@@ -6908,9 +14359,9 @@ proto.wsman.WorkspaceConditions.deserializeBinaryFromReader = function(msg, read
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceConditions.prototype.serializeBinary = function() {
+proto.wsman.HeadlessWorkspaceCompletion.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceConditions.serializeBinaryToWriter(this, writer);
+  proto.wsman.HeadlessWorkspaceCompletion.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6918,252 +14369,238 @@ proto.wsman.WorkspaceConditions.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceConditions} message
+ * @param {!proto.wsman.HeadlessWorkspaceCompletion} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceConditions.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.HeadlessWorkspaceCompletion.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFailed();
+  f = message.getId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getTimeout();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getPullingImages();
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.wsman.WorkspaceMetadata.serializeBinaryToWriter
+    );
+  }
+  f = message.getType();
   if (f !== 0.0) {
     writer.writeEnum(
       3,
       f
     );
   }
-  f = message.getSnapshot();
+  f = message.getSuccess();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getFailed();
   if (f.length > 0) {
     writer.writeString(
       5,
       f
     );
   }
-  f = message.getFinalBackupComplete();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getHeadlessTaskFailed();
+  if (f.length > 0) {
+    writer.writeString(
       6,
       f
     );
   }
-  f = message.getDeployed();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeInt32(
       7,
       f
     );
   }
-  f = message.getNetworkNotReady();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      8,
-      f
-    );
-  }
-  f = message.getFirstUserActivity();
-  if (f != null) {
-    writer.writeMessage(
-      9,
-      f,
-      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
-    );
-  }
-  f = message.getHeadlessTaskFailed();
+  f = message.getSnapshot();
   if (f.length > 0) {
     writer.writeString(
-      10,
+      8,
       f
     );
   }
-  f = message.getStoppedByRequest();
+  f = message.getStopReason();
   if (f !== 0.0) {
     writer.writeEnum(
-      11,
+      9,
       f
     );
   }
-  f = message.getVolumeSnapshot();
+  f = message.getCompletedAt();
   if (f != null) {
     writer.writeMessage(
-      12,
+      10,
       f,
-      proto.wsman.VolumeSnapshotInfo.serializeBinaryToWriter
-    );
-  }
-  f = message.getAborted();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      13,
-      f
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string failed = 1;
+ * optional string id = 1;
  * @return {string}
  */
-proto.wsman.WorkspaceConditions.prototype.getFailed = function() {
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.setFailed = function(value) {
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string timeout = 2;
- * @return {string}
+ * optional WorkspaceMetadata metadata = 2;
+ * @return {?proto.wsman.WorkspaceMetadata}
  */
-proto.wsman.WorkspaceConditions.prototype.getTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getMetadata = function() {
+  return /** @type{?proto.wsman.WorkspaceMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.WorkspaceMetadata, 2));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
- */
-proto.wsman.WorkspaceConditions.prototype.setTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+ * @param {?proto.wsman.WorkspaceMetadata|undefined} value
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
+*/
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
 };
 
 
 /**
- * optional WorkspaceConditionBool pulling_images = 3;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.getPullingImages = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.wsman.WorkspaceConditions.prototype.setPullingImages = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 2) != null;
 };
 
 
 /**
- * optional string snapshot = 5;
- * @return {string}
+ * optional WorkspaceType type = 3;
+ * @return {!proto.wsman.WorkspaceType}
  */
-proto.wsman.WorkspaceConditions.prototype.getSnapshot = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getType = function() {
+  return /** @type {!proto.wsman.WorkspaceType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @param {!proto.wsman.WorkspaceType} value
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.setSnapshot = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
 };
 
 
 /**
- * optional WorkspaceConditionBool final_backup_complete = 6;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * optional bool success = 4;
+ * @return {boolean}
  */
-proto.wsman.WorkspaceConditions.prototype.getFinalBackupComplete = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getSuccess = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @param {boolean} value
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.setFinalBackupComplete = function(value) {
-  return jspb.Message.setProto3EnumField(this, 6, value);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setSuccess = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 4, value);
 };
 
 
 /**
- * optional WorkspaceConditionBool deployed = 7;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * optional string failed = 5;
+ * @return {string}
  */
-proto.wsman.WorkspaceConditions.prototype.getDeployed = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getFailed = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @param {string} value
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.setDeployed = function(value) {
-  return jspb.Message.setProto3EnumField(this, 7, value);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setFailed = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * optional WorkspaceConditionBool network_not_ready = 8;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * optional string headless_task_failed = 6;
+ * @return {string}
  */
-proto.wsman.WorkspaceConditions.prototype.getNetworkNotReady = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getHeadlessTaskFailed = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @param {string} value
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.setNetworkNotReady = function(value) {
-  return jspb.Message.setProto3EnumField(this, 8, value);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setHeadlessTaskFailed = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * optional google.protobuf.Timestamp first_user_activity = 9;
- * @return {?proto.google.protobuf.Timestamp}
+ * optional int32 exit_code = 7;
+ * @return {number}
  */
-proto.wsman.WorkspaceConditions.prototype.getFirstUserActivity = function() {
-  return /** @type{?proto.google.protobuf.Timestamp} */ (
-    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 9));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {?proto.google.protobuf.Timestamp|undefined} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
-*/
-proto.wsman.WorkspaceConditions.prototype.setFirstUserActivity = function(value) {
-  return jspb.Message.setWrapperField(this, 9, value);
+ * @param {number} value
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
+ */
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setExitCode = function(value) {
+  return jspb.Message.setField(this, 7, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.clearFirstUserActivity = function() {
-  return this.setFirstUserActivity(undefined);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.clearExitCode = function() {
+  return jspb.Message.setField(this, 7, undefined);
 };
 
 
@@ -7171,72 +14608,72 @@ proto.wsman.WorkspaceConditions.prototype.clearFirstUserActivity = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.WorkspaceConditions.prototype.hasFirstUserActivity = function() {
-  return jspb.Message.getField(this, 9) != null;
+proto.wsman.HeadlessWorkspaceCompletion.prototype.hasExitCode = function() {
+  return jspb.Message.getField(this, 7) != null;
 };
 
 
 /**
- * optional string headless_task_failed = 10;
+ * optional string snapshot = 8;
  * @return {string}
  */
-proto.wsman.WorkspaceConditions.prototype.getHeadlessTaskFailed = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getSnapshot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.setHeadlessTaskFailed = function(value) {
-  return jspb.Message.setProto3StringField(this, 10, value);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setSnapshot = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
 };
 
 
 /**
- * optional WorkspaceConditionBool stopped_by_request = 11;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * optional StopReason stop_reason = 9;
+ * @return {!proto.wsman.StopReason}
  */
-proto.wsman.WorkspaceConditions.prototype.getStoppedByRequest = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 11, 0));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getStopReason = function() {
+  return /** @type {!proto.wsman.StopReason} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @param {!proto.wsman.StopReason} value
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.setStoppedByRequest = function(value) {
-  return jspb.Message.setProto3EnumField(this, 11, value);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setStopReason = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
 };
 
 
 /**
- * optional VolumeSnapshotInfo volume_snapshot = 12;
- * @return {?proto.wsman.VolumeSnapshotInfo}
+ * optional google.protobuf.Timestamp completed_at = 10;
+ * @return {?proto.google.protobuf.Timestamp}
  */
-proto.wsman.WorkspaceConditions.prototype.getVolumeSnapshot = function() {
-  return /** @type{?proto.wsman.VolumeSnapshotInfo} */ (
-    jspb.Message.getWrapperField(this, proto.wsman.VolumeSnapshotInfo, 12));
+proto.wsman.HeadlessWorkspaceCompletion.prototype.getCompletedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 10));
 };
 
 
 /**
- * @param {?proto.wsman.VolumeSnapshotInfo|undefined} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
 */
-proto.wsman.WorkspaceConditions.prototype.setVolumeSnapshot = function(value) {
-  return jspb.Message.setWrapperField(this, 12, value);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.setCompletedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
 };
 
 
 /**
  * Clears the message field making it undefined.
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * @return {!proto.wsman.HeadlessWorkspaceCompletion} returns this
  */
-proto.wsman.WorkspaceConditions.prototype.clearVolumeSnapshot = function() {
-  return this.setVolumeSnapshot(undefined);
+proto.wsman.HeadlessWorkspaceCompletion.prototype.clearCompletedAt = function() {
+  return this.setCompletedAt(undefined);
 };
 
 
@@ -7244,26 +14681,109 @@ proto.wsman.WorkspaceConditions.prototype.clearVolumeSnapshot = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.WorkspaceConditions.prototype.hasVolumeSnapshot = function() {
-  return jspb.Message.getField(this, 12) != null;
+proto.wsman.HeadlessWorkspaceCompletion.prototype.hasCompletedAt = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceCompletedResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceCompletedResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceCompletedResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceCompletedResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceCompletedResponse}
+ */
+proto.wsman.WorkspaceCompletedResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceCompletedResponse;
+  return proto.wsman.WorkspaceCompletedResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceCompletedResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceCompletedResponse}
+ */
+proto.wsman.WorkspaceCompletedResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional WorkspaceConditionBool aborted = 13;
- * @return {!proto.wsman.WorkspaceConditionBool}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceConditions.prototype.getAborted = function() {
-  return /** @type {!proto.wsman.WorkspaceConditionBool} */ (jspb.Message.getFieldWithDefault(this, 13, 0));
+proto.wsman.WorkspaceCompletedResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceCompletedResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {!proto.wsman.WorkspaceConditionBool} value
- * @return {!proto.wsman.WorkspaceConditions} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceCompletedResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceConditions.prototype.setAborted = function(value) {
-  return jspb.Message.setProto3EnumField(this, 13, value);
+proto.wsman.WorkspaceCompletedResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
@@ -7554,47 +15074,288 @@ proto.wsman.WorkspaceMetadata.prototype.setTeam = function(value) {
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * Clears the field making it undefined.
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.clearTeam = function() {
+  return jspb.Message.setField(this, 5, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceMetadata.prototype.hasTeam = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional string project = 6;
+ * @return {string}
+ */
+proto.wsman.WorkspaceMetadata.prototype.getProject = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.setProject = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.wsman.WorkspaceMetadata} returns this
+ */
+proto.wsman.WorkspaceMetadata.prototype.clearProject = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceMetadata.prototype.hasProject = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.WorkspaceRuntimeInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.wsman.WorkspaceRuntimeInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceRuntimeInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    nodeName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    podName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    nodeIp: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    gpu: (f = msg.getGpu()) && proto.wsman.GPUAllocation.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.wsman.WorkspaceRuntimeInfo}
+ */
+proto.wsman.WorkspaceRuntimeInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.wsman.WorkspaceRuntimeInfo;
+  return proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.wsman.WorkspaceRuntimeInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.wsman.WorkspaceRuntimeInfo}
+ */
+proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNodeName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPodName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNodeIp(value);
+      break;
+    case 4:
+      var value = new proto.wsman.GPUAllocation;
+      reader.readMessage(value,proto.wsman.GPUAllocation.deserializeBinaryFromReader);
+      msg.setGpu(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.wsman.WorkspaceRuntimeInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNodeName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPodName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getNodeIp();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getGpu();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.wsman.GPUAllocation.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string node_name = 1;
+ * @return {string}
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.getNodeName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.setNodeName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string pod_name = 2;
+ * @return {string}
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.getPodName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ */
+proto.wsman.WorkspaceRuntimeInfo.prototype.setPodName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string node_ip = 3;
+ * @return {string}
  */
-proto.wsman.WorkspaceMetadata.prototype.clearTeam = function() {
-  return jspb.Message.setField(this, 5, undefined);
+proto.wsman.WorkspaceRuntimeInfo.prototype.getNodeIp = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.hasTeam = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.wsman.WorkspaceRuntimeInfo.prototype.setNodeIp = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional string project = 6;
- * @return {string}
+ * optional GPUAllocation gpu = 4;
+ * @return {?proto.wsman.GPUAllocation}
  */
-proto.wsman.WorkspaceMetadata.prototype.getProject = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.wsman.WorkspaceRuntimeInfo.prototype.getGpu = function() {
+  return /** @type{?proto.wsman.GPUAllocation} */ (
+    jspb.Message.getWrapperField(this, proto.wsman.GPUAllocation, 4));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceMetadata} returns this
- */
-proto.wsman.WorkspaceMetadata.prototype.setProject = function(value) {
-  return jspb.Message.setField(this, 6, value);
+ * @param {?proto.wsman.GPUAllocation|undefined} value
+ * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+*/
+proto.wsman.WorkspaceRuntimeInfo.prototype.setGpu = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.wsman.WorkspaceMetadata} returns this
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
  */
-proto.wsman.WorkspaceMetadata.prototype.clearProject = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.wsman.WorkspaceRuntimeInfo.prototype.clearGpu = function() {
+  return this.setGpu(undefined);
 };
 
 
@@ -7602,8 +15363,8 @@ proto.wsman.WorkspaceMetadata.prototype.clearProject = function() {
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.wsman.WorkspaceMetadata.prototype.hasProject = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.wsman.WorkspaceRuntimeInfo.prototype.hasGpu = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -7623,8 +15384,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.wsman.WorkspaceRuntimeInfo.toObject(opt_includeInstance, this);
+proto.wsman.GPUAllocation.prototype.toObject = function(opt_includeInstance) {
+  return proto.wsman.GPUAllocation.toObject(opt_includeInstance, this);
 };
 
 
@@ -7633,15 +15394,15 @@ proto.wsman.WorkspaceRuntimeInfo.prototype.toObject = function(opt_includeInstan
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.wsman.WorkspaceRuntimeInfo} msg The msg instance to transform.
+ * @param {!proto.wsman.GPUAllocation} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceRuntimeInfo.toObject = function(includeInstance, msg) {
+proto.wsman.GPUAllocation.toObject = function(includeInstance, msg) {
   var f, obj = {
-    nodeName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    podName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    nodeIp: jspb.Message.getFieldWithDefault(msg, 3, "")
+    count: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    resourceName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    product: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -7655,23 +15416,23 @@ proto.wsman.WorkspaceRuntimeInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.wsman.WorkspaceRuntimeInfo}
+ * @return {!proto.wsman.GPUAllocation}
  */
-proto.wsman.WorkspaceRuntimeInfo.deserializeBinary = function(bytes) {
+proto.wsman.GPUAllocation.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.wsman.WorkspaceRuntimeInfo;
-  return proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.wsman.GPUAllocation;
+  return proto.wsman.GPUAllocation.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.wsman.WorkspaceRuntimeInfo} msg The message object to deserialize into.
+ * @param {!proto.wsman.GPUAllocation} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.wsman.WorkspaceRuntimeInfo}
+ * @return {!proto.wsman.GPUAllocation}
  */
-proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.wsman.GPUAllocation.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7679,16 +15440,16 @@ proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader = function(msg, rea
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setNodeName(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCount(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPodName(value);
+      msg.setResourceName(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setNodeIp(value);
+      msg.setProduct(value);
       break;
     default:
       reader.skipField();
@@ -7703,9 +15464,9 @@ proto.wsman.WorkspaceRuntimeInfo.deserializeBinaryFromReader = function(msg, rea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.serializeBinary = function() {
+proto.wsman.GPUAllocation.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter(this, writer);
+  proto.wsman.GPUAllocation.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7713,27 +15474,27 @@ proto.wsman.WorkspaceRuntimeInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.wsman.WorkspaceRuntimeInfo} message
+ * @param {!proto.wsman.GPUAllocation} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter = function(message, writer) {
+proto.wsman.GPUAllocation.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getNodeName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getCount();
+  if (f !== 0) {
+    writer.writeInt64(
       1,
       f
     );
   }
-  f = message.getPodName();
+  f = message.getResourceName();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getNodeIp();
+  f = message.getProduct();
   if (f.length > 0) {
     writer.writeString(
       3,
@@ -7744,55 +15505,55 @@ proto.wsman.WorkspaceRuntimeInfo.serializeBinaryToWriter = function(message, wri
 
 
 /**
- * optional string node_name = 1;
- * @return {string}
+ * optional int64 count = 1;
+ * @return {number}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.getNodeName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.wsman.GPUAllocation.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ * @param {number} value
+ * @return {!proto.wsman.GPUAllocation} returns this
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.setNodeName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.wsman.GPUAllocation.prototype.setCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string pod_name = 2;
+ * optional string resource_name = 2;
  * @return {string}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.getPodName = function() {
+proto.wsman.GPUAllocation.prototype.getResourceName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ * @return {!proto.wsman.GPUAllocation} returns this
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.setPodName = function(value) {
+proto.wsman.GPUAllocation.prototype.setResourceName = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string node_ip = 3;
+ * optional string product = 3;
  * @return {string}
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.getNodeIp = function() {
+proto.wsman.GPUAllocation.prototype.getProduct = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.wsman.WorkspaceRuntimeInfo} returns this
+ * @return {!proto.wsman.GPUAllocation} returns this
  */
-proto.wsman.WorkspaceRuntimeInfo.prototype.setNodeIp = function(value) {
+proto.wsman.GPUAllocation.prototype.setProduct = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
@@ -7830,7 +15591,8 @@ proto.wsman.WorkspaceAuthentication.prototype.toObject = function(opt_includeIns
 proto.wsman.WorkspaceAuthentication.toObject = function(includeInstance, msg) {
   var f, obj = {
     admission: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, "")
+    ownerToken: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    admissionExpiry: (f = msg.getAdmissionExpiry()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -7875,6 +15637,11 @@ proto.wsman.WorkspaceAuthentication.deserializeBinaryFromReader = function(msg,
       var value = /** @type {string} */ (reader.readString());
       msg.setOwnerToken(value);
       break;
+    case 3:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setAdmissionExpiry(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -7918,6 +15685,14 @@ proto.wsman.WorkspaceAuthentication.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getAdmissionExpiry();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -7957,13 +15732,50 @@ proto.wsman.WorkspaceAuthentication.prototype.setOwnerToken = function(value) {
 };
 
 
+/**
+ * optional google.protobuf.Timestamp admission_expiry = 3;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.wsman.WorkspaceAuthentication.prototype.getAdmissionExpiry = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.wsman.WorkspaceAuthentication} returns this
+*/
+proto.wsman.WorkspaceAuthentication.prototype.setAdmissionExpiry = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.wsman.WorkspaceAuthentication} returns this
+ */
+proto.wsman.WorkspaceAuthentication.prototype.clearAdmissionExpiry = function() {
+  return this.setAdmissionExpiry(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.wsman.WorkspaceAuthentication.prototype.hasAdmissionExpiry = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.wsman.StartWorkspaceSpec.repeatedFields_ = [3,5,6,15,16,17];
+proto.wsman.StartWorkspaceSpec.repeatedFields_ = [3,5,6,15,16,17,20];
 
 
 
@@ -8014,7 +15826,11 @@ proto.wsman.StartWorkspaceSpec.toObject = function(includeInstance, msg) {
     proto.wsman.EnvironmentVariable.toObject, includeInstance),
     ideImageLayersList: (f = jspb.Message.getRepeatedField(msg, 17)) == null ? undefined : f,
     closedTimeout: jspb.Message.getFieldWithDefault(msg, 18, ""),
-    maximumLifetime: jspb.Message.getFieldWithDefault(msg, 19, "")
+    maximumLifetime: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    sidecarsList: (f = jspb.Message.getRepeatedField(msg, 20)) == null ? undefined : f,
+    openPullRequest: jspb.Message.getBooleanFieldWithDefault(msg, 21, false),
+    clockOffset: jspb.Message.getFieldWithDefault(msg, 22, ""),
+    ephemeral: jspb.Message.getBooleanFieldWithDefault(msg, 23, false)
   };
 
   if (includeInstance) {
@@ -8123,6 +15939,22 @@ proto.wsman.StartWorkspaceSpec.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {string} */ (reader.readString());
       msg.setMaximumLifetime(value);
       break;
+    case 20:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addSidecars(value);
+      break;
+    case 21:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOpenPullRequest(value);
+      break;
+    case 22:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setClockOffset(value);
+      break;
+    case 23:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setEphemeral(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8270,6 +16102,34 @@ proto.wsman.StartWorkspaceSpec.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getSidecarsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      20,
+      f
+    );
+  }
+  f = message.getOpenPullRequest();
+  if (f) {
+    writer.writeBool(
+      21,
+      f
+    );
+  }
+  f = message.getClockOffset();
+  if (f.length > 0) {
+    writer.writeString(
+      22,
+      f
+    );
+  }
+  f = message.getEphemeral();
+  if (f) {
+    writer.writeBool(
+      23,
+      f
+    );
+  }
 };
 
 
@@ -8735,6 +16595,97 @@ proto.wsman.StartWorkspaceSpec.prototype.setMaximumLifetime = function(value) {
 };
 
 
+/**
+ * repeated string sidecars = 20;
+ * @return {!Array<string>}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getSidecarsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 20));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setSidecarsList = function(value) {
+  return jspb.Message.setField(this, 20, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.addSidecars = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 20, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.clearSidecarsList = function() {
+  return this.setSidecarsList([]);
+};
+
+
+/**
+ * optional bool open_pull_request = 21;
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getOpenPullRequest = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 21, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setOpenPullRequest = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 21, value);
+};
+
+
+/**
+ * optional string clock_offset = 22;
+ * @return {string}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getClockOffset = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 22, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setClockOffset = function(value) {
+  return jspb.Message.setProto3StringField(this, 22, value);
+};
+
+
+/**
+ * optional bool ephemeral = 23;
+ * @return {boolean}
+ */
+proto.wsman.StartWorkspaceSpec.prototype.getEphemeral = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 23, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.wsman.StartWorkspaceSpec} returns this
+ */
+proto.wsman.StartWorkspaceSpec.prototype.setEphemeral = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 23, value);
+};
+
+
 
 
 
@@ -9908,7 +17859,8 @@ proto.wsman.WorkspaceClass.toObject = function(includeInstance, msg) {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
     displayName: jspb.Message.getFieldWithDefault(msg, 2, ""),
     description: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    creditsPerMinute: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0)
+    creditsPerMinute: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    gpuCount: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -9961,6 +17913,10 @@ proto.wsman.WorkspaceClass.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readFloat());
       msg.setCreditsPerMinute(value);
       break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setGpuCount(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -10018,6 +17974,13 @@ proto.wsman.WorkspaceClass.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getGpuCount();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -10093,6 +18056,24 @@ proto.wsman.WorkspaceClass.prototype.setCreditsPerMinute = function(value) {
 };
 
 
+/**
+ * optional int64 gpu_count = 5;
+ * @return {number}
+ */
+proto.wsman.WorkspaceClass.prototype.getGpuCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.wsman.WorkspaceClass} returns this
+ */
+proto.wsman.WorkspaceClass.prototype.setGpuCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
 /**
  * @enum {number}
  */
@@ -10102,6 +18083,17 @@ proto.wsman.StopWorkspacePolicy = {
   ABORT: 2
 };
 
+/**
+ * @enum {number}
+ */
+proto.wsman.ActivitySource = {
+  ACTIVITY_SOURCE_UNSPECIFIED: 0,
+  ACTIVITY_SOURCE_IDE_HEARTBEAT: 1,
+  ACTIVITY_SOURCE_PORT_TRAFFIC: 2,
+  ACTIVITY_SOURCE_TERMINAL_INPUT: 3,
+  ACTIVITY_SOURCE_API_CALL: 4
+};
+
 /**
  * @enum {number}
  */
@@ -10110,6 +18102,15 @@ proto.wsman.TimeoutType = {
   CLOSED_TIMEOUT: 1
 };
 
+/**
+ * @enum {number}
+ */
+proto.wsman.RestoreConflictPolicy = {
+  RESTORE_CONFLICT_FAIL: 0,
+  RESTORE_CONFLICT_SKIP: 1,
+  RESTORE_CONFLICT_OVERWRITE: 2
+};
+
 /**
  * @enum {number}
  */
@@ -10131,7 +18132,22 @@ proto.wsman.PortVisibility = {
  */
 proto.wsman.PortProtocol = {
   PORT_PROTOCOL_HTTP: 0,
-  PORT_PROTOCOL_HTTPS: 1
+  PORT_PROTOCOL_HTTPS: 1,
+  PORT_PROTOCOL_TCP: 2
+};
+
+/**
+ * @enum {number}
+ */
+proto.wsman.StopReason = {
+  STOP_REASON_UNSPECIFIED: 0,
+  STOP_REASON_TIMEOUT_INACTIVITY: 1,
+  STOP_REASON_USER_REQUESTED: 2,
+  STOP_REASON_ADMIN_STOPPED: 3,
+  STOP_REASON_NODE_FAILURE: 4,
+  STOP_REASON_BACKUP_FAILURE: 5,
+  STOP_REASON_AGENT_SMITH: 6,
+  STOP_REASON_OOM_KILLED: 7
 };
 
 /**
@@ -10149,6 +18165,7 @@ proto.wsman.WorkspaceConditionBool = {
 proto.wsman.WorkspacePhase = {
   UNKNOWN: 0,
   PENDING: 1,
+  PENDING_QUEUE: 8,
   CREATING: 2,
   INITIALIZING: 3,
   RUNNING: 4,
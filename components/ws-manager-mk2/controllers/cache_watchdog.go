@@ -0,0 +1,267 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	// cacheWatchdogInterval is how often we compare the informer cache against the API server
+	cacheWatchdogInterval = 5 * time.Minute
+
+	// cacheWatchdogPageSize is the page size of the LIST requests against the API server
+	cacheWatchdogPageSize = 500
+
+	// cacheResyncAnnotation is set on objects whose update or creation the cache missed, so that the API server
+	// sends a fresh watch event with the current state of the object
+	cacheResyncAnnotation = "gitpod.io/cacheResync"
+
+	cacheDesyncTotal string = "cache_desync_total"
+)
+
+// cacheDesync is the way the cache is out of sync with the API server for an object
+type cacheDesync string
+
+const (
+	cacheDesyncMissedAdd    cacheDesync = "missed_add"
+	cacheDesyncMissedUpdate cacheDesync = "missed_update"
+	cacheDesyncMissedDelete cacheDesync = "missed_delete"
+)
+
+// watchedResource is a resource whose cache we check
+type watchedResource struct {
+	Name    string
+	NewList func() client.ObjectList
+}
+
+var cacheWatchdogResources = []watchedResource{
+	{Name: "workspaces", NewList: func() client.ObjectList { return &workspacev1.WorkspaceList{} }},
+	{Name: "pods", NewList: func() client.ObjectList { return &corev1.PodList{} }},
+}
+
+type cacheObjectKey struct {
+	Resource string
+	Name     string
+}
+
+func NewCacheWatchdog(cache, api client.Reader, c client.Client, namespace string, reg prometheus.Registerer) (*CacheWatchdog, error) {
+	desyncs := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsWorkspaceSubsystem,
+		Name:      cacheDesyncTotal,
+		Help:      "Total number of objects the informer cache was found out of sync with the API server for",
+	}, []string{"resource", "type"})
+	err := reg.Register(desyncs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CacheWatchdog{
+		Cache:     cache,
+		API:       api,
+		Client:    c,
+		Namespace: namespace,
+		desyncs:   desyncs,
+		suspects:  make(map[cacheObjectKey]string),
+		phantoms:  make(map[cacheObjectKey]struct{}),
+	}, nil
+}
+
+// CacheWatchdog periodically compares the informer cache against a live LIST from the API server. Should the cache miss
+// watch events, e.g. after API server hiccups, we would act on stale objects, like phantom workspaces stuck in running.
+//
+// We resync objects whose creation or update the cache missed by touching them, which makes the API server send a fresh watch
+// event. There is nothing to touch for objects whose deletion the cache missed. Instead we fail the health check, so that we
+// get restarted and start over with a fresh cache.
+type CacheWatchdog struct {
+	Cache     client.Reader
+	API       client.Reader
+	Client    client.Client
+	Namespace string
+
+	desyncs *prometheus.CounterVec
+
+	mu sync.Mutex
+	// suspects are objects which were out of sync at the last check, with the resource version we found in the cache
+	suspects map[cacheObjectKey]string
+	// phantoms are objects which exist in the cache only
+	phantoms map[cacheObjectKey]struct{}
+}
+
+// Start implements manager.Runnable
+func (w *CacheWatchdog) Start(ctx context.Context) error {
+	ticker := time.NewTicker(cacheWatchdogInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.check(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. All replicas serve from their cache, hence all need checking it.
+func (w *CacheWatchdog) NeedLeaderElection() bool {
+	return false
+}
+
+// Check implements healthz.Checker and fails if the cache holds objects which no longer exist.
+func (w *CacheWatchdog) Check(_ *http.Request) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.phantoms) == 0 {
+		return nil
+	}
+	var phantoms []string
+	for k := range w.phantoms {
+		phantoms = append(phantoms, k.Resource+"/"+k.Name)
+	}
+	sort.Strings(phantoms)
+	return fmt.Errorf("cache missed the deletion of %s", strings.Join(phantoms, ", "))
+}
+
+func (w *CacheWatchdog) check(ctx context.Context) {
+	log := log.FromContext(ctx).WithName("cache-watchdog")
+
+	suspects := make(map[cacheObjectKey]string)
+	phantoms := make(map[cacheObjectKey]struct{})
+	for _, res := range cacheWatchdogResources {
+		// we list the cache first, so that objects created in between show up as missing from the cache rather than the API server
+		cached, err := w.list(ctx, w.Cache, res, false)
+		if err != nil {
+			log.Error(err, "cannot list cache", "resource", res.Name)
+			return
+		}
+		live, err := w.list(ctx, w.API, res, true)
+		if err != nil {
+			log.Error(err, "cannot list API server", "resource", res.Name)
+			return
+		}
+
+		for name, desync := range compareCache(cached, live) {
+			key := cacheObjectKey{Resource: res.Name, Name: name}
+			var cachedVersion string
+			if obj, ok := cached[name]; ok {
+				cachedVersion = obj.GetResourceVersion()
+			}
+			suspects[key] = cachedVersion
+
+			// The cache might just lag behind. Only if it still has the same version of an object at the next check, it missed an event.
+			w.mu.Lock()
+			prev, suspected := w.suspects[key]
+			_, known := w.phantoms[key]
+			w.mu.Unlock()
+			if !suspected || prev != cachedVersion {
+				continue
+			}
+			if !known {
+				w.desyncs.WithLabelValues(res.Name, string(desync)).Inc()
+			}
+
+			log.Info("cache is out of sync with the API server", "resource", res.Name, "name", name, "type", desync)
+			if desync == cacheDesyncMissedDelete {
+				phantoms[key] = struct{}{}
+				continue
+			}
+			// we touch the object every check until the cache has caught up
+			err = w.touch(ctx, live[name])
+			if err != nil && !errors.IsNotFound(err) {
+				log.Error(err, "cannot resync object", "resource", res.Name, "name", name)
+			}
+		}
+	}
+
+	w.mu.Lock()
+	w.suspects = suspects
+	w.phantoms = phantoms
+	w.mu.Unlock()
+}
+
+// list returns the objects of a resource by name. LISTs against the API server are paginated.
+func (w *CacheWatchdog) list(ctx context.Context, rdr client.Reader, res watchedResource, paginate bool) (map[string]client.Object, error) {
+	result := make(map[string]client.Object)
+	var cont string
+	for {
+		list := res.NewList()
+		opts := []client.ListOption{client.InNamespace(w.Namespace)}
+		if paginate {
+			opts = append(opts, client.Limit(cacheWatchdogPageSize), client.Continue(cont))
+		}
+		err := rdr.List(ctx, list, opts...)
+		if err != nil {
+			return nil, err
+		}
+		err = meta.EachListItem(list, func(o runtime.Object) error {
+			obj, ok := o.(client.Object)
+			if !ok {
+				return fmt.Errorf("unexpected list item %T", o)
+			}
+			result[obj.GetName()] = obj
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		cont = list.GetContinue()
+		if !paginate || cont == "" {
+			return result, nil
+		}
+	}
+}
+
+// touch annotates obj, which produces a watch event with its current state
+func (w *CacheWatchdog) touch(ctx context.Context, obj client.Object) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[cacheResyncAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	obj.SetAnnotations(annotations)
+	return w.Client.Patch(ctx, obj, patch)
+}
+
+// compareCache returns the objects whose resource version in the cache differs from the API server
+func compareCache(cached, live map[string]client.Object) map[string]cacheDesync {
+	res := make(map[string]cacheDesync)
+	for name, c := range cached {
+		l, ok := live[name]
+		if !ok {
+			res[name] = cacheDesyncMissedDelete
+			continue
+		}
+		if c.GetResourceVersion() != l.GetResourceVersion() {
+			res[name] = cacheDesyncMissedUpdate
+		}
+	}
+	for name := range live {
+		if _, ok := cached[name]; !ok {
+			res[name] = cacheDesyncMissedAdd
+		}
+	}
+	return res
+}
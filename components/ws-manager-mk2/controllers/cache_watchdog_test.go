@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestCompareCache(t *testing.T) {
+	obj := func(name, version string) client.Object {
+		return &workspacev1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: name, ResourceVersion: version}}
+	}
+	objs := func(o ...client.Object) map[string]client.Object {
+		res := make(map[string]client.Object)
+		for _, obj := range o {
+			res[obj.GetName()] = obj
+		}
+		return res
+	}
+
+	act := compareCache(
+		objs(obj("in-sync", "1"), obj("deleted", "1"), obj("updated", "1")),
+		objs(obj("in-sync", "1"), obj("updated", "2"), obj("added", "1")),
+	)
+	expectation := map[string]cacheDesync{
+		"deleted": cacheDesyncMissedDelete,
+		"updated": cacheDesyncMissedUpdate,
+		"added":   cacheDesyncMissedAdd,
+	}
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected desync (-want +got):\n%s", diff)
+	}
+}
+
+func TestCacheWatchdog(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	workspace := func(name string) *workspacev1.Workspace {
+		return &workspacev1.Workspace{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}}
+	}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "ws-in-sync", Namespace: "default"}}
+
+	// the cache missed the deletion of the phantom workspace and the creation of the new one
+	cache := fake.NewClientBuilder().WithScheme(scheme).WithObjects(workspace("in-sync"), workspace("phantom"), pod.DeepCopy()).Build()
+	api := fake.NewClientBuilder().WithScheme(scheme).WithObjects(workspace("in-sync"), workspace("new"), pod.DeepCopy()).Build()
+
+	w, err := NewCacheWatchdog(cache, api, api, "default", prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// the cache might just lag behind at the first check
+	w.check(ctx)
+	if err := w.Check(nil); err != nil {
+		t.Errorf("first check must not fail health check: %v", err)
+	}
+	if n := testutil.ToFloat64(w.desyncs.WithLabelValues("workspaces", string(cacheDesyncMissedAdd))); n != 0 {
+		t.Errorf("first check must not count desyncs, got %v", n)
+	}
+
+	w.check(ctx)
+	if err := w.Check(nil); err == nil {
+		t.Error("missed deletion must fail health check")
+	}
+	for desync, expectation := range map[cacheDesync]float64{
+		cacheDesyncMissedAdd:    1,
+		cacheDesyncMissedDelete: 1,
+		cacheDesyncMissedUpdate: 0,
+	} {
+		if n := testutil.ToFloat64(w.desyncs.WithLabelValues("workspaces", string(desync))); n != expectation {
+			t.Errorf("unexpected %s count: want %v, got %v", desync, expectation, n)
+		}
+	}
+	if n := testutil.ToFloat64(w.desyncs.WithLabelValues("pods", string(cacheDesyncMissedUpdate))); n != 0 {
+		t.Errorf("pods in sync must not count, got %v", n)
+	}
+
+	var ws workspacev1.Workspace
+	err = api.Get(ctx, client.ObjectKey{Namespace: "default", Name: "new"}, &ws)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ws.Annotations[cacheResyncAnnotation]; !ok {
+		t.Error("workspace the cache missed must be touched")
+	}
+
+	// a missed deletion is counted once only
+	w.check(ctx)
+	if n := testutil.ToFloat64(w.desyncs.WithLabelValues("workspaces", string(cacheDesyncMissedDelete))); n != 1 {
+		t.Errorf("missed deletion must be counted once, got %v", n)
+	}
+
+	err = cache.Delete(ctx, workspace("phantom"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.check(ctx)
+	if err := w.Check(nil); err != nil {
+		t.Errorf("health check must pass once the cache caught up: %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	// completionHooksRetryInterval is how long we wait before we notify the completion hooks again after they failed
+	completionHooksRetryInterval = 30 * time.Second
+	// completionHooksRetryTimeout is how long we keep on trying to notify the completion hooks before we give up
+	completionHooksRetryTimeout = 10 * time.Minute
+
+	completionHooksReasonNotified = "Notified"
+	completionHooksReasonFailed   = "NotificationFailed"
+	completionHooksReasonGaveUp   = "GaveUp"
+)
+
+// CompletionNotifier notifies external systems once a headless workspace completed
+type CompletionNotifier interface {
+	NotifyCompletion(ctx context.Context, ws *workspacev1.Workspace) error
+}
+
+// needsCompletionNotification returns true if the completion hooks have yet to learn about the stopped workspace
+func (r *WorkspaceReconciler) needsCompletionNotification(ws *workspacev1.Workspace) bool {
+	return r.CompletionHooks != nil &&
+		ws.IsHeadless() &&
+		ws.Status.Phase == workspacev1.WorkspacePhaseStopped &&
+		!ws.IsConditionTrue(workspacev1.WorkspaceConditionCompletionHooksNotified)
+}
+
+// notifyCompletionHooks notifies the completion hooks of a stopped headless workspace and records the outcome in the
+// CompletionHooksNotified condition. Notifications which fail are retried until completionHooksRetryTimeout, hence done is
+// false while the workspace must be kept around for another attempt.
+func (r *WorkspaceReconciler) notifyCompletionHooks(ctx context.Context, ws *workspacev1.Workspace) (done bool, err error) {
+	log := log.FromContext(ctx)
+
+	patch := client.MergeFrom(ws.DeepCopy())
+	notifyErr := r.CompletionHooks.NotifyCompletion(ctx, ws)
+	switch {
+	case notifyErr == nil:
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionCompletionHooksNotified(metav1.ConditionTrue, completionHooksReasonNotified, ""))
+		done = true
+
+	default:
+		cond := workspacev1.NewWorkspaceConditionCompletionHooksNotified(metav1.ConditionFalse, completionHooksReasonFailed, notifyErr.Error())
+		if prev := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionCompletionHooksNotified)); prev != nil && prev.Status == metav1.ConditionFalse {
+			// the transition time marks the first attempt which failed
+			cond.LastTransitionTime = prev.LastTransitionTime
+		}
+		if time.Since(cond.LastTransitionTime.Time) >= completionHooksRetryTimeout {
+			log.Error(notifyErr, "giving up on notifying completion hooks")
+			r.Recorder.Event(ws, corev1.EventTypeWarning, "CompletionHooksFailed", notifyErr.Error())
+			cond = workspacev1.NewWorkspaceConditionCompletionHooksNotified(metav1.ConditionTrue, completionHooksReasonGaveUp, notifyErr.Error())
+			done = true
+		}
+		ws.Status.SetCondition(cond)
+	}
+
+	err = r.Status().Patch(ctx, ws, patch)
+	if err != nil {
+		return false, err
+	}
+	return done, nil
+}
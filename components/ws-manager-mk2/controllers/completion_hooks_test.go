@@ -0,0 +1,144 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+)
+
+type fakeCompletionNotifier struct {
+	err   error
+	calls int
+}
+
+func (n *fakeCompletionNotifier) NotifyCompletion(ctx context.Context, ws *workspacev1.Workspace) error {
+	n.calls++
+	return n.err
+}
+
+func TestNotifyCompletionHooks(t *testing.T) {
+	type Expectation struct {
+		Done   bool
+		Status metav1.ConditionStatus
+		Reason string
+	}
+	tests := []struct {
+		Name        string
+		Err         error
+		Previous    *metav1.Condition
+		Expectation Expectation
+	}{
+		{
+			Name:        "notified",
+			Expectation: Expectation{Done: true, Status: metav1.ConditionTrue, Reason: completionHooksReasonNotified},
+		},
+		{
+			Name:        "first failure",
+			Err:         errors.New("unavailable"),
+			Expectation: Expectation{Status: metav1.ConditionFalse, Reason: completionHooksReasonFailed},
+		},
+		{
+			Name: "retry succeeds",
+			Previous: &metav1.Condition{
+				Type:               string(workspacev1.WorkspaceConditionCompletionHooksNotified),
+				Status:             metav1.ConditionFalse,
+				Reason:             completionHooksReasonFailed,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second)),
+			},
+			Expectation: Expectation{Done: true, Status: metav1.ConditionTrue, Reason: completionHooksReasonNotified},
+		},
+		{
+			Name: "retry fails",
+			Err:  errors.New("unavailable"),
+			Previous: &metav1.Condition{
+				Type:               string(workspacev1.WorkspaceConditionCompletionHooksNotified),
+				Status:             metav1.ConditionFalse,
+				Reason:             completionHooksReasonFailed,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second)),
+			},
+			Expectation: Expectation{Status: metav1.ConditionFalse, Reason: completionHooksReasonFailed},
+		},
+		{
+			Name: "gives up",
+			Err:  errors.New("unavailable"),
+			Previous: &metav1.Condition{
+				Type:               string(workspacev1.WorkspaceConditionCompletionHooksNotified),
+				Status:             metav1.ConditionFalse,
+				Reason:             completionHooksReasonFailed,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-completionHooksRetryTimeout)),
+			},
+			Expectation: Expectation{Done: true, Status: metav1.ConditionTrue, Reason: completionHooksReasonGaveUp},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ws := &workspacev1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+				Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypePrebuild},
+				Status:     workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseStopped},
+			}
+			if test.Previous != nil {
+				ws.Status.Conditions = []metav1.Condition{*test.Previous}
+			}
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(ws.DeepCopy()).Build()
+			notifier := &fakeCompletionNotifier{err: test.Err}
+			r := &WorkspaceReconciler{
+				Client:          clnt,
+				Recorder:        record.NewFakeRecorder(10),
+				CompletionHooks: notifier,
+			}
+			if !r.needsCompletionNotification(ws) {
+				t.Fatal("workspace does not need a completion notification")
+			}
+
+			done, err := r.notifyCompletionHooks(context.Background(), ws)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var act workspacev1.Workspace
+			err = clnt.Get(context.Background(), client.ObjectKeyFromObject(ws), &act)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cond := wsk8s.GetCondition(act.Status.Conditions, string(workspacev1.WorkspaceConditionCompletionHooksNotified))
+			if cond == nil {
+				t.Fatal("condition was not set")
+			}
+			if diff := cmp.Diff(test.Expectation, Expectation{Done: done, Status: cond.Status, Reason: cond.Reason}); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+			if test.Previous != nil && cond.Status == metav1.ConditionFalse && !cond.LastTransitionTime.Equal(&test.Previous.LastTransitionTime) {
+				t.Errorf("retry must keep the time of the first failure, got %v", cond.LastTransitionTime)
+			}
+			if notifier.calls != 1 {
+				t.Errorf("expected one notification, got %d", notifier.calls)
+			}
+			if done && r.needsCompletionNotification(&act) {
+				t.Error("workspace still needs a completion notification")
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package controllers
 import (
 	"context"
 	"crypto/rand"
+	"encoding/json"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -19,9 +20,14 @@ import (
 	"golang.org/x/xerrors"
 	"google.golang.org/protobuf/proto"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
@@ -41,6 +47,10 @@ const (
 	// headlessLabel marks a workspace as headless
 	headlessLabel = "gitpod.io/headless"
 
+	// networkPolicyLabel selects the NetworkPolicy which applies to a workspace pod. Pods labelled "default" are subject
+	// to the cluster-wide workspace policy, pods labelled "workspace" have a policy of their own.
+	networkPolicyLabel = "gitpod.io/networkpolicy"
+
 	// instanceIDLabel is added for the container dispatch mechanism in ws-daemon to work
 	// TODO(furisto): remove this label once we have moved ws-daemon to a controller setup
 	instanceIDLabel = "gitpod.io/instanceID"
@@ -62,11 +72,13 @@ type startWorkspaceContext struct {
 	IDEPort        int32             `json:"idePort"`
 	SupervisorPort int32             `json:"supervisorPort"`
 	Headless       bool              `json:"headless"`
+	// PodTemplatePatch is the strategic merge patch of the workspace class, if it has one
+	PodTemplatePatch []byte `json:"-"`
 }
 
 // createWorkspacePod creates the actual workspace pod based on the definite workspace pod and appropriate
 // templates. The result of this function is not expected to be modified prior to being passed to Kubernetes.
-func (r *WorkspaceReconciler) createWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error) {
+func createWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error) {
 	class, ok := sctx.Config.WorkspaceClasses[sctx.Workspace.Spec.Class]
 	if !ok {
 		return nil, xerrors.Errorf("unknown workspace class: %s", sctx.Workspace.Spec.Class)
@@ -104,9 +116,121 @@ func (r *WorkspaceReconciler) createWorkspacePod(sctx *startWorkspaceContext) (*
 	if err != nil {
 		return nil, xerrors.Errorf("cannot create workspace pod: %w", err)
 	}
+
+	if len(sctx.PodTemplatePatch) > 0 {
+		pod, err = applyPodTemplatePatch(pod, sctx.PodTemplatePatch)
+		if err != nil {
+			return nil, xerrors.Errorf("cannot apply pod template patch of workspace class %s: %w", sctx.Workspace.Spec.Class, err)
+		}
+	}
 	return pod, nil
 }
 
+// createWorkspaceNetworkPolicy renders the NetworkPolicy of a workspace from the template of its class.
+// Returns nil if the class has no network policy template, in which case the cluster-wide workspace policy applies.
+func createWorkspaceNetworkPolicy(sctx *startWorkspaceContext) (*networkingv1.NetworkPolicy, error) {
+	class, ok := sctx.Config.WorkspaceClasses[sctx.Workspace.Spec.Class]
+	if !ok {
+		return nil, xerrors.Errorf("unknown workspace class: %s", sctx.Workspace.Spec.Class)
+	}
+
+	tpl, err := config.GetNetworkPolicyTemplate(class.Templates.NetworkPolicyPath)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read network policy template - this is a configuration problem: %w", err)
+	}
+	if tpl == nil {
+		return nil, nil
+	}
+
+	labels := make(map[string]string, len(sctx.Labels))
+	for k, v := range sctx.Labels {
+		labels[k] = v
+	}
+
+	spec := tpl.Spec
+	spec.PodSelector = metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			wsk8s.WorkspaceIDLabel: sctx.Workspace.Name,
+		},
+	}
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        sctx.Workspace.Name,
+			Namespace:   sctx.Config.Namespace,
+			Labels:      labels,
+			Annotations: tpl.Annotations,
+		},
+		Spec: spec,
+	}, nil
+}
+
+// RenderWorkspacePod produces the pod the workspace controller would create for a workspace, without creating it.
+func RenderWorkspacePod(ctx context.Context, clnt client.Reader, cfg *config.Configuration, ws *workspacev1.Workspace) (*corev1.Pod, error) {
+	sctx, err := newStartWorkspaceContext(ctx, cfg, ws)
+	if err != nil {
+		return nil, err
+	}
+	sctx.PodTemplatePatch, err = loadPodTemplatePatch(ctx, clnt, cfg, ws.Spec.Class)
+	if err != nil {
+		return nil, err
+	}
+
+	return createWorkspacePod(sctx)
+}
+
+// loadPodTemplatePatch reads the pod template patch of a workspace class from its ConfigMap.
+// Returns nil if the class has no patch configured.
+func loadPodTemplatePatch(ctx context.Context, clnt client.Reader, cfg *config.Configuration, className string) ([]byte, error) {
+	class, ok := cfg.WorkspaceClasses[className]
+	if !ok || class.PodTemplatePatch == nil {
+		return nil, nil
+	}
+
+	var cm corev1.ConfigMap
+	err := clnt.Get(ctx, types.NamespacedName{Namespace: cfg.Namespace, Name: class.PodTemplatePatch.ConfigMap}, &cm)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot get pod template patch config map %s: %w", class.PodTemplatePatch.ConfigMap, err)
+	}
+	patch, ok := cm.Data[class.PodTemplatePatch.GetKey()]
+	if !ok {
+		return nil, xerrors.Errorf("pod template patch config map %s has no key %s", class.PodTemplatePatch.ConfigMap, class.PodTemplatePatch.GetKey())
+	}
+	return []byte(patch), nil
+}
+
+// applyPodTemplatePatch applies a strategic merge patch in YAML or JSON to a workspace pod. The patch must not
+// change the identity of the pod, i.e. its name, namespace or the labels ws-manager relies on.
+func applyPodTemplatePatch(pod *corev1.Pod, patch []byte) (*corev1.Pod, error) {
+	patchJSON, err := yaml.YAMLToJSON(patch)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot parse patch: %w", err)
+	}
+	original, err := json.Marshal(pod)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot marshal workspace pod: %w", err)
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, patchJSON, corev1.Pod{})
+	if err != nil {
+		return nil, xerrors.Errorf("cannot patch workspace pod: %w", err)
+	}
+
+	var res corev1.Pod
+	err = json.Unmarshal(patched, &res)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot unmarshal patched workspace pod: %w", err)
+	}
+
+	if res.Name != pod.Name || res.Namespace != pod.Namespace {
+		return nil, xerrors.Errorf("patch must not change the name or namespace of the pod")
+	}
+	for k, v := range pod.Labels {
+		if res.Labels[k] != v {
+			return nil, xerrors.Errorf("patch must not change the label %s", k)
+		}
+	}
+	return &res, nil
+}
+
 // combineDefiniteWorkspacePodWithTemplate merges a definite workspace pod with a user-provided template.
 // In essence this function just calls mergo, but we need to make sure we use the right flags (and that we can test the right flags).
 func combineDefiniteWorkspacePodWithTemplate(pod *corev1.Pod, template *corev1.Pod) error {
@@ -198,15 +322,14 @@ func mergeNodeAffinityMatchExpressions(dst, src reflect.Value) (err error) {
 	srcs := src.Interface().([]corev1.NodeSelectorTerm)
 	dsts := dst.Interface().([]corev1.NodeSelectorTerm)
 
-	if len(dsts) > 1 {
-		// we only run this mechanism if it's clear where we merge into
-		return nil
-	}
 	if len(dsts) == 0 {
 		dsts = srcs
 	} else {
-		for _, term := range srcs {
-			dsts[0].MatchExpressions = append(dsts[0].MatchExpressions, term.MatchExpressions...)
+		// node selector terms are OR'ed, hence every term has to satisfy the template's expressions
+		for i := range dsts {
+			for _, term := range srcs {
+				dsts[i].MatchExpressions = append(dsts[i].MatchExpressions, term.MatchExpressions...)
+			}
 		}
 	}
 	dst.Set(reflect.ValueOf(dsts))
@@ -259,7 +382,11 @@ func createDefiniteWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error
 	}
 
 	labels := make(map[string]string)
-	labels["gitpod.io/networkpolicy"] = "default"
+	labels[networkPolicyLabel] = "default"
+	if class, ok := sctx.Config.WorkspaceClasses[sctx.Workspace.Spec.Class]; ok && class.Templates.NetworkPolicyPath != "" {
+		// network policies are additive, hence the cluster-wide policy must not widen the policy of the workspace class
+		labels[networkPolicyLabel] = "workspace"
+	}
 	for k, v := range sctx.Labels {
 		labels[k] = v
 	}
@@ -278,7 +405,7 @@ func createDefiniteWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error
 		"prometheus.io/scrape": "true",
 		"prometheus.io/path":   "/metrics",
 		"prometheus.io/port":   strconv.Itoa(int(sctx.IDEPort)),
-		"container.apparmor.security.beta.kubernetes.io/workspace": "unconfined",
+		"container.apparmor.security.beta.kubernetes.io/workspace": sctx.Config.ClassAppArmorProfile(sctx.Workspace.Spec.Class),
 		// prevent cluster-autoscaler from removing a node
 		// https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/FAQ.md#what-types-of-pods-can-prevent-ca-from-removing-a-node
 		"cluster-autoscaler.kubernetes.io/safe-to-evict": "false",
@@ -344,6 +471,18 @@ func createDefiniteWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error
 			Key:      "gitpod.io/registry-facade_ready_ns_" + sctx.Config.Namespace,
 			Operator: corev1.NodeSelectorOpExists,
 		},
+		{
+			Key:      constants.DaemonUnavailableLabelPrefix + sctx.Config.Namespace,
+			Operator: corev1.NodeSelectorOpDoesNotExist,
+		},
+	}
+
+	if target := sctx.Workspace.RelocationTarget(); target != "" {
+		matchExpressions = append(matchExpressions, corev1.NodeSelectorRequirement{
+			Key:      corev1.LabelHostname,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{target},
+		})
 	}
 
 	affinity := &corev1.Affinity{
@@ -358,6 +497,77 @@ func createDefiniteWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error
 		},
 	}
 
+	tolerations := []corev1.Toleration{
+		{
+			Key:      "node.kubernetes.io/disk-pressure",
+			Operator: "Exists",
+			Effect:   "NoExecute",
+			// Tolarate Indefinitely
+		},
+		{
+			Key:      "node.kubernetes.io/memory-pressure",
+			Operator: "Exists",
+			Effect:   "NoExecute",
+			// Tolarate Indefinitely
+		},
+		{
+			Key:               "node.kubernetes.io/network-unavailable",
+			Operator:          "Exists",
+			Effect:            "NoExecute",
+			TolerationSeconds: &perssureToleranceSeconds,
+		},
+	}
+
+	class := sctx.Config.WorkspaceClasses[sctx.Workspace.Spec.Class]
+
+	sidecars, err := createSidecarContainers(sctx, class)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot create sidecar containers: %w", err)
+	}
+
+	var (
+		runtimeClassName *string
+		nodeSelector     map[string]string
+	)
+	if class != nil && class.GPU != nil {
+		runtimeClassName = class.GPU.GetRuntimeClassName()
+		if len(class.GPU.NodeSelector) > 0 {
+			nodeSelector = make(map[string]string, len(class.GPU.NodeSelector))
+			for k, v := range class.GPU.NodeSelector {
+				nodeSelector[k] = v
+			}
+		}
+		// GPU nodes are commonly tainted with the GPU resource name to keep other workloads off them
+		tolerations = append(tolerations, corev1.Toleration{
+			Key:      string(class.GPU.GetResourceName()),
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		})
+	}
+
+	// The pod is merged with the pod templates later on, hence we must not share anything with the configuration.
+	var topologySpreadConstraints []corev1.TopologySpreadConstraint
+	if class != nil && class.Scheduling != nil {
+		scheduling := class.Scheduling
+		if len(scheduling.NodeSelectorTerms) > 0 {
+			terms := make([]corev1.NodeSelectorTerm, 0, len(scheduling.NodeSelectorTerms))
+			for _, term := range scheduling.NodeSelectorTerms {
+				term := term.DeepCopy()
+				term.MatchExpressions = append(append([]corev1.NodeSelectorRequirement{}, matchExpressions...), term.MatchExpressions...)
+				terms = append(terms, *term)
+			}
+			affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = terms
+		}
+		for _, term := range scheduling.PreferredNodeAffinity {
+			affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, *term.DeepCopy())
+		}
+		affinity.PodAffinity = scheduling.PodAffinity.DeepCopy()
+		affinity.PodAntiAffinity = scheduling.PodAntiAffinity.DeepCopy()
+		for _, c := range scheduling.TopologySpreadConstraints {
+			topologySpreadConstraints = append(topologySpreadConstraints, *c.DeepCopy())
+		}
+	}
+
 	graceSec := int64(gracePeriod.Seconds())
 	pod := corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -374,58 +584,98 @@ func createDefiniteWorkspacePod(sctx *startWorkspaceContext) (*corev1.Pod, error
 			SchedulerName:                sctx.Config.SchedulerName,
 			EnableServiceLinks:           pointer.Bool(false),
 			Affinity:                     affinity,
+			TopologySpreadConstraints:    topologySpreadConstraints,
 			SecurityContext: &corev1.PodSecurityContext{
 				// We're using a custom seccomp profile for user namespaces to allow clone, mount and chroot.
+				// Workspace classes may use stricter profiles, which must permit the same.
 				SeccompProfile: &corev1.SeccompProfile{
 					Type:             corev1.SeccompProfileTypeLocalhost,
-					LocalhostProfile: pointer.String(sctx.Config.SeccompProfile),
+					LocalhostProfile: pointer.String(sctx.Config.ClassSeccompProfile(sctx.Workspace.Spec.Class)),
 				},
 			},
+			InitContainers: sidecars,
 			Containers: []corev1.Container{
 				*workspaceContainer,
 			},
 			RestartPolicy:                 corev1.RestartPolicyNever,
 			Volumes:                       volumes,
 			TerminationGracePeriodSeconds: &graceSec,
-			Tolerations: []corev1.Toleration{
-				{
-					Key:      "node.kubernetes.io/disk-pressure",
-					Operator: "Exists",
-					Effect:   "NoExecute",
-					// Tolarate Indefinitely
-				},
-				{
-					Key:      "node.kubernetes.io/memory-pressure",
-					Operator: "Exists",
-					Effect:   "NoExecute",
-					// Tolarate Indefinitely
-				},
-				{
-					Key:               "node.kubernetes.io/network-unavailable",
-					Operator:          "Exists",
-					Effect:            "NoExecute",
-					TolerationSeconds: &perssureToleranceSeconds,
-				},
-			},
+			Tolerations:                   tolerations,
+			RuntimeClassName:              runtimeClassName,
+			NodeSelector:                  nodeSelector,
 		},
 	}
 
 	return &pod, nil
 }
 
+// createSidecarContainers creates the sidecars of the workspace class and those requested by the workspace.
+// They run as native sidecars, i.e. init containers which keep running, such that they start before and
+// terminate after the workspace container, and do not keep headless workspaces from finishing.
+func createSidecarContainers(sctx *startWorkspaceContext, class *config.WorkspaceClass) ([]corev1.Container, error) {
+	var names []string
+	if class != nil {
+		names = append(names, class.Sidecars...)
+	}
+	names = append(names, sctx.Workspace.Spec.Sidecars...)
+
+	var (
+		res  []corev1.Container
+		seen = make(map[string]struct{}, len(names))
+	)
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		sidecar, ok := sctx.Config.SidecarCatalog[name]
+		if !ok {
+			return nil, xerrors.Errorf("sidecar %s is not in the sidecar catalog", name)
+		}
+		resources, err := sidecar.ContainerResources()
+		if err != nil {
+			return nil, xerrors.Errorf("sidecar %s: %w", name, err)
+		}
+
+		var env []corev1.EnvVar
+		for _, e := range sidecar.Env {
+			env = append(env, *e.DeepCopy())
+		}
+		res = append(res, corev1.Container{
+			Name:            config.SidecarContainerName(name),
+			Image:           sidecar.Image,
+			Command:         append([]string(nil), sidecar.Command...),
+			Args:            append([]string(nil), sidecar.Args...),
+			Env:             env,
+			Resources:       resources,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			RestartPolicy:   &sidecarRestartPolicy,
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: pointer.Bool(false),
+				Capabilities: &corev1.Capabilities{
+					Drop: []corev1.Capability{"ALL"},
+				},
+				Privileged:   pointer.Bool(false),
+				RunAsNonRoot: pointer.Bool(true),
+			},
+			TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+		})
+	}
+	return res, nil
+}
+
+var sidecarRestartPolicy = corev1.ContainerRestartPolicyAlways
+
 func createWorkspaceContainer(sctx *startWorkspaceContext) (*corev1.Container, error) {
 	class, ok := sctx.Config.WorkspaceClasses[sctx.Workspace.Spec.Class]
 	if !ok {
 		return nil, xerrors.Errorf("unknown workspace class: %s", sctx.Workspace.Spec.Class)
 	}
 
-	limits, err := class.Container.Limits.ResourceList()
-	if err != nil {
-		return nil, xerrors.Errorf("cannot parse workspace container limits: %w", err)
-	}
-	requests, err := class.Container.Requests.ResourceList()
+	resources, err := class.ContainerResources()
 	if err != nil {
-		return nil, xerrors.Errorf("cannot parse workspace container requests: %w", err)
+		return nil, err
 	}
 	env, err := createWorkspaceEnvironment(sctx)
 	if err != nil {
@@ -491,10 +741,7 @@ func createWorkspaceContainer(sctx *startWorkspaceContext) (*corev1.Container, e
 		Ports: []corev1.ContainerPort{
 			{ContainerPort: sctx.IDEPort},
 		},
-		Resources: corev1.ResourceRequirements{
-			Limits:   limits,
-			Requests: requests,
-		},
+		Resources:                resources,
 		VolumeMounts:             volumeMounts,
 		ReadinessProbe:           readinessProbe,
 		Env:                      env,
@@ -557,6 +804,16 @@ func createWorkspaceEnvironment(sctx *startWorkspaceContext) ([]corev1.EnvVar, e
 
 	result = append(result, corev1.EnvVar{Name: "GITPOD_SSH_CA_PUBLIC_KEY", Value: sctx.Workspace.Spec.SSHGatewayCAPublicKey})
 
+	if offset := sctx.Workspace.Spec.ClockOffset; offset != nil && offset.Duration != 0 {
+		// workspacekit shifts the clocks of the workspace and does not pass this on to ring2
+		result = append(result, corev1.EnvVar{Name: "WORKSPACEKIT_CLOCK_OFFSET", Value: offset.Duration.String()})
+	}
+
+	if class, ok := sctx.Config.WorkspaceClasses[sctx.Workspace.Spec.Class]; ok && class.FUSEMounts {
+		// workspacekit shares the FUSE mounts below /workspace and does not pass this on to ring2
+		result = append(result, corev1.EnvVar{Name: "WORKSPACEKIT_FUSE_MOUNTS", Value: "true"})
+	}
+
 	// We don't require that Git be configured for workspaces
 	if sctx.Workspace.Spec.Git != nil {
 		result = append(result, corev1.EnvVar{Name: "GITPOD_GIT_USER_NAME", Value: sctx.Workspace.Spec.Git.Username})
@@ -618,6 +875,10 @@ func createWorkspaceEnvironment(sctx *startWorkspaceContext) ([]corev1.EnvVar, e
 	cpuCount := res.Cpu().Value()
 	result = append(result, corev1.EnvVar{Name: "GITPOD_CPU_COUNT", Value: strconv.FormatInt(int64(cpuCount), 10)})
 
+	if class.GPU != nil {
+		result = append(result, corev1.EnvVar{Name: "GITPOD_GPU_COUNT", Value: strconv.FormatInt(class.GPU.Count, 10)})
+	}
+
 	if sctx.Headless {
 		result = append(result, corev1.EnvVar{Name: "GITPOD_HEADLESS", Value: "true"})
 	}
@@ -5,12 +5,18 @@
 package controllers
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/gitpod-io/gitpod/ws-manager/api/config"
 	v1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestCreateWorkspaceEnvironment(t *testing.T) {
@@ -103,3 +109,511 @@ func TestCreateWorkspaceEnvironment(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateDefiniteWorkspacePodScheduling(t *testing.T) {
+	workspaceNodeExpressions := []corev1.NodeSelectorRequirement{
+		{Key: "gitpod.io/workload_workspace_regular", Operator: corev1.NodeSelectorOpExists},
+		{Key: "gitpod.io/ws-daemon_ready_ns_default", Operator: corev1.NodeSelectorOpExists},
+		{Key: "gitpod.io/registry-facade_ready_ns_default", Operator: corev1.NodeSelectorOpExists},
+		{Key: "gitpod.io/ws-daemon_unavailable_ns_default", Operator: corev1.NodeSelectorOpDoesNotExist},
+	}
+	poolExpression := func(pool string) corev1.NodeSelectorRequirement {
+		return corev1.NodeSelectorRequirement{Key: "gitpod.io/pool", Operator: corev1.NodeSelectorOpIn, Values: []string{pool}}
+	}
+	antiAffinity := &corev1.PodAntiAffinity{
+		PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+			{Weight: 1, PodAffinityTerm: corev1.PodAffinityTerm{TopologyKey: corev1.LabelHostname}},
+		},
+	}
+	spread := []corev1.TopologySpreadConstraint{
+		{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway},
+	}
+
+	type Expectation struct {
+		Affinity                  *corev1.Affinity
+		TopologySpreadConstraints []corev1.TopologySpreadConstraint
+	}
+	tests := []struct {
+		Name        string
+		Scheduling  *config.WorkspaceClassScheduling
+		Expectation Expectation
+	}{
+		{
+			Name: "no scheduling",
+			Expectation: Expectation{
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: workspaceNodeExpressions}},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "dedicated node pools",
+			Scheduling: &config.WorkspaceClassScheduling{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: []corev1.NodeSelectorRequirement{poolExpression("customer-a")}},
+					{MatchExpressions: []corev1.NodeSelectorRequirement{poolExpression("shared")}},
+				},
+				PodAntiAffinity:           antiAffinity,
+				TopologySpreadConstraints: spread,
+			},
+			Expectation: Expectation{
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{MatchExpressions: append(append([]corev1.NodeSelectorRequirement{}, workspaceNodeExpressions...), poolExpression("customer-a"))},
+								{MatchExpressions: append(append([]corev1.NodeSelectorRequirement{}, workspaceNodeExpressions...), poolExpression("shared"))},
+							},
+						},
+					},
+					PodAntiAffinity: antiAffinity,
+				},
+				TopologySpreadConstraints: spread,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			sctx := &startWorkspaceContext{
+				Config: &config.Configuration{
+					Namespace: "default",
+					WorkspaceClasses: map[string]*config.WorkspaceClass{
+						"default": {
+							Name: "default",
+							Container: config.ContainerConfiguration{
+								Limits: &config.ResourceLimitConfiguration{Storage: "10G"},
+							},
+							Scheduling: test.Scheduling,
+						},
+					},
+				},
+				Workspace: &v1.Workspace{
+					Spec: v1.WorkspaceSpec{
+						Class:     "default",
+						Type:      v1.WorkspaceTypeRegular,
+						Ownership: v1.Ownership{WorkspaceID: "foobar"},
+					},
+				},
+			}
+
+			pod, err := createDefiniteWorkspacePod(sctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act := Expectation{
+				Affinity:                  pod.Spec.Affinity,
+				TopologySpreadConstraints: pod.Spec.TopologySpreadConstraints,
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected scheduling (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateDefiniteWorkspacePodGPU(t *testing.T) {
+	sctx := &startWorkspaceContext{
+		Config: &config.Configuration{
+			Namespace: "default",
+			WorkspaceClasses: map[string]*config.WorkspaceClass{
+				"gpu": {
+					Name: "gpu",
+					Container: config.ContainerConfiguration{
+						Limits: &config.ResourceLimitConfiguration{Storage: "10G"},
+					},
+					GPU: &config.GPUConfiguration{
+						Count:        2,
+						NodeSelector: map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-40GB"},
+					},
+				},
+			},
+		},
+		Workspace: &v1.Workspace{
+			Spec: v1.WorkspaceSpec{
+				Class:     "gpu",
+				Type:      v1.WorkspaceTypeRegular,
+				Ownership: v1.Ownership{WorkspaceID: "foobar"},
+			},
+		},
+	}
+
+	pod, err := createDefiniteWorkspacePod(sctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := pod.Spec.Containers[0].Resources
+	if q := res.Limits[config.DefaultGPUResourceName]; q.Value() != 2 {
+		t.Errorf("expected a limit of 2 GPUs, got %s", q.String())
+	}
+	if q := res.Requests[config.DefaultGPUResourceName]; q.Value() != 2 {
+		t.Errorf("expected a request of 2 GPUs, got %s", q.String())
+	}
+	if diff := cmp.Diff(pointer.String(config.DefaultGPURuntimeClassName), pod.Spec.RuntimeClassName); diff != "" {
+		t.Errorf("unexpected runtime class (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-40GB"}, pod.Spec.NodeSelector); diff != "" {
+		t.Errorf("unexpected node selector (-want +got):\n%s", diff)
+	}
+	var tolerated bool
+	for _, tol := range pod.Spec.Tolerations {
+		if tol.Key == config.DefaultGPUResourceName && tol.Effect == corev1.TaintEffectNoSchedule {
+			tolerated = true
+		}
+	}
+	if !tolerated {
+		t.Errorf("expected pod to tolerate the %s taint", config.DefaultGPUResourceName)
+	}
+
+	sctx.Config.WorkspaceClasses["gpu"].GPU.RuntimeClassName = pointer.String("")
+	pod, err = createDefiniteWorkspacePod(sctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pod.Spec.RuntimeClassName != nil {
+		t.Errorf("expected no runtime class, got %s", *pod.Spec.RuntimeClassName)
+	}
+}
+
+func TestCreateDefiniteWorkspacePodSecurityProfile(t *testing.T) {
+	type Expectation struct {
+		Seccomp  string
+		AppArmor string
+	}
+	tests := []struct {
+		Name        string
+		Profile     *config.SecurityProfileConfiguration
+		Expectation Expectation
+	}{
+		{
+			Name:        "default profiles",
+			Expectation: Expectation{Seccomp: "workspace_default.json", AppArmor: "unconfined"},
+		},
+		{
+			Name:        "class profiles",
+			Profile:     &config.SecurityProfileConfiguration{Seccomp: "gitpod/untrusted.json", AppArmor: "gitpod-untrusted"},
+			Expectation: Expectation{Seccomp: "gitpod/untrusted.json", AppArmor: "localhost/gitpod-untrusted"},
+		},
+		{
+			Name:        "AppArmor profile only",
+			Profile:     &config.SecurityProfileConfiguration{AppArmor: "gitpod-untrusted"},
+			Expectation: Expectation{Seccomp: "workspace_default.json", AppArmor: "localhost/gitpod-untrusted"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			sctx := &startWorkspaceContext{
+				Config: &config.Configuration{
+					Namespace:      "default",
+					SeccompProfile: "workspace_default.json",
+					WorkspaceClasses: map[string]*config.WorkspaceClass{
+						"untrusted": {
+							Name: "untrusted",
+							Container: config.ContainerConfiguration{
+								Limits: &config.ResourceLimitConfiguration{Storage: "10G"},
+							},
+							SecurityProfile: test.Profile,
+						},
+					},
+				},
+				Workspace: &v1.Workspace{
+					Spec: v1.WorkspaceSpec{
+						Class:     "untrusted",
+						Type:      v1.WorkspaceTypeRegular,
+						Ownership: v1.Ownership{WorkspaceID: "foobar"},
+					},
+				},
+			}
+
+			pod, err := createDefiniteWorkspacePod(sctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act := Expectation{
+				Seccomp:  pointer.StringDeref(pod.Spec.SecurityContext.SeccompProfile.LocalhostProfile, ""),
+				AppArmor: pod.Annotations["container.apparmor.security.beta.kubernetes.io/workspace"],
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected security profiles (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateDefiniteWorkspacePodSidecars(t *testing.T) {
+	sctx := &startWorkspaceContext{
+		Config: &config.Configuration{
+			Namespace: "default",
+			SidecarCatalog: map[string]*config.SidecarConfiguration{
+				"cloud-sql-proxy": {
+					Image: "gcr.io/cloud-sql-connectors/cloud-sql-proxy:2.8.1",
+					Args:  []string{"--port=5432"},
+					Container: config.ContainerConfiguration{
+						Limits: &config.ResourceLimitConfiguration{CPU: &config.CpuResourceLimit{BurstLimit: "500m"}, Memory: "256Mi"},
+					},
+				},
+				"vault-agent": {
+					Image: "hashicorp/vault:1.15",
+					Container: config.ContainerConfiguration{
+						Limits: &config.ResourceLimitConfiguration{CPU: &config.CpuResourceLimit{BurstLimit: "200m"}, Memory: "128Mi"},
+					},
+				},
+			},
+			WorkspaceClasses: map[string]*config.WorkspaceClass{
+				"default": {
+					Name: "default",
+					Container: config.ContainerConfiguration{
+						Limits: &config.ResourceLimitConfiguration{Storage: "10G"},
+					},
+					Sidecars: []string{"vault-agent"},
+				},
+			},
+		},
+		Workspace: &v1.Workspace{
+			Spec: v1.WorkspaceSpec{
+				Class:     "default",
+				Type:      v1.WorkspaceTypeRegular,
+				Ownership: v1.Ownership{WorkspaceID: "foobar"},
+				Sidecars:  []string{"cloud-sql-proxy", "vault-agent"},
+			},
+		},
+	}
+
+	pod, err := createDefiniteWorkspacePod(sctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, c := range pod.Spec.InitContainers {
+		names = append(names, c.Name)
+		if c.RestartPolicy == nil || *c.RestartPolicy != corev1.ContainerRestartPolicyAlways {
+			t.Errorf("sidecar %s must be restartable", c.Name)
+		}
+		if c.Resources.Limits.Cpu().IsZero() || c.Resources.Limits.Memory().IsZero() {
+			t.Errorf("sidecar %s must have resource limits", c.Name)
+		}
+	}
+	if diff := cmp.Diff([]string{"sidecar-vault-agent", "sidecar-cloud-sql-proxy"}, names); diff != "" {
+		t.Errorf("unexpected sidecars (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"--port=5432"}, pod.Spec.InitContainers[1].Args); diff != "" {
+		t.Errorf("unexpected sidecar args (-want +got):\n%s", diff)
+	}
+	if len(pod.Spec.Containers) != 1 {
+		t.Errorf("expected the workspace container only, got %d containers", len(pod.Spec.Containers))
+	}
+
+	sctx.Workspace.Spec.Sidecars = []string{"unknown"}
+	_, err = createDefiniteWorkspacePod(sctx)
+	if err == nil {
+		t.Error("expected an error for a sidecar which is not in the catalog")
+	}
+}
+
+func TestCreateWorkspacePodTemplatePatch(t *testing.T) {
+	const kataPatch = `
+spec:
+  runtimeClassName: kata
+  tolerations:
+  - key: kata
+    operator: Exists
+    effect: NoSchedule
+  volumes:
+  - name: kernel-modules
+    hostPath:
+      path: /lib/modules
+  containers:
+  - name: workspace
+    volumeMounts:
+    - name: kernel-modules
+      mountPath: /lib/modules
+      readOnly: true
+`
+
+	tests := []struct {
+		Name  string
+		Patch string
+		Error string
+	}{
+		{Name: "kata", Patch: kataPatch},
+		{Name: "json", Patch: `{"spec":{"runtimeClassName":"kata"}}`},
+		{Name: "changes name", Patch: `{"metadata":{"name":"foo"}}`, Error: "cannot apply pod template patch of workspace class default: patch must not change the name or namespace of the pod"},
+		{Name: "changes label", Patch: `{"metadata":{"labels":{"component":"foo"}}}`, Error: "cannot apply pod template patch of workspace class default: patch must not change the label component"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg := &config.Configuration{
+				Namespace: "default",
+				WorkspaceClasses: map[string]*config.WorkspaceClass{
+					"default": {
+						Name: "default",
+						Container: config.ContainerConfiguration{
+							Limits: &config.ResourceLimitConfiguration{Storage: "10G"},
+						},
+						PodTemplatePatch: &config.PodTemplatePatchConfiguration{ConfigMap: "kata-patch"},
+					},
+				},
+			}
+			ws := &v1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+				Spec: v1.WorkspaceSpec{
+					Class:     "default",
+					Type:      v1.WorkspaceTypeRegular,
+					Ownership: v1.Ownership{WorkspaceID: "foobar"},
+				},
+			}
+			clnt := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "kata-patch", Namespace: "default"},
+				Data:       map[string]string{config.DefaultPodTemplatePatchKey: test.Patch},
+			}).Build()
+
+			pod, err := RenderWorkspacePod(context.Background(), clnt, cfg, ws)
+			var errMsg string
+			if err != nil {
+				errMsg = err.Error()
+			}
+			if errMsg != test.Error {
+				t.Fatalf("unexpected error: expect \"%s\", got \"%s\"", test.Error, errMsg)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(pointer.String("kata"), pod.Spec.RuntimeClassName); diff != "" {
+				t.Errorf("unexpected runtime class (-want +got):\n%s", diff)
+			}
+			if test.Patch != kataPatch {
+				return
+			}
+			if len(pod.Spec.Containers) != 1 || pod.Spec.Containers[0].Image == "" {
+				t.Fatalf("patch must be merged into the workspace container, got %v", pod.Spec.Containers)
+			}
+			var mounted bool
+			for _, m := range pod.Spec.Containers[0].VolumeMounts {
+				mounted = mounted || m.Name == "kernel-modules"
+			}
+			if !mounted || len(pod.Spec.Containers[0].VolumeMounts) < 2 {
+				t.Errorf("expected kernel-modules to be mounted next to the workspace volume, got %v", pod.Spec.Containers[0].VolumeMounts)
+			}
+			var volume bool
+			for _, v := range pod.Spec.Volumes {
+				volume = volume || v.Name == "kernel-modules"
+			}
+			if !volume || len(pod.Spec.Volumes) < 2 {
+				t.Errorf("expected kernel-modules volume to be added next to the workspace volume, got %v", pod.Spec.Volumes)
+			}
+		})
+	}
+
+	_, err := loadPodTemplatePatch(context.Background(), fake.NewClientBuilder().Build(), &config.Configuration{
+		Namespace: "default",
+		WorkspaceClasses: map[string]*config.WorkspaceClass{
+			"default": {PodTemplatePatch: &config.PodTemplatePatchConfiguration{ConfigMap: "kata-patch"}},
+		},
+	}, "default")
+	if err == nil {
+		t.Error("expected an error for a missing config map")
+	}
+}
+
+func TestCreateWorkspaceNetworkPolicy(t *testing.T) {
+	const template = `
+metadata:
+  name: ignored
+spec:
+  podSelector:
+    matchLabels:
+      component: workspace
+  policyTypes:
+  - Egress
+  egress:
+  - to:
+    - ipBlock:
+        cidr: 0.0.0.0/0
+        except:
+        - 10.0.0.0/8
+        - 172.16.0.0/12
+        - 192.168.0.0/16
+`
+	fn := filepath.Join(t.TempDir(), "networkpolicy.yaml")
+	if err := os.WriteFile(fn, []byte(template), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	newConfig := func(networkPolicyPath string) *config.Configuration {
+		return &config.Configuration{
+			Namespace: "default",
+			WorkspaceClasses: map[string]*config.WorkspaceClass{
+				"default": {
+					Name: "default",
+					Container: config.ContainerConfiguration{
+						Limits: &config.ResourceLimitConfiguration{Storage: "10G"},
+					},
+					Templates: config.WorkspacePodTemplateConfiguration{NetworkPolicyPath: networkPolicyPath},
+				},
+			},
+		}
+	}
+	ws := &v1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+		Spec: v1.WorkspaceSpec{
+			Class:     "default",
+			Type:      v1.WorkspaceTypeRegular,
+			Ownership: v1.Ownership{WorkspaceID: "foobar"},
+		},
+	}
+
+	tests := []struct {
+		Name              string
+		NetworkPolicyPath string
+		ExpectPolicy      bool
+		ExpectLabel       string
+	}{
+		{Name: "cluster-wide policy", ExpectLabel: "default"},
+		{Name: "class policy", NetworkPolicyPath: fn, ExpectPolicy: true, ExpectLabel: "workspace"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cfg := newConfig(test.NetworkPolicyPath)
+			sctx, err := newStartWorkspaceContext(context.Background(), cfg, ws)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			policy, err := createWorkspaceNetworkPolicy(sctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (policy != nil) != test.ExpectPolicy {
+				t.Fatalf("unexpected policy: %v", policy)
+			}
+			if policy != nil {
+				if policy.Name != "foobar" || policy.Namespace != "default" {
+					t.Errorf("unexpected policy name %s/%s", policy.Namespace, policy.Name)
+				}
+				if diff := cmp.Diff(map[string]string{"workspaceID": "foobar"}, policy.Spec.PodSelector.MatchLabels); diff != "" {
+					t.Errorf("unexpected pod selector (-want +got):\n%s", diff)
+				}
+				if len(policy.Spec.Egress) != 1 || len(policy.Spec.Egress[0].To[0].IPBlock.Except) != 3 {
+					t.Errorf("egress rules of the template were not preserved: %v", policy.Spec.Egress)
+				}
+			}
+
+			pod, err := RenderWorkspacePod(context.Background(), fake.NewClientBuilder().Build(), cfg, ws)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if l := pod.Labels[networkPolicyLabel]; l != test.ExpectLabel {
+				t.Errorf("unexpected network policy label: expected %s, got %s", test.ExpectLabel, l)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// recordEvent records a lifecycle event of a workspace. Next to the workspace, the event is recorded with the
+// workspace pod as involved object if there is one, such that standard cluster tooling and event exporters
+// which only know about pods capture the workspace history, too.
+func recordEvent(recorder record.EventRecorder, ws *workspacev1.Workspace, pod *corev1.Pod, eventtype, reason, message string) {
+	recorder.Event(ws, eventtype, reason, message)
+	if pod != nil {
+		recorder.Event(pod, eventtype, reason, message)
+	}
+}
+
+// getWorkspacePod returns the pod of a workspace as recorded in its runtime status, or nil if the workspace
+// has no pod (anymore).
+func getWorkspacePod(ctx context.Context, c client.Client, ws *workspacev1.Workspace) *corev1.Pod {
+	if ws.Status.Runtime == nil || ws.Status.Runtime.PodName == "" {
+		return nil
+	}
+
+	var pod corev1.Pod
+	err := c.Get(ctx, types.NamespacedName{Namespace: ws.Namespace, Name: ws.Status.Runtime.PodName}, &pod)
+	if err != nil {
+		return nil
+	}
+	return &pod
+}
+
+// emitSnapshotEvents records an event for a workspace once one of its snapshots completed.
+func (r *WorkspaceReconciler) emitSnapshotEvents(ctx context.Context, e event.UpdateEvent) {
+	oldSnapshot, ok := e.ObjectOld.(*workspacev1.Snapshot)
+	if !ok {
+		return
+	}
+	newSnapshot, ok := e.ObjectNew.(*workspacev1.Snapshot)
+	if !ok {
+		return
+	}
+	if oldSnapshot.Status.Completed || !newSnapshot.Status.Completed {
+		return
+	}
+
+	var ws workspacev1.Workspace
+	err := r.Get(ctx, types.NamespacedName{Namespace: newSnapshot.Namespace, Name: newSnapshot.Spec.WorkspaceID}, &ws)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "cannot get workspace of snapshot", "snapshot", newSnapshot.Name)
+		return
+	}
+
+	pod := getWorkspacePod(ctx, r.Client, &ws)
+	if newSnapshot.Status.Error != "" {
+		recordEvent(r.Recorder, &ws, pod, corev1.EventTypeWarning, "SnapshotFailed", fmt.Sprintf("snapshot %s failed: %s", newSnapshot.Name, newSnapshot.Status.Error))
+		return
+	}
+	recordEvent(r.Recorder, &ws, pod, corev1.EventTypeNormal, "SnapshotReady", fmt.Sprintf("snapshot %s is ready: %s", newSnapshot.Name, newSnapshot.Status.URL))
+}
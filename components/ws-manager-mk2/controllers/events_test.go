@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestEmitPhaseEvents(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "ws-foobar", Namespace: "default"}}
+
+	tests := []struct {
+		Name        string
+		Old         workspacev1.WorkspaceStatus
+		New         workspacev1.WorkspaceStatus
+		Pod         *corev1.Pod
+		Expectation []string
+	}{
+		{
+			Name:        "running without pod",
+			Old:         workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseInitializing},
+			New:         workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseRunning},
+			Expectation: []string{"Normal Running "},
+		},
+		{
+			Name:        "running with pod",
+			Old:         workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseInitializing},
+			New:         workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseRunning},
+			Pod:         pod,
+			Expectation: []string{"Normal Running ", "Normal Running "},
+		},
+		{
+			Name: "backup failed",
+			Old:  workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseStopping},
+			New: workspacev1.WorkspaceStatus{
+				Phase:      workspacev1.WorkspacePhaseStopping,
+				Conditions: []metav1.Condition{workspacev1.NewWorkspaceConditionBackupFailure("upload failed")},
+			},
+			Pod:         pod,
+			Expectation: []string{"Warning BackupFailed upload failed", "Warning BackupFailed upload failed"},
+		},
+		{
+			Name: "backup failed before",
+			Old: workspacev1.WorkspaceStatus{
+				Phase:      workspacev1.WorkspacePhaseStopping,
+				Conditions: []metav1.Condition{workspacev1.NewWorkspaceConditionBackupFailure("upload failed")},
+			},
+			New: workspacev1.WorkspaceStatus{
+				Phase:      workspacev1.WorkspacePhaseStopped,
+				Conditions: []metav1.Condition{workspacev1.NewWorkspaceConditionBackupFailure("upload failed")},
+			},
+		},
+		{
+			Name: "backup complete",
+			Old:  workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseStopping},
+			New: workspacev1.WorkspaceStatus{
+				Phase:      workspacev1.WorkspacePhaseStopping,
+				Conditions: []metav1.Condition{workspacev1.NewWorkspaceConditionBackupComplete()},
+			},
+			Expectation: []string{"Normal BackupComplete "},
+		},
+		{
+			Name:        "admission changed",
+			Old:         workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseRunning, Admission: workspacev1.AdmissionLevelOwner},
+			New:         workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseRunning, Admission: workspacev1.AdmissionLevelEveryone},
+			Expectation: []string{"Normal AdmissionChanged admission level changed from Owner to Everyone"},
+		},
+		{
+			Name: "admission observed first",
+			Old:  workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseRunning},
+			New:  workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseRunning, Admission: workspacev1.AdmissionLevelOwner},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			r := &WorkspaceReconciler{Recorder: recorder}
+			ws := &workspacev1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+				Status:     test.New,
+			}
+
+			r.emitPhaseEvents(context.Background(), ws, test.Pod, &test.Old)
+
+			if diff := cmp.Diff(test.Expectation, drainEvents(recorder)); diff != "" {
+				t.Errorf("unexpected events (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEmitSnapshotEvents(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	tests := []struct {
+		Name        string
+		Old         workspacev1.SnapshotStatus
+		New         workspacev1.SnapshotStatus
+		Expectation []string
+	}{
+		{
+			Name:        "ready",
+			New:         workspacev1.SnapshotStatus{Completed: true, URL: "gs://bucket/snapshot.tar"},
+			Expectation: []string{"Normal SnapshotReady snapshot foobar-1 is ready: gs://bucket/snapshot.tar", "Normal SnapshotReady snapshot foobar-1 is ready: gs://bucket/snapshot.tar"},
+		},
+		{
+			Name:        "failed",
+			New:         workspacev1.SnapshotStatus{Completed: true, Error: "disk full"},
+			Expectation: []string{"Warning SnapshotFailed snapshot foobar-1 failed: disk full", "Warning SnapshotFailed snapshot foobar-1 failed: disk full"},
+		},
+		{
+			Name: "completed before",
+			Old:  workspacev1.SnapshotStatus{Completed: true, URL: "gs://bucket/snapshot.tar"},
+			New:  workspacev1.SnapshotStatus{Completed: true, URL: "gs://bucket/snapshot.tar"},
+		},
+		{
+			Name: "in progress",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ws := &workspacev1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+				Status:     workspacev1.WorkspaceStatus{Runtime: &workspacev1.WorkspaceRuntimeStatus{PodName: "ws-foobar"}},
+			}
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "ws-foobar", Namespace: "default"}}
+			recorder := record.NewFakeRecorder(10)
+			r := &WorkspaceReconciler{
+				Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(ws, pod).Build(),
+				Recorder: recorder,
+			}
+			snapshot := workspacev1.Snapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "foobar-1", Namespace: "default"},
+				Spec:       workspacev1.SnapshotSpec{WorkspaceID: "foobar"},
+			}
+			oldSnapshot, newSnapshot := snapshot.DeepCopy(), snapshot.DeepCopy()
+			oldSnapshot.Status = test.Old
+			newSnapshot.Status = test.New
+
+			r.emitSnapshotEvents(context.Background(), event.UpdateEvent{ObjectOld: oldSnapshot, ObjectNew: newSnapshot})
+
+			if diff := cmp.Diff(test.Expectation, drainEvents(recorder)); diff != "" {
+				t.Errorf("unexpected events (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var res []string
+	for {
+		select {
+		case evt := <-recorder.Events:
+			res = append(res, evt)
+		default:
+			return res
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -27,6 +28,8 @@ const (
 	workspaceStartupSeconds       string = "workspace_startup_seconds"
 	workspacePendingSeconds       string = "workspace_pending_seconds"
 	workspaceCreatingSeconds      string = "workspace_creating_seconds"
+	workspaceStartupPhaseSeconds  string = "workspace_startup_phase_seconds"
+	workspaceTimeToMilestone      string = "workspace_time_to_milestone_seconds"
 	workspaceStartFailuresTotal   string = "workspace_starts_failure_total"
 	workspaceFailuresTotal        string = "workspace_failure_total"
 	workspaceStopsTotal           string = "workspace_stops_total"
@@ -50,10 +53,39 @@ const (
 	StopReasonRegular      = "regular-stop"
 )
 
+// startupPhase is a phase of the workspace startup we observe the duration of
+type startupPhase string
+
+const (
+	startupPhaseScheduling      startupPhase = "scheduling"
+	startupPhaseImagePull       startupPhase = "image_pull"
+	startupPhaseContentInit     startupPhase = "content_init"
+	startupPhaseSupervisorReady startupPhase = "supervisor_ready"
+)
+
+// milestone is a point in the life of a workspace we observe the time to, counting from the start of the workspace.
+// Together they tell the time a user waits until they can interact with their workspace, rather than until its pod runs.
+type milestone string
+
+const (
+	milestoneContentReady      milestone = "content_ready"
+	milestoneEverReady         milestone = "ever_ready"
+	milestoneFirstUserActivity milestone = "first_user_activity"
+)
+
+// milestoneConditions are the workspace conditions which mark the milestones
+var milestoneConditions = map[milestone]workspacev1.WorkspaceCondition{
+	milestoneContentReady:      workspacev1.WorkspaceConditionContentReady,
+	milestoneEverReady:         workspacev1.WorkspaceConditionEverReady,
+	milestoneFirstUserActivity: workspacev1.WorkspaceConditionFirstUserActivity,
+}
+
 type controllerMetrics struct {
 	startupTimeHistVec           *prometheus.HistogramVec
 	pendingTimeHistVec           *prometheus.HistogramVec
 	creatingTimeHistVec          *prometheus.HistogramVec
+	startupPhaseHistVec          *prometheus.HistogramVec
+	milestoneHistVec             *prometheus.HistogramVec
 	totalStartsFailureCounterVec *prometheus.CounterVec
 	totalFailuresCounterVec      *prometheus.CounterVec
 	totalStopsCounterVec         *prometheus.CounterVec
@@ -102,6 +134,20 @@ func newControllerMetrics(r *WorkspaceReconciler) (*controllerMetrics, error) {
 			Help:      "time the workspace spent in creation",
 			Buckets:   prometheus.ExponentialBuckets(2, 2, 10),
 		}, []string{"type", "class"}),
+		startupPhaseHistVec: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      workspaceStartupPhaseSeconds,
+			Help:      "time the individual phases of a workspace startup took",
+			Buckets:   prometheus.ExponentialBuckets(0.5, 2, 12),
+		}, []string{"phase", "type", "class"}),
+		milestoneHistVec: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsWorkspaceSubsystem,
+			Name:      workspaceTimeToMilestone,
+			Help:      "time from the start of a workspace until it reached a milestone, e.g. it became ready for the first time",
+			Buckets:   prometheus.ExponentialBuckets(2, 2, 12),
+		}, []string{"milestone", "type", "class"}),
 		totalStartsFailureCounterVec: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Namespace: metricsNamespace,
 			Subsystem: metricsWorkspaceSubsystem,
@@ -191,6 +237,97 @@ func (m *controllerMetrics) recordWorkspaceCreatingTime(log *logr.Logger, ws *wo
 	hist.Observe(time.Since(creatingTs).Seconds())
 }
 
+func (m *controllerMetrics) recordWorkspaceStartupPhases(log *logr.Logger, ws *workspacev1.Workspace, pod *corev1.Pod) {
+	class := ws.Spec.Class
+	tpe := string(ws.Spec.Type)
+
+	for phase, duration := range startupPhases(ws, pod) {
+		hist, err := m.startupPhaseHistVec.GetMetricWithLabelValues(string(phase), tpe, class)
+		if err != nil {
+			log.Error(err, "could not record workspace startup phase", "phase", phase, "type", tpe, "class", class)
+			continue
+		}
+
+		hist.Observe(duration.Seconds())
+	}
+}
+
+// startupPhases breaks the startup of a workspace down into phases, based on the conditions of its pod and
+// the ContentReady condition set by ws-daemon. ws-daemon initializes the content while the image is pulled,
+// hence those phases overlap. Phases we cannot tell the start or end of are omitted.
+func startupPhases(ws *workspacev1.Workspace, pod *corev1.Pod) map[startupPhase]time.Duration {
+	res := make(map[startupPhase]time.Duration)
+	if pod == nil {
+		return res
+	}
+
+	var (
+		scheduled        = podConditionTime(pod, corev1.PodScheduled)
+		containersReady  = podConditionTime(pod, corev1.ContainersReady)
+		containerStarted time.Time
+		contentReady     time.Time
+	)
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == "workspace" && cs.State.Running != nil {
+			containerStarted = cs.State.Running.StartedAt.Time
+		}
+	}
+	if c := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionContentReady)); c != nil && c.Status == metav1.ConditionTrue {
+		contentReady = c.LastTransitionTime.Time
+	}
+
+	observe := func(phase startupPhase, start, end time.Time) {
+		if start.IsZero() || end.IsZero() || end.Before(start) {
+			return
+		}
+		res[phase] = end.Sub(start)
+	}
+	// the pod, rather than the workspace, marks the start as a workspace gets a new pod when it's relocated
+	observe(startupPhaseScheduling, pod.CreationTimestamp.Time, scheduled)
+	observe(startupPhaseImagePull, scheduled, containerStarted)
+	observe(startupPhaseContentInit, scheduled, contentReady)
+	observe(startupPhaseSupervisorReady, containerStarted, containersReady)
+
+	return res
+}
+
+// recordWorkspaceMilestone observes the time to a milestone once the workspace reached it
+func (m *controllerMetrics) recordWorkspaceMilestone(log *logr.Logger, ws *workspacev1.Workspace, ms milestone) {
+	class := ws.Spec.Class
+	tpe := string(ws.Spec.Type)
+
+	duration, ok := timeToMilestone(ws, ms)
+	if !ok {
+		return
+	}
+
+	hist, err := m.milestoneHistVec.GetMetricWithLabelValues(string(ms), tpe, class)
+	if err != nil {
+		log.Error(err, "could not record workspace milestone", "milestone", ms, "type", tpe, "class", class)
+		return
+	}
+
+	hist.Observe(duration.Seconds())
+}
+
+// timeToMilestone returns the time from the creation of a workspace until it reached a milestone
+func timeToMilestone(ws *workspacev1.Workspace, ms milestone) (time.Duration, bool) {
+	c := wsk8s.GetCondition(ws.Status.Conditions, string(milestoneConditions[ms]))
+	if c == nil || c.Status != metav1.ConditionTrue || c.LastTransitionTime.Before(&ws.CreationTimestamp) {
+		return 0, false
+	}
+	return c.LastTransitionTime.Sub(ws.CreationTimestamp.Time), true
+}
+
+func podConditionTime(pod *corev1.Pod, tpe corev1.PodConditionType) time.Time {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == tpe && c.Status == corev1.ConditionTrue {
+			return c.LastTransitionTime.Time
+		}
+	}
+	return time.Time{}
+}
+
 func (m *controllerMetrics) countWorkspaceStartFailures(log *logr.Logger, ws *workspacev1.Workspace) {
 	class := ws.Spec.Class
 	tpe := string(ws.Spec.Type)
@@ -289,6 +426,8 @@ type metricState struct {
 	recordedStartFailure    bool
 	recordedFailure         bool
 	recordedContentReady    bool
+	recordedEverReady       bool
+	recordedFirstActivity   bool
 	recordedBackupFailed    bool
 	recordedBackupCompleted bool
 }
@@ -304,6 +443,8 @@ func newMetricState(ws *workspacev1.Workspace) metricState {
 		recordedStartFailure:    ws.Status.Phase == workspacev1.WorkspacePhaseStopped && isStartFailure(ws),
 		recordedFailure:         ws.IsConditionTrue(workspacev1.WorkspaceConditionFailed),
 		recordedContentReady:    ws.IsConditionTrue(workspacev1.WorkspaceConditionContentReady),
+		recordedEverReady:       ws.IsConditionTrue(workspacev1.WorkspaceConditionEverReady),
+		recordedFirstActivity:   ws.IsConditionTrue(workspacev1.WorkspaceConditionFirstUserActivity),
 		recordedBackupFailed:    ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupFailure),
 		recordedBackupCompleted: ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete),
 	}
@@ -324,6 +465,8 @@ func (m *controllerMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.startupTimeHistVec.Describe(ch)
 	m.pendingTimeHistVec.Describe(ch)
 	m.creatingTimeHistVec.Describe(ch)
+	m.startupPhaseHistVec.Describe(ch)
+	m.milestoneHistVec.Describe(ch)
 	m.totalStopsCounterVec.Describe(ch)
 	m.totalStartsFailureCounterVec.Describe(ch)
 	m.totalFailuresCounterVec.Describe(ch)
@@ -344,6 +487,8 @@ func (m *controllerMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.startupTimeHistVec.Collect(ch)
 	m.pendingTimeHistVec.Collect(ch)
 	m.creatingTimeHistVec.Collect(ch)
+	m.startupPhaseHistVec.Collect(ch)
+	m.milestoneHistVec.Collect(ch)
 	m.totalStopsCounterVec.Collect(ch)
 	m.totalStartsFailureCounterVec.Collect(ch)
 	m.totalFailuresCounterVec.Collect(ch)
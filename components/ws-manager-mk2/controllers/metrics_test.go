@@ -0,0 +1,131 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStartupPhases(t *testing.T) {
+	created := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(seconds int) metav1.Time {
+		return metav1.NewTime(created.Add(time.Duration(seconds) * time.Second))
+	}
+	contentReady := func(ws *workspacev1.Workspace, seconds int) *workspacev1.Workspace {
+		ws.Status.Conditions = []metav1.Condition{{
+			Type:               string(workspacev1.WorkspaceConditionContentReady),
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: at(seconds),
+		}}
+		return ws
+	}
+	pod := func(conditions []corev1.PodCondition, containerStarted *metav1.Time) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: at(0)},
+			Status:     corev1.PodStatus{Conditions: conditions},
+		}
+		if containerStarted != nil {
+			p.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name:  "workspace",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: *containerStarted}},
+			}}
+		}
+		return p
+	}
+	started := at(30)
+
+	tests := []struct {
+		Name        string
+		Workspace   *workspacev1.Workspace
+		Pod         *corev1.Pod
+		Expectation map[startupPhase]time.Duration
+	}{
+		{
+			Name:        "no pod",
+			Workspace:   &workspacev1.Workspace{},
+			Expectation: map[startupPhase]time.Duration{},
+		},
+		{
+			Name:      "all phases",
+			Workspace: contentReady(&workspacev1.Workspace{}, 20),
+			Pod: pod([]corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue, LastTransitionTime: at(5)},
+				{Type: corev1.ContainersReady, Status: corev1.ConditionTrue, LastTransitionTime: at(40)},
+			}, &started),
+			Expectation: map[startupPhase]time.Duration{
+				startupPhaseScheduling:      5 * time.Second,
+				startupPhaseImagePull:       25 * time.Second,
+				startupPhaseContentInit:     15 * time.Second,
+				startupPhaseSupervisorReady: 10 * time.Second,
+			},
+		},
+		{
+			Name:      "container not started",
+			Workspace: &workspacev1.Workspace{},
+			Pod: pod([]corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue, LastTransitionTime: at(5)},
+				{Type: corev1.ContainersReady, Status: corev1.ConditionFalse, LastTransitionTime: at(6)},
+			}, nil),
+			Expectation: map[startupPhase]time.Duration{
+				startupPhaseScheduling: 5 * time.Second,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := startupPhases(test.Workspace, test.Pod)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected startup phases (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTimeToMilestone(t *testing.T) {
+	created := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(seconds int) metav1.Time {
+		return metav1.NewTime(created.Add(time.Duration(seconds) * time.Second))
+	}
+	ws := &workspacev1.Workspace{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		Status: workspacev1.WorkspaceStatus{
+			Conditions: []metav1.Condition{
+				{Type: string(workspacev1.WorkspaceConditionContentReady), Status: metav1.ConditionTrue, LastTransitionTime: at(20)},
+				{Type: string(workspacev1.WorkspaceConditionEverReady), Status: metav1.ConditionTrue, LastTransitionTime: at(45)},
+				{Type: string(workspacev1.WorkspaceConditionFirstUserActivity), Status: metav1.ConditionFalse, LastTransitionTime: at(50)},
+			},
+		},
+	}
+
+	type Expectation struct {
+		Duration time.Duration
+		OK       bool
+	}
+	tests := []struct {
+		Milestone   milestone
+		Expectation Expectation
+	}{
+		{Milestone: milestoneContentReady, Expectation: Expectation{Duration: 20 * time.Second, OK: true}},
+		{Milestone: milestoneEverReady, Expectation: Expectation{Duration: 45 * time.Second, OK: true}},
+		{Milestone: milestoneFirstUserActivity, Expectation: Expectation{}},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.Milestone), func(t *testing.T) {
+			duration, ok := timeToMilestone(ws, test.Milestone)
+			act := Expectation{Duration: duration, OK: ok}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected time to milestone (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
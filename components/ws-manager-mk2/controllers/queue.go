@@ -0,0 +1,257 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License-AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	// queueRequeue is the interval in which queued workspaces check whether they can leave the queue
+	queueRequeue = 10 * time.Second
+
+	// queueThroughputWindow is the period over which we measure how fast a queue drains to estimate when queued workspaces start
+	queueThroughputWindow = 15 * time.Minute
+
+	prebuildQueue = "PrebuildQueue"
+	startQueue    = "StartQueue"
+)
+
+// workspaceQueue is a queue workspaces wait in before their pod is created
+type workspaceQueue struct {
+	// name is the reason of the Queued condition
+	name     string
+	member   func(ws *workspacev1.Workspace) bool
+	position func(workspaces []workspacev1.Workspace, name string) (position, length int)
+}
+
+// queueFor returns the queue a workspace has to pass before its pod is created, or nil if no queue is configured
+func (r *WorkspaceReconciler) queueFor(ws *workspacev1.Workspace) *workspaceQueue {
+	isPrebuild := func(ws *workspacev1.Workspace) bool { return ws.Spec.Type == workspacev1.WorkspaceTypePrebuild }
+	switch {
+	case isPrebuild(ws) && r.Config.PrebuildQueue != nil:
+		return &workspaceQueue{
+			name:   prebuildQueue,
+			member: isPrebuild,
+			position: func(workspaces []workspacev1.Workspace, name string) (int, int) {
+				return PrebuildQueuePosition(workspaces, r.Config.PrebuildQueue, name)
+			},
+		}
+	case !isPrebuild(ws) && r.Config.StartQueue != nil:
+		return &workspaceQueue{
+			name:   startQueue,
+			member: func(ws *workspacev1.Workspace) bool { return !isPrebuild(ws) },
+			position: func(workspaces []workspacev1.Workspace, name string) (int, int) {
+				return StartQueuePosition(workspaces, r.Config.StartQueue, name)
+			},
+		}
+	}
+	return nil
+}
+
+// PrebuildQueuePosition returns the one-based position of a prebuild in the prebuild queue, or zero if the
+// prebuild can start, as well as the number of queued prebuilds. Prebuilds whose pod got created take up
+// capacity, all others wait in the order of their priority and creation time.
+func PrebuildQueuePosition(workspaces []workspacev1.Workspace, cfg *config.PrebuildQueueConfiguration, name string) (position, length int) {
+	if cfg == nil {
+		return 0, 0
+	}
+
+	var (
+		running        int
+		runningProject = make(map[string]int)
+		waiting        []*workspacev1.Workspace
+	)
+	for i := range workspaces {
+		ws := &workspaces[i]
+		if ws.Spec.Type != workspacev1.WorkspaceTypePrebuild || ws.Status.Phase == workspacev1.WorkspacePhaseStopped {
+			continue
+		}
+		if ws.Status.PodStarts > 0 {
+			running++
+			runningProject[ws.Spec.Ownership.Project]++
+			continue
+		}
+		if isStopRequested(ws) {
+			continue
+		}
+		waiting = append(waiting, ws)
+	}
+	sortQueue(waiting)
+
+	for _, ws := range waiting {
+		project := ws.Spec.Ownership.Project
+		canStart := cfg.MaxConcurrent == 0 || running < cfg.MaxConcurrent
+		if project != "" && cfg.MaxConcurrentPerProject > 0 && runningProject[project] >= cfg.MaxConcurrentPerProject {
+			canStart = false
+		}
+		if canStart {
+			running++
+			runningProject[project]++
+			continue
+		}
+
+		length++
+		if ws.Name == name {
+			position = length
+		}
+	}
+	return position, length
+}
+
+// StartQueuePosition returns the one-based position of a workspace in the start queue, or zero if the
+// workspace can start, as well as the number of queued workspaces. All workspaces whose pod got created take up
+// capacity. All but prebuilds wait in the order of their priority and creation time.
+func StartQueuePosition(workspaces []workspacev1.Workspace, cfg *config.StartQueueConfiguration, name string) (position, length int) {
+	if cfg == nil {
+		return 0, 0
+	}
+
+	var (
+		running int
+		waiting []*workspacev1.Workspace
+	)
+	for i := range workspaces {
+		ws := &workspaces[i]
+		if ws.Status.Phase == workspacev1.WorkspacePhaseStopped {
+			continue
+		}
+		if ws.Status.PodStarts > 0 {
+			running++
+			continue
+		}
+		if ws.Spec.Type == workspacev1.WorkspaceTypePrebuild || isStopRequested(ws) {
+			continue
+		}
+		waiting = append(waiting, ws)
+	}
+	sortQueue(waiting)
+
+	for _, ws := range waiting {
+		if running < cfg.MaxConcurrent {
+			running++
+			continue
+		}
+
+		length++
+		if ws.Name == name {
+			position = length
+		}
+	}
+	return position, length
+}
+
+// sortQueue sorts waiting workspaces by their priority first and their creation time second
+func sortQueue(waiting []*workspacev1.Workspace) {
+	sort.SliceStable(waiting, func(i, j int) bool {
+		a, b := waiting[i], waiting[j]
+		if a.Spec.Priority != b.Spec.Priority {
+			return a.Spec.Priority > b.Spec.Priority
+		}
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		return a.Name < b.Name
+	})
+}
+
+// estimateQueueStart estimates when the workspace at the given position leaves the queue, assuming that
+// the queue drains at the rate at which its members left it during the last queueThroughputWindow.
+// It returns nil if no member left the queue during that time.
+func estimateQueueStart(workspaces []workspacev1.Workspace, member func(ws *workspacev1.Workspace) bool, position int, now time.Time) *metav1.Time {
+	var left int
+	for i := range workspaces {
+		ws := &workspaces[i]
+		if !member(ws) || ws.Status.PodStarts == 0 {
+			continue
+		}
+		c := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionQueued))
+		if c == nil || c.Status != metav1.ConditionFalse || now.Sub(c.LastTransitionTime.Time) > queueThroughputWindow {
+			continue
+		}
+		left++
+	}
+	if left == 0 {
+		return nil
+	}
+
+	wait := queueThroughputWindow * time.Duration(position) / time.Duration(left)
+	// we round to the minute so that the estimate does not change with every reconciliation
+	start := metav1.NewTime(now.Add(wait).Truncate(time.Minute))
+	return &start
+}
+
+// isStopRequested returns true if a workspace ought to stop, no matter whether it has a pod yet
+func isStopRequested(ws *workspacev1.Workspace) bool {
+	return ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest) ||
+		ws.IsConditionTrue(workspacev1.WorkspaceConditionTimeout) ||
+		ws.IsConditionTrue(workspacev1.WorkspaceConditionFailed) ||
+		isWorkspaceBeingDeleted(ws)
+}
+
+// admitToQueue decides whether the pod of a workspace can be created, or whether the workspace has to wait in the queue.
+// Workspaces which are stopped while they are queued stop without ever creating a pod.
+func (r *WorkspaceReconciler) admitToQueue(ctx context.Context, workspace *workspacev1.Workspace, queue *workspaceQueue) (admitted bool, result ctrl.Result, err error) {
+	span, ctx := tracing.FromContext(ctx, "admitToQueue")
+	defer tracing.FinishSpan(span, &err)
+	log := log.FromContext(ctx)
+
+	patch := client.MergeFrom(workspace.DeepCopy())
+	if isStopRequested(workspace) {
+		workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionDequeued(queue.name))
+		workspace.Status.Queue = nil
+		workspace.Status.Phase = workspacev1.WorkspacePhaseStopped
+		return false, ctrl.Result{}, r.Status().Patch(ctx, workspace, patch)
+	}
+
+	var workspaces workspacev1.WorkspaceList
+	err = r.List(ctx, &workspaces, client.InNamespace(workspace.Namespace))
+	if err != nil {
+		return false, ctrl.Result{}, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	position, length := queue.position(workspaces.Items, workspace.Name)
+	if position == 0 {
+		if workspace.IsConditionTrue(workspacev1.WorkspaceConditionQueued) {
+			workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionDequeued(queue.name))
+			workspace.Status.Queue = nil
+			err = r.Status().Patch(ctx, workspace, patch)
+			if err != nil {
+				return false, ctrl.Result{}, err
+			}
+			log.Info("workspace left the queue", "queue", queue.name)
+		}
+		return true, ctrl.Result{}, nil
+	}
+
+	queueStatus := &workspacev1.QueueStatus{
+		Position:       position,
+		Length:         length,
+		EstimatedStart: estimateQueueStart(workspaces.Items, queue.member, position, time.Now()),
+	}
+	if !workspace.IsConditionTrue(workspacev1.WorkspaceConditionQueued) || !equality.Semantic.DeepEqual(workspace.Status.Queue, queueStatus) {
+		workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionQueued(queue.name, fmt.Sprintf("position %d of %d", position, length)))
+		workspace.Status.Queue = queueStatus
+		err = r.Status().Patch(ctx, workspace, patch)
+		if err != nil {
+			return false, ctrl.Result{}, err
+		}
+	}
+	return false, ctrl.Result{RequeueAfter: queueRequeue}, nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPrebuildQueuePosition(t *testing.T) {
+	created := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	prebuild := func(name, project string, age time.Duration, mod func(ws *workspacev1.Workspace)) workspacev1.Workspace {
+		ws := workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+			},
+			Spec: workspacev1.WorkspaceSpec{
+				Type:      workspacev1.WorkspaceTypePrebuild,
+				Ownership: workspacev1.Ownership{Project: project},
+			},
+			Status: workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhasePending},
+		}
+		if mod != nil {
+			mod(&ws)
+		}
+		return ws
+	}
+	running := func(ws *workspacev1.Workspace) {
+		ws.Status.PodStarts = 1
+		ws.Status.Phase = workspacev1.WorkspacePhaseRunning
+	}
+	openPullRequest := func(ws *workspacev1.Workspace) {
+		ws.Spec.Priority = 1
+	}
+
+	type Position struct {
+		Position int
+		Length   int
+	}
+	tests := []struct {
+		Name        string
+		Config      *config.PrebuildQueueConfiguration
+		Workspaces  []workspacev1.Workspace
+		Expectation map[string]Position
+	}{
+		{
+			Name: "no queue",
+			Workspaces: []workspacev1.Workspace{
+				prebuild("a", "p1", 2*time.Minute, running),
+				prebuild("b", "p1", time.Minute, nil),
+			},
+			Expectation: map[string]Position{"a": {}, "b": {}},
+		},
+		{
+			Name:   "global limit",
+			Config: &config.PrebuildQueueConfiguration{MaxConcurrent: 2},
+			Workspaces: []workspacev1.Workspace{
+				prebuild("a", "p1", 4*time.Minute, running),
+				prebuild("b", "p2", 3*time.Minute, nil),
+				prebuild("c", "p3", 2*time.Minute, nil),
+				prebuild("d", "p4", time.Minute, nil),
+			},
+			Expectation: map[string]Position{"a": {Length: 2}, "b": {Length: 2}, "c": {1, 2}, "d": {2, 2}},
+		},
+		{
+			Name:   "project limit",
+			Config: &config.PrebuildQueueConfiguration{MaxConcurrentPerProject: 1},
+			Workspaces: []workspacev1.Workspace{
+				prebuild("a", "p1", 4*time.Minute, running),
+				prebuild("b", "p1", 3*time.Minute, nil),
+				prebuild("c", "p2", 2*time.Minute, nil),
+				prebuild("d", "", time.Minute, nil),
+			},
+			Expectation: map[string]Position{"a": {Length: 1}, "b": {1, 1}, "c": {Length: 1}, "d": {Length: 1}},
+		},
+		{
+			Name:   "open pull requests first",
+			Config: &config.PrebuildQueueConfiguration{MaxConcurrent: 1},
+			Workspaces: []workspacev1.Workspace{
+				prebuild("a", "p1", 3*time.Minute, nil),
+				prebuild("b", "p2", 2*time.Minute, nil),
+				prebuild("c", "p3", time.Minute, openPullRequest),
+			},
+			Expectation: map[string]Position{"a": {1, 2}, "b": {2, 2}, "c": {Length: 2}},
+		},
+		{
+			Name:   "stopped prebuilds and regular workspaces",
+			Config: &config.PrebuildQueueConfiguration{MaxConcurrent: 1},
+			Workspaces: []workspacev1.Workspace{
+				prebuild("a", "p1", 4*time.Minute, func(ws *workspacev1.Workspace) {
+					running(ws)
+					ws.Status.Phase = workspacev1.WorkspacePhaseStopped
+				}),
+				prebuild("b", "p1", 3*time.Minute, func(ws *workspacev1.Workspace) {
+					ws.Spec.Type = workspacev1.WorkspaceTypeRegular
+					running(ws)
+				}),
+				prebuild("c", "p2", 2*time.Minute, func(ws *workspacev1.Workspace) {
+					ws.Status.SetCondition(workspacev1.NewWorkspaceConditionStoppedByRequest("1s"))
+				}),
+				prebuild("d", "p3", time.Minute, nil),
+			},
+			Expectation: map[string]Position{"a": {}, "b": {}, "c": {}, "d": {}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := make(map[string]Position, len(test.Workspaces))
+			for _, ws := range test.Workspaces {
+				position, length := PrebuildQueuePosition(test.Workspaces, test.Config, ws.Name)
+				act[ws.Name] = Position{Position: position, Length: length}
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected queue positions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStartQueuePosition(t *testing.T) {
+	created := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	workspace := func(name string, tpe workspacev1.WorkspaceType, age time.Duration, running bool) workspacev1.Workspace {
+		ws := workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+			},
+			Spec:   workspacev1.WorkspaceSpec{Type: tpe},
+			Status: workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhasePending},
+		}
+		if running {
+			ws.Status.PodStarts = 1
+			ws.Status.Phase = workspacev1.WorkspacePhaseRunning
+		}
+		return ws
+	}
+
+	workspaces := []workspacev1.Workspace{
+		workspace("a", workspacev1.WorkspaceTypePrebuild, 5*time.Minute, true),
+		workspace("b", workspacev1.WorkspaceTypeRegular, 4*time.Minute, true),
+		workspace("c", workspacev1.WorkspaceTypeRegular, 3*time.Minute, false),
+		workspace("d", workspacev1.WorkspaceTypePrebuild, 2*time.Minute, false),
+		workspace("e", workspacev1.WorkspaceTypeImageBuild, time.Minute, false),
+	}
+	cfg := &config.StartQueueConfiguration{MaxConcurrent: 3}
+
+	type Position struct {
+		Position int
+		Length   int
+	}
+	act := make(map[string]Position, len(workspaces))
+	for _, ws := range workspaces {
+		position, length := StartQueuePosition(workspaces, cfg, ws.Name)
+		act[ws.Name] = Position{Position: position, Length: length}
+	}
+	// prebuilds take up capacity, but do not wait in the start queue
+	expectation := map[string]Position{"a": {Length: 1}, "b": {Length: 1}, "c": {Length: 1}, "d": {Length: 1}, "e": {1, 1}}
+	if diff := cmp.Diff(expectation, act); diff != "" {
+		t.Errorf("unexpected queue positions (-want +got):\n%s", diff)
+	}
+}
+
+func TestEstimateQueueStart(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 30, 0, time.UTC)
+	dequeued := func(name string, ago time.Duration) workspacev1.Workspace {
+		ws := workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     workspacev1.WorkspaceStatus{PodStarts: 1},
+		}
+		cond := workspacev1.NewWorkspaceConditionDequeued(startQueue)
+		cond.LastTransitionTime = metav1.NewTime(now.Add(-ago))
+		ws.Status.SetCondition(cond)
+		return ws
+	}
+	member := func(ws *workspacev1.Workspace) bool { return true }
+
+	if act := estimateQueueStart([]workspacev1.Workspace{dequeued("a", time.Hour)}, member, 1, now); act != nil {
+		t.Errorf("expected no estimate without recent throughput, got %v", act)
+	}
+
+	// three workspaces left the queue in the last 15 minutes, i.e. one every five minutes
+	workspaces := []workspacev1.Workspace{
+		dequeued("a", time.Minute),
+		dequeued("b", 5*time.Minute),
+		dequeued("c", 10*time.Minute),
+		dequeued("d", time.Hour),
+	}
+	act := estimateQueueStart(workspaces, member, 2, now)
+	if act == nil {
+		t.Fatal("expected an estimate")
+	}
+	if expectation := time.Date(2023, 6, 1, 12, 10, 0, 0, time.UTC); !act.Time.Equal(expectation) {
+		t.Errorf("unexpected estimate: %v, expected %v", act.Time, expectation)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	config "github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// resizeWorkspacePod applies the resources of the workspace class to the running pod, e.g. after the class of the
+// workspace changed. ws-daemon picks up the new CPU, network and memory limits from the pod annotations.
+func (r *WorkspaceReconciler) resizeWorkspacePod(ctx context.Context, ws *workspacev1.Workspace, pod *corev1.Pod) error {
+	if !r.Config.InPlacePodResize {
+		return nil
+	}
+	class, ok := r.Config.WorkspaceClasses[ws.Spec.Class]
+	if !ok {
+		return nil
+	}
+	resources, err := class.ContainerResources()
+	if err != nil {
+		return err
+	}
+
+	var container *corev1.Container
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == "workspace" {
+			container = &pod.Spec.Containers[i]
+		}
+	}
+	if container == nil {
+		return nil
+	}
+
+	annotationsChanged := false
+	for _, k := range []string{wsk8s.WorkspaceCpuMinLimitAnnotation, wsk8s.WorkspaceCpuBurstLimitAnnotation, wsk8s.WorkspaceCpuBurstCreditAnnotation, wsk8s.WorkspaceNetEgressBandwidthAnnotation, wsk8s.WorkspaceNetIngressBandwidthAnnotation, wsk8s.WorkspaceMemoryHighAnnotation, wsk8s.WorkspaceMemorySwapAnnotation} {
+		v, ok := ws.Annotations[k]
+		if pv, pok := pod.Annotations[k]; pv == v && pok == ok {
+			continue
+		}
+		annotationsChanged = true
+		if ok {
+			if pod.Annotations == nil {
+				pod.Annotations = make(map[string]string)
+			}
+			pod.Annotations[k] = v
+		} else {
+			delete(pod.Annotations, k)
+		}
+	}
+	if equality.Semantic.DeepEqual(container.Resources, resources) && !annotationsChanged {
+		return nil
+	}
+	if !config.CanResizeInPlace(container.Resources, resources) {
+		// the workspace restarts with the new class instead
+		return nil
+	}
+
+	container.Resources = resources
+	err = r.Client.Update(ctx, pod)
+	if err != nil {
+		return fmt.Errorf("failed to resize workspace pod: %w", err)
+	}
+	r.Recorder.Event(ws, corev1.EventTypeNormal, "Resized", ws.Spec.Class)
+	return nil
+}
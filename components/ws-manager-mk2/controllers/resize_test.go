@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestResizeWorkspacePod(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	classes := map[string]*config.WorkspaceClass{
+		"small": {Container: config.ContainerConfiguration{
+			Limits: &config.ResourceLimitConfiguration{CPU: &config.CpuResourceLimit{MinLimit: "1", BurstLimit: "2"}, Memory: "4Gi"},
+		}},
+		"large": {Container: config.ContainerConfiguration{
+			Limits: &config.ResourceLimitConfiguration{CPU: &config.CpuResourceLimit{MinLimit: "4", BurstLimit: "8"}, Memory: "16Gi"},
+		}},
+		"gpu": {
+			Container: config.ContainerConfiguration{
+				Limits: &config.ResourceLimitConfiguration{CPU: &config.CpuResourceLimit{MinLimit: "4", BurstLimit: "8"}, Memory: "16Gi"},
+			},
+			GPU: &config.GPUConfiguration{Count: 1},
+		},
+	}
+	smallLimits := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("2"),
+		corev1.ResourceMemory: resource.MustParse("4Gi"),
+	}
+	largeLimits := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("8"),
+		corev1.ResourceMemory: resource.MustParse("16Gi"),
+	}
+
+	type Expectation struct {
+		Limits      corev1.ResourceList
+		Annotations map[string]string
+	}
+	tests := []struct {
+		Name             string
+		Class            string
+		InPlacePodResize bool
+		Expectation      Expectation
+	}{
+		{
+			Name:             "class unchanged",
+			Class:            "small",
+			InPlacePodResize: true,
+			Expectation: Expectation{
+				Limits:      smallLimits,
+				Annotations: map[string]string{"gitpod.io/cpuMinLimit": "1", "gitpod.io/cpuBurstLimit": "2"},
+			},
+		},
+		{
+			Name:             "class changed",
+			Class:            "large",
+			InPlacePodResize: true,
+			Expectation: Expectation{
+				Limits:      largeLimits,
+				Annotations: map[string]string{"gitpod.io/cpuMinLimit": "4", "gitpod.io/cpuBurstLimit": "8"},
+			},
+		},
+		{
+			Name:  "in-place resize disabled",
+			Class: "large",
+			Expectation: Expectation{
+				Limits:      smallLimits,
+				Annotations: map[string]string{"gitpod.io/cpuMinLimit": "1", "gitpod.io/cpuBurstLimit": "2"},
+			},
+		},
+		{
+			Name:             "GPUs cannot be added in place",
+			Class:            "gpu",
+			InPlacePodResize: true,
+			Expectation: Expectation{
+				Limits:      smallLimits,
+				Annotations: map[string]string{"gitpod.io/cpuMinLimit": "1", "gitpod.io/cpuBurstLimit": "2"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "ws-foobar",
+					Namespace:   "default",
+					Annotations: map[string]string{"gitpod.io/cpuMinLimit": "1", "gitpod.io/cpuBurstLimit": "2"},
+				},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{
+					Name:      "workspace",
+					Resources: corev1.ResourceRequirements{Limits: smallLimits, Requests: corev1.ResourceList{}},
+				}}},
+			}
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithObjects(pod).Build()
+			r := &WorkspaceReconciler{
+				Client:   clnt,
+				Config:   &config.Configuration{WorkspaceClasses: classes, InPlacePodResize: test.InPlacePodResize},
+				Recorder: record.NewFakeRecorder(10),
+			}
+			cpuLimits := classes[test.Class].Container.Limits.CPU
+			ws := &workspacev1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "foobar",
+					Annotations: map[string]string{"gitpod.io/cpuMinLimit": cpuLimits.MinLimit, "gitpod.io/cpuBurstLimit": cpuLimits.BurstLimit},
+				},
+				Spec: workspacev1.WorkspaceSpec{Class: test.Class},
+			}
+
+			err := r.resizeWorkspacePod(context.Background(), ws, pod)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var act corev1.Pod
+			err = clnt.Get(context.Background(), client.ObjectKeyFromObject(pod), &act)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, Expectation{Limits: act.Spec.Containers[0].Resources.Limits, Annotations: act.Annotations}); diff != "" {
+				t.Errorf("unexpected pod (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsReplacedByRelocation(t *testing.T) {
+	relocating := metav1.Now()
+	before := metav1.NewTime(relocating.Add(-time.Hour))
+	after := metav1.NewTime(relocating.Add(time.Hour))
+
+	tests := []struct {
+		Name        string
+		NodeName    string
+		Created     metav1.Time
+		Expectation bool
+	}{
+		{Name: "other node", NodeName: "node-a", Created: before, Expectation: true},
+		{Name: "restart on the same node", NodeName: "node-b", Created: before, Expectation: true},
+		{Name: "replacement pod", NodeName: "node-b", Created: after},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cond := workspacev1.NewWorkspaceConditionRelocating("node-b")
+			cond.LastTransitionTime = relocating
+			ws := &workspacev1.Workspace{Status: workspacev1.WorkspaceStatus{Conditions: []metav1.Condition{cond}}}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: test.Created},
+				Spec:       corev1.PodSpec{NodeName: test.NodeName},
+			}
+
+			act := isReplacedByRelocation(ws, pod)
+			if act != test.Expectation {
+				t.Errorf("unexpected result: expected %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
 	"github.com/gitpod-io/gitpod/common-go/tracing"
@@ -37,6 +38,15 @@ const (
 	// headlessTaskFailedPrefix is the prefix of the pod termination message if a headless task failed (e.g. user error
 	// or aborted prebuild).
 	headlessTaskFailedPrefix = "headless task failed: "
+
+	// reasonErrImagePull is the waiting reason of a container whose image the kubelet failed to pull.
+	// The waiting message carries the error of the container runtime.
+	reasonErrImagePull = "ErrImagePull"
+	// reasonImagePullBackOff is the waiting reason of a container while the kubelet backs off from pulling its image again
+	reasonImagePullBackOff = "ImagePullBackOff"
+
+	// gpuProductLabel is set on GPU nodes by NVIDIA's GPU feature discovery
+	gpuProductLabel = "nvidia.com/gpu.product"
 )
 
 func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspace *workspacev1.Workspace, pods *corev1.PodList, cfg *config.Configuration) (err error) {
@@ -52,6 +62,20 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 		}
 	}()
 
+	workspace.Status.Admission = workspace.Spec.Admission.Level
+
+	if workspace.RelocationTarget() != "" {
+		if reason := relocationCancelReason(workspace); reason != "" {
+			workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionRelocationCancelled(reason))
+			if len(pods.Items) == 0 && workspace.Status.Phase == workspacev1.WorkspacePhasePending && workspace.Status.PodStarts > 0 {
+				// The previous pod is gone and its content was backed up before the replacement pod got created.
+				workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionBackupComplete())
+				workspace.Status.Phase = workspacev1.WorkspacePhaseStopped
+				return nil
+			}
+		}
+	}
+
 	switch len(pods.Items) {
 	case 0:
 		if workspace.Status.Phase == "" {
@@ -59,6 +83,11 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 		}
 
 		if workspace.Status.Phase == workspacev1.WorkspacePhaseStopping && isDisposalFinished(workspace) {
+			if isRelocationReady(workspace) {
+				// The old pod is gone and its content is backed up, start over on the target node.
+				resetForRelocation(workspace)
+				return nil
+			}
 			workspace.Status.Phase = workspacev1.WorkspacePhaseStopped
 		}
 
@@ -95,6 +124,12 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 		workspace.Status.Runtime.PodName = pod.Name
 	}
 
+	updateImagePullStatus(workspace, pod)
+
+	if err := r.updateGPUStatus(ctx, workspace, pod, cfg); err != nil {
+		return err
+	}
+
 	// Check if the node has disappeared. If so, ws-daemon has also disappeared and we need to
 	// mark the workspace backup as failed if it didn't complete disposal yet.
 	// Otherwise, the workspace will be stuck in the Stopping phase forever.
@@ -102,6 +137,15 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 		return err
 	}
 
+	// Stop the workspace while its node can still back up the content if the node is about to be preempted.
+	if err := r.checkNodePreempted(ctx, workspace, pod, cfg); err != nil {
+		return err
+	}
+
+	// Give up on the final backup once the grace period of the workspace class is over,
+	// such that the pod doesn't hold on to the node forever.
+	checkBackupGracePeriod(ctx, workspace, pod, cfg)
+
 	if workspace.Status.URL == "" {
 		url, err := config.RenderWorkspaceURL(cfg.WorkspaceURLTemplate, workspace.Name, workspace.Spec.Ownership.WorkspaceID, cfg.GitpodHostURL)
 		if err != nil {
@@ -118,6 +162,10 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 		workspace.Status.OwnerToken = ownerToken
 	}
 
+	if isWorkspaceContainerOOMKilled(pod) {
+		workspace.SetStopReason(workspacev1.StopReasonOOMKilled, "workspace container ran out of memory")
+	}
+
 	failure, phase := r.extractFailure(ctx, workspace, pod)
 	if phase != nil {
 		workspace.Status.Phase = *phase
@@ -126,8 +174,12 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 	if failure != "" && !workspace.IsConditionTrue(workspacev1.WorkspaceConditionFailed) {
 		// workspaces can fail only once - once there is a failed condition set, stick with it
 		log.Info("workspace failed", "workspace", workspace.Name, "reason", failure)
+		if workspace.IsConditionTrue(workspacev1.WorkspaceConditionBackupFailure) && !isStopRequested(workspace) {
+			// a backup which fails while stopping is a consequence of the stop, not its reason
+			workspace.SetStopReason(workspacev1.StopReasonBackupFailure, failure)
+		}
 		workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionFailed(failure))
-		r.Recorder.Event(workspace, corev1.EventTypeWarning, "Failed", failure)
+		recordEvent(r.Recorder, workspace, pod, corev1.EventTypeWarning, "Failed", failure)
 	}
 
 	if workspace.IsHeadless() && !workspace.IsConditionTrue(workspacev1.WorkspaceConditionsHeadlessTaskFailed) {
@@ -145,9 +197,10 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 		workspace.UpsertConditionOnStatusChange(workspacev1.NewWorkspaceConditionContainerRunning(metav1.ConditionFalse))
 	}
 
+	headlessFinished, headlessSucceeded := isWorkspaceContainerFinished(pod)
 	switch {
 	case isPodBeingDeleted(pod):
-		if workspace.Status.Phase == workspacev1.WorkspacePhaseStopping && isDisposalFinished(workspace) {
+		if workspace.Status.Phase == workspacev1.WorkspacePhaseStopping && isDisposalFinished(workspace) && !isRelocationReady(workspace) {
 			workspace.Status.Phase = workspacev1.WorkspacePhaseStopped
 		} else if workspace.Status.Phase != workspacev1.WorkspacePhaseStopped {
 			// Move to (or stay in) Stopping if not yet Stopped.
@@ -160,7 +213,7 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 		for _, cs := range pod.Status.ContainerStatuses {
 			if cs.State.Waiting != nil {
 				switch cs.State.Waiting.Reason {
-				case "ContainerCreating", "ImagePullBackOff", "ErrImagePull":
+				case "ContainerCreating", reasonImagePullBackOff, reasonErrImagePull:
 					creating = true
 				}
 
@@ -175,6 +228,28 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 			workspace.Status.Phase = workspacev1.WorkspacePhasePending
 		}
 
+	case workspace.IsHeadless() && headlessFinished:
+		if code := workspaceContainerExitCode(pod); code != nil {
+			workspace.Status.ExitCode = code
+		}
+		if headlessSucceeded && !workspace.IsConditionTrue(workspacev1.WorkspaceConditionEverReady) {
+			// Fix for Prebuilds that instantly succeed (e.g. empty task), sometimes we don't observe the
+			// workspace `Running` phase for these, and never had the opportunity to add the EverReady condition.
+			// This would then cause a "start failure" in the metrics. So we retroactively add the EverReady
+			// condition here if the pod succeeded.
+			workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionEverReady())
+		}
+
+		if workspace.Status.Phase == workspacev1.WorkspacePhaseStopping && isDisposalFinished(workspace) {
+			workspace.Status.Phase = workspacev1.WorkspacePhaseStopped
+		} else if workspace.Status.Phase != workspacev1.WorkspacePhaseStopped {
+			// Should be in Stopping phase, but isn't yet.
+			// Move to Stopping to start disposal, but only if maintenance mode is disabled.
+			if !r.maintenance.IsEnabled(ctx) {
+				workspace.Status.Phase = workspacev1.WorkspacePhaseStopping
+			}
+		}
+
 	case pod.Status.Phase == corev1.PodRunning:
 		everReady := workspace.IsConditionTrue(workspacev1.WorkspaceConditionEverReady)
 		if everReady {
@@ -199,31 +274,16 @@ func (r *WorkspaceReconciler) updateWorkspaceStatus(ctx context.Context, workspa
 				if !workspace.IsConditionTrue(workspacev1.WorkspaceConditionEverReady) {
 					workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionEverReady())
 				}
+				if workspace.RelocationTarget() != "" && pod.Spec.NodeName == workspace.RelocationTarget() {
+					workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionRelocated())
+					recordEvent(r.Recorder, workspace, pod, corev1.EventTypeNormal, "Relocated", pod.Spec.NodeName)
+				}
 			} else {
 				// workspace has not become ready yet - it must be initializing then.
 				workspace.Status.Phase = workspacev1.WorkspacePhaseInitializing
 			}
 		}
 
-	case workspace.IsHeadless() && (pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed):
-		if pod.Status.Phase == corev1.PodSucceeded && !workspace.IsConditionTrue(workspacev1.WorkspaceConditionEverReady) {
-			// Fix for Prebuilds that instantly succeed (e.g. empty task), sometimes we don't observe the
-			// workspace `Running` phase for these, and never had the opportunity to add the EverReady condition.
-			// This would then cause a "start failure" in the metrics. So we retroactively add the EverReady
-			// condition here if the pod succeeded.
-			workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionEverReady())
-		}
-
-		if workspace.Status.Phase == workspacev1.WorkspacePhaseStopping && isDisposalFinished(workspace) {
-			workspace.Status.Phase = workspacev1.WorkspacePhaseStopped
-		} else if workspace.Status.Phase != workspacev1.WorkspacePhaseStopped {
-			// Should be in Stopping phase, but isn't yet.
-			// Move to Stopping to start disposal, but only if maintenance mode is disabled.
-			if !r.maintenance.IsEnabled(ctx) {
-				workspace.Status.Phase = workspacev1.WorkspacePhaseStopping
-			}
-		}
-
 	case pod.Status.Phase == corev1.PodUnknown:
 		workspace.Status.Phase = workspacev1.WorkspacePhaseUnknown
 
@@ -262,14 +322,150 @@ func (r *WorkspaceReconciler) checkNodeDisappeared(ctx context.Context, workspac
 		workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionBackupFailure("workspace node disappeared before backup was taken"))
 	}
 
+	if !isStopRequested(workspace) {
+		workspace.SetStopReason(workspacev1.StopReasonNodeFailure, fmt.Sprintf("node %s disappeared", pod.Spec.NodeName))
+	}
+
 	// Must set this after checking isDisposalFinished, as that method also checks for the NodeDisappeared condition.
 	workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionNodeDisappeared())
 	return nil
 }
 
+func (r *WorkspaceReconciler) checkNodePreempted(ctx context.Context, workspace *workspacev1.Workspace, pod *corev1.Pod, cfg *config.Configuration) (err error) {
+	span, ctx := tracing.FromContext(ctx, "checkNodePreempted")
+	defer tracing.FinishSpan(span, &err)
+
+	if cfg.NodePreemption == nil || pod.Spec.NodeName == "" {
+		return nil
+	}
+	if workspace.IsConditionTrue(workspacev1.WorkspaceConditionNodePreempted) ||
+		workspace.Status.Phase == workspacev1.WorkspacePhaseStopping || workspace.Status.Phase == workspacev1.WorkspacePhaseStopped {
+		return nil
+	}
+
+	var node corev1.Node
+	err = r.Get(ctx, types.NamespacedName{Namespace: "", Name: pod.Spec.NodeName}, &node)
+	if errors.IsNotFound(err) {
+		// checkNodeDisappeared takes care of this
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	signal := nodePreemptionSignal(cfg.NodePreemption, &node)
+	if signal == "" {
+		return nil
+	}
+	log.FromContext(ctx).Info("workspace node is being preempted", "node", node.Name, "signal", signal)
+	workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionNodePreempted(signal))
+	if !isStopRequested(workspace) {
+		workspace.SetStopReason(workspacev1.StopReasonNodeFailure, fmt.Sprintf("node %s is being preempted: %s", node.Name, signal))
+	}
+	return nil
+}
+
+// checkBackupGracePeriod marks the backup of a workspace as failed if it didn't complete within the backup grace period
+// of its class after the pod deletion was requested
+func checkBackupGracePeriod(ctx context.Context, workspace *workspacev1.Workspace, pod *corev1.Pod, cfg *config.Configuration) {
+	if !isPodBeingDeleted(pod) || isDisposalFinished(workspace) {
+		return
+	}
+
+	gracePeriod := cfg.BackupGracePeriod(workspace.Spec.Class)
+	if time.Since(podDeletionRequested(pod)) < gracePeriod {
+		return
+	}
+
+	log.FromContext(ctx).Info("workspace backup did not complete within the grace period", "gracePeriod", gracePeriod)
+	workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionBackupFailure(fmt.Sprintf("backup did not complete within %s", gracePeriod)))
+}
+
+// backupGracePeriodRemaining returns how much of the backup grace period of the workspace is left
+func backupGracePeriodRemaining(workspace *workspacev1.Workspace, pod *corev1.Pod, cfg *config.Configuration) time.Duration {
+	return cfg.BackupGracePeriod(workspace.Spec.Class) - time.Since(podDeletionRequested(pod))
+}
+
+// podDeletionRequested returns when the deletion of a pod was requested. The deletion timestamp of a pod
+// lies its termination grace period after the request.
+func podDeletionRequested(pod *corev1.Pod) time.Time {
+	requested := pod.DeletionTimestamp.Time
+	if pod.DeletionGracePeriodSeconds != nil {
+		requested = requested.Add(-time.Duration(*pod.DeletionGracePeriodSeconds) * time.Second)
+	}
+	return requested
+}
+
+// nodePreemptionSignal returns the condition or taint which marks a node as being preempted, or an empty string
+// if the node is not being preempted.
+func nodePreemptionSignal(cfg *config.NodePreemptionConfiguration, node *corev1.Node) string {
+	for _, tpe := range cfg.NodeConditions {
+		for _, c := range node.Status.Conditions {
+			if string(c.Type) == tpe && c.Status == corev1.ConditionTrue {
+				return fmt.Sprintf("node condition %s", tpe)
+			}
+		}
+	}
+	for _, key := range cfg.Taints {
+		for _, t := range node.Spec.Taints {
+			if t.Key == key {
+				return fmt.Sprintf("node taint %s", key)
+			}
+		}
+	}
+	return ""
+}
+
+// updateGPUStatus reflects the GPUs allocated to the workspace pod, including the GPU model of the node once the pod is scheduled.
+func (r *WorkspaceReconciler) updateGPUStatus(ctx context.Context, workspace *workspacev1.Workspace, pod *corev1.Pod, cfg *config.Configuration) error {
+	class, ok := cfg.WorkspaceClasses[workspace.Spec.Class]
+	if !ok || class.GPU == nil {
+		workspace.Status.GPU = nil
+		return nil
+	}
+	resourceName := class.GPU.GetResourceName()
+
+	var count int64
+	for _, c := range pod.Spec.Containers {
+		if q, ok := c.Resources.Limits[resourceName]; ok {
+			count += q.Value()
+		}
+	}
+	if count == 0 {
+		workspace.Status.GPU = nil
+		return nil
+	}
+
+	if workspace.Status.GPU == nil {
+		workspace.Status.GPU = &workspacev1.GPUStatus{}
+	}
+	workspace.Status.GPU.Count = count
+	workspace.Status.GPU.ResourceName = string(resourceName)
+	if workspace.Status.GPU.NodeName == pod.Spec.NodeName {
+		// the pod isn't scheduled yet, or we've already looked at its node
+		return nil
+	}
+
+	var node corev1.Node
+	err := r.Get(ctx, types.NamespacedName{Namespace: "", Name: pod.Spec.NodeName}, &node)
+	if errors.IsNotFound(err) {
+		// checkNodeDisappeared takes care of this
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// nodes without GPU feature discovery don't have the label, which we must not look up again on every reconcile
+	workspace.Status.GPU.Product = node.Labels[gpuProductLabel]
+	workspace.Status.GPU.NodeName = node.Name
+	return nil
+}
+
 func isDisposalFinished(ws *workspacev1.Workspace) bool {
 	return ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete) ||
 		ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupFailure) ||
+		// Ephemeral workspaces are never backed up, only their content is deleted.
+		ws.IsConditionTrue(workspacev1.WorkspaceConditionContentDeleted) ||
 		ws.IsConditionTrue(workspacev1.WorkspaceConditionAborted) ||
 		// Nothing to dispose if content wasn't ready.
 		!ws.IsConditionTrue(workspacev1.WorkspaceConditionContentReady) ||
@@ -279,6 +475,54 @@ func isDisposalFinished(ws *workspacev1.Workspace) bool {
 		ws.Spec.Type == workspacev1.WorkspaceTypeImageBuild
 }
 
+// isRelocationReady returns true if the workspace is being relocated and the content of its
+// previous pod has been backed up.
+func isRelocationReady(ws *workspacev1.Workspace) bool {
+	return ws.RelocationTarget() != "" &&
+		ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete) &&
+		relocationCancelReason(ws) == ""
+}
+
+// relocationCancelReason returns why a relocation must not continue, or an empty string if it may.
+// A workspace that is stopped while being relocated must end up stopped, not on the target node.
+func relocationCancelReason(ws *workspacev1.Workspace) string {
+	switch {
+	case isWorkspaceBeingDeleted(ws):
+		return "workspace deleted"
+	case ws.IsConditionTrue(workspacev1.WorkspaceConditionFailed):
+		return "workspace failed"
+	case ws.IsConditionTrue(workspacev1.WorkspaceConditionAborted):
+		return "workspace aborted"
+	case ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest):
+		return "workspace stopped by request"
+	case ws.IsConditionTrue(workspacev1.WorkspaceConditionTimeout):
+		return "workspace timed out"
+	default:
+		return ""
+	}
+}
+
+// resetForRelocation clears the status of a workspace whose previous pod is gone, such that
+// a replacement pod gets created and its content restored from the backup.
+func resetForRelocation(ws *workspacev1.Workspace) {
+	conds := make([]metav1.Condition, 0, len(ws.Status.Conditions))
+	for _, c := range ws.Status.Conditions {
+		switch workspacev1.WorkspaceCondition(c.Type) {
+		case workspacev1.WorkspaceConditionDeployed,
+			workspacev1.WorkspaceConditionContentReady,
+			workspacev1.WorkspaceConditionEverReady,
+			workspacev1.WorkspaceConditionBackupComplete,
+			workspacev1.WorkspaceConditionContainerRunning:
+			continue
+		}
+		conds = append(conds, c)
+	}
+	ws.Status.Conditions = conds
+	ws.Status.Runtime = nil
+	ws.Status.GPU = nil
+	ws.Status.Phase = workspacev1.WorkspacePhasePending
+}
+
 // extractFailure returns a pod failure reason and possibly a phase. If phase is nil then
 // one should extract the phase themselves. If the pod has not failed, this function returns "", nil.
 // This failure is then stored in the Failed condition on the workspace.
@@ -316,7 +560,7 @@ func (r *WorkspaceReconciler) extractFailure(ctx context.Context, ws *workspacev
 
 	for _, cs := range status.ContainerStatuses {
 		if cs.State.Waiting != nil {
-			if cs.State.Waiting.Reason == "ImagePullBackOff" || cs.State.Waiting.Reason == "ErrImagePull" {
+			if cs.State.Waiting.Reason == reasonImagePullBackOff || cs.State.Waiting.Reason == reasonErrImagePull {
 				// If the image pull failed we were definitely in the api.WorkspacePhase_CREATING phase,
 				// unless of course this pod has been deleted already.
 				var res *workspacev1.WorkspacePhase
@@ -329,7 +573,7 @@ func (r *WorkspaceReconciler) extractFailure(ctx context.Context, ws *workspacev
 					c := workspacev1.WorkspacePhaseCreating
 					res = &c
 				}
-				return fmt.Sprintf("cannot pull image: %s", cs.State.Waiting.Message), res
+				return imagePullFailure(cs), res
 			}
 		}
 
@@ -396,6 +640,39 @@ func (r *WorkspaceReconciler) extractFailure(ctx context.Context, ws *workspacev
 	return "", nil
 }
 
+// updateImagePullStatus sets the PullingImageFailed condition while the kubelet cannot pull an image of the workspace pod,
+// such that clients learn which image failed rather than only seeing the workspace stuck in Creating.
+func updateImagePullStatus(ws *workspacev1.Workspace, pod *corev1.Pod) {
+	// sidecars run as init containers
+	statuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, cs := range statuses {
+		if cs.State.Waiting == nil {
+			continue
+		}
+		switch cs.State.Waiting.Reason {
+		case reasonErrImagePull:
+			ws.UpsertConditionOnStatusChange(workspacev1.NewWorkspaceConditionPullingImageFailed(reasonErrImagePull, imagePullFailure(cs)))
+			return
+		case reasonImagePullBackOff:
+			// The back-off message does not tell why the pull failed. Keep the error of the failed pull if we saw it.
+			if !ws.IsConditionTrue(workspacev1.WorkspaceConditionPullingImageFailed) {
+				ws.Status.SetCondition(workspacev1.NewWorkspaceConditionPullingImageFailed(reasonImagePullBackOff, imagePullFailure(cs)))
+			}
+			return
+		}
+	}
+
+	if ws.IsConditionTrue(workspacev1.WorkspaceConditionPullingImageFailed) && !isPodBeingDeleted(pod) {
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionImagePulled())
+	}
+}
+
+func imagePullFailure(cs corev1.ContainerStatus) string {
+	return fmt.Sprintf("cannot pull image %s: %s", cs.Image, cs.State.Waiting.Message)
+}
+
 func isWorkspaceContainerRunning(statuses []corev1.ContainerStatus) bool {
 	for _, cs := range statuses {
 		if cs.Name == "workspace" {
@@ -408,6 +685,52 @@ func isWorkspaceContainerRunning(statuses []corev1.ContainerStatus) bool {
 	return false
 }
 
+// isWorkspaceContainerFinished returns whether the workspace container has terminated, and if so whether it succeeded.
+// Sidecars are stopped only after the workspace container exited, hence the workspace container can be done while the
+// pod is still running. Waiting for the pod to finish would let sidecars delay the backup of headless workspaces.
+func isWorkspaceContainerFinished(pod *corev1.Pod) (finished bool, succeeded bool) {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return true, true
+	case corev1.PodFailed:
+		return true, false
+	}
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == "workspace" {
+			if cs.State.Terminated != nil {
+				return true, cs.State.Terminated.ExitCode == 0
+			}
+			break
+		}
+	}
+	return false, false
+}
+
+// isWorkspaceContainerOOMKilled returns whether the kernel killed the workspace container because it exceeded its memory limit
+func isWorkspaceContainerOOMKilled(pod *corev1.Pod) bool {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == "workspace" {
+			terminated := cs.State.Terminated
+			if terminated == nil {
+				terminated = cs.LastTerminationState.Terminated
+			}
+			return terminated != nil && terminated.Reason == "OOMKilled"
+		}
+	}
+	return false
+}
+
+// workspaceContainerExitCode returns the exit code of the workspace container, or nil if it has not terminated
+func workspaceContainerExitCode(pod *corev1.Pod) *int32 {
+	for _, cs := range pod.Status.ContainerStatuses {
+		if cs.Name == "workspace" && cs.State.Terminated != nil {
+			code := cs.State.Terminated.ExitCode
+			return &code
+		}
+	}
+	return nil
+}
+
 // extractFailureFromLogs attempts to extract the last error message from a workspace
 // container's log output.
 func extractFailureFromLogs(logs []byte) string {
@@ -0,0 +1,559 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	v1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUpdateGPUStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	cfg := &config.Configuration{
+		WorkspaceClasses: map[string]*config.WorkspaceClass{
+			"default": {},
+			"gpu":     {GPU: &config.GPUConfiguration{Count: 1}},
+		},
+	}
+	gpuPod := func(nodeName string) *corev1.Pod {
+		return &corev1.Pod{Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Containers: []corev1.Container{{
+				Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+					config.DefaultGPUResourceName: *resource.NewQuantity(1, resource.DecimalSI),
+				}},
+			}},
+		}}
+	}
+
+	type Expectation struct {
+		Status *v1.GPUStatus
+		Gets   int
+	}
+	tests := []struct {
+		Name        string
+		Class       string
+		Pod         *corev1.Pod
+		Status      *v1.GPUStatus
+		Expectation Expectation
+	}{
+		{
+			Name:        "class without GPU",
+			Class:       "default",
+			Pod:         gpuPod("gpu-node"),
+			Expectation: Expectation{},
+		},
+		{
+			Name:  "not scheduled yet",
+			Class: "gpu",
+			Pod:   gpuPod(""),
+			Expectation: Expectation{
+				Status: &v1.GPUStatus{Count: 1, ResourceName: config.DefaultGPUResourceName},
+			},
+		},
+		{
+			Name:  "scheduled",
+			Class: "gpu",
+			Pod:   gpuPod("gpu-node"),
+			Expectation: Expectation{
+				Status: &v1.GPUStatus{Count: 1, ResourceName: config.DefaultGPUResourceName, Product: "NVIDIA-A100", NodeName: "gpu-node"},
+				Gets:   1,
+			},
+		},
+		{
+			Name:  "node without product label",
+			Class: "gpu",
+			Pod:   gpuPod("plain-node"),
+			Expectation: Expectation{
+				Status: &v1.GPUStatus{Count: 1, ResourceName: config.DefaultGPUResourceName, NodeName: "plain-node"},
+				Gets:   1,
+			},
+		},
+		{
+			Name:   "node without product label was looked up already",
+			Class:  "gpu",
+			Pod:    gpuPod("plain-node"),
+			Status: &v1.GPUStatus{Count: 1, ResourceName: config.DefaultGPUResourceName, NodeName: "plain-node"},
+			Expectation: Expectation{
+				Status: &v1.GPUStatus{Count: 1, ResourceName: config.DefaultGPUResourceName, NodeName: "plain-node"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			clnt := &countingClient{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "gpu-node", Labels: map[string]string{gpuProductLabel: "NVIDIA-A100"}}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "plain-node"}},
+			).Build()}
+			r := &WorkspaceReconciler{Client: clnt}
+			ws := &v1.Workspace{
+				Spec:   v1.WorkspaceSpec{Class: test.Class},
+				Status: v1.WorkspaceStatus{GPU: test.Status},
+			}
+
+			err := r.updateGPUStatus(context.Background(), ws, test.Pod, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act := Expectation{Status: ws.Status.GPU, Gets: clnt.Gets}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected GPU status (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type countingClient struct {
+	client.Client
+	Gets int
+}
+
+func (c *countingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	c.Gets++
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+func TestUpdateImagePullStatus(t *testing.T) {
+	const image = "reg.gitpod.io/remote/foobar"
+	waitingPod := func(reason, message string) *corev1.Pod {
+		return &corev1.Pod{Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "workspace",
+				Image: image,
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason, Message: message}},
+			}},
+		}}
+	}
+	pullFailed := func(reason, message string) *metav1.Condition {
+		c := v1.NewWorkspaceConditionPullingImageFailed(reason, message)
+		return &c
+	}
+
+	type Expectation struct {
+		Status  metav1.ConditionStatus
+		Reason  string
+		Message string
+	}
+	tests := []struct {
+		Name        string
+		Pod         *corev1.Pod
+		Condition   *metav1.Condition
+		Expectation *Expectation
+	}{
+		{
+			Name:        "creating",
+			Pod:         waitingPod("ContainerCreating", ""),
+			Expectation: nil,
+		},
+		{
+			Name: "pull failed",
+			Pod:  waitingPod("ErrImagePull", "manifest unknown"),
+			Expectation: &Expectation{
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrImagePull",
+				Message: "cannot pull image " + image + ": manifest unknown",
+			},
+		},
+		{
+			Name:      "back-off keeps the pull error",
+			Pod:       waitingPod("ImagePullBackOff", `Back-off pulling image "`+image+`"`),
+			Condition: pullFailed("ErrImagePull", "cannot pull image "+image+": manifest unknown"),
+			Expectation: &Expectation{
+				Status:  metav1.ConditionTrue,
+				Reason:  "ErrImagePull",
+				Message: "cannot pull image " + image + ": manifest unknown",
+			},
+		},
+		{
+			Name: "back-off without pull error",
+			Pod:  waitingPod("ImagePullBackOff", `Back-off pulling image "`+image+`"`),
+			Expectation: &Expectation{
+				Status:  metav1.ConditionTrue,
+				Reason:  "ImagePullBackOff",
+				Message: "cannot pull image " + image + `: Back-off pulling image "` + image + `"`,
+			},
+		},
+		{
+			Name:      "pulled eventually",
+			Pod:       waitingPod("ContainerCreating", ""),
+			Condition: pullFailed("ErrImagePull", "cannot pull image "+image+": i/o timeout"),
+			Expectation: &Expectation{
+				Status: metav1.ConditionFalse,
+				Reason: "ImagePulled",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ws := &v1.Workspace{}
+			if test.Condition != nil {
+				ws.Status.SetCondition(*test.Condition)
+			}
+
+			updateImagePullStatus(ws, test.Pod)
+
+			var act *Expectation
+			for _, c := range ws.Status.Conditions {
+				if c.Type == string(v1.WorkspaceConditionPullingImageFailed) {
+					act = &Expectation{Status: c.Status, Reason: c.Reason, Message: c.Message}
+				}
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected PullingImageFailed condition (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateWorkspaceStatusSidecars(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	pod := func(phase corev1.PodPhase, workspace corev1.ContainerState) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "ws-foobar"},
+			Status: corev1.PodStatus{
+				Phase: phase,
+				InitContainerStatuses: []corev1.ContainerStatus{{
+					Name:  config.SidecarContainerName("vpn"),
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				}},
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "workspace",
+					State: workspace,
+				}},
+			},
+		}
+	}
+	var (
+		running   = corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+		completed = corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}}
+	)
+
+	type Expectation struct {
+		Phase            v1.WorkspacePhase
+		ContainerRunning bool
+		EverReady        bool
+	}
+	tests := []struct {
+		Name        string
+		Type        v1.WorkspaceType
+		Pod         *corev1.Pod
+		Expectation Expectation
+	}{
+		{
+			Name: "prebuild running",
+			Type: v1.WorkspaceTypePrebuild,
+			Pod:  pod(corev1.PodRunning, running),
+			Expectation: Expectation{
+				Phase:            v1.WorkspacePhaseInitializing,
+				ContainerRunning: true,
+			},
+		},
+		{
+			Name: "prebuild finished while sidecars still run",
+			Type: v1.WorkspaceTypePrebuild,
+			Pod:  pod(corev1.PodRunning, completed),
+			Expectation: Expectation{
+				Phase:     v1.WorkspacePhaseStopping,
+				EverReady: true,
+			},
+		},
+		{
+			Name: "prebuild pod succeeded",
+			Type: v1.WorkspaceTypePrebuild,
+			Pod:  pod(corev1.PodSucceeded, completed),
+			Expectation: Expectation{
+				Phase:     v1.WorkspacePhaseStopping,
+				EverReady: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			r := &WorkspaceReconciler{
+				Client:      fake.NewClientBuilder().WithScheme(scheme).Build(),
+				maintenance: &fakeMaintenance{},
+				Recorder:    record.NewFakeRecorder(10),
+			}
+			ws := &v1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "foobar"},
+				Spec:       v1.WorkspaceSpec{Type: test.Type},
+				Status: v1.WorkspaceStatus{
+					Phase:      v1.WorkspacePhaseInitializing,
+					URL:        "https://foobar.gitpod.io",
+					OwnerToken: "token",
+				},
+			}
+
+			err := r.updateWorkspaceStatus(context.Background(), ws, &corev1.PodList{Items: []corev1.Pod{*test.Pod}}, &config.Configuration{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			act := Expectation{
+				Phase:            ws.Status.Phase,
+				ContainerRunning: ws.IsConditionTrue(v1.WorkspaceConditionContainerRunning),
+				EverReady:        ws.IsConditionTrue(v1.WorkspaceConditionEverReady),
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected workspace status (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckNodePreempted(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	cfg := &config.Configuration{
+		NodePreemption: &config.NodePreemptionConfiguration{
+			NodeConditions: []string{"TerminationImminent"},
+			Taints:         []string{"aws-node-termination-handler/spot-itn"},
+		},
+	}
+
+	tests := []struct {
+		Name        string
+		Config      *config.Configuration
+		Node        *corev1.Node
+		Phase       v1.WorkspacePhase
+		Expectation string
+	}{
+		{
+			Name:   "healthy node",
+			Config: cfg,
+			Node:   &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+			Phase:  v1.WorkspacePhaseRunning,
+		},
+		{
+			Name:   "node condition",
+			Config: cfg,
+			Node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+					{Type: "TerminationImminent", Status: corev1.ConditionTrue},
+				}},
+			},
+			Phase:       v1.WorkspacePhaseRunning,
+			Expectation: "node condition TerminationImminent",
+		},
+		{
+			Name:   "node condition false",
+			Config: cfg,
+			Node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+					{Type: "TerminationImminent", Status: corev1.ConditionFalse},
+				}},
+			},
+			Phase: v1.WorkspacePhaseRunning,
+		},
+		{
+			Name:   "node taint",
+			Config: cfg,
+			Node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+					{Key: "aws-node-termination-handler/spot-itn", Effect: corev1.TaintEffectNoSchedule},
+				}},
+			},
+			Phase:       v1.WorkspacePhaseInitializing,
+			Expectation: "node taint aws-node-termination-handler/spot-itn",
+		},
+		{
+			Name:   "already stopping",
+			Config: cfg,
+			Node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+					{Key: "aws-node-termination-handler/spot-itn", Effect: corev1.TaintEffectNoSchedule},
+				}},
+			},
+			Phase: v1.WorkspacePhaseStopping,
+		},
+		{
+			Name:   "disabled",
+			Config: &config.Configuration{},
+			Node: &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+					{Key: "aws-node-termination-handler/spot-itn", Effect: corev1.TaintEffectNoSchedule},
+				}},
+			},
+			Phase: v1.WorkspacePhaseRunning,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			r := &WorkspaceReconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(test.Node).Build(),
+			}
+			ws := &v1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "foobar"},
+				Status:     v1.WorkspaceStatus{Phase: test.Phase},
+			}
+			pod := &corev1.Pod{Spec: corev1.PodSpec{NodeName: "node-1"}}
+
+			err := r.checkNodePreempted(context.Background(), ws, pod, test.Config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var act string
+			if ws.IsConditionTrue(v1.WorkspaceConditionNodePreempted) {
+				act = ws.Status.Conditions[0].Message
+			}
+			if act != test.Expectation {
+				t.Errorf("unexpected preemption: expect \"%s\", got \"%s\"", test.Expectation, act)
+			}
+			if preempted := ws.StopReason() == v1.StopReasonNodeFailure; preempted != (test.Expectation != "") {
+				t.Errorf("unexpected stop reason \"%s\"", ws.StopReason())
+			}
+		})
+	}
+}
+
+func TestCheckBackupGracePeriod(t *testing.T) {
+	classGracePeriod := util.Duration(20 * time.Minute)
+	cfg := &config.Configuration{
+		Timeouts: config.WorkspaceTimeoutConfiguration{
+			ContentFinalization: util.Duration(time.Minute),
+		},
+		WorkspaceClasses: map[string]*config.WorkspaceClass{
+			"default": {},
+			"large":   {BackupGracePeriod: &classGracePeriod},
+		},
+	}
+
+	tests := []struct {
+		Name        string
+		Class       string
+		DeletedAgo  time.Duration
+		Conditions  []metav1.Condition
+		Expectation bool
+	}{
+		{
+			Name:  "pod not being deleted",
+			Class: "default",
+		},
+		{
+			Name:       "within content finalization timeout",
+			Class:      "default",
+			DeletedAgo: 30 * time.Second,
+		},
+		{
+			Name:        "beyond content finalization timeout",
+			Class:       "default",
+			DeletedAgo:  2 * time.Minute,
+			Expectation: true,
+		},
+		{
+			Name:       "within class grace period",
+			Class:      "large",
+			DeletedAgo: 10 * time.Minute,
+		},
+		{
+			Name:        "beyond class grace period",
+			Class:       "large",
+			DeletedAgo:  30 * time.Minute,
+			Expectation: true,
+		},
+		{
+			Name:       "backup complete",
+			Class:      "default",
+			DeletedAgo: 2 * time.Minute,
+			Conditions: []metav1.Condition{v1.NewWorkspaceConditionBackupComplete()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ws := &v1.Workspace{
+				Spec: v1.WorkspaceSpec{Class: test.Class},
+				Status: v1.WorkspaceStatus{
+					Phase:      v1.WorkspacePhaseStopping,
+					Conditions: append([]metav1.Condition{v1.NewWorkspaceConditionContentReady(metav1.ConditionTrue, "", "")}, test.Conditions...),
+				},
+			}
+			pod := &corev1.Pod{}
+			if test.DeletedAgo != 0 {
+				// the deletion timestamp lies the termination grace period after the deletion request
+				gracePeriodSeconds := int64(30)
+				pod.DeletionGracePeriodSeconds = &gracePeriodSeconds
+				pod.DeletionTimestamp = &metav1.Time{Time: time.Now().Add(-test.DeletedAgo + 30*time.Second)}
+			}
+
+			checkBackupGracePeriod(context.Background(), ws, pod, cfg)
+
+			if act := ws.IsConditionTrue(v1.WorkspaceConditionBackupFailure); act != test.Expectation {
+				t.Errorf("unexpected backup failure: expected %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestIsWorkspaceContainerOOMKilled(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Statuses    []corev1.ContainerStatus
+		Expectation bool
+	}{
+		{
+			Name:     "running",
+			Statuses: []corev1.ContainerStatus{{Name: "workspace", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}},
+		},
+		{
+			Name:        "oom killed",
+			Statuses:    []corev1.ContainerStatus{{Name: "workspace", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}}}},
+			Expectation: true,
+		},
+		{
+			Name:        "oom killed before",
+			Statuses:    []corev1.ContainerStatus{{Name: "workspace", LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}}}},
+			Expectation: true,
+		},
+		{
+			Name:     "terminated with error",
+			Statuses: []corev1.ContainerStatus{{Name: "workspace", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}}}},
+		},
+		{
+			Name:     "sidecar oom killed",
+			Statuses: []corev1.ContainerStatus{{Name: "sidecar", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			pod := &corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: test.Statuses}}
+			if act := isWorkspaceContainerOOMKilled(pod); act != test.Expectation {
+				t.Errorf("unexpected result: got %v, want %v", act, test.Expectation)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
@@ -84,6 +85,11 @@ func (r *TimeoutReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 	log = log.WithValues("owi", workspace.OWI())
 	ctx = logr.NewContext(ctx, log)
 
+	err = r.expireAdmission(ctx, &workspace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	if workspace.IsConditionTrue(workspacev1.WorkspaceConditionTimeout) {
 		// Workspace has already been marked as timed out.
 		// Return and don't requeue another reconciliation.
@@ -103,7 +109,7 @@ func (r *TimeoutReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		result.RequeueAfter = r.reconcileInterval
 	}()
 
-	timedout := r.isWorkspaceTimedOut(&workspace)
+	timedout, activity := r.isWorkspaceTimedOut(&workspace)
 	if timedout == "" {
 		// Hasn't timed out.
 		return ctrl.Result{}, nil
@@ -118,16 +124,54 @@ func (r *TimeoutReconciler) Reconcile(ctx context.Context, req ctrl.Request) (re
 		}
 
 		workspace.Status.SetCondition(workspacev1.NewWorkspaceConditionTimeout(timedout))
+		if activity == activityNone || activity == activityClosed {
+			workspace.SetStopReason(workspacev1.StopReasonTimeoutInactivity, timedout)
+		}
 		return r.Status().Update(ctx, &workspace)
 	}); err != nil {
 		log.Error(err, "Failed to update workspace status with Timeout condition")
 		return ctrl.Result{}, fmt.Errorf("failed to add timeout condition: %w", err)
 	}
 
-	r.recorder.Event(&workspace, corev1.EventTypeNormal, "TimedOut", timedout)
+	recordEvent(r.recorder, &workspace, getWorkspacePod(ctx, r.Client, &workspace), corev1.EventTypeNormal, "TimedOut", timedout)
 	return ctrl.Result{}, nil
 }
 
+// expireAdmission reverts the admission level of a shared workspace to owner only once the sharing expired.
+// The workspace controller picks up the spec change and publishes the new admission level with the workspace status.
+func (r *TimeoutReconciler) expireAdmission(ctx context.Context, workspace *workspacev1.Workspace) error {
+	if expiry := workspace.Spec.Admission.Expiry; expiry == nil || time.Now().Before(expiry.Time) {
+		return nil
+	}
+
+	var expired bool
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		err := r.Get(ctx, types.NamespacedName{Name: workspace.Name, Namespace: workspace.Namespace}, workspace)
+		if err != nil {
+			return err
+		}
+		if expiry := workspace.Spec.Admission.Expiry; expiry == nil || time.Now().Before(expiry.Time) {
+			// sharing was changed in the meantime
+			expired = false
+			return nil
+		}
+
+		workspace.Spec.Admission.Level = workspacev1.AdmissionLevelOwner
+		workspace.Spec.Admission.Expiry = nil
+		expired = true
+		return r.Update(ctx, workspace)
+	})
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to revert expired workspace admission")
+		return fmt.Errorf("failed to revert expired admission: %w", err)
+	}
+
+	if expired {
+		recordEvent(r.recorder, workspace, getWorkspacePod(ctx, r.Client, workspace), corev1.EventTypeNormal, "AdmissionExpired", "Workspace sharing expired, only the owner is admitted")
+	}
+	return nil
+}
+
 type timeoutActivity string
 
 const (
@@ -146,26 +190,34 @@ const (
 
 // isWorkspaceTimedOut determines if a workspace is timed out based on the manager configuration and state the pod is in.
 // This function does NOT use the Timeout condition, but rather is used to set that condition in the first place.
-func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reason string) {
+// If the workspace timed out, timedOut is the activity which took too long.
+func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reason string, timedOut timeoutActivity) {
 	timeouts := r.Config.Timeouts
 	phase := ws.Status.Phase
 
-	decide := func(start time.Time, timeout util.Duration, activity timeoutActivity) string {
+	decide := func(start time.Time, timeout util.Duration, activity timeoutActivity) (string, timeoutActivity) {
 		td := time.Duration(timeout)
 		inactivity := time.Since(start)
 		if inactivity < td {
-			return ""
+			return "", ""
 		}
 
-		return fmt.Sprintf("workspace timed out after %s (%s) took longer than %s", activity, formatDuration(inactivity), formatDuration(td))
+		return fmt.Sprintf("workspace timed out after %s (%s) took longer than %s", activity, formatDuration(inactivity), formatDuration(td)), activity
 	}
 
 	start := ws.ObjectMeta.CreationTimestamp.Time
+	if c := k8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionQueued)); c != nil && c.Status == metav1.ConditionFalse {
+		// the time spent in a queue does not count towards the timeouts of a workspace
+		start = c.LastTransitionTime.Time
+	}
 	lastActivity := activity.Last(ws)
 	isClosed := ws.IsConditionTrue(workspacev1.WorkspaceConditionClosed)
 
 	switch phase {
 	case workspacev1.WorkspacePhasePending:
+		if ws.IsConditionTrue(workspacev1.WorkspaceConditionQueued) {
+			return "", ""
+		}
 		return decide(start, timeouts.Initialization, activityInit)
 
 	case workspacev1.WorkspacePhaseInitializing:
@@ -182,8 +234,8 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 	case workspacev1.WorkspacePhaseRunning:
 		// First check is always for the max lifetime
 		maxLifetime := r.getMaxLifetime(ws)
-		if msg := decide(start, maxLifetime, activityMaxLifetime); msg != "" {
-			return msg
+		if msg, activity := decide(start, maxLifetime, activityMaxLifetime); msg != "" {
+			return msg, activity
 		}
 
 		timeout := timeouts.RegularWorkspace
@@ -199,37 +251,37 @@ func (r *TimeoutReconciler) isWorkspaceTimedOut(ws *workspacev1.Workspace) (reas
 			// The workspace is up and running, but the user has never produced any activity
 			return decide(start, timeouts.TotalStartup, activityNone)
 		} else if isClosed {
-			reason := func() string {
+			reason, activity := func() (string, timeoutActivity) {
 				afterClosed := timeouts.AfterClose
 				if customClosedTimeout := ws.Spec.Timeout.ClosedTimeout; customClosedTimeout != nil {
 					afterClosed = util.Duration(customClosedTimeout.Duration)
 					if afterClosed == 0 {
-						return ""
+						return "", ""
 					}
 				}
 				return decide(*lastActivity, afterClosed, activityClosed)
 			}()
 			if reason != "" {
-				return reason
+				return reason, activity
 			}
 		}
 		return decide(*lastActivity, timeout, activity)
 
 	case workspacev1.WorkspacePhaseStopping:
-		if isWorkspaceBeingDeleted(ws) && !ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete) {
-			// Beware: we apply the ContentFinalization timeout only to workspaces which are currently being deleted.
+		if isWorkspaceBeingDeleted(ws) && !ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete) && !ws.IsConditionTrue(workspacev1.WorkspaceConditionContentDeleted) {
+			// Beware: we apply the backup grace period only to workspaces which are currently being deleted.
 			//         We basically don't expect a workspace to be in content finalization before it's been deleted.
-			return decide(ws.DeletionTimestamp.Time, timeouts.ContentFinalization, activityBackup)
+			return decide(ws.DeletionTimestamp.Time, util.Duration(r.Config.BackupGracePeriod(ws.Spec.Class)), activityBackup)
 		} else if !isWorkspaceBeingDeleted(ws) {
 			// workspaces that have not been deleted have never timed out
-			return ""
+			return "", ""
 		} else {
 			return decide(ws.DeletionTimestamp.Time, timeouts.Stopping, activityStopping)
 		}
 
 	default:
 		// The only other phases we can be in is stopped which is pointless to time out
-		return ""
+		return "", ""
 	}
 }
 
@@ -191,6 +191,22 @@ var _ = Describe("TimeoutController", func() {
 			Expect(res.RequeueAfter).To(Equal(r.reconcileInterval))
 		})
 
+		It("should revert expired admission", func() {
+			ws := newWorkspace(uuid.NewString(), "default")
+			ws.Spec.Admission = workspacev1.AdmissionSpec{
+				Level:  workspacev1.AdmissionLevelEveryone,
+				Expiry: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			}
+			_ = createWorkspaceExpectPod(ws)
+
+			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: ws.Name, Namespace: ws.Namespace}})
+			Expect(err).To(BeNil())
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: ws.Name, Namespace: ws.Namespace}, ws)).To(Succeed())
+			Expect(ws.Spec.Admission.Level).To(Equal(workspacev1.AdmissionLevelOwner))
+			Expect(ws.Spec.Admission.Expiry).To(BeNil())
+		})
+
 		It("should not requeue when resource is not found", func() {
 			res, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "does-not-exist", Namespace: "default"}})
 			Expect(err).ToNot(HaveOccurred(), "not-found errors should not be returned")
@@ -75,6 +75,9 @@ type WorkspaceReconciler struct {
 	metrics     *controllerMetrics
 	maintenance maintenance.Maintenance
 	Recorder    record.EventRecorder
+
+	// CompletionHooks are notified once headless workspaces stopped, if configured
+	CompletionHooks CompletionNotifier
 }
 
 //+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaces,verbs=get;list;watch;create;update;patch;delete
@@ -82,6 +85,7 @@ type WorkspaceReconciler struct {
 //+kubebuilder:rbac:groups=workspace.gitpod.io,resources=workspaces/finalizers,verbs=update
 //+kubebuilder:rbac:groups=core,resources=pod,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=pod/status,verbs=get
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;create;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -129,8 +133,12 @@ func (r *WorkspaceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return ctrl.Result{}, fmt.Errorf("failed to compute latest workspace status: %w", err)
 	}
 
-	r.updateMetrics(ctx, &workspace)
-	r.emitPhaseEvents(ctx, &workspace, oldStatus)
+	r.updateMetrics(ctx, &workspace, workspacePods)
+	var pod *corev1.Pod
+	if len(workspacePods.Items) > 0 {
+		pod = &workspacePods.Items[0]
+	}
+	r.emitPhaseEvents(ctx, &workspace, pod, oldStatus)
 
 	var podStatus *corev1.PodStatus
 	if len(workspacePods.Items) > 0 {
@@ -180,19 +188,37 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 	if len(workspacePods.Items) == 0 {
 		// if there isn't a workspace pod and we're not currently deleting this workspace,// create one.
 		switch {
-		case workspace.Status.PodStarts == 0:
+		case (workspace.Status.PodStarts == 0 && workspace.Status.Phase != workspacev1.WorkspacePhaseStopped) || isRelocationPending(workspace):
+			if queue := r.queueFor(workspace); workspace.Status.PodStarts == 0 && queue != nil {
+				admitted, result, err := r.admitToQueue(ctx, workspace, queue)
+				if !admitted || err != nil {
+					return result, err
+				}
+			}
+
 			sctx, err := newStartWorkspaceContext(ctx, r.Config, workspace)
 			if err != nil {
 				log.Error(err, "unable to create startWorkspace context")
 				return ctrl.Result{Requeue: true}, err
 			}
+			sctx.PodTemplatePatch, err = loadPodTemplatePatch(ctx, r.Client, r.Config, workspace.Spec.Class)
+			if err != nil {
+				log.Error(err, "unable to load pod template patch")
+				return ctrl.Result{Requeue: true}, err
+			}
 
-			pod, err := r.createWorkspacePod(sctx)
+			pod, err := createWorkspacePod(sctx)
 			if err != nil {
 				log.Error(err, "unable to produce workspace pod")
 				return ctrl.Result{}, err
 			}
 
+			// the network policy must exist before the pod, as the cluster-wide policy does not apply to the pod
+			if err := r.createWorkspaceNetworkPolicy(ctx, sctx, workspace); err != nil {
+				log.Error(err, "unable to create NetworkPolicy for Workspace")
+				return ctrl.Result{Requeue: true}, err
+			}
+
 			if err := ctrl.SetControllerReference(workspace, pod, r.Scheme); err != nil {
 				return ctrl.Result{}, err
 			}
@@ -218,10 +244,21 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 					return ctrl.Result{}, err
 				}
 
-				r.Recorder.Event(workspace, corev1.EventTypeNormal, "Creating", "")
+				recordEvent(r.Recorder, workspace, pod, corev1.EventTypeNormal, "Creating", "")
 			}
 
 		case workspace.Status.Phase == workspacev1.WorkspacePhaseStopped:
+			// The workspace must not go away before the completion hooks learnt about it.
+			if r.needsCompletionNotification(workspace) {
+				done, err := r.notifyCompletionHooks(ctx, workspace)
+				if err != nil {
+					return ctrl.Result{}, err
+				}
+				if !done {
+					return ctrl.Result{RequeueAfter: completionHooksRetryInterval}, nil
+				}
+			}
+
 			if err := r.deleteWorkspaceSecrets(ctx, workspace); err != nil {
 				return ctrl.Result{}, err
 			}
@@ -281,6 +318,11 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 	case workspace.IsConditionTrue(workspacev1.WorkspaceConditionNodeDisappeared) && !isPodBeingDeleted(pod):
 		return r.deleteWorkspacePod(ctx, pod, "node disappeared")
 
+	// if the node is about to be preempted, delete the pod while the content can still be backed up.
+	case workspace.IsConditionTrue(workspacev1.WorkspaceConditionNodePreempted) && !isPodBeingDeleted(pod):
+		recordEvent(r.Recorder, workspace, pod, corev1.EventTypeWarning, "NodePreempted", pod.Spec.NodeName)
+		return r.deleteWorkspacePod(ctx, pod, "node preempted")
+
 	// if the workspace timed out, delete it
 	case workspace.IsConditionTrue(workspacev1.WorkspaceConditionTimeout) && !isPodBeingDeleted(pod):
 		return r.deleteWorkspacePod(ctx, pod, "timed out")
@@ -302,12 +344,41 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 			return ctrl.Result{Requeue: true}, err
 		}
 
+	// if the workspace is to be relocated, delete the pod. Its content is backed up
+	// and restored by a replacement pod on the target node.
+	case workspace.RelocationTarget() != "" && workspace.Status.Phase == workspacev1.WorkspacePhaseRunning &&
+		isReplacedByRelocation(workspace, pod) && !isPodBeingDeleted(pod):
+		recordEvent(r.Recorder, workspace, pod, corev1.EventTypeNormal, "Relocating", workspace.RelocationTarget())
+		return r.deleteWorkspacePod(ctx, pod, "relocating")
+
+	// the content of a relocating workspace is backed up, release the old pod
+	case isRelocationReady(workspace) && isPodBeingDeleted(pod):
+		if controllerutil.RemoveFinalizer(pod, workspacev1.GitpodFinalizerName) {
+			if err := r.Client.Update(ctx, pod); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to remove gitpod finalizer from pod: %w", err)
+			}
+		}
+
+	// wait for the final backup, but no longer than the backup grace period of the workspace class
+	case workspace.Status.Phase == workspacev1.WorkspacePhaseStopping && isPodBeingDeleted(pod) && !isDisposalFinished(workspace):
+		if remaining := backupGracePeriodRemaining(workspace, pod, r.Config); remaining > 0 {
+			return ctrl.Result{RequeueAfter: remaining}, nil
+		}
+		return ctrl.Result{Requeue: true}, nil
+
 	case workspace.Status.Phase == workspacev1.WorkspacePhaseRunning:
 		err := r.deleteWorkspaceSecrets(ctx, workspace)
 		if err != nil {
 			log.Error(err, "could not delete workspace secrets")
 		}
 
+		if workspace.RelocationTarget() == "" && !isPodBeingDeleted(pod) {
+			err = r.resizeWorkspacePod(ctx, workspace, pod)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+
 	// we've disposed already - try to remove the finalizer and call it a day
 	case workspace.Status.Phase == workspacev1.WorkspacePhaseStopped:
 		hadFinalizer := controllerutil.ContainsFinalizer(pod, workspacev1.GitpodFinalizerName)
@@ -325,7 +396,7 @@ func (r *WorkspaceReconciler) actOnStatus(ctx context.Context, workspace *worksp
 	return ctrl.Result{}, nil
 }
 
-func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *workspacev1.Workspace) {
+func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *workspacev1.Workspace, workspacePods *corev1.PodList) {
 	log := log.FromContext(ctx)
 
 	ok, lastState := r.metrics.getWorkspace(&log, workspace)
@@ -359,9 +430,20 @@ func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *work
 
 	if !lastState.recordedContentReady && workspace.IsConditionTrue(workspacev1.WorkspaceConditionContentReady) {
 		r.metrics.countTotalRestores(&log, workspace)
+		r.metrics.recordWorkspaceMilestone(&log, workspace, milestoneContentReady)
 		lastState.recordedContentReady = true
 	}
 
+	if !lastState.recordedEverReady && workspace.IsConditionTrue(workspacev1.WorkspaceConditionEverReady) {
+		r.metrics.recordWorkspaceMilestone(&log, workspace, milestoneEverReady)
+		lastState.recordedEverReady = true
+	}
+
+	if !lastState.recordedFirstActivity && workspace.IsConditionTrue(workspacev1.WorkspaceConditionFirstUserActivity) {
+		r.metrics.recordWorkspaceMilestone(&log, workspace, milestoneFirstUserActivity)
+		lastState.recordedFirstActivity = true
+	}
+
 	if !lastState.recordedBackupFailed && workspace.IsConditionTrue(workspacev1.WorkspaceConditionBackupFailure) {
 		r.metrics.countTotalBackups(&log, workspace)
 		r.metrics.countTotalBackupFailures(&log, workspace)
@@ -375,6 +457,9 @@ func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *work
 
 	if !lastState.recordedStartTime && workspace.Status.Phase == workspacev1.WorkspacePhaseRunning {
 		r.metrics.recordWorkspaceStartupTime(&log, workspace)
+		if len(workspacePods.Items) > 0 {
+			r.metrics.recordWorkspaceStartupPhases(&log, workspace, &workspacePods.Items[0])
+		}
 		lastState.recordedStartTime = true
 	}
 
@@ -395,6 +480,22 @@ func (r *WorkspaceReconciler) updateMetrics(ctx context.Context, workspace *work
 	r.metrics.rememberWorkspace(workspace, &lastState)
 }
 
+// isReplacedByRelocation returns true if the pod has to make way for a replacement pod on the relocation target.
+// A workspace that changes its class restarts on the node it runs on already, hence a pod on the target node
+// is replaced too if it was created before the relocation started.
+func isReplacedByRelocation(ws *workspacev1.Workspace, pod *corev1.Pod) bool {
+	if pod.Spec.NodeName != ws.RelocationTarget() {
+		return true
+	}
+	c := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionRelocating))
+	return c != nil && pod.CreationTimestamp.Before(&c.LastTransitionTime)
+}
+
+// isRelocationPending returns true if a relocating workspace waits for its replacement pod.
+func isRelocationPending(ws *workspacev1.Workspace) bool {
+	return ws.RelocationTarget() != "" && ws.Status.Phase == workspacev1.WorkspacePhasePending && relocationCancelReason(ws) == ""
+}
+
 func isStartFailure(ws *workspacev1.Workspace) bool {
 	// Consider workspaces that never became ready as start failures.
 	everReady := ws.IsConditionTrue(workspacev1.WorkspaceConditionEverReady)
@@ -406,20 +507,57 @@ func isStartFailure(ws *workspacev1.Workspace) bool {
 	return !everReady && !isAborted && !isStoppedByRequest
 }
 
-func (r *WorkspaceReconciler) emitPhaseEvents(ctx context.Context, ws *workspacev1.Workspace, old *workspacev1.WorkspaceStatus) {
+func (r *WorkspaceReconciler) emitPhaseEvents(ctx context.Context, ws *workspacev1.Workspace, pod *corev1.Pod, old *workspacev1.WorkspaceStatus) {
 	if ws.Status.Phase == workspacev1.WorkspacePhaseInitializing && old.Phase != workspacev1.WorkspacePhaseInitializing {
-		r.Recorder.Event(ws, corev1.EventTypeNormal, "Initializing", "")
+		recordEvent(r.Recorder, ws, pod, corev1.EventTypeNormal, "Initializing", "")
 	}
 
 	if ws.Status.Phase == workspacev1.WorkspacePhaseRunning && old.Phase != workspacev1.WorkspacePhaseRunning {
-		r.Recorder.Event(ws, corev1.EventTypeNormal, "Running", "")
+		recordEvent(r.Recorder, ws, pod, corev1.EventTypeNormal, "Running", "")
 	}
 
 	if ws.Status.Phase == workspacev1.WorkspacePhaseStopping && old.Phase != workspacev1.WorkspacePhaseStopping {
-		r.Recorder.Event(ws, corev1.EventTypeNormal, "Stopping", "")
+		recordEvent(r.Recorder, ws, pod, corev1.EventTypeNormal, "Stopping", "")
+	}
+
+	if c := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionBackupComplete)); c != nil && c.Status == metav1.ConditionTrue &&
+		!wsk8s.ConditionPresentAndTrue(old.Conditions, string(workspacev1.WorkspaceConditionBackupComplete)) {
+		recordEvent(r.Recorder, ws, pod, corev1.EventTypeNormal, "BackupComplete", c.Message)
+	}
+
+	if c := wsk8s.GetCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionBackupFailure)); c != nil && c.Status == metav1.ConditionTrue &&
+		!wsk8s.ConditionPresentAndTrue(old.Conditions, string(workspacev1.WorkspaceConditionBackupFailure)) {
+		recordEvent(r.Recorder, ws, pod, corev1.EventTypeWarning, "BackupFailed", c.Message)
+	}
+
+	// the admission level of workspaces which were created before it was part of the status is not known
+	if old.Admission != "" && ws.Status.Admission != old.Admission {
+		recordEvent(r.Recorder, ws, pod, corev1.EventTypeNormal, "AdmissionChanged", fmt.Sprintf("admission level changed from %s to %s", old.Admission, ws.Status.Admission))
 	}
 }
 
+// createWorkspaceNetworkPolicy creates the NetworkPolicy of a workspace if its class has a network policy template.
+// The policy is owned by the workspace and garbage collected together with it.
+func (r *WorkspaceReconciler) createWorkspaceNetworkPolicy(ctx context.Context, sctx *startWorkspaceContext, workspace *workspacev1.Workspace) error {
+	policy, err := createWorkspaceNetworkPolicy(sctx)
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		return nil
+	}
+
+	if err := ctrl.SetControllerReference(workspace, policy, r.Scheme); err != nil {
+		return err
+	}
+	err = r.Create(ctx, policy)
+	if apierrors.IsAlreadyExists(err) {
+		// the workspace had a pod before, e.g. prior to a relocation
+		return nil
+	}
+	return err
+}
+
 func (r *WorkspaceReconciler) deleteWorkspacePod(ctx context.Context, pod *corev1.Pod, reason string) (result ctrl.Result, err error) {
 	span, ctx := tracing.FromContext(ctx, "deleteWorkspacePod")
 	defer tracing.FinishSpan(span, &err)
@@ -544,33 +682,59 @@ func (r *WorkspaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		// Add a watch for Nodes, so that they're cached in memory and don't require calling the k8s API
 		// when reconciling workspaces.
 		Watches(&corev1.Node{}, &handler.Funcs{
-			// Only enqueue events for workspaces when the node gets deleted,
+			// Only enqueue events for workspaces when the node gets deleted or preempted,
 			// such that we can trigger their cleanup.
-			DeleteFunc: func(ctx context.Context, e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
-				if e.Object == nil {
+			UpdateFunc: func(ctx context.Context, e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+				if r.Config.NodePreemption == nil {
 					return
 				}
-
-				var wsList workspacev1.WorkspaceList
-				err := r.List(ctx, &wsList)
-				if err != nil {
-					log.FromContext(ctx).Error(err, "cannot list workspaces")
+				oldNode, ok := e.ObjectOld.(*corev1.Node)
+				if !ok {
 					return
 				}
-				for _, ws := range wsList.Items {
-					if ws.Status.Runtime == nil || ws.Status.Runtime.NodeName != e.Object.GetName() {
-						continue
-					}
-					queue.Add(ctrl.Request{NamespacedName: types.NamespacedName{
-						Namespace: ws.Namespace,
-						Name:      ws.Name,
-					}})
+				newNode, ok := e.ObjectNew.(*corev1.Node)
+				if !ok {
+					return
+				}
+				if nodePreemptionSignal(r.Config.NodePreemption, newNode) == "" || nodePreemptionSignal(r.Config.NodePreemption, oldNode) != "" {
+					return
+				}
+				r.enqueueWorkspacesOnNode(ctx, newNode.Name, queue)
+			},
+			DeleteFunc: func(ctx context.Context, e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+				if e.Object == nil {
+					return
 				}
+				r.enqueueWorkspacesOnNode(ctx, e.Object.GetName(), queue)
+			},
+		}).
+		// Watch snapshots to record an event on their workspace once they completed.
+		Watches(&workspacev1.Snapshot{}, &handler.Funcs{
+			UpdateFunc: func(ctx context.Context, e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+				r.emitSnapshotEvents(ctx, e)
 			},
 		}).
 		Complete(r)
 }
 
+func (r *WorkspaceReconciler) enqueueWorkspacesOnNode(ctx context.Context, nodeName string, queue workqueue.RateLimitingInterface) {
+	var wsList workspacev1.WorkspaceList
+	err := r.List(ctx, &wsList)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "cannot list workspaces")
+		return
+	}
+	for _, ws := range wsList.Items {
+		if ws.Status.Runtime == nil || ws.Status.Runtime.NodeName != nodeName {
+			continue
+		}
+		queue.Add(ctrl.Request{NamespacedName: types.NamespacedName{
+			Namespace: ws.Namespace,
+			Name:      ws.Name,
+		}})
+	}
+}
+
 func SetupIndexer(mgr ctrl.Manager) error {
 	var err error
 	var once sync.Once
@@ -293,6 +293,91 @@ var _ = Describe("WorkspaceController", func() {
 			})
 		})
 
+		It("should relocate workspace to another node", func() {
+			ws := newWorkspace(uuid.NewString(), "default")
+			pod := createWorkspaceExpectPod(ws)
+
+			markReady(ws)
+			updateObjWithRetries(k8sClient, pod, true, func(pod *corev1.Pod) {
+				pod.Status.Phase = corev1.PodRunning
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+					Name:  "workspace",
+					Ready: true,
+				}}
+			})
+			expectPhaseEventually(ws, workspacev1.WorkspacePhaseRunning)
+
+			By("requesting relocation")
+			targetNode := uuid.NewString()
+			updateObjWithRetries(k8sClient, ws, true, func(ws *workspacev1.Workspace) {
+				ws.Status.SetCondition(workspacev1.NewWorkspaceConditionRelocating(targetNode))
+			})
+
+			// The old pod should be deleted and wait for the backup to complete.
+			expectPhaseEventually(ws, workspacev1.WorkspacePhaseStopping)
+			expectFinalizerAndMarkBackupCompleted(ws, pod)
+
+			By("controller creating the replacement pod on the target node")
+			Eventually(func(g Gomega) {
+				var newPod corev1.Pod
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace}, &newPod)).To(Succeed())
+				g.Expect(newPod.UID).ToNot(Equal(pod.UID))
+				g.Expect(newPod.Spec.Affinity).ToNot(BeNil())
+				terms := newPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+				g.Expect(terms).To(HaveLen(1))
+				g.Expect(terms[0].MatchExpressions).To(ContainElement(corev1.NodeSelectorRequirement{
+					Key:      corev1.LabelHostname,
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{targetNode},
+				}))
+			}, timeout, interval).Should(Succeed())
+
+			By("controller resetting the workspace status for the replacement pod")
+			Eventually(func(g Gomega) {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: ws.Name, Namespace: ws.Namespace}, ws)).To(Succeed())
+				g.Expect(ws.Status.PodStarts).To(Equal(2))
+				g.Expect(ws.RelocationTarget()).To(Equal(targetNode))
+				g.Expect(ws.IsConditionTrue(workspacev1.WorkspaceConditionContentReady)).To(BeFalse())
+				g.Expect(ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupComplete)).To(BeFalse())
+			}, timeout, interval).Should(Succeed())
+		})
+
+		It("should stop workspace that is stopped during relocation", func() {
+			ws := newWorkspace(uuid.NewString(), "default")
+			m := collectMetricCounts(wsMetrics, ws)
+			pod := createWorkspaceExpectPod(ws)
+
+			markReady(ws)
+			updateObjWithRetries(k8sClient, pod, true, func(pod *corev1.Pod) {
+				pod.Status.Phase = corev1.PodRunning
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+					Name:  "workspace",
+					Ready: true,
+				}}
+			})
+			expectPhaseEventually(ws, workspacev1.WorkspacePhaseRunning)
+
+			By("requesting relocation")
+			updateObjWithRetries(k8sClient, ws, true, func(ws *workspacev1.Workspace) {
+				ws.Status.SetCondition(workspacev1.NewWorkspaceConditionRelocating(uuid.NewString()))
+			})
+			expectPhaseEventually(ws, workspacev1.WorkspacePhaseStopping)
+
+			requestStop(ws)
+			expectConditionEventually(ws, string(workspacev1.WorkspaceConditionRelocating), metav1.ConditionFalse, "RelocationCancelled")
+
+			expectFinalizerAndMarkBackupCompleted(ws, pod)
+
+			// The workspace must stop instead of starting a replacement pod on the target node.
+			expectWorkspaceCleanup(ws, pod)
+
+			expectMetricsDelta(m, collectMetricCounts(wsMetrics, ws), metricCounts{
+				restores: 1,
+				stops:    map[StopReason]int{StopReasonRegular: 1},
+				backups:  1,
+			})
+		})
+
 		It("node disappearing should fail with backup failure", func() {
 			ws := newWorkspace(uuid.NewString(), "default")
 			m := collectMetricCounts(wsMetrics, ws)
@@ -5,12 +5,12 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"flag"
 	"fmt"
 	"net"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -24,6 +24,7 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -32,10 +33,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
+	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	commoncfg "github.com/gitpod-io/gitpod/common-go/config"
+	"github.com/gitpod-io/gitpod/common-go/crash"
 	common_grpc "github.com/gitpod-io/gitpod/common-go/grpc"
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/pprof"
@@ -44,6 +49,7 @@ import (
 	imgbldr "github.com/gitpod-io/gitpod/image-builder/api"
 	regapi "github.com/gitpod-io/gitpod/registry-facade/api"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/controllers"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/grpcpool"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/maintenance"
 	imgproxy "github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/proxy"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/service"
@@ -63,6 +69,13 @@ var (
 	setupLog = ctrl.Log.WithName("setup")
 )
 
+const (
+	// shutdownTimeout limits how long ws-manager takes to shut down once asked to terminate
+	shutdownTimeout = 25 * time.Second
+	// grpcStopTimeout limits how long we wait for pending RPCs when shutting down
+	grpcStopTimeout = 10 * time.Second
+)
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
@@ -98,9 +111,12 @@ func main() {
 			},
 		},
 	}
+	shutdown := baseserver.NewShutdownManager(log.Log)
 	closer := tracing.Init(ServiceName, tracing.WithPrometheusReporter(promrep))
 	if closer != nil {
-		defer closer.Close()
+		shutdown.Register(baseserver.ShutdownPhaseFlush, "tracing", 0, func(ctx context.Context) error {
+			return closer.Close()
+		})
 	}
 
 	cfg, err := getConfig(configFN)
@@ -113,19 +129,7 @@ func main() {
 		go pprof.Serve(cfg.PProf.Addr)
 	}
 
-	// Check that namespace config values are set. Empty namespaces default to a cluster-scoped cache,
-	// which the controller doesn't have the right RBAC for.
-	if cfg.Manager.Namespace == "" {
-		setupLog.Error(nil, "namespace cannot be empty")
-		os.Exit(1)
-	}
-
-	if cfg.Manager.SecretsNamespace == "" {
-		setupLog.Error(nil, "secretsNamespace cannot be empty")
-		os.Exit(1)
-	}
-
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	mgrOpts := ctrl.Options{
 		Scheme:  scheme,
 		Metrics: metricsserver.Options{BindAddress: cfg.Prometheus.Addr},
 		Cache: cache.Options{
@@ -152,7 +156,14 @@ func main() {
 
 			return c, nil
 		},
-	})
+	}
+	if le := cfg.LeaderElection; le != nil {
+		leaseDuration, renewDeadline, retryPeriod := time.Duration(le.LeaseDuration), time.Duration(le.RenewDeadline), time.Duration(le.RetryPeriod)
+		mgrOpts.LeaseDuration = &leaseDuration
+		mgrOpts.RenewDeadline = &renewDeadline
+		mgrOpts.RetryPeriod = &retryPeriod
+	}
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), mgrOpts)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
@@ -172,7 +183,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	wsmanService, err := setupGRPCService(cfg, mgr.GetClient(), maintenanceReconciler)
+	wsmanService, err := setupGRPCService(cfg, mgr.GetClient(), maintenanceReconciler, shutdown)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager service")
 		os.Exit(1)
@@ -197,8 +208,32 @@ func main() {
 		os.Exit(1)
 	}
 
+	var completionHooks controllers.CompletionNotifier
+	if len(cfg.Manager.HeadlessCompletionHooks) > 0 {
+		hooks, err := service.NewCompletionHooks(cfg.Manager.HeadlessCompletionHooks)
+		if err != nil {
+			setupLog.Error(err, "unable to create completion hooks")
+			os.Exit(1)
+		}
+		defer hooks.Close()
+		metrics.Registry.MustRegister(hooks)
+		completionHooks = hooks
+		setupLog.Info("notifying completion hooks of headless workspaces", "hooks", len(cfg.Manager.HeadlessCompletionHooks))
+	}
+
+	// all replicas serve the gRPC API, but only the leader acts on workspaces
+	leader := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "gitpod",
+		Subsystem: "ws_manager_mk2",
+		Name:      "leader",
+		Help:      "1 if this replica is the leader which runs the workspace controller, 0 otherwise",
+	})
+	metrics.Registry.MustRegister(leader)
+
 	go func() {
 		<-mgr.Elected()
+		setupLog.Info("elected as leader, starting workspace controller")
+		leader.Set(1)
 
 		workspaceReconciler, err := controllers.NewWorkspaceReconciler(
 			mgr.GetClient(), mgr.GetScheme(), mgr.GetEventRecorderFor("workspace"), &cfg.Manager, metrics.Registry, maintenanceReconciler)
@@ -206,6 +241,7 @@ func main() {
 			setupLog.Error(err, "unable to create controller", "controller", "Workspace")
 			os.Exit(1)
 		}
+		workspaceReconciler.CompletionHooks = completionHooks
 
 		if err = workspaceReconciler.SetupWithManager(mgr); err != nil {
 			setupLog.Error(err, "unable to setup workspace controller with manager", "controller", "Workspace")
@@ -234,19 +270,58 @@ func main() {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
+
+	cacheWatchdog, err := controllers.NewCacheWatchdog(mgr.GetCache(), mgr.GetAPIReader(), mgr.GetClient(), cfg.Manager.Namespace, metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to create cache watchdog")
+		os.Exit(1)
+	}
+	if err := mgr.Add(cacheWatchdog); err != nil {
+		setupLog.Error(err, "unable to set up cache watchdog")
+		os.Exit(1)
+	}
+	// restarts us if the cache missed deletions, which we cannot resync otherwise
+	if err := mgr.AddHealthzCheck("cache", cacheWatchdog.Check); err != nil {
+		setupLog.Error(err, "unable to set up cache health check")
+		os.Exit(1)
+	}
+	// makes nodes available again once ws-daemon recovers, even if no request goes to the node
+	if err := mgr.Add(manager.RunnableFunc(wsmanService.WatchWorkspaceDaemons)); err != nil {
+		setupLog.Error(err, "unable to set up ws-daemon availability check")
+		os.Exit(1)
+	}
 	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
 
+	// stop accepting RPCs as soon as we're asked to terminate, while the controllers finish their work
+	go func() {
+		<-mgrCtx.Done()
+		shutdownGracefully(shutdown)
+	}()
+
 	setupLog.Info("starting manager")
-	if err := mgr.Start(mgrCtx); err != nil {
+	err = mgr.Start(mgrCtx)
+	// waits for a shutdown which is already in progress
+	shutdownGracefully(shutdown)
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
 }
 
-func setupGRPCService(cfg *config.ServiceConfiguration, k8s client.Client, maintenance maintenance.Maintenance) (*service.WorkspaceManagerServer, error) {
+func shutdownGracefully(shutdown *baseserver.ShutdownManager) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := shutdown.Shutdown(ctx)
+	if err != nil {
+		setupLog.Error(err, "cannot shut down gracefully")
+	}
+}
+
+func setupGRPCService(cfg *config.ServiceConfiguration, k8s client.Client, maintenance maintenance.Maintenance, shutdown *baseserver.ShutdownManager) (*service.WorkspaceManagerServer, error) {
 	// TODO(cw): remove use of common-go/log
 
 	if len(cfg.RPCServer.RateLimits) > 0 {
@@ -256,7 +331,7 @@ func setupGRPCService(cfg *config.ServiceConfiguration, k8s client.Client, maint
 
 	grpcMetrics := grpc_prometheus.NewServerMetrics()
 	grpcMetrics.EnableHandlingTimeHistogram()
-	metrics.Registry.MustRegister(grpcMetrics)
+	metrics.Registry.MustRegister(grpcMetrics, crash.DefaultReporter)
 
 	grpcOpts := common_grpc.ServerOptionsWithInterceptors(
 		[]grpc.StreamServerInterceptor{grpcMetrics.StreamServerInterceptor()},
@@ -279,6 +354,7 @@ func setupGRPCService(cfg *config.ServiceConfiguration, k8s client.Client, maint
 
 	grpcServer := grpc.NewServer(grpcOpts...)
 
+	var imageBuilder imgbldr.ImageBuilderClient
 	if cfg.ImageBuilderProxy.TargetAddr != "" {
 		creds := insecure.NewCredentials()
 		if cfg.ImageBuilderProxy.TLS.CA != "" && cfg.ImageBuilderProxy.TLS.Certificate != "" && cfg.ImageBuilderProxy.TLS.PrivateKey != "" {
@@ -299,10 +375,66 @@ func setupGRPCService(cfg *config.ServiceConfiguration, k8s client.Client, maint
 		if err != nil {
 			log.WithError(err).Fatal("failed to connect to image builder")
 		}
-		imgbldr.RegisterImageBuilderServer(grpcServer, imgproxy.ImageBuilder{D: imgbldr.NewImageBuilderClient(conn)})
+		imageBuilder = imgbldr.NewImageBuilderClient(conn)
+		imgbldr.RegisterImageBuilderServer(grpcServer, imgproxy.ImageBuilder{D: imageBuilder})
+	}
+
+	wsdaemonConnfactory, err := newWorkspaceDaemonConnectionFactory(cfg.Manager.WorkspaceDaemon)
+	if err != nil {
+		return nil, err
+	}
+	wsdaemonPool := grpcpool.New(wsdaemonConnfactory, func(podIP string) bool {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		var pods corev1.PodList
+		err := k8s.List(ctx, &pods,
+			client.InNamespace(cfg.Manager.Namespace),
+			client.MatchingLabels{"component": "ws-daemon"},
+		)
+		if err != nil {
+			// keep the connection if we cannot tell
+			return true
+		}
+		for _, pod := range pods.Items {
+			if pod.Status.PodIP == podIP {
+				return true
+			}
+		}
+		return false
+	})
+
+	var admission *service.StartAdmission
+	if cfg.Manager.StartAdmission != nil {
+		admission, err = service.NewStartAdmission(*cfg.Manager.StartAdmission)
+		if err != nil {
+			return nil, fmt.Errorf("cannot configure start admission: %w", err)
+		}
+		metrics.Registry.MustRegister(admission)
+		log.WithField("url", cfg.Manager.StartAdmission.URL).Info("consulting admission endpoint before workspace starts")
 	}
 
-	srv := service.NewWorkspaceManagerServer(k8s, &cfg.Manager, metrics.Registry, maintenance)
+	var policy *service.StartPolicy
+	if cfg.Manager.StartPolicy != nil {
+		policy, err = service.NewStartPolicy(*cfg.Manager.StartPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("cannot configure start policy: %w", err)
+		}
+		metrics.Registry.MustRegister(policy)
+		log.WithField("url", cfg.Manager.StartPolicy.URL).Info("evaluating start policy before workspace starts")
+	}
+
+	var rateLimiter *service.StartRateLimiter
+	if cfg.Manager.StartRateLimit != nil {
+		rateLimiter, err = service.NewStartRateLimiter(*cfg.Manager.StartRateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("cannot configure start rate limit: %w", err)
+		}
+		metrics.Registry.MustRegister(rateLimiter)
+		log.WithField("startRateLimit", cfg.Manager.StartRateLimit).Info("rate limiting workspace starts per owner")
+	}
+
+	srv := service.NewWorkspaceManagerServer(k8s, &cfg.Manager, metrics.Registry, maintenance, wsdaemonPool, imageBuilder, admission, policy, rateLimiter)
 
 	grpc_prometheus.Register(grpcServer)
 	wsmanapi.RegisterWorkspaceManagerServer(grpcServer, srv)
@@ -322,31 +454,53 @@ func setupGRPCService(cfg *config.ServiceConfiguration, k8s client.Client, maint
 		}
 	}()
 	log.WithField("addr", cfg.RPCServer.Addr).Info("started gRPC server")
+	// subscriptions never finish on their own, hence we don't wait for long
+	shutdown.Register(baseserver.ShutdownPhaseStopAccepting, "grpc", grpcStopTimeout, func(ctx context.Context) error {
+		return baseserver.GracefulStopGRPC(ctx, grpcServer)
+	})
 
 	return srv, nil
 }
 
-func getConfig(fn string) (*config.ServiceConfiguration, error) {
-	ctnt, err := os.ReadFile(fn)
-	if err != nil {
-		return nil, fmt.Errorf("cannot read configuration. Maybe missing --config?: %w", err)
+func newWorkspaceDaemonConnectionFactory(cfg config.WorkspaceDaemonConfiguration) (grpcpool.Factory, error) {
+	creds := insecure.NewCredentials()
+	if cfg.TLS.Authority != "" && cfg.TLS.Certificate != "" && cfg.TLS.PrivateKey != "" {
+		tlsConfig, err := common_grpc.ClientAuthTLSConfig(
+			cfg.TLS.Authority, cfg.TLS.Certificate, cfg.TLS.PrivateKey,
+			common_grpc.WithSetRootCAs(true),
+			common_grpc.WithServerName("wsdaemon"),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load ws-daemon TLS certs: %w", err)
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	} else {
+		log.Warn("no TLS configured - connections to ws-daemon will be unsecured")
 	}
 
-	var cfg config.ServiceConfiguration
-	dec := json.NewDecoder(bytes.NewReader(ctnt))
-	dec.DisallowUnknownFields()
-	err = dec.Decode(&cfg)
+	grpcOpts := append(common_grpc.DefaultClientOptions(), grpc.WithTransportCredentials(creds))
+	return func(host string) (*grpc.ClientConn, error) {
+		// the default client options block until the connection is up
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		return grpc.DialContext(ctx, fmt.Sprintf("%s:%d", host, cfg.Port), grpcOpts...)
+	}, nil
+}
+
+func getConfig(fn string) (*config.ServiceConfiguration, error) {
+	cfg, err := commoncfg.Load[config.ServiceConfiguration](fn)
 	if err != nil {
-		return nil, fmt.Errorf("cannot decode configuration from %s: %w", fn, err)
+		return nil, err
 	}
 
 	if cfg.Manager.SSHGatewayCAPublicKeyFile != "" {
 		ca, err := os.ReadFile(cfg.Manager.SSHGatewayCAPublicKeyFile)
 		if err != nil {
 			log.WithError(err).Error("cannot read SSH Gateway CA public key")
-			return &cfg, nil
+			return cfg, nil
 		}
 		cfg.Manager.SSHGatewayCAPublicKey = string(ca)
 	}
-	return &cfg, nil
+	return cfg, nil
 }
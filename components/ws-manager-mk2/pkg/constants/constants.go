@@ -6,3 +6,8 @@ package constants
 
 // ManagedBy identifies who is managing the workspace
 const ManagedBy = "ws-manager-mk2"
+
+// DaemonUnavailableLabelPrefix marks nodes on which ws-manager cannot reach ws-daemon. Workspace pods are not scheduled
+// to such nodes. Unlike the ws-daemon ready label maintained by node-labeler, only ws-manager sets and removes this label.
+// The namespace of ws-manager is appended to the prefix.
+const DaemonUnavailableLabelPrefix = "gitpod.io/ws-daemon_unavailable_ns_"
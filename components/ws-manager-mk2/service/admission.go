@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+// StartAdmissionReview is the body POSTed to the admission endpoint. Request is the
+// StartWorkspaceRequest in its protobuf JSON encoding.
+type StartAdmissionReview struct {
+	Request json.RawMessage `json:"request"`
+	// DryRun is true if the start is only validated, i.e. the endpoint must not account for it
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// StartAdmissionResponse is the body the admission endpoint answers with
+type StartAdmissionResponse struct {
+	Allowed bool `json:"allowed"`
+	// Reason explains why a start was rejected. It is passed on to the caller of StartWorkspace.
+	Reason string `json:"reason,omitempty"`
+	// Spec replaces the StartWorkspaceSpec of the request if present. It uses the protobuf JSON encoding.
+	Spec json.RawMessage `json:"spec,omitempty"`
+}
+
+// StartAdmission consults an external endpoint before workspaces are started. Installations use this
+// to enforce quotas or compliance rules, or to modify the spec of the workspace.
+type StartAdmission struct {
+	*webhookClient
+}
+
+// NewStartAdmission creates a new admission client for the given configuration
+func NewStartAdmission(cfg config.StartAdmissionConfiguration) (*StartAdmission, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newWebhookClient(webhookOptions{
+		Name:          "start admission",
+		Metric:        "start_admission",
+		DecisionsHelp: "total number of workspace starts reviewed by the admission endpoint",
+		DurationHelp:  "time it takes the admission endpoint to review a workspace start",
+		Buckets:       prometheus.ExponentialBuckets(0.01, 2, 10),
+		URL:           cfg.URL,
+		CA:            cfg.CA,
+		Timeout:       cfg.GetTimeout(),
+		FailurePolicy: cfg.GetFailurePolicy(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &StartAdmission{webhookClient: client}, nil
+}
+
+// Admit asks the admission endpoint whether the workspace may start. If the endpoint modified the spec,
+// the spec of req is replaced. Rejected starts produce a PermissionDenied error.
+func (a *StartAdmission) Admit(ctx context.Context, req *wsmanapi.StartWorkspaceRequest, dryRun bool) error {
+	resp, err := a.review(ctx, req, dryRun)
+	if err != nil {
+		return a.failed(req, err)
+	}
+
+	if !resp.Allowed {
+		a.decided(admissionResultRejected)
+		reason := resp.Reason
+		if reason == "" {
+			reason = "no reason given"
+		}
+		return status.Errorf(codes.PermissionDenied, "workspace start was rejected: %s", reason)
+	}
+
+	if len(resp.Spec) == 0 {
+		a.decided(admissionResultAllowed)
+		return nil
+	}
+	var spec wsmanapi.StartWorkspaceSpec
+	err = protojson.Unmarshal(resp.Spec, &spec)
+	if err != nil {
+		a.decided(admissionResultError)
+		return status.Errorf(codes.Internal, "admission endpoint returned an invalid spec: %v", err)
+	}
+	a.decided(admissionResultMutated)
+	req.Spec = &spec
+	return nil
+}
+
+func (a *StartAdmission) review(ctx context.Context, req *wsmanapi.StartWorkspaceRequest, dryRun bool) (*StartAdmissionResponse, error) {
+	request, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal start request: %w", err)
+	}
+
+	var resp StartAdmissionResponse
+	err = a.post(ctx, StartAdmissionReview{Request: request, DryRun: dryRun}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+func TestStartAdmission(t *testing.T) {
+	type Expectation struct {
+		Code  codes.Code
+		Class string
+	}
+	tests := []struct {
+		Name          string
+		Handler       func(w http.ResponseWriter, review StartAdmissionReview)
+		FailurePolicy config.AdmissionFailurePolicy
+		DryRun        bool
+		Expectation   Expectation
+	}{
+		{
+			Name: "allowed",
+			Handler: func(w http.ResponseWriter, review StartAdmissionReview) {
+				_ = json.NewEncoder(w).Encode(StartAdmissionResponse{Allowed: true})
+			},
+			Expectation: Expectation{Code: codes.OK, Class: "default"},
+		},
+		{
+			Name: "rejected",
+			Handler: func(w http.ResponseWriter, review StartAdmissionReview) {
+				_ = json.NewEncoder(w).Encode(StartAdmissionResponse{Reason: "quota exceeded"})
+			},
+			Expectation: Expectation{Code: codes.PermissionDenied, Class: "default"},
+		},
+		{
+			Name: "mutated",
+			Handler: func(w http.ResponseWriter, review StartAdmissionReview) {
+				var req wsmanapi.StartWorkspaceRequest
+				_ = protojson.Unmarshal(review.Request, &req)
+				req.Spec.Class = "small"
+				spec, _ := protojson.Marshal(req.Spec)
+				_ = json.NewEncoder(w).Encode(StartAdmissionResponse{Allowed: true, Spec: spec})
+			},
+			Expectation: Expectation{Code: codes.OK, Class: "small"},
+		},
+		{
+			Name:   "dry run",
+			DryRun: true,
+			Handler: func(w http.ResponseWriter, review StartAdmissionReview) {
+				_ = json.NewEncoder(w).Encode(StartAdmissionResponse{Allowed: review.DryRun})
+			},
+			Expectation: Expectation{Code: codes.OK, Class: "default"},
+		},
+		{
+			Name: "failing endpoint",
+			Handler: func(w http.ResponseWriter, review StartAdmissionReview) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			Expectation: Expectation{Code: codes.Unavailable, Class: "default"},
+		},
+		{
+			Name:          "failing endpoint with ignore policy",
+			FailurePolicy: config.AdmissionFailurePolicyIgnore,
+			Handler: func(w http.ResponseWriter, review StartAdmissionReview) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			Expectation: Expectation{Code: codes.OK, Class: "default"},
+		},
+		{
+			Name: "slow endpoint",
+			Handler: func(w http.ResponseWriter, review StartAdmissionReview) {
+				time.Sleep(500 * time.Millisecond)
+				_ = json.NewEncoder(w).Encode(StartAdmissionResponse{Allowed: true})
+			},
+			Expectation: Expectation{Code: codes.Unavailable, Class: "default"},
+		},
+		{
+			Name: "invalid spec",
+			Handler: func(w http.ResponseWriter, review StartAdmissionReview) {
+				_ = json.NewEncoder(w).Encode(StartAdmissionResponse{Allowed: true, Spec: json.RawMessage(`{"class":42}`)})
+			},
+			Expectation: Expectation{Code: codes.Internal, Class: "default"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var review StartAdmissionReview
+				err := json.NewDecoder(r.Body).Decode(&review)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				test.Handler(w, review)
+			}))
+			defer srv.Close()
+
+			admission, err := NewStartAdmission(config.StartAdmissionConfiguration{
+				URL:           srv.URL,
+				Timeout:       util.Duration(100 * time.Millisecond),
+				FailurePolicy: test.FailurePolicy,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := &wsmanapi.StartWorkspaceRequest{
+				Id:       "foobar",
+				Metadata: &wsmanapi.WorkspaceMetadata{Owner: "owner", MetaId: "meta"},
+				Spec:     &wsmanapi.StartWorkspaceSpec{Class: "default"},
+			}
+
+			err = admission.Admit(context.Background(), req, test.DryRun)
+
+			act := Expectation{Code: status.Code(err), Class: req.Spec.Class}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
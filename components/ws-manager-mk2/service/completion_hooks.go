@@ -0,0 +1,252 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	completionHookResultSuccess = "success"
+	completionHookResultError   = "error"
+)
+
+// completionHook delivers the completion of a headless workspace to an endpoint
+type completionHook interface {
+	Notify(ctx context.Context, completion *wsmanapi.HeadlessWorkspaceCompletion) error
+	Close() error
+}
+
+type configuredCompletionHook struct {
+	cfg  *config.CompletionHookConfiguration
+	hook completionHook
+}
+
+// CompletionHooks notifies external systems once headless workspaces, e.g. prebuilds, complete
+type CompletionHooks struct {
+	hooks []configuredCompletionHook
+
+	notifications *prometheus.CounterVec
+}
+
+// NewCompletionHooks creates clients for the configured completion hooks
+func NewCompletionHooks(cfgs []*config.CompletionHookConfiguration) (*CompletionHooks, error) {
+	res := &CompletionHooks{
+		notifications: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "ws_manager_mk2",
+			Name:      "completion_hook_notifications_total",
+			Help:      "total number of headless workspace completions delivered to completion hooks",
+		}, []string{"hook", "result"}),
+	}
+	for _, cfg := range cfgs {
+		err := cfg.Validate()
+		if err != nil {
+			res.Close()
+			return nil, fmt.Errorf("completion hook %s: %w", cfg.Name, err)
+		}
+
+		var pool *x509.CertPool
+		if cfg.CA != "" {
+			ca, err := os.ReadFile(cfg.CA)
+			if err != nil {
+				res.Close()
+				return nil, fmt.Errorf("cannot read CA of completion hook %s: %w", cfg.Name, err)
+			}
+			pool = x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				res.Close()
+				return nil, fmt.Errorf("CA %s of completion hook %s contains no certificates", cfg.CA, cfg.Name)
+			}
+		}
+
+		var hook completionHook
+		if cfg.URL != "" {
+			hook = newWebhookCompletionHook(cfg.URL, pool)
+		} else {
+			hook, err = newGRPCCompletionHook(cfg.GRPC, pool)
+			if err != nil {
+				res.Close()
+				return nil, fmt.Errorf("completion hook %s: %w", cfg.Name, err)
+			}
+		}
+		res.hooks = append(res.hooks, configuredCompletionHook{cfg: cfg, hook: hook})
+	}
+	return res, nil
+}
+
+// NotifyCompletion notifies all hooks configured for the type of the workspace. If any of them fails,
+// the caller is expected to retry, which notifies the other hooks again.
+func (c *CompletionHooks) NotifyCompletion(ctx context.Context, ws *workspacev1.Workspace) error {
+	completion := newHeadlessWorkspaceCompletion(ws)
+
+	var errs []error
+	for _, h := range c.hooks {
+		if !completionHookMatches(h.cfg, ws.Spec.Type) {
+			continue
+		}
+
+		hookCtx, cancel := context.WithTimeout(ctx, h.cfg.GetTimeout())
+		err := h.hook.Notify(hookCtx, completion)
+		cancel()
+		if err != nil {
+			c.notifications.WithLabelValues(h.cfg.Name, completionHookResultError).Inc()
+			log.WithError(err).WithFields(ws.OWI()).WithField("hook", h.cfg.Name).Warn("cannot notify completion hook")
+			errs = append(errs, fmt.Errorf("%s: %w", h.cfg.Name, err))
+			continue
+		}
+		c.notifications.WithLabelValues(h.cfg.Name, completionHookResultSuccess).Inc()
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes the connections to all hooks
+func (c *CompletionHooks) Close() error {
+	var errs []error
+	for _, h := range c.hooks {
+		errs = append(errs, h.hook.Close())
+	}
+	return errors.Join(errs...)
+}
+
+// Describe implements Collector
+func (c *CompletionHooks) Describe(ch chan<- *prometheus.Desc) {
+	c.notifications.Describe(ch)
+}
+
+// Collect implements Collector
+func (c *CompletionHooks) Collect(ch chan<- prometheus.Metric) {
+	c.notifications.Collect(ch)
+}
+
+func completionHookMatches(cfg *config.CompletionHookConfiguration, tpe workspacev1.WorkspaceType) bool {
+	if len(cfg.WorkspaceTypes) == 0 {
+		return true
+	}
+	for _, t := range cfg.WorkspaceTypes {
+		if t == string(tpe) {
+			return true
+		}
+	}
+	return false
+}
+
+func newHeadlessWorkspaceCompletion(ws *workspacev1.Workspace) *wsmanapi.HeadlessWorkspaceCompletion {
+	var (
+		failed             = getConditionMessageIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionFailed))
+		headlessTaskFailed = getConditionMessageIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionsHeadlessTaskFailed))
+		stopReason         = convertStopReason(ws.StopReason())
+	)
+	return &wsmanapi.HeadlessWorkspaceCompletion{
+		Id: ws.Name,
+		Metadata: &wsmanapi.WorkspaceMetadata{
+			Owner:       ws.Spec.Ownership.Owner,
+			MetaId:      ws.Spec.Ownership.WorkspaceID,
+			StartedAt:   timestamppb.New(ws.CreationTimestamp.Time),
+			Annotations: ws.Annotations,
+		},
+		Type:               convertWorkspaceType(ws.Spec.Type),
+		Success:            failed == "" && headlessTaskFailed == "" && stopReason == wsmanapi.StopReason_STOP_REASON_UNSPECIFIED && ws.Status.ExitCode != nil && *ws.Status.ExitCode == 0,
+		Failed:             failed,
+		HeadlessTaskFailed: headlessTaskFailed,
+		ExitCode:           ws.Status.ExitCode,
+		Snapshot:           ws.Status.Snapshot,
+		StopReason:         stopReason,
+		CompletedAt:        timestamppb.Now(),
+	}
+}
+
+// maxCompletionHookResponseSize limits how much of the webhook response we read
+const maxCompletionHookResponseSize = 1 << 16
+
+type webhookCompletionHook struct {
+	url    string
+	client *http.Client
+}
+
+func newWebhookCompletionHook(url string, pool *x509.CertPool) *webhookCompletionHook {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if pool != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return &webhookCompletionHook{url: url, client: &http.Client{Transport: transport}}
+}
+
+// Notify POSTs the completion in its protobuf JSON encoding. Any 2xx status counts as delivered.
+func (h *webhookCompletionHook) Notify(ctx context.Context, completion *wsmanapi.HeadlessWorkspaceCompletion) error {
+	body, err := protojson.Marshal(completion)
+	if err != nil {
+		return fmt.Errorf("cannot marshal completion: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxCompletionHookResponseSize))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (h *webhookCompletionHook) Close() error {
+	h.client.CloseIdleConnections()
+	return nil
+}
+
+type grpcCompletionHook struct {
+	conn   *grpc.ClientConn
+	client wsmanapi.HeadlessCompletionHookClient
+}
+
+func newGRPCCompletionHook(addr string, pool *x509.CertPool) (*grpcCompletionHook, error) {
+	creds := insecure.NewCredentials()
+	if pool != nil {
+		creds = credentials.NewTLS(&tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12})
+	}
+	// Dial does not block, the connection is established with the first notification
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return &grpcCompletionHook{conn: conn, client: wsmanapi.NewHeadlessCompletionHookClient(conn)}, nil
+}
+
+func (h *grpcCompletionHook) Notify(ctx context.Context, completion *wsmanapi.HeadlessWorkspaceCompletion) error {
+	_, err := h.client.WorkspaceCompleted(ctx, completion)
+	return err
+}
+
+func (h *grpcCompletionHook) Close() error {
+	return h.conn.Close()
+}
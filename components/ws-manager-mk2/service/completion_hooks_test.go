@@ -0,0 +1,230 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+type recordingCompletionHook struct {
+	api.UnimplementedHeadlessCompletionHookServer
+
+	mu          sync.Mutex
+	completions []*api.HeadlessWorkspaceCompletion
+}
+
+func (h *recordingCompletionHook) WorkspaceCompleted(ctx context.Context, req *api.HeadlessWorkspaceCompletion) (*api.WorkspaceCompletedResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.completions = append(h.completions, req)
+	return &api.WorkspaceCompletedResponse{}, nil
+}
+
+func (h *recordingCompletionHook) received() []*api.HeadlessWorkspaceCompletion {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.completions
+}
+
+func TestNotifyCompletion(t *testing.T) {
+	exitCode := func(c int32) *int32 { return &c }
+	newWorkspace := func(tpe workspacev1.WorkspaceType, code *int32, conds ...metav1.Condition) *workspacev1.Workspace {
+		return &workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ws1", Annotations: map[string]string{"foo": "bar"}},
+			Spec: workspacev1.WorkspaceSpec{
+				Type:      tpe,
+				Ownership: workspacev1.Ownership{Owner: "owner", WorkspaceID: "meta"},
+			},
+			Status: workspacev1.WorkspaceStatus{
+				Phase:      workspacev1.WorkspacePhaseStopped,
+				ExitCode:   code,
+				Snapshot:   "snapshot",
+				Conditions: conds,
+			},
+		}
+	}
+
+	type Expectation struct {
+		Error   bool
+		Webhook []*api.HeadlessWorkspaceCompletion
+		GRPC    []*api.HeadlessWorkspaceCompletion
+	}
+	tests := []struct {
+		Name          string
+		Workspace     *workspacev1.Workspace
+		WebhookTypes  []string
+		WebhookStatus int
+		Expectation   Expectation
+	}{
+		{
+			Name:      "successful prebuild",
+			Workspace: newWorkspace(workspacev1.WorkspaceTypePrebuild, exitCode(0)),
+			Expectation: Expectation{
+				Webhook: []*api.HeadlessWorkspaceCompletion{{
+					Id:       "ws1",
+					Metadata: &api.WorkspaceMetadata{Owner: "owner", MetaId: "meta", Annotations: map[string]string{"foo": "bar"}},
+					Type:     api.WorkspaceType_PREBUILD,
+					Success:  true,
+					ExitCode: exitCode(0),
+					Snapshot: "snapshot",
+				}},
+				GRPC: []*api.HeadlessWorkspaceCompletion{{
+					Id:       "ws1",
+					Metadata: &api.WorkspaceMetadata{Owner: "owner", MetaId: "meta", Annotations: map[string]string{"foo": "bar"}},
+					Type:     api.WorkspaceType_PREBUILD,
+					Success:  true,
+					ExitCode: exitCode(0),
+					Snapshot: "snapshot",
+				}},
+			},
+		},
+		{
+			Name: "failed task",
+			Workspace: newWorkspace(workspacev1.WorkspaceTypePrebuild, exitCode(1),
+				metav1.Condition{Type: string(workspacev1.WorkspaceConditionsHeadlessTaskFailed), Status: metav1.ConditionTrue, Message: "task failed"},
+				metav1.Condition{Type: string(workspacev1.WorkspaceConditionStopReason), Status: metav1.ConditionTrue, Reason: string(workspacev1.StopReasonTimeoutInactivity)},
+			),
+			Expectation: Expectation{
+				Webhook: []*api.HeadlessWorkspaceCompletion{{
+					Id:                 "ws1",
+					Metadata:           &api.WorkspaceMetadata{Owner: "owner", MetaId: "meta", Annotations: map[string]string{"foo": "bar"}},
+					Type:               api.WorkspaceType_PREBUILD,
+					HeadlessTaskFailed: "task failed",
+					ExitCode:           exitCode(1),
+					Snapshot:           "snapshot",
+					StopReason:         api.StopReason_STOP_REASON_TIMEOUT_INACTIVITY,
+				}},
+				GRPC: []*api.HeadlessWorkspaceCompletion{{
+					Id:                 "ws1",
+					Metadata:           &api.WorkspaceMetadata{Owner: "owner", MetaId: "meta", Annotations: map[string]string{"foo": "bar"}},
+					Type:               api.WorkspaceType_PREBUILD,
+					HeadlessTaskFailed: "task failed",
+					ExitCode:           exitCode(1),
+					Snapshot:           "snapshot",
+					StopReason:         api.StopReason_STOP_REASON_TIMEOUT_INACTIVITY,
+				}},
+			},
+		},
+		{
+			Name:         "filtered by workspace type",
+			Workspace:    newWorkspace(workspacev1.WorkspaceTypeImageBuild, exitCode(0)),
+			WebhookTypes: []string{string(workspacev1.WorkspaceTypePrebuild)},
+			Expectation: Expectation{
+				GRPC: []*api.HeadlessWorkspaceCompletion{{
+					Id:       "ws1",
+					Metadata: &api.WorkspaceMetadata{Owner: "owner", MetaId: "meta", Annotations: map[string]string{"foo": "bar"}},
+					Type:     api.WorkspaceType_IMAGEBUILD,
+					Success:  true,
+					ExitCode: exitCode(0),
+					Snapshot: "snapshot",
+				}},
+			},
+		},
+		{
+			Name:          "webhook failure",
+			Workspace:     newWorkspace(workspacev1.WorkspaceTypePrebuild, exitCode(0)),
+			WebhookStatus: http.StatusServiceUnavailable,
+			Expectation: Expectation{
+				Error: true,
+				GRPC: []*api.HeadlessWorkspaceCompletion{{
+					Id:       "ws1",
+					Metadata: &api.WorkspaceMetadata{Owner: "owner", MetaId: "meta", Annotations: map[string]string{"foo": "bar"}},
+					Type:     api.WorkspaceType_PREBUILD,
+					Success:  true,
+					ExitCode: exitCode(0),
+					Snapshot: "snapshot",
+				}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				webhooks []*api.HeadlessWorkspaceCompletion
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if test.WebhookStatus != 0 {
+					w.WriteHeader(test.WebhookStatus)
+					return
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("cannot read webhook body: %v", err)
+					return
+				}
+				var completion api.HeadlessWorkspaceCompletion
+				err = protojson.Unmarshal(body, &completion)
+				if err != nil {
+					t.Errorf("cannot unmarshal webhook body: %v", err)
+					return
+				}
+				mu.Lock()
+				webhooks = append(webhooks, &completion)
+				mu.Unlock()
+			}))
+			defer srv.Close()
+
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			grpcHook := &recordingCompletionHook{}
+			grpcSrv := grpc.NewServer()
+			api.RegisterHeadlessCompletionHookServer(grpcSrv, grpcHook)
+			go func() {
+				_ = grpcSrv.Serve(lis)
+			}()
+			defer grpcSrv.Stop()
+
+			hooks, err := NewCompletionHooks([]*config.CompletionHookConfiguration{
+				{Name: "webhook", URL: srv.URL, WorkspaceTypes: test.WebhookTypes},
+				{Name: "grpc", GRPC: lis.Addr().String()},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer hooks.Close()
+
+			err = hooks.NotifyCompletion(context.Background(), test.Workspace)
+			if (err != nil) != test.Expectation.Error {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			opts := []cmp.Option{
+				protocmp.Transform(),
+				protocmp.IgnoreFields(&api.HeadlessWorkspaceCompletion{}, "completed_at"),
+				protocmp.IgnoreFields(&api.WorkspaceMetadata{}, "started_at"),
+				cmpopts.EquateEmpty(),
+			}
+			if diff := cmp.Diff(test.Expectation.Webhook, webhooks, opts...); diff != "" {
+				t.Errorf("unexpected webhook notifications (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.Expectation.GRPC, grpcHook.received(), opts...); diff != "" {
+				t.Errorf("unexpected gRPC notifications (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
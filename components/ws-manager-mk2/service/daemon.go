@@ -0,0 +1,280 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/constants"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	// daemonUnavailableAfter is how long connecting to ws-daemon on a node must fail before we consider it unavailable
+	daemonUnavailableAfter = 2 * time.Minute
+
+	// daemonRetryInterval is how often we try to reach ws-daemon on a node we consider unavailable
+	daemonRetryInterval = 30 * time.Second
+)
+
+// daemonAvailability tracks on which nodes we cannot reach ws-daemon. Its zero value is ready to use.
+type daemonAvailability struct {
+	now func() time.Time
+
+	mu    sync.Mutex
+	nodes map[string]*daemonNodeState
+}
+
+type daemonNodeState struct {
+	failingSince time.Time
+	lastAttempt  time.Time
+	unavailable  bool
+}
+
+func (d *daemonAvailability) time() time.Time {
+	if d.now == nil {
+		return time.Now()
+	}
+	return d.now()
+}
+
+// allow returns true if we should try to connect to ws-daemon on the node. Once we consider ws-daemon unavailable,
+// we try at most every daemonRetryInterval.
+func (d *daemonAvailability) allow(node string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	s, ok := d.nodes[node]
+	if !ok || !s.unavailable {
+		return true
+	}
+	now := d.time()
+	if now.Sub(s.lastAttempt) < daemonRetryInterval {
+		return false
+	}
+	s.lastAttempt = now
+	return true
+}
+
+// failed records a failed connection attempt. It returns true if ws-daemon on the node just became unavailable.
+func (d *daemonAvailability) failed(node string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.nodes == nil {
+		d.nodes = make(map[string]*daemonNodeState)
+	}
+	now := d.time()
+	s, ok := d.nodes[node]
+	if !ok {
+		s = &daemonNodeState{failingSince: now}
+		d.nodes[node] = s
+	}
+	s.lastAttempt = now
+	if s.unavailable || now.Sub(s.failingSince) < daemonUnavailableAfter {
+		return false
+	}
+	s.unavailable = true
+	return true
+}
+
+// succeeded records a successful connection. It returns true if we considered ws-daemon on the node unavailable before.
+func (d *daemonAvailability) succeeded(node string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	s, ok := d.nodes[node]
+	if !ok {
+		return false
+	}
+	delete(d.nodes, node)
+	return s.unavailable
+}
+
+// unavailableNodes returns the nodes on which we consider ws-daemon unavailable
+func (d *daemonAvailability) unavailableNodes() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var res []string
+	for node, s := range d.nodes {
+		if s.unavailable {
+			res = append(res, node)
+		}
+	}
+	return res
+}
+
+// forget stops tracking the node, e.g. because it was removed from the cluster
+func (d *daemonAvailability) forget(node string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.nodes, node)
+}
+
+// connectToWorkspaceDaemon returns a connection to ws-daemon on the node. If we cannot reach ws-daemon for a while,
+// we stop scheduling workspaces to the node and mark the workspaces on it, rather than failing every request on our way.
+func (wsm *WorkspaceManagerServer) connectToWorkspaceDaemon(ctx context.Context, nodeName string) (*grpc.ClientConn, error) {
+	if !wsm.daemons.allow(nodeName) {
+		return nil, status.Errorf(codes.Unavailable, "ws-daemon on node %s is unavailable", nodeName)
+	}
+
+	host, err := wsm.workspaceDaemonHost(ctx, nodeName)
+	if status.Code(err) == codes.Internal {
+		// we cannot tell if ws-daemon is there
+		return nil, err
+	}
+	var conn *grpc.ClientConn
+	if err == nil {
+		conn, err = wsm.wsdaemonPool.Get(host)
+		if err != nil {
+			err = status.Errorf(codes.Unavailable, "cannot connect to ws-daemon: %v", err)
+		}
+	}
+	if err != nil {
+		if wsm.daemons.failed(nodeName) {
+			log.WithError(err).WithField("node", nodeName).Warn("ws-daemon is unavailable, not scheduling workspaces to the node")
+			wsm.markWorkspaceDaemonAvailability(ctx, nodeName, false)
+		}
+		return nil, err
+	}
+
+	if wsm.daemons.succeeded(nodeName) {
+		log.WithField("node", nodeName).Info("ws-daemon is available again")
+		wsm.markWorkspaceDaemonAvailability(ctx, nodeName, true)
+	}
+	return conn, nil
+}
+
+// markWorkspaceDaemonAvailability takes the node out of scheduling if ws-daemon is unavailable and sets the DaemonUnavailable
+// condition on the workspaces running on it. We label the node with a label of our own, which workspace pods must not have,
+// rather than touching the ws-daemon ready label node-labeler maintains.
+func (wsm *WorkspaceManagerServer) markWorkspaceDaemonAvailability(ctx context.Context, nodeName string, available bool) {
+	// the request which noticed might go away, but we must not leave the node half way
+	ctx = context.WithoutCancel(ctx)
+
+	err := wsm.labelDaemonUnavailable(ctx, nodeName, !available)
+	if err != nil {
+		log.WithError(err).WithField("node", nodeName).Error("cannot update ws-daemon availability label of node")
+	}
+
+	var workspaces workspacev1.WorkspaceList
+	err = wsm.Client.List(ctx, &workspaces, client.InNamespace(wsm.Config.Namespace))
+	if err != nil {
+		log.WithError(err).WithField("node", nodeName).Error("cannot list workspaces to mark ws-daemon availability")
+		return
+	}
+	for _, ws := range workspaces.Items {
+		if ws.Status.Runtime == nil || ws.Status.Runtime.NodeName != nodeName || ws.Status.Phase == workspacev1.WorkspacePhaseStopped {
+			continue
+		}
+		if !available == ws.IsConditionTrue(workspacev1.WorkspaceConditionDaemonUnavailable) {
+			continue
+		}
+
+		err = wsm.modifyWorkspace(ctx, ws.Name, true, func(ws *workspacev1.Workspace) error {
+			if available {
+				ws.Status.SetCondition(workspacev1.NewWorkspaceConditionDaemonAvailable())
+			} else {
+				ws.Status.SetCondition(workspacev1.NewWorkspaceConditionDaemonUnavailable(fmt.Sprintf("cannot reach ws-daemon on node %s", nodeName)))
+			}
+			return nil
+		})
+		if err != nil {
+			log.WithError(err).WithFields(ws.OWI()).Error("cannot mark ws-daemon availability of workspace")
+		}
+	}
+}
+
+// labelDaemonUnavailable adds or removes the label which keeps workspace pods off the node
+func (wsm *WorkspaceManagerServer) labelDaemonUnavailable(ctx context.Context, nodeName string, unavailable bool) error {
+	label := constants.DaemonUnavailableLabelPrefix + wsm.Config.Namespace
+
+	var node corev1.Node
+	err := wsm.Client.Get(ctx, types.NamespacedName{Name: nodeName}, &node)
+	if err != nil {
+		return err
+	}
+	if _, labeled := node.Labels[label]; labeled == unavailable {
+		return nil
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	if unavailable {
+		if node.Labels == nil {
+			node.Labels = make(map[string]string)
+		}
+		node.Labels[label] = "true"
+	} else {
+		delete(node.Labels, label)
+	}
+	return wsm.Client.Patch(ctx, &node, patch)
+}
+
+// WatchWorkspaceDaemons periodically tries to reach ws-daemon on the nodes we consider unavailable, such that they
+// become available again without waiting for a request to the node. This includes nodes labeled unavailable before
+// ws-manager restarted. It runs until ctx is cancelled.
+func (wsm *WorkspaceManagerServer) WatchWorkspaceDaemons(ctx context.Context) error {
+	tick := time.NewTicker(daemonRetryInterval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick.C:
+			wsm.checkWorkspaceDaemons(ctx)
+		}
+	}
+}
+
+// checkWorkspaceDaemons tries to reach ws-daemon on all nodes we consider unavailable
+func (wsm *WorkspaceManagerServer) checkWorkspaceDaemons(ctx context.Context) {
+	var nodes corev1.NodeList
+	err := wsm.Client.List(ctx, &nodes, client.HasLabels{constants.DaemonUnavailableLabelPrefix + wsm.Config.Namespace})
+	if err != nil {
+		log.WithError(err).Warn("cannot list nodes with unavailable ws-daemon")
+		return
+	}
+	labeled := make(map[string]bool, len(nodes.Items))
+	for _, node := range nodes.Items {
+		labeled[node.Name] = true
+	}
+	for _, node := range wsm.daemons.unavailableNodes() {
+		if _, ok := labeled[node]; !ok {
+			labeled[node] = false
+		}
+	}
+
+	for node, isLabeled := range labeled {
+		if !isLabeled {
+			var n corev1.Node
+			err := wsm.Client.Get(ctx, types.NamespacedName{Name: node}, &n)
+			if errors.IsNotFound(err) {
+				// nothing left to make available
+				wsm.daemons.forget(node)
+				continue
+			}
+		}
+
+		_, err := wsm.connectToWorkspaceDaemon(ctx, node)
+		if err == nil && isLabeled {
+			// we may not have known about the node, e.g. because it was labeled before we restarted
+			wsm.markWorkspaceDaemonAvailability(ctx, node, true)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/grpcpool"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/constants"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestDaemonAvailability(t *testing.T) {
+	now := time.Now()
+	d := daemonAvailability{now: func() time.Time { return now }}
+
+	if d.failed("node") {
+		t.Error("first failure must not make ws-daemon unavailable")
+	}
+	now = now.Add(daemonUnavailableAfter)
+	if !d.allow("node") {
+		t.Error("must allow connections while ws-daemon is not considered unavailable")
+	}
+	if !d.failed("node") {
+		t.Error("persistent failures must make ws-daemon unavailable")
+	}
+	if d.failed("node") {
+		t.Error("ws-daemon must become unavailable only once")
+	}
+	if d.allow("node") {
+		t.Error("must not allow connections to unavailable ws-daemon before the retry interval")
+	}
+	if !d.allow("other-node") {
+		t.Error("must allow connections to other nodes")
+	}
+	now = now.Add(daemonRetryInterval)
+	if !d.allow("node") {
+		t.Error("must allow connections to unavailable ws-daemon after the retry interval")
+	}
+	if !d.succeeded("node") {
+		t.Error("success must make ws-daemon available again")
+	}
+	if d.succeeded("node") {
+		t.Error("ws-daemon must become available only once")
+	}
+}
+
+func TestConnectToWorkspaceDaemon(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	const (
+		readyLabel       = "gitpod.io/ws-daemon_ready_ns_default"
+		unavailableLabel = constants.DaemonUnavailableLabelPrefix + "default"
+	)
+	clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: map[string]string{readyLabel: "true"}},
+		},
+		&workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+			Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+			Status: workspacev1.WorkspaceStatus{
+				Phase:   workspacev1.WorkspacePhaseRunning,
+				Runtime: &workspacev1.WorkspaceRuntimeStatus{NodeName: "node"},
+			},
+		},
+	).Build()
+
+	now := time.Now()
+	srv := WorkspaceManagerServer{
+		Client: clnt,
+		Config: &config.Configuration{Namespace: "default"},
+		wsdaemonPool: grpcpool.New(func(host string) (*grpc.ClientConn, error) {
+			return grpc.Dial(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		}, func(checkAddress string) bool { return true }),
+		daemons: daemonAvailability{now: func() time.Time { return now }},
+	}
+	ctx := context.Background()
+
+	type Expectation struct {
+		Code        codes.Code
+		Excluded    bool
+		Unavailable bool
+	}
+	expect := func(name string, exp Expectation, err error) {
+		t.Helper()
+
+		var node corev1.Node
+		if err := clnt.Get(ctx, client.ObjectKey{Name: "node"}, &node); err != nil {
+			t.Fatal(err)
+		}
+		var ws workspacev1.Workspace
+		if err := clnt.Get(ctx, client.ObjectKey{Namespace: "default", Name: "foobar"}, &ws); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := node.Labels[readyLabel]; !ok {
+			t.Errorf("%s: the ws-daemon ready label of node-labeler must not be removed", name)
+		}
+		_, excluded := node.Labels[unavailableLabel]
+		act := Expectation{
+			Code:        status.Code(err),
+			Excluded:    excluded,
+			Unavailable: ws.IsConditionTrue(workspacev1.WorkspaceConditionDaemonUnavailable),
+		}
+		if act != exp {
+			t.Errorf("%s: unexpected result: want %+v, got %+v", name, exp, act)
+		}
+	}
+
+	_, err := srv.connectToWorkspaceDaemon(ctx, "node")
+	expect("first failure", Expectation{Code: codes.Unavailable}, err)
+
+	now = now.Add(daemonUnavailableAfter)
+	_, err = srv.connectToWorkspaceDaemon(ctx, "node")
+	expect("persistent failure", Expectation{Code: codes.Unavailable, Excluded: true, Unavailable: true}, err)
+
+	err = clnt.Create(ctx, &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws-daemon", Namespace: "default", Labels: map[string]string{"component": "ws-daemon"}},
+		Spec:       corev1.PodSpec{NodeName: "node"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = srv.connectToWorkspaceDaemon(ctx, "node")
+	expect("before retry interval", Expectation{Code: codes.Unavailable, Excluded: true, Unavailable: true}, err)
+
+	now = now.Add(daemonRetryInterval)
+	_, err = srv.connectToWorkspaceDaemon(ctx, "node")
+	expect("recovered", Expectation{Code: codes.OK}, err)
+}
+
+func TestCheckWorkspaceDaemons(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	unavailableLabel := constants.DaemonUnavailableLabelPrefix + "default"
+	clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(
+		// labeled by a previous ws-manager
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "recovered", Labels: map[string]string{unavailableLabel: "true"}},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "broken", Labels: map[string]string{unavailableLabel: "true"}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "ws-daemon", Namespace: "default", Labels: map[string]string{"component": "ws-daemon"}},
+			Spec:       corev1.PodSpec{NodeName: "recovered"},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+		},
+		&workspacev1.Workspace{
+			ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+			Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+			Status: workspacev1.WorkspaceStatus{
+				Phase:      workspacev1.WorkspacePhaseRunning,
+				Runtime:    &workspacev1.WorkspaceRuntimeStatus{NodeName: "recovered"},
+				Conditions: []metav1.Condition{workspacev1.NewWorkspaceConditionDaemonUnavailable("cannot reach ws-daemon on node recovered")},
+			},
+		},
+	).Build()
+
+	srv := WorkspaceManagerServer{
+		Client: clnt,
+		Config: &config.Configuration{Namespace: "default"},
+		wsdaemonPool: grpcpool.New(func(host string) (*grpc.ClientConn, error) {
+			return grpc.Dial(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		}, func(checkAddress string) bool { return true }),
+	}
+	ctx := context.Background()
+	srv.checkWorkspaceDaemons(ctx)
+
+	for node, excluded := range map[string]bool{"recovered": false, "broken": true} {
+		var n corev1.Node
+		if err := clnt.Get(ctx, client.ObjectKey{Name: node}, &n); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := n.Labels[unavailableLabel]; ok != excluded {
+			t.Errorf("node %s: expected unavailable label to be present: %v", node, excluded)
+		}
+	}
+
+	var ws workspacev1.Workspace
+	if err := clnt.Get(ctx, client.ObjectKey{Namespace: "default", Name: "foobar"}, &ws); err != nil {
+		t.Fatal(err)
+	}
+	if ws.IsConditionTrue(workspacev1.WorkspaceConditionDaemonUnavailable) {
+		t.Error("workspace on the recovered node must not be marked as DaemonUnavailable")
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/common-go/tracing"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+const (
+	// workspaceSupervisorPort is the port supervisor serves its API on in every workspace pod
+	workspaceSupervisorPort = 22999
+
+	// logsIdleTimeout ends a task's log stream once it produced no output for this long, unless the client follows the logs.
+	// supervisor replays the output a task produced so far when we connect, hence a short timeout suffices.
+	logsIdleTimeout = 2 * time.Second
+
+	// listTasksAttempts is how often we ask supervisor for the tasks of a workspace until all of them have a terminal
+	listTasksAttempts = 10
+)
+
+// StreamWorkspaceLogs streams the task output of a headless workspace straight from supervisor, so that the logs of
+// image builds and prebuilds can be shown while they run rather than after they were uploaded.
+func (wsm *WorkspaceManagerServer) StreamWorkspaceLogs(req *wsmanapi.StreamWorkspaceLogsRequest, srv wsmanapi.WorkspaceManager_StreamWorkspaceLogsServer) (err error) {
+	span, ctx := tracing.FromContext(srv.Context(), "StreamWorkspaceLogs")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "invalid request: id: cannot be blank")
+	}
+	bookmark, err := parseLogBookmark(req.Bookmark)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid bookmark: %v", err)
+	}
+
+	var ws workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
+	if errors.IsNotFound(err) {
+		return status.Errorf(codes.NotFound, "workspace %s not found", req.Id)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot lookup workspace: %v", err)
+	}
+	if !ws.IsHeadless() {
+		return status.Errorf(codes.FailedPrecondition, "workspace %s is not headless", req.Id)
+	}
+	if ws.Status.Phase != workspacev1.WorkspacePhaseRunning || ws.Status.Runtime == nil || ws.Status.Runtime.PodIP == "" {
+		return status.Errorf(codes.FailedPrecondition, "workspace %s is not running", req.Id)
+	}
+
+	supervisorURL := fmt.Sprintf("http://%s:%d", ws.Status.Runtime.PodIP, workspaceSupervisorPort)
+	return streamTaskLogs(ctx, http.DefaultClient, supervisorURL, req.Follow, bookmark, srv.Send)
+}
+
+// logBookmark records how many bytes of output of each task a client has received
+type logBookmark map[string]int64
+
+func parseLogBookmark(s string) (logBookmark, error) {
+	res := make(logBookmark)
+	vals, err := url.ParseQuery(s)
+	if err != nil {
+		return nil, err
+	}
+	for task, v := range vals {
+		n, err := strconv.ParseInt(v[0], 10, 64)
+		if err != nil || n < 0 {
+			return nil, xerrors.Errorf("invalid offset %q for task %s", v[0], task)
+		}
+		res[task] = n
+	}
+	return res, nil
+}
+
+func (b logBookmark) String() string {
+	vals := make(url.Values, len(b))
+	for task, n := range b {
+		vals.Set(task, strconv.FormatInt(n, 10))
+	}
+	return vals.Encode()
+}
+
+type workspaceTask struct {
+	ID       string
+	Name     string
+	Terminal string
+}
+
+type taskLogChunk struct {
+	Task workspaceTask
+	Data []byte
+}
+
+// streamTaskLogs listens to the terminals of all tasks of a workspace and sends their output. Output the bookmark
+// says was already received is skipped.
+func streamTaskLogs(ctx context.Context, clnt *http.Client, supervisorURL string, follow bool, bookmark logBookmark, send func(*wsmanapi.StreamWorkspaceLogsResponse) error) error {
+	tasks, err := listWorkspaceTasks(ctx, clnt, supervisorURL)
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "cannot list workspace tasks: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		chunks = make(chan taskLogChunk)
+		errs   = make(chan error, len(tasks))
+	)
+	for _, task := range tasks {
+		go func(task workspaceTask) {
+			errs <- listenToTaskLogs(ctx, clnt, supervisorURL, task, follow, chunks)
+		}(task)
+	}
+
+	// position is the number of bytes we received per task, which includes the output the bookmark tells us to skip
+	position := make(map[string]int64, len(tasks))
+	for running := len(tasks); running > 0; {
+		select {
+		case <-ctx.Done():
+			// our client went away
+			return nil
+		case err := <-errs:
+			if err != nil {
+				return status.Errorf(codes.Unavailable, "cannot stream task logs: %v", err)
+			}
+			running--
+		case chunk := <-chunks:
+			id := chunk.Task.ID
+			start := position[id]
+			position[id] += int64(len(chunk.Data))
+			if position[id] <= bookmark[id] {
+				continue
+			}
+			data := chunk.Data
+			if skip := bookmark[id] - start; skip > 0 {
+				data = data[skip:]
+			}
+			bookmark[id] = position[id]
+
+			err := send(&wsmanapi.StreamWorkspaceLogsResponse{
+				TaskId:   id,
+				TaskName: chunk.Task.Name,
+				Data:     data,
+				Bookmark: bookmark.String(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// listenToTaskLogs forwards the output of a task's terminal until the terminal closes, or the output goes idle if we don't follow
+func listenToTaskLogs(ctx context.Context, clnt *http.Client, supervisorURL string, task workspaceTask, follow bool, chunks chan<- taskLogChunk) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, supervisorURL+"/_supervisor/v1/terminal/listen/"+url.PathEscape(task.Terminal), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := clnt.Do(req)
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		// supervisor removes a terminal once its task has finished, its output is only available from the uploaded log
+		log.WithField("task", task.ID).Debug("task terminal is gone")
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return xerrors.Errorf("cannot listen to terminal of task %s: %s", task.ID, resp.Status)
+	}
+
+	var idle *time.Timer
+	if !follow {
+		idle = time.AfterFunc(logsIdleTimeout, cancel)
+		defer idle.Stop()
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	for {
+		var msg struct {
+			Result struct {
+				Data []byte `json:"data"`
+			} `json:"result"`
+			Error *struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		err := dec.Decode(&msg)
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Error != nil {
+			return xerrors.Errorf("cannot listen to terminal of task %s: %s", task.ID, msg.Error.Message)
+		}
+		if len(msg.Result.Data) == 0 {
+			// title or exit code updates
+			continue
+		}
+
+		// we must not run into the idle timeout while waiting for the output to be sent
+		if idle != nil {
+			idle.Stop()
+		}
+		select {
+		case chunks <- taskLogChunk{Task: task, Data: msg.Result.Data}:
+		case <-ctx.Done():
+			return nil
+		}
+		if idle != nil {
+			idle.Reset(logsIdleTimeout)
+		}
+	}
+}
+
+// listWorkspaceTasks asks supervisor for the tasks of a workspace. Tasks get their terminal shortly after supervisor started,
+// hence we retry until all of them have one.
+func listWorkspaceTasks(ctx context.Context, clnt *http.Client, supervisorURL string) (tasks []workspaceTask, err error) {
+	for i := 0; i < listTasksAttempts; i++ {
+		if i > 0 {
+			select {
+			case <-time.After(1 * time.Second):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		tasks, err = getWorkspaceTasks(ctx, clnt, supervisorURL)
+		if err != nil {
+			log.WithError(err).WithField("attempt", i).Debug("cannot list workspace tasks")
+			continue
+		}
+
+		complete := true
+		for _, t := range tasks {
+			if t.Terminal == "" {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			return tasks, nil
+		}
+		err = xerrors.Errorf("not all tasks have a terminal yet")
+	}
+	return nil, err
+}
+
+func getWorkspaceTasks(ctx context.Context, clnt *http.Client, supervisorURL string) ([]workspaceTask, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, supervisorURL+"/_supervisor/v1/status/tasks", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := clnt.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, xerrors.Errorf("received non-200 status: %s", resp.Status)
+	}
+
+	var msg struct {
+		Result struct {
+			Tasks []struct {
+				ID           string `json:"id"`
+				Terminal     string `json:"terminal"`
+				Presentation struct {
+					Name string `json:"name"`
+				} `json:"presentation"`
+			} `json:"tasks"`
+		} `json:"result"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&msg)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot decode supervisor status response: %w", err)
+	}
+
+	res := make([]workspaceTask, 0, len(msg.Result.Tasks))
+	for _, t := range msg.Result.Tasks {
+		res = append(res, workspaceTask{
+			ID:       t.ID,
+			Name:     t.Presentation.Name,
+			Terminal: t.Terminal,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+)
+
+func newFakeSupervisor(t *testing.T, output map[string][]string, keepOpen bool) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/_supervisor/v1/status/tasks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"result":{"tasks":[{"id":"0","terminal":"term-0","presentation":{"name":"build"}},{"id":"1","terminal":"term-1","presentation":{"name":"test"}}]}}`)
+	})
+	mux.HandleFunc("/_supervisor/v1/terminal/listen/", func(w http.ResponseWriter, r *http.Request) {
+		lines, ok := output[r.URL.Path[len("/_supervisor/v1/terminal/listen/"):]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		enc := json.NewEncoder(w)
+		_ = enc.Encode(map[string]interface{}{"result": map[string]interface{}{"title": "bash"}})
+		for _, l := range lines {
+			_ = enc.Encode(map[string]interface{}{"result": map[string]interface{}{"data": []byte(l)}})
+		}
+		w.(http.Flusher).Flush()
+		if keepOpen {
+			<-r.Context().Done()
+		}
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestStreamTaskLogs(t *testing.T) {
+	output := map[string][]string{
+		"term-0": {"hello ", "world\n"},
+	}
+
+	type Expectation struct {
+		Data     string
+		Bookmark string
+	}
+	tests := []struct {
+		Name        string
+		Follow      bool
+		Bookmark    logBookmark
+		Expectation []Expectation
+	}{
+		{
+			Name:   "follow",
+			Follow: true,
+			Expectation: []Expectation{
+				{Data: "hello ", Bookmark: "0=6"},
+				{Data: "world\n", Bookmark: "0=12"},
+			},
+		},
+		{
+			Name:     "bookmark within a chunk",
+			Follow:   true,
+			Bookmark: logBookmark{"0": 8},
+			Expectation: []Expectation{
+				{Data: "rld\n", Bookmark: "0=12"},
+			},
+		},
+		{
+			Name:     "bookmark at the end",
+			Follow:   true,
+			Bookmark: logBookmark{"0": 12},
+		},
+		{
+			Name: "no follow",
+			Expectation: []Expectation{
+				{Data: "hello ", Bookmark: "0=6"},
+				{Data: "world\n", Bookmark: "0=12"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			// without follow the terminal stays open, as it would while the task is still running
+			srv := newFakeSupervisor(t, output, !test.Follow)
+
+			bookmark := test.Bookmark
+			if bookmark == nil {
+				bookmark = make(logBookmark)
+			}
+			var act []Expectation
+			err := streamTaskLogs(context.Background(), srv.Client(), srv.URL, test.Follow, bookmark, func(resp *wsmanapi.StreamWorkspaceLogsResponse) error {
+				if resp.TaskId != "0" || resp.TaskName != "build" {
+					t.Errorf("unexpected task %s (%s)", resp.TaskId, resp.TaskName)
+				}
+				act = append(act, Expectation{Data: string(resp.Data), Bookmark: resp.Bookmark})
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected output (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLogBookmark(t *testing.T) {
+	b, err := parseLogBookmark("0=12&1=3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(logBookmark{"0": 12, "1": 3}, b); diff != "" {
+		t.Errorf("unexpected bookmark (-want +got):\n%s", diff)
+	}
+	if s := b.String(); s != "0=12&1=3" {
+		t.Errorf("unexpected bookmark string %q", s)
+	}
+
+	for _, invalid := range []string{"0=abc", "0=-1"} {
+		if _, err := parseLogBookmark(invalid); err == nil {
+			t.Errorf("expected error for bookmark %q", invalid)
+		}
+	}
+}
@@ -7,7 +7,10 @@ package service
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -42,6 +45,10 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/tracing"
 	"github.com/gitpod-io/gitpod/common-go/util"
 	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	imgbldr "github.com/gitpod-io/gitpod/image-builder/api"
+	wsdaemon "github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/controllers"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/grpcpool"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/activity"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/constants"
 	"github.com/gitpod-io/gitpod/ws-manager-mk2/pkg/maintenance"
@@ -55,6 +62,10 @@ const (
 	stopWorkspaceNormallyGracePeriod = 30 * time.Second
 	// stopWorkspaceImmediatelyGracePeriod is the grace period we use when stopping a pod as soon as possbile
 	stopWorkspaceImmediatelyGracePeriod = 1 * time.Second
+	// openPullRequestPriority is the priority of prebuilds of branches with an open pull request in the prebuild queue
+	openPullRequestPriority = 1
+	// defaultDrainNodeTimeout is how long we wait for the workspaces of a drained node to stop if the request does not say otherwise
+	defaultDrainNodeTimeout = 30 * time.Minute
 )
 
 var (
@@ -66,17 +77,28 @@ var (
 		Factor:   2.0,
 		Jitter:   0.2,
 	}
+
+	// drainNodePollInterval is how often we check on the workspaces of a node that is being drained
+	drainNodePollInterval = 2 * time.Second
+
+	// imageTagRegexp matches valid OCI image tags
+	imageTagRegexp = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
 )
 
-func NewWorkspaceManagerServer(clnt client.Client, cfg *config.Configuration, reg prometheus.Registerer, maintenance maintenance.Maintenance) *WorkspaceManagerServer {
+func NewWorkspaceManagerServer(clnt client.Client, cfg *config.Configuration, reg prometheus.Registerer, maintenance maintenance.Maintenance, wsdaemonPool *grpcpool.Pool, imageBuilder imgbldr.ImageBuilderClient, admission *StartAdmission, policy *StartPolicy, rateLimiter *StartRateLimiter) *WorkspaceManagerServer {
 	metrics := newWorkspaceMetrics(cfg.Namespace, clnt)
 	reg.MustRegister(metrics)
 
 	return &WorkspaceManagerServer{
-		Client:      clnt,
-		Config:      cfg,
-		metrics:     metrics,
-		maintenance: maintenance,
+		Client:       clnt,
+		Config:       cfg,
+		metrics:      metrics,
+		maintenance:  maintenance,
+		wsdaemonPool: wsdaemonPool,
+		imageBuilder: imageBuilder,
+		admission:    admission,
+		policy:       policy,
+		rateLimiter:  rateLimiter,
 		subs: subscriptions{
 			subscribers: make(map[string]chan *wsmanapi.SubscribeResponse),
 		},
@@ -84,10 +106,20 @@ func NewWorkspaceManagerServer(clnt client.Client, cfg *config.Configuration, re
 }
 
 type WorkspaceManagerServer struct {
-	Client      client.Client
-	Config      *config.Configuration
-	metrics     *workspaceMetrics
-	maintenance maintenance.Maintenance
+	Client       client.Client
+	Config       *config.Configuration
+	metrics      *workspaceMetrics
+	maintenance  maintenance.Maintenance
+	wsdaemonPool *grpcpool.Pool
+	// daemons tracks the nodes on which we cannot reach ws-daemon
+	daemons      daemonAvailability
+	imageBuilder imgbldr.ImageBuilderClient
+	// admission is consulted before workspaces start. It is nil if no admission endpoint is configured.
+	admission *StartAdmission
+	// policy is evaluated for the resolved workspace before it starts. It is nil if no start policy is configured.
+	policy *StartPolicy
+	// rateLimiter limits how often an owner can start workspaces. It is nil if starts are not rate limited.
+	rateLimiter *StartRateLimiter
 
 	subs subscriptions
 	wsmanapi.UnimplementedWorkspaceManagerServer
@@ -112,10 +144,284 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		return &wsmanapi.StartWorkspaceResponse{}, status.Error(codes.FailedPrecondition, "under maintenance")
 	}
 
+	if req.ValidateOnly {
+		return wsm.validateStartWorkspace(ctx, req)
+	}
+
+	if err := validateStartWorkspaceRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.IdempotencyKey != "" {
+		// a retry must not be rate limited or admitted again, the workspace was started already
+		resp, err := wsm.startedWorkspace(ctx, req)
+		if err != nil || resp != nil {
+			return resp, err
+		}
+	}
+
+	if wsm.rateLimiter != nil {
+		err = wsm.rateLimiter.Allow(req.Metadata.Owner)
+		if err != nil {
+			log.WithFields(owi).Warn("workspace start was rate limited")
+			return nil, err
+		}
+	}
+
+	restartClass, err := wsm.applyRestartClass(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if wsm.admission != nil {
+		err = wsm.admission.Admit(ctx, req, false)
+		if err != nil {
+			return nil, err
+		}
+		// the admission endpoint might have changed the spec
+		if err := validateStartWorkspaceRequest(req); err != nil {
+			return nil, err
+		}
+	}
+
+	ws, envData, tokenData, err := wsm.newPolicedWorkspaceResource(ctx, req, false)
+	if err != nil {
+		return nil, err
+	}
+	envSecretName := fmt.Sprintf("%s-%s", req.Id, "env")
+
+	exists, err := wsm.workspaceExists(ctx, req.Metadata.MetaId)
+	if err != nil {
+		return nil, fmt.Errorf("cannot check if workspace %s exists: %w", req.Metadata.MetaId, err)
+	}
+
+	if exists {
+		if req.IdempotencyKey != "" {
+			// the original request might have created the workspace in the meantime
+			resp, err := wsm.startedWorkspace(ctx, req)
+			if err != nil || resp != nil {
+				return resp, err
+			}
+		}
+		return nil, status.Errorf(codes.AlreadyExists, "workspace %s already exists", req.Metadata.MetaId)
+	}
+
+	err = wsm.createWorkspaceSecret(ctx, ws, envSecretName, wsm.Config.Namespace, envData)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create env secret for workspace %s: %w", req.Id, err)
+	}
+
+	err = wsm.createWorkspaceSecret(ctx, ws, fmt.Sprintf("%s-%s", req.Id, "tokens"), wsm.Config.SecretsNamespace, tokenData)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create token secret for workspace %s: %w", req.Id, err)
+	}
+
+	err = wsm.Client.Create(ctx, ws)
+	if errors.IsAlreadyExists(err) && req.IdempotencyKey != "" {
+		// a retry which raced the original request
+		log.WithFields(owi).Info("workspace was created by a concurrent request with the same idempotency key")
+		return wsm.startedWorkspace(ctx, req)
+	}
+	if err != nil {
+		log.WithError(err).WithFields(owi).Error("error creating workspace")
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot create workspace")
+	}
+	wsm.metrics.recordWorkspaceStart(ws)
+	if restartClass != nil {
+		err = wsm.Client.Delete(ctx, restartClass)
+		if err != nil && !errors.IsNotFound(err) {
+			log.WithError(err).WithFields(owi).Warn("cannot remove restart class of workspace")
+		}
+	}
+
+	return wsm.waitForWorkspaceURL(ctx, ws.Name)
+}
+
+// waitForWorkspaceURL waits until the controller has set the URL and owner token of a workspace
+func (wsm *WorkspaceManagerServer) waitForWorkspaceURL(ctx context.Context, name string) (*wsmanapi.StartWorkspaceResponse, error) {
+	var wsr workspacev1.Workspace
+	err := wait.PollWithContext(ctx, 100*time.Millisecond, 30*time.Second, func(c context.Context) (done bool, err error) {
+		err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: name}, &wsr)
+		if err != nil {
+			return false, nil
+		}
+
+		if wsr.Status.OwnerToken != "" && wsr.Status.URL != "" {
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot wait for workspace URL: %q", err)
+	}
+
+	return &wsmanapi.StartWorkspaceResponse{
+		Url:        wsr.Status.URL,
+		OwnerToken: wsr.Status.OwnerToken,
+	}, nil
+}
+
+// idempotencyKeyLabelValue hashes an idempotency key into a valid label value
+func idempotencyKeyLabelValue(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:24])
+}
+
+// startedWorkspace returns the response to a start request whose idempotency key was used to start a workspace
+// before, or nil if the key was not used yet.
+func (wsm *WorkspaceManagerServer) startedWorkspace(ctx context.Context, req *wsmanapi.StartWorkspaceRequest) (*wsmanapi.StartWorkspaceResponse, error) {
+	var workspaces workspacev1.WorkspaceList
+	err := wsm.Client.List(ctx, &workspaces,
+		client.InNamespace(wsm.Config.Namespace),
+		client.MatchingLabels{wsk8s.IdempotencyKeyLabel: idempotencyKeyLabelValue(req.IdempotencyKey)},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup idempotency key: %v", err)
+	}
+	if len(workspaces.Items) == 0 {
+		return nil, nil
+	}
+
+	ws := workspaces.Items[0]
+	if ws.Name != req.Id || ws.Spec.Ownership.WorkspaceID != req.Metadata.MetaId {
+		return nil, status.Errorf(codes.AlreadyExists, "idempotency key was used to start instance %s", ws.Name)
+	}
+	log.WithFields(log.OWI(req.Metadata.Owner, req.Metadata.MetaId, req.Id)).Info("workspace was started before, returning the original response")
+	return wsm.waitForWorkspaceURL(ctx, ws.Name)
+}
+
+// validateStartWorkspace checks if a workspace could be started without creating anything. The problems
+// which would make the start fail are returned as part of the response.
+func (wsm *WorkspaceManagerServer) validateStartWorkspace(ctx context.Context, req *wsmanapi.StartWorkspaceRequest) (*wsmanapi.StartWorkspaceResponse, error) {
 	if err := validateStartWorkspaceRequest(req); err != nil {
+		return &wsmanapi.StartWorkspaceResponse{Problems: []string{status.Convert(err).Message()}}, nil
+	}
+
+	_, err := wsm.applyRestartClass(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	if wsm.admission != nil {
+		err := wsm.admission.Admit(ctx, req, true)
+		if err != nil {
+			problems = append(problems, status.Convert(err).Message())
+		} else if err := validateStartWorkspaceRequest(req); err != nil {
+			return &wsmanapi.StartWorkspaceResponse{Problems: []string{status.Convert(err).Message()}}, nil
+		}
+	}
+
+	problems = append(problems, validateEnvVarSizes(req.Spec.Envvars)...)
+	problems = append(problems, validateEnvVarSizes(req.Spec.SysEnvvars)...)
+	problems = append(problems, wsm.validateImages(ctx, req.Spec)...)
+
+	ws, envData, _, err := wsm.newPolicedWorkspaceResource(ctx, req, true)
+	if code := status.Code(err); code == codes.InvalidArgument || code == codes.PermissionDenied || code == codes.Unavailable {
+		problems = append(problems, status.Convert(err).Message())
+		return &wsmanapi.StartWorkspaceResponse{Problems: problems}, nil
+	}
+	if err != nil {
 		return nil, err
 	}
 
+	exists, err := wsm.workspaceExists(ctx, req.Metadata.MetaId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot check if workspace %s exists: %v", req.Metadata.MetaId, err)
+	}
+	if exists {
+		problems = append(problems, fmt.Sprintf("workspace %s already exists", req.Metadata.MetaId))
+	}
+
+	var secretSize int
+	for k, v := range envData {
+		secretSize += len(k) + len(v)
+	}
+	if secretSize > corev1.MaxSecretSize {
+		problems = append(problems, fmt.Sprintf("environment variables are too large: %d bytes exceed the limit of %d bytes", secretSize, corev1.MaxSecretSize))
+	}
+
+	err = wsm.Client.Create(ctx, ws, client.DryRunAll)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("workspace would be rejected: %v", err))
+	}
+
+	pod, err := controllers.RenderWorkspacePod(ctx, wsm.Client, wsm.Config, ws)
+	if err != nil {
+		problems = append(problems, fmt.Sprintf("cannot render workspace pod: %v", err))
+	} else if err = wsm.Client.Create(ctx, pod, client.DryRunAll); err != nil {
+		problems = append(problems, fmt.Sprintf("workspace pod would be rejected: %v", err))
+	}
+
+	return &wsmanapi.StartWorkspaceResponse{Problems: problems}, nil
+}
+
+// validateImages resolves the images of a workspace using image-builder, which has access to the registries.
+// Without image-builder the images are not validated.
+func (wsm *WorkspaceManagerServer) validateImages(ctx context.Context, spec *wsmanapi.StartWorkspaceSpec) []string {
+	if wsm.imageBuilder == nil {
+		return nil
+	}
+
+	refs := []string{spec.WorkspaceImage}
+	if spec.IdeImage != nil {
+		refs = append(refs, spec.IdeImage.WebRef, spec.IdeImage.SupervisorRef)
+	}
+	refs = append(refs, spec.IdeImageLayers...)
+
+	var problems []string
+	for _, ref := range refs {
+		if ref == "" {
+			continue
+		}
+		_, err := wsm.imageBuilder.ResolveBaseImage(ctx, &imgbldr.ResolveBaseImageRequest{
+			Ref: ref,
+			Auth: &imgbldr.BuildRegistryAuth{
+				Mode: &imgbldr.BuildRegistryAuth_Total{Total: &imgbldr.BuildRegistryAuthTotal{AllowAll: true}},
+			},
+		})
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("cannot resolve image %s: %s", ref, status.Convert(err).Message()))
+		}
+	}
+	return problems
+}
+
+// maxEnvVarSize is the maximum size of a single "name=value" environment variable string (MAX_ARG_STRLEN on Linux).
+const maxEnvVarSize = 128 * 1024
+
+func validateEnvVarSizes(envs []*wsmanapi.EnvironmentVariable) []string {
+	var problems []string
+	for _, e := range envs {
+		if size := len(e.Name) + 1 + len(e.Value); size > maxEnvVarSize {
+			problems = append(problems, fmt.Sprintf("environment variable %s is too large: %d bytes exceed the limit of %d bytes", e.Name, size, maxEnvVarSize))
+		}
+	}
+	return problems
+}
+
+// newPolicedWorkspaceResource creates the workspace resource for a request and evaluates the start policy for it.
+// If the policy modified the request, the workspace resource is created again from the modified request.
+func (wsm *WorkspaceManagerServer) newPolicedWorkspaceResource(ctx context.Context, req *wsmanapi.StartWorkspaceRequest, dryRun bool) (ws *workspacev1.Workspace, envData, tokenData map[string]string, err error) {
+	ws, envData, tokenData, err = wsm.newWorkspaceResource(req)
+	if err != nil || wsm.policy == nil {
+		return ws, envData, tokenData, err
+	}
+
+	mutated, err := wsm.policy.Evaluate(ctx, req, ws, dryRun)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !mutated {
+		return ws, envData, tokenData, nil
+	}
+	return wsm.newWorkspaceResource(req)
+}
+
+// newWorkspaceResource produces the workspace resource for a start workspace request, alongside the data of
+// the env and token secrets.
+func (wsm *WorkspaceManagerServer) newWorkspaceResource(req *wsmanapi.StartWorkspaceRequest) (ws *workspacev1.Workspace, envData, tokenData map[string]string, err error) {
 	var workspaceType workspacev1.WorkspaceType
 	switch req.Type {
 	case wsmanapi.WorkspaceType_IMAGEBUILD:
@@ -125,7 +431,7 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 	case wsmanapi.WorkspaceType_REGULAR:
 		workspaceType = workspacev1.WorkspaceTypeRegular
 	default:
-		return nil, status.Errorf(codes.InvalidArgument, "unsupported workspace type: %v", req.Type)
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "unsupported workspace type: %v", req.Type)
 	}
 
 	var git *workspacev1.GitSpec
@@ -138,17 +444,28 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 
 	timeout, err := parseTimeout(req.Spec.Timeout)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, nil, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	closedTimeout, err := parseTimeout(req.Spec.ClosedTimeout)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, nil, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	maximumLifetime, err := parseTimeout(req.Spec.MaximumLifetime)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var clockOffset *metav1.Duration
+	if req.Spec.ClockOffset != "" {
+		d, err := time.ParseDuration(req.Spec.ClockOffset)
+		if err != nil {
+			return nil, nil, nil, status.Errorf(codes.InvalidArgument, "invalid clock offset: %v", err)
+		}
+		if d != 0 {
+			clockOffset = &metav1.Duration{Duration: d}
+		}
 	}
 
 	var admissionLevel workspacev1.AdmissionLevel
@@ -158,24 +475,12 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 	case wsmanapi.AdmissionLevel_ADMIT_OWNER_ONLY:
 		admissionLevel = workspacev1.AdmissionLevelOwner
 	default:
-		return nil, status.Errorf(codes.InvalidArgument, "unsupported admission level: %v", req.Spec.Admission)
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "unsupported admission level: %v", req.Spec.Admission)
 	}
 
 	ports := make([]workspacev1.PortSpec, 0, len(req.Spec.Ports))
 	for _, p := range req.Spec.Ports {
-		v := workspacev1.AdmissionLevelOwner
-		if p.Visibility == wsmanapi.PortVisibility_PORT_VISIBILITY_PUBLIC {
-			v = workspacev1.AdmissionLevelEveryone
-		}
-		protocol := workspacev1.PortProtocolHttp
-		if p.Protocol == wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS {
-			protocol = workspacev1.PortProtocolHttps
-		}
-		ports = append(ports, workspacev1.PortSpec{
-			Port:       p.Port,
-			Visibility: v,
-			Protocol:   protocol,
-		})
+		ports = append(ports, convertPortSpec(p))
 	}
 
 	var classID string
@@ -188,13 +493,19 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 
 	class, ok := wsm.Config.WorkspaceClasses[classID]
 	if !ok {
-		return nil, status.Errorf(codes.InvalidArgument, "workspace class \"%s\" is unknown", req.Spec.Class)
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "workspace class \"%s\" is unknown", req.Spec.Class)
+	}
+
+	for _, sidecar := range req.Spec.Sidecars {
+		if _, ok := wsm.Config.SidecarCatalog[sidecar]; !ok {
+			return nil, nil, nil, status.Errorf(codes.InvalidArgument, "sidecar \"%s\" is not in the sidecar catalog", sidecar)
+		}
 	}
 
 	storage, err := class.Container.Limits.StorageQuantity()
 	if err != nil {
 		msg := fmt.Sprintf("workspace class %s has invalid storage quantity: %v", class.Name, err)
-		return nil, status.Errorf(codes.InvalidArgument, msg)
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, msg)
 	}
 
 	annotations := make(map[string]string)
@@ -211,6 +522,26 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 		if limits.CPU.BurstLimit != "" {
 			annotations[wsk8s.WorkspaceCpuBurstLimitAnnotation] = limits.CPU.BurstLimit
 		}
+
+		if limits.CPU.BurstCredit != "" {
+			annotations[wsk8s.WorkspaceCpuBurstCreditAnnotation] = limits.CPU.BurstCredit
+		}
+	}
+	if limits != nil && limits.Network != nil {
+		if limits.Network.Egress != "" {
+			annotations[wsk8s.WorkspaceNetEgressBandwidthAnnotation] = limits.Network.Egress
+		}
+		if limits.Network.Ingress != "" {
+			annotations[wsk8s.WorkspaceNetIngressBandwidthAnnotation] = limits.Network.Ingress
+		}
+	}
+	if limits != nil && limits.MemoryControl != nil {
+		if limits.MemoryControl.High != "" {
+			annotations[wsk8s.WorkspaceMemoryHighAnnotation] = limits.MemoryControl.High
+		}
+		if limits.MemoryControl.Swap != "" {
+			annotations[wsk8s.WorkspaceMemorySwapAnnotation] = limits.MemoryControl.Swap
+		}
 	}
 
 	var sshGatewayCAPublicKey string
@@ -229,13 +560,13 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 	userEnvVars, envData := extractWorkspaceUserEnv(envSecretName, req.Spec.Envvars, req.Spec.SysEnvvars)
 	sysEnvVars := extractWorkspaceSysEnv(req.Spec.SysEnvvars)
 
-	tokenData := extractWorkspaceTokenData(req.Spec)
+	tokenData = extractWorkspaceTokenData(req.Spec)
 	initializer, err := proto.Marshal(req.Spec.Initializer)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "cannot serialise content initializer: %v", err)
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, "cannot serialise content initializer: %v", err)
 	}
 
-	ws := workspacev1.Workspace{
+	ws = &workspacev1.Workspace{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: workspacev1.GroupVersion.String(),
 			Kind:       "Workspace",
@@ -254,6 +585,7 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 			Ownership: workspacev1.Ownership{
 				Owner:       req.Metadata.Owner,
 				WorkspaceID: req.Metadata.MetaId,
+				Project:     req.Metadata.GetProject(),
 			},
 			Type:  workspaceType,
 			Class: classID,
@@ -284,57 +616,20 @@ func (wsm *WorkspaceManagerServer) StartWorkspace(ctx context.Context, req *wsma
 			SshPublicKeys:         req.Spec.SshPublicKeys,
 			StorageQuota:          int(storage.Value()),
 			SSHGatewayCAPublicKey: sshGatewayCAPublicKey,
+			Sidecars:              req.Spec.Sidecars,
+			ClockOffset:           clockOffset,
+			Ephemeral:             req.Spec.Ephemeral,
 		},
 	}
-	controllerutil.AddFinalizer(&ws, workspacev1.GitpodFinalizerName)
-
-	exists, err := wsm.workspaceExists(ctx, req.Metadata.MetaId)
-	if err != nil {
-		return nil, fmt.Errorf("cannot check if workspace %s exists: %w", req.Metadata.MetaId, err)
-	}
-
-	if exists {
-		return nil, status.Errorf(codes.AlreadyExists, "workspace %s already exists", req.Metadata.MetaId)
-	}
-
-	err = wsm.createWorkspaceSecret(ctx, &ws, envSecretName, wsm.Config.Namespace, envData)
-	if err != nil {
-		return nil, fmt.Errorf("cannot create env secret for workspace %s: %w", req.Id, err)
-	}
-
-	err = wsm.createWorkspaceSecret(ctx, &ws, fmt.Sprintf("%s-%s", req.Id, "tokens"), wsm.Config.SecretsNamespace, tokenData)
-	if err != nil {
-		return nil, fmt.Errorf("cannot create token secret for workspace %s: %w", req.Id, err)
-	}
-
-	wsm.metrics.recordWorkspaceStart(&ws)
-	err = wsm.Client.Create(ctx, &ws)
-	if err != nil {
-		log.WithError(err).WithFields(owi).Error("error creating workspace")
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot create workspace")
+	if req.Spec.OpenPullRequest {
+		ws.Spec.Priority = openPullRequestPriority
 	}
-
-	var wsr workspacev1.Workspace
-	err = wait.PollWithContext(ctx, 100*time.Millisecond, 30*time.Second, func(c context.Context) (done bool, err error) {
-		err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: ws.Name}, &wsr)
-		if err != nil {
-			return false, nil
-		}
-
-		if wsr.Status.OwnerToken != "" && wsr.Status.URL != "" {
-			return true, nil
-		}
-
-		return false, nil
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "cannot wait for workspace URL: %q", err)
+	if req.IdempotencyKey != "" {
+		ws.Labels[wsk8s.IdempotencyKeyLabel] = idempotencyKeyLabelValue(req.IdempotencyKey)
 	}
+	controllerutil.AddFinalizer(ws, workspacev1.GitpodFinalizerName)
 
-	return &wsmanapi.StartWorkspaceResponse{
-		Url:        wsr.Status.URL,
-		OwnerToken: wsr.Status.OwnerToken,
-	}, nil
+	return ws, envData, tokenData, nil
 }
 
 func (wsm *WorkspaceManagerServer) workspaceExists(ctx context.Context, id string) (bool, error) {
@@ -407,6 +702,15 @@ func (wsm *WorkspaceManagerServer) StopWorkspace(ctx context.Context, req *wsman
 		return &wsmanapi.StopWorkspaceResponse{}, status.Error(codes.FailedPrecondition, "under maintenance")
 	}
 
+	stopReason := workspacev1.StopReasonUserRequested
+	if req.Reason != wsmanapi.StopReason_STOP_REASON_UNSPECIFIED {
+		var ok bool
+		stopReason, ok = stopReasons[req.Reason]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported stop reason %v", req.Reason)
+		}
+	}
+
 	gracePeriod := stopWorkspaceNormallyGracePeriod
 	if req.Policy == wsmanapi.StopWorkspacePolicy_IMMEDIATELY {
 		span.LogKV("policy", "immediately")
@@ -421,10 +725,7 @@ func (wsm *WorkspaceManagerServer) StopWorkspace(ctx context.Context, req *wsman
 			log.WithError(err).WithFields(owi).Error("failed to add Aborted condition to workspace")
 		}
 	}
-	err = wsm.modifyWorkspace(ctx, req.Id, true, func(ws *workspacev1.Workspace) error {
-		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionStoppedByRequest(gracePeriod.String()))
-		return nil
-	})
+	err = wsm.stopWorkspace(ctx, req.Id, gracePeriod, stopReason, "StopWorkspaceRequest")
 	// Ignore NotFound errors, workspace has already been stopped.
 	if err != nil && status.Code(err) != codes.NotFound {
 		return nil, err
@@ -432,6 +733,19 @@ func (wsm *WorkspaceManagerServer) StopWorkspace(ctx context.Context, req *wsman
 	return &wsmanapi.StopWorkspaceResponse{}, nil
 }
 
+// stopWorkspace marks a workspace as stopped by request, which makes the controller delete its pod after the grace period
+func (wsm *WorkspaceManagerServer) stopWorkspace(ctx context.Context, id string, gracePeriod time.Duration, stopReason workspacev1.StopReason, reason string) error {
+	return wsm.modifyWorkspace(ctx, id, true, func(ws *workspacev1.Workspace) error {
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionStoppedByRequest(gracePeriod.String()))
+		ws.SetStopReason(stopReason, reason)
+		if ws.RelocationTarget() != "" {
+			// a stopped workspace must not be started on the relocation target
+			ws.Status.SetCondition(workspacev1.NewWorkspaceConditionRelocationCancelled(reason))
+		}
+		return nil
+	})
+}
+
 func (wsm *WorkspaceManagerServer) GetWorkspaces(ctx context.Context, req *wsmanapi.GetWorkspacesRequest) (*wsmanapi.GetWorkspacesResponse, error) {
 	labelSelector, err := metadataFilterToLabelSelector(req.MustMatch)
 	if err != nil {
@@ -520,8 +834,16 @@ func (wsm *WorkspaceManagerServer) MarkActive(ctx context.Context, req *wsmanapi
 	lastActivityStatus := metav1.NewTime(now)
 	ws.Status.LastActivity = &lastActivityStatus
 
+	source, ok := activitySources[req.Source]
+	if !ok {
+		source = workspacev1.ActivitySourceUnspecified
+	}
 	err = wsm.modifyWorkspace(ctx, req.Id, true, func(ws *workspacev1.Workspace) error {
 		ws.Status.LastActivity = &lastActivityStatus
+		if ws.Status.LastActivityBySource == nil {
+			ws.Status.LastActivityBySource = make(map[workspacev1.ActivitySource]metav1.Time)
+		}
+		ws.Status.LastActivityBySource[source] = lastActivityStatus
 		return nil
 	})
 	if err != nil {
@@ -565,6 +887,36 @@ func (wsm *WorkspaceManagerServer) MarkActive(ctx context.Context, req *wsmanapi
 	return &wsmanapi.MarkActiveResponse{}, nil
 }
 
+func (wsm *WorkspaceManagerServer) GetLastActivity(ctx context.Context, req *wsmanapi.GetLastActivityRequest) (res *wsmanapi.GetLastActivityResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "GetLastActivity")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	var ws workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
+	if errors.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s does not exist", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup workspace: %v", err)
+	}
+
+	res = &wsmanapi.GetLastActivityResponse{}
+	if ws.Status.LastActivity != nil {
+		res.LastActivity = timestamppb.New(ws.Status.LastActivity.Time)
+	}
+	for src, t := range ws.Status.LastActivityBySource {
+		res.Sources = append(res.Sources, &wsmanapi.SourceActivity{
+			Source:       activitySourcesAPI[src],
+			LastActivity: timestamppb.New(t.Time),
+		})
+	}
+	sort.Slice(res.Sources, func(i, j int) bool {
+		return res.Sources[i].Source < res.Sources[j].Source
+	})
+	return res, nil
+}
+
 func (wsm *WorkspaceManagerServer) SetTimeout(ctx context.Context, req *wsmanapi.SetTimeoutRequest) (*wsmanapi.SetTimeoutResponse, error) {
 	duration, err := time.ParseDuration(req.Duration)
 	if err != nil {
@@ -598,6 +950,12 @@ func (wsm *WorkspaceManagerServer) ControlPort(ctx context.Context, req *wsmanap
 	if req.Spec == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing spec")
 	}
+	if req.Spec.Port == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "missing port")
+	}
+	if _, ok := wsmanapi.PortProtocol_name[int32(req.Spec.Protocol)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported port protocol: %v", req.Spec.Protocol)
+	}
 
 	port := req.Spec.Port
 	err = wsm.modifyWorkspace(ctx, req.Id, false, func(ws *workspacev1.Workspace) error {
@@ -611,19 +969,7 @@ func (wsm *WorkspaceManagerServer) ControlPort(ctx context.Context, req *wsmanap
 		ws.Spec.Ports = ws.Spec.Ports[:n]
 
 		if req.Expose {
-			visibility := workspacev1.AdmissionLevelOwner
-			protocol := workspacev1.PortProtocolHttp
-			if req.Spec.Visibility == wsmanapi.PortVisibility_PORT_VISIBILITY_PUBLIC {
-				visibility = workspacev1.AdmissionLevelEveryone
-			}
-			if req.Spec.Protocol == wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS {
-				protocol = workspacev1.PortProtocolHttps
-			}
-			ws.Spec.Ports = append(ws.Spec.Ports, workspacev1.PortSpec{
-				Port:       port,
-				Visibility: visibility,
-				Protocol:   protocol,
-			})
+			ws.Spec.Ports = append(ws.Spec.Ports, convertPortSpec(req.Spec))
 		}
 
 		return nil
@@ -643,6 +989,15 @@ func (wsm *WorkspaceManagerServer) TakeSnapshot(ctx context.Context, req *wsmana
 		return &wsmanapi.TakeSnapshotResponse{}, status.Error(codes.FailedPrecondition, "under maintenance")
 	}
 
+	if len(req.Message) > maxSnapshotMessageLength {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: message: must not be longer than %d characters", maxSnapshotMessageLength)
+	}
+	for k := range req.Labels {
+		if k == "" {
+			return nil, status.Error(codes.InvalidArgument, "invalid request: labels: key cannot be blank")
+		}
+	}
+
 	var ws workspacev1.Workspace
 	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
 	if errors.IsNotFound(err) {
@@ -655,6 +1010,9 @@ func (wsm *WorkspaceManagerServer) TakeSnapshot(ctx context.Context, req *wsmana
 	if ws.Status.Phase != workspacev1.WorkspacePhaseRunning {
 		return nil, status.Errorf(codes.FailedPrecondition, "snapshots can only be taken of running workspaces, not %s workspaces", ws.Status.Phase)
 	}
+	if ws.Spec.Ephemeral {
+		return nil, status.Error(codes.FailedPrecondition, "snapshots cannot be taken of ephemeral workspaces")
+	}
 
 	snapshot := workspacev1.Snapshot{
 		TypeMeta: metav1.TypeMeta{
@@ -664,10 +1022,13 @@ func (wsm *WorkspaceManagerServer) TakeSnapshot(ctx context.Context, req *wsmana
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-%d", req.Id, time.Now().UnixNano()),
 			Namespace: wsm.Config.Namespace,
+			Labels:    snapshotLabels(&ws),
 		},
 		Spec: workspacev1.SnapshotSpec{
 			NodeName:    ws.Status.Runtime.NodeName,
 			WorkspaceID: ws.Name,
+			Message:     req.Message,
+			Labels:      req.Labels,
 		},
 	}
 
@@ -731,7 +1092,245 @@ func (wsm *WorkspaceManagerServer) TakeSnapshot(ctx context.Context, req *wsmana
 	}, nil
 }
 
+// ExportSnapshot takes a snapshot of a running workspace, which ws-daemon pushes as OCI image to the repository it is configured with
+func (wsm *WorkspaceManagerServer) ExportSnapshot(ctx context.Context, req *wsmanapi.ExportSnapshotRequest) (res *wsmanapi.ExportSnapshotResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "ExportSnapshot")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	if wsm.maintenance.IsEnabled(ctx) {
+		return &wsmanapi.ExportSnapshotResponse{}, status.Error(codes.FailedPrecondition, "under maintenance")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: id: cannot be blank")
+	}
+	if req.Tag != "" && !imageTagRegexp.MatchString(req.Tag) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: tag: %q is not a valid image tag", req.Tag)
+	}
+
+	var ws workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
+	if errors.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup workspace: %v", err)
+	}
+
+	if ws.Status.Phase != workspacev1.WorkspacePhaseRunning {
+		return nil, status.Errorf(codes.FailedPrecondition, "snapshots can only be exported from running workspaces, not %s workspaces", ws.Status.Phase)
+	}
+	if ws.Spec.Ephemeral {
+		return nil, status.Error(codes.FailedPrecondition, "snapshots cannot be exported from ephemeral workspaces")
+	}
+
+	name := fmt.Sprintf("%s-%d", req.Id, time.Now().UnixNano())
+	tag := req.Tag
+	if tag == "" {
+		tag = name
+	}
+	snapshot := workspacev1.Snapshot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: workspacev1.GroupVersion.String(),
+			Kind:       "Snapshot",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: wsm.Config.Namespace,
+			Labels:    snapshotLabels(&ws),
+		},
+		Spec: workspacev1.SnapshotSpec{
+			NodeName:    ws.Status.Runtime.NodeName,
+			WorkspaceID: ws.Name,
+			Export:      &workspacev1.SnapshotExport{Tag: tag},
+		},
+	}
+
+	err = controllerutil.SetOwnerReference(&ws, &snapshot, wsm.Client.Scheme())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot set owner for snapshot: %q", err)
+	}
+
+	err = wsm.Client.Create(ctx, &snapshot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot create snapshot object: %q", err)
+	}
+
+	// the image is only pushed once the snapshot was uploaded, hence we wait for the whole operation
+	var sso workspacev1.Snapshot
+	err = wait.PollWithContext(ctx, 100*time.Millisecond, 0, func(c context.Context) (done bool, err error) {
+		err = wsm.Client.Get(c, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: snapshot.Name}, &sso)
+		if err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return sso.Status.Completed, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot wait for snapshot export: %v", err)
+	}
+	if sso.Status.Error != "" {
+		return nil, status.Errorf(codes.Internal, "cannot export snapshot: %s", sso.Status.Error)
+	}
+
+	return &wsmanapi.ExportSnapshotResponse{
+		Url:      sso.Status.URL,
+		ImageRef: sso.Status.ImageRef,
+	}, nil
+}
+
+// maxSnapshotMessageLength matches the validation of the snapshot resource
+const maxSnapshotMessageLength = 1024
+
+// snapshotLabels makes the snapshots of a workspace selectable by the workspace and its owner
+func snapshotLabels(ws *workspacev1.Workspace) map[string]string {
+	return map[string]string{
+		wsk8s.WorkspaceIDLabel: ws.Labels[wsk8s.WorkspaceIDLabel],
+		wsk8s.OwnerLabel:       ws.Labels[wsk8s.OwnerLabel],
+	}
+}
+
+// ListSnapshots lists the snapshots of the workspaces which still exist in this cluster. Snapshot resources are
+// owned by their workspace, hence the snapshots of deleted workspaces are not listed anymore.
+func (wsm *WorkspaceManagerServer) ListSnapshots(ctx context.Context, req *wsmanapi.ListSnapshotsRequest) (*wsmanapi.ListSnapshotsResponse, error) {
+	labelSelector, err := metadataFilterToLabelSelector(req.MustMatch)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot convert metadata filter: %v", err)
+	}
+
+	var snapshots workspacev1.SnapshotList
+	err = wsm.Client.List(ctx, &snapshots, &client.ListOptions{
+		Namespace:     wsm.Config.Namespace,
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list snapshots: %v", err)
+	}
+	sort.Slice(snapshots.Items, func(i, j int) bool {
+		ti, tj := snapshots.Items[i].CreationTimestamp, snapshots.Items[j].CreationTimestamp
+		if ti.Equal(&tj) {
+			return snapshots.Items[i].Name < snapshots.Items[j].Name
+		}
+		return ti.Before(&tj)
+	})
+
+	res := make([]*wsmanapi.SnapshotInfo, 0, len(snapshots.Items))
+	for _, ss := range snapshots.Items {
+		if !matchesSnapshotLabels(&ss, req.MustMatch) {
+			continue
+		}
+
+		res = append(res, &wsmanapi.SnapshotInfo{
+			Id:           ss.Name,
+			InstanceId:   ss.Spec.WorkspaceID,
+			MetaId:       ss.Labels[wsk8s.WorkspaceIDLabel],
+			Owner:        ss.Labels[wsk8s.OwnerLabel],
+			Url:          ss.Status.URL,
+			Message:      ss.Spec.Message,
+			Labels:       ss.Spec.Labels,
+			CreationTime: timestamppb.New(ss.CreationTimestamp.Time),
+			Size:         ss.Status.Size,
+			Completed:    ss.Status.Completed,
+			Error:        ss.Status.Error,
+		})
+	}
+
+	return &wsmanapi.ListSnapshotsResponse{Snapshots: res}, nil
+}
+
+// RestoreSnapshot unpacks a snapshot or backup into a directory of a running workspace. The ws-daemon on the node
+// of the workspace downloads the snapshot and merges it with the workspace content according to the conflict policy.
+func (wsm *WorkspaceManagerServer) RestoreSnapshot(ctx context.Context, req *wsmanapi.RestoreSnapshotRequest) (res *wsmanapi.RestoreSnapshotResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "RestoreSnapshot")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	if wsm.maintenance.IsEnabled(ctx) {
+		return nil, status.Error(codes.FailedPrecondition, "under maintenance")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: id: cannot be blank")
+	}
+	if req.Snapshot == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: snapshot: cannot be blank")
+	}
+	policy, ok := restoreConflictPolicies[req.ConflictPolicy]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: conflict_policy: unknown policy %d", req.ConflictPolicy)
+	}
+
+	var ws workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
+	if errors.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup workspace: %v", err)
+	}
+	if ws.Status.Phase != workspacev1.WorkspacePhaseRunning || ws.Status.Runtime == nil || ws.Status.Runtime.NodeName == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "snapshots can only be restored into running workspaces, not %s workspaces", ws.Status.Phase)
+	}
+	if ws.Spec.Ephemeral {
+		return nil, status.Error(codes.FailedPrecondition, "snapshots cannot be restored into ephemeral workspaces")
+	}
+
+	conn, err := wsm.connectToWorkspaceDaemon(ctx, ws.Status.Runtime.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := wsdaemon.NewWorkspaceContentServiceClient(conn).RestoreSnapshot(ctx, &wsdaemon.RestoreSnapshotRequest{
+		Id:             req.Id,
+		Snapshot:       req.Snapshot,
+		Target:         req.Target,
+		ConflictPolicy: policy,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, status.Error(codes.FailedPrecondition, "restoring snapshots is not supported by ws-daemon")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &wsmanapi.RestoreSnapshotResponse{Conflicts: resp.Conflicts}, nil
+}
+
+var restoreConflictPolicies = map[wsmanapi.RestoreConflictPolicy]wsdaemon.RestoreConflictPolicy{
+	wsmanapi.RestoreConflictPolicy_RESTORE_CONFLICT_FAIL:      wsdaemon.RestoreConflictPolicy_RESTORE_CONFLICT_FAIL,
+	wsmanapi.RestoreConflictPolicy_RESTORE_CONFLICT_SKIP:      wsdaemon.RestoreConflictPolicy_RESTORE_CONFLICT_SKIP,
+	wsmanapi.RestoreConflictPolicy_RESTORE_CONFLICT_OVERWRITE: wsdaemon.RestoreConflictPolicy_RESTORE_CONFLICT_OVERWRITE,
+}
+
+func matchesSnapshotLabels(ss *workspacev1.Snapshot, filter *wsmanapi.MetadataFilter) bool {
+	if filter == nil {
+		return true
+	}
+	for k, v := range filter.Annotations {
+		lv, ok := ss.Spec.Labels[k]
+		if !ok || lv != v {
+			return false
+		}
+	}
+	return true
+}
+
 func (wsm *WorkspaceManagerServer) ControlAdmission(ctx context.Context, req *wsmanapi.ControlAdmissionRequest) (*wsmanapi.ControlAdmissionResponse, error) {
+	var expiry *metav1.Time
+	if req.Expiry != "" {
+		if req.Level != wsmanapi.AdmissionLevel_ADMIT_EVERYONE {
+			return nil, status.Errorf(codes.InvalidArgument, "expiry is only supported when admitting everyone")
+		}
+		d, err := time.ParseDuration(req.Expiry)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expiry: %v", err)
+		}
+		if d <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expiry: must be positive")
+		}
+		expiry = &metav1.Time{Time: time.Now().Add(d)}
+	}
+
 	err := wsm.modifyWorkspace(ctx, req.Id, false, func(ws *workspacev1.Workspace) error {
 		switch req.Level {
 		case wsmanapi.AdmissionLevel_ADMIT_EVERYONE:
@@ -741,69 +1340,844 @@ func (wsm *WorkspaceManagerServer) ControlAdmission(ctx context.Context, req *ws
 		default:
 			return status.Errorf(codes.InvalidArgument, "unsupported admission level: %v", req.Level)
 		}
-		return nil
+		// the timeout controller reverts the admission level once the expiry has passed
+		ws.Spec.Admission.Expiry = expiry
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &wsmanapi.ControlAdmissionResponse{}, nil
+}
+
+// GetQueuePosition returns the position of a prebuild in the prebuild queue
+func (wsm *WorkspaceManagerServer) GetQueuePosition(ctx context.Context, req *wsmanapi.GetQueuePositionRequest) (res *wsmanapi.GetQueuePositionResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "GetQueuePosition")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	var ws workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
+	if errors.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup workspace: %v", err)
+	}
+	if ws.Spec.Type != workspacev1.WorkspaceTypePrebuild {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace %s is not a prebuild", req.Id)
+	}
+
+	var workspaces workspacev1.WorkspaceList
+	err = wsm.Client.List(ctx, &workspaces, client.InNamespace(wsm.Config.Namespace))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list workspaces: %v", err)
+	}
+
+	position, length := controllers.PrebuildQueuePosition(workspaces.Items, wsm.Config.PrebuildQueue, ws.Name)
+	return &wsmanapi.GetQueuePositionResponse{
+		Queued:   position > 0,
+		Position: int32(position),
+		Length:   int32(length),
+	}, nil
+}
+
+func (wsm *WorkspaceManagerServer) UpdateSSHKey(ctx context.Context, req *wsmanapi.UpdateSSHKeyRequest) (res *wsmanapi.UpdateSSHKeyResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "UpdateSSHKey")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	if err = validateUpdateSSHKeyRequest(req); err != nil {
+		return &wsmanapi.UpdateSSHKeyResponse{}, err
+	}
+
+	err = wsm.modifyWorkspace(ctx, req.Id, false, func(ws *workspacev1.Workspace) error {
+		ws.Spec.SshPublicKeys = req.Keys
+		return nil
+	})
+
+	return &wsmanapi.UpdateSSHKeyResponse{}, err
+}
+
+func (wsm *WorkspaceManagerServer) DescribeCluster(ctx context.Context, req *wsmanapi.DescribeClusterRequest) (res *wsmanapi.DescribeClusterResponse, err error) {
+	//nolint:ineffassign
+	span, ctx := tracing.FromContext(ctx, "DescribeCluster")
+	defer tracing.FinishSpan(span, &err)
+
+	classes := make([]*wsmanapi.WorkspaceClass, 0, len(wsm.Config.WorkspaceClasses))
+	for id, class := range wsm.Config.WorkspaceClasses {
+		var gpus int64
+		if class.GPU != nil {
+			gpus = class.GPU.Count
+		}
+
+		var cpu, ram, disk resource.Quantity
+		desc := class.Description
+		if desc == "" {
+			if class.Container.Limits != nil {
+				cpu, _ = resource.ParseQuantity(class.Container.Limits.CPU.BurstLimit)
+				ram, _ = resource.ParseQuantity(class.Container.Limits.Memory)
+				disk, _ = resource.ParseQuantity(class.Container.Limits.Storage)
+			}
+			if cpu.Value() == 0 && class.Container.Requests != nil {
+				cpu, _ = resource.ParseQuantity(class.Container.Requests.CPU)
+			}
+			if ram.Value() == 0 && class.Container.Requests != nil {
+				ram, _ = resource.ParseQuantity(class.Container.Requests.Memory)
+			}
+			desc = fmt.Sprintf("%d vCPU, %dGB memory, %dGB disk", cpu.Value(), ram.ScaledValue(resource.Giga), disk.ScaledValue(resource.Giga))
+			if gpus > 0 {
+				desc += fmt.Sprintf(", %d GPU", gpus)
+			}
+		}
+		classes = append(classes, &wsmanapi.WorkspaceClass{
+			Id:               id,
+			DisplayName:      class.Name,
+			Description:      desc,
+			CreditsPerMinute: class.CreditsPerMinute,
+			GpuCount:         gpus,
+		})
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i].Id < classes[j].Id
+	})
+
+	return &wsmanapi.DescribeClusterResponse{
+		WorkspaceClasses:        classes,
+		PreferredWorkspaceClass: wsm.Config.PreferredWorkspaceClass,
+	}, nil
+}
+
+func (wsm *WorkspaceManagerServer) RelocateWorkspace(ctx context.Context, req *wsmanapi.RelocateWorkspaceRequest) (res *wsmanapi.RelocateWorkspaceResponse, err error) {
+	owi := log.OWI("", "", req.Id)
+	span, ctx := tracing.FromContext(ctx, "RelocateWorkspace")
+	tracing.ApplyOWI(span, owi)
+	defer tracing.FinishSpan(span, &err)
+
+	if wsm.maintenance.IsEnabled(ctx) {
+		return &wsmanapi.RelocateWorkspaceResponse{}, status.Error(codes.FailedPrecondition, "under maintenance")
+	}
+
+	if err = validateRelocateWorkspaceRequest(req); err != nil {
+		return nil, err
+	}
+
+	var ws workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
+	if errors.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup workspace: %v", err)
+	}
+
+	if ws.Spec.Type != workspacev1.WorkspaceTypeRegular {
+		return nil, status.Errorf(codes.FailedPrecondition, "only regular workspaces can be relocated")
+	}
+	if ws.Status.Phase != workspacev1.WorkspacePhaseRunning {
+		return nil, status.Errorf(codes.FailedPrecondition, "only running workspaces can be relocated, not %s workspaces", ws.Status.Phase)
+	}
+	if target := ws.RelocationTarget(); target != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace is already being relocated to node %s", target)
+	}
+	if ws.Status.Runtime != nil && ws.Status.Runtime.NodeName == req.TargetNode {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace is already running on node %s", req.TargetNode)
+	}
+	if ws.Status.Storage.VolumeName != "" {
+		// Only workspaces whose content is backed up to remote storage can be restored on another node.
+		return nil, status.Errorf(codes.FailedPrecondition, "workspaces with a persistent volume cannot be relocated")
+	}
+
+	var node corev1.Node
+	err = wsm.Client.Get(ctx, types.NamespacedName{Name: req.TargetNode}, &node)
+	if errors.IsNotFound(err) {
+		return nil, status.Errorf(codes.InvalidArgument, "target node %s does not exist", req.TargetNode)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup target node: %v", err)
+	}
+	if node.Spec.Unschedulable {
+		return nil, status.Errorf(codes.FailedPrecondition, "target node %s is unschedulable", req.TargetNode)
+	}
+	if err = wsm.validateRelocationTarget(&ws, &node); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "target node %s cannot run workspace: %v", req.TargetNode, err)
+	}
+
+	err = wsm.relocate(ctx, &ws, req.TargetNode, "", req.Envvars, req.SysEnvvars)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wsmanapi.RelocateWorkspaceResponse{}, nil
+}
+
+// UpdateWorkspaceClass moves a running workspace to another class. The pod of the workspace is resized in place if the cluster
+// supports it, otherwise the workspace restarts with the new class on its node.
+func (wsm *WorkspaceManagerServer) UpdateWorkspaceClass(ctx context.Context, req *wsmanapi.UpdateWorkspaceClassRequest) (res *wsmanapi.UpdateWorkspaceClassResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "UpdateWorkspaceClass")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	if wsm.maintenance.IsEnabled(ctx) {
+		return &wsmanapi.UpdateWorkspaceClassResponse{}, status.Error(codes.FailedPrecondition, "under maintenance")
+	}
+
+	if err = validateUpdateWorkspaceClassRequest(req); err != nil {
+		return nil, err
+	}
+
+	var ws workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
+	if errors.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup workspace: %v", err)
+	}
+
+	if ws.Spec.Type != workspacev1.WorkspaceTypeRegular {
+		return nil, status.Errorf(codes.FailedPrecondition, "only regular workspaces can change their class")
+	}
+	if ws.Status.Phase != workspacev1.WorkspacePhaseRunning || ws.Status.Runtime == nil || ws.Status.Runtime.NodeName == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "only running workspaces can change their class, not %s workspaces", ws.Status.Phase)
+	}
+	if target := ws.RelocationTarget(); target != "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace is being relocated to node %s", target)
+	}
+	if ws.Spec.Class == req.WorkspaceClass {
+		return &wsmanapi.UpdateWorkspaceClassResponse{}, nil
+	}
+
+	from, ok := wsm.Config.WorkspaceClasses[ws.Spec.Class]
+	if !ok || !from.Resizable {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace class %s is not resizable", ws.Spec.Class)
+	}
+	to, ok := wsm.Config.WorkspaceClasses[req.WorkspaceClass]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "workspace class %s does not exist", req.WorkspaceClass)
+	}
+	if !to.Resizable {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace class %s is not resizable", req.WorkspaceClass)
+	}
+	fromResources, err := from.ContainerResources()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "workspace class %s has invalid resources: %v", ws.Spec.Class, err)
+	}
+	toResources, err := to.ContainerResources()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "workspace class %s has invalid resources: %v", req.WorkspaceClass, err)
+	}
+
+	// The workspace keeps running on its node either way, which must suit the new class.
+	nodeName := ws.Status.Runtime.NodeName
+	var node corev1.Node
+	err = wsm.Client.Get(ctx, types.NamespacedName{Name: nodeName}, &node)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup node %s: %v", nodeName, err)
+	}
+	resized := ws.DeepCopy()
+	resized.Spec.Class = req.WorkspaceClass
+	if err = wsm.validateRelocationTarget(resized, &node); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "node %s cannot run workspace class %s: %v", nodeName, req.WorkspaceClass, err)
+	}
+
+	if wsm.Config.InPlacePodResize && config.CanResizeInPlace(fromResources, toResources) {
+		// the workspace controller resizes the pod once the class changed
+		err = wsm.modifyWorkspace(ctx, req.Id, false, func(ws *workspacev1.Workspace) error {
+			wsm.setWorkspaceClass(ws, req.WorkspaceClass)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &wsmanapi.UpdateWorkspaceClassResponse{}, nil
+	}
+
+	// The workspace restarts on its node, such that it can restore its content from the node's restore cache.
+	err = wsm.relocate(ctx, &ws, nodeName, req.WorkspaceClass, req.Envvars, req.SysEnvvars)
+	if err != nil {
+		return nil, err
+	}
+	return &wsmanapi.UpdateWorkspaceClassResponse{Restarting: true}, nil
+}
+
+// setWorkspaceClass changes the class of a workspace together with the CPU, network and memory limits ws-daemon applies to it
+func (wsm *WorkspaceManagerServer) setWorkspaceClass(ws *workspacev1.Workspace, name string) {
+	ws.Spec.Class = name
+
+	var (
+		limits  *config.CpuResourceLimit
+		network *config.NetworkResourceLimit
+		memory  *config.MemoryResourceLimit
+	)
+	if class, ok := wsm.Config.WorkspaceClasses[name]; ok && class.Container.Limits != nil {
+		limits = class.Container.Limits.CPU
+		network = class.Container.Limits.Network
+		memory = class.Container.Limits.MemoryControl
+	}
+	if ws.Annotations == nil {
+		ws.Annotations = make(map[string]string)
+	}
+	delete(ws.Annotations, wsk8s.WorkspaceCpuMinLimitAnnotation)
+	delete(ws.Annotations, wsk8s.WorkspaceCpuBurstLimitAnnotation)
+	delete(ws.Annotations, wsk8s.WorkspaceCpuBurstCreditAnnotation)
+	delete(ws.Annotations, wsk8s.WorkspaceNetEgressBandwidthAnnotation)
+	delete(ws.Annotations, wsk8s.WorkspaceNetIngressBandwidthAnnotation)
+	delete(ws.Annotations, wsk8s.WorkspaceMemoryHighAnnotation)
+	delete(ws.Annotations, wsk8s.WorkspaceMemorySwapAnnotation)
+	if limits != nil && limits.MinLimit != "" {
+		ws.Annotations[wsk8s.WorkspaceCpuMinLimitAnnotation] = limits.MinLimit
+	}
+	if limits != nil && limits.BurstLimit != "" {
+		ws.Annotations[wsk8s.WorkspaceCpuBurstLimitAnnotation] = limits.BurstLimit
+	}
+	if limits != nil && limits.BurstCredit != "" {
+		ws.Annotations[wsk8s.WorkspaceCpuBurstCreditAnnotation] = limits.BurstCredit
+	}
+	if network != nil && network.Egress != "" {
+		ws.Annotations[wsk8s.WorkspaceNetEgressBandwidthAnnotation] = network.Egress
+	}
+	if network != nil && network.Ingress != "" {
+		ws.Annotations[wsk8s.WorkspaceNetIngressBandwidthAnnotation] = network.Ingress
+	}
+	if memory != nil && memory.High != "" {
+		ws.Annotations[wsk8s.WorkspaceMemoryHighAnnotation] = memory.High
+	}
+	if memory != nil && memory.Swap != "" {
+		ws.Annotations[wsk8s.WorkspaceMemorySwapAnnotation] = memory.Swap
+	}
+}
+
+// SetRestartClass marks a stopped workspace to start with another class the next time it starts. The workspace keeps its ID,
+// and hence its URL. Its content is carried over by the backup initializer of that start, which does not depend on the class.
+func (wsm *WorkspaceManagerServer) SetRestartClass(ctx context.Context, req *wsmanapi.SetRestartClassRequest) (res *wsmanapi.SetRestartClassResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "SetRestartClass")
+	tracing.ApplyOWI(span, log.OWI("", req.WorkspaceId, ""))
+	defer tracing.FinishSpan(span, &err)
+
+	if wsm.maintenance.IsEnabled(ctx) {
+		return &wsmanapi.SetRestartClassResponse{}, status.Error(codes.FailedPrecondition, "under maintenance")
+	}
+
+	if err = validateSetRestartClassRequest(req); err != nil {
+		return nil, err
+	}
+	if _, ok := wsm.Config.WorkspaceClasses[req.WorkspaceClass]; req.WorkspaceClass != "" && !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "workspace class %s does not exist", req.WorkspaceClass)
+	}
+
+	exists, err := wsm.workspaceExists(ctx, req.WorkspaceId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot check if workspace %s exists: %v", req.WorkspaceId, err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace %s is not stopped, use UpdateWorkspaceClass to change the class of running workspaces", req.WorkspaceId)
+	}
+
+	mark := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      restartClassMarkName(req.WorkspaceId),
+			Namespace: wsm.Config.Namespace,
+		},
+	}
+	if req.WorkspaceClass == "" {
+		err = wsm.Client.Delete(ctx, &mark)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, status.Errorf(codes.Internal, "cannot remove restart class: %v", err)
+		}
+		return &wsmanapi.SetRestartClassResponse{}, nil
+	}
+
+	_, err = controllerutil.CreateOrUpdate(ctx, wsm.Client, &mark, func() error {
+		mark.Labels = map[string]string{
+			wsk8s.WorkspaceIDLabel:        req.WorkspaceId,
+			wsk8s.WorkspaceManagedByLabel: constants.ManagedBy,
+		}
+		mark.Data = map[string]string{restartClassKey: req.WorkspaceClass}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot mark restart class: %v", err)
+	}
+
+	return &wsmanapi.SetRestartClassResponse{}, nil
+}
+
+// restartClassKey is the key of the class in the ConfigMap which marks the class a stopped workspace starts with
+const restartClassKey = "workspaceClass"
+
+func restartClassMarkName(workspaceID string) string {
+	return "restart-class-" + workspaceID
+}
+
+// applyRestartClass replaces the class of a start request with the one the workspace was marked to restart with. It returns
+// the mark, which is to be removed once the workspace has started, or nil if there is none.
+func (wsm *WorkspaceManagerServer) applyRestartClass(ctx context.Context, req *wsmanapi.StartWorkspaceRequest) (*corev1.ConfigMap, error) {
+	if req.Type != wsmanapi.WorkspaceType_REGULAR {
+		return nil, nil
+	}
+
+	var mark corev1.ConfigMap
+	err := wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: restartClassMarkName(req.Metadata.MetaId)}, &mark)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup restart class: %v", err)
+	}
+
+	class := mark.Data[restartClassKey]
+	owi := log.OWI(req.Metadata.Owner, req.Metadata.MetaId, req.Id)
+	if _, ok := wsm.Config.WorkspaceClasses[class]; !ok {
+		log.WithFields(owi).WithField("class", class).Warn("workspace class of restart does not exist anymore, keeping the requested class")
+		return &mark, nil
+	}
+
+	log.WithFields(owi).WithField("from", req.Spec.Class).WithField("to", class).Info("workspace restarts with another class")
+	req.Spec.Class = class
+	return &mark, nil
+}
+
+// relocate restarts a running workspace on the target node. If class is not empty, the workspace restarts with that class.
+func (wsm *WorkspaceManagerServer) relocate(ctx context.Context, ws *workspacev1.Workspace, targetNode, class string, envvars, sysEnvvars []*wsmanapi.EnvironmentVariable) error {
+	owi := log.OWI(ws.Spec.Ownership.Owner, ws.Spec.Ownership.WorkspaceID, ws.Name)
+
+	if ws.Spec.Ephemeral {
+		// a relocation moves the content by means of a backup, which ephemeral workspaces must never have
+		return status.Error(codes.FailedPrecondition, "ephemeral workspaces cannot be relocated")
+	}
+
+	var checkoutLocation string
+	var init csapi.WorkspaceInitializer
+	if err := proto.Unmarshal(ws.Spec.Initializer, &init); err == nil {
+		if locs := csapi.GetCheckoutLocationsFromInitializer(&init); len(locs) > 0 {
+			checkoutLocation = locs[0]
+		}
+	}
+	// The replacement pod restores the content from the backup the current pod takes on its way out.
+	initializer, err := proto.Marshal(&csapi.WorkspaceInitializer{
+		Spec: &csapi.WorkspaceInitializer_Backup{
+			Backup: &csapi.FromBackupInitializer{
+				CheckoutLocation: checkoutLocation,
+			},
+		},
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot serialise content initializer: %v", err)
+	}
+
+	envSecretName := fmt.Sprintf("%s-%s", ws.Name, "env")
+	userEnvVars, envData := extractWorkspaceUserEnv(envSecretName, envvars, sysEnvvars)
+	sysEnvVars := extractWorkspaceSysEnv(sysEnvvars)
+
+	// The spec and status are separate subresources and cannot be updated at once. The spec is only used once the
+	// workspace is marked as relocating, hence it is updated first and restored if marking the workspace fails.
+	oldSpec := ws.Spec.DeepCopy()
+	err = wsm.modifyWorkspace(ctx, ws.Name, false, func(ws *workspacev1.Workspace) error {
+		ws.Spec.Initializer = initializer
+		ws.Spec.UserEnvVars = userEnvVars
+		ws.Spec.SysEnvVars = sysEnvVars
+		if class != "" {
+			wsm.setWorkspaceClass(ws, class)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Mark the workspace as relocating before re-creating its secrets. Otherwise the workspace
+	// controller would remove the secrets again as the workspace is still running.
+	err = wsm.modifyWorkspace(ctx, ws.Name, true, func(ws *workspacev1.Workspace) error {
+		if ws.Status.Phase != workspacev1.WorkspacePhaseRunning {
+			return status.Errorf(codes.FailedPrecondition, "only running workspaces can be relocated, not %s workspaces", ws.Status.Phase)
+		}
+		ws.Status.SetCondition(workspacev1.NewWorkspaceConditionRelocating(targetNode))
+		return nil
+	})
+	if err != nil {
+		rerr := wsm.modifyWorkspace(ctx, ws.Name, false, func(ws *workspacev1.Workspace) error {
+			ws.Spec.Initializer = oldSpec.Initializer
+			ws.Spec.UserEnvVars = oldSpec.UserEnvVars
+			ws.Spec.SysEnvVars = oldSpec.SysEnvVars
+			if class != "" {
+				wsm.setWorkspaceClass(ws, oldSpec.Class)
+			}
+			return nil
+		})
+		if rerr != nil {
+			log.WithError(rerr).WithFields(owi).Error("cannot restore workspace spec after failed relocation")
+		}
+		return err
+	}
+
+	err = wsm.createWorkspaceSecret(ctx, ws, envSecretName, wsm.Config.Namespace, envData)
+	if err == nil {
+		err = wsm.createWorkspaceSecret(ctx, ws, fmt.Sprintf("%s-%s", ws.Name, "tokens"), wsm.Config.SecretsNamespace, map[string]string{})
+	}
+	if err != nil {
+		// Without its secrets the replacement pod cannot start - keep the workspace where it is.
+		cerr := wsm.modifyWorkspace(ctx, ws.Name, true, func(ws *workspacev1.Workspace) error {
+			ws.Status.SetCondition(workspacev1.NewWorkspaceConditionRelocationCancelled("cannot create secrets"))
+			return nil
+		})
+		if cerr != nil {
+			log.WithError(cerr).WithFields(owi).Error("cannot cancel relocation")
+		}
+		if class != "" {
+			rerr := wsm.modifyWorkspace(ctx, ws.Name, false, func(ws *workspacev1.Workspace) error {
+				wsm.setWorkspaceClass(ws, oldSpec.Class)
+				return nil
+			})
+			if rerr != nil {
+				log.WithError(rerr).WithFields(owi).Error("cannot restore workspace class after failed relocation")
+			}
+		}
+		return fmt.Errorf("cannot create secrets for workspace %s: %w", ws.Name, err)
+	}
+
+	return nil
+}
+
+// validateRelocationTarget checks that the target node satisfies the same scheduling constraints
+// the replacement pod is created with, such that a relocation cannot get stuck in pending.
+func (wsm *WorkspaceManagerServer) validateRelocationTarget(ws *workspacev1.Workspace, node *corev1.Node) error {
+	required := []corev1.NodeSelectorRequirement{
+		{Key: "gitpod.io/workload_workspace_regular", Operator: corev1.NodeSelectorOpExists},
+		{Key: "gitpod.io/ws-daemon_ready_ns_" + wsm.Config.Namespace, Operator: corev1.NodeSelectorOpExists},
+		{Key: "gitpod.io/registry-facade_ready_ns_" + wsm.Config.Namespace, Operator: corev1.NodeSelectorOpExists},
+		{Key: constants.DaemonUnavailableLabelPrefix + wsm.Config.Namespace, Operator: corev1.NodeSelectorOpDoesNotExist},
+	}
+	if ok, err := nodeMatchesRequirements(node, required); err != nil {
+		return err
+	} else if !ok {
+		return xerrors.Errorf("node is not ready for workspaces")
+	}
+
+	class, ok := wsm.Config.WorkspaceClasses[ws.Spec.Class]
+	if !ok {
+		return nil
+	}
+	if class.GPU != nil {
+		for k, v := range class.GPU.NodeSelector {
+			if node.Labels[k] != v {
+				return xerrors.Errorf("node does not match the GPU node selector of class %s", ws.Spec.Class)
+			}
+		}
+	}
+	if class.Scheduling == nil || len(class.Scheduling.NodeSelectorTerms) == 0 {
+		return nil
+	}
+	// node selector terms are OR'ed
+	for _, term := range class.Scheduling.NodeSelectorTerms {
+		ok, err := nodeMatchesRequirements(node, term.MatchExpressions)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	return xerrors.Errorf("node does not match the node selector terms of class %s", ws.Spec.Class)
+}
+
+func nodeMatchesRequirements(node *corev1.Node, reqs []corev1.NodeSelectorRequirement) (bool, error) {
+	operators := map[corev1.NodeSelectorOperator]selection.Operator{
+		corev1.NodeSelectorOpIn:           selection.In,
+		corev1.NodeSelectorOpNotIn:        selection.NotIn,
+		corev1.NodeSelectorOpExists:       selection.Exists,
+		corev1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+		corev1.NodeSelectorOpGt:           selection.GreaterThan,
+		corev1.NodeSelectorOpLt:           selection.LessThan,
+	}
+
+	selector := labels.NewSelector()
+	for _, r := range reqs {
+		op, ok := operators[r.Operator]
+		if !ok {
+			return false, xerrors.Errorf("unsupported node selector operator %q", r.Operator)
+		}
+		req, err := labels.NewRequirement(r.Key, op, r.Values)
+		if err != nil {
+			return false, err
+		}
+		selector = selector.Add(*req)
+	}
+	return selector.Matches(labels.Set(node.Labels)), nil
+}
+
+// GetWorkspaceResourceUsage streams the resource usage of a workspace as sampled by the ws-daemon on its node
+func (wsm *WorkspaceManagerServer) GetWorkspaceResourceUsage(req *wsmanapi.GetWorkspaceResourceUsageRequest, srv wsmanapi.WorkspaceManager_GetWorkspaceResourceUsageServer) (err error) {
+	span, ctx := tracing.FromContext(srv.Context(), "GetWorkspaceResourceUsage")
+	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
+	defer tracing.FinishSpan(span, &err)
+
+	if req.Id == "" {
+		return status.Error(codes.InvalidArgument, "invalid request: id: cannot be blank")
+	}
+
+	// ws-daemon samples at its default interval if we don't ask for one
+	var interval time.Duration
+	if req.Interval != "" {
+		interval, err = time.ParseDuration(req.Interval)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid interval: %v", err)
+		}
+	}
+
+	var ws workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
+	if errors.IsNotFound(err) {
+		return status.Errorf(codes.NotFound, "workspace %s not found", req.Id)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot lookup workspace: %v", err)
+	}
+	if ws.Status.Phase != workspacev1.WorkspacePhaseRunning || ws.Status.Runtime == nil || ws.Status.Runtime.NodeName == "" {
+		return status.Errorf(codes.FailedPrecondition, "workspace %s is not running", req.Id)
+	}
+
+	conn, err := wsm.connectToWorkspaceDaemon(ctx, ws.Status.Runtime.NodeName)
+	if err != nil {
+		return err
+	}
+
+	usage, err := wsdaemon.NewWorkspaceResourceServiceClient(conn).WorkspaceResourceUsage(ctx, &wsdaemon.WorkspaceResourceUsageRequest{
+		Id:         req.Id,
+		IntervalMs: interval.Milliseconds(),
 	})
 	if err != nil {
-		return nil, err
+		return err
+	}
+	for {
+		resp, err := usage.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			// either the workspace stopped, or our client went away
+			return nil
+		}
+		if status.Code(err) == codes.Unimplemented {
+			return status.Error(codes.FailedPrecondition, "resource usage is not enabled on ws-daemon")
+		}
+		if err != nil {
+			return err
+		}
+
+		err = srv.Send(&wsmanapi.GetWorkspaceResourceUsageResponse{
+			Usage: convertResourceUsage(resp),
+		})
+		if err != nil {
+			return err
+		}
 	}
-	return &wsmanapi.ControlAdmissionResponse{}, nil
 }
 
-func (wsm *WorkspaceManagerServer) UpdateSSHKey(ctx context.Context, req *wsmanapi.UpdateSSHKeyRequest) (res *wsmanapi.UpdateSSHKeyResponse, err error) {
-	span, ctx := tracing.FromContext(ctx, "UpdateSSHKey")
+// GetWorkspaceDiskUsage returns the disk usage of a workspace as reported by the ws-daemon on its node
+func (wsm *WorkspaceManagerServer) GetWorkspaceDiskUsage(ctx context.Context, req *wsmanapi.GetWorkspaceDiskUsageRequest) (res *wsmanapi.GetWorkspaceDiskUsageResponse, err error) {
+	span, ctx := tracing.FromContext(ctx, "GetWorkspaceDiskUsage")
 	tracing.ApplyOWI(span, log.OWI("", "", req.Id))
 	defer tracing.FinishSpan(span, &err)
 
-	if err = validateUpdateSSHKeyRequest(req); err != nil {
-		return &wsmanapi.UpdateSSHKeyResponse{}, err
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request: id: cannot be blank")
 	}
 
-	err = wsm.modifyWorkspace(ctx, req.Id, false, func(ws *workspacev1.Workspace) error {
-		ws.Spec.SshPublicKeys = req.Keys
-		return nil
+	var ws workspacev1.Workspace
+	err = wsm.Client.Get(ctx, types.NamespacedName{Namespace: wsm.Config.Namespace, Name: req.Id}, &ws)
+	if errors.IsNotFound(err) {
+		return nil, status.Errorf(codes.NotFound, "workspace %s not found", req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot lookup workspace: %v", err)
+	}
+	if ws.Status.Phase != workspacev1.WorkspacePhaseRunning || ws.Status.Runtime == nil || ws.Status.Runtime.NodeName == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "workspace %s is not running", req.Id)
+	}
+
+	conn, err := wsm.connectToWorkspaceDaemon(ctx, ws.Status.Runtime.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := wsdaemon.NewWorkspaceResourceServiceClient(conn).GetWorkspaceDiskUsage(ctx, &wsdaemon.GetWorkspaceDiskUsageRequest{
+		Id: req.Id,
 	})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, status.Error(codes.FailedPrecondition, "resource usage is not enabled on ws-daemon")
+	}
+	if err != nil {
+		return nil, err
+	}
 
-	return &wsmanapi.UpdateSSHKeyResponse{}, err
+	return &wsmanapi.GetWorkspaceDiskUsageResponse{
+		Workspace:   convertDiskUsage(usage.Workspace),
+		Tmp:         convertDiskUsage(usage.Tmp),
+		Scratch:     convertDiskUsage(usage.Scratch),
+		CollectedAt: timestamppb.New(time.UnixMilli(usage.CollectedAtMs)),
+	}, nil
 }
 
-func (wsm *WorkspaceManagerServer) DescribeCluster(ctx context.Context, req *wsmanapi.DescribeClusterRequest) (res *wsmanapi.DescribeClusterResponse, err error) {
-	//nolint:ineffassign
-	span, ctx := tracing.FromContext(ctx, "DescribeCluster")
+// DrainNode cordons a node and stops all workspaces running on it. The workspaces back up their content while they stop.
+// The progress is streamed until all workspaces have stopped or the timeout expires, workspaces are never force-deleted.
+func (wsm *WorkspaceManagerServer) DrainNode(req *wsmanapi.DrainNodeRequest, srv wsmanapi.WorkspaceManager_DrainNodeServer) (err error) {
+	span, ctx := tracing.FromContext(srv.Context(), "DrainNode")
+	span.SetTag("node", req.NodeName)
 	defer tracing.FinishSpan(span, &err)
 
-	classes := make([]*wsmanapi.WorkspaceClass, 0, len(wsm.Config.WorkspaceClasses))
-	for id, class := range wsm.Config.WorkspaceClasses {
-		var cpu, ram, disk resource.Quantity
-		desc := class.Description
-		if desc == "" {
-			if class.Container.Limits != nil {
-				cpu, _ = resource.ParseQuantity(class.Container.Limits.CPU.BurstLimit)
-				ram, _ = resource.ParseQuantity(class.Container.Limits.Memory)
-				disk, _ = resource.ParseQuantity(class.Container.Limits.Storage)
+	if wsm.maintenance.IsEnabled(ctx) {
+		return status.Error(codes.FailedPrecondition, "under maintenance")
+	}
+
+	if req.NodeName == "" {
+		return status.Error(codes.InvalidArgument, "invalid request: node_name: cannot be blank")
+	}
+	timeout := defaultDrainNodeTimeout
+	if req.Timeout != "" {
+		timeout, err = time.ParseDuration(req.Timeout)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid timeout: %v", err)
+		}
+		if timeout <= 0 {
+			return status.Errorf(codes.InvalidArgument, "invalid timeout: must be positive")
+		}
+	}
+
+	var node corev1.Node
+	err = wsm.Client.Get(ctx, types.NamespacedName{Name: req.NodeName}, &node)
+	if errors.IsNotFound(err) {
+		return status.Errorf(codes.NotFound, "node %s not found", req.NodeName)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "cannot lookup node: %v", err)
+	}
+	if !node.Spec.Unschedulable {
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = true
+		err = wsm.Client.Patch(ctx, &node, patch)
+		if err != nil {
+			return status.Errorf(codes.Internal, "cannot cordon node: %v", err)
+		}
+	}
+
+	workspaces, err := wsm.workspacesOnNode(ctx, req.NodeName)
+	if err != nil {
+		return err
+	}
+	log.WithField("node", req.NodeName).WithField("workspaces", len(workspaces)).Info("draining node")
+
+	remaining := make(map[string]struct{}, len(workspaces))
+	for _, ws := range workspaces {
+		remaining[ws.Name] = struct{}{}
+		if ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest) {
+			continue
+		}
+		err := wsm.stopWorkspace(ctx, ws.Name, stopWorkspaceNormallyGracePeriod, workspacev1.StopReasonAdminStopped, "DrainNodeRequest")
+		if err != nil && status.Code(err) != codes.NotFound {
+			// the workspace remains on the node and is reported as such
+			log.WithError(err).WithFields(ws.OWI()).Error("cannot stop workspace while draining node")
+		}
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	tick := time.NewTicker(drainNodePollInterval)
+	defer tick.Stop()
+
+	var (
+		total    = int32(len(remaining))
+		failed   = make(map[string]struct{})
+		last     *wsmanapi.DrainNodeResponse
+		timedOut bool
+	)
+	for {
+		workspaces, err = wsm.workspacesOnNode(ctx, req.NodeName)
+		if err != nil {
+			return err
+		}
+		stillRunning := make(map[string]struct{}, len(remaining))
+		for _, ws := range workspaces {
+			if _, ok := remaining[ws.Name]; !ok {
+				continue
 			}
-			if cpu.Value() == 0 && class.Container.Requests != nil {
-				cpu, _ = resource.ParseQuantity(class.Container.Requests.CPU)
+			// the backup outcome is known before the workspace is stopped and deleted
+			if ws.IsConditionTrue(workspacev1.WorkspaceConditionBackupFailure) {
+				failed[ws.Name] = struct{}{}
 			}
-			if ram.Value() == 0 && class.Container.Requests != nil {
-				ram, _ = resource.ParseQuantity(class.Container.Requests.Memory)
+			stillRunning[ws.Name] = struct{}{}
+		}
+		remaining = stillRunning
+
+		resp := &wsmanapi.DrainNodeResponse{
+			Total:     total,
+			Remaining: sortedKeys(remaining),
+			Failed:    sortedKeys(failed),
+			Done:      len(remaining) == 0 || timedOut,
+		}
+		if last == nil || !proto.Equal(last, resp) {
+			err = srv.Send(resp)
+			if err != nil {
+				return err
 			}
-			desc = fmt.Sprintf("%d vCPU, %dGB memory, %dGB disk", cpu.Value(), ram.ScaledValue(resource.Giga), disk.ScaledValue(resource.Giga))
+			last = resp
+		}
+		if resp.Done {
+			if len(resp.Remaining) > 0 {
+				log.WithField("node", req.NodeName).WithField("remaining", resp.Remaining).Warn("workspaces did not stop before the node drain timed out")
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-tick.C:
+		case <-deadline.C:
+			timedOut = true
 		}
-		classes = append(classes, &wsmanapi.WorkspaceClass{
-			Id:               id,
-			DisplayName:      class.Name,
-			Description:      desc,
-			CreditsPerMinute: class.CreditsPerMinute,
-		})
 	}
-	sort.Slice(classes, func(i, j int) bool {
-		return classes[i].Id < classes[j].Id
-	})
+}
 
-	return &wsmanapi.DescribeClusterResponse{
-		WorkspaceClasses:        classes,
-		PreferredWorkspaceClass: wsm.Config.PreferredWorkspaceClass,
-	}, nil
+// workspacesOnNode lists the workspaces on a node that have not stopped yet
+func (wsm *WorkspaceManagerServer) workspacesOnNode(ctx context.Context, nodeName string) ([]workspacev1.Workspace, error) {
+	var workspaces workspacev1.WorkspaceList
+	err := wsm.Client.List(ctx, &workspaces, client.InNamespace(wsm.Config.Namespace))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot list workspaces: %v", err)
+	}
+	res := make([]workspacev1.Workspace, 0, len(workspaces.Items))
+	for _, ws := range workspaces.Items {
+		if ws.Status.Runtime == nil || ws.Status.Runtime.NodeName != nodeName || ws.Status.Phase == workspacev1.WorkspacePhaseStopped {
+			continue
+		}
+		res = append(res, ws)
+	}
+	return res, nil
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// workspaceDaemonHost returns the address of the ws-daemon running on a node
+func (wsm *WorkspaceManagerServer) workspaceDaemonHost(ctx context.Context, nodeName string) (string, error) {
+	var pods corev1.PodList
+	err := wsm.Client.List(ctx, &pods,
+		client.InNamespace(wsm.Config.Namespace),
+		client.MatchingLabels{"component": "ws-daemon"},
+	)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "cannot list ws-daemon pods: %v", err)
+	}
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == nodeName && pod.Status.Phase == corev1.PodRunning && pod.Status.PodIP != "" {
+			return pod.Status.PodIP, nil
+		}
+	}
+	return "", status.Errorf(codes.Unavailable, "no ws-daemon running on node %s", nodeName)
 }
 
 // modifyWorkspace modifies a workspace object using the mod function. If the mod function returns a gRPC status error, that error
@@ -872,6 +2246,10 @@ func validateStartWorkspaceRequest(req *wsmanapi.StartWorkspaceRequest) error {
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
 	}
+	if req.Spec.Ephemeral && req.Type != wsmanapi.WorkspaceType_REGULAR {
+		// prebuilds exist to produce a snapshot, image builds have no content to begin with
+		return status.Error(codes.InvalidArgument, "invalid request: ephemeral: only regular workspaces can be ephemeral")
+	}
 
 	return nil
 }
@@ -889,6 +2267,44 @@ func validateUpdateSSHKeyRequest(req *wsmanapi.UpdateSSHKeyRequest) error {
 	return nil
 }
 
+func validateUpdateWorkspaceClassRequest(req *wsmanapi.UpdateWorkspaceClassRequest) error {
+	err := validation.ValidateStruct(req,
+		validation.Field(&req.Id, validation.Required),
+		validation.Field(&req.WorkspaceClass, validation.Required),
+	)
+
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	return nil
+}
+
+func validateSetRestartClassRequest(req *wsmanapi.SetRestartClassRequest) error {
+	err := validation.ValidateStruct(req,
+		validation.Field(&req.WorkspaceId, validation.Required),
+	)
+
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	return nil
+}
+
+func validateRelocateWorkspaceRequest(req *wsmanapi.RelocateWorkspaceRequest) error {
+	err := validation.ValidateStruct(req,
+		validation.Field(&req.Id, validation.Required),
+		validation.Field(&req.TargetNode, validation.Required),
+	)
+
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+	}
+
+	return nil
+}
+
 func isValidWorkspaceType(value interface{}) error {
 	s, ok := value.(wsmanapi.WorkspaceType)
 	if !ok {
@@ -1011,15 +2427,7 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 	log := log.WithFields(log.OWI(ws.Spec.Ownership.Owner, ws.Spec.Ownership.WorkspaceID, ws.Name))
 	version, _ := strconv.ParseUint(ws.ResourceVersion, 10, 64)
 
-	var tpe wsmanapi.WorkspaceType
-	switch ws.Spec.Type {
-	case workspacev1.WorkspaceTypeImageBuild:
-		tpe = wsmanapi.WorkspaceType_IMAGEBUILD
-	case workspacev1.WorkspaceTypePrebuild:
-		tpe = wsmanapi.WorkspaceType_PREBUILD
-	case workspacev1.WorkspaceTypeRegular:
-		tpe = wsmanapi.WorkspaceType_REGULAR
-	}
+	tpe := convertWorkspaceType(ws.Spec.Type)
 
 	timeout := wsm.Config.Timeouts.RegularWorkspace.String()
 	if ws.Spec.Timeout.Time != nil {
@@ -1052,6 +2460,15 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 		phase = wsmanapi.WorkspacePhase_UNKNOWN
 	}
 
+	var relocating string
+	if target := ws.RelocationTarget(); target != "" && !ws.IsConditionTrue(workspacev1.WorkspaceConditionFailed) {
+		// A relocation stops the workspace, backs it up and restores it on the target node. The workspace is not
+		// reachable until it runs on the target node, but it must not be treated as stopped either, hence it is
+		// interrupted for the whole relocation.
+		relocating = target
+		phase = wsmanapi.WorkspacePhase_INTERRUPTED
+	}
+
 	var firstUserActivity *timestamppb.Timestamp
 	for _, c := range ws.Status.Conditions {
 		if c.Type == string(workspacev1.WorkspaceConditionFirstUserActivity) {
@@ -1059,6 +2476,31 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 		}
 	}
 
+	finalBackupComplete := convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionBackupComplete))
+	if relocating != "" {
+		// the backup taken for a relocation is not a final one
+		finalBackupComplete = wsmanapi.WorkspaceConditionBool_FALSE
+	}
+
+	var message string
+	pullingImageFailed := getConditionMessageIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionPullingImageFailed))
+	if pullingImageFailed != "" && phase == wsmanapi.WorkspacePhase_CREATING {
+		message = pullingImageFailed
+	}
+
+	var queue *wsmanapi.WorkspaceQueueStatus
+	if q := ws.Status.Queue; q != nil && phase == wsmanapi.WorkspacePhase_PENDING && ws.IsConditionTrue(workspacev1.WorkspaceConditionQueued) {
+		phase = wsmanapi.WorkspacePhase_PENDING_QUEUE
+		message = getConditionMessageIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionQueued))
+		queue = &wsmanapi.WorkspaceQueueStatus{
+			Position: int32(q.Position),
+			Length:   int32(q.Length),
+		}
+		if q.EstimatedStart != nil {
+			queue.EstimatedStart = timestamppb.New(q.EstimatedStart.Time)
+		}
+	}
+
 	var runtime *wsmanapi.WorkspaceRuntimeInfo
 	if rt := ws.Status.Runtime; rt != nil {
 		runtime = &wsmanapi.WorkspaceRuntimeInfo{
@@ -1067,6 +2509,13 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 			PodName:  rt.PodName,
 		}
 	}
+	if gpu := ws.Status.GPU; runtime != nil && gpu != nil {
+		runtime.Gpu = &wsmanapi.GPUAllocation{
+			Count:        gpu.Count,
+			ResourceName: gpu.ResourceName,
+			Product:      gpu.Product,
+		}
+	}
 
 	var admissionLevel wsmanapi.AdmissionLevel
 	switch ws.Spec.Admission.Level {
@@ -1075,6 +2524,10 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 	case workspacev1.AdmissionLevelOwner:
 		admissionLevel = wsmanapi.AdmissionLevel_ADMIT_OWNER_ONLY
 	}
+	var admissionExpiry *timestamppb.Timestamp
+	if ws.Spec.Admission.Expiry != nil {
+		admissionExpiry = timestamppb.New(ws.Spec.Admission.Expiry.Time)
+	}
 
 	ports := make([]*wsmanapi.PortSpec, 0, len(ws.Spec.Ports))
 	for _, p := range ws.Spec.Ports {
@@ -1082,10 +2535,6 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 		if p.Visibility == workspacev1.AdmissionLevelEveryone {
 			v = wsmanapi.PortVisibility_PORT_VISIBILITY_PUBLIC
 		}
-		protocol := wsmanapi.PortProtocol_PORT_PROTOCOL_HTTP
-		if p.Protocol == workspacev1.PortProtocolHttps {
-			protocol = wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS
-		}
 		url, err := config.RenderWorkspacePortURL(wsm.Config.WorkspacePortURLTemplate, config.PortURLContext{
 			Host:          wsm.Config.GitpodHostURL,
 			ID:            ws.Name,
@@ -1101,7 +2550,7 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 			Port:       p.Port,
 			Visibility: v,
 			Url:        url,
-			Protocol:   protocol,
+			Protocol:   convertPortProtocol(p.Protocol),
 		})
 	}
 
@@ -1138,13 +2587,23 @@ func (wsm *WorkspaceManagerServer) extractWorkspaceStatus(ws *workspacev1.Worksp
 			FirstUserActivity:   firstUserActivity,
 			HeadlessTaskFailed:  getConditionMessageIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionsHeadlessTaskFailed)),
 			StoppedByRequest:    convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionStoppedByRequest)),
-			FinalBackupComplete: convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionBackupComplete)),
+			FinalBackupComplete: finalBackupComplete,
 			Aborted:             convertCondition(ws.Status.Conditions, string(workspacev1.WorkspaceConditionAborted)),
+			PullingImageFailed:  pullingImageFailed,
+			NodePreempted:       getConditionMessageIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionNodePreempted)),
+			StopReason:          convertStopReason(ws.StopReason()),
+			ContentReady:        getConditionTimeIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionContentReady)),
+			EverReady:           getConditionTimeIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionEverReady)),
+			OomKill:             getConditionMessageIfTrue(ws.Status.Conditions, string(workspacev1.WorkspaceConditionOOMKill)),
+			Relocating:          relocating,
 		},
+		Message: message,
 		Runtime: runtime,
+		Queue:   queue,
 		Auth: &wsmanapi.WorkspaceAuthentication{
-			Admission:  admissionLevel,
-			OwnerToken: ws.Status.OwnerToken,
+			Admission:       admissionLevel,
+			OwnerToken:      ws.Status.OwnerToken,
+			AdmissionExpiry: admissionExpiry,
 		},
 		Repo: convertGitStatus(ws.Status.GitStatus),
 	}
@@ -1161,6 +2620,16 @@ func getConditionMessageIfTrue(conds []metav1.Condition, tpe string) string {
 	return ""
 }
 
+// getConditionTimeIfTrue returns the time a condition became true, or nil if it is not true
+func getConditionTimeIfTrue(conds []metav1.Condition, tpe string) *timestamppb.Timestamp {
+	for _, c := range conds {
+		if c.Type == tpe && c.Status == metav1.ConditionTrue {
+			return timestamppb.New(c.LastTransitionTime.Time)
+		}
+	}
+	return nil
+}
+
 func convertGitStatus(gs *workspacev1.GitStatus) *csapi.GitStatus {
 	if gs == nil {
 		return nil
@@ -1177,6 +2646,115 @@ func convertGitStatus(gs *workspacev1.GitStatus) *csapi.GitStatus {
 	}
 }
 
+func convertResourceUsage(usage *wsdaemon.WorkspaceResourceUsageResponse) *wsmanapi.WorkspaceResourceUsage {
+	return &wsmanapi.WorkspaceResourceUsage{
+		Cpu: &wsmanapi.ResourceUsage{
+			Used:  usage.CpuUsed,
+			Limit: usage.CpuLimit,
+		},
+		Memory: &wsmanapi.ResourceUsage{
+			Used:  usage.MemoryUsed,
+			Limit: usage.MemoryLimit,
+		},
+		Disk: &wsmanapi.ResourceUsage{
+			Used:  usage.DiskUsed,
+			Limit: usage.DiskLimit,
+		},
+		NetworkRxBytes: usage.NetworkRxBytes,
+		NetworkTxBytes: usage.NetworkTxBytes,
+		CollectedAt:    timestamppb.New(time.UnixMilli(usage.CollectedAtMs)),
+	}
+}
+
+func convertDiskUsage(usage *wsdaemon.DiskUsage) *wsmanapi.ResourceUsage {
+	if usage == nil {
+		return nil
+	}
+	return &wsmanapi.ResourceUsage{
+		Used:  usage.Used,
+		Limit: usage.Limit,
+	}
+}
+
+// convertPortSpec translates a port of the API into its representation on the workspace resource
+func convertPortSpec(p *wsmanapi.PortSpec) workspacev1.PortSpec {
+	res := workspacev1.PortSpec{
+		Port:       p.Port,
+		Visibility: workspacev1.AdmissionLevelOwner,
+		Protocol:   workspacev1.PortProtocolHttp,
+	}
+	if p.Visibility == wsmanapi.PortVisibility_PORT_VISIBILITY_PUBLIC {
+		res.Visibility = workspacev1.AdmissionLevelEveryone
+	}
+	switch p.Protocol {
+	case wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS:
+		res.Protocol = workspacev1.PortProtocolHttps
+	case wsmanapi.PortProtocol_PORT_PROTOCOL_TCP:
+		res.Protocol = workspacev1.PortProtocolTcp
+	}
+	return res
+}
+
+func convertWorkspaceType(tpe workspacev1.WorkspaceType) wsmanapi.WorkspaceType {
+	switch tpe {
+	case workspacev1.WorkspaceTypeImageBuild:
+		return wsmanapi.WorkspaceType_IMAGEBUILD
+	case workspacev1.WorkspaceTypePrebuild:
+		return wsmanapi.WorkspaceType_PREBUILD
+	default:
+		return wsmanapi.WorkspaceType_REGULAR
+	}
+}
+
+func convertPortProtocol(p workspacev1.PortProtocol) wsmanapi.PortProtocol {
+	switch p {
+	case workspacev1.PortProtocolHttps:
+		return wsmanapi.PortProtocol_PORT_PROTOCOL_HTTPS
+	case workspacev1.PortProtocolTcp:
+		return wsmanapi.PortProtocol_PORT_PROTOCOL_TCP
+	default:
+		return wsmanapi.PortProtocol_PORT_PROTOCOL_HTTP
+	}
+}
+
+// activitySources maps the activity sources of the API to those we record on the workspace
+var activitySources = map[wsmanapi.ActivitySource]workspacev1.ActivitySource{
+	wsmanapi.ActivitySource_ACTIVITY_SOURCE_UNSPECIFIED:    workspacev1.ActivitySourceUnspecified,
+	wsmanapi.ActivitySource_ACTIVITY_SOURCE_IDE_HEARTBEAT:  workspacev1.ActivitySourceIDEHeartbeat,
+	wsmanapi.ActivitySource_ACTIVITY_SOURCE_PORT_TRAFFIC:   workspacev1.ActivitySourcePortTraffic,
+	wsmanapi.ActivitySource_ACTIVITY_SOURCE_TERMINAL_INPUT: workspacev1.ActivitySourceTerminalInput,
+	wsmanapi.ActivitySource_ACTIVITY_SOURCE_API_CALL:       workspacev1.ActivitySourceAPICall,
+}
+
+// activitySourcesAPI is the inverse of activitySources
+var activitySourcesAPI = func() map[workspacev1.ActivitySource]wsmanapi.ActivitySource {
+	res := make(map[workspacev1.ActivitySource]wsmanapi.ActivitySource, len(activitySources))
+	for k, v := range activitySources {
+		res[v] = k
+	}
+	return res
+}()
+
+// stopReasons maps the stop reasons of the API to those we record on the workspace
+var stopReasons = map[wsmanapi.StopReason]workspacev1.StopReason{
+	wsmanapi.StopReason_STOP_REASON_TIMEOUT_INACTIVITY: workspacev1.StopReasonTimeoutInactivity,
+	wsmanapi.StopReason_STOP_REASON_USER_REQUESTED:     workspacev1.StopReasonUserRequested,
+	wsmanapi.StopReason_STOP_REASON_ADMIN_STOPPED:      workspacev1.StopReasonAdminStopped,
+	wsmanapi.StopReason_STOP_REASON_NODE_FAILURE:       workspacev1.StopReasonNodeFailure,
+	wsmanapi.StopReason_STOP_REASON_BACKUP_FAILURE:     workspacev1.StopReasonBackupFailure,
+	wsmanapi.StopReason_STOP_REASON_AGENT_SMITH:        workspacev1.StopReasonAgentSmith,
+	wsmanapi.StopReason_STOP_REASON_OOM_KILLED:         workspacev1.StopReasonOOMKilled,
+}
+
+func convertStopReason(r workspacev1.StopReason) wsmanapi.StopReason {
+	for api, reason := range stopReasons {
+		if reason == r {
+			return api
+		}
+	}
+	return wsmanapi.StopReason_STOP_REASON_UNSPECIFIED
+}
+
 func convertCondition(conds []metav1.Condition, tpe string) wsmanapi.WorkspaceConditionBool {
 	res := wsk8s.GetCondition(conds, tpe)
 	if res == nil {
@@ -1215,14 +2793,14 @@ func metadataFilterToLabelSelector(filter *wsmanapi.MetadataFilter) (labels.Sele
 		if err != nil {
 			return nil, xerrors.Errorf("cannot create metaID filter: %w", err)
 		}
-		res.Add(*req)
+		res = res.Add(*req)
 	}
 	if filter.Owner != "" {
 		req, err := labels.NewRequirement(wsk8s.OwnerLabel, selection.Equals, []string{filter.Owner})
 		if err != nil {
 			return nil, xerrors.Errorf("cannot create owner filter: %w", err)
 		}
-		res.Add(*req)
+		res = res.Add(*req)
 	}
 	return res, nil
 }
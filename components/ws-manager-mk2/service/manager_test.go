@@ -6,12 +6,38 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"strings"
 	"testing"
+	"time"
 
+	wsk8s "github.com/gitpod-io/gitpod/common-go/kubernetes"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	imgbldr "github.com/gitpod-io/gitpod/image-builder/api"
+	wsdaemon "github.com/gitpod-io/gitpod/ws-daemon/api"
+	"github.com/gitpod-io/gitpod/ws-manager-mk2/grpcpool"
 	"github.com/gitpod-io/gitpod/ws-manager/api"
 	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestDescribeCluster(t *testing.T) {
@@ -93,3 +119,1900 @@ func TestDescribeCluster(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStartWorkspace(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	newRequest := func(mod func(req *api.StartWorkspaceRequest)) *api.StartWorkspaceRequest {
+		req := &api.StartWorkspaceRequest{
+			Id:            "test",
+			ServicePrefix: "test",
+			Type:          api.WorkspaceType_REGULAR,
+			ValidateOnly:  true,
+			Metadata: &api.WorkspaceMetadata{
+				Owner:  "owner",
+				MetaId: "meta-id",
+			},
+			Spec: &api.StartWorkspaceSpec{
+				WorkspaceImage:    "workspace-image",
+				WorkspaceLocation: "/workspace",
+				Initializer:       &csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Empty{Empty: &csapi.EmptyInitializer{}}},
+				IdeImage:          &api.IDEImage{WebRef: "web-ref", SupervisorRef: "supervisor-ref"},
+			},
+		}
+		if mod != nil {
+			mod(req)
+		}
+		return req
+	}
+
+	tests := []struct {
+		Name        string
+		Request     *api.StartWorkspaceRequest
+		Expectation []string
+	}{
+		{
+			Name:    "valid request",
+			Request: newRequest(nil),
+		},
+		{
+			Name: "invalid request",
+			Request: newRequest(func(req *api.StartWorkspaceRequest) {
+				req.Spec.WorkspaceImage = ""
+			}),
+			Expectation: []string{"invalid request: workspace_image: cannot be blank."},
+		},
+		{
+			Name: "unknown class falls back to the default class",
+			Request: newRequest(func(req *api.StartWorkspaceRequest) {
+				req.Spec.Class = "xlarge"
+			}),
+		},
+		{
+			Name: "unresolvable images",
+			Request: newRequest(func(req *api.StartWorkspaceRequest) {
+				req.Spec.IdeImage.SupervisorRef = "missing-supervisor-ref"
+				req.Spec.IdeImageLayers = []string{"layer-ref", "missing-layer-ref"}
+			}),
+			Expectation: []string{
+				"cannot resolve image missing-supervisor-ref: not found",
+				"cannot resolve image missing-layer-ref: not found",
+			},
+		},
+		{
+			Name: "env var too large",
+			Request: newRequest(func(req *api.StartWorkspaceRequest) {
+				req.Spec.Envvars = []*api.EnvironmentVariable{{Name: "FOO", Value: strings.Repeat("a", maxEnvVarSize)}}
+			}),
+			Expectation: []string{fmt.Sprintf("environment variable FOO is too large: %d bytes exceed the limit of %d bytes", maxEnvVarSize+4, maxEnvVarSize)},
+		},
+		{
+			Name: "sidecar from the catalog",
+			Request: newRequest(func(req *api.StartWorkspaceRequest) {
+				req.Spec.Sidecars = []string{"cloud-sql-proxy"}
+			}),
+		},
+		{
+			Name: "sidecar not in the catalog",
+			Request: newRequest(func(req *api.StartWorkspaceRequest) {
+				req.Spec.Sidecars = []string{"docker-in-docker"}
+			}),
+			Expectation: []string{`sidecar "docker-in-docker" is not in the sidecar catalog`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			srv := WorkspaceManagerServer{
+				Client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Config: &config.Configuration{
+					Namespace: "default",
+					WorkspaceClasses: map[string]*config.WorkspaceClass{
+						config.DefaultWorkspaceClass: {
+							Container: config.ContainerConfiguration{
+								Limits: &config.ResourceLimitConfiguration{Storage: "10G"},
+							},
+						},
+					},
+					SidecarCatalog: map[string]*config.SidecarConfiguration{
+						"cloud-sql-proxy": {Image: "gcr.io/cloud-sql-connectors/cloud-sql-proxy:2.8.1"},
+					},
+				},
+				maintenance:  maintenanceDisabled{},
+				imageBuilder: fakeImageResolver{},
+			}
+
+			resp, err := srv.StartWorkspace(context.Background(), test.Request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(test.Expectation, resp.Problems); diff != "" {
+				t.Errorf("unexpected problems (-want +got):\n%s", diff)
+			}
+
+			var workspaces workspacev1.WorkspaceList
+			err = srv.Client.List(context.Background(), &workspaces)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(workspaces.Items) != 0 {
+				t.Errorf("validation created %d workspaces", len(workspaces.Items))
+			}
+		})
+	}
+}
+
+func TestStartWorkspaceIdempotencyKey(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	type Expectation struct {
+		Code       codes.Code
+		URL        string
+		Workspaces int
+	}
+	tests := []struct {
+		Name        string
+		ID          string
+		Key         string
+		Expectation Expectation
+	}{
+		{
+			Name:        "retry",
+			ID:          "instance-id",
+			Key:         "start-1",
+			Expectation: Expectation{URL: "https://meta-id.ws.gitpod.io", Workspaces: 1},
+		},
+		{
+			Name:        "key of another instance",
+			ID:          "other-instance-id",
+			Key:         "start-1",
+			Expectation: Expectation{Code: codes.AlreadyExists, Workspaces: 1},
+		},
+		{
+			Name:        "no key",
+			ID:          "instance-id",
+			Expectation: Expectation{Code: codes.AlreadyExists, Workspaces: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(
+				&workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "instance-id",
+						Namespace: "default",
+						Labels: map[string]string{
+							wsk8s.WorkspaceIDLabel:    "meta-id",
+							wsk8s.IdempotencyKeyLabel: idempotencyKeyLabelValue("start-1"),
+						},
+					},
+					Spec: workspacev1.WorkspaceSpec{
+						Type:      workspacev1.WorkspaceTypeRegular,
+						Ownership: workspacev1.Ownership{Owner: "owner", WorkspaceID: "meta-id"},
+					},
+					Status: workspacev1.WorkspaceStatus{
+						Phase:      workspacev1.WorkspacePhaseCreating,
+						URL:        "https://meta-id.ws.gitpod.io",
+						OwnerToken: "owner-token",
+					},
+				},
+			).Build()
+			srv := WorkspaceManagerServer{
+				Client: clnt,
+				Config: &config.Configuration{
+					Namespace: "default",
+					WorkspaceClasses: map[string]*config.WorkspaceClass{
+						config.DefaultWorkspaceClass: {
+							Container: config.ContainerConfiguration{
+								Limits: &config.ResourceLimitConfiguration{Storage: "10G"},
+							},
+						},
+					},
+				},
+				maintenance:  maintenanceDisabled{},
+				imageBuilder: fakeImageResolver{},
+			}
+
+			resp, err := srv.StartWorkspace(context.Background(), &api.StartWorkspaceRequest{
+				Id:             test.ID,
+				ServicePrefix:  test.ID,
+				Type:           api.WorkspaceType_REGULAR,
+				IdempotencyKey: test.Key,
+				Metadata:       &api.WorkspaceMetadata{Owner: "owner", MetaId: "meta-id"},
+				Spec: &api.StartWorkspaceSpec{
+					WorkspaceImage:    "workspace-image",
+					WorkspaceLocation: "/workspace",
+					Initializer:       &csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Empty{Empty: &csapi.EmptyInitializer{}}},
+					IdeImage:          &api.IDEImage{WebRef: "web-ref", SupervisorRef: "supervisor-ref"},
+				},
+			})
+			act := Expectation{Code: status.Code(err)}
+			if resp != nil {
+				act.URL = resp.Url
+			}
+			var workspaces workspacev1.WorkspaceList
+			err = clnt.List(context.Background(), &workspaces)
+			if err != nil {
+				t.Fatal(err)
+			}
+			act.Workspaces = len(workspaces.Items)
+
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateEphemeralStartWorkspaceRequest(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Type        api.WorkspaceType
+		Expectation codes.Code
+	}{
+		{Name: "regular", Type: api.WorkspaceType_REGULAR, Expectation: codes.OK},
+		{Name: "prebuild", Type: api.WorkspaceType_PREBUILD, Expectation: codes.InvalidArgument},
+		{Name: "image build", Type: api.WorkspaceType_IMAGEBUILD, Expectation: codes.InvalidArgument},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			err := validateStartWorkspaceRequest(&api.StartWorkspaceRequest{
+				Id:            "test",
+				ServicePrefix: "test",
+				Type:          test.Type,
+				Spec: &api.StartWorkspaceSpec{
+					WorkspaceImage:    "workspace-image",
+					WorkspaceLocation: "/workspace",
+					Initializer:       &csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Empty{Empty: &csapi.EmptyInitializer{}}},
+					Ephemeral:         true,
+				},
+			})
+			if code := status.Code(err); code != test.Expectation {
+				t.Errorf("unexpected result: expected %v, got %v", test.Expectation, err)
+			}
+		})
+	}
+}
+
+// fakeImageResolver resolves all refs except those starting with "missing-"
+type fakeImageResolver struct {
+	imgbldr.ImageBuilderClient
+}
+
+func (fakeImageResolver) ResolveBaseImage(ctx context.Context, req *imgbldr.ResolveBaseImageRequest, opts ...grpc.CallOption) (*imgbldr.ResolveBaseImageResponse, error) {
+	if strings.HasPrefix(req.Ref, "missing-") {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &imgbldr.ResolveBaseImageResponse{Ref: req.Ref}, nil
+}
+
+func TestValidateRelocationTarget(t *testing.T) {
+	readyLabels := map[string]string{
+		"gitpod.io/workload_workspace_regular":       "true",
+		"gitpod.io/ws-daemon_ready_ns_default":       "true",
+		"gitpod.io/registry-facade_ready_ns_default": "true",
+		"topology.kubernetes.io/zone":                "zone-b",
+	}
+	withLabels := func(extra map[string]string, without ...string) map[string]string {
+		res := make(map[string]string, len(readyLabels)+len(extra))
+		for k, v := range readyLabels {
+			res[k] = v
+		}
+		for k, v := range extra {
+			res[k] = v
+		}
+		for _, k := range without {
+			delete(res, k)
+		}
+		return res
+	}
+	zoneTerm := func(zone string) corev1.NodeSelectorTerm {
+		return corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "topology.kubernetes.io/zone", Operator: corev1.NodeSelectorOpIn, Values: []string{zone}},
+		}}
+	}
+
+	tests := []struct {
+		Name        string
+		Class       string
+		Labels      map[string]string
+		Expectation string
+	}{
+		{
+			Name:   "ready node",
+			Class:  "default",
+			Labels: readyLabels,
+		},
+		{
+			Name:        "ws-daemon not ready",
+			Class:       "default",
+			Labels:      withLabels(nil, "gitpod.io/ws-daemon_ready_ns_default"),
+			Expectation: "node is not ready for workspaces",
+		},
+		{
+			Name:        "registry-facade not ready",
+			Class:       "default",
+			Labels:      withLabels(nil, "gitpod.io/registry-facade_ready_ns_default"),
+			Expectation: "node is not ready for workspaces",
+		},
+		{
+			Name:   "matches one of the class terms",
+			Class:  "zoned",
+			Labels: readyLabels,
+		},
+		{
+			Name:        "matches none of the class terms",
+			Class:       "zoned",
+			Labels:      withLabels(map[string]string{"topology.kubernetes.io/zone": "zone-c"}),
+			Expectation: "node does not match the node selector terms of class zoned",
+		},
+		{
+			Name:        "missing GPU node selector",
+			Class:       "gpu",
+			Labels:      readyLabels,
+			Expectation: "node does not match the GPU node selector of class gpu",
+		},
+		{
+			Name:   "GPU node",
+			Class:  "gpu",
+			Labels: withLabels(map[string]string{"nvidia.com/gpu.present": "true"}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			srv := WorkspaceManagerServer{
+				Config: &config.Configuration{
+					Namespace: "default",
+					WorkspaceClasses: map[string]*config.WorkspaceClass{
+						"default": {},
+						"zoned": {
+							Scheduling: &config.WorkspaceClassScheduling{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{zoneTerm("zone-a"), zoneTerm("zone-b")},
+							},
+						},
+						"gpu": {
+							GPU: &config.GPUConfiguration{Count: 1, NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"}},
+						},
+					},
+				},
+			}
+			ws := &workspacev1.Workspace{Spec: workspacev1.WorkspaceSpec{Class: test.Class}}
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "target", Labels: test.Labels}}
+
+			var act string
+			if err := srv.validateRelocationTarget(ws, node); err != nil {
+				act = err.Error()
+			}
+			if act != test.Expectation {
+				t.Errorf("unexpected result: expected %q, got %q", test.Expectation, act)
+			}
+		})
+	}
+}
+
+type maintenanceDisabled struct{}
+
+func (maintenanceDisabled) IsEnabled(context.Context) bool { return false }
+
+type fakeResourceService struct {
+	wsdaemon.UnimplementedWorkspaceResourceServiceServer
+
+	Samples   []*wsdaemon.WorkspaceResourceUsageResponse
+	Request   *wsdaemon.WorkspaceResourceUsageRequest
+	DiskUsage *wsdaemon.GetWorkspaceDiskUsageResponse
+}
+
+func (f *fakeResourceService) WorkspaceResourceUsage(req *wsdaemon.WorkspaceResourceUsageRequest, srv wsdaemon.WorkspaceResourceService_WorkspaceResourceUsageServer) error {
+	f.Request = req
+	for _, s := range f.Samples {
+		err := srv.Send(s)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeResourceService) GetWorkspaceDiskUsage(ctx context.Context, req *wsdaemon.GetWorkspaceDiskUsageRequest) (*wsdaemon.GetWorkspaceDiskUsageResponse, error) {
+	return f.DiskUsage, nil
+}
+
+type fakeResourceUsageServer struct {
+	grpc.ServerStream
+
+	Responses []*api.GetWorkspaceResourceUsageResponse
+}
+
+func (f *fakeResourceUsageServer) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeResourceUsageServer) Send(resp *api.GetWorkspaceResourceUsageResponse) error {
+	f.Responses = append(f.Responses, resp)
+	return nil
+}
+
+func TestGetWorkspaceResourceUsage(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	collectedAt := time.Unix(1700000000, 0)
+	type Expectation struct {
+		Code      codes.Code
+		Responses []*api.GetWorkspaceResourceUsageResponse
+		Interval  int64
+	}
+	tests := []struct {
+		Name        string
+		Request     *api.GetWorkspaceResourceUsageRequest
+		Phase       workspacev1.WorkspacePhase
+		NoDaemon    bool
+		Expectation Expectation
+	}{
+		{
+			Name:    "streams ws-daemon samples",
+			Request: &api.GetWorkspaceResourceUsageRequest{Id: "foobar", Interval: "2s"},
+			Phase:   workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{
+				Interval: 2000,
+				Responses: []*api.GetWorkspaceResourceUsageResponse{
+					{Usage: &api.WorkspaceResourceUsage{
+						Cpu:            &api.ResourceUsage{Used: 500, Limit: 2000},
+						Memory:         &api.ResourceUsage{Used: 1024, Limit: 4096},
+						Disk:           &api.ResourceUsage{Used: 10, Limit: 100},
+						NetworkRxBytes: 5,
+						NetworkTxBytes: 6,
+						CollectedAt:    timestamppb.New(collectedAt),
+					}},
+					{Usage: &api.WorkspaceResourceUsage{
+						Cpu:         &api.ResourceUsage{Used: 1000, Limit: 2000},
+						Memory:      &api.ResourceUsage{Used: 2048, Limit: 4096},
+						Disk:        &api.ResourceUsage{Used: 20, Limit: 100},
+						CollectedAt: timestamppb.New(collectedAt.Add(2 * time.Second)),
+					}},
+				},
+			},
+		},
+		{
+			Name:        "unknown workspace",
+			Request:     &api.GetWorkspaceResourceUsageRequest{Id: "unknown"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.NotFound},
+		},
+		{
+			Name:        "workspace not running",
+			Request:     &api.GetWorkspaceResourceUsageRequest{Id: "foobar"},
+			Phase:       workspacev1.WorkspacePhaseCreating,
+			Expectation: Expectation{Code: codes.FailedPrecondition},
+		},
+		{
+			Name:        "invalid interval",
+			Request:     &api.GetWorkspaceResourceUsageRequest{Id: "foobar", Interval: "often"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+		{
+			Name:        "no ws-daemon on node",
+			Request:     &api.GetWorkspaceResourceUsageRequest{Id: "foobar"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			NoDaemon:    true,
+			Expectation: Expectation{Code: codes.Unavailable},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			daemon := &fakeResourceService{
+				Samples: []*wsdaemon.WorkspaceResourceUsageResponse{
+					{CpuUsed: 500, CpuLimit: 2000, MemoryUsed: 1024, MemoryLimit: 4096, DiskUsed: 10, DiskLimit: 100, NetworkRxBytes: 5, NetworkTxBytes: 6, CollectedAtMs: collectedAt.UnixMilli()},
+					{CpuUsed: 1000, CpuLimit: 2000, MemoryUsed: 2048, MemoryLimit: 4096, DiskUsed: 20, DiskLimit: 100, CollectedAtMs: collectedAt.Add(2 * time.Second).UnixMilli()},
+				},
+			}
+			lis := bufconn.Listen(1024 * 1024)
+			grpcServer := grpc.NewServer()
+			wsdaemon.RegisterWorkspaceResourceServiceServer(grpcServer, daemon)
+			go func() {
+				_ = grpcServer.Serve(lis)
+			}()
+			defer grpcServer.Stop()
+
+			var dialedHost string
+			pool := grpcpool.New(func(host string) (*grpc.ClientConn, error) {
+				dialedHost = host
+				return grpc.Dial("bufnet",
+					grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return lis.DialContext(ctx) }),
+					grpc.WithTransportCredentials(insecure.NewCredentials()),
+				)
+			}, func(hostIP string) bool { return true })
+			defer pool.Close()
+
+			objs := []client.Object{
+				&workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+					Status: workspacev1.WorkspaceStatus{
+						Phase:   test.Phase,
+						Runtime: &workspacev1.WorkspaceRuntimeStatus{NodeName: "node-a"},
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "ws-daemon-b", Namespace: "default", Labels: map[string]string{"component": "ws-daemon"}},
+					Spec:       corev1.PodSpec{NodeName: "node-b"},
+					Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.2"},
+				},
+			}
+			if !test.NoDaemon {
+				objs = append(objs, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "ws-daemon-a", Namespace: "default", Labels: map[string]string{"component": "ws-daemon"}},
+					Spec:       corev1.PodSpec{NodeName: "node-a"},
+					Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+				})
+			}
+
+			srv := WorkspaceManagerServer{
+				Client:       fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+				Config:       &config.Configuration{Namespace: "default"},
+				wsdaemonPool: pool,
+			}
+			stream := &fakeResourceUsageServer{}
+			err := srv.GetWorkspaceResourceUsage(test.Request, stream)
+
+			act := Expectation{Code: status.Code(err), Responses: stream.Responses}
+			if daemon.Request != nil {
+				act.Interval = daemon.Request.IntervalMs
+				if dialedHost != "10.0.0.1" {
+					t.Errorf("connected to ws-daemon at %s instead of the workspace's node", dialedHost)
+				}
+			}
+			if diff := cmp.Diff(test.Expectation, act, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetWorkspaceDiskUsage(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	collectedAt := time.Unix(1700000000, 0)
+	type Expectation struct {
+		Code     codes.Code
+		Response *api.GetWorkspaceDiskUsageResponse
+	}
+	tests := []struct {
+		Name        string
+		Request     *api.GetWorkspaceDiskUsageRequest
+		Phase       workspacev1.WorkspacePhase
+		Expectation Expectation
+	}{
+		{
+			Name:    "returns ws-daemon usage",
+			Request: &api.GetWorkspaceDiskUsageRequest{Id: "foobar"},
+			Phase:   workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{
+				Response: &api.GetWorkspaceDiskUsageResponse{
+					Workspace:   &api.ResourceUsage{Used: 10, Limit: 100},
+					Tmp:         &api.ResourceUsage{Used: 5},
+					CollectedAt: timestamppb.New(collectedAt),
+				},
+			},
+		},
+		{
+			Name:        "missing id",
+			Request:     &api.GetWorkspaceDiskUsageRequest{},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+		{
+			Name:        "workspace not running",
+			Request:     &api.GetWorkspaceDiskUsageRequest{Id: "foobar"},
+			Phase:       workspacev1.WorkspacePhaseStopping,
+			Expectation: Expectation{Code: codes.FailedPrecondition},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			daemon := &fakeResourceService{
+				DiskUsage: &wsdaemon.GetWorkspaceDiskUsageResponse{
+					Workspace:     &wsdaemon.DiskUsage{Used: 10, Limit: 100},
+					Tmp:           &wsdaemon.DiskUsage{Used: 5},
+					CollectedAtMs: collectedAt.UnixMilli(),
+				},
+			}
+			lis := bufconn.Listen(1024 * 1024)
+			grpcServer := grpc.NewServer()
+			wsdaemon.RegisterWorkspaceResourceServiceServer(grpcServer, daemon)
+			go func() {
+				_ = grpcServer.Serve(lis)
+			}()
+			defer grpcServer.Stop()
+
+			pool := grpcpool.New(func(host string) (*grpc.ClientConn, error) {
+				return grpc.Dial("bufnet",
+					grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return lis.DialContext(ctx) }),
+					grpc.WithTransportCredentials(insecure.NewCredentials()),
+				)
+			}, func(hostIP string) bool { return true })
+			defer pool.Close()
+
+			srv := WorkspaceManagerServer{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&workspacev1.Workspace{
+						ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+						Status: workspacev1.WorkspaceStatus{
+							Phase:   test.Phase,
+							Runtime: &workspacev1.WorkspaceRuntimeStatus{NodeName: "node-a"},
+						},
+					},
+					&corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: "ws-daemon-a", Namespace: "default", Labels: map[string]string{"component": "ws-daemon"}},
+						Spec:       corev1.PodSpec{NodeName: "node-a"},
+						Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+					},
+				).Build(),
+				Config:       &config.Configuration{Namespace: "default"},
+				wsdaemonPool: pool,
+			}
+			resp, err := srv.GetWorkspaceDiskUsage(context.Background(), test.Request)
+
+			act := Expectation{Code: status.Code(err), Response: resp}
+			if diff := cmp.Diff(test.Expectation, act, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeContentService struct {
+	wsdaemon.UnimplementedWorkspaceContentServiceServer
+
+	Conflicts []string
+	Request   *wsdaemon.RestoreSnapshotRequest
+}
+
+func (f *fakeContentService) RestoreSnapshot(ctx context.Context, req *wsdaemon.RestoreSnapshotRequest) (*wsdaemon.RestoreSnapshotResponse, error) {
+	f.Request = req
+	return &wsdaemon.RestoreSnapshotResponse{Conflicts: f.Conflicts}, nil
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	type Expectation struct {
+		Code          codes.Code
+		Response      *api.RestoreSnapshotResponse
+		DaemonRequest *wsdaemon.RestoreSnapshotRequest
+	}
+	tests := []struct {
+		Name          string
+		Request       *api.RestoreSnapshotRequest
+		Phase         workspacev1.WorkspacePhase
+		Ephemeral     bool
+		Unimplemented bool
+		Expectation   Expectation
+	}{
+		{
+			Name:    "restores through ws-daemon",
+			Request: &api.RestoreSnapshotRequest{Id: "foobar", Snapshot: "workspaces/foobar/snapshot.tar@bucket", Target: "restored", ConflictPolicy: api.RestoreConflictPolicy_RESTORE_CONFLICT_SKIP},
+			Phase:   workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{
+				Response:      &api.RestoreSnapshotResponse{Conflicts: []string{"main.go"}},
+				DaemonRequest: &wsdaemon.RestoreSnapshotRequest{Id: "foobar", Snapshot: "workspaces/foobar/snapshot.tar@bucket", Target: "restored", ConflictPolicy: wsdaemon.RestoreConflictPolicy_RESTORE_CONFLICT_SKIP},
+			},
+		},
+		{
+			Name:        "unknown workspace",
+			Request:     &api.RestoreSnapshotRequest{Id: "unknown", Snapshot: "snapshot"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.NotFound},
+		},
+		{
+			Name:        "workspace not running",
+			Request:     &api.RestoreSnapshotRequest{Id: "foobar", Snapshot: "snapshot"},
+			Phase:       workspacev1.WorkspacePhaseStopping,
+			Expectation: Expectation{Code: codes.FailedPrecondition},
+		},
+		{
+			Name:        "ephemeral workspace",
+			Request:     &api.RestoreSnapshotRequest{Id: "foobar", Snapshot: "snapshot"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Ephemeral:   true,
+			Expectation: Expectation{Code: codes.FailedPrecondition},
+		},
+		{
+			Name:        "missing snapshot",
+			Request:     &api.RestoreSnapshotRequest{Id: "foobar"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+		{
+			Name:        "unknown conflict policy",
+			Request:     &api.RestoreSnapshotRequest{Id: "foobar", Snapshot: "snapshot", ConflictPolicy: 42},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+		{
+			Name:          "ws-daemon does not support restores",
+			Request:       &api.RestoreSnapshotRequest{Id: "foobar", Snapshot: "snapshot"},
+			Phase:         workspacev1.WorkspacePhaseRunning,
+			Unimplemented: true,
+			Expectation:   Expectation{Code: codes.FailedPrecondition},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			daemon := &fakeContentService{Conflicts: []string{"main.go"}}
+			lis := bufconn.Listen(1024 * 1024)
+			grpcServer := grpc.NewServer()
+			if !test.Unimplemented {
+				wsdaemon.RegisterWorkspaceContentServiceServer(grpcServer, daemon)
+			}
+			go func() {
+				_ = grpcServer.Serve(lis)
+			}()
+			defer grpcServer.Stop()
+
+			pool := grpcpool.New(func(host string) (*grpc.ClientConn, error) {
+				return grpc.Dial("bufnet",
+					grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return lis.DialContext(ctx) }),
+					grpc.WithTransportCredentials(insecure.NewCredentials()),
+				)
+			}, func(hostIP string) bool { return true })
+			defer pool.Close()
+
+			objs := []client.Object{
+				&workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+					Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular, Ephemeral: test.Ephemeral},
+					Status: workspacev1.WorkspaceStatus{
+						Phase:   test.Phase,
+						Runtime: &workspacev1.WorkspaceRuntimeStatus{NodeName: "node-a"},
+					},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "ws-daemon-a", Namespace: "default", Labels: map[string]string{"component": "ws-daemon"}},
+					Spec:       corev1.PodSpec{NodeName: "node-a"},
+					Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+				},
+			}
+
+			srv := WorkspaceManagerServer{
+				Client:       fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+				Config:       &config.Configuration{Namespace: "default"},
+				maintenance:  maintenanceDisabled{},
+				wsdaemonPool: pool,
+			}
+			resp, err := srv.RestoreSnapshot(context.Background(), test.Request)
+
+			act := Expectation{Code: status.Code(err), Response: resp, DaemonRequest: daemon.Request}
+			if diff := cmp.Diff(test.Expectation, act, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateWorkspaceClass(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	classes := map[string]*config.WorkspaceClass{
+		"small": {
+			Resizable: true,
+			Container: config.ContainerConfiguration{
+				Requests: &config.ResourceRequestConfiguration{CPU: "1", Memory: "2Gi"},
+				Limits:   &config.ResourceLimitConfiguration{CPU: &config.CpuResourceLimit{MinLimit: "1", BurstLimit: "2"}, Memory: "4Gi"},
+			},
+		},
+		"large": {
+			Resizable: true,
+			Container: config.ContainerConfiguration{
+				Requests: &config.ResourceRequestConfiguration{CPU: "4", Memory: "8Gi"},
+				Limits:   &config.ResourceLimitConfiguration{CPU: &config.CpuResourceLimit{MinLimit: "4", BurstLimit: "8"}, Memory: "16Gi"},
+			},
+		},
+		"fixed": {},
+	}
+
+	type Expectation struct {
+		Code             codes.Code
+		Restarting       bool
+		Class            string
+		Annotations      map[string]string
+		RelocationTarget string
+	}
+	tests := []struct {
+		Name             string
+		Request          *api.UpdateWorkspaceClassRequest
+		Phase            workspacev1.WorkspacePhase
+		InPlacePodResize bool
+		Expectation      Expectation
+	}{
+		{
+			Name:             "resize in place",
+			Request:          &api.UpdateWorkspaceClassRequest{Id: "foobar", WorkspaceClass: "large"},
+			Phase:            workspacev1.WorkspacePhaseRunning,
+			InPlacePodResize: true,
+			Expectation: Expectation{
+				Class:       "large",
+				Annotations: map[string]string{"gitpod.io/cpuMinLimit": "4", "gitpod.io/cpuBurstLimit": "8"},
+			},
+		},
+		{
+			Name:    "restart",
+			Request: &api.UpdateWorkspaceClassRequest{Id: "foobar", WorkspaceClass: "large"},
+			Phase:   workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{
+				Restarting:       true,
+				Class:            "large",
+				Annotations:      map[string]string{"gitpod.io/cpuMinLimit": "4", "gitpod.io/cpuBurstLimit": "8"},
+				RelocationTarget: "node-a",
+			},
+		},
+		{
+			Name:             "same class",
+			Request:          &api.UpdateWorkspaceClassRequest{Id: "foobar", WorkspaceClass: "small"},
+			Phase:            workspacev1.WorkspacePhaseRunning,
+			InPlacePodResize: true,
+			Expectation: Expectation{
+				Class:       "small",
+				Annotations: map[string]string{"gitpod.io/cpuMinLimit": "1", "gitpod.io/cpuBurstLimit": "2"},
+			},
+		},
+		{
+			Name:        "class is not resizable",
+			Request:     &api.UpdateWorkspaceClassRequest{Id: "foobar", WorkspaceClass: "fixed"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.FailedPrecondition},
+		},
+		{
+			Name:        "unknown class",
+			Request:     &api.UpdateWorkspaceClassRequest{Id: "foobar", WorkspaceClass: "huge"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+		{
+			Name:        "workspace not running",
+			Request:     &api.UpdateWorkspaceClassRequest{Id: "foobar", WorkspaceClass: "large"},
+			Phase:       workspacev1.WorkspacePhaseStopping,
+			Expectation: Expectation{Code: codes.FailedPrecondition},
+		},
+		{
+			Name:        "missing class",
+			Request:     &api.UpdateWorkspaceClassRequest{Id: "foobar"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(
+				&workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "foobar",
+						Namespace:   "default",
+						Annotations: map[string]string{"gitpod.io/cpuMinLimit": "1", "gitpod.io/cpuBurstLimit": "2"},
+					},
+					Spec: workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular, Class: "small"},
+					Status: workspacev1.WorkspaceStatus{
+						Phase:   test.Phase,
+						Runtime: &workspacev1.WorkspaceRuntimeStatus{NodeName: "node-a"},
+					},
+				},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a", Labels: map[string]string{
+					"gitpod.io/workload_workspace_regular":       "true",
+					"gitpod.io/ws-daemon_ready_ns_default":       "true",
+					"gitpod.io/registry-facade_ready_ns_default": "true",
+				}}},
+			).Build()
+			srv := WorkspaceManagerServer{
+				Client: clnt,
+				Config: &config.Configuration{
+					Namespace:        "default",
+					SecretsNamespace: "default",
+					WorkspaceClasses: classes,
+					InPlacePodResize: test.InPlacePodResize,
+				},
+				maintenance: maintenanceDisabled{},
+			}
+
+			resp, err := srv.UpdateWorkspaceClass(context.Background(), test.Request)
+			act := Expectation{Code: status.Code(err)}
+			if err == nil {
+				act.Restarting = resp.Restarting
+
+				var ws workspacev1.Workspace
+				err = clnt.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "foobar"}, &ws)
+				if err != nil {
+					t.Fatal(err)
+				}
+				act.Class = ws.Spec.Class
+				act.Annotations = ws.Annotations
+				act.RelocationTarget = ws.RelocationTarget()
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetRestartClass(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	type Expectation struct {
+		Code  codes.Code
+		Class string
+	}
+	tests := []struct {
+		Name        string
+		Request     *api.SetRestartClassRequest
+		Phase       workspacev1.WorkspacePhase
+		Marked      bool
+		Expectation Expectation
+	}{
+		{
+			Name:        "stopped workspace",
+			Request:     &api.SetRestartClassRequest{WorkspaceId: "meta-id", WorkspaceClass: "large"},
+			Expectation: Expectation{Class: "large"},
+		},
+		{
+			Name:        "marked workspace",
+			Request:     &api.SetRestartClassRequest{WorkspaceId: "meta-id", WorkspaceClass: "large"},
+			Marked:      true,
+			Expectation: Expectation{Class: "large"},
+		},
+		{
+			Name:        "workspace which has stopped but not been removed",
+			Request:     &api.SetRestartClassRequest{WorkspaceId: "meta-id", WorkspaceClass: "large"},
+			Phase:       workspacev1.WorkspacePhaseStopped,
+			Expectation: Expectation{Class: "large"},
+		},
+		{
+			Name:        "remove mark",
+			Request:     &api.SetRestartClassRequest{WorkspaceId: "meta-id"},
+			Marked:      true,
+			Expectation: Expectation{},
+		},
+		{
+			Name:        "running workspace",
+			Request:     &api.SetRestartClassRequest{WorkspaceId: "meta-id", WorkspaceClass: "large"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.FailedPrecondition},
+		},
+		{
+			Name:        "unknown class",
+			Request:     &api.SetRestartClassRequest{WorkspaceId: "meta-id", WorkspaceClass: "xlarge"},
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+		{
+			Name:        "missing workspace id",
+			Request:     &api.SetRestartClassRequest{WorkspaceClass: "large"},
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var objs []client.Object
+			if test.Phase != "" {
+				objs = append(objs, &workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{Name: "instance-id", Namespace: "default", Labels: map[string]string{wsk8s.WorkspaceIDLabel: "meta-id"}},
+					Status:     workspacev1.WorkspaceStatus{Phase: test.Phase},
+				})
+			}
+			if test.Marked {
+				objs = append(objs, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: restartClassMarkName("meta-id"), Namespace: "default"},
+					Data:       map[string]string{restartClassKey: "small"},
+				})
+			}
+
+			srv := WorkspaceManagerServer{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+				Config: &config.Configuration{
+					Namespace:        "default",
+					WorkspaceClasses: map[string]*config.WorkspaceClass{"small": {}, "large": {}},
+				},
+				maintenance: maintenanceDisabled{},
+			}
+			_, err := srv.SetRestartClass(context.Background(), test.Request)
+
+			act := Expectation{Code: status.Code(err)}
+			var mark corev1.ConfigMap
+			err = srv.Client.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: restartClassMarkName("meta-id")}, &mark)
+			if err == nil && act.Code == codes.OK {
+				act.Class = mark.Data[restartClassKey]
+			} else if err != nil && !errors.IsNotFound(err) {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyRestartClass(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+
+	type Expectation struct {
+		Class  string
+		Marked bool
+	}
+	tests := []struct {
+		Name        string
+		Type        api.WorkspaceType
+		Mark        string
+		Expectation Expectation
+	}{
+		{Name: "no mark", Type: api.WorkspaceType_REGULAR, Expectation: Expectation{Class: "small"}},
+		{Name: "marked", Type: api.WorkspaceType_REGULAR, Mark: "large", Expectation: Expectation{Class: "large", Marked: true}},
+		{Name: "class does not exist anymore", Type: api.WorkspaceType_REGULAR, Mark: "xlarge", Expectation: Expectation{Class: "small", Marked: true}},
+		{Name: "prebuild", Type: api.WorkspaceType_PREBUILD, Mark: "large", Expectation: Expectation{Class: "small"}},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var objs []client.Object
+			if test.Mark != "" {
+				objs = append(objs, &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: restartClassMarkName("meta-id"), Namespace: "default"},
+					Data:       map[string]string{restartClassKey: test.Mark},
+				})
+			}
+			srv := WorkspaceManagerServer{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+				Config: &config.Configuration{
+					Namespace:        "default",
+					WorkspaceClasses: map[string]*config.WorkspaceClass{"small": {}, "large": {}},
+				},
+			}
+			req := &api.StartWorkspaceRequest{
+				Id:       "instance-id",
+				Type:     test.Type,
+				Metadata: &api.WorkspaceMetadata{Owner: "owner", MetaId: "meta-id"},
+				Spec: &api.StartWorkspaceSpec{
+					Class:       "small",
+					Initializer: &csapi.WorkspaceInitializer{Spec: &csapi.WorkspaceInitializer_Backup{Backup: &csapi.FromBackupInitializer{}}},
+				},
+			}
+
+			mark, err := srv.applyRestartClass(context.Background(), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			act := Expectation{Class: req.Spec.Class, Marked: mark != nil}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type fakeDrainNodeServer struct {
+	grpc.ServerStream
+
+	Responses []*api.DrainNodeResponse
+	OnSend    func(resp *api.DrainNodeResponse)
+}
+
+func (f *fakeDrainNodeServer) Context() context.Context {
+	return context.Background()
+}
+
+func (f *fakeDrainNodeServer) Send(resp *api.DrainNodeResponse) error {
+	f.Responses = append(f.Responses, resp)
+	if f.OnSend != nil {
+		f.OnSend(resp)
+	}
+	return nil
+}
+
+func TestStopWorkspace(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	type Expectation struct {
+		Code       codes.Code
+		StopReason api.StopReason
+	}
+	tests := []struct {
+		Name        string
+		Request     *api.StopWorkspaceRequest
+		Conditions  []metav1.Condition
+		Expectation Expectation
+	}{
+		{
+			Name:        "unspecified reason",
+			Request:     &api.StopWorkspaceRequest{Id: "foobar"},
+			Expectation: Expectation{StopReason: api.StopReason_STOP_REASON_USER_REQUESTED},
+		},
+		{
+			Name:        "agent smith",
+			Request:     &api.StopWorkspaceRequest{Id: "foobar", Policy: api.StopWorkspacePolicy_IMMEDIATELY, Reason: api.StopReason_STOP_REASON_AGENT_SMITH},
+			Expectation: Expectation{StopReason: api.StopReason_STOP_REASON_AGENT_SMITH},
+		},
+		{
+			Name:        "admin",
+			Request:     &api.StopWorkspaceRequest{Id: "foobar", Reason: api.StopReason_STOP_REASON_ADMIN_STOPPED},
+			Expectation: Expectation{StopReason: api.StopReason_STOP_REASON_ADMIN_STOPPED},
+		},
+		{
+			Name:        "first reason sticks",
+			Request:     &api.StopWorkspaceRequest{Id: "foobar", Reason: api.StopReason_STOP_REASON_ADMIN_STOPPED},
+			Conditions:  []metav1.Condition{workspacev1.NewWorkspaceConditionStopReason(workspacev1.StopReasonTimeoutInactivity, "timed out")},
+			Expectation: Expectation{StopReason: api.StopReason_STOP_REASON_TIMEOUT_INACTIVITY},
+		},
+		{
+			Name:        "unknown reason",
+			Request:     &api.StopWorkspaceRequest{Id: "foobar", Reason: api.StopReason(42)},
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(
+				&workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+					Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+					Status: workspacev1.WorkspaceStatus{
+						Phase:      workspacev1.WorkspacePhaseRunning,
+						Conditions: test.Conditions,
+					},
+				},
+			).Build()
+			srv := WorkspaceManagerServer{
+				Client:      clnt,
+				Config:      &config.Configuration{Namespace: "default"},
+				maintenance: maintenanceDisabled{},
+			}
+
+			_, err := srv.StopWorkspace(context.Background(), test.Request)
+			act := Expectation{Code: status.Code(err)}
+			if err == nil {
+				var ws workspacev1.Workspace
+				err = clnt.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "foobar"}, &ws)
+				if err != nil {
+					t.Fatal(err)
+				}
+				act.StopReason = srv.extractWorkspaceStatus(&ws).Conditions.StopReason
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExtractWorkspaceStatusRelocation(t *testing.T) {
+	type Expectation struct {
+		Phase               api.WorkspacePhase
+		Relocating          string
+		FinalBackupComplete api.WorkspaceConditionBool
+	}
+	tests := []struct {
+		Name        string
+		Phase       workspacev1.WorkspacePhase
+		Conditions  []metav1.Condition
+		Expectation Expectation
+	}{
+		{
+			Name:        "not relocating",
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Phase: api.WorkspacePhase_RUNNING, FinalBackupComplete: api.WorkspaceConditionBool_FALSE},
+		},
+		{
+			Name:        "relocation requested",
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Conditions:  []metav1.Condition{workspacev1.NewWorkspaceConditionRelocating("node-b")},
+			Expectation: Expectation{Phase: api.WorkspacePhase_INTERRUPTED, Relocating: "node-b", FinalBackupComplete: api.WorkspaceConditionBool_FALSE},
+		},
+		{
+			Name:        "stopping on the source node",
+			Phase:       workspacev1.WorkspacePhaseStopping,
+			Conditions:  []metav1.Condition{workspacev1.NewWorkspaceConditionRelocating("node-b")},
+			Expectation: Expectation{Phase: api.WorkspacePhase_INTERRUPTED, Relocating: "node-b", FinalBackupComplete: api.WorkspaceConditionBool_FALSE},
+		},
+		{
+			Name:        "backed up for the relocation",
+			Phase:       workspacev1.WorkspacePhaseStopped,
+			Conditions:  []metav1.Condition{workspacev1.NewWorkspaceConditionRelocating("node-b"), workspacev1.NewWorkspaceConditionBackupComplete()},
+			Expectation: Expectation{Phase: api.WorkspacePhase_INTERRUPTED, Relocating: "node-b", FinalBackupComplete: api.WorkspaceConditionBool_FALSE},
+		},
+		{
+			Name:        "starting on the target node",
+			Phase:       workspacev1.WorkspacePhaseInitializing,
+			Conditions:  []metav1.Condition{workspacev1.NewWorkspaceConditionRelocating("node-b")},
+			Expectation: Expectation{Phase: api.WorkspacePhase_INTERRUPTED, Relocating: "node-b", FinalBackupComplete: api.WorkspaceConditionBool_FALSE},
+		},
+		{
+			Name:        "relocated",
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Conditions:  []metav1.Condition{workspacev1.NewWorkspaceConditionRelocated()},
+			Expectation: Expectation{Phase: api.WorkspacePhase_RUNNING, FinalBackupComplete: api.WorkspaceConditionBool_FALSE},
+		},
+		{
+			Name:        "cancelled relocation",
+			Phase:       workspacev1.WorkspacePhaseStopping,
+			Conditions:  []metav1.Condition{workspacev1.NewWorkspaceConditionRelocationCancelled("workspace stopped by request")},
+			Expectation: Expectation{Phase: api.WorkspacePhase_STOPPING, FinalBackupComplete: api.WorkspaceConditionBool_FALSE},
+		},
+		{
+			Name:        "failed relocation",
+			Phase:       workspacev1.WorkspacePhaseStopping,
+			Conditions:  []metav1.Condition{workspacev1.NewWorkspaceConditionRelocating("node-b"), workspacev1.NewWorkspaceConditionFailed("cannot restore")},
+			Expectation: Expectation{Phase: api.WorkspacePhase_STOPPING, FinalBackupComplete: api.WorkspaceConditionBool_FALSE},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			srv := WorkspaceManagerServer{Config: &config.Configuration{Namespace: "default"}}
+			ws := &workspacev1.Workspace{
+				ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+				Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+				Status:     workspacev1.WorkspaceStatus{Phase: test.Phase, Conditions: test.Conditions},
+			}
+
+			sts := srv.extractWorkspaceStatus(ws)
+			act := Expectation{
+				Phase:               sts.Phase,
+				Relocating:          sts.Conditions.Relocating,
+				FinalBackupComplete: sts.Conditions.FinalBackupComplete,
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected status (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetLastActivity(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	type Expectation struct {
+		Code        codes.Code
+		HasActivity bool
+		Sources     []api.ActivitySource
+	}
+	tests := []struct {
+		Name        string
+		ID          string
+		MarkActive  []api.ActivitySource
+		Expectation Expectation
+	}{
+		{
+			Name:        "no activity",
+			ID:          "foobar",
+			Expectation: Expectation{},
+		},
+		{
+			Name:       "single source",
+			ID:         "foobar",
+			MarkActive: []api.ActivitySource{api.ActivitySource_ACTIVITY_SOURCE_IDE_HEARTBEAT},
+			Expectation: Expectation{
+				HasActivity: true,
+				Sources:     []api.ActivitySource{api.ActivitySource_ACTIVITY_SOURCE_IDE_HEARTBEAT},
+			},
+		},
+		{
+			Name: "multiple sources are ordered",
+			ID:   "foobar",
+			MarkActive: []api.ActivitySource{
+				api.ActivitySource_ACTIVITY_SOURCE_API_CALL,
+				api.ActivitySource_ACTIVITY_SOURCE_PORT_TRAFFIC,
+				api.ActivitySource_ACTIVITY_SOURCE_API_CALL,
+				api.ActivitySource_ACTIVITY_SOURCE_UNSPECIFIED,
+			},
+			Expectation: Expectation{
+				HasActivity: true,
+				Sources: []api.ActivitySource{
+					api.ActivitySource_ACTIVITY_SOURCE_UNSPECIFIED,
+					api.ActivitySource_ACTIVITY_SOURCE_PORT_TRAFFIC,
+					api.ActivitySource_ACTIVITY_SOURCE_API_CALL,
+				},
+			},
+		},
+		{
+			Name:        "unknown workspace",
+			ID:          "unknown",
+			Expectation: Expectation{Code: codes.NotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(
+				&workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+					Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+					Status:     workspacev1.WorkspaceStatus{Phase: workspacev1.WorkspacePhaseRunning},
+				},
+			).Build()
+			srv := WorkspaceManagerServer{
+				Client:      clnt,
+				Config:      &config.Configuration{Namespace: "default"},
+				maintenance: maintenanceDisabled{},
+			}
+
+			for _, src := range test.MarkActive {
+				_, err := srv.MarkActive(context.Background(), &api.MarkActiveRequest{Id: test.ID, Source: src})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			res, err := srv.GetLastActivity(context.Background(), &api.GetLastActivityRequest{Id: test.ID})
+			act := Expectation{Code: status.Code(err)}
+			if err == nil {
+				act.HasActivity = res.LastActivity != nil
+				for _, s := range res.Sources {
+					act.Sources = append(act.Sources, s.Source)
+					if s.LastActivity == nil {
+						t.Errorf("source %s has no last activity", s.Source)
+					}
+				}
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDrainNode(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	defer func(interval time.Duration) { drainNodePollInterval = interval }(drainNodePollInterval)
+	drainNodePollInterval = 10 * time.Millisecond
+
+	type Expectation struct {
+		Code          codes.Code
+		Responses     []*api.DrainNodeResponse
+		Unschedulable bool
+		Stopped       []string
+	}
+	tests := []struct {
+		Name           string
+		Request        *api.DrainNodeRequest
+		StopWorkspaces bool
+		Expectation    Expectation
+	}{
+		{
+			Name:           "workspaces stop",
+			Request:        &api.DrainNodeRequest{NodeName: "node-a"},
+			StopWorkspaces: true,
+			Expectation: Expectation{
+				Responses: []*api.DrainNodeResponse{
+					{Total: 2, Remaining: []string{"failing-backup", "running"}, Failed: []string{"failing-backup"}},
+					{Total: 2, Failed: []string{"failing-backup"}, Done: true},
+				},
+				Unschedulable: true,
+				Stopped:       []string{"failing-backup", "running"},
+			},
+		},
+		{
+			Name:    "timeout",
+			Request: &api.DrainNodeRequest{NodeName: "node-a", Timeout: "50ms"},
+			Expectation: Expectation{
+				Responses: []*api.DrainNodeResponse{
+					{Total: 2, Remaining: []string{"failing-backup", "running"}, Failed: []string{"failing-backup"}},
+					{Total: 2, Remaining: []string{"failing-backup", "running"}, Failed: []string{"failing-backup"}, Done: true},
+				},
+				Unschedulable: true,
+				Stopped:       []string{"failing-backup", "running"},
+			},
+		},
+		{
+			Name:        "missing node name",
+			Request:     &api.DrainNodeRequest{},
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+		{
+			Name:        "invalid timeout",
+			Request:     &api.DrainNodeRequest{NodeName: "node-a", Timeout: "-1m"},
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+		{
+			Name:        "unknown node",
+			Request:     &api.DrainNodeRequest{NodeName: "node-c"},
+			Expectation: Expectation{Code: codes.NotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			newWorkspace := func(name, node string, phase workspacev1.WorkspacePhase, conditions ...metav1.Condition) *workspacev1.Workspace {
+				return &workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+					Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular},
+					Status: workspacev1.WorkspaceStatus{
+						Phase:      phase,
+						Runtime:    &workspacev1.WorkspaceRuntimeStatus{NodeName: node},
+						Conditions: conditions,
+					},
+				}
+			}
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Workspace{}).WithObjects(
+				newWorkspace("running", "node-a", workspacev1.WorkspacePhaseRunning),
+				newWorkspace("failing-backup", "node-a", workspacev1.WorkspacePhaseStopping,
+					workspacev1.NewWorkspaceConditionStoppedByRequest(stopWorkspaceNormallyGracePeriod.String()),
+					workspacev1.NewWorkspaceConditionBackupFailure("cannot upload backup"),
+				),
+				newWorkspace("stopped", "node-a", workspacev1.WorkspacePhaseStopped),
+				newWorkspace("elsewhere", "node-b", workspacev1.WorkspacePhaseRunning),
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-b"}},
+			).Build()
+			srv := WorkspaceManagerServer{
+				Client:      clnt,
+				Config:      &config.Configuration{Namespace: "default"},
+				maintenance: maintenanceDisabled{},
+			}
+
+			var stopped []string
+			fakeSrv := &fakeDrainNodeServer{}
+			fakeSrv.OnSend = func(resp *api.DrainNodeResponse) {
+				if len(fakeSrv.Responses) > 1 {
+					return
+				}
+				// the workspaces are asked to stop before the first progress report
+				var workspaces workspacev1.WorkspaceList
+				err := clnt.List(context.Background(), &workspaces)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, ws := range workspaces.Items {
+					if ws.IsConditionTrue(workspacev1.WorkspaceConditionStoppedByRequest) {
+						stopped = append(stopped, ws.Name)
+						if test.StopWorkspaces {
+							err = clnt.Delete(context.Background(), &ws)
+							if err != nil {
+								t.Fatal(err)
+							}
+						}
+					}
+				}
+			}
+			err := srv.DrainNode(test.Request, fakeSrv)
+
+			act := Expectation{Code: status.Code(err), Responses: fakeSrv.Responses, Stopped: stopped}
+			if err == nil {
+				var node corev1.Node
+				err = clnt.Get(context.Background(), client.ObjectKey{Name: "node-a"}, &node)
+				if err != nil {
+					t.Fatal(err)
+				}
+				act.Unschedulable = node.Spec.Unschedulable
+			}
+			if diff := cmp.Diff(test.Expectation, act, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExportSnapshot(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	type Expectation struct {
+		Code     codes.Code
+		Response *api.ExportSnapshotResponse
+		Export   *workspacev1.SnapshotExport
+	}
+	tests := []struct {
+		Name        string
+		Request     *api.ExportSnapshotRequest
+		Phase       workspacev1.WorkspacePhase
+		Ephemeral   bool
+		Error       string
+		Expectation Expectation
+	}{
+		{
+			Name:    "exported",
+			Request: &api.ExportSnapshotRequest{Id: "foobar", Tag: "v1"},
+			Phase:   workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{
+				Response: &api.ExportSnapshotResponse{Url: "snapshot.tar@bucket", ImageRef: "registry/snapshots@sha256:abc"},
+				Export:   &workspacev1.SnapshotExport{Tag: "v1"},
+			},
+		},
+		{
+			Name:    "export failed",
+			Request: &api.ExportSnapshotRequest{Id: "foobar", Tag: "v1"},
+			Phase:   workspacev1.WorkspacePhaseRunning,
+			Error:   "cannot push image",
+			Expectation: Expectation{
+				Code:   codes.Internal,
+				Export: &workspacev1.SnapshotExport{Tag: "v1"},
+			},
+		},
+		{
+			Name:        "invalid tag",
+			Request:     &api.ExportSnapshotRequest{Id: "foobar", Tag: "-v1"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.InvalidArgument},
+		},
+		{
+			Name:        "workspace not running",
+			Request:     &api.ExportSnapshotRequest{Id: "foobar"},
+			Phase:       workspacev1.WorkspacePhaseStopping,
+			Expectation: Expectation{Code: codes.FailedPrecondition},
+		},
+		{
+			Name:        "ephemeral workspace",
+			Request:     &api.ExportSnapshotRequest{Id: "foobar"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Ephemeral:   true,
+			Expectation: Expectation{Code: codes.FailedPrecondition},
+		},
+		{
+			Name:        "unknown workspace",
+			Request:     &api.ExportSnapshotRequest{Id: "unknown"},
+			Phase:       workspacev1.WorkspacePhaseRunning,
+			Expectation: Expectation{Code: codes.NotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&workspacev1.Snapshot{}).WithObjects(
+				&workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+					Spec:       workspacev1.WorkspaceSpec{Type: workspacev1.WorkspaceTypeRegular, Ephemeral: test.Ephemeral},
+					Status: workspacev1.WorkspaceStatus{
+						Phase:   test.Phase,
+						Runtime: &workspacev1.WorkspaceRuntimeStatus{NodeName: "node-a"},
+					},
+				},
+			).Build()
+			srv := WorkspaceManagerServer{
+				Client:      clnt,
+				Config:      &config.Configuration{Namespace: "default"},
+				maintenance: maintenanceDisabled{},
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// act as ws-daemon, which completes the snapshot once it was taken and exported
+			var export *workspacev1.SnapshotExport
+			go func() {
+				for ctx.Err() == nil {
+					var snapshots workspacev1.SnapshotList
+					if err := clnt.List(ctx, &snapshots); err != nil || len(snapshots.Items) == 0 {
+						time.Sleep(10 * time.Millisecond)
+						continue
+					}
+					ss := snapshots.Items[0]
+					export = ss.Spec.Export
+					ss.Status = workspacev1.SnapshotStatus{Completed: true, URL: "snapshot.tar@bucket", Error: test.Error}
+					if test.Error == "" {
+						ss.Status.ImageRef = "registry/snapshots@sha256:abc"
+					}
+					_ = clnt.Status().Update(ctx, &ss)
+					return
+				}
+			}()
+
+			resp, err := srv.ExportSnapshot(ctx, test.Request)
+			act := Expectation{Code: status.Code(err), Response: resp, Export: export}
+			if diff := cmp.Diff(test.Expectation, act, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListSnapshots(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	created := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	snapshot := func(name, metaID, owner string, age time.Duration, labels map[string]string, status workspacev1.SnapshotStatus) *workspacev1.Snapshot {
+		return &workspacev1.Snapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+				Labels: map[string]string{
+					wsk8s.WorkspaceIDLabel: metaID,
+					wsk8s.OwnerLabel:       owner,
+				},
+			},
+			Spec: workspacev1.SnapshotSpec{
+				WorkspaceID: "instance-" + metaID,
+				Message:     "snapshot of " + metaID,
+				Labels:      labels,
+			},
+			Status: status,
+		}
+	}
+	info := func(name, metaID, owner string, age time.Duration, labels map[string]string, status workspacev1.SnapshotStatus) *api.SnapshotInfo {
+		return &api.SnapshotInfo{
+			Id:           name,
+			InstanceId:   "instance-" + metaID,
+			MetaId:       metaID,
+			Owner:        owner,
+			Url:          status.URL,
+			Message:      "snapshot of " + metaID,
+			Labels:       labels,
+			CreationTime: timestamppb.New(created.Add(-age)),
+			Size:         status.Size,
+			Completed:    status.Completed,
+			Error:        status.Error,
+		}
+	}
+	var (
+		done    = workspacev1.SnapshotStatus{Completed: true, URL: "a.tar@bucket", Size: 1024}
+		pending = workspacev1.SnapshotStatus{URL: "b.tar@bucket"}
+		failed  = workspacev1.SnapshotStatus{Completed: true, URL: "c.tar@bucket", Error: "could not take snapshot"}
+		release = map[string]string{"purpose": "release"}
+	)
+	objs := []client.Object{
+		snapshot("a", "ws-1", "alice", 2*time.Hour, release, done),
+		snapshot("b", "ws-1", "alice", time.Hour, nil, pending),
+		snapshot("c", "ws-2", "bob", 3*time.Hour, nil, failed),
+	}
+
+	type Expectation struct {
+		Code      codes.Code
+		Snapshots []*api.SnapshotInfo
+	}
+	tests := []struct {
+		Name        string
+		Request     *api.ListSnapshotsRequest
+		Expectation Expectation
+	}{
+		{
+			Name:    "all",
+			Request: &api.ListSnapshotsRequest{},
+			Expectation: Expectation{Snapshots: []*api.SnapshotInfo{
+				info("c", "ws-2", "bob", 3*time.Hour, nil, failed),
+				info("a", "ws-1", "alice", 2*time.Hour, release, done),
+				info("b", "ws-1", "alice", time.Hour, nil, pending),
+			}},
+		},
+		{
+			Name:    "by workspace",
+			Request: &api.ListSnapshotsRequest{MustMatch: &api.MetadataFilter{MetaId: "ws-1"}},
+			Expectation: Expectation{Snapshots: []*api.SnapshotInfo{
+				info("a", "ws-1", "alice", 2*time.Hour, release, done),
+				info("b", "ws-1", "alice", time.Hour, nil, pending),
+			}},
+		},
+		{
+			Name:    "by owner",
+			Request: &api.ListSnapshotsRequest{MustMatch: &api.MetadataFilter{Owner: "bob"}},
+			Expectation: Expectation{Snapshots: []*api.SnapshotInfo{
+				info("c", "ws-2", "bob", 3*time.Hour, nil, failed),
+			}},
+		},
+		{
+			Name:    "by label",
+			Request: &api.ListSnapshotsRequest{MustMatch: &api.MetadataFilter{Owner: "alice", Annotations: release}},
+			Expectation: Expectation{Snapshots: []*api.SnapshotInfo{
+				info("a", "ws-1", "alice", 2*time.Hour, release, done),
+			}},
+		},
+		{
+			Name:        "no match",
+			Request:     &api.ListSnapshotsRequest{MustMatch: &api.MetadataFilter{Owner: "carol"}},
+			Expectation: Expectation{Snapshots: []*api.SnapshotInfo{}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			srv := WorkspaceManagerServer{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+				Config: &config.Configuration{Namespace: "default"},
+			}
+
+			resp, err := srv.ListSnapshots(context.Background(), test.Request)
+			act := Expectation{Code: status.Code(err), Snapshots: resp.GetSnapshots()}
+			if diff := cmp.Diff(test.Expectation, act, protocmp.Transform()); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestControlAdmission(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	type Expectation struct {
+		Code      codes.Code
+		Level     workspacev1.AdmissionLevel
+		HasExpiry bool
+	}
+	tests := []struct {
+		Name        string
+		Request     *api.ControlAdmissionRequest
+		Expectation Expectation
+	}{
+		{
+			Name:        "share",
+			Request:     &api.ControlAdmissionRequest{Id: "foobar", Level: api.AdmissionLevel_ADMIT_EVERYONE},
+			Expectation: Expectation{Level: workspacev1.AdmissionLevelEveryone},
+		},
+		{
+			Name:        "share with expiry",
+			Request:     &api.ControlAdmissionRequest{Id: "foobar", Level: api.AdmissionLevel_ADMIT_EVERYONE, Expiry: "2h"},
+			Expectation: Expectation{Level: workspacev1.AdmissionLevelEveryone, HasExpiry: true},
+		},
+		{
+			Name:        "stop sharing",
+			Request:     &api.ControlAdmissionRequest{Id: "foobar", Level: api.AdmissionLevel_ADMIT_OWNER_ONLY},
+			Expectation: Expectation{Level: workspacev1.AdmissionLevelOwner},
+		},
+		{
+			Name:        "expiry for owner only",
+			Request:     &api.ControlAdmissionRequest{Id: "foobar", Level: api.AdmissionLevel_ADMIT_OWNER_ONLY, Expiry: "2h"},
+			Expectation: Expectation{Code: codes.InvalidArgument, Level: workspacev1.AdmissionLevelEveryone, HasExpiry: true},
+		},
+		{
+			Name:        "invalid expiry",
+			Request:     &api.ControlAdmissionRequest{Id: "foobar", Level: api.AdmissionLevel_ADMIT_EVERYONE, Expiry: "-1h"},
+			Expectation: Expectation{Code: codes.InvalidArgument, Level: workspacev1.AdmissionLevelEveryone, HasExpiry: true},
+		},
+		{
+			Name:        "unknown workspace",
+			Request:     &api.ControlAdmissionRequest{Id: "unknown", Level: api.AdmissionLevel_ADMIT_EVERYONE},
+			Expectation: Expectation{Code: codes.NotFound, Level: workspacev1.AdmissionLevelEveryone, HasExpiry: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			// the workspace is shared with an expiry at first
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+					Spec: workspacev1.WorkspaceSpec{
+						Type: workspacev1.WorkspaceTypeRegular,
+						Admission: workspacev1.AdmissionSpec{
+							Level:  workspacev1.AdmissionLevelEveryone,
+							Expiry: &metav1.Time{Time: time.Now().Add(time.Hour)},
+						},
+					},
+				},
+			).Build()
+			srv := WorkspaceManagerServer{
+				Client: clnt,
+				Config: &config.Configuration{Namespace: "default"},
+			}
+
+			_, err := srv.ControlAdmission(context.Background(), test.Request)
+
+			var ws workspacev1.Workspace
+			if err := clnt.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "foobar"}, &ws); err != nil {
+				t.Fatal(err)
+			}
+			act := Expectation{
+				Code:      status.Code(err),
+				Level:     ws.Spec.Admission.Level,
+				HasExpiry: ws.Spec.Admission.Expiry != nil,
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestControlPort(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(workspacev1.AddToScheme(scheme))
+
+	type Expectation struct {
+		Code  codes.Code
+		Ports []workspacev1.PortSpec
+	}
+	existing := workspacev1.PortSpec{Port: 3000, Visibility: workspacev1.AdmissionLevelOwner, Protocol: workspacev1.PortProtocolHttp}
+	tests := []struct {
+		Name        string
+		Request     *api.ControlPortRequest
+		Expectation Expectation
+	}{
+		{
+			Name: "expose tcp port",
+			Request: &api.ControlPortRequest{Id: "foobar", Expose: true, Spec: &api.PortSpec{
+				Port:       5432,
+				Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC,
+				Protocol:   api.PortProtocol_PORT_PROTOCOL_TCP,
+			}},
+			Expectation: Expectation{Ports: []workspacev1.PortSpec{
+				existing,
+				{Port: 5432, Visibility: workspacev1.AdmissionLevelEveryone, Protocol: workspacev1.PortProtocolTcp},
+			}},
+		},
+		{
+			Name: "change protocol",
+			Request: &api.ControlPortRequest{Id: "foobar", Expose: true, Spec: &api.PortSpec{
+				Port:     3000,
+				Protocol: api.PortProtocol_PORT_PROTOCOL_HTTPS,
+			}},
+			Expectation: Expectation{Ports: []workspacev1.PortSpec{
+				{Port: 3000, Visibility: workspacev1.AdmissionLevelOwner, Protocol: workspacev1.PortProtocolHttps},
+			}},
+		},
+		{
+			Name:        "unexpose port",
+			Request:     &api.ControlPortRequest{Id: "foobar", Spec: &api.PortSpec{Port: 3000}},
+			Expectation: Expectation{Ports: []workspacev1.PortSpec{}},
+		},
+		{
+			Name:        "missing port",
+			Request:     &api.ControlPortRequest{Id: "foobar", Expose: true, Spec: &api.PortSpec{}},
+			Expectation: Expectation{Code: codes.InvalidArgument, Ports: []workspacev1.PortSpec{existing}},
+		},
+		{
+			Name:        "unknown protocol",
+			Request:     &api.ControlPortRequest{Id: "foobar", Expose: true, Spec: &api.PortSpec{Port: 8080, Protocol: 42}},
+			Expectation: Expectation{Code: codes.InvalidArgument, Ports: []workspacev1.PortSpec{existing}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			clnt := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&workspacev1.Workspace{
+					ObjectMeta: metav1.ObjectMeta{Name: "foobar", Namespace: "default"},
+					Spec: workspacev1.WorkspaceSpec{
+						Type:  workspacev1.WorkspaceTypeRegular,
+						Ports: []workspacev1.PortSpec{existing},
+					},
+				},
+			).Build()
+			srv := WorkspaceManagerServer{
+				Client: clnt,
+				Config: &config.Configuration{Namespace: "default"},
+			}
+
+			_, err := srv.ControlPort(context.Background(), test.Request)
+
+			var ws workspacev1.Workspace
+			if err := clnt.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "foobar"}, &ws); err != nil {
+				t.Fatal(err)
+			}
+			act := Expectation{
+				Code:  status.Code(err),
+				Ports: ws.Spec.Ports,
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+// StartRateLimiter limits how often a single owner can start workspaces, so that a misbehaving
+// automation cannot exhaust the capacity of the cluster. Every owner gets their own token bucket.
+type StartRateLimiter struct {
+	cfg config.StartRateLimitConfiguration
+	now func() time.Time
+
+	// mu serialises the creation of buckets, the buckets themselves are safe for concurrent use
+	mu      sync.Mutex
+	buckets *lru.Cache
+
+	starts *prometheus.CounterVec
+}
+
+// NewStartRateLimiter creates a new rate limiter for the given configuration
+func NewStartRateLimiter(cfg config.StartRateLimitConfiguration) (*StartRateLimiter, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	buckets, err := lru.New(cfg.GetKeyCacheSize())
+	if err != nil {
+		return nil, err
+	}
+
+	return &StartRateLimiter{
+		cfg:     cfg,
+		now:     time.Now,
+		buckets: buckets,
+		starts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "ws_manager_mk2",
+			Name:      "start_rate_limiter_total",
+			Help:      "total number of workspace starts checked against the per-owner rate limit",
+		}, []string{"rate_limited"}),
+	}, nil
+}
+
+// Allow takes a token from the bucket of the owner. If the bucket is empty it returns a ResourceExhausted
+// error which tells the caller when to retry.
+func (l *StartRateLimiter) Allow(owner string) error {
+	now := l.now()
+	r := l.bucket(owner).ReserveN(now, 1)
+	delay := r.DelayFrom(now)
+	if delay == 0 {
+		l.starts.WithLabelValues("false").Inc()
+		return nil
+	}
+	// we reject the start, hence must not consume the token
+	r.CancelAt(now)
+	l.starts.WithLabelValues("true").Inc()
+
+	// round up so that retrying after the hint always succeeds
+	retryAfter := delay.Truncate(time.Second)
+	if retryAfter < delay {
+		retryAfter += time.Second
+	}
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("too many workspace starts, retry after %s", retryAfter))
+	st, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return status.Errorf(codes.ResourceExhausted, "too many workspace starts, retry after %s", retryAfter)
+	}
+	return st.Err()
+}
+
+func (l *StartRateLimiter) bucket(owner string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, ok := l.buckets.Get(owner); ok {
+		return b.(*rate.Limiter)
+	}
+	b := rate.NewLimiter(rate.Every(time.Duration(l.cfg.RefillInterval)), int(l.cfg.BucketSize))
+	l.buckets.Add(owner, b)
+	return b
+}
+
+// Describe implements Collector
+func (l *StartRateLimiter) Describe(ch chan<- *prometheus.Desc) {
+	l.starts.Describe(ch)
+}
+
+// Collect implements Collector
+func (l *StartRateLimiter) Collect(ch chan<- prometheus.Metric) {
+	l.starts.Collect(ch)
+}
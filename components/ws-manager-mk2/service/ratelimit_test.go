@@ -0,0 +1,61 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+func TestStartRateLimiter(t *testing.T) {
+	limiter, err := NewStartRateLimiter(config.StartRateLimitConfiguration{
+		BucketSize:     2,
+		RefillInterval: util.Duration(90 * time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if err := limiter.Allow("owner"); err != nil {
+			t.Fatalf("start %d within the burst was rate limited: %v", i, err)
+		}
+	}
+	if err := limiter.Allow("other-owner"); err != nil {
+		t.Fatalf("owners must not share a bucket: %v", err)
+	}
+
+	err = limiter.Allow("owner")
+	if code := status.Code(err); code != codes.ResourceExhausted {
+		t.Fatalf("unexpected status code: %v", code)
+	}
+	var retryAfter time.Duration
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok {
+			retryAfter = info.RetryDelay.AsDuration()
+		}
+	}
+	if retryAfter != 90*time.Second {
+		t.Errorf("unexpected retry delay: %v", retryAfter)
+	}
+
+	// rejected starts must not consume tokens
+	now = now.Add(90 * time.Second)
+	if err := limiter.Allow("owner"); err != nil {
+		t.Errorf("start after refill was rate limited: %v", err)
+	}
+	if err := limiter.Allow("owner"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected start to be rate limited, got %v", err)
+	}
+}
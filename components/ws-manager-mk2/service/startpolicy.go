@@ -0,0 +1,191 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+// StartPolicyInput is the input of the policy decision
+type StartPolicyInput struct {
+	// Request is the StartWorkspaceRequest in its protobuf JSON encoding
+	Request json.RawMessage `json:"request"`
+	// Workspace is what the request resolved to
+	Workspace StartPolicyWorkspace `json:"workspace"`
+	// DryRun is true if the start is only validated
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// StartPolicyWorkspace describes the workspace a start request resolved to
+type StartPolicyWorkspace struct {
+	Owner          string   `json:"owner"`
+	Type           string   `json:"type"`
+	Class          string   `json:"class"`
+	WorkspaceImage string   `json:"workspaceImage,omitempty"`
+	IDEImage       string   `json:"ideImage,omitempty"`
+	Repositories   []string `json:"repositories,omitempty"`
+}
+
+// StartPolicyDecision is the result of the policy decision
+type StartPolicyDecision struct {
+	Allow bool `json:"allow"`
+	// Deny lists the reasons why the start is denied. The start is denied if there is any reason, even if Allow is true.
+	Deny []string `json:"deny,omitempty"`
+	// Class replaces the workspace class of the request if present
+	Class string `json:"class,omitempty"`
+	// Annotations are added to the workspace
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// StartPolicy evaluates an Open Policy Agent decision before workspaces are started. Platform teams use
+// this to restrict which images, classes or repositories can be used, or to adjust the workspace accordingly.
+// OPA is the only supported policy engine.
+type StartPolicy struct {
+	*webhookClient
+}
+
+// NewStartPolicy creates a new policy client for the given configuration
+func NewStartPolicy(cfg config.StartPolicyConfiguration) (*StartPolicy, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newWebhookClient(webhookOptions{
+		Name:          "start policy",
+		Metric:        "start_policy",
+		DecisionsHelp: "total number of workspace starts evaluated against the start policy",
+		DurationHelp:  "time it takes to evaluate the start policy",
+		Buckets:       prometheus.ExponentialBuckets(0.005, 2, 10),
+		URL:           cfg.URL,
+		CA:            cfg.CA,
+		Timeout:       cfg.GetTimeout(),
+		FailurePolicy: cfg.GetFailurePolicy(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &StartPolicy{webhookClient: client}, nil
+}
+
+// Evaluate evaluates the policy for the request and the workspace it resolved to. Modifications of the policy
+// are applied to req, in which case mutated is true and the workspace needs to be resolved again.
+// Denied starts produce a PermissionDenied error.
+func (p *StartPolicy) Evaluate(ctx context.Context, req *wsmanapi.StartWorkspaceRequest, ws *workspacev1.Workspace, dryRun bool) (mutated bool, err error) {
+	decision, err := p.decide(ctx, req, ws, dryRun)
+	if err != nil {
+		return false, p.failed(req, err)
+	}
+
+	if !decision.Allow || len(decision.Deny) > 0 {
+		p.decided(admissionResultRejected)
+		reason := strings.Join(decision.Deny, "; ")
+		if reason == "" {
+			reason = "no reason given"
+		}
+		return false, status.Errorf(codes.PermissionDenied, "workspace start was denied by policy: %s", reason)
+	}
+
+	if decision.Class != "" && decision.Class != req.Spec.Class {
+		req.Spec.Class = decision.Class
+		mutated = true
+	}
+	for k, v := range decision.Annotations {
+		if req.Metadata.Annotations == nil {
+			req.Metadata.Annotations = make(map[string]string)
+		}
+		if cur, ok := req.Metadata.Annotations[k]; ok && cur == v {
+			continue
+		}
+		req.Metadata.Annotations[k] = v
+		mutated = true
+	}
+
+	if mutated {
+		p.decided(admissionResultMutated)
+	} else {
+		p.decided(admissionResultAllowed)
+	}
+	return mutated, nil
+}
+
+func (p *StartPolicy) decide(ctx context.Context, req *wsmanapi.StartWorkspaceRequest, ws *workspacev1.Workspace, dryRun bool) (*StartPolicyDecision, error) {
+	request, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal start request: %w", err)
+	}
+	input := StartPolicyInput{
+		Request:   request,
+		Workspace: newStartPolicyWorkspace(ws),
+		DryRun:    dryRun,
+	}
+
+	// the body and answer follow the OPA data API
+	var resp struct {
+		Result *StartPolicyDecision `json:"result"`
+	}
+	err = p.post(ctx, struct {
+		Input StartPolicyInput `json:"input"`
+	}{Input: input}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Result == nil {
+		// OPA omits the result if the decision is undefined, e.g. because the policy is not loaded
+		return nil, fmt.Errorf("policy decision is undefined")
+	}
+	return resp.Result, nil
+}
+
+func newStartPolicyWorkspace(ws *workspacev1.Workspace) StartPolicyWorkspace {
+	res := StartPolicyWorkspace{
+		Owner:    ws.Spec.Ownership.Owner,
+		Type:     string(ws.Spec.Type),
+		Class:    ws.Spec.Class,
+		IDEImage: ws.Spec.Image.IDE.Web,
+	}
+	if ws.Spec.Image.Workspace.Ref != nil {
+		res.WorkspaceImage = *ws.Spec.Image.Workspace.Ref
+	}
+
+	var init csapi.WorkspaceInitializer
+	err := proto.Unmarshal(ws.Spec.Initializer, &init)
+	if err == nil {
+		res.Repositories = initializerRepositories(&init)
+	}
+	return res
+}
+
+// initializerRepositories returns the remote URIs of all Git repositories the initializer clones
+func initializerRepositories(init *csapi.WorkspaceInitializer) []string {
+	var res []string
+	if git := init.GetGit(); git != nil {
+		res = append(res, git.RemoteUri)
+	}
+	for _, git := range init.GetPrebuild().GetGit() {
+		res = append(res, git.RemoteUri)
+	}
+	for _, git := range init.GetMultiRepo().GetRepositories() {
+		res = append(res, git.RemoteUri)
+	}
+	for _, i := range init.GetComposite().GetInitializer() {
+		res = append(res, initializerRepositories(i)...)
+	}
+	return res
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/utils/pointer"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+	csapi "github.com/gitpod-io/gitpod/content-service/api"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+	workspacev1 "github.com/gitpod-io/gitpod/ws-manager/api/crd/v1"
+)
+
+func TestStartPolicy(t *testing.T) {
+	type Expectation struct {
+		Code        codes.Code
+		Mutated     bool
+		Class       string
+		Annotations map[string]string
+	}
+	tests := []struct {
+		Name          string
+		Handler       func(w http.ResponseWriter, input StartPolicyInput)
+		FailurePolicy config.AdmissionFailurePolicy
+		Expectation   Expectation
+	}{
+		{
+			Name: "allowed",
+			Handler: func(w http.ResponseWriter, input StartPolicyInput) {
+				writeStartPolicyDecision(w, &StartPolicyDecision{Allow: true})
+			},
+			Expectation: Expectation{Code: codes.OK, Class: "default"},
+		},
+		{
+			Name: "denied",
+			Handler: func(w http.ResponseWriter, input StartPolicyInput) {
+				writeStartPolicyDecision(w, &StartPolicyDecision{Allow: true, Deny: []string{"image is not allowed"}})
+			},
+			Expectation: Expectation{Code: codes.PermissionDenied, Class: "default"},
+		},
+		{
+			Name: "not allowed",
+			Handler: func(w http.ResponseWriter, input StartPolicyInput) {
+				writeStartPolicyDecision(w, &StartPolicyDecision{})
+			},
+			Expectation: Expectation{Code: codes.PermissionDenied, Class: "default"},
+		},
+		{
+			Name: "decision on resolved workspace",
+			Handler: func(w http.ResponseWriter, input StartPolicyInput) {
+				exp := StartPolicyWorkspace{
+					Owner:          "owner",
+					Type:           "Regular",
+					Class:          "default",
+					WorkspaceImage: "eu.gcr.io/gitpod/workspace:latest",
+					Repositories:   []string{"https://github.com/gitpod-io/gitpod.git", "https://github.com/gitpod-io/website.git"},
+				}
+				writeStartPolicyDecision(w, &StartPolicyDecision{Allow: cmp.Equal(exp, input.Workspace)})
+			},
+			Expectation: Expectation{Code: codes.OK, Class: "default"},
+		},
+		{
+			Name: "mutated",
+			Handler: func(w http.ResponseWriter, input StartPolicyInput) {
+				writeStartPolicyDecision(w, &StartPolicyDecision{
+					Allow:       true,
+					Class:       "small",
+					Annotations: map[string]string{"policy": "restricted"},
+				})
+			},
+			Expectation: Expectation{Code: codes.OK, Mutated: true, Class: "small", Annotations: map[string]string{"policy": "restricted"}},
+		},
+		{
+			Name: "undefined decision",
+			Handler: func(w http.ResponseWriter, input StartPolicyInput) {
+				writeStartPolicyDecision(w, nil)
+			},
+			Expectation: Expectation{Code: codes.Unavailable, Class: "default"},
+		},
+		{
+			Name:          "undefined decision with ignore policy",
+			FailurePolicy: config.AdmissionFailurePolicyIgnore,
+			Handler: func(w http.ResponseWriter, input StartPolicyInput) {
+				writeStartPolicyDecision(w, nil)
+			},
+			Expectation: Expectation{Code: codes.OK, Class: "default"},
+		},
+		{
+			Name: "slow endpoint",
+			Handler: func(w http.ResponseWriter, input StartPolicyInput) {
+				time.Sleep(500 * time.Millisecond)
+				writeStartPolicyDecision(w, &StartPolicyDecision{Allow: true})
+			},
+			Expectation: Expectation{Code: codes.Unavailable, Class: "default"},
+		},
+	}
+
+	initializer, err := proto.Marshal(&csapi.WorkspaceInitializer{
+		Spec: &csapi.WorkspaceInitializer_Composite{
+			Composite: &csapi.CompositeInitializer{
+				Initializer: []*csapi.WorkspaceInitializer{
+					{Spec: &csapi.WorkspaceInitializer_Git{Git: &csapi.GitInitializer{RemoteUri: "https://github.com/gitpod-io/gitpod.git"}}},
+					{Spec: &csapi.WorkspaceInitializer_Git{Git: &csapi.GitInitializer{RemoteUri: "https://github.com/gitpod-io/website.git"}}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Input StartPolicyInput `json:"input"`
+				}
+				err := json.NewDecoder(r.Body).Decode(&body)
+				if err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				test.Handler(w, body.Input)
+			}))
+			defer srv.Close()
+
+			policy, err := NewStartPolicy(config.StartPolicyConfiguration{
+				URL:           srv.URL,
+				Timeout:       util.Duration(100 * time.Millisecond),
+				FailurePolicy: test.FailurePolicy,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := &wsmanapi.StartWorkspaceRequest{
+				Id:       "foobar",
+				Metadata: &wsmanapi.WorkspaceMetadata{Owner: "owner", MetaId: "meta"},
+				Spec:     &wsmanapi.StartWorkspaceSpec{Class: "default"},
+			}
+			ws := &workspacev1.Workspace{
+				Spec: workspacev1.WorkspaceSpec{
+					Ownership:   workspacev1.Ownership{Owner: "owner", WorkspaceID: "meta"},
+					Type:        workspacev1.WorkspaceTypeRegular,
+					Class:       "default",
+					Image:       workspacev1.WorkspaceImages{Workspace: workspacev1.WorkspaceImage{Ref: pointer.String("eu.gcr.io/gitpod/workspace:latest")}},
+					Initializer: initializer,
+				},
+			}
+
+			mutated, err := policy.Evaluate(context.Background(), req, ws, false)
+
+			act := Expectation{Code: status.Code(err), Mutated: mutated, Class: req.Spec.Class, Annotations: req.Metadata.Annotations}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func writeStartPolicyDecision(w http.ResponseWriter, decision *StartPolicyDecision) {
+	_ = json.NewEncoder(w).Encode(struct {
+		Result *StartPolicyDecision `json:"result,omitempty"`
+	}{Result: decision})
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	wsmanapi "github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-manager/api/config"
+)
+
+const (
+	admissionResultAllowed  = "allowed"
+	admissionResultMutated  = "mutated"
+	admissionResultRejected = "rejected"
+	admissionResultError    = "error"
+	admissionResultIgnored  = "ignored"
+)
+
+// maxWebhookResponseSize limits how much of a webhook response we read
+const maxWebhookResponseSize = 1 << 20
+
+// webhookOptions configures a webhookClient
+type webhookOptions struct {
+	// Name describes the webhook in logs and errors, e.g. "start admission"
+	Name string
+	// Metric is the prefix of the metric names, e.g. "start_admission"
+	Metric        string
+	DecisionsHelp string
+	DurationHelp  string
+	Buckets       []float64
+
+	URL           string
+	CA            string
+	Timeout       time.Duration
+	FailurePolicy config.AdmissionFailurePolicy
+}
+
+// webhookClient POSTs workspace starts to an external endpoint which decides about them. It implements
+// what the start admission and the start policy have in common: the TLS setup, the failure policy and the metrics.
+type webhookClient struct {
+	opts   webhookOptions
+	client *http.Client
+
+	decisions *prometheus.CounterVec
+	duration  prometheus.Histogram
+}
+
+func newWebhookClient(opts webhookOptions) (*webhookClient, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.CA != "" {
+		ca, err := os.ReadFile(opts.CA)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read %s CA: %w", opts.Name, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("%s CA %s contains no certificates", opts.Name, opts.CA)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &webhookClient{
+		opts:   opts,
+		client: &http.Client{Transport: transport, Timeout: opts.Timeout},
+		decisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "gitpod",
+			Subsystem: "ws_manager_mk2",
+			Name:      opts.Metric + "_total",
+			Help:      opts.DecisionsHelp,
+		}, []string{"result"}),
+		duration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "gitpod",
+			Subsystem: "ws_manager_mk2",
+			Name:      opts.Metric + "_duration_seconds",
+			Help:      opts.DurationHelp,
+			Buckets:   opts.Buckets,
+		}),
+	}, nil
+}
+
+// post sends body as JSON to the endpoint and decodes its answer into resp
+func (w *webhookClient) post(ctx context.Context, body interface{}, resp interface{}) error {
+	start := time.Now()
+	defer func() { w.duration.Observe(time.Since(start).Seconds()) }()
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("cannot marshal %s request: %w", w.opts.Name, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, w.opts.Timeout)
+	defer cancel()
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, w.opts.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := w.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s endpoint returned %s", w.opts.Name, httpResp.Status)
+	}
+	err = json.NewDecoder(io.LimitReader(httpResp.Body, maxWebhookResponseSize)).Decode(resp)
+	if err != nil {
+		return fmt.Errorf("cannot decode %s response: %w", w.opts.Name, err)
+	}
+	return nil
+}
+
+// failed applies the failure policy to an error which prevented a decision. It returns nil if the
+// workspace may start anyway.
+func (w *webhookClient) failed(req *wsmanapi.StartWorkspaceRequest, err error) error {
+	owi := log.OWI(req.Metadata.GetOwner(), req.Metadata.GetMetaId(), req.Id)
+	if w.opts.FailurePolicy == config.AdmissionFailurePolicyIgnore {
+		w.decided(admissionResultIgnored)
+		log.WithError(err).WithFields(owi).Warnf("%s failed - starting workspace anyway", w.opts.Name)
+		return nil
+	}
+	w.decided(admissionResultError)
+	log.WithError(err).WithFields(owi).Errorf("%s failed", w.opts.Name)
+	return status.Errorf(codes.Unavailable, "cannot decide about workspace start: %s failed: %v", w.opts.Name, err)
+}
+
+// decided counts a decision
+func (w *webhookClient) decided(result string) {
+	w.decisions.WithLabelValues(result).Inc()
+}
+
+// Describe implements Collector
+func (w *webhookClient) Describe(ch chan<- *prometheus.Desc) {
+	w.decisions.Describe(ch)
+	w.duration.Describe(ch)
+}
+
+// Collect implements Collector
+func (w *webhookClient) Collect(ch chan<- prometheus.Metric) {
+	w.decisions.Collect(ch)
+	w.duration.Collect(ch)
+}
@@ -157,6 +157,14 @@ var runCmd = &cobra.Command{
 			readCAKeyFile()
 		}
 
+		var accessTokens *sshproxy.AccessTokens
+		if cfg.Proxy.SSHAccessTokenSecretFile != "" {
+			accessTokens, err = sshproxy.NewAccessTokens(cfg.Proxy.SSHAccessTokenSecretFile)
+			if err != nil {
+				log.WithError(err).Fatal("cannot configure SSH access tokens")
+			}
+		}
+
 		var signers []ssh.Signer
 		var sshGatewayServer *sshproxy.Server
 		flist, err := os.ReadDir("/mnt/host-key")
@@ -176,7 +184,7 @@ var runCmd = &cobra.Command{
 				signers = append(signers, hostSigner)
 			}
 			if len(signers) > 0 {
-				sshGatewayServer = sshproxy.New(signers, infoprov, heartbeat, caKey)
+				sshGatewayServer = sshproxy.New(signers, infoprov, heartbeat, caKey, accessTokens)
 				l, err := net.Listen("tcp", ":2200")
 				if err != nil {
 					panic(err)
@@ -17,14 +17,20 @@ import (
 	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
 )
 
+// workspaceCookiePrefix returns the prefix of the names of all workspace cookies of an installation.
+func workspaceCookiePrefix(domain string) string {
+	prefix := domain
+	for _, c := range []string{" ", "-", "."} {
+		prefix = strings.ReplaceAll(prefix, c, "_")
+	}
+	return "_" + prefix + "_ws_"
+}
+
 // WorkspaceAuthHandler rejects requests which are not authenticated or authorized to access a workspace.
-func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.MiddlewareFunc {
+// If sessions is not nil, owners who have been authenticated once are admitted to all of their workspaces and ports.
+func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider, sessions *AuthSessions) mux.MiddlewareFunc {
 	return func(h http.Handler) http.Handler {
-		cookiePrefix := domain
-		for _, c := range []string{" ", "-", "."} {
-			cookiePrefix = strings.ReplaceAll(cookiePrefix, c, "_")
-		}
-		cookiePrefix = "_" + cookiePrefix + "_ws_"
+		cookiePrefix := workspaceCookiePrefix(domain)
 
 		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
 			var (
@@ -83,6 +89,15 @@ func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.
 				// port seems to be private - subject it to the same access policy as the workspace itself
 			}
 
+			if sessions != nil && ws.OwnerUserId != "" {
+				if owner, ok := sessions.Owner(req); ok && owner == ws.OwnerUserId {
+					// the owner authenticated for another of their workspaces or ports already
+					h.ServeHTTP(resp, req)
+
+					return
+				}
+			}
+
 			tkn := req.Header.Get("x-gitpod-owner-token")
 			if tkn == "" {
 				cn := fmt.Sprintf("%s%s_owner_", cookiePrefix, ws.InstanceID)
@@ -111,6 +126,12 @@ func WorkspaceAuthHandler(domain string, info common.WorkspaceInfoProvider) mux.
 				return
 			}
 
+			if sessions != nil && ws.OwnerUserId != "" {
+				// share the authentication with all other workspace and port subdomains, such that flows which
+				// redirect there (e.g. OAuth callbacks) don't depend on the owner cookie of that very instance
+				sessions.Start(resp, ws.OwnerUserId)
+			}
+
 			h.ServeHTTP(resp, req)
 		})
 	}
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/gorilla/mux"
@@ -225,7 +226,7 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.Name, func(t *testing.T) {
 			var res testResult
-			handler := WorkspaceAuthHandler(domain, &fixedInfoProvider{Infos: test.Infos})(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			handler := WorkspaceAuthHandler(domain, &fixedInfoProvider{Infos: test.Infos}, nil)(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
 				res.HandlerCalled = true
 				resp.WriteHeader(http.StatusOK)
 			}))
@@ -253,6 +254,167 @@ func TestWorkspaceAuthHandler(t *testing.T) {
 	}
 }
 
+func TestWorkspaceAuthHandlerSession(t *testing.T) {
+	log.Log.Logger.SetLevel(logrus.PanicLevel)
+	type testResult struct {
+		HandlerCalled  bool
+		StatusCode     int
+		SessionStarted bool
+	}
+
+	const (
+		domain        = "test-domain.com"
+		workspaceID   = "workspac-65f4-43c9-bf46-3541b89dca85"
+		instanceID    = "instance-fce1-4ff6-9364-cf6dff0c4ecf"
+		ownerToken    = "owner-token"
+		otherID       = "workspac-ac3c-4ae4-a9b8-3c4c1e4c7e34"
+		otherInstance = "instance-3b27-4a4e-8e49-2c1f3a5a5e61"
+		ownerID       = "owner-user-id"
+		testPort      = 8080
+	)
+	infos := map[string]*common.WorkspaceInfo{
+		workspaceID: {
+			WorkspaceID: workspaceID,
+			InstanceID:  instanceID,
+			OwnerUserId: ownerID,
+			Auth: &api.WorkspaceAuthentication{
+				Admission:  api.AdmissionLevel_ADMIT_OWNER_ONLY,
+				OwnerToken: ownerToken,
+			},
+		},
+		otherID: {
+			WorkspaceID: otherID,
+			InstanceID:  otherInstance,
+			OwnerUserId: ownerID,
+			Auth: &api.WorkspaceAuthentication{
+				Admission:  api.AdmissionLevel_ADMIT_OWNER_ONLY,
+				OwnerToken: "another-owner-token",
+			},
+			Ports: []*api.PortSpec{{Port: testPort, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE}},
+		},
+		"someone-elses": {
+			WorkspaceID: "someone-elses",
+			InstanceID:  "someone-elses-instance",
+			OwnerUserId: "someone-else",
+			Auth: &api.WorkspaceAuthentication{
+				Admission:  api.AdmissionLevel_ADMIT_OWNER_ONLY,
+				OwnerToken: "someone-elses-token",
+			},
+		},
+	}
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sessions := newAuthSessions(&GitpodInstallation{HostName: domain, WorkspaceHostSuffix: ".ws." + domain}, time.Hour, []byte("a-secret-which-is-long-enough-for-sessions"))
+	sessions.now = func() time.Time { return now }
+
+	startSession := func(ownerID string, age time.Duration) string {
+		sessions.now = func() time.Time { return now.Add(-age) }
+		defer func() { sessions.now = func() time.Time { return now } }()
+
+		rr := httptest.NewRecorder()
+		sessions.Start(rr, ownerID)
+		c := rr.Result().Cookies()[0]
+		return c.Value
+	}
+
+	tests := []struct {
+		Name        string
+		WorkspaceID string
+		Port        string
+		OwnerCookie string
+		Session     string
+		Expected    testResult
+	}{
+		{
+			Name:        "owner token starts session",
+			WorkspaceID: workspaceID,
+			OwnerCookie: ownerToken,
+			Expected:    testResult{HandlerCalled: true, StatusCode: http.StatusOK, SessionStarted: true},
+		},
+		{
+			Name:        "wrong owner token starts no session",
+			WorkspaceID: workspaceID,
+			OwnerCookie: "this is the wrong value",
+			Expected:    testResult{StatusCode: http.StatusForbidden},
+		},
+		{
+			Name:        "session admits other workspace of the owner",
+			WorkspaceID: otherID,
+			Session:     startSession(ownerID, 0),
+			Expected:    testResult{HandlerCalled: true, StatusCode: http.StatusOK},
+		},
+		{
+			Name:        "session admits private port of the owner",
+			WorkspaceID: otherID,
+			Port:        strconv.Itoa(testPort),
+			Session:     startSession(ownerID, 0),
+			Expected:    testResult{HandlerCalled: true, StatusCode: http.StatusOK},
+		},
+		{
+			Name:        "session does not admit other users",
+			WorkspaceID: "someone-elses",
+			Session:     startSession(ownerID, 0),
+			Expected:    testResult{StatusCode: http.StatusUnauthorized},
+		},
+		{
+			Name:        "expired session",
+			WorkspaceID: otherID,
+			Session:     startSession(ownerID, 2*time.Hour),
+			Expected:    testResult{StatusCode: http.StatusUnauthorized},
+		},
+		{
+			Name:        "forged session",
+			WorkspaceID: "someone-elses",
+			Session:     strings.Replace(startSession(ownerID, 0), ownerID, "someone-else", 1),
+			Expected:    testResult{StatusCode: http.StatusUnauthorized},
+		},
+		{
+			Name:        "broken session",
+			WorkspaceID: otherID,
+			Session:     "not-a-session",
+			Expected:    testResult{StatusCode: http.StatusUnauthorized},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var res testResult
+			handler := WorkspaceAuthHandler(domain, &fixedInfoProvider{Infos: infos}, sessions)(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+				res.HandlerCalled = true
+				resp.WriteHeader(http.StatusOK)
+			}))
+
+			rr := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://%s/", domain), nil)
+			if test.OwnerCookie != "" {
+				setOwnerTokenCookie(req, domain, infos[test.WorkspaceID].InstanceID, test.OwnerCookie)
+			}
+			if test.Session != "" {
+				req.AddCookie(&http.Cookie{Name: sessions.cookieName, Value: test.Session})
+			}
+			vars := map[string]string{
+				common.WorkspaceIDIdentifier: test.WorkspaceID,
+			}
+			if test.Port != "" {
+				vars[common.WorkspacePortIdentifier] = test.Port
+			}
+			req = mux.SetURLVars(req, vars)
+
+			handler.ServeHTTP(rr, req)
+			res.StatusCode = rr.Code
+			for _, c := range rr.Result().Cookies() {
+				if c.Name == sessions.cookieName && c.Domain == "ws."+domain {
+					res.SessionStarted = true
+				}
+			}
+
+			if diff := cmp.Diff(test.Expected, res); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func setOwnerTokenCookie(r *http.Request, domain, instanceID, token string) {
 	c := ownerTokenCookie(domain, instanceID, token)
 	r.AddCookie(c)
@@ -7,6 +7,10 @@ package proxy
 import (
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 	"golang.org/x/xerrors"
@@ -29,6 +33,20 @@ type Config struct {
 
 	BuiltinPages        BuiltinPagesConfig `json:"builtinPages"`
 	SSHGatewayCAKeyFile string             `json:"sshCAKeyFile"`
+	// SSHAccessTokenSecretFile contains the key server signs short-lived SSH access tokens with.
+	// If empty, the SSH gateway only accepts owner tokens and SSH keys.
+	SSHAccessTokenSecretFile string `json:"sshAccessTokenSecretFile,omitempty"`
+
+	WorkspacePortSecurityHeaders *SecurityHeadersConfig `json:"workspacePortSecurityHeaders,omitempty"`
+
+	// UsageRecords enables periodic records of the network usage of every workspace
+	UsageRecords *UsageRecordsConfig `json:"usageRecords,omitempty"`
+
+	// TLSPassthrough enables forwarding TLS connections to workspace ports based on their server name
+	TLSPassthrough *TLSPassthroughConfig `json:"tlsPassthrough,omitempty"`
+
+	// AuthSession enables auth sessions shared across all workspace and port subdomains
+	AuthSession *AuthSessionConfig `json:"authSession,omitempty"`
 }
 
 // Validate validates the configuration to catch issues during startup and not at runtime.
@@ -41,6 +59,10 @@ func (c *Config) Validate() error {
 		c.BlobServer,
 		c.GitpodInstallation,
 		c.WorkspacePodConfig,
+		c.WorkspacePortSecurityHeaders,
+		c.UsageRecords,
+		c.TLSPassthrough,
+		c.AuthSession,
 	} {
 		err := v.Validate()
 		if err != nil {
@@ -170,6 +192,140 @@ func (c *TransportConfig) Validate() error {
 	)
 }
 
+// SecurityHeaders are the security headers ws-proxy sets on responses from exposed workspace ports.
+// A nil header leaves the header of the workspace's response untouched, an empty header removes it,
+// and any other value replaces it.
+type SecurityHeaders struct {
+	ContentSecurityPolicy   *string `json:"contentSecurityPolicy,omitempty"`
+	XFrameOptions           *string `json:"xFrameOptions,omitempty"`
+	StrictTransportSecurity *string `json:"strictTransportSecurity,omitempty"`
+}
+
+var hstsMaxAgeRegexp = regexp.MustCompile(`(?i)(^|;)\s*max-age=\d+\s*(;|$)`)
+
+// Validate validates the configuration to catch issues during startup and not at runtime.
+func (c *SecurityHeaders) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(c,
+		validation.Field(&c.XFrameOptions, validation.By(func(value interface{}) error {
+			v, _ := value.(*string)
+			if v == nil || *v == "" {
+				return nil
+			}
+			switch strings.ToUpper(*v) {
+			case "DENY", "SAMEORIGIN":
+				return nil
+			default:
+				return xerrors.Errorf("must be DENY or SAMEORIGIN")
+			}
+		})),
+		validation.Field(&c.StrictTransportSecurity, validation.By(func(value interface{}) error {
+			v, _ := value.(*string)
+			if v == nil || *v == "" {
+				return nil
+			}
+			if !hstsMaxAgeRegexp.MatchString(*v) {
+				return xerrors.Errorf("must contain a max-age directive")
+			}
+			return nil
+		})),
+	)
+}
+
+// merge returns a copy of c with all headers that are set in o replaced.
+func (c SecurityHeaders) merge(o *SecurityHeaders) SecurityHeaders {
+	if o == nil {
+		return c
+	}
+	if o.ContentSecurityPolicy != nil {
+		c.ContentSecurityPolicy = o.ContentSecurityPolicy
+	}
+	if o.XFrameOptions != nil {
+		c.XFrameOptions = o.XFrameOptions
+	}
+	if o.StrictTransportSecurity != nil {
+		c.StrictTransportSecurity = o.StrictTransportSecurity
+	}
+	return c
+}
+
+// SecurityHeadersConfig configures the security headers of exposed workspace ports for the installation,
+// and optionally overrides them for individual port numbers.
+type SecurityHeadersConfig struct {
+	Default *SecurityHeaders            `json:"default,omitempty"`
+	Ports   map[string]*SecurityHeaders `json:"ports,omitempty"`
+}
+
+// Validate validates the configuration to catch issues during startup and not at runtime.
+func (c *SecurityHeadersConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	err := c.Default.Validate()
+	if err != nil {
+		return xerrors.Errorf("invalid default security headers: %w", err)
+	}
+	for port, headers := range c.Ports {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return xerrors.Errorf("invalid security headers port %q: %w", port, err)
+		}
+		err = headers.Validate()
+		if err != nil {
+			return xerrors.Errorf("invalid security headers for port %s: %w", port, err)
+		}
+	}
+	return nil
+}
+
+// ForPort returns the security headers for an exposed workspace port. Unless configured otherwise
+// the X-Frame-Options header is removed, such that ports can be embedded in the IDE's preview.
+func (c *SecurityHeadersConfig) ForPort(port string) SecurityHeaders {
+	empty := ""
+	res := SecurityHeaders{XFrameOptions: &empty}
+	if c == nil {
+		return res
+	}
+	return res.merge(c.Default).merge(c.Ports[port])
+}
+
+// UsageRecordsConfig configures the records of the bytes proxied from and to workspaces.
+type UsageRecordsConfig struct {
+	// Interval is how often the usage of every workspace with network traffic is recorded
+	Interval util.Duration `json:"interval"`
+}
+
+// Validate validates the configuration to catch issues during startup and not at runtime.
+func (c *UsageRecordsConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Interval, validation.Required, validation.Min(util.Duration(time.Second))),
+	)
+}
+
+// TLSPassthroughConfig configures the listener which forwards TLS connections to workspace ports without terminating them.
+// Clients select the port using the server name <port>-<workspaceID>.ssl<workspaceHostSuffix>.
+type TLSPassthroughConfig struct {
+	Address string `json:"address"`
+}
+
+// Validate validates the configuration to catch issues during startup and not at runtime.
+func (c *TLSPassthroughConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(c,
+		validation.Field(&c.Address, validation.Required),
+	)
+}
+
 // BuiltinPagesConfig configures pages served directly by ws-proxy.
 type BuiltinPagesConfig struct {
 	Location string `json:"location"`
@@ -124,8 +124,11 @@ func (r *CRDWorkspaceInfoProvider) Reconcile(ctx context.Context, req ctrl.Reque
 		if p.Visibility == workspacev1.AdmissionLevelEveryone {
 			v = wsapi.PortVisibility_PORT_VISIBILITY_PUBLIC
 		}
-		if p.Protocol == workspacev1.PortProtocolHttps {
+		switch p.Protocol {
+		case workspacev1.PortProtocolHttps:
 			protocol = wsapi.PortProtocol_PORT_PROTOCOL_HTTPS
+		case workspacev1.PortProtocolTcp:
+			protocol = wsapi.PortProtocol_PORT_PROTOCOL_TCP
 		}
 		ports = append(ports, &wsapi.PortSpec{
 			Port:       p.Port,
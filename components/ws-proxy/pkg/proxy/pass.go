@@ -242,10 +242,23 @@ func withLongTermCaching() proxyPassOpt {
 	}
 }
 
-func withXFrameOptionsFilter() proxyPassOpt {
+// withSecurityHeaders sets, replaces or removes the configured security headers of the response.
+func withSecurityHeaders(headers SecurityHeaders) proxyPassOpt {
 	return func(cfg *proxyPassConfig) {
 		cfg.appendResponseHandler(func(resp *http.Response, req *http.Request) error {
-			resp.Header.Del("X-Frame-Options")
+			for name, value := range map[string]*string{
+				"Content-Security-Policy":   headers.ContentSecurityPolicy,
+				"X-Frame-Options":           headers.XFrameOptions,
+				"Strict-Transport-Security": headers.StrictTransportSecurity,
+			} {
+				switch {
+				case value == nil:
+				case *value == "":
+					resp.Header.Del(name)
+				default:
+					resp.Header.Set(name, *value)
+				}
+			}
 			return nil
 		})
 	}
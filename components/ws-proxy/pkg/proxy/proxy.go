@@ -10,6 +10,7 @@ import (
 	"crypto/tls"
 	"errors"
 	stdlog "log"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -30,6 +31,7 @@ type WorkspaceProxy struct {
 	WorkspaceRouter       WorkspaceRouter
 	WorkspaceInfoProvider common.WorkspaceInfoProvider
 	SSHGatewayServer      *sshproxy.Server
+	UsageTracker          *UsageTracker
 }
 
 // NewWorkspaceProxy creates a new workspace proxy.
@@ -40,6 +42,7 @@ func NewWorkspaceProxy(ingress HostBasedIngressConfig, config Config, workspaceR
 		WorkspaceRouter:       workspaceRouter,
 		WorkspaceInfoProvider: workspaceInfoProvider,
 		SSHGatewayServer:      sshGatewayServer,
+		UsageTracker:          NewUsageTracker(),
 	}
 }
 
@@ -110,8 +113,25 @@ func (p *WorkspaceProxy) MustServe(ctx context.Context) {
 		}
 	}()
 
+	var tlsPassthrough net.Listener
+	if p.Config.TLSPassthrough != nil {
+		tlsPassthrough, err = net.Listen("tcp", p.Config.TLSPassthrough.Address)
+		if err != nil {
+			log.WithError(err).Fatal("cannot start TLS passthrough")
+		}
+		go p.serveTLSPassthrough(tlsPassthrough)
+	}
+
+	if p.Config.UsageRecords != nil {
+		go p.UsageTracker.Run(ctx, time.Duration(p.Config.UsageRecords.Interval))
+	}
+
 	<-ctx.Done()
 
+	if tlsPassthrough != nil {
+		_ = tlsPassthrough.Close()
+	}
+
 	shutDownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
 	defer cancel()
 
@@ -135,11 +155,17 @@ func (p *WorkspaceProxy) Handler() (http.Handler, error) {
 	})
 
 	// install routes
-	handlerConfig, err := NewRouteHandlerConfig(&p.Config, WithDefaultAuth(p.WorkspaceInfoProvider))
+	sessions, err := NewAuthSessions(p.Config.GitpodInstallation, p.Config.AuthSession)
+	if err != nil {
+		return nil, err
+	}
+	handlerConfig, err := NewRouteHandlerConfig(&p.Config, WithDefaultAuth(p.WorkspaceInfoProvider, sessions))
 	if err != nil {
 		return nil, err
 	}
 	ideRouter, portRouter, foreignRouter := p.WorkspaceRouter(r, p.WorkspaceInfoProvider)
+	ideRouter.Use(p.UsageTracker.Middleware(p.WorkspaceInfoProvider, usageRouteIDE))
+	portRouter.Use(p.UsageTracker.Middleware(p.WorkspaceInfoProvider, usageRoutePort))
 	err = installWorkspaceRoutes(ideRouter, handlerConfig, p.WorkspaceInfoProvider, p.SSHGatewayServer)
 	if err != nil {
 		return nil, err
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io"
 	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -52,10 +53,10 @@ type RouteHandlerConfig struct {
 // RouteHandlerConfigOpt modifies the router handler config.
 type RouteHandlerConfigOpt func(*Config, *RouteHandlerConfig)
 
-// WithDefaultAuth enables workspace access authentication.
-func WithDefaultAuth(infoprov common.WorkspaceInfoProvider) RouteHandlerConfigOpt {
+// WithDefaultAuth enables workspace access authentication. sessions may be nil.
+func WithDefaultAuth(infoprov common.WorkspaceInfoProvider, sessions *AuthSessions) RouteHandlerConfigOpt {
 	return func(config *Config, c *RouteHandlerConfig) {
-		c.WorkspaceAuthHandler = WorkspaceAuthHandler(config.GitpodInstallation.HostName, infoprov)
+		c.WorkspaceAuthHandler = WorkspaceAuthHandler(config.GitpodInstallation.HostName, infoprov, sessions)
 	}
 }
 
@@ -525,6 +526,9 @@ func installDebugWorkspaceRoutes(r *mux.Router, config *RouteHandlerConfig, info
 	return nil
 }
 
+// tcpPortDialTimeout is the time we wait for a workspace port which serves TCP to accept the tunnelled connection
+const tcpPortDialTimeout = 10 * time.Second
+
 // installWorkspacePortRoutes configures routing for exposed ports.
 func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig, infoProvider common.WorkspaceInfoProvider) error {
 	showPortNotFoundPage, err := servePortNotFoundPage(config.Config)
@@ -540,6 +544,15 @@ func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig, infoP
 	// forward request to workspace port
 	r.NewRoute().HandlerFunc(
 		func(rw http.ResponseWriter, r *http.Request) {
+			coords := getWorkspaceCoords(r)
+			if !coords.Debug {
+				workspaceInfo := infoProvider.WorkspaceInfo(coords.ID)
+				if workspacePortProtocol(workspaceInfo, coords.Port) == api.PortProtocol_PORT_PROTOCOL_TCP {
+					tunnelTCPPort(rw, r, workspaceInfo.IPAddress, coords.Port)
+					return
+				}
+			}
+
 			// a work-around for servers which does not respect case-insensitive headers, see https://github.com/gitpod-io/gitpod/issues/4047#issuecomment-856566526
 			for _, name := range []string{"Key", "Extensions", "Accept", "Protocol", "Version"} {
 				values := r.Header["Sec-Websocket-"+name]
@@ -552,7 +565,6 @@ func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig, infoP
 			r.Header.Add("X-Forwarded-Host", r.Host)
 			r.Header.Add("X-Forwarded-Port", "443")
 
-			coords := getWorkspaceCoords(r)
 			if coords.Debug {
 				r.Header.Add("X-WS-Proxy-Debug-Port", coords.Port)
 			}
@@ -562,7 +574,7 @@ func installWorkspacePortRoutes(r *mux.Router, config *RouteHandlerConfig, infoP
 				infoProvider,
 				workspacePodPortResolver,
 				withHTTPErrorHandler(showPortNotFoundPage),
-				withXFrameOptionsFilter(),
+				withSecurityHeaders(config.Config.WorkspacePortSecurityHeaders.ForPort(coords.Port)),
 				func(h *proxyPassConfig) {
 					h.Transport = &http.Transport{
 						TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
@@ -598,21 +610,73 @@ func workspacePodPortResolver(config *Config, infoProvider common.WorkspaceInfoP
 		port = fmt.Sprint(config.WorkspacePodConfig.DebugWorkspaceProxyPort)
 	} else {
 		port = coords.Port
-		prt, err := strconv.ParseUint(port, 10, 16)
-		if err != nil {
-			log.WithField("port", port).WithError(err).Error("cannot convert port to int")
-		} else {
-			for _, p := range workspaceInfo.Ports {
-				if p.Port == uint32(prt) {
-					protocol = p.Protocol
-					break
-				}
-			}
-		}
+		protocol = workspacePortProtocol(workspaceInfo, port)
 	}
 	return buildWorkspacePodURL(protocol, workspaceInfo.IPAddress, port)
 }
 
+// workspacePortProtocol returns the protocol of an exposed workspace port. Ports which aren't exposed are assumed to serve HTTP.
+func workspacePortProtocol(workspaceInfo *common.WorkspaceInfo, port string) api.PortProtocol {
+	prt, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		log.WithField("port", port).WithError(err).Error("cannot convert port to int")
+		return api.PortProtocol_PORT_PROTOCOL_HTTP
+	}
+	if workspaceInfo == nil {
+		return api.PortProtocol_PORT_PROTOCOL_HTTP
+	}
+	for _, p := range workspaceInfo.Ports {
+		if p.Port == uint32(prt) {
+			return p.Protocol
+		}
+	}
+	return api.PortProtocol_PORT_PROTOCOL_HTTP
+}
+
+// tunnelTCPPort forwards a WebSocket connection to a workspace port which serves raw TCP, e.g. a database.
+// Such ports cannot be proxied as HTTP, hence clients need to tunnel them, much like we do for SSH.
+func tunnelTCPPort(rw http.ResponseWriter, r *http.Request, ipAddress, port string) {
+	log := log.WithField("port", port).WithField("remoteAddr", r.RemoteAddr)
+	if !isWebSocketUpgrade(r) {
+		http.Error(rw, "this port serves TCP and is reachable through a WebSocket tunnel only", http.StatusBadRequest)
+		return
+	}
+
+	target, err := net.DialTimeout("tcp", net.JoinHostPort(ipAddress, port), tcpPortDialTimeout)
+	if err != nil {
+		log.WithError(err).Debug("tunnel tcp: cannot connect to workspace port")
+		http.Error(rw, "cannot connect to workspace port", http.StatusBadGateway)
+		return
+	}
+	defer target.Close()
+
+	upgrader := websocket.Upgrader{}
+	wsConn, err := upgrader.Upgrade(rw, r, nil)
+	if err != nil {
+		log.WithError(err).Error("tunnel tcp: upgrade to the WebSocket protocol failed")
+		return
+	}
+	conn, err := gitpod.NewWebsocketConnection(r.Context(), wsConn, func(staleErr error) {
+		log.WithError(staleErr).Error("tunnel tcp: closing stale connection")
+	})
+	if err != nil {
+		log.WithError(err).Error("tunnel tcp: upgrade to the WebSocket protocol failed")
+		return
+	}
+	defer conn.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(target, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, target)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
 // workspacePodSupervisorResolver resolves to the workspace pods Supervisor url from the given request.
 func workspacePodSupervisorResolver(config *Config, infoProvider common.WorkspaceInfoProvider, req *http.Request) (url *url.URL, err error) {
 	coords := getWorkspaceCoords(req)
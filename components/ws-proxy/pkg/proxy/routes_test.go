@@ -24,6 +24,7 @@ import (
 	"github.com/gitpod-io/golang-crypto/ssh"
 	"github.com/google/go-cmp/cmp"
 	"github.com/sirupsen/logrus"
+	"k8s.io/utils/pointer"
 
 	"github.com/gitpod-io/gitpod/common-go/log"
 	"github.com/gitpod-io/gitpod/common-go/util"
@@ -710,6 +711,84 @@ func TestRoutes(t *testing.T) {
 				Body:   "host: 28080-amaranth-smelt-9ba20cc1.test-domain.com\n",
 			},
 		},
+		{
+			Desc: "port GET 200 with install security headers",
+			Config: func() *Config {
+				cfg := config
+				cfg.WorkspacePortSecurityHeaders = &SecurityHeadersConfig{
+					Default: &SecurityHeaders{
+						ContentSecurityPolicy:   pointer.String("frame-ancestors 'self'"),
+						XFrameOptions:           pointer.String("SAMEORIGIN"),
+						StrictTransportSecurity: pointer.String("max-age=31536000"),
+					},
+				}
+				return &cfg
+			}(),
+			Request: modifyRequest(httptest.NewRequest("GET", workspaces[0].Ports[0].Url+"returns-200-with-frame-options-header", nil),
+				addHostHeader,
+				addOwnerToken(domain, workspaces[0].InstanceID, workspaces[0].Auth.OwnerToken),
+			),
+			Targets: &Targets{
+				Port: &Target{
+					Handler: func(w http.ResponseWriter, r *http.Request, requestCount uint8) {
+						w.Header().Add("X-Frame-Options", "deny")
+						fmt.Fprintf(w, "host: %s\n", r.Host)
+						w.WriteHeader(http.StatusOK)
+					},
+				},
+			},
+			Expectation: Expectation{
+				Header: http.Header{
+					"Content-Length":            {"52"},
+					"Content-Security-Policy":   {"frame-ancestors 'self'"},
+					"Content-Type":              {"text/plain; charset=utf-8"},
+					"Strict-Transport-Security": {"max-age=31536000"},
+					"X-Frame-Options":           {"SAMEORIGIN"},
+				},
+				Status: http.StatusOK,
+				Body:   "host: 28080-amaranth-smelt-9ba20cc1.test-domain.com\n",
+			},
+		},
+		{
+			Desc: "port GET 200 with port security headers",
+			Config: func() *Config {
+				cfg := config
+				cfg.WorkspacePortSecurityHeaders = &SecurityHeadersConfig{
+					Default: &SecurityHeaders{
+						ContentSecurityPolicy: pointer.String("frame-ancestors 'self'"),
+					},
+					Ports: map[string]*SecurityHeaders{
+						"28080": {
+							ContentSecurityPolicy: pointer.String(""),
+							XFrameOptions:         pointer.String("DENY"),
+						},
+					},
+				}
+				return &cfg
+			}(),
+			Request: modifyRequest(httptest.NewRequest("GET", workspaces[0].Ports[0].Url+"returns-200-with-csp-header", nil),
+				addHostHeader,
+				addOwnerToken(domain, workspaces[0].InstanceID, workspaces[0].Auth.OwnerToken),
+			),
+			Targets: &Targets{
+				Port: &Target{
+					Handler: func(w http.ResponseWriter, r *http.Request, requestCount uint8) {
+						w.Header().Add("Content-Security-Policy", "default-src 'self'")
+						fmt.Fprintf(w, "host: %s\n", r.Host)
+						w.WriteHeader(http.StatusOK)
+					},
+				},
+			},
+			Expectation: Expectation{
+				Header: http.Header{
+					"Content-Length":  {"52"},
+					"Content-Type":    {"text/plain; charset=utf-8"},
+					"X-Frame-Options": {"DENY"},
+				},
+				Status: http.StatusOK,
+				Body:   "host: 28080-amaranth-smelt-9ba20cc1.test-domain.com\n",
+			},
+		},
 		{
 			Desc:   "debug IDE authorized GE",
 			Config: &config,
@@ -1051,3 +1130,32 @@ func TestSensitiveCookieHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkspacePortProtocol(t *testing.T) {
+	info := &common.WorkspaceInfo{
+		Ports: []*api.PortSpec{
+			{Port: 3000, Protocol: api.PortProtocol_PORT_PROTOCOL_HTTPS},
+			{Port: 5432, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP},
+		},
+	}
+	tests := []struct {
+		Name        string
+		Info        *common.WorkspaceInfo
+		Port        string
+		Expectation api.PortProtocol
+	}{
+		{Name: "https port", Info: info, Port: "3000", Expectation: api.PortProtocol_PORT_PROTOCOL_HTTPS},
+		{Name: "tcp port", Info: info, Port: "5432", Expectation: api.PortProtocol_PORT_PROTOCOL_TCP},
+		{Name: "unexposed port", Info: info, Port: "8080", Expectation: api.PortProtocol_PORT_PROTOCOL_HTTP},
+		{Name: "invalid port", Info: info, Port: "foo", Expectation: api.PortProtocol_PORT_PROTOCOL_HTTP},
+		{Name: "unknown workspace", Port: "5432", Expectation: api.PortProtocol_PORT_PROTOCOL_HTTP},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := workspacePortProtocol(test.Info, test.Port)
+			if act != test.Expectation {
+				t.Errorf("unexpected protocol: want %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
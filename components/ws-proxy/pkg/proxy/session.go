@@ -0,0 +1,143 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"golang.org/x/xerrors"
+
+	"github.com/gitpod-io/gitpod/common-go/util"
+)
+
+// minAuthSessionSecretSize is the minimum number of bytes of the key auth sessions are signed with
+const minAuthSessionSecretSize = 32
+
+// AuthSessionConfig configures auth sessions which are shared across all workspace and port subdomains
+// of an installation. Once the owner of a workspace has been authenticated with the owner token of one of
+// their workspaces, ws-proxy admits them to all of their workspaces and private ports until the session expires.
+type AuthSessionConfig struct {
+	// SecretFile contains the key sessions are signed with. All ws-proxy replicas must use the same key.
+	SecretFile string `json:"secretFile"`
+	// Lifetime is how long a session is valid after it was started
+	Lifetime util.Duration `json:"lifetime"`
+}
+
+// Validate validates the configuration to catch issues during startup and not at runtime.
+func (c *AuthSessionConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	return validation.ValidateStruct(c,
+		validation.Field(&c.SecretFile, validation.Required),
+		validation.Field(&c.Lifetime, validation.Required, validation.Min(util.Duration(time.Minute))),
+	)
+}
+
+// AuthSessions issues and verifies the install-level auth session cookie.
+type AuthSessions struct {
+	cookieName string
+	domain     string
+	lifetime   time.Duration
+	secret     []byte
+
+	now func() time.Time
+}
+
+// NewAuthSessions creates a new session issuer from the configuration. If cfg is nil, sessions are disabled
+// and nil is returned.
+func NewAuthSessions(installation *GitpodInstallation, cfg *AuthSessionConfig) (*AuthSessions, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	secret, err := os.ReadFile(cfg.SecretFile)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read auth session secret: %w", err)
+	}
+	secret = bytes.TrimSpace(secret)
+	if len(secret) < minAuthSessionSecretSize {
+		return nil, xerrors.Errorf("auth session secret must be at least %d bytes long", minAuthSessionSecretSize)
+	}
+
+	return newAuthSessions(installation, time.Duration(cfg.Lifetime), secret), nil
+}
+
+func newAuthSessions(installation *GitpodInstallation, lifetime time.Duration, secret []byte) *AuthSessions {
+	return &AuthSessions{
+		cookieName: workspaceCookiePrefix(installation.HostName) + "session_",
+		// the session covers every workspace and port subdomain, e.g. <port>-<workspaceID>.ws.gitpod.io
+		domain:   strings.TrimPrefix(installation.WorkspaceHostSuffix, "."),
+		lifetime: lifetime,
+		secret:   secret,
+		now:      time.Now,
+	}
+}
+
+// Owner returns the ID of the user a request has a valid session for.
+func (s *AuthSessions) Owner(req *http.Request) (ownerID string, ok bool) {
+	c, err := req.Cookie(s.cookieName)
+	if err != nil {
+		return "", false
+	}
+
+	// the value is <ownerID>.<expiry>.<signature>
+	payload, sig, ok := cutLast(c.Value, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.sign(payload))) {
+		return "", false
+	}
+	ownerID, exp, ok := cutLast(payload, ".")
+	if !ok || ownerID == "" {
+		return "", false
+	}
+	expiry, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || s.now().Unix() >= expiry {
+		return "", false
+	}
+
+	return ownerID, true
+}
+
+// Start sets the session cookie for a user on the response.
+func (s *AuthSessions) Start(resp http.ResponseWriter, ownerID string) {
+	expiry := s.now().Add(s.lifetime)
+	payload := ownerID + "." + strconv.FormatInt(expiry.Unix(), 10)
+
+	http.SetCookie(resp, &http.Cookie{
+		Name:     s.cookieName,
+		Value:    payload + "." + s.sign(payload),
+		Path:     "/",
+		Domain:   s.domain,
+		Expires:  expiry,
+		MaxAge:   int(s.lifetime.Seconds()),
+		Secure:   true,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (s *AuthSessions) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	_, _ = mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
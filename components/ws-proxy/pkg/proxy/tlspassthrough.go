@@ -0,0 +1,154 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+// tlsPassthroughHelloTimeout is how long we wait for a client to send its TLS ClientHello
+const tlsPassthroughHelloTimeout = 10 * time.Second
+
+var errClientHelloRead = errors.New("client hello read")
+
+// serveTLSPassthrough accepts TLS connections and forwards them to the workspace port their server name points to.
+// We never terminate TLS, the workspace port does, which lets clients speak protocols other than HTTP over TLS, e.g. PostgreSQL or MQTT.
+func (p *WorkspaceProxy) serveTLSPassthrough(ln net.Listener) {
+	match := matchTLSPassthroughServerName(p.Config.GitpodInstallation.WorkspaceHostSuffix)
+	for {
+		conn, err := ln.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		}
+		if err != nil {
+			log.WithError(err).Warn("tls passthrough: cannot accept connection")
+			continue
+		}
+
+		go p.forwardTLSPassthrough(conn, match)
+	}
+}
+
+func (p *WorkspaceProxy) forwardTLSPassthrough(conn net.Conn, match func(serverName string) (workspaceID, port string, ok bool)) {
+	defer conn.Close()
+	log := log.WithField("remoteAddr", conn.RemoteAddr().String())
+
+	_ = conn.SetReadDeadline(time.Now().Add(tlsPassthroughHelloTimeout))
+	serverName, hello, err := readServerName(conn)
+	if err != nil {
+		log.WithError(err).Debug("tls passthrough: cannot read client hello")
+		return
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	workspaceID, port, ok := match(serverName)
+	if !ok {
+		log.WithField("serverName", serverName).Debug("tls passthrough: server name does not point to a workspace port")
+		return
+	}
+	log = log.WithField("workspaceId", workspaceID).WithField("port", port)
+
+	info := p.WorkspaceInfoProvider.WorkspaceInfo(workspaceID)
+	if info == nil || !info.IsRunning {
+		log.Debug("tls passthrough: workspace is not running")
+		return
+	}
+	// We cannot authenticate the owner without terminating TLS, hence only public ports are reachable.
+	if !isTLSPassthroughPort(info, port) {
+		log.Debug("tls passthrough: port is not a public TCP port")
+		return
+	}
+
+	target, err := net.DialTimeout("tcp", net.JoinHostPort(info.IPAddress, port), tcpPortDialTimeout)
+	if err != nil {
+		log.WithError(err).Debug("tls passthrough: cannot connect to workspace port")
+		return
+	}
+	defer target.Close()
+
+	// the workspace port needs to see the ClientHello we have consumed already
+	_, err = target.Write(hello)
+	if err != nil {
+		log.WithError(err).Debug("tls passthrough: cannot forward client hello")
+		return
+	}
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(target, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(conn, target)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// matchTLSPassthroughServerName returns a function which extracts the workspace ID and port
+// from server names of the form <port>-<workspaceID>.ssl<wsHostSuffix>.
+func matchTLSPassthroughServerName(wsHostSuffix string) func(serverName string) (workspaceID, port string, ok bool) {
+	r := regexp.MustCompile("^" + workspacePortRegex + workspaceIDRegex + `\.ssl` + wsHostSuffix + "$")
+	return func(serverName string) (workspaceID, port string, ok bool) {
+		matches := r.FindStringSubmatch(serverName)
+		if matches == nil {
+			return "", "", false
+		}
+		return matches[r.SubexpIndex(common.WorkspaceIDIdentifier)], matches[r.SubexpIndex(common.WorkspacePortIdentifier)], true
+	}
+}
+
+func isTLSPassthroughPort(info *common.WorkspaceInfo, port string) bool {
+	prt, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return false
+	}
+	for _, p := range info.Ports {
+		if p.Port == uint32(prt) {
+			return p.Protocol == api.PortProtocol_PORT_PROTOCOL_TCP && p.Visibility == api.PortVisibility_PORT_VISIBILITY_PUBLIC
+		}
+	}
+	return false
+}
+
+// readServerName reads the TLS ClientHello from conn without answering it. It returns the server name the client asked for,
+// and the bytes it has read which need forwarding ahead of the remainder of the connection.
+func readServerName(conn net.Conn) (serverName string, hello []byte, err error) {
+	var (
+		buf  bytes.Buffer
+		read bool
+	)
+	err = tls.Server(readOnlyConn{Conn: conn, r: io.TeeReader(conn, &buf)}, &tls.Config{
+		GetConfigForClient: func(info *tls.ClientHelloInfo) (*tls.Config, error) {
+			serverName = info.ServerName
+			read = true
+			return nil, errClientHelloRead
+		},
+	}).Handshake()
+	if !read {
+		return "", nil, err
+	}
+	return serverName, buf.Bytes(), nil
+}
+
+// readOnlyConn lets crypto/tls read the ClientHello, but drops everything it would send to the client
+type readOnlyConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c readOnlyConn) Read(p []byte) (int, error)  { return c.r.Read(p) }
+func (c readOnlyConn) Write(p []byte) (int, error) { return 0, io.ErrClosedPipe }
@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"crypto/tls"
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/gitpod-io/gitpod/ws-manager/api"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+func TestMatchTLSPassthroughServerName(t *testing.T) {
+	type Expectation struct {
+		WorkspaceID string
+		Port        string
+		OK          bool
+	}
+	tests := []struct {
+		Name        string
+		ServerName  string
+		Expectation Expectation
+	}{
+		{Name: "port", ServerName: "5432-amaranth-smelt-9ba20cc1.ssl.ws.gitpod.io", Expectation: Expectation{WorkspaceID: "amaranth-smelt-9ba20cc1", Port: "5432", OK: true}},
+		{Name: "no port", ServerName: "amaranth-smelt-9ba20cc1.ssl.ws.gitpod.io"},
+		{Name: "no ssl", ServerName: "5432-amaranth-smelt-9ba20cc1.ws.gitpod.io"},
+		{Name: "other suffix", ServerName: "5432-amaranth-smelt-9ba20cc1.ssl.ws.gitpod.io.evil.com"},
+		{Name: "empty", ServerName: ""},
+	}
+	match := matchTLSPassthroughServerName(".ws.gitpod.io")
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var act Expectation
+			act.WorkspaceID, act.Port, act.OK = match(test.ServerName)
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected match (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTLSPassthroughPort(t *testing.T) {
+	info := &common.WorkspaceInfo{
+		Ports: []*api.PortSpec{
+			{Port: 5432, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC},
+			{Port: 1883, Protocol: api.PortProtocol_PORT_PROTOCOL_TCP, Visibility: api.PortVisibility_PORT_VISIBILITY_PRIVATE},
+			{Port: 3000, Protocol: api.PortProtocol_PORT_PROTOCOL_HTTPS, Visibility: api.PortVisibility_PORT_VISIBILITY_PUBLIC},
+		},
+	}
+	tests := []struct {
+		Name        string
+		Port        string
+		Expectation bool
+	}{
+		{Name: "public tcp port", Port: "5432", Expectation: true},
+		{Name: "private tcp port", Port: "1883"},
+		{Name: "http port", Port: "3000"},
+		{Name: "unexposed port", Port: "8080"},
+		{Name: "invalid port", Port: "foo"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := isTLSPassthroughPort(info, test.Port)
+			if act != test.Expectation {
+				t.Errorf("unexpected result: want %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
+
+func TestReadServerName(t *testing.T) {
+	const serverName = "5432-amaranth-smelt-9ba20cc1.ssl.ws.gitpod.io"
+
+	client, server := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+	go func() {
+		_ = tls.Client(client, &tls.Config{ServerName: serverName}).Handshake()
+	}()
+
+	act, hello, err := readServerName(server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if act != serverName {
+		t.Errorf("unexpected server name: want %s, got %s", serverName, act)
+	}
+	// the ClientHello must be forwarded as is, starting with the TLS handshake record header
+	if len(hello) < 5 || hello[0] != 0x16 {
+		t.Errorf("unexpected client hello: %x", hello)
+	}
+}
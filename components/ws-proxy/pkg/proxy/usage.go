@@ -0,0 +1,250 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/xerrors"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/gitpod-io/gitpod/common-go/analytics"
+	"github.com/gitpod-io/gitpod/common-go/log"
+	tracker "github.com/gitpod-io/gitpod/ws-proxy/pkg/analytics"
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+const (
+	// usageRouteIDE marks traffic to the IDE and supervisor of a workspace
+	usageRouteIDE = "ide"
+	// usageRoutePort marks traffic to an exposed workspace port
+	usageRoutePort = "port"
+)
+
+var transferredBytesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "gitpod_ws_proxy_workspace_transferred_bytes_total",
+	Help: "Total number of bytes proxied from and to workspaces",
+}, []string{"direction", "route"})
+
+func init() {
+	metrics.Registry.MustRegister(transferredBytesTotal)
+}
+
+// UsageRecord is the network usage of a workspace port during one reporting interval
+type UsageRecord struct {
+	WorkspaceID string
+	InstanceID  string
+	OwnerID     string
+	// Port is the exposed workspace port, or empty for traffic to the IDE
+	Port string
+
+	// Received is the number of bytes sent by clients to the workspace
+	Received int64
+	// Sent is the number of bytes sent by the workspace to clients
+	Sent int64
+}
+
+type usageKey struct {
+	WorkspaceID string
+	InstanceID  string
+	OwnerID     string
+	Port        string
+}
+
+type usageCounter struct {
+	received atomic.Int64
+	sent     atomic.Int64
+}
+
+// UsageTracker accounts the bytes proxied per workspace and port, such that network usage can be attributed
+// to workspaces. All bytes are counted in Prometheus metrics, the per-workspace usage is reported periodically.
+type UsageTracker struct {
+	mu    sync.Mutex
+	usage map[usageKey]*usageCounter
+}
+
+// NewUsageTracker creates a new usage tracker
+func NewUsageTracker() *UsageTracker {
+	return &UsageTracker{
+		usage: make(map[usageKey]*usageCounter),
+	}
+}
+
+// Middleware counts the bytes of all requests and responses, including upgraded connections, handled by the router.
+func (t *UsageTracker) Middleware(infoProvider common.WorkspaceInfoProvider, route string) mux.MiddlewareFunc {
+	var (
+		receivedTotal = transferredBytesTotal.WithLabelValues("received", route)
+		sentTotal     = transferredBytesTotal.WithLabelValues("sent", route)
+	)
+	return func(h http.Handler) http.Handler {
+		if t == nil {
+			return h
+		}
+		return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			coords := getWorkspaceCoords(req)
+			key := usageKey{WorkspaceID: coords.ID, Port: coords.Port}
+			if info := infoProvider.WorkspaceInfo(coords.ID); info != nil {
+				key.InstanceID = info.InstanceID
+				key.OwnerID = info.OwnerUserId
+			}
+			c := t.counter(key)
+			received := func(n int) {
+				c.received.Add(int64(n))
+				receivedTotal.Add(float64(n))
+			}
+			sent := func(n int) {
+				c.sent.Add(int64(n))
+				sentTotal.Add(float64(n))
+			}
+
+			if req.Body != nil && req.Body != http.NoBody {
+				req.Body = &countingReadCloser{ReadCloser: req.Body, count: received}
+			}
+			h.ServeHTTP(&countingResponseWriter{ResponseWriter: resp, received: received, sent: sent}, req)
+		})
+	}
+}
+
+func (t *UsageTracker) counter(key usageKey) *usageCounter {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	c, ok := t.usage[key]
+	if !ok {
+		c = &usageCounter{}
+		t.usage[key] = c
+	}
+	return c
+}
+
+// Flush returns the usage since the last flush. Workspaces without traffic are left out.
+func (t *UsageTracker) Flush() []UsageRecord {
+	t.mu.Lock()
+	usage := t.usage
+	t.usage = make(map[usageKey]*usageCounter, len(usage))
+	t.mu.Unlock()
+
+	res := make([]UsageRecord, 0, len(usage))
+	for key, c := range usage {
+		r := UsageRecord{
+			WorkspaceID: key.WorkspaceID,
+			InstanceID:  key.InstanceID,
+			OwnerID:     key.OwnerID,
+			Port:        key.Port,
+			// connections which are still open may count against the counter a little longer, which we accept
+			Received: c.received.Load(),
+			Sent:     c.sent.Load(),
+		}
+		if r.Received == 0 && r.Sent == 0 {
+			continue
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+// Run reports the usage of every workspace once per interval until the context is canceled.
+func (t *UsageTracker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.report(interval)
+			return
+		case <-ticker.C:
+			t.report(interval)
+		}
+	}
+}
+
+func (t *UsageTracker) report(interval time.Duration) {
+	for _, r := range t.Flush() {
+		log.WithFields(log.OWI(r.OwnerID, r.WorkspaceID, r.InstanceID)).WithField("port", r.Port).WithField("received", r.Received).WithField("sent", r.Sent).Debug("workspace network usage")
+		tracker.Track(analytics.TrackMessage{
+			Identity: analytics.Identity{UserID: r.OwnerID},
+			Event:    "workspace_network_usage",
+			Properties: map[string]interface{}{
+				"workspaceId":     r.WorkspaceID,
+				"instanceId":      r.InstanceID,
+				"port":            r.Port,
+				"bytesReceived":   r.Received,
+				"bytesSent":       r.Sent,
+				"intervalSeconds": int64(interval.Seconds()),
+			},
+		})
+	}
+}
+
+type countingReadCloser struct {
+	io.ReadCloser
+	count func(int)
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.count(n)
+	return n, err
+}
+
+// countingResponseWriter counts the bytes of the response. Upgraded connections, e.g. websockets, are counted
+// in both directions once they were hijacked.
+type countingResponseWriter struct {
+	http.ResponseWriter
+	received func(int)
+	sent     func(int)
+}
+
+func (w *countingResponseWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	w.sent(n)
+	return n, err
+}
+
+func (w *countingResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *countingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, brw, err := http.NewResponseController(w.ResponseWriter).Hijack()
+	if err != nil {
+		return nil, nil, xerrors.Errorf("cannot hijack connection: %w", err)
+	}
+	return &countingConn{Conn: conn, received: w.received, sent: w.sent}, brw, nil
+}
+
+func (w *countingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+type countingConn struct {
+	net.Conn
+	received func(int)
+	sent     func(int)
+}
+
+func (c *countingConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.received(n)
+	return n, err
+}
+
+func (c *countingConn) Write(p []byte) (int, error) {
+	n, err := c.Conn.Write(p)
+	c.sent(n)
+	return n, err
+}
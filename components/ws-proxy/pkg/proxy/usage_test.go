@@ -0,0 +1,133 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/gorilla/mux"
+
+	"github.com/gitpod-io/gitpod/ws-proxy/pkg/common"
+)
+
+func TestUsageTracker(t *testing.T) {
+	infoProvider := &fakeWsInfoProvider{infos: []common.WorkspaceInfo{
+		{WorkspaceID: "amaranth-smelt-9ba20cc1", InstanceID: "instance-id", OwnerUserId: "owner-id"},
+	}}
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = fmt.Fprintf(w, "you said %s", body)
+	})
+
+	type Request struct {
+		Route string
+		Vars  map[string]string
+		Body  string
+	}
+	tests := []struct {
+		Name        string
+		Requests    []Request
+		Expectation []UsageRecord
+	}{
+		{
+			Name:        "no traffic",
+			Expectation: []UsageRecord{},
+		},
+		{
+			Name: "ide and ports",
+			Requests: []Request{
+				{Route: usageRouteIDE, Vars: map[string]string{common.WorkspaceIDIdentifier: "amaranth-smelt-9ba20cc1"}, Body: "hello"},
+				{Route: usageRoutePort, Vars: map[string]string{common.WorkspaceIDIdentifier: "amaranth-smelt-9ba20cc1", common.WorkspacePortIdentifier: "3000"}, Body: "foo"},
+				{Route: usageRoutePort, Vars: map[string]string{common.WorkspaceIDIdentifier: "amaranth-smelt-9ba20cc1", common.WorkspacePortIdentifier: "3000"}, Body: "bar"},
+			},
+			Expectation: []UsageRecord{
+				{WorkspaceID: "amaranth-smelt-9ba20cc1", InstanceID: "instance-id", OwnerID: "owner-id", Received: 5, Sent: 14},
+				{WorkspaceID: "amaranth-smelt-9ba20cc1", InstanceID: "instance-id", OwnerID: "owner-id", Port: "3000", Received: 6, Sent: 24},
+			},
+		},
+		{
+			Name: "unknown workspace",
+			Requests: []Request{
+				{Route: usageRoutePort, Vars: map[string]string{common.WorkspaceIDIdentifier: "blue-whale-1a2b3c4d", common.WorkspacePortIdentifier: "8080"}},
+			},
+			Expectation: []UsageRecord{
+				{WorkspaceID: "blue-whale-1a2b3c4d", Port: "8080", Sent: 9},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			tracker := NewUsageTracker()
+			for _, r := range test.Requests {
+				req := httptest.NewRequest(http.MethodPost, "https://example.com/", strings.NewReader(r.Body))
+				req = mux.SetURLVars(req, r.Vars)
+				tracker.Middleware(infoProvider, r.Route)(echo).ServeHTTP(httptest.NewRecorder(), req)
+			}
+
+			act := tracker.Flush()
+			if diff := cmp.Diff(test.Expectation, act, cmpopts.SortSlices(func(a, b UsageRecord) bool { return a.Port < b.Port })); diff != "" {
+				t.Errorf("unexpected usage (-want +got):\n%s", diff)
+			}
+			if act := tracker.Flush(); len(act) != 0 {
+				t.Errorf("usage was not reset: %v", act)
+			}
+		})
+	}
+}
+
+func TestUsageTrackerHijackedConnection(t *testing.T) {
+	tracker := NewUsageTracker()
+	done := make(chan struct{})
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(done)
+		conn, _, err := http.NewResponseController(w).Hijack()
+		if err != nil {
+			t.Errorf("cannot hijack connection: %v", err)
+			return
+		}
+		defer conn.Close()
+
+		_, _ = conn.Write([]byte("pong"))
+		_, _ = io.ReadFull(conn, make([]byte, 4))
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = mux.SetURLVars(r, map[string]string{common.WorkspaceIDIdentifier: "amaranth-smelt-9ba20cc1", common.WorkspacePortIdentifier: "3000"})
+		tracker.Middleware(&fakeWsInfoProvider{}, usageRoutePort)(handler).ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	conn, err := net.Dial("tcp", srv.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte("GET / HTTP/1.1\r\nHost: example.com\r\nConnection: Upgrade\r\nUpgrade: websocket\r\n\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = io.ReadFull(conn, make([]byte, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = conn.Write([]byte("ping"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+
+	expectation := []UsageRecord{{WorkspaceID: "amaranth-smelt-9ba20cc1", Port: "3000", Received: 4, Sent: 4}}
+	if diff := cmp.Diff(expectation, tracker.Flush()); diff != "" {
+		t.Errorf("unexpected usage (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// minAccessTokenSecretSize is the minimum number of bytes of the key SSH access tokens are signed with
+const minAccessTokenSecretSize = 32
+
+// AccessTokens verifies the short-lived SSH access tokens server issues. A token grants SSH access
+// to a single workspace instance until it expires. Unlike the owner token, it is of no use to ws-proxy's
+// HTTP routes.
+type AccessTokens struct {
+	secret []byte
+
+	now func() time.Time
+}
+
+// NewAccessTokens reads the key SSH access tokens are signed with. Server must sign tokens with the same key.
+func NewAccessTokens(secretFile string) (*AccessTokens, error) {
+	secret, err := os.ReadFile(secretFile)
+	if err != nil {
+		return nil, xerrors.Errorf("cannot read SSH access token secret: %w", err)
+	}
+	secret = bytes.TrimSpace(secret)
+	if len(secret) < minAccessTokenSecretSize {
+		return nil, xerrors.Errorf("SSH access token secret must be at least %d bytes long", minAccessTokenSecretSize)
+	}
+
+	return &AccessTokens{secret: secret, now: time.Now}, nil
+}
+
+// Verify returns true if token is a valid, unexpired access token for the workspace instance.
+// Verify is safe to call on a nil receiver, in which case no token is valid.
+func (t *AccessTokens) Verify(token, workspaceID, instanceID string) bool {
+	if t == nil || workspaceID == "" || instanceID == "" {
+		return false
+	}
+
+	// the token is <expiry>.<signature>
+	exp, sig, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	if !hmac.Equal([]byte(sig), []byte(t.sign(workspaceID, instanceID, exp))) {
+		return false
+	}
+	expiry, err := strconv.ParseInt(exp, 10, 64)
+	if err != nil || t.now().Unix() >= expiry {
+		return false
+	}
+	return true
+}
+
+func (t *AccessTokens) sign(workspaceID, instanceID, expiry string) string {
+	mac := hmac.New(sha256.New, t.secret)
+	_, _ = mac.Write([]byte("ssh." + workspaceID + "." + instanceID + "." + expiry))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
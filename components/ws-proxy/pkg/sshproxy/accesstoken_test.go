@@ -0,0 +1,49 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package sshproxy
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestAccessTokens(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tokens := &AccessTokens{
+		secret: []byte("0123456789abcdef0123456789abcdef"),
+		now:    func() time.Time { return now },
+	}
+	token := func(workspaceID, instanceID string, expiry time.Time) string {
+		exp := strconv.FormatInt(expiry.Unix(), 10)
+		return exp + "." + tokens.sign(workspaceID, instanceID, exp)
+	}
+	valid := token("ws", "instance", now.Add(time.Minute))
+
+	tests := []struct {
+		Name        string
+		Tokens      *AccessTokens
+		Token       string
+		WorkspaceID string
+		InstanceID  string
+		Expectation bool
+	}{
+		{Name: "valid", Tokens: tokens, Token: valid, WorkspaceID: "ws", InstanceID: "instance", Expectation: true},
+		{Name: "other workspace", Tokens: tokens, Token: valid, WorkspaceID: "other-ws", InstanceID: "instance"},
+		{Name: "other instance", Tokens: tokens, Token: valid, WorkspaceID: "ws", InstanceID: "other-instance"},
+		{Name: "expired", Tokens: tokens, Token: token("ws", "instance", now), WorkspaceID: "ws", InstanceID: "instance"},
+		{Name: "prolonged", Tokens: tokens, Token: strconv.FormatInt(now.Add(time.Hour).Unix(), 10) + valid[len("1700000060"):], WorkspaceID: "ws", InstanceID: "instance"},
+		{Name: "owner token", Tokens: tokens, Token: "owner-token", WorkspaceID: "ws", InstanceID: "instance"},
+		{Name: "disabled", Token: valid, WorkspaceID: "ws", InstanceID: "instance"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			act := test.Tokens.Verify(test.Token, test.WorkspaceID, test.InstanceID)
+			if act != test.Expectation {
+				t.Errorf("unexpected result: want %v, got %v", test.Expectation, act)
+			}
+		})
+	}
+}
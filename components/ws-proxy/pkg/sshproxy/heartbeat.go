@@ -32,6 +32,7 @@ func (m *WorkspaceManagerHeartbeat) SendHeartbeat(instanceID string, isClosed, i
 		Id:             instanceID,
 		Closed:         isClosed,
 		IgnoreIfActive: ignoreIfActive,
+		Source:         wsmanapi.ActivitySource_ACTIVITY_SOURCE_TERMINAL_INPUT,
 	})
 	if err != nil {
 		log.WithError(err).Warn("cannot send heartbeat for workspace instance")
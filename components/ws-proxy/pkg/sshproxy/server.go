@@ -114,6 +114,7 @@ type Server struct {
 	sshConfig             *ssh.ServerConfig
 	workspaceInfoProvider common.WorkspaceInfoProvider
 	caKey                 ssh.Signer
+	accessTokens          *AccessTokens
 }
 
 func init() {
@@ -125,14 +126,15 @@ func init() {
 	)
 }
 
-// New creates a new SSH proxy server
+// New creates a new SSH proxy server. If accessTokens is nil, clients authenticate with the owner token or an SSH key only.
 
-func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvider, heartbeat Heartbeat, caKey ssh.Signer) *Server {
+func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvider, heartbeat Heartbeat, caKey ssh.Signer, accessTokens *AccessTokens) *Server {
 	server := &Server{
 		workspaceInfoProvider: workspaceInfoProvider,
 		Heartbeater:           &noHeartbeat{},
 		HostKeys:              signers,
 		caKey:                 caKey,
+		accessTokens:          accessTokens,
 	}
 	if heartbeat != nil {
 		server.Heartbeater = heartbeat
@@ -179,11 +181,11 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 			if err != nil {
 				return nil, err
 			}
-			// NoClientAuthCallback only support workspaceId#ownerToken
+			// NoClientAuthCallback only support workspaceId#token
 			if len(args) != 2 {
 				return nil, ssh.ErrNoAuth
 			}
-			if wsInfo.Auth.OwnerToken != args[1] {
+			if !server.authenticateToken(wsInfo, args[1]) {
 				return nil, ErrAuthFailedWithReject
 			}
 			server.TrackSSHConnection(wsInfo, "auth", nil)
@@ -195,7 +197,7 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 			}, nil
 		},
 		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (perm *ssh.Permissions, err error) {
-			workspaceId, ownerToken := conn.User(), string(password)
+			workspaceId, token := conn.User(), string(password)
 			var debugWorkspace string
 			if strings.HasPrefix(workspaceId, "debug-") {
 				debugWorkspace = "true"
@@ -208,7 +210,7 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 			defer func() {
 				server.TrackSSHConnection(wsInfo, "auth", err)
 			}()
-			if wsInfo.Auth.OwnerToken != ownerToken {
+			if !server.authenticateToken(wsInfo, token) {
 				return nil, ErrAuthFailed
 			}
 			return &ssh.Permissions{
@@ -255,6 +257,14 @@ func New(signers []ssh.Signer, workspaceInfoProvider common.WorkspaceInfoProvide
 	return server
 }
 
+// authenticateToken returns true if token is the owner token of the workspace or an SSH access token for it
+func (s *Server) authenticateToken(wsInfo *common.WorkspaceInfo, token string) bool {
+	if wsInfo.Auth != nil && wsInfo.Auth.OwnerToken == token {
+		return true
+	}
+	return s.accessTokens.Verify(token, wsInfo.WorkspaceID, wsInfo.InstanceID)
+}
+
 func ReportSSHAttemptMetrics(err error) {
 	if err == nil {
 		SSHAttemptTotal.WithLabelValues("success", "").Inc()
@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
+	"github.com/gitpod-io/gitpod/installer/pkg/backup"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/util/homedir"
+)
+
+var backupOpts struct {
+	Kube      kubeConfig
+	Namespace string
+	Database  bool
+}
+
+// backupCmd represents the backup command
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Export and import the installation state",
+	Long: `Export and import the installation state
+
+The installation state consists of the config file, the secrets the config
+refers to and the list of database migrations that have been applied. Together
+with a database dump and the object storage this is everything needed to rebuild
+an installation after a disaster or in a new cluster.
+
+The backup contains the secrets in plain text - store it accordingly.`,
+}
+
+// readDatabaseState connects to the database using the DB_HOST, DB_PORT, DB_USERNAME,
+// DB_PASSWORD and DB_CA_CERT environment variables and reads the applied migrations
+func readDatabaseState() (*backup.DatabaseState, error) {
+	conn, err := db.Connect(db.ConnectionParamsFromEnv())
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to database: %w", err)
+	}
+	return backup.ReadDatabaseState(conn)
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+
+	backupCmd.PersistentFlags().StringVar(&backupOpts.Kube.Config, "kubeconfig", filepath.Join(homedir.HomeDir(), ".kube", "config"), "path to the kubeconfig file")
+	backupCmd.PersistentFlags().StringVarP(&backupOpts.Namespace, "namespace", "n", getEnvvar("NAMESPACE", "default"), "namespace Gitpod is installed to")
+	backupCmd.PersistentFlags().BoolVar(&backupOpts.Database, "database", false, "connect to the database using the DB_HOST, DB_PORT, DB_USERNAME and DB_PASSWORD environment variables to record or check the applied migrations")
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/installer/pkg/backup"
+	"github.com/gitpod-io/gitpod/installer/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+var backupExportOpts struct {
+	Config string
+	Output string
+}
+
+// backupExportCmd represents the backup export command
+var backupExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the installation state to a file",
+	Example: `  # Export the config, its secrets and the database migrations to backup.yaml
+DB_HOST=127.0.0.1 DB_PORT=3306 DB_USERNAME=gitpod DB_PASSWORD=... \
+  gitpod-installer backup export -c ./gitpod.config.yaml -n gitpod --database -o backup.yaml`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfgBytes, err := os.ReadFile(backupExportOpts.Config)
+		if err != nil {
+			return err
+		}
+		rawCfg, cfgVersion, _, err := loadConfig(backupExportOpts.Config)
+		if err != nil {
+			return err
+		}
+		apiVersion, err := config.LoadConfigVersion(cfgVersion)
+		if err != nil {
+			return err
+		}
+
+		state := &backup.State{
+			Version:   backup.FormatVersion,
+			CreatedAt: time.Now().UTC(),
+			Config:    string(cfgBytes),
+		}
+		if versionMF, err := getVersionManifest(); err == nil {
+			state.InstallerVersion = versionMF.Version
+		} else {
+			log.WithError(err).Warn("cannot determine installer version")
+		}
+
+		_, clientset, err := authClusterOrKubeconfig(backupOpts.Kube.Config)
+		if err != nil {
+			return err
+		}
+		secretNames := apiVersion.SecretRefs(rawCfg)
+		state.Secrets, err = backup.ExportSecrets(cmd.Context(), clientset, backupOpts.Namespace, secretNames)
+		if err != nil {
+			return err
+		}
+		log.WithField("secrets", secretNames).Info("exported secrets")
+
+		if backupOpts.Database {
+			state.Database, err = readDatabaseState()
+			if err != nil {
+				return err
+			}
+			log.WithField("migrations", len(state.Database.Migrations)).Info("exported database migrations")
+		}
+
+		out, err := state.Marshal()
+		if err != nil {
+			return err
+		}
+		// the backup contains secrets, hence only the owner must be able to read it
+		err = os.WriteFile(backupExportOpts.Output, out, 0600)
+		if err != nil {
+			return fmt.Errorf("cannot write backup: %w", err)
+		}
+		log.Infof("Backup written to %s", backupExportOpts.Output)
+
+		return nil
+	},
+}
+
+func init() {
+	backupCmd.AddCommand(backupExportCmd)
+
+	dir, err := os.Getwd()
+	if err != nil {
+		log.WithError(err).Fatal("Failed to get working directory")
+	}
+
+	backupExportCmd.Flags().StringVarP(&backupExportOpts.Config, "config", "c", getEnvvar("GITPOD_INSTALLER_CONFIG", filepath.Join(dir, "gitpod.config.yaml")), "path to the config file")
+	backupExportCmd.Flags().StringVarP(&backupExportOpts.Output, "output", "o", "gitpod-backup.yaml", "path the backup is written to")
+}
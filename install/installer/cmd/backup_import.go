@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/installer/pkg/backup"
+	"github.com/spf13/cobra"
+)
+
+var backupImportOpts struct {
+	Config    string
+	Overwrite bool
+}
+
+// backupImportCmd represents the backup import command
+var backupImportCmd = &cobra.Command{
+	Use:   "import <backup>",
+	Short: "Restore the installation state from a file",
+	Long: `Restore the installation state from a file
+
+Writes the config file and creates the secrets in the namespace. If the
+backup records the database migrations and --database is set, the import
+fails unless the database has been restored from a dump which is at least
+as recent as the backup.
+
+Run "render" with the restored config afterwards to reinstall Gitpod.`,
+	Example: `  # Restore the installation state into the gitpod namespace
+gitpod-installer backup import backup.yaml -c ./gitpod.config.yaml -n gitpod`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		state, err := backup.Unmarshal(data)
+		if err != nil {
+			return fmt.Errorf("cannot read backup: %w", err)
+		}
+		log.WithField("createdAt", state.CreatedAt).WithField("installerVersion", state.InstallerVersion).Info("restoring backup")
+
+		if backupOpts.Database {
+			if state.Database == nil {
+				log.Warn("backup does not record the database migrations - skipping database check")
+			} else {
+				current, err := readDatabaseState()
+				if err != nil {
+					return err
+				}
+				err = backup.CheckDatabase(state.Database, current)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if _, err := os.Stat(backupImportOpts.Config); err == nil && !backupImportOpts.Overwrite {
+			return fmt.Errorf("file %s exists - to overwrite add --overwrite flag", backupImportOpts.Config)
+		}
+
+		_, clientset, err := authClusterOrKubeconfig(backupOpts.Kube.Config)
+		if err != nil {
+			return err
+		}
+		err = backup.ImportSecrets(cmd.Context(), clientset, backupOpts.Namespace, state.Secrets, backupImportOpts.Overwrite)
+		if err != nil {
+			return err
+		}
+		log.Infof("Restored %d secrets to namespace %s", len(state.Secrets), backupOpts.Namespace)
+
+		err = os.WriteFile(backupImportOpts.Config, []byte(state.Config), 0644)
+		if err != nil {
+			return err
+		}
+		log.Infof("File written to %s", backupImportOpts.Config)
+
+		return nil
+	},
+}
+
+func init() {
+	backupCmd.AddCommand(backupImportCmd)
+
+	dir, err := os.Getwd()
+	if err != nil {
+		log.WithError(err).Fatal("Failed to get working directory")
+	}
+
+	backupImportCmd.Flags().StringVarP(&backupImportOpts.Config, "config", "c", getEnvvar("GITPOD_INSTALLER_CONFIG", filepath.Join(dir, "gitpod.config.yaml")), "path the config file is written to")
+	backupImportCmd.Flags().BoolVar(&backupImportOpts.Overwrite, "overwrite", false, "overwrite the config file and secrets if they exist")
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gitpod-io/gitpod/common-go/log"
+	"github.com/gitpod-io/gitpod/installer/pkg/bundle"
+	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/spf13/cobra"
+)
+
+var bundleOpts struct {
+	ConfigFN              string
+	Namespace             string
+	Output                string
+	Repository            string
+	NoInstaller           bool
+	UseExperimentalConfig bool
+}
+
+// bundleCmd represents the bundle command
+var bundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Packages everything required to install Gitpod into a single bundle",
+	Long: `Packages everything required to install Gitpod into a single bundle
+
+The bundle is a gzipped tarball suitable for airgapped delivery. It contains
+the config, the rendered Kubernetes manifests split into steps, the list of
+images which need to be mirrored, this installer to run the preflight checks,
+and an install.sh script which applies the steps in order.
+
+The "repository" field of the config, or the --repository flag, must be set
+to the registry the images are mirrored to.`,
+	Example: `  # Create the bundle
+  gitpod-installer bundle --config config.yaml --namespace gitpod --repository registry.example.com/gitpod
+
+  # Install from the bundle, after mirroring the images in images.json
+  tar xzf gitpod-bundle.tar.gz && ./gitpod-bundle/install.sh
+
+  # Install a single step
+  ./gitpod-bundle/install.sh crds`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfgBytes, err := os.ReadFile(bundleOpts.ConfigFN)
+		if err != nil {
+			return err
+		}
+		_, cfgVersion, cfg, err := loadConfig(bundleOpts.ConfigFN)
+		if err != nil {
+			return err
+		}
+		if bundleOpts.Repository != "" {
+			cfg.Repository = bundleOpts.Repository
+		}
+		if cfg.Repository == common.GitpodContainerRegistry {
+			return fmt.Errorf("an airgapped installation cannot pull from %s, set the repository the images are mirrored to with --repository", common.GitpodContainerRegistry)
+		}
+		if cfg.Experimental != nil && !bundleOpts.UseExperimentalConfig {
+			fmt.Fprintf(os.Stderr, "ignoring experimental config. Use `--use-experimental-config` to include the experimental section in config\n")
+			cfg.Experimental = nil
+		}
+
+		b := &bundle.Bundle{
+			Manifest: bundle.Manifest{
+				CreatedAt:  time.Now().UTC(),
+				Domain:     cfg.Domain,
+				Namespace:  bundleOpts.Namespace,
+				Repository: cfg.Repository,
+			},
+			Config: cfgBytes,
+		}
+		if versionMF, err := getVersionManifest(); err == nil {
+			b.Manifest.InstallerVersion = versionMF.Version
+		} else {
+			log.WithError(err).Warn("cannot determine installer version")
+		}
+
+		renderOpts.Namespace = bundleOpts.Namespace
+		manifests, err := renderKubernetesObjects(cfgVersion, cfg)
+		if err != nil {
+			return err
+		}
+		for _, mf := range manifests {
+			objs, err := common.YamlToRuntimeObject([]string{mf})
+			if err != nil {
+				return err
+			}
+			b.Objects = append(b.Objects, bundle.Object{Kind: objs[0].Kind, Content: mf})
+		}
+
+		// generateMirrorList renders the objects once more for every dependency, hence needs a config of its own
+		_, _, mirrorCfg, err := loadConfig(bundleOpts.ConfigFN)
+		if err != nil {
+			return err
+		}
+		mirrorCfg.Repository = cfg.Repository
+		mirrorCfg.Experimental = cfg.Experimental
+		images, err := generateMirrorList(cfgVersion, mirrorCfg)
+		if err != nil {
+			return err
+		}
+		for _, img := range images {
+			b.Images = append(b.Images, bundle.Image{Original: img.Original, Target: img.Target})
+		}
+
+		if !bundleOpts.NoInstaller {
+			self, err := os.Executable()
+			if err != nil {
+				return err
+			}
+			b.Installer, err = os.ReadFile(self)
+			if err != nil {
+				return fmt.Errorf("cannot read installer: %w", err)
+			}
+		}
+
+		f, err := os.OpenFile(bundleOpts.Output, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		err = b.Write(f)
+		if err != nil {
+			return fmt.Errorf("cannot write bundle: %w", err)
+		}
+		err = f.Close()
+		if err != nil {
+			return fmt.Errorf("cannot write bundle: %w", err)
+		}
+		log.WithField("objects", len(b.Objects)).WithField("images", len(b.Images)).Infof("Bundle written to %s", bundleOpts.Output)
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(bundleCmd)
+
+	dir, err := os.Getwd()
+	if err != nil {
+		log.WithError(err).Fatal("Failed to get working directory")
+	}
+
+	bundleCmd.Flags().StringVarP(&bundleOpts.ConfigFN, "config", "c", getEnvvar("GITPOD_INSTALLER_CONFIG", filepath.Join(dir, "gitpod.config.yaml")), "path to the config file")
+	bundleCmd.Flags().StringVarP(&bundleOpts.Namespace, "namespace", "n", getEnvvar("NAMESPACE", "default"), "namespace to deploy to")
+	bundleCmd.Flags().StringVarP(&bundleOpts.Output, "output", "o", "gitpod-bundle.tar.gz", "path the bundle is written to")
+	bundleCmd.Flags().StringVar(&bundleOpts.Repository, "repository", "", "overwrite the registry in the config")
+	bundleCmd.Flags().BoolVar(&bundleOpts.NoInstaller, "no-installer", false, "do not include the installer, which skips the preflight checks on install")
+	bundleCmd.Flags().BoolVar(&bundleOpts.UseExperimentalConfig, "use-experimental-config", false, "enable the use of experimental config that is prone to be changed")
+}
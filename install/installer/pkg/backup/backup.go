@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// FormatVersion is the version of the backup format written by this installer
+const FormatVersion = "v1"
+
+// State is everything needed to rebuild an installation next to the database and object storage
+type State struct {
+	// Version is the version of the backup format
+	Version   string    `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	// InstallerVersion is the version of the installer which created the backup
+	InstallerVersion string `json:"installerVersion,omitempty"`
+
+	// Config is the installer config file as it was on disk
+	Config string `json:"config"`
+	// Secrets are the secrets the config refers to
+	Secrets []Secret `json:"secrets,omitempty"`
+	// Database records the schema of the database at the time of the backup
+	Database *DatabaseState `json:"database,omitempty"`
+}
+
+// Secret is a secret the config refers to
+type Secret struct {
+	Name string            `json:"name"`
+	Type corev1.SecretType `json:"type,omitempty"`
+	Data map[string][]byte `json:"data"`
+}
+
+// Marshal serialises the state as YAML
+func (s *State) Marshal() ([]byte, error) {
+	return yaml.Marshal(s)
+}
+
+// Unmarshal parses a backup and checks that this installer understands its format
+func Unmarshal(data []byte) (*State, error) {
+	var res State
+	err := yaml.UnmarshalStrict(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	if res.Version != FormatVersion {
+		return nil, fmt.Errorf("unsupported backup version %q: expected %s", res.Version, FormatVersion)
+	}
+	return &res, nil
+}
+
+// ExportSecrets reads the named secrets from the namespace. All secrets must exist.
+func ExportSecrets(ctx context.Context, clientset kubernetes.Interface, namespace string, names []string) ([]Secret, error) {
+	res := make([]Secret, 0, len(names))
+	for _, name := range names {
+		secret, err := clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("cannot read secret %s: %w", name, err)
+		}
+		res = append(res, Secret{
+			Name: secret.Name,
+			Type: secret.Type,
+			Data: secret.Data,
+		})
+	}
+	return res, nil
+}
+
+// ImportSecrets creates the secrets in the namespace. Existing secrets are only replaced if overwrite is true.
+func ImportSecrets(ctx context.Context, clientset kubernetes.Interface, namespace string, secrets []Secret, overwrite bool) error {
+	client := clientset.CoreV1().Secrets(namespace)
+	for _, s := range secrets {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.Name,
+				Namespace: namespace,
+			},
+			Type: s.Type,
+			Data: s.Data,
+		}
+
+		_, err := client.Create(ctx, secret, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) {
+			if !overwrite {
+				return fmt.Errorf("secret %s already exists", s.Name)
+			}
+
+			var existing *corev1.Secret
+			existing, err = client.Get(ctx, s.Name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("cannot read secret %s: %w", s.Name, err)
+			}
+			if existing.Type != s.Type {
+				return fmt.Errorf("secret %s exists with type %s, but the backup has type %s", s.Name, existing.Type, s.Type)
+			}
+			existing.Data = s.Data
+			_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return fmt.Errorf("cannot restore secret %s: %w", s.Name, err)
+		}
+	}
+	return nil
+}
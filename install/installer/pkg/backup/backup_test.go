@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package backup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	source := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "https-certificates", Namespace: "gitpod"},
+			Type:       corev1.SecretTypeTLS,
+			Data:       map[string][]byte{"tls.crt": []byte("crt"), "tls.key": []byte("key")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "database", Namespace: "gitpod"},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		},
+	)
+
+	secrets, err := ExportSecrets(ctx, source, "gitpod", []string{"database", "https-certificates"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ExportSecrets(ctx, source, "gitpod", []string{"missing"})
+	if err == nil {
+		t.Error("expected error for missing secret")
+	}
+
+	state := &State{
+		Version:   FormatVersion,
+		CreatedAt: time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+		Config:    "apiVersion: v1\ndomain: gitpod.example.com\n",
+		Secrets:   secrets,
+		Database:  &DatabaseState{Migrations: []string{"Baseline1592203031938", "AddProjects1623163230302"}},
+	}
+	data, err := state.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored, err := Unmarshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(state, restored); diff != "" {
+		t.Fatalf("unexpected state after round trip (-want +got):\n%s", diff)
+	}
+
+	target := fake.NewSimpleClientset(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "database", Namespace: "gitpod"},
+		Data:       map[string][]byte{"password": []byte("other")},
+	})
+	err = ImportSecrets(ctx, target, "gitpod", restored.Secrets, false)
+	if err == nil {
+		t.Fatal("expected error when overwriting an existing secret")
+	}
+	err = ImportSecrets(ctx, target, "gitpod", restored.Secrets, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range secrets {
+		act, err := target.CoreV1().Secrets("gitpod").Get(ctx, s.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(s, Secret{Name: act.Name, Type: act.Type, Data: act.Data}); diff != "" {
+			t.Errorf("unexpected secret %s (-want +got):\n%s", s.Name, diff)
+		}
+	}
+}
+
+func TestCheckDatabase(t *testing.T) {
+	backup := &DatabaseState{Migrations: []string{"a", "b"}}
+	tests := []struct {
+		Name        string
+		Backup      *DatabaseState
+		Current     *DatabaseState
+		Expectation string
+	}{
+		{Name: "no markers", Current: &DatabaseState{}},
+		{Name: "same", Backup: backup, Current: &DatabaseState{Migrations: []string{"a", "b"}}},
+		{Name: "newer", Backup: backup, Current: &DatabaseState{Migrations: []string{"a", "b", "c"}}},
+		{
+			Name:        "older",
+			Backup:      backup,
+			Current:     &DatabaseState{Migrations: []string{"a"}},
+			Expectation: "database is older than the backup, it misses 1 migrations (first missing is b) - restore a database dump taken after the backup",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var act string
+			if err := CheckDatabase(test.Backup, test.Current); err != nil {
+				act = err.Error()
+			}
+			if act != test.Expectation {
+				t.Errorf("unexpected error: %q, expected %q", act, test.Expectation)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package backup
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// DatabaseState records which migrations had been applied to the database
+type DatabaseState struct {
+	// Migrations are the names of the applied migrations in the order they were applied
+	Migrations []string `json:"migrations"`
+}
+
+// ReadDatabaseState reads the migrations table which the database migrations maintain
+func ReadDatabaseState(conn *gorm.DB) (*DatabaseState, error) {
+	var migrations []string
+	err := conn.Table("migrations").Order("timestamp").Pluck("name", &migrations).Error
+	if err != nil {
+		return nil, fmt.Errorf("cannot read migrations: %w", err)
+	}
+	return &DatabaseState{Migrations: migrations}, nil
+}
+
+// CheckDatabase makes sure the database is not older than the backup, i.e. that the
+// database contains every migration that had been applied when the backup was taken.
+// A database restored from an older dump would not match the restored config and secrets.
+func CheckDatabase(backup, current *DatabaseState) error {
+	if backup == nil {
+		return nil
+	}
+
+	applied := make(map[string]struct{}, len(current.Migrations))
+	for _, m := range current.Migrations {
+		applied[m] = struct{}{}
+	}
+	var missing []string
+	for _, m := range backup.Migrations {
+		if _, ok := applied[m]; !ok {
+			missing = append(missing, m)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("database is older than the backup, it misses %d migrations (first missing is %s) - restore a database dump taken after the backup", len(missing), missing[0])
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// FormatVersion is the version of the bundle format written by this installer
+const FormatVersion = "v1"
+
+const (
+	// root is the directory a bundle unpacks into
+	root = "gitpod-bundle"
+
+	ManifestFile  = "bundle.yaml"
+	ConfigFile    = "gitpod.config.yaml"
+	ImagesFile    = "images.json"
+	InstallerFile = "gitpod-installer"
+	InstallFile   = "install.sh"
+)
+
+//go:embed install.sh.tpl
+var installScript string
+
+// Step is a part of the installation which is applied, and waited for, before the next one
+type Step struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	File        string `json:"file"`
+}
+
+// Steps are the steps of an installation in the order they're applied in
+var Steps = []Step{
+	{Name: "crds", Description: "Custom resource definitions", File: "manifests/01-crds.yaml"},
+	{Name: "cluster", Description: "Namespace and cluster-wide resources", File: "manifests/02-cluster.yaml"},
+	{Name: "gitpod", Description: "Gitpod components", File: "manifests/03-gitpod.yaml"},
+}
+
+// clusterKinds are the kinds which are installed in the cluster step, next to namespaces
+var clusterKinds = map[string]struct{}{
+	"Namespace":          {},
+	"ClusterRole":        {},
+	"ClusterRoleBinding": {},
+	"PriorityClass":      {},
+	"RuntimeClass":       {},
+	"StorageClass":       {},
+	"PodSecurityPolicy":  {},
+}
+
+// Manifest describes a bundle. It is written to the root of the bundle.
+type Manifest struct {
+	// Version is the version of the bundle format
+	Version   string    `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	// InstallerVersion is the version of the installer which created the bundle
+	InstallerVersion string `json:"installerVersion,omitempty"`
+
+	Domain    string `json:"domain"`
+	Namespace string `json:"namespace"`
+	// Repository is the registry the images need to be mirrored to before installing
+	Repository string `json:"repository"`
+
+	Steps []Step `json:"steps"`
+	// IncludesInstaller is true if the bundle contains the installer, which runs the preflight checks
+	IncludesInstaller bool `json:"includesInstaller"`
+}
+
+// Object is a rendered Kubernetes object
+type Object struct {
+	Kind    string
+	Content string
+}
+
+// Image is an image the installation uses, and where it needs to be mirrored to
+type Image struct {
+	Original string `json:"original"`
+	Target   string `json:"target"`
+}
+
+// Bundle is everything needed to install Gitpod without access to the internet, apart from the images
+type Bundle struct {
+	Manifest Manifest
+	// Config is the installer config file the manifests were rendered from
+	Config    []byte
+	Objects   []Object
+	Images    []Image
+	Installer []byte
+}
+
+type bundleFile struct {
+	Name    string
+	Mode    int64
+	Content []byte
+}
+
+// StepOf returns the step an object of the kind is installed in
+func StepOf(kind string) Step {
+	if kind == "CustomResourceDefinition" {
+		return Steps[0]
+	}
+	if _, ok := clusterKinds[kind]; ok {
+		return Steps[1]
+	}
+	return Steps[2]
+}
+
+// Write writes the bundle as gzipped tarball to w
+func (b *Bundle) Write(w io.Writer) error {
+	b.Manifest.Version = FormatVersion
+	b.Manifest.Steps = Steps
+	b.Manifest.IncludesInstaller = len(b.Installer) > 0
+
+	manifest, err := yaml.Marshal(b.Manifest)
+	if err != nil {
+		return err
+	}
+	images, err := json.MarshalIndent(b.Images, "", "  ")
+	if err != nil {
+		return err
+	}
+	var script bytes.Buffer
+	err = template.Must(template.New("install").Parse(installScript)).Execute(&script, b.Manifest)
+	if err != nil {
+		return err
+	}
+
+	steps := make(map[string]*strings.Builder, len(Steps))
+	for _, s := range Steps {
+		steps[s.File] = &strings.Builder{}
+	}
+	for _, o := range b.Objects {
+		sb := steps[StepOf(o.Kind).File]
+		sb.WriteString("---\n")
+		sb.WriteString(strings.TrimPrefix(strings.TrimSpace(o.Content), "---\n"))
+		sb.WriteString("\n")
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	files := []bundleFile{
+		{Name: ManifestFile, Mode: 0644, Content: manifest},
+		{Name: ConfigFile, Mode: 0644, Content: b.Config},
+		{Name: ImagesFile, Mode: 0644, Content: images},
+		{Name: InstallFile, Mode: 0755, Content: script.Bytes()},
+	}
+	for _, s := range Steps {
+		files = append(files, bundleFile{Name: s.File, Mode: 0644, Content: []byte(steps[s.File].String())})
+	}
+	if len(b.Installer) > 0 {
+		files = append(files, bundleFile{Name: InstallerFile, Mode: 0755, Content: b.Installer})
+	}
+
+	for _, f := range files {
+		err = tw.WriteHeader(&tar.Header{
+			Name:    path.Join(root, f.Name),
+			Mode:    f.Mode,
+			Size:    int64(len(f.Content)),
+			ModTime: b.Manifest.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(f.Content)
+		if err != nil {
+			return fmt.Errorf("cannot write %s: %w", f.Name, err)
+		}
+	}
+
+	err = tw.Close()
+	if err != nil {
+		return err
+	}
+	return gz.Close()
+}
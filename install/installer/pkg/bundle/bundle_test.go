@@ -0,0 +1,119 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/yaml"
+)
+
+func TestWrite(t *testing.T) {
+	b := &Bundle{
+		Manifest: Manifest{
+			CreatedAt:        time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+			InstallerVersion: "commit-abc",
+			Domain:           "gitpod.example.com",
+			Namespace:        "gitpod",
+			Repository:       "registry.example.com/gitpod",
+		},
+		Config: []byte("apiVersion: v1\ndomain: gitpod.example.com\n"),
+		Objects: []Object{
+			{Kind: "CustomResourceDefinition", Content: "---\n# apiextensions.k8s.io/v1/CustomResourceDefinition workspaces.workspace.gitpod.io\nkind: CustomResourceDefinition"},
+			{Kind: "ClusterRole", Content: "---\n# rbac.authorization.k8s.io/v1/ClusterRole server\nkind: ClusterRole"},
+			{Kind: "Deployment", Content: "---\n# apps/v1/Deployment server\nkind: Deployment"},
+			{Kind: "Service", Content: "---\n# v1/Service server\nkind: Service"},
+		},
+		Images:    []Image{{Original: "eu.gcr.io/gitpod-core-dev/build/server:commit-abc", Target: "registry.example.com/gitpod/build/server:commit-abc"}},
+		Installer: []byte("binary"),
+	}
+
+	var buf bytes.Buffer
+	err := b.Write(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := make(map[string]string)
+	gz, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[hdr.Name] = string(content)
+	}
+
+	var names []string
+	for n := range files {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	expectedNames := []string{
+		"gitpod-bundle/bundle.yaml",
+		"gitpod-bundle/gitpod-installer",
+		"gitpod-bundle/gitpod.config.yaml",
+		"gitpod-bundle/images.json",
+		"gitpod-bundle/install.sh",
+		"gitpod-bundle/manifests/01-crds.yaml",
+		"gitpod-bundle/manifests/02-cluster.yaml",
+		"gitpod-bundle/manifests/03-gitpod.yaml",
+	}
+	if diff := cmp.Diff(expectedNames, names); diff != "" {
+		t.Errorf("unexpected files (-want +got):\n%s", diff)
+	}
+
+	steps := map[string]string{
+		"gitpod-bundle/manifests/01-crds.yaml":    "---\n# apiextensions.k8s.io/v1/CustomResourceDefinition workspaces.workspace.gitpod.io\nkind: CustomResourceDefinition\n",
+		"gitpod-bundle/manifests/02-cluster.yaml": "---\n# rbac.authorization.k8s.io/v1/ClusterRole server\nkind: ClusterRole\n",
+		"gitpod-bundle/manifests/03-gitpod.yaml":  "---\n# apps/v1/Deployment server\nkind: Deployment\n---\n# v1/Service server\nkind: Service\n",
+	}
+	for fn, expectation := range steps {
+		if diff := cmp.Diff(expectation, files[fn]); diff != "" {
+			t.Errorf("unexpected content of %s (-want +got):\n%s", fn, diff)
+		}
+	}
+
+	var manifest Manifest
+	err = yaml.Unmarshal([]byte(files["gitpod-bundle/bundle.yaml"]), &manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Version != FormatVersion || !manifest.IncludesInstaller || len(manifest.Steps) != len(Steps) {
+		t.Errorf("unexpected manifest: %+v", manifest)
+	}
+
+	script := files["gitpod-bundle/install.sh"]
+	for _, expectation := range []string{
+		`NAMESPACE="gitpod"`,
+		"./gitpod-installer validate cluster",
+		"apply crds manifests/01-crds.yaml",
+		"apply gitpod manifests/03-gitpod.yaml",
+	} {
+		if !strings.Contains(script, expectation) {
+			t.Errorf("install script does not contain %q:\n%s", expectation, script)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+#!/bin/sh
+# Installs Gitpod {{ .InstallerVersion }} for {{ .Domain }} from this bundle.
+#
+# Mirror the images listed in images.json to {{ .Repository }} before you install.
+# Run ./install.sh to run the preflight checks and install every step in order,
+# or ./install.sh <step> to run a single step: preflight{{ range .Steps }}, {{ .Name }}{{ end }}.
+set -eu
+cd "$(dirname "$0")"
+
+NAMESPACE="{{ .Namespace }}"
+
+preflight() {
+{{- if .IncludesInstaller }}
+    echo "running preflight checks"
+    ./gitpod-installer validate cluster --config gitpod.config.yaml --namespace "$NAMESPACE"
+{{- else }}
+    echo "the bundle does not include the installer, skipping preflight checks"
+{{- end }}
+}
+
+# apply <step> <file>
+apply() {
+    if ! grep -q '^kind:' "$2"; then
+        return 0
+    fi
+    echo "installing $1"
+    kubectl apply -f "$2"
+    if [ "$1" = "crds" ]; then
+        kubectl wait --for condition=established --timeout=60s -f "$2"
+    fi
+}
+
+case "${1:-all}" in
+    preflight)
+        preflight
+        ;;
+{{- range .Steps }}
+    {{ .Name }})
+        apply {{ .Name }} {{ .File }}
+        ;;
+{{- end }}
+    all)
+        preflight
+{{- range .Steps }}
+        apply {{ .Name }} {{ .File }}
+{{- end }}
+        ;;
+    *)
+        echo "unknown step $1" >&2
+        exit 1
+        ;;
+esac
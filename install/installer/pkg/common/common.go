@@ -109,6 +109,11 @@ func DefaultEnv(cfg *config.Config) []corev1.EnvVar {
 		logLevel = string(cfg.Observability.LogLevel)
 	}
 
+	var crashReportEnv []corev1.EnvVar
+	if cfg.Observability.CrashReportEndpoint != "" {
+		crashReportEnv = []corev1.EnvVar{{Name: "GITPOD_CRASH_REPORT_ENDPOINT", Value: cfg.Observability.CrashReportEndpoint}}
+	}
+
 	return MergeEnv(
 		[]corev1.EnvVar{
 			{Name: "GITPOD_DOMAIN", Value: cfg.Domain},
@@ -123,6 +128,7 @@ func DefaultEnv(cfg *config.Config) []corev1.EnvVar {
 			{Name: "KUBE_DOMAIN", Value: "svc.cluster.local"},
 			{Name: "LOG_LEVEL", Value: strings.ToLower(logLevel)},
 		},
+		crashReportEnv,
 		ProxyEnv(cfg),
 	)
 }
@@ -46,6 +46,16 @@ func CompositeRenderFunc(f ...RenderFunc) RenderFunc {
 	}
 }
 
+// ComponentRenderFunc renders the component unless it is disabled in the config
+func ComponentRenderFunc(component string, f RenderFunc) RenderFunc {
+	return func(ctx *RenderContext) ([]runtime.Object, error) {
+		if !ctx.Config.ComponentEnabled(component) {
+			return nil, nil
+		}
+		return f(ctx)
+	}
+}
+
 func CompositeHelmFunc(f ...HelmFunc) HelmFunc {
 	return func(ctx *RenderContext) ([]string, error) {
 		var res []string
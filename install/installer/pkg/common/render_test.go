@@ -36,6 +36,34 @@ func TestCompositeRenderFunc_NilObjectsNilError(t *testing.T) {
 	require.Len(t, objects, 0)
 }
 
+func TestComponentRenderFunc(t *testing.T) {
+	f := common.ComponentRenderFunc(dashboard.Component, func(cfg *common.RenderContext) ([]runtime.Object, error) {
+		return []runtime.Object{&corev1.ConfigMap{}}, nil
+	})
+
+	tests := []struct {
+		Name        string
+		Disabled    []string
+		Expectation int
+	}{
+		{Name: "enabled", Expectation: 1},
+		{Name: "other component disabled", Disabled: []string{"agent-smith"}, Expectation: 1},
+		{Name: "disabled", Disabled: []string{dashboard.Component}, Expectation: 0},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, err := common.NewRenderContext(config.Config{
+				Components: &config.Components{Disabled: test.Disabled},
+			}, versions.Manifest{}, "test_namespace")
+			require.NoError(t, err)
+
+			objects, err := f(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, test.Expectation)
+		})
+	}
+}
+
 func TestReplicas(t *testing.T) {
 	testCases := []struct {
 		Component        string
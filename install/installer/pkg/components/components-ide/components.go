@@ -15,10 +15,10 @@ import (
 
 var Objects = common.CompositeRenderFunc(
 	blobserve.Objects,
-	ide_metrics.Objects,
+	common.ComponentRenderFunc(ide_metrics.Component, ide_metrics.Objects),
 	ide_service.Objects,
 	ide_proxy.Objects,
-	openvsxproxy.Objects,
+	common.ComponentRenderFunc(openvsxproxy.Component, openvsxproxy.Objects),
 )
 
 var Helm = common.CompositeHelmFunc()
@@ -23,14 +23,14 @@ import (
 
 var Objects = common.CompositeRenderFunc(
 	contentservice.Objects,
-	dashboard.Objects,
+	common.ComponentRenderFunc(dashboard.Component, dashboard.Objects),
 	database.Objects,
 	migrations.Objects,
 	minio.Objects,
 	proxy.Objects,
 	server.Objects,
 	wsmanagerbridge.Objects,
-	public_api_server.Objects,
+	common.ComponentRenderFunc(public_api_server.Component, public_api_server.Objects),
 	usage.Objects,
 	spicedb.Objects,
 	redis.Objects,
@@ -17,7 +17,7 @@ import (
 )
 
 var Objects = common.CompositeRenderFunc(
-	agentsmith.Objects,
+	common.ComponentRenderFunc(agentsmith.Component, agentsmith.Objects),
 	registryfacade.Objects,
 	workspace.Objects,
 	wsdaemon.Objects,
@@ -6,11 +6,14 @@ package content_service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
+	"github.com/gitpod-io/gitpod/common-go/util"
 
 	"github.com/gitpod-io/gitpod/content-service/api/config"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -24,6 +27,21 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		Storage: common.StorageConfig(ctx),
 	}
 
+	err := ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || ucfg.Workspace.ContentService.ForensicsRetention == "" {
+			return nil
+		}
+		retention, err := time.ParseDuration(ucfg.Workspace.ContentService.ForensicsRetention)
+		if err != nil {
+			return fmt.Errorf("invalid forensics retention: %w", err)
+		}
+		cscfg.Forensics.Retention = util.Duration(retention)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	fc, err := common.ToJSONString(cscfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal content-service config: %w", err)
@@ -78,6 +78,11 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 	if workspaceImage == "" {
 		workspaceImage = ctx.ImageName(common.ThirdPartyContainerRepo(ctx.Config.Repository, ""), workspace.DefaultWorkspaceImage, workspace.DefaultWorkspaceImageVersion)
 	}
+	orchestrator.ImagePolicy.DefaultImage = workspaceImage
+	if policy := ctx.Config.Workspace.ImagePolicy; policy != nil {
+		orchestrator.ImagePolicy.AllowedRegistries = policy.AllowedRegistries
+		orchestrator.ImagePolicy.DeniedRegistries = policy.DeniedRegistries
+	}
 
 	var tls *baseserver.TLSConfiguration
 	if ctx.Config.Kind == configv1.InstallationWorkspace {
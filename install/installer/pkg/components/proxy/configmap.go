@@ -38,11 +38,31 @@ var vhostOpenVSXTmpl []byte
 //go:embed templates/configmap/vhost.ide-proxy.tpl
 var ideProxyTmpl []byte
 
+//go:embed templates/configmap/component.tpl
+var componentTmpl []byte
+
+// optionalComponents are the components the Caddyfile routes to which can be disabled
+var optionalComponents = []string{
+	common.DashboardComponent,
+	common.PublicApiComponent,
+}
+
 type commonTpl struct {
 	Domain       string
 	ReverseProxy string
 }
 
+type ideProxyTpl struct {
+	Domain       string
+	ReverseProxy string
+	IDEMetrics   bool
+}
+
+type componentTpl struct {
+	Component string
+	Enabled   bool
+}
+
 type dockerRegistryTpl struct {
 	Domain       string
 	ReverseProxy string
@@ -80,17 +100,10 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		return nil, err
 	}
 
-	openVSX, err := renderTemplate(vhostOpenVSXTmpl, openVSXTpl{
-		Domain:  ctx.Config.Domain,
-		RepoURL: fmt.Sprintf("openvsx-proxy.%s.%s:%d", ctx.Namespace, kubeDomain, openvsxproxy.ServicePort),
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	ideProxy, err := renderTemplate(ideProxyTmpl, commonTpl{
+	ideProxy, err := renderTemplate(ideProxyTmpl, ideProxyTpl{
 		Domain:       ctx.Config.Domain,
 		ReverseProxy: fmt.Sprintf("ide-proxy.%s.%s:%d", ctx.Namespace, kubeDomain, ideProxyComponent.ServicePort),
+		IDEMetrics:   ctx.Config.ComponentEnabled(common.IDEMetricsComponent),
 	})
 	if err != nil {
 		return nil, err
@@ -98,10 +111,31 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 
 	data := map[string]string{
 		"vhost.empty":     *empty,
-		"vhost.open-vsx":  *openVSX,
 		"vhost.ide-proxy": *ideProxy,
 	}
 
+	for _, c := range optionalComponents {
+		component, err := renderTemplate(componentTmpl, componentTpl{
+			Component: c,
+			Enabled:   ctx.Config.ComponentEnabled(c),
+		})
+		if err != nil {
+			return nil, err
+		}
+		data["component."+c] = *component
+	}
+
+	if ctx.Config.ComponentEnabled(openvsxproxy.Component) {
+		openVSX, err := renderTemplate(vhostOpenVSXTmpl, openVSXTpl{
+			Domain:  ctx.Config.Domain,
+			RepoURL: fmt.Sprintf("openvsx-proxy.%s.%s:%d", ctx.Namespace, kubeDomain, openvsxproxy.ServicePort),
+		})
+		if err != nil {
+			return nil, err
+		}
+		data["vhost.open-vsx"] = *openVSX
+	}
+
 	if ctx.Config.ObjectStorage.CloudStorage == nil {
 		// Don't expose Minio if using cloud storage
 		minio, err := renderTemplate(vhostMinioTmpl, commonTpl{
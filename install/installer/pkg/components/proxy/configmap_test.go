@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	config "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
+)
+
+func TestConfigMap_DisabledComponents(t *testing.T) {
+	const (
+		dashboardDisabled  = "respond \"dashboard is not installed\" 404"
+		publicAPIDisabled  = "respond \"public-api-server is not installed\" 404"
+		ideMetricsDisabled = "respond @ide_metrics \"ide-metrics is not installed\" 404"
+	)
+
+	tests := []struct {
+		Name     string
+		Disabled []string
+		Expect   func(t *testing.T, data map[string]string)
+	}{
+		{
+			Name: "all enabled",
+			Expect: func(t *testing.T, data map[string]string) {
+				assert.NotContains(t, data["component.dashboard"], dashboardDisabled)
+				assert.NotContains(t, data["component.public-api-server"], publicAPIDisabled)
+				assert.NotContains(t, data["vhost.ide-proxy"], ideMetricsDisabled)
+				assert.Contains(t, data, "vhost.open-vsx")
+			},
+		},
+		{
+			Name:     "dashboard disabled",
+			Disabled: []string{common.DashboardComponent},
+			Expect: func(t *testing.T, data map[string]string) {
+				assert.Contains(t, data["component.dashboard"], dashboardDisabled)
+				assert.NotContains(t, data["component.public-api-server"], publicAPIDisabled)
+			},
+		},
+		{
+			Name:     "public-api-server disabled",
+			Disabled: []string{common.PublicApiComponent},
+			Expect: func(t *testing.T, data map[string]string) {
+				assert.Contains(t, data["component.public-api-server"], publicAPIDisabled)
+				assert.NotContains(t, data["component.dashboard"], dashboardDisabled)
+			},
+		},
+		{
+			Name:     "ide-metrics disabled",
+			Disabled: []string{common.IDEMetricsComponent},
+			Expect: func(t *testing.T, data map[string]string) {
+				assert.Contains(t, data["vhost.ide-proxy"], ideMetricsDisabled)
+			},
+		},
+		{
+			Name:     "openvsx-proxy disabled",
+			Disabled: []string{common.OpenVSXProxyComponent},
+			Expect: func(t *testing.T, data map[string]string) {
+				assert.NotContains(t, data, "vhost.open-vsx")
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx := renderContextWithProxyConfig(t, nil, &config.Components{Disabled: test.Disabled})
+
+			objects, err := configmap(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1, "must render only one object")
+
+			test.Expect(t, objects[0].(*corev1.ConfigMap).Data)
+		})
+	}
+}
@@ -0,0 +1,6 @@
+{{ if .Enabled -}}
+# {{ .Component }} is enabled
+{{ else -}}
+# {{ .Component }} is disabled
+respond "{{ .Component }} is not installed" 404
+{{ end -}}
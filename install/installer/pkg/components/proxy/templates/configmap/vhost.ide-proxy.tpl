@@ -2,6 +2,12 @@ https://ide.{{.Domain}} {
     import enable_log_debug
     import remove_server_header
     import ssl_configuration
+{{- if not .IDEMetrics }}
+
+    # ide-proxy forwards everything but its own assets to ide-metrics, which is disabled
+    @ide_metrics not path /blobserve/* /code/* /image/* /static/*
+    respond @ide_metrics "ide-metrics is not installed" 404
+{{- end }}
 
     reverse_proxy  {
         to h2c://{{.ReverseProxy}}
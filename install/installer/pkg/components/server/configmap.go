@@ -41,6 +41,14 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		workspaceImage = ctx.ImageName(common.ThirdPartyContainerRepo(ctx.Config.Repository, ""), workspace.DefaultWorkspaceImage, workspace.DefaultWorkspaceImageVersion)
 	}
 
+	var imagePolicy *WorkspaceImagePolicy
+	if policy := ctx.Config.Workspace.ImagePolicy; policy != nil {
+		imagePolicy = &WorkspaceImagePolicy{
+			AllowedRegistries: append([]string{}, policy.AllowedRegistries...),
+			DeniedRegistries:  append([]string{}, policy.DeniedRegistries...),
+		}
+	}
+
 	sessionSecret := "Important!Really-Change-This-Key!"
 	_ = ctx.WithExperimental(func(cfg *experimental.Config) error {
 		if cfg.WebApp != nil && cfg.WebApp.Server != nil && cfg.WebApp.Server.Session.Secret != "" {
@@ -172,6 +180,12 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		return nil
 	})
 
+	var sshAccessTokenSigningKeyPath string
+	_ = ctx.WithExperimental(func(cfg *experimental.Config) error {
+		_, _, sshAccessTokenSigningKeyPath, _ = getSSHAccessTokenSigningKey(cfg)
+		return nil
+	})
+
 	showSetupModal := true // old default to make self-hosted continue to work!
 	_ = ctx.WithExperimental(func(cfg *experimental.Config) error {
 		if cfg.WebApp != nil && cfg.WebApp.Server != nil && cfg.WebApp.Server.ShowSetupModal != nil {
@@ -201,6 +215,12 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		return nil
 	})
 
+	vsxRegistryURL := fmt.Sprintf("https://open-vsx.%s", ctx.Config.Domain)
+	if !ctx.Config.ComponentEnabled(common.OpenVSXProxyComponent) {
+		// without the proxy, extensions are fetched from the registry directly
+		vsxRegistryURL = ctx.Config.OpenVSX.URL
+	}
+
 	// todo(sje): all these values are configurable
 	scfg := ConfigSerialized{
 		Version:               ctx.VersionManifest.Version,
@@ -216,6 +236,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 			DefaultFeatureFlags: []NamedWorkspaceFeatureFlag{},
 			TimeoutDefault:      ctx.Config.Workspace.TimeoutDefault,
 			TimeoutExtended:     ctx.Config.Workspace.TimeoutExtended,
+			ImagePolicy:         imagePolicy,
 		},
 		Session: Session{
 			MaxAgeMs: 259200000,
@@ -278,7 +299,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		IDEServiceAddr:      common.ClusterAddress(ideservice.Component, ctx.Namespace, ideservice.GRPCServicePort),
 		MaximumEventLoopLag: 0.35,
 		CodeSync:            CodeSync{},
-		VSXRegistryUrl:      fmt.Sprintf("https://open-vsx.%s", ctx.Config.Domain), // todo(sje): or "https://{{ .Values.vsxRegistry.host | default "open-vsx.org" }}" if not using OpenVSX proxy
+		VSXRegistryUrl:      vsxRegistryURL,
 		EnablePayment:       stripeSecret != "" || stripeConfig != "",
 		StripeSecretsFile:   fmt.Sprintf("%s/apikeys", stripeSecretMountPath),
 		LinkedInSecretsFile: fmt.Sprintf("%s/linkedin", linkedInSecretMountPath),
@@ -293,6 +314,7 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		WorkspaceClasses:               workspaceClasses,
 		InactivityPeriodForReposInDays: inactivityPeriodForReposInDays,
 		PATSigningKeyFile:              personalAccessTokenSigningKeyPath,
+		SSHAccessTokenSigningKeyFile:   sshAccessTokenSigningKeyPath,
 		Admin: AdminConfig{
 			CredentialsPath: adminCredentialsPath,
 		},
@@ -355,6 +377,38 @@ func getPersonalAccessTokenSigningKey(cfg *experimental.Config) (corev1.Volume,
 	return volume, mount, path, true
 }
 
+// getSSHAccessTokenSigningKey mounts the key which the SSH gateway of ws-proxy verifies SSH access tokens with
+func getSSHAccessTokenSigningKey(cfg *experimental.Config) (corev1.Volume, corev1.VolumeMount, string, bool) {
+	var volume corev1.Volume
+	var mount corev1.VolumeMount
+	var path string
+
+	if cfg == nil || cfg.Workspace == nil || cfg.Workspace.WSProxy.SSHAccessTokenSigningKeySecretName == "" {
+		return volume, mount, path, false
+	}
+
+	path = sshAccessTokenSigningKeyMountPath
+
+	volume = corev1.Volume{
+		Name: "ssh-access-token-signing-key",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: cfg.Workspace.WSProxy.SSHAccessTokenSigningKeySecretName,
+				Optional:   pointer.Bool(true),
+			},
+		},
+	}
+
+	mount = corev1.VolumeMount{
+		Name:      "ssh-access-token-signing-key",
+		MountPath: sshAccessTokenSigningKeyMountPath,
+		SubPath:   "ssh-access-token-signing-key",
+		ReadOnly:  true,
+	}
+
+	return volume, mount, path, true
+}
+
 func getAdminCredentials() (corev1.Volume, corev1.VolumeMount, string) {
 	volume := corev1.Volume{
 		Name: "admin-credentials",
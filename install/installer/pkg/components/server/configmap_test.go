@@ -30,6 +30,7 @@ func TestConfigMap(t *testing.T) {
 		DisableWorkspaceGarbageCollection bool
 		DefaultBaseImageRegistryWhiteList []string
 		WorkspaceImage                    string
+		ImagePolicy                       *WorkspaceImagePolicy
 		JWTSecret                         string
 		SessionSecret                     string
 		GitHubApp                         experimental.GithubApp
@@ -44,8 +45,12 @@ func TestConfigMap(t *testing.T) {
 		DisableWorkspaceGarbageCollection: true,
 		DefaultBaseImageRegistryWhiteList: []string{"some-registry"},
 		WorkspaceImage:                    "some-workspace-image",
-		JWTSecret:                         "some-jwt-secret",
-		SessionSecret:                     "some-session-secret",
+		ImagePolicy: &WorkspaceImagePolicy{
+			AllowedRegistries: []string{"docker.io", "eu.gcr.io/some-project"},
+			DeniedRegistries:  []string{"docker.io/library"},
+		},
+		JWTSecret:     "some-jwt-secret",
+		SessionSecret: "some-session-secret",
 		GitHubApp: experimental.GithubApp{
 			AppId:           123,
 			AuthProviderId:  "some-auth-provider-id",
@@ -86,6 +91,10 @@ func TestConfigMap(t *testing.T) {
 		Domain: "awesome.domain",
 		Workspace: config.Workspace{
 			WorkspaceImage: expectation.WorkspaceImage,
+			ImagePolicy: &config.WorkspaceImagePolicy{
+				AllowedRegistries: expectation.ImagePolicy.AllowedRegistries,
+				DeniedRegistries:  expectation.ImagePolicy.DeniedRegistries,
+			},
 		},
 		ContainerRegistry: config.ContainerRegistry{
 			PrivateBaseImageAllowList: expectation.DefaultBaseImageRegistryWhiteList,
@@ -138,6 +147,7 @@ func TestConfigMap(t *testing.T) {
 		DisableWorkspaceGarbageCollection: config.WorkspaceGarbageCollection.Disabled,
 		DefaultBaseImageRegistryWhiteList: config.DefaultBaseImageRegistryWhitelist,
 		WorkspaceImage:                    config.WorkspaceDefaults.WorkspaceImage,
+		ImagePolicy:                       config.WorkspaceDefaults.ImagePolicy,
 		JWTSecret:                         config.OAuthServer.JWTSecret,
 		SessionSecret:                     config.Session.Secret,
 		GitHubApp: experimental.GithubApp{
@@ -157,3 +167,32 @@ func TestConfigMap(t *testing.T) {
 
 	assert.Equal(t, expectation, actual)
 }
+
+func TestConfigMap_VSXRegistryURL(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Disabled    []string
+		Expectation string
+	}{
+		{Name: "openvsx-proxy", Expectation: "https://open-vsx.awesome.domain"},
+		{Name: "openvsx-proxy disabled", Disabled: []string{common.OpenVSXProxyComponent}, Expectation: "https://open-vsx.example.com"},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, err := common.NewRenderContext(config.Config{
+				Domain:     "awesome.domain",
+				OpenVSX:    config.OpenVSX{URL: "https://open-vsx.example.com"},
+				Components: &config.Components{Disabled: test.Disabled},
+			}, versions.Manifest{}, "test_namespace")
+			require.NoError(t, err)
+
+			objs, err := configmap(ctx)
+			require.NoError(t, err)
+
+			var cfg ConfigSerialized
+			err = json.Unmarshal([]byte(objs[0].(*corev1.ConfigMap).Data["config.json"]), &cfg)
+			require.NoError(t, err)
+			assert.Equal(t, test.Expectation, cfg.VSXRegistryUrl)
+		})
+	}
+}
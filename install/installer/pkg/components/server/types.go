@@ -39,6 +39,7 @@ type ConfigSerialized struct {
 	EnablePayment                     bool        `json:"enablePayment"`
 	LinkedInSecretsFile               string      `json:"linkedInSecretsFile"`
 	PATSigningKeyFile                 string      `json:"patSigningKeyFile"`
+	SSHAccessTokenSigningKeyFile      string      `json:"sshAccessTokenSigningKeyFile,omitempty"`
 	ShowSetupModal                    bool        `json:"showSetupModal"`
 	Auth                              auth.Config `json:"auth"`
 	IsSingleOrgInstallation           bool        `json:"isSingleOrgInstallation"`
@@ -146,6 +147,12 @@ type WorkspaceDefaults struct {
 	DefaultFeatureFlags []NamedWorkspaceFeatureFlag `json:"defaultFeatureFlags"`
 	TimeoutDefault      *util.Duration              `json:"timeoutDefault,omitempty"`
 	TimeoutExtended     *util.Duration              `json:"timeoutExtended,omitempty"`
+	ImagePolicy         *WorkspaceImagePolicy       `json:"imagePolicy,omitempty"`
+}
+
+type WorkspaceImagePolicy struct {
+	AllowedRegistries []string `json:"allowedRegistries"`
+	DeniedRegistries  []string `json:"deniedRegistries"`
 }
 
 type WorkspaceClass struct {
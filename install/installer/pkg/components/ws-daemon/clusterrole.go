@@ -40,6 +40,11 @@ func clusterrole(ctx *common.RenderContext) ([]runtime.Object, error) {
 					Resources: []string{"pods"},
 					Verbs:     []string{"delete", "update", "patch"},
 				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods/log"},
+					Verbs:     []string{"get"},
+				},
 				{
 					APIGroups: []string{"workspace.gitpod.io"},
 					Resources: []string{"workspaces"},
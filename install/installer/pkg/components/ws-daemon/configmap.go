@@ -6,10 +6,12 @@ package wsdaemon
 
 import (
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	"github.com/gitpod-io/gitpod/common-go/util"
+	carchive "github.com/gitpod-io/gitpod/content-service/pkg/archive"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
 	config "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
@@ -21,10 +23,16 @@ import (
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/daemon"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/diskguard"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/forensics"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/iws"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/memlimit"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/netlimit"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/quota"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/resourceusage"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/secprofile"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -52,6 +60,8 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		ConnectionsPerMinute: 3000,
 		BucketSize:           1000,
 	}
+	var networkBandwidthConfig netlimit.BandwidthConfig
+	var memoryLimitConfig memlimit.Config
 
 	oomScoreAdjConfig := cgroup.OOMScoreAdjConfig{
 		Enabled: false,
@@ -65,10 +75,38 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 
 	var wscontroller daemon.WorkspaceControllerConfig
 
+	var resourceUsageConfig resourceusage.Config
+
+	var forensicsConfig forensics.Config
+
+	var restoreCacheConfig content.RestoreCacheConfig
+
+	var snapshotExportConfig content.SnapshotExportConfig
+
+	var dockerCacheConfig content.DockerCacheConfig
+
+	var deltaBackupConfig content.DeltaBackupConfig
+
+	var liveBackupConfig content.LiveBackupConfig
+
+	var (
+		backupEngine content.BackupEngine
+		resticConfig content.ResticConfig
+	)
+
+	var encryptionConfig content.EncryptionConfig
+	var ownershipRepairConfig content.OwnershipRepairConfig
+
+	var (
+		backupCompression      carchive.Compression
+		backupCompressionLevel int
+		backupUploadConfig     content.BackupUploadConfig
+	)
+
 	// default workspace network CIDR (and fallback)
 	workspaceCIDR := "10.0.5.0/30"
 
-	ctx.WithExperimental(func(ucfg *experimental.Config) error {
+	err := ctx.WithExperimental(func(ucfg *experimental.Config) error {
 		if ucfg.Workspace == nil {
 			return nil
 		}
@@ -77,16 +115,53 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		cpuLimitConfig.BurstLimit = ucfg.Workspace.CPULimits.BurstLimit
 		cpuLimitConfig.Limit = ucfg.Workspace.CPULimits.Limit
 		cpuLimitConfig.TotalBandwidth = ucfg.Workspace.CPULimits.NodeCPUBandwidth
+		if psi := ucfg.Workspace.CPULimits.PSI; psi != nil {
+			cpuLimitConfig.PSI = cpulimit.PressureConfig{
+				Enabled:            psi.Enabled,
+				NodeThreshold:      psi.NodeThreshold,
+				WorkspaceThreshold: psi.WorkspaceThreshold,
+			}
+			if psi.MaxCredit != "" {
+				d, err := time.ParseDuration(psi.MaxCredit)
+				if err != nil {
+					return fmt.Errorf("invalid CPU burst max credit: %w", err)
+				}
+				cpuLimitConfig.PSI.MaxCredit = util.Duration(d)
+			}
+		}
 
 		ioLimitConfig.WriteBWPerSecond = ucfg.Workspace.IOLimits.WriteBWPerSecond
 		ioLimitConfig.ReadBWPerSecond = ucfg.Workspace.IOLimits.ReadBWPerSecond
 		ioLimitConfig.WriteIOPS = ucfg.Workspace.IOLimits.WriteIOPS
 		ioLimitConfig.ReadIOPS = ucfg.Workspace.IOLimits.ReadIOPS
+		if burst := ucfg.Workspace.IOLimits.Burst; burst != nil {
+			ioLimitConfig.Burst = &daemon.IOLimitBurstConfig{
+				WriteBWPerSecond: burst.WriteBWPerSecond,
+				ReadBWPerSecond:  burst.ReadBWPerSecond,
+				WriteIOPS:        burst.WriteIOPS,
+				ReadIOPS:         burst.ReadIOPS,
+			}
+			if burst.MaxDuration != "" {
+				d, err := time.ParseDuration(burst.MaxDuration)
+				if err != nil {
+					return fmt.Errorf("invalid IO burst max duration: %w", err)
+				}
+				ioLimitConfig.Burst.MaxDuration = util.Duration(d)
+			}
+		}
 
 		networkLimitConfig.Enabled = ucfg.Workspace.NetworkLimits.Enabled
 		networkLimitConfig.Enforce = ucfg.Workspace.NetworkLimits.Enforce
 		networkLimitConfig.ConnectionsPerMinute = ucfg.Workspace.NetworkLimits.ConnectionsPerMinute
 		networkLimitConfig.BucketSize = ucfg.Workspace.NetworkLimits.BucketSize
+		networkBandwidthConfig.Enabled = ucfg.Workspace.NetworkLimits.EnableBandwidth
+
+		memoryLimitConfig.Enabled = ucfg.Workspace.MemoryLimits.Enabled
+		memoryLimitConfig.Swap = memlimit.SwapConfig{
+			Enabled:   ucfg.Workspace.MemoryLimits.Swap.Enabled,
+			Size:      ucfg.Workspace.MemoryLimits.Swap.Size,
+			Algorithm: ucfg.Workspace.MemoryLimits.Swap.Algorithm,
+		}
 
 		oomScoreAdjConfig.Enabled = ucfg.Workspace.OOMScores.Enabled
 		oomScoreAdjConfig.Tier1 = ucfg.Workspace.OOMScores.Tier1
@@ -102,6 +177,115 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 
 		procLimit = ucfg.Workspace.ProcLimit
 
+		resourceUsageConfig.Enabled = ucfg.Workspace.WSDaemon.EnableResourceUsage
+
+		forensicsConfig.Enabled = ucfg.Workspace.WSDaemon.Forensics.Enabled
+		forensicsConfig.LogTailLines = ucfg.Workspace.WSDaemon.Forensics.LogTailLines
+
+		if ucfg.Workspace.WSDaemon.RestoreCache.Enabled {
+			if ucfg.Workspace.WSDaemon.ContentEncryption.Enabled {
+				return fmt.Errorf("the restore cache cannot be enabled together with content encryption")
+			}
+			restoreCacheConfig = content.RestoreCacheConfig{
+				Enabled:  true,
+				Location: ContainerRestoreCache,
+				MaxBytes: ucfg.Workspace.WSDaemon.RestoreCache.MaxSize.Value(),
+				MaxAge:   util.Duration(24 * time.Hour),
+
+				Prebuilds: ucfg.Workspace.WSDaemon.RestoreCache.Prebuilds,
+			}
+		}
+
+		if exp := ucfg.Workspace.WSDaemon.SnapshotExport; exp.Repository != "" {
+			snapshotExportConfig.Repository = exp.Repository
+			if exp.Secret != "" {
+				snapshotExportConfig.AuthConfig = filepath.Join(ContainerSnapshotExport, "config.json")
+			}
+		}
+
+		if dc := ucfg.Workspace.WSDaemon.DockerCache; dc.Enabled {
+			dockerCacheConfig = content.DockerCacheConfig{
+				Enabled:         true,
+				Size:            quota.Size(dc.Size.Value()),
+				IncludeInBackup: dc.IncludeInBackup,
+			}
+		}
+
+		encryptionConfig.Enabled = ucfg.Workspace.WSDaemon.ContentEncryption.Enabled
+
+		ownershipRepairConfig.Enabled = ucfg.Workspace.WSDaemon.OwnershipRepair.Enabled
+		ownershipRepairConfig.IncludeRoot = ucfg.Workspace.WSDaemon.OwnershipRepair.IncludeRoot
+
+		if db := ucfg.Workspace.WSDaemon.DeltaBackups; db.Enabled {
+			deltaBackupConfig = content.DeltaBackupConfig{
+				Enabled:            true,
+				FullBackupInterval: db.FullBackupInterval,
+			}
+			if deltaBackupConfig.FullBackupInterval == 0 {
+				deltaBackupConfig.FullBackupInterval = 10
+			}
+		}
+
+		if be := ucfg.Workspace.WSDaemon.BackupEngine; be.Engine != "" {
+			backupEngine = content.BackupEngine(be.Engine)
+			if err := backupEngine.Validate(); err != nil {
+				return err
+			}
+			if backupEngine == content.BackupEngineRestic {
+				if be.ResticSecret == "" {
+					return fmt.Errorf("the restic backup engine requires resticSecret")
+				}
+				resticConfig = content.ResticConfig{
+					PasswordFile: filepath.Join(ContainerResticSecret, "password"),
+					KeepLast:     be.ResticKeepLast,
+				}
+			}
+		}
+
+		if lb := ucfg.Workspace.WSDaemon.LiveBackups; lb.Enabled {
+			if !deltaBackupConfig.Enabled && backupEngine != content.BackupEngineRestic {
+				return fmt.Errorf("live backups require delta backups")
+			}
+			liveBackupConfig = content.LiveBackupConfig{
+				Enabled:  true,
+				Interval: util.Duration(5 * time.Minute),
+			}
+			if lb.Interval != "" {
+				d, err := time.ParseDuration(lb.Interval)
+				if err != nil {
+					return fmt.Errorf("invalid live backup interval: %w", err)
+				}
+				liveBackupConfig.Interval = util.Duration(d)
+			}
+		}
+
+		if bc := ucfg.Workspace.WSDaemon.BackupCompression; bc.Codec != "" {
+			backupCompression = carchive.Compression(bc.Codec)
+			backupCompressionLevel = bc.Level
+		}
+
+		bu := ucfg.Workspace.WSDaemon.BackupUpload
+		if bu.PartSize != nil {
+			backupUploadConfig.PartSize = bu.PartSize.Value()
+		}
+		backupUploadConfig.Parallelism = bu.Parallelism
+		if bu.BandwidthLimit != nil {
+			backupUploadConfig.BandwidthLimit = bu.BandwidthLimit.Value()
+		}
+		for name, class := range ucfg.Workspace.WorkspaceClasses {
+			if class.Resources.Limits.BackupBandwidth == "" {
+				continue
+			}
+			limit, err := resource.ParseQuantity(class.Resources.Limits.BackupBandwidth)
+			if err != nil {
+				return fmt.Errorf("cannot parse backup bandwidth of workspace class %s: %w", name, err)
+			}
+			if backupUploadConfig.ClassBandwidthLimit == nil {
+				backupUploadConfig.ClassBandwidthLimit = make(map[string]int64)
+			}
+			backupUploadConfig.ClassBandwidthLimit[name] = limit.Value()
+		}
+
 		wscontroller.MaxConcurrentReconciles = 15
 
 		if ucfg.Workspace.WorkspaceCIDR != "" {
@@ -110,6 +294,19 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var securityProfilesConfig secprofile.Config
+	if securityProfiles(ctx) != nil {
+		securityProfilesConfig = secprofile.Config{
+			Enabled:    true,
+			Profiles:   filepath.Join(ContainerSecurityProfiles, "profiles.json"),
+			SeccompDir: ContainerSeccomp,
+			AppArmorFS: filepath.Join(ContainerSecurityFS, "apparmor"),
+		}
+	}
 
 	wsdcfg := wsdconfig.Config{
 		Daemon: daemon.Config{
@@ -140,10 +337,24 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				Backup: content.BackupConfig{
 					Timeout:  util.Duration(time.Minute * 5),
 					Attempts: 3,
+					Delta:    deltaBackupConfig,
+
+					Compression:      backupCompression,
+					CompressionLevel: backupCompressionLevel,
+					Upload:           backupUploadConfig,
+					Live:             liveBackupConfig,
+
+					Engine: backupEngine,
+					Restic: resticConfig,
 				},
 				Initializer: content.InitializerConfig{
 					Command: "/app/content-initializer",
 				},
+				RestoreCache:    restoreCacheConfig,
+				SnapshotExport:  snapshotExportConfig,
+				DockerCache:     dockerCacheConfig,
+				Encryption:      encryptionConfig,
+				OwnershipRepair: ownershipRepairConfig,
 			},
 			Uidmapper: iws.UidmapperConfig{
 				ProcLocation: "/proc",
@@ -156,11 +367,13 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 					Size:  70000,
 				}},
 			},
-			CPULimit:  cpuLimitConfig,
-			IOLimit:   ioLimitConfig,
-			ProcLimit: procLimit,
-			NetLimit:  networkLimitConfig,
-			OOMScores: oomScoreAdjConfig,
+			CPULimit:          cpuLimitConfig,
+			IOLimit:           ioLimitConfig,
+			ProcLimit:         procLimit,
+			NetLimit:          networkLimitConfig,
+			NetBandwidthLimit: networkBandwidthConfig,
+			MemoryLimit:       memoryLimitConfig,
+			OOMScores:         oomScoreAdjConfig,
 			DiskSpaceGuard: diskguard.Config{
 				Enabled:  true,
 				Interval: util.Duration(5 * time.Minute),
@@ -170,6 +383,9 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				}},
 			},
 			WorkspaceController: wscontroller,
+			ResourceUsage:       resourceUsageConfig,
+			Forensics:           forensicsConfig,
+			SecurityProfiles:    securityProfilesConfig,
 		},
 		Service: baseserver.ServerConfiguration{
 			Address: fmt.Sprintf("0.0.0.0:%d", ServicePort),
@@ -7,12 +7,20 @@ package wsdaemon
 import "github.com/gitpod-io/gitpod/common-go/baseserver"
 
 const (
-	Component               = "ws-daemon"
-	ServicePort             = 8080
-	HostWorkingAreaMk2      = "/var/gitpod/workspaces-mk2"
-	ContainerWorkingAreaMk2 = "/mnt/workingarea-mk2"
-	HostBackupPath          = "/var/gitpod/tmp/backup"
-	TLSSecretName           = "ws-daemon-tls"
-	VolumeTLSCerts          = "ws-daemon-tls-certs"
-	ReadinessPort           = baseserver.BuiltinHealthPort
+	Component                 = "ws-daemon"
+	ServicePort               = 8080
+	HostWorkingAreaMk2        = "/var/gitpod/workspaces-mk2"
+	ContainerWorkingAreaMk2   = "/mnt/workingarea-mk2"
+	HostBackupPath            = "/var/gitpod/tmp/backup"
+	HostRestoreCache          = "/var/gitpod/restore-cache"
+	ContainerRestoreCache     = "/mnt/restore-cache"
+	ContainerSnapshotExport   = "/config/snapshot-export"
+	ContainerResticSecret     = "/config/restic"
+	ContainerSecurityProfiles = "/config/security-profiles"
+	ContainerSeccomp          = "/mnt/seccomp"
+	ContainerSecurityFS       = "/mnt/securityfs"
+	SecurityProfilesConfigMap = "ws-daemon-security-profiles"
+	TLSSecretName             = "ws-daemon-tls"
+	VolumeTLSCerts            = "ws-daemon-tls-certs"
+	ReadinessPort             = baseserver.BuiltinHealthPort
 )
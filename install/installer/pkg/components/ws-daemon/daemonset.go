@@ -9,6 +9,7 @@ import (
 
 	"github.com/gitpod-io/gitpod/installer/pkg/cluster"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -188,6 +189,98 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 		common.CAVolumeMount(),
 	}
 
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || !ucfg.Workspace.WSDaemon.RestoreCache.Enabled {
+			return nil
+		}
+
+		volumes = append(volumes, corev1.Volume{
+			Name: "restore-cache",
+			VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+				Path: HostRestoreCache,
+				Type: func() *corev1.HostPathType { r := corev1.HostPathDirectoryOrCreate; return &r }(),
+			}},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "restore-cache",
+			MountPath: ContainerRestoreCache,
+		})
+		return nil
+	})
+
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || ucfg.Workspace.WSDaemon.SnapshotExport.Secret == "" {
+			return nil
+		}
+
+		volumes = append(volumes, corev1.Volume{
+			Name: "snapshot-export-secret",
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: ucfg.Workspace.WSDaemon.SnapshotExport.Secret,
+				Items:      []corev1.KeyToPath{{Key: ".dockerconfigjson", Path: "config.json"}},
+			}},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "snapshot-export-secret",
+			MountPath: ContainerSnapshotExport,
+			ReadOnly:  true,
+		})
+		return nil
+	})
+
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || ucfg.Workspace.WSDaemon.BackupEngine.ResticSecret == "" {
+			return nil
+		}
+
+		volumes = append(volumes, corev1.Volume{
+			Name: "restic-secret",
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: ucfg.Workspace.WSDaemon.BackupEngine.ResticSecret,
+				Items:      []corev1.KeyToPath{{Key: "password", Path: "password"}},
+			}},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "restic-secret",
+			MountPath: ContainerResticSecret,
+			ReadOnly:  true,
+		})
+		return nil
+	})
+
+	if securityProfiles(ctx) != nil {
+		volumes = append(volumes,
+			corev1.Volume{
+				Name: "security-profiles",
+				VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: SecurityProfilesConfigMap},
+				}},
+			},
+			corev1.Volume{
+				Name: "node-securityfs",
+				VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{
+					Path: "/sys/kernel/security",
+					Type: func() *corev1.HostPathType { r := corev1.HostPathDirectory; return &r }(),
+				}},
+			},
+		)
+		volumeMounts = append(volumeMounts,
+			corev1.VolumeMount{
+				Name:      "security-profiles",
+				MountPath: ContainerSecurityProfiles,
+				ReadOnly:  true,
+			},
+			corev1.VolumeMount{
+				Name:      "hostseccomp",
+				MountPath: ContainerSeccomp,
+			},
+			corev1.VolumeMount{
+				Name:      "node-securityfs",
+				MountPath: ContainerSecurityFS,
+			},
+		)
+	}
+
 	tolerations := []corev1.Toleration{
 		{
 			Key:      "node.kubernetes.io/disk-pressure",
@@ -269,7 +362,7 @@ func daemonset(ctx *common.RenderContext) ([]runtime.Object, error) {
 			*common.KubeRBACProxyContainer(ctx),
 		},
 		RestartPolicy:                 corev1.RestartPolicyAlways,
-		TerminationGracePeriodSeconds: pointer.Int64(30),
+		TerminationGracePeriodSeconds: pointer.Int64(180),
 		DNSPolicy:                     corev1.DNSClusterFirst,
 		ServiceAccountName:            Component,
 		HostPID:                       true,
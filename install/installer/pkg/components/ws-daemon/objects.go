@@ -12,6 +12,7 @@ var Objects = common.CompositeRenderFunc(
 	role,
 	clusterrole,
 	configmap,
+	securityProfilesConfigmap,
 	common.DefaultServiceAccount(Component),
 	daemonset,
 	rolebinding,
@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package wsdaemon
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
+	"github.com/gitpod-io/gitpod/ws-daemon/pkg/secprofile"
+)
+
+// SeccompProfilePath returns the path of a workspace seccomp profile relative to the seccomp profile directory of the kubelet
+func SeccompProfilePath(name string) string {
+	return fmt.Sprintf("gitpod/%s.json", name)
+}
+
+// securityProfiles returns the workspace security profiles, or nil if there are none
+func securityProfiles(ctx *common.RenderContext) *experimental.WorkspaceSecurityProfiles {
+	var res *experimental.WorkspaceSecurityProfiles
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace != nil {
+			res = ucfg.Workspace.SecurityProfiles
+		}
+		return nil
+	})
+	return res
+}
+
+// securityProfilesConfigmap renders the profiles ws-daemon installs on its node. They live apart from the
+// ws-daemon config, so that ws-daemon applies changes without a restart.
+func securityProfilesConfigmap(ctx *common.RenderContext) ([]runtime.Object, error) {
+	profiles := securityProfiles(ctx)
+	if profiles == nil {
+		return nil, nil
+	}
+
+	res := secprofile.Profiles{
+		Seccomp:  make(map[string]json.RawMessage, len(profiles.Seccomp)),
+		AppArmor: profiles.AppArmor,
+	}
+	for name, profile := range profiles.Seccomp {
+		if !json.Valid([]byte(profile)) {
+			return nil, fmt.Errorf("seccomp profile %s is not valid JSON", name)
+		}
+		res.Seccomp[SeccompProfilePath(name)] = json.RawMessage(profile)
+	}
+	fc, err := common.ToJSONString(res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal security profiles: %w", err)
+	}
+
+	return []runtime.Object{&corev1.ConfigMap{
+		TypeMeta: common.TypeMetaConfigmap,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        SecurityProfilesConfigMap,
+			Namespace:   ctx.Namespace,
+			Labels:      common.CustomizeLabel(ctx, Component, common.TypeMetaConfigmap),
+			Annotations: common.CustomizeAnnotation(ctx, Component, common.TypeMetaConfigmap),
+		},
+		Data: map[string]string{
+			"profiles.json": string(fc),
+		},
+	}}, nil
+}
@@ -111,8 +111,9 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 					},
 					Limits: &config.ResourceLimitConfiguration{
 						CPU: &config.CpuResourceLimit{
-							MinLimit:   c.Resources.Limits.Cpu.MinLimit,
-							BurstLimit: c.Resources.Limits.Cpu.BurstLimit,
+							MinLimit:    c.Resources.Limits.Cpu.MinLimit,
+							BurstLimit:  c.Resources.Limits.Cpu.BurstLimit,
+							BurstCredit: c.Resources.Limits.Cpu.BurstCredit,
 						},
 						Memory:           c.Resources.Limits.Memory,
 						EphemeralStorage: c.Resources.Limits.EphemeralStorage,
@@ -121,6 +122,36 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 				},
 				Templates: tplsCfg,
 			}
+			if n := c.Resources.Limits.Network; n != nil {
+				classes[k].Container.Limits.Network = &config.NetworkResourceLimit{
+					Egress:  n.Egress,
+					Ingress: n.Ingress,
+				}
+			}
+			if m := c.Resources.Limits.MemoryControl; m != nil {
+				classes[k].Container.Limits.MemoryControl = &config.MemoryResourceLimit{
+					High: m.High,
+					Swap: m.Swap,
+				}
+			}
+			if p := c.SecurityProfile; p != nil {
+				profiles := ucfg.Workspace.SecurityProfiles
+				if profiles == nil {
+					profiles = &experimental.WorkspaceSecurityProfiles{}
+				}
+				classes[k].SecurityProfile = &config.SecurityProfileConfiguration{AppArmor: p.AppArmor}
+				if p.Seccomp != "" {
+					if _, ok := profiles.Seccomp[p.Seccomp]; !ok {
+						return fmt.Errorf("workspace class %q uses unknown seccomp profile %q", k, p.Seccomp)
+					}
+					classes[k].SecurityProfile.Seccomp = wsdaemon.SeccompProfilePath(p.Seccomp)
+				}
+				if p.AppArmor != "" {
+					if _, ok := profiles.AppArmor[p.AppArmor]; !ok {
+						return fmt.Errorf("workspace class %q uses unknown AppArmor profile %q", k, p.AppArmor)
+					}
+				}
+			}
 			for tmpl_n, tmpl_v := range ctpls {
 				if _, ok := tpls[tmpl_n]; ok {
 					return fmt.Errorf("duplicate workspace template %q in workspace class %q", tmpl_n, k)
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
 	config "github.com/gitpod-io/gitpod/installer/pkg/config/v1"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 	"github.com/gitpod-io/gitpod/installer/pkg/config/versions"
 	wsmancfg "github.com/gitpod-io/gitpod/ws-manager/api/config"
 )
@@ -210,3 +211,73 @@ func TestWorkspaceURLTemplates(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkspaceClassSecurityProfile(t *testing.T) {
+	type Expectation struct {
+		Profile *wsmancfg.SecurityProfileConfiguration
+		Error   bool
+	}
+	tests := []struct {
+		Name        string
+		Profile     *experimental.WorkspaceClassSecurityProfile
+		Expectation Expectation
+	}{
+		{
+			Name: "default profiles",
+		},
+		{
+			Name:    "class profiles",
+			Profile: &experimental.WorkspaceClassSecurityProfile{Seccomp: "untrusted", AppArmor: "gitpod-untrusted"},
+			Expectation: Expectation{
+				Profile: &wsmancfg.SecurityProfileConfiguration{Seccomp: "gitpod/untrusted.json", AppArmor: "gitpod-untrusted"},
+			},
+		},
+		{
+			Name:        "unknown seccomp profile",
+			Profile:     &experimental.WorkspaceClassSecurityProfile{Seccomp: "strict"},
+			Expectation: Expectation{Error: true},
+		},
+		{
+			Name:        "unknown AppArmor profile",
+			Profile:     &experimental.WorkspaceClassSecurityProfile{AppArmor: "gitpod-strict"},
+			Expectation: Expectation{Error: true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			ctx, err := common.NewRenderContext(config.Config{
+				Domain: "example.com",
+				ObjectStorage: config.ObjectStorage{
+					InCluster: pointer.Bool(true),
+				},
+				Experimental: &experimental.Config{
+					Workspace: &experimental.WorkspaceConfig{
+						SecurityProfiles: &experimental.WorkspaceSecurityProfiles{
+							Seccomp:  map[string]string{"untrusted": `{"defaultAction":"SCMP_ACT_ERRNO"}`},
+							AppArmor: map[string]string{"gitpod-untrusted": "profile gitpod-untrusted {}"},
+						},
+						WorkspaceClasses: map[string]experimental.WorkspaceClass{
+							"untrusted": {Name: "untrusted", SecurityProfile: test.Profile},
+						},
+					},
+				},
+			}, versions.Manifest{}, "test_namespace")
+			require.NoError(t, err)
+
+			var act Expectation
+			objs, err := configmap(ctx)
+			if err != nil {
+				act.Error = true
+			} else {
+				var serviceConfig wsmancfg.ServiceConfiguration
+				err = json.Unmarshal([]byte(objs[0].(*corev1.ConfigMap).Data["config.json"]), &serviceConfig)
+				require.NoError(t, err)
+				act.Profile = serviceConfig.Manager.WorkspaceClasses["untrusted"].SecurityProfile
+			}
+			if diff := cmp.Diff(test.Expectation, act); diff != "" {
+				t.Errorf("unexpected security profile (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
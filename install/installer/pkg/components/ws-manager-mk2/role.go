@@ -31,6 +31,15 @@ var controllerRules = []rbacv1.PolicyRule{
 		APIGroups: []string{""},
 		Resources: []string{"pod/status"},
 	},
+	{
+		APIGroups: []string{"networking.k8s.io"},
+		Resources: []string{"networkpolicies"},
+		Verbs: []string{
+			"create",
+			"delete",
+			"get",
+		},
+	},
 	{
 		APIGroups: []string{"workspace.gitpod.io"},
 		Resources: []string{"workspaces"},
@@ -110,6 +119,7 @@ var controllerClusterRules = []rbacv1.PolicyRule{
 			"get",
 			"list",
 			"watch",
+			"patch",
 		},
 	},
 }
@@ -50,10 +50,16 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		},
 	}
 
-	ctx.WithExperimental(func(ucfg *experimental.Config) error {
+	var (
+		securityHeaders          *proxy.SecurityHeadersConfig
+		authSession              *proxy.AuthSessionConfig
+		sshAccessTokenSecretFile string
+	)
+	err := ctx.WithExperimental(func(ucfg *experimental.Config) error {
 		if ucfg.Workspace == nil {
 			return nil
 		}
+		securityHeaders = ucfg.Workspace.WSProxy.WorkspacePortSecurityHeaders
 		if ucfg.Workspace.WSProxy.IngressHeader != "" {
 			header = ucfg.Workspace.WSProxy.IngressHeader
 		}
@@ -69,9 +75,28 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 		if ucfg.Workspace.WSProxy.GitpodInstallationWorkspaceHostSuffixRegex != "" {
 			gitpodInstallationWorkspaceHostSuffixRegex = ucfg.Workspace.WSProxy.GitpodInstallationWorkspaceHostSuffixRegex
 		}
+		if as := ucfg.Workspace.WSProxy.AuthSession; as.SecretName != "" {
+			authSession = &proxy.AuthSessionConfig{
+				SecretFile: authSessionSecretMountPath + "/secret",
+				Lifetime:   util.Duration(24 * time.Hour),
+			}
+			if as.Lifetime != "" {
+				d, err := time.ParseDuration(as.Lifetime)
+				if err != nil {
+					return fmt.Errorf("invalid ws-proxy auth session lifetime: %w", err)
+				}
+				authSession.Lifetime = util.Duration(d)
+			}
+		}
+		if ucfg.Workspace.WSProxy.SSHAccessTokenSigningKeySecretName != "" {
+			sshAccessTokenSecretFile = sshAccessTokenSecretMountPath + "/ssh-access-token-signing-key"
+		}
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	// todo(sje): wsManagerProxy seems to be unused
 	wspcfg := config.Config{
@@ -117,6 +142,9 @@ func configmap(ctx *common.RenderContext) ([]runtime.Object, error) {
 			BuiltinPages: proxy.BuiltinPagesConfig{
 				Location: "/app/public",
 			},
+			WorkspacePortSecurityHeaders: securityHeaders,
+			AuthSession:                  authSession,
+			SSHAccessTokenSecretFile:     sshAccessTokenSecretFile,
 		},
 		PProfAddr:          common.LocalhostAddressFromPort(baseserver.BuiltinDebugPort),
 		PrometheusAddr:     common.LocalhostPrometheusAddr(),
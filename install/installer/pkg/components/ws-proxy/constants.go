@@ -21,4 +21,7 @@ const (
 	SSHTargetPort        = 2200
 	SSHPortName          = "ssh"
 	ReadinessPort        = 8086
+
+	authSessionSecretMountPath    = "/mnt/auth-session"
+	sshAccessTokenSecretMountPath = "/mnt/ssh-access-token"
 )
@@ -8,6 +8,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/baseserver"
 	"github.com/gitpod-io/gitpod/installer/pkg/cluster"
 	"github.com/gitpod-io/gitpod/installer/pkg/common"
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
 
 	wsmanagermk2 "github.com/gitpod-io/gitpod/installer/pkg/components/ws-manager-mk2"
 
@@ -81,6 +82,48 @@ func deployment(ctx *common.RenderContext) ([]runtime.Object, error) {
 		})
 	}
 
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || ucfg.Workspace.WSProxy.SSHAccessTokenSigningKeySecretName == "" {
+			return nil
+		}
+
+		volumes = append(volumes, corev1.Volume{
+			Name: "ssh-access-token",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: ucfg.Workspace.WSProxy.SSHAccessTokenSigningKeySecretName,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "ssh-access-token",
+			MountPath: sshAccessTokenSecretMountPath,
+			ReadOnly:  true,
+		})
+		return nil
+	})
+
+	_ = ctx.WithExperimental(func(ucfg *experimental.Config) error {
+		if ucfg.Workspace == nil || ucfg.Workspace.WSProxy.AuthSession.SecretName == "" {
+			return nil
+		}
+
+		volumes = append(volumes, corev1.Volume{
+			Name: "auth-session",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: ucfg.Workspace.WSProxy.AuthSession.SecretName,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "auth-session",
+			MountPath: authSessionSecretMountPath,
+			ReadOnly:  true,
+		})
+		return nil
+	})
+
 	podSpec := corev1.PodSpec{
 		PriorityClassName:         common.SystemNodeCritical,
 		Affinity:                  cluster.WithNodeAffinityHostnameAntiAffinity(Component, cluster.AffinityLabelServices),
@@ -48,6 +48,9 @@ type ConfigVersion interface {
 	// ClusterValidation introduces configuration specific cluster validation checks
 	ClusterValidation(cfg interface{}) cluster.ValidationChecks
 
+	// SecretRefs lists the names of all Kubernetes secrets the config refers to
+	SecretRefs(cfg interface{}) []string
+
 	// CheckDeprecated checks for deprecated config params.
 	// Returns key/value pair of deprecated params/values and any error messages (used for conflicting params)
 	CheckDeprecated(cfg interface{}) (map[string]interface{}, []string)
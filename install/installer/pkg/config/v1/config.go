@@ -172,6 +172,8 @@ const (
 type Observability struct {
 	LogLevel LogLevel `json:"logLevel" validate:"required,log_level"`
 	Tracing  *Tracing `json:"tracing,omitempty"`
+	// CrashReportEndpoint receives the crash reports of panics in the request handlers of Go components
+	CrashReportEndpoint string `json:"crashReportEndpoint,omitempty"`
 }
 
 type Analytics struct {
@@ -350,6 +352,16 @@ type Workspace struct {
 	TimeoutAfterClose *util.Duration `json:"timeoutAfterClose,omitempty"`
 
 	WorkspaceImage string `json:"workspaceImage,omitempty"`
+
+	// ImagePolicy restricts the images users can use for their workspaces, e.g. in their .gitpod.yml
+	ImagePolicy *WorkspaceImagePolicy `json:"imagePolicy,omitempty"`
+}
+
+type WorkspaceImagePolicy struct {
+	// AllowedRegistries are the registries (e.g. docker.io) or repository prefixes (e.g. docker.io/gitpod) images can be used from. All registries are allowed if empty.
+	AllowedRegistries []string `json:"allowedRegistries,omitempty" validate:"dive,required"`
+	// DeniedRegistries are the registries or repository prefixes images must not be used from. Takes precedence over AllowedRegistries.
+	DeniedRegistries []string `json:"deniedRegistries,omitempty" validate:"dive,required"`
 }
 
 type OpenVSX struct {
@@ -409,6 +421,32 @@ type Components struct {
 	IDE        *IDEComponents        `json:"ide"`
 	PodConfig  map[string]*PodConfig `json:"podConfig,omitempty"`
 	Proxy      *ProxyComponent       `json:"proxy,omitempty"`
+	// Disabled lists the components which are not installed, e.g. for minimal installations or because the
+	// environment provides its own equivalent. Only the components in DisableableComponents can be disabled.
+	Disabled []string `json:"disabled,omitempty" validate:"component_dependencies,dive,disableable_component"`
+}
+
+// DisableableComponents maps the components which can be disabled to the components which depend on them.
+// A component can only be disabled together with the components which depend on it.
+var DisableableComponents = map[string][]string{
+	"agent-smith":       {},
+	"dashboard":         {},
+	"ide-metrics":       {},
+	"openvsx-proxy":     {},
+	"public-api-server": {"dashboard"},
+}
+
+// ComponentEnabled returns false if the component is disabled
+func (c *Config) ComponentEnabled(component string) bool {
+	if c.Components == nil {
+		return true
+	}
+	for _, d := range c.Components.Disabled {
+		if d == component {
+			return false
+		}
+	}
+	return true
 }
 
 type IDEComponents struct {
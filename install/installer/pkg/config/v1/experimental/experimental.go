@@ -17,6 +17,7 @@ import (
 	"github.com/gitpod-io/gitpod/common-go/grpc"
 	db "github.com/gitpod-io/gitpod/components/gitpod-db/go"
 	"github.com/gitpod-io/gitpod/ws-daemon/pkg/cpulimit"
+	wsproxy "github.com/gitpod-io/gitpod/ws-proxy/pkg/proxy"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,6 +48,15 @@ type NodeToContainerMappingValues struct {
 	Value string `json:"value"`
 }
 
+type IOLimitsBurst struct {
+	WriteBWPerSecond resource.Quantity `json:"writeBandwidthPerSecond"`
+	ReadBWPerSecond  resource.Quantity `json:"readBandwidthPerSecond"`
+	WriteIOPS        int64             `json:"writeIOPS"`
+	ReadIOPS         int64             `json:"readIOPS"`
+	// MaxDuration limits how long a workspace can burst, e.g. 10m
+	MaxDuration string `json:"maxDuration,omitempty"`
+}
+
 type WorkspaceConfig struct {
 	Tracing                  *Tracing `json:"tracing,omitempty"`
 	Stage                    string   `json:"stage,omitempty"`
@@ -63,19 +73,39 @@ type WorkspaceConfig struct {
 		NodeCPUBandwidth resource.Quantity `json:"nodeBandwidth"`
 		Limit            resource.Quantity `json:"limit"`
 		BurstLimit       resource.Quantity `json:"burstLimit"`
+		// PSI makes workspaces burst based on CPU pressure stall information rather than CFS throttling
+		PSI *CPULimitsPSI `json:"psi,omitempty"`
 	}
 	IOLimits struct {
 		WriteBWPerSecond resource.Quantity `json:"writeBandwidthPerSecond"`
 		ReadBWPerSecond  resource.Quantity `json:"readBandwidthPerSecond"`
 		WriteIOPS        int64             `json:"writeIOPS"`
 		ReadIOPS         int64             `json:"readIOPS"`
+		// Burst are the limits which apply while the content of a workspace is initialized
+		Burst *IOLimitsBurst `json:"burst,omitempty"`
 	} `json:"ioLimits"`
 	NetworkLimits struct {
 		Enabled              bool  `json:"enabled"`
 		Enforce              bool  `json:"enforce"`
 		ConnectionsPerMinute int64 `json:"connectionsPerMinute"`
 		BucketSize           int64 `json:"bucketSize"`
+		// EnableBandwidth shapes the network traffic of workspaces to the bandwidth of their workspace class
+		EnableBandwidth bool `json:"enableBandwidth"`
 	} `json:"networkLimits"`
+	// MemoryLimits throttles workspaces above the memory.high of their workspace class and lets them swap
+	MemoryLimits struct {
+		Enabled bool `json:"enabled"`
+		// Swap sets up a zram device on each node which workspaces swap to, if their class allows it
+		Swap struct {
+			Enabled   bool              `json:"enabled"`
+			Size      resource.Quantity `json:"size"`
+			Algorithm string            `json:"algorithm,omitempty"`
+		} `json:"swap"`
+	} `json:"memoryLimits"`
+	// SecurityProfiles are the seccomp and AppArmor profiles workspace classes can use instead of the defaults.
+	// ws-daemon installs them on every workspace node and applies changes without a restart.
+	SecurityProfiles *WorkspaceSecurityProfiles `json:"securityProfiles,omitempty"`
+
 	OOMScores struct {
 		Enabled bool `json:"enabled"`
 		Tier1   int  `json:"tier1"`
@@ -105,6 +135,85 @@ type WorkspaceConfig struct {
 		Runtime struct {
 			NodeToContainerMapping []NodeToContainerMappingValues `json:"nodeToContainerMapping"`
 		} `json:"runtime"`
+		// EnableResourceUsage lets ws-manager stream the resource usage of workspaces from ws-daemon
+		EnableResourceUsage bool `json:"enableResourceUsage"`
+		// RestoreCache keeps recently uploaded backups on the node, such that workspaces restarting on the same node skip the download.
+		// The cache keeps backups unencrypted, hence it cannot be enabled together with ContentEncryption.
+		RestoreCache struct {
+			Enabled bool              `json:"enabled"`
+			MaxSize resource.Quantity `json:"maxSize,omitempty"`
+			// Prebuilds downloads prebuilds into the cache, such that workspaces starting from the same prebuild on a node share the download
+			Prebuilds bool `json:"prebuilds,omitempty"`
+		} `json:"restoreCache"`
+		// SnapshotExport lets workspace snapshots be pushed as images to a registry
+		SnapshotExport struct {
+			// Repository is the image repository snapshots are pushed to
+			Repository string `json:"repository"`
+			// Secret is the name of a dockerconfigjson secret holding the credentials for the repository
+			Secret string `json:"secret,omitempty"`
+		} `json:"snapshotExport"`
+		// DockerCache puts the Docker data root of workspaces under a quota of its own and excludes it from backups
+		DockerCache struct {
+			Enabled bool              `json:"enabled"`
+			Size    resource.Quantity `json:"size,omitempty"`
+			// IncludeInBackup keeps the Docker data root in workspace backups nonetheless
+			IncludeInBackup bool `json:"includeInBackup,omitempty"`
+		} `json:"dockerCache"`
+		// DeltaBackups uploads only the files which changed since the previous backup of a workspace
+		DeltaBackups struct {
+			Enabled bool `json:"enabled"`
+			// FullBackupInterval is the number of delta backups after which a full backup is uploaded again
+			FullBackupInterval int `json:"fullBackupInterval,omitempty"`
+		} `json:"deltaBackups"`
+		// LiveBackups uploads the changes of running workspaces every interval, such that the loss of a node
+		// loses at most one interval of work. Requires deltaBackups unless the restic backup engine is used.
+		LiveBackups struct {
+			Enabled bool `json:"enabled"`
+			// Interval is the time between the live backups of a workspace, e.g. 5m. Defaults to 5m
+			Interval string `json:"interval,omitempty"`
+		} `json:"liveBackups"`
+		// BackupCompression compresses workspace backups, using one of none, gzip or zstd
+		BackupCompression struct {
+			Codec string `json:"codec,omitempty"`
+			// Level is the compression level of the codec, the default level of the codec is used if unset
+			Level int `json:"level,omitempty"`
+		} `json:"backupCompression"`
+		// BackupUpload splits large workspace backups into parts which are uploaded concurrently
+		BackupUpload struct {
+			// PartSize is the size of the parts, e.g. 64Mi. The default of the storage is used if unset
+			PartSize *resource.Quantity `json:"partSize,omitempty"`
+			// Parallelism is the number of parts uploaded concurrently
+			Parallelism int `json:"parallelism,omitempty"`
+			// BandwidthLimit limits the upload rate of each workspace's backups per second, e.g. 50Mi.
+			// Workspace classes can override it using their backupBandwidth limit.
+			BandwidthLimit *resource.Quantity `json:"bandwidthLimit,omitempty"`
+		} `json:"backupUpload"`
+		// BackupEngine selects how workspace backups are uploaded, either as tar archives or as snapshots of a
+		// restic repository per workspace, which are deduplicated, encrypted and incremental. Defaults to tar.
+		BackupEngine struct {
+			Engine string `json:"engine,omitempty"`
+			// ResticSecret is the name of a secret holding the password of the restic repositories in its password key
+			ResticSecret string `json:"resticSecret,omitempty"`
+			// ResticKeepLast is the number of restic backups kept per workspace. Defaults to 3
+			ResticKeepLast int `json:"resticKeepLast,omitempty"`
+		} `json:"backupEngine"`
+		// Forensics collects a bundle of cgroup stats, processes, mounts and logs when a workspace terminates abnormally
+		Forensics struct {
+			Enabled bool `json:"enabled"`
+			// LogTailLines is the number of log lines of each workspace container in a bundle
+			LogTailLines int64 `json:"logTailLines,omitempty"`
+		} `json:"forensics"`
+		// ContentEncryption encrypts the content of workspaces on the node using fscrypt with a key per workspace,
+		// which is wiped when the workspace stops. The filesystem of the working area must support fscrypt.
+		ContentEncryption struct {
+			Enabled bool `json:"enabled"`
+		} `json:"contentEncryption"`
+		// OwnershipRepair hands restored files the workspace user cannot access back to it, e.g. from older backups
+		OwnershipRepair struct {
+			Enabled bool `json:"enabled"`
+			// IncludeRoot also hands files owned by root within the workspace to the workspace user
+			IncludeRoot bool `json:"includeRoot,omitempty"`
+		} `json:"ownershipRepair"`
 	} `json:"wsDaemon"`
 
 	WorkspaceClasses        map[string]WorkspaceClass `json:"classes,omitempty"`
@@ -116,11 +225,27 @@ type WorkspaceConfig struct {
 		GitpodInstallationHostName                 string `json:"gitpodInstallationHostName"`
 		GitpodInstallationWorkspaceHostSuffix      string `json:"gitpodInstallationWorkspaceHostSuffix"`
 		GitpodInstallationWorkspaceHostSuffixRegex string `json:"gitpodInstallationWorkspaceHostSuffixRegex"`
+		// WorkspacePortSecurityHeaders overrides the security headers of responses from exposed workspace ports
+		WorkspacePortSecurityHeaders *wsproxy.SecurityHeadersConfig `json:"workspacePortSecurityHeaders,omitempty"`
+		// AuthSession shares the authentication of workspace owners across all workspace and port subdomains,
+		// such that owners don't need the owner cookie of every workspace instance they access.
+		AuthSession struct {
+			// SecretName is the name of the secret whose "secret" key holds the signing key of at least 32 bytes
+			SecretName string `json:"secretName,omitempty"`
+			// Lifetime is how long a session is valid, e.g. 12h. Defaults to 24h
+			Lifetime string `json:"lifetime,omitempty"`
+		} `json:"authSession"`
+		// SSHAccessTokenSigningKeySecretName is the name of the secret whose "ssh-access-token-signing-key" key holds the key of at
+		// least 32 bytes which server signs short-lived SSH access tokens with, and which the SSH gateway verifies them with.
+		// Without it, server does not issue SSH access tokens.
+		SSHAccessTokenSigningKeySecretName string `json:"sshAccessTokenSigningKeySecretName,omitempty"`
 	} `json:"wsProxy"`
 
 	ContentService struct {
 		// Deprecated
 		UsageReportBucketName string `json:"usageReportBucketName"`
+		// ForensicsRetention is how long forensic bundles of abnormally terminated workspaces are kept, e.g. 336h
+		ForensicsRetention string `json:"forensicsRetention,omitempty"`
 	} `json:"contentService"`
 
 	EnableProtectedSecrets *bool `json:"enableProtectedSecrets"`
@@ -136,6 +261,13 @@ type WorkspaceClass struct {
 	Description string             `json:"description"`
 	Resources   WorkspaceResources `json:"resources" validate:"required"`
 	Templates   WorkspaceTemplates `json:"templates,omitempty"`
+	// SecurityProfile names the entries of the workspace security profiles workspaces of this class run with
+	SecurityProfile *WorkspaceClassSecurityProfile `json:"securityProfile,omitempty"`
+}
+
+type WorkspaceClassSecurityProfile struct {
+	Seccomp  string `json:"seccomp,omitempty"`
+	AppArmor string `json:"appArmor,omitempty"`
 }
 
 type WorkspaceResources struct {
@@ -148,12 +280,47 @@ type WorkspaceLimits struct {
 	Memory           string             `json:"memory"`
 	Storage          string             `json:"storage"`
 	EphemeralStorage string             `json:"ephemeral-storage"`
+	// BackupBandwidth limits the upload rate of backups of workspaces of this class per second, e.g. 100Mi
+	BackupBandwidth string `json:"backupBandwidth,omitempty"`
+	// Network limits the bandwidth of workspaces of this class in bits per second, e.g. 100M
+	Network *WorkspaceNetworkLimits `json:"network,omitempty"`
+	// MemoryControl throttles workspaces of this class above High and lets them swap up to Swap, e.g. 7Gi and 4Gi
+	MemoryControl *WorkspaceMemoryControl `json:"memoryControl,omitempty"`
+}
+
+type WorkspaceNetworkLimits struct {
+	Egress  string `json:"egress,omitempty"`
+	Ingress string `json:"ingress,omitempty"`
+}
+
+type WorkspaceSecurityProfiles struct {
+	// Seccomp maps profile names to seccomp profiles in JSON
+	Seccomp map[string]string `json:"seccomp,omitempty"`
+	// AppArmor maps profile names to AppArmor profiles, each of which must declare a profile of its name
+	AppArmor map[string]string `json:"appArmor,omitempty"`
+}
+
+type WorkspaceMemoryControl struct {
+	High string `json:"high,omitempty"`
+	Swap string `json:"swap,omitempty"`
 }
 
 type WorkspaceCpuLimits struct {
 	Buckets    []cpulimit.Bucket `json:"buckets"`
 	MinLimit   string            `json:"min"`
 	BurstLimit string            `json:"burst"`
+	// BurstCredit is the CPU time a workspace can save up for bursts while it is under CPU pressure, e.g. 10m
+	BurstCredit string `json:"burstCredit,omitempty"`
+}
+
+type CPULimitsPSI struct {
+	Enabled bool `json:"enabled"`
+	// NodeThreshold is the share of time (0-1) the node may stall on CPU before no workspace may burst
+	NodeThreshold float64 `json:"nodeThreshold,omitempty"`
+	// WorkspaceThreshold is the share of time (0-1) a workspace must stall on CPU to spend its burst credit
+	WorkspaceThreshold float64 `json:"workspaceThreshold,omitempty"`
+	// MaxCredit is the default burst credit of workspaces, e.g. 10m
+	MaxCredit string `json:"maxCredit,omitempty"`
 }
 
 type WorkspaceTemplates struct {
@@ -0,0 +1,50 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package experimental
+
+// SecretRefs lists the names of the Kubernetes secrets the experimental config refers to
+func SecretRefs(cfg *Config) []string {
+	if cfg == nil {
+		return nil
+	}
+
+	var res []string
+	add := func(name string) {
+		if name != "" {
+			res = append(res, name)
+		}
+	}
+
+	if ws := cfg.Workspace; ws != nil {
+		add(ws.RegistryFacade.RedisCache.PasswordSecret)
+		add(ws.WSDaemon.SnapshotExport.Secret)
+		add(ws.WSDaemon.BackupEngine.ResticSecret)
+	}
+
+	if webapp := cfg.WebApp; webapp != nil {
+		if webapp.PublicAPI != nil {
+			add(webapp.PublicAPI.StripeSecretName)
+			add(webapp.PublicAPI.PersonalAccessTokenSigningKeySecretName)
+		}
+		if webapp.Server != nil {
+			add(webapp.Server.StripeSecret)
+			add(webapp.Server.LinkedInSecret)
+			if webapp.Server.GithubApp != nil {
+				add(webapp.Server.GithubApp.CertSecretName)
+			}
+		}
+		if webapp.IAM != nil {
+			add(webapp.IAM.OIDCClientsSecretName)
+		}
+		if webapp.SpiceDB != nil {
+			add(webapp.SpiceDB.SecretRef)
+		}
+		if webapp.Redis != nil {
+			add(webapp.Redis.SecretRef)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2023 Gitpod GmbH. All rights reserved.
+// Licensed under the GNU Affero General Public License (AGPL).
+// See License.AGPL.txt in the project root for license information.
+
+package config
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/gitpod-io/gitpod/installer/pkg/config/v1/experimental"
+)
+
+var objectRefType = reflect.TypeOf(ObjectRef{})
+
+// SecretRefs lists the names of all Kubernetes secrets the config refers to, sorted and without duplicates
+func (v version) SecretRefs(rcfg interface{}) []string {
+	cfg := rcfg.(*Config)
+
+	names := make(map[string]struct{})
+	collectSecretRefs(reflect.ValueOf(cfg), names)
+	for _, name := range experimental.SecretRefs(cfg.Experimental) {
+		names[name] = struct{}{}
+	}
+
+	res := make([]string, 0, len(names))
+	for name := range names {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// collectSecretRefs walks the config and adds the name of every ObjectRef of kind secret
+func collectSecretRefs(val reflect.Value, names map[string]struct{}) {
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !val.IsNil() {
+			collectSecretRefs(val.Elem(), names)
+		}
+	case reflect.Struct:
+		if val.Type() == objectRefType {
+			ref := val.Interface().(ObjectRef)
+			if ref.Kind == ObjectRefSecret && ref.Name != "" {
+				names[ref.Name] = struct{}{}
+			}
+			return
+		}
+		for i := 0; i < val.NumField(); i++ {
+			if val.Type().Field(i).IsExported() {
+				collectSecretRefs(val.Field(i), names)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			collectSecretRefs(val.Index(i), names)
+		}
+	case reflect.Map:
+		iter := val.MapRange()
+		for iter.Next() {
+			collectSecretRefs(iter.Value(), names)
+		}
+	}
+}
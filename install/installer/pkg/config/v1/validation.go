@@ -64,6 +64,24 @@ func (v version) LoadValidationFuncs(validate *validator.Validate) error {
 			_, ok := LogLevelList[LogLevel(fl.Field().String())]
 			return ok
 		},
+		"disableable_component": func(fl validator.FieldLevel) bool {
+			_, ok := DisableableComponents[fl.Field().String()]
+			return ok
+		},
+		"component_dependencies": func(fl validator.FieldLevel) bool {
+			disabled := make(map[string]struct{}, fl.Field().Len())
+			for i := 0; i < fl.Field().Len(); i++ {
+				disabled[fl.Field().Index(i).String()] = struct{}{}
+			}
+			for component := range disabled {
+				for _, dependent := range DisableableComponents[component] {
+					if _, ok := disabled[dependent]; !ok {
+						return false
+					}
+				}
+			}
+			return true
+		},
 		"block_new_users_passlist": func(fl validator.FieldLevel) bool {
 			if !fl.Parent().FieldByName("Enabled").Bool() {
 				// Not enabled - it's valid
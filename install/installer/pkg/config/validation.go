@@ -57,6 +57,10 @@ func Validate(version ConfigVersion, cfg interface{}) (r *ValidationResult, err
 					res.Fatal = append(res.Fatal, fmt.Sprintf("Field '%s' must start with '%s'", v.Namespace(), v.Param()))
 				case "block_new_users_passlist":
 					res.Fatal = append(res.Fatal, fmt.Sprintf("Field '%s' failed. If 'Enabled = true', there must be at least one fully-qualified domain name in the passlist", v.Namespace()))
+				case "disableable_component":
+					res.Fatal = append(res.Fatal, fmt.Sprintf("Field '%s' failed. Component '%s' cannot be disabled", v.Namespace(), v.Value()))
+				case "component_dependencies":
+					res.Fatal = append(res.Fatal, fmt.Sprintf("Field '%s' failed. Components can only be disabled together with the components depending on them", v.Namespace()))
 				default:
 					// General error message
 					res.Fatal = append(res.Fatal, fmt.Sprintf("Field '%s' failed %s validation", v.Namespace(), v.Tag()))